@@ -0,0 +1,106 @@
+package adapter
+
+import (
+	"context"
+
+	"github.com/go-errors/errors"
+	"github.com/hantabaru1014/baru-reso-headless-controller/db"
+	"github.com/hantabaru1014/baru-reso-headless-controller/domain"
+	"github.com/hantabaru1014/baru-reso-headless-controller/domain/entity"
+	"github.com/hantabaru1014/baru-reso-headless-controller/usecase/port"
+)
+
+var _ port.ApiTokenRepository = (*ApiTokenRepository)(nil)
+
+type ApiTokenRepository struct {
+	q *db.Queries
+}
+
+func NewApiTokenRepository(q *db.Queries) *ApiTokenRepository {
+	return &ApiTokenRepository{q: q}
+}
+
+func (r *ApiTokenRepository) Create(ctx context.Context, token *entity.ApiToken) error {
+	permissionKeys := token.PermissionKeys
+	if permissionKeys == nil {
+		permissionKeys = []string{}
+	}
+
+	row, err := r.q.CreateApiToken(ctx, db.CreateApiTokenParams{
+		ID:             token.ID,
+		UserID:         token.UserID,
+		Name:           token.Name,
+		TokenHash:      token.TokenHash,
+		TokenPrefix:    token.TokenPrefix,
+		GroupID:        textFromPtr(token.GroupID),
+		PermissionKeys: permissionKeys,
+		ExpiresAt:      timestamptzFromPtr(token.ExpiresAt),
+	})
+	if err != nil {
+		return errors.WrapPrefix(convertDBErr(err), "api_token", 0)
+	}
+
+	*token = *apiTokenToEntity(row)
+
+	return nil
+}
+
+func (r *ApiTokenRepository) GetByHash(ctx context.Context, tokenHash string) (*entity.ApiToken, error) {
+	row, err := r.q.GetApiTokenByHash(ctx, tokenHash)
+	if err != nil {
+		return nil, errors.WrapPrefix(convertDBErr(err), "api_token", 0)
+	}
+
+	return apiTokenToEntity(row), nil
+}
+
+func (r *ApiTokenRepository) ListByUser(ctx context.Context, userID string) (entity.ApiTokenList, error) {
+	rows, err := r.q.ListApiTokensByUser(ctx, userID)
+	if err != nil {
+		return nil, errors.WrapPrefix(convertDBErr(err), "api_token", 0)
+	}
+
+	result := make(entity.ApiTokenList, 0, len(rows))
+	for _, row := range rows {
+		result = append(result, apiTokenToEntity(row))
+	}
+
+	return result, nil
+}
+
+func (r *ApiTokenRepository) Revoke(ctx context.Context, userID, id string) error {
+	n, err := r.q.RevokeApiToken(ctx, db.RevokeApiTokenParams{ID: id, UserID: userID})
+	if err != nil {
+		return errors.WrapPrefix(convertDBErr(err), "api_token", 0)
+	}
+
+	if n == 0 {
+		return errors.WrapPrefix(domain.ErrNotFound, "api_token", 0)
+	}
+
+	return nil
+}
+
+func (r *ApiTokenRepository) TouchLastUsed(ctx context.Context, id string) error {
+	if err := r.q.TouchApiTokenLastUsed(ctx, id); err != nil {
+		return errors.WrapPrefix(convertDBErr(err), "api_token", 0)
+	}
+
+	return nil
+}
+
+func apiTokenToEntity(row db.ApiToken) *entity.ApiToken {
+	return &entity.ApiToken{
+		ID:             row.ID,
+		UserID:         row.UserID,
+		Name:           row.Name,
+		TokenHash:      row.TokenHash,
+		TokenPrefix:    row.TokenPrefix,
+		GroupID:        ptrFromText(row.GroupID),
+		PermissionKeys: row.PermissionKeys,
+		ExpiresAt:      ptrFromTimestamptz(row.ExpiresAt),
+		LastUsedAt:     ptrFromTimestamptz(row.LastUsedAt),
+		RevokedAt:      ptrFromTimestamptz(row.RevokedAt),
+		CreatedAt:      row.CreatedAt.Time,
+	}
+}
@@ -0,0 +1,126 @@
+package adapter
+
+import (
+	"context"
+
+	"github.com/go-errors/errors"
+	"github.com/hantabaru1014/baru-reso-headless-controller/db"
+	"github.com/hantabaru1014/baru-reso-headless-controller/domain/entity"
+	"github.com/hantabaru1014/baru-reso-headless-controller/usecase/port"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+var _ port.AuditEventRepository = (*AuditEventRepository)(nil)
+
+type AuditEventRepository struct {
+	q *db.Queries
+}
+
+func NewAuditEventRepository(q *db.Queries) *AuditEventRepository {
+	return &AuditEventRepository{q: q}
+}
+
+func (r *AuditEventRepository) Create(ctx context.Context, event *entity.AuditEvent) error {
+	summary := []byte(event.RequestSummary)
+	if len(summary) == 0 {
+		summary = []byte("{}")
+	}
+
+	row, err := r.q.CreateAuditEvent(ctx, db.CreateAuditEventParams{
+		UserID:         textFromPtr(event.UserID),
+		Procedure:      event.Procedure,
+		ResourceType:   string(event.ResourceType),
+		ResourceID:     textFromPtr(event.ResourceID),
+		GroupID:        textFromPtr(event.GroupID),
+		RequestSummary: summary,
+		Outcome:        event.Outcome,
+		ErrorMessage:   textFromPtr(event.ErrorMessage),
+		RemoteAddr:     textFromPtr(event.RemoteAddr),
+	})
+	if err != nil {
+		return errors.WrapPrefix(convertDBErr(err), "audit_event", 0)
+	}
+
+	event.ID = row.ID
+	event.OccurredAt = row.OccurredAt.Time
+
+	return nil
+}
+
+func (r *AuditEventRepository) List(ctx context.Context, filter port.AuditEventFilter, pageIndex, pageSize int32) (*port.AuditEventListResult, error) {
+	params := db.ListAuditEventsParams{
+		GroupID:      textFromPtr(filter.GroupID),
+		UserID:       textFromPtr(filter.UserID),
+		ResourceType: resourceTypeText(filter.ResourceType),
+		ResourceID:   textFromPtr(filter.ResourceID),
+		Since:        timestamptzFromPtr(filter.Since),
+		Until:        timestamptzFromPtr(filter.Until),
+		PageSize:     pageSize,
+		PageOffset:   pageIndex * pageSize,
+	}
+
+	rows, err := r.q.ListAuditEvents(ctx, params)
+	if err != nil {
+		return nil, errors.WrapPrefix(convertDBErr(err), "audit_event", 0)
+	}
+
+	result := &port.AuditEventListResult{
+		Items: make(entity.AuditEventList, 0, len(rows)),
+	}
+	if len(rows) > 0 {
+		result.TotalCount = int32(rows[0].TotalCount) //nolint:gosec // G115: ページングの表示用. int32 を超える件数は想定しない
+	}
+
+	for _, row := range rows {
+		result.Items = append(result.Items, auditEventToEntity(row.AuditEvent))
+	}
+
+	return result, nil
+}
+
+func (r *AuditEventRepository) ListAfter(ctx context.Context, filter port.AuditEventFilter, afterID int64, limit int32) (entity.AuditEventList, error) {
+	rows, err := r.q.ListAuditEventsAfter(ctx, db.ListAuditEventsAfterParams{
+		AfterID:      afterID,
+		GroupID:      textFromPtr(filter.GroupID),
+		UserID:       textFromPtr(filter.UserID),
+		ResourceType: resourceTypeText(filter.ResourceType),
+		ResourceID:   textFromPtr(filter.ResourceID),
+		Since:        timestamptzFromPtr(filter.Since),
+		Until:        timestamptzFromPtr(filter.Until),
+		PageSize:     limit,
+	})
+	if err != nil {
+		return nil, errors.WrapPrefix(convertDBErr(err), "audit_event", 0)
+	}
+
+	result := make(entity.AuditEventList, 0, len(rows))
+	for _, row := range rows {
+		result = append(result, auditEventToEntity(row))
+	}
+
+	return result, nil
+}
+
+func resourceTypeText(t *entity.AuditResourceType) pgtype.Text {
+	if t == nil {
+		return pgtype.Text{}
+	}
+
+	return pgtype.Text{String: string(*t), Valid: true}
+}
+
+func auditEventToEntity(row db.AuditEvent) *entity.AuditEvent {
+	return &entity.AuditEvent{
+		ID:             row.ID,
+		OccurredAt:     row.OccurredAt.Time,
+		UserID:         ptrFromText(row.UserID),
+		Procedure:      row.Procedure,
+		ResourceType:   entity.AuditResourceType(row.ResourceType),
+		ResourceID:     ptrFromText(row.ResourceID),
+		GroupID:        ptrFromText(row.GroupID),
+		RequestSummary: row.RequestSummary,
+		Outcome:        row.Outcome,
+		ErrorMessage:   ptrFromText(row.ErrorMessage),
+		RemoteAddr:     ptrFromText(row.RemoteAddr),
+	}
+}
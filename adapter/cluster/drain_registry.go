@@ -0,0 +1,106 @@
+package cluster
+
+import (
+	"context"
+	"log/slog"
+	"sync"
+	"time"
+
+	"github.com/go-errors/errors"
+	"github.com/hantabaru1014/baru-reso-headless-controller/config"
+	"github.com/hantabaru1014/baru-reso-headless-controller/db"
+	"github.com/hantabaru1014/baru-reso-headless-controller/usecase/port"
+)
+
+// DrainRegistry shares the hosts the leader's HostUpgradeOrchestrator is
+// draining through host_upgrade_drains. The leader writes it as a
+// worker.HostDrainStore; every instance polls it so StartSession is
+// rejected for a draining host whichever instance serves the request.
+type DrainRegistry struct {
+	q        *db.Queries
+	interval time.Duration
+
+	mu       sync.RWMutex
+	draining map[string]struct{}
+}
+
+var _ port.HostDrainer = (*DrainRegistry)(nil)
+
+func NewDrainRegistry(q *db.Queries, cfg *config.ClusterConfig) *DrainRegistry {
+	return &DrainRegistry{
+		q:        q,
+		interval: cfg.HeartbeatInterval,
+		draining: make(map[string]struct{}),
+	}
+}
+
+func (r *DrainRegistry) Name() string { return "cluster-drain-registry" }
+
+func (r *DrainRegistry) Run(ctx context.Context) error {
+	r.refresh(ctx)
+
+	ticker := time.NewTicker(r.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+			r.refresh(ctx)
+		}
+	}
+}
+
+// IsHostDraining implements port.HostDrainer. It may lag the leader by up
+// to one poll interval.
+func (r *DrainRegistry) IsHostDraining(hostID string) bool {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	_, ok := r.draining[hostID]
+
+	return ok
+}
+
+func (r *DrainRegistry) AddDrain(ctx context.Context, hostID, targetTag string) error {
+	if err := r.q.UpsertHostUpgradeDrain(ctx, db.UpsertHostUpgradeDrainParams{HostID: hostID, TargetTag: targetTag}); err != nil {
+		return errors.WrapPrefix(err, "add host drain", 0)
+	}
+
+	return nil
+}
+
+func (r *DrainRegistry) RemoveDrain(ctx context.Context, hostID string) error {
+	if err := r.q.DeleteHostUpgradeDrain(ctx, hostID); err != nil {
+		return errors.WrapPrefix(err, "remove host drain", 0)
+	}
+
+	return nil
+}
+
+func (r *DrainRegistry) ClearDrains(ctx context.Context) error {
+	if err := r.q.DeleteAllHostUpgradeDrains(ctx); err != nil {
+		return errors.WrapPrefix(err, "clear host drains", 0)
+	}
+
+	return nil
+}
+
+func (r *DrainRegistry) refresh(ctx context.Context) {
+	ids, err := r.q.ListHostUpgradeDrainHostIDs(ctx)
+	if err != nil {
+		slog.Error("cluster-drain-registry: failed to list draining hosts", "error", err)
+
+		return
+	}
+
+	draining := make(map[string]struct{}, len(ids))
+	for _, id := range ids {
+		draining[id] = struct{}{}
+	}
+
+	r.mu.Lock()
+	r.draining = draining
+	r.mu.Unlock()
+}
@@ -0,0 +1,107 @@
+package cluster
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/go-errors/errors"
+	"github.com/hantabaru1014/baru-reso-headless-controller/config"
+	"github.com/hantabaru1014/baru-reso-headless-controller/db"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// leaderLockKey is the advisory lock key guarding the leadership. Any
+// constant works as long as nothing else in the database uses it.
+const leaderLockKey int64 = 0x62726863_6c656164 // "brhclead"
+
+// AdvisoryLockElector elects the leader with a session-level Postgres
+// advisory lock held on a dedicated connection. If that connection dies
+// the server drops the lock, so a crashed leader is replaced as soon as
+// Postgres notices, and this instance stops acting as the leader once its
+// health check fails.
+type AdvisoryLockElector struct {
+	pool     *pgxpool.Pool
+	interval time.Duration
+}
+
+func NewAdvisoryLockElector(pool *pgxpool.Pool, cfg *config.ClusterConfig) *AdvisoryLockElector {
+	return &AdvisoryLockElector{pool: pool, interval: cfg.HeartbeatInterval}
+}
+
+// Campaign implements worker.LeaderElector.
+func (e *AdvisoryLockElector) Campaign(ctx context.Context) (context.Context, func(), error) {
+	conn, err := e.pool.Acquire(ctx)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, 0)
+	}
+
+	q := db.New(conn)
+
+	for {
+		acquired, err := q.TryAdvisoryLock(ctx, leaderLockKey)
+		if err != nil {
+			conn.Release()
+
+			return nil, nil, errors.WrapPrefix(err, "try leader lock", 0)
+		}
+
+		if acquired {
+			break
+		}
+
+		select {
+		case <-ctx.Done():
+			conn.Release()
+
+			return nil, nil, ctx.Err()
+		case <-time.After(e.interval):
+		}
+	}
+
+	leaderCtx, cancel := context.WithCancel(ctx)
+	watched := make(chan struct{})
+
+	go func() {
+		defer close(watched)
+
+		e.watch(leaderCtx, cancel, conn)
+	}()
+
+	resign := func() {
+		cancel()
+		<-watched
+
+		// Closing the session is what releases the lock, and it also works
+		// when the connection is already broken.
+		if err := conn.Hijack().Close(context.Background()); err != nil {
+			slog.Debug("leader-elector: close after resign", "error", err)
+		}
+	}
+
+	return leaderCtx, resign, nil
+}
+
+// watch pings the lock connection and cancels the leadership when it fails.
+func (e *AdvisoryLockElector) watch(ctx context.Context, cancel context.CancelFunc, conn *pgxpool.Conn) {
+	ticker := time.NewTicker(e.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			pingCtx, pingCancel := context.WithTimeout(ctx, e.interval)
+			err := conn.Ping(pingCtx)
+			pingCancel()
+
+			if err != nil && ctx.Err() == nil {
+				slog.Error("leader-elector: lost the lock connection; stepping down", "error", err)
+				cancel()
+
+				return
+			}
+		}
+	}
+}
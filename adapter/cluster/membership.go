@@ -0,0 +1,177 @@
+package cluster
+
+import (
+	"context"
+	"hash/fnv"
+	"log/slog"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/hantabaru1014/baru-reso-headless-controller/config"
+	"github.com/hantabaru1014/baru-reso-headless-controller/db"
+	"github.com/hantabaru1014/baru-reso-headless-controller/usecase/port"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+// staleInstanceRetention is how long rows of instances that stopped
+// heartbeating are kept before being cleaned up. They stop counting as
+// live after the configured TTL already; this only keeps the table small.
+const staleInstanceRetention = time.Hour
+
+// Membership heartbeats this instance into controller_instances and
+// assigns every stream key (host or docker node id) to exactly one live
+// instance by rendezvous hashing, so only the keys of an instance that
+// joins or leaves move.
+//
+// Until the first heartbeat has completed the instance owns nothing, and
+// it gives up everything when it could not heartbeat for longer than the
+// TTL, since by then the others have taken its keys over.
+type Membership struct {
+	q          *db.Queries
+	instanceID string
+	interval   time.Duration
+	ttl        time.Duration
+
+	mu       sync.RWMutex
+	live     []string
+	lastBeat time.Time
+}
+
+var _ port.StreamOwnership = (*Membership)(nil)
+
+func NewMembership(q *db.Queries, cfg *config.ClusterConfig) *Membership {
+	return &Membership{
+		q:          q,
+		instanceID: cfg.InstanceID,
+		interval:   cfg.HeartbeatInterval,
+		ttl:        cfg.InstanceTTL,
+	}
+}
+
+func (m *Membership) Name() string { return "cluster-membership" }
+
+func (m *Membership) Run(ctx context.Context) error {
+	m.heartbeat(ctx)
+
+	ticker := time.NewTicker(m.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			m.leave()
+
+			return ctx.Err()
+		case <-ticker.C:
+			m.heartbeat(ctx)
+		}
+	}
+}
+
+// Owns reports whether key is assigned to this instance.
+func (m *Membership) Owns(key string) bool {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	return ownerOf(m.live, key) == m.instanceID
+}
+
+func (m *Membership) heartbeat(ctx context.Context) {
+	if err := m.q.UpsertControllerInstance(ctx, m.instanceID); err != nil {
+		slog.Error("cluster-membership: heartbeat failed", "instanceID", m.instanceID, "error", err)
+		m.expireIfStale()
+
+		return
+	}
+
+	live, err := m.q.ListLiveControllerInstanceIDs(ctx, durationInterval(m.ttl))
+	if err != nil {
+		slog.Error("cluster-membership: failed to list live instances", "error", err)
+		m.expireIfStale()
+
+		return
+	}
+
+	m.mu.Lock()
+	changed := !slices.Equal(m.live, live)
+	m.live = live
+	m.lastBeat = time.Now()
+	m.mu.Unlock()
+
+	if changed {
+		slog.Info("cluster-membership: live instances changed", "instances", live)
+	}
+
+	if _, err := m.q.DeleteStaleControllerInstances(ctx, durationInterval(staleInstanceRetention)); err != nil {
+		slog.Warn("cluster-membership: failed to clean up stale instances", "error", err)
+	}
+}
+
+func (m *Membership) expireIfStale() {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if m.live != nil && time.Since(m.lastBeat) > m.ttl {
+		slog.Warn("cluster-membership: heartbeat overdue; releasing all streams", "instanceID", m.instanceID)
+
+		m.live = nil
+	}
+}
+
+// leave removes this instance right away on a graceful shutdown so the
+// others take its streams over without waiting for the TTL.
+func (m *Membership) leave() {
+	ctx, cancel := context.WithTimeout(context.Background(), notifyTimeout)
+	defer cancel()
+
+	if err := m.q.DeleteControllerInstance(ctx, m.instanceID); err != nil {
+		slog.Warn("cluster-membership: failed to deregister", "instanceID", m.instanceID, "error", err)
+	}
+
+	m.mu.Lock()
+	m.live = nil
+	m.mu.Unlock()
+}
+
+// ownerOf picks the member with the highest hash of (member, key).
+func ownerOf(members []string, key string) string {
+	var (
+		owner string
+		best  uint64
+	)
+
+	for _, member := range members {
+		score := rendezvousScore(member, key)
+		if owner == "" || score > best {
+			owner, best = member, score
+		}
+	}
+
+	return owner
+}
+
+func rendezvousScore(member, key string) uint64 {
+	h := fnv.New64a()
+	_, _ = h.Write([]byte(member))
+	_, _ = h.Write([]byte{0})
+	_, _ = h.Write([]byte(key))
+
+	return mix64(h.Sum64())
+}
+
+// mix64 is the splitmix64 finalizer. FNV alone spreads short, similar ids
+// poorly, which would skew how hosts are split between instances.
+func mix64(x uint64) uint64 {
+	x ^= x >> 30
+	x *= 0xbf58476d1ce4e5b9
+	x ^= x >> 27
+	x *= 0x94d049bb133111eb
+	x ^= x >> 31
+
+	return x
+}
+
+func durationInterval(d time.Duration) pgtype.Interval {
+	return pgtype.Interval{Microseconds: d.Microseconds(), Valid: true}
+}
@@ -0,0 +1,58 @@
+package cluster
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestOwnerOf(t *testing.T) {
+	t.Parallel()
+
+	members := []string{"controller-a", "controller-b", "controller-c"}
+	keys := make([]string, 300)
+	for i := range keys {
+		keys[i] = fmt.Sprintf("host-%d", i)
+	}
+
+	t.Run("メンバーの並び順に関係なく同じオーナーになる", func(t *testing.T) {
+		t.Parallel()
+
+		reversed := []string{"controller-c", "controller-b", "controller-a"}
+		for _, key := range keys {
+			assert.Equal(t, ownerOf(members, key), ownerOf(reversed, key), key)
+		}
+	})
+
+	t.Run("全メンバーに偏りなく割り当てられる", func(t *testing.T) {
+		t.Parallel()
+
+		counts := map[string]int{}
+		for _, key := range keys {
+			counts[ownerOf(members, key)]++
+		}
+
+		for _, member := range members {
+			assert.Greater(t, counts[member], len(keys)/6, member)
+		}
+	})
+
+	t.Run("メンバーが抜けても他のメンバーのキーは動かない", func(t *testing.T) {
+		t.Parallel()
+
+		remaining := []string{"controller-a", "controller-c"}
+		for _, key := range keys {
+			before := ownerOf(members, key)
+			if before != "controller-b" {
+				assert.Equal(t, before, ownerOf(remaining, key), key)
+			}
+		}
+	})
+
+	t.Run("メンバーがいなければ誰も所有しない", func(t *testing.T) {
+		t.Parallel()
+
+		assert.Empty(t, ownerOf(nil, "host-1"))
+	})
+}
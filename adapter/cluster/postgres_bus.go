@@ -0,0 +1,109 @@
+package cluster
+
+import (
+	"context"
+	"encoding/json"
+	"log/slog"
+
+	hdlctrlv1 "github.com/hantabaru1014/baru-reso-headless-controller/pbgen/hdlctrl/v1"
+	"github.com/hantabaru1014/baru-reso-headless-controller/usecase/notification"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+const notificationChannel = "brhc_notification"
+
+// busMessage is one published event. Target is set for PublishTo.
+type busMessage struct {
+	Target string          `json:"target,omitempty"`
+	Event  json.RawMessage `json:"event"`
+}
+
+// PostgresBus is the notification.Bus for several controller instances.
+// Events are delivered to this instance's subscribers right away and
+// relayed to the other instances over NOTIFY, which deliver them to their
+// own subscribers. Subscribers therefore see every event no matter which
+// instance published it.
+//
+// An event that does not fit into a NOTIFY only reaches this instance's
+// subscribers; clients of the other instances still get it from the
+// history when they reconnect.
+type PostgresBus struct {
+	local  *notification.MemoryBus
+	pubsub *PubSub
+}
+
+var _ notification.Bus = (*PostgresBus)(nil)
+
+func NewPostgresBus(pubsub *PubSub) *PostgresBus {
+	b := &PostgresBus{
+		local:  notification.NewBus(),
+		pubsub: pubsub,
+	}
+	pubsub.Handle(notificationChannel, b.receive)
+
+	return b
+}
+
+func (b *PostgresBus) Publish(ev *hdlctrlv1.NotificationEvent) {
+	if ev == nil {
+		return
+	}
+
+	b.local.Publish(ev)
+	b.relay("", ev)
+}
+
+func (b *PostgresBus) PublishTo(userID string, ev *hdlctrlv1.NotificationEvent) {
+	if ev == nil || userID == "" {
+		return
+	}
+
+	b.local.PublishTo(userID, ev)
+	b.relay(userID, ev)
+}
+
+func (b *PostgresBus) Subscribe(ctx context.Context, userID string) (<-chan *hdlctrlv1.NotificationEvent, func()) {
+	return b.local.Subscribe(ctx, userID)
+}
+
+func (b *PostgresBus) SubscribeAll(ctx context.Context) (<-chan *hdlctrlv1.NotificationEvent, func()) {
+	return b.local.SubscribeAll(ctx)
+}
+
+func (b *PostgresBus) relay(target string, ev *hdlctrlv1.NotificationEvent) {
+	raw, err := protojson.Marshal(ev)
+	if err != nil {
+		slog.Error("notification bus: failed to encode event for other instances", "error", err)
+
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), notifyTimeout)
+	defer cancel()
+
+	if err := b.pubsub.Notify(ctx, notificationChannel, busMessage{Target: target, Event: raw}); err != nil {
+		slog.Error("notification bus: failed to relay event to other instances", "eventID", ev.GetId(), "error", err)
+	}
+}
+
+func (b *PostgresBus) receive(data []byte) {
+	var msg busMessage
+	if err := json.Unmarshal(data, &msg); err != nil {
+		slog.Warn("notification bus: dropped malformed relayed event", "error", err)
+
+		return
+	}
+
+	ev := &hdlctrlv1.NotificationEvent{}
+	if err := (protojson.UnmarshalOptions{DiscardUnknown: true}).Unmarshal(msg.Event, ev); err != nil {
+		slog.Warn("notification bus: dropped undecodable relayed event", "error", err)
+
+		return
+	}
+
+	if msg.Target != "" {
+		b.local.PublishTo(msg.Target, ev)
+	} else {
+		b.local.Publish(ev)
+	}
+}
@@ -0,0 +1,171 @@
+// Package cluster holds what lets several controller instances share one
+// database: instance membership and the per-host stream ownership derived
+// from it, leader election for the singleton workers, and Postgres
+// LISTEN/NOTIFY based fan-out for the notification bus and the session
+// state cache.
+package cluster
+
+import (
+	"context"
+	"encoding/json"
+	"log/slog"
+	"sync"
+	"time"
+
+	"github.com/go-errors/errors"
+	"github.com/hantabaru1014/baru-reso-headless-controller/config"
+	"github.com/hantabaru1014/baru-reso-headless-controller/db"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// maxNotifyPayload is a little under Postgres' 8000 byte NOTIFY limit.
+const maxNotifyPayload = 7900
+
+// listenReconnectDelay is the wait before re-establishing a lost LISTEN
+// connection.
+const listenReconnectDelay = 2 * time.Second
+
+// notifyTimeout bounds a NOTIFY issued from a call path without a context.
+const notifyTimeout = 5 * time.Second
+
+// ErrPayloadTooLarge is returned by Notify when the message does not fit
+// into a single NOTIFY.
+var ErrPayloadTooLarge = errors.New("notify payload too large")
+
+// envelope is the NOTIFY payload. Origin lets an instance skip its own
+// messages, which it already applied locally.
+type envelope struct {
+	Origin string          `json:"origin"`
+	Data   json.RawMessage `json:"data"`
+}
+
+// PubSub sends and receives JSON messages between controller instances
+// over Postgres LISTEN/NOTIFY. Delivery is best effort: messages sent while
+// an instance's LISTEN connection is down are not redelivered to it.
+type PubSub struct {
+	pool       *pgxpool.Pool
+	q          *db.Queries
+	instanceID string
+
+	mu       sync.RWMutex
+	handlers map[string]func(data []byte)
+}
+
+func NewPubSub(pool *pgxpool.Pool, q *db.Queries, cfg *config.ClusterConfig) *PubSub {
+	return &PubSub{
+		pool:       pool,
+		q:          q,
+		instanceID: cfg.InstanceID,
+		handlers:   make(map[string]func(data []byte)),
+	}
+}
+
+// Handle registers fn for the messages other instances send on channel.
+// Register every channel before Run starts; later ones are only listened
+// to after the next reconnect.
+func (p *PubSub) Handle(channel string, fn func(data []byte)) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.handlers[channel] = fn
+}
+
+// Notify sends v as JSON to the other instances listening on channel.
+func (p *PubSub) Notify(ctx context.Context, channel string, v any) error {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return errors.Wrap(err, 0)
+	}
+
+	payload, err := json.Marshal(envelope{Origin: p.instanceID, Data: data})
+	if err != nil {
+		return errors.Wrap(err, 0)
+	}
+
+	if len(payload) > maxNotifyPayload {
+		return errors.Errorf("%d bytes on %s: %w", len(payload), channel, ErrPayloadTooLarge)
+	}
+
+	if err := p.q.NotifyChannel(ctx, db.NotifyChannelParams{Channel: channel, Payload: string(payload)}); err != nil {
+		return errors.WrapPrefix(err, "notify "+channel, 0)
+	}
+
+	return nil
+}
+
+func (p *PubSub) Name() string { return "cluster-pubsub" }
+
+// Run keeps a dedicated connection LISTENing on every registered channel
+// and dispatches incoming messages until ctx is cancelled.
+func (p *PubSub) Run(ctx context.Context) error {
+	for {
+		err := p.listen(ctx)
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+
+		slog.Warn("cluster-pubsub: listen connection lost; reconnecting", "delay", listenReconnectDelay, "error", err)
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(listenReconnectDelay):
+		}
+	}
+}
+
+func (p *PubSub) listen(ctx context.Context) error {
+	conn, err := p.pool.Acquire(ctx)
+	if err != nil {
+		return errors.Wrap(err, 0)
+	}
+	// The connection carries LISTEN state, so it never goes back to the pool.
+	defer conn.Hijack().Close(context.Background()) //nolint:errcheck // best effort
+
+	p.mu.RLock()
+	channels := make([]string, 0, len(p.handlers))
+
+	for channel := range p.handlers {
+		channels = append(channels, channel)
+	}
+	p.mu.RUnlock()
+
+	for _, channel := range channels {
+		if _, err := conn.Exec(ctx, "LISTEN "+pgx.Identifier{channel}.Sanitize()); err != nil {
+			return errors.WrapPrefix(err, "listen "+channel, 0)
+		}
+	}
+
+	slog.Info("cluster-pubsub: listening", "channels", channels)
+
+	for {
+		n, err := conn.Conn().WaitForNotification(ctx)
+		if err != nil {
+			return errors.Wrap(err, 0)
+		}
+
+		p.dispatch(n.Channel, []byte(n.Payload))
+	}
+}
+
+func (p *PubSub) dispatch(channel string, payload []byte) {
+	var env envelope
+	if err := json.Unmarshal(payload, &env); err != nil {
+		slog.Warn("cluster-pubsub: dropped malformed message", "channel", channel, "error", err)
+
+		return
+	}
+
+	if env.Origin == p.instanceID {
+		return
+	}
+
+	p.mu.RLock()
+	fn := p.handlers[channel]
+	p.mu.RUnlock()
+
+	if fn != nil {
+		fn(env.Data)
+	}
+}
@@ -0,0 +1,137 @@
+package cluster
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"log/slog"
+
+	"github.com/hantabaru1014/baru-reso-headless-controller/adapter/sessionstate"
+	headlessv1 "github.com/hantabaru1014/baru-reso-headless-controller/pbgen/headless/v1"
+	"github.com/hantabaru1014/baru-reso-headless-controller/usecase/port"
+	"google.golang.org/protobuf/proto"
+)
+
+const sessionStateChannel = "brhc_session_state"
+
+type sessionStateOp string
+
+const (
+	sessionStateOp_SET    sessionStateOp = "set"
+	sessionStateOp_DELETE sessionStateOp = "delete"
+	sessionStateOp_PRUNE  sessionStateOp = "prune"
+)
+
+type sessionStateMessage struct {
+	Op        sessionStateOp `json:"op"`
+	HostID    string         `json:"host_id,omitempty"`
+	SessionID string         `json:"session_id,omitempty"`
+	// Snapshot is the protobuf encoding of headlessv1.Session.
+	Snapshot []byte   `json:"snapshot,omitempty"`
+	Live     []string `json:"live,omitempty"`
+}
+
+// ReplicatedSessionStateCache keeps the session state cache of every
+// controller instance in step. Host events only reach the instance that
+// owns the host, so each write is applied locally and replayed on the
+// other instances over NOTIFY. A snapshot too large for a NOTIFY is
+// dropped on the others instead, which then fetch it from the container
+// like after a restart.
+type ReplicatedSessionStateCache struct {
+	local  *sessionstate.MemoryCache
+	pubsub *PubSub
+}
+
+var _ port.SessionStateCache = (*ReplicatedSessionStateCache)(nil)
+
+func NewReplicatedSessionStateCache(local *sessionstate.MemoryCache, pubsub *PubSub) *ReplicatedSessionStateCache {
+	c := &ReplicatedSessionStateCache{local: local, pubsub: pubsub}
+	pubsub.Handle(sessionStateChannel, c.receive)
+
+	return c
+}
+
+func (c *ReplicatedSessionStateCache) Get(sessionID string) (*headlessv1.Session, bool) {
+	return c.local.Get(sessionID)
+}
+
+func (c *ReplicatedSessionStateCache) Set(hostID, sessionID string, snapshot *headlessv1.Session) {
+	if snapshot == nil {
+		return
+	}
+
+	c.local.Set(hostID, sessionID, snapshot)
+
+	raw, err := proto.Marshal(snapshot)
+	if err == nil {
+		err = c.send(sessionStateMessage{Op: sessionStateOp_SET, HostID: hostID, SessionID: sessionID, Snapshot: raw})
+	}
+
+	if err != nil {
+		if !errors.Is(err, ErrPayloadTooLarge) {
+			slog.Warn("session state cache: failed to replicate snapshot; invalidating instead", "sessionID", sessionID, "error", err)
+		}
+
+		c.replicate(sessionStateMessage{Op: sessionStateOp_DELETE, SessionID: sessionID})
+	}
+}
+
+func (c *ReplicatedSessionStateCache) Delete(sessionID string) {
+	c.local.Delete(sessionID)
+	c.replicate(sessionStateMessage{Op: sessionStateOp_DELETE, SessionID: sessionID})
+}
+
+func (c *ReplicatedSessionStateCache) PruneHost(hostID string, liveSessionIDs map[string]struct{}) {
+	c.local.PruneHost(hostID, liveSessionIDs)
+
+	live := make([]string, 0, len(liveSessionIDs))
+	for id := range liveSessionIDs {
+		live = append(live, id)
+	}
+
+	c.replicate(sessionStateMessage{Op: sessionStateOp_PRUNE, HostID: hostID, Live: live})
+}
+
+func (c *ReplicatedSessionStateCache) replicate(msg sessionStateMessage) {
+	if err := c.send(msg); err != nil {
+		slog.Warn("session state cache: failed to replicate", "op", msg.Op, "error", err)
+	}
+}
+
+func (c *ReplicatedSessionStateCache) send(msg sessionStateMessage) error {
+	ctx, cancel := context.WithTimeout(context.Background(), notifyTimeout)
+	defer cancel()
+
+	return c.pubsub.Notify(ctx, sessionStateChannel, msg)
+}
+
+func (c *ReplicatedSessionStateCache) receive(data []byte) {
+	var msg sessionStateMessage
+	if err := json.Unmarshal(data, &msg); err != nil {
+		slog.Warn("session state cache: dropped malformed replicated write", "error", err)
+
+		return
+	}
+
+	switch msg.Op {
+	case sessionStateOp_SET:
+		snapshot := &headlessv1.Session{}
+		if err := proto.Unmarshal(msg.Snapshot, snapshot); err != nil {
+			// Serving the previous snapshot would be stale, so drop it.
+			c.local.Delete(msg.SessionID)
+
+			return
+		}
+
+		c.local.Set(msg.HostID, msg.SessionID, snapshot)
+	case sessionStateOp_DELETE:
+		c.local.Delete(msg.SessionID)
+	case sessionStateOp_PRUNE:
+		live := make(map[string]struct{}, len(msg.Live))
+		for _, id := range msg.Live {
+			live[id] = struct{}{}
+		}
+
+		c.local.PruneHost(msg.HostID, live)
+	}
+}
@@ -0,0 +1,422 @@
+package adapter
+
+import (
+	"bufio"
+	"compress/gzip"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log/slog"
+	"os"
+	"path"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/go-errors/errors"
+	"github.com/hantabaru1014/baru-reso-headless-controller/db"
+	"github.com/hantabaru1014/baru-reso-headless-controller/domain"
+	"github.com/hantabaru1014/baru-reso-headless-controller/lib/blobstore"
+	"github.com/hantabaru1014/baru-reso-headless-controller/usecase/port"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+const (
+	containerLogArchiveBatch = 5000
+	// containerLogArchiveCacheSize は展開済みの退避ログを保持する数.
+	// 過去のインスタンスのログをページ送りで読むたびに展開し直さないためのもの.
+	containerLogArchiveCacheSize = 4
+)
+
+var _ port.HostLogArchive = (*ContainerLogArchive)(nil)
+
+// ContainerLogArchive はインスタンス単位でホストのログを NDJSON (gzip) にまとめて
+// blob store に退避し、container_log_archives に記録する.
+// 1 行は container_logs の 1 行 ({"id", "ts", "data"}) で、id の昇順に並ぶ.
+type ContainerLogArchive struct {
+	q    *db.Queries
+	blob blobstore.Client
+
+	mu    sync.Mutex
+	cache []*cachedLogArchive // 先頭ほど最近使ったもの
+}
+
+type cachedLogArchive struct {
+	key  string
+	logs port.LogLineList
+}
+
+type archivedLogLine struct {
+	ID   int64           `json:"id"`
+	Ts   time.Time       `json:"ts"`
+	Data json.RawMessage `json:"data"`
+}
+
+func NewContainerLogArchive(q *db.Queries, blob blobstore.Client) *ContainerLogArchive {
+	return &ContainerLogArchive{q: q, blob: blob}
+}
+
+// EnsureBucket implements port.HostLogArchive.
+func (a *ContainerLogArchive) EnsureBucket(ctx context.Context) error {
+	if err := a.blob.EnsureBucket(ctx); err != nil {
+		return errors.WrapPrefix(err, "container log archive bucket", 0)
+	}
+
+	return nil
+}
+
+// ListPastInstances implements port.HostLogArchive.
+func (a *ContainerLogArchive) ListPastInstances(ctx context.Context) ([]*port.PastLogInstance, error) {
+	rows, err := a.q.ListPastContainerLogInstances(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, 0)
+	}
+
+	result := make([]*port.PastLogInstance, 0, len(rows))
+
+	for _, row := range rows {
+		inst := &port.PastLogInstance{
+			HostID:     row.HostID,
+			InstanceID: row.InstanceID,
+			LastLogAt:  row.LastLogAt.Time,
+		}
+		if row.LogRetentionDays.Valid {
+			days := row.LogRetentionDays.Int32
+			inst.RetentionDays = &days
+		}
+
+		result = append(result, inst)
+	}
+
+	return result, nil
+}
+
+// Archive implements port.HostLogArchive.
+// 新しいオブジェクトを書いて記録を差し替えてから DB の行を消すため、途中で失敗しても
+// ログは失われない (次回に同じ範囲をもう一度退避する).
+func (a *ContainerLogArchive) Archive(ctx context.Context, hostID string, instanceID int32) (int64, error) {
+	prev, err := a.find(ctx, hostID, instanceID)
+	if err != nil {
+		return 0, err
+	}
+
+	tmpFile, err := os.CreateTemp("", "container-log-archive-*")
+	if err != nil {
+		return 0, errors.Wrap(err, 0)
+	}
+
+	defer func() {
+		_ = tmpFile.Close()
+		_ = os.Remove(tmpFile.Name())
+	}()
+
+	gz := gzip.NewWriter(tmpFile)
+	stats := archiveStats{}
+
+	if prev != nil {
+		if err := a.copyArchive(ctx, prev.ObjectKey, gz); err != nil {
+			return 0, err
+		}
+
+		stats = archiveStats{
+			first:  prev.FirstLogAt.Time,
+			last:   prev.LastLogAt.Time,
+			count:  prev.LogCount,
+			lastID: prev.LastLogID,
+		}
+	}
+
+	archived, err := a.writeRows(ctx, containerLogTag(hostID, instanceID), gz, &stats)
+	if err != nil {
+		return 0, err
+	}
+
+	if archived == 0 {
+		return 0, nil
+	}
+
+	if err := gz.Close(); err != nil {
+		return 0, errors.Wrap(err, 0)
+	}
+
+	size, err := tmpFile.Seek(0, io.SeekCurrent)
+	if err != nil {
+		return 0, errors.Wrap(err, 0)
+	}
+
+	if _, err := tmpFile.Seek(0, io.SeekStart); err != nil {
+		return 0, errors.Wrap(err, 0)
+	}
+
+	key := fmt.Sprintf("container-logs/%s/%d-%d.ndjson.gz", hostID, instanceID, stats.lastID)
+	if err := a.blob.Upload(ctx, key, tmpFile, size, path.Base(key), "application/gzip"); err != nil {
+		return 0, errors.WrapPrefix(err, "upload container log archive", 0)
+	}
+
+	err = a.q.UpsertContainerLogArchive(ctx, db.UpsertContainerLogArchiveParams{
+		HostID:     hostID,
+		InstanceID: instanceID,
+		ObjectKey:  key,
+		FirstLogAt: pgtype.Timestamp{Time: stats.first, Valid: true},
+		LastLogAt:  pgtype.Timestamp{Time: stats.last, Valid: true},
+		LogCount:   stats.count,
+		LastLogID:  stats.lastID,
+		SizeBytes:  size,
+	})
+	if err != nil {
+		return 0, errors.Wrap(err, 0)
+	}
+
+	if prev != nil && prev.ObjectKey != key {
+		if err := a.blob.RemoveObject(ctx, prev.ObjectKey); err != nil {
+			slog.Warn("container log archive: failed to remove superseded archive", "key", prev.ObjectKey, "error", err)
+		}
+	}
+
+	if _, err := a.q.DeleteContainerLogsByTagUpToID(ctx, db.DeleteContainerLogsByTagUpToIDParams{
+		Tag:   pgtype.Text{String: containerLogTag(hostID, instanceID), Valid: true},
+		MaxID: stats.lastID,
+	}); err != nil {
+		return 0, errors.Wrap(err, 0)
+	}
+
+	return archived, nil
+}
+
+type archiveStats struct {
+	first, last time.Time
+	count       int64
+	lastID      int64
+}
+
+func (s *archiveStats) add(id int64, ts time.Time) {
+	if s.count == 0 || ts.Before(s.first) {
+		s.first = ts
+	}
+
+	if s.count == 0 || ts.After(s.last) {
+		s.last = ts
+	}
+
+	s.count++
+	s.lastID = id
+}
+
+// writeRows は stats.lastID より後の行を w に書き出し、書いた行数を返す.
+func (a *ContainerLogArchive) writeRows(ctx context.Context, tag string, w io.Writer, stats *archiveStats) (int64, error) {
+	enc := json.NewEncoder(w)
+
+	var written int64
+
+	for {
+		rows, err := a.q.ListContainerLogsAfterID(ctx, db.ListContainerLogsAfterIDParams{
+			Tag:     pgtype.Text{String: tag, Valid: true},
+			AfterID: stats.lastID,
+			MaxRows: containerLogArchiveBatch,
+		})
+		if err != nil {
+			return 0, errors.Wrap(err, 0)
+		}
+
+		for _, row := range rows {
+			if !row.ID.Valid || !row.Ts.Valid {
+				continue
+			}
+
+			line := archivedLogLine{ID: row.ID.Int64, Ts: row.Ts.Time, Data: row.Data}
+			if err := enc.Encode(line); err != nil {
+				return 0, errors.Wrap(err, 0)
+			}
+
+			stats.add(line.ID, line.Ts)
+			written++
+		}
+
+		if len(rows) < containerLogArchiveBatch {
+			return written, nil
+		}
+	}
+}
+
+// copyArchive は退避済みのオブジェクトを展開して w にそのまま書き出す.
+func (a *ContainerLogArchive) copyArchive(ctx context.Context, key string, w io.Writer) error {
+	rc, _, _, _, err := a.blob.GetObject(ctx, key)
+	if err != nil {
+		return errors.WrapPrefix(err, "open container log archive", 0)
+	}
+	defer rc.Close()
+
+	gz, err := gzip.NewReader(rc)
+	if err != nil {
+		return errors.Wrap(err, 0)
+	}
+	defer gz.Close()
+
+	if _, err := io.Copy(w, gz); err != nil {
+		return errors.Wrap(err, 0)
+	}
+
+	return nil
+}
+
+// find はインスタンスの退避記録を返す. 退避していなければ nil.
+func (a *ContainerLogArchive) find(ctx context.Context, hostID string, instanceID int32) (*db.ContainerLogArchive, error) {
+	archive, err := a.q.GetContainerLogArchive(ctx, db.GetContainerLogArchiveParams{
+		HostID:     hostID,
+		InstanceID: instanceID,
+	})
+	if err != nil {
+		if errors.Is(convertDBErr(err), domain.ErrNotFound) {
+			return nil, nil
+		}
+
+		return nil, errors.Wrap(err, 0)
+	}
+
+	return &archive, nil
+}
+
+// getLogs は退避したログから params の範囲を GetLogs と同じ並び (時系列順) で返す.
+func (a *ContainerLogArchive) getLogs(ctx context.Context, archive *db.ContainerLogArchive, params port.GetLogsParams) (port.LogLineList, error) {
+	logs, err := a.load(ctx, archive.ObjectKey)
+	if err != nil {
+		return nil, err
+	}
+
+	// logs は ID の昇順
+	lo, _ := slices.BinarySearchFunc(logs, params.AfterID+1, compareLogID)
+	hi := len(logs)
+
+	if params.BeforeID > 0 {
+		hi, _ = slices.BinarySearchFunc(logs, params.BeforeID, compareLogID)
+	}
+
+	if lo >= hi {
+		return port.LogLineList{}, nil
+	}
+
+	limit := int(params.Limit)
+
+	if hi-lo > limit {
+		if params.AfterID > 0 {
+			hi = lo + limit
+		} else {
+			lo = hi - limit
+		}
+	}
+
+	return slices.Clone(logs[lo:hi]), nil
+}
+
+func compareLogID(l *port.LogLine, id int64) int {
+	switch {
+	case l.ID < id:
+		return -1
+	case l.ID > id:
+		return 1
+	default:
+		return 0
+	}
+}
+
+// load は退避したログを展開して返す. 最近読んだものはキャッシュから返す.
+func (a *ContainerLogArchive) load(ctx context.Context, key string) (port.LogLineList, error) {
+	a.mu.Lock()
+	for i, c := range a.cache {
+		if c.key == key {
+			a.cache = slices.Insert(slices.Delete(a.cache, i, i+1), 0, c)
+			a.mu.Unlock()
+
+			return c.logs, nil
+		}
+	}
+	a.mu.Unlock()
+
+	logs, err := a.read(ctx, key)
+	if err != nil {
+		return nil, err
+	}
+
+	a.mu.Lock()
+	a.cache = slices.Insert(a.cache, 0, &cachedLogArchive{key: key, logs: logs})
+	if len(a.cache) > containerLogArchiveCacheSize {
+		a.cache = a.cache[:containerLogArchiveCacheSize]
+	}
+	a.mu.Unlock()
+
+	return logs, nil
+}
+
+func (a *ContainerLogArchive) read(ctx context.Context, key string) (port.LogLineList, error) {
+	rc, _, _, _, err := a.blob.GetObject(ctx, key)
+	if err != nil {
+		return nil, errors.WrapPrefix(err, "open container log archive", 0)
+	}
+	defer rc.Close()
+
+	gz, err := gzip.NewReader(rc)
+	if err != nil {
+		return nil, errors.Wrap(err, 0)
+	}
+	defer gz.Close()
+
+	logs := port.LogLineList{}
+	scanner := bufio.NewScanner(gz)
+	scanner.Buffer(nil, 16*1024*1024) //nolint:mnd // 1 行のログの上限
+
+	for scanner.Scan() {
+		var line archivedLogLine
+		if err := json.Unmarshal(scanner.Bytes(), &line); err != nil {
+			continue
+		}
+
+		logLine, err := parseContainerLog(
+			pgtype.Int8{Int64: line.ID, Valid: true},
+			pgtype.Timestamp{Time: line.Ts, Valid: true},
+			line.Data,
+		)
+		if err != nil {
+			continue
+		}
+
+		logs = append(logs, logLine)
+	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, errors.Wrap(err, 0)
+	}
+
+	return logs, nil
+}
+
+// listByHost はホストの退避記録をインスタンスの新しい順に返す.
+func (a *ContainerLogArchive) listByHost(ctx context.Context, hostID string) ([]db.ContainerLogArchive, error) {
+	archives, err := a.q.ListContainerLogArchivesByHostID(ctx, hostID)
+	if err != nil {
+		return nil, errors.Wrap(err, 0)
+	}
+
+	return archives, nil
+}
+
+// removeHost はホストの退避したログを blob store から削除する.
+// 記録の行はホストの削除に合わせて消える.
+func (a *ContainerLogArchive) removeHost(ctx context.Context, hostID string) error {
+	archives, err := a.listByHost(ctx, hostID)
+	if err != nil {
+		return err
+	}
+
+	for _, archive := range archives {
+		if err := a.blob.RemoveObject(ctx, archive.ObjectKey); err != nil {
+			return errors.WrapPrefix(err, "remove container log archive", 0)
+		}
+
+		a.mu.Lock()
+		a.cache = slices.DeleteFunc(a.cache, func(c *cachedLogArchive) bool { return c.key == archive.ObjectKey })
+		a.mu.Unlock()
+	}
+
+	return nil
+}
@@ -0,0 +1,194 @@
+package adapter_test
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/hantabaru1014/baru-reso-headless-controller/adapter"
+	"github.com/hantabaru1014/baru-reso-headless-controller/adapter/hostconnector"
+	"github.com/hantabaru1014/baru-reso-headless-controller/config"
+	"github.com/hantabaru1014/baru-reso-headless-controller/db"
+	"github.com/hantabaru1014/baru-reso-headless-controller/domain/entity"
+	"github.com/hantabaru1014/baru-reso-headless-controller/lib/blobstore"
+	"github.com/hantabaru1014/baru-reso-headless-controller/testutil"
+	"github.com/hantabaru1014/baru-reso-headless-controller/usecase/port"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// memBlobStore は blobstore.Client のメモリ上の実装.
+type memBlobStore struct {
+	mu      sync.Mutex
+	objects map[string][]byte
+}
+
+var _ blobstore.Client = (*memBlobStore)(nil)
+
+func newMemBlobStore() *memBlobStore {
+	return &memBlobStore{objects: make(map[string][]byte)}
+}
+
+func (s *memBlobStore) EnsureBucket(context.Context) error { return nil }
+
+func (s *memBlobStore) Upload(_ context.Context, key string, reader io.Reader, _ int64, _, _ string) error {
+	data, err := io.ReadAll(reader)
+	if err != nil {
+		return err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.objects[key] = data
+
+	return nil
+}
+
+func (s *memBlobStore) GetObject(_ context.Context, key string) (io.ReadCloser, int64, string, string, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	data, ok := s.objects[key]
+	if !ok {
+		return nil, 0, "", "", blobstore.ErrNotFound
+	}
+
+	return io.NopCloser(bytes.NewReader(data)), int64(len(data)), "application/gzip", "", nil
+}
+
+func (s *memBlobStore) RemoveObject(_ context.Context, key string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	delete(s.objects, key)
+
+	return nil
+}
+
+func (s *memBlobStore) keys() []string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	keys := make([]string, 0, len(s.objects))
+	for k := range s.objects {
+		keys = append(keys, k)
+	}
+
+	return keys
+}
+
+func logBodies(logs port.LogLineList) []string {
+	bodies := make([]string, 0, len(logs))
+	for _, l := range logs {
+		bodies = append(bodies, l.Body)
+	}
+
+	return bodies
+}
+
+func TestContainerLogArchive_ArchiveAndReadBack(t *testing.T) {
+	queries, pool := testutil.SetupTestDB(t)
+	testutil.CleanupTables(t, pool)
+
+	account := testutil.CreateTestHeadlessAccount(t, queries, "U-archive", "archive@example.test", "p")
+	host := testutil.CreateTestHeadlessHost(t, queries, account.ResoniteID, "archive-host", entity.HeadlessHostStatus_RUNNING)
+	_, err := queries.IncrementHostInstanceCount(t.Context(), host.ID) // 現在のインスタンスは 2
+	require.NoError(t, err)
+
+	old := time.Now().Add(-60 * 24 * time.Hour)
+	for i, body := range []string{"one", "two", "three"} {
+		testutil.InsertTestContainerLog(t, queries, host.ID, 1, old.Add(time.Duration(i)*time.Second), "stdout", body)
+	}
+
+	testutil.InsertTestContainerLog(t, queries, host.ID, 2, time.Now(), "stdout", "current")
+
+	store := newMemBlobStore()
+	archive := adapter.NewContainerLogArchive(queries, store)
+	repo := adapter.NewHeadlessHostRepository(queries, hostconnector.Connectors{}, &config.GRPCConfig{}, archive)
+
+	past, err := archive.ListPastInstances(t.Context())
+	require.NoError(t, err)
+	require.Len(t, past, 1, "現在のインスタンスは対象外")
+	assert.Equal(t, host.ID, past[0].HostID)
+	assert.Equal(t, int32(1), past[0].InstanceID)
+	assert.Nil(t, past[0].RetentionDays)
+
+	n, err := archive.Archive(t.Context(), host.ID, 1)
+	require.NoError(t, err)
+	assert.Equal(t, int64(3), n)
+
+	remaining, err := queries.GetContainerLogsByTag(t.Context(), db.GetContainerLogsByTagParams{
+		Tag:     pgtype.Text{String: "headless-" + host.ID + "-1", Valid: true},
+		MaxRows: 10,
+	})
+	require.NoError(t, err)
+	assert.Empty(t, remaining, "退避した行は DB から消える")
+
+	t.Run("退避したログを GetLogs で読める", func(t *testing.T) {
+		latest, err := repo.GetLogs(t.Context(), port.GetLogsParams{HostID: host.ID, InstanceID: 1, Limit: 2})
+		require.NoError(t, err)
+		assert.Equal(t, []string{"two", "three"}, logBodies(latest))
+
+		older, err := repo.GetLogs(t.Context(), port.GetLogsParams{HostID: host.ID, InstanceID: 1, Limit: 2, BeforeID: latest[0].ID})
+		require.NoError(t, err)
+		assert.Equal(t, []string{"one"}, logBodies(older))
+
+		newer, err := repo.GetLogs(t.Context(), port.GetLogsParams{HostID: host.ID, InstanceID: 1, Limit: 2, AfterID: older[0].ID})
+		require.NoError(t, err)
+		assert.Equal(t, []string{"two", "three"}, logBodies(newer))
+	})
+
+	t.Run("後から書き込まれたログは既存の退避分に追記される", func(t *testing.T) {
+		testutil.InsertTestContainerLog(t, queries, host.ID, 1, old.Add(time.Minute), "stderr", "late")
+
+		logs, err := repo.GetLogs(t.Context(), port.GetLogsParams{HostID: host.ID, InstanceID: 1, Limit: 10})
+		require.NoError(t, err)
+		assert.Equal(t, []string{"one", "two", "three", "late"}, logBodies(logs), "退避前でも DB の分とつなげて読める")
+
+		n, err := archive.Archive(t.Context(), host.ID, 1)
+		require.NoError(t, err)
+		assert.Equal(t, int64(1), n)
+		assert.Len(t, store.keys(), 1, "古いオブジェクトは削除される")
+
+		logs, err = repo.GetLogs(t.Context(), port.GetLogsParams{HostID: host.ID, InstanceID: 1, Limit: 10})
+		require.NoError(t, err)
+		assert.Equal(t, []string{"one", "two", "three", "late"}, logBodies(logs))
+		assert.True(t, logs[3].IsError)
+	})
+
+	t.Run("インスタンス一覧に退避済みのインスタンスも含まれる", func(t *testing.T) {
+		instances, err := repo.GetInstanceTimestamps(t.Context(), host.ID)
+		require.NoError(t, err)
+		require.Len(t, instances, 2)
+
+		assert.Equal(t, int32(2), instances[0].InstanceID)
+		assert.False(t, instances[0].Archived)
+		assert.Equal(t, int32(1), instances[1].InstanceID)
+		assert.True(t, instances[1].Archived)
+		assert.Equal(t, int64(4), instances[1].LogCount)
+		require.NotNil(t, instances[1].FirstLogAt)
+		require.NotNil(t, instances[1].LastLogAt)
+		assert.Equal(t, int64(60), *instances[1].LastLogAt-*instances[1].FirstLogAt)
+	})
+
+	t.Run("グループの保持日数が返る", func(t *testing.T) {
+		testutil.InsertTestContainerLog(t, queries, host.ID, 1, old, "stdout", "again")
+
+		groupRepo := adapter.NewGroupRepository(queries)
+		days := int32(7)
+		require.NoError(t, groupRepo.UpdateLogRetentionDays(t.Context(), host.GroupID, &days))
+		// groups はテーブルの掃除対象外なので戻しておく
+		t.Cleanup(func() { _ = groupRepo.UpdateLogRetentionDays(context.Background(), host.GroupID, nil) })
+
+		past, err := archive.ListPastInstances(t.Context())
+		require.NoError(t, err)
+		require.Len(t, past, 1)
+		require.NotNil(t, past[0].RetentionDays)
+		assert.Equal(t, int32(7), *past[0].RetentionDays)
+	})
+}
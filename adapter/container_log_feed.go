@@ -0,0 +1,200 @@
+package adapter
+
+import (
+	"context"
+	"log/slog"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/hantabaru1014/baru-reso-headless-controller/db"
+	"github.com/hantabaru1014/baru-reso-headless-controller/usecase/port"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+const (
+	containerLogPollInterval = time.Second
+	containerLogPollBatch    = 500
+	// containerLogMaxBatchesPerPoll は 1 回のポーリングでタグあたりに読む上限.
+	// 残りは次のポーリングに回し、1 つのタグが他を待たせないようにする.
+	containerLogMaxBatchesPerPoll = 10
+	logFeedSubscriberBuffer       = 64
+)
+
+var _ port.HostLogFeed = (*ContainerLogFeed)(nil)
+
+// ContainerLogFeed は fluent-bit が container_logs に書き込んだログを、購読されている
+// タグごとに一定間隔でまとめて読み出して購読者へ配る. 同じホストを何人が見ていても
+// クエリはタグあたり 1 本で済む.
+//
+// 読み出しは ID の昇順で「前回より後」を取る. fluent-bit は 1 接続から順に INSERT
+// するため、ID の順とコミットの順は一致する.
+type ContainerLogFeed struct {
+	q        *db.Queries
+	interval time.Duration
+
+	mu   sync.Mutex
+	subs map[string]map[*logFeedSubscriber]struct{}
+}
+
+type logFeedSubscriber struct {
+	ch chan port.LogLineList
+	// cursor は送り済みの最後のログID.
+	cursor int64
+}
+
+func NewContainerLogFeed(q *db.Queries) *ContainerLogFeed {
+	return &ContainerLogFeed{
+		q:        q,
+		interval: containerLogPollInterval,
+		subs:     make(map[string]map[*logFeedSubscriber]struct{}),
+	}
+}
+
+func (f *ContainerLogFeed) Name() string { return "container-log-feed" }
+
+func (f *ContainerLogFeed) Run(ctx context.Context) error {
+	ticker := time.NewTicker(f.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+			for tag, from := range f.cursors() {
+				f.pollTag(ctx, tag, from)
+			}
+		}
+	}
+}
+
+// Follow implements port.HostLogFeed.
+func (f *ContainerLogFeed) Follow(ctx context.Context, hostID string, instanceID int32, afterID int64) <-chan port.LogLineList {
+	tag := containerLogTag(hostID, instanceID)
+	sub := &logFeedSubscriber{
+		ch:     make(chan port.LogLineList, logFeedSubscriberBuffer),
+		cursor: afterID,
+	}
+
+	f.mu.Lock()
+	if f.subs[tag] == nil {
+		f.subs[tag] = make(map[*logFeedSubscriber]struct{})
+	}
+	f.subs[tag][sub] = struct{}{}
+	f.mu.Unlock()
+
+	go func() {
+		<-ctx.Done()
+		f.unsubscribe(tag, sub)
+	}()
+
+	return sub.ch
+}
+
+// cursors はタグごとに、購読者の中で最も遅れているカーソルを返す.
+func (f *ContainerLogFeed) cursors() map[string]int64 {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	cursors := make(map[string]int64, len(f.subs))
+
+	for tag, subs := range f.subs {
+		first := true
+		for sub := range subs {
+			if first || sub.cursor < cursors[tag] {
+				cursors[tag] = sub.cursor
+				first = false
+			}
+		}
+	}
+
+	return cursors
+}
+
+func (f *ContainerLogFeed) pollTag(ctx context.Context, tag string, from int64) {
+	for range containerLogMaxBatchesPerPoll {
+		rows, err := f.q.ListContainerLogsAfterID(ctx, db.ListContainerLogsAfterIDParams{
+			Tag:     pgtype.Text{String: tag, Valid: true},
+			AfterID: from,
+			MaxRows: containerLogPollBatch,
+		})
+		if err != nil {
+			if ctx.Err() == nil {
+				slog.Error("container-log-feed: failed to read logs", "tag", tag, "error", err)
+			}
+
+			return
+		}
+
+		if len(rows) == 0 {
+			return
+		}
+
+		lines := make(port.LogLineList, 0, len(rows))
+
+		for _, row := range rows {
+			line, err := parseContainerLog(row.ID, row.Ts, row.Data)
+			if err != nil {
+				continue
+			}
+
+			lines = append(lines, line)
+		}
+
+		from = rows[len(rows)-1].ID.Int64
+		f.deliver(tag, lines, from)
+
+		if len(rows) < containerLogPollBatch {
+			return
+		}
+	}
+}
+
+// deliver は lines (ID 昇順) のうち各購読者がまだ受け取っていないものを送り、
+// カーソルを last まで進める. 受け取りが追いついていない購読者は打ち切る.
+func (f *ContainerLogFeed) deliver(tag string, lines port.LogLineList, last int64) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	for sub := range f.subs[tag] {
+		if sub.cursor >= last {
+			continue
+		}
+
+		start := sort.Search(len(lines), func(i int) bool { return lines[i].ID > sub.cursor })
+		sub.cursor = last
+
+		if start == len(lines) {
+			continue
+		}
+
+		select {
+		case sub.ch <- lines[start:]:
+		default:
+			slog.Warn("container-log-feed: subscriber fell behind; closing", "tag", tag)
+			f.removeLocked(tag, sub)
+		}
+	}
+}
+
+func (f *ContainerLogFeed) unsubscribe(tag string, sub *logFeedSubscriber) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	f.removeLocked(tag, sub)
+}
+
+func (f *ContainerLogFeed) removeLocked(tag string, sub *logFeedSubscriber) {
+	subs := f.subs[tag]
+	if _, ok := subs[sub]; !ok {
+		return
+	}
+
+	delete(subs, sub)
+	close(sub.ch)
+
+	if len(subs) == 0 {
+		delete(f.subs, tag)
+	}
+}
@@ -0,0 +1,74 @@
+package adapter_test
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/hantabaru1014/baru-reso-headless-controller/adapter"
+	"github.com/hantabaru1014/baru-reso-headless-controller/db"
+	"github.com/hantabaru1014/baru-reso-headless-controller/testutil"
+	"github.com/hantabaru1014/baru-reso-headless-controller/usecase/port"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func insertTestContainerLog(t *testing.T, queries *db.Queries, tag, body string) {
+	t.Helper()
+
+	err := queries.InsertContainerLog(t.Context(), db.InsertContainerLogParams{
+		Tag:  pgtype.Text{String: tag, Valid: true},
+		Ts:   pgtype.Timestamp{Time: time.Now(), Valid: true},
+		Data: fmt.Appendf(nil, `{"log":%q,"stream":"stdout"}`, body+"\n"),
+	})
+	require.NoError(t, err)
+}
+
+func receiveLogBodies(t *testing.T, ch <-chan port.LogLineList) []string {
+	t.Helper()
+
+	select {
+	case lines, ok := <-ch:
+		require.True(t, ok, "feed closed unexpectedly")
+
+		bodies := make([]string, 0, len(lines))
+		for _, line := range lines {
+			bodies = append(bodies, line.Body)
+		}
+
+		return bodies
+	case <-time.After(5 * time.Second):
+		t.Fatal("no logs received")
+
+		return nil
+	}
+}
+
+func TestContainerLogFeed_Follow(t *testing.T) {
+	queries, pool := testutil.SetupTestDB(t)
+	testutil.CleanupTables(t, pool)
+
+	insertTestContainerLog(t, queries, "headless-host-1-1", "before")
+
+	rows, err := queries.GetContainerLogsByTag(t.Context(), db.GetContainerLogsByTagParams{
+		Tag:     pgtype.Text{String: "headless-host-1-1", Valid: true},
+		MaxRows: 1,
+	})
+	require.NoError(t, err)
+	require.Len(t, rows, 1)
+
+	feed := adapter.NewContainerLogFeed(queries)
+
+	go func() { _ = feed.Run(t.Context()) }()
+
+	// 既存のログより後から追従する購読者と、最初から追従する購読者.
+	latest := feed.Follow(t.Context(), "host-1", 1, rows[0].ID.Int64)
+	fromStart := feed.Follow(t.Context(), "host-1", 1, 0)
+
+	insertTestContainerLog(t, queries, "headless-host-1-1", "after")
+	insertTestContainerLog(t, queries, "headless-host-1-2", "other instance")
+
+	assert.Equal(t, []string{"after"}, receiveLogBodies(t, latest))
+	assert.Equal(t, []string{"before", "after"}, receiveLogBodies(t, fromStart))
+}
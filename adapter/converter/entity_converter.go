@@ -8,21 +8,75 @@ import (
 )
 
 func HeadlessHostEntityToProto(e *entity.HeadlessHost) *hdlctrlv1.HeadlessHost {
-	return &hdlctrlv1.HeadlessHost{
-		Id:               e.ID,
-		Name:             e.Name,
-		ResoniteVersion:  e.ResoniteVersion,
-		AppVersion:       e.AppVersion,
-		AccountId:        e.AccountId,
-		AccountName:      e.AccountName,
-		Fps:              e.Fps,
-		Status:           hdlctrlv1.HeadlessHostStatus(e.Status),
-		AutoUpdatePolicy: hdlctrlv1.HeadlessHostAutoUpdatePolicy(e.AutoUpdatePolicy),
-		HostSettings:     HeadlessHostSettingsToProto(&e.HostSettings),
-		Memo:             e.Memo,
-		InstanceId:       e.InstanceId,
-		GroupId:          e.GroupID,
-		CreatedBy:        e.CreatedBy,
+	d := &hdlctrlv1.HeadlessHost{
+		Id:                e.ID,
+		Name:              e.Name,
+		ResoniteVersion:   e.ResoniteVersion,
+		AppVersion:        e.AppVersion,
+		AccountId:         e.AccountId,
+		AccountName:       e.AccountName,
+		Fps:               e.Fps,
+		Status:            hdlctrlv1.HeadlessHostStatus(e.Status),
+		AutoUpdatePolicy:  hdlctrlv1.HeadlessHostAutoUpdatePolicy(e.AutoUpdatePolicy),
+		HostSettings:      HeadlessHostSettingsToProto(&e.HostSettings),
+		Memo:              e.Memo,
+		InstanceId:        e.InstanceId,
+		GroupId:           e.GroupID,
+		CreatedBy:         e.CreatedBy,
+		NodeId:            e.NodeID,
+		ContainerSettings: HostContainerSettingsToProto(&e.ContainerSettings),
+
+		AutoRestartPolicy:     hdlctrlv1.HeadlessHostAutoRestartPolicy(e.AutoRestartPolicy),
+		AutoRestartMaxRetries: e.AutoRestartMaxRetries,
+		CrashCount:            e.CrashCount,
+		AutoRestartSuspended:  e.AutoRestartSuspended,
+	}
+	if e.LastCrashedAt != nil {
+		d.LastCrashedAt = timestamppb.New(*e.LastCrashedAt)
+	}
+
+	return d
+}
+
+func HostContainerSettingsToProto(e *entity.HostContainerSettings) *hdlctrlv1.HeadlessHostContainerSettings {
+	bindMounts := make([]*hdlctrlv1.HeadlessHostBindMount, 0, len(e.BindMounts))
+	for _, m := range e.BindMounts {
+		bindMounts = append(bindMounts, &hdlctrlv1.HeadlessHostBindMount{
+			Source:   m.Source,
+			Target:   m.Target,
+			ReadOnly: m.ReadOnly,
+		})
+	}
+
+	return &hdlctrlv1.HeadlessHostContainerSettings{
+		Cpus:              e.CPUs,
+		MemoryBytes:       e.MemoryBytes,
+		MemorySwapBytes:   e.MemorySwapBytes,
+		CpusetCpus:        e.CpusetCpus,
+		RestartPolicy:     hdlctrlv1.HeadlessHostRestartPolicy(e.RestartPolicy),
+		RestartMaxRetries: e.RestartMaxRetries,
+		BindMounts:        bindMounts,
+	}
+}
+
+func HostContainerSettingsProtoToEntity(proto *hdlctrlv1.HeadlessHostContainerSettings) *entity.HostContainerSettings {
+	bindMounts := make([]entity.HostBindMount, 0, len(proto.GetBindMounts()))
+	for _, m := range proto.GetBindMounts() {
+		bindMounts = append(bindMounts, entity.HostBindMount{
+			Source:   m.GetSource(),
+			Target:   m.GetTarget(),
+			ReadOnly: m.GetReadOnly(),
+		})
+	}
+
+	return &entity.HostContainerSettings{
+		CPUs:              proto.GetCpus(),
+		MemoryBytes:       proto.GetMemoryBytes(),
+		MemorySwapBytes:   proto.GetMemorySwapBytes(),
+		CpusetCpus:        proto.GetCpusetCpus(),
+		RestartPolicy:     entity.HostRestartPolicy(proto.GetRestartPolicy()),
+		RestartMaxRetries: proto.GetRestartMaxRetries(),
+		BindMounts:        bindMounts,
 	}
 }
 
@@ -74,6 +128,7 @@ func HeadlessHostSettingsToStartupConfigProto(e *entity.HeadlessHostSettings) *h
 		MaxConcurrentAssetTransfers: &e.MaxConcurrentAssetTransfers,
 		UsernameOverride:            e.UsernameOverride,
 		AllowedUrlHosts:             allowedUrlHosts,
+		AutoSpawnItems:              e.AutoSpawnItems,
 		StartWorlds:                 e.StartWorlds,
 	}
 }
@@ -110,6 +165,7 @@ func HeadlessHostSettingsProtoToEntity(proto *headlessv1.StartupConfig) *entity.
 		MaxConcurrentAssetTransfers: maxConcurrentAssetTransfers,
 		UsernameOverride:            proto.UsernameOverride,
 		AllowedUrlHosts:             allowedUrlHosts,
+		AutoSpawnItems:              proto.GetAutoSpawnItems(),
 		StartWorlds:                 proto.GetStartWorlds(),
 	}
 }
@@ -128,8 +184,9 @@ func SessionEntityToProto(e *entity.Session) *hdlctrlv1.Session {
 		CreatedBy: e.CreatedBy,
 		GroupId:   e.GroupID,
 
-		AutoUpgrade: e.AutoUpgrade,
-		Memo:        e.Memo,
+		AutoUpgrade:    e.AutoUpgrade,
+		RestoreOnCrash: e.RestoreOnCrash,
+		Memo:           e.Memo,
 	}
 	if e.StartedAt != nil {
 		d.StartedAt = timestamppb.New(*e.StartedAt)
@@ -0,0 +1,81 @@
+package adapter
+
+import (
+	"github.com/go-errors/errors"
+	"github.com/hantabaru1014/baru-reso-headless-controller/domain/entity"
+	"github.com/hantabaru1014/baru-reso-headless-controller/lib/secretbox"
+	"github.com/hantabaru1014/baru-reso-headless-controller/usecase/port"
+)
+
+var _ port.CredentialCipher = (*CredentialCipher)(nil)
+
+// CredentialCipher は secretbox で headless account の認証情報をエンベロープ暗号化する.
+// credential と password は 1 つのデータ鍵で暗号化し、resonite_id を AAD にする.
+type CredentialCipher struct {
+	keyring *secretbox.Keyring
+}
+
+func NewCredentialCipher(keyring *secretbox.Keyring) *CredentialCipher {
+	return &CredentialCipher{keyring: keyring}
+}
+
+// Seal implements port.CredentialCipher.
+func (c *CredentialCipher) Seal(resoniteID, credential, password string) (entity.SealedCredentials, error) {
+	env, err := c.keyring.Seal([]byte(resoniteID), []byte(credential), []byte(password))
+	if err != nil {
+		return entity.SealedCredentials{}, errors.Wrap(err, 0)
+	}
+
+	return envelopeToSealed(env), nil
+}
+
+// Open implements port.CredentialCipher.
+func (c *CredentialCipher) Open(resoniteID string, sealed entity.SealedCredentials) (string, string, error) {
+	if sealed.IsLegacyPlaintext() {
+		return sealed.LegacyCredential, sealed.LegacyPassword, nil
+	}
+
+	plaintexts, err := c.keyring.Open(sealedToEnvelope(sealed), []byte(resoniteID))
+	if err != nil {
+		return "", "", errors.WrapPrefix(err, "failed to decrypt credentials of "+resoniteID, 0)
+	}
+
+	return string(plaintexts[0]), string(plaintexts[1]), nil
+}
+
+// Rewrap implements port.CredentialCipher.
+func (c *CredentialCipher) Rewrap(resoniteID string, sealed entity.SealedCredentials) (entity.SealedCredentials, bool, error) {
+	if sealed.IsLegacyPlaintext() {
+		s, err := c.Seal(resoniteID, sealed.LegacyCredential, sealed.LegacyPassword)
+
+		return s, err == nil, err
+	}
+
+	if sealed.KeyID == c.keyring.PrimaryKeyID() {
+		return sealed, false, nil
+	}
+
+	env, err := c.keyring.Rewrap(sealedToEnvelope(sealed), []byte(resoniteID))
+	if err != nil {
+		return entity.SealedCredentials{}, false, errors.WrapPrefix(err, "failed to rewrap credentials of "+resoniteID, 0)
+	}
+
+	return envelopeToSealed(env), true, nil
+}
+
+func envelopeToSealed(env *secretbox.Envelope) entity.SealedCredentials {
+	return entity.SealedCredentials{
+		KeyID:      env.KeyID,
+		DataKey:    env.DataKey,
+		Credential: env.Ciphertexts[0],
+		Password:   env.Ciphertexts[1],
+	}
+}
+
+func sealedToEnvelope(s entity.SealedCredentials) *secretbox.Envelope {
+	return &secretbox.Envelope{
+		KeyID:       s.KeyID,
+		DataKey:     s.DataKey,
+		Ciphertexts: [][]byte{s.Credential, s.Password},
+	}
+}
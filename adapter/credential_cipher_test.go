@@ -0,0 +1,93 @@
+package adapter_test
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/hantabaru1014/baru-reso-headless-controller/adapter"
+	"github.com/hantabaru1014/baru-reso-headless-controller/domain/entity"
+	"github.com/hantabaru1014/baru-reso-headless-controller/lib/secretbox"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestCipher(t *testing.T, primaryID string, ids ...string) *adapter.CredentialCipher {
+	t.Helper()
+
+	keys := make(map[string][]byte, len(ids))
+	for i, id := range ids {
+		keys[id] = bytes.Repeat([]byte{byte(i + 1)}, secretbox.KeySize)
+	}
+
+	keyring, err := secretbox.NewKeyring(keys, primaryID)
+	require.NoError(t, err)
+
+	return adapter.NewCredentialCipher(keyring)
+}
+
+func TestCredentialCipher(t *testing.T) {
+	t.Run("Seal した認証情報を Open で戻せる", func(t *testing.T) {
+		c := newTestCipher(t, "k1", "k1")
+
+		sealed, err := c.Seal("U-bot", "bot@example.com", "hunter2")
+		require.NoError(t, err)
+		assert.Equal(t, "k1", sealed.KeyID)
+		assert.False(t, sealed.IsLegacyPlaintext())
+
+		credential, password, err := c.Open("U-bot", sealed)
+		require.NoError(t, err)
+		assert.Equal(t, "bot@example.com", credential)
+		assert.Equal(t, "hunter2", password)
+	})
+
+	t.Run("別のアカウントの ID では Open できない", func(t *testing.T) {
+		c := newTestCipher(t, "k1", "k1")
+
+		sealed, err := c.Seal("U-bot", "bot@example.com", "hunter2")
+		require.NoError(t, err)
+
+		_, _, err = c.Open("U-other", sealed)
+		require.ErrorIs(t, err, secretbox.ErrDecrypt)
+	})
+
+	t.Run("平文の行は Open でそのまま返し、Rewrap で暗号化する", func(t *testing.T) {
+		c := newTestCipher(t, "k1", "k1")
+		legacy := entity.SealedCredentials{LegacyCredential: "bot@example.com", LegacyPassword: "hunter2"}
+
+		credential, password, err := c.Open("U-bot", legacy)
+		require.NoError(t, err)
+		assert.Equal(t, "bot@example.com", credential)
+		assert.Equal(t, "hunter2", password)
+
+		sealed, changed, err := c.Rewrap("U-bot", legacy)
+		require.NoError(t, err)
+		assert.True(t, changed)
+		assert.Equal(t, "k1", sealed.KeyID)
+		assert.Empty(t, sealed.LegacyPassword)
+
+		_, password, err = c.Open("U-bot", sealed)
+		require.NoError(t, err)
+		assert.Equal(t, "hunter2", password)
+	})
+
+	t.Run("Rewrap は primary 以外の鍵の行だけ掛け直す", func(t *testing.T) {
+		old := newTestCipher(t, "k1", "k1")
+		sealed, err := old.Seal("U-bot", "bot@example.com", "hunter2")
+		require.NoError(t, err)
+
+		rotated := newTestCipher(t, "k2", "k1", "k2")
+
+		rewrapped, changed, err := rotated.Rewrap("U-bot", sealed)
+		require.NoError(t, err)
+		assert.True(t, changed)
+		assert.Equal(t, "k2", rewrapped.KeyID)
+
+		_, changed, err = rotated.Rewrap("U-bot", rewrapped)
+		require.NoError(t, err)
+		assert.False(t, changed)
+
+		_, password, err := rotated.Open("U-bot", rewrapped)
+		require.NoError(t, err)
+		assert.Equal(t, "hunter2", password)
+	})
+}
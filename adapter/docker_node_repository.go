@@ -0,0 +1,133 @@
+package adapter
+
+import (
+	"context"
+
+	"github.com/dchest/uniuri"
+	"github.com/go-errors/errors"
+	"github.com/hantabaru1014/baru-reso-headless-controller/db"
+	"github.com/hantabaru1014/baru-reso-headless-controller/domain/entity"
+	"github.com/hantabaru1014/baru-reso-headless-controller/usecase/port"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+var _ port.DockerNodeRepository = (*DockerNodeRepository)(nil)
+
+type DockerNodeRepository struct {
+	q *db.Queries
+}
+
+func NewDockerNodeRepository(q *db.Queries) *DockerNodeRepository {
+	return &DockerNodeRepository{q: q}
+}
+
+// Create implements port.DockerNodeRepository.
+// node.ID が空なら採番する.
+func (r *DockerNodeRepository) Create(ctx context.Context, node *entity.DockerNode) (*entity.DockerNode, error) {
+	id := node.ID
+	if id == "" {
+		id = uniuri.New()
+	}
+
+	row, err := r.q.CreateDockerNode(ctx, db.CreateDockerNodeParams{
+		ID:             id,
+		Name:           node.Name,
+		Endpoint:       node.Endpoint,
+		RpcAddress:     textFromNonEmpty(node.RpcAddress),
+		TlsCaCertPath:  textFromNonEmpty(node.TLSCACertPath),
+		TlsCertPath:    textFromNonEmpty(node.TLSCertPath),
+		TlsKeyPath:     textFromNonEmpty(node.TLSKeyPath),
+		FluentdAddress: textFromNonEmpty(node.FluentdAddress),
+		MaxHosts:       node.MaxHosts,
+		Enabled:        node.Enabled,
+	})
+	if err != nil {
+		return nil, errors.WrapPrefix(convertDBErr(err), "create docker node", 0)
+	}
+
+	return dbDockerNodeToEntity(row), nil
+}
+
+// Get implements port.DockerNodeRepository.
+func (r *DockerNodeRepository) Get(ctx context.Context, id string) (*entity.DockerNode, error) {
+	row, err := r.q.GetDockerNode(ctx, id)
+	if err != nil {
+		return nil, errors.WrapPrefix(convertDBErr(err), "docker node", 0)
+	}
+
+	return dbDockerNodeToEntity(row), nil
+}
+
+// ListAll implements port.DockerNodeRepository.
+func (r *DockerNodeRepository) ListAll(ctx context.Context) (entity.DockerNodeList, error) {
+	rows, err := r.q.ListDockerNodes(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, 0)
+	}
+
+	result := make(entity.DockerNodeList, 0, len(rows))
+	for _, row := range rows {
+		result = append(result, dbDockerNodeToEntity(row))
+	}
+
+	return result, nil
+}
+
+// SetEnabled implements port.DockerNodeRepository.
+func (r *DockerNodeRepository) SetEnabled(ctx context.Context, id string, enabled bool) error {
+	if _, err := r.Get(ctx, id); err != nil {
+		return err
+	}
+
+	return r.q.UpdateDockerNodeEnabled(ctx, db.UpdateDockerNodeEnabledParams{
+		ID:      id,
+		Enabled: enabled,
+	})
+}
+
+// Delete implements port.DockerNodeRepository.
+func (r *DockerNodeRepository) Delete(ctx context.Context, id string) error {
+	count, err := r.q.CountHostsOnDockerNode(ctx, id)
+	if err != nil {
+		return errors.Wrap(err, 0)
+	}
+
+	if count > 0 {
+		return errors.Errorf("docker node %s still has %d host(s); delete or move them first", id, count)
+	}
+
+	return r.q.DeleteDockerNode(ctx, id)
+}
+
+func dbDockerNodeToEntity(n db.DockerNode) *entity.DockerNode {
+	e := &entity.DockerNode{
+		ID:             n.ID,
+		Name:           n.Name,
+		Endpoint:       n.Endpoint,
+		RpcAddress:     n.RpcAddress.String,
+		TLSCACertPath:  n.TlsCaCertPath.String,
+		TLSCertPath:    n.TlsCertPath.String,
+		TLSKeyPath:     n.TlsKeyPath.String,
+		FluentdAddress: n.FluentdAddress.String,
+		MaxHosts:       n.MaxHosts,
+		Enabled:        n.Enabled,
+	}
+	if n.CreatedAt.Valid {
+		e.CreatedAt = n.CreatedAt.Time
+	}
+
+	if n.UpdatedAt.Valid {
+		e.UpdatedAt = n.UpdatedAt.Time
+	}
+
+	return e
+}
+
+// textFromNonEmpty は空文字を NULL として pgtype.Text に変換する.
+func textFromNonEmpty(s string) pgtype.Text {
+	if s == "" {
+		return pgtype.Text{}
+	}
+
+	return pgtype.Text{String: s, Valid: true}
+}
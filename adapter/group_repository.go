@@ -86,6 +86,18 @@ func (r *GroupRepository) UpdateName(ctx context.Context, id, name string) error
 	})
 }
 
+func (r *GroupRepository) UpdateLogRetentionDays(ctx context.Context, id string, days *int32) error {
+	var v pgtype.Int4
+	if days != nil {
+		v = pgtype.Int4{Int32: *days, Valid: true}
+	}
+
+	return r.q.UpdateGroupLogRetentionDays(ctx, db.UpdateGroupLogRetentionDaysParams{
+		ID:               id,
+		LogRetentionDays: v,
+	})
+}
+
 func (r *GroupRepository) Delete(ctx context.Context, id string) error {
 	return r.q.DeleteGroup(ctx, id)
 }
@@ -104,6 +116,11 @@ func dbGroupToEntity(g db.Group) *entity.Group {
 		e.UpdatedAt = g.UpdatedAt.Time
 	}
 
+	if g.LogRetentionDays.Valid {
+		days := g.LogRetentionDays.Int32
+		e.LogRetentionDays = &days
+	}
+
 	return e
 }
 
@@ -1,6 +1,7 @@
 package adapter
 
 import (
+	"cmp"
 	"context"
 	"encoding/json"
 	"fmt"
@@ -19,6 +20,7 @@ import (
 	"github.com/hantabaru1014/baru-reso-headless-controller/db"
 	"github.com/hantabaru1014/baru-reso-headless-controller/domain"
 	"github.com/hantabaru1014/baru-reso-headless-controller/domain/entity"
+	hdlctrlv1 "github.com/hantabaru1014/baru-reso-headless-controller/pbgen/hdlctrl/v1"
 	headlessv1 "github.com/hantabaru1014/baru-reso-headless-controller/pbgen/headless/v1"
 	"github.com/hantabaru1014/baru-reso-headless-controller/usecase/port"
 	"github.com/jackc/pgx/v5/pgtype"
@@ -30,21 +32,25 @@ const (
 	createHostCleanupTimeout = 30 * time.Second
 	// createHostCleanupStopGrace は補償停止時に container Stop に与える grace period (秒).
 	createHostCleanupStopGrace = 10
+	// scanLogsBatch は ScanLogs が 1 回に fn へ渡すログの上限.
+	scanLogsBatch = 1000
 )
 
 var _ port.HeadlessHostRepository = (*HeadlessHostRepository)(nil)
 
 type HeadlessHostRepository struct {
-	q         *db.Queries
-	connector hostconnector.HostConnector
-	grpcCfg   *config.GRPCConfig
+	q          *db.Queries
+	connectors hostconnector.Connectors
+	grpcCfg    *config.GRPCConfig
+	archive    *ContainerLogArchive
 }
 
-func NewHeadlessHostRepository(q *db.Queries, connector hostconnector.HostConnector, grpcCfg *config.GRPCConfig) *HeadlessHostRepository {
+func NewHeadlessHostRepository(q *db.Queries, connectors hostconnector.Connectors, grpcCfg *config.GRPCConfig, archive *ContainerLogArchive) *HeadlessHostRepository {
 	return &HeadlessHostRepository{
-		q:         q,
-		connector: connector,
-		grpcCfg:   grpcCfg,
+		q:          q,
+		connectors: connectors,
+		grpcCfg:    grpcCfg,
+		archive:    archive,
 	}
 }
 
@@ -69,6 +75,28 @@ func (h *HeadlessHostRepository) UpdateAutoUpdatePolicy(ctx context.Context, id
 	})
 }
 
+// UpdateContainerSettings implements port.HeadlessHostRepository.
+func (h *HeadlessHostRepository) UpdateContainerSettings(ctx context.Context, id string, settings *entity.HostContainerSettings) error {
+	json, err := marshalContainerSettings(settings)
+	if err != nil {
+		return err
+	}
+
+	return h.q.UpdateHostContainerSettings(ctx, db.UpdateHostContainerSettingsParams{
+		ID:                id,
+		ContainerSettings: json,
+	})
+}
+
+// UpdateAutoRestartPolicy implements port.HeadlessHostRepository.
+func (h *HeadlessHostRepository) UpdateAutoRestartPolicy(ctx context.Context, id string, policy entity.HostAutoRestartPolicy, maxRetries int32) error {
+	return h.q.UpdateHostAutoRestartPolicy(ctx, db.UpdateHostAutoRestartPolicyParams{
+		ID:                    id,
+		AutoRestartPolicy:     int32(policy),
+		AutoRestartMaxRetries: maxRetries,
+	})
+}
+
 // GetGroupID implements port.HeadlessHostRepository.
 // DB のみで完結する軽量メソッド (RUNNING host への container RPC を起こさない).
 func (h *HeadlessHostRepository) GetGroupID(ctx context.Context, id string) (string, error) {
@@ -80,6 +108,22 @@ func (h *HeadlessHostRepository) GetGroupID(ctx context.Context, id string) (str
 	return host.GroupID, nil
 }
 
+// ListStatuses implements port.HeadlessHostRepository.
+// GetGroupID と同じく DB のみで完結する.
+func (h *HeadlessHostRepository) ListStatuses(ctx context.Context) (map[string]entity.HeadlessHostStatus, error) {
+	hosts, err := h.q.ListHosts(ctx)
+	if err != nil {
+		return nil, errors.WrapPrefix(convertDBErr(err), "headless host", 0)
+	}
+
+	result := make(map[string]entity.HeadlessHostStatus, len(hosts))
+	for _, host := range hosts {
+		result[host.ID] = entity.HeadlessHostStatus(host.Status)
+	}
+
+	return result, nil
+}
+
 // Find implements port.HeadlessHostRepository.
 func (h *HeadlessHostRepository) Find(ctx context.Context, id string, fetchOptions port.HeadlessHostFetchOptions) (*entity.HeadlessHost, error) {
 	host, err := h.q.GetHost(ctx, id)
@@ -91,9 +135,10 @@ func (h *HeadlessHostRepository) Find(ctx context.Context, id string, fetchOptio
 }
 
 // GetLogs implements port.HeadlessHostRepository.
+// 退避済みのインスタンスは退避したログと DB に残っているログ (退避後に書き込まれたもの) を
+// つなげて返す. 退避したログの ID は DB に残っているものより必ず小さい.
 func (h *HeadlessHostRepository) GetLogs(ctx context.Context, params port.GetLogsParams) (port.LogLineList, error) {
-	// FluentBitタグを構築: headless-{hostID}-{instanceID}
-	tag := fmt.Sprintf("headless-%s-%d", params.HostID, params.InstanceID)
+	tag := containerLogTag(params.HostID, params.InstanceID)
 
 	queryLimit := params.Limit
 	if queryLimit <= 0 {
@@ -113,7 +158,7 @@ func (h *HeadlessHostRepository) GetLogs(ctx context.Context, params port.GetLog
 	logs := make(port.LogLineList, 0, len(rows))
 
 	for _, row := range rows {
-		logLine, err := h.parseContainerLogRow(row)
+		logLine, err := parseContainerLog(row.ID, row.Ts, row.Data)
 		if err != nil {
 			continue
 		}
@@ -123,10 +168,132 @@ func (h *HeadlessHostRepository) GetLogs(ctx context.Context, params port.GetLog
 
 	slices.Reverse(logs) // 時系列順に反転
 
+	archive, err := h.archive.find(ctx, params.HostID, params.InstanceID)
+	if err != nil || archive == nil {
+		return logs, err
+	}
+
+	params.Limit = queryLimit
+
+	archived, err := h.archive.getLogs(ctx, archive, params)
+	if err != nil {
+		return nil, err
+	}
+
+	logs = append(archived, logs...)
+	if len(logs) > int(queryLimit) {
+		if params.AfterID > 0 {
+			logs = logs[:queryLimit]
+		} else {
+			logs = logs[len(logs)-int(queryLimit):]
+		}
+	}
+
 	return logs, nil
 }
 
+// ScanLogs implements port.HeadlessHostRepository.
+func (h *HeadlessHostRepository) ScanLogs(ctx context.Context, hostID string, instanceID int32, fn func(port.LogLineList) error) error {
+	var cursor int64
+
+	archive, err := h.archive.find(ctx, hostID, instanceID)
+	if err != nil {
+		return err
+	}
+
+	if archive != nil {
+		archived, err := h.archive.load(ctx, archive.ObjectKey)
+		if err != nil {
+			return err
+		}
+
+		for chunk := range slices.Chunk(archived, scanLogsBatch) {
+			if err := fn(chunk); err != nil {
+				return err
+			}
+		}
+
+		cursor = archive.LastLogID
+	}
+
+	tag := pgtype.Text{String: containerLogTag(hostID, instanceID), Valid: true}
+
+	for {
+		rows, err := h.q.ListContainerLogsAfterID(ctx, db.ListContainerLogsAfterIDParams{
+			Tag:     tag,
+			AfterID: cursor,
+			MaxRows: scanLogsBatch,
+		})
+		if err != nil {
+			return errors.Wrap(err, 0)
+		}
+
+		logs := make(port.LogLineList, 0, len(rows))
+
+		for _, row := range rows {
+			cursor = row.ID.Int64
+
+			logLine, err := parseContainerLog(row.ID, row.Ts, row.Data)
+			if err != nil {
+				continue
+			}
+
+			logs = append(logs, logLine)
+		}
+
+		if len(logs) > 0 {
+			if err := fn(logs); err != nil {
+				return err
+			}
+		}
+
+		if len(rows) < scanLogsBatch {
+			return nil
+		}
+	}
+}
+
+// SearchLogs implements port.HeadlessHostRepository.
+func (h *HeadlessHostRepository) SearchLogs(ctx context.Context, params port.SearchLogsParams) ([]*port.LogSearchHit, error) {
+	var hostIDs []string
+	if len(params.HostIDs) > 0 {
+		hostIDs = params.HostIDs
+	}
+
+	rows, err := h.q.SearchContainerLogs(ctx, db.SearchContainerLogsParams{
+		Pattern:  "%" + escapeLikePattern(params.Query) + "%",
+		GroupIds: params.GroupIDs,
+		HostIds:  hostIDs,
+		Since:    timestampFromPtr(params.Since),
+		Until:    timestampFromPtr(params.Until),
+		BeforeID: params.BeforeID,
+		MaxRows:  params.Limit,
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, 0)
+	}
+
+	hits := make([]*port.LogSearchHit, 0, len(rows))
+
+	for _, row := range rows {
+		logLine, err := parseContainerLog(row.ID, row.Ts, row.Data)
+		if err != nil {
+			continue
+		}
+
+		hits = append(hits, &port.LogSearchHit{
+			HostID:     row.HostID,
+			HostName:   row.HostName,
+			InstanceID: row.InstanceID,
+			Log:        logLine,
+		})
+	}
+
+	return hits, nil
+}
+
 // GetInstanceTimestamps implements port.HeadlessHostRepository.
+// 退避済みのインスタンスも含め、インスタンスの新しい順に返す.
 func (h *HeadlessHostRepository) GetInstanceTimestamps(ctx context.Context, hostID string) (port.InstanceTimestampList, error) {
 	rows, err := h.q.GetInstanceTimestamps(ctx, pgtype.Text{String: hostID, Valid: true})
 	if err != nil {
@@ -156,6 +323,40 @@ func (h *HeadlessHostRepository) GetInstanceTimestamps(ctx context.Context, host
 		})
 	}
 
+	archives, err := h.archive.listByHost(ctx, hostID)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, archive := range archives {
+		firstLogAt := archive.FirstLogAt.Time.Unix()
+		lastLogAt := archive.LastLogAt.Time.Unix()
+
+		idx := slices.IndexFunc(result, func(ts *port.InstanceTimestamp) bool { return ts.InstanceID == archive.InstanceID })
+		if idx < 0 {
+			result = append(result, &port.InstanceTimestamp{
+				InstanceID: archive.InstanceID,
+				FirstLogAt: &firstLogAt,
+				LastLogAt:  &lastLogAt,
+				LogCount:   archive.LogCount,
+				Archived:   true,
+			})
+
+			continue
+		}
+
+		// 退避後に書き込まれたログが DB に残っている
+		ts := result[idx]
+		ts.FirstLogAt = &firstLogAt
+		if ts.LastLogAt == nil {
+			ts.LastLogAt = &lastLogAt
+		}
+		ts.LogCount += archive.LogCount
+		ts.Archived = true
+	}
+
+	slices.SortFunc(result, func(a, b *port.InstanceTimestamp) int { return cmp.Compare(b.InstanceID, a.InstanceID) })
+
 	return result, nil
 }
 
@@ -236,8 +437,17 @@ func (h *HeadlessHostRepository) ListRunningByAccount(ctx context.Context, accou
 }
 
 // ListContainerTags implements port.HeadlessHostRepository.
+// イメージのレジストリは connector によらず共通なので、どれか1つに問い合わせる.
 func (h *HeadlessHostRepository) ListContainerTags(ctx context.Context, lastTag *string) (port.ContainerImageList, error) {
-	return h.connector.ListContainerTags(ctx, lastTag)
+	if connector, ok := h.connectors[port.HostConnectorType_DOCKER]; ok {
+		return connector.ListContainerTags(ctx, lastTag)
+	}
+
+	for _, connector := range h.connectors {
+		return connector.ListContainerTags(ctx, lastTag)
+	}
+
+	return nil, errors.New("no host connector is configured")
 }
 
 // Rename implements port.HeadlessHostRepository.
@@ -260,6 +470,11 @@ func (h *HeadlessHostRepository) Restart(ctx context.Context, id string, newStar
 		return errors.Wrap(err, 0)
 	}
 
+	containerSettings, err := unmarshalContainerSettings(dbHost.ContainerSettings)
+	if err != nil {
+		return err
+	}
+
 	connectStr := hostconnector.HostConnectString(dbHost.ConnectString)
 	wasRunning := dbHost.Status == int32(entity.HeadlessHostStatus_RUNNING)
 
@@ -318,6 +533,9 @@ func (h *HeadlessHostRepository) Restart(ctx context.Context, id string, newStar
 		ContainerImageTag: newStartupConfig.ContainerImageTag,
 		HeadlessAccount:   newStartupConfig.HeadlessAccount,
 		StartupConfig:     newStartupConfig.StartupConfig,
+		NodeID:            newStartupConfig.NodeID,
+		PreferredNodeID:   nodeIDOfHost(&dbHost),
+		ContainerSettings: *containerSettings,
 	}
 
 	newConnectStr, err := connector.Start(ctx, hostStartParams)
@@ -366,6 +584,11 @@ func (h *HeadlessHostRepository) Start(ctx context.Context, connector port.HostC
 		return "", errors.Wrap(err, 0)
 	}
 
+	containerSettingsJson, err := marshalContainerSettings(&params.ContainerSettings)
+	if err != nil {
+		return "", err
+	}
+
 	id := uniuri.New()
 
 	// 空文字 userId は NULL 扱いにする (empty string と NULL を混在させない).
@@ -381,6 +604,8 @@ func (h *HeadlessHostRepository) Start(ctx context.Context, connector port.HostC
 		ContainerImageTag: params.ContainerImageTag,
 		HeadlessAccount:   params.HeadlessAccount,
 		StartupConfig:     params.StartupConfig,
+		NodeID:            params.NodeID,
+		ContainerSettings: params.ContainerSettings,
 	}
 
 	newConnectStr, err := connectorImpl.Start(ctx, startParams)
@@ -408,7 +633,10 @@ func (h *HeadlessHostRepository) Start(ctx context.Context, connector port.HostC
 			Valid: true,
 			Time:  time.Now(),
 		},
-		InstanceCount: 1,
+		InstanceCount:         1,
+		ContainerSettings:     containerSettingsJson,
+		AutoRestartPolicy:     int32(params.AutoRestartPolicy),
+		AutoRestartMaxRetries: params.AutoRestartMaxRetries,
 	})
 	if err != nil {
 		// DB INSERT が失敗するとコンテナだけ起動した孤児状態が残る. docker-event-watcher
@@ -547,6 +775,11 @@ func (h *HeadlessHostRepository) Delete(ctx context.Context, id string) error {
 		return errors.Wrap(err, 0)
 	}
 
+	err = h.archive.removeHost(ctx, id)
+	if err != nil {
+		return errors.Wrap(err, 0)
+	}
+
 	return h.q.DeleteHost(ctx, id)
 }
 
@@ -598,10 +831,21 @@ func (h *HeadlessHostRepository) dbHostsToEntities(ctx context.Context, hosts []
 				InstanceId:       hosts[r.index].InstanceCount,
 				GroupID:          hosts[r.index].GroupID,
 				CreatedBy:        ptrFromText(hosts[r.index].CreatedBy),
+				NodeID:           nodeIDOfHost(&hosts[r.index]),
+
+				AutoRestartPolicy:     entity.HostAutoRestartPolicy(hosts[r.index].AutoRestartPolicy),
+				AutoRestartMaxRetries: hosts[r.index].AutoRestartMaxRetries,
+				CrashCount:            hosts[r.index].CrashCount,
+				LastCrashedAt:         ptrFromTimestamptz(hosts[r.index].LastCrashedAt),
+				AutoRestartSuspended:  hosts[r.index].AutoRestartSuspended,
 			}
 			if hosts[r.index].Memo.Valid {
 				result[r.index].Memo = hosts[r.index].Memo.String
 			}
+
+			if cs, err := unmarshalContainerSettings(hosts[r.index].ContainerSettings); err == nil {
+				result[r.index].ContainerSettings = *cs
+			}
 		} else {
 			result[r.index] = r.host
 			successCount++
@@ -613,36 +857,74 @@ func (h *HeadlessHostRepository) dbHostsToEntities(ctx context.Context, hosts []
 	return result
 }
 
-func (h *HeadlessHostRepository) parseContainerLogRow(row db.GetContainerLogsByTagRow) (*port.LogLine, error) {
+// containerLogTag は container_logs のタグ (FluentBit のタグ) を組み立てる: headless-{hostID}-{instanceID}.
+func containerLogTag(hostID string, instanceID int32) string {
+	return fmt.Sprintf("headless-%s-%d", hostID, instanceID)
+}
+
+func parseContainerLog(id pgtype.Int8, ts pgtype.Timestamp, raw []byte) (*port.LogLine, error) {
 	var data struct {
 		Log    string `json:"log"`
 		Stream string `json:"stream"` // "stdout" or "stderr"
 	}
 
-	err := json.Unmarshal(row.Data, &data)
+	err := json.Unmarshal(raw, &data)
 	if err != nil {
 		return nil, err
 	}
 
-	if !row.Ts.Valid || !row.ID.Valid {
+	if !ts.Valid || !id.Valid {
 		return nil, errors.New("invalid row data")
 	}
 
+	isError := data.Stream == "stderr"
+	body := strings.TrimSuffix(data.Log, "\n")
+
 	return &port.LogLine{
-		ID:        row.ID.Int64,
-		Timestamp: row.Ts.Time.Unix(),
-		IsError:   data.Stream == "stderr",
-		Body:      strings.TrimSuffix(data.Log, "\n"),
+		ID:        id.Int64,
+		Timestamp: ts.Time.Unix(),
+		IsError:   isError,
+		Body:      body,
+		Level:     entity.ClassifyHostLogLevel(isError, body),
 	}, nil
 }
 
 func (h *HeadlessHostRepository) getConnector(connector_type string) (hostconnector.HostConnector, error) {
-	switch port.HostConnectorType(connector_type) {
-	case port.HostConnectorType_DOCKER:
-		return h.connector, nil
-	default:
+	connector, ok := h.connectors[port.HostConnectorType(connector_type)]
+	if !ok {
 		return nil, errors.New("unsupported connector type: " + connector_type)
 	}
+
+	return connector, nil
+}
+
+func marshalContainerSettings(settings *entity.HostContainerSettings) ([]byte, error) {
+	json, err := protojson.Marshal(converter.HostContainerSettingsToProto(settings))
+	if err != nil {
+		return nil, errors.Wrap(err, 0)
+	}
+
+	return json, nil
+}
+
+func unmarshalContainerSettings(json []byte) (*entity.HostContainerSettings, error) {
+	parsed := &hdlctrlv1.HeadlessHostContainerSettings{}
+	if len(json) > 0 {
+		if err := protojson.Unmarshal(json, parsed); err != nil {
+			return nil, errors.WrapPrefix(err, "container settings", 0)
+		}
+	}
+
+	return converter.HostContainerSettingsProtoToEntity(parsed), nil
+}
+
+// nodeIDOfHost は connect_string から host が配置されている node を取り出す.
+func nodeIDOfHost(dbHost *db.Host) string {
+	if port.HostConnectorType(dbHost.ConnectorType) != port.HostConnectorType_DOCKER {
+		return ""
+	}
+
+	return hostconnector.DockerNodeIDOf(hostconnector.HostConnectString(dbHost.ConnectString))
 }
 
 func (h *HeadlessHostRepository) fetchHostInfo(ctx context.Context, host *entity.HeadlessHost, client headlessv1.HeadlessControlServiceClient) error {
@@ -712,11 +994,25 @@ func (h *HeadlessHostRepository) dbToEntity(ctx context.Context, dbHost *db.Host
 		InstanceId:       dbHost.InstanceCount,
 		GroupID:          dbHost.GroupID,
 		CreatedBy:        ptrFromText(dbHost.CreatedBy),
+		NodeID:           nodeIDOfHost(dbHost),
+
+		AutoRestartPolicy:     entity.HostAutoRestartPolicy(dbHost.AutoRestartPolicy),
+		AutoRestartMaxRetries: dbHost.AutoRestartMaxRetries,
+		CrashCount:            dbHost.CrashCount,
+		LastCrashedAt:         ptrFromTimestamptz(dbHost.LastCrashedAt),
+		AutoRestartSuspended:  dbHost.AutoRestartSuspended,
 	}
 	if dbHost.Memo.Valid {
 		host.Memo = dbHost.Memo.String
 	}
 
+	containerSettings, err := unmarshalContainerSettings(dbHost.ContainerSettings)
+	if err != nil {
+		return nil, err
+	}
+
+	host.ContainerSettings = *containerSettings
+
 	// Only fetch live data if running
 	if status == entity.HeadlessHostStatus_RUNNING {
 		connector, err := h.getConnector(dbHost.ConnectorType)
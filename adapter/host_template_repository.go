@@ -0,0 +1,188 @@
+package adapter
+
+import (
+	"context"
+
+	"github.com/go-errors/errors"
+	"github.com/hantabaru1014/baru-reso-headless-controller/db"
+	"github.com/hantabaru1014/baru-reso-headless-controller/domain/entity"
+	headlessv1 "github.com/hantabaru1014/baru-reso-headless-controller/pbgen/headless/v1"
+	"github.com/hantabaru1014/baru-reso-headless-controller/usecase/port"
+	"github.com/jackc/pgx/v5/pgtype"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+var _ port.HostTemplateRepository = (*HostTemplateRepository)(nil)
+
+type HostTemplateRepository struct {
+	q *db.Queries
+}
+
+func NewHostTemplateRepository(q *db.Queries) *HostTemplateRepository {
+	return &HostTemplateRepository{q: q}
+}
+
+func (r *HostTemplateRepository) Create(ctx context.Context, tmpl *entity.HostTemplate) error {
+	startupConfig, err := marshalTemplateStartupConfig(tmpl.StartupConfig)
+	if err != nil {
+		return err
+	}
+
+	containerSettings, err := marshalContainerSettings(&tmpl.ContainerSettings)
+	if err != nil {
+		return err
+	}
+
+	row, err := r.q.CreateHostTemplate(ctx, db.CreateHostTemplateParams{
+		ID:                    tmpl.ID,
+		GroupID:               tmpl.GroupID,
+		Name:                  tmpl.Name,
+		Description:           tmpl.Description,
+		ImageTag:              tmpl.ImageTag,
+		StartupConfig:         startupConfig,
+		AutoUpdatePolicy:      int32(tmpl.AutoUpdatePolicy),
+		Memo:                  tmpl.Memo,
+		ContainerSettings:     containerSettings,
+		AutoRestartPolicy:     int32(tmpl.AutoRestartPolicy),
+		AutoRestartMaxRetries: tmpl.AutoRestartMaxRetries,
+		CreatedBy:             textFromPtr(tmpl.CreatedBy),
+	})
+	if err != nil {
+		return errors.WrapPrefix(convertTemplateDBErr(err), "host_template", 0)
+	}
+
+	created, err := hostTemplateToEntity(row)
+	if err != nil {
+		return err
+	}
+
+	*tmpl = *created
+
+	return nil
+}
+
+func (r *HostTemplateRepository) Get(ctx context.Context, id string) (*entity.HostTemplate, error) {
+	row, err := r.q.GetHostTemplate(ctx, id)
+	if err != nil {
+		return nil, errors.WrapPrefix(convertDBErr(err), "host_template", 0)
+	}
+
+	return hostTemplateToEntity(row)
+}
+
+func (r *HostTemplateRepository) ListByGroup(ctx context.Context, groupID string) (entity.HostTemplateList, error) {
+	rows, err := r.q.ListHostTemplatesByGroup(ctx, groupID)
+	if err != nil {
+		return nil, errors.WrapPrefix(convertDBErr(err), "host_template", 0)
+	}
+
+	result := make(entity.HostTemplateList, 0, len(rows))
+
+	for _, row := range rows {
+		tmpl, err := hostTemplateToEntity(row)
+		if err != nil {
+			return nil, err
+		}
+
+		result = append(result, tmpl)
+	}
+
+	return result, nil
+}
+
+func (r *HostTemplateRepository) Update(ctx context.Context, id string, params port.HostTemplateUpdateParams) (*entity.HostTemplate, error) {
+	arg := db.UpdateHostTemplateParams{
+		ID:          id,
+		Name:        textFromPtr(params.Name),
+		Description: textFromPtr(params.Description),
+		ImageTag:    textFromPtr(params.ImageTag),
+		Memo:        textFromPtr(params.Memo),
+	}
+
+	if params.StartupConfig != nil {
+		b, err := marshalTemplateStartupConfig(params.StartupConfig)
+		if err != nil {
+			return nil, err
+		}
+
+		arg.StartupConfig = b
+	}
+
+	if params.AutoUpdatePolicy != nil {
+		arg.AutoUpdatePolicy = pgtype.Int4{Int32: int32(*params.AutoUpdatePolicy), Valid: true}
+	}
+
+	if params.ContainerSettings != nil {
+		b, err := marshalContainerSettings(params.ContainerSettings)
+		if err != nil {
+			return nil, err
+		}
+
+		arg.ContainerSettings = b
+	}
+
+	if params.AutoRestartPolicy != nil {
+		arg.AutoRestartPolicy = pgtype.Int4{Int32: int32(*params.AutoRestartPolicy), Valid: true}
+	}
+
+	if params.AutoRestartMaxRetries != nil {
+		arg.AutoRestartMaxRetries = pgtype.Int4{Int32: *params.AutoRestartMaxRetries, Valid: true}
+	}
+
+	row, err := r.q.UpdateHostTemplate(ctx, arg)
+	if err != nil {
+		return nil, errors.WrapPrefix(convertTemplateDBErr(err), "host_template", 0)
+	}
+
+	return hostTemplateToEntity(row)
+}
+
+func (r *HostTemplateRepository) Delete(ctx context.Context, id string) error {
+	if err := r.q.DeleteHostTemplate(ctx, id); err != nil {
+		return errors.WrapPrefix(convertDBErr(err), "host_template", 0)
+	}
+
+	return nil
+}
+
+func marshalTemplateStartupConfig(cfg *headlessv1.StartupConfig) ([]byte, error) {
+	if cfg == nil {
+		cfg = &headlessv1.StartupConfig{}
+	}
+
+	b, err := protojson.Marshal(cfg)
+	if err != nil {
+		return nil, errors.Wrap(err, 0)
+	}
+
+	return b, nil
+}
+
+func hostTemplateToEntity(row db.HostTemplate) (*entity.HostTemplate, error) {
+	startupConfig := &headlessv1.StartupConfig{}
+	if err := protojson.Unmarshal(row.StartupConfig, startupConfig); err != nil {
+		return nil, errors.Wrap(err, 0)
+	}
+
+	containerSettings, err := unmarshalContainerSettings(row.ContainerSettings)
+	if err != nil {
+		return nil, err
+	}
+
+	return &entity.HostTemplate{
+		ID:                    row.ID,
+		GroupID:               row.GroupID,
+		Name:                  row.Name,
+		Description:           row.Description,
+		ImageTag:              row.ImageTag,
+		StartupConfig:         startupConfig,
+		AutoUpdatePolicy:      entity.HostAutoUpdatePolicy(row.AutoUpdatePolicy),
+		Memo:                  row.Memo,
+		ContainerSettings:     *containerSettings,
+		AutoRestartPolicy:     entity.HostAutoRestartPolicy(row.AutoRestartPolicy),
+		AutoRestartMaxRetries: row.AutoRestartMaxRetries,
+		CreatedBy:             ptrFromText(row.CreatedBy),
+		CreatedAt:             row.CreatedAt.Time,
+		UpdatedAt:             row.UpdatedAt.Time,
+	}, nil
+}
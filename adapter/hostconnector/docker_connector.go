@@ -7,13 +7,16 @@ import (
 	"encoding/json"
 	"fmt"
 	"io"
+	"log/slog"
 	"net"
 	"net/http"
 	"slices"
 	"strconv"
 	"strings"
+	"sync"
 
 	"github.com/moby/moby/api/types/container"
+	"github.com/moby/moby/api/types/mount"
 	"github.com/moby/moby/client"
 	"github.com/go-errors/errors"
 	"github.com/hantabaru1014/baru-reso-headless-controller/config"
@@ -22,8 +25,6 @@ import (
 	"github.com/hantabaru1014/baru-reso-headless-controller/lib"
 	headlessv1 "github.com/hantabaru1014/baru-reso-headless-controller/pbgen/headless/v1"
 	"github.com/hantabaru1014/baru-reso-headless-controller/usecase/port"
-	"google.golang.org/grpc"
-	"google.golang.org/grpc/credentials/insecure"
 	"google.golang.org/protobuf/encoding/protojson"
 )
 
@@ -31,26 +32,47 @@ var ErrContainerNotFound = errors.New("container not found")
 
 var _ HostConnector = (*DockerHostConnector)(nil)
 
+// DockerHostConnector runs headless hosts as containers on a pool of Docker
+// daemons ("nodes"): the controller's own daemon plus any remote daemons
+// registered in the docker_nodes table. The connect string records which
+// node a container lives on.
 type DockerHostConnector struct {
 	dockerCfg *config.DockerConfig
 	grpcCfg   *config.GRPCConfig
+	nodeRepo  port.DockerNodeRepository
+	cipher    port.CredentialCipher
+
+	clientsMu sync.Mutex
+	clients   map[string]*dockerNodeClient
+
+	// portMu serialises RPC port allocation + container creation on
+	// remote nodes so two concurrent starts cannot pick the same port.
+	portMu sync.Mutex
 }
 
-func NewDockerHostConnector(dockerCfg *config.DockerConfig, grpcCfg *config.GRPCConfig) *DockerHostConnector {
+func NewDockerHostConnector(dockerCfg *config.DockerConfig, grpcCfg *config.GRPCConfig, nodeRepo port.DockerNodeRepository, cipher port.CredentialCipher) *DockerHostConnector {
 	return &DockerHostConnector{
 		dockerCfg: dockerCfg,
 		grpcCfg:   grpcCfg,
+		nodeRepo:  nodeRepo,
+		cipher:    cipher,
+		clients:   make(map[string]*dockerNodeClient),
 	}
 }
 
 // GetStatus implements HostConnector.
 func (d *DockerHostConnector) GetStatus(ctx context.Context, connect_string HostConnectString) entity.HeadlessHostStatus {
-	id, _, err := parseConnectString(connect_string)
+	target, err := parseConnectString(connect_string)
+	if err != nil {
+		return entity.HeadlessHostStatus_UNKNOWN
+	}
+
+	cli, _, err := d.clientForNode(ctx, target.NodeID)
 	if err != nil {
 		return entity.HeadlessHostStatus_UNKNOWN
 	}
 
-	container, err := d.getContainer(ctx, id)
+	container, err := d.findContainer(ctx, cli, target.ContainerID)
 	if err != nil {
 		return entity.HeadlessHostStatus_UNKNOWN
 	}
@@ -60,12 +82,17 @@ func (d *DockerHostConnector) GetStatus(ctx context.Context, connect_string Host
 
 // GetRpcClient implements HostConnector.
 func (d *DockerHostConnector) GetRpcClient(ctx context.Context, connect_string HostConnectString) (headlessv1.HeadlessControlServiceClient, error) {
-	id, port, err := parseConnectString(connect_string)
+	target, err := parseConnectString(connect_string)
 	if err != nil {
 		return nil, err
 	}
 
-	container, err := d.getContainer(ctx, id)
+	cli, node, err := d.clientForNode(ctx, target.NodeID)
+	if err != nil {
+		return nil, err
+	}
+
+	container, err := d.findContainer(ctx, cli, target.ContainerID)
 	if err != nil {
 		if errors.Is(err, ErrContainerNotFound) {
 			return nil, errors.WrapPrefix(domain.ErrNotFound, "grpc client (docker container)", 0)
@@ -78,30 +105,27 @@ func (d *DockerHostConnector) GetRpcClient(ctx context.Context, connect_string H
 		return nil, errors.Errorf("specific container is not running")
 	}
 
-	address := fmt.Sprintf("localhost:%d", port)
-
-	conn, err := grpc.NewClient(address,
-		grpc.WithTransportCredentials(insecure.NewCredentials()),
-		grpc.WithConnectParams(grpc.ConnectParams{
-			MinConnectTimeout: d.grpcCfg.ConnectTimeout,
-		}),
-	)
-	if err != nil {
-		return nil, errors.New(err)
-	}
+	address := net.JoinHostPort(rpcHostForNode(node), strconv.Itoa(target.Port))
 
-	return headlessv1.NewHeadlessControlServiceClient(conn), nil
+	return newHeadlessRpcClient(address, d.grpcCfg)
 }
 
 func (d *DockerHostConnector) ListContainerTags(ctx context.Context, lastTag *string) (port.ContainerImageList, error) {
+	return listRegistryTags(ctx, d.dockerCfg, lastTag)
+}
+
+// listRegistryTags lists the versioned tags of the headless image straight
+// from the registry. It does not depend on a container runtime, so every
+// connector shares it.
+func listRegistryTags(ctx context.Context, dockerCfg *config.DockerConfig, lastTag *string) (port.ContainerImageList, error) {
 	type tagsResponse struct {
 		Name string   `json:"name"`
 		Tags []string `json:"tags"`
 	}
 
-	imageNameParts := strings.Split(d.dockerCfg.HeadlessImageName, "/")
+	imageNameParts := strings.Split(dockerCfg.HeadlessImageName, "/")
 	if len(imageNameParts) != 3 { //nolint:mnd // registry/user/image format
-		return nil, errors.Errorf("invalid image name format: %s", d.dockerCfg.HeadlessImageName)
+		return nil, errors.Errorf("invalid image name format: %s", dockerCfg.HeadlessImageName)
 	}
 
 	registryName := imageNameParts[0]
@@ -123,11 +147,11 @@ func (d *DockerHostConnector) ListContainerTags(ctx context.Context, lastTag *st
 		}
 
 		if registryName == "ghcr.io" {
-			if d.dockerCfg.GHCRAuthToken == "" {
+			if dockerCfg.GHCRAuthToken == "" {
 				return nil, errors.Errorf("GHCR_AUTH_TOKEN is not set")
 			}
 
-			req.Header.Set("Authorization", "Bearer "+base64.StdEncoding.EncodeToString([]byte(d.dockerCfg.GHCRAuthToken)))
+			req.Header.Set("Authorization", "Bearer "+base64.StdEncoding.EncodeToString([]byte(dockerCfg.GHCRAuthToken)))
 		}
 
 		resp, err := client.Do(req)
@@ -177,12 +201,42 @@ func (d *DockerHostConnector) ListContainerTags(ctx context.Context, lastTag *st
 	return allTags, nil
 }
 
+// PullContainerImage implements HostConnector.
+// The image is pulled on every node so that a later Start is fast no matter
+// where it lands. Nodes that fail are reported after all others were tried.
 func (d *DockerHostConnector) PullContainerImage(ctx context.Context, tag string) (string, error) {
-	cli, err := d.newDockerClient()
+	nodeIDs, err := d.ListNodeIDs(ctx)
 	if err != nil {
-		return "", errors.New(err)
+		return "", err
+	}
+
+	var (
+		out      strings.Builder
+		firstErr error
+	)
+
+	for _, nodeID := range nodeIDs {
+		cli, _, err := d.clientForNode(ctx, nodeID)
+		if err == nil {
+			var pulled string
+
+			pulled, err = d.pullImage(ctx, cli, tag)
+			out.WriteString(pulled)
+		}
+
+		if err != nil {
+			slog.Warn("failed to pull container image on docker node", "nodeID", nodeID, "tag", tag, "error", err)
+
+			if firstErr == nil {
+				firstErr = errors.Errorf("node %s: %w", nodeID, err)
+			}
+		}
 	}
 
+	return out.String(), firstErr
+}
+
+func (d *DockerHostConnector) pullImage(ctx context.Context, cli *client.Client, tag string) (string, error) {
 	registryAuth := base64.StdEncoding.EncodeToString([]byte(d.dockerCfg.HeadlessRegistryAuth))
 	refStr := fmt.Sprintf("%s:%s", d.dockerCfg.HeadlessImageName, tag)
 
@@ -206,22 +260,55 @@ func (d *DockerHostConnector) PullContainerImage(ctx context.Context, tag string
 
 // Start implements HostConnector.
 func (d *DockerHostConnector) Start(ctx context.Context, params HostStartParams) (HostConnectString, error) {
-	cli, err := d.newDockerClient()
+	credential, password, err := d.cipher.Open(params.HeadlessAccount.ResoniteID, params.HeadlessAccount.Credentials)
+	if err != nil {
+		return "", err
+	}
+
+	nodeID, err := d.selectNode(ctx, params.NodeID, params.PreferredNodeID)
+	if err != nil {
+		return "", err
+	}
+
+	cli, node, err := d.clientForNode(ctx, nodeID)
 	if err != nil {
 		return "", errors.Errorf("failed to create docker client: %w", err)
 	}
 
 	imageTag := params.ContainerImageTag
-	if !d.isAvailableTag(ctx, imageTag) {
-		_, err := d.PullContainerImage(ctx, imageTag)
+	if !d.isAvailableTag(ctx, cli, imageTag) {
+		_, err := d.pullImage(ctx, cli, imageTag)
 		if err != nil {
 			return "", errors.Errorf("failed to pull container image: %w", err)
 		}
 	}
 
-	port, err := getFreePort()
-	if err != nil {
-		return "", errors.Errorf("failed to get free port: %w", err)
+	// On the local node the RPC server only needs to be reachable from
+	// this machine. On a remote node the controller dials it over the
+	// network, so it has to listen on all interfaces and the port has to
+	// be picked without probing (see allocateRemotePort).
+	var (
+		port       int
+		rpcBindURL string
+	)
+
+	if node == nil {
+		port, err = getFreePort()
+		if err != nil {
+			return "", errors.Errorf("failed to get free port: %w", err)
+		}
+
+		rpcBindURL = fmt.Sprintf("http://localhost:%d", port)
+	} else {
+		d.portMu.Lock()
+		defer d.portMu.Unlock()
+
+		port, err = d.allocateRemotePort(ctx, cli)
+		if err != nil {
+			return "", err
+		}
+
+		rpcBindURL = fmt.Sprintf("http://0.0.0.0:%d", port)
 	}
 
 	var startupConfig *string
@@ -237,9 +324,9 @@ func (d *DockerHostConnector) Start(ctx context.Context, params HostStartParams)
 	}
 
 	envs := []string{
-		"RpcHostUrl=" + fmt.Sprintf("http://localhost:%d", port),
-		"HeadlessUserCredential=" + params.HeadlessAccount.Credential,
-		"HeadlessUserPassword=" + params.HeadlessAccount.Password,
+		"RpcHostUrl=" + rpcBindURL,
+		"HeadlessUserCredential=" + credential,
+		"HeadlessUserPassword=" + password,
 	}
 	if startupConfig != nil {
 		envs = append(envs, "StartupConfig="+*startupConfig)
@@ -248,9 +335,17 @@ func (d *DockerHostConnector) Start(ctx context.Context, params HostStartParams)
 	config := container.Config{
 		Env:   envs,
 		Image: fmt.Sprintf("%s:%s", d.dockerCfg.HeadlessImageName, imageTag),
+		Labels: map[string]string{
+			containerLabelHostID:  params.ID,
+			containerLabelRpcPort: strconv.Itoa(port),
+		},
 	}
 
 	fluentdAddr := d.dockerCfg.FluentdAddress
+	if node != nil && node.FluentdAddress != "" {
+		fluentdAddr = node.FluentdAddress
+	}
+
 	if fluentdAddr == "" {
 		fluentdAddr = "localhost:24224"
 	}
@@ -265,6 +360,7 @@ func (d *DockerHostConnector) Start(ctx context.Context, params HostStartParams)
 			},
 		},
 	}
+	applyContainerSettings(&hostConfig, &params.ContainerSettings)
 
 	createResp, err := cli.ContainerCreate(ctx, client.ContainerCreateOptions{
 		Config:     &config,
@@ -279,22 +375,57 @@ func (d *DockerHostConnector) Start(ctx context.Context, params HostStartParams)
 		return "", errors.Errorf("failed to start container: %w", err)
 	}
 
-	return HostConnectString(createResp.ID + fmt.Sprintf(":%d", port)), nil
+	return formatConnectString(nodeID, createResp.ID, port), nil
+}
+
+// applyContainerSettings copies the per-host limits onto hostConfig. Zero
+// values are left unset so the daemon defaults (no limit) apply.
+func applyContainerSettings(hostConfig *container.HostConfig, s *entity.HostContainerSettings) {
+	if s.CPUs > 0 {
+		hostConfig.NanoCPUs = int64(s.CPUs * 1e9)
+	}
+
+	hostConfig.Memory = s.MemoryBytes
+	hostConfig.MemorySwap = s.MemorySwapBytes
+	hostConfig.CpusetCpus = s.CpusetCpus
+
+	switch s.RestartPolicy {
+	case entity.HostRestartPolicy_ON_FAILURE:
+		hostConfig.RestartPolicy = container.RestartPolicy{
+			Name:              container.RestartPolicyOnFailure,
+			MaximumRetryCount: int(s.RestartMaxRetries),
+		}
+	case entity.HostRestartPolicy_ALWAYS:
+		hostConfig.RestartPolicy = container.RestartPolicy{Name: container.RestartPolicyAlways}
+	case entity.HostRestartPolicy_UNLESS_STOPPED:
+		hostConfig.RestartPolicy = container.RestartPolicy{Name: container.RestartPolicyUnlessStopped}
+	case entity.HostRestartPolicy_UNSPECIFIED, entity.HostRestartPolicy_NO:
+		hostConfig.RestartPolicy = container.RestartPolicy{Name: container.RestartPolicyDisabled}
+	}
+
+	for _, m := range s.BindMounts {
+		hostConfig.Mounts = append(hostConfig.Mounts, mount.Mount{
+			Type:     mount.TypeBind,
+			Source:   m.Source,
+			Target:   m.Target,
+			ReadOnly: m.ReadOnly,
+		})
+	}
 }
 
 // Stop implements HostConnector.
 func (d *DockerHostConnector) Stop(ctx context.Context, connect_string HostConnectString, timeoutSeconds int) error {
-	id, _, err := parseConnectString(connect_string)
+	target, err := parseConnectString(connect_string)
 	if err != nil {
 		return err
 	}
 
-	cli, err := d.newDockerClient()
+	cli, _, err := d.clientForNode(ctx, target.NodeID)
 	if err != nil {
 		return errors.Errorf("failed to create docker client: %w", err)
 	}
 
-	_, err = cli.ContainerStop(ctx, id, client.ContainerStopOptions{
+	_, err = cli.ContainerStop(ctx, target.ContainerID, client.ContainerStopOptions{
 		Timeout: &timeoutSeconds,
 	})
 	if err != nil {
@@ -306,17 +437,17 @@ func (d *DockerHostConnector) Stop(ctx context.Context, connect_string HostConne
 
 // Kill implements HostConnector.
 func (d *DockerHostConnector) Kill(ctx context.Context, connect_string HostConnectString) error {
-	id, _, err := parseConnectString(connect_string)
+	target, err := parseConnectString(connect_string)
 	if err != nil {
 		return err
 	}
 
-	cli, err := d.newDockerClient()
+	cli, _, err := d.clientForNode(ctx, target.NodeID)
 	if err != nil {
 		return errors.Errorf("failed to create docker client: %w", err)
 	}
 
-	_, err = cli.ContainerKill(ctx, id, client.ContainerKillOptions{Signal: "SIGKILL"})
+	_, err = cli.ContainerKill(ctx, target.ContainerID, client.ContainerKillOptions{Signal: "SIGKILL"})
 	if err != nil {
 		return errors.Errorf("failed to kill container: %w", err)
 	}
@@ -326,12 +457,17 @@ func (d *DockerHostConnector) Kill(ctx context.Context, connect_string HostConne
 
 // Remove implements HostConnector.
 func (d *DockerHostConnector) Remove(ctx context.Context, connect_string HostConnectString) error {
-	id, _, err := parseConnectString(connect_string)
+	target, err := parseConnectString(connect_string)
 	if err != nil {
 		return err
 	}
 
-	_, err = d.getContainer(ctx, id)
+	cli, _, err := d.clientForNode(ctx, target.NodeID)
+	if err != nil {
+		return errors.Errorf("failed to create docker client: %w", err)
+	}
+
+	_, err = d.findContainer(ctx, cli, target.ContainerID)
 	if err != nil {
 		if errors.Is(err, ErrContainerNotFound) {
 			return nil
@@ -340,12 +476,7 @@ func (d *DockerHostConnector) Remove(ctx context.Context, connect_string HostCon
 		return errors.Errorf("failed to get container: %w", err)
 	}
 
-	cli, err := d.newDockerClient()
-	if err != nil {
-		return errors.Errorf("failed to create docker client: %w", err)
-	}
-
-	_, err = cli.ContainerRemove(ctx, id, client.ContainerRemoveOptions{
+	_, err = cli.ContainerRemove(ctx, target.ContainerID, client.ContainerRemoveOptions{
 		Force: true,
 	})
 	if err != nil {
@@ -355,25 +486,43 @@ func (d *DockerHostConnector) Remove(ctx context.Context, connect_string HostCon
 	return nil
 }
 
-// ParseConnectString splits a `containerID:port` connect string into its
-// parts. Exported so other packages can decode the same format without
+// ParseConnectString splits a `[nodeID/]containerID:port` connect string
+// into its container ID and port. Use DockerNodeIDOf for the node part.
+// Exported so other packages can decode the same format without
 // re-implementing the parser.
 func ParseConnectString(connect_string HostConnectString) (string, int, error) {
-	return parseConnectString(connect_string)
+	target, err := parseConnectString(connect_string)
+	if err != nil {
+		return "", 0, err
+	}
+
+	return target.ContainerID, target.Port, nil
 }
 
-func parseConnectString(connect_string HostConnectString) (string, int, error) {
-	splitted := strings.Split(string(connect_string), ":")
+func parseConnectString(connect_string HostConnectString) (dockerTarget, error) {
+	nodeID := LocalDockerNodeID
+	rest := string(connect_string)
+
+	if i := strings.Index(rest, connectStringNodeSeparator); i >= 0 {
+		nodeID = rest[:i]
+		rest = rest[i+1:]
+
+		if nodeID == "" {
+			return dockerTarget{}, errors.Errorf("invalid connect string format: %s", connect_string)
+		}
+	}
+
+	splitted := strings.Split(rest, ":")
 	if len(splitted) != 2 { //nolint:mnd // id:port format
-		return "", 0, errors.Errorf("invalid connect string format: %s", connect_string)
+		return dockerTarget{}, errors.Errorf("invalid connect string format: %s", connect_string)
 	}
 
 	port, err := strconv.Atoi(splitted[1])
 	if err != nil {
-		return "", 0, errors.Errorf("invalid port format: %s", splitted[1])
+		return dockerTarget{}, errors.Errorf("invalid port format: %s", splitted[1])
 	}
 
-	return splitted[0], port, nil
+	return dockerTarget{NodeID: nodeID, ContainerID: splitted[0], Port: port}, nil
 }
 
 type TagInfo struct {
@@ -473,11 +622,11 @@ type ContainerEvent struct {
 	ExitCode    string // from event attributes, empty if not applicable
 }
 
-// SubscribeEvents starts listening to Docker container events.
+// SubscribeEvents starts listening to container events of one node.
 // Returns two channels: events and errors.
 // The caller should handle reconnection by calling SubscribeEvents again on error.
-func (d *DockerHostConnector) SubscribeEvents(ctx context.Context) (<-chan ContainerEvent, <-chan error, error) {
-	cli, err := d.newDockerClient()
+func (d *DockerHostConnector) SubscribeEvents(ctx context.Context, nodeID string) (<-chan ContainerEvent, <-chan error, error) {
+	cli, _, err := d.clientForNode(ctx, nodeID)
 	if err != nil {
 		return nil, nil, errors.Errorf("failed to create docker client: %w", err)
 	}
@@ -494,7 +643,6 @@ func (d *DockerHostConnector) SubscribeEvents(ctx context.Context) (<-chan Conta
 	outErrChan := make(chan error, 1)
 
 	go func() {
-		defer func() { _ = cli.Close() }()
 		defer close(outChan)
 		defer close(outErrChan)
 
@@ -529,9 +677,10 @@ func (d *DockerHostConnector) SubscribeEvents(ctx context.Context) (<-chan Conta
 	return outChan, outErrChan, nil
 }
 
-// ListAllContainerStatuses returns current status of all containers matching our image.
-func (d *DockerHostConnector) ListAllContainerStatuses(ctx context.Context) (map[string]entity.HeadlessHostStatus, error) {
-	cli, err := d.newDockerClient()
+// ListAllContainerStatuses returns current status of all containers on one
+// node that match our image.
+func (d *DockerHostConnector) ListAllContainerStatuses(ctx context.Context, nodeID string) (map[string]entity.HeadlessHostStatus, error) {
+	cli, _, err := d.clientForNode(ctx, nodeID)
 	if err != nil {
 		return nil, errors.Errorf("failed to create docker client: %w", err)
 	}
@@ -551,7 +700,7 @@ func (d *DockerHostConnector) ListAllContainerStatuses(ctx context.Context) (map
 
 	for _, c := range containers.Items {
 		// Filter by image name prefix (imageName:tag format)
-		if !strings.HasPrefix(c.Image, d.dockerCfg.HeadlessImageName+":") && c.Image != d.dockerCfg.HeadlessImageName {
+		if !d.isHeadlessImage(c.Image) {
 			continue
 		}
 
@@ -562,13 +711,8 @@ func (d *DockerHostConnector) ListAllContainerStatuses(ctx context.Context) (map
 	return result, nil
 }
 
-// 指定したタグがローカルに存在するかどうかを確認する.
-func (d *DockerHostConnector) isAvailableTag(ctx context.Context, tag string) bool {
-	cli, err := d.newDockerClient()
-	if err != nil {
-		return false
-	}
-
+// 指定したタグが node 上に存在するかどうかを確認する.
+func (d *DockerHostConnector) isAvailableTag(ctx context.Context, cli *client.Client, tag string) bool {
 	images, err := cli.ImageList(ctx, client.ImageListOptions{
 		All:     true,
 		Filters: make(client.Filters).Add("reference", d.dockerCfg.HeadlessImageName),
@@ -595,21 +739,7 @@ func (d *DockerHostConnector) isAvailableTag(ctx context.Context, tag string) bo
 	return false
 }
 
-func (d *DockerHostConnector) newDockerClient() (*client.Client, error) {
-	cli, err := client.New(client.FromEnv)
-	if err != nil {
-		return nil, errors.Wrap(err, 0)
-	}
-
-	return cli, nil
-}
-
-func (d *DockerHostConnector) getContainer(ctx context.Context, container_id string) (*container.Summary, error) {
-	cli, err := d.newDockerClient()
-	if err != nil {
-		return nil, errors.New(err)
-	}
-
+func (d *DockerHostConnector) findContainer(ctx context.Context, cli *client.Client, container_id string) (*container.Summary, error) {
 	containers, err := cli.ContainerList(ctx, client.ContainerListOptions{
 		All:     true,
 		Filters: make(client.Filters).Add("id", container_id),
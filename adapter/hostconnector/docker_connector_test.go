@@ -0,0 +1,49 @@
+package hostconnector
+
+import (
+	"testing"
+
+	"github.com/hantabaru1014/baru-reso-headless-controller/domain/entity"
+	"github.com/moby/moby/api/types/container"
+	"github.com/moby/moby/api/types/mount"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestApplyContainerSettings(t *testing.T) {
+	t.Parallel()
+
+	t.Run("zero value leaves limits unset", func(t *testing.T) {
+		t.Parallel()
+
+		hc := container.HostConfig{NetworkMode: "host"}
+		applyContainerSettings(&hc, &entity.HostContainerSettings{})
+
+		assert.Zero(t, hc.NanoCPUs)
+		assert.Zero(t, hc.Memory)
+		assert.Zero(t, hc.MemorySwap)
+		assert.Empty(t, hc.Mounts)
+		assert.Equal(t, container.RestartPolicyDisabled, hc.RestartPolicy.Name)
+	})
+
+	t.Run("all settings", func(t *testing.T) {
+		t.Parallel()
+
+		hc := container.HostConfig{}
+		applyContainerSettings(&hc, &entity.HostContainerSettings{
+			CPUs:              1.5,
+			MemoryBytes:       4 << 30,
+			MemorySwapBytes:   -1,
+			CpusetCpus:        "0-3",
+			RestartPolicy:     entity.HostRestartPolicy_ON_FAILURE,
+			RestartMaxRetries: 5,
+			BindMounts:        []entity.HostBindMount{{Source: "/srv/cache", Target: "/Cache", ReadOnly: true}},
+		})
+
+		assert.Equal(t, int64(1_500_000_000), hc.NanoCPUs)
+		assert.Equal(t, int64(4<<30), hc.Memory)
+		assert.Equal(t, int64(-1), hc.MemorySwap)
+		assert.Equal(t, "0-3", hc.CpusetCpus)
+		assert.Equal(t, container.RestartPolicy{Name: container.RestartPolicyOnFailure, MaximumRetryCount: 5}, hc.RestartPolicy)
+		assert.Equal(t, []mount.Mount{{Type: mount.TypeBind, Source: "/srv/cache", Target: "/Cache", ReadOnly: true}}, hc.Mounts)
+	})
+}
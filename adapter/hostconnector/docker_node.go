@@ -0,0 +1,376 @@
+package hostconnector
+
+import (
+	"context"
+	"log/slog"
+	"math/rand/v2"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/docker/cli/cli/connhelper"
+	"github.com/go-errors/errors"
+	"github.com/hantabaru1014/baru-reso-headless-controller/domain/entity"
+	"github.com/moby/moby/client"
+)
+
+// LocalDockerNodeID identifies the controller's own Docker daemon
+// (DOCKER_HOST / the local unix socket). It is never stored in the
+// docker_nodes table, and hosts placed on it keep the legacy
+// `containerID:port` connect string so existing rows need no migration.
+const LocalDockerNodeID = "local"
+
+// ErrNoDockerNodeAvailable is returned by Start when every candidate node
+// is disabled, unreachable or at its max_hosts capacity.
+var ErrNoDockerNodeAvailable = errors.New("no docker node available")
+
+const (
+	// containerLabelHostID / containerLabelRpcPort are attached to every
+	// container we create. The port label is what lets us allocate RPC
+	// ports on remote nodes without being able to bind-probe them.
+	containerLabelHostID  = "brhc.host_id"
+	containerLabelRpcPort = "brhc.rpc_port"
+
+	connectStringNodeSeparator = "/"
+)
+
+// dockerTarget is a decoded docker connect string.
+type dockerTarget struct {
+	NodeID      string
+	ContainerID string
+	Port        int
+}
+
+// dockerNodeClient is a cached Docker client for one node. updatedAt is the
+// node row's updated_at so that edits to the node invalidate the cache.
+type dockerNodeClient struct {
+	cli       *client.Client
+	updatedAt time.Time
+}
+
+// DockerNodeIDOf returns the node a docker connect string points at.
+// Malformed strings are attributed to the local node.
+func DockerNodeIDOf(connectString HostConnectString) string {
+	target, err := parseConnectString(connectString)
+	if err != nil {
+		return LocalDockerNodeID
+	}
+
+	return target.NodeID
+}
+
+// DockerConnectStringPrefix returns the part of a connect string that
+// precedes the port, i.e. what `hosts.connect_string LIKE prefix || ':%'`
+// must match to find the host owning containerID on nodeID.
+func DockerConnectStringPrefix(nodeID, containerID string) string {
+	if nodeID == LocalDockerNodeID || nodeID == "" {
+		return containerID
+	}
+
+	return nodeID + connectStringNodeSeparator + containerID
+}
+
+func formatConnectString(nodeID, containerID string, port int) HostConnectString {
+	return HostConnectString(DockerConnectStringPrefix(nodeID, containerID) + ":" + strconv.Itoa(port))
+}
+
+// ListNodeIDs returns the IDs of every node the connector can reach:
+// the local node (when enabled) followed by all registered nodes,
+// including disabled ones — disabled nodes still run hosts that need to
+// be watched, they just don't receive new placements.
+func (d *DockerHostConnector) ListNodeIDs(ctx context.Context) ([]string, error) {
+	ids := make([]string, 0)
+	if d.dockerCfg.LocalNodeEnabled {
+		ids = append(ids, LocalDockerNodeID)
+	}
+
+	nodes, err := d.nodeRepo.ListAll(ctx)
+	if err != nil {
+		return nil, errors.Errorf("failed to list docker nodes: %w", err)
+	}
+
+	for _, n := range nodes {
+		ids = append(ids, n.ID)
+	}
+
+	return ids, nil
+}
+
+// clientForNode returns a (cached) Docker client for nodeID together with
+// the node definition (nil for the local node).
+func (d *DockerHostConnector) clientForNode(ctx context.Context, nodeID string) (*client.Client, *entity.DockerNode, error) {
+	var node *entity.DockerNode
+
+	if nodeID != LocalDockerNodeID {
+		n, err := d.nodeRepo.Get(ctx, nodeID)
+		if err != nil {
+			return nil, nil, errors.Errorf("failed to get docker node %s: %w", nodeID, err)
+		}
+
+		node = n
+	}
+
+	d.clientsMu.Lock()
+	defer d.clientsMu.Unlock()
+
+	if cached, ok := d.clients[nodeID]; ok {
+		if node == nil || cached.updatedAt.Equal(node.UpdatedAt) {
+			return cached.cli, node, nil
+		}
+
+		_ = cached.cli.Close()
+		delete(d.clients, nodeID)
+	}
+
+	cli, err := newDockerClientForNode(node)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	entry := &dockerNodeClient{cli: cli}
+	if node != nil {
+		entry.updatedAt = node.UpdatedAt
+	}
+
+	d.clients[nodeID] = entry
+
+	return cli, node, nil
+}
+
+func newDockerClientForNode(node *entity.DockerNode) (*client.Client, error) {
+	if node == nil {
+		cli, err := client.New(client.FromEnv)
+		if err != nil {
+			return nil, errors.Wrap(err, 0)
+		}
+
+		return cli, nil
+	}
+
+	u, err := url.Parse(node.Endpoint)
+	if err != nil {
+		return nil, errors.Errorf("invalid docker node endpoint %q: %w", node.Endpoint, err)
+	}
+
+	var opts []client.Opt
+
+	switch u.Scheme {
+	case "ssh":
+		helper, err := connhelper.GetConnectionHelper(node.Endpoint)
+		if err != nil {
+			return nil, errors.Errorf("failed to set up ssh connection to %s: %w", node.Endpoint, err)
+		}
+
+		// WithHost reconfigures the transport, so the dialer must be
+		// applied after it.
+		opts = append(opts, client.WithHost(helper.Host), client.WithDialContext(helper.Dialer))
+	case "tcp":
+		opts = append(opts, client.WithHost(node.Endpoint))
+		if node.TLSCACertPath != "" || node.TLSCertPath != "" || node.TLSKeyPath != "" {
+			opts = append(opts, client.WithTLSClientConfig(node.TLSCACertPath, node.TLSCertPath, node.TLSKeyPath))
+		}
+	default:
+		return nil, errors.Errorf("unsupported docker node endpoint scheme: %q", u.Scheme)
+	}
+
+	cli, err := client.New(opts...)
+	if err != nil {
+		return nil, errors.Wrap(err, 0)
+	}
+
+	return cli, nil
+}
+
+// rpcHostForNode returns the address the controller dials to reach a
+// headless container's gRPC port on the given node.
+func rpcHostForNode(node *entity.DockerNode) string {
+	if node == nil {
+		return "localhost"
+	}
+
+	if node.RpcAddress != "" {
+		return node.RpcAddress
+	}
+
+	u, err := url.Parse(node.Endpoint)
+	if err != nil {
+		return node.Endpoint
+	}
+
+	return u.Hostname()
+}
+
+// selectNode decides where a new container goes. A pinned node is used
+// as-is (and failing to use it is an error); a preferred node is tried
+// first and skipped with a warning when unusable. Otherwise the enabled node
+// with the fewest running headless containers wins, ties going to the
+// local node and then to registration order.
+func (d *DockerHostConnector) selectNode(ctx context.Context, pinned, preferred string) (string, error) {
+	if pinned != "" {
+		if err := d.checkNodeUsable(ctx, pinned); err != nil {
+			return "", err
+		}
+
+		return pinned, nil
+	}
+
+	if preferred != "" {
+		err := d.checkNodeUsable(ctx, preferred)
+		if err == nil {
+			return preferred, nil
+		}
+
+		slog.Warn("preferred docker node is not usable, picking another one", "nodeID", preferred, "error", err)
+	}
+
+	type candidate struct {
+		id       string
+		maxHosts int32
+	}
+
+	candidates := make([]candidate, 0)
+	if d.dockerCfg.LocalNodeEnabled {
+		candidates = append(candidates, candidate{id: LocalDockerNodeID})
+	}
+
+	nodes, err := d.nodeRepo.ListAll(ctx)
+	if err != nil {
+		return "", errors.Errorf("failed to list docker nodes: %w", err)
+	}
+
+	for _, n := range nodes {
+		if n.Enabled {
+			candidates = append(candidates, candidate{id: n.ID, maxHosts: n.MaxHosts})
+		}
+	}
+
+	bestID := ""
+	bestCount := -1
+
+	for _, c := range candidates {
+		count, err := d.countRunningContainers(ctx, c.id)
+		if err != nil {
+			slog.Warn("skipping unreachable docker node", "nodeID", c.id, "error", err)
+
+			continue
+		}
+
+		if c.maxHosts > 0 && count >= int(c.maxHosts) {
+			continue
+		}
+
+		if bestCount < 0 || count < bestCount {
+			bestID = c.id
+			bestCount = count
+		}
+	}
+
+	if bestCount < 0 {
+		return "", ErrNoDockerNodeAvailable
+	}
+
+	return bestID, nil
+}
+
+// checkNodeUsable reports why a node cannot take a new container, if at all.
+func (d *DockerHostConnector) checkNodeUsable(ctx context.Context, nodeID string) error {
+	if nodeID == LocalDockerNodeID {
+		if !d.dockerCfg.LocalNodeEnabled {
+			return errors.Errorf("local docker node is disabled")
+		}
+
+		return nil
+	}
+
+	node, err := d.nodeRepo.Get(ctx, nodeID)
+	if err != nil {
+		return errors.Errorf("failed to get docker node %s: %w", nodeID, err)
+	}
+
+	if !node.Enabled {
+		return errors.Errorf("docker node %s is disabled", node.Name)
+	}
+
+	count, err := d.countRunningContainers(ctx, nodeID)
+	if err != nil {
+		return errors.Errorf("docker node %s is unreachable: %w", node.Name, err)
+	}
+
+	if node.MaxHosts > 0 && count >= int(node.MaxHosts) {
+		return errors.Errorf("docker node %s is full (%d/%d)", node.Name, count, node.MaxHosts)
+	}
+
+	return nil
+}
+
+func (d *DockerHostConnector) countRunningContainers(ctx context.Context, nodeID string) (int, error) {
+	cli, _, err := d.clientForNode(ctx, nodeID)
+	if err != nil {
+		return 0, err
+	}
+
+	containers, err := cli.ContainerList(ctx, client.ContainerListOptions{})
+	if err != nil {
+		return 0, errors.Errorf("failed to list containers: %w", err)
+	}
+
+	count := 0
+
+	for _, c := range containers.Items {
+		if d.isHeadlessImage(c.Image) {
+			count++
+		}
+	}
+
+	return count, nil
+}
+
+// allocateRemotePort picks an RPC port on a remote node. We cannot
+// bind-probe a remote machine, so instead we avoid every port already
+// claimed by a running container of ours (via the rpc-port label) and
+// pick randomly from the configured range. Callers must hold portMu.
+func (d *DockerHostConnector) allocateRemotePort(ctx context.Context, cli *client.Client) (int, error) {
+	portMin, portMax := d.dockerCfg.NodeRpcPortMin, d.dockerCfg.NodeRpcPortMax
+	if portMin <= 0 || portMax < portMin {
+		return 0, errors.Errorf("invalid docker node rpc port range: %d-%d", portMin, portMax)
+	}
+
+	containers, err := cli.ContainerList(ctx, client.ContainerListOptions{
+		Filters: make(client.Filters).Add("label", containerLabelRpcPort),
+	})
+	if err != nil {
+		return 0, errors.Errorf("failed to list containers: %w", err)
+	}
+
+	used := make(map[int]struct{}, len(containers.Items))
+
+	for _, c := range containers.Items {
+		if p, err := strconv.Atoi(c.Labels[containerLabelRpcPort]); err == nil {
+			used[p] = struct{}{}
+		}
+	}
+
+	return pickFreePort(used, portMin, portMax)
+}
+
+// pickFreePort returns a random port in [portMin, portMax] that is not in
+// used. Starting from a random offset keeps concurrent controllers (and
+// quick restarts) from all converging on the lowest free port.
+func pickFreePort(used map[int]struct{}, portMin, portMax int) (int, error) {
+	size := portMax - portMin + 1
+	offset := rand.IntN(size) //nolint:gosec // G404: port spreading, not security sensitive
+
+	for i := range size {
+		candidate := portMin + (offset+i)%size
+		if _, taken := used[candidate]; !taken {
+			return candidate, nil
+		}
+	}
+
+	return 0, errors.Errorf("no free rpc port in range %d-%d", portMin, portMax)
+}
+
+func (d *DockerHostConnector) isHeadlessImage(image string) bool {
+	return strings.HasPrefix(image, d.dockerCfg.HeadlessImageName+":") || image == d.dockerCfg.HeadlessImageName
+}
@@ -0,0 +1,56 @@
+package hostconnector
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseConnectString(t *testing.T) {
+	t.Parallel()
+
+	t.Run("legacy local format", func(t *testing.T) {
+		t.Parallel()
+
+		target, err := parseConnectString("abc123:5000")
+		require.NoError(t, err)
+		assert.Equal(t, dockerTarget{NodeID: LocalDockerNodeID, ContainerID: "abc123", Port: 5000}, target)
+	})
+
+	t.Run("remote node format", func(t *testing.T) {
+		t.Parallel()
+
+		target, err := parseConnectString("node1/abc123:30001")
+		require.NoError(t, err)
+		assert.Equal(t, dockerTarget{NodeID: "node1", ContainerID: "abc123", Port: 30001}, target)
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		t.Parallel()
+
+		for _, cs := range []HostConnectString{"abc123", "/abc123:5000", "node1/abc123:port", "a:b:c"} {
+			_, err := parseConnectString(cs)
+			assert.Error(t, err, cs)
+		}
+	})
+}
+
+func TestFormatConnectString_RoundTrip(t *testing.T) {
+	t.Parallel()
+
+	for _, nodeID := range []string{LocalDockerNodeID, "node1"} {
+		cs := formatConnectString(nodeID, "abc123", 30001)
+
+		target, err := parseConnectString(cs)
+		require.NoError(t, err)
+		assert.Equal(t, nodeID, target.NodeID)
+		assert.Equal(t, "abc123", target.ContainerID)
+		assert.Equal(t, 30001, target.Port)
+		assert.Equal(t, nodeID, DockerNodeIDOf(cs))
+	}
+
+	assert.Equal(t, HostConnectString("abc123:5000"), formatConnectString(LocalDockerNodeID, "abc123", 5000),
+		"local hosts must keep the legacy format so existing rows still resolve")
+	assert.Equal(t, "node1/abc123", DockerConnectStringPrefix("node1", "abc123"))
+}
@@ -3,12 +3,15 @@ package hostconnector
 import (
 	"context"
 
+	"github.com/go-errors/errors"
+	"github.com/hantabaru1014/baru-reso-headless-controller/config"
 	"github.com/hantabaru1014/baru-reso-headless-controller/domain/entity"
 	headlessv1 "github.com/hantabaru1014/baru-reso-headless-controller/pbgen/headless/v1"
 	"github.com/hantabaru1014/baru-reso-headless-controller/usecase/port"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
 )
 
-
 type HostConnectString string
 
 type HostStartParams struct {
@@ -17,6 +20,16 @@ type HostStartParams struct {
 	ContainerImageTag string
 	HeadlessAccount   entity.HeadlessAccount
 	StartupConfig     *headlessv1.StartupConfig
+	// NodeID pins the host to a specific node; Start fails if that node
+	// cannot take it. Empty lets the connector choose.
+	NodeID string
+	// PreferredNodeID is tried first when NodeID is empty, falling back to
+	// the connector's own choice. Restart uses it to keep a host on the
+	// node it was already running on.
+	PreferredNodeID string
+	// ContainerSettings holds resource limits and mounts. Connectors apply
+	// what their runtime supports and ignore the rest.
+	ContainerSettings entity.HostContainerSettings
 }
 
 type HostConnector interface {
@@ -30,3 +43,22 @@ type HostConnector interface {
 	// Remove removes the container. Returns nil if the container does not exist.
 	Remove(ctx context.Context, connect_string HostConnectString) error
 }
+
+// Connectors maps each connector type to its implementation. Hosts remember
+// the type they were started with, so every type that may still own hosts
+// has to be present even if new hosts go elsewhere.
+type Connectors map[port.HostConnectorType]HostConnector
+
+func newHeadlessRpcClient(address string, grpcCfg *config.GRPCConfig) (headlessv1.HeadlessControlServiceClient, error) {
+	conn, err := grpc.NewClient(address,
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		grpc.WithConnectParams(grpc.ConnectParams{
+			MinConnectTimeout: grpcCfg.ConnectTimeout,
+		}),
+	)
+	if err != nil {
+		return nil, errors.New(err)
+	}
+
+	return headlessv1.NewHeadlessControlServiceClient(conn), nil
+}
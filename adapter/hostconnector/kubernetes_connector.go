@@ -0,0 +1,728 @@
+package hostconnector
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"net"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/dchest/uniuri"
+	"github.com/go-errors/errors"
+	"github.com/hantabaru1014/baru-reso-headless-controller/config"
+	"github.com/hantabaru1014/baru-reso-headless-controller/domain"
+	"github.com/hantabaru1014/baru-reso-headless-controller/domain/entity"
+	headlessv1 "github.com/hantabaru1014/baru-reso-headless-controller/pbgen/headless/v1"
+	"github.com/hantabaru1014/baru-reso-headless-controller/usecase/port"
+	"google.golang.org/protobuf/encoding/protojson"
+	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/apimachinery/pkg/watch"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/rest"
+	"k8s.io/client-go/tools/clientcmd"
+)
+
+var _ HostConnector = (*KubernetesHostConnector)(nil)
+
+const (
+	KubernetesNetworkModeHost    = "host"
+	KubernetesNetworkModeService = "service"
+
+	// podLabelManagedBy marks every object we create so list/watch calls
+	// never see pods that belong to something else in the namespace.
+	podLabelManagedBy = "app.kubernetes.io/managed-by"
+	podManagedByValue = "baru-reso-headless-controller"
+
+	headlessContainerName = "headless"
+
+	secretKeyCredential = "credential"
+	secretKeyPassword   = "password"
+
+	podNameRandomLen = 10
+	// podTerminationGracePeriod is the pod-level default used when Stop is
+	// asked to wait indefinitely (timeoutSeconds < 0).
+	podTerminationGracePeriod int64 = 600
+	podDeletePollInterval           = time.Second
+)
+
+var podNameChars = []byte("abcdefghijklmnopqrstuvwxyz0123456789")
+
+// KubernetesHostConnector runs each headless host as a bare Pod (restart
+// policy Never — restarts are the controller's job, as with Docker). The
+// headless credentials go into a Secret owned by the pod so they do not
+// show up in the pod spec and are garbage-collected with it.
+//
+// The RPC port is exposed either through hostNetwork (the pod binds an
+// allocated port on its node and we dial the node IP) or through a
+// ClusterIP Service named after the pod. Connect strings have the form
+// `namespace/podName:port`.
+type KubernetesHostConnector struct {
+	cfg       *config.KubernetesConfig
+	dockerCfg *config.DockerConfig
+	grpcCfg   *config.GRPCConfig
+	cipher    port.CredentialCipher
+
+	clientOnce sync.Once
+	clientset  kubernetes.Interface
+	clientErr  error
+
+	// portMu serialises host-port allocation + pod creation in host mode.
+	portMu sync.Mutex
+}
+
+// NewKubernetesHostConnector creates the connector. The API client is built
+// lazily on first use, so a controller that never places hosts on
+// Kubernetes does not need a kubeconfig.
+func NewKubernetesHostConnector(cfg *config.KubernetesConfig, dockerCfg *config.DockerConfig, grpcCfg *config.GRPCConfig, cipher port.CredentialCipher) *KubernetesHostConnector {
+	return &KubernetesHostConnector{
+		cfg:       cfg,
+		dockerCfg: dockerCfg,
+		grpcCfg:   grpcCfg,
+		cipher:    cipher,
+	}
+}
+
+func newKubernetesHostConnectorWithClientset(
+	cfg *config.KubernetesConfig,
+	dockerCfg *config.DockerConfig,
+	grpcCfg *config.GRPCConfig,
+	cipher port.CredentialCipher,
+	clientset kubernetes.Interface,
+) *KubernetesHostConnector {
+	k := NewKubernetesHostConnector(cfg, dockerCfg, grpcCfg, cipher)
+	k.clientOnce.Do(func() { k.clientset = clientset })
+
+	return k
+}
+
+func (k *KubernetesHostConnector) client() (kubernetes.Interface, error) {
+	k.clientOnce.Do(func() {
+		if !k.cfg.Enabled {
+			k.clientErr = errors.New("kubernetes connector is not enabled (set KUBERNETES_ENABLED=true)")
+
+			return
+		}
+
+		k.clientset, k.clientErr = newKubernetesClientset(k.cfg)
+	})
+
+	return k.clientset, k.clientErr
+}
+
+func newKubernetesClientset(cfg *config.KubernetesConfig) (kubernetes.Interface, error) {
+	var (
+		restCfg *rest.Config
+		err     error
+	)
+
+	if cfg.Kubeconfig != "" {
+		restCfg, err = clientcmd.BuildConfigFromFlags("", cfg.Kubeconfig)
+	} else {
+		restCfg, err = rest.InClusterConfig()
+	}
+
+	if err != nil {
+		return nil, errors.Errorf("failed to load kubernetes config: %w", err)
+	}
+
+	cs, err := kubernetes.NewForConfig(restCfg)
+	if err != nil {
+		return nil, errors.Errorf("failed to create kubernetes client: %w", err)
+	}
+
+	return cs, nil
+}
+
+type kubernetesTarget struct {
+	Namespace string
+	PodName   string
+	Port      int
+}
+
+func parseKubernetesConnectString(connect_string HostConnectString) (kubernetesTarget, error) {
+	ref, portStr, ok := strings.Cut(string(connect_string), ":")
+	if !ok {
+		return kubernetesTarget{}, errors.Errorf("invalid connect string format: %s", connect_string)
+	}
+
+	namespace, podName, ok := strings.Cut(ref, "/")
+	if !ok || namespace == "" || podName == "" {
+		return kubernetesTarget{}, errors.Errorf("invalid connect string format: %s", connect_string)
+	}
+
+	port, err := strconv.Atoi(portStr)
+	if err != nil {
+		return kubernetesTarget{}, errors.Errorf("invalid port format: %s", portStr)
+	}
+
+	return kubernetesTarget{Namespace: namespace, PodName: podName, Port: port}, nil
+}
+
+// KubernetesConnectStringPrefix returns the part of a connect string that
+// precedes the port, i.e. what `hosts.connect_string LIKE prefix || ':%'`
+// must match to find the host owning the pod.
+func KubernetesConnectStringPrefix(namespace, podName string) string {
+	return namespace + "/" + podName
+}
+
+func (k *KubernetesHostConnector) isHostNetwork() bool {
+	return k.cfg.NetworkMode != KubernetesNetworkModeService
+}
+
+func (k *KubernetesHostConnector) managedSelector() string {
+	return podLabelManagedBy + "=" + podManagedByValue
+}
+
+// GetStatus implements HostConnector.
+func (k *KubernetesHostConnector) GetStatus(ctx context.Context, connect_string HostConnectString) entity.HeadlessHostStatus {
+	target, err := parseKubernetesConnectString(connect_string)
+	if err != nil {
+		return entity.HeadlessHostStatus_UNKNOWN
+	}
+
+	cs, err := k.client()
+	if err != nil {
+		return entity.HeadlessHostStatus_UNKNOWN
+	}
+
+	pod, err := cs.CoreV1().Pods(target.Namespace).Get(ctx, target.PodName, metav1.GetOptions{})
+	if err != nil {
+		return entity.HeadlessHostStatus_UNKNOWN
+	}
+
+	return podStatusToEntityStatus(pod)
+}
+
+// GetRpcClient implements HostConnector.
+func (k *KubernetesHostConnector) GetRpcClient(ctx context.Context, connect_string HostConnectString) (headlessv1.HeadlessControlServiceClient, error) {
+	target, err := parseKubernetesConnectString(connect_string)
+	if err != nil {
+		return nil, err
+	}
+
+	cs, err := k.client()
+	if err != nil {
+		return nil, err
+	}
+
+	pod, err := cs.CoreV1().Pods(target.Namespace).Get(ctx, target.PodName, metav1.GetOptions{})
+	if err != nil {
+		if k8serrors.IsNotFound(err) {
+			return nil, errors.WrapPrefix(domain.ErrNotFound, "grpc client (kubernetes pod)", 0)
+		}
+
+		return nil, errors.Errorf("failed to get pod: %w", err)
+	}
+
+	if pod.Status.Phase != corev1.PodRunning {
+		return nil, errors.Errorf("specific pod is not running")
+	}
+
+	var rpcHost string
+
+	if pod.Spec.HostNetwork {
+		rpcHost = pod.Status.HostIP
+		if rpcHost == "" {
+			rpcHost = pod.Status.PodIP
+		}
+	} else {
+		rpcHost = fmt.Sprintf("%s.%s.svc", pod.Name, pod.Namespace)
+	}
+
+	return newHeadlessRpcClient(net.JoinHostPort(rpcHost, strconv.Itoa(target.Port)), k.grpcCfg)
+}
+
+// ListContainerTags implements HostConnector.
+func (k *KubernetesHostConnector) ListContainerTags(ctx context.Context, lastTag *string) (port.ContainerImageList, error) {
+	return listRegistryTags(ctx, k.dockerCfg, lastTag)
+}
+
+// PullContainerImage implements HostConnector.
+// Images are pulled by the kubelet of whichever node a pod is scheduled to,
+// so there is nothing to warm up from the controller side.
+func (k *KubernetesHostConnector) PullContainerImage(_ context.Context, tag string) (string, error) {
+	return fmt.Sprintf("%s:%s is pulled by the kubelet when a pod starts", k.dockerCfg.HeadlessImageName, tag), nil
+}
+
+// Start implements HostConnector.
+func (k *KubernetesHostConnector) Start(ctx context.Context, params HostStartParams) (HostConnectString, error) {
+	cs, err := k.client()
+	if err != nil {
+		return "", err
+	}
+
+	credential, password, err := k.cipher.Open(params.HeadlessAccount.ResoniteID, params.HeadlessAccount.Credentials)
+	if err != nil {
+		return "", err
+	}
+
+	namespace := k.cfg.Namespace
+	podName := "headless-" + uniuri.NewLenChars(podNameRandomLen, podNameChars)
+
+	var rpcPort int
+
+	if k.isHostNetwork() {
+		k.portMu.Lock()
+		defer k.portMu.Unlock()
+
+		rpcPort, err = k.allocateHostPort(ctx, cs)
+		if err != nil {
+			return "", err
+		}
+	} else {
+		rpcPort = k.cfg.RpcPort
+	}
+
+	var startupConfig *string
+
+	if params.StartupConfig != nil {
+		configJson, err := protojson.Marshal(params.StartupConfig)
+		if err != nil {
+			return "", errors.Errorf("failed to marshal startup config: %w", err)
+		}
+
+		str := string(configJson)
+		startupConfig = &str
+	}
+
+	labels := map[string]string{
+		podLabelManagedBy:     podManagedByValue,
+		containerLabelHostID:  params.ID,
+		containerLabelRpcPort: strconv.Itoa(rpcPort),
+	}
+
+	// The secret is created before the pod so the container never observes
+	// a missing secret, then re-parented onto the pod once its UID exists.
+	secret, err := cs.CoreV1().Secrets(namespace).Create(ctx, &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: podName, Namespace: namespace, Labels: labels},
+		StringData: map[string]string{
+			secretKeyCredential: credential,
+			secretKeyPassword:   password,
+		},
+	}, metav1.CreateOptions{})
+	if err != nil {
+		return "", errors.Errorf("failed to create secret: %w", err)
+	}
+
+	pod, err := cs.CoreV1().Pods(namespace).Create(ctx, k.buildPod(podName, labels, params, rpcPort, startupConfig), metav1.CreateOptions{})
+	if err != nil {
+		if delErr := cs.CoreV1().Secrets(namespace).Delete(ctx, secret.Name, metav1.DeleteOptions{}); delErr != nil {
+			slog.Warn("failed to delete secret after pod creation error", "secret", secret.Name, "error", delErr)
+		}
+
+		return "", errors.Errorf("failed to create pod: %w", err)
+	}
+
+	owner := []metav1.OwnerReference{{
+		APIVersion: "v1",
+		Kind:       "Pod",
+		Name:       pod.Name,
+		UID:        pod.UID,
+	}}
+
+	secret.OwnerReferences = owner
+	if _, err := cs.CoreV1().Secrets(namespace).Update(ctx, secret, metav1.UpdateOptions{}); err != nil {
+		// Remove deletes the secret explicitly as well, so this only
+		// matters if the pod disappears behind our back.
+		slog.Warn("failed to set owner of headless secret", "secret", secret.Name, "error", err)
+	}
+
+	if !k.isHostNetwork() {
+		_, err := cs.CoreV1().Services(namespace).Create(ctx, &corev1.Service{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:            pod.Name,
+				Namespace:       namespace,
+				Labels:          labels,
+				OwnerReferences: owner,
+			},
+			Spec: corev1.ServiceSpec{
+				Type:     corev1.ServiceTypeClusterIP,
+				Selector: map[string]string{podLabelManagedBy: podManagedByValue, containerLabelHostID: params.ID},
+				Ports: []corev1.ServicePort{{
+					Name:       "rpc",
+					Port:       int32(rpcPort), //nolint:gosec // G115: bounded by config
+					TargetPort: intstr.FromInt(rpcPort),
+					Protocol:   corev1.ProtocolTCP,
+				}},
+			},
+		}, metav1.CreateOptions{})
+		if err != nil {
+			_ = cs.CoreV1().Pods(namespace).Delete(ctx, pod.Name, metav1.DeleteOptions{})
+
+			return "", errors.Errorf("failed to create service: %w", err)
+		}
+	}
+
+	return HostConnectString(fmt.Sprintf("%s:%d", KubernetesConnectStringPrefix(namespace, pod.Name), rpcPort)), nil
+}
+
+func (k *KubernetesHostConnector) buildPod(
+	podName string,
+	labels map[string]string,
+	params HostStartParams,
+	rpcPort int,
+	startupConfig *string,
+) *corev1.Pod {
+	secretEnv := func(name, key string) corev1.EnvVar {
+		return corev1.EnvVar{
+			Name: name,
+			ValueFrom: &corev1.EnvVarSource{
+				SecretKeyRef: &corev1.SecretKeySelector{
+					LocalObjectReference: corev1.LocalObjectReference{Name: podName},
+					Key:                  key,
+				},
+			},
+		}
+	}
+
+	envs := []corev1.EnvVar{
+		{Name: "RpcHostUrl", Value: fmt.Sprintf("http://0.0.0.0:%d", rpcPort)},
+		secretEnv("HeadlessUserCredential", secretKeyCredential),
+		secretEnv("HeadlessUserPassword", secretKeyPassword),
+	}
+	if startupConfig != nil {
+		envs = append(envs, corev1.EnvVar{Name: "StartupConfig", Value: *startupConfig})
+	}
+
+	containerPort := corev1.ContainerPort{
+		Name:          "rpc",
+		ContainerPort: int32(rpcPort), //nolint:gosec // G115: bounded by config
+		Protocol:      corev1.ProtocolTCP,
+	}
+
+	dnsPolicy := corev1.DNSClusterFirst
+	if k.isHostNetwork() {
+		// Declaring the host port lets the scheduler avoid nodes where it
+		// is already taken.
+		containerPort.HostPort = containerPort.ContainerPort
+		dnsPolicy = corev1.DNSClusterFirstWithHostNet
+	}
+
+	gracePeriod := podTerminationGracePeriod
+
+	pod := &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      podName,
+			Namespace: k.cfg.Namespace,
+			Labels:    labels,
+			Annotations: map[string]string{
+				"brhc.instance_id": strconv.Itoa(int(params.InstanceId)),
+			},
+		},
+		Spec: corev1.PodSpec{
+			RestartPolicy:                 corev1.RestartPolicyNever,
+			HostNetwork:                   k.isHostNetwork(),
+			DNSPolicy:                     dnsPolicy,
+			NodeSelector:                  k.cfg.NodeSelector,
+			TerminationGracePeriodSeconds: &gracePeriod,
+			Containers: []corev1.Container{{
+				Name:  headlessContainerName,
+				Image: fmt.Sprintf("%s:%s", k.dockerCfg.HeadlessImageName, params.ContainerImageTag),
+				Env:   envs,
+				Ports: []corev1.ContainerPort{containerPort},
+			}},
+		},
+	}
+
+	if k.cfg.ImagePullSecret != "" {
+		pod.Spec.ImagePullSecrets = []corev1.LocalObjectReference{{Name: k.cfg.ImagePullSecret}}
+	}
+
+	applyPodContainerSettings(pod, &params.ContainerSettings)
+
+	return pod
+}
+
+// applyPodContainerSettings maps the per-host settings onto the pod. CPU and
+// memory become limits and bind mounts become hostPath volumes. Swap,
+// cpuset and the restart policy have no pod-level equivalent (restarts are
+// handled by the controller) and are ignored.
+func applyPodContainerSettings(pod *corev1.Pod, s *entity.HostContainerSettings) {
+	c := &pod.Spec.Containers[0]
+
+	limits := corev1.ResourceList{}
+	if s.CPUs > 0 {
+		limits[corev1.ResourceCPU] = *resource.NewMilliQuantity(int64(s.CPUs*1000), resource.DecimalSI)
+	}
+
+	if s.MemoryBytes > 0 {
+		limits[corev1.ResourceMemory] = *resource.NewQuantity(s.MemoryBytes, resource.BinarySI)
+	}
+
+	if len(limits) > 0 {
+		c.Resources.Limits = limits
+	}
+
+	for i, m := range s.BindMounts {
+		name := fmt.Sprintf("bind-%d", i)
+		pod.Spec.Volumes = append(pod.Spec.Volumes, corev1.Volume{
+			Name: name,
+			VolumeSource: corev1.VolumeSource{
+				HostPath: &corev1.HostPathVolumeSource{Path: m.Source},
+			},
+		})
+		c.VolumeMounts = append(c.VolumeMounts, corev1.VolumeMount{
+			Name:      name,
+			MountPath: m.Target,
+			ReadOnly:  m.ReadOnly,
+		})
+	}
+}
+
+// allocateHostPort picks a host port for a hostNetwork pod. As with remote
+// Docker nodes we cannot probe the node, so ports of our existing pods are
+// avoided and the scheduler handles the rest via the declared hostPort.
+func (k *KubernetesHostConnector) allocateHostPort(ctx context.Context, cs kubernetes.Interface) (int, error) {
+	portMin, portMax := k.cfg.HostRpcPortMin, k.cfg.HostRpcPortMax
+	if portMin <= 0 || portMax < portMin {
+		return 0, errors.Errorf("invalid kubernetes host rpc port range: %d-%d", portMin, portMax)
+	}
+
+	pods, err := cs.CoreV1().Pods(k.cfg.Namespace).List(ctx, metav1.ListOptions{LabelSelector: k.managedSelector()})
+	if err != nil {
+		return 0, errors.Errorf("failed to list pods: %w", err)
+	}
+
+	used := make(map[int]struct{}, len(pods.Items))
+
+	for _, p := range pods.Items {
+		if p.Status.Phase == corev1.PodSucceeded || p.Status.Phase == corev1.PodFailed {
+			continue
+		}
+
+		if n, err := strconv.Atoi(p.Labels[containerLabelRpcPort]); err == nil {
+			used[n] = struct{}{}
+		}
+	}
+
+	return pickFreePort(used, portMin, portMax)
+}
+
+// Stop implements HostConnector.
+// A pod cannot be stopped without being deleted, so this deletes it with the
+// given grace period and waits until it is gone.
+func (k *KubernetesHostConnector) Stop(ctx context.Context, connect_string HostConnectString, timeoutSeconds int) error {
+	var grace *int64
+
+	if timeoutSeconds >= 0 {
+		g := int64(timeoutSeconds)
+		grace = &g
+	}
+
+	return k.deletePod(ctx, connect_string, grace, true)
+}
+
+// Kill implements HostConnector.
+func (k *KubernetesHostConnector) Kill(ctx context.Context, connect_string HostConnectString) error {
+	zero := int64(0)
+
+	return k.deletePod(ctx, connect_string, &zero, true)
+}
+
+// Remove implements HostConnector.
+func (k *KubernetesHostConnector) Remove(ctx context.Context, connect_string HostConnectString) error {
+	target, err := parseKubernetesConnectString(connect_string)
+	if err != nil {
+		return err
+	}
+
+	zero := int64(0)
+	if err := k.deletePod(ctx, connect_string, &zero, false); err != nil && !errors.Is(err, ErrContainerNotFound) {
+		return err
+	}
+
+	cs, err := k.client()
+	if err != nil {
+		return err
+	}
+
+	// Owned objects are garbage-collected with the pod; deleting them here
+	// too covers the case where setting the owner reference failed.
+	if err := cs.CoreV1().Secrets(target.Namespace).Delete(ctx, target.PodName, metav1.DeleteOptions{}); err != nil && !k8serrors.IsNotFound(err) {
+		return errors.Errorf("failed to delete secret: %w", err)
+	}
+
+	if err := cs.CoreV1().Services(target.Namespace).Delete(ctx, target.PodName, metav1.DeleteOptions{}); err != nil && !k8serrors.IsNotFound(err) {
+		return errors.Errorf("failed to delete service: %w", err)
+	}
+
+	return nil
+}
+
+func (k *KubernetesHostConnector) deletePod(ctx context.Context, connect_string HostConnectString, grace *int64, wait bool) error {
+	target, err := parseKubernetesConnectString(connect_string)
+	if err != nil {
+		return err
+	}
+
+	cs, err := k.client()
+	if err != nil {
+		return err
+	}
+
+	pods := cs.CoreV1().Pods(target.Namespace)
+
+	err = pods.Delete(ctx, target.PodName, metav1.DeleteOptions{GracePeriodSeconds: grace})
+	if err != nil {
+		if k8serrors.IsNotFound(err) {
+			if wait {
+				return nil
+			}
+
+			return ErrContainerNotFound
+		}
+
+		return errors.Errorf("failed to delete pod: %w", err)
+	}
+
+	if !wait {
+		return nil
+	}
+
+	ticker := time.NewTicker(podDeletePollInterval)
+	defer ticker.Stop()
+
+	for {
+		_, err := pods.Get(ctx, target.PodName, metav1.GetOptions{})
+		if k8serrors.IsNotFound(err) {
+			return nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return errors.Errorf("waiting for pod %s to terminate: %w", target.PodName, ctx.Err())
+		case <-ticker.C:
+		}
+	}
+}
+
+// podStatusToEntityStatus maps a pod to the host status the rest of the
+// controller understands. Pods are never restarted in place (restart policy
+// Never), so a terminated headless container means the pod is done.
+func podStatusToEntityStatus(pod *corev1.Pod) entity.HeadlessHostStatus {
+	if pod.DeletionTimestamp != nil &&
+		(pod.Status.Phase == corev1.PodRunning || pod.Status.Phase == corev1.PodPending) {
+		return entity.HeadlessHostStatus_STOPPING
+	}
+
+	switch pod.Status.Phase {
+	case corev1.PodPending:
+		return entity.HeadlessHostStatus_STARTING
+	case corev1.PodRunning:
+		return entity.HeadlessHostStatus_RUNNING
+	case corev1.PodSucceeded:
+		return entity.HeadlessHostStatus_EXITED
+	case corev1.PodFailed:
+		for _, st := range pod.Status.ContainerStatuses {
+			if st.Name == headlessContainerName && st.State.Terminated != nil && st.State.Terminated.ExitCode == 0 {
+				return entity.HeadlessHostStatus_EXITED
+			}
+		}
+
+		return entity.HeadlessHostStatus_CRASHED
+	case corev1.PodUnknown:
+		return entity.HeadlessHostStatus_UNKNOWN
+	default:
+		return entity.HeadlessHostStatus_UNKNOWN
+	}
+}
+
+// PodEvent is a status change of one of our pods, keyed by the connect
+// string prefix (see KubernetesConnectStringPrefix).
+type PodEvent struct {
+	ConnectStringPrefix string
+	Status              entity.HeadlessHostStatus
+	// Deleted is set when the pod object itself is gone. Status then holds
+	// the last status observed before deletion.
+	Deleted bool
+}
+
+// ListPodStatuses returns the status of every pod we manage together with
+// the list's resource version, from which WatchPods can continue without
+// missing changes.
+func (k *KubernetesHostConnector) ListPodStatuses(ctx context.Context) (map[string]entity.HeadlessHostStatus, string, error) {
+	cs, err := k.client()
+	if err != nil {
+		return nil, "", err
+	}
+
+	pods, err := cs.CoreV1().Pods(k.cfg.Namespace).List(ctx, metav1.ListOptions{LabelSelector: k.managedSelector()})
+	if err != nil {
+		return nil, "", errors.Errorf("failed to list pods: %w", err)
+	}
+
+	result := make(map[string]entity.HeadlessHostStatus, len(pods.Items))
+	for i := range pods.Items {
+		p := &pods.Items[i]
+		result[KubernetesConnectStringPrefix(p.Namespace, p.Name)] = podStatusToEntityStatus(p)
+	}
+
+	return result, pods.ResourceVersion, nil
+}
+
+// WatchPods streams status changes of our pods starting after
+// resourceVersion. Like SubscribeEvents, the caller reconnects (and
+// re-lists) when the error channel fires or the event channel closes.
+func (k *KubernetesHostConnector) WatchPods(ctx context.Context, resourceVersion string) (<-chan PodEvent, <-chan error, error) {
+	cs, err := k.client()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	w, err := cs.CoreV1().Pods(k.cfg.Namespace).Watch(ctx, metav1.ListOptions{
+		LabelSelector:   k.managedSelector(),
+		ResourceVersion: resourceVersion,
+	})
+	if err != nil {
+		return nil, nil, errors.Errorf("failed to watch pods: %w", err)
+	}
+
+	eventChan := make(chan PodEvent)
+	errChan := make(chan error, 1)
+
+	go func() {
+		defer close(eventChan)
+		defer w.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case ev, ok := <-w.ResultChan():
+				if !ok {
+					return
+				}
+
+				if ev.Type == watch.Error {
+					errChan <- errors.Errorf("pod watch error: %v", k8serrors.FromObject(ev.Object))
+
+					return
+				}
+
+				pod, ok := ev.Object.(*corev1.Pod)
+				if !ok {
+					continue
+				}
+
+				select {
+				case eventChan <- PodEvent{
+					ConnectStringPrefix: KubernetesConnectStringPrefix(pod.Namespace, pod.Name),
+					Status:              podStatusToEntityStatus(pod),
+					Deleted:             ev.Type == watch.Deleted,
+				}:
+				case <-ctx.Done():
+					return
+				}
+			}
+		}
+	}()
+
+	return eventChan, errChan, nil
+}
@@ -0,0 +1,286 @@
+package hostconnector
+
+import (
+	"context"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/hantabaru1014/baru-reso-headless-controller/config"
+	"github.com/hantabaru1014/baru-reso-headless-controller/domain/entity"
+	"github.com/hantabaru1014/baru-reso-headless-controller/usecase/port"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+// fakeCredentialCipher は暗号文をそのまま平文として返す.
+type fakeCredentialCipher struct {
+	port.CredentialCipher
+}
+
+func (fakeCredentialCipher) Open(_ string, sealed entity.SealedCredentials) (string, string, error) {
+	return string(sealed.Credential), string(sealed.Password), nil
+}
+
+func newTestKubernetesConnector(t *testing.T, networkMode string, objects ...*corev1.Pod) (*KubernetesHostConnector, *fake.Clientset) {
+	t.Helper()
+
+	cs := fake.NewClientset()
+	for _, p := range objects {
+		_, err := cs.CoreV1().Pods(p.Namespace).Create(context.Background(), p, metav1.CreateOptions{})
+		require.NoError(t, err)
+	}
+
+	k := newKubernetesHostConnectorWithClientset(
+		&config.KubernetesConfig{
+			Enabled:        true,
+			Namespace:      "brhc",
+			NetworkMode:    networkMode,
+			RpcPort:        5000,
+			HostRpcPortMin: 30000,
+			HostRpcPortMax: 30001,
+		},
+		&config.DockerConfig{HeadlessImageName: "ghcr.io/example/headless"},
+		&config.GRPCConfig{ConnectTimeout: time.Second},
+		fakeCredentialCipher{},
+		cs,
+	)
+
+	return k, cs
+}
+
+func managedPod(name string, rpcPort int, phase corev1.PodPhase) *corev1.Pod {
+	return &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: "brhc",
+			Labels: map[string]string{
+				podLabelManagedBy:     podManagedByValue,
+				containerLabelRpcPort: strconv.Itoa(rpcPort),
+			},
+		},
+		Status: corev1.PodStatus{Phase: phase},
+	}
+}
+
+func testStartParams() HostStartParams {
+	return HostStartParams{
+		ID:                "host-1",
+		InstanceId:        1,
+		ContainerImageTag: "2025.1.1",
+		HeadlessAccount: entity.HeadlessAccount{
+			ResoniteID:  "U-bot",
+			Credentials: entity.SealedCredentials{KeyID: "k1", Credential: []byte("user@example.com"), Password: []byte("secret")},
+		},
+	}
+}
+
+func TestKubernetesHostConnector_Start_HostNetwork(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	k, cs := newTestKubernetesConnector(t, KubernetesNetworkModeHost, managedPod("headless-used", 30000, corev1.PodRunning))
+
+	connectString, err := k.Start(ctx, testStartParams())
+	require.NoError(t, err)
+
+	target, err := parseKubernetesConnectString(connectString)
+	require.NoError(t, err)
+	assert.Equal(t, "brhc", target.Namespace)
+	assert.Equal(t, 30001, target.Port, "the port of the existing pod must be avoided")
+
+	pod, err := cs.CoreV1().Pods("brhc").Get(ctx, target.PodName, metav1.GetOptions{})
+	require.NoError(t, err)
+	assert.True(t, pod.Spec.HostNetwork)
+	assert.Equal(t, corev1.DNSClusterFirstWithHostNet, pod.Spec.DNSPolicy)
+	assert.Equal(t, corev1.RestartPolicyNever, pod.Spec.RestartPolicy)
+	assert.Equal(t, "host-1", pod.Labels[containerLabelHostID])
+
+	container := pod.Spec.Containers[0]
+	assert.Equal(t, "ghcr.io/example/headless:2025.1.1", container.Image)
+	assert.Equal(t, int32(30001), container.Ports[0].HostPort)
+
+	for _, env := range container.Env {
+		if env.Name == "HeadlessUserPassword" {
+			require.NotNil(t, env.ValueFrom)
+			assert.Equal(t, target.PodName, env.ValueFrom.SecretKeyRef.Name)
+			assert.Empty(t, env.Value, "credentials must not be inlined into the pod spec")
+		}
+	}
+
+	secret, err := cs.CoreV1().Secrets("brhc").Get(ctx, target.PodName, metav1.GetOptions{})
+	require.NoError(t, err)
+	assert.Equal(t, "secret", secret.StringData[secretKeyPassword])
+	require.Len(t, secret.OwnerReferences, 1)
+	assert.Equal(t, target.PodName, secret.OwnerReferences[0].Name)
+
+	_, err = cs.CoreV1().Services("brhc").Get(ctx, target.PodName, metav1.GetOptions{})
+	assert.True(t, k8serrors.IsNotFound(err), "host network mode must not create a service")
+
+	// 30000 and 30001 are now both taken.
+	_, err = k.Start(ctx, testStartParams())
+	assert.Error(t, err)
+}
+
+func TestKubernetesHostConnector_Start_Service(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	k, cs := newTestKubernetesConnector(t, KubernetesNetworkModeService)
+
+	connectString, err := k.Start(ctx, testStartParams())
+	require.NoError(t, err)
+
+	target, err := parseKubernetesConnectString(connectString)
+	require.NoError(t, err)
+	assert.Equal(t, 5000, target.Port)
+
+	pod, err := cs.CoreV1().Pods("brhc").Get(ctx, target.PodName, metav1.GetOptions{})
+	require.NoError(t, err)
+	assert.False(t, pod.Spec.HostNetwork)
+	assert.Zero(t, pod.Spec.Containers[0].Ports[0].HostPort)
+
+	svc, err := cs.CoreV1().Services("brhc").Get(ctx, target.PodName, metav1.GetOptions{})
+	require.NoError(t, err)
+	assert.Equal(t, int32(5000), svc.Spec.Ports[0].Port)
+	assert.Equal(t, "host-1", svc.Spec.Selector[containerLabelHostID])
+}
+
+func TestKubernetesHostConnector_Start_ContainerSettings(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	k, cs := newTestKubernetesConnector(t, KubernetesNetworkModeService)
+
+	params := testStartParams()
+	params.ContainerSettings = entity.HostContainerSettings{
+		CPUs:        1.5,
+		MemoryBytes: 4 << 30,
+		BindMounts:  []entity.HostBindMount{{Source: "/srv/cache", Target: "/Cache", ReadOnly: true}},
+	}
+
+	connectString, err := k.Start(ctx, params)
+	require.NoError(t, err)
+
+	target, err := parseKubernetesConnectString(connectString)
+	require.NoError(t, err)
+
+	pod, err := cs.CoreV1().Pods("brhc").Get(ctx, target.PodName, metav1.GetOptions{})
+	require.NoError(t, err)
+
+	limits := pod.Spec.Containers[0].Resources.Limits
+	assert.Equal(t, "1500m", limits.Cpu().String())
+	assert.Equal(t, "4Gi", limits.Memory().String())
+
+	require.Len(t, pod.Spec.Volumes, 1)
+	assert.Equal(t, "/srv/cache", pod.Spec.Volumes[0].HostPath.Path)
+	assert.Equal(t, []corev1.VolumeMount{{Name: pod.Spec.Volumes[0].Name, MountPath: "/Cache", ReadOnly: true}},
+		pod.Spec.Containers[0].VolumeMounts)
+}
+
+func TestKubernetesHostConnector_StopAndRemove(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	k, cs := newTestKubernetesConnector(t, KubernetesNetworkModeService)
+
+	connectString, err := k.Start(ctx, testStartParams())
+	require.NoError(t, err)
+
+	target, err := parseKubernetesConnectString(connectString)
+	require.NoError(t, err)
+
+	require.NoError(t, k.Stop(ctx, connectString, 10))
+
+	_, err = cs.CoreV1().Pods("brhc").Get(ctx, target.PodName, metav1.GetOptions{})
+	assert.True(t, k8serrors.IsNotFound(err))
+
+	// Stopping an already gone pod is not an error.
+	require.NoError(t, k.Stop(ctx, connectString, 10))
+
+	require.NoError(t, k.Remove(ctx, connectString))
+
+	_, err = cs.CoreV1().Secrets("brhc").Get(ctx, target.PodName, metav1.GetOptions{})
+	assert.True(t, k8serrors.IsNotFound(err))
+	_, err = cs.CoreV1().Services("brhc").Get(ctx, target.PodName, metav1.GetOptions{})
+	assert.True(t, k8serrors.IsNotFound(err))
+
+	// Remove is idempotent.
+	require.NoError(t, k.Remove(ctx, connectString))
+	assert.Equal(t, entity.HeadlessHostStatus_UNKNOWN, k.GetStatus(ctx, connectString))
+}
+
+func TestKubernetesHostConnector_ListPodStatuses(t *testing.T) {
+	t.Parallel()
+
+	k, _ := newTestKubernetesConnector(t, KubernetesNetworkModeHost,
+		managedPod("headless-a", 30000, corev1.PodRunning),
+		managedPod("headless-b", 30001, corev1.PodPending),
+		&corev1.Pod{ObjectMeta: metav1.ObjectMeta{Name: "unrelated", Namespace: "brhc"}},
+	)
+
+	statuses, _, err := k.ListPodStatuses(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, map[string]entity.HeadlessHostStatus{
+		"brhc/headless-a": entity.HeadlessHostStatus_RUNNING,
+		"brhc/headless-b": entity.HeadlessHostStatus_STARTING,
+	}, statuses)
+}
+
+func TestPodStatusToEntityStatus(t *testing.T) {
+	t.Parallel()
+
+	terminated := func(code int32) corev1.PodStatus {
+		return corev1.PodStatus{
+			Phase: corev1.PodFailed,
+			ContainerStatuses: []corev1.ContainerStatus{{
+				Name:  headlessContainerName,
+				State: corev1.ContainerState{Terminated: &corev1.ContainerStateTerminated{ExitCode: code}},
+			}},
+		}
+	}
+	now := metav1.Now()
+
+	tests := []struct {
+		name     string
+		pod      corev1.Pod
+		expected entity.HeadlessHostStatus
+	}{
+		{"pending", corev1.Pod{Status: corev1.PodStatus{Phase: corev1.PodPending}}, entity.HeadlessHostStatus_STARTING},
+		{"running", corev1.Pod{Status: corev1.PodStatus{Phase: corev1.PodRunning}}, entity.HeadlessHostStatus_RUNNING},
+		{"succeeded", corev1.Pod{Status: corev1.PodStatus{Phase: corev1.PodSucceeded}}, entity.HeadlessHostStatus_EXITED},
+		{"failed with exit 0", corev1.Pod{Status: terminated(0)}, entity.HeadlessHostStatus_EXITED},
+		{"failed with exit 1", corev1.Pod{Status: terminated(1)}, entity.HeadlessHostStatus_CRASHED},
+		{"failed without status", corev1.Pod{Status: corev1.PodStatus{Phase: corev1.PodFailed}}, entity.HeadlessHostStatus_CRASHED},
+		{"terminating", corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{DeletionTimestamp: &now},
+			Status:     corev1.PodStatus{Phase: corev1.PodRunning},
+		}, entity.HeadlessHostStatus_STOPPING},
+		{"unknown", corev1.Pod{Status: corev1.PodStatus{Phase: corev1.PodUnknown}}, entity.HeadlessHostStatus_UNKNOWN},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, tt.expected, podStatusToEntityStatus(&tt.pod))
+		})
+	}
+}
+
+func TestParseKubernetesConnectString(t *testing.T) {
+	t.Parallel()
+
+	target, err := parseKubernetesConnectString("brhc/headless-abc:30001")
+	require.NoError(t, err)
+	assert.Equal(t, kubernetesTarget{Namespace: "brhc", PodName: "headless-abc", Port: 30001}, target)
+
+	for _, cs := range []HostConnectString{"headless-abc:5000", "/headless-abc:5000", "brhc/:5000", "brhc/headless-abc:port"} {
+		_, err := parseKubernetesConnectString(cs)
+		assert.Error(t, err, cs)
+	}
+}
@@ -0,0 +1,115 @@
+package adapter
+
+import (
+	"context"
+	"time"
+
+	"github.com/go-errors/errors"
+	"github.com/hantabaru1014/baru-reso-headless-controller/db"
+	"github.com/hantabaru1014/baru-reso-headless-controller/domain/entity"
+	"github.com/hantabaru1014/baru-reso-headless-controller/usecase/port"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+var _ port.MetricSampleRepository = (*MetricSampleRepository)(nil)
+
+type MetricSampleRepository struct {
+	q *db.Queries
+}
+
+func NewMetricSampleRepository(q *db.Queries) *MetricSampleRepository {
+	return &MetricSampleRepository{q: q}
+}
+
+func (r *MetricSampleRepository) InsertRaw(ctx context.Context, at time.Time, values []port.RawMetricValue) error {
+	if len(values) == 0 {
+		return nil
+	}
+
+	params := db.InsertRawMetricSamplesParams{
+		Kinds:        make([]int16, 0, len(values)),
+		TargetIds:    make([]string, 0, len(values)),
+		BucketAt:     pgtype.Timestamptz{Time: at, Valid: true},
+		MetricValues: make([]float32, 0, len(values)),
+	}
+	for _, v := range values {
+		params.Kinds = append(params.Kinds, int16(v.Kind))
+		params.TargetIds = append(params.TargetIds, v.TargetID)
+		params.MetricValues = append(params.MetricValues, float32(v.Value))
+	}
+
+	if _, err := r.q.InsertRawMetricSamples(ctx, params); err != nil {
+		return errors.WrapPrefix(convertDBErr(err), "metric_sample", 0)
+	}
+
+	return nil
+}
+
+func (r *MetricSampleRepository) Rollup(ctx context.Context, to entity.MetricResolution, since, until time.Time) (int64, error) {
+	var (
+		rows int64
+		err  error
+	)
+
+	switch to {
+	case entity.MetricResolution_Minute:
+		rows, err = r.q.RollupMetricSamplesToMinute(ctx, db.RollupMetricSamplesToMinuteParams{
+			Since: pgtype.Timestamptz{Time: since, Valid: true},
+			Until: pgtype.Timestamptz{Time: until, Valid: true},
+		})
+	case entity.MetricResolution_Hour:
+		rows, err = r.q.RollupMetricSamplesToHour(ctx, db.RollupMetricSamplesToHourParams{
+			Since: pgtype.Timestamptz{Time: since, Valid: true},
+			Until: pgtype.Timestamptz{Time: until, Valid: true},
+		})
+	default:
+		return 0, errors.Errorf("cannot roll up into resolution %d", to)
+	}
+
+	if err != nil {
+		return 0, errors.WrapPrefix(convertDBErr(err), "metric_sample", 0)
+	}
+
+	return rows, nil
+}
+
+func (r *MetricSampleRepository) DeleteBefore(ctx context.Context, resolution entity.MetricResolution, before time.Time) (int64, error) {
+	rows, err := r.q.DeleteMetricSamplesBefore(ctx, db.DeleteMetricSamplesBeforeParams{
+		Resolution: int16(resolution),
+		Before:     pgtype.Timestamptz{Time: before, Valid: true},
+	})
+	if err != nil {
+		return 0, errors.WrapPrefix(convertDBErr(err), "metric_sample", 0)
+	}
+
+	return rows, nil
+}
+
+func (r *MetricSampleRepository) List(ctx context.Context, kind entity.MetricKind, targetID string, resolution entity.MetricResolution, since, until time.Time) (entity.MetricSampleList, error) {
+	rows, err := r.q.ListMetricSamples(ctx, db.ListMetricSamplesParams{
+		Kind:       int16(kind),
+		TargetID:   targetID,
+		Resolution: int16(resolution),
+		Since:      pgtype.Timestamptz{Time: since, Valid: true},
+		Until:      pgtype.Timestamptz{Time: until, Valid: true},
+	})
+	if err != nil {
+		return nil, errors.WrapPrefix(convertDBErr(err), "metric_sample", 0)
+	}
+
+	result := make(entity.MetricSampleList, 0, len(rows))
+	for _, row := range rows {
+		result = append(result, &entity.MetricSample{
+			Kind:       entity.MetricKind(row.Kind),
+			TargetID:   row.TargetID,
+			Resolution: entity.MetricResolution(row.Resolution),
+			BucketAt:   row.BucketAt.Time,
+			Avg:        float64(row.AvgValue),
+			Min:        float64(row.MinValue),
+			Max:        float64(row.MaxValue),
+			Count:      row.SampleCount,
+		})
+	}
+
+	return result, nil
+}
@@ -0,0 +1,205 @@
+package adapter
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"github.com/go-errors/errors"
+	"github.com/hantabaru1014/baru-reso-headless-controller/db"
+	"github.com/hantabaru1014/baru-reso-headless-controller/domain/entity"
+	hdlctrlv1 "github.com/hantabaru1014/baru-reso-headless-controller/pbgen/hdlctrl/v1"
+	"github.com/hantabaru1014/baru-reso-headless-controller/usecase/port"
+	"github.com/jackc/pgx/v5/pgtype"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+var _ port.NotificationRepository = (*NotificationRepository)(nil)
+
+type NotificationRepository struct {
+	q *db.Queries
+}
+
+func NewNotificationRepository(q *db.Queries) *NotificationRepository {
+	return &NotificationRepository{q: q}
+}
+
+func (r *NotificationRepository) Append(ctx context.Context, rec *entity.NotificationRecord) error {
+	// id は seq から復元するので保存しない.
+	payload, err := protojson.Marshal(rec.Event)
+	if err != nil {
+		return errors.Wrap(err, 0)
+	}
+
+	var scope pgtype.Text
+	if rec.Scope != entity.NotificationScope_None {
+		scope = pgtype.Text{String: string(rec.Scope), Valid: true}
+	}
+
+	seq, err := r.q.CreateNotificationEvent(ctx, db.CreateNotificationEventParams{
+		TargetUserID: textFromPtr(rec.TargetUserID),
+		HostID:       textFromPtr(rec.HostID),
+		Scope:        scope,
+		Inbox:        rec.Inbox,
+		Payload:      payload,
+		OccurredAt:   pgtype.Timestamptz{Time: rec.Event.GetOccurredAt().AsTime(), Valid: true},
+	})
+	if err != nil {
+		return errors.WrapPrefix(convertDBErr(err), "notification_event", 0)
+	}
+
+	rec.Seq = seq
+
+	return nil
+}
+
+func (r *NotificationRepository) ListAfter(ctx context.Context, userID string, afterSeq int64, limit int32) (entity.NotificationRecordList, error) {
+	rows, err := r.q.ListNotificationEventsAfter(ctx, db.ListNotificationEventsAfterParams{
+		AfterSeq: afterSeq,
+		UserID:   userID,
+		PageSize: limit,
+	})
+	if err != nil {
+		return nil, errors.WrapPrefix(convertDBErr(err), "notification_event", 0)
+	}
+
+	result := make(entity.NotificationRecordList, 0, len(rows))
+
+	for _, row := range rows {
+		rec, err := notificationRecordToEntity(row)
+		if err != nil {
+			return nil, err
+		}
+
+		result = append(result, rec)
+	}
+
+	return result, nil
+}
+
+func (r *NotificationRepository) OldestSeq(ctx context.Context) (int64, error) {
+	seq, err := r.q.GetOldestNotificationEventSeq(ctx)
+	if err != nil {
+		return 0, errors.WrapPrefix(convertDBErr(err), "notification_event", 0)
+	}
+
+	return seq, nil
+}
+
+func (r *NotificationRepository) ListInbox(ctx context.Context, vis port.NotificationVisibility, unreadOnly bool, pageIndex, pageSize int32) (*port.NotificationInboxResult, error) {
+	scopes := notificationGrantsToText(vis.Grants)
+
+	rows, err := r.q.ListInboxNotifications(ctx, db.ListInboxNotificationsParams{
+		UserID:         vis.UserID,
+		ListAll:        vis.ListAll,
+		ReadableScopes: scopes,
+		UnreadOnly:     unreadOnly,
+		PageSize:       pageSize,
+		PageOffset:     pageIndex * pageSize,
+	})
+	if err != nil {
+		return nil, errors.WrapPrefix(convertDBErr(err), "notification_event", 0)
+	}
+
+	unread, err := r.q.CountUnreadInboxNotifications(ctx, db.CountUnreadInboxNotificationsParams{
+		UserID:         vis.UserID,
+		ListAll:        vis.ListAll,
+		ReadableScopes: scopes,
+	})
+	if err != nil {
+		return nil, errors.WrapPrefix(convertDBErr(err), "notification_event", 0)
+	}
+
+	result := &port.NotificationInboxResult{
+		Items:       make(entity.NotificationInboxItemList, 0, len(rows)),
+		UnreadCount: int32(unread), //nolint:gosec // G115: 表示用. int32 を超える件数は想定しない
+	}
+	if len(rows) > 0 {
+		result.TotalCount = int32(rows[0].TotalCount) //nolint:gosec // G115: ページングの表示用. int32 を超える件数は想定しない
+	}
+
+	for _, row := range rows {
+		rec, err := notificationRecordToEntity(row.NotificationEvent)
+		if err != nil {
+			return nil, err
+		}
+
+		result.Items = append(result.Items, &entity.NotificationInboxItem{Record: rec, Read: row.IsRead})
+	}
+
+	return result, nil
+}
+
+func (r *NotificationRepository) MarkRead(ctx context.Context, userID string, seqs []int64) error {
+	if len(seqs) == 0 {
+		return nil
+	}
+
+	err := r.q.MarkNotificationsRead(ctx, db.MarkNotificationsReadParams{UserID: userID, Seqs: seqs})
+	if err != nil {
+		return errors.WrapPrefix(convertDBErr(err), "notification_read", 0)
+	}
+
+	return nil
+}
+
+func (r *NotificationRepository) MarkAllRead(ctx context.Context, vis port.NotificationVisibility) error {
+	err := r.q.MarkAllNotificationsRead(ctx, db.MarkAllNotificationsReadParams{
+		UserID:         vis.UserID,
+		ListAll:        vis.ListAll,
+		ReadableScopes: notificationGrantsToText(vis.Grants),
+	})
+	if err != nil {
+		return errors.WrapPrefix(convertDBErr(err), "notification_read", 0)
+	}
+
+	return nil
+}
+
+func (r *NotificationRepository) DeleteBefore(ctx context.Context, before time.Time) (int64, error) {
+	rows, err := r.q.DeleteNotificationEventsBefore(ctx, pgtype.Timestamptz{Time: before, Valid: true})
+	if err != nil {
+		return 0, errors.WrapPrefix(convertDBErr(err), "notification_event", 0)
+	}
+
+	return rows, nil
+}
+
+func (r *NotificationRepository) DeleteBeyondLatest(ctx context.Context, keep int32) (int64, error) {
+	rows, err := r.q.DeleteNotificationEventsBeyondLatest(ctx, keep)
+	if err != nil {
+		return 0, errors.WrapPrefix(convertDBErr(err), "notification_event", 0)
+	}
+
+	return rows, nil
+}
+
+// notificationGrantsToText は SQL 側の '<group_id>/<scope>' 表現に変換する.
+func notificationGrantsToText(grants []port.NotificationGrant) []string {
+	result := make([]string, 0, len(grants))
+	for _, g := range grants {
+		result = append(result, g.GroupID+"/"+string(g.Scope))
+	}
+
+	return result
+}
+
+func notificationRecordToEntity(row db.NotificationEvent) (*entity.NotificationRecord, error) {
+	ev := &hdlctrlv1.NotificationEvent{}
+	if err := (protojson.UnmarshalOptions{DiscardUnknown: true}).Unmarshal(row.Payload, ev); err != nil {
+		return nil, errors.WrapPrefix(err, "notification_event payload", 0)
+	}
+
+	ev.Id = strconv.FormatInt(row.Seq, 10)
+
+	return &entity.NotificationRecord{
+		Seq:          row.Seq,
+		TargetUserID: ptrFromText(row.TargetUserID),
+		HostID:       ptrFromText(row.HostID),
+		GroupID:      ptrFromText(row.GroupID),
+		Scope:        entity.NotificationScope(row.Scope.String),
+		Inbox:        row.Inbox,
+		Event:        ev,
+		CreatedAt:    row.CreatedAt.Time,
+	}, nil
+}
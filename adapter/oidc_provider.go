@@ -0,0 +1,114 @@
+package adapter
+
+import (
+	"context"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/coreos/go-oidc/v3/oidc"
+	"github.com/go-errors/errors"
+	"github.com/hantabaru1014/baru-reso-headless-controller/config"
+	"github.com/hantabaru1014/baru-reso-headless-controller/usecase/port"
+	"golang.org/x/oauth2"
+)
+
+var _ port.OIDCProvider = (*OIDCProvider)(nil)
+
+const oidcHTTPTimeout = 10 * time.Second
+
+// OIDCProvider は go-oidc で IdP とやり取りする.
+// discovery は最初のログイン時に行い、成功するまで毎回やり直す.
+// IdP に繋がらなくてもコントローラ自体の起動は妨げない.
+type OIDCProvider struct {
+	cfg    *config.OIDCConfig
+	client *http.Client
+
+	mu       sync.Mutex
+	provider *oidc.Provider
+}
+
+func NewOIDCProvider(cfg *config.OIDCConfig) *OIDCProvider {
+	return &OIDCProvider{
+		cfg:    cfg,
+		client: &http.Client{Timeout: oidcHTTPTimeout},
+	}
+}
+
+// AuthCodeURL implements port.OIDCProvider.
+func (p *OIDCProvider) AuthCodeURL(ctx context.Context, state, nonce, codeVerifier string) (string, error) {
+	provider, err := p.discover(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	return p.oauth2Config(provider).AuthCodeURL(state, oidc.Nonce(nonce), oauth2.S256ChallengeOption(codeVerifier)), nil
+}
+
+// Exchange implements port.OIDCProvider.
+func (p *OIDCProvider) Exchange(ctx context.Context, code, codeVerifier, nonce string) (*port.OIDCIdentity, error) {
+	provider, err := p.discover(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx = oidc.ClientContext(ctx, p.client)
+
+	token, err := p.oauth2Config(provider).Exchange(ctx, code, oauth2.VerifierOption(codeVerifier))
+	if err != nil {
+		return nil, errors.WrapPrefix(err, "oidc code exchange", 0)
+	}
+
+	rawIDToken, ok := token.Extra("id_token").(string)
+	if !ok || rawIDToken == "" {
+		return nil, errors.New("oidc token response has no id_token")
+	}
+
+	idToken, err := provider.Verifier(&oidc.Config{ClientID: p.cfg.ClientID}).Verify(ctx, rawIDToken)
+	if err != nil {
+		return nil, errors.WrapPrefix(err, "oidc id_token verification", 0)
+	}
+
+	if idToken.Nonce != nonce {
+		return nil, errors.New("oidc id_token nonce mismatch")
+	}
+
+	claims := map[string]any{}
+	if err := idToken.Claims(&claims); err != nil {
+		return nil, errors.WrapPrefix(err, "oidc id_token claims", 0)
+	}
+
+	return &port.OIDCIdentity{
+		Issuer:  idToken.Issuer,
+		Subject: idToken.Subject,
+		Claims:  claims,
+	}, nil
+}
+
+func (p *OIDCProvider) discover(ctx context.Context) (*oidc.Provider, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if p.provider != nil {
+		return p.provider, nil
+	}
+
+	provider, err := oidc.NewProvider(oidc.ClientContext(ctx, p.client), p.cfg.IssuerURL)
+	if err != nil {
+		return nil, errors.WrapPrefix(err, "oidc discovery", 0)
+	}
+
+	p.provider = provider
+
+	return provider, nil
+}
+
+func (p *OIDCProvider) oauth2Config(provider *oidc.Provider) *oauth2.Config {
+	return &oauth2.Config{
+		ClientID:     p.cfg.ClientID,
+		ClientSecret: p.cfg.ClientSecret,
+		Endpoint:     provider.Endpoint(),
+		RedirectURL:  p.cfg.RedirectURL,
+		Scopes:       p.cfg.Scopes,
+	}
+}
@@ -0,0 +1,86 @@
+package adapter_test
+
+import (
+	"testing"
+
+	"github.com/hantabaru1014/baru-reso-headless-controller/adapter"
+	"github.com/hantabaru1014/baru-reso-headless-controller/config"
+	"github.com/hantabaru1014/baru-reso-headless-controller/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestOIDCProvider(t *testing.T) (*adapter.OIDCProvider, *testutil.MockOIDCProvider) {
+	t.Helper()
+
+	idp := testutil.NewMockOIDCProvider(t, "brhc")
+
+	return adapter.NewOIDCProvider(&config.OIDCConfig{
+		IssuerURL:   idp.Issuer(),
+		ClientID:    "brhc",
+		RedirectURL: "http://localhost:8014/sign-in/oidc",
+		Scopes:      []string{"openid", "email"},
+	}), idp
+}
+
+func TestOIDCProvider(t *testing.T) {
+	t.Run("認可コードを交換して ID トークンの claim を取り出せる", func(t *testing.T) {
+		p, idp := newTestOIDCProvider(t)
+		idp.SetUser("sub-alice", map[string]any{"email": "alice@example.test", "email_verified": true})
+
+		authURL, err := p.AuthCodeURL(t.Context(), "state-1", "nonce-1", "verifier-0123456789012345678901234567890123")
+		require.NoError(t, err)
+
+		code, state := idp.Authorize(t, authURL)
+		assert.Equal(t, "state-1", state)
+
+		identity, err := p.Exchange(t.Context(), code, "verifier-0123456789012345678901234567890123", "nonce-1")
+		require.NoError(t, err)
+		assert.Equal(t, idp.Issuer(), identity.Issuer)
+		assert.Equal(t, "sub-alice", identity.Subject)
+		assert.Equal(t, "alice@example.test", identity.StringClaim("email"))
+		assert.Equal(t, true, identity.Claims["email_verified"])
+	})
+
+	t.Run("PKCE verifier が違えば交換できない", func(t *testing.T) {
+		p, idp := newTestOIDCProvider(t)
+		idp.SetUser("sub-alice", map[string]any{"email": "alice@example.test"})
+
+		authURL, err := p.AuthCodeURL(t.Context(), "state-1", "nonce-1", "verifier-0123456789012345678901234567890123")
+		require.NoError(t, err)
+
+		code, _ := idp.Authorize(t, authURL)
+
+		_, err = p.Exchange(t.Context(), code, "verifier-other-6789012345678901234567890123", "nonce-1")
+		require.Error(t, err)
+	})
+
+	t.Run("nonce が違う ID トークンは拒否する", func(t *testing.T) {
+		p, idp := newTestOIDCProvider(t)
+		idp.SetUser("sub-alice", map[string]any{"email": "alice@example.test"})
+
+		authURL, err := p.AuthCodeURL(t.Context(), "state-1", "nonce-1", "verifier-0123456789012345678901234567890123")
+		require.NoError(t, err)
+
+		code, _ := idp.Authorize(t, authURL)
+
+		_, err = p.Exchange(t.Context(), code, "verifier-0123456789012345678901234567890123", "nonce-other")
+		require.ErrorContains(t, err, "nonce")
+	})
+
+	t.Run("認可コードは 1 回しか使えない", func(t *testing.T) {
+		p, idp := newTestOIDCProvider(t)
+		idp.SetUser("sub-alice", map[string]any{"email": "alice@example.test"})
+
+		authURL, err := p.AuthCodeURL(t.Context(), "state-1", "nonce-1", "verifier-0123456789012345678901234567890123")
+		require.NoError(t, err)
+
+		code, _ := idp.Authorize(t, authURL)
+
+		_, err = p.Exchange(t.Context(), code, "verifier-0123456789012345678901234567890123", "nonce-1")
+		require.NoError(t, err)
+
+		_, err = p.Exchange(t.Context(), code, "verifier-0123456789012345678901234567890123", "nonce-1")
+		require.Error(t, err)
+	})
+}
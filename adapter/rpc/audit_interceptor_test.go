@@ -0,0 +1,300 @@
+package rpc
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"connectrpc.com/connect"
+	"github.com/hantabaru1014/baru-reso-headless-controller/domain/entity"
+	hdlctrlv1 "github.com/hantabaru1014/baru-reso-headless-controller/pbgen/hdlctrl/v1"
+	"github.com/hantabaru1014/baru-reso-headless-controller/pbgen/hdlctrl/v1/hdlctrlv1connect"
+	"github.com/hantabaru1014/baru-reso-headless-controller/testutil"
+	"github.com/hantabaru1014/baru-reso-headless-controller/usecase/port"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+)
+
+// TestAuditRules_KnownProcedures は auditRules のキーに typo が無いことを確認する.
+func TestAuditRules_KnownProcedures(t *testing.T) {
+	known := make(map[string]struct{})
+	for _, p := range allKnownProcedures() {
+		known[p] = struct{}{}
+	}
+
+	for p := range auditRules {
+		if _, ok := known[p]; !ok {
+			t.Errorf("audit rule %q is not a known procedure", p)
+		}
+	}
+}
+
+func TestAuditRules_HostTemplateIDs(t *testing.T) {
+	create := auditRules[hdlctrlv1connect.ControllerServiceCreateHostTemplateProcedure]
+	require.NotNil(t, create.resourceID)
+	assert.Equal(t, "tmpl-1", create.resourceID(t.Context(), &hdlctrlv1.CreateHostTemplateRequest{},
+		&hdlctrlv1.CreateHostTemplateResponse{Template: &hdlctrlv1.HostTemplate{Id: "tmpl-1"}}))
+
+	for _, p := range []string{
+		hdlctrlv1connect.ControllerServiceUpdateHostTemplateProcedure,
+		hdlctrlv1connect.ControllerServiceDeleteHostTemplateProcedure,
+	} {
+		rule, ok := auditRules[p]
+		require.True(t, ok, p)
+		assert.Equal(t, entity.AuditResourceType_HostTemplate, rule.resourceType)
+	}
+
+	assert.Equal(t, "tmpl-1", defaultAuditResourceID(entity.AuditResourceType_HostTemplate, &hdlctrlv1.DeleteHostTemplateRequest{TemplateId: "tmpl-1"}))
+	assert.Contains(t, auditRules, hdlctrlv1connect.ControllerServiceCloneHeadlessHostProcedure)
+}
+
+func TestAuditRules_SessionTemplateIDs(t *testing.T) {
+	create := auditRules[hdlctrlv1connect.ControllerServiceCreateSessionTemplateProcedure]
+	require.NotNil(t, create.resourceID)
+	assert.Equal(t, "tmpl-1", create.resourceID(t.Context(), &hdlctrlv1.CreateSessionTemplateRequest{},
+		&hdlctrlv1.CreateSessionTemplateResponse{Template: &hdlctrlv1.SessionTemplate{Id: "tmpl-1"}}))
+
+	for _, p := range []string{
+		hdlctrlv1connect.ControllerServiceUpdateSessionTemplateProcedure,
+		hdlctrlv1connect.ControllerServiceDeleteSessionTemplateProcedure,
+	} {
+		rule, ok := auditRules[p]
+		require.True(t, ok, p)
+		assert.Equal(t, entity.AuditResourceType_SessionTemplate, rule.resourceType)
+	}
+
+	assert.Equal(t, "tmpl-1", defaultAuditResourceID(entity.AuditResourceType_SessionTemplate, &hdlctrlv1.UpdateSessionTemplateRequest{TemplateId: "tmpl-1"}))
+}
+
+func TestAuditRequestSummary(t *testing.T) {
+	t.Run("成功: パスワードとトークンを伏せる", func(t *testing.T) {
+		summary := auditRequestSummary(&hdlctrlv1.RegisterWithTokenRequest{
+			Token:    "raw-token",
+			Password: "raw-password",
+			UserId:   "alice",
+		})
+
+		var got map[string]any
+		require.NoError(t, json.Unmarshal(summary, &got))
+		assert.Equal(t, auditRedacted, got["token"])
+		assert.Equal(t, auditRedacted, got["password"])
+		assert.Equal(t, "alice", got["userId"])
+		assert.NotContains(t, string(summary), "raw-")
+	})
+
+	t.Run("成功: バイナリは落とす", func(t *testing.T) {
+		summary := auditRequestSummary(&hdlctrlv1.UpdateHeadlessAccountIconRequest{
+			AccountId: "U-acc",
+			IconData:  []byte("png-bytes"),
+		})
+
+		var got map[string]any
+		require.NoError(t, json.Unmarshal(summary, &got))
+		assert.Equal(t, "U-acc", got["accountId"])
+		assert.NotContains(t, got, "iconData")
+	})
+
+	t.Run("成功: 元のリクエストは書き換えない", func(t *testing.T) {
+		req := &hdlctrlv1.ChangePasswordRequest{CurrentPassword: "old", NewPassword: "new"}
+		_ = auditRequestSummary(req)
+
+		assert.Equal(t, "old", req.GetCurrentPassword())
+		assert.Equal(t, "new", req.GetNewPassword())
+	})
+}
+
+func TestAuditInterceptor_StopSession(t *testing.T) {
+	listEvents := func(t *testing.T, setup *controllerServiceTestSetup) []*entity.AuditEvent {
+		t.Helper()
+
+		procedure := hdlctrlv1connect.ControllerServiceStopSessionProcedure
+		resourceType := entity.AuditResourceType_Session
+		res, err := newAuditUsecaseForTest(setup.queries).List(t.Context(), port.AuditEventFilter{
+			ResourceType: &resourceType,
+		}, 0, 10)
+		require.NoError(t, err)
+
+		events := make([]*entity.AuditEvent, 0, len(res.Items))
+		for _, e := range res.Items {
+			if e.Procedure == procedure {
+				events = append(events, e)
+			}
+		}
+
+		return events
+	}
+
+	t.Run("成功: 実行者・対象・グループ・結果が記録される", func(t *testing.T) {
+		setup := setupControllerServiceTest(t)
+		defer setup.Cleanup()
+
+		client := setupAuthenticatedClient(t, setup.service)
+
+		const groupID = "g-audit-stop"
+		testutil.CreateTestHeadlessAccountInGroup(t, setup.queries, "U-audit-acc", "audit@example.test", "password", groupID)
+		host := testutil.CreateTestHeadlessHostInGroup(t, setup.queries, "U-audit-acc", "TestHost", entity.HeadlessHostStatus_EXITED, groupID)
+		session := testutil.CreateTestSessionInGroup(t, setup.queries, host.ID, "AuditSession", entity.SessionStatus_ENDED, groupID)
+
+		req := authAsMinPerm(t, setup.queries, &hdlctrlv1.StopSessionRequest{
+			SessionId: session.ID,
+		}, "U-audit-stop", groupID, []string{entity.PermKey_SessionWrite})
+
+		_, err := client.StopSession(t.Context(), req)
+		require.NoError(t, err)
+
+		events := listEvents(t, setup)
+		require.Len(t, events, 1)
+
+		e := events[0]
+		assert.Equal(t, entity.AuditOutcome_OK, e.Outcome)
+		require.NotNil(t, e.UserID)
+		assert.Equal(t, "U-audit-stop", *e.UserID)
+		require.NotNil(t, e.ResourceID)
+		assert.Equal(t, session.ID, *e.ResourceID)
+		require.NotNil(t, e.GroupID)
+		assert.Equal(t, groupID, *e.GroupID)
+		assert.Nil(t, e.ErrorMessage)
+		assert.Contains(t, string(e.RequestSummary), session.ID)
+	})
+
+	t.Run("成功: 権限不足で拒否された操作も記録される", func(t *testing.T) {
+		setup := setupControllerServiceTest(t)
+		defer setup.Cleanup()
+
+		client := setupAuthenticatedClient(t, setup.service)
+
+		const groupID = "g-audit-deny"
+		testutil.CreateTestHeadlessAccountInGroup(t, setup.queries, "U-audit-deny-acc", "deny@example.test", "password", groupID)
+		host := testutil.CreateTestHeadlessHostInGroup(t, setup.queries, "U-audit-deny-acc", "TestHost", entity.HeadlessHostStatus_EXITED, groupID)
+		session := testutil.CreateTestSessionInGroup(t, setup.queries, host.ID, "DenySession", entity.SessionStatus_ENDED, groupID)
+
+		req := authAsMinPerm(t, setup.queries, &hdlctrlv1.StopSessionRequest{
+			SessionId: session.ID,
+		}, "U-audit-deny", groupID, []string{entity.PermKey_SessionRead})
+
+		_, err := client.StopSession(t.Context(), req)
+		require.Error(t, err)
+
+		events := listEvents(t, setup)
+		require.Len(t, events, 1)
+		assert.Equal(t, connect.CodePermissionDenied.String(), events[0].Outcome)
+		require.NotNil(t, events[0].ErrorMessage)
+		require.NotNil(t, events[0].GroupID)
+		assert.Equal(t, groupID, *events[0].GroupID)
+	})
+}
+
+func TestAuditService_ListAuditEvents(t *testing.T) {
+	setupClient := func(t *testing.T, setup *controllerServiceTestSetup) hdlctrlv1connect.AuditServiceClient {
+		t.Helper()
+
+		service := NewAuditService(newAuditUsecaseForTest(setup.queries), newPermissionUsecaseForTest(setup.queries))
+		server := testutil.SetupAuthenticatedHTTPServer(t, service)
+		t.Cleanup(server.Close)
+
+		return hdlctrlv1connect.NewAuditServiceClient(server.Client(), server.URL)
+	}
+
+	seed := func(t *testing.T, setup *controllerServiceTestSetup, groupID string) {
+		t.Helper()
+
+		auditUC := newAuditUsecaseForTest(setup.queries)
+		for _, g := range []string{groupID, "g-audit-other"} {
+			resourceID := "S-" + g
+			require.NoError(t, auditUC.Record(t.Context(), &entity.AuditEvent{
+				Procedure:      hdlctrlv1connect.ControllerServiceBanUserProcedure,
+				ResourceType:   entity.AuditResourceType_Session,
+				ResourceID:     &resourceID,
+				GroupID:        &g,
+				RequestSummary: json.RawMessage(`{}`),
+				Outcome:        entity.AuditOutcome_OK,
+			}))
+		}
+	}
+
+	t.Run("成功: group:audit.read でそのグループの操作だけ見える", func(t *testing.T) {
+		setup := setupControllerServiceTest(t)
+		defer setup.Cleanup()
+
+		client := setupClient(t, setup)
+
+		const groupID = "g-audit-list"
+		req := authAsMinPerm(t, setup.queries, &hdlctrlv1.ListAuditEventsRequest{
+			GroupId: proto.String(groupID),
+		}, "U-audit-reader", groupID, []string{entity.PermKey_GroupAuditRead})
+		seed(t, setup, groupID)
+
+		res, err := client.ListAuditEvents(t.Context(), req)
+		require.NoError(t, err)
+		require.Len(t, res.Msg.GetEvents(), 1)
+		assert.Equal(t, "S-"+groupID, res.Msg.GetEvents()[0].GetResourceId())
+		assert.Equal(t, int32(1), res.Msg.GetPage().GetTotalCount())
+	})
+
+	t.Run("失敗: group:audit.read が無い", func(t *testing.T) {
+		setup := setupControllerServiceTest(t)
+		defer setup.Cleanup()
+
+		client := setupClient(t, setup)
+
+		const groupID = "g-audit-noperm"
+		req := authAsMinPerm(t, setup.queries, &hdlctrlv1.ListAuditEventsRequest{
+			GroupId: proto.String(groupID),
+		}, "U-audit-noperm", groupID, []string{entity.PermKey_SessionRead})
+
+		_, err := client.ListAuditEvents(t.Context(), req)
+		require.Error(t, err)
+		assert.Equal(t, connect.CodePermissionDenied, connect.CodeOf(err))
+	})
+
+	t.Run("失敗: group 未指定は system:audit.read が必要", func(t *testing.T) {
+		setup := setupControllerServiceTest(t)
+		defer setup.Cleanup()
+
+		client := setupClient(t, setup)
+
+		req := authAsMinPerm(t, setup.queries, &hdlctrlv1.ListAuditEventsRequest{},
+			"U-audit-nogroup", "g-audit-nogroup", []string{entity.PermKey_GroupAuditRead})
+
+		_, err := client.ListAuditEvents(t.Context(), req)
+		require.Error(t, err)
+		assert.Equal(t, connect.CodePermissionDenied, connect.CodeOf(err))
+	})
+
+	t.Run("成功: system:audit.read なら全グループ", func(t *testing.T) {
+		setup := setupControllerServiceTest(t)
+		defer setup.Cleanup()
+
+		client := setupClient(t, setup)
+
+		testutil.SetupUserWithExactSystemPermissions(t, setup.queries, "U-audit-sys", []string{entity.PermKey_SystemAuditRead})
+		seed(t, setup, "g-audit-sys")
+
+		req := testutil.CreateAuthenticatedRequest(t, &hdlctrlv1.ListAuditEventsRequest{}, "U-audit-sys", "U-resonite-U-audit-sys", "")
+
+		res, err := client.ListAuditEvents(t.Context(), req)
+		require.NoError(t, err)
+		assert.Len(t, res.Msg.GetEvents(), 2)
+	})
+
+	t.Run("失敗: 不明な resource_type", func(t *testing.T) {
+		setup := setupControllerServiceTest(t)
+		defer setup.Cleanup()
+
+		client := setupClient(t, setup)
+
+		testutil.SetupUserWithExactSystemPermissions(t, setup.queries, "U-audit-badtype", []string{entity.PermKey_SystemAuditRead})
+
+		req := testutil.CreateAuthenticatedRequest(t, &hdlctrlv1.ListAuditEventsRequest{
+			ResourceType: proto.String("planet"),
+		}, "U-audit-badtype", "U-resonite-U-audit-badtype", "")
+
+		_, err := client.ListAuditEvents(t.Context(), req)
+		require.Error(t, err)
+
+		connectErr := &connect.Error{}
+		require.True(t, errors.As(err, &connectErr))
+		assert.Equal(t, connect.CodeInvalidArgument, connectErr.Code())
+	})
+}
@@ -0,0 +1,165 @@
+package rpc
+
+import (
+	"context"
+	"net/http"
+	"slices"
+	"strings"
+
+	"connectrpc.com/connect"
+	"github.com/go-errors/errors"
+	"github.com/hantabaru1014/baru-reso-headless-controller/domain/entity"
+	"github.com/hantabaru1014/baru-reso-headless-controller/lib/auth"
+	"github.com/hantabaru1014/baru-reso-headless-controller/lib/logging"
+	hdlctrlv1 "github.com/hantabaru1014/baru-reso-headless-controller/pbgen/hdlctrl/v1"
+	"github.com/hantabaru1014/baru-reso-headless-controller/pbgen/hdlctrl/v1/hdlctrlv1connect"
+	"github.com/hantabaru1014/baru-reso-headless-controller/usecase"
+	"github.com/hantabaru1014/baru-reso-headless-controller/usecase/port"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+var _ hdlctrlv1connect.AuditServiceHandler = (*AuditService)(nil)
+
+// auditResourceTypes は ListAuditEvents の resource_type に指定できる値.
+var auditResourceTypes = []entity.AuditResourceType{
+	entity.AuditResourceType_Host,
+	entity.AuditResourceType_Session,
+	entity.AuditResourceType_Account,
+	entity.AuditResourceType_Group,
+	entity.AuditResourceType_Role,
+	entity.AuditResourceType_User,
+	entity.AuditResourceType_ScheduledOperation,
+	entity.AuditResourceType_Webhook,
+}
+
+type AuditService struct {
+	auditUC *usecase.AuditUsecase
+	permUC  *usecase.PermissionUsecase
+}
+
+func NewAuditService(auditUC *usecase.AuditUsecase, permUC *usecase.PermissionUsecase) *AuditService {
+	return &AuditService{
+		auditUC: auditUC,
+		permUC:  permUC,
+	}
+}
+
+func (s *AuditService) NewHandler() (string, http.Handler) {
+	interceptors := connect.WithInterceptors(
+		logging.NewErrorLogInterceptor(),
+		auth.NewAuthInterceptor(),
+		NewPermissionInterceptor(s.permUC, PermissionDeps{}),
+	)
+
+	return hdlctrlv1connect.NewAuditServiceHandler(s, interceptors)
+}
+
+// ListAuditEvents: group_id 指定時はそのグループに group:audit.read,
+// 未指定 (全グループ + system 操作) は system:audit.read.
+var _ = registerRPCPermission(
+	hdlctrlv1connect.AuditServiceListAuditEventsProcedure,
+	checkListAuditEvents,
+)
+
+func (s *AuditService) ListAuditEvents(ctx context.Context, req *connect.Request[hdlctrlv1.ListAuditEventsRequest]) (*connect.Response[hdlctrlv1.ListAuditEventsResponse], error) {
+	pageIndex, pageSize, err := normalizePageRequest(req.Msg.GetPage())
+	if err != nil {
+		return nil, err
+	}
+
+	filter := port.AuditEventFilter{
+		GroupID:    nonEmptyPtr(req.Msg.GetGroupId()),
+		UserID:     nonEmptyPtr(req.Msg.GetUserId()),
+		ResourceID: nonEmptyPtr(req.Msg.GetResourceId()),
+	}
+
+	if t := req.Msg.GetResourceType(); t != "" {
+		rt := entity.AuditResourceType(t)
+		if !slices.Contains(auditResourceTypes, rt) {
+			return nil, connect.NewError(connect.CodeInvalidArgument, errors.Errorf("unknown resource_type: %s", t))
+		}
+
+		filter.ResourceType = &rt
+	}
+
+	if req.Msg.Since != nil {
+		t := req.Msg.GetSince().AsTime()
+		filter.Since = &t
+	}
+
+	if req.Msg.Until != nil {
+		t := req.Msg.GetUntil().AsTime()
+		filter.Until = &t
+	}
+
+	result, err := s.auditUC.List(ctx, filter, pageIndex, pageSize)
+	if err != nil {
+		return nil, convertErr(err)
+	}
+
+	events := make([]*hdlctrlv1.AuditEvent, 0, len(result.Items))
+	for _, e := range result.Items {
+		events = append(events, auditEventToProto(e))
+	}
+
+	return connect.NewResponse(&hdlctrlv1.ListAuditEventsResponse{
+		Events: events,
+		Page: &hdlctrlv1.PageResponse{
+			TotalCount: result.TotalCount,
+			PageIndex:  pageIndex,
+			PageSize:   pageSize,
+		},
+	}), nil
+}
+
+func checkListAuditEvents(ctx context.Context, req connect.AnyRequest, _ *PermissionDeps, permUC *usecase.PermissionUsecase) error {
+	msg, ok := req.Any().(*hdlctrlv1.ListAuditEventsRequest)
+	if !ok {
+		return connect.NewError(connect.CodeInternal, errors.New("unexpected request type"))
+	}
+
+	claims, err := extractClaims(ctx)
+	if err != nil {
+		return err
+	}
+
+	if groupID := strings.TrimSpace(msg.GetGroupId()); groupID != "" {
+		return requirePerm(ctx, permUC, claims.UserID, groupID, entity.PermKey_GroupAuditRead)
+	}
+
+	ok, err = permUC.HasSystemPermission(ctx, claims.UserID, entity.PermKey_SystemAuditRead)
+	if err != nil {
+		return connect.NewError(connect.CodeInternal, err)
+	}
+
+	if !ok {
+		return permissionDenied(entity.PermKey_SystemAuditRead)
+	}
+
+	return nil
+}
+
+func auditEventToProto(e *entity.AuditEvent) *hdlctrlv1.AuditEvent {
+	return &hdlctrlv1.AuditEvent{
+		Id:             e.ID,
+		OccurredAt:     timestamppb.New(e.OccurredAt),
+		UserId:         e.UserID,
+		Procedure:      e.Procedure,
+		ResourceType:   string(e.ResourceType),
+		ResourceId:     e.ResourceID,
+		GroupId:        e.GroupID,
+		RequestSummary: string(e.RequestSummary),
+		Outcome:        e.Outcome,
+		ErrorMessage:   e.ErrorMessage,
+		RemoteAddr:     e.RemoteAddr,
+	}
+}
+
+func nonEmptyPtr(s string) *string {
+	s = strings.TrimSpace(s)
+	if s == "" {
+		return nil
+	}
+
+	return &s
+}
@@ -55,12 +55,18 @@ type ControllerService struct {
 	hhrepo         port.HeadlessHostRepository
 	srepo          port.SessionRepository
 	hhuc           *usecase.HeadlessHostUsecase
+	hluc           *usecase.HostLogUsecase
 	hauc           *usecase.HeadlessAccountUsecase
 	suc            *usecase.SessionUsecase
+	shuc           *usecase.SessionHistoryUsecase
+	muc            *usecase.MetricsUsecase
+	stuc           *usecase.SessionTemplateUsecase
+	htuc           *usecase.HostTemplateUsecase
 	buc            *usecase.BlobUsecase
 	souc           *usecase.ScheduledSessionOperationUsecase
 	ajuc           *async_job.Usecase
 	permUC         *usecase.PermissionUsecase
+	auditUC        *usecase.AuditUsecase
 	groupRepo      port.GroupRepository
 	roleRepo       port.RoleRepository
 	skyfrostClient skyfrost.Client
@@ -71,12 +77,18 @@ func NewControllerService(
 	hhrepo port.HeadlessHostRepository,
 	srepo port.SessionRepository,
 	hhuc *usecase.HeadlessHostUsecase,
+	hluc *usecase.HostLogUsecase,
 	hauc *usecase.HeadlessAccountUsecase,
 	suc *usecase.SessionUsecase,
+	shuc *usecase.SessionHistoryUsecase,
+	muc *usecase.MetricsUsecase,
+	stuc *usecase.SessionTemplateUsecase,
+	htuc *usecase.HostTemplateUsecase,
 	buc *usecase.BlobUsecase,
 	souc *usecase.ScheduledSessionOperationUsecase,
 	ajuc *async_job.Usecase,
 	permUC *usecase.PermissionUsecase,
+	auditUC *usecase.AuditUsecase,
 	groupRepo port.GroupRepository,
 	roleRepo port.RoleRepository,
 	skyfrostClient skyfrost.Client,
@@ -86,12 +98,18 @@ func NewControllerService(
 		hhrepo:         hhrepo,
 		srepo:          srepo,
 		hhuc:           hhuc,
+		hluc:           hluc,
 		hauc:           hauc,
 		suc:            suc,
+		shuc:           shuc,
+		muc:            muc,
+		stuc:           stuc,
+		htuc:           htuc,
 		buc:            buc,
 		souc:           souc,
 		ajuc:           ajuc,
 		permUC:         permUC,
+		auditUC:        auditUC,
 		groupRepo:      groupRepo,
 		roleRepo:       roleRepo,
 		skyfrostClient: skyfrostClient,
@@ -103,6 +121,7 @@ func (c *ControllerService) NewHandler() (string, http.Handler) {
 	interceptors := connect.WithInterceptors(
 		logging.NewErrorLogInterceptor(),
 		auth.NewAuthInterceptor(),
+		NewAuditInterceptor(c.auditUC),
 		NewPermissionInterceptor(c.permUC, PermissionDeps{
 			HostRepo:    c.hhrepo,
 			SessionRepo: c.srepo,
@@ -133,6 +152,10 @@ func convertErr(err error) error {
 		return connect.NewError(connect.CodePermissionDenied, err)
 	}
 
+	if errors.Is(err, domain.ErrInvalidArgument) {
+		return connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
 	// ErrHostDraining is a precondition violation — the host has been
 	// enrolled for an auto-upgrade and is no longer accepting new
 	// sessions. Surface the distinction so the frontend can show a
@@ -142,6 +165,11 @@ func convertErr(err error) error {
 		return connect.NewError(connect.CodeFailedPrecondition, err)
 	}
 
+	// 追従が打ち切られただけなので、クライアントは after_id を付けて開き直せる.
+	if errors.Is(err, usecase.ErrHostLogTailLagged) {
+		return connect.NewError(connect.CodeResourceExhausted, err)
+	}
+
 	return connect.NewError(connect.CodeInternal, err)
 }
 
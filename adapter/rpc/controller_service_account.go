@@ -143,7 +143,12 @@ func (c *ControllerService) GetHeadlessAccountStorageInfo(ctx context.Context, r
 		return nil, convertErr(err)
 	}
 
-	storageInfo, err := c.skyfrostClient.GetStorageInfo(ctx, account.Credential, account.Password, account.ResoniteID)
+	credential, password, err := c.hauc.OpenCredentials(account)
+	if err != nil {
+		return nil, convertErr(err)
+	}
+
+	storageInfo, err := c.skyfrostClient.GetStorageInfo(ctx, credential, password, account.ResoniteID)
 	if err != nil {
 		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to get storage info for user: %w", err))
 	}
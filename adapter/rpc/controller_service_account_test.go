@@ -167,9 +167,15 @@ func TestControllerService_CreateHeadlessAccount(t *testing.T) {
 		assert.NotNil(t, res.Msg)
 
 		// Verify account was created in DB
-		account, err := setup.queries.GetHeadlessAccount(t.Context(), "U-testuser123")
+		account, err := setup.service.hauc.GetHeadlessAccount(t.Context(), "U-testuser123")
 		require.NoError(t, err)
-		assert.Equal(t, "testuser@example.test", account.Credential)
+		assert.False(t, account.Credentials.IsLegacyPlaintext())
+		assert.NotContains(t, string(account.Credentials.Credential), "testuser@example.test")
+
+		credential, password, err := setup.service.hauc.OpenCredentials(account)
+		require.NoError(t, err)
+		assert.Equal(t, "testuser@example.test", credential)
+		assert.Equal(t, "testpass123", password)
 	})
 
 	t.Run("成功: 最小権限 caller (account:write) で group_id 指定で作成", func(t *testing.T) {
@@ -361,9 +367,15 @@ func TestControllerService_UpdateHeadlessAccountCredentials(t *testing.T) {
 		assert.NotNil(t, res.Msg)
 
 		// Verify credentials were updated in DB
-		account, err := setup.queries.GetHeadlessAccount(t.Context(), "U-update")
+		account, err := setup.service.hauc.GetHeadlessAccount(t.Context(), "U-update")
+		require.NoError(t, err)
+		assert.False(t, account.Credentials.IsLegacyPlaintext())
+		assert.NotContains(t, string(account.Credentials.Credential), "new@example.test")
+
+		credential, password, err := setup.service.hauc.OpenCredentials(account)
 		require.NoError(t, err)
-		assert.Equal(t, "new@example.test", account.Credential)
+		assert.Equal(t, "new@example.test", credential)
+		assert.Equal(t, "newpassword", password)
 	})
 
 	t.Run("成功: 最小権限 caller (account:write) で更新", func(t *testing.T) {
@@ -64,7 +64,12 @@ func (c *ControllerService) GetFriendRequests(ctx context.Context, req *connect.
 		return nil, convertErr(err)
 	}
 
-	contacts, err := c.skyfrostClient.GetContacts(ctx, account.Credential, account.Password)
+	credential, password, err := c.hauc.OpenCredentials(account)
+	if err != nil {
+		return nil, convertErr(err)
+	}
+
+	contacts, err := c.skyfrostClient.GetContacts(ctx, credential, password)
 	if err != nil {
 		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to get friend requests: %w", err))
 	}
@@ -177,7 +182,12 @@ func (c *ControllerService) GetOwnWorlds(ctx context.Context, req *connect.Reque
 		return nil, convertErr(err)
 	}
 
-	result, err := c.skyfrostClient.GetOwnWorlds(ctx, account.Credential, account.Password, int(req.Msg.GetPageIndex()))
+	credential, password, err := c.hauc.OpenCredentials(account)
+	if err != nil {
+		return nil, convertErr(err)
+	}
+
+	result, err := c.skyfrostClient.GetOwnWorlds(ctx, credential, password, int(req.Msg.GetPageIndex()))
 	if err != nil {
 		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to get own worlds: %w", err))
 	}
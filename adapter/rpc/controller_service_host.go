@@ -2,6 +2,7 @@ package rpc
 
 import (
 	"context"
+	"errors"
 	"time"
 
 	"connectrpc.com/connect"
@@ -75,6 +76,28 @@ func (c *ControllerService) StartHeadlessHost(ctx context.Context, req *connect.
 		req.Msg.GroupId = &gid
 	}
 
+	// テンプレートは受付時に展開し、job には展開済みの request を渡す.
+	if req.Msg.GetTemplateId() != "" {
+		tmpl, err := c.htuc.ResolveForStart(ctx, req.Msg.GetTemplateId(), req.Msg.GetGroupId())
+		if err != nil {
+			return nil, convertErr(err)
+		}
+
+		fillStartRequestFromTemplate(req.Msg, tmpl)
+	}
+	req.Msg.TemplateId = nil
+
+	// コンテナ設定の不正は job の失敗ではなく受付時のエラーとして返す.
+	if req.Msg.ContainerSettings != nil {
+		if err := c.hhuc.ValidateContainerSettings(converter.HostContainerSettingsProtoToEntity(req.Msg.GetContainerSettings())); err != nil {
+			return nil, convertErr(err)
+		}
+	}
+
+	if err := usecase.ValidateAutoRestartMaxRetries(req.Msg.GetAutoRestartMaxRetries()); err != nil {
+		return nil, convertErr(err)
+	}
+
 	jobID, err := c.ajuc.EnqueueStartHost(ctx, req.Msg, &claims.UserID)
 	if err != nil {
 		return nil, convertErr(err)
@@ -133,7 +156,7 @@ func (c *ControllerService) UpdateHeadlessHostSettings(ctx context.Context, req
 
 	if req.Msg.AutoUpdatePolicy != nil &&
 		req.Msg.GetAutoUpdatePolicy() != hdlctrlv1.HeadlessHostAutoUpdatePolicy_HEADLESS_HOST_AUTO_UPDATE_POLICY_UNKNOWN {
-		err := c.hhrepo.UpdateAutoUpdatePolicy(
+		err := c.hhuc.HeadlessHostUpdateAutoUpdatePolicy(
 			ctx,
 			req.Msg.GetHostId(),
 			entity.HostAutoUpdatePolicy(req.Msg.GetAutoUpdatePolicy()),
@@ -143,72 +166,45 @@ func (c *ControllerService) UpdateHeadlessHostSettings(ctx context.Context, req
 		}
 	}
 
-	hasUpdateReq := false
-	updateReq := &headlessv1.UpdateHostSettingsRequest{}
-	settings := host.HostSettings
-
-	if req.Msg.TickRate != nil {
-		hasUpdateReq = true
-		tickRate := req.Msg.GetTickRate()
-		updateReq.TickRate = &tickRate
-		settings.TickRate = tickRate
-	}
-
-	if req.Msg.MaxConcurrentAssetTransfers != nil {
-		hasUpdateReq = true
-		maxConcurrentAssetTransfers := req.Msg.GetMaxConcurrentAssetTransfers()
-		updateReq.MaxConcurrentAssetTransfers = &maxConcurrentAssetTransfers
-		settings.MaxConcurrentAssetTransfers = maxConcurrentAssetTransfers
+	if req.Msg.ContainerSettings != nil {
+		err := c.hhuc.HeadlessHostUpdateContainerSettings(
+			ctx,
+			req.Msg.GetHostId(),
+			converter.HostContainerSettingsProtoToEntity(req.Msg.GetContainerSettings()),
+		)
+		if err != nil {
+			return nil, convertErr(err)
+		}
 	}
 
-	if req.Msg.UsernameOverride != nil {
-		hasUpdateReq = true
-		usernameOverride := req.Msg.GetUsernameOverride()
-		updateReq.UsernameOverride = &usernameOverride
-		settings.UsernameOverride = &usernameOverride
-	}
+	if req.Msg.AutoRestartPolicy != nil || req.Msg.AutoRestartMaxRetries != nil {
+		policy := host.AutoRestartPolicy
+		if req.Msg.AutoRestartPolicy != nil {
+			policy = entity.HostAutoRestartPolicy(req.Msg.GetAutoRestartPolicy())
+		}
 
-	if req.Msg.GetUpdateAutoSpawnItems() {
-		hasUpdateReq = true
-		updateReq.UpdateAutoSpawnItems = true
-		updateReq.AutoSpawnItems = req.Msg.GetAutoSpawnItems()
-		settings.AutoSpawnItems = req.Msg.GetAutoSpawnItems()
-	}
+		maxRetries := host.AutoRestartMaxRetries
+		if req.Msg.AutoRestartMaxRetries != nil {
+			maxRetries = req.Msg.GetAutoRestartMaxRetries()
+		}
 
-	if req.Msg.UniverseId != nil {
-		hasUpdateReq = true
-		// 実行中のホストのUniverseIDの更新は未対応
-		settings.UniverseID = req.Msg.UniverseId
+		err := c.hhuc.HeadlessHostUpdateAutoRestartPolicy(ctx, req.Msg.GetHostId(), policy, maxRetries)
+		if err != nil {
+			return nil, convertErr(err)
+		}
 	}
 
-	if hasUpdateReq {
-		if host.Status == entity.HeadlessHostStatus_RUNNING {
-			conn, err := c.hhrepo.GetRpcClient(ctx, req.Msg.GetHostId())
-			if err != nil {
-				return nil, convertRpcClientErr(err)
-			}
-
-			_, err = conn.UpdateHostSettings(ctx, updateReq)
-			if err != nil {
-				return nil, convertRpcClientErr(err)
-			}
-
-			updated, err := conn.GetStartupConfigToRestore(ctx, &headlessv1.GetStartupConfigToRestoreRequest{
-				IncludeStartWorlds: false,
-			})
-			if err != nil {
-				return nil, convertRpcClientErr(err)
-			}
-
-			err = c.hhrepo.UpdateHostSettings(ctx, req.Msg.GetHostId(), converter.HeadlessHostSettingsProtoToEntity(updated.GetStartupConfig()))
-			if err != nil {
-				return nil, convertErr(err)
-			}
-		} else {
-			err = c.hhrepo.UpdateHostSettings(ctx, req.Msg.GetHostId(), &settings)
-			if err != nil {
-				return nil, convertErr(err)
-			}
+	if req.Msg.TickRate != nil || req.Msg.MaxConcurrentAssetTransfers != nil || req.Msg.UsernameOverride != nil ||
+		req.Msg.GetUpdateAutoSpawnItems() || req.Msg.UniverseId != nil {
+		err := c.hhuc.HeadlessHostUpdateHostSettings(ctx, req.Msg.GetHostId(), &headlessv1.UpdateHostSettingsRequest{
+			TickRate:                    req.Msg.TickRate,
+			MaxConcurrentAssetTransfers: req.Msg.MaxConcurrentAssetTransfers,
+			UsernameOverride:            req.Msg.UsernameOverride,
+			UpdateAutoSpawnItems:        req.Msg.GetUpdateAutoSpawnItems(),
+			AutoSpawnItems:              req.Msg.GetAutoSpawnItems(),
+		}, req.Msg.UniverseId)
+		if err != nil {
+			return nil, convertErr(err)
 		}
 	}
 
@@ -248,23 +244,189 @@ func (c *ControllerService) GetHeadlessHostLogs(ctx context.Context, req *connec
 		return nil, convertErr(err)
 	}
 
-	protoLogs := make([]*hdlctrlv1.GetHeadlessHostLogsResponse_Log, 0, len(result.Logs))
-	for _, log := range result.Logs {
+	res := connect.NewResponse(&hdlctrlv1.GetHeadlessHostLogsResponse{
+		Logs:          logLinesToProto(result.Logs),
+		HasMoreBefore: result.HasMoreBefore,
+		HasMoreAfter:  result.HasMoreAfter,
+	})
+
+	return res, nil
+}
+
+// TailHeadlessHostLogs implements hdlctrlv1connect.ControllerServiceHandler.
+// 権限: host.group_id に対して host:read. permission interceptor は streaming を
+// 通さないため、HostLogUsecase.OpenTail 側で確認する.
+// バックログを 1 メッセージで送ったあと、追記されたログを届き次第送る.
+// 新しいログがなくても keepAliveInterval ごとに空のメッセージを送る.
+func (c *ControllerService) TailHeadlessHostLogs(
+	ctx context.Context,
+	req *connect.Request[hdlctrlv1.TailHeadlessHostLogsRequest],
+	stream *connect.ServerStream[hdlctrlv1.TailHeadlessHostLogsResponse],
+) error {
+	tail, err := c.hluc.OpenTail(ctx, usecase.HostLogTailParams{
+		HostID:     req.Msg.GetHostId(),
+		InstanceID: req.Msg.GetInstanceId(),
+		Backlog:    req.Msg.GetBacklog(),
+		AfterID:    req.Msg.AfterId,
+		Filter: usecase.HostLogFilter{
+			Contains: req.Msg.GetContains(),
+			Pattern:  req.Msg.GetPattern(),
+			MinLevel: entity.HostLogLevel(req.Msg.GetMinLevel()),
+		},
+	})
+	if err != nil {
+		return convertErr(err)
+	}
+
+	err = stream.Send(&hdlctrlv1.TailHeadlessHostLogsResponse{
+		Logs:            logLinesToProto(tail.Backlog),
+		InstanceId:      tail.InstanceID,
+		BacklogComplete: true,
+	})
+	if err != nil {
+		return err
+	}
+
+	ticker := time.NewTicker(keepAliveInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+
+		case <-ticker.C:
+			if err := stream.Send(&hdlctrlv1.TailHeadlessHostLogsResponse{InstanceId: tail.InstanceID}); err != nil {
+				return err
+			}
+
+		case lines, ok := <-tail.Lines:
+			if !ok {
+				return convertErr(tail.Err())
+			}
+
+			err := stream.Send(&hdlctrlv1.TailHeadlessHostLogsResponse{
+				Logs:       logLinesToProto(lines),
+				InstanceId: tail.InstanceID,
+			})
+			if err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// SearchHeadlessHostLogs implements hdlctrlv1connect.ControllerServiceHandler.
+// 権限: handler 側で resolveListGroupFilter により認可する (interceptor は通過のみ).
+var _ = registerRPCPermission(
+	hdlctrlv1connect.ControllerServiceSearchHeadlessHostLogsProcedure,
+	requireAuthOnly,
+)
+
+func (c *ControllerService) SearchHeadlessHostLogs(ctx context.Context, req *connect.Request[hdlctrlv1.SearchHeadlessHostLogsRequest]) (*connect.Response[hdlctrlv1.SearchHeadlessHostLogsResponse], error) {
+	groupIDs, err := c.resolveListGroupFilter(ctx, req.Msg.GetGroupId(), entity.PermKey_HostRead)
+	if err != nil {
+		return nil, err
+	}
+
+	params := usecase.HostLogSearchParams{
+		Query:    req.Msg.GetQuery(),
+		GroupIDs: groupIDs,
+		HostIDs:  req.Msg.GetHostIds(),
+		BeforeID: req.Msg.GetBeforeId(),
+		Limit:    req.Msg.GetLimit(),
+	}
+
+	if req.Msg.Since != nil {
+		t := req.Msg.GetSince().AsTime()
+		params.Since = &t
+	}
+
+	if req.Msg.Until != nil {
+		t := req.Msg.GetUntil().AsTime()
+		params.Until = &t
+	}
+
+	result, err := c.hluc.Search(ctx, params)
+	if err != nil {
+		return nil, convertErr(err)
+	}
+
+	groups := make([]*hdlctrlv1.SearchHeadlessHostLogsResponse_Group, 0, len(result.Groups))
+	for _, group := range result.Groups {
+		groups = append(groups, &hdlctrlv1.SearchHeadlessHostLogsResponse_Group{
+			HostId:     group.HostID,
+			HostName:   group.HostName,
+			InstanceId: group.InstanceID,
+			Logs:       logLinesToProto(group.Logs),
+		})
+	}
+
+	res := connect.NewResponse(&hdlctrlv1.SearchHeadlessHostLogsResponse{
+		Groups:       groups,
+		NextBeforeId: result.NextBeforeID,
+	})
+
+	return res, nil
+}
+
+// PrepareHeadlessHostLogDownload implements hdlctrlv1connect.ControllerServiceHandler.
+// 権限: host.group_id に対して host:read.
+var _ = registerRPCPermission(
+	hdlctrlv1connect.ControllerServicePrepareHeadlessHostLogDownloadProcedure,
+	checkHostPermission(entity.PermKey_HostRead, hostIDFromPrepareLogDownload),
+)
+
+func (c *ControllerService) PrepareHeadlessHostLogDownload(ctx context.Context, req *connect.Request[hdlctrlv1.PrepareHeadlessHostLogDownloadRequest]) (*connect.Response[hdlctrlv1.PrepareHeadlessHostLogDownloadResponse], error) {
+	params := usecase.HostLogDownloadParams{
+		HostID:     req.Msg.GetHostId(),
+		InstanceID: req.Msg.GetInstanceId(),
+	}
+
+	switch req.Msg.GetFormat() {
+	case hdlctrlv1.HeadlessHostLogExportFormat_HEADLESS_HOST_LOG_EXPORT_FORMAT_TEXT:
+		params.Format = usecase.HostLogExportFormat_Text
+	case hdlctrlv1.HeadlessHostLogExportFormat_HEADLESS_HOST_LOG_EXPORT_FORMAT_NDJSON:
+		params.Format = usecase.HostLogExportFormat_NDJSON
+	default:
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("format is required"))
+	}
+
+	if req.Msg.Since != nil {
+		t := req.Msg.GetSince().AsTime()
+		params.Since = &t
+	}
+
+	if req.Msg.Until != nil {
+		t := req.Msg.GetUntil().AsTime()
+		params.Until = &t
+	}
+
+	download, err := c.buc.PrepareHostLogDownload(ctx, params)
+	if err != nil {
+		return nil, convertErr(err)
+	}
+
+	return connect.NewResponse(&hdlctrlv1.PrepareHeadlessHostLogDownloadResponse{
+		DownloadUrl: download.URL,
+		Filename:    download.Filename,
+		LineCount:   download.LineCount,
+	}), nil
+}
+
+func logLinesToProto(lines port.LogLineList) []*hdlctrlv1.GetHeadlessHostLogsResponse_Log {
+	protoLogs := make([]*hdlctrlv1.GetHeadlessHostLogsResponse_Log, 0, len(lines))
+	for _, log := range lines {
 		protoLogs = append(protoLogs, &hdlctrlv1.GetHeadlessHostLogsResponse_Log{
 			Timestamp: timestamppb.New(time.Unix(log.Timestamp, 0)),
 			IsError:   log.IsError,
 			Body:      log.Body,
 			Id:        log.ID,
+			Level:     hdlctrlv1.HeadlessHostLogLevel(log.Level),
 		})
 	}
 
-	res := connect.NewResponse(&hdlctrlv1.GetHeadlessHostLogsResponse{
-		Logs:          protoLogs,
-		HasMoreBefore: result.HasMoreBefore,
-		HasMoreAfter:  result.HasMoreAfter,
-	})
-
-	return res, nil
+	return protoLogs
 }
 
 // ListHeadlessHostInstances implements hdlctrlv1connect.ControllerServiceHandler.
@@ -287,6 +449,7 @@ func (c *ControllerService) ListHeadlessHostInstances(ctx context.Context, req *
 			InstanceId: inst.InstanceID,
 			LogCount:   inst.LogCount,
 			IsCurrent:  inst.IsCurrent,
+			Archived:   inst.Archived,
 		}
 		if inst.FirstLogAt != nil {
 			protoInst.FirstLogAt = timestamppb.New(time.Unix(*inst.FirstLogAt, 0))
@@ -306,6 +469,74 @@ func (c *ControllerService) ListHeadlessHostInstances(ctx context.Context, req *
 	return res, nil
 }
 
+// CloneHeadlessHost implements hdlctrlv1connect.ControllerServiceHandler.
+// コピー元の設定から StartHeadlessHost と同じ起動 job を enqueue する.
+// 権限: コピー元 host.group_id に対して host:write (interceptor) と account:use (handler).
+// 使うアカウントはコピー元と同じグループのものに限る.
+var _ = registerRPCPermission(
+	hdlctrlv1connect.ControllerServiceCloneHeadlessHostProcedure,
+	checkHostPermission(entity.PermKey_HostWrite, hostIDFromClone),
+)
+
+func (c *ControllerService) CloneHeadlessHost(ctx context.Context, req *connect.Request[hdlctrlv1.CloneHeadlessHostRequest]) (*connect.Response[hdlctrlv1.CloneHeadlessHostResponse], error) {
+	claims, err := auth.GetAuthClaimsFromContext(ctx)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeUnauthenticated, err)
+	}
+
+	src, err := c.hhuc.HeadlessHostGet(ctx, req.Msg.GetSourceHostId())
+	if err != nil {
+		return nil, convertErr(err)
+	}
+
+	accountID := src.AccountId
+	if req.Msg.GetHeadlessAccountId() != "" {
+		accountID = req.Msg.GetHeadlessAccountId()
+	}
+
+	account, err := c.hauc.GetHeadlessAccount(ctx, accountID)
+	if err != nil {
+		return nil, convertErr(err)
+	}
+
+	if account.GroupID != src.GroupID {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("account group does not match source host group"))
+	}
+
+	if err := c.permUC.RequirePermissionForGroup(ctx, src.GroupID, entity.PermKey_AccountUse); err != nil {
+		return nil, convertErr(err)
+	}
+
+	name := src.Name + "のコピー"
+	if req.Msg.GetName() != "" {
+		name = req.Msg.GetName()
+	}
+
+	startupConfig := converter.HeadlessHostSettingsToStartupConfigProto(&src.HostSettings)
+	startupConfig.StartWorlds = nil
+
+	startReq := &hdlctrlv1.StartHeadlessHostRequest{
+		Name:                  name,
+		HeadlessAccountId:     accountID,
+		ImageTag:              req.Msg.ImageTag,
+		StartupConfig:         startupConfig,
+		AutoUpdatePolicy:      hdlctrlv1.HeadlessHostAutoUpdatePolicy(src.AutoUpdatePolicy).Enum(),
+		Memo:                  &src.Memo,
+		GroupId:               &src.GroupID,
+		NodeId:                req.Msg.NodeId,
+		ContainerSettings:     converter.HostContainerSettingsToProto(&src.ContainerSettings),
+		AutoRestartPolicy:     hdlctrlv1.HeadlessHostAutoRestartPolicy(src.AutoRestartPolicy).Enum(),
+		AutoRestartMaxRetries: &src.AutoRestartMaxRetries,
+	}
+
+	jobID, err := c.ajuc.EnqueueStartHost(ctx, startReq, &claims.UserID)
+	if err != nil {
+		return nil, convertErr(err)
+	}
+
+	return connect.NewResponse(&hdlctrlv1.CloneHeadlessHostResponse{JobId: jobID}), nil
+}
+
 // ShutdownHeadlessHost implements hdlctrlv1connect.ControllerServiceHandler.
 // graceful shutdown は container 終了待ちが入るため非同期 job 化する.
 // 権限: host.group_id に対して host:write.
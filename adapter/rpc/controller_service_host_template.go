@@ -0,0 +1,187 @@
+package rpc
+
+import (
+	"context"
+	"errors"
+
+	"connectrpc.com/connect"
+	"github.com/hantabaru1014/baru-reso-headless-controller/adapter/converter"
+	"github.com/hantabaru1014/baru-reso-headless-controller/domain/entity"
+	hdlctrlv1 "github.com/hantabaru1014/baru-reso-headless-controller/pbgen/hdlctrl/v1"
+	"github.com/hantabaru1014/baru-reso-headless-controller/pbgen/hdlctrl/v1/hdlctrlv1connect"
+	"github.com/hantabaru1014/baru-reso-headless-controller/usecase/port"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// テンプレート ID を取る RPC は handler 側 (usecase) でテンプレートの group_id に対して
+// 権限をチェックする (interceptor は通過のみ).
+// 権限: 一覧 / 取得は host:read, 作成 / 更新 / 削除は host:write.
+var (
+	_ = registerRPCPermission(
+		hdlctrlv1connect.ControllerServiceListHostTemplatesProcedure,
+		checkGroupPermission(entity.PermKey_HostRead, func(r *hdlctrlv1.ListHostTemplatesRequest) string { return r.GetGroupId() }, false),
+	)
+	_ = registerRPCPermission(
+		hdlctrlv1connect.ControllerServiceGetHostTemplateProcedure,
+		requireAuthOnly,
+	)
+	_ = registerRPCPermission(
+		hdlctrlv1connect.ControllerServiceCreateHostTemplateProcedure,
+		checkGroupPermission(entity.PermKey_HostWrite, func(r *hdlctrlv1.CreateHostTemplateRequest) string { return r.GetGroupId() }, false),
+	)
+	_ = registerRPCPermission(
+		hdlctrlv1connect.ControllerServiceUpdateHostTemplateProcedure,
+		requireAuthOnly,
+	)
+	_ = registerRPCPermission(
+		hdlctrlv1connect.ControllerServiceDeleteHostTemplateProcedure,
+		requireAuthOnly,
+	)
+)
+
+// ListHostTemplates implements hdlctrlv1connect.ControllerServiceHandler.
+func (c *ControllerService) ListHostTemplates(ctx context.Context, req *connect.Request[hdlctrlv1.ListHostTemplatesRequest]) (*connect.Response[hdlctrlv1.ListHostTemplatesResponse], error) {
+	templates, err := c.htuc.ListTemplates(ctx, req.Msg.GetGroupId())
+	if err != nil {
+		return nil, convertErr(err)
+	}
+
+	protoList := make([]*hdlctrlv1.HostTemplate, 0, len(templates))
+	for _, t := range templates {
+		protoList = append(protoList, hostTemplateToProto(t))
+	}
+
+	return connect.NewResponse(&hdlctrlv1.ListHostTemplatesResponse{Templates: protoList}), nil
+}
+
+// GetHostTemplate implements hdlctrlv1connect.ControllerServiceHandler.
+func (c *ControllerService) GetHostTemplate(ctx context.Context, req *connect.Request[hdlctrlv1.GetHostTemplateRequest]) (*connect.Response[hdlctrlv1.GetHostTemplateResponse], error) {
+	if req.Msg.GetTemplateId() == "" {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("template_id is required"))
+	}
+
+	tmpl, err := c.htuc.GetTemplate(ctx, req.Msg.GetTemplateId())
+	if err != nil {
+		return nil, convertErr(err)
+	}
+
+	return connect.NewResponse(&hdlctrlv1.GetHostTemplateResponse{Template: hostTemplateToProto(tmpl)}), nil
+}
+
+// CreateHostTemplate implements hdlctrlv1connect.ControllerServiceHandler.
+func (c *ControllerService) CreateHostTemplate(ctx context.Context, req *connect.Request[hdlctrlv1.CreateHostTemplateRequest]) (*connect.Response[hdlctrlv1.CreateHostTemplateResponse], error) {
+	tmpl := &entity.HostTemplate{
+		GroupID:               req.Msg.GetGroupId(),
+		Name:                  req.Msg.GetName(),
+		Description:           req.Msg.GetDescription(),
+		ImageTag:              req.Msg.GetImageTag(),
+		StartupConfig:         req.Msg.GetStartupConfig(),
+		AutoUpdatePolicy:      entity.HostAutoUpdatePolicy(req.Msg.GetAutoUpdatePolicy()),
+		Memo:                  req.Msg.GetMemo(),
+		AutoRestartPolicy:     entity.HostAutoRestartPolicy(req.Msg.GetAutoRestartPolicy()),
+		AutoRestartMaxRetries: req.Msg.GetAutoRestartMaxRetries(),
+	}
+	if req.Msg.ContainerSettings != nil {
+		tmpl.ContainerSettings = *converter.HostContainerSettingsProtoToEntity(req.Msg.GetContainerSettings())
+	}
+
+	if err := c.htuc.CreateTemplate(ctx, tmpl); err != nil {
+		return nil, convertErr(err)
+	}
+
+	return connect.NewResponse(&hdlctrlv1.CreateHostTemplateResponse{Template: hostTemplateToProto(tmpl)}), nil
+}
+
+// UpdateHostTemplate implements hdlctrlv1connect.ControllerServiceHandler.
+func (c *ControllerService) UpdateHostTemplate(ctx context.Context, req *connect.Request[hdlctrlv1.UpdateHostTemplateRequest]) (*connect.Response[hdlctrlv1.UpdateHostTemplateResponse], error) {
+	if req.Msg.GetTemplateId() == "" {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("template_id is required"))
+	}
+
+	params := port.HostTemplateUpdateParams{
+		Name:                  req.Msg.Name,
+		Description:           req.Msg.Description,
+		ImageTag:              req.Msg.ImageTag,
+		StartupConfig:         req.Msg.StartupConfig,
+		Memo:                  req.Msg.Memo,
+		AutoRestartMaxRetries: req.Msg.AutoRestartMaxRetries,
+	}
+	if req.Msg.AutoUpdatePolicy != nil {
+		policy := entity.HostAutoUpdatePolicy(req.Msg.GetAutoUpdatePolicy())
+		params.AutoUpdatePolicy = &policy
+	}
+	if req.Msg.AutoRestartPolicy != nil {
+		policy := entity.HostAutoRestartPolicy(req.Msg.GetAutoRestartPolicy())
+		params.AutoRestartPolicy = &policy
+	}
+	if req.Msg.ContainerSettings != nil {
+		params.ContainerSettings = converter.HostContainerSettingsProtoToEntity(req.Msg.GetContainerSettings())
+	}
+
+	tmpl, err := c.htuc.UpdateTemplate(ctx, req.Msg.GetTemplateId(), params)
+	if err != nil {
+		return nil, convertErr(err)
+	}
+
+	return connect.NewResponse(&hdlctrlv1.UpdateHostTemplateResponse{Template: hostTemplateToProto(tmpl)}), nil
+}
+
+// DeleteHostTemplate implements hdlctrlv1connect.ControllerServiceHandler.
+func (c *ControllerService) DeleteHostTemplate(ctx context.Context, req *connect.Request[hdlctrlv1.DeleteHostTemplateRequest]) (*connect.Response[hdlctrlv1.DeleteHostTemplateResponse], error) {
+	if req.Msg.GetTemplateId() == "" {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("template_id is required"))
+	}
+
+	if err := c.htuc.DeleteTemplate(ctx, req.Msg.GetTemplateId()); err != nil {
+		return nil, convertErr(err)
+	}
+
+	return connect.NewResponse(&hdlctrlv1.DeleteHostTemplateResponse{}), nil
+}
+
+// fillStartRequestFromTemplate は req で未指定の項目だけを tmpl の値で埋める.
+// テンプレート側が空 / 未設定の image_tag・auto_update_policy・memo は埋めない
+// (起動時の既定値に任せる).
+func fillStartRequestFromTemplate(req *hdlctrlv1.StartHeadlessHostRequest, tmpl *entity.HostTemplate) {
+	if req.ImageTag == nil && tmpl.ImageTag != "" {
+		req.ImageTag = proto.String(tmpl.ImageTag)
+	}
+	if req.StartupConfig == nil && tmpl.StartupConfig != nil {
+		req.StartupConfig = proto.CloneOf(tmpl.StartupConfig)
+	}
+	if req.AutoUpdatePolicy == nil && tmpl.AutoUpdatePolicy != entity.HostAutoUpdatePolicy_UNSPECIFIED {
+		req.AutoUpdatePolicy = hdlctrlv1.HeadlessHostAutoUpdatePolicy(tmpl.AutoUpdatePolicy).Enum()
+	}
+	if req.Memo == nil && tmpl.Memo != "" {
+		req.Memo = proto.String(tmpl.Memo)
+	}
+	if req.ContainerSettings == nil {
+		req.ContainerSettings = converter.HostContainerSettingsToProto(&tmpl.ContainerSettings)
+	}
+	if req.AutoRestartPolicy == nil {
+		req.AutoRestartPolicy = hdlctrlv1.HeadlessHostAutoRestartPolicy(tmpl.AutoRestartPolicy).Enum()
+	}
+	if req.AutoRestartMaxRetries == nil {
+		req.AutoRestartMaxRetries = proto.Int32(tmpl.AutoRestartMaxRetries)
+	}
+}
+
+func hostTemplateToProto(t *entity.HostTemplate) *hdlctrlv1.HostTemplate {
+	return &hdlctrlv1.HostTemplate{
+		Id:                    t.ID,
+		GroupId:               t.GroupID,
+		Name:                  t.Name,
+		Description:           t.Description,
+		ImageTag:              t.ImageTag,
+		StartupConfig:         t.StartupConfig,
+		AutoUpdatePolicy:      hdlctrlv1.HeadlessHostAutoUpdatePolicy(t.AutoUpdatePolicy),
+		Memo:                  t.Memo,
+		ContainerSettings:     converter.HostContainerSettingsToProto(&t.ContainerSettings),
+		AutoRestartPolicy:     hdlctrlv1.HeadlessHostAutoRestartPolicy(t.AutoRestartPolicy),
+		AutoRestartMaxRetries: t.AutoRestartMaxRetries,
+		CreatedBy:             t.CreatedBy,
+		CreatedAt:             timestamppb.New(t.CreatedAt),
+		UpdatedAt:             timestamppb.New(t.UpdatedAt),
+	}
+}
@@ -0,0 +1,72 @@
+package rpc
+
+import (
+	"testing"
+
+	"github.com/hantabaru1014/baru-reso-headless-controller/domain/entity"
+	hdlctrlv1 "github.com/hantabaru1014/baru-reso-headless-controller/pbgen/hdlctrl/v1"
+	headlessv1 "github.com/hantabaru1014/baru-reso-headless-controller/pbgen/headless/v1"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestFillStartRequestFromTemplate(t *testing.T) {
+	t.Parallel()
+
+	tmpl := &entity.HostTemplate{
+		ImageTag:              "v1.2.3",
+		StartupConfig:         &headlessv1.StartupConfig{TickRate: proto.Float32(30)},
+		AutoUpdatePolicy:      entity.HostAutoUpdatePolicy_USERS_EMPTY,
+		Memo:                  "template memo",
+		ContainerSettings:     entity.HostContainerSettings{MemoryBytes: 1 << 30},
+		AutoRestartPolicy:     entity.HostAutoRestartPolicy_ON_CRASH,
+		AutoRestartMaxRetries: 3,
+	}
+
+	t.Run("未指定の項目はテンプレートで埋める", func(t *testing.T) {
+		t.Parallel()
+
+		req := &hdlctrlv1.StartHeadlessHostRequest{Name: "host", HeadlessAccountId: "acc"}
+		fillStartRequestFromTemplate(req, tmpl)
+
+		assert.Equal(t, "v1.2.3", req.GetImageTag())
+		assert.InDelta(t, 30, req.GetStartupConfig().GetTickRate(), 0)
+		assert.Equal(t, hdlctrlv1.HeadlessHostAutoUpdatePolicy_HEADLESS_HOST_AUTO_UPDATE_POLICY_USERS_EMPTY, req.GetAutoUpdatePolicy())
+		assert.Equal(t, "template memo", req.GetMemo())
+		assert.Equal(t, int64(1<<30), req.GetContainerSettings().GetMemoryBytes())
+		assert.Equal(t, hdlctrlv1.HeadlessHostAutoRestartPolicy_HEADLESS_HOST_AUTO_RESTART_POLICY_ON_CRASH, req.GetAutoRestartPolicy())
+		assert.Equal(t, int32(3), req.GetAutoRestartMaxRetries())
+
+		// テンプレートの startup_config を共有しない
+		req.GetStartupConfig().TickRate = proto.Float32(60)
+		assert.InDelta(t, 30, tmpl.StartupConfig.GetTickRate(), 0)
+	})
+
+	t.Run("指定済みの項目は上書きしない", func(t *testing.T) {
+		t.Parallel()
+
+		req := &hdlctrlv1.StartHeadlessHostRequest{
+			ImageTag:              proto.String("v9"),
+			StartupConfig:         &headlessv1.StartupConfig{TickRate: proto.Float32(60)},
+			Memo:                  proto.String(""),
+			AutoRestartMaxRetries: proto.Int32(0),
+		}
+		fillStartRequestFromTemplate(req, tmpl)
+
+		assert.Equal(t, "v9", req.GetImageTag())
+		assert.InDelta(t, 60, req.GetStartupConfig().GetTickRate(), 0)
+		assert.Empty(t, req.GetMemo())
+		assert.Equal(t, int32(0), req.GetAutoRestartMaxRetries())
+	})
+
+	t.Run("テンプレートの空の image_tag は埋めない", func(t *testing.T) {
+		t.Parallel()
+
+		req := &hdlctrlv1.StartHeadlessHostRequest{}
+		fillStartRequestFromTemplate(req, &entity.HostTemplate{})
+
+		assert.Nil(t, req.ImageTag)
+		assert.Nil(t, req.AutoUpdatePolicy)
+		assert.Nil(t, req.Memo)
+	})
+}
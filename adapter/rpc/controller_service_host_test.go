@@ -17,6 +17,9 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"go.uber.org/mock/gomock"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 func TestControllerService_ListHeadlessHostImageTags(t *testing.T) {
@@ -328,6 +331,109 @@ func TestControllerService_UpdateHeadlessHostSettings(t *testing.T) {
 			"AutoUpdatePolicy passed in request should be persisted")
 	})
 
+	t.Run("成功: コンテナ設定を更新", func(t *testing.T) {
+		setup := setupControllerServiceTest(t)
+		defer setup.Cleanup()
+
+		client := setupAuthenticatedClient(t, setup.service)
+
+		testutil.CreateTestHeadlessAccount(t, setup.queries, "U-test-cs", "test-cs@example.test", "password")
+		host := testutil.CreateTestHeadlessHost(t, setup.queries, "U-test-cs", "TestHost", entity.HeadlessHostStatus_EXITED)
+
+		req := testutil.CreateDefaultAuthenticatedRequest(t, &hdlctrlv1.UpdateHeadlessHostSettingsRequest{
+			HostId: host.ID,
+			ContainerSettings: &hdlctrlv1.HeadlessHostContainerSettings{
+				Cpus:          2,
+				MemoryBytes:   4 << 30,
+				RestartPolicy: hdlctrlv1.HeadlessHostRestartPolicy_HEADLESS_HOST_RESTART_POLICY_UNLESS_STOPPED,
+			},
+		})
+
+		_, err := client.UpdateHeadlessHostSettings(t.Context(), req)
+		require.NoError(t, err)
+
+		updatedHost, err := setup.queries.GetHost(t.Context(), host.ID)
+		require.NoError(t, err)
+
+		saved := &hdlctrlv1.HeadlessHostContainerSettings{}
+		require.NoError(t, protojson.Unmarshal(updatedHost.ContainerSettings, saved))
+		assert.InDelta(t, 2, saved.GetCpus(), 0)
+		assert.Equal(t, int64(4<<30), saved.GetMemoryBytes())
+		assert.Equal(t, hdlctrlv1.HeadlessHostRestartPolicy_HEADLESS_HOST_RESTART_POLICY_UNLESS_STOPPED, saved.GetRestartPolicy())
+	})
+
+	t.Run("失敗: 不正なコンテナ設定", func(t *testing.T) {
+		setup := setupControllerServiceTest(t)
+		defer setup.Cleanup()
+
+		client := setupAuthenticatedClient(t, setup.service)
+
+		testutil.CreateTestHeadlessAccount(t, setup.queries, "U-test-cs2", "test-cs2@example.test", "password")
+		host := testutil.CreateTestHeadlessHost(t, setup.queries, "U-test-cs2", "TestHost", entity.HeadlessHostStatus_EXITED)
+
+		req := testutil.CreateDefaultAuthenticatedRequest(t, &hdlctrlv1.UpdateHeadlessHostSettingsRequest{
+			HostId: host.ID,
+			ContainerSettings: &hdlctrlv1.HeadlessHostContainerSettings{
+				BindMounts: []*hdlctrlv1.HeadlessHostBindMount{{Source: "/", Target: "/host"}},
+			},
+		})
+
+		_, err := client.UpdateHeadlessHostSettings(t.Context(), req)
+		require.Error(t, err)
+
+		connectErr := &connect.Error{}
+		require.True(t, errors.As(err, &connectErr))
+		assert.Equal(t, connect.CodeInvalidArgument, connectErr.Code())
+	})
+
+	t.Run("成功: 自動再起動ポリシーを更新すると crash loop の停止が解除される", func(t *testing.T) {
+		setup := setupControllerServiceTest(t)
+		defer setup.Cleanup()
+
+		client := setupAuthenticatedClient(t, setup.service)
+
+		testutil.CreateTestHeadlessAccount(t, setup.queries, "U-test-ar", "test-ar@example.test", "password")
+		host := testutil.CreateTestHeadlessHost(t, setup.queries, "U-test-ar", "TestHost", entity.HeadlessHostStatus_CRASHED)
+		require.NoError(t, setup.queries.SuspendHostAutoRestart(t.Context(), host.ID))
+
+		req := testutil.CreateDefaultAuthenticatedRequest(t, &hdlctrlv1.UpdateHeadlessHostSettingsRequest{
+			HostId:                host.ID,
+			AutoRestartPolicy:     hdlctrlv1.HeadlessHostAutoRestartPolicy_HEADLESS_HOST_AUTO_RESTART_POLICY_ON_CRASH.Enum(),
+			AutoRestartMaxRetries: proto.Int32(3),
+		})
+
+		_, err := client.UpdateHeadlessHostSettings(t.Context(), req)
+		require.NoError(t, err)
+
+		updatedHost, err := setup.queries.GetHost(t.Context(), host.ID)
+		require.NoError(t, err)
+		assert.Equal(t, int32(entity.HostAutoRestartPolicy_ON_CRASH), updatedHost.AutoRestartPolicy)
+		assert.Equal(t, int32(3), updatedHost.AutoRestartMaxRetries)
+		assert.False(t, updatedHost.AutoRestartSuspended)
+	})
+
+	t.Run("失敗: 負の自動再起動上限回数", func(t *testing.T) {
+		setup := setupControllerServiceTest(t)
+		defer setup.Cleanup()
+
+		client := setupAuthenticatedClient(t, setup.service)
+
+		testutil.CreateTestHeadlessAccount(t, setup.queries, "U-test-ar2", "test-ar2@example.test", "password")
+		host := testutil.CreateTestHeadlessHost(t, setup.queries, "U-test-ar2", "TestHost", entity.HeadlessHostStatus_EXITED)
+
+		req := testutil.CreateDefaultAuthenticatedRequest(t, &hdlctrlv1.UpdateHeadlessHostSettingsRequest{
+			HostId:                host.ID,
+			AutoRestartMaxRetries: proto.Int32(-1),
+		})
+
+		_, err := client.UpdateHeadlessHostSettings(t.Context(), req)
+		require.Error(t, err)
+
+		connectErr := &connect.Error{}
+		require.True(t, errors.As(err, &connectErr))
+		assert.Equal(t, connect.CodeInvalidArgument, connectErr.Code())
+	})
+
 	t.Run("成功: 最小権限 caller (host:write) で実行", func(t *testing.T) {
 		setup := setupControllerServiceTest(t)
 		defer setup.Cleanup()
@@ -359,7 +465,7 @@ func TestControllerService_UpdateHeadlessHostSettings(t *testing.T) {
 		testutil.CreateTestHeadlessAccount(t, setup.queries, "U-test2", "test2@example.test", "password")
 		host := testutil.CreateTestHeadlessHost(t, setup.queries, "U-test2", "RunningHost", entity.HeadlessHostStatus_RUNNING)
 
-		// Mock RPC calls - GetRpcClient is called twice: once in dbToEntity, once before UpdateHostSettings
+		// Mock RPC calls - GetRpcClient is called twice: once in dbToEntity, once in HeadlessHostUpdateHostSettings
 		setup.mockHostConnector.EXPECT().
 			GetRpcClient(gomock.Any(), gomock.Any()).
 			Return(setup.mockRpcClient, nil).
@@ -1318,3 +1424,137 @@ func TestControllerService_DenyHostAccess(t *testing.T) {
 		assert.Equal(t, connect.CodeInternal, connectErr.Code())
 	})
 }
+
+func TestControllerService_SearchHeadlessHostLogs(t *testing.T) {
+	t.Run("成功: ホスト・インスタンスをまたいで検索し、まとめて返す", func(t *testing.T) {
+		setup := setupControllerServiceTest(t)
+		defer setup.Cleanup()
+
+		client := setupAuthenticatedClient(t, setup.service)
+
+		testutil.CreateTestHeadlessAccount(t, setup.queries, "U-test", "test@example.test", "password")
+		hostA := testutil.CreateTestHeadlessHost(t, setup.queries, "U-test", "HostA", entity.HeadlessHostStatus_EXITED)
+		hostB := testutil.CreateTestHeadlessHost(t, setup.queries, "U-test", "HostB", entity.HeadlessHostStatus_EXITED)
+
+		baseTime := time.Date(2024, 1, 15, 10, 30, 0, 0, time.UTC)
+		testutil.InsertTestContainerLog(t, setup.queries, hostA.ID, 1, baseTime, "stderr", "NullReferenceException: first")
+		testutil.InsertTestContainerLog(t, setup.queries, hostA.ID, 1, baseTime.Add(time.Second), "stdout", "unrelated")
+		testutil.InsertTestContainerLog(t, setup.queries, hostA.ID, 2, baseTime.Add(2*time.Second), "stdout", "nullreferenceexception: second")
+		testutil.InsertTestContainerLog(t, setup.queries, hostB.ID, 1, baseTime.Add(3*time.Second), "stdout", "NullReferenceException: third")
+		testutil.InsertTestContainerLog(t, setup.queries, hostA.ID, 1, baseTime.Add(4*time.Second), "stdout", "NullReferenceException: fourth")
+
+		req := testutil.CreateDefaultAuthenticatedRequest(t, &hdlctrlv1.SearchHeadlessHostLogsRequest{
+			Query: "NullReference",
+		})
+
+		res, err := client.SearchHeadlessHostLogs(t.Context(), req)
+		require.NoError(t, err)
+		assert.Zero(t, res.Msg.GetNextBeforeId())
+
+		groups := res.Msg.GetGroups()
+		require.Len(t, groups, 3)
+
+		// 一致が新しい順に並べて先に現れるものから.
+		assert.Equal(t, hostA.ID, groups[0].GetHostId())
+		assert.Equal(t, "HostA", groups[0].GetHostName())
+		assert.Equal(t, int32(1), groups[0].GetInstanceId())
+		require.Len(t, groups[0].GetLogs(), 2)
+		assert.Equal(t, "NullReferenceException: first", groups[0].GetLogs()[0].GetBody())
+		assert.Equal(t, "NullReferenceException: fourth", groups[0].GetLogs()[1].GetBody())
+		assert.Equal(t, hdlctrlv1.HeadlessHostLogLevel_HEADLESS_HOST_LOG_LEVEL_ERROR, groups[0].GetLogs()[0].GetLevel())
+
+		assert.Equal(t, hostB.ID, groups[1].GetHostId())
+		assert.Equal(t, hostA.ID, groups[2].GetHostId())
+		assert.Equal(t, int32(2), groups[2].GetInstanceId())
+	})
+
+	t.Run("成功: 期間とホストで絞り込み、ページングする", func(t *testing.T) {
+		setup := setupControllerServiceTest(t)
+		defer setup.Cleanup()
+
+		client := setupAuthenticatedClient(t, setup.service)
+
+		testutil.CreateTestHeadlessAccount(t, setup.queries, "U-test", "test@example.test", "password")
+		hostA := testutil.CreateTestHeadlessHost(t, setup.queries, "U-test", "HostA", entity.HeadlessHostStatus_EXITED)
+		hostB := testutil.CreateTestHeadlessHost(t, setup.queries, "U-test", "HostB", entity.HeadlessHostStatus_EXITED)
+
+		baseTime := time.Date(2024, 1, 15, 10, 30, 0, 0, time.UTC)
+		for i := range 4 {
+			testutil.InsertTestContainerLog(t, setup.queries, hostA.ID, 1, baseTime.Add(time.Duration(i)*time.Hour), "stdout", fmt.Sprintf("world saved %d", i))
+		}
+
+		testutil.InsertTestContainerLog(t, setup.queries, hostB.ID, 1, baseTime, "stdout", "world saved on B")
+
+		search := func(beforeID int64) *hdlctrlv1.SearchHeadlessHostLogsResponse {
+			req := testutil.CreateDefaultAuthenticatedRequest(t, &hdlctrlv1.SearchHeadlessHostLogsRequest{
+				Query:    "world saved",
+				HostIds:  []string{hostA.ID},
+				Since:    timestamppb.New(baseTime.Add(time.Hour)),
+				Until:    timestamppb.New(baseTime.Add(4 * time.Hour)),
+				Limit:    2,
+				BeforeId: beforeID,
+			})
+
+			res, err := client.SearchHeadlessHostLogs(t.Context(), req)
+			require.NoError(t, err)
+
+			return res.Msg
+		}
+
+		first := search(0)
+		require.Len(t, first.GetGroups(), 1)
+		require.Len(t, first.GetGroups()[0].GetLogs(), 2)
+		assert.Equal(t, "world saved 2", first.GetGroups()[0].GetLogs()[0].GetBody())
+		assert.Equal(t, "world saved 3", first.GetGroups()[0].GetLogs()[1].GetBody())
+		require.NotZero(t, first.GetNextBeforeId())
+
+		second := search(first.GetNextBeforeId())
+		require.Len(t, second.GetGroups(), 1)
+		require.Len(t, second.GetGroups()[0].GetLogs(), 1)
+		assert.Equal(t, "world saved 1", second.GetGroups()[0].GetLogs()[0].GetBody())
+		assert.Zero(t, second.GetNextBeforeId())
+	})
+
+	t.Run("成功: host:read を持たないグループのホストは含まれない", func(t *testing.T) {
+		setup := setupControllerServiceTest(t)
+		defer setup.Cleanup()
+
+		client := setupAuthenticatedClient(t, setup.service)
+
+		const groupID = "g-mp-searchlogs"
+		testutil.CreateTestHeadlessAccountInGroup(t, setup.queries, "U-mp-searchlogs-acc", "mp@example.test", "password", groupID)
+		readable := testutil.CreateTestHeadlessHostInGroup(t, setup.queries, "U-mp-searchlogs-acc", "Readable", entity.HeadlessHostStatus_EXITED, groupID)
+		other := testutil.CreateTestHeadlessHost(t, setup.queries, "U-mp-searchlogs-acc", "Other", entity.HeadlessHostStatus_EXITED)
+
+		baseTime := time.Date(2024, 1, 15, 10, 30, 0, 0, time.UTC)
+		testutil.InsertTestContainerLog(t, setup.queries, readable.ID, 1, baseTime, "stdout", "session started")
+		testutil.InsertTestContainerLog(t, setup.queries, other.ID, 1, baseTime, "stdout", "session started")
+
+		req := authAsMinPerm(t, setup.queries, &hdlctrlv1.SearchHeadlessHostLogsRequest{
+			Query: "session started",
+		}, "U-mp-searchlogs", groupID, []string{entity.PermKey_HostRead})
+
+		res, err := client.SearchHeadlessHostLogs(t.Context(), req)
+		require.NoError(t, err)
+		require.Len(t, res.Msg.GetGroups(), 1)
+		assert.Equal(t, readable.ID, res.Msg.GetGroups()[0].GetHostId())
+	})
+
+	t.Run("失敗: 短すぎる検索語は InvalidArgument", func(t *testing.T) {
+		setup := setupControllerServiceTest(t)
+		defer setup.Cleanup()
+
+		client := setupAuthenticatedClient(t, setup.service)
+
+		req := testutil.CreateDefaultAuthenticatedRequest(t, &hdlctrlv1.SearchHeadlessHostLogsRequest{
+			Query: "ab",
+		})
+
+		_, err := client.SearchHeadlessHostLogs(t.Context(), req)
+		require.Error(t, err)
+
+		connectErr := &connect.Error{}
+		require.ErrorAs(t, err, &connectErr)
+		assert.Equal(t, connect.CodeInvalidArgument, connectErr.Code())
+	})
+}
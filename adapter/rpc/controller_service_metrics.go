@@ -0,0 +1,91 @@
+package rpc
+
+import (
+	"context"
+	"errors"
+
+	"connectrpc.com/connect"
+	"github.com/hantabaru1014/baru-reso-headless-controller/domain/entity"
+	hdlctrlv1 "github.com/hantabaru1014/baru-reso-headless-controller/pbgen/hdlctrl/v1"
+	"github.com/hantabaru1014/baru-reso-headless-controller/pbgen/hdlctrl/v1/hdlctrlv1connect"
+	"github.com/hantabaru1014/baru-reso-headless-controller/usecase"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// GetMetricsSeries implements hdlctrlv1connect.ControllerServiceHandler.
+// 権限: ホストのメトリクスは host.group_id に対して host:read,
+// セッションのメトリクスは session.group_id に対して session:read.
+var _ = registerRPCPermission(
+	hdlctrlv1connect.ControllerServiceGetMetricsSeriesProcedure,
+	checkGetMetricsSeries,
+)
+
+func (c *ControllerService) GetMetricsSeries(ctx context.Context, req *connect.Request[hdlctrlv1.GetMetricsSeriesRequest]) (*connect.Response[hdlctrlv1.GetMetricsSeriesResponse], error) {
+	if req.Msg.Since == nil || req.Msg.Until == nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("since and until are required"))
+	}
+
+	params := usecase.MetricsSeriesParams{
+		Kind:     entity.MetricKind(req.Msg.GetKind()),
+		TargetID: req.Msg.GetTargetId(),
+		Since:    req.Msg.GetSince().AsTime(),
+		Until:    req.Msg.GetUntil().AsTime(),
+	}
+
+	if r := req.Msg.GetResolution(); r != hdlctrlv1.MetricResolution_METRIC_RESOLUTION_UNSPECIFIED {
+		resolution, ok := metricResolutionFromProto(r)
+		if !ok {
+			return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("unknown resolution"))
+		}
+
+		params.Resolution = &resolution
+	}
+
+	series, err := c.muc.GetSeries(ctx, params)
+	if err != nil {
+		return nil, convertErr(err)
+	}
+
+	points := make([]*hdlctrlv1.MetricPoint, 0, len(series.Samples))
+	for _, s := range series.Samples {
+		points = append(points, &hdlctrlv1.MetricPoint{
+			At:          timestamppb.New(s.BucketAt),
+			Avg:         s.Avg,
+			Min:         s.Min,
+			Max:         s.Max,
+			SampleCount: s.Count,
+		})
+	}
+
+	return connect.NewResponse(&hdlctrlv1.GetMetricsSeriesResponse{
+		Resolution: metricResolutionToProto(series.Resolution),
+		Points:     points,
+	}), nil
+}
+
+// proto の MetricResolution は 0 を「自動」に使っているので entity とは 1 ずれる.
+func metricResolutionFromProto(r hdlctrlv1.MetricResolution) (entity.MetricResolution, bool) {
+	switch r {
+	case hdlctrlv1.MetricResolution_METRIC_RESOLUTION_RAW:
+		return entity.MetricResolution_Raw, true
+	case hdlctrlv1.MetricResolution_METRIC_RESOLUTION_MINUTE:
+		return entity.MetricResolution_Minute, true
+	case hdlctrlv1.MetricResolution_METRIC_RESOLUTION_HOUR:
+		return entity.MetricResolution_Hour, true
+	default:
+		return 0, false
+	}
+}
+
+func metricResolutionToProto(r entity.MetricResolution) hdlctrlv1.MetricResolution {
+	switch r {
+	case entity.MetricResolution_Raw:
+		return hdlctrlv1.MetricResolution_METRIC_RESOLUTION_RAW
+	case entity.MetricResolution_Minute:
+		return hdlctrlv1.MetricResolution_METRIC_RESOLUTION_MINUTE
+	case entity.MetricResolution_Hour:
+		return hdlctrlv1.MetricResolution_METRIC_RESOLUTION_HOUR
+	default:
+		return hdlctrlv1.MetricResolution_METRIC_RESOLUTION_UNSPECIFIED
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"time"
 
 	"connectrpc.com/connect"
 	"github.com/hantabaru1014/baru-reso-headless-controller/adapter/converter"
@@ -233,6 +234,18 @@ func (c *ControllerService) StartWorld(ctx context.Context, req *connect.Request
 		req.Msg.GroupId = &hostGroupID
 	}
 
+	// テンプレート指定なら、この時点のテンプレートに上書きを重ねた parameters で job を積む.
+	if req.Msg.TemplateId != nil {
+		params, err := c.stuc.ResolveStartupParameters(ctx, req.Msg.GetTemplateId(), req.Msg.GetGroupId(), req.Msg.GetParameters(), req.Msg.GetOverrideFields())
+		if err != nil {
+			return nil, convertErr(err)
+		}
+
+		req.Msg.Parameters = params
+		req.Msg.TemplateId = nil
+		req.Msg.OverrideFields = nil
+	}
+
 	jobID, err := c.ajuc.EnqueueStartSession(ctx, req.Msg, &claims.UserID)
 	if err != nil {
 		return nil, convertErr(err)
@@ -305,6 +318,145 @@ func (c *ControllerService) IssueResoniteLinkConnection(ctx context.Context, req
 	return res, nil
 }
 
+// ListSessionUserEvents implements hdlctrlv1connect.ControllerServiceHandler.
+// 権限: session.group_id に対して session:read.
+var _ = registerRPCPermission(
+	hdlctrlv1connect.ControllerServiceListSessionUserEventsProcedure,
+	checkSessionPermission(entity.PermKey_SessionRead, sessionIDFromListUserEvents),
+)
+
+func (c *ControllerService) ListSessionUserEvents(ctx context.Context, req *connect.Request[hdlctrlv1.ListSessionUserEventsRequest]) (*connect.Response[hdlctrlv1.ListSessionUserEventsResponse], error) {
+	page, err := c.shuc.ListUserEvents(ctx, req.Msg.GetSessionId(), req.Msg.GetAfterId(), req.Msg.GetLimit())
+	if err != nil {
+		return nil, convertErr(err)
+	}
+
+	events := make([]*hdlctrlv1.SessionUserEvent, 0, len(page.Events))
+	for _, e := range page.Events {
+		events = append(events, &hdlctrlv1.SessionUserEvent{
+			Id:         e.ID,
+			Kind:       hdlctrlv1.SessionUserEventKind(e.Kind),
+			UserId:     e.UserID,
+			UserName:   e.UserName,
+			OccurredAt: timestamppb.New(e.OccurredAt),
+		})
+	}
+
+	return connect.NewResponse(&hdlctrlv1.ListSessionUserEventsResponse{
+		Events:      events,
+		NextAfterId: page.NextAfterID,
+	}), nil
+}
+
+// GetSessionAttendance implements hdlctrlv1connect.ControllerServiceHandler.
+// 権限: session.group_id に対して session:read.
+var _ = registerRPCPermission(
+	hdlctrlv1connect.ControllerServiceGetSessionAttendanceProcedure,
+	checkSessionPermission(entity.PermKey_SessionRead, sessionIDFromGetAttendance),
+)
+
+func (c *ControllerService) GetSessionAttendance(ctx context.Context, req *connect.Request[hdlctrlv1.GetSessionAttendanceRequest]) (*connect.Response[hdlctrlv1.GetSessionAttendanceResponse], error) {
+	attendance, err := c.shuc.GetAttendance(ctx, req.Msg.GetSessionId())
+	if err != nil {
+		return nil, convertErr(err)
+	}
+
+	attendees := make([]*hdlctrlv1.SessionAttendee, 0, len(attendance.Attendees))
+	for _, a := range attendance.Attendees {
+		attendee := &hdlctrlv1.SessionAttendee{
+			UserId:          a.UserID,
+			UserName:        a.UserName,
+			FirstJoinedAt:   timestamppb.New(a.FirstJoinedAt),
+			DurationSeconds: int64(a.Duration.Seconds()),
+			JoinCount:       a.JoinCount,
+			Present:         a.Present,
+		}
+		if a.LastLeftAt != nil {
+			attendee.LastLeftAt = timestamppb.New(*a.LastLeftAt)
+		}
+
+		attendees = append(attendees, attendee)
+	}
+
+	res := &hdlctrlv1.GetSessionAttendanceResponse{
+		Attendees:            attendees,
+		UniqueUsers:          attendance.UniqueUsers,
+		PeakUsers:            attendance.PeakUsers,
+		TotalDurationSeconds: int64(attendance.TotalDuration.Seconds()),
+	}
+	if attendance.PeakAt != nil {
+		res.PeakAt = timestamppb.New(*attendance.PeakAt)
+	}
+
+	return connect.NewResponse(res), nil
+}
+
+// GetSessionUsageStats implements hdlctrlv1connect.ControllerServiceHandler.
+// 権限: handler 側で resolveListGroupFilter により認可する (interceptor は通過のみ).
+var _ = registerRPCPermission(
+	hdlctrlv1connect.ControllerServiceGetSessionUsageStatsProcedure,
+	requireAuthOnly,
+)
+
+func (c *ControllerService) GetSessionUsageStats(ctx context.Context, req *connect.Request[hdlctrlv1.GetSessionUsageStatsRequest]) (*connect.Response[hdlctrlv1.GetSessionUsageStatsResponse], error) {
+	if req.Msg.Since == nil || req.Msg.Until == nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("since and until are required"))
+	}
+
+	params := usecase.SessionUsageParams{
+		HostID:   req.Msg.HostId,
+		Since:    req.Msg.GetSince().AsTime(),
+		Until:    req.Msg.GetUntil().AsTime(),
+		GroupBy:  usecase.SessionUsageGroupBy(req.Msg.GetGroupBy()),
+		Interval: usecase.SessionUsageInterval(req.Msg.GetInterval()),
+	}
+
+	if tz := req.Msg.GetTimeZone(); tz != "" {
+		loc, err := time.LoadLocation(tz)
+		if err != nil {
+			return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("invalid time_zone: %w", err))
+		}
+
+		params.Location = loc
+	}
+
+	groupIDs, err := c.resolveListGroupFilter(ctx, req.Msg.GetGroupId(), entity.PermKey_SessionRead)
+	if err != nil {
+		return nil, err
+	}
+
+	params.GroupIDs = groupIDs
+
+	result, err := c.shuc.GetUsageStats(ctx, params)
+	if err != nil {
+		return nil, convertErr(err)
+	}
+
+	series := make([]*hdlctrlv1.SessionUsageSeries, 0, len(result))
+	for _, s := range result {
+		buckets := make([]*hdlctrlv1.SessionUsageBucket, 0, len(s.Buckets))
+		for _, b := range s.Buckets {
+			buckets = append(buckets, &hdlctrlv1.SessionUsageBucket{
+				Start:               timestamppb.New(b.Start),
+				Sessions:            b.Sessions,
+				UniqueUsers:         b.UniqueUsers,
+				PeakUsers:           b.PeakUsers,
+				UserDurationSeconds: int64(b.UserDuration.Seconds()),
+			})
+		}
+
+		series = append(series, &hdlctrlv1.SessionUsageSeries{
+			Key:                 s.Key,
+			Label:               s.Label,
+			Buckets:             buckets,
+			UniqueUsers:         s.UniqueUsers,
+			UserDurationSeconds: int64(s.UserDuration.Seconds()),
+		})
+	}
+
+	return connect.NewResponse(&hdlctrlv1.GetSessionUsageStatsResponse{Series: series}), nil
+}
+
 // StopSession implements hdlctrlv1connect.ControllerServiceHandler.
 // container への StopSession RPC は時間がかかるため非同期 job 化する.
 // 権限: session.group_id に対して session:write.
@@ -414,7 +566,7 @@ var _ = registerRPCPermission(
 )
 
 func (c *ControllerService) UpdateSessionExtraSettings(ctx context.Context, req *connect.Request[hdlctrlv1.UpdateSessionExtraSettingsRequest]) (*connect.Response[hdlctrlv1.UpdateSessionExtraSettingsResponse], error) {
-	if err := c.suc.UpdateSessionExtraSettings(ctx, req.Msg.GetSessionId(), req.Msg.AutoUpgrade, req.Msg.Memo); err != nil { //nolint:protogetter // optional 3 値を保つため pointer field を直接渡す
+	if err := c.suc.UpdateSessionExtraSettings(ctx, req.Msg.GetSessionId(), req.Msg.AutoUpgrade, req.Msg.Memo, req.Msg.RestoreOnCrash); err != nil { //nolint:protogetter // optional 3 値を保つため pointer field を直接渡す
 		return nil, convertErr(err)
 	}
 
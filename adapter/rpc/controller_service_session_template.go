@@ -0,0 +1,122 @@
+package rpc
+
+import (
+	"context"
+	"errors"
+
+	"connectrpc.com/connect"
+	"github.com/hantabaru1014/baru-reso-headless-controller/domain/entity"
+	hdlctrlv1 "github.com/hantabaru1014/baru-reso-headless-controller/pbgen/hdlctrl/v1"
+	"github.com/hantabaru1014/baru-reso-headless-controller/pbgen/hdlctrl/v1/hdlctrlv1connect"
+	"github.com/hantabaru1014/baru-reso-headless-controller/usecase/port"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// テンプレート ID を取る RPC は handler 側 (usecase) でテンプレートの group_id に対して
+// 権限をチェックする (interceptor は通過のみ).
+// 権限: 一覧 / 取得は session:read, 作成 / 更新 / 削除は session:write.
+var (
+	_ = registerRPCPermission(
+		hdlctrlv1connect.ControllerServiceListSessionTemplatesProcedure,
+		checkGroupPermission(entity.PermKey_SessionRead, func(r *hdlctrlv1.ListSessionTemplatesRequest) string { return r.GetGroupId() }, false),
+	)
+	_ = registerRPCPermission(
+		hdlctrlv1connect.ControllerServiceGetSessionTemplateProcedure,
+		requireAuthOnly,
+	)
+	_ = registerRPCPermission(
+		hdlctrlv1connect.ControllerServiceCreateSessionTemplateProcedure,
+		checkGroupPermission(entity.PermKey_SessionWrite, func(r *hdlctrlv1.CreateSessionTemplateRequest) string { return r.GetGroupId() }, false),
+	)
+	_ = registerRPCPermission(
+		hdlctrlv1connect.ControllerServiceUpdateSessionTemplateProcedure,
+		requireAuthOnly,
+	)
+	_ = registerRPCPermission(
+		hdlctrlv1connect.ControllerServiceDeleteSessionTemplateProcedure,
+		requireAuthOnly,
+	)
+)
+
+// ListSessionTemplates implements hdlctrlv1connect.ControllerServiceHandler.
+func (c *ControllerService) ListSessionTemplates(ctx context.Context, req *connect.Request[hdlctrlv1.ListSessionTemplatesRequest]) (*connect.Response[hdlctrlv1.ListSessionTemplatesResponse], error) {
+	templates, err := c.stuc.ListTemplates(ctx, req.Msg.GetGroupId())
+	if err != nil {
+		return nil, convertErr(err)
+	}
+
+	protoList := make([]*hdlctrlv1.SessionTemplate, 0, len(templates))
+	for _, t := range templates {
+		protoList = append(protoList, sessionTemplateToProto(t))
+	}
+
+	return connect.NewResponse(&hdlctrlv1.ListSessionTemplatesResponse{Templates: protoList}), nil
+}
+
+// GetSessionTemplate implements hdlctrlv1connect.ControllerServiceHandler.
+func (c *ControllerService) GetSessionTemplate(ctx context.Context, req *connect.Request[hdlctrlv1.GetSessionTemplateRequest]) (*connect.Response[hdlctrlv1.GetSessionTemplateResponse], error) {
+	if req.Msg.GetTemplateId() == "" {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("template_id is required"))
+	}
+
+	tmpl, err := c.stuc.GetTemplate(ctx, req.Msg.GetTemplateId())
+	if err != nil {
+		return nil, convertErr(err)
+	}
+
+	return connect.NewResponse(&hdlctrlv1.GetSessionTemplateResponse{Template: sessionTemplateToProto(tmpl)}), nil
+}
+
+// CreateSessionTemplate implements hdlctrlv1connect.ControllerServiceHandler.
+func (c *ControllerService) CreateSessionTemplate(ctx context.Context, req *connect.Request[hdlctrlv1.CreateSessionTemplateRequest]) (*connect.Response[hdlctrlv1.CreateSessionTemplateResponse], error) {
+	tmpl, err := c.stuc.CreateTemplate(ctx, req.Msg.GetGroupId(), req.Msg.GetName(), req.Msg.GetDescription(), req.Msg.GetParameters())
+	if err != nil {
+		return nil, convertErr(err)
+	}
+
+	return connect.NewResponse(&hdlctrlv1.CreateSessionTemplateResponse{Template: sessionTemplateToProto(tmpl)}), nil
+}
+
+// UpdateSessionTemplate implements hdlctrlv1connect.ControllerServiceHandler.
+func (c *ControllerService) UpdateSessionTemplate(ctx context.Context, req *connect.Request[hdlctrlv1.UpdateSessionTemplateRequest]) (*connect.Response[hdlctrlv1.UpdateSessionTemplateResponse], error) {
+	if req.Msg.GetTemplateId() == "" {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("template_id is required"))
+	}
+
+	tmpl, err := c.stuc.UpdateTemplate(ctx, req.Msg.GetTemplateId(), port.SessionTemplateUpdateParams{
+		Name:        req.Msg.Name,
+		Description: req.Msg.Description,
+		Parameters:  req.Msg.GetParameters(),
+	})
+	if err != nil {
+		return nil, convertErr(err)
+	}
+
+	return connect.NewResponse(&hdlctrlv1.UpdateSessionTemplateResponse{Template: sessionTemplateToProto(tmpl)}), nil
+}
+
+// DeleteSessionTemplate implements hdlctrlv1connect.ControllerServiceHandler.
+func (c *ControllerService) DeleteSessionTemplate(ctx context.Context, req *connect.Request[hdlctrlv1.DeleteSessionTemplateRequest]) (*connect.Response[hdlctrlv1.DeleteSessionTemplateResponse], error) {
+	if req.Msg.GetTemplateId() == "" {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("template_id is required"))
+	}
+
+	if err := c.stuc.DeleteTemplate(ctx, req.Msg.GetTemplateId()); err != nil {
+		return nil, convertErr(err)
+	}
+
+	return connect.NewResponse(&hdlctrlv1.DeleteSessionTemplateResponse{}), nil
+}
+
+func sessionTemplateToProto(t *entity.SessionTemplate) *hdlctrlv1.SessionTemplate {
+	return &hdlctrlv1.SessionTemplate{
+		Id:          t.ID,
+		GroupId:     t.GroupID,
+		Name:        t.Name,
+		Description: t.Description,
+		Parameters:  t.Parameters,
+		CreatedBy:   t.CreatedBy,
+		CreatedAt:   timestamppb.New(t.CreatedAt),
+		UpdatedAt:   timestamppb.New(t.UpdatedAt),
+	}
+}
@@ -1450,6 +1450,52 @@ func TestControllerService_UpdateSessionExtraSettings(t *testing.T) {
 		assert.True(t, updatedSession.AutoUpgrade)
 	})
 
+	t.Run("成功: クラッシュ時の復元を有効化しても他の設定は保持される", func(t *testing.T) {
+		setup := setupControllerServiceTest(t)
+		defer setup.Cleanup()
+
+		client := setupAuthenticatedClient(t, setup.service)
+
+		testutil.CreateTestHeadlessAccount(t, setup.queries, "U-test", "test@example.test", "password")
+		host := testutil.CreateTestHeadlessHost(t, setup.queries, "U-test", "TestHost", entity.HeadlessHostStatus_RUNNING)
+		session := testutil.CreateTestSession(t, setup.queries, host.ID, "TestSession", entity.SessionStatus_RUNNING)
+
+		setup.mockHostConnector.EXPECT().
+			GetRpcClient(gomock.Any(), gomock.Any()).
+			Return(setup.mockRpcClient, nil).
+			AnyTimes()
+		setup.mockRpcClient.EXPECT().
+			GetSession(gomock.Any(), gomock.Any()).
+			Return(&headlessv1.GetSessionResponse{
+				Session: &headlessv1.Session{
+					Id:   session.ID,
+					Name: session.Name,
+				},
+			}, nil).
+			AnyTimes()
+
+		memo := "常設"
+		_, err := client.UpdateSessionExtraSettings(t.Context(), testutil.CreateDefaultAuthenticatedRequest(t, &hdlctrlv1.UpdateSessionExtraSettingsRequest{
+			SessionId: session.ID,
+			Memo:      &memo,
+		}))
+		require.NoError(t, err)
+
+		restoreOnCrash := true
+		_, err = client.UpdateSessionExtraSettings(t.Context(), testutil.CreateDefaultAuthenticatedRequest(t, &hdlctrlv1.UpdateSessionExtraSettingsRequest{
+			SessionId:      session.ID,
+			RestoreOnCrash: &restoreOnCrash,
+		}))
+		require.NoError(t, err)
+
+		res, err := client.GetSessionDetails(t.Context(), testutil.CreateDefaultAuthenticatedRequest(t, &hdlctrlv1.GetSessionDetailsRequest{
+			SessionId: session.ID,
+		}))
+		require.NoError(t, err)
+		assert.True(t, res.Msg.GetSession().GetRestoreOnCrash())
+		assert.Equal(t, memo, res.Msg.GetSession().GetMemo())
+	})
+
 	t.Run("成功: 最小権限 caller (session:write) で更新", func(t *testing.T) {
 		setup := setupControllerServiceTest(t)
 		defer setup.Cleanup()
@@ -5,6 +5,7 @@ import (
 
 	"connectrpc.com/connect"
 	"github.com/hantabaru1014/baru-reso-headless-controller/adapter"
+	"github.com/hantabaru1014/baru-reso-headless-controller/adapter/hostconnector"
 	hostconnectormock "github.com/hantabaru1014/baru-reso-headless-controller/adapter/hostconnector/mock"
 	"github.com/hantabaru1014/baru-reso-headless-controller/adapter/sessionstate"
 	"github.com/hantabaru1014/baru-reso-headless-controller/config"
@@ -70,7 +71,7 @@ func setupControllerServiceTest(t *testing.T) *controllerServiceTestSetup {
 
 	// Setup repositories with real implementations
 	srepo := adapter.NewSessionRepository(queries)
-	hhrepo := adapter.NewHeadlessHostRepository(queries, mockHostConnector, &cfg.GRPC)
+	hhrepo := adapter.NewHeadlessHostRepository(queries, hostconnector.Connectors{port.HostConnectorType_DOCKER: mockHostConnector}, &cfg.GRPC, adapter.NewContainerLogArchive(queries, mockBlobstore))
 	stateCache := sessionstate.NewMemoryCache()
 	groupRepo := adapter.NewGroupRepository(queries)
 	roleRepo := adapter.NewRoleRepository(queries)
@@ -80,17 +81,39 @@ func setupControllerServiceTest(t *testing.T) *controllerServiceTestSetup {
 	permUC := usecase.NewPermissionUsecase(groupRepo, memberRepo, roleRepo)
 
 	// Setup usecases with real repositories
-	hauc := usecase.NewHeadlessAccountUsecase(queries, mockSkyfrost, permUC)
+	hauc := usecase.NewHeadlessAccountUsecase(queries, mockSkyfrost, permUC, testutil.NewTestCredentialCipher())
 	suc := usecase.NewSessionUsecase(srepo, hhrepo, port.NoopHostDrainer{}, stateCache, &cfg.Server, &cfg.ResoniteLink, permUC)
-	hhuc := usecase.NewHeadlessHostUsecase(hhrepo, srepo, suc, hauc, permUC)
+	hhuc := usecase.NewHeadlessHostUsecase(hhrepo, srepo, suc, hauc, permUC, &cfg.Connector)
 	buc := usecase.NewBlobUsecase(srepo, hhrepo, mockBlobstore)
 	sorepo := adapter.NewScheduledSessionOperationRepository(queries)
 	souc := usecase.NewScheduledSessionOperationUsecase(sorepo, hhrepo, srepo, permUC)
 	ajrepo := adapter.NewAsyncJobRepository(queries)
 	ajuc := async_job.NewUsecase(ajrepo)
 
+	hluc := usecase.NewHostLogUsecase(hhrepo, adapter.NewContainerLogFeed(queries), permUC)
+
 	// Setup service with real repositories
-	service := NewControllerService(hhrepo, srepo, hhuc, hauc, suc, buc, souc, ajuc, permUC, groupRepo, roleRepo, mockSkyfrost, notification.NewBus())
+	service := NewControllerService(
+		hhrepo,
+		srepo,
+		hhuc,
+		hluc,
+		hauc,
+		suc,
+		usecase.NewSessionHistoryUsecase(srepo, adapter.NewSessionUserEventRepository(queries), hhrepo),
+		usecase.NewMetricsUsecase(adapter.NewMetricSampleRepository(queries), &cfg.Worker),
+		usecase.NewSessionTemplateUsecase(adapter.NewSessionTemplateRepository(queries), permUC),
+		usecase.NewHostTemplateUsecase(adapter.NewHostTemplateRepository(queries), permUC, hhuc),
+		buc,
+		souc,
+		ajuc,
+		permUC,
+		newAuditUsecaseForTest(queries),
+		groupRepo,
+		roleRepo,
+		mockSkyfrost,
+		notification.NewBus(),
+	)
 
 	return &controllerServiceTestSetup{
 		service:           service,
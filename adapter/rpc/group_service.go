@@ -21,6 +21,7 @@ var _ hdlctrlv1connect.GroupServiceHandler = (*GroupService)(nil)
 type GroupService struct {
 	guc       *usecase.GroupUsecase
 	permUC    *usecase.PermissionUsecase
+	auditUC   *usecase.AuditUsecase
 	groupRepo port.GroupRepository
 	roleRepo  port.RoleRepository
 
@@ -33,6 +34,7 @@ type GroupService struct {
 func NewGroupService(
 	guc *usecase.GroupUsecase,
 	permUC *usecase.PermissionUsecase,
+	auditUC *usecase.AuditUsecase,
 	groupRepo port.GroupRepository,
 	roleRepo port.RoleRepository,
 	hostRepo port.HeadlessHostRepository,
@@ -42,6 +44,7 @@ func NewGroupService(
 	return &GroupService{
 		guc:         guc,
 		permUC:      permUC,
+		auditUC:     auditUC,
 		groupRepo:   groupRepo,
 		roleRepo:    roleRepo,
 		hostRepo:    hostRepo,
@@ -54,6 +57,7 @@ func (s *GroupService) NewHandler() (string, http.Handler) {
 	interceptors := connect.WithInterceptors(
 		logging.NewErrorLogInterceptor(),
 		auth.NewAuthInterceptor(),
+		NewAuditInterceptor(s.auditUC),
 		NewPermissionInterceptor(s.permUC, PermissionDeps{
 			HostRepo:    s.hostRepo,
 			SessionRepo: s.sessionRepo,
@@ -134,7 +138,7 @@ var _ = registerRPCPermission(
 )
 
 func (s *GroupService) UpdateGroup(ctx context.Context, req *connect.Request[hdlctrlv1.UpdateGroupRequest]) (*connect.Response[hdlctrlv1.UpdateGroupResponse], error) {
-	if req.Msg.Name == nil {
+	if req.Msg.Name == nil && req.Msg.LogRetentionDays == nil {
 		// nothing to update
 		g, err := s.guc.GetGroup(ctx, req.Msg.GetGroupId())
 		if err != nil {
@@ -144,13 +148,33 @@ func (s *GroupService) UpdateGroup(ctx context.Context, req *connect.Request[hdl
 		return connect.NewResponse(&hdlctrlv1.UpdateGroupResponse{Group: groupToProto(g)}), nil
 	}
 
-	g, err := s.guc.UpdateGroupName(ctx, req.Msg.GetGroupId(), req.Msg.GetName())
-	if err != nil {
-		if errors.Is(err, usecase.ErrGroupOperationForbidden) {
-			return nil, connect.NewError(connect.CodeFailedPrecondition, err)
+	var (
+		g   *entity.Group
+		err error
+	)
+
+	if req.Msg.Name != nil {
+		g, err = s.guc.UpdateGroupName(ctx, req.Msg.GetGroupId(), req.Msg.GetName())
+		if err != nil {
+			if errors.Is(err, usecase.ErrGroupOperationForbidden) {
+				return nil, connect.NewError(connect.CodeFailedPrecondition, err)
+			}
+
+			return nil, convertErr(err)
 		}
+	}
 
-		return nil, convertErr(err)
+	if req.Msg.LogRetentionDays != nil {
+		// 0 は設定の解除
+		var days *int32
+		if req.Msg.GetLogRetentionDays() != 0 {
+			days = req.Msg.LogRetentionDays
+		}
+
+		g, err = s.guc.UpdateGroupLogRetention(ctx, req.Msg.GetGroupId(), days)
+		if err != nil {
+			return nil, convertErr(err)
+		}
 	}
 
 	return connect.NewResponse(&hdlctrlv1.UpdateGroupResponse{Group: groupToProto(g)}), nil
@@ -259,9 +283,10 @@ func (s *GroupService) UpdateGroupMemberRole(ctx context.Context, req *connect.R
 
 func groupToProto(g *entity.Group) *hdlctrlv1.Group {
 	p := &hdlctrlv1.Group{
-		Id:   g.ID,
-		Name: g.Name,
-		Type: groupTypeToProto(g.Type),
+		Id:               g.ID,
+		Name:             g.Name,
+		Type:             groupTypeToProto(g.Type),
+		LogRetentionDays: g.LogRetentionDays,
 	}
 
 	if !g.CreatedAt.IsZero() {
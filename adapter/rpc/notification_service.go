@@ -7,7 +7,6 @@ import (
 	"time"
 
 	"connectrpc.com/connect"
-	"github.com/hantabaru1014/baru-reso-headless-controller/domain/entity"
 	"github.com/hantabaru1014/baru-reso-headless-controller/lib/auth"
 	"github.com/hantabaru1014/baru-reso-headless-controller/lib/logging"
 	hdlctrlv1 "github.com/hantabaru1014/baru-reso-headless-controller/pbgen/hdlctrl/v1"
@@ -29,13 +28,19 @@ const keepAliveInterval = 30 * time.Second
 const notificationPermCacheTTL = 30 * time.Second
 
 type NotificationService struct {
-	bus      notification.Bus
-	hostRepo port.HeadlessHostRepository
-	permUC   *usecase.PermissionUsecase
+	bus            notification.Bus
+	hostRepo       port.HeadlessHostRepository
+	permUC         *usecase.PermissionUsecase
+	notificationUC *usecase.NotificationUsecase
 }
 
-func NewNotificationService(bus notification.Bus, hostRepo port.HeadlessHostRepository, permUC *usecase.PermissionUsecase) *NotificationService {
-	return &NotificationService{bus: bus, hostRepo: hostRepo, permUC: permUC}
+func NewNotificationService(
+	bus notification.Bus,
+	hostRepo port.HeadlessHostRepository,
+	permUC *usecase.PermissionUsecase,
+	notificationUC *usecase.NotificationUsecase,
+) *NotificationService {
+	return &NotificationService{bus: bus, hostRepo: hostRepo, permUC: permUC, notificationUC: notificationUC}
 }
 
 func (s *NotificationService) NewHandler() (string, http.Handler) {
@@ -44,6 +49,7 @@ func (s *NotificationService) NewHandler() (string, http.Handler) {
 		connect.WithInterceptors(
 			logging.NewErrorLogInterceptor(),
 			auth.NewAuthInterceptor(),
+			NewPermissionInterceptor(s.permUC, PermissionDeps{}),
 		),
 	)
 }
@@ -52,11 +58,12 @@ func (s *NotificationService) NewHandler() (string, http.Handler) {
 // を server-streaming で push する. bus は全 subscriber へブロードキャスト
 // するため、送信前にイベントの host が属するグループへの閲覧権限で絞り込む
 // (グループ分離: 他グループのホスト/セッション活動を漏らさない).
+// last_event_id が指定されていれば、それ以降の履歴を同じフィルタに通して先に再送する.
 // 30 秒ごとに KeepAlive を送って proxy のアイドル切断を防ぐ.
 // context.Done() か stream.Send 失敗で抜けて bus subscriber を解放する.
 func (s *NotificationService) SubscribeNotifications(
 	ctx context.Context,
-	_ *connect.Request[hdlctrlv1.SubscribeNotificationsRequest],
+	req *connect.Request[hdlctrlv1.SubscribeNotificationsRequest],
 	stream *connect.ServerStream[hdlctrlv1.NotificationEvent],
 ) error {
 	claims, err := auth.GetAuthClaimsFromContext(ctx)
@@ -64,14 +71,24 @@ func (s *NotificationService) SubscribeNotifications(
 		return connect.NewError(connect.CodeUnauthenticated, err)
 	}
 
+	// 履歴を読む前に subscribe しておき、その間に publish されたイベントを取りこぼさない.
 	ch, cancel := s.bus.Subscribe(ctx, claims.UserID)
 	defer cancel()
 
+	permCache := map[string]notificationPermCacheEntry{}
+
+	var replayed map[string]struct{}
+
+	if req.Msg.LastEventId != nil {
+		replayed, err = s.replayMissedEvents(ctx, claims.UserID, req.Msg.GetLastEventId(), stream, permCache)
+		if err != nil {
+			return err
+		}
+	}
+
 	ticker := time.NewTicker(keepAliveInterval)
 	defer ticker.Stop()
 
-	permCache := map[string]notificationPermCacheEntry{}
-
 	for {
 		select {
 		case <-ctx.Done():
@@ -87,6 +104,11 @@ func (s *NotificationService) SubscribeNotifications(
 				return nil
 			}
 
+			// subscribe と履歴の読み出しの間に publish されたものは両方から届く.
+			if _, dup := replayed[ev.GetId()]; dup && ev.GetId() != "" {
+				continue
+			}
+
 			if !s.canReceiveEvent(ctx, claims.UserID, ev, permCache) {
 				continue
 			}
@@ -98,6 +120,97 @@ func (s *NotificationService) SubscribeNotifications(
 	}
 }
 
+// replayMissedEvents は lastEventID より後の履歴を送り、送ったイベントの id を返す.
+func (s *NotificationService) replayMissedEvents(
+	ctx context.Context,
+	userID, lastEventID string,
+	stream *connect.ServerStream[hdlctrlv1.NotificationEvent],
+	permCache map[string]notificationPermCacheEntry,
+) (map[string]struct{}, error) {
+	events, truncated, err := s.notificationUC.ListMissedEvents(ctx, userID, lastEventID)
+	if err != nil {
+		return nil, convertErr(err)
+	}
+
+	if truncated {
+		if err := stream.Send(notification.HistoryTruncated()); err != nil {
+			return nil, err
+		}
+	}
+
+	replayed := make(map[string]struct{}, len(events))
+
+	for _, ev := range events {
+		replayed[ev.GetId()] = struct{}{}
+
+		if !s.canReceiveEvent(ctx, userID, ev, permCache) {
+			continue
+		}
+
+		if err := stream.Send(ev); err != nil {
+			return nil, err
+		}
+	}
+
+	return replayed, nil
+}
+
+var _ = registerRPCPermission(hdlctrlv1connect.NotificationServiceListNotificationsProcedure, requireAuthenticated)
+
+// ListNotifications は呼び出し user の通知 inbox を返す. 閲覧範囲は stream の配信フィルタと同じ.
+func (s *NotificationService) ListNotifications(
+	ctx context.Context,
+	req *connect.Request[hdlctrlv1.ListNotificationsRequest],
+) (*connect.Response[hdlctrlv1.ListNotificationsResponse], error) {
+	pageIndex, pageSize, err := normalizePageRequest(req.Msg.GetPage())
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := s.notificationUC.ListNotifications(ctx, req.Msg.GetUnreadOnly(), pageIndex, pageSize)
+	if err != nil {
+		return nil, convertErr(err)
+	}
+
+	items := make([]*hdlctrlv1.NotificationInboxItem, 0, len(result.Items))
+	for _, item := range result.Items {
+		items = append(items, &hdlctrlv1.NotificationInboxItem{
+			Event: item.Record.Event,
+			Read:  item.Read,
+		})
+	}
+
+	return connect.NewResponse(&hdlctrlv1.ListNotificationsResponse{
+		Items: items,
+		Page: &hdlctrlv1.PageResponse{
+			TotalCount: result.TotalCount,
+			PageIndex:  pageIndex,
+			PageSize:   pageSize,
+		},
+		UnreadCount: result.UnreadCount,
+	}), nil
+}
+
+var _ = registerRPCPermission(hdlctrlv1connect.NotificationServiceMarkNotificationsReadProcedure, requireAuthenticated)
+
+func (s *NotificationService) MarkNotificationsRead(
+	ctx context.Context,
+	req *connect.Request[hdlctrlv1.MarkNotificationsReadRequest],
+) (*connect.Response[hdlctrlv1.MarkNotificationsReadResponse], error) {
+	var err error
+	if req.Msg.GetAll() {
+		err = s.notificationUC.MarkAllNotificationsRead(ctx)
+	} else {
+		err = s.notificationUC.MarkNotificationsRead(ctx, req.Msg.GetEventIds())
+	}
+
+	if err != nil {
+		return nil, convertErr(err)
+	}
+
+	return connect.NewResponse(&hdlctrlv1.MarkNotificationsReadResponse{}), nil
+}
+
 type notificationPermCacheEntry struct {
 	allowed   bool
 	expiresAt time.Time
@@ -156,33 +269,13 @@ func (s *NotificationService) canReceiveEvent(
 }
 
 // notificationEventScope はイベントの認可単位 (host_id, 受信に必要な permission key
-// 群 (OR), 認可要否) を返す.
-//
-// 認可を要さない (gated=false) のは、リソース識別子を含まない KeepAlive /
-// HostListChanged と、PublishTo で宛先を絞って配信される JobCompleted のみを
-// 明示列挙する. それ以外の未知 payload は fail-closed (gated=true, host_id 空 =
-// 配信不可) とし、将来 host/session scoped イベントを追加した際に本 switch の
-// 更新を忘れても全ユーザーへ漏れないようにする.
+// 群 (OR), 認可要否) を返す. 分類は notification.EventScope に従う (未知 payload は
+// fail-closed).
 //
 // permission key は write-implies-read: host:write / session:write 保持者も
 // 対応する read イベントを受信できる (usecase 層 requireHostRead と揃える).
 func notificationEventScope(ev *hdlctrlv1.NotificationEvent) (string, []string, bool) {
-	switch p := ev.GetPayload().(type) {
-	case *hdlctrlv1.NotificationEvent_HostUpdated:
-		return p.HostUpdated.GetHostId(), []string{entity.PermKey_HostRead, entity.PermKey_HostWrite}, true
-	case *hdlctrlv1.NotificationEvent_SessionUpdated:
-		return p.SessionUpdated.GetHostId(), []string{entity.PermKey_SessionRead, entity.PermKey_SessionWrite}, true
-	case *hdlctrlv1.NotificationEvent_SessionUserChanged:
-		return p.SessionUserChanged.GetHostId(), []string{entity.PermKey_SessionRead, entity.PermKey_SessionWrite}, true
-	case *hdlctrlv1.NotificationEvent_SessionLifecycle:
-		return p.SessionLifecycle.GetHostId(), []string{entity.PermKey_SessionRead, entity.PermKey_SessionWrite}, true
-	case *hdlctrlv1.NotificationEvent_KeepAlive,
-		*hdlctrlv1.NotificationEvent_HostListChanged,
-		*hdlctrlv1.NotificationEvent_JobCompleted:
-		// broadcast-safe (識別子を持たない / PublishTo で宛先限定済み).
-		return "", nil, false
-	default:
-		// 未知 payload は fail-closed.
-		return "", nil, true
-	}
+	hostID, scope, gated := notification.EventScope(ev)
+
+	return hostID, scope.PermKeys(), gated
 }
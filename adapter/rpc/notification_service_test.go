@@ -0,0 +1,220 @@
+package rpc
+
+import (
+	"context"
+	"testing"
+
+	"connectrpc.com/connect"
+	"github.com/hantabaru1014/baru-reso-headless-controller/adapter"
+	"github.com/hantabaru1014/baru-reso-headless-controller/adapter/hostconnector"
+	"github.com/hantabaru1014/baru-reso-headless-controller/config"
+	"github.com/hantabaru1014/baru-reso-headless-controller/db"
+	"github.com/hantabaru1014/baru-reso-headless-controller/domain/entity"
+	"github.com/hantabaru1014/baru-reso-headless-controller/lib/auth"
+	blobstoremock "github.com/hantabaru1014/baru-reso-headless-controller/lib/blobstore/mock"
+	hdlctrlv1 "github.com/hantabaru1014/baru-reso-headless-controller/pbgen/hdlctrl/v1"
+	"github.com/hantabaru1014/baru-reso-headless-controller/pbgen/hdlctrl/v1/hdlctrlv1connect"
+	"github.com/hantabaru1014/baru-reso-headless-controller/testutil"
+	"github.com/hantabaru1014/baru-reso-headless-controller/usecase"
+	"github.com/hantabaru1014/baru-reso-headless-controller/usecase/notification"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+type notificationServiceTestSetup struct {
+	service *NotificationService
+	bus     *notification.PersistentBus
+	repo    *adapter.NotificationRepository
+	queries *db.Queries
+	client  hdlctrlv1connect.NotificationServiceClient
+	hostA   db.Host
+	hostB   db.Host
+}
+
+// setupNotificationServiceTest は 2 グループ (g-notif-a / g-notif-b) にホストを 1 台ずつ作る.
+// alice は g-notif-a で host:read のみ, bob は g-notif-b で session:read のみを持つ.
+func setupNotificationServiceTest(t *testing.T) *notificationServiceTestSetup {
+	t.Helper()
+
+	auth.Init("test-jwt-secret-for-testing")
+
+	queries, pool := testutil.SetupTestDB(t)
+	testutil.CleanupTables(t, pool)
+
+	testutil.SetupUserWithExactPermissions(t, queries, "alice", "g-notif-a", []string{entity.PermKey_HostRead})
+	testutil.SetupUserWithExactPermissions(t, queries, "bob", "g-notif-b", []string{entity.PermKey_SessionRead})
+
+	testutil.CreateTestHeadlessAccount(t, queries, "U-notif-acc", "notif@example.test", "p")
+	hostA := testutil.CreateTestHeadlessHostInGroup(t, queries, "U-notif-acc", "host-a", entity.HeadlessHostStatus_RUNNING, "g-notif-a")
+	hostB := testutil.CreateTestHeadlessHostInGroup(t, queries, "U-notif-acc", "host-b", entity.HeadlessHostStatus_RUNNING, "g-notif-b")
+
+	permUC := usecase.NewPermissionUsecase(
+		adapter.NewGroupRepository(queries),
+		adapter.NewGroupMemberRepository(queries),
+		adapter.NewRoleRepository(queries),
+	)
+	hostRepo := adapter.NewHeadlessHostRepository(queries, hostconnector.Connectors{}, &config.GRPCConfig{}, adapter.NewContainerLogArchive(queries, blobstoremock.NewMockClient(gomock.NewController(t))))
+	repo := adapter.NewNotificationRepository(queries)
+	bus := notification.NewPersistentBus(repo, notification.NewBus())
+	service := NewNotificationService(bus, hostRepo, permUC, usecase.NewNotificationUsecase(repo, permUC))
+
+	server := testutil.SetupAuthenticatedHTTPServer(t, service)
+	t.Cleanup(server.Close)
+
+	return &notificationServiceTestSetup{
+		service: service,
+		bus:     bus,
+		repo:    repo,
+		queries: queries,
+		client:  hdlctrlv1connect.NewNotificationServiceClient(server.Client(), server.URL),
+		hostA:   hostA,
+		hostB:   hostB,
+	}
+}
+
+// publishSampleEvents は両グループの host / session イベントと、bob 宛ての job 完了を publish する.
+// host-a の HostUpdated だけは inbox 対象外.
+func (s *notificationServiceTestSetup) publishSampleEvents() {
+	s.bus.Publish(notification.HostAutoRestart(s.hostA.ID, hdlctrlv1.HostAutoRestartEvent_KIND_RESTARTED, 1, "restarted A", hdlctrlv1.JobCompletedEvent_LEVEL_SUCCESS))
+	s.bus.Publish(notification.SessionLifecycle("S-a", s.hostA.ID, "", hdlctrlv1.SessionLifecycleEvent_KIND_STARTED, nil))
+	s.bus.Publish(notification.HostUpdated(s.hostA.ID, "", nil))
+	s.bus.Publish(notification.HostAutoRestart(s.hostB.ID, hdlctrlv1.HostAutoRestartEvent_KIND_RESTARTED, 1, "restarted B", hdlctrlv1.JobCompletedEvent_LEVEL_SUCCESS))
+	s.bus.Publish(notification.SessionLifecycle("S-b", s.hostB.ID, "", hdlctrlv1.SessionLifecycleEvent_KIND_STARTED, nil))
+	s.bus.PublishTo("bob", notification.JobCompleted("job-1", "done", hdlctrlv1.JobCompletedEvent_LEVEL_SUCCESS))
+}
+
+func listNotificationsAs(t *testing.T, s *notificationServiceTestSetup, userID string, unreadOnly bool) *hdlctrlv1.ListNotificationsResponse {
+	t.Helper()
+
+	req := testutil.CreateAuthenticatedRequest(t, &hdlctrlv1.ListNotificationsRequest{UnreadOnly: unreadOnly}, userID, "U-"+userID, "")
+	res, err := s.client.ListNotifications(t.Context(), req)
+	require.NoError(t, err)
+
+	return res.Msg
+}
+
+func TestNotificationService_ListNotifications(t *testing.T) {
+	t.Run("成功: 閲覧権限のあるグループのイベントと自分宛てのイベントだけが見える", func(t *testing.T) {
+		s := setupNotificationServiceTest(t)
+		s.publishSampleEvents()
+
+		alice := listNotificationsAs(t, s, "alice", false)
+		require.Len(t, alice.GetItems(), 1)
+		assert.Equal(t, "restarted A", alice.GetItems()[0].GetEvent().GetHostAutoRestart().GetMessage())
+		assert.EqualValues(t, 1, alice.GetUnreadCount())
+
+		bob := listNotificationsAs(t, s, "bob", false)
+		require.Len(t, bob.GetItems(), 2)
+		// 新しい順
+		assert.Equal(t, "job-1", bob.GetItems()[0].GetEvent().GetJobCompleted().GetJobId())
+		assert.Equal(t, "S-b", bob.GetItems()[1].GetEvent().GetSessionLifecycle().GetSessionId())
+		assert.EqualValues(t, 2, bob.GetPage().GetTotalCount())
+		assert.EqualValues(t, 2, bob.GetUnreadCount())
+
+		for _, item := range bob.GetItems() {
+			assert.NotEmpty(t, item.GetEvent().GetId())
+			assert.False(t, item.GetRead())
+		}
+	})
+
+	t.Run("成功: 既読にすると unread_only から外れる", func(t *testing.T) {
+		s := setupNotificationServiceTest(t)
+		s.publishSampleEvents()
+
+		bob := listNotificationsAs(t, s, "bob", false)
+		require.Len(t, bob.GetItems(), 2)
+
+		markReq := testutil.CreateAuthenticatedRequest(t, &hdlctrlv1.MarkNotificationsReadRequest{
+			EventIds: []string{bob.GetItems()[0].GetEvent().GetId()},
+		}, "bob", "U-bob", "")
+		_, err := s.client.MarkNotificationsRead(t.Context(), markReq)
+		require.NoError(t, err)
+
+		unread := listNotificationsAs(t, s, "bob", true)
+		require.Len(t, unread.GetItems(), 1)
+		assert.Equal(t, "S-b", unread.GetItems()[0].GetEvent().GetSessionLifecycle().GetSessionId())
+		assert.EqualValues(t, 1, unread.GetUnreadCount())
+
+		// 他人の既読状態には影響しない
+		s.bus.PublishTo("alice", notification.JobCompleted("job-2", "done", hdlctrlv1.JobCompletedEvent_LEVEL_SUCCESS))
+
+		markAllReq := testutil.CreateAuthenticatedRequest(t, &hdlctrlv1.MarkNotificationsReadRequest{All: true}, "bob", "U-bob", "")
+		_, err = s.client.MarkNotificationsRead(t.Context(), markAllReq)
+		require.NoError(t, err)
+
+		assert.EqualValues(t, 0, listNotificationsAs(t, s, "bob", false).GetUnreadCount())
+		assert.EqualValues(t, 2, listNotificationsAs(t, s, "alice", false).GetUnreadCount())
+	})
+
+	t.Run("失敗: 不正な event id", func(t *testing.T) {
+		s := setupNotificationServiceTest(t)
+
+		req := testutil.CreateAuthenticatedRequest(t, &hdlctrlv1.MarkNotificationsReadRequest{EventIds: []string{"abc"}}, "bob", "U-bob", "")
+		_, err := s.client.MarkNotificationsRead(t.Context(), req)
+		require.Error(t, err)
+		assert.Equal(t, connect.CodeInvalidArgument, connect.CodeOf(err))
+	})
+}
+
+// receiveN は stream から n 件受け取る.
+func receiveN(t *testing.T, stream *connect.ServerStreamForClient[hdlctrlv1.NotificationEvent], n int) []*hdlctrlv1.NotificationEvent {
+	t.Helper()
+
+	events := make([]*hdlctrlv1.NotificationEvent, 0, n)
+	for len(events) < n {
+		require.True(t, stream.Receive(), "stream closed: %v", stream.Err())
+		events = append(events, stream.Msg())
+	}
+
+	return events
+}
+
+func TestNotificationService_SubscribeNotificationsReplay(t *testing.T) {
+	t.Run("成功: last_event_id 以降を権限で絞って再送し、その後は live で届く", func(t *testing.T) {
+		s := setupNotificationServiceTest(t)
+		s.publishSampleEvents()
+
+		ctx, cancel := context.WithCancel(t.Context())
+		defer cancel()
+
+		req := testutil.CreateAuthenticatedRequest(t, &hdlctrlv1.SubscribeNotificationsRequest{LastEventId: strPtr("0")}, "bob", "U-bob", "")
+		stream, err := s.client.SubscribeNotifications(ctx, req)
+		require.NoError(t, err)
+
+		defer stream.Close()
+
+		// bob は host:read を持たないので host-b の AutoRestart は届かず、g-notif-a のイベントも届かない.
+		replayed := receiveN(t, stream, 2)
+		assert.Equal(t, "S-b", replayed[0].GetSessionLifecycle().GetSessionId())
+		assert.Equal(t, "job-1", replayed[1].GetJobCompleted().GetJobId())
+
+		s.bus.Publish(notification.SessionLifecycle("S-b2", s.hostB.ID, "", hdlctrlv1.SessionLifecycleEvent_KIND_STARTED, nil))
+
+		live := receiveN(t, stream, 1)
+		assert.Equal(t, "S-b2", live[0].GetSessionLifecycle().GetSessionId())
+	})
+
+	t.Run("成功: 履歴が削除されていれば HistoryTruncated を先に送る", func(t *testing.T) {
+		s := setupNotificationServiceTest(t)
+		s.publishSampleEvents()
+
+		_, err := s.repo.DeleteBeyondLatest(t.Context(), 1)
+		require.NoError(t, err)
+
+		ctx, cancel := context.WithCancel(t.Context())
+		defer cancel()
+
+		req := testutil.CreateAuthenticatedRequest(t, &hdlctrlv1.SubscribeNotificationsRequest{LastEventId: strPtr("0")}, "bob", "U-bob", "")
+		stream, err := s.client.SubscribeNotifications(ctx, req)
+		require.NoError(t, err)
+
+		defer stream.Close()
+
+		events := receiveN(t, stream, 2)
+		assert.NotNil(t, events[0].GetHistoryTruncated())
+		assert.Equal(t, "job-1", events[1].GetJobCompleted().GetJobId())
+	})
+}
+
+func strPtr(s string) *string { return &s }
@@ -14,6 +14,12 @@ func hostIDFromGetLogs(r *hdlctrlv1.GetHeadlessHostLogsRequest) string {
 func hostIDFromListInstances(r *hdlctrlv1.ListHeadlessHostInstancesRequest) string {
 	return r.GetHostId()
 }
+func hostIDFromPrepareLogDownload(r *hdlctrlv1.PrepareHeadlessHostLogDownloadRequest) string {
+	return r.GetHostId()
+}
+func hostIDFromClone(r *hdlctrlv1.CloneHeadlessHostRequest) string {
+	return r.GetSourceHostId()
+}
 func hostIDFromShutdown(r *hdlctrlv1.ShutdownHeadlessHostRequest) string { return r.GetHostId() }
 func hostIDFromKill(r *hdlctrlv1.KillHeadlessHostRequest) string         { return r.GetHostId() }
 func hostIDFromUpdateSettings(r *hdlctrlv1.UpdateHeadlessHostSettingsRequest) string {
@@ -82,6 +88,15 @@ func sessionIDFromUpdateExtra(r *hdlctrlv1.UpdateSessionExtraSettingsRequest) st
 func sessionIDFromIssueLink(r *hdlctrlv1.IssueResoniteLinkConnectionRequest) string {
 	return r.GetSessionId()
 }
+func sessionIDFromListUserEvents(r *hdlctrlv1.ListSessionUserEventsRequest) string {
+	return r.GetSessionId()
+}
+func sessionIDFromGetAttendance(r *hdlctrlv1.GetSessionAttendanceRequest) string {
+	return r.GetSessionId()
+}
+func targetIDFromGetMetricsSeries(r *hdlctrlv1.GetMetricsSeriesRequest) string {
+	return r.GetTargetId()
+}
 
 // ===== Group ID extractors =====
 
@@ -30,6 +30,7 @@ type PermissionDeps struct {
 	AccountUC   *usecase.HeadlessAccountUsecase
 	GroupRepo   port.GroupRepository
 	RoleRepo    port.RoleRepository
+	WebhookRepo port.WebhookRepository
 }
 
 // permissionCheck は 1 つの RPC についての permission 判定ロジック.
@@ -91,7 +92,10 @@ func allKnownProcedures() []string {
 		hdlctrlv1connect.ControllerServiceListHeadlessHostProcedure,
 		hdlctrlv1connect.ControllerServiceGetHeadlessHostProcedure,
 		hdlctrlv1connect.ControllerServiceGetHeadlessHostLogsProcedure,
+		hdlctrlv1connect.ControllerServiceSearchHeadlessHostLogsProcedure,
+		hdlctrlv1connect.ControllerServicePrepareHeadlessHostLogDownloadProcedure,
 		hdlctrlv1connect.ControllerServiceListHeadlessHostInstancesProcedure,
+		hdlctrlv1connect.ControllerServiceCloneHeadlessHostProcedure,
 		hdlctrlv1connect.ControllerServiceShutdownHeadlessHostProcedure,
 		hdlctrlv1connect.ControllerServiceKillHeadlessHostProcedure,
 		hdlctrlv1connect.ControllerServiceUpdateHeadlessHostSettingsProcedure,
@@ -141,11 +145,35 @@ func allKnownProcedures() []string {
 		hdlctrlv1connect.ControllerServiceKickUserProcedure,
 		hdlctrlv1connect.ControllerServiceBanUserProcedure,
 		hdlctrlv1connect.ControllerServiceIssueResoniteLinkConnectionProcedure,
+		hdlctrlv1connect.ControllerServiceListSessionUserEventsProcedure,
+		hdlctrlv1connect.ControllerServiceGetSessionAttendanceProcedure,
+		hdlctrlv1connect.ControllerServiceGetSessionUsageStatsProcedure,
+
+		// ===== ControllerService: メトリクス系 =====
+		hdlctrlv1connect.ControllerServiceGetMetricsSeriesProcedure,
+
+		// ===== ControllerService: セッションテンプレート系 =====
+		hdlctrlv1connect.ControllerServiceListSessionTemplatesProcedure,
+		hdlctrlv1connect.ControllerServiceGetSessionTemplateProcedure,
+		hdlctrlv1connect.ControllerServiceCreateSessionTemplateProcedure,
+		hdlctrlv1connect.ControllerServiceUpdateSessionTemplateProcedure,
+		hdlctrlv1connect.ControllerServiceDeleteSessionTemplateProcedure,
+
+		// ===== ControllerService: ホストテンプレート系 =====
+		hdlctrlv1connect.ControllerServiceListHostTemplatesProcedure,
+		hdlctrlv1connect.ControllerServiceGetHostTemplateProcedure,
+		hdlctrlv1connect.ControllerServiceCreateHostTemplateProcedure,
+		hdlctrlv1connect.ControllerServiceUpdateHostTemplateProcedure,
+		hdlctrlv1connect.ControllerServiceDeleteHostTemplateProcedure,
 
 		// ===== ControllerService: 予約操作系 =====
 		hdlctrlv1connect.ControllerServiceCreateScheduledSessionOperationProcedure,
 		hdlctrlv1connect.ControllerServiceListScheduledSessionOperationsProcedure,
 		hdlctrlv1connect.ControllerServiceCancelScheduledSessionOperationProcedure,
+		hdlctrlv1connect.ControllerServicePauseScheduledSessionOperationProcedure,
+		hdlctrlv1connect.ControllerServiceResumeScheduledSessionOperationProcedure,
+		hdlctrlv1connect.ControllerServiceSkipScheduledSessionOperationOccurrenceProcedure,
+		hdlctrlv1connect.ControllerServiceListScheduledSessionOperationRunsProcedure,
 
 		// ===== GroupService =====
 		hdlctrlv1connect.GroupServiceCreateGroupProcedure,
@@ -166,17 +194,49 @@ func allKnownProcedures() []string {
 		hdlctrlv1connect.RoleServiceListPermissionsProcedure,
 		hdlctrlv1connect.RoleServiceGetMyPermissionsProcedure,
 
+		// ===== AuditService =====
+		hdlctrlv1connect.AuditServiceListAuditEventsProcedure,
+
+		// ===== WebhookService =====
+		hdlctrlv1connect.WebhookServiceListWebhooksProcedure,
+		hdlctrlv1connect.WebhookServiceCreateWebhookProcedure,
+		hdlctrlv1connect.WebhookServiceUpdateWebhookProcedure,
+		hdlctrlv1connect.WebhookServiceDeleteWebhookProcedure,
+		hdlctrlv1connect.WebhookServiceRotateWebhookSecretProcedure,
+		hdlctrlv1connect.WebhookServiceTestWebhookProcedure,
+		hdlctrlv1connect.WebhookServiceListWebhookDeliveriesProcedure,
+
+		// ===== NotificationService =====
+		// SubscribeNotifications は streaming のため interceptor を通らない (handler で認可).
+		hdlctrlv1connect.NotificationServiceListNotificationsProcedure,
+		hdlctrlv1connect.NotificationServiceMarkNotificationsReadProcedure,
+
 		// ===== UserService (管理用 RPC) =====
 		hdlctrlv1connect.UserServiceListUsersProcedure,
 		hdlctrlv1connect.UserServiceGetUserProcedure,
 		hdlctrlv1connect.UserServiceCreateRegistrationTokenProcedure,
 		hdlctrlv1connect.UserServiceDeleteUserProcedure,
+		hdlctrlv1connect.UserServiceCreateApiTokenProcedure,
+		hdlctrlv1connect.UserServiceListApiTokensProcedure,
+		hdlctrlv1connect.UserServiceRevokeApiTokenProcedure,
+		hdlctrlv1connect.UserServiceGetTotpStatusProcedure,
+		hdlctrlv1connect.UserServiceDisableTotpProcedure,
+		hdlctrlv1connect.UserServiceListMySessionsProcedure,
+		hdlctrlv1connect.UserServiceRevokeSessionProcedure,
+		hdlctrlv1connect.UserServiceBeginOidcLinkProcedure,
+		hdlctrlv1connect.UserServiceCompleteOidcLinkProcedure,
 
 		// ===== UserService (公開 RPC: 認証不要 or refresh token 経由) =====
 		// fail-closed default では明示登録が必要.
 		hdlctrlv1connect.UserServiceGetTokenByPasswordProcedure,
 		hdlctrlv1connect.UserServiceValidateRegistrationTokenProcedure,
 		hdlctrlv1connect.UserServiceRegisterWithTokenProcedure,
+		hdlctrlv1connect.UserServiceGetLoginOptionsProcedure,
+		hdlctrlv1connect.UserServiceBeginOidcLoginProcedure,
+		hdlctrlv1connect.UserServiceCompleteOidcLoginProcedure,
+		hdlctrlv1connect.UserServiceVerifyTotpLoginProcedure,
+		hdlctrlv1connect.UserServiceBeginTotpEnrollmentProcedure,
+		hdlctrlv1connect.UserServiceConfirmTotpEnrollmentProcedure,
 		hdlctrlv1connect.UserServiceRefreshTokenProcedure,
 		hdlctrlv1connect.UserServiceChangePasswordProcedure,
 	}
@@ -284,7 +344,10 @@ func permissionDenied(key string) error {
 }
 
 // requirePerm は 1 件の permission を判定して足りなければ PermissionDenied を返す.
+// 判定したグループは監査ログの対象グループとしても記録する.
 func requirePerm(ctx context.Context, permUC *usecase.PermissionUsecase, userID, groupID, key string) error {
+	noteAuditGroup(ctx, groupID)
+
 	ok, err := permUC.HasPermission(ctx, userID, groupID, key)
 	if err != nil {
 		return connect.NewError(connect.CodeInternal, err)
@@ -417,6 +480,11 @@ func checkGroupPermission[T any](permKey string, extract idExtractor[T], readOnl
 // (`<user-id>-personal` 形式からユーザー存在) を漏らさないため、非所属は
 // グループの存在有無に関わらず一様に PermissionDenied を返す.
 func checkGroupReadable(ctx context.Context, userID, groupID, permKey string, deps *PermissionDeps, permUC *usecase.PermissionUsecase) error {
+	// 下の所属チェックは HasPermission を通らないので、API トークンの制限はここで見る.
+	if !usecase.APITokenAllows(ctx, userID, groupID, permKey) {
+		return permissionDenied(permKey)
+	}
+
 	canList, err := permUC.HasSystemPermission(ctx, userID, entity.PermKey_SystemGroupList)
 	if err != nil {
 		return connect.NewError(connect.CodeInternal, err)
@@ -579,6 +647,24 @@ func checkUpdateSessionParameters(ctx context.Context, req connect.AnyRequest, d
 	return requirePerm(ctx, permUC, claims.UserID, s.GroupID, entity.PermKey_SessionWrite)
 }
 
+// checkGetMetricsSeries: target_id が何を指すかは kind で決まる.
+// ホストのメトリクスは host:read, セッションのメトリクスは session:read.
+func checkGetMetricsSeries(ctx context.Context, req connect.AnyRequest, deps *PermissionDeps, permUC *usecase.PermissionUsecase) error {
+	msg, ok := req.Any().(*hdlctrlv1.GetMetricsSeriesRequest)
+	if !ok {
+		return connect.NewError(connect.CodeInternal, errors.New("unexpected request type"))
+	}
+
+	switch msg.GetKind() {
+	case hdlctrlv1.MetricKind_METRIC_KIND_HOST_FPS, hdlctrlv1.MetricKind_METRIC_KIND_HOST_RUNNING, hdlctrlv1.MetricKind_METRIC_KIND_HOST_USERS:
+		return checkHostPermission(entity.PermKey_HostRead, targetIDFromGetMetricsSeries)(ctx, req, deps, permUC)
+	case hdlctrlv1.MetricKind_METRIC_KIND_SESSION_USERS:
+		return checkSessionPermission(entity.PermKey_SessionRead, targetIDFromGetMetricsSeries)(ctx, req, deps, permUC)
+	default:
+		return connect.NewError(connect.CodeInvalidArgument, errors.New("kind is required"))
+	}
+}
+
 // ===== GroupService / RoleService の個別チェック =====
 
 // checkUpdateGroupMemberRole: personal なら system:group.manage 必須.
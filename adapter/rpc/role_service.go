@@ -21,6 +21,7 @@ var _ hdlctrlv1connect.RoleServiceHandler = (*RoleService)(nil)
 type RoleService struct {
 	ruc       *usecase.RoleUsecase
 	permUC    *usecase.PermissionUsecase
+	auditUC   *usecase.AuditUsecase
 	groupRepo port.GroupRepository
 	roleRepo  port.RoleRepository
 
@@ -33,6 +34,7 @@ type RoleService struct {
 func NewRoleService(
 	ruc *usecase.RoleUsecase,
 	permUC *usecase.PermissionUsecase,
+	auditUC *usecase.AuditUsecase,
 	groupRepo port.GroupRepository,
 	roleRepo port.RoleRepository,
 	hostRepo port.HeadlessHostRepository,
@@ -42,6 +44,7 @@ func NewRoleService(
 	return &RoleService{
 		ruc:         ruc,
 		permUC:      permUC,
+		auditUC:     auditUC,
 		groupRepo:   groupRepo,
 		roleRepo:    roleRepo,
 		hostRepo:    hostRepo,
@@ -54,6 +57,7 @@ func (s *RoleService) NewHandler() (string, http.Handler) {
 	interceptors := connect.WithInterceptors(
 		logging.NewErrorLogInterceptor(),
 		auth.NewAuthInterceptor(),
+		NewAuditInterceptor(s.auditUC),
 		NewPermissionInterceptor(s.permUC, PermissionDeps{
 			HostRepo:    s.hostRepo,
 			SessionRepo: s.sessionRepo,
@@ -34,6 +34,22 @@ var (
 		hdlctrlv1connect.ControllerServiceCancelScheduledSessionOperationProcedure,
 		requireAuthOnly,
 	)
+	_ = registerRPCPermission(
+		hdlctrlv1connect.ControllerServicePauseScheduledSessionOperationProcedure,
+		requireAuthOnly,
+	)
+	_ = registerRPCPermission(
+		hdlctrlv1connect.ControllerServiceResumeScheduledSessionOperationProcedure,
+		requireAuthOnly,
+	)
+	_ = registerRPCPermission(
+		hdlctrlv1connect.ControllerServiceSkipScheduledSessionOperationOccurrenceProcedure,
+		requireAuthOnly,
+	)
+	_ = registerRPCPermission(
+		hdlctrlv1connect.ControllerServiceListScheduledSessionOperationRunsProcedure,
+		requireAuthOnly,
+	)
 )
 
 var headlessUpdateParamsZero = headlessv1.UpdateSessionParametersRequest{}
@@ -84,6 +100,12 @@ func (c *ControllerService) CreateScheduledSessionOperation(ctx context.Context,
 		return nil, connect.NewError(connect.CodeInvalidArgument, err)
 	}
 
+	if start, ok := action.(*actions.StartSessionAction); ok && start.TemplateID != nil {
+		if err := c.prepareScheduledStartTemplate(ctx, start); err != nil {
+			return nil, convertErr(err)
+		}
+	}
+
 	createdBy := callerUserIDOrNil(ctx)
 
 	created, err := c.souc.Create(ctx, usecase.CreateScheduledSessionOperationParams{
@@ -183,6 +205,131 @@ func (c *ControllerService) CancelScheduledSessionOperation(ctx context.Context,
 	return connect.NewResponse(&hdlctrlv1.CancelScheduledSessionOperationResponse{}), nil
 }
 
+// PauseScheduledSessionOperation implements hdlctrlv1connect.ControllerServiceHandler.
+func (c *ControllerService) PauseScheduledSessionOperation(ctx context.Context, req *connect.Request[hdlctrlv1.PauseScheduledSessionOperationRequest]) (*connect.Response[hdlctrlv1.PauseScheduledSessionOperationResponse], error) {
+	id := req.Msg.GetId()
+	if id == "" {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("id is required"))
+	}
+
+	op, err := c.souc.Pause(ctx, id)
+	if err != nil {
+		return nil, convertScheduledOperationStateErr(err)
+	}
+
+	protoOp, err := scheduledOperationToProto(op)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	return connect.NewResponse(&hdlctrlv1.PauseScheduledSessionOperationResponse{
+		ScheduledOperation: protoOp,
+	}), nil
+}
+
+// ResumeScheduledSessionOperation implements hdlctrlv1connect.ControllerServiceHandler.
+func (c *ControllerService) ResumeScheduledSessionOperation(ctx context.Context, req *connect.Request[hdlctrlv1.ResumeScheduledSessionOperationRequest]) (*connect.Response[hdlctrlv1.ResumeScheduledSessionOperationResponse], error) {
+	id := req.Msg.GetId()
+	if id == "" {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("id is required"))
+	}
+
+	op, err := c.souc.Resume(ctx, id)
+	if err != nil {
+		return nil, convertScheduledOperationStateErr(err)
+	}
+
+	protoOp, err := scheduledOperationToProto(op)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	return connect.NewResponse(&hdlctrlv1.ResumeScheduledSessionOperationResponse{
+		ScheduledOperation: protoOp,
+	}), nil
+}
+
+// SkipScheduledSessionOperationOccurrence implements hdlctrlv1connect.ControllerServiceHandler.
+func (c *ControllerService) SkipScheduledSessionOperationOccurrence(ctx context.Context, req *connect.Request[hdlctrlv1.SkipScheduledSessionOperationOccurrenceRequest]) (*connect.Response[hdlctrlv1.SkipScheduledSessionOperationOccurrenceResponse], error) {
+	id := req.Msg.GetId()
+	if id == "" {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("id is required"))
+	}
+
+	op, err := c.souc.SkipNext(ctx, id)
+	if err != nil {
+		return nil, convertScheduledOperationStateErr(err)
+	}
+
+	protoOp, err := scheduledOperationToProto(op)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	return connect.NewResponse(&hdlctrlv1.SkipScheduledSessionOperationOccurrenceResponse{
+		ScheduledOperation: protoOp,
+	}), nil
+}
+
+// ListScheduledSessionOperationRuns implements hdlctrlv1connect.ControllerServiceHandler.
+func (c *ControllerService) ListScheduledSessionOperationRuns(ctx context.Context, req *connect.Request[hdlctrlv1.ListScheduledSessionOperationRunsRequest]) (*connect.Response[hdlctrlv1.ListScheduledSessionOperationRunsResponse], error) {
+	id := req.Msg.GetId()
+	if id == "" {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("id is required"))
+	}
+
+	pageIndex, pageSize, err := normalizePageRequest(req.Msg.GetPage())
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := c.souc.ListRuns(ctx, id, pageIndex, pageSize)
+	if err != nil {
+		return nil, convertErr(err)
+	}
+
+	runs := make([]*hdlctrlv1.ScheduledOperationRun, 0, len(result.Items))
+	for _, r := range result.Items {
+		runs = append(runs, scheduledOperationRunToProto(r))
+	}
+
+	return connect.NewResponse(&hdlctrlv1.ListScheduledSessionOperationRunsResponse{
+		Runs: runs,
+		Page: &hdlctrlv1.PageResponse{
+			TotalCount: result.TotalCount,
+			PageIndex:  pageIndex,
+			PageSize:   pageSize,
+		},
+	}), nil
+}
+
+// convertScheduledOperationStateErr は状態が合わない操作を FailedPrecondition にする.
+func convertScheduledOperationStateErr(err error) error {
+	if errors.Is(err, usecase.ErrScheduledOperationNotPending) || errors.Is(err, usecase.ErrScheduledOperationNotPaused) {
+		return connect.NewError(connect.CodeFailedPrecondition, err)
+	}
+
+	return convertErr(err)
+}
+
+// prepareScheduledStartTemplate はテンプレート指定の起動予約を登録前に検証する.
+// テンプレートの中身は発火時に解決するが、存在 / グループ / 上書きフィールド名の誤りは
+// ここで弾く. group_id が未指定ならテンプレートとの照合のために host のグループで埋める.
+func (c *ControllerService) prepareScheduledStartTemplate(ctx context.Context, start *actions.StartSessionAction) error {
+	if start.GroupID == "" {
+		groupID, err := c.hhrepo.GetGroupID(ctx, start.HostID)
+		if err != nil {
+			return err
+		}
+
+		start.GroupID = groupID
+	}
+
+	_, err := c.stuc.ResolveStartupParameters(ctx, *start.TemplateID, start.GroupID, startupParamsFromJSON(start.StartupParamsJSON), start.OverrideFields)
+
+	return err
+}
+
 // buildActionFromProto は ScheduledOperation oneof → scheduled_op.Action 変換と、
 // 一覧フィルタ用の (host_id, session_id) の抽出を兼ねる.
 func buildActionFromProto(op *hdlctrlv1.ScheduledOperation) (scheduled_op.Action, *string, *string, error) {
@@ -207,6 +354,8 @@ func buildActionFromProto(op *hdlctrlv1.ScheduledOperation) (scheduled_op.Action
 
 		hostID := start.GetHostId()
 		act := actions.NewStartSessionAction(hostID, start.GetGroupId(), nil, memo, paramsJSON)
+		act.TemplateID = start.TemplateId
+		act.OverrideFields = start.GetOverrideFields()
 
 		return act, &hostID, nil, nil
 	case *hdlctrlv1.ScheduledOperation_StopSession:
@@ -262,7 +411,14 @@ func buildActionFromProto(op *hdlctrlv1.ScheduledOperation) (scheduled_op.Action
 			memo = &v
 		}
 
-		act := actions.NewUpdateExtraSettingsAction(sid, autoUpgrade, memo)
+		var restoreOnCrash *bool
+
+		if upd.RestoreOnCrash != nil {
+			v := upd.GetRestoreOnCrash()
+			restoreOnCrash = &v
+		}
+
+		act := actions.NewUpdateExtraSettingsAction(sid, autoUpgrade, memo, restoreOnCrash)
 
 		return act, nil, &sid, nil
 	default:
@@ -306,6 +462,12 @@ func buildTriggerFromProto(tr *hdlctrlv1.ScheduledTrigger) (scheduled_op.Trigger
 		}
 
 		return triggers.NewSessionUserCountTrigger(sid, cmp, threshold), nil
+	case *hdlctrlv1.ScheduledTrigger_Cron:
+		if x.Cron.GetExpression() == "" {
+			return nil, errors.New("cron trigger: expression is required")
+		}
+
+		return triggers.NewCronTrigger(x.Cron.GetExpression(), x.Cron.GetTimezone())
 	default:
 		return nil, errors.New("trigger oneof is not set")
 	}
@@ -401,6 +563,15 @@ func triggerToProto(t scheduled_op.Trigger) (*hdlctrlv1.ScheduledTrigger, error)
 				},
 			},
 		}, nil
+	case *triggers.CronTrigger:
+		return &hdlctrlv1.ScheduledTrigger{
+			Trigger: &hdlctrlv1.ScheduledTrigger_Cron{
+				Cron: &hdlctrlv1.CronTrigger{
+					Expression: v.Expression,
+					Timezone:   v.Timezone,
+				},
+			},
+		}, nil
 	default:
 		return nil, errors.New("unknown trigger type")
 	}
@@ -420,6 +591,9 @@ func actionToProto(a scheduled_op.Action) (*hdlctrlv1.ScheduledOperation, error)
 			params.Memo = *v.Memo
 		}
 
+		params.TemplateId = v.TemplateID
+		params.OverrideFields = v.OverrideFields
+
 		return &hdlctrlv1.ScheduledOperation{
 			Operation: &hdlctrlv1.ScheduledOperation_StartSession{StartSession: params},
 		}, nil
@@ -452,6 +626,10 @@ func actionToProto(a scheduled_op.Action) (*hdlctrlv1.ScheduledOperation, error)
 			req.Memo = v.Memo
 		}
 
+		if v.RestoreOnCrash != nil {
+			req.RestoreOnCrash = v.RestoreOnCrash
+		}
+
 		return &hdlctrlv1.ScheduledOperation{
 			Operation: &hdlctrlv1.ScheduledOperation_UpdateExtraSettings{UpdateExtraSettings: req},
 		}, nil
@@ -472,6 +650,8 @@ func protoStatusToDomain(p hdlctrlv1.ScheduledOperationStatus) entity.ScheduledO
 		return entity.ScheduledOperationStatus_FAILED
 	case hdlctrlv1.ScheduledOperationStatus_SCHEDULED_OPERATION_STATUS_CANCELED:
 		return entity.ScheduledOperationStatus_CANCELED
+	case hdlctrlv1.ScheduledOperationStatus_SCHEDULED_OPERATION_STATUS_PAUSED:
+		return entity.ScheduledOperationStatus_PAUSED
 	case hdlctrlv1.ScheduledOperationStatus_SCHEDULED_OPERATION_STATUS_UNSPECIFIED:
 		return entity.ScheduledOperationStatus_PENDING
 	default:
@@ -491,11 +671,30 @@ func domainStatusToProto(s entity.ScheduledOperationStatus) hdlctrlv1.ScheduledO
 		return hdlctrlv1.ScheduledOperationStatus_SCHEDULED_OPERATION_STATUS_FAILED
 	case entity.ScheduledOperationStatus_CANCELED:
 		return hdlctrlv1.ScheduledOperationStatus_SCHEDULED_OPERATION_STATUS_CANCELED
+	case entity.ScheduledOperationStatus_PAUSED:
+		return hdlctrlv1.ScheduledOperationStatus_SCHEDULED_OPERATION_STATUS_PAUSED
 	default:
 		return hdlctrlv1.ScheduledOperationStatus_SCHEDULED_OPERATION_STATUS_UNSPECIFIED
 	}
 }
 
+func scheduledOperationRunToProto(r *entity.ScheduledOperationRun) *hdlctrlv1.ScheduledOperationRun {
+	out := &hdlctrlv1.ScheduledOperationRun{
+		Id:           r.ID,
+		ScheduledFor: timestamppb.New(r.ScheduledFor),
+		FinishedAt:   timestamppb.New(r.FinishedAt),
+		Outcome:      hdlctrlv1.ScheduledOperationRunOutcome(r.Outcome),
+		Error:        r.Error,
+		InstanceId:   r.InstanceID,
+	}
+
+	if r.StartedAt != nil {
+		out.StartedAt = timestamppb.New(*r.StartedAt)
+	}
+
+	return out
+}
+
 // callerUserIDOrNil は AuthInterceptor が ctx に詰めた user id を取り出す.
 // 失敗時は CLI / system 由来とみなして nil を返す.
 func callerUserIDOrNil(ctx context.Context) *string {
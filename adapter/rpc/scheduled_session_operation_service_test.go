@@ -150,6 +150,104 @@ func TestControllerService_ScheduledSessionOperations(t *testing.T) {
 		assert.Equal(t, int32(0), condTrig.GetThreshold())
 	})
 
+	t.Run("成功: Cron trigger で予約 → 一時停止 → 再開 → 次回をスキップ → 履歴", func(t *testing.T) {
+		setup := setupControllerServiceTest(t)
+		defer setup.Cleanup()
+
+		client := setupAuthenticatedClient(t, setup.service)
+
+		setupScheduledOpTarget(t, setup.queries, "S-cron")
+
+		createReq := testutil.CreateDefaultAuthenticatedRequest(t, &hdlctrlv1.CreateScheduledSessionOperationRequest{
+			Operation: &hdlctrlv1.ScheduledOperation{
+				Operation: &hdlctrlv1.ScheduledOperation_StopSession{
+					StopSession: &hdlctrlv1.StopSessionRequest{SessionId: "S-cron"},
+				},
+			},
+			Trigger: &hdlctrlv1.ScheduledTrigger{
+				Trigger: &hdlctrlv1.ScheduledTrigger_Cron{
+					Cron: &hdlctrlv1.CronTrigger{Expression: "0 21 * * FRI", Timezone: "Asia/Tokyo"},
+				},
+			},
+		})
+		createRes, err := client.CreateScheduledSessionOperation(t.Context(), createReq)
+		require.NoError(t, err)
+
+		created := createRes.Msg.GetScheduledOperation()
+		opID := created.GetId()
+		assert.Equal(t, "0 21 * * FRI", created.GetTrigger().GetCron().GetExpression())
+		assert.Equal(t, "Asia/Tokyo", created.GetTrigger().GetCron().GetTimezone())
+
+		jst, err := time.LoadLocation("Asia/Tokyo")
+		require.NoError(t, err)
+
+		firstFire := created.GetNextFireAt().AsTime().In(jst)
+		assert.Equal(t, time.Friday, firstFire.Weekday())
+		assert.Equal(t, 21, firstFire.Hour())
+
+		// Pause → PAUSED. 2 回目は FailedPrecondition
+		pauseReq := testutil.CreateDefaultAuthenticatedRequest(t, &hdlctrlv1.PauseScheduledSessionOperationRequest{Id: opID})
+		pauseRes, err := client.PauseScheduledSessionOperation(t.Context(), pauseReq)
+		require.NoError(t, err)
+		assert.Equal(t, hdlctrlv1.ScheduledOperationStatus_SCHEDULED_OPERATION_STATUS_PAUSED, pauseRes.Msg.GetScheduledOperation().GetStatus())
+
+		_, err = client.PauseScheduledSessionOperation(t.Context(), pauseReq)
+
+		connectErr := &connect.Error{}
+		require.ErrorAs(t, err, &connectErr)
+		assert.Equal(t, connect.CodeFailedPrecondition, connectErr.Code())
+
+		// Resume → PENDING
+		resumeReq := testutil.CreateDefaultAuthenticatedRequest(t, &hdlctrlv1.ResumeScheduledSessionOperationRequest{Id: opID})
+		resumeRes, err := client.ResumeScheduledSessionOperation(t.Context(), resumeReq)
+		require.NoError(t, err)
+		assert.Equal(t, hdlctrlv1.ScheduledOperationStatus_SCHEDULED_OPERATION_STATUS_PENDING, resumeRes.Msg.GetScheduledOperation().GetStatus())
+		assert.True(t, firstFire.Equal(resumeRes.Msg.GetScheduledOperation().GetNextFireAt().AsTime()))
+
+		// Skip → 1 週間後に進み、履歴に SKIPPED が残る
+		skipReq := testutil.CreateDefaultAuthenticatedRequest(t, &hdlctrlv1.SkipScheduledSessionOperationOccurrenceRequest{Id: opID})
+		skipRes, err := client.SkipScheduledSessionOperationOccurrence(t.Context(), skipReq)
+		require.NoError(t, err)
+		assert.Equal(t, firstFire.AddDate(0, 0, 7).Unix(), skipRes.Msg.GetScheduledOperation().GetNextFireAt().AsTime().Unix())
+
+		runsReq := testutil.CreateDefaultAuthenticatedRequest(t, &hdlctrlv1.ListScheduledSessionOperationRunsRequest{Id: opID})
+		runsRes, err := client.ListScheduledSessionOperationRuns(t.Context(), runsReq)
+		require.NoError(t, err)
+		require.Len(t, runsRes.Msg.GetRuns(), 1)
+		assert.Equal(t, int32(1), runsRes.Msg.GetPage().GetTotalCount())
+
+		run := runsRes.Msg.GetRuns()[0]
+		assert.Equal(t, hdlctrlv1.ScheduledOperationRunOutcome_SCHEDULED_OPERATION_RUN_OUTCOME_SKIPPED, run.GetOutcome())
+		assert.Equal(t, firstFire.Unix(), run.GetScheduledFor().AsTime().Unix())
+		assert.Nil(t, run.GetStartedAt())
+	})
+
+	t.Run("失敗: Cron trigger の式が不正なら InvalidArgument", func(t *testing.T) {
+		setup := setupControllerServiceTest(t)
+		defer setup.Cleanup()
+
+		client := setupAuthenticatedClient(t, setup.service)
+
+		req := testutil.CreateDefaultAuthenticatedRequest(t, &hdlctrlv1.CreateScheduledSessionOperationRequest{
+			Operation: &hdlctrlv1.ScheduledOperation{
+				Operation: &hdlctrlv1.ScheduledOperation_StopSession{
+					StopSession: &hdlctrlv1.StopSessionRequest{SessionId: "S-x"},
+				},
+			},
+			Trigger: &hdlctrlv1.ScheduledTrigger{
+				Trigger: &hdlctrlv1.ScheduledTrigger_Cron{
+					Cron: &hdlctrlv1.CronTrigger{Expression: "0 25 * * *"},
+				},
+			},
+		})
+		_, err := client.CreateScheduledSessionOperation(t.Context(), req)
+		require.Error(t, err)
+
+		connectErr := &connect.Error{}
+		require.ErrorAs(t, err, &connectErr)
+		assert.Equal(t, connect.CodeInvalidArgument, connectErr.Code())
+	})
+
 	t.Run("失敗: SessionUserCount trigger の comparator 未指定で InvalidArgument", func(t *testing.T) {
 		setup := setupControllerServiceTest(t)
 		defer setup.Cleanup()
@@ -2,6 +2,7 @@ package rpc
 
 import (
 	"context"
+	"net"
 	"net/http"
 
 	"github.com/go-errors/errors"
@@ -20,14 +21,24 @@ import (
 var _ hdlctrlv1connect.UserServiceHandler = (*UserService)(nil)
 
 type UserService struct {
-	uu     *usecase.UserUsecase
-	permUC *usecase.PermissionUsecase
+	uu        *usecase.UserUsecase
+	atuc      *usecase.ApiTokenUsecase
+	oidcUC    *usecase.OIDCLoginUsecase
+	totpUC    *usecase.TOTPUsecase
+	sessionUC *usecase.UserSessionUsecase
+	permUC    *usecase.PermissionUsecase
+	auditUC   *usecase.AuditUsecase
 }
 
-func NewUserService(uu *usecase.UserUsecase, permUC *usecase.PermissionUsecase) *UserService {
+func NewUserService(uu *usecase.UserUsecase, atuc *usecase.ApiTokenUsecase, oidcUC *usecase.OIDCLoginUsecase, totpUC *usecase.TOTPUsecase, sessionUC *usecase.UserSessionUsecase, permUC *usecase.PermissionUsecase, auditUC *usecase.AuditUsecase) *UserService {
 	return &UserService{
-		uu:     uu,
-		permUC: permUC,
+		uu:        uu,
+		atuc:      atuc,
+		oidcUC:    oidcUC,
+		totpUC:    totpUC,
+		sessionUC: sessionUC,
+		permUC:    permUC,
+		auditUC:   auditUC,
 	}
 }
 
@@ -37,6 +48,10 @@ var (
 	_ = registerRPCPermission(hdlctrlv1connect.UserServiceGetTokenByPasswordProcedure, publicRPC)
 	_ = registerRPCPermission(hdlctrlv1connect.UserServiceValidateRegistrationTokenProcedure, publicRPC)
 	_ = registerRPCPermission(hdlctrlv1connect.UserServiceRegisterWithTokenProcedure, publicRPC)
+	_ = registerRPCPermission(hdlctrlv1connect.UserServiceGetLoginOptionsProcedure, publicRPC)
+	_ = registerRPCPermission(hdlctrlv1connect.UserServiceBeginOidcLoginProcedure, publicRPC)
+	_ = registerRPCPermission(hdlctrlv1connect.UserServiceCompleteOidcLoginProcedure, publicRPC)
+	_ = registerRPCPermission(hdlctrlv1connect.UserServiceVerifyTotpLoginProcedure, publicRPC)
 	// RefreshToken は Bearer ヘッダの refresh token を handler で検証するため
 	// 認証は handler 側でやる. ここでは public 扱い.
 	_ = registerRPCPermission(hdlctrlv1connect.UserServiceRefreshTokenProcedure, publicRPC)
@@ -51,14 +66,15 @@ func (u *UserService) RefreshToken(ctx context.Context, req *connect.Request[hdl
 		return nil, errors.Wrap(err, 0)
 	}
 
-	token, refreshToken, err := auth.GenerateTokensWithDefaultTTL(*claims)
+	// ログインセッションが失効していないか、refresh token が使い回されていないかを確認して世代を進める.
+	tokens, err := u.sessionUC.Refresh(ctx, claims, sessionClient(req))
 	if err != nil {
-		return nil, errors.Wrap(err, 0)
+		return nil, convertErr(err)
 	}
 
 	res := connect.NewResponse(&hdlctrlv1.TokenSetResponse{
-		Token:        token,
-		RefreshToken: refreshToken,
+		Token:        tokens.Token,
+		RefreshToken: tokens.RefreshToken,
 	})
 	auth.SetSuccessResponseHeader(res)
 
@@ -72,21 +88,116 @@ func (u *UserService) GetTokenByPassword(ctx context.Context, req *connect.Reque
 		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("invalid id or password"))
 	}
 
-	token, refreshToken, err := auth.GenerateTokensWithDefaultTTL(auth.AuthClaims{
-		UserID:     user.ID,
-		ResoniteID: user.ResoniteID.String,
-		IconUrl:    user.IconUrl.String,
-	})
+	// 2 段階認証が必要ならトークンの代わりに challenge を返す.
+	challenge, err := u.totpUC.BeginLogin(ctx, user.ID)
 	if err != nil {
-		return nil, errors.Wrap(err, 0)
+		return nil, convertErr(err)
 	}
 
-	res := connect.NewResponse(&hdlctrlv1.TokenSetResponse{
-		Token:        token,
-		RefreshToken: refreshToken,
-	})
+	if challenge != nil {
+		return connect.NewResponse(&hdlctrlv1.TokenSetResponse{
+			TotpChallenge:          challenge.Token,
+			TotpEnrollmentRequired: challenge.EnrollmentRequired,
+		}), nil
+	}
 
-	return res, nil
+	res, err := u.startSession(ctx, req, user)
+	if err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(res), nil
+}
+
+// VerifyTotpLogin implements hdlctrlv1connect.UserServiceHandler.
+func (u *UserService) VerifyTotpLogin(ctx context.Context, req *connect.Request[hdlctrlv1.VerifyTotpLoginRequest]) (*connect.Response[hdlctrlv1.TokenSetResponse], error) {
+	user, err := u.totpUC.VerifyLogin(ctx, req.Msg.GetChallenge(), req.Msg.GetCode())
+	if err != nil {
+		return nil, convertTOTPErr(err)
+	}
+
+	res, err := u.startSession(ctx, req, user)
+	if err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(res), nil
+}
+
+// GetLoginOptions implements hdlctrlv1connect.UserServiceHandler.
+func (u *UserService) GetLoginOptions(_ context.Context, _ *connect.Request[hdlctrlv1.GetLoginOptionsRequest]) (*connect.Response[hdlctrlv1.GetLoginOptionsResponse], error) {
+	res := &hdlctrlv1.GetLoginOptionsResponse{OidcEnabled: u.oidcUC.Enabled()}
+	if res.OidcEnabled {
+		res.OidcProviderName = u.oidcUC.ProviderName()
+	}
+
+	return connect.NewResponse(res), nil
+}
+
+// BeginOidcLogin implements hdlctrlv1connect.UserServiceHandler.
+func (u *UserService) BeginOidcLogin(ctx context.Context, _ *connect.Request[hdlctrlv1.BeginOidcLoginRequest]) (*connect.Response[hdlctrlv1.BeginOidcLoginResponse], error) {
+	start, err := u.oidcUC.BeginLogin(ctx)
+	if err != nil {
+		return nil, convertOIDCLoginErr(err)
+	}
+
+	return connect.NewResponse(&hdlctrlv1.BeginOidcLoginResponse{
+		AuthorizationUrl: start.AuthorizationURL,
+		State:            start.State,
+	}), nil
+}
+
+// CompleteOidcLogin implements hdlctrlv1connect.UserServiceHandler.
+func (u *UserService) CompleteOidcLogin(ctx context.Context, req *connect.Request[hdlctrlv1.CompleteOidcLoginRequest]) (*connect.Response[hdlctrlv1.TokenSetResponse], error) {
+	user, err := u.oidcUC.CompleteLogin(ctx, req.Msg.GetCode(), req.Msg.GetState())
+	if err != nil {
+		return nil, convertOIDCLoginErr(err)
+	}
+
+	res, err := u.startSession(ctx, req, user)
+	if err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(res), nil
+}
+
+// 外部アカウントの紐付けは caller 自身にだけ行うので認証のみ要求する.
+// API トークンからの操作は usecase 側で弾く.
+var (
+	_ = registerRPCPermission(hdlctrlv1connect.UserServiceBeginOidcLinkProcedure, requireAuthenticated)
+	_ = registerRPCPermission(hdlctrlv1connect.UserServiceCompleteOidcLinkProcedure, requireAuthenticated)
+)
+
+// BeginOidcLink implements hdlctrlv1connect.UserServiceHandler.
+func (u *UserService) BeginOidcLink(ctx context.Context, _ *connect.Request[hdlctrlv1.BeginOidcLinkRequest]) (*connect.Response[hdlctrlv1.BeginOidcLoginResponse], error) {
+	start, err := u.oidcUC.BeginLink(ctx)
+	if err != nil {
+		return nil, convertOIDCLoginErr(err)
+	}
+
+	return connect.NewResponse(&hdlctrlv1.BeginOidcLoginResponse{
+		AuthorizationUrl: start.AuthorizationURL,
+		State:            start.State,
+	}), nil
+}
+
+// CompleteOidcLink implements hdlctrlv1connect.UserServiceHandler.
+func (u *UserService) CompleteOidcLink(ctx context.Context, req *connect.Request[hdlctrlv1.CompleteOidcLinkRequest]) (*connect.Response[hdlctrlv1.CompleteOidcLinkResponse], error) {
+	if err := u.oidcUC.CompleteLink(ctx, req.Msg.GetCode(), req.Msg.GetState()); err != nil {
+		return nil, convertOIDCLoginErr(err)
+	}
+
+	return connect.NewResponse(&hdlctrlv1.CompleteOidcLinkResponse{}), nil
+}
+
+// convertOIDCLoginErr は SSO が無効なことを FailedPrecondition として返す.
+func convertOIDCLoginErr(err error) error {
+	if errors.Is(err, usecase.ErrOIDCDisabled) {
+		return connect.NewError(connect.CodeFailedPrecondition, err)
+	}
+
+	return convertErr(err)
 }
 
 // ValidateRegistrationToken implements hdlctrlv1connect.UserServiceHandler.
@@ -118,19 +229,12 @@ func (u *UserService) RegisterWithToken(ctx context.Context, req *connect.Reques
 		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("registration failed: invalid token or user already exists"))
 	}
 
-	token, refreshToken, err := auth.GenerateTokensWithDefaultTTL(auth.AuthClaims{
-		UserID:     user.ID,
-		ResoniteID: user.ResoniteID.String,
-		IconUrl:    user.IconUrl.String,
-	})
+	res, err := u.startSession(ctx, req, user)
 	if err != nil {
-		return nil, errors.Wrap(err, 0)
+		return nil, err
 	}
 
-	return connect.NewResponse(&hdlctrlv1.TokenSetResponse{
-		Token:        token,
-		RefreshToken: refreshToken,
-	}), nil
+	return connect.NewResponse(res), nil
 }
 
 // ChangePassword implements hdlctrlv1connect.UserServiceHandler.
@@ -245,6 +349,242 @@ func (u *UserService) DeleteUser(ctx context.Context, req *connect.Request[hdlct
 	return connect.NewResponse(&hdlctrlv1.DeleteUserResponse{}), nil
 }
 
+// API トークン系 RPC は自分のトークンだけを扱うので認証のみ要求する.
+// API トークンからの発行禁止は usecase 側で弾く.
+var (
+	_ = registerRPCPermission(hdlctrlv1connect.UserServiceCreateApiTokenProcedure, requireAuthenticated)
+	_ = registerRPCPermission(hdlctrlv1connect.UserServiceListApiTokensProcedure, requireAuthenticated)
+	_ = registerRPCPermission(hdlctrlv1connect.UserServiceRevokeApiTokenProcedure, requireAuthenticated)
+)
+
+// CreateApiToken implements hdlctrlv1connect.UserServiceHandler.
+func (u *UserService) CreateApiToken(ctx context.Context, req *connect.Request[hdlctrlv1.CreateApiTokenRequest]) (*connect.Response[hdlctrlv1.CreateApiTokenResponse], error) {
+	params := usecase.CreateApiTokenParams{
+		Name:           req.Msg.GetName(),
+		GroupID:        req.Msg.GroupId,
+		PermissionKeys: req.Msg.GetPermissionKeys(),
+	}
+	if req.Msg.ExpiresAt != nil {
+		expiresAt := req.Msg.GetExpiresAt().AsTime()
+		params.ExpiresAt = &expiresAt
+	}
+
+	token, plain, err := u.atuc.CreateApiToken(ctx, params)
+	if err != nil {
+		return nil, convertErr(err)
+	}
+
+	return connect.NewResponse(&hdlctrlv1.CreateApiTokenResponse{
+		ApiToken: apiTokenToProto(token),
+		Token:    plain,
+	}), nil
+}
+
+// ListApiTokens implements hdlctrlv1connect.UserServiceHandler.
+func (u *UserService) ListApiTokens(ctx context.Context, _ *connect.Request[hdlctrlv1.ListApiTokensRequest]) (*connect.Response[hdlctrlv1.ListApiTokensResponse], error) {
+	tokens, err := u.atuc.ListApiTokens(ctx)
+	if err != nil {
+		return nil, convertErr(err)
+	}
+
+	protoTokens := make([]*hdlctrlv1.ApiToken, 0, len(tokens))
+	for _, t := range tokens {
+		protoTokens = append(protoTokens, apiTokenToProto(t))
+	}
+
+	return connect.NewResponse(&hdlctrlv1.ListApiTokensResponse{ApiTokens: protoTokens}), nil
+}
+
+// RevokeApiToken implements hdlctrlv1connect.UserServiceHandler.
+func (u *UserService) RevokeApiToken(ctx context.Context, req *connect.Request[hdlctrlv1.RevokeApiTokenRequest]) (*connect.Response[hdlctrlv1.RevokeApiTokenResponse], error) {
+	if req.Msg.GetId() == "" {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("id is required"))
+	}
+
+	if err := u.atuc.RevokeApiToken(ctx, req.Msg.GetId()); err != nil {
+		return nil, convertErr(err)
+	}
+
+	return connect.NewResponse(&hdlctrlv1.RevokeApiTokenResponse{}), nil
+}
+
+// 2 段階認証の設定は自分の分だけを扱うので認証のみ要求する.
+// 登録はサインイン前 (challenge 付き) にも呼ぶため public 扱いにし、
+// challenge も access token も無ければ usecase 側で Unauthenticated にする.
+var (
+	_ = registerRPCPermission(hdlctrlv1connect.UserServiceGetTotpStatusProcedure, requireAuthenticated)
+	_ = registerRPCPermission(hdlctrlv1connect.UserServiceBeginTotpEnrollmentProcedure, publicRPC)
+	_ = registerRPCPermission(hdlctrlv1connect.UserServiceConfirmTotpEnrollmentProcedure, publicRPC)
+	_ = registerRPCPermission(hdlctrlv1connect.UserServiceDisableTotpProcedure, requireAuthenticated)
+)
+
+// GetTotpStatus implements hdlctrlv1connect.UserServiceHandler.
+func (u *UserService) GetTotpStatus(ctx context.Context, _ *connect.Request[hdlctrlv1.GetTotpStatusRequest]) (*connect.Response[hdlctrlv1.GetTotpStatusResponse], error) {
+	status, err := u.totpUC.GetStatus(ctx)
+	if err != nil {
+		return nil, convertTOTPErr(err)
+	}
+
+	return connect.NewResponse(&hdlctrlv1.GetTotpStatusResponse{
+		Enabled:                status.Enabled,
+		Required:               status.Required,
+		RemainingRecoveryCodes: int32(status.RemainingRecoveryCodes), //nolint:gosec // 最大 10
+	}), nil
+}
+
+// BeginTotpEnrollment implements hdlctrlv1connect.UserServiceHandler.
+func (u *UserService) BeginTotpEnrollment(ctx context.Context, req *connect.Request[hdlctrlv1.BeginTotpEnrollmentRequest]) (*connect.Response[hdlctrlv1.BeginTotpEnrollmentResponse], error) {
+	enrollment, err := u.totpUC.BeginEnrollment(ctx, req.Msg.GetChallenge())
+	if err != nil {
+		return nil, convertTOTPErr(err)
+	}
+
+	return connect.NewResponse(&hdlctrlv1.BeginTotpEnrollmentResponse{
+		Secret: enrollment.Secret,
+		KeyUri: enrollment.KeyURI,
+	}), nil
+}
+
+// ConfirmTotpEnrollment implements hdlctrlv1connect.UserServiceHandler.
+func (u *UserService) ConfirmTotpEnrollment(ctx context.Context, req *connect.Request[hdlctrlv1.ConfirmTotpEnrollmentRequest]) (*connect.Response[hdlctrlv1.ConfirmTotpEnrollmentResponse], error) {
+	result, err := u.totpUC.ConfirmEnrollment(ctx, req.Msg.GetChallenge(), req.Msg.GetCode())
+	if err != nil {
+		return nil, convertTOTPErr(err)
+	}
+
+	res := &hdlctrlv1.ConfirmTotpEnrollmentResponse{RecoveryCodes: result.RecoveryCodes}
+
+	if result.User != nil {
+		res.Tokens, err = u.startSession(ctx, req, result.User)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return connect.NewResponse(res), nil
+}
+
+// DisableTotp implements hdlctrlv1connect.UserServiceHandler.
+func (u *UserService) DisableTotp(ctx context.Context, req *connect.Request[hdlctrlv1.DisableTotpRequest]) (*connect.Response[hdlctrlv1.DisableTotpResponse], error) {
+	if err := u.totpUC.Disable(ctx, req.Msg.GetCode()); err != nil {
+		return nil, convertTOTPErr(err)
+	}
+
+	return connect.NewResponse(&hdlctrlv1.DisableTotpResponse{}), nil
+}
+
+// convertTOTPErr は登録状態と合わない操作を FailedPrecondition として返す.
+func convertTOTPErr(err error) error {
+	if errors.Is(err, usecase.ErrTOTPAlreadyEnabled) || errors.Is(err, usecase.ErrTOTPNotEnabled) || errors.Is(err, usecase.ErrTOTPRequired) {
+		return connect.NewError(connect.CodeFailedPrecondition, err)
+	}
+
+	return convertErr(err)
+}
+
+// ログインセッション系 RPC は自分のセッションだけを扱うので認証のみ要求する.
+// API トークンからの操作は usecase 側で弾く.
+var (
+	_ = registerRPCPermission(hdlctrlv1connect.UserServiceListMySessionsProcedure, requireAuthenticated)
+	_ = registerRPCPermission(hdlctrlv1connect.UserServiceRevokeSessionProcedure, requireAuthenticated)
+)
+
+// ListMySessions implements hdlctrlv1connect.UserServiceHandler.
+func (u *UserService) ListMySessions(ctx context.Context, _ *connect.Request[hdlctrlv1.ListMySessionsRequest]) (*connect.Response[hdlctrlv1.ListMySessionsResponse], error) {
+	sessions, err := u.sessionUC.ListMySessions(ctx)
+	if err != nil {
+		return nil, convertErr(err)
+	}
+
+	var currentID string
+	if claims, err := auth.GetAuthClaimsFromContext(ctx); err == nil {
+		currentID = claims.UserSessionID
+	}
+
+	protoSessions := make([]*hdlctrlv1.UserSession, 0, len(sessions))
+	for _, s := range sessions {
+		protoSessions = append(protoSessions, userSessionToProto(s, currentID))
+	}
+
+	return connect.NewResponse(&hdlctrlv1.ListMySessionsResponse{Sessions: protoSessions}), nil
+}
+
+// RevokeSession implements hdlctrlv1connect.UserServiceHandler.
+func (u *UserService) RevokeSession(ctx context.Context, req *connect.Request[hdlctrlv1.RevokeSessionRequest]) (*connect.Response[hdlctrlv1.RevokeSessionResponse], error) {
+	if req.Msg.GetId() == "" {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("id is required"))
+	}
+
+	if err := u.sessionUC.RevokeSession(ctx, req.Msg.GetId()); err != nil {
+		return nil, convertErr(err)
+	}
+
+	return connect.NewResponse(&hdlctrlv1.RevokeSessionResponse{}), nil
+}
+
+func userSessionToProto(s *entity.UserSession, currentID string) *hdlctrlv1.UserSession {
+	return &hdlctrlv1.UserSession{
+		Id:         s.ID,
+		UserAgent:  s.UserAgent,
+		IpAddress:  s.IPAddress,
+		CreatedAt:  timestamppb.New(s.CreatedAt),
+		LastSeenAt: timestamppb.New(s.LastSeenAt),
+		ExpiresAt:  timestamppb.New(s.ExpiresAt),
+		Current:    s.ID == currentID,
+	}
+}
+
+// startSession はサインインしたユーザーのログインセッションを作ってトークンを発行する.
+func (u *UserService) startSession(ctx context.Context, req connect.AnyRequest, user *db.User) (*hdlctrlv1.TokenSetResponse, error) {
+	tokens, err := u.sessionUC.StartSession(ctx, user, sessionClient(req))
+	if err != nil {
+		return nil, convertErr(err)
+	}
+
+	return &hdlctrlv1.TokenSetResponse{
+		Token:        tokens.Token,
+		RefreshToken: tokens.RefreshToken,
+	}, nil
+}
+
+// sessionClient はセッション一覧に表示する端末の情報をリクエストから取り出す.
+func sessionClient(req connect.AnyRequest) usecase.UserSessionClient {
+	addr := req.Peer().Addr
+	if host, _, err := net.SplitHostPort(addr); err == nil {
+		addr = host
+	}
+
+	return usecase.UserSessionClient{
+		UserAgent: req.Header().Get("User-Agent"),
+		IPAddress: addr,
+	}
+}
+
+func apiTokenToProto(t *entity.ApiToken) *hdlctrlv1.ApiToken {
+	p := &hdlctrlv1.ApiToken{
+		Id:             t.ID,
+		Name:           t.Name,
+		TokenPrefix:    t.TokenPrefix,
+		GroupId:        t.GroupID,
+		PermissionKeys: t.PermissionKeys,
+		CreatedAt:      timestamppb.New(t.CreatedAt),
+	}
+
+	if t.ExpiresAt != nil {
+		p.ExpiresAt = timestamppb.New(*t.ExpiresAt)
+	}
+
+	if t.LastUsedAt != nil {
+		p.LastUsedAt = timestamppb.New(*t.LastUsedAt)
+	}
+
+	if t.RevokedAt != nil {
+		p.RevokedAt = timestamppb.New(*t.RevokedAt)
+	}
+
+	return p
+}
+
 func userToProto(u *db.User) *hdlctrlv1.User {
 	p := &hdlctrlv1.User{
 		Id:         u.ID,
@@ -266,7 +606,9 @@ func userToProto(u *db.User) *hdlctrlv1.User {
 func (u *UserService) NewHandler() (string, http.Handler) {
 	interceptors := connect.WithInterceptors(
 		logging.NewErrorLogInterceptor(),
-		auth.NewOptionalAuthInterceptor(),
+		// refresh token は RefreshToken でだけ受け付ける (handler で世代を検証する).
+		auth.NewOptionalAuthInterceptor(hdlctrlv1connect.UserServiceRefreshTokenProcedure),
+		NewAuditInterceptor(u.auditUC),
 		// 管理用 RPC (ListUsers / GetUser / CreateRegistrationToken / DeleteUser) の
 		// 権限チェック. 公開 RPC は rpcPermissionRules に登録されていないので pass-through.
 		NewPermissionInterceptor(u.permUC, PermissionDeps{}),
@@ -1,22 +1,27 @@
 package rpc
 
 import (
+	"encoding/base32"
 	"errors"
+	"strings"
 	"testing"
 	"testing/synctest"
 	"time"
 
 	"connectrpc.com/connect"
 	"github.com/hantabaru1014/baru-reso-headless-controller/adapter"
+	"github.com/hantabaru1014/baru-reso-headless-controller/config"
 	"github.com/hantabaru1014/baru-reso-headless-controller/db"
 	"github.com/hantabaru1014/baru-reso-headless-controller/domain/entity"
 	"github.com/hantabaru1014/baru-reso-headless-controller/lib/auth"
 	"github.com/hantabaru1014/baru-reso-headless-controller/lib/skyfrost"
 	skyfrostmock "github.com/hantabaru1014/baru-reso-headless-controller/lib/skyfrost/mock"
+	"github.com/hantabaru1014/baru-reso-headless-controller/lib/totp"
 	hdlctrlv1 "github.com/hantabaru1014/baru-reso-headless-controller/pbgen/hdlctrl/v1"
 	"github.com/hantabaru1014/baru-reso-headless-controller/pbgen/hdlctrl/v1/hdlctrlv1connect"
 	"github.com/hantabaru1014/baru-reso-headless-controller/testutil"
 	"github.com/hantabaru1014/baru-reso-headless-controller/usecase"
+	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgtype"
 	"github.com/jackc/pgx/v5/pgxpool"
 	"github.com/stretchr/testify/assert"
@@ -45,7 +50,7 @@ func TestUserService_GetTokenByPassword(t *testing.T) {
 	permUC := newPermissionUsecaseForTest(queries)
 	guc := newGroupUsecaseForTest(queries, permUC)
 	uu := usecase.NewUserUsecase(queries, pool, mockSkyfrost, guc, permUC)
-	service := NewUserService(uu, permUC)
+	service := NewUserService(uu, newApiTokenUsecaseForTest(queries), usecase.NewOIDCLoginUsecase(queries, pool, nil, guc, usecase.OIDCLoginOptions{}), newTOTPUsecaseForTest(queries, pool, false), newUserSessionUsecaseForTest(queries), permUC, newAuditUsecaseForTest(queries))
 
 	t.Run("成功: 正しいIDとパスワードでトークンを取得", func(t *testing.T) {
 		req := testutil.CreateUnauthenticatedRequest(&hdlctrlv1.GetTokenByPasswordRequest{
@@ -110,7 +115,7 @@ func TestUserService_RefreshToken(t *testing.T) {
 	permUC := newPermissionUsecaseForTest(queries)
 	guc := newGroupUsecaseForTest(queries, permUC)
 	uu := usecase.NewUserUsecase(queries, pool, mockSkyfrost, guc, permUC)
-	service := NewUserService(uu, permUC)
+	service := NewUserService(uu, newApiTokenUsecaseForTest(queries), usecase.NewOIDCLoginUsecase(queries, pool, nil, guc, usecase.OIDCLoginOptions{}), newTOTPUsecaseForTest(queries, pool, false), newUserSessionUsecaseForTest(queries), permUC, newAuditUsecaseForTest(queries))
 
 	t.Run("成功: 有効なトークンでリフレッシュ", func(t *testing.T) {
 		synctest.Test(t, func(t *testing.T) {
@@ -217,10 +222,43 @@ func newGroupUsecaseForTest(queries *db.Queries, permUC *usecase.PermissionUseca
 		adapter.NewGroupRepository(queries),
 		adapter.NewGroupMemberRepository(queries),
 		adapter.NewRoleRepository(queries),
+		adapter.NewUserSessionRepository(queries),
 		permUC,
+		queries,
 	)
 }
 
+// newApiTokenUsecaseForTest は API トークンを実 DB で扱う ApiTokenUsecase を返し、
+// auth interceptor の verifier としても登録する.
+func newApiTokenUsecaseForTest(queries *db.Queries) *usecase.ApiTokenUsecase {
+	atuc := usecase.NewApiTokenUsecase(adapter.NewApiTokenRepository(queries), adapter.NewGroupRepository(queries))
+	auth.SetAPITokenVerifier(atuc)
+
+	return atuc
+}
+
+// newUserSessionUsecaseForTest はログインセッションを実 DB で扱う UserSessionUsecase を返し、
+// auth interceptor の verifier としても登録する.
+func newUserSessionUsecaseForTest(queries *db.Queries) *usecase.UserSessionUsecase {
+	usuc := usecase.NewUserSessionUsecase(adapter.NewUserSessionRepository(queries))
+	auth.SetUserSessionVerifier(usuc)
+
+	return usuc
+}
+
+// newAuditUsecaseForTest は実 DB に監査ログを書き込む AuditUsecase を返す.
+func newAuditUsecaseForTest(queries *db.Queries) *usecase.AuditUsecase {
+	return usecase.NewAuditUsecase(adapter.NewAuditEventRepository(queries))
+}
+
+// newTOTPUsecaseForTest は testutil の固定鍵で secret を暗号化する TOTPUsecase を返す.
+func newTOTPUsecaseForTest(queries *db.Queries, pool *pgxpool.Pool, requiredForSystemRoles bool) *usecase.TOTPUsecase {
+	return usecase.NewTOTPUsecase(queries, pool, testutil.NewTestTOTPSecretCipher(), adapter.NewGroupMemberRepository(queries), usecase.TOTPOptions{
+		Issuer:                 "brhc",
+		RequiredForSystemRoles: requiredForSystemRoles,
+	})
+}
+
 func setupUserServiceTest(t *testing.T) *userServiceTestSetup {
 	t.Helper()
 
@@ -237,7 +275,7 @@ func setupUserServiceTest(t *testing.T) *userServiceTestSetup {
 	permUC := newPermissionUsecaseForTest(queries)
 	guc := newGroupUsecaseForTest(queries, permUC)
 	uu := usecase.NewUserUsecase(queries, pool, mockSkyfrost, guc, permUC)
-	service := NewUserService(uu, permUC)
+	service := NewUserService(uu, newApiTokenUsecaseForTest(queries), usecase.NewOIDCLoginUsecase(queries, pool, nil, guc, usecase.OIDCLoginOptions{}), newTOTPUsecaseForTest(queries, pool, false), newUserSessionUsecaseForTest(queries), permUC, newAuditUsecaseForTest(queries))
 
 	return &userServiceTestSetup{
 		service:      service,
@@ -728,3 +766,961 @@ func TestUserService_DeleteUser(t *testing.T) {
 		assert.Equal(t, connect.CodeInvalidArgument, connectErr.Code())
 	})
 }
+
+// bearerRequest は Bearer に API トークンやログインで得たトークンを付けたリクエストを作る.
+func bearerRequest[T any](msg *T, token string) *connect.Request[T] {
+	req := connect.NewRequest(msg)
+	req.Header().Set("Authorization", "Bearer "+token)
+
+	return req
+}
+
+func TestUserService_ApiTokens(t *testing.T) {
+	createToken := func(t *testing.T, client hdlctrlv1connect.UserServiceClient, msg *hdlctrlv1.CreateApiTokenRequest) *hdlctrlv1.CreateApiTokenResponse {
+		t.Helper()
+
+		res, err := client.CreateApiToken(t.Context(), testutil.CreateDefaultAuthenticatedRequest(t, msg))
+		require.NoError(t, err)
+
+		return res.Msg
+	}
+
+	t.Run("成功: 発行したトークンで認証でき、一覧には平文が出ない", func(t *testing.T) {
+		setup := setupUserServiceTest(t)
+		defer setup.Cleanup()
+
+		client := setupUserServiceClient(t, setup.service)
+
+		created := createToken(t, client, &hdlctrlv1.CreateApiTokenRequest{Name: " discord bot "})
+		assert.True(t, strings.HasPrefix(created.GetToken(), auth.APITokenPrefix))
+		assert.Equal(t, "discord bot", created.GetApiToken().GetName())
+		assert.True(t, strings.HasPrefix(created.GetToken(), created.GetApiToken().GetTokenPrefix()))
+
+		_, err := client.ListUsers(t.Context(), bearerRequest(&hdlctrlv1.ListUsersRequest{}, created.GetToken()))
+		require.NoError(t, err)
+
+		res, err := client.ListApiTokens(t.Context(), bearerRequest(&hdlctrlv1.ListApiTokensRequest{}, created.GetToken()))
+		require.NoError(t, err)
+		require.Len(t, res.Msg.GetApiTokens(), 1)
+
+		listed := res.Msg.GetApiTokens()[0]
+		assert.Equal(t, created.GetApiToken().GetId(), listed.GetId())
+		assert.NotNil(t, listed.GetLastUsedAt(), "last_used_at should be recorded")
+		assert.NotContains(t, listed.String(), created.GetToken())
+	})
+
+	t.Run("失敗: permission key を制限したトークンでは他の権限を使えない", func(t *testing.T) {
+		setup := setupUserServiceTest(t)
+		defer setup.Cleanup()
+
+		client := setupUserServiceClient(t, setup.service)
+		createNormalUser(t, setup.queries, "bob@example.test")
+
+		created := createToken(t, client, &hdlctrlv1.CreateApiTokenRequest{
+			Name:           "readonly",
+			PermissionKeys: []string{entity.PermKey_HostRead},
+		})
+
+		_, err := client.DeleteUser(t.Context(), bearerRequest(&hdlctrlv1.DeleteUserRequest{UserId: "bob@example.test"}, created.GetToken()))
+		require.Error(t, err)
+
+		connectErr := &connect.Error{}
+		require.ErrorAs(t, err, &connectErr)
+		assert.Equal(t, connect.CodePermissionDenied, connectErr.Code())
+
+		_, err = setup.queries.GetUser(t.Context(), "bob@example.test")
+		require.NoError(t, err)
+	})
+
+	t.Run("失敗: API トークンからは発行も JWT の refresh もできない", func(t *testing.T) {
+		setup := setupUserServiceTest(t)
+		defer setup.Cleanup()
+
+		client := setupUserServiceClient(t, setup.service)
+
+		created := createToken(t, client, &hdlctrlv1.CreateApiTokenRequest{Name: "bot"})
+
+		_, err := client.CreateApiToken(t.Context(), bearerRequest(&hdlctrlv1.CreateApiTokenRequest{Name: "child"}, created.GetToken()))
+		require.Error(t, err)
+		assert.Equal(t, connect.CodePermissionDenied, connect.CodeOf(err))
+
+		_, err = setup.service.RefreshToken(t.Context(), bearerRequest(&hdlctrlv1.RefreshTokenRequest{}, created.GetToken()))
+		require.Error(t, err)
+		assert.Equal(t, connect.CodeUnauthenticated, connect.CodeOf(err))
+	})
+
+	t.Run("成功: 失効したトークンは使えなくなる", func(t *testing.T) {
+		setup := setupUserServiceTest(t)
+		defer setup.Cleanup()
+
+		client := setupUserServiceClient(t, setup.service)
+
+		created := createToken(t, client, &hdlctrlv1.CreateApiTokenRequest{Name: "bot"})
+
+		_, err := client.RevokeApiToken(t.Context(), testutil.CreateDefaultAuthenticatedRequest(t, &hdlctrlv1.RevokeApiTokenRequest{
+			Id: created.GetApiToken().GetId(),
+		}))
+		require.NoError(t, err)
+
+		_, err = client.ListUsers(t.Context(), bearerRequest(&hdlctrlv1.ListUsersRequest{}, created.GetToken()))
+		require.Error(t, err)
+		assert.Equal(t, connect.CodeUnauthenticated, connect.CodeOf(err))
+
+		// 二重の失効は NotFound.
+		_, err = client.RevokeApiToken(t.Context(), testutil.CreateDefaultAuthenticatedRequest(t, &hdlctrlv1.RevokeApiTokenRequest{
+			Id: created.GetApiToken().GetId(),
+		}))
+		require.Error(t, err)
+		assert.Equal(t, connect.CodeNotFound, connect.CodeOf(err))
+	})
+
+	t.Run("失敗: 他人のトークンは失効できない", func(t *testing.T) {
+		setup := setupUserServiceTest(t)
+		defer setup.Cleanup()
+
+		client := setupUserServiceClient(t, setup.service)
+		createNormalUser(t, setup.queries, "alice@example.test")
+
+		created := createToken(t, client, &hdlctrlv1.CreateApiTokenRequest{Name: "bot"})
+
+		_, err := client.RevokeApiToken(t.Context(), testutil.CreateAuthenticatedRequest(
+			t,
+			&hdlctrlv1.RevokeApiTokenRequest{Id: created.GetApiToken().GetId()},
+			"alice@example.test", "U-alice", "",
+		))
+		require.Error(t, err)
+		assert.Equal(t, connect.CodeNotFound, connect.CodeOf(err))
+	})
+
+	t.Run("失敗: 不正な permission key / グループ制限と system 権限の組み合わせ", func(t *testing.T) {
+		setup := setupUserServiceTest(t)
+		defer setup.Cleanup()
+
+		client := setupUserServiceClient(t, setup.service)
+		groupID := "test@example.test-personal"
+
+		for _, msg := range []*hdlctrlv1.CreateApiTokenRequest{
+			{Name: "bot", PermissionKeys: []string{"host:nope"}},
+			{Name: "bot", GroupId: &groupID, PermissionKeys: []string{entity.PermKey_SystemUserList}},
+			{Name: ""},
+		} {
+			_, err := client.CreateApiToken(t.Context(), testutil.CreateDefaultAuthenticatedRequest(t, msg))
+			require.Error(t, err)
+			assert.Equal(t, connect.CodeInvalidArgument, connect.CodeOf(err))
+		}
+	})
+}
+
+// setupOidcLoginClient はモック IdP に向けた SSO 有効な UserService のクライアントを返す.
+func setupOidcLoginClient(t *testing.T, setup *userServiceTestSetup, opts usecase.OIDCLoginOptions) (hdlctrlv1connect.UserServiceClient, *testutil.MockOIDCProvider) {
+	t.Helper()
+
+	idp := testutil.NewMockOIDCProvider(t, "brhc")
+	provider := adapter.NewOIDCProvider(&config.OIDCConfig{
+		IssuerURL:   idp.Issuer(),
+		ClientID:    "brhc",
+		RedirectURL: "http://localhost:8014/sign-in/oidc",
+		Scopes:      []string{"openid", "email"},
+	})
+
+	if opts.UserIDClaim == "" {
+		opts.UserIDClaim = "email"
+	}
+
+	permUC := newPermissionUsecaseForTest(setup.queries)
+	guc := newGroupUsecaseForTest(setup.queries, permUC)
+	uu := usecase.NewUserUsecase(setup.queries, setup.pool, setup.mockSkyfrost, guc, permUC)
+	oidcUC := usecase.NewOIDCLoginUsecase(setup.queries, setup.pool, provider, guc, opts)
+	service := NewUserService(uu, newApiTokenUsecaseForTest(setup.queries), oidcUC, newTOTPUsecaseForTest(setup.queries, setup.pool, false), newUserSessionUsecaseForTest(setup.queries), permUC, newAuditUsecaseForTest(setup.queries))
+
+	return setupUserServiceClient(t, service), idp
+}
+
+// oidcLogin は Begin → IdP → Complete を 1 周して結果を返す.
+func oidcLogin(t *testing.T, client hdlctrlv1connect.UserServiceClient, idp *testutil.MockOIDCProvider) (*connect.Response[hdlctrlv1.TokenSetResponse], error) {
+	t.Helper()
+
+	begin, err := client.BeginOidcLogin(t.Context(), connect.NewRequest(&hdlctrlv1.BeginOidcLoginRequest{}))
+	require.NoError(t, err)
+
+	code, state := idp.Authorize(t, begin.Msg.GetAuthorizationUrl())
+	require.Equal(t, begin.Msg.GetState(), state)
+
+	return client.CompleteOidcLogin(t.Context(), connect.NewRequest(&hdlctrlv1.CompleteOidcLoginRequest{
+		Code:  code,
+		State: state,
+	}))
+}
+
+// oidcLink は token のユーザーで Begin → IdP → Complete の紐付けを 1 周する.
+func oidcLink(t *testing.T, client hdlctrlv1connect.UserServiceClient, idp *testutil.MockOIDCProvider, token string) error {
+	t.Helper()
+
+	begin, err := client.BeginOidcLink(t.Context(), bearerRequest(&hdlctrlv1.BeginOidcLinkRequest{}, token))
+	require.NoError(t, err)
+
+	code, state := idp.Authorize(t, begin.Msg.GetAuthorizationUrl())
+	require.Equal(t, begin.Msg.GetState(), state)
+
+	_, err = client.CompleteOidcLink(t.Context(), bearerRequest(&hdlctrlv1.CompleteOidcLinkRequest{Code: code, State: state}, token))
+
+	return err
+}
+
+func TestUserService_OidcLogin(t *testing.T) {
+	t.Run("成功: ログイン中に紐付けたアカウントでサインインできる", func(t *testing.T) {
+		setup := setupUserServiceTest(t)
+		defer setup.Cleanup()
+
+		createNormalUser(t, setup.queries, "alice@example.test")
+
+		client, idp := setupOidcLoginClient(t, setup, usecase.OIDCLoginOptions{})
+		idp.SetUser("sub-alice", map[string]any{"email": "alice@example.test", "email_verified": true})
+
+		login := loginWithUserAgent(t, client, "alice@example.test", "browser")
+		require.NoError(t, oidcLink(t, client, idp, login.GetToken()))
+
+		res, err := oidcLogin(t, client, idp)
+		require.NoError(t, err)
+		assert.NotEmpty(t, res.Msg.GetRefreshToken())
+
+		claims, err := auth.ParseToken(res.Msg.GetToken())
+		require.NoError(t, err)
+		assert.Equal(t, "alice@example.test", claims.UserID)
+
+		// 紐付け後は email が変わっても同じユーザーとしてログインする.
+		idp.SetUser("sub-alice", map[string]any{"email": "alice@new.example.test", "email_verified": true})
+
+		res, err = oidcLogin(t, client, idp)
+		require.NoError(t, err)
+
+		claims, err = auth.ParseToken(res.Msg.GetToken())
+		require.NoError(t, err)
+		assert.Equal(t, "alice@example.test", claims.UserID)
+	})
+
+	t.Run("失敗: claim が一致しても既存ユーザーには自動で紐付けない", func(t *testing.T) {
+		setup := setupUserServiceTest(t)
+		defer setup.Cleanup()
+
+		createNormalUser(t, setup.queries, "alice@example.test")
+
+		client, idp := setupOidcLoginClient(t, setup, usecase.OIDCLoginOptions{AutoProvision: true})
+		idp.SetUser("sub-mallory", map[string]any{"email": "alice@example.test", "email_verified": true})
+
+		_, err := oidcLogin(t, client, idp)
+		require.Error(t, err)
+		assert.Equal(t, connect.CodePermissionDenied, connect.CodeOf(err))
+
+		_, err = setup.queries.GetUserIdentity(t.Context(), db.GetUserIdentityParams{Issuer: idp.Issuer(), Subject: "sub-mallory"})
+		require.ErrorIs(t, err, pgx.ErrNoRows)
+	})
+
+	t.Run("成功: 自動プロビジョニングで personal グループと指定グループに所属させる", func(t *testing.T) {
+		setup := setupUserServiceTest(t)
+		defer setup.Cleanup()
+
+		testutil.CreateTestGroup(t, setup.queries, "team", "test@example.test")
+
+		client, idp := setupOidcLoginClient(t, setup, usecase.OIDCLoginOptions{
+			AutoProvision:    true,
+			PersonalRoleID:   entity.SeedRoleID_User,
+			ProvisionGroupID: "team",
+			ProvisionRoleID:  entity.SeedRoleID_SessionOperator,
+		})
+		idp.SetUser("sub-bob", map[string]any{"email": "bob@example.test", "email_verified": true})
+
+		_, err := oidcLogin(t, client, idp)
+		require.NoError(t, err)
+
+		user, err := setup.queries.GetUser(t.Context(), "bob@example.test")
+		require.NoError(t, err)
+		assert.Empty(t, user.Password, "SSO ユーザーはパスワードでログインできない")
+
+		personal, err := setup.queries.GetGroupMember(t.Context(), db.GetGroupMemberParams{GroupID: "bob@example.test-personal", UserID: "bob@example.test"})
+		require.NoError(t, err)
+		assert.Equal(t, entity.SeedRoleID_User, personal.RoleID)
+
+		team, err := setup.queries.GetGroupMember(t.Context(), db.GetGroupMemberParams{GroupID: "team", UserID: "bob@example.test"})
+		require.NoError(t, err)
+		assert.Equal(t, entity.SeedRoleID_SessionOperator, team.RoleID)
+	})
+
+	t.Run("失敗: プロビジョニングが途中で失敗したらユーザーを残さない", func(t *testing.T) {
+		setup := setupUserServiceTest(t)
+		defer setup.Cleanup()
+
+		client, idp := setupOidcLoginClient(t, setup, usecase.OIDCLoginOptions{
+			AutoProvision:    true,
+			ProvisionGroupID: "missing-group",
+			ProvisionRoleID:  entity.SeedRoleID_User,
+		})
+		idp.SetUser("sub-erin", map[string]any{"email": "erin@example.test", "email_verified": true})
+
+		_, err := oidcLogin(t, client, idp)
+		require.Error(t, err)
+
+		_, err = setup.queries.GetUser(t.Context(), "erin@example.test")
+		require.ErrorIs(t, err, pgx.ErrNoRows)
+
+		_, err = setup.queries.GetUserIdentity(t.Context(), db.GetUserIdentityParams{Issuer: idp.Issuer(), Subject: "sub-erin"})
+		require.ErrorIs(t, err, pgx.ErrNoRows)
+	})
+
+	t.Run("失敗: 自動プロビジョニング無しで未知のアカウント → PermissionDenied", func(t *testing.T) {
+		setup := setupUserServiceTest(t)
+		defer setup.Cleanup()
+
+		client, idp := setupOidcLoginClient(t, setup, usecase.OIDCLoginOptions{})
+		idp.SetUser("sub-carol", map[string]any{"email": "carol@example.test", "email_verified": true})
+
+		_, err := oidcLogin(t, client, idp)
+		require.Error(t, err)
+		assert.Equal(t, connect.CodePermissionDenied, connect.CodeOf(err))
+	})
+
+	t.Run("失敗: 未検証 / 検証状態の無いメールアドレスではユーザーを作らない", func(t *testing.T) {
+		setup := setupUserServiceTest(t)
+		defer setup.Cleanup()
+
+		client, idp := setupOidcLoginClient(t, setup, usecase.OIDCLoginOptions{AutoProvision: true})
+
+		for _, claims := range []map[string]any{
+			{"email": "dave@example.test", "email_verified": false},
+			// email_verified を返さない IdP は未検証として扱う.
+			{"email": "dave@example.test"},
+		} {
+			idp.SetUser("sub-dave", claims)
+
+			_, err := oidcLogin(t, client, idp)
+			require.Error(t, err)
+			assert.Equal(t, connect.CodePermissionDenied, connect.CodeOf(err))
+		}
+
+		_, err := setup.queries.GetUser(t.Context(), "dave@example.test")
+		require.ErrorIs(t, err, pgx.ErrNoRows)
+	})
+
+	t.Run("失敗: 紐付け用の state は他のユーザーやサインインには使えない", func(t *testing.T) {
+		setup := setupUserServiceTest(t)
+		defer setup.Cleanup()
+
+		createNormalUser(t, setup.queries, "alice@example.test")
+
+		client, idp := setupOidcLoginClient(t, setup, usecase.OIDCLoginOptions{})
+		idp.SetUser("sub-alice", map[string]any{"email": "alice@example.test", "email_verified": true})
+
+		alice := loginWithUserAgent(t, client, "alice@example.test", "browser")
+		other := loginWithUserAgent(t, client, "test@example.test", "browser")
+
+		beginLink := func() (string, string) {
+			begin, err := client.BeginOidcLink(t.Context(), bearerRequest(&hdlctrlv1.BeginOidcLinkRequest{}, alice.GetToken()))
+			require.NoError(t, err)
+
+			return idp.Authorize(t, begin.Msg.GetAuthorizationUrl())
+		}
+
+		code, state := beginLink()
+		_, err := client.CompleteOidcLink(t.Context(), bearerRequest(&hdlctrlv1.CompleteOidcLinkRequest{Code: code, State: state}, other.GetToken()))
+		assert.Equal(t, connect.CodePermissionDenied, connect.CodeOf(err))
+
+		code, state = beginLink()
+		_, err = client.CompleteOidcLogin(t.Context(), connect.NewRequest(&hdlctrlv1.CompleteOidcLoginRequest{Code: code, State: state}))
+		assert.Equal(t, connect.CodeUnauthenticated, connect.CodeOf(err))
+
+		// サインイン用の state では紐付けられない.
+		begin, err := client.BeginOidcLogin(t.Context(), connect.NewRequest(&hdlctrlv1.BeginOidcLoginRequest{}))
+		require.NoError(t, err)
+
+		code, state = idp.Authorize(t, begin.Msg.GetAuthorizationUrl())
+		_, err = client.CompleteOidcLink(t.Context(), bearerRequest(&hdlctrlv1.CompleteOidcLinkRequest{Code: code, State: state}, alice.GetToken()))
+		assert.Equal(t, connect.CodePermissionDenied, connect.CodeOf(err))
+
+		_, err = setup.queries.GetUserIdentity(t.Context(), db.GetUserIdentityParams{Issuer: idp.Issuer(), Subject: "sub-alice"})
+		require.ErrorIs(t, err, pgx.ErrNoRows)
+	})
+
+	t.Run("失敗: 他のユーザーに紐付いたアカウントは紐付けられない", func(t *testing.T) {
+		setup := setupUserServiceTest(t)
+		defer setup.Cleanup()
+
+		createNormalUser(t, setup.queries, "alice@example.test")
+
+		client, idp := setupOidcLoginClient(t, setup, usecase.OIDCLoginOptions{})
+		idp.SetUser("sub-alice", map[string]any{"email": "alice@example.test", "email_verified": true})
+
+		alice := loginWithUserAgent(t, client, "alice@example.test", "browser")
+		require.NoError(t, oidcLink(t, client, idp, alice.GetToken()))
+		// 同じユーザーに紐付け直すのは何もしない.
+		require.NoError(t, oidcLink(t, client, idp, alice.GetToken()))
+
+		other := loginWithUserAgent(t, client, "test@example.test", "browser")
+		err := oidcLink(t, client, idp, other.GetToken())
+		assert.Equal(t, connect.CodePermissionDenied, connect.CodeOf(err))
+	})
+
+	t.Run("失敗: system ユーザーには紐付けない", func(t *testing.T) {
+		setup := setupUserServiceTest(t)
+		defer setup.Cleanup()
+
+		client, idp := setupOidcLoginClient(t, setup, usecase.OIDCLoginOptions{UserIDClaim: "preferred_username"})
+		idp.SetUser("sub-system", map[string]any{"preferred_username": "system"})
+
+		_, err := oidcLogin(t, client, idp)
+		require.Error(t, err)
+		assert.Equal(t, connect.CodePermissionDenied, connect.CodeOf(err))
+	})
+
+	t.Run("失敗: 同じ state は 2 回使えない", func(t *testing.T) {
+		setup := setupUserServiceTest(t)
+		defer setup.Cleanup()
+
+		client, idp := setupOidcLoginClient(t, setup, usecase.OIDCLoginOptions{AutoProvision: true})
+		idp.SetUser("sub-alice", map[string]any{"email": "alice@example.test", "email_verified": true})
+
+		begin, err := client.BeginOidcLogin(t.Context(), connect.NewRequest(&hdlctrlv1.BeginOidcLoginRequest{}))
+		require.NoError(t, err)
+
+		code, state := idp.Authorize(t, begin.Msg.GetAuthorizationUrl())
+		req := &hdlctrlv1.CompleteOidcLoginRequest{Code: code, State: state}
+
+		_, err = client.CompleteOidcLogin(t.Context(), connect.NewRequest(req))
+		require.NoError(t, err)
+
+		_, err = client.CompleteOidcLogin(t.Context(), connect.NewRequest(req))
+		require.Error(t, err)
+		assert.Equal(t, connect.CodeUnauthenticated, connect.CodeOf(err))
+	})
+
+	t.Run("SSO 無効: GetLoginOptions は無効を返し Begin は FailedPrecondition", func(t *testing.T) {
+		setup := setupUserServiceTest(t)
+		defer setup.Cleanup()
+
+		client := setupUserServiceClient(t, setup.service)
+
+		opts, err := client.GetLoginOptions(t.Context(), connect.NewRequest(&hdlctrlv1.GetLoginOptionsRequest{}))
+		require.NoError(t, err)
+		assert.False(t, opts.Msg.GetOidcEnabled())
+
+		_, err = client.BeginOidcLogin(t.Context(), connect.NewRequest(&hdlctrlv1.BeginOidcLoginRequest{}))
+		require.Error(t, err)
+		assert.Equal(t, connect.CodeFailedPrecondition, connect.CodeOf(err))
+	})
+}
+
+// setupTotpClient は 2 段階認証の必須設定を切り替えた UserService のクライアントを返す.
+func setupTotpClient(t *testing.T, setup *userServiceTestSetup, requiredForSystemRoles bool) hdlctrlv1connect.UserServiceClient {
+	t.Helper()
+
+	permUC := newPermissionUsecaseForTest(setup.queries)
+	guc := newGroupUsecaseForTest(setup.queries, permUC)
+	uu := usecase.NewUserUsecase(setup.queries, setup.pool, setup.mockSkyfrost, guc, permUC)
+	service := NewUserService(uu, newApiTokenUsecaseForTest(setup.queries),
+		usecase.NewOIDCLoginUsecase(setup.queries, setup.pool, nil, guc, usecase.OIDCLoginOptions{}),
+		newTOTPUsecaseForTest(setup.queries, setup.pool, requiredForSystemRoles),
+		newUserSessionUsecaseForTest(setup.queries),
+		permUC, newAuditUsecaseForTest(setup.queries))
+
+	return setupUserServiceClient(t, service)
+}
+
+// totpCode は base32 の secret から現在 + offset ステップのコードを作る (認証アプリの代わり).
+func totpCode(t *testing.T, secret string, offset int64) string {
+	t.Helper()
+
+	raw, err := base32.StdEncoding.WithPadding(base32.NoPadding).DecodeString(secret)
+	require.NoError(t, err)
+
+	return totp.Code(raw, totp.Step(time.Now())+offset)
+}
+
+// enrollTotp は access token で TOTP を登録し、secret とリカバリーコードを返す.
+func enrollTotp(t *testing.T, client hdlctrlv1connect.UserServiceClient) (string, []string) {
+	t.Helper()
+
+	begin, err := client.BeginTotpEnrollment(t.Context(), testutil.CreateDefaultAuthenticatedRequest(t, &hdlctrlv1.BeginTotpEnrollmentRequest{}))
+	require.NoError(t, err)
+	assert.True(t, strings.HasPrefix(begin.Msg.GetKeyUri(), "otpauth://totp/"))
+
+	confirm, err := client.ConfirmTotpEnrollment(t.Context(), testutil.CreateDefaultAuthenticatedRequest(t, &hdlctrlv1.ConfirmTotpEnrollmentRequest{
+		Code: totpCode(t, begin.Msg.GetSecret(), 0),
+	}))
+	require.NoError(t, err)
+	assert.Nil(t, confirm.Msg.GetTokens())
+
+	return begin.Msg.GetSecret(), confirm.Msg.GetRecoveryCodes()
+}
+
+func passwordLogin(t *testing.T, client hdlctrlv1connect.UserServiceClient) *hdlctrlv1.TokenSetResponse {
+	t.Helper()
+
+	res, err := client.GetTokenByPassword(t.Context(), connect.NewRequest(&hdlctrlv1.GetTokenByPasswordRequest{
+		Id:       "test@example.test",
+		Password: "dummy-password",
+	}))
+	require.NoError(t, err)
+
+	return res.Msg
+}
+
+func TestUserService_Totp(t *testing.T) {
+	verify := func(client hdlctrlv1connect.UserServiceClient, challenge, code string) (*connect.Response[hdlctrlv1.TokenSetResponse], error) {
+		return client.VerifyTotpLogin(t.Context(), connect.NewRequest(&hdlctrlv1.VerifyTotpLoginRequest{
+			Challenge: challenge,
+			Code:      code,
+		}))
+	}
+
+	t.Run("成功: 登録後はパスワードの後にコードを確認してからトークンを発行", func(t *testing.T) {
+		setup := setupUserServiceTest(t)
+		defer setup.Cleanup()
+
+		client := setupTotpClient(t, setup, false)
+
+		assert.NotEmpty(t, passwordLogin(t, client).GetToken(), "not enrolled yet")
+
+		secret, recoveryCodes := enrollTotp(t, client)
+		assert.Len(t, recoveryCodes, 10)
+
+		status, err := client.GetTotpStatus(t.Context(), testutil.CreateDefaultAuthenticatedRequest(t, &hdlctrlv1.GetTotpStatusRequest{}))
+		require.NoError(t, err)
+		assert.True(t, status.Msg.GetEnabled())
+		assert.False(t, status.Msg.GetRequired())
+		assert.Equal(t, int32(10), status.Msg.GetRemainingRecoveryCodes())
+
+		login := passwordLogin(t, client)
+		assert.Empty(t, login.GetToken())
+		assert.Empty(t, login.GetRefreshToken())
+		require.NotEmpty(t, login.GetTotpChallenge())
+		assert.False(t, login.GetTotpEnrollmentRequired())
+
+		_, err = verify(client, login.GetTotpChallenge(), "not-a-code")
+		assert.Equal(t, connect.CodeUnauthenticated, connect.CodeOf(err))
+
+		// 登録時に使ったステップのコードは使えないので次のステップのコードを使う.
+		code := totpCode(t, secret, 1)
+
+		res, err := verify(client, login.GetTotpChallenge(), code)
+		require.NoError(t, err)
+		assert.NotEmpty(t, res.Msg.GetToken())
+		assert.NotEmpty(t, res.Msg.GetRefreshToken())
+
+		_, err = verify(client, login.GetTotpChallenge(), code)
+		assert.Equal(t, connect.CodeUnauthenticated, connect.CodeOf(err), "challenge is single use")
+
+		_, err = verify(client, passwordLogin(t, client).GetTotpChallenge(), code)
+		assert.Equal(t, connect.CodeUnauthenticated, connect.CodeOf(err), "code cannot be replayed")
+	})
+
+	t.Run("成功: リカバリーコードは 1 回ずつ使える", func(t *testing.T) {
+		setup := setupUserServiceTest(t)
+		defer setup.Cleanup()
+
+		client := setupTotpClient(t, setup, false)
+		_, recoveryCodes := enrollTotp(t, client)
+
+		res, err := verify(client, passwordLogin(t, client).GetTotpChallenge(), strings.ToUpper(recoveryCodes[0]))
+		require.NoError(t, err)
+		assert.NotEmpty(t, res.Msg.GetToken())
+
+		_, err = verify(client, passwordLogin(t, client).GetTotpChallenge(), recoveryCodes[0])
+		assert.Equal(t, connect.CodeUnauthenticated, connect.CodeOf(err))
+
+		status, err := client.GetTotpStatus(t.Context(), testutil.CreateDefaultAuthenticatedRequest(t, &hdlctrlv1.GetTotpStatusRequest{}))
+		require.NoError(t, err)
+		assert.Equal(t, int32(9), status.Msg.GetRemainingRecoveryCodes())
+	})
+
+	t.Run("失敗: 試行回数の上限を超えた challenge は正しいコードでも通らない", func(t *testing.T) {
+		setup := setupUserServiceTest(t)
+		defer setup.Cleanup()
+
+		client := setupTotpClient(t, setup, false)
+		_, recoveryCodes := enrollTotp(t, client)
+
+		challenge := passwordLogin(t, client).GetTotpChallenge()
+		for range 5 {
+			_, err := verify(client, challenge, "wrong-code")
+			assert.Equal(t, connect.CodeUnauthenticated, connect.CodeOf(err))
+		}
+
+		_, err := verify(client, challenge, recoveryCodes[0])
+		assert.Equal(t, connect.CodeUnauthenticated, connect.CodeOf(err))
+	})
+
+	t.Run("成功: コードを確認して無効にするとパスワードだけでサインインできる", func(t *testing.T) {
+		setup := setupUserServiceTest(t)
+		defer setup.Cleanup()
+
+		client := setupTotpClient(t, setup, false)
+		secret, _ := enrollTotp(t, client)
+
+		_, err := client.DisableTotp(t.Context(), testutil.CreateDefaultAuthenticatedRequest(t, &hdlctrlv1.DisableTotpRequest{Code: "000000x"}))
+		assert.Equal(t, connect.CodeInvalidArgument, connect.CodeOf(err))
+
+		_, err = client.DisableTotp(t.Context(), testutil.CreateDefaultAuthenticatedRequest(t, &hdlctrlv1.DisableTotpRequest{
+			Code: totpCode(t, secret, 1),
+		}))
+		require.NoError(t, err)
+
+		assert.NotEmpty(t, passwordLogin(t, client).GetToken())
+	})
+
+	t.Run("成功: 必須のユーザーは challenge で登録してからサインインし、無効にはできない", func(t *testing.T) {
+		setup := setupUserServiceTest(t)
+		defer setup.Cleanup()
+
+		client := setupTotpClient(t, setup, true)
+
+		login := passwordLogin(t, client)
+		assert.Empty(t, login.GetToken())
+		require.NotEmpty(t, login.GetTotpChallenge())
+		assert.True(t, login.GetTotpEnrollmentRequired())
+
+		_, err := verify(client, login.GetTotpChallenge(), "123456")
+		assert.Equal(t, connect.CodeFailedPrecondition, connect.CodeOf(err))
+
+		begin, err := client.BeginTotpEnrollment(t.Context(), connect.NewRequest(&hdlctrlv1.BeginTotpEnrollmentRequest{
+			Challenge: login.GetTotpChallenge(),
+		}))
+		require.NoError(t, err)
+
+		confirm, err := client.ConfirmTotpEnrollment(t.Context(), connect.NewRequest(&hdlctrlv1.ConfirmTotpEnrollmentRequest{
+			Challenge: login.GetTotpChallenge(),
+			Code:      totpCode(t, begin.Msg.GetSecret(), 0),
+		}))
+		require.NoError(t, err)
+		assert.Len(t, confirm.Msg.GetRecoveryCodes(), 10)
+		assert.NotEmpty(t, confirm.Msg.GetTokens().GetToken())
+
+		_, err = client.BeginTotpEnrollment(t.Context(), connect.NewRequest(&hdlctrlv1.BeginTotpEnrollmentRequest{
+			Challenge: login.GetTotpChallenge(),
+		}))
+		assert.Equal(t, connect.CodeUnauthenticated, connect.CodeOf(err), "challenge is consumed by the enrollment")
+
+		_, err = client.DisableTotp(t.Context(), testutil.CreateDefaultAuthenticatedRequest(t, &hdlctrlv1.DisableTotpRequest{
+			Code: confirm.Msg.GetRecoveryCodes()[0],
+		}))
+		assert.Equal(t, connect.CodeFailedPrecondition, connect.CodeOf(err))
+
+		assert.False(t, passwordLogin(t, client).GetTotpEnrollmentRequired())
+	})
+
+	t.Run("失敗: challenge での登録確認も試行回数の上限を超えると正しいコードでも通らない", func(t *testing.T) {
+		setup := setupUserServiceTest(t)
+		defer setup.Cleanup()
+
+		client := setupTotpClient(t, setup, true)
+
+		challenge := passwordLogin(t, client).GetTotpChallenge()
+		require.NotEmpty(t, challenge)
+
+		begin, err := client.BeginTotpEnrollment(t.Context(), connect.NewRequest(&hdlctrlv1.BeginTotpEnrollmentRequest{
+			Challenge: challenge,
+		}))
+		require.NoError(t, err)
+
+		for range 5 {
+			_, err := client.ConfirmTotpEnrollment(t.Context(), connect.NewRequest(&hdlctrlv1.ConfirmTotpEnrollmentRequest{
+				Challenge: challenge,
+				Code:      "wrong-code",
+			}))
+			assert.Equal(t, connect.CodeInvalidArgument, connect.CodeOf(err))
+		}
+
+		_, err = client.ConfirmTotpEnrollment(t.Context(), connect.NewRequest(&hdlctrlv1.ConfirmTotpEnrollmentRequest{
+			Challenge: challenge,
+			Code:      totpCode(t, begin.Msg.GetSecret(), 0),
+		}))
+		assert.Equal(t, connect.CodeUnauthenticated, connect.CodeOf(err))
+	})
+
+	t.Run("失敗: access token も challenge も無ければ登録できない", func(t *testing.T) {
+		setup := setupUserServiceTest(t)
+		defer setup.Cleanup()
+
+		client := setupTotpClient(t, setup, false)
+
+		_, err := client.BeginTotpEnrollment(t.Context(), connect.NewRequest(&hdlctrlv1.BeginTotpEnrollmentRequest{}))
+		assert.Equal(t, connect.CodeUnauthenticated, connect.CodeOf(err))
+	})
+}
+
+// loginWithUserAgent は userID でパスワードログインし、userAgent の端末のセッションを作る.
+func loginWithUserAgent(t *testing.T, client hdlctrlv1connect.UserServiceClient, userID, userAgent string) *hdlctrlv1.TokenSetResponse {
+	t.Helper()
+
+	req := connect.NewRequest(&hdlctrlv1.GetTokenByPasswordRequest{Id: userID, Password: "dummy-password"})
+	req.Header().Set("User-Agent", userAgent)
+
+	res, err := client.GetTokenByPassword(t.Context(), req)
+	require.NoError(t, err)
+	require.NotEmpty(t, res.Msg.GetToken())
+
+	return res.Msg
+}
+
+func TestUserService_Sessions(t *testing.T) {
+	listSessions := func(client hdlctrlv1connect.UserServiceClient, token string) ([]*hdlctrlv1.UserSession, error) {
+		res, err := client.ListMySessions(t.Context(), bearerRequest(&hdlctrlv1.ListMySessionsRequest{}, token))
+		if err != nil {
+			return nil, err
+		}
+
+		return res.Msg.GetSessions(), nil
+	}
+
+	refresh := func(client hdlctrlv1connect.UserServiceClient, refreshToken string) (*hdlctrlv1.TokenSetResponse, error) {
+		res, err := client.RefreshToken(t.Context(), bearerRequest(&hdlctrlv1.RefreshTokenRequest{}, refreshToken))
+		if err != nil {
+			return nil, err
+		}
+
+		return res.Msg, nil
+	}
+
+	t.Run("成功: ログインごとのセッションを端末情報付きで一覧できる", func(t *testing.T) {
+		setup := setupUserServiceTest(t)
+		defer setup.Cleanup()
+
+		client := setupUserServiceClient(t, setup.service)
+
+		laptop := loginWithUserAgent(t, client, "test@example.test", "laptop-browser")
+		_ = loginWithUserAgent(t, client, "test@example.test", "phone-browser")
+
+		sessions, err := listSessions(client, laptop.GetToken())
+		require.NoError(t, err)
+		require.Len(t, sessions, 2)
+
+		var current []*hdlctrlv1.UserSession
+
+		for _, s := range sessions {
+			assert.Equal(t, "127.0.0.1", s.GetIpAddress())
+
+			if s.GetCurrent() {
+				current = append(current, s)
+			}
+		}
+
+		require.Len(t, current, 1)
+		assert.Equal(t, "laptop-browser", current[0].GetUserAgent())
+	})
+
+	t.Run("成功: refresh token は使うたびに世代が進み、使い回すとセッションごと失効", func(t *testing.T) {
+		setup := setupUserServiceTest(t)
+		defer setup.Cleanup()
+
+		client := setupUserServiceClient(t, setup.service)
+
+		login := loginWithUserAgent(t, client, "test@example.test", "browser")
+
+		second, err := refresh(client, login.GetRefreshToken())
+		require.NoError(t, err)
+
+		// 直前の世代は同時に refresh したタブのために少しの間だけ受け付ける.
+		_, err = refresh(client, login.GetRefreshToken())
+		require.NoError(t, err)
+
+		third, err := refresh(client, second.GetRefreshToken())
+		require.NoError(t, err)
+
+		_, err = refresh(client, login.GetRefreshToken())
+		assert.Equal(t, connect.CodeUnauthenticated, connect.CodeOf(err), "reused an old refresh token")
+
+		_, err = refresh(client, third.GetRefreshToken())
+		assert.Equal(t, connect.CodeUnauthenticated, connect.CodeOf(err), "the whole session is revoked")
+
+		_, err = listSessions(client, third.GetToken())
+		assert.Equal(t, connect.CodeUnauthenticated, connect.CodeOf(err))
+	})
+
+	t.Run("失敗: refresh token は access token として使えない", func(t *testing.T) {
+		setup := setupUserServiceTest(t)
+		defer setup.Cleanup()
+
+		client := setupUserServiceClient(t, setup.service)
+
+		login := loginWithUserAgent(t, client, "test@example.test", "browser")
+
+		_, err := listSessions(client, login.GetRefreshToken())
+		assert.Equal(t, connect.CodeUnauthenticated, connect.CodeOf(err))
+
+		_, err = client.GetUser(t.Context(), bearerRequest(&hdlctrlv1.GetUserRequest{UserId: "test@example.test"}, login.GetRefreshToken()))
+		assert.Equal(t, connect.CodeUnauthenticated, connect.CodeOf(err))
+
+		_, err = refresh(client, login.GetRefreshToken())
+		require.NoError(t, err)
+	})
+
+	t.Run("失敗: access token とセッションの無い refresh token では refresh できない", func(t *testing.T) {
+		setup := setupUserServiceTest(t)
+		defer setup.Cleanup()
+
+		client := setupUserServiceClient(t, setup.service)
+
+		login := loginWithUserAgent(t, client, "test@example.test", "browser")
+
+		_, err := refresh(client, login.GetToken())
+		assert.Equal(t, connect.CodeUnauthenticated, connect.CodeOf(err))
+
+		_, legacyRefreshToken, err := auth.GenerateTokensWithDefaultTTL(auth.AuthClaims{UserID: "test@example.test"})
+		require.NoError(t, err)
+
+		_, err = refresh(client, legacyRefreshToken)
+		assert.Equal(t, connect.CodeUnauthenticated, connect.CodeOf(err))
+	})
+
+	t.Run("成功: 失効させたセッションのトークンはすぐに使えなくなる", func(t *testing.T) {
+		setup := setupUserServiceTest(t)
+		defer setup.Cleanup()
+
+		client := setupUserServiceClient(t, setup.service)
+
+		laptop := loginWithUserAgent(t, client, "test@example.test", "laptop-browser")
+		stolen := loginWithUserAgent(t, client, "test@example.test", "stolen-browser")
+
+		sessions, err := listSessions(client, laptop.GetToken())
+		require.NoError(t, err)
+
+		var stolenID string
+
+		for _, s := range sessions {
+			if s.GetUserAgent() == "stolen-browser" {
+				stolenID = s.GetId()
+			}
+		}
+
+		require.NotEmpty(t, stolenID)
+
+		_, err = client.RevokeSession(t.Context(), bearerRequest(&hdlctrlv1.RevokeSessionRequest{Id: stolenID}, laptop.GetToken()))
+		require.NoError(t, err)
+
+		_, err = listSessions(client, stolen.GetToken())
+		assert.Equal(t, connect.CodeUnauthenticated, connect.CodeOf(err))
+
+		_, err = refresh(client, stolen.GetRefreshToken())
+		assert.Equal(t, connect.CodeUnauthenticated, connect.CodeOf(err))
+
+		sessions, err = listSessions(client, laptop.GetToken())
+		require.NoError(t, err)
+		assert.Len(t, sessions, 1)
+
+		_, err = client.RevokeSession(t.Context(), bearerRequest(&hdlctrlv1.RevokeSessionRequest{Id: stolenID}, laptop.GetToken()))
+		assert.Equal(t, connect.CodeNotFound, connect.CodeOf(err), "already revoked")
+	})
+
+	t.Run("失敗: 他人のセッションは失効させられない", func(t *testing.T) {
+		setup := setupUserServiceTest(t)
+		defer setup.Cleanup()
+
+		client := setupUserServiceClient(t, setup.service)
+
+		createNormalUser(t, setup.queries, "other@example.test")
+		other := loginWithUserAgent(t, client, "other@example.test", "browser")
+		mine := loginWithUserAgent(t, client, "test@example.test", "browser")
+
+		sessions, err := listSessions(client, other.GetToken())
+		require.NoError(t, err)
+		require.Len(t, sessions, 1)
+
+		_, err = client.RevokeSession(t.Context(), bearerRequest(&hdlctrlv1.RevokeSessionRequest{Id: sessions[0].GetId()}, mine.GetToken()))
+		assert.Equal(t, connect.CodeNotFound, connect.CodeOf(err))
+
+		_, err = listSessions(client, other.GetToken())
+		require.NoError(t, err)
+	})
+
+	t.Run("成功: パスワードを変えると他のセッションだけ失効", func(t *testing.T) {
+		setup := setupUserServiceTest(t)
+		defer setup.Cleanup()
+
+		client := setupUserServiceClient(t, setup.service)
+
+		laptop := loginWithUserAgent(t, client, "test@example.test", "laptop-browser")
+		phone := loginWithUserAgent(t, client, "test@example.test", "phone-browser")
+
+		_, err := client.ChangePassword(t.Context(), bearerRequest(&hdlctrlv1.ChangePasswordRequest{
+			CurrentPassword: "dummy-password",
+			NewPassword:     "new-password-123",
+		}, laptop.GetToken()))
+		require.NoError(t, err)
+
+		_, err = listSessions(client, phone.GetToken())
+		assert.Equal(t, connect.CodeUnauthenticated, connect.CodeOf(err))
+
+		sessions, err := listSessions(client, laptop.GetToken())
+		require.NoError(t, err)
+		require.Len(t, sessions, 1)
+		assert.True(t, sessions[0].GetCurrent())
+	})
+
+	t.Run("成功: グループから外す / 権限の減るロールに変えるとセッションを失効", func(t *testing.T) {
+		setup := setupUserServiceTest(t)
+		defer setup.Cleanup()
+
+		client := setupUserServiceClient(t, setup.service)
+
+		createNormalUser(t, setup.queries, "member@example.test")
+		testutil.CreateTestGroup(t, setup.queries, "team", "test@example.test")
+		_, err := setup.queries.AddGroupMember(t.Context(), db.AddGroupMemberParams{
+			GroupID: "team",
+			UserID:  "member@example.test",
+			RoleID:  entity.SeedRoleID_User,
+		})
+		require.NoError(t, err)
+
+		guc := newGroupUsecaseForTest(setup.queries, newPermissionUsecaseForTest(setup.queries))
+		adminCtx := testutil.CreateAuthenticatedContext("test@example.test", "", "")
+
+		// 権限が増えるだけのロール変更では失効させない.
+		login := loginWithUserAgent(t, client, "member@example.test", "browser")
+		_, err = guc.UpdateGroupMemberRole(adminCtx, "team", "member@example.test", entity.SeedRoleID_Admin)
+		require.NoError(t, err)
+
+		_, err = listSessions(client, login.GetToken())
+		require.NoError(t, err)
+
+		_, err = guc.UpdateGroupMemberRole(adminCtx, "team", "member@example.test", entity.SeedRoleID_User)
+		require.NoError(t, err)
+
+		_, err = listSessions(client, login.GetToken())
+		assert.Equal(t, connect.CodeUnauthenticated, connect.CodeOf(err))
+
+		login = loginWithUserAgent(t, client, "member@example.test", "browser")
+		require.NoError(t, guc.RemoveGroupMember(adminCtx, "team", "member@example.test"))
+
+		_, err = listSessions(client, login.GetToken())
+		assert.Equal(t, connect.CodeUnauthenticated, connect.CodeOf(err))
+	})
+
+	t.Run("成功: 削除したユーザーのトークンはすぐに使えなくなる", func(t *testing.T) {
+		setup := setupUserServiceTest(t)
+		defer setup.Cleanup()
+
+		client := setupUserServiceClient(t, setup.service)
+
+		createNormalUser(t, setup.queries, "victim@example.test")
+		victim := loginWithUserAgent(t, client, "victim@example.test", "browser")
+
+		_, err := client.DeleteUser(t.Context(), testutil.CreateDefaultAuthenticatedRequest(t, &hdlctrlv1.DeleteUserRequest{
+			UserId: "victim@example.test",
+		}))
+		require.NoError(t, err)
+
+		_, err = client.GetUser(t.Context(), bearerRequest(&hdlctrlv1.GetUserRequest{UserId: "test@example.test"}, victim.GetToken()))
+		assert.Equal(t, connect.CodeUnauthenticated, connect.CodeOf(err))
+	})
+
+	t.Run("失敗: API トークンではセッションを操作できない", func(t *testing.T) {
+		setup := setupUserServiceTest(t)
+		defer setup.Cleanup()
+
+		client := setupUserServiceClient(t, setup.service)
+
+		created, err := client.CreateApiToken(t.Context(), testutil.CreateDefaultAuthenticatedRequest(t, &hdlctrlv1.CreateApiTokenRequest{Name: "bot"}))
+		require.NoError(t, err)
+
+		_, err = listSessions(client, created.Msg.GetToken())
+		assert.Equal(t, connect.CodePermissionDenied, connect.CodeOf(err))
+	})
+}
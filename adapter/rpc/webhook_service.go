@@ -0,0 +1,293 @@
+package rpc
+
+import (
+	"context"
+	"net/http"
+	"strings"
+
+	"connectrpc.com/connect"
+	"github.com/go-errors/errors"
+	"github.com/hantabaru1014/baru-reso-headless-controller/domain/entity"
+	"github.com/hantabaru1014/baru-reso-headless-controller/lib/auth"
+	"github.com/hantabaru1014/baru-reso-headless-controller/lib/logging"
+	hdlctrlv1 "github.com/hantabaru1014/baru-reso-headless-controller/pbgen/hdlctrl/v1"
+	"github.com/hantabaru1014/baru-reso-headless-controller/pbgen/hdlctrl/v1/hdlctrlv1connect"
+	"github.com/hantabaru1014/baru-reso-headless-controller/usecase"
+	"github.com/hantabaru1014/baru-reso-headless-controller/usecase/port"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+var _ hdlctrlv1connect.WebhookServiceHandler = (*WebhookService)(nil)
+
+type WebhookService struct {
+	webhookUC   *usecase.WebhookUsecase
+	permUC      *usecase.PermissionUsecase
+	auditUC     *usecase.AuditUsecase
+	webhookRepo port.WebhookRepository
+}
+
+func NewWebhookService(
+	webhookUC *usecase.WebhookUsecase,
+	permUC *usecase.PermissionUsecase,
+	auditUC *usecase.AuditUsecase,
+	webhookRepo port.WebhookRepository,
+) *WebhookService {
+	return &WebhookService{
+		webhookUC:   webhookUC,
+		permUC:      permUC,
+		auditUC:     auditUC,
+		webhookRepo: webhookRepo,
+	}
+}
+
+func (s *WebhookService) NewHandler() (string, http.Handler) {
+	interceptors := connect.WithInterceptors(
+		logging.NewErrorLogInterceptor(),
+		auth.NewAuthInterceptor(),
+		NewAuditInterceptor(s.auditUC),
+		NewPermissionInterceptor(s.permUC, PermissionDeps{
+			WebhookRepo: s.webhookRepo,
+		}),
+	)
+
+	return hdlctrlv1connect.NewWebhookServiceHandler(s, interceptors)
+}
+
+var (
+	_ = registerRPCPermission(
+		hdlctrlv1connect.WebhookServiceListWebhooksProcedure,
+		checkGroupPermission(entity.PermKey_GroupWebhooksManage, func(r *hdlctrlv1.ListWebhooksRequest) string { return r.GetGroupId() }, false),
+	)
+	_ = registerRPCPermission(
+		hdlctrlv1connect.WebhookServiceCreateWebhookProcedure,
+		checkGroupPermission(entity.PermKey_GroupWebhooksManage, func(r *hdlctrlv1.CreateWebhookRequest) string { return r.GetGroupId() }, false),
+	)
+	_ = registerRPCPermission(
+		hdlctrlv1connect.WebhookServiceUpdateWebhookProcedure,
+		checkWebhookPermission(func(r *hdlctrlv1.UpdateWebhookRequest) string { return r.GetId() }),
+	)
+	_ = registerRPCPermission(
+		hdlctrlv1connect.WebhookServiceDeleteWebhookProcedure,
+		checkWebhookPermission(func(r *hdlctrlv1.DeleteWebhookRequest) string { return r.GetId() }),
+	)
+	_ = registerRPCPermission(
+		hdlctrlv1connect.WebhookServiceRotateWebhookSecretProcedure,
+		checkWebhookPermission(func(r *hdlctrlv1.RotateWebhookSecretRequest) string { return r.GetId() }),
+	)
+	_ = registerRPCPermission(
+		hdlctrlv1connect.WebhookServiceTestWebhookProcedure,
+		checkWebhookPermission(func(r *hdlctrlv1.TestWebhookRequest) string { return r.GetId() }),
+	)
+	_ = registerRPCPermission(
+		hdlctrlv1connect.WebhookServiceListWebhookDeliveriesProcedure,
+		checkWebhookPermission(func(r *hdlctrlv1.ListWebhookDeliveriesRequest) string { return r.GetWebhookId() }),
+	)
+)
+
+func (s *WebhookService) ListWebhooks(ctx context.Context, req *connect.Request[hdlctrlv1.ListWebhooksRequest]) (*connect.Response[hdlctrlv1.ListWebhooksResponse], error) {
+	subs, err := s.webhookUC.ListWebhooks(ctx, req.Msg.GetGroupId())
+	if err != nil {
+		return nil, convertErr(err)
+	}
+
+	webhooks := make([]*hdlctrlv1.Webhook, 0, len(subs))
+	for _, sub := range subs {
+		webhooks = append(webhooks, webhookToProto(sub))
+	}
+
+	return connect.NewResponse(&hdlctrlv1.ListWebhooksResponse{Webhooks: webhooks}), nil
+}
+
+func (s *WebhookService) CreateWebhook(ctx context.Context, req *connect.Request[hdlctrlv1.CreateWebhookRequest]) (*connect.Response[hdlctrlv1.CreateWebhookResponse], error) {
+	sub, err := s.webhookUC.CreateWebhook(
+		ctx,
+		req.Msg.GetGroupId(),
+		strings.TrimSpace(req.Msg.GetName()),
+		strings.TrimSpace(req.Msg.GetUrl()),
+		entity.WebhookFormat(req.Msg.GetFormat()),
+		webhookEventTypesFromProto(req.Msg.GetEventTypes()),
+	)
+	if err != nil {
+		return nil, convertErr(err)
+	}
+
+	return connect.NewResponse(&hdlctrlv1.CreateWebhookResponse{
+		Webhook: webhookToProto(sub),
+		Secret:  sub.Secret,
+	}), nil
+}
+
+func (s *WebhookService) UpdateWebhook(ctx context.Context, req *connect.Request[hdlctrlv1.UpdateWebhookRequest]) (*connect.Response[hdlctrlv1.UpdateWebhookResponse], error) {
+	params := port.WebhookSubscriptionUpdateParams{
+		Enabled: req.Msg.Enabled,
+	}
+
+	if req.Msg.Name != nil {
+		name := strings.TrimSpace(req.Msg.GetName())
+		params.Name = &name
+	}
+
+	if req.Msg.Url != nil {
+		url := strings.TrimSpace(req.Msg.GetUrl())
+		params.URL = &url
+	}
+
+	if req.Msg.Format != nil {
+		format := entity.WebhookFormat(req.Msg.GetFormat())
+		params.Format = &format
+	}
+
+	if req.Msg.EventTypes != nil {
+		params.EventTypes = webhookEventTypesFromProto(req.Msg.GetEventTypes().GetEventTypes())
+	}
+
+	sub, err := s.webhookUC.UpdateWebhook(ctx, req.Msg.GetId(), params)
+	if err != nil {
+		return nil, convertErr(err)
+	}
+
+	return connect.NewResponse(&hdlctrlv1.UpdateWebhookResponse{Webhook: webhookToProto(sub)}), nil
+}
+
+func (s *WebhookService) DeleteWebhook(ctx context.Context, req *connect.Request[hdlctrlv1.DeleteWebhookRequest]) (*connect.Response[hdlctrlv1.DeleteWebhookResponse], error) {
+	if err := s.webhookUC.DeleteWebhook(ctx, req.Msg.GetId()); err != nil {
+		return nil, convertErr(err)
+	}
+
+	return connect.NewResponse(&hdlctrlv1.DeleteWebhookResponse{}), nil
+}
+
+func (s *WebhookService) RotateWebhookSecret(ctx context.Context, req *connect.Request[hdlctrlv1.RotateWebhookSecretRequest]) (*connect.Response[hdlctrlv1.RotateWebhookSecretResponse], error) {
+	sub, err := s.webhookUC.RotateWebhookSecret(ctx, req.Msg.GetId())
+	if err != nil {
+		return nil, convertErr(err)
+	}
+
+	return connect.NewResponse(&hdlctrlv1.RotateWebhookSecretResponse{Secret: sub.Secret}), nil
+}
+
+func (s *WebhookService) TestWebhook(ctx context.Context, req *connect.Request[hdlctrlv1.TestWebhookRequest]) (*connect.Response[hdlctrlv1.TestWebhookResponse], error) {
+	delivery, err := s.webhookUC.TestWebhook(ctx, req.Msg.GetId())
+	if err != nil {
+		return nil, convertErr(err)
+	}
+
+	return connect.NewResponse(&hdlctrlv1.TestWebhookResponse{Delivery: webhookDeliveryToProto(delivery)}), nil
+}
+
+func (s *WebhookService) ListWebhookDeliveries(ctx context.Context, req *connect.Request[hdlctrlv1.ListWebhookDeliveriesRequest]) (*connect.Response[hdlctrlv1.ListWebhookDeliveriesResponse], error) {
+	pageIndex, pageSize, err := normalizePageRequest(req.Msg.GetPage())
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := s.webhookUC.ListDeliveries(ctx, req.Msg.GetWebhookId(), pageIndex, pageSize)
+	if err != nil {
+		return nil, convertErr(err)
+	}
+
+	deliveries := make([]*hdlctrlv1.WebhookDelivery, 0, len(result.Items))
+	for _, d := range result.Items {
+		deliveries = append(deliveries, webhookDeliveryToProto(d))
+	}
+
+	return connect.NewResponse(&hdlctrlv1.ListWebhookDeliveriesResponse{
+		Deliveries: deliveries,
+		Page: &hdlctrlv1.PageResponse{
+			TotalCount: result.TotalCount,
+			PageIndex:  pageIndex,
+			PageSize:   pageSize,
+		},
+	}), nil
+}
+
+// checkWebhookPermission は webhook の id を含む RPC 用. webhook の group_id に対して
+// group:webhooks.manage を要求する.
+func checkWebhookPermission[T any](extract idExtractor[T]) permissionCheck {
+	return func(ctx context.Context, req connect.AnyRequest, deps *PermissionDeps, permUC *usecase.PermissionUsecase) error {
+		typed, ok := req.Any().(*T)
+		if !ok {
+			return connect.NewError(connect.CodeInternal, errors.New("unexpected request type in permission rule"))
+		}
+
+		claims, err := extractClaims(ctx)
+		if err != nil {
+			return err
+		}
+
+		id := strings.TrimSpace(extract(typed))
+		if id == "" {
+			return connect.NewError(connect.CodeInvalidArgument, errors.New("webhook id is required"))
+		}
+
+		sub, err := deps.WebhookRepo.GetSubscription(ctx, id)
+		if err != nil {
+			return convertErr(err)
+		}
+
+		return requirePerm(ctx, permUC, claims.UserID, sub.GroupID, entity.PermKey_GroupWebhooksManage)
+	}
+}
+
+func webhookEventTypesFromProto(types []string) []entity.WebhookEventType {
+	result := make([]entity.WebhookEventType, 0, len(types))
+	for _, t := range types {
+		result = append(result, entity.WebhookEventType(t))
+	}
+
+	return result
+}
+
+// webhookToProto は署名鍵を含めない. 鍵は作成 / ローテーションのレスポンスでのみ返す.
+func webhookToProto(sub *entity.WebhookSubscription) *hdlctrlv1.Webhook {
+	eventTypes := make([]string, 0, len(sub.EventTypes))
+	for _, t := range sub.EventTypes {
+		eventTypes = append(eventTypes, string(t))
+	}
+
+	return &hdlctrlv1.Webhook{
+		Id:         sub.ID,
+		GroupId:    sub.GroupID,
+		Name:       sub.Name,
+		Url:        sub.URL,
+		Format:     string(sub.Format),
+		EventTypes: eventTypes,
+		Enabled:    sub.Enabled,
+		CreatedBy:  sub.CreatedBy,
+		CreatedAt:  timestamppb.New(sub.CreatedAt),
+		UpdatedAt:  timestamppb.New(sub.UpdatedAt),
+	}
+}
+
+func webhookDeliveryToProto(d *entity.WebhookDelivery) *hdlctrlv1.WebhookDelivery {
+	var status hdlctrlv1.WebhookDelivery_Status
+
+	switch d.Status {
+	case entity.WebhookDeliveryStatus_PENDING:
+		status = hdlctrlv1.WebhookDelivery_STATUS_PENDING
+	case entity.WebhookDeliveryStatus_SENDING:
+		status = hdlctrlv1.WebhookDelivery_STATUS_SENDING
+	case entity.WebhookDeliveryStatus_SUCCEEDED:
+		status = hdlctrlv1.WebhookDelivery_STATUS_SUCCEEDED
+	case entity.WebhookDeliveryStatus_FAILED:
+		status = hdlctrlv1.WebhookDelivery_STATUS_FAILED
+	}
+
+	var deliveredAt *timestamppb.Timestamp
+	if d.DeliveredAt != nil {
+		deliveredAt = timestamppb.New(*d.DeliveredAt)
+	}
+
+	return &hdlctrlv1.WebhookDelivery{
+		Id:             d.ID,
+		WebhookId:      d.SubscriptionID,
+		EventType:      string(d.EventType),
+		Status:         status,
+		Attempts:       d.Attempts,
+		NextAttemptAt:  timestamppb.New(d.NextAttemptAt),
+		LastStatusCode: d.LastStatusCode,
+		LastError:      d.LastError,
+		DeliveredAt:    deliveredAt,
+		CreatedAt:      timestamppb.New(d.CreatedAt),
+	}
+}
@@ -187,6 +187,136 @@ func (r *ScheduledSessionOperationRepository) Cancel(ctx context.Context, id str
 	return rows > 0, nil
 }
 
+func (r *ScheduledSessionOperationRepository) Rearm(ctx context.Context, id string, nextFireAt time.Time, lastError *string) error {
+	uid, err := parseUUID(id)
+	if err != nil {
+		return err
+	}
+
+	if _, err := r.q.RearmScheduledSessionOperation(ctx, db.RearmScheduledSessionOperationParams{
+		ID:         uid,
+		NextFireAt: pgtype.Timestamptz{Time: nextFireAt, Valid: true},
+		LastError:  textFromPtr(lastError),
+	}); err != nil {
+		return errors.WrapPrefix(convertDBErr(err), "scheduled_session_operation", 0)
+	}
+
+	return nil
+}
+
+func (r *ScheduledSessionOperationRepository) Pause(ctx context.Context, id string) (bool, error) {
+	uid, err := parseUUID(id)
+	if err != nil {
+		return false, err
+	}
+
+	rows, err := r.q.PauseScheduledSessionOperation(ctx, uid)
+	if err != nil {
+		return false, errors.WrapPrefix(convertDBErr(err), "scheduled_session_operation", 0)
+	}
+
+	return rows > 0, nil
+}
+
+func (r *ScheduledSessionOperationRepository) Resume(ctx context.Context, id string, nextFireAt *time.Time) (bool, error) {
+	uid, err := parseUUID(id)
+	if err != nil {
+		return false, err
+	}
+
+	rows, err := r.q.ResumeScheduledSessionOperation(ctx, db.ResumeScheduledSessionOperationParams{
+		ID:         uid,
+		NextFireAt: timestamptzFromPtr(nextFireAt),
+	})
+	if err != nil {
+		return false, errors.WrapPrefix(convertDBErr(err), "scheduled_session_operation", 0)
+	}
+
+	return rows > 0, nil
+}
+
+func (r *ScheduledSessionOperationRepository) Skip(ctx context.Context, id string, currentFireAt, nextFireAt time.Time) (bool, error) {
+	uid, err := parseUUID(id)
+	if err != nil {
+		return false, err
+	}
+
+	rows, err := r.q.SkipScheduledSessionOperationOccurrence(ctx, db.SkipScheduledSessionOperationOccurrenceParams{
+		ID:            uid,
+		NextFireAt:    pgtype.Timestamptz{Time: nextFireAt, Valid: true},
+		CurrentFireAt: pgtype.Timestamptz{Time: currentFireAt, Valid: true},
+	})
+	if err != nil {
+		return false, errors.WrapPrefix(convertDBErr(err), "scheduled_session_operation", 0)
+	}
+
+	return rows > 0, nil
+}
+
+func (r *ScheduledSessionOperationRepository) CreateRun(ctx context.Context, params port.ScheduledOperationRunCreateParams) error {
+	uid, err := parseUUID(params.OperationID)
+	if err != nil {
+		return err
+	}
+
+	if err := r.q.CreateScheduledSessionOperationRun(ctx, db.CreateScheduledSessionOperationRunParams{
+		OperationID:  uid,
+		ScheduledFor: pgtype.Timestamptz{Time: params.ScheduledFor, Valid: true},
+		StartedAt:    timestamptzFromPtr(params.StartedAt),
+		Outcome:      int32(params.Outcome),
+		Error:        textFromPtr(params.Error),
+		InstanceID:   textFromPtr(params.InstanceID),
+	}); err != nil {
+		return errors.WrapPrefix(convertDBErr(err), "scheduled_session_operation_run", 0)
+	}
+
+	return nil
+}
+
+func (r *ScheduledSessionOperationRepository) ListRuns(ctx context.Context, operationID string, pageIndex, pageSize int32) (*port.ScheduledOperationRunListResult, error) {
+	uid, err := parseUUID(operationID)
+	if err != nil {
+		return nil, err
+	}
+
+	if pageSize <= 0 {
+		pageSize = 100
+	}
+
+	rows, err := r.q.ListScheduledSessionOperationRuns(ctx, db.ListScheduledSessionOperationRunsParams{
+		OperationID: uid,
+		PageSize:    pageSize,
+		PageOffset:  pageIndex * pageSize,
+	})
+	if err != nil {
+		return nil, errors.WrapPrefix(convertDBErr(err), "scheduled_session_operation_run", 0)
+	}
+
+	result := &port.ScheduledOperationRunListResult{
+		Items: make(entity.ScheduledOperationRunList, 0, len(rows)),
+	}
+	if len(rows) > 0 {
+		result.TotalCount = int32(rows[0].TotalCount) //nolint:gosec // G115: テーブル件数なので int32 範囲を超えない
+	}
+
+	for _, row := range rows {
+		run := row.ScheduledSessionOperationRun
+
+		result.Items = append(result.Items, &entity.ScheduledOperationRun{
+			ID:           run.ID,
+			OperationID:  operationID,
+			ScheduledFor: run.ScheduledFor.Time,
+			StartedAt:    ptrFromTimestamptz(run.StartedAt),
+			FinishedAt:   run.FinishedAt.Time,
+			Outcome:      entity.ScheduledOperationRunOutcome(run.Outcome),
+			Error:        ptrFromText(run.Error),
+			InstanceID:   ptrFromText(run.InstanceID),
+		})
+	}
+
+	return result, nil
+}
+
 func scheduledSessionOperationToEntity(s db.ScheduledSessionOperation) (*entity.ScheduledSessionOperation, error) {
 	id, err := formatUUID(s.ID)
 	if err != nil {
@@ -80,6 +80,7 @@ func (r *SessionRepository) Upsert(ctx context.Context, session *entity.Session)
 		StartupParametersSchemaVersion: 1,
 		AutoUpgrade:                    session.AutoUpgrade,
 		Memo:                           memo,
+		RestoreOnCrash:                 session.RestoreOnCrash,
 	})
 	if err != nil {
 		return errors.Wrap(err, 0)
@@ -140,6 +141,24 @@ func (r *SessionRepository) DowngradeToUnknownIfRunning(ctx context.Context, id
 	return r.q.DowngradeSessionToUnknownIfRunning(ctx, id)
 }
 
+func (r *SessionRepository) MarkCrashedForRestore(ctx context.Context, hostID string) (entity.SessionList, error) {
+	sessions, err := r.q.MarkSessionsCrashedForRestore(ctx, hostID)
+	if err != nil {
+		return nil, errors.WrapPrefix(convertDBErr(err), "session", 0)
+	}
+
+	return sessionsToEntities(sessions)
+}
+
+func (r *SessionRepository) ListPendingRestore(ctx context.Context) (entity.SessionList, error) {
+	sessions, err := r.q.ListSessionsPendingRestore(ctx)
+	if err != nil {
+		return nil, errors.WrapPrefix(convertDBErr(err), "session", 0)
+	}
+
+	return sessionsToEntities(sessions)
+}
+
 func (r *SessionRepository) Get(ctx context.Context, id string) (*entity.Session, error) {
 	s, err := r.q.GetSession(ctx, id)
 	if err != nil {
@@ -324,6 +343,39 @@ func (r *SessionRepository) ListPaged(ctx context.Context, opts port.SessionList
 	return result, nil
 }
 
+func (r *SessionRepository) ListActiveBetween(ctx context.Context, opts port.SessionActiveBetweenOptions) (entity.SessionList, error) {
+	params := db.ListSessionsActiveBetweenParams{
+		Since:    pgtype.Timestamptz{Time: opts.Since, Valid: true},
+		Until:    pgtype.Timestamptz{Time: opts.Until, Valid: true},
+		GroupIds: opts.GroupIDs,
+	}
+	if opts.HostID != nil {
+		params.HostID = pgtype.Text{String: *opts.HostID, Valid: true}
+	}
+
+	sessions, err := r.q.ListSessionsActiveBetween(ctx, params)
+	if err != nil {
+		return nil, errors.WrapPrefix(convertDBErr(err), "session", 0)
+	}
+
+	return sessionsToEntities(sessions)
+}
+
+func sessionsToEntities(sessions []db.Session) (entity.SessionList, error) {
+	result := make(entity.SessionList, 0, len(sessions))
+
+	for _, s := range sessions {
+		entity, err := sessionToEntity(s)
+		if err != nil {
+			return nil, errors.Wrap(err, 0)
+		}
+
+		result = append(result, entity)
+	}
+
+	return result, nil
+}
+
 func sessionToEntity(s db.Session) (*entity.Session, error) {
 	var startedAt *time.Time
 	if s.StartedAt.Valid {
@@ -364,6 +416,7 @@ func sessionToEntity(s db.Session) (*entity.Session, error) {
 		HostID:            s.HostID,
 		StartupParameters: &startupParams,
 		AutoUpgrade:       s.AutoUpgrade,
+		RestoreOnCrash:    s.RestoreOnCrash,
 		Memo:              memo,
 		GroupID:           s.GroupID,
 	}, nil
@@ -0,0 +1,155 @@
+package adapter
+
+import (
+	"context"
+
+	"github.com/go-errors/errors"
+	"github.com/hantabaru1014/baru-reso-headless-controller/db"
+	"github.com/hantabaru1014/baru-reso-headless-controller/domain"
+	"github.com/hantabaru1014/baru-reso-headless-controller/domain/entity"
+	headlessv1 "github.com/hantabaru1014/baru-reso-headless-controller/pbgen/headless/v1"
+	"github.com/hantabaru1014/baru-reso-headless-controller/usecase/port"
+	"github.com/jackc/pgx/v5/pgconn"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+var _ port.SessionTemplateRepository = (*SessionTemplateRepository)(nil)
+
+type SessionTemplateRepository struct {
+	q *db.Queries
+}
+
+func NewSessionTemplateRepository(q *db.Queries) *SessionTemplateRepository {
+	return &SessionTemplateRepository{q: q}
+}
+
+func (r *SessionTemplateRepository) Create(ctx context.Context, tmpl *entity.SessionTemplate) error {
+	params, err := marshalTemplateParameters(tmpl.Parameters)
+	if err != nil {
+		return err
+	}
+
+	row, err := r.q.CreateSessionTemplate(ctx, db.CreateSessionTemplateParams{
+		ID:          tmpl.ID,
+		GroupID:     tmpl.GroupID,
+		Name:        tmpl.Name,
+		Description: tmpl.Description,
+		Parameters:  params,
+		CreatedBy:   textFromPtr(tmpl.CreatedBy),
+	})
+	if err != nil {
+		return errors.WrapPrefix(convertTemplateDBErr(err), "session_template", 0)
+	}
+
+	created, err := sessionTemplateToEntity(row)
+	if err != nil {
+		return err
+	}
+
+	*tmpl = *created
+
+	return nil
+}
+
+func (r *SessionTemplateRepository) Get(ctx context.Context, id string) (*entity.SessionTemplate, error) {
+	row, err := r.q.GetSessionTemplate(ctx, id)
+	if err != nil {
+		return nil, errors.WrapPrefix(convertDBErr(err), "session_template", 0)
+	}
+
+	return sessionTemplateToEntity(row)
+}
+
+func (r *SessionTemplateRepository) ListByGroup(ctx context.Context, groupID string) (entity.SessionTemplateList, error) {
+	rows, err := r.q.ListSessionTemplatesByGroup(ctx, groupID)
+	if err != nil {
+		return nil, errors.WrapPrefix(convertDBErr(err), "session_template", 0)
+	}
+
+	result := make(entity.SessionTemplateList, 0, len(rows))
+
+	for _, row := range rows {
+		tmpl, err := sessionTemplateToEntity(row)
+		if err != nil {
+			return nil, err
+		}
+
+		result = append(result, tmpl)
+	}
+
+	return result, nil
+}
+
+func (r *SessionTemplateRepository) Update(ctx context.Context, id string, params port.SessionTemplateUpdateParams) (*entity.SessionTemplate, error) {
+	arg := db.UpdateSessionTemplateParams{
+		ID:          id,
+		Name:        textFromPtr(params.Name),
+		Description: textFromPtr(params.Description),
+	}
+
+	if params.Parameters != nil {
+		b, err := marshalTemplateParameters(params.Parameters)
+		if err != nil {
+			return nil, err
+		}
+
+		arg.Parameters = b
+	}
+
+	row, err := r.q.UpdateSessionTemplate(ctx, arg)
+	if err != nil {
+		return nil, errors.WrapPrefix(convertTemplateDBErr(err), "session_template", 0)
+	}
+
+	return sessionTemplateToEntity(row)
+}
+
+func (r *SessionTemplateRepository) Delete(ctx context.Context, id string) error {
+	if err := r.q.DeleteSessionTemplate(ctx, id); err != nil {
+		return errors.WrapPrefix(convertDBErr(err), "session_template", 0)
+	}
+
+	return nil
+}
+
+// convertTemplateDBErr はセッション / ホストテンプレートの、グループ内の名前の重複を
+// ErrInvalidArgument にする.
+func convertTemplateDBErr(err error) error {
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) && pgErr.Code == "23505" {
+		return errors.Errorf("template name already exists in the group: %w", domain.ErrInvalidArgument)
+	}
+
+	return convertDBErr(err)
+}
+
+func marshalTemplateParameters(params *headlessv1.WorldStartupParameters) ([]byte, error) {
+	if params == nil {
+		params = &headlessv1.WorldStartupParameters{}
+	}
+
+	b, err := protojson.Marshal(params)
+	if err != nil {
+		return nil, errors.Wrap(err, 0)
+	}
+
+	return b, nil
+}
+
+func sessionTemplateToEntity(row db.SessionTemplate) (*entity.SessionTemplate, error) {
+	params := &headlessv1.WorldStartupParameters{}
+	if err := protojson.Unmarshal(row.Parameters, params); err != nil {
+		return nil, errors.Wrap(err, 0)
+	}
+
+	return &entity.SessionTemplate{
+		ID:          row.ID,
+		GroupID:     row.GroupID,
+		Name:        row.Name,
+		Description: row.Description,
+		Parameters:  params,
+		CreatedBy:   ptrFromText(row.CreatedBy),
+		CreatedAt:   row.CreatedAt.Time,
+		UpdatedAt:   row.UpdatedAt.Time,
+	}, nil
+}
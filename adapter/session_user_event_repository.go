@@ -0,0 +1,82 @@
+package adapter
+
+import (
+	"context"
+
+	"github.com/go-errors/errors"
+	"github.com/hantabaru1014/baru-reso-headless-controller/db"
+	"github.com/hantabaru1014/baru-reso-headless-controller/domain/entity"
+	"github.com/hantabaru1014/baru-reso-headless-controller/usecase/port"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+var _ port.SessionUserEventRepository = (*SessionUserEventRepository)(nil)
+
+type SessionUserEventRepository struct {
+	q *db.Queries
+}
+
+func NewSessionUserEventRepository(q *db.Queries) *SessionUserEventRepository {
+	return &SessionUserEventRepository{q: q}
+}
+
+func (r *SessionUserEventRepository) Insert(ctx context.Context, event *entity.SessionUserEvent) (bool, error) {
+	rows, err := r.q.InsertSessionUserEvent(ctx, db.InsertSessionUserEventParams{
+		SessionID:  event.SessionID,
+		HostID:     event.HostID,
+		EventID:    event.EventID,
+		Kind:       int16(event.Kind),
+		UserID:     event.UserID,
+		UserName:   event.UserName,
+		OccurredAt: pgtype.Timestamptz{Time: event.OccurredAt, Valid: true},
+	})
+	if err != nil {
+		return false, errors.WrapPrefix(convertDBErr(err), "session_user_event", 0)
+	}
+
+	return rows > 0, nil
+}
+
+func (r *SessionUserEventRepository) ListBySession(ctx context.Context, sessionID string, afterID int64, limit int32) (entity.SessionUserEventList, error) {
+	rows, err := r.q.ListSessionUserEvents(ctx, db.ListSessionUserEventsParams{
+		SessionID: sessionID,
+		AfterID:   afterID,
+		RowLimit:  limit,
+	})
+	if err != nil {
+		return nil, errors.WrapPrefix(convertDBErr(err), "session_user_event", 0)
+	}
+
+	return sessionUserEventsToEntities(rows), nil
+}
+
+func (r *SessionUserEventRepository) ListBySessionIDs(ctx context.Context, sessionIDs []string) (entity.SessionUserEventList, error) {
+	if len(sessionIDs) == 0 {
+		return entity.SessionUserEventList{}, nil
+	}
+
+	rows, err := r.q.ListSessionUserEventsBySessionIDs(ctx, sessionIDs)
+	if err != nil {
+		return nil, errors.WrapPrefix(convertDBErr(err), "session_user_event", 0)
+	}
+
+	return sessionUserEventsToEntities(rows), nil
+}
+
+func sessionUserEventsToEntities(rows []db.SessionUserEvent) entity.SessionUserEventList {
+	result := make(entity.SessionUserEventList, 0, len(rows))
+	for _, row := range rows {
+		result = append(result, &entity.SessionUserEvent{
+			ID:         row.ID,
+			SessionID:  row.SessionID,
+			HostID:     row.HostID,
+			EventID:    row.EventID,
+			Kind:       entity.SessionUserEventKind(row.Kind),
+			UserID:     row.UserID,
+			UserName:   row.UserName,
+			OccurredAt: row.OccurredAt.Time,
+		})
+	}
+
+	return result
+}
@@ -81,6 +81,17 @@ func (c *MemoryCache) Set(hostID, sessionID string, snapshot *headlessv1.Session
 	set[sessionID] = struct{}{}
 }
 
+// Range calls fn for every cached snapshot. fn runs under the read lock,
+// so it must not call back into the cache.
+func (c *MemoryCache) Range(fn func(hostID, sessionID string, snapshot *headlessv1.Session)) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	for sessionID, e := range c.sessions {
+		fn(e.hostID, sessionID, e.snapshot)
+	}
+}
+
 func (c *MemoryCache) Delete(sessionID string) {
 	c.mu.Lock()
 	defer c.mu.Unlock()
@@ -0,0 +1,74 @@
+package adapter
+
+import (
+	"github.com/go-errors/errors"
+	"github.com/hantabaru1014/baru-reso-headless-controller/domain/entity"
+	"github.com/hantabaru1014/baru-reso-headless-controller/lib/secretbox"
+	"github.com/hantabaru1014/baru-reso-headless-controller/usecase/port"
+)
+
+var _ port.TOTPSecretCipher = (*TOTPSecretCipher)(nil)
+
+// TOTPSecretCipher は secretbox で TOTP secret をエンベロープ暗号化する.
+// headless account の認証情報と鍵を共有するので、AAD には接頭辞を付けて区別する.
+type TOTPSecretCipher struct {
+	keyring *secretbox.Keyring
+}
+
+func NewTOTPSecretCipher(keyring *secretbox.Keyring) *TOTPSecretCipher {
+	return &TOTPSecretCipher{keyring: keyring}
+}
+
+// Seal implements port.TOTPSecretCipher.
+func (c *TOTPSecretCipher) Seal(userID string, secret []byte) (entity.SealedSecret, error) {
+	env, err := c.keyring.Seal(totpSecretAAD(userID), secret)
+	if err != nil {
+		return entity.SealedSecret{}, errors.Wrap(err, 0)
+	}
+
+	return envelopeToSealedSecret(env), nil
+}
+
+// Open implements port.TOTPSecretCipher.
+func (c *TOTPSecretCipher) Open(userID string, sealed entity.SealedSecret) ([]byte, error) {
+	plaintexts, err := c.keyring.Open(sealedSecretToEnvelope(sealed), totpSecretAAD(userID))
+	if err != nil {
+		return nil, errors.WrapPrefix(err, "failed to decrypt totp secret of "+userID, 0)
+	}
+
+	return plaintexts[0], nil
+}
+
+// Rewrap implements port.TOTPSecretCipher.
+func (c *TOTPSecretCipher) Rewrap(userID string, sealed entity.SealedSecret) (entity.SealedSecret, bool, error) {
+	if sealed.KeyID == c.keyring.PrimaryKeyID() {
+		return sealed, false, nil
+	}
+
+	env, err := c.keyring.Rewrap(sealedSecretToEnvelope(sealed), totpSecretAAD(userID))
+	if err != nil {
+		return entity.SealedSecret{}, false, errors.WrapPrefix(err, "failed to rewrap totp secret of "+userID, 0)
+	}
+
+	return envelopeToSealedSecret(env), true, nil
+}
+
+func totpSecretAAD(userID string) []byte {
+	return []byte("totp:" + userID)
+}
+
+func envelopeToSealedSecret(env *secretbox.Envelope) entity.SealedSecret {
+	return entity.SealedSecret{
+		KeyID:      env.KeyID,
+		DataKey:    env.DataKey,
+		Ciphertext: env.Ciphertexts[0],
+	}
+}
+
+func sealedSecretToEnvelope(s entity.SealedSecret) *secretbox.Envelope {
+	return &secretbox.Envelope{
+		KeyID:       s.KeyID,
+		DataKey:     s.DataKey,
+		Ciphertexts: [][]byte{s.Ciphertext},
+	}
+}
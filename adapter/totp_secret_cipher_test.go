@@ -0,0 +1,71 @@
+package adapter_test
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/hantabaru1014/baru-reso-headless-controller/adapter"
+	"github.com/hantabaru1014/baru-reso-headless-controller/lib/secretbox"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTOTPSecretCipher(t *testing.T) {
+	keyring := func(primaryID string) *secretbox.Keyring {
+		k, err := secretbox.NewKeyring(map[string][]byte{
+			"k1": bytes.Repeat([]byte{1}, secretbox.KeySize),
+			"k2": bytes.Repeat([]byte{2}, secretbox.KeySize),
+		}, primaryID)
+		require.NoError(t, err)
+
+		return k
+	}
+
+	t.Run("Seal した secret を同じユーザーでだけ Open できる", func(t *testing.T) {
+		c := adapter.NewTOTPSecretCipher(keyring("k1"))
+
+		sealed, err := c.Seal("alice", []byte("secret"))
+		require.NoError(t, err)
+
+		secret, err := c.Open("alice", sealed)
+		require.NoError(t, err)
+		assert.Equal(t, []byte("secret"), secret)
+
+		_, err = c.Open("bob", sealed)
+		require.ErrorIs(t, err, secretbox.ErrDecrypt)
+	})
+
+	t.Run("headless account の暗号文としては Open できない", func(t *testing.T) {
+		kr := keyring("k1")
+
+		sealed, err := adapter.NewTOTPSecretCipher(kr).Seal("U-bot", []byte("secret"))
+		require.NoError(t, err)
+
+		_, err = kr.Open(&secretbox.Envelope{
+			KeyID:       sealed.KeyID,
+			DataKey:     sealed.DataKey,
+			Ciphertexts: [][]byte{sealed.Ciphertext},
+		}, []byte("U-bot"))
+		require.ErrorIs(t, err, secretbox.ErrDecrypt)
+	})
+
+	t.Run("Rewrap で primary の鍵に掛け直す", func(t *testing.T) {
+		sealed, err := adapter.NewTOTPSecretCipher(keyring("k1")).Seal("alice", []byte("secret"))
+		require.NoError(t, err)
+
+		c := adapter.NewTOTPSecretCipher(keyring("k2"))
+
+		rewrapped, changed, err := c.Rewrap("alice", sealed)
+		require.NoError(t, err)
+		assert.True(t, changed)
+		assert.Equal(t, "k2", rewrapped.KeyID)
+
+		secret, err := c.Open("alice", rewrapped)
+		require.NoError(t, err)
+		assert.Equal(t, []byte("secret"), secret)
+
+		_, changed, err = c.Rewrap("alice", rewrapped)
+		require.NoError(t, err)
+		assert.False(t, changed)
+	})
+}
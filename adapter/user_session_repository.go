@@ -0,0 +1,131 @@
+package adapter
+
+import (
+	"context"
+	"time"
+
+	"github.com/go-errors/errors"
+	"github.com/hantabaru1014/baru-reso-headless-controller/db"
+	"github.com/hantabaru1014/baru-reso-headless-controller/domain"
+	"github.com/hantabaru1014/baru-reso-headless-controller/domain/entity"
+	"github.com/hantabaru1014/baru-reso-headless-controller/usecase/port"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+var _ port.UserSessionRepository = (*UserSessionRepository)(nil)
+
+type UserSessionRepository struct {
+	q *db.Queries
+}
+
+func NewUserSessionRepository(q *db.Queries) *UserSessionRepository {
+	return &UserSessionRepository{q: q}
+}
+
+func (r *UserSessionRepository) Create(ctx context.Context, session *entity.UserSession) error {
+	row, err := r.q.CreateUserSession(ctx, db.CreateUserSessionParams{
+		ID:        session.ID,
+		UserID:    session.UserID,
+		UserAgent: session.UserAgent,
+		IpAddress: session.IPAddress,
+		ExpiresAt: pgtype.Timestamptz{Time: session.ExpiresAt, Valid: true},
+	})
+	if err != nil {
+		return errors.WrapPrefix(convertDBErr(err), "user_session", 0)
+	}
+
+	*session = *userSessionToEntity(row)
+
+	return nil
+}
+
+func (r *UserSessionRepository) Get(ctx context.Context, id string) (*entity.UserSession, error) {
+	row, err := r.q.GetUserSession(ctx, id)
+	if err != nil {
+		return nil, errors.WrapPrefix(convertDBErr(err), "user_session", 0)
+	}
+
+	return userSessionToEntity(row), nil
+}
+
+func (r *UserSessionRepository) ListActiveByUser(ctx context.Context, userID string) (entity.UserSessionList, error) {
+	rows, err := r.q.ListActiveUserSessionsByUser(ctx, userID)
+	if err != nil {
+		return nil, errors.WrapPrefix(convertDBErr(err), "user_session", 0)
+	}
+
+	result := make(entity.UserSessionList, 0, len(rows))
+	for _, row := range rows {
+		result = append(result, userSessionToEntity(row))
+	}
+
+	return result, nil
+}
+
+func (r *UserSessionRepository) Rotate(ctx context.Context, id string, generation int64, userAgent, ipAddress string, expiresAt time.Time) (bool, error) {
+	n, err := r.q.RotateUserSession(ctx, db.RotateUserSessionParams{
+		ID:         id,
+		Generation: generation,
+		UserAgent:  userAgent,
+		IpAddress:  ipAddress,
+		ExpiresAt:  pgtype.Timestamptz{Time: expiresAt, Valid: true},
+	})
+	if err != nil {
+		return false, errors.WrapPrefix(convertDBErr(err), "user_session", 0)
+	}
+
+	return n > 0, nil
+}
+
+func (r *UserSessionRepository) TouchLastSeen(ctx context.Context, id string) error {
+	if err := r.q.TouchUserSession(ctx, id); err != nil {
+		return errors.WrapPrefix(convertDBErr(err), "user_session", 0)
+	}
+
+	return nil
+}
+
+func (r *UserSessionRepository) Revoke(ctx context.Context, userID, id string) error {
+	n, err := r.q.RevokeUserSession(ctx, db.RevokeUserSessionParams{ID: id, UserID: userID})
+	if err != nil {
+		return errors.WrapPrefix(convertDBErr(err), "user_session", 0)
+	}
+
+	if n == 0 {
+		return errors.WrapPrefix(domain.ErrNotFound, "user_session", 0)
+	}
+
+	return nil
+}
+
+func (r *UserSessionRepository) RevokeAllByUser(ctx context.Context, userID, exceptID string) (int64, error) {
+	n, err := r.q.RevokeUserSessionsByUser(ctx, db.RevokeUserSessionsByUserParams{UserID: userID, ExceptID: exceptID})
+	if err != nil {
+		return 0, errors.WrapPrefix(convertDBErr(err), "user_session", 0)
+	}
+
+	return n, nil
+}
+
+func (r *UserSessionRepository) DeleteStale(ctx context.Context) error {
+	if _, err := r.q.DeleteStaleUserSessions(ctx); err != nil {
+		return errors.WrapPrefix(convertDBErr(err), "user_session", 0)
+	}
+
+	return nil
+}
+
+func userSessionToEntity(row db.UserSession) *entity.UserSession {
+	return &entity.UserSession{
+		ID:                row.ID,
+		UserID:            row.UserID,
+		RefreshGeneration: row.RefreshGeneration,
+		RotatedAt:         row.RotatedAt.Time,
+		UserAgent:         row.UserAgent,
+		IPAddress:         row.IpAddress,
+		LastSeenAt:        row.LastSeenAt.Time,
+		ExpiresAt:         row.ExpiresAt.Time,
+		RevokedAt:         ptrFromTimestamptz(row.RevokedAt),
+		CreatedAt:         row.CreatedAt.Time,
+	}
+}
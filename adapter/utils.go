@@ -2,6 +2,7 @@ package adapter
 
 import (
 	"encoding/hex"
+	"strings"
 	"time"
 
 	"github.com/go-errors/errors"
@@ -50,6 +51,41 @@ func ptrFromTimestamptz(t pgtype.Timestamptz) *time.Time {
 	return &v
 }
 
+// timestamptzFromPtr は *time.Time を pgtype.Timestamptz に変換する (nil なら Valid=false).
+func timestamptzFromPtr(t *time.Time) pgtype.Timestamptz {
+	if t == nil {
+		return pgtype.Timestamptz{}
+	}
+
+	return pgtype.Timestamptz{Time: *t, Valid: true}
+}
+
+// timestampFromPtr は *time.Time を UTC の pgtype.Timestamp に変換する (nil なら Valid=false).
+// container_logs.ts のような timezone なしの列 (UTC で書き込まれる) との比較に使う.
+func timestampFromPtr(t *time.Time) pgtype.Timestamp {
+	if t == nil {
+		return pgtype.Timestamp{}
+	}
+
+	return pgtype.Timestamp{Time: t.UTC(), Valid: true}
+}
+
+// escapeLikePattern は LIKE / ILIKE のパターン中で特別な意味を持つ文字をエスケープする.
+func escapeLikePattern(s string) string {
+	return likePatternEscaper.Replace(s)
+}
+
+var likePatternEscaper = strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`)
+
+// int4FromPtr は *int32 を pgtype.Int4 に変換する (nil なら Valid=false).
+func int4FromPtr(p *int32) pgtype.Int4 {
+	if p == nil {
+		return pgtype.Int4{}
+	}
+
+	return pgtype.Int4{Int32: *p, Valid: true}
+}
+
 // parseUUID は canonical UUID 文字列 ("xxxxxxxx-xxxx-...") を pgtype.UUID に変換する.
 func parseUUID(id string) (pgtype.UUID, error) {
 	var u pgtype.UUID
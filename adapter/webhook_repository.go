@@ -0,0 +1,292 @@
+package adapter
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/go-errors/errors"
+	"github.com/hantabaru1014/baru-reso-headless-controller/db"
+	"github.com/hantabaru1014/baru-reso-headless-controller/domain/entity"
+	"github.com/hantabaru1014/baru-reso-headless-controller/usecase/port"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+var _ port.WebhookRepository = (*WebhookRepository)(nil)
+
+type WebhookRepository struct {
+	q *db.Queries
+}
+
+func NewWebhookRepository(q *db.Queries) *WebhookRepository {
+	return &WebhookRepository{q: q}
+}
+
+func (r *WebhookRepository) CreateSubscription(ctx context.Context, sub *entity.WebhookSubscription) error {
+	row, err := r.q.CreateWebhookSubscription(ctx, db.CreateWebhookSubscriptionParams{
+		ID:         sub.ID,
+		GroupID:    sub.GroupID,
+		Name:       sub.Name,
+		Url:        sub.URL,
+		Format:     string(sub.Format),
+		EventTypes: webhookEventTypesToText(sub.EventTypes),
+		Secret:     sub.Secret,
+		Enabled:    sub.Enabled,
+		CreatedBy:  textFromPtr(sub.CreatedBy),
+	})
+	if err != nil {
+		return errors.WrapPrefix(convertDBErr(err), "webhook_subscription", 0)
+	}
+
+	*sub = *webhookSubscriptionToEntity(row)
+
+	return nil
+}
+
+func (r *WebhookRepository) GetSubscription(ctx context.Context, id string) (*entity.WebhookSubscription, error) {
+	row, err := r.q.GetWebhookSubscription(ctx, id)
+	if err != nil {
+		return nil, errors.WrapPrefix(convertDBErr(err), "webhook_subscription", 0)
+	}
+
+	return webhookSubscriptionToEntity(row), nil
+}
+
+func (r *WebhookRepository) ListSubscriptionsByGroup(ctx context.Context, groupID string) (entity.WebhookSubscriptionList, error) {
+	rows, err := r.q.ListWebhookSubscriptionsByGroup(ctx, groupID)
+	if err != nil {
+		return nil, errors.WrapPrefix(convertDBErr(err), "webhook_subscription", 0)
+	}
+
+	return webhookSubscriptionsToEntity(rows), nil
+}
+
+func (r *WebhookRepository) ListSubscriptionsForEvent(ctx context.Context, groupID string, eventType entity.WebhookEventType) (entity.WebhookSubscriptionList, error) {
+	rows, err := r.q.ListEnabledWebhookSubscriptionsForEvent(ctx, db.ListEnabledWebhookSubscriptionsForEventParams{
+		GroupID:   groupID,
+		EventType: string(eventType),
+	})
+	if err != nil {
+		return nil, errors.WrapPrefix(convertDBErr(err), "webhook_subscription", 0)
+	}
+
+	return webhookSubscriptionsToEntity(rows), nil
+}
+
+func (r *WebhookRepository) UpdateSubscription(ctx context.Context, id string, params port.WebhookSubscriptionUpdateParams) (*entity.WebhookSubscription, error) {
+	arg := db.UpdateWebhookSubscriptionParams{
+		ID:   id,
+		Name: textFromPtr(params.Name),
+		Url:  textFromPtr(params.URL),
+	}
+	if params.Format != nil {
+		arg.Format = pgtype.Text{String: string(*params.Format), Valid: true}
+	}
+
+	if params.EventTypes != nil {
+		arg.EventTypes = webhookEventTypesToText(params.EventTypes)
+	}
+
+	if params.Enabled != nil {
+		arg.Enabled = pgtype.Bool{Bool: *params.Enabled, Valid: true}
+	}
+
+	row, err := r.q.UpdateWebhookSubscription(ctx, arg)
+	if err != nil {
+		return nil, errors.WrapPrefix(convertDBErr(err), "webhook_subscription", 0)
+	}
+
+	return webhookSubscriptionToEntity(row), nil
+}
+
+func (r *WebhookRepository) UpdateSubscriptionSecret(ctx context.Context, id, secret string) error {
+	err := r.q.UpdateWebhookSubscriptionSecret(ctx, db.UpdateWebhookSubscriptionSecretParams{
+		ID:     id,
+		Secret: secret,
+	})
+	if err != nil {
+		return errors.WrapPrefix(convertDBErr(err), "webhook_subscription", 0)
+	}
+
+	return nil
+}
+
+func (r *WebhookRepository) DeleteSubscription(ctx context.Context, id string) error {
+	if err := r.q.DeleteWebhookSubscription(ctx, id); err != nil {
+		return errors.WrapPrefix(convertDBErr(err), "webhook_subscription", 0)
+	}
+
+	return nil
+}
+
+func (r *WebhookRepository) EnqueueDelivery(ctx context.Context, subscriptionID string, eventType entity.WebhookEventType, body json.RawMessage) (*entity.WebhookDelivery, error) {
+	row, err := r.q.CreateWebhookDelivery(ctx, db.CreateWebhookDeliveryParams{
+		SubscriptionID: subscriptionID,
+		EventType:      string(eventType),
+		Body:           body,
+	})
+	if err != nil {
+		return nil, errors.WrapPrefix(convertDBErr(err), "webhook_delivery", 0)
+	}
+
+	return webhookDeliveryToEntity(row), nil
+}
+
+func (r *WebhookRepository) ClaimDueDeliveries(ctx context.Context, batchSize int32) (entity.WebhookDeliveryList, error) {
+	rows, err := r.q.ClaimDueWebhookDeliveries(ctx, batchSize)
+	if err != nil {
+		return nil, errors.WrapPrefix(convertDBErr(err), "webhook_delivery", 0)
+	}
+
+	result := make(entity.WebhookDeliveryList, 0, len(rows))
+	for _, row := range rows {
+		result = append(result, webhookDeliveryToEntity(row))
+	}
+
+	return result, nil
+}
+
+func (r *WebhookRepository) ReleaseStaleDeliveryClaims(ctx context.Context, staleAfter time.Duration) (int64, error) {
+	seconds := int32(staleAfter / time.Second) //nolint:gosec // G115: 設定値で int32 範囲を超えない
+
+	rows, err := r.q.ReleaseStaleWebhookDeliveryClaims(ctx, seconds)
+	if err != nil {
+		return 0, errors.WrapPrefix(convertDBErr(err), "webhook_delivery", 0)
+	}
+
+	return rows, nil
+}
+
+func (r *WebhookRepository) MarkDeliverySucceeded(ctx context.Context, id int64, statusCode int32) error {
+	err := r.q.MarkWebhookDeliverySucceeded(ctx, db.MarkWebhookDeliverySucceededParams{
+		ID:             id,
+		LastStatusCode: pgtype.Int4{Int32: statusCode, Valid: true},
+	})
+	if err != nil {
+		return errors.WrapPrefix(convertDBErr(err), "webhook_delivery", 0)
+	}
+
+	return nil
+}
+
+func (r *WebhookRepository) RetryDeliveryLater(ctx context.Context, id int64, nextAttemptAt time.Time, statusCode *int32, errMessage string) error {
+	err := r.q.RetryWebhookDeliveryLater(ctx, db.RetryWebhookDeliveryLaterParams{
+		ID:             id,
+		NextAttemptAt:  pgtype.Timestamptz{Time: nextAttemptAt, Valid: true},
+		LastStatusCode: int4FromPtr(statusCode),
+		LastError:      errMessage,
+	})
+	if err != nil {
+		return errors.WrapPrefix(convertDBErr(err), "webhook_delivery", 0)
+	}
+
+	return nil
+}
+
+func (r *WebhookRepository) MarkDeliveryFailed(ctx context.Context, id int64, statusCode *int32, errMessage string) error {
+	err := r.q.MarkWebhookDeliveryFailed(ctx, db.MarkWebhookDeliveryFailedParams{
+		ID:             id,
+		LastStatusCode: int4FromPtr(statusCode),
+		LastError:      errMessage,
+	})
+	if err != nil {
+		return errors.WrapPrefix(convertDBErr(err), "webhook_delivery", 0)
+	}
+
+	return nil
+}
+
+func (r *WebhookRepository) ListDeliveries(ctx context.Context, subscriptionID string, pageIndex, pageSize int32) (*port.WebhookDeliveryListResult, error) {
+	rows, err := r.q.ListWebhookDeliveries(ctx, db.ListWebhookDeliveriesParams{
+		SubscriptionID: subscriptionID,
+		PageSize:       pageSize,
+		PageOffset:     pageIndex * pageSize,
+	})
+	if err != nil {
+		return nil, errors.WrapPrefix(convertDBErr(err), "webhook_delivery", 0)
+	}
+
+	result := &port.WebhookDeliveryListResult{
+		Items: make(entity.WebhookDeliveryList, 0, len(rows)),
+	}
+	if len(rows) > 0 {
+		result.TotalCount = int32(rows[0].TotalCount) //nolint:gosec // G115: ページングの表示用. int32 を超える件数は想定しない
+	}
+
+	for _, row := range rows {
+		result.Items = append(result.Items, webhookDeliveryToEntity(row.WebhookDelivery))
+	}
+
+	return result, nil
+}
+
+func (r *WebhookRepository) DeleteFinishedDeliveriesBefore(ctx context.Context, before time.Time) (int64, error) {
+	rows, err := r.q.DeleteFinishedWebhookDeliveriesBefore(ctx, pgtype.Timestamptz{Time: before, Valid: true})
+	if err != nil {
+		return 0, errors.WrapPrefix(convertDBErr(err), "webhook_delivery", 0)
+	}
+
+	return rows, nil
+}
+
+// webhookEventTypesToText は nil を空配列にする (event_types は NOT NULL).
+func webhookEventTypesToText(types []entity.WebhookEventType) []string {
+	result := make([]string, 0, len(types))
+	for _, t := range types {
+		result = append(result, string(t))
+	}
+
+	return result
+}
+
+func webhookSubscriptionsToEntity(rows []db.WebhookSubscription) entity.WebhookSubscriptionList {
+	result := make(entity.WebhookSubscriptionList, 0, len(rows))
+	for _, row := range rows {
+		result = append(result, webhookSubscriptionToEntity(row))
+	}
+
+	return result
+}
+
+func webhookSubscriptionToEntity(row db.WebhookSubscription) *entity.WebhookSubscription {
+	eventTypes := make([]entity.WebhookEventType, 0, len(row.EventTypes))
+	for _, t := range row.EventTypes {
+		eventTypes = append(eventTypes, entity.WebhookEventType(t))
+	}
+
+	return &entity.WebhookSubscription{
+		ID:         row.ID,
+		GroupID:    row.GroupID,
+		Name:       row.Name,
+		URL:        row.Url,
+		Format:     entity.WebhookFormat(row.Format),
+		EventTypes: eventTypes,
+		Secret:     row.Secret,
+		Enabled:    row.Enabled,
+		CreatedBy:  ptrFromText(row.CreatedBy),
+		CreatedAt:  row.CreatedAt.Time,
+		UpdatedAt:  row.UpdatedAt.Time,
+	}
+}
+
+func webhookDeliveryToEntity(row db.WebhookDelivery) *entity.WebhookDelivery {
+	var statusCode *int32
+	if row.LastStatusCode.Valid {
+		code := row.LastStatusCode.Int32
+		statusCode = &code
+	}
+
+	return &entity.WebhookDelivery{
+		ID:             row.ID,
+		SubscriptionID: row.SubscriptionID,
+		EventType:      entity.WebhookEventType(row.EventType),
+		Body:           row.Body,
+		Status:         entity.WebhookDeliveryStatus(row.Status),
+		Attempts:       row.Attempts,
+		NextAttemptAt:  row.NextAttemptAt.Time,
+		LastStatusCode: statusCode,
+		LastError:      ptrFromText(row.LastError),
+		DeliveredAt:    ptrFromTimestamptz(row.DeliveredAt),
+		CreatedAt:      row.CreatedAt.Time,
+	}
+}
@@ -7,6 +7,8 @@ import (
 	"github.com/hantabaru1014/baru-reso-headless-controller/lib/auth"
 	"github.com/hantabaru1014/baru-reso-headless-controller/lib/skyfrost"
 	"github.com/hantabaru1014/baru-reso-headless-controller/usecase"
+	"github.com/hantabaru1014/baru-reso-headless-controller/usecase/desired_state"
+	"github.com/hantabaru1014/baru-reso-headless-controller/usecase/port"
 	"github.com/spf13/cobra"
 )
 
@@ -17,10 +19,17 @@ type Cli struct {
 func NewCli(
 	queries *db.Queries,
 	uu *usecase.UserUsecase,
+	hau *usecase.HeadlessAccountUsecase,
 	hu *usecase.HeadlessHostUsecase,
 	sou *usecase.ScheduledSessionOperationUsecase,
 	guc *usecase.GroupUsecase,
 	skyfrostClient skyfrost.Client,
+	nodeRepo port.DockerNodeRepository,
+	auc *usecase.AuditUsecase,
+	rec *desired_state.Reconciler,
+	atuc *usecase.ApiTokenUsecase,
+	tu *usecase.TOTPUsecase,
+	usu *usecase.UserSessionUsecase,
 ) *Cli {
 	rootCmd := &cobra.Command{
 		Use:   "brhcli",
@@ -32,11 +41,17 @@ func NewCli(
 		},
 	}
 	rootCmd.AddCommand(commands.NewHostCommand(hu))
-	rootCmd.AddCommand(commands.NewUserCommand(uu, skyfrostClient))
+	rootCmd.AddCommand(commands.NewUserCommand(uu, tu, usu, skyfrostClient))
 	rootCmd.AddCommand(commands.NewMigrateCommand())
 	rootCmd.AddCommand(commands.NewImportLegacyHostsCommand(queries, skyfrostClient))
 	rootCmd.AddCommand(commands.NewScheduledCommand(sou))
 	rootCmd.AddCommand(commands.NewSystemAdminCommand(guc))
+	rootCmd.AddCommand(commands.NewNodeCommand(nodeRepo))
+	rootCmd.AddCommand(commands.NewAuditCommand(auc))
+	rootCmd.AddCommand(commands.NewApplyCommand(rec))
+	rootCmd.AddCommand(commands.NewExportCommand(rec))
+	rootCmd.AddCommand(commands.NewAccountsCommand(hau, tu))
+	rootCmd.AddCommand(commands.NewTokenCommand(atuc))
 
 	return &Cli{rootCmd: rootCmd}
 }
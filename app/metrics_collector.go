@@ -0,0 +1,187 @@
+package app
+
+import (
+	"context"
+	"log/slog"
+	"net/http"
+	"time"
+
+	"github.com/hantabaru1014/baru-reso-headless-controller/adapter/sessionstate"
+	"github.com/hantabaru1014/baru-reso-headless-controller/config"
+	"github.com/hantabaru1014/baru-reso-headless-controller/db"
+	"github.com/hantabaru1014/baru-reso-headless-controller/domain/entity"
+	"github.com/hantabaru1014/baru-reso-headless-controller/lib/metrics"
+	headlessv1 "github.com/hantabaru1014/baru-reso-headless-controller/pbgen/headless/v1"
+	"github.com/hantabaru1014/baru-reso-headless-controller/usecase/port"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// MetricsHandler serves /metrics.
+type MetricsHandler http.Handler
+
+// metricsScrapeTimeout bounds the DB queries and host RPCs of one scrape so
+// an unresponsive host cannot hang the scraper.
+const metricsScrapeTimeout = 5 * time.Second
+
+var (
+	hostStatusDesc = prometheus.NewDesc(
+		prometheus.BuildFQName(metrics.Namespace, "host", "status"),
+		"Current status of each host (1 for the status the host is in).",
+		[]string{"host_id", "host_name", "status"}, nil)
+	hostFpsDesc = prometheus.NewDesc(
+		prometheus.BuildFQName(metrics.Namespace, "host", "fps"),
+		"FPS reported by running hosts.",
+		[]string{"host_id", "host_name"}, nil)
+	hostInstanceCountDesc = prometheus.NewDesc(
+		prometheus.BuildFQName(metrics.Namespace, "host", "instance_count"),
+		"Number of times a host has been (re)started.",
+		[]string{"host_id", "host_name"}, nil)
+	hostCrashesDesc = prometheus.NewDesc(
+		prometheus.BuildFQName(metrics.Namespace, "host", "crashes_total"),
+		"Crashes recorded for each host.",
+		[]string{"host_id", "host_name"}, nil)
+	sessionUsersDesc = prometheus.NewDesc(
+		prometheus.BuildFQName(metrics.Namespace, "session", "users"),
+		"Users currently in each running session.",
+		[]string{"session_id", "session_name", "host_id"}, nil)
+	sessionMaxUsersDesc = prometheus.NewDesc(
+		prometheus.BuildFQName(metrics.Namespace, "session", "max_users"),
+		"Configured user limit of each running session.",
+		[]string{"session_id", "session_name", "host_id"}, nil)
+	asyncJobsDesc = prometheus.NewDesc(
+		prometheus.BuildFQName(metrics.Namespace, "async_job", "queue_depth"),
+		"Async jobs waiting for or being executed.",
+		[]string{"status"}, nil)
+	asyncJobOldestPendingDesc = prometheus.NewDesc(
+		prometheus.BuildFQName(metrics.Namespace, "async_job", "oldest_pending_age_seconds"),
+		"Age of the oldest async job nobody has claimed yet (0 if none).",
+		nil, nil)
+	scheduledOpsDesc = prometheus.NewDesc(
+		prometheus.BuildFQName(metrics.Namespace, "scheduled_operation", "queue_depth"),
+		"Scheduled operations by state. due counts pending operations whose time has come.",
+		[]string{"status"}, nil)
+	scheduledOpOldestDueDesc = prometheus.NewDesc(
+		prometheus.BuildFQName(metrics.Namespace, "scheduled_operation", "oldest_due_age_seconds"),
+		"How long the oldest due scheduled operation has been waiting (0 if none).",
+		nil, nil)
+)
+
+// stateCollector reports the current state of hosts, sessions and the job
+// queues at scrape time, so the values never go stale between scrapes.
+type stateCollector struct {
+	hostRepo   port.HeadlessHostRepository
+	stateCache *sessionstate.MemoryCache
+	q          *db.Queries
+}
+
+var _ prometheus.Collector = (*stateCollector)(nil)
+
+func (c *stateCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- hostStatusDesc
+	ch <- hostFpsDesc
+	ch <- hostInstanceCountDesc
+	ch <- hostCrashesDesc
+	ch <- sessionUsersDesc
+	ch <- sessionMaxUsersDesc
+	ch <- asyncJobsDesc
+	ch <- asyncJobOldestPendingDesc
+	ch <- scheduledOpsDesc
+	ch <- scheduledOpOldestDueDesc
+}
+
+func (c *stateCollector) Collect(ch chan<- prometheus.Metric) {
+	ctx, cancel := context.WithTimeout(context.Background(), metricsScrapeTimeout)
+	defer cancel()
+
+	c.collectHosts(ctx, ch)
+	c.collectSessions(ch)
+	c.collectQueues(ctx, ch)
+}
+
+func (c *stateCollector) collectHosts(ctx context.Context, ch chan<- prometheus.Metric) {
+	hosts, err := c.hostRepo.ListAll(ctx, port.HeadlessHostFetchOptions{})
+	if err != nil {
+		slog.Warn("metrics: failed to list hosts", "error", err)
+
+		return
+	}
+
+	for _, h := range hosts {
+		ch <- prometheus.MustNewConstMetric(hostStatusDesc, prometheus.GaugeValue, 1, h.ID, h.Name, hostStatusLabel(h.Status))
+		ch <- prometheus.MustNewConstMetric(hostInstanceCountDesc, prometheus.GaugeValue, float64(h.InstanceId), h.ID, h.Name)
+		ch <- prometheus.MustNewConstMetric(hostCrashesDesc, prometheus.CounterValue, float64(h.CrashCount), h.ID, h.Name)
+
+		if h.Status == entity.HeadlessHostStatus_RUNNING {
+			ch <- prometheus.MustNewConstMetric(hostFpsDesc, prometheus.GaugeValue, float64(h.Fps), h.ID, h.Name)
+		}
+	}
+}
+
+func (c *stateCollector) collectSessions(ch chan<- prometheus.Metric) {
+	c.stateCache.Range(func(hostID, sessionID string, s *headlessv1.Session) {
+		ch <- prometheus.MustNewConstMetric(sessionUsersDesc, prometheus.GaugeValue, float64(s.GetUsersCount()), sessionID, s.GetName(), hostID)
+		ch <- prometheus.MustNewConstMetric(sessionMaxUsersDesc, prometheus.GaugeValue, float64(s.GetMaxUsers()), sessionID, s.GetName(), hostID)
+	})
+}
+
+func (c *stateCollector) collectQueues(ctx context.Context, ch chan<- prometheus.Metric) {
+	now := time.Now()
+
+	if jobs, err := c.q.GetAsyncJobQueueStats(ctx); err != nil {
+		slog.Warn("metrics: failed to get async job queue stats", "error", err)
+	} else {
+		ch <- prometheus.MustNewConstMetric(asyncJobsDesc, prometheus.GaugeValue, float64(jobs.Pending), "pending")
+		ch <- prometheus.MustNewConstMetric(asyncJobsDesc, prometheus.GaugeValue, float64(jobs.Running), "running")
+		ch <- prometheus.MustNewConstMetric(asyncJobOldestPendingDesc, prometheus.GaugeValue, ageSeconds(now, jobs.OldestPendingAt.Time, jobs.OldestPendingAt.Valid))
+	}
+
+	if ops, err := c.q.GetScheduledSessionOperationQueueStats(ctx); err != nil {
+		slog.Warn("metrics: failed to get scheduled operation queue stats", "error", err)
+	} else {
+		ch <- prometheus.MustNewConstMetric(scheduledOpsDesc, prometheus.GaugeValue, float64(ops.Pending), "pending")
+		ch <- prometheus.MustNewConstMetric(scheduledOpsDesc, prometheus.GaugeValue, float64(ops.Due), "due")
+		ch <- prometheus.MustNewConstMetric(scheduledOpsDesc, prometheus.GaugeValue, float64(ops.Running), "running")
+		ch <- prometheus.MustNewConstMetric(scheduledOpOldestDueDesc, prometheus.GaugeValue, ageSeconds(now, ops.OldestDueAt.Time, ops.OldestDueAt.Valid))
+	}
+}
+
+func ageSeconds(now, t time.Time, valid bool) float64 {
+	if !valid {
+		return 0
+	}
+
+	return max(now.Sub(t).Seconds(), 0)
+}
+
+func hostStatusLabel(s entity.HeadlessHostStatus) string {
+	switch s {
+	case entity.HeadlessHostStatus_STARTING:
+		return "starting"
+	case entity.HeadlessHostStatus_RUNNING:
+		return "running"
+	case entity.HeadlessHostStatus_STOPPING:
+		return "stopping"
+	case entity.HeadlessHostStatus_EXITED:
+		return "exited"
+	case entity.HeadlessHostStatus_CRASHED:
+		return "crashed"
+	default:
+		return "unknown"
+	}
+}
+
+// ProvideMetricsHandler registers the state collector and returns the
+// /metrics handler. Server is built once per process, so registering here
+// does not collide.
+func ProvideMetricsHandler(
+	hostRepo port.HeadlessHostRepository,
+	stateCache *sessionstate.MemoryCache,
+	q *db.Queries,
+	serverCfg *config.ServerConfig,
+) MetricsHandler {
+	if err := metrics.Registry.Register(&stateCollector{hostRepo: hostRepo, stateCache: stateCache, q: q}); err != nil {
+		slog.Error("metrics: failed to register state collector", "error", err)
+	}
+
+	return metrics.Handler(serverCfg.MetricsToken)
+}
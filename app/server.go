@@ -17,7 +17,9 @@ import (
 	"github.com/hantabaru1014/baru-reso-headless-controller/adapter/resonitelink"
 	"github.com/hantabaru1014/baru-reso-headless-controller/adapter/rpc"
 	"github.com/hantabaru1014/baru-reso-headless-controller/front"
+	"github.com/hantabaru1014/baru-reso-headless-controller/lib/auth"
 	"github.com/hantabaru1014/baru-reso-headless-controller/lib/blobstore"
+	"github.com/hantabaru1014/baru-reso-headless-controller/usecase"
 	"github.com/hantabaru1014/baru-reso-headless-controller/worker"
 )
 
@@ -27,9 +29,12 @@ type Server struct {
 	notificationService *rpc.NotificationService
 	groupService        *rpc.GroupService
 	roleService         *rpc.RoleService
+	auditService        *rpc.AuditService
+	webhookService      *rpc.WebhookService
 	workerManager       *worker.Manager
 	blobClient          blobstore.Client
 	resoniteLinkBridge  *resonitelink.Bridge
+	metricsHandler      MetricsHandler
 	httpServer          *http.Server
 }
 
@@ -39,19 +44,31 @@ func NewServer(
 	notificationService *rpc.NotificationService,
 	groupService *rpc.GroupService,
 	roleService *rpc.RoleService,
+	auditService *rpc.AuditService,
+	webhookService *rpc.WebhookService,
 	workerManager *worker.Manager,
 	blobClient blobstore.Client,
 	resoniteLinkBridge *resonitelink.Bridge,
+	metricsHandler MetricsHandler,
+	apiTokenUC *usecase.ApiTokenUsecase,
+	userSessionUC *usecase.UserSessionUsecase,
 ) *Server {
+	// API トークンとログインセッションは全 service の auth interceptor で検証するので、lib/auth に登録しておく.
+	auth.SetAPITokenVerifier(apiTokenUC)
+	auth.SetUserSessionVerifier(userSessionUC)
+
 	return &Server{
 		userService:         userService,
 		controllerService:   controllerService,
 		notificationService: notificationService,
 		groupService:        groupService,
 		roleService:         roleService,
+		auditService:        auditService,
+		webhookService:      webhookService,
 		workerManager:       workerManager,
 		blobClient:          blobClient,
 		resoniteLinkBridge:  resoniteLinkBridge,
+		metricsHandler:      metricsHandler,
 	}
 }
 
@@ -158,9 +175,18 @@ func (s *Server) ListenAndServe(addr string, frontUrl string) error {
 		p, h := s.roleService.NewHandler()
 		router.PathPrefix(p).Handler(h)
 	}
+	{
+		p, h := s.auditService.NewHandler()
+		router.PathPrefix(p).Handler(h)
+	}
+	{
+		p, h := s.webhookService.NewHandler()
+		router.PathPrefix(p).Handler(h)
+	}
 
 	router.HandleFunc("/blobs/{uuid}", makeBlobHandler(s.blobClient)).Methods(http.MethodGet, http.MethodHead)
 	router.HandleFunc(resonitelink.WSPath, s.resoniteLinkBridge.ServeHTTP).Methods(http.MethodGet)
+	router.Handle("/metrics", s.metricsHandler).Methods(http.MethodGet)
 
 	if len(frontUrl) > 0 {
 		rpURL, err := url.Parse(frontUrl)
@@ -5,6 +5,7 @@ package app
 import (
 	"github.com/google/wire"
 	"github.com/hantabaru1014/baru-reso-headless-controller/adapter"
+	"github.com/hantabaru1014/baru-reso-headless-controller/adapter/cluster"
 	"github.com/hantabaru1014/baru-reso-headless-controller/adapter/hostconnector"
 	"github.com/hantabaru1014/baru-reso-headless-controller/adapter/resonitelink"
 	"github.com/hantabaru1014/baru-reso-headless-controller/adapter/rpc"
@@ -12,12 +13,15 @@ import (
 	"github.com/hantabaru1014/baru-reso-headless-controller/config"
 	"github.com/hantabaru1014/baru-reso-headless-controller/db"
 	"github.com/hantabaru1014/baru-reso-headless-controller/lib/blobstore"
+	"github.com/hantabaru1014/baru-reso-headless-controller/lib/secretbox"
 	"github.com/hantabaru1014/baru-reso-headless-controller/lib/skyfrost"
 	"github.com/hantabaru1014/baru-reso-headless-controller/usecase"
 	"github.com/hantabaru1014/baru-reso-headless-controller/usecase/async_job"
+	"github.com/hantabaru1014/baru-reso-headless-controller/usecase/desired_state"
 	"github.com/hantabaru1014/baru-reso-headless-controller/usecase/notification"
 	"github.com/hantabaru1014/baru-reso-headless-controller/usecase/port"
 	"github.com/hantabaru1014/baru-reso-headless-controller/worker"
+	"github.com/jackc/pgx/v5/pgxpool"
 )
 
 // Config providers
@@ -49,14 +53,172 @@ func ProvideResoniteLinkConfig(cfg *config.EnvConfig) *config.ResoniteLinkConfig
 	return &cfg.ResoniteLink
 }
 
+func ProvideKubernetesConfig(cfg *config.EnvConfig) *config.KubernetesConfig {
+	return &cfg.Kubernetes
+}
+
+func ProvideHostConnectorConfig(cfg *config.EnvConfig) *config.HostConnectorConfig {
+	return &cfg.Connector
+}
+
+func ProvideClusterConfig(cfg *config.EnvConfig) *config.ClusterConfig {
+	return &cfg.Cluster
+}
+
+func ProvideCredentialConfig(cfg *config.EnvConfig) *config.CredentialConfig {
+	return &cfg.Credential
+}
+
+func ProvideOIDCConfig(cfg *config.EnvConfig) *config.OIDCConfig {
+	return &cfg.OIDC
+}
+
+// ProvideOIDCLoginUsecase leaves the provider nil while OIDC_ISSUER_URL is
+// unset, which the usecase treats as SSO being disabled.
+func ProvideOIDCLoginUsecase(q *db.Queries, pool *pgxpool.Pool, cfg *config.OIDCConfig, guc *usecase.GroupUsecase) *usecase.OIDCLoginUsecase {
+	var provider port.OIDCProvider
+	if cfg.Enabled() {
+		provider = adapter.NewOIDCProvider(cfg)
+	}
+
+	return usecase.NewOIDCLoginUsecase(q, pool, provider, guc, usecase.OIDCLoginOptions{
+		ProviderName:     cfg.ProviderName,
+		UserIDClaim:      cfg.UserIDClaim,
+		AutoProvision:    cfg.AutoProvision,
+		PersonalRoleID:   cfg.PersonalRoleID,
+		ProvisionGroupID: cfg.ProvisionGroupID,
+		ProvisionRoleID:  cfg.ProvisionRoleID,
+	})
+}
+
+// ProvideSecretKeyring builds the keyring shared by the headless account
+// credentials and the TOTP secrets. The keys were checked by
+// EnvConfig.Validate, so like the database pool it panics on a bad config.
+func ProvideSecretKeyring(cfg *config.CredentialConfig) *secretbox.Keyring {
+	keyring, err := secretbox.NewKeyring(cfg.EncryptionKeys, cfg.PrimaryKeyID)
+	if err != nil {
+		panic(err)
+	}
+
+	return keyring
+}
+
+func ProvideTOTPConfig(cfg *config.EnvConfig) *config.TOTPConfig {
+	return &cfg.TOTP
+}
+
+func ProvideTOTPUsecase(
+	q *db.Queries,
+	pool *pgxpool.Pool,
+	cipher port.TOTPSecretCipher,
+	memberRepo port.GroupMemberRepository,
+	cfg *config.TOTPConfig,
+) *usecase.TOTPUsecase {
+	return usecase.NewTOTPUsecase(q, pool, cipher, memberRepo, usecase.TOTPOptions{
+		Issuer:                 cfg.Issuer,
+		RequiredForSystemRoles: cfg.RequiredForSystemRoles,
+	})
+}
+
+// ProvideNotificationBus relays events between controller instances over
+// Postgres when clustering is enabled, and keeps them in process otherwise.
+func ProvideNotificationBus(
+	clusterCfg *config.ClusterConfig,
+	repo port.NotificationRepository,
+	pgBus *cluster.PostgresBus,
+) *notification.PersistentBus {
+	if clusterCfg.Enabled {
+		return notification.NewPersistentBus(repo, pgBus)
+	}
+
+	return notification.NewPersistentBus(repo, notification.NewBus())
+}
+
+// ProvideSessionStateCache replicates the cache to the other controller
+// instances when clustering is enabled.
+func ProvideSessionStateCache(
+	clusterCfg *config.ClusterConfig,
+	local *sessionstate.MemoryCache,
+	replicated *cluster.ReplicatedSessionStateCache,
+) port.SessionStateCache {
+	if clusterCfg.Enabled {
+		return replicated
+	}
+
+	return local
+}
+
+// ProvideStreamOwnership splits the per-host and per-node event streams
+// between the live controller instances when clustering is enabled.
+func ProvideStreamOwnership(clusterCfg *config.ClusterConfig, membership *cluster.Membership) port.StreamOwnership {
+	if clusterCfg.Enabled {
+		return membership
+	}
+
+	return port.SingleInstanceOwnership{}
+}
+
+// ProvideHostDrainer also consults the shared drain registry when
+// clustering is enabled, because the orchestrator only drains hosts on
+// the leader.
+func ProvideHostDrainer(
+	clusterCfg *config.ClusterConfig,
+	orchestrator *worker.HostUpgradeOrchestrator,
+	registry *cluster.DrainRegistry,
+) port.HostDrainer {
+	if clusterCfg.Enabled {
+		return port.HostDrainers{orchestrator, registry}
+	}
+
+	return orchestrator
+}
+
+// ProvideContainerLogArchive keeps archived host logs in a bucket of their
+// own, because objects in the downloads bucket expire. Like the database
+// pool it panics on a bad config; minio only rejects a malformed endpoint.
+func ProvideContainerLogArchive(q *db.Queries, cfg *config.RustFSConfig) *adapter.ContainerLogArchive {
+	blob, err := blobstore.NewMinioClientForBucket(cfg, cfg.ContainerLogArchivesBucket, 0)
+	if err != nil {
+		panic(err)
+	}
+
+	return adapter.NewContainerLogArchive(q, blob)
+}
+
+// ProvideHostConnectors registers every connector hosts may be placed on.
+// Docker is always present so hosts created before switching HOST_CONNECTOR
+// stay manageable; Kubernetes only when it is enabled.
+func ProvideHostConnectors(
+	dc *hostconnector.DockerHostConnector,
+	kc *hostconnector.KubernetesHostConnector,
+	k8sCfg *config.KubernetesConfig,
+) hostconnector.Connectors {
+	connectors := hostconnector.Connectors{
+		port.HostConnectorType_DOCKER: dc,
+	}
+	if k8sCfg.Enabled {
+		connectors[port.HostConnectorType_KUBERNETES] = kc
+	}
+
+	return connectors
+}
+
 // ProvideWorkerManager groups the concrete background workers AND
-// performs two post-construction links that wire itself cannot express:
+// performs the post-construction links that wire itself cannot express:
 //   - The orchestrator needs a SessionStopper (SessionUsecase), but
 //     SessionUsecase needs a HostDrainer (the orchestrator). Wire can
 //     pick only one direction at construction time; we close the cycle
 //     by setting the stopper here, after both ends exist.
 //   - The orchestrator subscribes to ImageChecker so registry polling
 //     happens in exactly one place.
+//   - With clustering the orchestrator shares its drain set through the
+//     drain registry.
+//
+// Workers that poll without claiming rows, or that must see every event
+// once (webhook dispatch), are singletons: with clustering only the leader
+// runs them. Workers that claim their rows with SKIP LOCKED, that follow
+// the hosts owned by this instance, or that serve this instance's own
+// clients (the log feed) run everywhere.
 func ProvideWorkerManager(
 	imageChecker *worker.ImageChecker,
 	dockerEventWatcher *worker.DockerEventWatcher,
@@ -64,19 +226,57 @@ func ProvideWorkerManager(
 	upgradeOrchestrator *worker.HostUpgradeOrchestrator,
 	scheduledOpExecutor *worker.ScheduledOperationExecutor,
 	asyncJobExecutor *worker.AsyncJobExecutor,
+	podWatcher *worker.KubernetesPodWatcher,
+	crashRecoverer *worker.HostCrashRecoverer,
+	sessionRestorer *worker.SessionRestorer,
+	webhookDispatcher *worker.WebhookDispatcher,
+	webhookDeliverer *worker.WebhookDeliverer,
+	notificationPruner *worker.NotificationHistoryPruner,
+	logArchiver *worker.ContainerLogArchiver,
+	metricsSampler *worker.MetricsSampler,
+	logFeed *adapter.ContainerLogFeed,
+	k8sCfg *config.KubernetesConfig,
 	sessionStopper port.SessionStopper,
+	clusterCfg *config.ClusterConfig,
+	pubsub *cluster.PubSub,
+	membership *cluster.Membership,
+	drainRegistry *cluster.DrainRegistry,
+	elector *cluster.AdvisoryLockElector,
 ) *worker.Manager {
 	upgradeOrchestrator.SetSessionStopper(sessionStopper)
 	imageChecker.Subscribe(upgradeOrchestrator.OnNewImage)
 
-	return worker.NewManager([]worker.Runner{
-		imageChecker,
+	runners := []worker.Runner{
 		dockerEventWatcher,
 		hostEventWatcher,
-		upgradeOrchestrator,
 		scheduledOpExecutor,
 		asyncJobExecutor,
-	})
+		crashRecoverer,
+		webhookDeliverer,
+		logFeed,
+	}
+	singletons := []worker.Runner{
+		imageChecker,
+		upgradeOrchestrator,
+		crashRecoverer.Resumer(),
+		sessionRestorer,
+		webhookDispatcher,
+		notificationPruner,
+		logArchiver,
+		metricsSampler,
+	}
+	if k8sCfg.Enabled {
+		singletons = append(singletons, podWatcher)
+	}
+
+	if !clusterCfg.Enabled {
+		return worker.NewLeaderElectedManager(runners, singletons, nil)
+	}
+
+	upgradeOrchestrator.SetDrainStore(drainRegistry)
+	runners = append(runners, pubsub, membership, drainRegistry)
+
+	return worker.NewLeaderElectedManager(runners, singletons, elector)
 }
 
 // ProvideScheduledOperationExecutor は scheduled session operation worker を
@@ -85,11 +285,12 @@ func ProvideWorkerManager(
 func ProvideScheduledOperationExecutor(
 	repo port.ScheduledSessionOperationRepository,
 	suc *usecase.SessionUsecase,
+	stuc *usecase.SessionTemplateUsecase,
 	srepo port.SessionRepository,
 	stateCache port.SessionStateCache,
 	userChecker worker.UserExistenceChecker,
 ) *worker.ScheduledOperationExecutor {
-	return worker.NewScheduledOperationExecutor(repo, suc, srepo, stateCache, userChecker, worker.ScheduledOperationExecutorOptions{})
+	return worker.NewScheduledOperationExecutor(repo, suc, stuc, srepo, stateCache, userChecker, worker.ScheduledOperationExecutorOptions{})
 }
 
 // ProvideAsyncJobDispatcher はホスト/セッションの非同期 job を実行する dispatcher を
@@ -103,6 +304,44 @@ func ProvideAsyncJobDispatcher(
 	return async_job.NewDispatcher(hhuc, suc, hauc)
 }
 
+// ProvideHostCrashRecoverer はクラッシュしたホストを自動再起動する worker を構築する.
+// 再起動は HeadlessHostUsecase をそのまま HostRestarter として渡し、ユーザー操作の
+// 再起動と同じ経路 (ワールド復元込み) を通す.
+func ProvideHostCrashRecoverer(
+	q *db.Queries,
+	hhuc *usecase.HeadlessHostUsecase,
+	bus notification.Bus,
+	cfg *config.WorkerConfig,
+) *worker.HostCrashRecoverer {
+	return worker.NewHostCrashRecoverer(q, hhuc, bus, cfg)
+}
+
+// ProvideSessionRestorer はホストのクラッシュ後にセッションを起動し直す worker を構築する.
+// 起動は SessionUsecase をそのまま SessionStarter として渡し、ユーザー操作の
+// セッション開始と同じ経路 (ポート割り当て / state cache 更新込み) を通す.
+func ProvideSessionRestorer(
+	srepo port.SessionRepository,
+	suc *usecase.SessionUsecase,
+	hostDrainer port.HostDrainer,
+	bus notification.Bus,
+	cfg *config.WorkerConfig,
+) *worker.SessionRestorer {
+	return worker.NewSessionRestorer(srepo, suc, hostDrainer, bus, cfg)
+}
+
+// ProvideHostTerminationObserver はホストの予期しない停止を受け取る observer をまとめる.
+// HeadlessHostRestart は RUNNING のセッションを終了扱いにするので、復元対象の
+// セッションを退避する SessionRestorer を自動再起動より先に呼ぶ.
+// WebhookDispatcher は停止時点で RUNNING だったセッションをクラッシュとして通知するので、
+// セッションの状態を変える SessionRestorer よりさらに先に呼ぶ.
+func ProvideHostTerminationObserver(
+	webhookDispatcher *worker.WebhookDispatcher,
+	sessionRestorer *worker.SessionRestorer,
+	crashRecoverer *worker.HostCrashRecoverer,
+) worker.HostTerminationObserver {
+	return worker.HostTerminationObservers{webhookDispatcher, sessionRestorer, crashRecoverer}
+}
+
 // ProvideAsyncJobExecutor は AsyncJobExecutor worker を構築する.
 func ProvideAsyncJobExecutor(
 	repo port.AsyncJobRepository,
@@ -115,8 +354,9 @@ func ProvideAsyncJobExecutor(
 
 // ProvideHostEventHandlers gathers consumers for the per-host event
 // streams. Order matters:
-//   - DB-mutating handlers (state sync, lifecycle, upgrade orchestrator) run
-//     first so the DB reflects the new state.
+//   - DB-mutating handlers (state sync, lifecycle, user event recorder,
+//     upgrade orchestrator) run first so the DB reflects the new state. The
+//     recorder follows lifecycle because its rows reference the session.
 //   - NotificationDispatcher runs after those so frontend clients that
 //     re-fetch on receipt of the notification get the post-mutation rows.
 //   - LoggingHostEventHandler runs last so log lines reflect what all the
@@ -124,11 +364,12 @@ func ProvideAsyncJobExecutor(
 func ProvideHostEventHandlers(
 	sessionStateSyncHandler *worker.SessionStateSyncHandler,
 	sessionLifecycleHandler *worker.SessionLifecycleHandler,
+	sessionUserEventRecorder *worker.SessionUserEventRecorder,
 	upgradeOrchestrator *worker.HostUpgradeOrchestrator,
 	notificationDispatcher *worker.NotificationDispatcher,
 	loggingHandler *worker.LoggingHostEventHandler,
 ) []worker.HostEventHandler {
-	return []worker.HostEventHandler{sessionStateSyncHandler, sessionLifecycleHandler, upgradeOrchestrator, notificationDispatcher, loggingHandler}
+	return []worker.HostEventHandler{sessionStateSyncHandler, sessionLifecycleHandler, sessionUserEventRecorder, upgradeOrchestrator, notificationDispatcher, loggingHandler}
 }
 
 // ProvideHeadlessAccountFetcher exposes HeadlessAccountUsecase under the
@@ -146,6 +387,12 @@ var ConfigSet = wire.NewSet(
 	ProvideServerConfig,
 	ProvideRustFSConfig,
 	ProvideResoniteLinkConfig,
+	ProvideKubernetesConfig,
+	ProvideHostConnectorConfig,
+	ProvideClusterConfig,
+	ProvideCredentialConfig,
+	ProvideOIDCConfig,
+	ProvideTOTPConfig,
 )
 
 func InitializeServer(cfg *config.EnvConfig) (*Server, error) {
@@ -157,9 +404,19 @@ func InitializeServer(cfg *config.EnvConfig) (*Server, error) {
 		db.NewConnPool,
 		db.NewQueriesFromPool,
 
+		// headless account の認証情報と TOTP secret の暗号化
+		ProvideSecretKeyring,
+		adapter.NewCredentialCipher,
+		wire.Bind(new(port.CredentialCipher), new(*adapter.CredentialCipher)),
+		adapter.NewTOTPSecretCipher,
+		wire.Bind(new(port.TOTPSecretCipher), new(*adapter.TOTPSecretCipher)),
+
 		// host connector
 		hostconnector.NewDockerHostConnector,
-		wire.Bind(new(hostconnector.HostConnector), new(*hostconnector.DockerHostConnector)),
+		hostconnector.NewKubernetesHostConnector,
+		ProvideHostConnectors,
+		wire.Bind(new(port.DockerNodeRepository), new(*adapter.DockerNodeRepository)),
+		adapter.NewDockerNodeRepository,
 
 		// skyfrost client
 		skyfrost.NewDefaultClient,
@@ -172,8 +429,17 @@ func InitializeServer(cfg *config.EnvConfig) (*Server, error) {
 		// repository
 		wire.Bind(new(port.HeadlessHostRepository), new(*adapter.HeadlessHostRepository)),
 		adapter.NewHeadlessHostRepository,
+		ProvideContainerLogArchive,
 		wire.Bind(new(port.SessionRepository), new(*adapter.SessionRepository)),
 		adapter.NewSessionRepository,
+		wire.Bind(new(port.SessionUserEventRepository), new(*adapter.SessionUserEventRepository)),
+		adapter.NewSessionUserEventRepository,
+		wire.Bind(new(port.MetricSampleRepository), new(*adapter.MetricSampleRepository)),
+		adapter.NewMetricSampleRepository,
+		wire.Bind(new(port.SessionTemplateRepository), new(*adapter.SessionTemplateRepository)),
+		adapter.NewSessionTemplateRepository,
+		wire.Bind(new(port.HostTemplateRepository), new(*adapter.HostTemplateRepository)),
+		adapter.NewHostTemplateRepository,
 		wire.Bind(new(port.ScheduledSessionOperationRepository), new(*adapter.ScheduledSessionOperationRepository)),
 		adapter.NewScheduledSessionOperationRepository,
 		wire.Bind(new(port.AsyncJobRepository), new(*adapter.AsyncJobRepository)),
@@ -186,27 +452,60 @@ func InitializeServer(cfg *config.EnvConfig) (*Server, error) {
 		adapter.NewRoleRepository,
 		wire.Bind(new(port.GroupMemberRepository), new(*adapter.GroupMemberRepository)),
 		adapter.NewGroupMemberRepository,
+		wire.Bind(new(port.AuditEventRepository), new(*adapter.AuditEventRepository)),
+		adapter.NewAuditEventRepository,
+		wire.Bind(new(port.WebhookRepository), new(*adapter.WebhookRepository)),
+		adapter.NewWebhookRepository,
+		wire.Bind(new(port.ApiTokenRepository), new(*adapter.ApiTokenRepository)),
+		adapter.NewApiTokenRepository,
+		wire.Bind(new(port.UserSessionRepository), new(*adapter.UserSessionRepository)),
+		adapter.NewUserSessionRepository,
+		wire.Bind(new(port.NotificationRepository), new(*adapter.NotificationRepository)),
+		adapter.NewNotificationRepository,
+		wire.Bind(new(port.HostLogFeed), new(*adapter.ContainerLogFeed)),
+		adapter.NewContainerLogFeed,
+
+		// multi-instance coordination (only started when CLUSTER_ENABLED)
+		cluster.NewPubSub,
+		cluster.NewMembership,
+		cluster.NewAdvisoryLockElector,
+		cluster.NewDrainRegistry,
+		cluster.NewPostgresBus,
+		cluster.NewReplicatedSessionStateCache,
+		ProvideStreamOwnership,
 
 		// in-memory session-state cache (volatile snapshot owned by container)
 		sessionstate.NewMemoryCache,
-		wire.Bind(new(port.SessionStateCache), new(*sessionstate.MemoryCache)),
+		ProvideSessionStateCache,
 
-		// in-memory notification bus (volatile pub/sub for frontend push)
-		notification.NewBus,
-		wire.Bind(new(notification.Bus), new(*notification.MemoryBus)),
+		// notification bus (pub/sub for frontend push, with a bounded history for replay)
+		ProvideNotificationBus,
+		wire.Bind(new(notification.Bus), new(*notification.PersistentBus)),
 
 		// worker
 		worker.NewImageChecker,
 		worker.NewDockerEventWatcher,
+		worker.NewKubernetesPodWatcher,
+		ProvideHostCrashRecoverer,
+		ProvideSessionRestorer,
+		worker.NewWebhookDispatcher,
+		worker.NewWebhookDeliverer,
+		worker.NewNotificationHistoryPruner,
+		worker.NewContainerLogArchiver,
+		worker.NewMetricsSampler,
+		wire.Bind(new(worker.SessionStateRanger), new(*sessionstate.MemoryCache)),
+		wire.Bind(new(port.HostLogArchive), new(*adapter.ContainerLogArchive)),
+		ProvideHostTerminationObserver,
 		worker.NewHostEventWatcher,
 		worker.NewSQLHostEventStore,
 		wire.Bind(new(worker.HostEventStore), new(*worker.SQLHostEventStore)),
 		worker.NewLoggingHostEventHandler,
 		worker.NewSessionStateSyncHandler,
 		worker.NewSessionLifecycleHandler,
+		worker.NewSessionUserEventRecorder,
 		worker.NewHostUpgradeOrchestrator,
 		worker.NewNotificationDispatcher,
-		wire.Bind(new(port.HostDrainer), new(*worker.HostUpgradeOrchestrator)),
+		ProvideHostDrainer,
 		ProvideScheduledOperationExecutor,
 		ProvideAsyncJobDispatcher,
 		ProvideAsyncJobExecutor,
@@ -216,14 +515,26 @@ func InitializeServer(cfg *config.EnvConfig) (*Server, error) {
 
 		// usecase
 		usecase.NewHeadlessHostUsecase,
+		usecase.NewHostLogUsecase,
 		usecase.NewUserUsecase,
 		usecase.NewHeadlessAccountUsecase,
 		usecase.NewSessionUsecase,
 		usecase.NewBlobUsecase,
+		usecase.NewSessionHistoryUsecase,
+		usecase.NewMetricsUsecase,
+		usecase.NewSessionTemplateUsecase,
+		usecase.NewHostTemplateUsecase,
 		usecase.NewScheduledSessionOperationUsecase,
 		usecase.NewPermissionUsecase,
 		usecase.NewGroupUsecase,
 		usecase.NewRoleUsecase,
+		usecase.NewAuditUsecase,
+		usecase.NewWebhookUsecase,
+		usecase.NewApiTokenUsecase,
+		ProvideOIDCLoginUsecase,
+		ProvideTOTPUsecase,
+		usecase.NewUserSessionUsecase,
+		usecase.NewNotificationUsecase,
 		async_job.NewUsecase,
 		wire.Bind(new(port.SessionStopper), new(*usecase.SessionUsecase)),
 
@@ -233,11 +544,14 @@ func InitializeServer(cfg *config.EnvConfig) (*Server, error) {
 		rpc.NewNotificationService,
 		rpc.NewGroupService,
 		rpc.NewRoleService,
+		rpc.NewAuditService,
+		rpc.NewWebhookService,
 
 		// resonite link bridge
 		resonitelink.NewBridge,
 
 		NewServer,
+		ProvideMetricsHandler,
 	)
 	return nil, nil
 }
@@ -251,9 +565,19 @@ func InitializeCli(cfg *config.EnvConfig) *Cli {
 		db.NewConnPool,
 		db.NewQueriesFromPool,
 
+		// headless account の認証情報と TOTP secret の暗号化
+		ProvideSecretKeyring,
+		adapter.NewCredentialCipher,
+		wire.Bind(new(port.CredentialCipher), new(*adapter.CredentialCipher)),
+		adapter.NewTOTPSecretCipher,
+		wire.Bind(new(port.TOTPSecretCipher), new(*adapter.TOTPSecretCipher)),
+
 		// host connector
 		hostconnector.NewDockerHostConnector,
-		wire.Bind(new(hostconnector.HostConnector), new(*hostconnector.DockerHostConnector)),
+		hostconnector.NewKubernetesHostConnector,
+		ProvideHostConnectors,
+		wire.Bind(new(port.DockerNodeRepository), new(*adapter.DockerNodeRepository)),
+		adapter.NewDockerNodeRepository,
 
 		// skyfrost client
 		skyfrost.NewDefaultClient,
@@ -262,6 +586,7 @@ func InitializeCli(cfg *config.EnvConfig) *Cli {
 		// repository
 		wire.Bind(new(port.HeadlessHostRepository), new(*adapter.HeadlessHostRepository)),
 		adapter.NewHeadlessHostRepository,
+		ProvideContainerLogArchive,
 		wire.Bind(new(port.SessionRepository), new(*adapter.SessionRepository)),
 		adapter.NewSessionRepository,
 		wire.Bind(new(port.ScheduledSessionOperationRepository), new(*adapter.ScheduledSessionOperationRepository)),
@@ -272,6 +597,12 @@ func InitializeCli(cfg *config.EnvConfig) *Cli {
 		adapter.NewRoleRepository,
 		wire.Bind(new(port.GroupMemberRepository), new(*adapter.GroupMemberRepository)),
 		adapter.NewGroupMemberRepository,
+		wire.Bind(new(port.AuditEventRepository), new(*adapter.AuditEventRepository)),
+		adapter.NewAuditEventRepository,
+		wire.Bind(new(port.ApiTokenRepository), new(*adapter.ApiTokenRepository)),
+		adapter.NewApiTokenRepository,
+		wire.Bind(new(port.UserSessionRepository), new(*adapter.UserSessionRepository)),
+		adapter.NewUserSessionRepository,
 
 		// CLI has no upgrade orchestrator running, so SessionUsecase
 		// gets a no-op drainer.
@@ -291,6 +622,11 @@ func InitializeCli(cfg *config.EnvConfig) *Cli {
 		usecase.NewScheduledSessionOperationUsecase,
 		usecase.NewPermissionUsecase,
 		usecase.NewGroupUsecase,
+		usecase.NewAuditUsecase,
+		usecase.NewApiTokenUsecase,
+		ProvideTOTPUsecase,
+		usecase.NewUserSessionUsecase,
+		desired_state.NewReconciler,
 
 		NewCli,
 	)
@@ -9,6 +9,7 @@ package app
 import (
 	"github.com/google/wire"
 	"github.com/hantabaru1014/baru-reso-headless-controller/adapter"
+	"github.com/hantabaru1014/baru-reso-headless-controller/adapter/cluster"
 	"github.com/hantabaru1014/baru-reso-headless-controller/adapter/hostconnector"
 	"github.com/hantabaru1014/baru-reso-headless-controller/adapter/resonitelink"
 	"github.com/hantabaru1014/baru-reso-headless-controller/adapter/rpc"
@@ -16,12 +17,15 @@ import (
 	"github.com/hantabaru1014/baru-reso-headless-controller/config"
 	"github.com/hantabaru1014/baru-reso-headless-controller/db"
 	"github.com/hantabaru1014/baru-reso-headless-controller/lib/blobstore"
+	"github.com/hantabaru1014/baru-reso-headless-controller/lib/secretbox"
 	"github.com/hantabaru1014/baru-reso-headless-controller/lib/skyfrost"
 	"github.com/hantabaru1014/baru-reso-headless-controller/usecase"
 	"github.com/hantabaru1014/baru-reso-headless-controller/usecase/async_job"
+	"github.com/hantabaru1014/baru-reso-headless-controller/usecase/desired_state"
 	"github.com/hantabaru1014/baru-reso-headless-controller/usecase/notification"
 	"github.com/hantabaru1014/baru-reso-headless-controller/usecase/port"
 	"github.com/hantabaru1014/baru-reso-headless-controller/worker"
+	"github.com/jackc/pgx/v5/pgxpool"
 )
 
 // Injectors from wire.go:
@@ -34,25 +38,61 @@ func InitializeServer(cfg *config.EnvConfig) (*Server, error) {
 	groupRepository := adapter.NewGroupRepository(queries)
 	groupMemberRepository := adapter.NewGroupMemberRepository(queries)
 	roleRepository := adapter.NewRoleRepository(queries)
+	userSessionRepository := adapter.NewUserSessionRepository(queries)
 	permissionUsecase := usecase.NewPermissionUsecase(groupRepository, groupMemberRepository, roleRepository)
-	groupUsecase := usecase.NewGroupUsecase(groupRepository, groupMemberRepository, roleRepository, permissionUsecase)
+	groupUsecase := usecase.NewGroupUsecase(groupRepository, groupMemberRepository, roleRepository, userSessionRepository, permissionUsecase, queries)
 	userUsecase := usecase.NewUserUsecase(queries, pool, defaultClient, groupUsecase, permissionUsecase)
-	userService := rpc.NewUserService(userUsecase, permissionUsecase)
+	apiTokenRepository := adapter.NewApiTokenRepository(queries)
+	apiTokenUsecase := usecase.NewApiTokenUsecase(apiTokenRepository, groupRepository)
+	oidcConfig := ProvideOIDCConfig(cfg)
+	oidcLoginUsecase := ProvideOIDCLoginUsecase(queries, pool, oidcConfig, groupUsecase)
+	credentialConfig := ProvideCredentialConfig(cfg)
+	keyring := ProvideSecretKeyring(credentialConfig)
+	totpSecretCipher := adapter.NewTOTPSecretCipher(keyring)
+	totpConfig := ProvideTOTPConfig(cfg)
+	totpUsecase := ProvideTOTPUsecase(queries, pool, totpSecretCipher, groupMemberRepository, totpConfig)
+	userSessionUsecase := usecase.NewUserSessionUsecase(userSessionRepository)
+	auditEventRepository := adapter.NewAuditEventRepository(queries)
+	auditUsecase := usecase.NewAuditUsecase(auditEventRepository)
+	userService := rpc.NewUserService(userUsecase, apiTokenUsecase, oidcLoginUsecase, totpUsecase, userSessionUsecase, permissionUsecase, auditUsecase)
 	dockerConfig := ProvideDockerConfig(cfg)
 	grpcConfig := ProvideGRPCConfig(cfg)
-	dockerHostConnector := hostconnector.NewDockerHostConnector(dockerConfig, grpcConfig)
-	headlessHostRepository := adapter.NewHeadlessHostRepository(queries, dockerHostConnector, grpcConfig)
+	dockerNodeRepository := adapter.NewDockerNodeRepository(queries)
+	credentialCipher := adapter.NewCredentialCipher(keyring)
+	dockerHostConnector := hostconnector.NewDockerHostConnector(dockerConfig, grpcConfig, dockerNodeRepository, credentialCipher)
+	kubernetesConfig := ProvideKubernetesConfig(cfg)
+	kubernetesHostConnector := hostconnector.NewKubernetesHostConnector(kubernetesConfig, dockerConfig, grpcConfig, credentialCipher)
+	connectors := ProvideHostConnectors(dockerHostConnector, kubernetesHostConnector, kubernetesConfig)
+	rustFSConfig := ProvideRustFSConfig(cfg)
+	containerLogArchive := ProvideContainerLogArchive(queries, rustFSConfig)
+	headlessHostRepository := adapter.NewHeadlessHostRepository(queries, connectors, grpcConfig, containerLogArchive)
 	sessionRepository := adapter.NewSessionRepository(queries)
-	headlessAccountUsecase := usecase.NewHeadlessAccountUsecase(queries, defaultClient, permissionUsecase)
+	clusterConfig := ProvideClusterConfig(cfg)
+	headlessAccountUsecase := usecase.NewHeadlessAccountUsecase(queries, defaultClient, permissionUsecase, credentialCipher)
 	headlessAccountFetcher := ProvideHeadlessAccountFetcher(headlessAccountUsecase)
 	workerConfig := ProvideWorkerConfig(cfg)
 	hostUpgradeOrchestrator := worker.NewHostUpgradeOrchestrator(headlessHostRepository, sessionRepository, headlessAccountFetcher, workerConfig)
+	drainRegistry := cluster.NewDrainRegistry(queries, clusterConfig)
+	hostDrainer := ProvideHostDrainer(clusterConfig, hostUpgradeOrchestrator, drainRegistry)
 	memoryCache := sessionstate.NewMemoryCache()
+	pubSub := cluster.NewPubSub(pool, queries, clusterConfig)
+	replicatedSessionStateCache := cluster.NewReplicatedSessionStateCache(memoryCache, pubSub)
+	sessionStateCache := ProvideSessionStateCache(clusterConfig, memoryCache, replicatedSessionStateCache)
 	serverConfig := ProvideServerConfig(cfg)
 	resoniteLinkConfig := ProvideResoniteLinkConfig(cfg)
-	sessionUsecase := usecase.NewSessionUsecase(sessionRepository, headlessHostRepository, hostUpgradeOrchestrator, memoryCache, serverConfig, resoniteLinkConfig, permissionUsecase)
-	headlessHostUsecase := usecase.NewHeadlessHostUsecase(headlessHostRepository, sessionRepository, sessionUsecase, headlessAccountUsecase, permissionUsecase)
-	rustFSConfig := ProvideRustFSConfig(cfg)
+	sessionUsecase := usecase.NewSessionUsecase(sessionRepository, headlessHostRepository, hostDrainer, sessionStateCache, serverConfig, resoniteLinkConfig, permissionUsecase)
+	hostConnectorConfig := ProvideHostConnectorConfig(cfg)
+	headlessHostUsecase := usecase.NewHeadlessHostUsecase(headlessHostRepository, sessionRepository, sessionUsecase, headlessAccountUsecase, permissionUsecase, hostConnectorConfig)
+	containerLogFeed := adapter.NewContainerLogFeed(queries)
+	hostLogUsecase := usecase.NewHostLogUsecase(headlessHostRepository, containerLogFeed, permissionUsecase)
+	sessionUserEventRepository := adapter.NewSessionUserEventRepository(queries)
+	sessionHistoryUsecase := usecase.NewSessionHistoryUsecase(sessionRepository, sessionUserEventRepository, headlessHostRepository)
+	metricSampleRepository := adapter.NewMetricSampleRepository(queries)
+	metricsUsecase := usecase.NewMetricsUsecase(metricSampleRepository, workerConfig)
+	sessionTemplateRepository := adapter.NewSessionTemplateRepository(queries)
+	sessionTemplateUsecase := usecase.NewSessionTemplateUsecase(sessionTemplateRepository, permissionUsecase)
+	hostTemplateRepository := adapter.NewHostTemplateRepository(queries)
+	hostTemplateUsecase := usecase.NewHostTemplateUsecase(hostTemplateRepository, permissionUsecase, headlessHostUsecase)
 	minioClient, err := blobstore.NewMinioClient(rustFSConfig)
 	if err != nil {
 		return nil, err
@@ -62,28 +102,49 @@ func InitializeServer(cfg *config.EnvConfig) (*Server, error) {
 	scheduledSessionOperationUsecase := usecase.NewScheduledSessionOperationUsecase(scheduledSessionOperationRepository, headlessHostRepository, sessionRepository, permissionUsecase)
 	asyncJobRepository := adapter.NewAsyncJobRepository(queries)
 	async_jobUsecase := async_job.NewUsecase(asyncJobRepository)
-	memoryBus := notification.NewBus()
-	controllerService := rpc.NewControllerService(headlessHostRepository, sessionRepository, headlessHostUsecase, headlessAccountUsecase, sessionUsecase, blobUsecase, scheduledSessionOperationUsecase, async_jobUsecase, permissionUsecase, groupRepository, roleRepository, defaultClient, memoryBus)
-	notificationService := rpc.NewNotificationService(memoryBus, headlessHostRepository, permissionUsecase)
-	groupService := rpc.NewGroupService(groupUsecase, permissionUsecase, groupRepository, roleRepository, headlessHostRepository, sessionRepository, headlessAccountUsecase)
+	notificationRepository := adapter.NewNotificationRepository(queries)
+	postgresBus := cluster.NewPostgresBus(pubSub)
+	persistentBus := ProvideNotificationBus(clusterConfig, notificationRepository, postgresBus)
+	controllerService := rpc.NewControllerService(headlessHostRepository, sessionRepository, headlessHostUsecase, hostLogUsecase, headlessAccountUsecase, sessionUsecase, sessionHistoryUsecase, metricsUsecase, sessionTemplateUsecase, hostTemplateUsecase, blobUsecase, scheduledSessionOperationUsecase, async_jobUsecase, permissionUsecase, auditUsecase, groupRepository, roleRepository, defaultClient, persistentBus)
+	notificationUsecase := usecase.NewNotificationUsecase(notificationRepository, permissionUsecase)
+	notificationService := rpc.NewNotificationService(persistentBus, headlessHostRepository, permissionUsecase, notificationUsecase)
+	groupService := rpc.NewGroupService(groupUsecase, permissionUsecase, auditUsecase, groupRepository, roleRepository, headlessHostRepository, sessionRepository, headlessAccountUsecase)
 	roleUsecase := usecase.NewRoleUsecase(roleRepository, groupRepository, permissionUsecase)
-	roleService := rpc.NewRoleService(roleUsecase, permissionUsecase, groupRepository, roleRepository, headlessHostRepository, sessionRepository, headlessAccountUsecase)
+	roleService := rpc.NewRoleService(roleUsecase, permissionUsecase, auditUsecase, groupRepository, roleRepository, headlessHostRepository, sessionRepository, headlessAccountUsecase)
+	auditService := rpc.NewAuditService(auditUsecase, permissionUsecase)
+	webhookRepository := adapter.NewWebhookRepository(queries)
+	webhookUsecase := usecase.NewWebhookUsecase(webhookRepository, permissionUsecase, workerConfig)
+	webhookService := rpc.NewWebhookService(webhookUsecase, permissionUsecase, auditUsecase, webhookRepository)
 	imageChecker := worker.NewImageChecker(dockerHostConnector, workerConfig)
-	dockerEventWatcher := worker.NewDockerEventWatcher(dockerHostConnector, queries, memoryBus, workerConfig)
+	webhookDispatcher := worker.NewWebhookDispatcher(queries, webhookRepository, persistentBus)
+	sessionRestorer := ProvideSessionRestorer(sessionRepository, sessionUsecase, hostDrainer, persistentBus, workerConfig)
+	hostCrashRecoverer := ProvideHostCrashRecoverer(queries, headlessHostUsecase, persistentBus, workerConfig)
+	hostTerminationObserver := ProvideHostTerminationObserver(webhookDispatcher, sessionRestorer, hostCrashRecoverer)
+	membership := cluster.NewMembership(queries, clusterConfig)
+	streamOwnership := ProvideStreamOwnership(clusterConfig, membership)
+	dockerEventWatcher := worker.NewDockerEventWatcher(dockerHostConnector, queries, persistentBus, hostTerminationObserver, streamOwnership, workerConfig)
 	sqlHostEventStore := worker.NewSQLHostEventStore(queries)
-	sessionStateSyncHandler := worker.NewSessionStateSyncHandler(sessionRepository, headlessHostRepository, memoryCache)
+	sessionStateSyncHandler := worker.NewSessionStateSyncHandler(sessionRepository, headlessHostRepository, sessionStateCache)
 	sessionLifecycleHandler := worker.NewSessionLifecycleHandler(sessionRepository)
-	notificationDispatcher := worker.NewNotificationDispatcher(memoryBus)
+	sessionUserEventRecorder := worker.NewSessionUserEventRecorder(sessionUserEventRepository)
+	notificationDispatcher := worker.NewNotificationDispatcher(persistentBus)
 	loggingHostEventHandler := worker.NewLoggingHostEventHandler()
-	v := ProvideHostEventHandlers(sessionStateSyncHandler, sessionLifecycleHandler, hostUpgradeOrchestrator, notificationDispatcher, loggingHostEventHandler)
-	hostEventWatcher := worker.NewHostEventWatcher(headlessHostRepository, sqlHostEventStore, workerConfig, v)
+	v := ProvideHostEventHandlers(sessionStateSyncHandler, sessionLifecycleHandler, sessionUserEventRecorder, hostUpgradeOrchestrator, notificationDispatcher, loggingHostEventHandler)
+	hostEventWatcher := worker.NewHostEventWatcher(headlessHostRepository, sqlHostEventStore, streamOwnership, workerConfig, v)
 	userExistenceChecker := adapter.NewUserExistenceChecker(queries)
-	scheduledOperationExecutor := ProvideScheduledOperationExecutor(scheduledSessionOperationRepository, sessionUsecase, sessionRepository, memoryCache, userExistenceChecker)
+	scheduledOperationExecutor := ProvideScheduledOperationExecutor(scheduledSessionOperationRepository, sessionUsecase, sessionTemplateUsecase, sessionRepository, sessionStateCache, userExistenceChecker)
 	dispatcher := ProvideAsyncJobDispatcher(headlessHostUsecase, sessionUsecase, headlessAccountUsecase)
-	asyncJobExecutor := ProvideAsyncJobExecutor(asyncJobRepository, dispatcher, memoryBus, userExistenceChecker)
-	manager := ProvideWorkerManager(imageChecker, dockerEventWatcher, hostEventWatcher, hostUpgradeOrchestrator, scheduledOperationExecutor, asyncJobExecutor, sessionUsecase)
+	asyncJobExecutor := ProvideAsyncJobExecutor(asyncJobRepository, dispatcher, persistentBus, userExistenceChecker)
+	kubernetesPodWatcher := worker.NewKubernetesPodWatcher(kubernetesHostConnector, queries, persistentBus, hostTerminationObserver, workerConfig)
+	webhookDeliverer := worker.NewWebhookDeliverer(webhookRepository, workerConfig)
+	notificationHistoryPruner := worker.NewNotificationHistoryPruner(notificationRepository, workerConfig)
+	containerLogArchiver := worker.NewContainerLogArchiver(containerLogArchive, workerConfig)
+	metricsSampler := worker.NewMetricsSampler(metricSampleRepository, headlessHostRepository, memoryCache, workerConfig)
+	advisoryLockElector := cluster.NewAdvisoryLockElector(pool, clusterConfig)
+	manager := ProvideWorkerManager(imageChecker, dockerEventWatcher, hostEventWatcher, hostUpgradeOrchestrator, scheduledOperationExecutor, asyncJobExecutor, kubernetesPodWatcher, hostCrashRecoverer, sessionRestorer, webhookDispatcher, webhookDeliverer, notificationHistoryPruner, containerLogArchiver, metricsSampler, containerLogFeed, kubernetesConfig, sessionUsecase, clusterConfig, pubSub, membership, drainRegistry, advisoryLockElector)
 	bridge := resonitelink.NewBridge(headlessHostRepository, sessionRepository, resoniteLinkConfig)
-	server := NewServer(userService, controllerService, notificationService, groupService, roleService, manager, minioClient, bridge)
+	metricsHandler := ProvideMetricsHandler(headlessHostRepository, memoryCache, queries, serverConfig)
+	server := NewServer(userService, controllerService, notificationService, groupService, roleService, auditService, webhookService, manager, minioClient, bridge, metricsHandler, apiTokenUsecase, userSessionUsecase)
 	return server, nil
 }
 
@@ -95,24 +156,44 @@ func InitializeCli(cfg *config.EnvConfig) *Cli {
 	groupRepository := adapter.NewGroupRepository(queries)
 	groupMemberRepository := adapter.NewGroupMemberRepository(queries)
 	roleRepository := adapter.NewRoleRepository(queries)
+	userSessionRepository := adapter.NewUserSessionRepository(queries)
 	permissionUsecase := usecase.NewPermissionUsecase(groupRepository, groupMemberRepository, roleRepository)
-	groupUsecase := usecase.NewGroupUsecase(groupRepository, groupMemberRepository, roleRepository, permissionUsecase)
+	groupUsecase := usecase.NewGroupUsecase(groupRepository, groupMemberRepository, roleRepository, userSessionRepository, permissionUsecase, queries)
 	userUsecase := usecase.NewUserUsecase(queries, pool, defaultClient, groupUsecase, permissionUsecase)
+	credentialConfig := ProvideCredentialConfig(cfg)
+	keyring := ProvideSecretKeyring(credentialConfig)
+	credentialCipher := adapter.NewCredentialCipher(keyring)
+	headlessAccountUsecase := usecase.NewHeadlessAccountUsecase(queries, defaultClient, permissionUsecase, credentialCipher)
 	dockerConfig := ProvideDockerConfig(cfg)
 	grpcConfig := ProvideGRPCConfig(cfg)
-	dockerHostConnector := hostconnector.NewDockerHostConnector(dockerConfig, grpcConfig)
-	headlessHostRepository := adapter.NewHeadlessHostRepository(queries, dockerHostConnector, grpcConfig)
+	dockerNodeRepository := adapter.NewDockerNodeRepository(queries)
+	dockerHostConnector := hostconnector.NewDockerHostConnector(dockerConfig, grpcConfig, dockerNodeRepository, credentialCipher)
+	kubernetesConfig := ProvideKubernetesConfig(cfg)
+	kubernetesHostConnector := hostconnector.NewKubernetesHostConnector(kubernetesConfig, dockerConfig, grpcConfig, credentialCipher)
+	connectors := ProvideHostConnectors(dockerHostConnector, kubernetesHostConnector, kubernetesConfig)
+	rustFSConfig := ProvideRustFSConfig(cfg)
+	containerLogArchive := ProvideContainerLogArchive(queries, rustFSConfig)
+	headlessHostRepository := adapter.NewHeadlessHostRepository(queries, connectors, grpcConfig, containerLogArchive)
 	sessionRepository := adapter.NewSessionRepository(queries)
 	noopHostDrainer := port.NoopHostDrainer{}
 	memoryCache := sessionstate.NewMemoryCache()
 	serverConfig := ProvideServerConfig(cfg)
 	resoniteLinkConfig := ProvideResoniteLinkConfig(cfg)
 	sessionUsecase := usecase.NewSessionUsecase(sessionRepository, headlessHostRepository, noopHostDrainer, memoryCache, serverConfig, resoniteLinkConfig, permissionUsecase)
-	headlessAccountUsecase := usecase.NewHeadlessAccountUsecase(queries, defaultClient, permissionUsecase)
-	headlessHostUsecase := usecase.NewHeadlessHostUsecase(headlessHostRepository, sessionRepository, sessionUsecase, headlessAccountUsecase, permissionUsecase)
+	hostConnectorConfig := ProvideHostConnectorConfig(cfg)
+	headlessHostUsecase := usecase.NewHeadlessHostUsecase(headlessHostRepository, sessionRepository, sessionUsecase, headlessAccountUsecase, permissionUsecase, hostConnectorConfig)
 	scheduledSessionOperationRepository := adapter.NewScheduledSessionOperationRepository(queries)
 	scheduledSessionOperationUsecase := usecase.NewScheduledSessionOperationUsecase(scheduledSessionOperationRepository, headlessHostRepository, sessionRepository, permissionUsecase)
-	cli := NewCli(queries, userUsecase, headlessHostUsecase, scheduledSessionOperationUsecase, groupUsecase, defaultClient)
+	auditEventRepository := adapter.NewAuditEventRepository(queries)
+	auditUsecase := usecase.NewAuditUsecase(auditEventRepository)
+	reconciler := desired_state.NewReconciler(groupUsecase, headlessHostUsecase, headlessAccountUsecase, sessionUsecase, scheduledSessionOperationUsecase)
+	apiTokenRepository := adapter.NewApiTokenRepository(queries)
+	apiTokenUsecase := usecase.NewApiTokenUsecase(apiTokenRepository, groupRepository)
+	totpSecretCipher := adapter.NewTOTPSecretCipher(keyring)
+	totpConfig := ProvideTOTPConfig(cfg)
+	totpUsecase := ProvideTOTPUsecase(queries, pool, totpSecretCipher, groupMemberRepository, totpConfig)
+	userSessionUsecase := usecase.NewUserSessionUsecase(userSessionRepository)
+	cli := NewCli(queries, userUsecase, headlessAccountUsecase, headlessHostUsecase, scheduledSessionOperationUsecase, groupUsecase, defaultClient, dockerNodeRepository, auditUsecase, reconciler, apiTokenUsecase, totpUsecase, userSessionUsecase)
 	return cli
 }
 
@@ -147,14 +228,170 @@ func ProvideResoniteLinkConfig(cfg *config.EnvConfig) *config.ResoniteLinkConfig
 	return &cfg.ResoniteLink
 }
 
+func ProvideKubernetesConfig(cfg *config.EnvConfig) *config.KubernetesConfig {
+	return &cfg.Kubernetes
+}
+
+func ProvideHostConnectorConfig(cfg *config.EnvConfig) *config.HostConnectorConfig {
+	return &cfg.Connector
+}
+
+func ProvideClusterConfig(cfg *config.EnvConfig) *config.ClusterConfig {
+	return &cfg.Cluster
+}
+
+func ProvideCredentialConfig(cfg *config.EnvConfig) *config.CredentialConfig {
+	return &cfg.Credential
+}
+
+func ProvideOIDCConfig(cfg *config.EnvConfig) *config.OIDCConfig {
+	return &cfg.OIDC
+}
+
+// ProvideOIDCLoginUsecase leaves the provider nil while OIDC_ISSUER_URL is
+// unset, which the usecase treats as SSO being disabled.
+func ProvideOIDCLoginUsecase(q *db.Queries, pool *pgxpool.Pool, cfg *config.OIDCConfig, guc *usecase.GroupUsecase) *usecase.OIDCLoginUsecase {
+	var provider port.OIDCProvider
+	if cfg.Enabled() {
+		provider = adapter.NewOIDCProvider(cfg)
+	}
+
+	return usecase.NewOIDCLoginUsecase(q, pool, provider, guc, usecase.OIDCLoginOptions{
+		ProviderName:     cfg.ProviderName,
+		UserIDClaim:      cfg.UserIDClaim,
+		AutoProvision:    cfg.AutoProvision,
+		PersonalRoleID:   cfg.PersonalRoleID,
+		ProvisionGroupID: cfg.ProvisionGroupID,
+		ProvisionRoleID:  cfg.ProvisionRoleID,
+	})
+}
+
+// ProvideSecretKeyring builds the keyring shared by the headless account
+// credentials and the TOTP secrets. The keys were checked by
+// EnvConfig.Validate, so like the database pool it panics on a bad config.
+func ProvideSecretKeyring(cfg *config.CredentialConfig) *secretbox.Keyring {
+	keyring, err := secretbox.NewKeyring(cfg.EncryptionKeys, cfg.PrimaryKeyID)
+	if err != nil {
+		panic(err)
+	}
+
+	return keyring
+}
+
+func ProvideTOTPConfig(cfg *config.EnvConfig) *config.TOTPConfig {
+	return &cfg.TOTP
+}
+
+func ProvideTOTPUsecase(
+	q *db.Queries,
+	pool *pgxpool.Pool,
+	cipher port.TOTPSecretCipher,
+	memberRepo port.GroupMemberRepository,
+	cfg *config.TOTPConfig,
+) *usecase.TOTPUsecase {
+	return usecase.NewTOTPUsecase(q, pool, cipher, memberRepo, usecase.TOTPOptions{
+		Issuer:                 cfg.Issuer,
+		RequiredForSystemRoles: cfg.RequiredForSystemRoles,
+	})
+}
+
+// ProvideNotificationBus relays events between controller instances over
+// Postgres when clustering is enabled, and keeps them in process otherwise.
+func ProvideNotificationBus(
+	clusterCfg *config.ClusterConfig,
+	repo port.NotificationRepository,
+	pgBus *cluster.PostgresBus,
+) *notification.PersistentBus {
+	if clusterCfg.Enabled {
+		return notification.NewPersistentBus(repo, pgBus)
+	}
+
+	return notification.NewPersistentBus(repo, notification.NewBus())
+}
+
+// ProvideSessionStateCache replicates the cache to the other controller
+// instances when clustering is enabled.
+func ProvideSessionStateCache(
+	clusterCfg *config.ClusterConfig,
+	local *sessionstate.MemoryCache,
+	replicated *cluster.ReplicatedSessionStateCache,
+) port.SessionStateCache {
+	if clusterCfg.Enabled {
+		return replicated
+	}
+
+	return local
+}
+
+// ProvideStreamOwnership splits the per-host and per-node event streams
+// between the live controller instances when clustering is enabled.
+func ProvideStreamOwnership(clusterCfg *config.ClusterConfig, membership *cluster.Membership) port.StreamOwnership {
+	if clusterCfg.Enabled {
+		return membership
+	}
+
+	return port.SingleInstanceOwnership{}
+}
+
+// ProvideHostDrainer also consults the shared drain registry when
+// clustering is enabled, because the orchestrator only drains hosts on
+// the leader.
+func ProvideHostDrainer(
+	clusterCfg *config.ClusterConfig,
+	orchestrator *worker.HostUpgradeOrchestrator,
+	registry *cluster.DrainRegistry,
+) port.HostDrainer {
+	if clusterCfg.Enabled {
+		return port.HostDrainers{orchestrator, registry}
+	}
+
+	return orchestrator
+}
+
+// ProvideContainerLogArchive keeps archived host logs in a bucket of their
+// own, because objects in the downloads bucket expire. Like the database
+// pool it panics on a bad config; minio only rejects a malformed endpoint.
+func ProvideContainerLogArchive(q *db.Queries, cfg *config.RustFSConfig) *adapter.ContainerLogArchive {
+	blob, err := blobstore.NewMinioClientForBucket(cfg, cfg.ContainerLogArchivesBucket, 0)
+	if err != nil {
+		panic(err)
+	}
+
+	return adapter.NewContainerLogArchive(q, blob)
+}
+
+// ProvideHostConnectors registers every connector hosts may be placed on.
+// Docker is always present so hosts created before switching HOST_CONNECTOR
+// stay manageable; Kubernetes only when it is enabled.
+func ProvideHostConnectors(
+	dc *hostconnector.DockerHostConnector,
+	kc *hostconnector.KubernetesHostConnector,
+	k8sCfg *config.KubernetesConfig,
+) hostconnector.Connectors {
+	connectors := hostconnector.Connectors{port.HostConnectorType_DOCKER: dc}
+	if k8sCfg.Enabled {
+		connectors[port.HostConnectorType_KUBERNETES] = kc
+	}
+
+	return connectors
+}
+
 // ProvideWorkerManager groups the concrete background workers AND
-// performs two post-construction links that wire itself cannot express:
+// performs the post-construction links that wire itself cannot express:
 //   - The orchestrator needs a SessionStopper (SessionUsecase), but
 //     SessionUsecase needs a HostDrainer (the orchestrator). Wire can
 //     pick only one direction at construction time; we close the cycle
 //     by setting the stopper here, after both ends exist.
 //   - The orchestrator subscribes to ImageChecker so registry polling
 //     happens in exactly one place.
+//   - With clustering the orchestrator shares its drain set through the
+//     drain registry.
+//
+// Workers that poll without claiming rows, or that must see every event
+// once (webhook dispatch), are singletons: with clustering only the leader
+// runs them. Workers that claim their rows with SKIP LOCKED, that follow
+// the hosts owned by this instance, or that serve this instance's own
+// clients (the log feed) run everywhere.
 func ProvideWorkerManager(
 	imageChecker *worker.ImageChecker,
 	dockerEventWatcher *worker.DockerEventWatcher,
@@ -162,19 +399,57 @@ func ProvideWorkerManager(
 	upgradeOrchestrator *worker.HostUpgradeOrchestrator,
 	scheduledOpExecutor *worker.ScheduledOperationExecutor,
 	asyncJobExecutor *worker.AsyncJobExecutor,
+	podWatcher *worker.KubernetesPodWatcher,
+	crashRecoverer *worker.HostCrashRecoverer,
+	sessionRestorer *worker.SessionRestorer,
+	webhookDispatcher *worker.WebhookDispatcher,
+	webhookDeliverer *worker.WebhookDeliverer,
+	notificationPruner *worker.NotificationHistoryPruner,
+	logArchiver *worker.ContainerLogArchiver,
+	metricsSampler *worker.MetricsSampler,
+	logFeed *adapter.ContainerLogFeed,
+	k8sCfg *config.KubernetesConfig,
 	sessionStopper port.SessionStopper,
+	clusterCfg *config.ClusterConfig,
+	pubsub *cluster.PubSub,
+	membership *cluster.Membership,
+	drainRegistry *cluster.DrainRegistry,
+	elector *cluster.AdvisoryLockElector,
 ) *worker.Manager {
 	upgradeOrchestrator.SetSessionStopper(sessionStopper)
 	imageChecker.Subscribe(upgradeOrchestrator.OnNewImage)
 
-	return worker.NewManager([]worker.Runner{
-		imageChecker,
+	runners := []worker.Runner{
 		dockerEventWatcher,
 		hostEventWatcher,
-		upgradeOrchestrator,
 		scheduledOpExecutor,
 		asyncJobExecutor,
-	})
+		crashRecoverer,
+		webhookDeliverer,
+		logFeed,
+	}
+	singletons := []worker.Runner{
+		imageChecker,
+		upgradeOrchestrator,
+		crashRecoverer.Resumer(),
+		sessionRestorer,
+		webhookDispatcher,
+		notificationPruner,
+		logArchiver,
+		metricsSampler,
+	}
+	if k8sCfg.Enabled {
+		singletons = append(singletons, podWatcher)
+	}
+
+	if !clusterCfg.Enabled {
+		return worker.NewLeaderElectedManager(runners, singletons, nil)
+	}
+
+	upgradeOrchestrator.SetDrainStore(drainRegistry)
+	runners = append(runners, pubsub, membership, drainRegistry)
+
+	return worker.NewLeaderElectedManager(runners, singletons, elector)
 }
 
 // ProvideScheduledOperationExecutor は scheduled session operation worker を
@@ -183,11 +458,12 @@ func ProvideWorkerManager(
 func ProvideScheduledOperationExecutor(
 	repo port.ScheduledSessionOperationRepository,
 	suc *usecase.SessionUsecase,
+	stuc *usecase.SessionTemplateUsecase,
 	srepo port.SessionRepository,
 	stateCache port.SessionStateCache,
 	userChecker worker.UserExistenceChecker,
 ) *worker.ScheduledOperationExecutor {
-	return worker.NewScheduledOperationExecutor(repo, suc, srepo, stateCache, userChecker, worker.ScheduledOperationExecutorOptions{})
+	return worker.NewScheduledOperationExecutor(repo, suc, stuc, srepo, stateCache, userChecker, worker.ScheduledOperationExecutorOptions{})
 }
 
 // ProvideAsyncJobDispatcher はホスト/セッションの非同期 job を実行する dispatcher を
@@ -201,6 +477,44 @@ func ProvideAsyncJobDispatcher(
 	return async_job.NewDispatcher(hhuc, suc, hauc)
 }
 
+// ProvideHostCrashRecoverer はクラッシュしたホストを自動再起動する worker を構築する.
+// 再起動は HeadlessHostUsecase をそのまま HostRestarter として渡し、ユーザー操作の
+// 再起動と同じ経路 (ワールド復元込み) を通す.
+func ProvideHostCrashRecoverer(
+	q *db.Queries,
+	hhuc *usecase.HeadlessHostUsecase,
+	bus notification.Bus,
+	cfg *config.WorkerConfig,
+) *worker.HostCrashRecoverer {
+	return worker.NewHostCrashRecoverer(q, hhuc, bus, cfg)
+}
+
+// ProvideSessionRestorer はホストのクラッシュ後にセッションを起動し直す worker を構築する.
+// 起動は SessionUsecase をそのまま SessionStarter として渡し、ユーザー操作の
+// セッション開始と同じ経路 (ポート割り当て / state cache 更新込み) を通す.
+func ProvideSessionRestorer(
+	srepo port.SessionRepository,
+	suc *usecase.SessionUsecase,
+	hostDrainer port.HostDrainer,
+	bus notification.Bus,
+	cfg *config.WorkerConfig,
+) *worker.SessionRestorer {
+	return worker.NewSessionRestorer(srepo, suc, hostDrainer, bus, cfg)
+}
+
+// ProvideHostTerminationObserver はホストの予期しない停止を受け取る observer をまとめる.
+// HeadlessHostRestart は RUNNING のセッションを終了扱いにするので、復元対象の
+// セッションを退避する SessionRestorer を自動再起動より先に呼ぶ.
+// WebhookDispatcher は停止時点で RUNNING だったセッションをクラッシュとして通知するので、
+// セッションの状態を変える SessionRestorer よりさらに先に呼ぶ.
+func ProvideHostTerminationObserver(
+	webhookDispatcher *worker.WebhookDispatcher,
+	sessionRestorer *worker.SessionRestorer,
+	crashRecoverer *worker.HostCrashRecoverer,
+) worker.HostTerminationObserver {
+	return worker.HostTerminationObservers{webhookDispatcher, sessionRestorer, crashRecoverer}
+}
+
 // ProvideAsyncJobExecutor は AsyncJobExecutor worker を構築する.
 func ProvideAsyncJobExecutor(
 	repo port.AsyncJobRepository,
@@ -213,8 +527,9 @@ func ProvideAsyncJobExecutor(
 
 // ProvideHostEventHandlers gathers consumers for the per-host event
 // streams. Order matters:
-//   - DB-mutating handlers (state sync, lifecycle, upgrade orchestrator) run
-//     first so the DB reflects the new state.
+//   - DB-mutating handlers (state sync, lifecycle, user event recorder,
+//     upgrade orchestrator) run first so the DB reflects the new state. The
+//     recorder follows lifecycle because its rows reference the session.
 //   - NotificationDispatcher runs after those so frontend clients that
 //     re-fetch on receipt of the notification get the post-mutation rows.
 //   - LoggingHostEventHandler runs last so log lines reflect what all the
@@ -222,11 +537,12 @@ func ProvideAsyncJobExecutor(
 func ProvideHostEventHandlers(
 	sessionStateSyncHandler *worker.SessionStateSyncHandler,
 	sessionLifecycleHandler *worker.SessionLifecycleHandler,
+	sessionUserEventRecorder *worker.SessionUserEventRecorder,
 	upgradeOrchestrator *worker.HostUpgradeOrchestrator,
 	notificationDispatcher *worker.NotificationDispatcher,
 	loggingHandler *worker.LoggingHostEventHandler,
 ) []worker.HostEventHandler {
-	return []worker.HostEventHandler{sessionStateSyncHandler, sessionLifecycleHandler, upgradeOrchestrator, notificationDispatcher, loggingHandler}
+	return []worker.HostEventHandler{sessionStateSyncHandler, sessionLifecycleHandler, sessionUserEventRecorder, upgradeOrchestrator, notificationDispatcher, loggingHandler}
 }
 
 // ProvideHeadlessAccountFetcher exposes HeadlessAccountUsecase under the
@@ -244,4 +560,10 @@ var ConfigSet = wire.NewSet(
 	ProvideServerConfig,
 	ProvideRustFSConfig,
 	ProvideResoniteLinkConfig,
+	ProvideKubernetesConfig,
+	ProvideHostConnectorConfig,
+	ProvideClusterConfig,
+	ProvideCredentialConfig,
+	ProvideOIDCConfig,
+	ProvideTOTPConfig,
 )
@@ -0,0 +1,44 @@
+package commands
+
+import (
+	"github.com/hantabaru1014/baru-reso-headless-controller/usecase"
+	"github.com/spf13/cobra"
+)
+
+// NewAccountsCommand は `brhcli accounts reencrypt` を提供する.
+// 同じ鍵で暗号化しているユーザーの TOTP secret もまとめて掛け直す.
+func NewAccountsCommand(hau *usecase.HeadlessAccountUsecase, tu *usecase.TOTPUsecase) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "accounts",
+		Short: "Manage headless accounts",
+	}
+
+	reencryptCmd := &cobra.Command{
+		Use:   "reencrypt",
+		Short: "Encrypt plaintext account credentials and move them to the primary encryption key",
+		Long: "Encrypts the credentials stored before encryption was introduced and re-wraps the ones\n" +
+			"encrypted with a key other than CREDENTIAL_ENCRYPTION_KEY_ID. Run it after adding a new\n" +
+			"key; the old key can be removed from CREDENTIAL_ENCRYPTION_KEYS once it has finished.\n" +
+			"The TOTP secrets of users are encrypted with the same keys and are re-wrapped as well.",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			result, err := hau.ReencryptCredentials(cmd.Context())
+			if result != nil {
+				cmd.Printf("encrypted: %d, rewrapped: %d, skipped (updated concurrently): %d\n",
+					result.Encrypted, result.Rewrapped, result.Skipped)
+			}
+
+			if err != nil {
+				return err
+			}
+
+			rewrapped, skipped, err := tu.ReencryptSecrets(cmd.Context())
+			cmd.Printf("totp secrets rewrapped: %d, skipped (updated concurrently): %d\n", rewrapped, skipped)
+
+			return err
+		},
+	}
+
+	cmd.AddCommand(reencryptCmd)
+
+	return cmd
+}
@@ -0,0 +1,162 @@
+package commands
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/hantabaru1014/baru-reso-headless-controller/usecase/desired_state"
+	"github.com/spf13/cobra"
+)
+
+// NewApplyCommand は `brhcli apply` を提供する.
+// 構成ファイルと現在の状態の差分 (plan) を表示し、確認のうえで反映する.
+func NewApplyCommand(rec *desired_state.Reconciler) *cobra.Command {
+	var (
+		file   string
+		prune  bool
+		dryRun bool
+		yes    bool
+	)
+
+	c := &cobra.Command{
+		Use:   "apply",
+		Short: "Reconcile hosts, groups and scheduled starts with a YAML config file",
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			ctx := cmd.Context()
+
+			spec, err := loadSpecFile(cmd, file)
+			if err != nil {
+				return err
+			}
+
+			plan, err := rec.Plan(ctx, spec, desired_state.PlanOptions{Prune: prune})
+			if err != nil {
+				return err
+			}
+
+			plan.Write(cmd.OutOrStdout())
+
+			if plan.IsEmpty() {
+				cmd.Println("No changes. The current state matches the config.")
+				return nil
+			}
+
+			if dryRun {
+				cmd.Printf("Dry run: %d change(s) not applied\n", len(plan.Changes))
+				return nil
+			}
+
+			if !yes {
+				ok, err := confirm(cmd, "Apply these changes? [y/N] ")
+				if err != nil {
+					return err
+				}
+
+				if !ok {
+					cmd.Println("Canceled")
+					return nil
+				}
+			}
+
+			applied := 0
+
+			err = rec.Apply(ctx, plan, func(c *desired_state.Change) {
+				applied++
+
+				cmd.Printf("done: %s %s\n", c.Kind, c.Target)
+			})
+			if err != nil {
+				return fmt.Errorf("applied %d of %d change(s): %w", applied, len(plan.Changes), err)
+			}
+
+			cmd.Printf("Applied %d change(s)\n", applied)
+
+			return nil
+		},
+	}
+	c.Flags().StringVarP(&file, "file", "f", "", "config file to apply (\"-\" for stdin)")
+	c.Flags().BoolVar(&prune, "prune", false, "remove members, stop sessions and cancel scheduled starts that are not in the config")
+	c.Flags().BoolVar(&dryRun, "dry-run", false, "only print the plan")
+	c.Flags().BoolVarP(&yes, "yes", "y", false, "apply without confirmation")
+	_ = c.MarkFlagRequired("file")
+
+	return c
+}
+
+// NewExportCommand は `brhcli export` を提供する. 出力はそのまま apply に渡せる.
+func NewExportCommand(rec *desired_state.Reconciler) *cobra.Command {
+	var output string
+
+	c := &cobra.Command{
+		Use:   "export",
+		Short: "Dump the current hosts, groups and scheduled starts as a YAML config file",
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			spec, err := rec.Export(cmd.Context())
+			if err != nil {
+				return err
+			}
+
+			w := cmd.OutOrStdout()
+
+			if output != "" {
+				f, err := os.Create(output)
+				if err != nil {
+					return err
+				}
+				defer f.Close() //nolint:errcheck // 書き込みエラーは WriteSpec で返す
+
+				w = f
+			}
+
+			if err := desired_state.WriteSpec(w, spec); err != nil {
+				return err
+			}
+
+			if output != "" {
+				cmd.PrintErrf("Exported %d host(s) and %d group(s) to %s\n", len(spec.Hosts), len(spec.Groups), output)
+			}
+
+			return nil
+		},
+	}
+	c.Flags().StringVarP(&output, "output", "o", "", "write to this file instead of stdout")
+
+	return c
+}
+
+func loadSpecFile(cmd *cobra.Command, path string) (*desired_state.Spec, error) {
+	var r io.Reader = cmd.InOrStdin()
+
+	if path != "-" {
+		f, err := os.Open(path)
+		if err != nil {
+			return nil, err
+		}
+		defer f.Close() //nolint:errcheck // 読み込み専用
+
+		r = f
+	}
+
+	spec, err := desired_state.LoadSpec(r)
+	if err != nil {
+		return nil, fmt.Errorf("invalid config %s: %w", path, err)
+	}
+
+	return spec, nil
+}
+
+func confirm(cmd *cobra.Command, prompt string) (bool, error) {
+	cmd.Print(prompt)
+
+	line, err := bufio.NewReader(cmd.InOrStdin()).ReadString('\n')
+	if err != nil && err != io.EOF {
+		return false, err
+	}
+
+	answer := strings.ToLower(strings.TrimSpace(line))
+
+	return answer == "y" || answer == "yes", nil
+}
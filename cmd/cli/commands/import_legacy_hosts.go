@@ -125,6 +125,7 @@ func NewImportLegacyHostsCommand(q *db.Queries, skyfrostClient skyfrost.Client)
 						Memo:                           pgtype.Text{String: "", Valid: true},
 						GroupID:                        entity.MigratedPrePermissionGroupID,
 						CreatedBy:                      pgtype.Text{String: systemUserID, Valid: true},
+						ContainerSettings:              []byte("{}"),
 					}
 					if startupConfig != "" {
 						createParams.LastStartupConfig = []byte(startupConfig)
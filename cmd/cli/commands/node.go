@@ -0,0 +1,130 @@
+package commands
+
+import (
+	"github.com/hantabaru1014/baru-reso-headless-controller/domain/entity"
+	"github.com/hantabaru1014/baru-reso-headless-controller/usecase/port"
+	"github.com/spf13/cobra"
+)
+
+// NewNodeCommand は `brhcli node list|add|remove|enable|disable` を提供する.
+// ホストを配置できるリモート Docker node を docker_nodes テーブルに登録する.
+func NewNodeCommand(nodeRepo port.DockerNodeRepository) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "node",
+		Short: "Manage remote docker nodes",
+	}
+
+	listCmd := &cobra.Command{
+		Use:   "list",
+		Short: "List registered docker nodes",
+		Run: func(cmd *cobra.Command, args []string) {
+			ctx := cmd.Context()
+
+			nodes, err := nodeRepo.ListAll(ctx)
+			if err != nil {
+				cmd.PrintErrln("Failed to list nodes:", err)
+
+				return
+			}
+
+			if len(nodes) == 0 {
+				cmd.Println("No nodes found")
+
+				return
+			}
+
+			for _, n := range nodes {
+				cmd.Printf("ID: %s, Name: %s, Endpoint: %s, MaxHosts: %d, Enabled: %t\n",
+					n.ID, n.Name, n.Endpoint, n.MaxHosts, n.Enabled)
+			}
+		},
+	}
+
+	addCmd := &cobra.Command{
+		Use:   "add <name>",
+		Short: "Register a docker node (tcp://host:2376 or ssh://user@host)",
+		Args:  cobra.ExactArgs(1),
+		Run: func(cmd *cobra.Command, args []string) {
+			ctx := cmd.Context()
+			flags := cmd.Flags()
+
+			node := &entity.DockerNode{
+				Name:    args[0],
+				Enabled: true,
+			}
+			node.Endpoint, _ = flags.GetString("endpoint")
+			node.RpcAddress, _ = flags.GetString("rpc-address")
+			node.TLSCACertPath, _ = flags.GetString("tls-ca")
+			node.TLSCertPath, _ = flags.GetString("tls-cert")
+			node.TLSKeyPath, _ = flags.GetString("tls-key")
+			node.FluentdAddress, _ = flags.GetString("fluentd-address")
+			node.MaxHosts, _ = flags.GetInt32("max-hosts")
+
+			created, err := nodeRepo.Create(ctx, node)
+			if err != nil {
+				cmd.PrintErrln("Failed to add node:", err)
+
+				return
+			}
+
+			cmd.Println("node added:", created.ID)
+		},
+	}
+	addCmd.Flags().String("endpoint", "", "Docker daemon endpoint (tcp://... or ssh://...)")
+	addCmd.Flags().String("rpc-address", "", "Address used to reach headless gRPC ports on the node (default: endpoint host)")
+	addCmd.Flags().String("tls-ca", "", "Path to the CA certificate for tcp endpoints")
+	addCmd.Flags().String("tls-cert", "", "Path to the client certificate for tcp endpoints")
+	addCmd.Flags().String("tls-key", "", "Path to the client key for tcp endpoints")
+	addCmd.Flags().String("fluentd-address", "", "Fluentd address reachable from the node (default: CONTAINER_LOGS_FLUENTD_ADDRESS)")
+	addCmd.Flags().Int32("max-hosts", 0, "Maximum number of hosts on the node (0 = unlimited)")
+	_ = addCmd.MarkFlagRequired("endpoint")
+
+	removeCmd := &cobra.Command{
+		Use:   "remove <nodeID>",
+		Short: "Unregister a docker node that has no hosts",
+		Args:  cobra.ExactArgs(1),
+		Run: func(cmd *cobra.Command, args []string) {
+			if err := nodeRepo.Delete(cmd.Context(), args[0]); err != nil {
+				cmd.PrintErrln("Failed to remove node:", err)
+
+				return
+			}
+
+			cmd.Println("node removed:", args[0])
+		},
+	}
+
+	enableCmd := &cobra.Command{
+		Use:   "enable <nodeID>",
+		Short: "Allow new hosts to be placed on a docker node",
+		Args:  cobra.ExactArgs(1),
+		Run: func(cmd *cobra.Command, args []string) {
+			if err := nodeRepo.SetEnabled(cmd.Context(), args[0], true); err != nil {
+				cmd.PrintErrln("Failed to enable node:", err)
+
+				return
+			}
+
+			cmd.Println("node enabled:", args[0])
+		},
+	}
+
+	disableCmd := &cobra.Command{
+		Use:   "disable <nodeID>",
+		Short: "Stop placing new hosts on a docker node (running hosts are kept)",
+		Args:  cobra.ExactArgs(1),
+		Run: func(cmd *cobra.Command, args []string) {
+			if err := nodeRepo.SetEnabled(cmd.Context(), args[0], false); err != nil {
+				cmd.PrintErrln("Failed to disable node:", err)
+
+				return
+			}
+
+			cmd.Println("node disabled:", args[0])
+		},
+	}
+
+	cmd.AddCommand(listCmd, addCmd, removeCmd, enableCmd, disableCmd)
+
+	return cmd
+}
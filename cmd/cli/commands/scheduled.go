@@ -80,7 +80,7 @@ func newScheduledListCmd(sou *usecase.ScheduledSessionOperationUsecase) *cobra.C
 	}
 	c.Flags().StringVar(&sessionID, "session", "", "filter by session_id")
 	c.Flags().StringVar(&hostID, "host", "", "filter by host_id")
-	c.Flags().StringVar(&status, "status", "", "filter by status (pending/running/succeeded/failed/canceled)")
+	c.Flags().StringVar(&status, "status", "", "filter by status (pending/running/succeeded/failed/canceled/paused)")
 
 	return c
 }
@@ -173,6 +173,8 @@ func parseStatus(s string) (entity.ScheduledOperationStatus, error) {
 		return entity.ScheduledOperationStatus_FAILED, nil
 	case "canceled":
 		return entity.ScheduledOperationStatus_CANCELED, nil
+	case "paused":
+		return entity.ScheduledOperationStatus_PAUSED, nil
 	default:
 		return 0, fmt.Errorf("unknown status: %s", s)
 	}
@@ -0,0 +1,158 @@
+package commands
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/hantabaru1014/baru-reso-headless-controller/domain/entity"
+	"github.com/hantabaru1014/baru-reso-headless-controller/lib/auth"
+	"github.com/hantabaru1014/baru-reso-headless-controller/usecase"
+	"github.com/spf13/cobra"
+)
+
+// NewTokenCommand は API トークンの管理 (create / list / revoke) を提供する.
+// CLI は system user で動くため、対象ユーザーを --user で指定してそのユーザーとして操作する.
+func NewTokenCommand(atuc *usecase.ApiTokenUsecase) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "token",
+		Short: "Personal API tokens for scripts and bots (create / list / revoke)",
+	}
+
+	var userID string
+
+	cmd.PersistentFlags().StringVar(&userID, "user", "", "user_id that owns the tokens (required)")
+	_ = cmd.MarkPersistentFlagRequired("user")
+
+	cmd.AddCommand(newTokenCreateCmd(atuc, &userID))
+	cmd.AddCommand(newTokenListCmd(atuc, &userID))
+	cmd.AddCommand(newTokenRevokeCmd(atuc, &userID))
+
+	return cmd
+}
+
+func newTokenCreateCmd(atuc *usecase.ApiTokenUsecase, userID *string) *cobra.Command {
+	var (
+		name           string
+		groupID        string
+		permissionKeys []string
+		expiresIn      time.Duration
+	)
+
+	c := &cobra.Command{
+		Use:   "create",
+		Short: "Create an API token. The token is printed only once",
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			ctx := auth.WithActAsUser(cmd.Context(), *userID)
+
+			if name == "" {
+				return errors.New("--name is required")
+			}
+
+			params := usecase.CreateApiTokenParams{
+				Name:           name,
+				PermissionKeys: permissionKeys,
+			}
+			if groupID != "" {
+				params.GroupID = &groupID
+			}
+
+			if expiresIn > 0 {
+				expiresAt := time.Now().Add(expiresIn)
+				params.ExpiresAt = &expiresAt
+			}
+
+			token, plain, err := atuc.CreateApiToken(ctx, params)
+			if err != nil {
+				return err
+			}
+
+			printApiToken(cmd.OutOrStdout(), token)
+			cmd.Println("Token (shown only once):")
+			cmd.Println(plain)
+
+			return nil
+		},
+	}
+	c.Flags().StringVar(&name, "name", "", "name to identify the token (required)")
+	c.Flags().StringVar(&groupID, "group", "", "restrict the token to this group_id")
+	c.Flags().StringSliceVar(&permissionKeys, "permission", nil, "restrict the token to these permission keys (repeatable)")
+	c.Flags().DurationVar(&expiresIn, "expires-in", 0, "expire the token after this duration (e.g. 720h). default: never")
+
+	return c
+}
+
+func newTokenListCmd(atuc *usecase.ApiTokenUsecase, userID *string) *cobra.Command {
+	return &cobra.Command{
+		Use:   "list",
+		Short: "List API tokens of the user, including revoked ones",
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			tokens, err := atuc.ListApiTokens(auth.WithActAsUser(cmd.Context(), *userID))
+			if err != nil {
+				return err
+			}
+
+			if len(tokens) == 0 {
+				cmd.Println("No API tokens found")
+				return nil
+			}
+
+			for _, t := range tokens {
+				printApiToken(cmd.OutOrStdout(), t)
+			}
+
+			return nil
+		},
+	}
+}
+
+func newTokenRevokeCmd(atuc *usecase.ApiTokenUsecase, userID *string) *cobra.Command {
+	return &cobra.Command{
+		Use:   "revoke <id>",
+		Short: "Revoke an API token",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := atuc.RevokeApiToken(auth.WithActAsUser(cmd.Context(), *userID), args[0]); err != nil {
+				return err
+			}
+
+			cmd.Printf("Revoked API token %s\n", args[0])
+
+			return nil
+		},
+	}
+}
+
+func printApiToken(w io.Writer, t *entity.ApiToken) {
+	row := struct {
+		ID             string   `json:"id"`
+		Name           string   `json:"name"`
+		TokenPrefix    string   `json:"token_prefix"`
+		GroupID        *string  `json:"group_id"`
+		PermissionKeys []string `json:"permission_keys"`
+		ExpiresAt      *string  `json:"expires_at"`
+		LastUsedAt     *string  `json:"last_used_at"`
+		RevokedAt      *string  `json:"revoked_at"`
+		CreatedAt      string   `json:"created_at"`
+	}{
+		ID:             t.ID,
+		Name:           t.Name,
+		TokenPrefix:    t.TokenPrefix,
+		GroupID:        t.GroupID,
+		PermissionKeys: t.PermissionKeys,
+		ExpiresAt:      timePtrOrNil(t.ExpiresAt),
+		LastUsedAt:     timePtrOrNil(t.LastUsedAt),
+		RevokedAt:      timePtrOrNil(t.RevokedAt),
+		CreatedAt:      t.CreatedAt.Format(time.RFC3339),
+	}
+
+	b, err := json.Marshal(row)
+	if err != nil {
+		_, _ = fmt.Fprintf(w, "marshal error: %v\n", err)
+		return
+	}
+
+	_, _ = fmt.Fprintln(w, string(b))
+}
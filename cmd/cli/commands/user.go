@@ -6,7 +6,7 @@ import (
 	"github.com/spf13/cobra"
 )
 
-func NewUserCommand(uu *usecase.UserUsecase, skyfrostClient skyfrost.Client) *cobra.Command {
+func NewUserCommand(uu *usecase.UserUsecase, tu *usecase.TOTPUsecase, usu *usecase.UserSessionUsecase, skyfrostClient skyfrost.Client) *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "user",
 		Short: "User management commands",
@@ -94,7 +94,38 @@ func NewUserCommand(uu *usecase.UserUsecase, skyfrostClient skyfrost.Client) *co
 		},
 	}
 
-	cmd.AddCommand(inviteCmd, createUserCmd, deleteUserCmd)
+	resetTOTPCmd := &cobra.Command{
+		Use:   "reset-totp <id>",
+		Short: "Remove the two-factor authentication of a user who lost their device and recovery codes",
+		Args:  cobra.ExactArgs(1),
+		Run: func(cmd *cobra.Command, args []string) {
+			if err := tu.Reset(cmd.Context(), args[0]); err != nil {
+				cmd.PrintErrln(err)
+
+				return
+			}
+
+			cmd.Println("Two-factor authentication has been reset. The user can sign in with the password and enroll again.")
+		},
+	}
+
+	revokeSessionsCmd := &cobra.Command{
+		Use:   "revoke-sessions <id>",
+		Short: "Sign a user out everywhere by revoking all of their login sessions",
+		Args:  cobra.ExactArgs(1),
+		Run: func(cmd *cobra.Command, args []string) {
+			n, err := usu.RevokeAllSessions(cmd.Context(), args[0])
+			if err != nil {
+				cmd.PrintErrln(err)
+
+				return
+			}
+
+			cmd.Printf("Revoked %d session(s)\n", n)
+		},
+	}
+
+	cmd.AddCommand(inviteCmd, createUserCmd, deleteUserCmd, resetTOTPCmd, revokeSessionsCmd)
 
 	return cmd
 }
@@ -1,9 +1,12 @@
 package config
 
 import (
+	"encoding/base64"
 	"errors"
 	"fmt"
+	"net/netip"
 	"os"
+	"slices"
 	"strconv"
 	"strings"
 	"time"
@@ -12,12 +15,18 @@ import (
 type EnvConfig struct {
 	Database     DatabaseConfig
 	Auth         AuthConfig
+	OIDC         OIDCConfig
+	TOTP         TOTPConfig
+	Credential   CredentialConfig
 	Docker       DockerConfig
+	Kubernetes   KubernetesConfig
+	Connector    HostConnectorConfig
 	GRPC         GRPCConfig
 	Worker       WorkerConfig
 	Server       ServerConfig
 	RustFS       RustFSConfig
 	ResoniteLink ResoniteLinkConfig
+	Cluster      ClusterConfig
 }
 
 type DatabaseConfig struct {
@@ -28,11 +37,109 @@ type AuthConfig struct {
 	JWTSecret string
 }
 
+// OIDCConfig configures single sign-on through an OpenID Connect provider.
+// SSO is disabled while IssuerURL is empty.
+type OIDCConfig struct {
+	IssuerURL string
+	ClientID  string
+	// ClientSecret may be empty for public clients, which rely on PKCE alone.
+	ClientSecret string
+	// RedirectURL is the frontend callback page (<origin>/sign-in/oidc) that
+	// has to be registered with the provider.
+	RedirectURL string
+	Scopes      []string
+	// ProviderName is shown on the sign-in button.
+	ProviderName string
+	// UserIDClaim names the ID token claim used as users.id when a user is
+	// provisioned for an unknown identity. With "email" the provider has to
+	// mark the address as verified (email_verified=true).
+	UserIDClaim string
+	// AutoProvision creates a user for an unknown identity. Without it only
+	// identities linked from user settings can sign in.
+	AutoProvision bool
+	// PersonalRoleID is the role provisioned users get in their personal
+	// group. Empty means the same default as `brhcli user create`.
+	PersonalRoleID string
+	// ProvisionGroupID, if set, is a group provisioned users join with
+	// ProvisionRoleID.
+	ProvisionGroupID string
+	ProvisionRoleID  string
+}
+
+// Enabled reports whether OIDC sign-in is configured.
+func (c OIDCConfig) Enabled() bool {
+	return c.IssuerURL != ""
+}
+
+// TOTPConfig configures two-factor authentication with authenticator apps.
+type TOTPConfig struct {
+	// Issuer is the account label shown in authenticator apps.
+	Issuer string
+	// RequiredForSystemRoles makes members of the system group enroll TOTP
+	// before they can sign in with a password.
+	RequiredForSystemRoles bool
+}
+
+// CredentialConfig holds the keys that encrypt the headless account
+// credentials stored in the database.
+type CredentialConfig struct {
+	// EncryptionKeys maps a key ID to a 32 byte key. Keep a retired key
+	// until `brhcli accounts reencrypt` has moved every account off it.
+	EncryptionKeys map[string][]byte
+	// PrimaryKeyID is the key new credentials are encrypted with.
+	PrimaryKeyID string
+}
+
 type DockerConfig struct {
 	HeadlessImageName    string
 	FluentdAddress       string
 	GHCRAuthToken        string
 	HeadlessRegistryAuth string
+	// LocalNodeEnabled controls whether the controller's own Docker daemon
+	// is part of the node pool. Disable it when hosts should only run on
+	// the remote nodes registered with `brhcli node add`.
+	LocalNodeEnabled bool
+	// NodeRpcPortMin / NodeRpcPortMax bound the gRPC ports allocated to
+	// headless containers on remote nodes.
+	NodeRpcPortMin int
+	NodeRpcPortMax int
+}
+
+// HostConnectorConfig selects where new headless hosts are started.
+type HostConnectorConfig struct {
+	// Default is the connector type ("docker" or "kubernetes") used for
+	// newly started hosts. Existing hosts keep the connector they were
+	// created with, so switching it does not orphan running hosts.
+	Default string
+	// AllowedBindMountSources lists the host directories that per-host bind
+	// mounts may use (the source must be one of them or below). Empty
+	// disables bind mounts, since anyone with host:write could otherwise
+	// mount arbitrary paths of the machine running the container.
+	AllowedBindMountSources []string
+}
+
+type KubernetesConfig struct {
+	// Enabled turns on KubernetesHostConnector and its pod watcher. It is
+	// implied by HOST_CONNECTOR=kubernetes but can also be set on its own
+	// while hosts created on Kubernetes are still around.
+	Enabled bool
+	// Kubeconfig is the path of a kubeconfig file. Empty means in-cluster
+	// configuration (the controller runs as a pod with a service account).
+	Kubeconfig string
+	Namespace  string
+	// NetworkMode is "host" (pods use hostNetwork and an allocated host
+	// port, dialled via the node IP) or "service" (pods listen on RpcPort
+	// and get a ClusterIP Service; requires the controller to run in-cluster).
+	NetworkMode string
+	// RpcPort is the container port used in service mode.
+	RpcPort int
+	// HostRpcPortMin / HostRpcPortMax bound the ports allocated in host mode.
+	HostRpcPortMin int
+	HostRpcPortMax int
+	// ImagePullSecret is the name of a docker-registry secret in Namespace.
+	ImagePullSecret string
+	// NodeSelector restricts headless pods to matching nodes.
+	NodeSelector map[string]string
 }
 
 type GRPCConfig struct {
@@ -53,6 +160,85 @@ type WorkerConfig struct {
 	// polls for newly available container image tags and reconciles its
 	// drain set.
 	UpgradeCheckInterval time.Duration
+	// DockerNodePollInterval controls how often DockerEventWatcher picks up
+	// docker nodes that were added or removed while it is running.
+	DockerNodePollInterval time.Duration
+	// AutoRestartBaseDelay and AutoRestartMaxDelay bound the exponential
+	// backoff HostCrashRecoverer waits before restarting a crashed host.
+	AutoRestartBaseDelay time.Duration
+	AutoRestartMaxDelay  time.Duration
+	// AutoRestartStableAfter is how long a host has to stay up after an
+	// automatic restart before its next crash starts a fresh retry count.
+	AutoRestartStableAfter time.Duration
+	// AutoRestartCrashLoopThreshold is the number of consecutive automatic
+	// restarts after which a host is considered crash looping and left
+	// down. Hosts with their own max retries use that instead.
+	AutoRestartCrashLoopThreshold int
+	// SessionRestorePollInterval controls how often SessionRestorer looks
+	// for restore-on-crash sessions whose host is running again.
+	SessionRestorePollInterval time.Duration
+	// SessionRestoreMaxAttempts is how many times SessionRestorer tries to
+	// start a session again before marking it ENDED.
+	SessionRestoreMaxAttempts int
+	// WebhookPollInterval controls how often WebhookDeliverer looks for
+	// queued webhook deliveries that are due.
+	WebhookPollInterval time.Duration
+	// WebhookTimeout bounds a single webhook HTTP request.
+	WebhookTimeout time.Duration
+	// WebhookRetryBaseDelay and WebhookRetryMaxDelay bound the exponential
+	// backoff between attempts of a failed delivery.
+	WebhookRetryBaseDelay time.Duration
+	WebhookRetryMaxDelay  time.Duration
+	// WebhookMaxAttempts is how many times a delivery is tried before it
+	// is given up.
+	WebhookMaxAttempts int
+	// WebhookDeliveryRetention is how long finished deliveries are kept
+	// as history.
+	WebhookDeliveryRetention time.Duration
+	// WebhookAllowedNetworks lists the loopback / private / link-local
+	// networks webhooks may still be sent to. Everything else in those
+	// ranges is refused, since webhook URLs are set by group members.
+	WebhookAllowedNetworks []netip.Prefix
+	// NotificationHistoryRetention and NotificationHistoryMaxEvents bound
+	// the notification history kept for reconnect replay and the inbox.
+	// Whichever limit is hit first wins.
+	NotificationHistoryRetention time.Duration
+	NotificationHistoryMaxEvents int
+	// ContainerLogRetentionDays is how many days the logs of a past host
+	// instance stay in container_logs before they are archived to the blob
+	// store. Groups can override it. Zero keeps them forever.
+	ContainerLogRetentionDays int
+	// ContainerLogArchiveInterval is how often instances past their
+	// retention are looked for.
+	ContainerLogArchiveInterval time.Duration
+	// MetricsSampleInterval is how often MetricsSampler records the host
+	// and session metrics. It has to be shorter than a minute so every
+	// 1m bucket gets at least one sample.
+	MetricsSampleInterval time.Duration
+	// MetricsRawRetention, MetricsMinuteRetention and MetricsHourRetention
+	// are how long the raw samples and the 1m / 1h rollups are kept. Zero
+	// keeps them forever.
+	MetricsRawRetention    time.Duration
+	MetricsMinuteRetention time.Duration
+	MetricsHourRetention   time.Duration
+}
+
+// ClusterConfig controls running several controller instances against the
+// same database.
+type ClusterConfig struct {
+	// Enabled switches the notification bus to Postgres LISTEN/NOTIFY,
+	// elects a leader for the singleton workers and splits the per-host
+	// event streams between the live instances.
+	Enabled bool
+	// InstanceID identifies this process among the instances. It must be
+	// unique per running process; the default is the hostname.
+	InstanceID string
+	// HeartbeatInterval is how often the instance refreshes its liveness
+	// and the set of live instances.
+	HeartbeatInterval time.Duration
+	// InstanceTTL is how long an instance counts as live after its last
+	// heartbeat. Its event streams are taken over once this has passed.
+	InstanceTTL time.Duration
 }
 
 type ServerConfig struct {
@@ -62,6 +248,8 @@ type ServerConfig struct {
 	ShutdownTimeout time.Duration
 	SessionPortMin  int
 	SessionPortMax  int
+	// MetricsToken, if set, is required as a bearer token on /metrics.
+	MetricsToken string
 }
 
 // ResoniteLinkConfig は ResoniteLink WebSocket ブリッジ用の設定.
@@ -84,6 +272,9 @@ type RustFSConfig struct {
 	UseSSL               bool
 	WorldDownloadsBucket string
 	BlobTTLDays          int
+	// ContainerLogArchivesBucket holds archived host logs. Unlike the
+	// downloads bucket its objects never expire.
+	ContainerLogArchivesBucket string
 }
 
 func LoadEnvConfig() (*EnvConfig, error) {
@@ -93,10 +284,49 @@ func LoadEnvConfig() (*EnvConfig, error) {
 
 	cfg.Auth.JWTSecret = os.Getenv("JWT_SECRET")
 
+	cfg.OIDC.IssuerURL = os.Getenv("OIDC_ISSUER_URL")
+	cfg.OIDC.ClientID = os.Getenv("OIDC_CLIENT_ID")
+	cfg.OIDC.ClientSecret = os.Getenv("OIDC_CLIENT_SECRET")
+	cfg.OIDC.RedirectURL = os.Getenv("OIDC_REDIRECT_URL")
+	cfg.OIDC.Scopes = parseCSV(getEnvWithDefault("OIDC_SCOPES", "openid,email,profile"))
+	cfg.OIDC.ProviderName = getEnvWithDefault("OIDC_PROVIDER_NAME", "SSO")
+	cfg.OIDC.UserIDClaim = getEnvWithDefault("OIDC_USER_ID_CLAIM", "email")
+	cfg.OIDC.AutoProvision = os.Getenv("OIDC_AUTO_PROVISION") == "true"
+	cfg.OIDC.PersonalRoleID = os.Getenv("OIDC_PERSONAL_ROLE_ID")
+	cfg.OIDC.ProvisionGroupID = os.Getenv("OIDC_PROVISION_GROUP_ID")
+	cfg.OIDC.ProvisionRoleID = getEnvWithDefault("OIDC_PROVISION_ROLE_ID", "seed-user")
+
+	cfg.TOTP.Issuer = getEnvWithDefault("TOTP_ISSUER", "brhc")
+	cfg.TOTP.RequiredForSystemRoles = os.Getenv("TOTP_REQUIRED_FOR_SYSTEM_ROLES") == "true"
+
+	keys, firstKeyID, err := parseEncryptionKeys(os.Getenv("CREDENTIAL_ENCRYPTION_KEYS"))
+	if err != nil {
+		return nil, err
+	}
+
+	cfg.Credential.EncryptionKeys = keys
+	cfg.Credential.PrimaryKeyID = getEnvWithDefault("CREDENTIAL_ENCRYPTION_KEY_ID", firstKeyID)
+
 	cfg.Docker.HeadlessImageName = os.Getenv("HEADLESS_IMAGE_NAME")
 	cfg.Docker.FluentdAddress = os.Getenv("CONTAINER_LOGS_FLUENTD_ADDRESS")
 	cfg.Docker.GHCRAuthToken = os.Getenv("GHCR_AUTH_TOKEN")
 	cfg.Docker.HeadlessRegistryAuth = os.Getenv("HEADLESS_REGISTRY_AUTH")
+	cfg.Docker.LocalNodeEnabled = os.Getenv("DOCKER_LOCAL_NODE_ENABLED") != "false"
+	cfg.Docker.NodeRpcPortMin = getEnvInt("DOCKER_NODE_RPC_PORT_MIN", 30000) //nolint:mnd // default
+	cfg.Docker.NodeRpcPortMax = getEnvInt("DOCKER_NODE_RPC_PORT_MAX", 30999) //nolint:mnd // default
+
+	cfg.Connector.Default = getEnvWithDefault("HOST_CONNECTOR", "docker")
+	cfg.Connector.AllowedBindMountSources = parseCSV(os.Getenv("HOST_BIND_MOUNT_ALLOWED_SOURCES"))
+
+	cfg.Kubernetes.Enabled = os.Getenv("KUBERNETES_ENABLED") == "true" || cfg.Connector.Default == "kubernetes"
+	cfg.Kubernetes.Kubeconfig = os.Getenv("KUBECONFIG")
+	cfg.Kubernetes.Namespace = getEnvWithDefault("KUBERNETES_NAMESPACE", "default")
+	cfg.Kubernetes.NetworkMode = getEnvWithDefault("KUBERNETES_NETWORK_MODE", "host")
+	cfg.Kubernetes.RpcPort = getEnvInt("KUBERNETES_RPC_PORT", 5000)                   //nolint:mnd // default
+	cfg.Kubernetes.HostRpcPortMin = getEnvInt("KUBERNETES_HOST_RPC_PORT_MIN", 30000) //nolint:mnd // default
+	cfg.Kubernetes.HostRpcPortMax = getEnvInt("KUBERNETES_HOST_RPC_PORT_MAX", 30999) //nolint:mnd // default
+	cfg.Kubernetes.ImagePullSecret = os.Getenv("KUBERNETES_IMAGE_PULL_SECRET")
+	cfg.Kubernetes.NodeSelector = parseKeyValues(os.Getenv("KUBERNETES_NODE_SELECTOR"))
 
 	cfg.GRPC.ConnectTimeout = getEnvDuration("GRPC_CONNECT_TIMEOUT", 5*time.Second)   //nolint:mnd // default
 	cfg.GRPC.CallTimeout = getEnvDuration("GRPC_CALL_TIMEOUT", 10*time.Second)        //nolint:mnd // default
@@ -107,11 +337,45 @@ func LoadEnvConfig() (*EnvConfig, error) {
 	cfg.Worker.EventMaxReconnectWait = getEnvDuration("EVENT_WATCHER_MAX_RECONNECT_WAIT", 5*time.Minute) //nolint:mnd // default
 	cfg.Worker.HostEventPollInterval = getEnvDuration("HOST_EVENT_POLL_INTERVAL", 10*time.Second)        //nolint:mnd // default
 	cfg.Worker.UpgradeCheckInterval = getEnvDuration("UPGRADE_CHECK_INTERVAL", time.Minute)
+	cfg.Worker.DockerNodePollInterval = getEnvDuration("DOCKER_NODE_POLL_INTERVAL", time.Minute)
+	cfg.Worker.AutoRestartBaseDelay = getEnvDuration("HOST_AUTO_RESTART_BASE_DELAY", 10*time.Second)     //nolint:mnd // default
+	cfg.Worker.AutoRestartMaxDelay = getEnvDuration("HOST_AUTO_RESTART_MAX_DELAY", 5*time.Minute)        //nolint:mnd // default
+	cfg.Worker.AutoRestartStableAfter = getEnvDuration("HOST_AUTO_RESTART_STABLE_AFTER", 10*time.Minute) //nolint:mnd // default
+	cfg.Worker.AutoRestartCrashLoopThreshold = getEnvInt("HOST_AUTO_RESTART_CRASH_LOOP_THRESHOLD", 5)    //nolint:mnd // default
+	cfg.Worker.SessionRestorePollInterval = getEnvDuration("SESSION_RESTORE_POLL_INTERVAL", 30*time.Second) //nolint:mnd // default
+	cfg.Worker.SessionRestoreMaxAttempts = getEnvInt("SESSION_RESTORE_MAX_ATTEMPTS", 5)                     //nolint:mnd // default
+	cfg.Worker.WebhookPollInterval = getEnvDuration("WEBHOOK_POLL_INTERVAL", 5*time.Second)                 //nolint:mnd // default
+	cfg.Worker.WebhookTimeout = getEnvDuration("WEBHOOK_TIMEOUT", 10*time.Second)                           //nolint:mnd // default
+	cfg.Worker.WebhookRetryBaseDelay = getEnvDuration("WEBHOOK_RETRY_BASE_DELAY", 30*time.Second)           //nolint:mnd // default
+	cfg.Worker.WebhookRetryMaxDelay = getEnvDuration("WEBHOOK_RETRY_MAX_DELAY", time.Hour)
+	cfg.Worker.WebhookMaxAttempts = getEnvInt("WEBHOOK_MAX_ATTEMPTS", 8)                                    //nolint:mnd // default
+	cfg.Worker.WebhookDeliveryRetention = getEnvDuration("WEBHOOK_DELIVERY_RETENTION", 7*24*time.Hour)      //nolint:mnd // default
+	cfg.Worker.NotificationHistoryRetention = getEnvDuration("NOTIFICATION_HISTORY_RETENTION", 7*24*time.Hour) //nolint:mnd // default
+	cfg.Worker.NotificationHistoryMaxEvents = getEnvInt("NOTIFICATION_HISTORY_MAX_EVENTS", 10000)             //nolint:mnd // default
+	cfg.Worker.ContainerLogRetentionDays = getEnvInt("CONTAINER_LOG_RETENTION_DAYS", 30)                       //nolint:mnd // default
+	cfg.Worker.ContainerLogArchiveInterval = getEnvDuration("CONTAINER_LOG_ARCHIVE_INTERVAL", time.Hour)
+	cfg.Worker.MetricsSampleInterval = getEnvDuration("METRICS_SAMPLE_INTERVAL", 15*time.Second)             //nolint:mnd // default
+	cfg.Worker.MetricsRawRetention = getEnvDuration("METRICS_RAW_RETENTION", 24*time.Hour)                  //nolint:mnd // default
+	cfg.Worker.MetricsMinuteRetention = getEnvDuration("METRICS_MINUTE_RETENTION", 14*24*time.Hour)         //nolint:mnd // default
+	cfg.Worker.MetricsHourRetention = getEnvDuration("METRICS_HOUR_RETENTION", 365*24*time.Hour)            //nolint:mnd // default
+
+	webhookNetworks, err := parseNetworks("WEBHOOK_ALLOWED_NETWORKS")
+	if err != nil {
+		return nil, err
+	}
+
+	cfg.Worker.WebhookAllowedNetworks = webhookNetworks
+
+	cfg.Cluster.Enabled = os.Getenv("CLUSTER_ENABLED") == "true"
+	cfg.Cluster.InstanceID = getEnvWithDefault("CLUSTER_INSTANCE_ID", defaultInstanceID())
+	cfg.Cluster.HeartbeatInterval = getEnvDuration("CLUSTER_HEARTBEAT_INTERVAL", 5*time.Second) //nolint:mnd // default
+	cfg.Cluster.InstanceTTL = getEnvDuration("CLUSTER_INSTANCE_TTL", 20*time.Second)            //nolint:mnd // default
 
 	cfg.Server.Host = getEnvWithDefault("HOST", ":8014")
 	cfg.Server.FrontDevMode = os.Getenv("FDEV") == "true"
 	cfg.Server.FrontDevURL = getEnvWithDefault("FDEV_URL", "http://localhost:5173")
 	cfg.Server.ShutdownTimeout = getEnvDuration("SHUTDOWN_TIMEOUT", 10*time.Second) //nolint:mnd // default
+	cfg.Server.MetricsToken = os.Getenv("METRICS_TOKEN")
 
 	portMin, portMax, err := parseSessionPortEnv()
 	if err != nil {
@@ -131,6 +395,7 @@ func LoadEnvConfig() (*EnvConfig, error) {
 	cfg.RustFS.UseSSL = os.Getenv("RUSTFS_USE_SSL") == "true"
 	cfg.RustFS.WorldDownloadsBucket = os.Getenv("WORLD_DOWNLOADS_BUCKET_NAME")
 	cfg.RustFS.BlobTTLDays = getEnvInt("BLOB_TTL_DAYS", 3) //nolint:mnd // default
+	cfg.RustFS.ContainerLogArchivesBucket = getEnvWithDefault("CONTAINER_LOG_ARCHIVES_BUCKET_NAME", "container-log-archives")
 
 	return cfg, nil
 }
@@ -140,6 +405,28 @@ func (c *EnvConfig) Validate() error {
 		return errors.New("JWT_SECRET is required")
 	}
 
+	if c.OIDC.Enabled() {
+		if c.OIDC.ClientID == "" {
+			return errors.New("OIDC_CLIENT_ID is required when OIDC_ISSUER_URL is set")
+		}
+
+		if c.OIDC.RedirectURL == "" {
+			return errors.New("OIDC_REDIRECT_URL is required when OIDC_ISSUER_URL is set")
+		}
+
+		if !slices.Contains(c.OIDC.Scopes, "openid") {
+			return errors.New("OIDC_SCOPES must include openid")
+		}
+	}
+
+	if len(c.Credential.EncryptionKeys) == 0 {
+		return errors.New("CREDENTIAL_ENCRYPTION_KEYS is required")
+	}
+
+	if _, ok := c.Credential.EncryptionKeys[c.Credential.PrimaryKeyID]; !ok {
+		return fmt.Errorf("CREDENTIAL_ENCRYPTION_KEY_ID %q is not in CREDENTIAL_ENCRYPTION_KEYS", c.Credential.PrimaryKeyID)
+	}
+
 	if c.Database.URL == "" {
 		return errors.New("DB_URL is required")
 	}
@@ -148,6 +435,18 @@ func (c *EnvConfig) Validate() error {
 		return errors.New("HEADLESS_IMAGE_NAME is required")
 	}
 
+	if c.Connector.Default != "docker" && c.Connector.Default != "kubernetes" {
+		return errors.New("HOST_CONNECTOR must be either docker or kubernetes")
+	}
+
+	if c.Kubernetes.Enabled && c.Kubernetes.NetworkMode != "host" && c.Kubernetes.NetworkMode != "service" {
+		return errors.New("KUBERNETES_NETWORK_MODE must be either host or service")
+	}
+
+	if c.Cluster.Enabled && c.Cluster.InstanceTTL <= c.Cluster.HeartbeatInterval {
+		return errors.New("CLUSTER_INSTANCE_TTL must be longer than CLUSTER_HEARTBEAT_INTERVAL")
+	}
+
 	if c.RustFS.Endpoint == "" {
 		return errors.New("RUSTFS_ENDPOINT is required")
 	}
@@ -168,6 +467,18 @@ func (c *EnvConfig) Validate() error {
 		return errors.New("BLOB_TTL_DAYS must be a positive integer")
 	}
 
+	if c.RustFS.ContainerLogArchivesBucket == c.RustFS.WorldDownloadsBucket {
+		return errors.New("CONTAINER_LOG_ARCHIVES_BUCKET_NAME must differ from WORLD_DOWNLOADS_BUCKET_NAME")
+	}
+
+	if c.Worker.ContainerLogRetentionDays < 0 {
+		return errors.New("CONTAINER_LOG_RETENTION_DAYS must not be negative")
+	}
+
+	if c.Worker.MetricsSampleInterval <= 0 || c.Worker.MetricsSampleInterval >= time.Minute {
+		return errors.New("METRICS_SAMPLE_INTERVAL must be positive and shorter than a minute")
+	}
+
 	return nil
 }
 
@@ -209,6 +520,16 @@ func getEnvDurationSec(key string, defaultValue time.Duration) time.Duration {
 	return defaultValue
 }
 
+// defaultInstanceID is the hostname, which is unique per container or pod.
+// Processes sharing a hostname have to set CLUSTER_INSTANCE_ID.
+func defaultInstanceID() string {
+	if name, err := os.Hostname(); err == nil && name != "" {
+		return name
+	}
+
+	return "controller"
+}
+
 func parseCSV(s string) []string {
 	if s == "" {
 		return nil
@@ -227,6 +548,82 @@ func parseCSV(s string) []string {
 	return out
 }
 
+// parseKeyValues parses "k1=v1,k2=v2". Entries without "=" are ignored.
+func parseKeyValues(s string) map[string]string {
+	out := make(map[string]string)
+
+	for _, p := range parseCSV(s) {
+		k, v, ok := strings.Cut(p, "=")
+		if !ok {
+			continue
+		}
+
+		out[strings.TrimSpace(k)] = strings.TrimSpace(v)
+	}
+
+	return out
+}
+
+// parseNetworks parses the comma separated CIDRs in the environment
+// variable key. A bare address is taken as a single host.
+func parseNetworks(key string) ([]netip.Prefix, error) {
+	var out []netip.Prefix
+
+	for _, p := range parseCSV(os.Getenv(key)) {
+		if addr, err := netip.ParseAddr(p); err == nil {
+			out = append(out, netip.PrefixFrom(addr, addr.BitLen()))
+
+			continue
+		}
+
+		prefix, err := netip.ParsePrefix(p)
+		if err != nil {
+			return nil, fmt.Errorf("%s: invalid network %q", key, p)
+		}
+
+		out = append(out, prefix.Masked())
+	}
+
+	return out, nil
+}
+
+// parseEncryptionKeys parses "id1:base64key1,id2:base64key2" and also
+// returns the first key ID, which is the default primary key.
+func parseEncryptionKeys(s string) (map[string][]byte, string, error) {
+	keys := make(map[string][]byte)
+	firstID := ""
+
+	for _, p := range parseCSV(s) {
+		id, encoded, ok := strings.Cut(p, ":")
+		id = strings.TrimSpace(id)
+
+		if !ok || id == "" {
+			return nil, "", errors.New("CREDENTIAL_ENCRYPTION_KEYS entries must be <key id>:<base64 key>")
+		}
+
+		key, err := base64.StdEncoding.DecodeString(strings.TrimSpace(encoded))
+		if err != nil {
+			return nil, "", fmt.Errorf("invalid base64 in CREDENTIAL_ENCRYPTION_KEYS for key %q", id)
+		}
+
+		if len(key) != 32 { //nolint:mnd // AES-256
+			return nil, "", fmt.Errorf("CREDENTIAL_ENCRYPTION_KEYS key %q must be 32 bytes, got %d", id, len(key))
+		}
+
+		if _, dup := keys[id]; dup {
+			return nil, "", fmt.Errorf("duplicate key id %q in CREDENTIAL_ENCRYPTION_KEYS", id)
+		}
+
+		if firstID == "" {
+			firstID = id
+		}
+
+		keys[id] = key
+	}
+
+	return keys, firstID, nil
+}
+
 func parseSessionPortEnv() (int, int, error) {
 	portMin, portMax := 0, 0
 	portMinStr := os.Getenv("SESSION_PORT_MIN")
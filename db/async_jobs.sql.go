@@ -140,6 +140,29 @@ func (q *Queries) GetAsyncJob(ctx context.Context, id pgtype.UUID) (AsyncJob, er
 	return i, err
 }
 
+const getAsyncJobQueueStats = `-- name: GetAsyncJobQueueStats :one
+SELECT
+    COUNT(*) FILTER (WHERE status = 0)::bigint AS pending,
+    COUNT(*) FILTER (WHERE status = 1)::bigint AS running,
+    MIN(created_at) FILTER (WHERE status = 0)::timestamptz AS oldest_pending_at
+FROM async_jobs
+WHERE status IN (0, 1)
+`
+
+type GetAsyncJobQueueStatsRow struct {
+	Pending         int64
+	Running         int64
+	OldestPendingAt pgtype.Timestamptz
+}
+
+// /metrics 用。未実行 (PENDING) / 実行中 (RUNNING) の件数と、最も古い PENDING の投入時刻。
+func (q *Queries) GetAsyncJobQueueStats(ctx context.Context) (GetAsyncJobQueueStatsRow, error) {
+	row := q.db.QueryRow(ctx, getAsyncJobQueueStats)
+	var i GetAsyncJobQueueStatsRow
+	err := row.Scan(&i.Pending, &i.Running, &i.OldestPendingAt)
+	return i, err
+}
+
 const markAsyncJobFailed = `-- name: MarkAsyncJobFailed :execrows
 UPDATE async_jobs
 SET status = 3, executed_at = NOW(), last_error = $2::text, claimed_by = NULL, claimed_at = NULL
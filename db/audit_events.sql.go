@@ -0,0 +1,210 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.30.0
+// source: audit_events.sql
+
+package db
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+const createAuditEvent = `-- name: CreateAuditEvent :one
+INSERT INTO audit_events (
+    user_id,
+    procedure,
+    resource_type,
+    resource_id,
+    group_id,
+    request_summary,
+    outcome,
+    error_message,
+    remote_addr
+) VALUES (
+    $1, $2, $3, $4, $5, $6, $7, $8, $9
+) RETURNING id, occurred_at, user_id, procedure, resource_type, resource_id, group_id, request_summary, outcome, error_message, remote_addr
+`
+
+type CreateAuditEventParams struct {
+	UserID         pgtype.Text
+	Procedure      string
+	ResourceType   string
+	ResourceID     pgtype.Text
+	GroupID        pgtype.Text
+	RequestSummary []byte
+	Outcome        string
+	ErrorMessage   pgtype.Text
+	RemoteAddr     pgtype.Text
+}
+
+func (q *Queries) CreateAuditEvent(ctx context.Context, arg CreateAuditEventParams) (AuditEvent, error) {
+	row := q.db.QueryRow(ctx, createAuditEvent,
+		arg.UserID,
+		arg.Procedure,
+		arg.ResourceType,
+		arg.ResourceID,
+		arg.GroupID,
+		arg.RequestSummary,
+		arg.Outcome,
+		arg.ErrorMessage,
+		arg.RemoteAddr,
+	)
+	var i AuditEvent
+	err := row.Scan(
+		&i.ID,
+		&i.OccurredAt,
+		&i.UserID,
+		&i.Procedure,
+		&i.ResourceType,
+		&i.ResourceID,
+		&i.GroupID,
+		&i.RequestSummary,
+		&i.Outcome,
+		&i.ErrorMessage,
+		&i.RemoteAddr,
+	)
+	return i, err
+}
+
+const listAuditEvents = `-- name: ListAuditEvents :many
+SELECT audit_events.id, audit_events.occurred_at, audit_events.user_id, audit_events.procedure, audit_events.resource_type, audit_events.resource_id, audit_events.group_id, audit_events.request_summary, audit_events.outcome, audit_events.error_message, audit_events.remote_addr, COUNT(*) OVER() AS total_count
+FROM audit_events
+WHERE ($1::text      IS NULL OR group_id      = $1::text)
+  AND ($2::text       IS NULL OR user_id       = $2::text)
+  AND ($3::text IS NULL OR resource_type = $3::text)
+  AND ($4::text   IS NULL OR resource_id   = $4::text)
+  AND ($5::timestamptz  IS NULL OR occurred_at  >= $5::timestamptz)
+  AND ($6::timestamptz  IS NULL OR occurred_at  <  $6::timestamptz)
+ORDER BY occurred_at DESC, id DESC
+LIMIT $8::int OFFSET $7::int
+`
+
+type ListAuditEventsParams struct {
+	GroupID      pgtype.Text
+	UserID       pgtype.Text
+	ResourceType pgtype.Text
+	ResourceID   pgtype.Text
+	Since        pgtype.Timestamptz
+	Until        pgtype.Timestamptz
+	PageOffset   int32
+	PageSize     int32
+}
+
+type ListAuditEventsRow struct {
+	AuditEvent AuditEvent
+	TotalCount int64
+}
+
+// 新しい順. フィルタは nullable パラメータで、NULL なら未指定として扱う。
+// total_count は全行同じ値が入る (COUNT(*) OVER())。
+func (q *Queries) ListAuditEvents(ctx context.Context, arg ListAuditEventsParams) ([]ListAuditEventsRow, error) {
+	rows, err := q.db.Query(ctx, listAuditEvents,
+		arg.GroupID,
+		arg.UserID,
+		arg.ResourceType,
+		arg.ResourceID,
+		arg.Since,
+		arg.Until,
+		arg.PageOffset,
+		arg.PageSize,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListAuditEventsRow
+	for rows.Next() {
+		var i ListAuditEventsRow
+		if err := rows.Scan(
+			&i.AuditEvent.ID,
+			&i.AuditEvent.OccurredAt,
+			&i.AuditEvent.UserID,
+			&i.AuditEvent.Procedure,
+			&i.AuditEvent.ResourceType,
+			&i.AuditEvent.ResourceID,
+			&i.AuditEvent.GroupID,
+			&i.AuditEvent.RequestSummary,
+			&i.AuditEvent.Outcome,
+			&i.AuditEvent.ErrorMessage,
+			&i.AuditEvent.RemoteAddr,
+			&i.TotalCount,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listAuditEventsAfter = `-- name: ListAuditEventsAfter :many
+SELECT id, occurred_at, user_id, procedure, resource_type, resource_id, group_id, request_summary, outcome, error_message, remote_addr
+FROM audit_events
+WHERE id > $1::bigint
+  AND ($2::text      IS NULL OR group_id      = $2::text)
+  AND ($3::text       IS NULL OR user_id       = $3::text)
+  AND ($4::text IS NULL OR resource_type = $4::text)
+  AND ($5::text   IS NULL OR resource_id   = $5::text)
+  AND ($6::timestamptz  IS NULL OR occurred_at  >= $6::timestamptz)
+  AND ($7::timestamptz  IS NULL OR occurred_at  <  $7::timestamptz)
+ORDER BY id ASC
+LIMIT $8::int
+`
+
+type ListAuditEventsAfterParams struct {
+	AfterID      int64
+	GroupID      pgtype.Text
+	UserID       pgtype.Text
+	ResourceType pgtype.Text
+	ResourceID   pgtype.Text
+	Since        pgtype.Timestamptz
+	Until        pgtype.Timestamptz
+	PageSize     int32
+}
+
+// export 用. id 昇順に after_id より後ろを返す (keyset pagination)。
+// 書き込みが続いていてもページ境界がずれない。
+func (q *Queries) ListAuditEventsAfter(ctx context.Context, arg ListAuditEventsAfterParams) ([]AuditEvent, error) {
+	rows, err := q.db.Query(ctx, listAuditEventsAfter,
+		arg.AfterID,
+		arg.GroupID,
+		arg.UserID,
+		arg.ResourceType,
+		arg.ResourceID,
+		arg.Since,
+		arg.Until,
+		arg.PageSize,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []AuditEvent
+	for rows.Next() {
+		var i AuditEvent
+		if err := rows.Scan(
+			&i.ID,
+			&i.OccurredAt,
+			&i.UserID,
+			&i.Procedure,
+			&i.ResourceType,
+			&i.ResourceID,
+			&i.GroupID,
+			&i.RequestSummary,
+			&i.Outcome,
+			&i.ErrorMessage,
+			&i.RemoteAddr,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
@@ -23,6 +23,53 @@ func (q *Queries) DeleteContainerLogsByHostID(ctx context.Context, hostID pgtype
 	return err
 }
 
+const deleteContainerLogsByTagUpToID = `-- name: DeleteContainerLogsByTagUpToID :execrows
+DELETE FROM container_logs
+WHERE tag = $1
+  AND id <= $2::bigint
+`
+
+type DeleteContainerLogsByTagUpToIDParams struct {
+	Tag   pgtype.Text
+	MaxID int64
+}
+
+// 退避済みの範囲 (id が max_id 以下) のログを削除
+func (q *Queries) DeleteContainerLogsByTagUpToID(ctx context.Context, arg DeleteContainerLogsByTagUpToIDParams) (int64, error) {
+	result, err := q.db.Exec(ctx, deleteContainerLogsByTagUpToID, arg.Tag, arg.MaxID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const getContainerLogArchive = `-- name: GetContainerLogArchive :one
+SELECT host_id, instance_id, object_key, first_log_at, last_log_at, log_count, last_log_id, size_bytes, created_at FROM container_log_archives
+WHERE host_id = $1 AND instance_id = $2
+`
+
+type GetContainerLogArchiveParams struct {
+	HostID     string
+	InstanceID int32
+}
+
+func (q *Queries) GetContainerLogArchive(ctx context.Context, arg GetContainerLogArchiveParams) (ContainerLogArchive, error) {
+	row := q.db.QueryRow(ctx, getContainerLogArchive, arg.HostID, arg.InstanceID)
+	var i ContainerLogArchive
+	err := row.Scan(
+		&i.HostID,
+		&i.InstanceID,
+		&i.ObjectKey,
+		&i.FirstLogAt,
+		&i.LastLogAt,
+		&i.LogCount,
+		&i.LastLogID,
+		&i.SizeBytes,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
 const getContainerLogsByTag = `-- name: GetContainerLogsByTag :many
 SELECT id, tag, ts, data
 FROM container_logs
@@ -140,3 +187,249 @@ func (q *Queries) InsertContainerLog(ctx context.Context, arg InsertContainerLog
 	_, err := q.db.Exec(ctx, insertContainerLog, arg.Tag, arg.Ts, arg.Data)
 	return err
 }
+
+const listContainerLogArchivesByHostID = `-- name: ListContainerLogArchivesByHostID :many
+SELECT host_id, instance_id, object_key, first_log_at, last_log_at, log_count, last_log_id, size_bytes, created_at FROM container_log_archives
+WHERE host_id = $1
+ORDER BY instance_id DESC
+`
+
+func (q *Queries) ListContainerLogArchivesByHostID(ctx context.Context, hostID string) ([]ContainerLogArchive, error) {
+	rows, err := q.db.Query(ctx, listContainerLogArchivesByHostID, hostID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ContainerLogArchive
+	for rows.Next() {
+		var i ContainerLogArchive
+		if err := rows.Scan(
+			&i.HostID,
+			&i.InstanceID,
+			&i.ObjectKey,
+			&i.FirstLogAt,
+			&i.LastLogAt,
+			&i.LogCount,
+			&i.LastLogID,
+			&i.SizeBytes,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listContainerLogsAfterID = `-- name: ListContainerLogsAfterID :many
+SELECT tag, ts, data, id
+FROM container_logs
+WHERE tag = $1
+  AND id > $2::bigint
+ORDER BY id ASC
+LIMIT $3
+`
+
+type ListContainerLogsAfterIDParams struct {
+	Tag     pgtype.Text
+	AfterID int64
+	MaxRows int32
+}
+
+// 特定のタグのログのうち after_id より後のものを古い順に取得 (追従用)
+func (q *Queries) ListContainerLogsAfterID(ctx context.Context, arg ListContainerLogsAfterIDParams) ([]ContainerLog, error) {
+	rows, err := q.db.Query(ctx, listContainerLogsAfterID, arg.Tag, arg.AfterID, arg.MaxRows)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ContainerLog
+	for rows.Next() {
+		var i ContainerLog
+		if err := rows.Scan(
+			&i.Tag,
+			&i.Ts,
+			&i.Data,
+			&i.ID,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listPastContainerLogInstances = `-- name: ListPastContainerLogInstances :many
+SELECT
+    h.id AS host_id,
+    CAST(SUBSTRING(l.tag FROM 'headless-[^-]+-(\d+)') AS INTEGER) AS instance_id,
+    l.tag,
+    CAST(MAX(l.ts) AS TIMESTAMP) AS last_log_at,
+    g.log_retention_days
+FROM container_logs l
+JOIN hosts h ON h.id = SUBSTRING(l.tag FROM '^headless-([^-]+)-\d+$')
+LEFT JOIN groups g ON g.id = h.group_id
+GROUP BY l.tag, h.id, h.instance_count, g.log_retention_days
+HAVING CAST(SUBSTRING(l.tag FROM 'headless-[^-]+-(\d+)') AS INTEGER) <> h.instance_count
+ORDER BY MAX(l.ts)
+`
+
+type ListPastContainerLogInstancesRow struct {
+	HostID           string
+	InstanceID       int32
+	Tag              pgtype.Text
+	LastLogAt        pgtype.Timestamp
+	LogRetentionDays pgtype.Int4
+}
+
+// ログが残っている過去のインスタンス (ホストの現在のインスタンス以外) を、最後のログが古い順に返す.
+// 退避するかは log_retention_days (ホストのグループの設定. NULL なら既定値) を見て呼び出し側が決める.
+func (q *Queries) ListPastContainerLogInstances(ctx context.Context) ([]ListPastContainerLogInstancesRow, error) {
+	rows, err := q.db.Query(ctx, listPastContainerLogInstances)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListPastContainerLogInstancesRow
+	for rows.Next() {
+		var i ListPastContainerLogInstancesRow
+		if err := rows.Scan(
+			&i.HostID,
+			&i.InstanceID,
+			&i.Tag,
+			&i.LastLogAt,
+			&i.LogRetentionDays,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const searchContainerLogs = `-- name: SearchContainerLogs :many
+SELECT
+    l.id,
+    l.ts,
+    l.data,
+    h.id AS host_id,
+    h.name AS host_name,
+    CAST(SUBSTRING(l.tag FROM 'headless-[^-]+-(\d+)') AS INTEGER) AS instance_id
+FROM container_logs l
+JOIN hosts h ON h.id = SUBSTRING(l.tag FROM '^headless-([^-]+)-\d+$')
+WHERE l.data->>'log' ILIKE $1::text
+  AND ($2::text[] IS NULL OR h.group_id = ANY($2::text[]))
+  AND ($3::text[] IS NULL OR h.id = ANY($3::text[]))
+  AND ($4::timestamp IS NULL OR l.ts >= $4::timestamp)
+  AND ($5::timestamp IS NULL OR l.ts < $5::timestamp)
+  AND ($6::bigint = 0 OR l.id < $6::bigint)
+ORDER BY l.id DESC
+LIMIT $7
+`
+
+type SearchContainerLogsParams struct {
+	Pattern  string
+	GroupIds []string
+	HostIds  []string
+	Since    pgtype.Timestamp
+	Until    pgtype.Timestamp
+	BeforeID int64
+	MaxRows  int32
+}
+
+type SearchContainerLogsRow struct {
+	ID         pgtype.Int8
+	Ts         pgtype.Timestamp
+	Data       []byte
+	HostID     string
+	HostName   string
+	InstanceID int32
+}
+
+// ホストのログ本文を部分一致 (大文字小文字を区別しない) で検索し、新しい順に返す.
+// pattern は LIKE のパターン (呼び出し側で % _ \ をエスケープし、前後に % を付ける).
+// group_ids / host_ids は nullable パラメータ. NULL の場合は絞り込まない.
+func (q *Queries) SearchContainerLogs(ctx context.Context, arg SearchContainerLogsParams) ([]SearchContainerLogsRow, error) {
+	rows, err := q.db.Query(ctx, searchContainerLogs,
+		arg.Pattern,
+		arg.GroupIds,
+		arg.HostIds,
+		arg.Since,
+		arg.Until,
+		arg.BeforeID,
+		arg.MaxRows,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []SearchContainerLogsRow
+	for rows.Next() {
+		var i SearchContainerLogsRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.Ts,
+			&i.Data,
+			&i.HostID,
+			&i.HostName,
+			&i.InstanceID,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const upsertContainerLogArchive = `-- name: UpsertContainerLogArchive :exec
+INSERT INTO container_log_archives (
+    host_id, instance_id, object_key, first_log_at, last_log_at, log_count, last_log_id, size_bytes
+) VALUES (
+    $1, $2, $3, $4, $5, $6, $7, $8
+)
+ON CONFLICT (host_id, instance_id) DO UPDATE SET
+    object_key = EXCLUDED.object_key,
+    first_log_at = EXCLUDED.first_log_at,
+    last_log_at = EXCLUDED.last_log_at,
+    log_count = EXCLUDED.log_count,
+    last_log_id = EXCLUDED.last_log_id,
+    size_bytes = EXCLUDED.size_bytes,
+    created_at = CURRENT_TIMESTAMP
+`
+
+type UpsertContainerLogArchiveParams struct {
+	HostID     string
+	InstanceID int32
+	ObjectKey  string
+	FirstLogAt pgtype.Timestamp
+	LastLogAt  pgtype.Timestamp
+	LogCount   int64
+	LastLogID  int64
+	SizeBytes  int64
+}
+
+func (q *Queries) UpsertContainerLogArchive(ctx context.Context, arg UpsertContainerLogArchiveParams) error {
+	_, err := q.db.Exec(ctx, upsertContainerLogArchive,
+		arg.HostID,
+		arg.InstanceID,
+		arg.ObjectKey,
+		arg.FirstLogAt,
+		arg.LastLogAt,
+		arg.LogCount,
+		arg.LastLogID,
+		arg.SizeBytes,
+	)
+	return err
+}
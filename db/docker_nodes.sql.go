@@ -0,0 +1,169 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.30.0
+// source: docker_nodes.sql
+
+package db
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+const countHostsOnDockerNode = `-- name: CountHostsOnDockerNode :one
+SELECT COUNT(*) FROM hosts WHERE connector_type = 'docker' AND connect_string LIKE $1::text || '/%'
+`
+
+// connect_string が "<node_id>/" で始まる host の数. node 削除前の参照チェック用.
+func (q *Queries) CountHostsOnDockerNode(ctx context.Context, nodeID string) (int64, error) {
+	row := q.db.QueryRow(ctx, countHostsOnDockerNode, nodeID)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
+const createDockerNode = `-- name: CreateDockerNode :one
+INSERT INTO docker_nodes (
+    id,
+    name,
+    endpoint,
+    rpc_address,
+    tls_ca_cert_path,
+    tls_cert_path,
+    tls_key_path,
+    fluentd_address,
+    max_hosts,
+    enabled
+) VALUES (
+    $1, $2, $3, $4, $5, $6, $7, $8, $9, $10
+) RETURNING id, name, endpoint, rpc_address, tls_ca_cert_path, tls_cert_path, tls_key_path, fluentd_address, max_hosts, enabled, created_at, updated_at
+`
+
+type CreateDockerNodeParams struct {
+	ID             string
+	Name           string
+	Endpoint       string
+	RpcAddress     pgtype.Text
+	TlsCaCertPath  pgtype.Text
+	TlsCertPath    pgtype.Text
+	TlsKeyPath     pgtype.Text
+	FluentdAddress pgtype.Text
+	MaxHosts       int32
+	Enabled        bool
+}
+
+func (q *Queries) CreateDockerNode(ctx context.Context, arg CreateDockerNodeParams) (DockerNode, error) {
+	row := q.db.QueryRow(ctx, createDockerNode,
+		arg.ID,
+		arg.Name,
+		arg.Endpoint,
+		arg.RpcAddress,
+		arg.TlsCaCertPath,
+		arg.TlsCertPath,
+		arg.TlsKeyPath,
+		arg.FluentdAddress,
+		arg.MaxHosts,
+		arg.Enabled,
+	)
+	var i DockerNode
+	err := row.Scan(
+		&i.ID,
+		&i.Name,
+		&i.Endpoint,
+		&i.RpcAddress,
+		&i.TlsCaCertPath,
+		&i.TlsCertPath,
+		&i.TlsKeyPath,
+		&i.FluentdAddress,
+		&i.MaxHosts,
+		&i.Enabled,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const deleteDockerNode = `-- name: DeleteDockerNode :exec
+DELETE FROM docker_nodes WHERE id = $1
+`
+
+func (q *Queries) DeleteDockerNode(ctx context.Context, id string) error {
+	_, err := q.db.Exec(ctx, deleteDockerNode, id)
+	return err
+}
+
+const getDockerNode = `-- name: GetDockerNode :one
+SELECT id, name, endpoint, rpc_address, tls_ca_cert_path, tls_cert_path, tls_key_path, fluentd_address, max_hosts, enabled, created_at, updated_at FROM docker_nodes WHERE id = $1 LIMIT 1
+`
+
+func (q *Queries) GetDockerNode(ctx context.Context, id string) (DockerNode, error) {
+	row := q.db.QueryRow(ctx, getDockerNode, id)
+	var i DockerNode
+	err := row.Scan(
+		&i.ID,
+		&i.Name,
+		&i.Endpoint,
+		&i.RpcAddress,
+		&i.TlsCaCertPath,
+		&i.TlsCertPath,
+		&i.TlsKeyPath,
+		&i.FluentdAddress,
+		&i.MaxHosts,
+		&i.Enabled,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const listDockerNodes = `-- name: ListDockerNodes :many
+SELECT id, name, endpoint, rpc_address, tls_ca_cert_path, tls_cert_path, tls_key_path, fluentd_address, max_hosts, enabled, created_at, updated_at FROM docker_nodes ORDER BY created_at ASC
+`
+
+func (q *Queries) ListDockerNodes(ctx context.Context) ([]DockerNode, error) {
+	rows, err := q.db.Query(ctx, listDockerNodes)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []DockerNode
+	for rows.Next() {
+		var i DockerNode
+		if err := rows.Scan(
+			&i.ID,
+			&i.Name,
+			&i.Endpoint,
+			&i.RpcAddress,
+			&i.TlsCaCertPath,
+			&i.TlsCertPath,
+			&i.TlsKeyPath,
+			&i.FluentdAddress,
+			&i.MaxHosts,
+			&i.Enabled,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const updateDockerNodeEnabled = `-- name: UpdateDockerNodeEnabled :exec
+UPDATE docker_nodes SET enabled = $2 WHERE id = $1
+`
+
+type UpdateDockerNodeEnabledParams struct {
+	ID      string
+	Enabled bool
+}
+
+func (q *Queries) UpdateDockerNodeEnabled(ctx context.Context, arg UpdateDockerNodeEnabledParams) error {
+	_, err := q.db.Exec(ctx, updateDockerNodeEnabled, arg.ID, arg.Enabled)
+	return err
+}
@@ -12,7 +12,7 @@ import (
 )
 
 const createGroup = `-- name: CreateGroup :one
-INSERT INTO groups (id, name, type) VALUES ($1, $2, $3) RETURNING id, name, type, created_at, updated_at
+INSERT INTO groups (id, name, type) VALUES ($1, $2, $3) RETURNING id, name, type, created_at, updated_at, log_retention_days
 `
 
 type CreateGroupParams struct {
@@ -30,6 +30,7 @@ func (q *Queries) CreateGroup(ctx context.Context, arg CreateGroupParams) (Group
 		&i.Type,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.LogRetentionDays,
 	)
 	return i, err
 }
@@ -45,7 +46,7 @@ func (q *Queries) DeleteGroup(ctx context.Context, id string) error {
 }
 
 const getGroup = `-- name: GetGroup :one
-SELECT id, name, type, created_at, updated_at FROM groups WHERE id = $1 LIMIT 1
+SELECT id, name, type, created_at, updated_at, log_retention_days FROM groups WHERE id = $1 LIMIT 1
 `
 
 func (q *Queries) GetGroup(ctx context.Context, id string) (Group, error) {
@@ -57,12 +58,13 @@ func (q *Queries) GetGroup(ctx context.Context, id string) (Group, error) {
 		&i.Type,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.LogRetentionDays,
 	)
 	return i, err
 }
 
 const getPersonalGroupByUser = `-- name: GetPersonalGroupByUser :one
-SELECT g.id, g.name, g.type, g.created_at, g.updated_at FROM groups g
+SELECT g.id, g.name, g.type, g.created_at, g.updated_at, g.log_retention_days FROM groups g
 INNER JOIN group_members gm ON gm.group_id = g.id
 WHERE g.type = 'personal' AND gm.user_id = $1
 LIMIT 1
@@ -78,12 +80,13 @@ func (q *Queries) GetPersonalGroupByUser(ctx context.Context, userID string) (Gr
 		&i.Type,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.LogRetentionDays,
 	)
 	return i, err
 }
 
 const listGroups = `-- name: ListGroups :many
-SELECT id, name, type, created_at, updated_at FROM groups ORDER BY created_at DESC
+SELECT id, name, type, created_at, updated_at, log_retention_days FROM groups ORDER BY created_at DESC
 `
 
 func (q *Queries) ListGroups(ctx context.Context) ([]Group, error) {
@@ -101,6 +104,7 @@ func (q *Queries) ListGroups(ctx context.Context) ([]Group, error) {
 			&i.Type,
 			&i.CreatedAt,
 			&i.UpdatedAt,
+			&i.LogRetentionDays,
 		); err != nil {
 			return nil, err
 		}
@@ -113,7 +117,7 @@ func (q *Queries) ListGroups(ctx context.Context) ([]Group, error) {
 }
 
 const listGroupsByType = `-- name: ListGroupsByType :many
-SELECT id, name, type, created_at, updated_at FROM groups WHERE type = $1 ORDER BY created_at DESC
+SELECT id, name, type, created_at, updated_at, log_retention_days FROM groups WHERE type = $1 ORDER BY created_at DESC
 `
 
 func (q *Queries) ListGroupsByType(ctx context.Context, type_ string) ([]Group, error) {
@@ -131,6 +135,7 @@ func (q *Queries) ListGroupsByType(ctx context.Context, type_ string) ([]Group,
 			&i.Type,
 			&i.CreatedAt,
 			&i.UpdatedAt,
+			&i.LogRetentionDays,
 		); err != nil {
 			return nil, err
 		}
@@ -143,7 +148,7 @@ func (q *Queries) ListGroupsByType(ctx context.Context, type_ string) ([]Group,
 }
 
 const listGroupsByUser = `-- name: ListGroupsByUser :many
-SELECT groups.id, groups.name, groups.type, groups.created_at, groups.updated_at, gm.role_id, gm.joined_at
+SELECT groups.id, groups.name, groups.type, groups.created_at, groups.updated_at, groups.log_retention_days, gm.role_id, gm.joined_at
 FROM groups
 INNER JOIN group_members gm ON gm.group_id = groups.id
 WHERE gm.user_id = $1
@@ -172,6 +177,7 @@ func (q *Queries) ListGroupsByUser(ctx context.Context, userID string) ([]ListGr
 			&i.Group.Type,
 			&i.Group.CreatedAt,
 			&i.Group.UpdatedAt,
+			&i.Group.LogRetentionDays,
 			&i.RoleID,
 			&i.JoinedAt,
 		); err != nil {
@@ -186,7 +192,7 @@ func (q *Queries) ListGroupsByUser(ctx context.Context, userID string) ([]ListGr
 }
 
 const listGroupsPaged = `-- name: ListGroupsPaged :many
-SELECT groups.id, groups.name, groups.type, groups.created_at, groups.updated_at, COUNT(*) OVER() AS total_count
+SELECT groups.id, groups.name, groups.type, groups.created_at, groups.updated_at, groups.log_retention_days, COUNT(*) OVER() AS total_count
 FROM groups
 WHERE ($1::text IS NULL OR type = $1::text)
 ORDER BY created_at DESC
@@ -220,6 +226,7 @@ func (q *Queries) ListGroupsPaged(ctx context.Context, arg ListGroupsPagedParams
 			&i.Group.Type,
 			&i.Group.CreatedAt,
 			&i.Group.UpdatedAt,
+			&i.Group.LogRetentionDays,
 			&i.TotalCount,
 		); err != nil {
 			return nil, err
@@ -232,6 +239,21 @@ func (q *Queries) ListGroupsPaged(ctx context.Context, arg ListGroupsPagedParams
 	return items, nil
 }
 
+const updateGroupLogRetentionDays = `-- name: UpdateGroupLogRetentionDays :exec
+UPDATE groups SET log_retention_days = $1 WHERE id = $2
+`
+
+type UpdateGroupLogRetentionDaysParams struct {
+	LogRetentionDays pgtype.Int4
+	ID               string
+}
+
+// NULL にすると CONTAINER_LOG_RETENTION_DAYS に従う
+func (q *Queries) UpdateGroupLogRetentionDays(ctx context.Context, arg UpdateGroupLogRetentionDaysParams) error {
+	_, err := q.db.Exec(ctx, updateGroupLogRetentionDays, arg.LogRetentionDays, arg.ID)
+	return err
+}
+
 const updateGroupName = `-- name: UpdateGroupName :exec
 UPDATE groups SET name = $2 WHERE id = $1
 `
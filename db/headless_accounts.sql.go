@@ -12,24 +12,28 @@ import (
 )
 
 const createHeadlessAccount = `-- name: CreateHeadlessAccount :exec
-INSERT INTO headless_accounts (resonite_id, credential, password, last_display_name, last_icon_url, group_id, created_by) VALUES ($1, $2, $3, $4, $5, $6, $7)
+INSERT INTO headless_accounts (resonite_id, credential_key_id, credential_data_key, encrypted_credential, encrypted_password, last_display_name, last_icon_url, group_id, created_by) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
 `
 
 type CreateHeadlessAccountParams struct {
-	ResoniteID      string
-	Credential      string
-	Password        string
-	LastDisplayName pgtype.Text
-	LastIconUrl     pgtype.Text
-	GroupID         string
-	CreatedBy       pgtype.Text
+	ResoniteID          string
+	CredentialKeyID     pgtype.Text
+	CredentialDataKey   []byte
+	EncryptedCredential []byte
+	EncryptedPassword   []byte
+	LastDisplayName     pgtype.Text
+	LastIconUrl         pgtype.Text
+	GroupID             string
+	CreatedBy           pgtype.Text
 }
 
 func (q *Queries) CreateHeadlessAccount(ctx context.Context, arg CreateHeadlessAccountParams) error {
 	_, err := q.db.Exec(ctx, createHeadlessAccount,
 		arg.ResoniteID,
-		arg.Credential,
-		arg.Password,
+		arg.CredentialKeyID,
+		arg.CredentialDataKey,
+		arg.EncryptedCredential,
+		arg.EncryptedPassword,
 		arg.LastDisplayName,
 		arg.LastIconUrl,
 		arg.GroupID,
@@ -48,7 +52,7 @@ func (q *Queries) DeleteHeadlessAccount(ctx context.Context, resoniteID string)
 }
 
 const getHeadlessAccount = `-- name: GetHeadlessAccount :one
-SELECT resonite_id, credential, password, last_display_name, last_icon_url, created_at, updated_at, group_id, created_by FROM headless_accounts WHERE resonite_id = $1
+SELECT resonite_id, credential, password, last_display_name, last_icon_url, created_at, updated_at, group_id, created_by, credential_key_id, credential_data_key, encrypted_credential, encrypted_password FROM headless_accounts WHERE resonite_id = $1
 `
 
 func (q *Queries) GetHeadlessAccount(ctx context.Context, resoniteID string) (HeadlessAccount, error) {
@@ -64,12 +68,16 @@ func (q *Queries) GetHeadlessAccount(ctx context.Context, resoniteID string) (He
 		&i.UpdatedAt,
 		&i.GroupID,
 		&i.CreatedBy,
+		&i.CredentialKeyID,
+		&i.CredentialDataKey,
+		&i.EncryptedCredential,
+		&i.EncryptedPassword,
 	)
 	return i, err
 }
 
 const listHeadlessAccounts = `-- name: ListHeadlessAccounts :many
-SELECT resonite_id, credential, password, last_display_name, last_icon_url, created_at, updated_at, group_id, created_by FROM headless_accounts ORDER BY resonite_id
+SELECT resonite_id, credential, password, last_display_name, last_icon_url, created_at, updated_at, group_id, created_by, credential_key_id, credential_data_key, encrypted_credential, encrypted_password FROM headless_accounts ORDER BY resonite_id
 `
 
 func (q *Queries) ListHeadlessAccounts(ctx context.Context) ([]HeadlessAccount, error) {
@@ -91,6 +99,10 @@ func (q *Queries) ListHeadlessAccounts(ctx context.Context) ([]HeadlessAccount,
 			&i.UpdatedAt,
 			&i.GroupID,
 			&i.CreatedBy,
+			&i.CredentialKeyID,
+			&i.CredentialDataKey,
+			&i.EncryptedCredential,
+			&i.EncryptedPassword,
 		); err != nil {
 			return nil, err
 		}
@@ -103,7 +115,7 @@ func (q *Queries) ListHeadlessAccounts(ctx context.Context) ([]HeadlessAccount,
 }
 
 const listHeadlessAccountsPaged = `-- name: ListHeadlessAccountsPaged :many
-SELECT headless_accounts.resonite_id, headless_accounts.credential, headless_accounts.password, headless_accounts.last_display_name, headless_accounts.last_icon_url, headless_accounts.created_at, headless_accounts.updated_at, headless_accounts.group_id, headless_accounts.created_by, COUNT(*) OVER() AS total_count
+SELECT headless_accounts.resonite_id, headless_accounts.credential, headless_accounts.password, headless_accounts.last_display_name, headless_accounts.last_icon_url, headless_accounts.created_at, headless_accounts.updated_at, headless_accounts.group_id, headless_accounts.created_by, headless_accounts.credential_key_id, headless_accounts.credential_data_key, headless_accounts.encrypted_credential, headless_accounts.encrypted_password, COUNT(*) OVER() AS total_count
 FROM headless_accounts
 WHERE ($1::text[] IS NULL OR group_id = ANY($1::text[]))
 ORDER BY resonite_id
@@ -143,6 +155,10 @@ func (q *Queries) ListHeadlessAccountsPaged(ctx context.Context, arg ListHeadles
 			&i.HeadlessAccount.UpdatedAt,
 			&i.HeadlessAccount.GroupID,
 			&i.HeadlessAccount.CreatedBy,
+			&i.HeadlessAccount.CredentialKeyID,
+			&i.HeadlessAccount.CredentialDataKey,
+			&i.HeadlessAccount.EncryptedCredential,
+			&i.HeadlessAccount.EncryptedPassword,
 			&i.TotalCount,
 		); err != nil {
 			return nil, err
@@ -155,6 +171,40 @@ func (q *Queries) ListHeadlessAccountsPaged(ctx context.Context, arg ListHeadles
 	return items, nil
 }
 
+const rewrapHeadlessAccountCredentials = `-- name: RewrapHeadlessAccountCredentials :execrows
+UPDATE headless_accounts
+SET credential_key_id = $1, credential_data_key = $2,
+    encrypted_credential = $3, encrypted_password = $4,
+    credential = NULL, password = NULL
+WHERE resonite_id = $5 AND credential_key_id IS NOT DISTINCT FROM $6
+`
+
+type RewrapHeadlessAccountCredentialsParams struct {
+	CredentialKeyID     pgtype.Text
+	CredentialDataKey   []byte
+	EncryptedCredential []byte
+	EncryptedPassword   []byte
+	ResoniteID          string
+	OldKeyID            pgtype.Text
+}
+
+// brhcli accounts reencrypt 用. 読んだ時点から鍵が変わっていない (= 並行して認証情報が
+// 更新されていない) 場合だけ書き換える. 平文の行は old_key_id に NULL を渡す.
+func (q *Queries) RewrapHeadlessAccountCredentials(ctx context.Context, arg RewrapHeadlessAccountCredentialsParams) (int64, error) {
+	result, err := q.db.Exec(ctx, rewrapHeadlessAccountCredentials,
+		arg.CredentialKeyID,
+		arg.CredentialDataKey,
+		arg.EncryptedCredential,
+		arg.EncryptedPassword,
+		arg.ResoniteID,
+		arg.OldKeyID,
+	)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
 const updateAccountIconUrl = `-- name: UpdateAccountIconUrl :exec
 UPDATE headless_accounts SET last_icon_url = $2 WHERE resonite_id = $1
 `
@@ -185,16 +235,28 @@ func (q *Queries) UpdateAccountInfo(ctx context.Context, arg UpdateAccountInfoPa
 }
 
 const updateHeadlessAccountCredentials = `-- name: UpdateHeadlessAccountCredentials :exec
-UPDATE headless_accounts SET credential = $2, password = $3 WHERE resonite_id = $1
+UPDATE headless_accounts
+SET credential_key_id = $2, credential_data_key = $3, encrypted_credential = $4, encrypted_password = $5,
+    credential = NULL, password = NULL
+WHERE resonite_id = $1
 `
 
 type UpdateHeadlessAccountCredentialsParams struct {
-	ResoniteID string
-	Credential string
-	Password   string
+	ResoniteID          string
+	CredentialKeyID     pgtype.Text
+	CredentialDataKey   []byte
+	EncryptedCredential []byte
+	EncryptedPassword   []byte
 }
 
+// 暗号化した認証情報で上書きする. 暗号化前の平文の列は消す.
 func (q *Queries) UpdateHeadlessAccountCredentials(ctx context.Context, arg UpdateHeadlessAccountCredentialsParams) error {
-	_, err := q.db.Exec(ctx, updateHeadlessAccountCredentials, arg.ResoniteID, arg.Credential, arg.Password)
+	_, err := q.db.Exec(ctx, updateHeadlessAccountCredentials,
+		arg.ResoniteID,
+		arg.CredentialKeyID,
+		arg.CredentialDataKey,
+		arg.EncryptedCredential,
+		arg.EncryptedPassword,
+	)
 	return err
 }
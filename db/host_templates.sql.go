@@ -0,0 +1,216 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.30.0
+// source: host_templates.sql
+
+package db
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+const createHostTemplate = `-- name: CreateHostTemplate :one
+INSERT INTO host_templates (
+    id,
+    group_id,
+    name,
+    description,
+    image_tag,
+    startup_config,
+    auto_update_policy,
+    memo,
+    container_settings,
+    auto_restart_policy,
+    auto_restart_max_retries,
+    created_by
+) VALUES (
+    $1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12
+) RETURNING id, group_id, name, description, image_tag, startup_config, auto_update_policy, memo, container_settings, auto_restart_policy, auto_restart_max_retries, created_by, created_at, updated_at
+`
+
+type CreateHostTemplateParams struct {
+	ID                    string
+	GroupID               string
+	Name                  string
+	Description           string
+	ImageTag              string
+	StartupConfig         []byte
+	AutoUpdatePolicy      int32
+	Memo                  string
+	ContainerSettings     []byte
+	AutoRestartPolicy     int32
+	AutoRestartMaxRetries int32
+	CreatedBy             pgtype.Text
+}
+
+func (q *Queries) CreateHostTemplate(ctx context.Context, arg CreateHostTemplateParams) (HostTemplate, error) {
+	row := q.db.QueryRow(ctx, createHostTemplate,
+		arg.ID,
+		arg.GroupID,
+		arg.Name,
+		arg.Description,
+		arg.ImageTag,
+		arg.StartupConfig,
+		arg.AutoUpdatePolicy,
+		arg.Memo,
+		arg.ContainerSettings,
+		arg.AutoRestartPolicy,
+		arg.AutoRestartMaxRetries,
+		arg.CreatedBy,
+	)
+	var i HostTemplate
+	err := row.Scan(
+		&i.ID,
+		&i.GroupID,
+		&i.Name,
+		&i.Description,
+		&i.ImageTag,
+		&i.StartupConfig,
+		&i.AutoUpdatePolicy,
+		&i.Memo,
+		&i.ContainerSettings,
+		&i.AutoRestartPolicy,
+		&i.AutoRestartMaxRetries,
+		&i.CreatedBy,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const deleteHostTemplate = `-- name: DeleteHostTemplate :exec
+DELETE FROM host_templates WHERE id = $1
+`
+
+func (q *Queries) DeleteHostTemplate(ctx context.Context, id string) error {
+	_, err := q.db.Exec(ctx, deleteHostTemplate, id)
+	return err
+}
+
+const getHostTemplate = `-- name: GetHostTemplate :one
+SELECT id, group_id, name, description, image_tag, startup_config, auto_update_policy, memo, container_settings, auto_restart_policy, auto_restart_max_retries, created_by, created_at, updated_at FROM host_templates WHERE id = $1 LIMIT 1
+`
+
+func (q *Queries) GetHostTemplate(ctx context.Context, id string) (HostTemplate, error) {
+	row := q.db.QueryRow(ctx, getHostTemplate, id)
+	var i HostTemplate
+	err := row.Scan(
+		&i.ID,
+		&i.GroupID,
+		&i.Name,
+		&i.Description,
+		&i.ImageTag,
+		&i.StartupConfig,
+		&i.AutoUpdatePolicy,
+		&i.Memo,
+		&i.ContainerSettings,
+		&i.AutoRestartPolicy,
+		&i.AutoRestartMaxRetries,
+		&i.CreatedBy,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const listHostTemplatesByGroup = `-- name: ListHostTemplatesByGroup :many
+SELECT id, group_id, name, description, image_tag, startup_config, auto_update_policy, memo, container_settings, auto_restart_policy, auto_restart_max_retries, created_by, created_at, updated_at FROM host_templates WHERE group_id = $1 ORDER BY name ASC
+`
+
+func (q *Queries) ListHostTemplatesByGroup(ctx context.Context, groupID string) ([]HostTemplate, error) {
+	rows, err := q.db.Query(ctx, listHostTemplatesByGroup, groupID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []HostTemplate
+	for rows.Next() {
+		var i HostTemplate
+		if err := rows.Scan(
+			&i.ID,
+			&i.GroupID,
+			&i.Name,
+			&i.Description,
+			&i.ImageTag,
+			&i.StartupConfig,
+			&i.AutoUpdatePolicy,
+			&i.Memo,
+			&i.ContainerSettings,
+			&i.AutoRestartPolicy,
+			&i.AutoRestartMaxRetries,
+			&i.CreatedBy,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const updateHostTemplate = `-- name: UpdateHostTemplate :one
+UPDATE host_templates
+SET name = COALESCE($1, name),
+    description = COALESCE($2, description),
+    image_tag = COALESCE($3, image_tag),
+    startup_config = COALESCE($4, startup_config),
+    auto_update_policy = COALESCE($5, auto_update_policy),
+    memo = COALESCE($6, memo),
+    container_settings = COALESCE($7, container_settings),
+    auto_restart_policy = COALESCE($8, auto_restart_policy),
+    auto_restart_max_retries = COALESCE($9, auto_restart_max_retries)
+WHERE id = $10
+RETURNING id, group_id, name, description, image_tag, startup_config, auto_update_policy, memo, container_settings, auto_restart_policy, auto_restart_max_retries, created_by, created_at, updated_at
+`
+
+type UpdateHostTemplateParams struct {
+	Name                  pgtype.Text
+	Description           pgtype.Text
+	ImageTag              pgtype.Text
+	StartupConfig         []byte
+	AutoUpdatePolicy      pgtype.Int4
+	Memo                  pgtype.Text
+	ContainerSettings     []byte
+	AutoRestartPolicy     pgtype.Int4
+	AutoRestartMaxRetries pgtype.Int4
+	ID                    string
+}
+
+func (q *Queries) UpdateHostTemplate(ctx context.Context, arg UpdateHostTemplateParams) (HostTemplate, error) {
+	row := q.db.QueryRow(ctx, updateHostTemplate,
+		arg.Name,
+		arg.Description,
+		arg.ImageTag,
+		arg.StartupConfig,
+		arg.AutoUpdatePolicy,
+		arg.Memo,
+		arg.ContainerSettings,
+		arg.AutoRestartPolicy,
+		arg.AutoRestartMaxRetries,
+		arg.ID,
+	)
+	var i HostTemplate
+	err := row.Scan(
+		&i.ID,
+		&i.GroupID,
+		&i.Name,
+		&i.Description,
+		&i.ImageTag,
+		&i.StartupConfig,
+		&i.AutoUpdatePolicy,
+		&i.Memo,
+		&i.ContainerSettings,
+		&i.AutoRestartPolicy,
+		&i.AutoRestartMaxRetries,
+		&i.CreatedBy,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
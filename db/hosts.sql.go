@@ -26,10 +26,13 @@ INSERT INTO hosts (
     auto_update_policy,
     memo,
     instance_count,
-    group_id
+    group_id,
+    container_settings,
+    auto_restart_policy,
+    auto_restart_max_retries
 ) VALUES (
-    $1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14
-) RETURNING id, name, status, account_id, created_by, last_startup_config, last_startup_config_schema_version, connector_type, connect_string, started_at, memo, auto_update_policy, created_at, updated_at, instance_count, group_id
+    $1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17
+) RETURNING id, name, status, account_id, created_by, last_startup_config, last_startup_config_schema_version, connector_type, connect_string, started_at, memo, auto_update_policy, created_at, updated_at, instance_count, group_id, container_settings, auto_restart_policy, auto_restart_max_retries, crash_count, last_crashed_at, auto_restart_attempts, auto_restart_last_attempt_at, auto_restart_suspended
 `
 
 type CreateHostParams struct {
@@ -47,6 +50,9 @@ type CreateHostParams struct {
 	Memo                           pgtype.Text
 	InstanceCount                  int32
 	GroupID                        string
+	ContainerSettings              []byte
+	AutoRestartPolicy              int32
+	AutoRestartMaxRetries          int32
 }
 
 func (q *Queries) CreateHost(ctx context.Context, arg CreateHostParams) (Host, error) {
@@ -65,6 +71,9 @@ func (q *Queries) CreateHost(ctx context.Context, arg CreateHostParams) (Host, e
 		arg.Memo,
 		arg.InstanceCount,
 		arg.GroupID,
+		arg.ContainerSettings,
+		arg.AutoRestartPolicy,
+		arg.AutoRestartMaxRetries,
 	)
 	var i Host
 	err := row.Scan(
@@ -84,6 +93,14 @@ func (q *Queries) CreateHost(ctx context.Context, arg CreateHostParams) (Host, e
 		&i.UpdatedAt,
 		&i.InstanceCount,
 		&i.GroupID,
+		&i.ContainerSettings,
+		&i.AutoRestartPolicy,
+		&i.AutoRestartMaxRetries,
+		&i.CrashCount,
+		&i.LastCrashedAt,
+		&i.AutoRestartAttempts,
+		&i.AutoRestartLastAttemptAt,
+		&i.AutoRestartSuspended,
 	)
 	return i, err
 }
@@ -98,7 +115,7 @@ func (q *Queries) DeleteHost(ctx context.Context, id string) error {
 }
 
 const getHost = `-- name: GetHost :one
-SELECT id, name, status, account_id, created_by, last_startup_config, last_startup_config_schema_version, connector_type, connect_string, started_at, memo, auto_update_policy, created_at, updated_at, instance_count, group_id FROM hosts WHERE id = $1 LIMIT 1
+SELECT id, name, status, account_id, created_by, last_startup_config, last_startup_config_schema_version, connector_type, connect_string, started_at, memo, auto_update_policy, created_at, updated_at, instance_count, group_id, container_settings, auto_restart_policy, auto_restart_max_retries, crash_count, last_crashed_at, auto_restart_attempts, auto_restart_last_attempt_at, auto_restart_suspended FROM hosts WHERE id = $1 LIMIT 1
 `
 
 func (q *Queries) GetHost(ctx context.Context, id string) (Host, error) {
@@ -121,12 +138,20 @@ func (q *Queries) GetHost(ctx context.Context, id string) (Host, error) {
 		&i.UpdatedAt,
 		&i.InstanceCount,
 		&i.GroupID,
+		&i.ContainerSettings,
+		&i.AutoRestartPolicy,
+		&i.AutoRestartMaxRetries,
+		&i.CrashCount,
+		&i.LastCrashedAt,
+		&i.AutoRestartAttempts,
+		&i.AutoRestartLastAttemptAt,
+		&i.AutoRestartSuspended,
 	)
 	return i, err
 }
 
 const getHostByContainerID = `-- name: GetHostByContainerID :one
-SELECT id, name, status, account_id, created_by, last_startup_config, last_startup_config_schema_version, connector_type, connect_string, started_at, memo, auto_update_policy, created_at, updated_at, instance_count, group_id FROM hosts WHERE connect_string LIKE $1 || ':%' LIMIT 1
+SELECT id, name, status, account_id, created_by, last_startup_config, last_startup_config_schema_version, connector_type, connect_string, started_at, memo, auto_update_policy, created_at, updated_at, instance_count, group_id, container_settings, auto_restart_policy, auto_restart_max_retries, crash_count, last_crashed_at, auto_restart_attempts, auto_restart_last_attempt_at, auto_restart_suspended FROM hosts WHERE connect_string LIKE $1 || ':%' LIMIT 1
 `
 
 func (q *Queries) GetHostByContainerID(ctx context.Context, dollar_1 pgtype.Text) (Host, error) {
@@ -149,6 +174,14 @@ func (q *Queries) GetHostByContainerID(ctx context.Context, dollar_1 pgtype.Text
 		&i.UpdatedAt,
 		&i.InstanceCount,
 		&i.GroupID,
+		&i.ContainerSettings,
+		&i.AutoRestartPolicy,
+		&i.AutoRestartMaxRetries,
+		&i.CrashCount,
+		&i.LastCrashedAt,
+		&i.AutoRestartAttempts,
+		&i.AutoRestartLastAttemptAt,
+		&i.AutoRestartSuspended,
 	)
 	return i, err
 }
@@ -165,7 +198,7 @@ func (q *Queries) IncrementHostInstanceCount(ctx context.Context, id string) (in
 }
 
 const listHosts = `-- name: ListHosts :many
-SELECT id, name, status, account_id, created_by, last_startup_config, last_startup_config_schema_version, connector_type, connect_string, started_at, memo, auto_update_policy, created_at, updated_at, instance_count, group_id FROM hosts ORDER BY started_at DESC
+SELECT id, name, status, account_id, created_by, last_startup_config, last_startup_config_schema_version, connector_type, connect_string, started_at, memo, auto_update_policy, created_at, updated_at, instance_count, group_id, container_settings, auto_restart_policy, auto_restart_max_retries, crash_count, last_crashed_at, auto_restart_attempts, auto_restart_last_attempt_at, auto_restart_suspended FROM hosts ORDER BY started_at DESC
 `
 
 func (q *Queries) ListHosts(ctx context.Context) ([]Host, error) {
@@ -194,6 +227,14 @@ func (q *Queries) ListHosts(ctx context.Context) ([]Host, error) {
 			&i.UpdatedAt,
 			&i.InstanceCount,
 			&i.GroupID,
+			&i.ContainerSettings,
+			&i.AutoRestartPolicy,
+			&i.AutoRestartMaxRetries,
+			&i.CrashCount,
+			&i.LastCrashedAt,
+			&i.AutoRestartAttempts,
+			&i.AutoRestartLastAttemptAt,
+			&i.AutoRestartSuspended,
 		); err != nil {
 			return nil, err
 		}
@@ -206,7 +247,7 @@ func (q *Queries) ListHosts(ctx context.Context) ([]Host, error) {
 }
 
 const listHostsByStatus = `-- name: ListHostsByStatus :many
-SELECT id, name, status, account_id, created_by, last_startup_config, last_startup_config_schema_version, connector_type, connect_string, started_at, memo, auto_update_policy, created_at, updated_at, instance_count, group_id FROM hosts WHERE status = $1 ORDER BY started_at DESC
+SELECT id, name, status, account_id, created_by, last_startup_config, last_startup_config_schema_version, connector_type, connect_string, started_at, memo, auto_update_policy, created_at, updated_at, instance_count, group_id, container_settings, auto_restart_policy, auto_restart_max_retries, crash_count, last_crashed_at, auto_restart_attempts, auto_restart_last_attempt_at, auto_restart_suspended FROM hosts WHERE status = $1 ORDER BY started_at DESC
 `
 
 func (q *Queries) ListHostsByStatus(ctx context.Context, status int32) ([]Host, error) {
@@ -235,6 +276,14 @@ func (q *Queries) ListHostsByStatus(ctx context.Context, status int32) ([]Host,
 			&i.UpdatedAt,
 			&i.InstanceCount,
 			&i.GroupID,
+			&i.ContainerSettings,
+			&i.AutoRestartPolicy,
+			&i.AutoRestartMaxRetries,
+			&i.CrashCount,
+			&i.LastCrashedAt,
+			&i.AutoRestartAttempts,
+			&i.AutoRestartLastAttemptAt,
+			&i.AutoRestartSuspended,
 		); err != nil {
 			return nil, err
 		}
@@ -247,7 +296,7 @@ func (q *Queries) ListHostsByStatus(ctx context.Context, status int32) ([]Host,
 }
 
 const listHostsPaged = `-- name: ListHostsPaged :many
-SELECT hosts.id, hosts.name, hosts.status, hosts.account_id, hosts.created_by, hosts.last_startup_config, hosts.last_startup_config_schema_version, hosts.connector_type, hosts.connect_string, hosts.started_at, hosts.memo, hosts.auto_update_policy, hosts.created_at, hosts.updated_at, hosts.instance_count, hosts.group_id, COUNT(*) OVER() AS total_count
+SELECT hosts.id, hosts.name, hosts.status, hosts.account_id, hosts.created_by, hosts.last_startup_config, hosts.last_startup_config_schema_version, hosts.connector_type, hosts.connect_string, hosts.started_at, hosts.memo, hosts.auto_update_policy, hosts.created_at, hosts.updated_at, hosts.instance_count, hosts.group_id, hosts.container_settings, hosts.auto_restart_policy, hosts.auto_restart_max_retries, hosts.crash_count, hosts.last_crashed_at, hosts.auto_restart_attempts, hosts.auto_restart_last_attempt_at, hosts.auto_restart_suspended, COUNT(*) OVER() AS total_count
 FROM hosts
 WHERE ($1::text[] IS NULL OR group_id = ANY($1::text[]))
 ORDER BY started_at DESC NULLS LAST, id ASC
@@ -294,6 +343,14 @@ func (q *Queries) ListHostsPaged(ctx context.Context, arg ListHostsPagedParams)
 			&i.Host.UpdatedAt,
 			&i.Host.InstanceCount,
 			&i.Host.GroupID,
+			&i.Host.ContainerSettings,
+			&i.Host.AutoRestartPolicy,
+			&i.Host.AutoRestartMaxRetries,
+			&i.Host.CrashCount,
+			&i.Host.LastCrashedAt,
+			&i.Host.AutoRestartAttempts,
+			&i.Host.AutoRestartLastAttemptAt,
+			&i.Host.AutoRestartSuspended,
 			&i.TotalCount,
 		); err != nil {
 			return nil, err
@@ -307,7 +364,7 @@ func (q *Queries) ListHostsPaged(ctx context.Context, arg ListHostsPagedParams)
 }
 
 const listRunningHostsByAccount = `-- name: ListRunningHostsByAccount :many
-SELECT id, name, status, account_id, created_by, last_startup_config, last_startup_config_schema_version, connector_type, connect_string, started_at, memo, auto_update_policy, created_at, updated_at, instance_count, group_id FROM hosts WHERE account_id = $1 AND status = 2 ORDER BY started_at DESC
+SELECT id, name, status, account_id, created_by, last_startup_config, last_startup_config_schema_version, connector_type, connect_string, started_at, memo, auto_update_policy, created_at, updated_at, instance_count, group_id, container_settings, auto_restart_policy, auto_restart_max_retries, crash_count, last_crashed_at, auto_restart_attempts, auto_restart_last_attempt_at, auto_restart_suspended FROM hosts WHERE account_id = $1 AND status = 2 ORDER BY started_at DESC
 `
 
 func (q *Queries) ListRunningHostsByAccount(ctx context.Context, accountID string) ([]Host, error) {
@@ -336,6 +393,14 @@ func (q *Queries) ListRunningHostsByAccount(ctx context.Context, accountID strin
 			&i.UpdatedAt,
 			&i.InstanceCount,
 			&i.GroupID,
+			&i.ContainerSettings,
+			&i.AutoRestartPolicy,
+			&i.AutoRestartMaxRetries,
+			&i.CrashCount,
+			&i.LastCrashedAt,
+			&i.AutoRestartAttempts,
+			&i.AutoRestartLastAttemptAt,
+			&i.AutoRestartSuspended,
 		); err != nil {
 			return nil, err
 		}
@@ -347,6 +412,135 @@ func (q *Queries) ListRunningHostsByAccount(ctx context.Context, accountID strin
 	return items, nil
 }
 
+const recordHostAutoRestartAttempt = `-- name: RecordHostAutoRestartAttempt :one
+UPDATE hosts SET
+    auto_restart_attempts = auto_restart_attempts + 1,
+    auto_restart_last_attempt_at = $1
+WHERE id = $2 AND status = $3 AND auto_restart_attempts = $4
+RETURNING auto_restart_attempts
+`
+
+type RecordHostAutoRestartAttemptParams struct {
+	AttemptedAt      pgtype.Timestamptz
+	ID               string
+	Status           int32
+	ExpectedAttempts int32
+}
+
+// 読み取った時点から status と試行回数が変わっていない場合だけ試行を記録する.
+// 行が返らなければ他のインスタンスかユーザーが先に手を付けているので再起動しない.
+func (q *Queries) RecordHostAutoRestartAttempt(ctx context.Context, arg RecordHostAutoRestartAttemptParams) (int32, error) {
+	row := q.db.QueryRow(ctx, recordHostAutoRestartAttempt,
+		arg.AttemptedAt,
+		arg.ID,
+		arg.Status,
+		arg.ExpectedAttempts,
+	)
+	var auto_restart_attempts int32
+	err := row.Scan(&auto_restart_attempts)
+	return auto_restart_attempts, err
+}
+
+const recordHostCrash = `-- name: RecordHostCrash :exec
+UPDATE hosts SET
+    crash_count = crash_count + 1,
+    last_crashed_at = $1
+WHERE id = $2
+`
+
+type RecordHostCrashParams struct {
+	CrashedAt pgtype.Timestamptz
+	ID        string
+}
+
+func (q *Queries) RecordHostCrash(ctx context.Context, arg RecordHostCrashParams) error {
+	_, err := q.db.Exec(ctx, recordHostCrash, arg.CrashedAt, arg.ID)
+	return err
+}
+
+const resetHostAutoRestartAttemptsIfStable = `-- name: ResetHostAutoRestartAttemptsIfStable :one
+UPDATE hosts SET
+    auto_restart_attempts = CASE
+        WHEN auto_restart_last_attempt_at IS NULL OR auto_restart_last_attempt_at < $1 THEN 0
+        ELSE auto_restart_attempts
+    END,
+    auto_restart_suspended = CASE
+        WHEN auto_restart_last_attempt_at IS NULL OR auto_restart_last_attempt_at < $1 THEN FALSE
+        ELSE auto_restart_suspended
+    END
+WHERE id = $2
+RETURNING id, name, status, account_id, created_by, last_startup_config, last_startup_config_schema_version, connector_type, connect_string, started_at, memo, auto_update_policy, created_at, updated_at, instance_count, group_id, container_settings, auto_restart_policy, auto_restart_max_retries, crash_count, last_crashed_at, auto_restart_attempts, auto_restart_last_attempt_at, auto_restart_suspended
+`
+
+type ResetHostAutoRestartAttemptsIfStableParams struct {
+	StableSince pgtype.Timestamptz
+	ID          string
+}
+
+// 最後の自動再起動が stable_since より前 (= その後しばらく安定稼働していた) なら
+// 連続再起動回数と crash loop による停止をリセットする。
+func (q *Queries) ResetHostAutoRestartAttemptsIfStable(ctx context.Context, arg ResetHostAutoRestartAttemptsIfStableParams) (Host, error) {
+	row := q.db.QueryRow(ctx, resetHostAutoRestartAttemptsIfStable, arg.StableSince, arg.ID)
+	var i Host
+	err := row.Scan(
+		&i.ID,
+		&i.Name,
+		&i.Status,
+		&i.AccountID,
+		&i.CreatedBy,
+		&i.LastStartupConfig,
+		&i.LastStartupConfigSchemaVersion,
+		&i.ConnectorType,
+		&i.ConnectString,
+		&i.StartedAt,
+		&i.Memo,
+		&i.AutoUpdatePolicy,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.InstanceCount,
+		&i.GroupID,
+		&i.ContainerSettings,
+		&i.AutoRestartPolicy,
+		&i.AutoRestartMaxRetries,
+		&i.CrashCount,
+		&i.LastCrashedAt,
+		&i.AutoRestartAttempts,
+		&i.AutoRestartLastAttemptAt,
+		&i.AutoRestartSuspended,
+	)
+	return i, err
+}
+
+const suspendHostAutoRestart = `-- name: SuspendHostAutoRestart :exec
+UPDATE hosts SET auto_restart_suspended = TRUE WHERE id = $1
+`
+
+func (q *Queries) SuspendHostAutoRestart(ctx context.Context, id string) error {
+	_, err := q.db.Exec(ctx, suspendHostAutoRestart, id)
+	return err
+}
+
+const updateHostAutoRestartPolicy = `-- name: UpdateHostAutoRestartPolicy :exec
+UPDATE hosts SET
+    auto_restart_policy = $2,
+    auto_restart_max_retries = $3,
+    auto_restart_attempts = 0,
+    auto_restart_suspended = FALSE
+WHERE id = $1
+`
+
+type UpdateHostAutoRestartPolicyParams struct {
+	ID                    string
+	AutoRestartPolicy     int32
+	AutoRestartMaxRetries int32
+}
+
+// ポリシーを変更したら連続再起動回数と crash loop による停止もリセットする。
+func (q *Queries) UpdateHostAutoRestartPolicy(ctx context.Context, arg UpdateHostAutoRestartPolicyParams) error {
+	_, err := q.db.Exec(ctx, updateHostAutoRestartPolicy, arg.ID, arg.AutoRestartPolicy, arg.AutoRestartMaxRetries)
+	return err
+}
+
 const updateHostAutoUpdatePolicy = `-- name: UpdateHostAutoUpdatePolicy :exec
 UPDATE hosts SET auto_update_policy = $2 WHERE id = $1
 `
@@ -375,6 +569,20 @@ func (q *Queries) UpdateHostConnectString(ctx context.Context, arg UpdateHostCon
 	return err
 }
 
+const updateHostContainerSettings = `-- name: UpdateHostContainerSettings :exec
+UPDATE hosts SET container_settings = $2 WHERE id = $1
+`
+
+type UpdateHostContainerSettingsParams struct {
+	ID                string
+	ContainerSettings []byte
+}
+
+func (q *Queries) UpdateHostContainerSettings(ctx context.Context, arg UpdateHostContainerSettingsParams) error {
+	_, err := q.db.Exec(ctx, updateHostContainerSettings, arg.ID, arg.ContainerSettings)
+	return err
+}
+
 const updateHostLastStartupConfig = `-- name: UpdateHostLastStartupConfig :exec
 UPDATE hosts SET last_startup_config = $2 WHERE id = $1
 `
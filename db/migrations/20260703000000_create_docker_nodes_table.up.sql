@@ -0,0 +1,23 @@
+-- headless host を起動できる Docker daemon (= node) の登録先.
+-- controller 自身の Docker daemon (DOCKER_HOST / unix socket) は暗黙の "local" node として
+-- 扱い、このテーブルには載せない. local 以外の node 上の host は connect_string が
+-- "<node_id>/<container_id>:<port>" 形式になる.
+CREATE TABLE docker_nodes (
+    id TEXT PRIMARY KEY,
+    name TEXT NOT NULL UNIQUE,
+    endpoint TEXT NOT NULL, -- tcp://host:2376 / ssh://user@host
+    rpc_address TEXT, -- headless container の gRPC を dial するホスト名. NULL なら endpoint の host 部分
+    tls_ca_cert_path TEXT, -- tcp+TLS 用. controller のファイルシステム上のパス
+    tls_cert_path TEXT,
+    tls_key_path TEXT,
+    fluentd_address TEXT, -- NULL なら CONTAINER_LOGS_FLUENTD_ADDRESS
+    max_hosts INTEGER NOT NULL DEFAULT 0, -- 0 は無制限
+    enabled BOOLEAN NOT NULL DEFAULT TRUE, -- false の node は新規 host の配置先に選ばれない
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE TRIGGER update_docker_nodes_modtime
+BEFORE UPDATE ON docker_nodes
+FOR EACH ROW
+EXECUTE PROCEDURE update_timestamp();
@@ -0,0 +1,3 @@
+-- ホストのコンテナに適用するリソース制限 / 配置設定 (hdlctrl.v1.HeadlessHostContainerSettings の protojson).
+-- '{}' は制限なし.
+ALTER TABLE hosts ADD COLUMN container_settings JSONB NOT NULL DEFAULT '{}';
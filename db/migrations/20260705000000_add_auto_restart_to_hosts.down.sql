@@ -0,0 +1,7 @@
+ALTER TABLE hosts DROP COLUMN auto_restart_suspended;
+ALTER TABLE hosts DROP COLUMN auto_restart_last_attempt_at;
+ALTER TABLE hosts DROP COLUMN auto_restart_attempts;
+ALTER TABLE hosts DROP COLUMN last_crashed_at;
+ALTER TABLE hosts DROP COLUMN crash_count;
+ALTER TABLE hosts DROP COLUMN auto_restart_max_retries;
+ALTER TABLE hosts DROP COLUMN auto_restart_policy;
@@ -0,0 +1,12 @@
+-- クラッシュ時の自動再起動ポリシー (entity.HostAutoRestartPolicy). 0 は NEVER 扱い.
+ALTER TABLE hosts ADD COLUMN auto_restart_policy INT NOT NULL DEFAULT 0;
+-- 連続で自動再起動する上限. 0 はサーバー設定の crash loop 判定回数に従う.
+ALTER TABLE hosts ADD COLUMN auto_restart_max_retries INT NOT NULL DEFAULT 0;
+-- 表示用の累計クラッシュ回数.
+ALTER TABLE hosts ADD COLUMN crash_count INT NOT NULL DEFAULT 0;
+ALTER TABLE hosts ADD COLUMN last_crashed_at TIMESTAMP WITH TIME ZONE;
+-- 安定稼働に戻るまでの連続自動再起動回数と、最後に試みた時刻 (backoff / crash loop 判定用).
+ALTER TABLE hosts ADD COLUMN auto_restart_attempts INT NOT NULL DEFAULT 0;
+ALTER TABLE hosts ADD COLUMN auto_restart_last_attempt_at TIMESTAMP WITH TIME ZONE;
+-- crash loop と判定して自動再起動を止めている.
+ALTER TABLE hosts ADD COLUMN auto_restart_suspended BOOLEAN NOT NULL DEFAULT FALSE;
@@ -0,0 +1,2 @@
+-- host のクラッシュ後、host が RUNNING に戻ったら同じ startup_parameters で起動し直す.
+ALTER TABLE sessions ADD COLUMN restore_on_crash BOOLEAN NOT NULL DEFAULT FALSE;
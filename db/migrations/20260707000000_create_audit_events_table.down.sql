@@ -0,0 +1,7 @@
+ALTER TABLE role_permissions DISABLE TRIGGER protect_builtin_role_permissions_trg;
+
+DELETE FROM role_permissions WHERE permission_key IN ('group:audit.read', 'system:audit.read');
+
+ALTER TABLE role_permissions ENABLE TRIGGER protect_builtin_role_permissions_trg;
+
+DROP TABLE IF EXISTS audit_events;
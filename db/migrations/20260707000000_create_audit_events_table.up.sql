@@ -0,0 +1,31 @@
+-- 変更系 RPC の監査ログ.
+-- user_id / group_id / resource_id には FK を貼らない: ユーザーやリソースが削除されても
+-- 「誰が何をしたか」を残すため.
+CREATE TABLE audit_events (
+    id BIGSERIAL PRIMARY KEY,
+    occurred_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    user_id TEXT, -- 実行ユーザー (未認証の RPC では NULL)
+    procedure TEXT NOT NULL, -- connect の procedure 名 (/hdlctrl.v1.ControllerService/StopSession)
+    resource_type TEXT NOT NULL, -- domain/entity/audit_event.go の AuditResourceType
+    resource_id TEXT, -- 作成前で ID が決まっていない場合は NULL
+    group_id TEXT, -- 権限判定に使われたグループ (system 権限のみの操作では NULL)
+    request_summary JSONB NOT NULL DEFAULT '{}'::jsonb, -- パスワード等を伏せたリクエスト (protojson)
+    outcome TEXT NOT NULL, -- 'ok' または connect のエラーコード (permission_denied 等)
+    error_message TEXT,
+    remote_addr TEXT
+);
+
+CREATE INDEX idx_audit_events_occurred_at ON audit_events (occurred_at DESC, id DESC);
+CREATE INDEX idx_audit_events_group ON audit_events (group_id, occurred_at DESC) WHERE group_id IS NOT NULL;
+CREATE INDEX idx_audit_events_user ON audit_events (user_id, occurred_at DESC) WHERE user_id IS NOT NULL;
+CREATE INDEX idx_audit_events_resource ON audit_events (resource_type, resource_id, occurred_at DESC);
+
+-- 監査ログ閲覧権限を seed ロールに追加する.
+-- builtin ロールの role_permissions は保護トリガーで変更できないため一時的に外す.
+ALTER TABLE role_permissions DISABLE TRIGGER protect_builtin_role_permissions_trg;
+
+INSERT INTO role_permissions (role_id, permission_key) VALUES
+    ('seed-admin', 'group:audit.read'),
+    ('seed-system-admin', 'system:audit.read');
+
+ALTER TABLE role_permissions ENABLE TRIGGER protect_builtin_role_permissions_trg;
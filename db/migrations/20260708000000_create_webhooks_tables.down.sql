@@ -0,0 +1,8 @@
+ALTER TABLE role_permissions DISABLE TRIGGER protect_builtin_role_permissions_trg;
+
+DELETE FROM role_permissions WHERE permission_key = 'group:webhooks.manage';
+
+ALTER TABLE role_permissions ENABLE TRIGGER protect_builtin_role_permissions_trg;
+
+DROP TABLE IF EXISTS webhook_deliveries;
+DROP TABLE IF EXISTS webhook_subscriptions;
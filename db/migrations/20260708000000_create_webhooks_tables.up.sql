@@ -0,0 +1,50 @@
+-- グループごとの Webhook 通知先.
+-- notification bus のイベントのうち event_types に一致するものを url に POST する.
+CREATE TABLE webhook_subscriptions (
+    id TEXT PRIMARY KEY,
+    group_id TEXT NOT NULL REFERENCES groups(id) ON DELETE CASCADE,
+    name TEXT NOT NULL,
+    url TEXT NOT NULL,
+    format TEXT NOT NULL, -- 'generic' / 'discord' / 'slack'
+    event_types TEXT[] NOT NULL DEFAULT '{}', -- domain/entity/webhook.go の WebhookEventType. 空なら全イベント
+    secret TEXT NOT NULL, -- HMAC-SHA256 署名鍵
+    enabled BOOLEAN NOT NULL DEFAULT TRUE,
+    created_by TEXT, -- users.id (削除されても subscription は残す)
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX idx_webhook_subscriptions_group ON webhook_subscriptions (group_id) WHERE enabled;
+
+CREATE TRIGGER update_webhook_subscriptions_modtime
+BEFORE UPDATE ON webhook_subscriptions
+FOR EACH ROW
+EXECUTE PROCEDURE update_timestamp();
+
+-- 送信キュー兼履歴. body は enqueue 時点の format で整形済みのものを持つ.
+CREATE TABLE webhook_deliveries (
+    id BIGSERIAL PRIMARY KEY,
+    subscription_id TEXT NOT NULL REFERENCES webhook_subscriptions(id) ON DELETE CASCADE,
+    event_type TEXT NOT NULL,
+    body JSONB NOT NULL,
+    status INTEGER NOT NULL DEFAULT 0, -- 0:PENDING / 1:SENDING / 2:SUCCEEDED / 3:FAILED
+    attempts INTEGER NOT NULL DEFAULT 0,
+    next_attempt_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    claimed_at TIMESTAMP WITH TIME ZONE,
+    last_status_code INTEGER, -- 最後の応答の HTTP status (接続できなかった場合は NULL)
+    last_error TEXT,
+    delivered_at TIMESTAMP WITH TIME ZONE,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX idx_webhook_deliveries_due ON webhook_deliveries (next_attempt_at) WHERE status = 0;
+CREATE INDEX idx_webhook_deliveries_sending ON webhook_deliveries (claimed_at) WHERE status = 1;
+CREATE INDEX idx_webhook_deliveries_subscription ON webhook_deliveries (subscription_id, created_at DESC);
+
+-- Webhook 管理権限を seed ロールに追加する.
+ALTER TABLE role_permissions DISABLE TRIGGER protect_builtin_role_permissions_trg;
+
+INSERT INTO role_permissions (role_id, permission_key) VALUES
+    ('seed-admin', 'group:webhooks.manage');
+
+ALTER TABLE role_permissions ENABLE TRIGGER protect_builtin_role_permissions_trg;
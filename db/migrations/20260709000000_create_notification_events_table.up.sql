@@ -0,0 +1,27 @@
+-- notification bus に publish されたイベントの履歴.
+-- 再接続時の取りこぼし再送 (SubscribeNotifications の last_event_id) と通知 inbox に使う.
+-- 保持期間 / 件数の上限を超えた古い行は worker.NotificationHistoryPruner が削除する.
+CREATE TABLE notification_events (
+    seq BIGSERIAL PRIMARY KEY, -- NotificationEvent.id として配る連番
+    target_user_id TEXT, -- PublishTo の宛先 users.id. NULL なら全 subscriber 向け
+    host_id TEXT, -- 認可単位のホスト (ホスト削除後も履歴は残す)
+    group_id TEXT, -- 記録時点での host_id の所属グループ
+    scope TEXT, -- 受信に必要な権限の種類 ('host' / 'session'). NULL なら権限不要
+    inbox BOOLEAN NOT NULL DEFAULT FALSE, -- 通知 inbox に表示するイベントか
+    payload JSONB NOT NULL, -- NotificationEvent の protojson
+    occurred_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX idx_notification_events_inbox ON notification_events (seq DESC) WHERE inbox;
+CREATE INDEX idx_notification_events_created_at ON notification_events (created_at);
+
+-- inbox の既読状態. 行があれば既読.
+CREATE TABLE notification_reads (
+    user_id TEXT NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    event_seq BIGINT NOT NULL REFERENCES notification_events(seq) ON DELETE CASCADE,
+    read_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (user_id, event_seq)
+);
+
+CREATE INDEX idx_notification_reads_event ON notification_reads (event_seq);
@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS host_upgrade_drains;
+DROP TABLE IF EXISTS controller_instances;
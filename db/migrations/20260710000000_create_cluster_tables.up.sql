@@ -0,0 +1,16 @@
+-- 複数 controller 構成 (CLUSTER_ENABLED) で稼働中のインスタンス.
+-- 各インスタンスが heartbeat_at を定期的に更新し、生存しているインスタンスの集合で
+-- ホスト / docker node ごとのイベントストリームの担当を分ける.
+CREATE TABLE controller_instances (
+    id TEXT PRIMARY KEY,
+    started_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    heartbeat_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+-- 自動アップデートのため drain 中のホスト. drain を管理するのは leader の
+-- HostUpgradeOrchestrator だけなので、他のインスタンスはここを見てセッション開始を拒否する.
+CREATE TABLE host_upgrade_drains (
+    host_id TEXT PRIMARY KEY REFERENCES hosts(id) ON DELETE CASCADE,
+    target_tag TEXT NOT NULL,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
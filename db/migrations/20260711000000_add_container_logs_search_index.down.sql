@@ -0,0 +1 @@
+DROP INDEX IF EXISTS idx_container_logs_log_trgm;
@@ -0,0 +1,4 @@
+-- ログ本文の部分一致検索 (SearchHeadlessHostLogs) 用のトライグラムインデックス
+CREATE EXTENSION IF NOT EXISTS pg_trgm;
+
+CREATE INDEX IF NOT EXISTS idx_container_logs_log_trgm ON container_logs USING gin ((data->>'log') gin_trgm_ops);
@@ -0,0 +1,3 @@
+ALTER TABLE groups DROP COLUMN IF EXISTS log_retention_days;
+
+DROP TABLE IF EXISTS container_log_archives;
@@ -0,0 +1,18 @@
+-- 保持期間を過ぎて blob store に退避したホストのログ (インスタンス単位).
+-- object_key のオブジェクトは container_logs の行を id 順に並べた NDJSON を gzip したもの.
+CREATE TABLE container_log_archives (
+    host_id TEXT NOT NULL REFERENCES hosts(id) ON DELETE CASCADE,
+    instance_id INTEGER NOT NULL,
+    object_key TEXT NOT NULL,
+    first_log_at TIMESTAMP WITHOUT TIME ZONE NOT NULL,
+    last_log_at TIMESTAMP WITHOUT TIME ZONE NOT NULL,
+    log_count BIGINT NOT NULL,
+    -- 退避した範囲. この id 以下の行を container_logs から削除している
+    last_log_id BIGINT NOT NULL,
+    size_bytes BIGINT NOT NULL,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (host_id, instance_id)
+);
+
+-- グループごとのログの保持日数. NULL なら CONTAINER_LOG_RETENTION_DAYS に従う
+ALTER TABLE groups ADD COLUMN log_retention_days INTEGER CHECK (log_retention_days > 0);
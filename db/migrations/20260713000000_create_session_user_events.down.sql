@@ -0,0 +1,3 @@
+DROP INDEX IF EXISTS sessions_started_at_idx;
+
+DROP TABLE IF EXISTS session_user_events;
@@ -0,0 +1,17 @@
+-- セッションへのユーザーの出入り (host event の UserJoinedSession / UserLeftSession).
+-- 出席や同時接続数の集計に使う. セッションを削除すると履歴も削除される.
+CREATE TABLE session_user_events (
+    id BIGSERIAL PRIMARY KEY,
+    session_id TEXT NOT NULL REFERENCES sessions(id) ON DELETE CASCADE,
+    host_id TEXT NOT NULL,
+    -- host event の id. 同じ event が再送されても 1 行にする
+    event_id TEXT NOT NULL,
+    kind SMALLINT NOT NULL, -- domain/entity/session_user_event.go のenum
+    user_id TEXT NOT NULL,
+    user_name TEXT NOT NULL,
+    occurred_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    UNIQUE (host_id, event_id)
+);
+
+CREATE INDEX session_user_events_session_id_idx ON session_user_events(session_id, occurred_at);
+CREATE INDEX sessions_started_at_idx ON sessions(started_at);
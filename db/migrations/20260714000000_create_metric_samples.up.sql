@@ -0,0 +1,17 @@
+-- ホスト / セッションのメトリクスの時系列 (worker/metrics_sampler.go が記録する).
+-- resolution ごとに 1 行 = 1 バケット. raw は 1 サンプル 1 行で、
+-- 1m / 1h は下の解像度から集計した平均 / 最小 / 最大を持つ.
+CREATE TABLE metric_samples (
+    kind SMALLINT NOT NULL, -- domain/entity/metric_sample.go のenum
+    target_id TEXT NOT NULL, -- kind に応じて host id か session id
+    resolution SMALLINT NOT NULL, -- domain/entity/metric_sample.go のenum
+    bucket_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    avg_value REAL NOT NULL,
+    min_value REAL NOT NULL,
+    max_value REAL NOT NULL,
+    sample_count INTEGER NOT NULL,
+    PRIMARY KEY (kind, target_id, resolution, bucket_at)
+);
+
+-- 集計と保持期間切れの削除用
+CREATE INDEX metric_samples_resolution_bucket_at_idx ON metric_samples(resolution, bucket_at);
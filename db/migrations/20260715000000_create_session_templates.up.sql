@@ -0,0 +1,18 @@
+-- グループごとのセッション起動テンプレート.
+-- parameters は headless.v1.WorldStartupParameters を protojson で保存したもの.
+CREATE TABLE session_templates (
+    id TEXT PRIMARY KEY,
+    group_id TEXT NOT NULL REFERENCES groups(id) ON DELETE CASCADE,
+    name TEXT NOT NULL,
+    description TEXT NOT NULL DEFAULT '',
+    parameters JSONB NOT NULL,
+    created_by TEXT, -- users.id (削除されてもテンプレートは残す)
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    UNIQUE (group_id, name)
+);
+
+CREATE TRIGGER update_session_templates_modtime
+BEFORE UPDATE ON session_templates
+FOR EACH ROW
+EXECUTE PROCEDURE update_timestamp();
@@ -0,0 +1,24 @@
+-- グループごとのホスト起動テンプレート.
+-- StartHeadlessHost で template_id を指定すると、リクエストで省略した項目をここから埋める.
+CREATE TABLE host_templates (
+    id TEXT PRIMARY KEY,
+    group_id TEXT NOT NULL REFERENCES groups(id) ON DELETE CASCADE,
+    name TEXT NOT NULL,
+    description TEXT NOT NULL DEFAULT '',
+    image_tag TEXT NOT NULL DEFAULT '', -- 空なら起動時点の最新リリース
+    startup_config JSONB NOT NULL, -- headless.v1.StartupConfig の protojson
+    auto_update_policy INTEGER NOT NULL DEFAULT 0,
+    memo TEXT NOT NULL DEFAULT '',
+    container_settings JSONB NOT NULL DEFAULT '{}', -- hosts.container_settings と同じ形式
+    auto_restart_policy INTEGER NOT NULL DEFAULT 0,
+    auto_restart_max_retries INTEGER NOT NULL DEFAULT 0,
+    created_by TEXT, -- users.id (削除されてもテンプレートは残す)
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    UNIQUE (group_id, name)
+);
+
+CREATE TRIGGER update_host_templates_modtime
+BEFORE UPDATE ON host_templates
+FOR EACH ROW
+EXECUTE PROCEDURE update_timestamp();
@@ -0,0 +1 @@
+DROP TABLE IF EXISTS scheduled_session_operation_runs;
@@ -0,0 +1,15 @@
+-- 予約操作の実行履歴. 繰り返し予約は実行後に PENDING へ戻るので、過去の回はここに残す.
+-- status 5 (PAUSED) が scheduled_session_operations に追加されるが、列の変更は無い.
+CREATE TABLE scheduled_session_operation_runs (
+    id BIGSERIAL PRIMARY KEY,
+    operation_id UUID NOT NULL REFERENCES scheduled_session_operations(id) ON DELETE CASCADE,
+    scheduled_for TIMESTAMP WITH TIME ZONE NOT NULL, -- その回の next_fire_at
+    started_at TIMESTAMP WITH TIME ZONE, -- SKIPPED / MISSED では NULL
+    finished_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    outcome INTEGER NOT NULL, -- 1:SUCCEEDED / 2:FAILED / 3:SKIPPED / 4:MISSED
+    error TEXT,
+    instance_id TEXT
+);
+
+CREATE INDEX idx_sched_op_runs_operation
+    ON scheduled_session_operation_runs (operation_id, scheduled_for DESC);
@@ -0,0 +1,10 @@
+-- 暗号化済みの行は SQL では復号できないため、ダウンする前に該当アカウントを削除しておく必要がある
+-- (残っていると NOT NULL 制約で失敗する).
+ALTER TABLE headless_accounts
+    DROP CONSTRAINT headless_accounts_credentials_check,
+    DROP COLUMN credential_key_id,
+    DROP COLUMN credential_data_key,
+    DROP COLUMN encrypted_credential,
+    DROP COLUMN encrypted_password,
+    ALTER COLUMN credential SET NOT NULL,
+    ALTER COLUMN password SET NOT NULL;
@@ -0,0 +1,16 @@
+-- headless account の認証情報をエンベロープ暗号化して保存する.
+-- 鍵はアプリの設定 (CREDENTIAL_ENCRYPTION_KEYS) にあるため、既存行の暗号化は SQL では行わず
+-- `brhcli accounts reencrypt` で行う. それまでの間、既存行は credential / password の平文のまま読める.
+ALTER TABLE headless_accounts
+    ALTER COLUMN credential DROP NOT NULL,
+    ALTER COLUMN password DROP NOT NULL,
+    ADD COLUMN credential_key_id TEXT, -- NULL なら暗号化前の平文の行
+    ADD COLUMN credential_data_key BYTEA, -- credential_key_id の鍵で暗号化したデータ鍵
+    ADD COLUMN encrypted_credential BYTEA,
+    ADD COLUMN encrypted_password BYTEA,
+    ADD CONSTRAINT headless_accounts_credentials_check CHECK (
+        (credential_key_id IS NULL AND credential IS NOT NULL AND password IS NOT NULL)
+        OR (credential_key_id IS NOT NULL AND credential_data_key IS NOT NULL
+            AND encrypted_credential IS NOT NULL AND encrypted_password IS NOT NULL
+            AND credential IS NULL AND password IS NULL)
+    );
@@ -0,0 +1,17 @@
+-- スクリプト / bot 向けの長期 API トークン.
+-- トークンの平文は発行時に一度だけ返し、DB には SHA-256 (hex) のみを保存する.
+CREATE TABLE api_tokens (
+    id TEXT PRIMARY KEY,
+    user_id TEXT NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    name TEXT NOT NULL,
+    token_hash TEXT NOT NULL UNIQUE,
+    token_prefix TEXT NOT NULL, -- 一覧で見分けるためのトークン先頭部分
+    group_id TEXT REFERENCES groups(id) ON DELETE CASCADE, -- NULL なら全グループ
+    permission_keys TEXT[] NOT NULL DEFAULT '{}', -- 空ならユーザーの全権限
+    expires_at TIMESTAMP WITH TIME ZONE, -- NULL なら無期限
+    last_used_at TIMESTAMP WITH TIME ZONE,
+    revoked_at TIMESTAMP WITH TIME ZONE,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX idx_api_tokens_user ON api_tokens (user_id, created_at DESC);
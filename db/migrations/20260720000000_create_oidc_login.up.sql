@@ -0,0 +1,22 @@
+-- OIDC ログイン開始時に発行した state. 認可コードを受け取ったら 1 回だけ消費する.
+-- 複数インスタンスで動かしてもコールバックを別インスタンスが受けられるよう DB に置く.
+CREATE TABLE oidc_login_states (
+    state TEXT PRIMARY KEY,
+    nonce TEXT NOT NULL,
+    code_verifier TEXT NOT NULL, -- PKCE
+    expires_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+-- 外部 IdP のアカウント (issuer + sub) とユーザーの紐付け.
+CREATE TABLE user_identities (
+    issuer TEXT NOT NULL,
+    subject TEXT NOT NULL,
+    user_id TEXT NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    email TEXT, -- 表示用. 紐付けの判定には使わない
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    last_login_at TIMESTAMP WITH TIME ZONE,
+    PRIMARY KEY (issuer, subject)
+);
+
+CREATE INDEX idx_user_identities_user ON user_identities (user_id);
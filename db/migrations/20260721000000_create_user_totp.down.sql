@@ -0,0 +1,3 @@
+DROP TABLE IF EXISTS totp_login_challenges;
+DROP TABLE IF EXISTS user_totp_recovery_codes;
+DROP TABLE IF EXISTS user_totp;
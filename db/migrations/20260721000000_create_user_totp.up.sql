@@ -0,0 +1,29 @@
+-- TOTP による 2 段階認証. secret はコードの検証に平文が必要なのでハッシュにはできず、
+-- headless account の認証情報と同じ鍵 (CREDENTIAL_ENCRYPTION_KEYS) でエンベロープ暗号化する.
+CREATE TABLE user_totp (
+    user_id TEXT PRIMARY KEY REFERENCES users(id) ON DELETE CASCADE,
+    secret_key_id TEXT NOT NULL,
+    secret_data_key BYTEA NOT NULL, -- secret_key_id の鍵で暗号化されたデータ鍵
+    encrypted_secret BYTEA NOT NULL,
+    last_used_step BIGINT NOT NULL DEFAULT 0, -- 同じコードを 2 回使えないよう最後に通したステップを持つ
+    enabled_at TIMESTAMP WITH TIME ZONE, -- NULL なら登録途中 (最初のコードの確認待ち)
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+-- 端末を失くしたとき用のリカバリーコード. SHA-256 (hex) のみを保存し、1 回ずつしか使えない.
+CREATE TABLE user_totp_recovery_codes (
+    user_id TEXT NOT NULL REFERENCES user_totp(user_id) ON DELETE CASCADE,
+    code_hash TEXT NOT NULL,
+    used_at TIMESTAMP WITH TIME ZONE,
+    PRIMARY KEY (user_id, code_hash)
+);
+
+-- パスワードを確認した後、TOTP の入力を待っているログイン.
+-- 総当たりを防ぐため試行回数を数える.
+CREATE TABLE totp_login_challenges (
+    token_hash TEXT PRIMARY KEY,
+    user_id TEXT NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    attempts INTEGER NOT NULL DEFAULT 0,
+    expires_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
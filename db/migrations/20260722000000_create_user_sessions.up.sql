@@ -0,0 +1,16 @@
+-- ログイン (パスワード / SSO / 2 段階認証) ごとのセッション. refresh token の family にあたる.
+-- refresh token は使うたびに世代を進め、古い世代が使われたら漏洩とみなしてセッションごと失効させる.
+CREATE TABLE user_sessions (
+    id TEXT PRIMARY KEY,
+    user_id TEXT NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    refresh_generation BIGINT NOT NULL DEFAULT 1, -- 最新の refresh token の世代
+    rotated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP, -- 世代を進めた日時
+    user_agent TEXT NOT NULL DEFAULT '',
+    ip_address TEXT NOT NULL DEFAULT '',
+    last_seen_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    expires_at TIMESTAMP WITH TIME ZONE NOT NULL, -- refresh するたびに延びる
+    revoked_at TIMESTAMP WITH TIME ZONE,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX idx_user_sessions_user ON user_sessions (user_id, last_seen_at DESC);
@@ -0,0 +1 @@
+ALTER TABLE oidc_login_states DROP COLUMN link_user_id;
@@ -0,0 +1,2 @@
+-- ログイン中のユーザーが外部アカウントを紐付けるときの state. NULL ならサインイン用.
+ALTER TABLE oidc_login_states ADD COLUMN link_user_id TEXT REFERENCES users(id) ON DELETE CASCADE;
@@ -0,0 +1,2 @@
+-- 消した応答本文は戻せない.
+SELECT 1;
@@ -0,0 +1,5 @@
+-- 送信履歴に残っていた受信側の応答本文を消し、HTTP status だけにする.
+-- 内部のホストに向けた Webhook の応答をグループのメンバーが読めてしまうため.
+UPDATE webhook_deliveries
+SET last_error = substring(last_error FROM '^webhook responded with status [0-9]+')
+WHERE last_error ~ '^webhook responded with status [0-9]+: ';
@@ -8,6 +8,20 @@ import (
 	"github.com/jackc/pgx/v5/pgtype"
 )
 
+type ApiToken struct {
+	ID             string
+	UserID         string
+	Name           string
+	TokenHash      string
+	TokenPrefix    string
+	GroupID        pgtype.Text
+	PermissionKeys []string
+	ExpiresAt      pgtype.Timestamptz
+	LastUsedAt     pgtype.Timestamptz
+	RevokedAt      pgtype.Timestamptz
+	CreatedAt      pgtype.Timestamptz
+}
+
 type AsyncJob struct {
 	ID            pgtype.UUID
 	JobType       int32
@@ -25,6 +39,20 @@ type AsyncJob struct {
 	UpdatedAt     pgtype.Timestamptz
 }
 
+type AuditEvent struct {
+	ID             int64
+	OccurredAt     pgtype.Timestamptz
+	UserID         pgtype.Text
+	Procedure      string
+	ResourceType   string
+	ResourceID     pgtype.Text
+	GroupID        pgtype.Text
+	RequestSummary []byte
+	Outcome        string
+	ErrorMessage   pgtype.Text
+	RemoteAddr     pgtype.Text
+}
+
 type ContainerLog struct {
 	Tag  pgtype.Text
 	Ts   pgtype.Timestamp
@@ -32,12 +60,46 @@ type ContainerLog struct {
 	ID   pgtype.Int8
 }
 
+type ContainerLogArchive struct {
+	HostID     string
+	InstanceID int32
+	ObjectKey  string
+	FirstLogAt pgtype.Timestamp
+	LastLogAt  pgtype.Timestamp
+	LogCount   int64
+	LastLogID  int64
+	SizeBytes  int64
+	CreatedAt  pgtype.Timestamptz
+}
+
+type ControllerInstance struct {
+	ID          string
+	StartedAt   pgtype.Timestamptz
+	HeartbeatAt pgtype.Timestamptz
+}
+
+type DockerNode struct {
+	ID             string
+	Name           string
+	Endpoint       string
+	RpcAddress     pgtype.Text
+	TlsCaCertPath  pgtype.Text
+	TlsCertPath    pgtype.Text
+	TlsKeyPath     pgtype.Text
+	FluentdAddress pgtype.Text
+	MaxHosts       int32
+	Enabled        bool
+	CreatedAt      pgtype.Timestamptz
+	UpdatedAt      pgtype.Timestamptz
+}
+
 type Group struct {
-	ID        string
-	Name      string
-	Type      string
-	CreatedAt pgtype.Timestamptz
-	UpdatedAt pgtype.Timestamptz
+	ID               string
+	Name             string
+	Type             string
+	CreatedAt        pgtype.Timestamptz
+	UpdatedAt        pgtype.Timestamptz
+	LogRetentionDays pgtype.Int4
 }
 
 type GroupMember struct {
@@ -49,15 +111,19 @@ type GroupMember struct {
 }
 
 type HeadlessAccount struct {
-	ResoniteID      string
-	Credential      string
-	Password        string
-	LastDisplayName pgtype.Text
-	LastIconUrl     pgtype.Text
-	CreatedAt       pgtype.Timestamptz
-	UpdatedAt       pgtype.Timestamptz
-	GroupID         string
-	CreatedBy       pgtype.Text
+	ResoniteID          string
+	Credential          pgtype.Text
+	Password            pgtype.Text
+	LastDisplayName     pgtype.Text
+	LastIconUrl         pgtype.Text
+	CreatedAt           pgtype.Timestamptz
+	UpdatedAt           pgtype.Timestamptz
+	GroupID             string
+	CreatedBy           pgtype.Text
+	CredentialKeyID     pgtype.Text
+	CredentialDataKey   []byte
+	EncryptedCredential []byte
+	EncryptedPassword   []byte
 }
 
 type Host struct {
@@ -77,6 +143,14 @@ type Host struct {
 	UpdatedAt                      pgtype.Timestamptz
 	InstanceCount                  int32
 	GroupID                        string
+	ContainerSettings              []byte
+	AutoRestartPolicy              int32
+	AutoRestartMaxRetries          int32
+	CrashCount                     int32
+	LastCrashedAt                  pgtype.Timestamptz
+	AutoRestartAttempts            int32
+	AutoRestartLastAttemptAt       pgtype.Timestamptz
+	AutoRestartSuspended           bool
 }
 
 type HostEventCheckpoint struct {
@@ -85,6 +159,67 @@ type HostEventCheckpoint struct {
 	UpdatedAt   pgtype.Timestamptz
 }
 
+type HostTemplate struct {
+	ID                    string
+	GroupID               string
+	Name                  string
+	Description           string
+	ImageTag              string
+	StartupConfig         []byte
+	AutoUpdatePolicy      int32
+	Memo                  string
+	ContainerSettings     []byte
+	AutoRestartPolicy     int32
+	AutoRestartMaxRetries int32
+	CreatedBy             pgtype.Text
+	CreatedAt             pgtype.Timestamptz
+	UpdatedAt             pgtype.Timestamptz
+}
+
+type HostUpgradeDrain struct {
+	HostID    string
+	TargetTag string
+	CreatedAt pgtype.Timestamptz
+}
+
+type MetricSample struct {
+	Kind        int16
+	TargetID    string
+	Resolution  int16
+	BucketAt    pgtype.Timestamptz
+	AvgValue    float32
+	MinValue    float32
+	MaxValue    float32
+	SampleCount int32
+}
+
+type NotificationEvent struct {
+	Seq          int64
+	TargetUserID pgtype.Text
+	HostID       pgtype.Text
+	GroupID      pgtype.Text
+	Scope        pgtype.Text
+	Inbox        bool
+	Payload      []byte
+	OccurredAt   pgtype.Timestamptz
+	CreatedAt    pgtype.Timestamptz
+}
+
+type NotificationRead struct {
+	UserID   string
+	EventSeq int64
+	ReadAt   pgtype.Timestamptz
+}
+
+type OidcLoginState struct {
+	State        string
+	Nonce        string
+	CodeVerifier string
+	ExpiresAt    pgtype.Timestamptz
+	CreatedAt    pgtype.Timestamptz
+	LinkUserID   pgtype.Text
+}
+
 type RegistrationToken struct {
 	Token          string
 	ResoniteID     string
@@ -128,6 +263,17 @@ type ScheduledSessionOperation struct {
 	UpdatedAt        pgtype.Timestamptz
 }
 
+type ScheduledSessionOperationRun struct {
+	ID           int64
+	OperationID  pgtype.UUID
+	ScheduledFor pgtype.Timestamptz
+	StartedAt    pgtype.Timestamptz
+	FinishedAt   pgtype.Timestamptz
+	Outcome      int32
+	Error        pgtype.Text
+	InstanceID   pgtype.Text
+}
+
 type Session struct {
 	ID                             string
 	Name                           string
@@ -143,6 +289,37 @@ type Session struct {
 	CreatedAt                      pgtype.Timestamptz
 	UpdatedAt                      pgtype.Timestamptz
 	GroupID                        string
+	RestoreOnCrash                 bool
+}
+
+type SessionTemplate struct {
+	ID          string
+	GroupID     string
+	Name        string
+	Description string
+	Parameters  []byte
+	CreatedBy   pgtype.Text
+	CreatedAt   pgtype.Timestamptz
+	UpdatedAt   pgtype.Timestamptz
+}
+
+type SessionUserEvent struct {
+	ID         int64
+	SessionID  string
+	HostID     string
+	EventID    string
+	Kind       int16
+	UserID     string
+	UserName   string
+	OccurredAt pgtype.Timestamptz
+}
+
+type TotpLoginChallenge struct {
+	TokenHash string
+	UserID    string
+	Attempts  int32
+	ExpiresAt pgtype.Timestamptz
+	CreatedAt pgtype.Timestamptz
 }
 
 type User struct {
@@ -153,3 +330,70 @@ type User struct {
 	CreatedAt  pgtype.Timestamptz
 	UpdatedAt  pgtype.Timestamptz
 }
+
+type UserIdentity struct {
+	Issuer      string
+	Subject     string
+	UserID      string
+	Email       pgtype.Text
+	CreatedAt   pgtype.Timestamptz
+	LastLoginAt pgtype.Timestamptz
+}
+
+type UserSession struct {
+	ID                string
+	UserID            string
+	RefreshGeneration int64
+	RotatedAt         pgtype.Timestamptz
+	UserAgent         string
+	IpAddress         string
+	LastSeenAt        pgtype.Timestamptz
+	ExpiresAt         pgtype.Timestamptz
+	RevokedAt         pgtype.Timestamptz
+	CreatedAt         pgtype.Timestamptz
+}
+
+type UserTotp struct {
+	UserID          string
+	SecretKeyID     string
+	SecretDataKey   []byte
+	EncryptedSecret []byte
+	LastUsedStep    int64
+	EnabledAt       pgtype.Timestamptz
+	CreatedAt       pgtype.Timestamptz
+}
+
+type UserTotpRecoveryCode struct {
+	UserID   string
+	CodeHash string
+	UsedAt   pgtype.Timestamptz
+}
+
+type WebhookDelivery struct {
+	ID             int64
+	SubscriptionID string
+	EventType      string
+	Body           []byte
+	Status         int32
+	Attempts       int32
+	NextAttemptAt  pgtype.Timestamptz
+	ClaimedAt      pgtype.Timestamptz
+	LastStatusCode pgtype.Int4
+	LastError      pgtype.Text
+	DeliveredAt    pgtype.Timestamptz
+	CreatedAt      pgtype.Timestamptz
+}
+
+type WebhookSubscription struct {
+	ID         string
+	GroupID    string
+	Name       string
+	Url        string
+	Format     string
+	EventTypes []string
+	Secret     string
+	Enabled    bool
+	CreatedBy  pgtype.Text
+	CreatedAt  pgtype.Timestamptz
+	UpdatedAt  pgtype.Timestamptz
+}
@@ -0,0 +1,298 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.30.0
+// source: notification_events.sql
+
+package db
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+const countUnreadInboxNotifications = `-- name: CountUnreadInboxNotifications :one
+SELECT COUNT(*)
+FROM notification_events
+WHERE inbox
+  AND (
+    target_user_id = $1::text
+    OR (target_user_id IS NULL AND (
+      scope IS NULL
+      OR $2::boolean
+      OR (group_id || '/' || scope) = ANY($3::text[])
+    ))
+  )
+  AND NOT EXISTS (
+    SELECT 1 FROM notification_reads
+    WHERE notification_reads.event_seq = notification_events.seq AND notification_reads.user_id = $1::text
+  )
+`
+
+type CountUnreadInboxNotificationsParams struct {
+	UserID         string
+	ListAll        bool
+	ReadableScopes []string
+}
+
+func (q *Queries) CountUnreadInboxNotifications(ctx context.Context, arg CountUnreadInboxNotificationsParams) (int64, error) {
+	row := q.db.QueryRow(ctx, countUnreadInboxNotifications, arg.UserID, arg.ListAll, arg.ReadableScopes)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
+const createNotificationEvent = `-- name: CreateNotificationEvent :one
+INSERT INTO notification_events (
+    target_user_id,
+    host_id,
+    group_id,
+    scope,
+    inbox,
+    payload,
+    occurred_at
+) VALUES (
+    $1,
+    $2::text,
+    (SELECT hosts.group_id FROM hosts WHERE hosts.id = $2::text),
+    $3,
+    $4,
+    $5,
+    $6
+) RETURNING seq
+`
+
+type CreateNotificationEventParams struct {
+	TargetUserID pgtype.Text
+	HostID       pgtype.Text
+	Scope        pgtype.Text
+	Inbox        bool
+	Payload      []byte
+	OccurredAt   pgtype.Timestamptz
+}
+
+// group_id は host_id の現在の所属グループを記録する (ホストが無ければ NULL)。
+func (q *Queries) CreateNotificationEvent(ctx context.Context, arg CreateNotificationEventParams) (int64, error) {
+	row := q.db.QueryRow(ctx, createNotificationEvent,
+		arg.TargetUserID,
+		arg.HostID,
+		arg.Scope,
+		arg.Inbox,
+		arg.Payload,
+		arg.OccurredAt,
+	)
+	var seq int64
+	err := row.Scan(&seq)
+	return seq, err
+}
+
+const deleteNotificationEventsBefore = `-- name: DeleteNotificationEventsBefore :execrows
+DELETE FROM notification_events WHERE created_at < $1::timestamptz
+`
+
+func (q *Queries) DeleteNotificationEventsBefore(ctx context.Context, before pgtype.Timestamptz) (int64, error) {
+	result, err := q.db.Exec(ctx, deleteNotificationEventsBefore, before)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const deleteNotificationEventsBeyondLatest = `-- name: DeleteNotificationEventsBeyondLatest :execrows
+DELETE FROM notification_events
+WHERE seq <= (
+    SELECT seq FROM notification_events ORDER BY seq DESC OFFSET $1::int LIMIT 1
+)
+`
+
+// 新しい順に keep_count 件だけ残して削除する。
+func (q *Queries) DeleteNotificationEventsBeyondLatest(ctx context.Context, keepCount int32) (int64, error) {
+	result, err := q.db.Exec(ctx, deleteNotificationEventsBeyondLatest, keepCount)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const getOldestNotificationEventSeq = `-- name: GetOldestNotificationEventSeq :one
+SELECT COALESCE(MIN(seq), 0)::bigint AS oldest_seq FROM notification_events
+`
+
+// 履歴が空なら 0。
+func (q *Queries) GetOldestNotificationEventSeq(ctx context.Context) (int64, error) {
+	row := q.db.QueryRow(ctx, getOldestNotificationEventSeq)
+	var oldest_seq int64
+	err := row.Scan(&oldest_seq)
+	return oldest_seq, err
+}
+
+const listInboxNotifications = `-- name: ListInboxNotifications :many
+SELECT notification_events.seq, notification_events.target_user_id, notification_events.host_id, notification_events.group_id, notification_events.scope, notification_events.inbox, notification_events.payload, notification_events.occurred_at, notification_events.created_at,
+       (notification_reads.user_id IS NOT NULL)::boolean AS is_read,
+       COUNT(*) OVER() AS total_count
+FROM notification_events
+LEFT JOIN notification_reads
+  ON notification_reads.event_seq = notification_events.seq AND notification_reads.user_id = $1::text
+WHERE notification_events.inbox
+  AND (
+    notification_events.target_user_id = $1::text
+    OR (notification_events.target_user_id IS NULL AND (
+      notification_events.scope IS NULL
+      OR $2::boolean
+      OR (notification_events.group_id || '/' || notification_events.scope) = ANY($3::text[])
+    ))
+  )
+  AND (NOT $4::boolean OR notification_reads.user_id IS NULL)
+ORDER BY notification_events.seq DESC
+LIMIT $6::int OFFSET $5::int
+`
+
+type ListInboxNotificationsParams struct {
+	UserID         string
+	ListAll        bool
+	ReadableScopes []string
+	UnreadOnly     bool
+	PageOffset     int32
+	PageSize       int32
+}
+
+type ListInboxNotificationsRow struct {
+	NotificationEvent NotificationEvent
+	IsRead            bool
+	TotalCount        int64
+}
+
+// 新しい順. readable_scopes は '<group_id>/<scope>' の配列で、list_all なら scope 付きの全イベントを返す。
+// total_count は全行同じ値が入る (COUNT(*) OVER())。
+func (q *Queries) ListInboxNotifications(ctx context.Context, arg ListInboxNotificationsParams) ([]ListInboxNotificationsRow, error) {
+	rows, err := q.db.Query(ctx, listInboxNotifications,
+		arg.UserID,
+		arg.ListAll,
+		arg.ReadableScopes,
+		arg.UnreadOnly,
+		arg.PageOffset,
+		arg.PageSize,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListInboxNotificationsRow
+	for rows.Next() {
+		var i ListInboxNotificationsRow
+		if err := rows.Scan(
+			&i.NotificationEvent.Seq,
+			&i.NotificationEvent.TargetUserID,
+			&i.NotificationEvent.HostID,
+			&i.NotificationEvent.GroupID,
+			&i.NotificationEvent.Scope,
+			&i.NotificationEvent.Inbox,
+			&i.NotificationEvent.Payload,
+			&i.NotificationEvent.OccurredAt,
+			&i.NotificationEvent.CreatedAt,
+			&i.IsRead,
+			&i.TotalCount,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listNotificationEventsAfter = `-- name: ListNotificationEventsAfter :many
+SELECT seq, target_user_id, host_id, group_id, scope, inbox, payload, occurred_at, created_at
+FROM notification_events
+WHERE seq > $1::bigint
+  AND (target_user_id IS NULL OR target_user_id = $2::text)
+ORDER BY seq ASC
+LIMIT $3::int
+`
+
+type ListNotificationEventsAfterParams struct {
+	AfterSeq int64
+	UserID   string
+	PageSize int32
+}
+
+// 再送用. after_seq より後ろで user_id が受信しうるもの (宛先なし or user_id 宛て) を古い順に返す。
+// 権限による絞り込みは呼び出し側で行う。
+func (q *Queries) ListNotificationEventsAfter(ctx context.Context, arg ListNotificationEventsAfterParams) ([]NotificationEvent, error) {
+	rows, err := q.db.Query(ctx, listNotificationEventsAfter, arg.AfterSeq, arg.UserID, arg.PageSize)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []NotificationEvent
+	for rows.Next() {
+		var i NotificationEvent
+		if err := rows.Scan(
+			&i.Seq,
+			&i.TargetUserID,
+			&i.HostID,
+			&i.GroupID,
+			&i.Scope,
+			&i.Inbox,
+			&i.Payload,
+			&i.OccurredAt,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const markAllNotificationsRead = `-- name: MarkAllNotificationsRead :exec
+INSERT INTO notification_reads (user_id, event_seq)
+SELECT $1::text, seq
+FROM notification_events
+WHERE inbox
+  AND (
+    target_user_id = $1::text
+    OR (target_user_id IS NULL AND (
+      scope IS NULL
+      OR $2::boolean
+      OR (group_id || '/' || scope) = ANY($3::text[])
+    ))
+  )
+ON CONFLICT DO NOTHING
+`
+
+type MarkAllNotificationsReadParams struct {
+	UserID         string
+	ListAll        bool
+	ReadableScopes []string
+}
+
+// ListInboxNotifications と同じ可視範囲を全て既読にする。
+func (q *Queries) MarkAllNotificationsRead(ctx context.Context, arg MarkAllNotificationsReadParams) error {
+	_, err := q.db.Exec(ctx, markAllNotificationsRead, arg.UserID, arg.ListAll, arg.ReadableScopes)
+	return err
+}
+
+const markNotificationsRead = `-- name: MarkNotificationsRead :exec
+INSERT INTO notification_reads (user_id, event_seq)
+SELECT $1::text, seq
+FROM notification_events
+WHERE seq = ANY($2::bigint[]) AND inbox
+ON CONFLICT DO NOTHING
+`
+
+type MarkNotificationsReadParams struct {
+	UserID string
+	Seqs   []int64
+}
+
+// 存在しない / inbox 対象外の seq は無視する。
+func (q *Queries) MarkNotificationsRead(ctx context.Context, arg MarkNotificationsReadParams) error {
+	_, err := q.db.Exec(ctx, markNotificationsRead, arg.UserID, arg.Seqs)
+	return err
+}
@@ -0,0 +1,30 @@
+-- name: CreateApiToken :one
+INSERT INTO api_tokens (
+    id,
+    user_id,
+    name,
+    token_hash,
+    token_prefix,
+    group_id,
+    permission_keys,
+    expires_at
+) VALUES (
+    $1, $2, $3, $4, $5, $6, $7, $8
+) RETURNING *;
+
+-- name: GetApiTokenByHash :one
+SELECT * FROM api_tokens WHERE token_hash = $1 LIMIT 1;
+
+-- name: ListApiTokensByUser :many
+SELECT * FROM api_tokens WHERE user_id = $1 ORDER BY created_at DESC;
+
+-- name: RevokeApiToken :execrows
+-- 本人のトークンのみ. 失効済みなら何もしない.
+UPDATE api_tokens SET revoked_at = NOW()
+WHERE id = @id AND user_id = @user_id AND revoked_at IS NULL;
+
+-- name: TouchApiTokenLastUsed :exec
+-- 毎リクエストの書き込みを避けるため、前回から 1 分以上空いたときだけ更新する.
+UPDATE api_tokens SET last_used_at = NOW()
+WHERE id = $1
+  AND (last_used_at IS NULL OR last_used_at < NOW() - INTERVAL '1 minute');
@@ -44,3 +44,12 @@ WHERE id = $1 AND status = 1;
 UPDATE async_jobs
 SET status = 3, executed_at = NOW(), last_error = @last_error::text, claimed_by = NULL, claimed_at = NULL
 WHERE id = $1 AND status = 1;
+
+-- name: GetAsyncJobQueueStats :one
+-- /metrics 用。未実行 (PENDING) / 実行中 (RUNNING) の件数と、最も古い PENDING の投入時刻。
+SELECT
+    COUNT(*) FILTER (WHERE status = 0)::bigint AS pending,
+    COUNT(*) FILTER (WHERE status = 1)::bigint AS running,
+    MIN(created_at) FILTER (WHERE status = 0)::timestamptz AS oldest_pending_at
+FROM async_jobs
+WHERE status IN (0, 1);
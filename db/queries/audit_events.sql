@@ -0,0 +1,43 @@
+-- name: CreateAuditEvent :one
+INSERT INTO audit_events (
+    user_id,
+    procedure,
+    resource_type,
+    resource_id,
+    group_id,
+    request_summary,
+    outcome,
+    error_message,
+    remote_addr
+) VALUES (
+    $1, $2, $3, $4, $5, $6, $7, $8, $9
+) RETURNING *;
+
+-- name: ListAuditEvents :many
+-- 新しい順. フィルタは nullable パラメータで、NULL なら未指定として扱う。
+-- total_count は全行同じ値が入る (COUNT(*) OVER())。
+SELECT sqlc.embed(audit_events), COUNT(*) OVER() AS total_count
+FROM audit_events
+WHERE (sqlc.narg('group_id')::text      IS NULL OR group_id      = sqlc.narg('group_id')::text)
+  AND (sqlc.narg('user_id')::text       IS NULL OR user_id       = sqlc.narg('user_id')::text)
+  AND (sqlc.narg('resource_type')::text IS NULL OR resource_type = sqlc.narg('resource_type')::text)
+  AND (sqlc.narg('resource_id')::text   IS NULL OR resource_id   = sqlc.narg('resource_id')::text)
+  AND (sqlc.narg('since')::timestamptz  IS NULL OR occurred_at  >= sqlc.narg('since')::timestamptz)
+  AND (sqlc.narg('until')::timestamptz  IS NULL OR occurred_at  <  sqlc.narg('until')::timestamptz)
+ORDER BY occurred_at DESC, id DESC
+LIMIT @page_size::int OFFSET @page_offset::int;
+
+-- name: ListAuditEventsAfter :many
+-- export 用. id 昇順に after_id より後ろを返す (keyset pagination)。
+-- 書き込みが続いていてもページ境界がずれない。
+SELECT *
+FROM audit_events
+WHERE id > @after_id::bigint
+  AND (sqlc.narg('group_id')::text      IS NULL OR group_id      = sqlc.narg('group_id')::text)
+  AND (sqlc.narg('user_id')::text       IS NULL OR user_id       = sqlc.narg('user_id')::text)
+  AND (sqlc.narg('resource_type')::text IS NULL OR resource_type = sqlc.narg('resource_type')::text)
+  AND (sqlc.narg('resource_id')::text   IS NULL OR resource_id   = sqlc.narg('resource_id')::text)
+  AND (sqlc.narg('since')::timestamptz  IS NULL OR occurred_at  >= sqlc.narg('since')::timestamptz)
+  AND (sqlc.narg('until')::timestamptz  IS NULL OR occurred_at  <  sqlc.narg('until')::timestamptz)
+ORDER BY id ASC
+LIMIT @page_size::int;
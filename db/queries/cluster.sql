@@ -0,0 +1,39 @@
+-- name: UpsertControllerInstance :exec
+INSERT INTO controller_instances (id, started_at, heartbeat_at)
+VALUES (@id, CURRENT_TIMESTAMP, CURRENT_TIMESTAMP)
+ON CONFLICT (id)
+DO UPDATE SET heartbeat_at = CURRENT_TIMESTAMP;
+
+-- name: ListLiveControllerInstanceIDs :many
+-- heartbeat が ttl 以内に更新されているインスタンス.
+SELECT id FROM controller_instances
+WHERE heartbeat_at > CURRENT_TIMESTAMP - @ttl::interval
+ORDER BY id;
+
+-- name: DeleteControllerInstance :exec
+DELETE FROM controller_instances WHERE id = @id;
+
+-- name: DeleteStaleControllerInstances :execrows
+DELETE FROM controller_instances
+WHERE heartbeat_at < CURRENT_TIMESTAMP - @ttl::interval;
+
+-- name: NotifyChannel :exec
+SELECT pg_notify(@channel::text, @payload::text);
+
+-- name: UpsertHostUpgradeDrain :exec
+INSERT INTO host_upgrade_drains (host_id, target_tag)
+VALUES (@host_id, @target_tag)
+ON CONFLICT (host_id)
+DO UPDATE SET target_tag = EXCLUDED.target_tag;
+
+-- name: DeleteHostUpgradeDrain :exec
+DELETE FROM host_upgrade_drains WHERE host_id = @host_id;
+
+-- name: DeleteAllHostUpgradeDrains :exec
+DELETE FROM host_upgrade_drains;
+
+-- name: ListHostUpgradeDrainHostIDs :many
+SELECT host_id FROM host_upgrade_drains;
+
+-- name: TryAdvisoryLock :one
+SELECT pg_try_advisory_lock(@key::bigint) AS acquired;
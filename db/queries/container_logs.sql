@@ -31,3 +31,80 @@ FROM container_logs
 WHERE tag LIKE 'headless-' || @host_id || '-%'
 GROUP BY SUBSTRING(tag FROM 'headless-[^-]+-(\d+)')
 ORDER BY instance_id DESC;
+
+-- name: ListContainerLogsAfterID :many
+-- 特定のタグのログのうち after_id より後のものを古い順に取得 (追従用)
+SELECT tag, ts, data, id
+FROM container_logs
+WHERE tag = @tag
+  AND id > @after_id::bigint
+ORDER BY id ASC
+LIMIT @max_rows;
+
+-- name: SearchContainerLogs :many
+-- ホストのログ本文を部分一致 (大文字小文字を区別しない) で検索し、新しい順に返す.
+-- pattern は LIKE のパターン (呼び出し側で % _ \ をエスケープし、前後に % を付ける).
+-- group_ids / host_ids は nullable パラメータ. NULL の場合は絞り込まない.
+SELECT
+    l.id,
+    l.ts,
+    l.data,
+    h.id AS host_id,
+    h.name AS host_name,
+    CAST(SUBSTRING(l.tag FROM 'headless-[^-]+-(\d+)') AS INTEGER) AS instance_id
+FROM container_logs l
+JOIN hosts h ON h.id = SUBSTRING(l.tag FROM '^headless-([^-]+)-\d+$')
+WHERE l.data->>'log' ILIKE @pattern::text
+  AND (sqlc.narg('group_ids')::text[] IS NULL OR h.group_id = ANY(sqlc.narg('group_ids')::text[]))
+  AND (sqlc.narg('host_ids')::text[] IS NULL OR h.id = ANY(sqlc.narg('host_ids')::text[]))
+  AND (sqlc.narg('since')::timestamp IS NULL OR l.ts >= sqlc.narg('since')::timestamp)
+  AND (sqlc.narg('until')::timestamp IS NULL OR l.ts < sqlc.narg('until')::timestamp)
+  AND (@before_id::bigint = 0 OR l.id < @before_id::bigint)
+ORDER BY l.id DESC
+LIMIT @max_rows;
+
+-- name: ListPastContainerLogInstances :many
+-- ログが残っている過去のインスタンス (ホストの現在のインスタンス以外) を、最後のログが古い順に返す.
+-- 退避するかは log_retention_days (ホストのグループの設定. NULL なら既定値) を見て呼び出し側が決める.
+SELECT
+    h.id AS host_id,
+    CAST(SUBSTRING(l.tag FROM 'headless-[^-]+-(\d+)') AS INTEGER) AS instance_id,
+    l.tag,
+    CAST(MAX(l.ts) AS TIMESTAMP) AS last_log_at,
+    g.log_retention_days
+FROM container_logs l
+JOIN hosts h ON h.id = SUBSTRING(l.tag FROM '^headless-([^-]+)-\d+$')
+LEFT JOIN groups g ON g.id = h.group_id
+GROUP BY l.tag, h.id, h.instance_count, g.log_retention_days
+HAVING CAST(SUBSTRING(l.tag FROM 'headless-[^-]+-(\d+)') AS INTEGER) <> h.instance_count
+ORDER BY MAX(l.ts);
+
+-- name: DeleteContainerLogsByTagUpToID :execrows
+-- 退避済みの範囲 (id が max_id 以下) のログを削除
+DELETE FROM container_logs
+WHERE tag = @tag
+  AND id <= @max_id::bigint;
+
+-- name: UpsertContainerLogArchive :exec
+INSERT INTO container_log_archives (
+    host_id, instance_id, object_key, first_log_at, last_log_at, log_count, last_log_id, size_bytes
+) VALUES (
+    @host_id, @instance_id, @object_key, @first_log_at, @last_log_at, @log_count, @last_log_id, @size_bytes
+)
+ON CONFLICT (host_id, instance_id) DO UPDATE SET
+    object_key = EXCLUDED.object_key,
+    first_log_at = EXCLUDED.first_log_at,
+    last_log_at = EXCLUDED.last_log_at,
+    log_count = EXCLUDED.log_count,
+    last_log_id = EXCLUDED.last_log_id,
+    size_bytes = EXCLUDED.size_bytes,
+    created_at = CURRENT_TIMESTAMP;
+
+-- name: GetContainerLogArchive :one
+SELECT * FROM container_log_archives
+WHERE host_id = @host_id AND instance_id = @instance_id;
+
+-- name: ListContainerLogArchivesByHostID :many
+SELECT * FROM container_log_archives
+WHERE host_id = @host_id
+ORDER BY instance_id DESC;
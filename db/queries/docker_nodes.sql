@@ -0,0 +1,31 @@
+-- name: CreateDockerNode :one
+INSERT INTO docker_nodes (
+    id,
+    name,
+    endpoint,
+    rpc_address,
+    tls_ca_cert_path,
+    tls_cert_path,
+    tls_key_path,
+    fluentd_address,
+    max_hosts,
+    enabled
+) VALUES (
+    $1, $2, $3, $4, $5, $6, $7, $8, $9, $10
+) RETURNING *;
+
+-- name: GetDockerNode :one
+SELECT * FROM docker_nodes WHERE id = $1 LIMIT 1;
+
+-- name: ListDockerNodes :many
+SELECT * FROM docker_nodes ORDER BY created_at ASC;
+
+-- name: UpdateDockerNodeEnabled :exec
+UPDATE docker_nodes SET enabled = $2 WHERE id = $1;
+
+-- name: DeleteDockerNode :exec
+DELETE FROM docker_nodes WHERE id = $1;
+
+-- name: CountHostsOnDockerNode :one
+-- connect_string が "<node_id>/" で始まる host の数. node 削除前の参照チェック用.
+SELECT COUNT(*) FROM hosts WHERE connector_type = 'docker' AND connect_string LIKE @node_id::text || '/%';
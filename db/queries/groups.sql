@@ -40,3 +40,7 @@ SELECT g.* FROM groups g
 INNER JOIN group_members gm ON gm.group_id = g.id
 WHERE g.type = 'personal' AND gm.user_id = $1
 LIMIT 1;
+
+-- name: UpdateGroupLogRetentionDays :exec
+-- NULL にすると CONTAINER_LOG_RETENTION_DAYS に従う
+UPDATE groups SET log_retention_days = sqlc.narg('log_retention_days') WHERE id = @id;
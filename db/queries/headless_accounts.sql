@@ -15,7 +15,7 @@ ORDER BY resonite_id
 LIMIT @page_size::int OFFSET @page_offset::int;
 
 -- name: CreateHeadlessAccount :exec
-INSERT INTO headless_accounts (resonite_id, credential, password, last_display_name, last_icon_url, group_id, created_by) VALUES ($1, $2, $3, $4, $5, $6, $7);
+INSERT INTO headless_accounts (resonite_id, credential_key_id, credential_data_key, encrypted_credential, encrypted_password, last_display_name, last_icon_url, group_id, created_by) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9);
 
 -- name: DeleteHeadlessAccount :exec
 DELETE FROM headless_accounts WHERE resonite_id = $1;
@@ -24,7 +24,20 @@ DELETE FROM headless_accounts WHERE resonite_id = $1;
 UPDATE headless_accounts SET last_display_name = $2, last_icon_url = $3 WHERE resonite_id = $1;
 
 -- name: UpdateHeadlessAccountCredentials :exec
-UPDATE headless_accounts SET credential = $2, password = $3 WHERE resonite_id = $1;
+-- 暗号化した認証情報で上書きする. 暗号化前の平文の列は消す.
+UPDATE headless_accounts
+SET credential_key_id = $2, credential_data_key = $3, encrypted_credential = $4, encrypted_password = $5,
+    credential = NULL, password = NULL
+WHERE resonite_id = $1;
 
 -- name: UpdateAccountIconUrl :exec
 UPDATE headless_accounts SET last_icon_url = $2 WHERE resonite_id = $1;
+
+-- name: RewrapHeadlessAccountCredentials :execrows
+-- brhcli accounts reencrypt 用. 読んだ時点から鍵が変わっていない (= 並行して認証情報が
+-- 更新されていない) 場合だけ書き換える. 平文の行は old_key_id に NULL を渡す.
+UPDATE headless_accounts
+SET credential_key_id = @credential_key_id, credential_data_key = @credential_data_key,
+    encrypted_credential = @encrypted_credential, encrypted_password = @encrypted_password,
+    credential = NULL, password = NULL
+WHERE resonite_id = @resonite_id AND credential_key_id IS NOT DISTINCT FROM sqlc.narg('old_key_id');
@@ -0,0 +1,40 @@
+-- name: CreateHostTemplate :one
+INSERT INTO host_templates (
+    id,
+    group_id,
+    name,
+    description,
+    image_tag,
+    startup_config,
+    auto_update_policy,
+    memo,
+    container_settings,
+    auto_restart_policy,
+    auto_restart_max_retries,
+    created_by
+) VALUES (
+    $1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12
+) RETURNING *;
+
+-- name: GetHostTemplate :one
+SELECT * FROM host_templates WHERE id = $1 LIMIT 1;
+
+-- name: ListHostTemplatesByGroup :many
+SELECT * FROM host_templates WHERE group_id = $1 ORDER BY name ASC;
+
+-- name: UpdateHostTemplate :one
+UPDATE host_templates
+SET name = COALESCE(sqlc.narg('name'), name),
+    description = COALESCE(sqlc.narg('description'), description),
+    image_tag = COALESCE(sqlc.narg('image_tag'), image_tag),
+    startup_config = COALESCE(sqlc.narg('startup_config'), startup_config),
+    auto_update_policy = COALESCE(sqlc.narg('auto_update_policy'), auto_update_policy),
+    memo = COALESCE(sqlc.narg('memo'), memo),
+    container_settings = COALESCE(sqlc.narg('container_settings'), container_settings),
+    auto_restart_policy = COALESCE(sqlc.narg('auto_restart_policy'), auto_restart_policy),
+    auto_restart_max_retries = COALESCE(sqlc.narg('auto_restart_max_retries'), auto_restart_max_retries)
+WHERE id = @id
+RETURNING *;
+
+-- name: DeleteHostTemplate :exec
+DELETE FROM host_templates WHERE id = $1;
@@ -35,9 +35,12 @@ INSERT INTO hosts (
     auto_update_policy,
     memo,
     instance_count,
-    group_id
+    group_id,
+    container_settings,
+    auto_restart_policy,
+    auto_restart_max_retries
 ) VALUES (
-    $1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14
+    $1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17
 ) RETURNING *;
 
 -- name: UpdateHostStatus :exec
@@ -58,6 +61,51 @@ UPDATE hosts SET memo = $2 WHERE id = $1;
 -- name: UpdateHostAutoUpdatePolicy :exec
 UPDATE hosts SET auto_update_policy = $2 WHERE id = $1;
 
+-- name: UpdateHostContainerSettings :exec
+UPDATE hosts SET container_settings = $2 WHERE id = $1;
+
+-- name: UpdateHostAutoRestartPolicy :exec
+-- ポリシーを変更したら連続再起動回数と crash loop による停止もリセットする。
+UPDATE hosts SET
+    auto_restart_policy = $2,
+    auto_restart_max_retries = $3,
+    auto_restart_attempts = 0,
+    auto_restart_suspended = FALSE
+WHERE id = $1;
+
+-- name: RecordHostCrash :exec
+UPDATE hosts SET
+    crash_count = crash_count + 1,
+    last_crashed_at = @crashed_at
+WHERE id = @id;
+
+-- name: ResetHostAutoRestartAttemptsIfStable :one
+-- 最後の自動再起動が stable_since より前 (= その後しばらく安定稼働していた) なら
+-- 連続再起動回数と crash loop による停止をリセットする。
+UPDATE hosts SET
+    auto_restart_attempts = CASE
+        WHEN auto_restart_last_attempt_at IS NULL OR auto_restart_last_attempt_at < @stable_since THEN 0
+        ELSE auto_restart_attempts
+    END,
+    auto_restart_suspended = CASE
+        WHEN auto_restart_last_attempt_at IS NULL OR auto_restart_last_attempt_at < @stable_since THEN FALSE
+        ELSE auto_restart_suspended
+    END
+WHERE id = @id
+RETURNING *;
+
+-- name: RecordHostAutoRestartAttempt :one
+-- 読み取った時点から status と試行回数が変わっていない場合だけ試行を記録する.
+-- 行が返らなければ他のインスタンスかユーザーが先に手を付けているので再起動しない.
+UPDATE hosts SET
+    auto_restart_attempts = auto_restart_attempts + 1,
+    auto_restart_last_attempt_at = @attempted_at
+WHERE id = @id AND status = @status AND auto_restart_attempts = @expected_attempts
+RETURNING auto_restart_attempts;
+
+-- name: SuspendHostAutoRestart :exec
+UPDATE hosts SET auto_restart_suspended = TRUE WHERE id = $1;
+
 -- name: UpdateHostConnectString :exec
 UPDATE hosts SET connect_string = $2 WHERE id = $1;
 
@@ -0,0 +1,102 @@
+-- name: InsertRawMetricSamples :execrows
+-- raw のサンプルをまとめて記録する. 同じ時刻のサンプルが既にあれば捨てる.
+INSERT INTO metric_samples (
+    kind,
+    target_id,
+    resolution,
+    bucket_at,
+    avg_value,
+    min_value,
+    max_value,
+    sample_count
+)
+SELECT
+    unnest(@kinds::smallint[]),
+    unnest(@target_ids::text[]),
+    0,
+    @bucket_at::timestamptz,
+    unnest(@metric_values::real[]),
+    unnest(@metric_values::real[]),
+    unnest(@metric_values::real[]),
+    1
+ON CONFLICT (kind, target_id, resolution, bucket_at) DO NOTHING;
+
+-- name: RollupMetricSamplesToMinute :execrows
+-- [since, until) の raw を 1 分ごとにまとめて 1m の行にする.
+-- 集計し直しても同じ結果になるので、同じ範囲を何度流してもよい.
+INSERT INTO metric_samples (
+    kind,
+    target_id,
+    resolution,
+    bucket_at,
+    avg_value,
+    min_value,
+    max_value,
+    sample_count
+)
+SELECT
+    kind,
+    target_id,
+    1,
+    date_bin('1 minute', bucket_at, TIMESTAMPTZ '2000-01-01 00:00:00+00'),
+    (sum(avg_value * sample_count) / sum(sample_count))::real,
+    min(min_value),
+    max(max_value),
+    sum(sample_count)::int
+FROM metric_samples
+WHERE resolution = 0
+  AND bucket_at >= @since::timestamptz
+  AND bucket_at < @until::timestamptz
+GROUP BY kind, target_id, date_bin('1 minute', bucket_at, TIMESTAMPTZ '2000-01-01 00:00:00+00')
+ON CONFLICT (kind, target_id, resolution, bucket_at) DO UPDATE SET
+    avg_value = EXCLUDED.avg_value,
+    min_value = EXCLUDED.min_value,
+    max_value = EXCLUDED.max_value,
+    sample_count = EXCLUDED.sample_count;
+
+-- name: RollupMetricSamplesToHour :execrows
+-- [since, until) の 1m を 1 時間ごとにまとめて 1h の行にする.
+INSERT INTO metric_samples (
+    kind,
+    target_id,
+    resolution,
+    bucket_at,
+    avg_value,
+    min_value,
+    max_value,
+    sample_count
+)
+SELECT
+    kind,
+    target_id,
+    2,
+    date_bin('1 hour', bucket_at, TIMESTAMPTZ '2000-01-01 00:00:00+00'),
+    (sum(avg_value * sample_count) / sum(sample_count))::real,
+    min(min_value),
+    max(max_value),
+    sum(sample_count)::int
+FROM metric_samples
+WHERE resolution = 1
+  AND bucket_at >= @since::timestamptz
+  AND bucket_at < @until::timestamptz
+GROUP BY kind, target_id, date_bin('1 hour', bucket_at, TIMESTAMPTZ '2000-01-01 00:00:00+00')
+ON CONFLICT (kind, target_id, resolution, bucket_at) DO UPDATE SET
+    avg_value = EXCLUDED.avg_value,
+    min_value = EXCLUDED.min_value,
+    max_value = EXCLUDED.max_value,
+    sample_count = EXCLUDED.sample_count;
+
+-- name: DeleteMetricSamplesBefore :execrows
+DELETE FROM metric_samples
+WHERE resolution = @resolution::smallint
+  AND bucket_at < @before::timestamptz;
+
+-- name: ListMetricSamples :many
+-- 1 系列の [since, until) を時刻順に返す.
+SELECT * FROM metric_samples
+WHERE kind = @kind::smallint
+  AND target_id = @target_id::text
+  AND resolution = @resolution::smallint
+  AND bucket_at >= @since::timestamptz
+  AND bucket_at < @until::timestamptz
+ORDER BY bucket_at ASC;
@@ -0,0 +1,106 @@
+-- name: CreateNotificationEvent :one
+-- group_id は host_id の現在の所属グループを記録する (ホストが無ければ NULL)。
+INSERT INTO notification_events (
+    target_user_id,
+    host_id,
+    group_id,
+    scope,
+    inbox,
+    payload,
+    occurred_at
+) VALUES (
+    sqlc.narg('target_user_id'),
+    sqlc.narg('host_id')::text,
+    (SELECT hosts.group_id FROM hosts WHERE hosts.id = sqlc.narg('host_id')::text),
+    sqlc.narg('scope'),
+    @inbox,
+    @payload,
+    @occurred_at
+) RETURNING seq;
+
+-- name: ListNotificationEventsAfter :many
+-- 再送用. after_seq より後ろで user_id が受信しうるもの (宛先なし or user_id 宛て) を古い順に返す。
+-- 権限による絞り込みは呼び出し側で行う。
+SELECT *
+FROM notification_events
+WHERE seq > @after_seq::bigint
+  AND (target_user_id IS NULL OR target_user_id = @user_id::text)
+ORDER BY seq ASC
+LIMIT @page_size::int;
+
+-- name: GetOldestNotificationEventSeq :one
+-- 履歴が空なら 0。
+SELECT COALESCE(MIN(seq), 0)::bigint AS oldest_seq FROM notification_events;
+
+-- name: ListInboxNotifications :many
+-- 新しい順. readable_scopes は '<group_id>/<scope>' の配列で、list_all なら scope 付きの全イベントを返す。
+-- total_count は全行同じ値が入る (COUNT(*) OVER())。
+SELECT sqlc.embed(notification_events),
+       (notification_reads.user_id IS NOT NULL)::boolean AS is_read,
+       COUNT(*) OVER() AS total_count
+FROM notification_events
+LEFT JOIN notification_reads
+  ON notification_reads.event_seq = notification_events.seq AND notification_reads.user_id = @user_id::text
+WHERE notification_events.inbox
+  AND (
+    notification_events.target_user_id = @user_id::text
+    OR (notification_events.target_user_id IS NULL AND (
+      notification_events.scope IS NULL
+      OR @list_all::boolean
+      OR (notification_events.group_id || '/' || notification_events.scope) = ANY(@readable_scopes::text[])
+    ))
+  )
+  AND (NOT @unread_only::boolean OR notification_reads.user_id IS NULL)
+ORDER BY notification_events.seq DESC
+LIMIT @page_size::int OFFSET @page_offset::int;
+
+-- name: CountUnreadInboxNotifications :one
+SELECT COUNT(*)
+FROM notification_events
+WHERE inbox
+  AND (
+    target_user_id = @user_id::text
+    OR (target_user_id IS NULL AND (
+      scope IS NULL
+      OR @list_all::boolean
+      OR (group_id || '/' || scope) = ANY(@readable_scopes::text[])
+    ))
+  )
+  AND NOT EXISTS (
+    SELECT 1 FROM notification_reads
+    WHERE notification_reads.event_seq = notification_events.seq AND notification_reads.user_id = @user_id::text
+  );
+
+-- name: MarkNotificationsRead :exec
+-- 存在しない / inbox 対象外の seq は無視する。
+INSERT INTO notification_reads (user_id, event_seq)
+SELECT @user_id::text, seq
+FROM notification_events
+WHERE seq = ANY(@seqs::bigint[]) AND inbox
+ON CONFLICT DO NOTHING;
+
+-- name: MarkAllNotificationsRead :exec
+-- ListInboxNotifications と同じ可視範囲を全て既読にする。
+INSERT INTO notification_reads (user_id, event_seq)
+SELECT @user_id::text, seq
+FROM notification_events
+WHERE inbox
+  AND (
+    target_user_id = @user_id::text
+    OR (target_user_id IS NULL AND (
+      scope IS NULL
+      OR @list_all::boolean
+      OR (group_id || '/' || scope) = ANY(@readable_scopes::text[])
+    ))
+  )
+ON CONFLICT DO NOTHING;
+
+-- name: DeleteNotificationEventsBefore :execrows
+DELETE FROM notification_events WHERE created_at < @before::timestamptz;
+
+-- name: DeleteNotificationEventsBeyondLatest :execrows
+-- 新しい順に keep_count 件だけ残して削除する。
+DELETE FROM notification_events
+WHERE seq <= (
+    SELECT seq FROM notification_events ORDER BY seq DESC OFFSET @keep_count::int LIMIT 1
+);
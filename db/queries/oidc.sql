@@ -0,0 +1,23 @@
+-- name: CreateOidcLoginState :exec
+INSERT INTO oidc_login_states (state, nonce, code_verifier, expires_at, link_user_id)
+VALUES ($1, $2, $3, $4, $5);
+
+-- name: ConsumeOidcLoginState :one
+-- 削除と同時に返すので、同じ state でのコールバックは 1 回しか通らない.
+DELETE FROM oidc_login_states
+WHERE state = $1 AND expires_at > NOW()
+RETURNING *;
+
+-- name: DeleteExpiredOidcLoginStates :execrows
+DELETE FROM oidc_login_states WHERE expires_at <= NOW();
+
+-- name: GetUserIdentity :one
+SELECT * FROM user_identities WHERE issuer = $1 AND subject = $2;
+
+-- name: CreateUserIdentity :exec
+INSERT INTO user_identities (issuer, subject, user_id, email, last_login_at)
+VALUES ($1, $2, $3, $4, NOW());
+
+-- name: TouchUserIdentity :exec
+UPDATE user_identities SET last_login_at = NOW(), email = $3
+WHERE issuer = $1 AND subject = $2;
@@ -64,8 +64,62 @@ UPDATE scheduled_session_operations
 SET status = 0, next_fire_at = @next_fire_at::timestamptz, claimed_by = NULL, claimed_at = NULL
 WHERE id = $1 AND status = 1;
 
+-- name: RearmScheduledSessionOperation :execrows
+-- 繰り返し予約の実行後。RUNNING の行を次回の発火時刻で PENDING に戻す。last_error は今回の結果。
+UPDATE scheduled_session_operations
+SET status = 0, next_fire_at = @next_fire_at::timestamptz, executed_at = NOW(), last_error = sqlc.narg('last_error')::text,
+    claimed_by = NULL, claimed_at = NULL
+WHERE id = $1 AND status = 1;
+
 -- name: CancelScheduledSessionOperation :execrows
--- PENDING のみキャンセル可能。RUNNING / SUCCEEDED / FAILED / CANCELED は呼び出し側で FailedPrecondition。
+-- PENDING / PAUSED のみキャンセル可能。RUNNING / SUCCEEDED / FAILED / CANCELED は呼び出し側で FailedPrecondition。
 UPDATE scheduled_session_operations
 SET status = 4
+WHERE id = $1 AND status IN (0, 5);
+
+-- name: PauseScheduledSessionOperation :execrows
+UPDATE scheduled_session_operations
+SET status = 5
 WHERE id = $1 AND status = 0;
+
+-- name: ResumeScheduledSessionOperation :execrows
+-- next_fire_at が NULL なら一時停止前の値のまま再開する。
+UPDATE scheduled_session_operations
+SET status = 0, next_fire_at = COALESCE(sqlc.narg('next_fire_at')::timestamptz, next_fire_at)
+WHERE id = $1 AND status = 5;
+
+-- name: SkipScheduledSessionOperationOccurrence :execrows
+-- 次の 1 回を飛ばす。worker が同じ回を claim していたら (status / next_fire_at が変わっていたら) 何もしない。
+UPDATE scheduled_session_operations
+SET next_fire_at = @next_fire_at::timestamptz
+WHERE id = $1 AND status = 0 AND next_fire_at = @current_fire_at::timestamptz;
+
+-- name: CreateScheduledSessionOperationRun :exec
+INSERT INTO scheduled_session_operation_runs (
+    operation_id,
+    scheduled_for,
+    started_at,
+    outcome,
+    error,
+    instance_id
+) VALUES (
+    $1, $2, $3, $4, $5, $6
+);
+
+-- name: ListScheduledSessionOperationRuns :many
+-- 新しい回から順に返す。total_count は全行同じ値が入る (COUNT(*) OVER())。
+SELECT sqlc.embed(scheduled_session_operation_runs), COUNT(*) OVER() AS total_count
+FROM scheduled_session_operation_runs
+WHERE operation_id = $1
+ORDER BY scheduled_for DESC, id DESC
+LIMIT @page_size::int OFFSET @page_offset::int;
+
+-- name: GetScheduledSessionOperationQueueStats :one
+-- /metrics 用。PENDING のうち実行時刻を過ぎたもの (due) / RUNNING の件数と、最も古い due の実行予定時刻。
+SELECT
+    COUNT(*) FILTER (WHERE status = 0)::bigint AS pending,
+    COUNT(*) FILTER (WHERE status = 0 AND next_fire_at <= NOW())::bigint AS due,
+    COUNT(*) FILTER (WHERE status = 1)::bigint AS running,
+    MIN(next_fire_at) FILTER (WHERE status = 0 AND next_fire_at <= NOW())::timestamptz AS oldest_due_at
+FROM scheduled_session_operations
+WHERE status IN (0, 1);
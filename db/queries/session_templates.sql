@@ -0,0 +1,28 @@
+-- name: CreateSessionTemplate :one
+INSERT INTO session_templates (
+    id,
+    group_id,
+    name,
+    description,
+    parameters,
+    created_by
+) VALUES (
+    $1, $2, $3, $4, $5, $6
+) RETURNING *;
+
+-- name: GetSessionTemplate :one
+SELECT * FROM session_templates WHERE id = $1 LIMIT 1;
+
+-- name: ListSessionTemplatesByGroup :many
+SELECT * FROM session_templates WHERE group_id = $1 ORDER BY name ASC;
+
+-- name: UpdateSessionTemplate :one
+UPDATE session_templates
+SET name = COALESCE(sqlc.narg('name'), name),
+    description = COALESCE(sqlc.narg('description'), description),
+    parameters = COALESCE(sqlc.narg('parameters'), parameters)
+WHERE id = @id
+RETURNING *;
+
+-- name: DeleteSessionTemplate :exec
+DELETE FROM session_templates WHERE id = $1;
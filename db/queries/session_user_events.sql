@@ -0,0 +1,32 @@
+-- name: InsertSessionUserEvent :execrows
+-- 再送された event は (host_id, event_id) の一意制約で捨てる.
+INSERT INTO session_user_events (
+    session_id,
+    host_id,
+    event_id,
+    kind,
+    user_id,
+    user_name,
+    occurred_at
+) VALUES (
+    $1, $2, $3, $4, $5, $6, $7
+) ON CONFLICT (host_id, event_id) DO NOTHING;
+
+-- name: ListSessionUserEvents :many
+-- セッションの出入りを id 順に after_id より後から返す.
+-- ホスト自身のアカウント (hosts.account_id) の出入りは含めない.
+SELECT e.* FROM session_user_events e
+LEFT JOIN hosts h ON h.id = e.host_id
+WHERE e.session_id = @session_id::text
+  AND e.id > @after_id::bigint
+  AND h.account_id IS DISTINCT FROM e.user_id
+ORDER BY e.id ASC
+LIMIT @row_limit::int;
+
+-- name: ListSessionUserEventsBySessionIDs :many
+-- 集計用. 複数セッションの出入りを発生順に返す. ホスト自身のアカウントは含めない.
+SELECT e.* FROM session_user_events e
+LEFT JOIN hosts h ON h.id = e.host_id
+WHERE e.session_id = ANY(@session_ids::text[])
+  AND h.account_id IS DISTINCT FROM e.user_id
+ORDER BY e.occurred_at ASC, e.id ASC;
@@ -15,9 +15,10 @@ INSERT INTO sessions (
     startup_parameters_schema_version,
     auto_upgrade,
     memo,
-    group_id
+    group_id,
+    restore_on_crash
 ) VALUES (
-    $1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12
+    $1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13
 ) ON CONFLICT (id) DO UPDATE SET
     name = EXCLUDED.name,
     status = EXCLUDED.status,
@@ -28,7 +29,8 @@ INSERT INTO sessions (
     startup_parameters = EXCLUDED.startup_parameters,
     startup_parameters_schema_version = EXCLUDED.startup_parameters_schema_version,
     auto_upgrade = EXCLUDED.auto_upgrade,
-    memo = EXCLUDED.memo
+    memo = EXCLUDED.memo,
+    restore_on_crash = EXCLUDED.restore_on_crash
 RETURNING *;
 
 -- name: UpdateSessionStatus :exec
@@ -40,6 +42,26 @@ UPDATE sessions SET status = $2 WHERE id = $1;
 -- 2 = SessionStatus_RUNNING, 0 = SessionStatus_UNKNOWN
 UPDATE sessions SET status = 0 WHERE id = $1 AND status = 2;
 
+-- name: MarkSessionsCrashedForRestore :many
+-- host の予期しない停止時に、restore_on_crash な RUNNING session を CRASHED にする。
+-- 自動再起動 (HeadlessHostRestart) は RUNNING session を ENDED にするので、
+-- それより先に呼んで復元対象として残す。
+-- 2 = SessionStatus_RUNNING, 4 = SessionStatus_CRASHED
+UPDATE sessions SET status = 4
+WHERE host_id = $1 AND status = 2 AND restore_on_crash
+RETURNING *;
+
+-- name: ListSessionsPendingRestore :many
+-- 復元待ちの session (restore_on_crash で UNKNOWN / CRASHED) のうち、host が
+-- RUNNING に戻っているもの。
+-- 0 = SessionStatus_UNKNOWN, 4 = SessionStatus_CRASHED, 2 = HeadlessHostStatus_RUNNING
+SELECT sessions.* FROM sessions
+JOIN hosts ON hosts.id = sessions.host_id
+WHERE sessions.restore_on_crash
+  AND sessions.status IN (0, 4)
+  AND hosts.status = 2
+ORDER BY sessions.started_at ASC NULLS LAST, sessions.id ASC;
+
 -- name: ApplySessionParametersChanged :exec
 -- event 駆動の SessionParametersChanged 反映用。SessionInfo の overlap field を
 -- startup_parameters JSONB に merge することで、in-world で session 設定が
@@ -170,3 +192,14 @@ LIMIT @page_size::int OFFSET @page_offset::int;
 
 -- name: DeleteSession :exec
 DELETE FROM sessions WHERE id = $1;
+
+-- name: ListSessionsActiveBetween :many
+-- [since, until) に開いていたセッション (開始済みで、終了していないか since 以降に終了).
+-- host_id / group_ids の扱いは ListSessionsPaged と同じ.
+SELECT * FROM sessions
+WHERE started_at IS NOT NULL
+  AND started_at < @until::timestamptz
+  AND (ended_at IS NULL OR ended_at > @since::timestamptz)
+  AND (sqlc.narg('host_id')::text IS NULL OR host_id = sqlc.narg('host_id')::text)
+  AND (sqlc.narg('group_ids')::text[] IS NULL OR group_id = ANY(sqlc.narg('group_ids')::text[]))
+ORDER BY started_at ASC, id ASC;
@@ -0,0 +1,65 @@
+-- name: GetUserTotp :one
+SELECT * FROM user_totp WHERE user_id = $1;
+
+-- name: ListUserTotp :many
+SELECT * FROM user_totp ORDER BY user_id;
+
+-- name: UpsertPendingUserTotp :execrows
+-- 登録済み (enabled_at が入っている) の secret は上書きしない.
+INSERT INTO user_totp (user_id, secret_key_id, secret_data_key, encrypted_secret)
+VALUES ($1, $2, $3, $4)
+ON CONFLICT (user_id) DO UPDATE SET
+    secret_key_id = EXCLUDED.secret_key_id,
+    secret_data_key = EXCLUDED.secret_data_key,
+    encrypted_secret = EXCLUDED.encrypted_secret,
+    last_used_step = 0,
+    created_at = NOW()
+WHERE user_totp.enabled_at IS NULL;
+
+-- name: EnableUserTotp :execrows
+UPDATE user_totp SET enabled_at = NOW(), last_used_step = $2
+WHERE user_id = $1 AND enabled_at IS NULL;
+
+-- name: UpdateUserTotpLastUsedStep :execrows
+-- 並行して同じコードが送られても先に更新した方だけが通る.
+UPDATE user_totp SET last_used_step = $2
+WHERE user_id = $1 AND last_used_step < $2;
+
+-- name: RewrapUserTotpSecret :execrows
+UPDATE user_totp SET secret_key_id = $2, secret_data_key = $3, encrypted_secret = $4
+WHERE user_id = $1 AND secret_key_id = @old_key_id;
+
+-- name: DeleteUserTotp :exec
+DELETE FROM user_totp WHERE user_id = $1;
+
+-- name: CreateTotpRecoveryCode :exec
+INSERT INTO user_totp_recovery_codes (user_id, code_hash) VALUES ($1, $2);
+
+-- name: DeleteTotpRecoveryCodes :exec
+DELETE FROM user_totp_recovery_codes WHERE user_id = $1;
+
+-- name: UseTotpRecoveryCode :execrows
+UPDATE user_totp_recovery_codes SET used_at = NOW()
+WHERE user_id = $1 AND code_hash = $2 AND used_at IS NULL;
+
+-- name: CountUnusedTotpRecoveryCodes :one
+SELECT COUNT(*) FROM user_totp_recovery_codes WHERE user_id = $1 AND used_at IS NULL;
+
+-- name: CreateTotpLoginChallenge :exec
+INSERT INTO totp_login_challenges (token_hash, user_id, expires_at) VALUES ($1, $2, $3);
+
+-- name: GetTotpLoginChallenge :one
+SELECT * FROM totp_login_challenges
+WHERE token_hash = $1 AND expires_at > NOW() AND attempts < @max_attempts::int;
+
+-- name: CountTotpLoginChallengeAttempt :one
+-- 試行回数を先に数えてからコードを確認するので、並行リクエストでも上限を超えて試せない.
+UPDATE totp_login_challenges SET attempts = attempts + 1
+WHERE token_hash = $1 AND expires_at > NOW() AND attempts < @max_attempts::int
+RETURNING *;
+
+-- name: DeleteTotpLoginChallenge :exec
+DELETE FROM totp_login_challenges WHERE token_hash = $1;
+
+-- name: DeleteExpiredTotpLoginChallenges :execrows
+DELETE FROM totp_login_challenges WHERE expires_at <= NOW();
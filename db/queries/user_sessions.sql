@@ -0,0 +1,53 @@
+-- name: CreateUserSession :one
+INSERT INTO user_sessions (
+    id,
+    user_id,
+    user_agent,
+    ip_address,
+    expires_at
+) VALUES (
+    $1, $2, $3, $4, $5
+) RETURNING *;
+
+-- name: GetUserSession :one
+SELECT * FROM user_sessions WHERE id = $1 LIMIT 1;
+
+-- name: ListActiveUserSessionsByUser :many
+SELECT * FROM user_sessions
+WHERE user_id = $1 AND revoked_at IS NULL AND expires_at > NOW()
+ORDER BY last_seen_at DESC;
+
+-- name: RotateUserSession :execrows
+-- 世代が @generation のままのときだけ進める. 同時に refresh されたら片方だけが成功する.
+UPDATE user_sessions
+SET refresh_generation = refresh_generation + 1,
+    rotated_at = NOW(),
+    last_seen_at = NOW(),
+    user_agent = @user_agent,
+    ip_address = @ip_address,
+    expires_at = @expires_at
+WHERE id = @id
+  AND refresh_generation = @generation
+  AND revoked_at IS NULL;
+
+-- name: TouchUserSession :exec
+-- 毎リクエストの書き込みを避けるため、前回から 1 分以上空いたときだけ更新する.
+UPDATE user_sessions SET last_seen_at = NOW()
+WHERE id = $1
+  AND last_seen_at < NOW() - INTERVAL '1 minute';
+
+-- name: RevokeUserSession :execrows
+-- 本人のセッションのみ. 失効済みなら何もしない.
+UPDATE user_sessions SET revoked_at = NOW()
+WHERE id = @id AND user_id = @user_id AND revoked_at IS NULL;
+
+-- name: RevokeUserSessionsByUser :execrows
+-- @except_id のセッション (操作したセッション自身) は残す. 全部失効させるなら空文字.
+UPDATE user_sessions SET revoked_at = NOW()
+WHERE user_id = @user_id AND revoked_at IS NULL AND id <> @except_id;
+
+-- name: DeleteStaleUserSessions :execrows
+-- 期限切れ / 失効から 7 日経ったセッションを消す.
+DELETE FROM user_sessions
+WHERE expires_at < NOW() - INTERVAL '7 days'
+   OR revoked_at < NOW() - INTERVAL '7 days';
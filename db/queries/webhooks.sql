@@ -0,0 +1,116 @@
+-- name: CreateWebhookSubscription :one
+INSERT INTO webhook_subscriptions (
+    id,
+    group_id,
+    name,
+    url,
+    format,
+    event_types,
+    secret,
+    enabled,
+    created_by
+) VALUES (
+    $1, $2, $3, $4, $5, $6, $7, $8, $9
+) RETURNING *;
+
+-- name: GetWebhookSubscription :one
+SELECT * FROM webhook_subscriptions WHERE id = $1 LIMIT 1;
+
+-- name: ListWebhookSubscriptionsByGroup :many
+SELECT * FROM webhook_subscriptions WHERE group_id = $1 ORDER BY created_at ASC;
+
+-- name: ListEnabledWebhookSubscriptionsForEvent :many
+-- event_types が空の subscription は全イベントを受け取る。
+SELECT * FROM webhook_subscriptions
+WHERE group_id = @group_id
+  AND enabled
+  AND (cardinality(event_types) = 0 OR @event_type::text = ANY(event_types))
+ORDER BY created_at ASC;
+
+-- name: UpdateWebhookSubscription :one
+UPDATE webhook_subscriptions
+SET name = COALESCE(sqlc.narg('name'), name),
+    url = COALESCE(sqlc.narg('url'), url),
+    format = COALESCE(sqlc.narg('format'), format),
+    event_types = COALESCE(sqlc.narg('event_types')::text[], event_types),
+    enabled = COALESCE(sqlc.narg('enabled'), enabled)
+WHERE id = @id
+RETURNING *;
+
+-- name: UpdateWebhookSubscriptionSecret :exec
+UPDATE webhook_subscriptions SET secret = $2 WHERE id = $1;
+
+-- name: DeleteWebhookSubscription :exec
+DELETE FROM webhook_subscriptions WHERE id = $1;
+
+-- name: CreateWebhookDelivery :one
+INSERT INTO webhook_deliveries (
+    subscription_id,
+    event_type,
+    body
+) VALUES (
+    $1, $2, $3
+) RETURNING *;
+
+-- name: ClaimDueWebhookDeliveries :many
+-- 1つのtxで原子的にclaim。FOR UPDATE SKIP LOCKED で他インスタンスとの競合を回避。
+-- 送信予定時刻を過ぎた PENDING を古い順に最大 batch_size 件 SENDING に遷移して返す。
+UPDATE webhook_deliveries
+SET status = 1, claimed_at = NOW()
+WHERE id IN (
+    SELECT id FROM webhook_deliveries
+    WHERE status = 0 AND next_attempt_at <= NOW()
+    ORDER BY next_attempt_at
+    LIMIT @batch_size::int
+    FOR UPDATE SKIP LOCKED
+)
+RETURNING *;
+
+-- name: ReleaseStaleWebhookDeliveryClaims :execrows
+-- 落ちた instance が残した SENDING 行を PENDING に戻す (クラッシュ救済)。
+UPDATE webhook_deliveries
+SET status = 0, claimed_at = NULL
+WHERE status = 1
+  AND claimed_at IS NOT NULL
+  AND claimed_at < NOW() - make_interval(secs => @stale_after_seconds::int);
+
+-- name: MarkWebhookDeliverySucceeded :exec
+UPDATE webhook_deliveries
+SET status = 2,
+    attempts = attempts + 1,
+    last_status_code = @last_status_code,
+    last_error = NULL,
+    claimed_at = NULL,
+    delivered_at = NOW()
+WHERE id = @id AND status = 1;
+
+-- name: RetryWebhookDeliveryLater :exec
+UPDATE webhook_deliveries
+SET status = 0,
+    attempts = attempts + 1,
+    next_attempt_at = @next_attempt_at,
+    last_status_code = @last_status_code,
+    last_error = @last_error::text,
+    claimed_at = NULL
+WHERE id = @id AND status = 1;
+
+-- name: MarkWebhookDeliveryFailed :exec
+UPDATE webhook_deliveries
+SET status = 3,
+    attempts = attempts + 1,
+    last_status_code = @last_status_code,
+    last_error = @last_error::text,
+    claimed_at = NULL
+WHERE id = @id AND status = 1;
+
+-- name: ListWebhookDeliveries :many
+SELECT sqlc.embed(webhook_deliveries), COUNT(*) OVER() AS total_count
+FROM webhook_deliveries
+WHERE subscription_id = $1
+ORDER BY created_at DESC, id DESC
+LIMIT @page_size OFFSET @page_offset;
+
+-- name: DeleteFinishedWebhookDeliveriesBefore :execrows
+-- 送信済み / 諦めた履歴を古いものから消す。
+DELETE FROM webhook_deliveries
+WHERE status IN (2, 3) AND created_at < @before;
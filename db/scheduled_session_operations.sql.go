@@ -14,10 +14,10 @@ import (
 const cancelScheduledSessionOperation = `-- name: CancelScheduledSessionOperation :execrows
 UPDATE scheduled_session_operations
 SET status = 4
-WHERE id = $1 AND status = 0
+WHERE id = $1 AND status IN (0, 5)
 `
 
-// PENDING のみキャンセル可能。RUNNING / SUCCEEDED / FAILED / CANCELED は呼び出し側で FailedPrecondition。
+// PENDING / PAUSED のみキャンセル可能。RUNNING / SUCCEEDED / FAILED / CANCELED は呼び出し側で FailedPrecondition。
 func (q *Queries) CancelScheduledSessionOperation(ctx context.Context, id pgtype.UUID) (int64, error) {
 	result, err := q.db.Exec(ctx, cancelScheduledSessionOperation, id)
 	if err != nil {
@@ -141,6 +141,40 @@ func (q *Queries) CreateScheduledSessionOperation(ctx context.Context, arg Creat
 	return i, err
 }
 
+const createScheduledSessionOperationRun = `-- name: CreateScheduledSessionOperationRun :exec
+INSERT INTO scheduled_session_operation_runs (
+    operation_id,
+    scheduled_for,
+    started_at,
+    outcome,
+    error,
+    instance_id
+) VALUES (
+    $1, $2, $3, $4, $5, $6
+)
+`
+
+type CreateScheduledSessionOperationRunParams struct {
+	OperationID  pgtype.UUID
+	ScheduledFor pgtype.Timestamptz
+	StartedAt    pgtype.Timestamptz
+	Outcome      int32
+	Error        pgtype.Text
+	InstanceID   pgtype.Text
+}
+
+func (q *Queries) CreateScheduledSessionOperationRun(ctx context.Context, arg CreateScheduledSessionOperationRunParams) error {
+	_, err := q.db.Exec(ctx, createScheduledSessionOperationRun,
+		arg.OperationID,
+		arg.ScheduledFor,
+		arg.StartedAt,
+		arg.Outcome,
+		arg.Error,
+		arg.InstanceID,
+	)
+	return err
+}
+
 const getScheduledSessionOperation = `-- name: GetScheduledSessionOperation :one
 SELECT id, operation_type, operation_payload, trigger_type, trigger_config, next_fire_at, host_id, session_id, status, last_error, claimed_by, claimed_at, executed_at, created_by, created_at, updated_at FROM scheduled_session_operations WHERE id = $1 LIMIT 1
 `
@@ -169,6 +203,86 @@ func (q *Queries) GetScheduledSessionOperation(ctx context.Context, id pgtype.UU
 	return i, err
 }
 
+const getScheduledSessionOperationQueueStats = `-- name: GetScheduledSessionOperationQueueStats :one
+SELECT
+    COUNT(*) FILTER (WHERE status = 0)::bigint AS pending,
+    COUNT(*) FILTER (WHERE status = 0 AND next_fire_at <= NOW())::bigint AS due,
+    COUNT(*) FILTER (WHERE status = 1)::bigint AS running,
+    MIN(next_fire_at) FILTER (WHERE status = 0 AND next_fire_at <= NOW())::timestamptz AS oldest_due_at
+FROM scheduled_session_operations
+WHERE status IN (0, 1)
+`
+
+type GetScheduledSessionOperationQueueStatsRow struct {
+	Pending     int64
+	Due         int64
+	Running     int64
+	OldestDueAt pgtype.Timestamptz
+}
+
+// /metrics 用。PENDING のうち実行時刻を過ぎたもの (due) / RUNNING の件数と、最も古い due の実行予定時刻。
+func (q *Queries) GetScheduledSessionOperationQueueStats(ctx context.Context) (GetScheduledSessionOperationQueueStatsRow, error) {
+	row := q.db.QueryRow(ctx, getScheduledSessionOperationQueueStats)
+	var i GetScheduledSessionOperationQueueStatsRow
+	err := row.Scan(
+		&i.Pending,
+		&i.Due,
+		&i.Running,
+		&i.OldestDueAt,
+	)
+	return i, err
+}
+
+const listScheduledSessionOperationRuns = `-- name: ListScheduledSessionOperationRuns :many
+SELECT scheduled_session_operation_runs.id, scheduled_session_operation_runs.operation_id, scheduled_session_operation_runs.scheduled_for, scheduled_session_operation_runs.started_at, scheduled_session_operation_runs.finished_at, scheduled_session_operation_runs.outcome, scheduled_session_operation_runs.error, scheduled_session_operation_runs.instance_id, COUNT(*) OVER() AS total_count
+FROM scheduled_session_operation_runs
+WHERE operation_id = $1
+ORDER BY scheduled_for DESC, id DESC
+LIMIT $3::int OFFSET $2::int
+`
+
+type ListScheduledSessionOperationRunsParams struct {
+	OperationID pgtype.UUID
+	PageOffset  int32
+	PageSize    int32
+}
+
+type ListScheduledSessionOperationRunsRow struct {
+	ScheduledSessionOperationRun ScheduledSessionOperationRun
+	TotalCount                   int64
+}
+
+// 新しい回から順に返す。total_count は全行同じ値が入る (COUNT(*) OVER())。
+func (q *Queries) ListScheduledSessionOperationRuns(ctx context.Context, arg ListScheduledSessionOperationRunsParams) ([]ListScheduledSessionOperationRunsRow, error) {
+	rows, err := q.db.Query(ctx, listScheduledSessionOperationRuns, arg.OperationID, arg.PageOffset, arg.PageSize)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListScheduledSessionOperationRunsRow
+	for rows.Next() {
+		var i ListScheduledSessionOperationRunsRow
+		if err := rows.Scan(
+			&i.ScheduledSessionOperationRun.ID,
+			&i.ScheduledSessionOperationRun.OperationID,
+			&i.ScheduledSessionOperationRun.ScheduledFor,
+			&i.ScheduledSessionOperationRun.StartedAt,
+			&i.ScheduledSessionOperationRun.FinishedAt,
+			&i.ScheduledSessionOperationRun.Outcome,
+			&i.ScheduledSessionOperationRun.Error,
+			&i.ScheduledSessionOperationRun.InstanceID,
+			&i.TotalCount,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const listScheduledSessionOperations = `-- name: ListScheduledSessionOperations :many
 SELECT scheduled_session_operations.id, scheduled_session_operations.operation_type, scheduled_session_operations.operation_payload, scheduled_session_operations.trigger_type, scheduled_session_operations.trigger_config, scheduled_session_operations.next_fire_at, scheduled_session_operations.host_id, scheduled_session_operations.session_id, scheduled_session_operations.status, scheduled_session_operations.last_error, scheduled_session_operations.claimed_by, scheduled_session_operations.claimed_at, scheduled_session_operations.executed_at, scheduled_session_operations.created_by, scheduled_session_operations.created_at, scheduled_session_operations.updated_at, COUNT(*) OVER() AS total_count
 FROM scheduled_session_operations
@@ -272,6 +386,42 @@ func (q *Queries) MarkScheduledSessionOperationSucceeded(ctx context.Context, id
 	return result.RowsAffected(), nil
 }
 
+const pauseScheduledSessionOperation = `-- name: PauseScheduledSessionOperation :execrows
+UPDATE scheduled_session_operations
+SET status = 5
+WHERE id = $1 AND status = 0
+`
+
+func (q *Queries) PauseScheduledSessionOperation(ctx context.Context, id pgtype.UUID) (int64, error) {
+	result, err := q.db.Exec(ctx, pauseScheduledSessionOperation, id)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const rearmScheduledSessionOperation = `-- name: RearmScheduledSessionOperation :execrows
+UPDATE scheduled_session_operations
+SET status = 0, next_fire_at = $2::timestamptz, executed_at = NOW(), last_error = $3::text,
+    claimed_by = NULL, claimed_at = NULL
+WHERE id = $1 AND status = 1
+`
+
+type RearmScheduledSessionOperationParams struct {
+	ID         pgtype.UUID
+	NextFireAt pgtype.Timestamptz
+	LastError  pgtype.Text
+}
+
+// 繰り返し予約の実行後。RUNNING の行を次回の発火時刻で PENDING に戻す。last_error は今回の結果。
+func (q *Queries) RearmScheduledSessionOperation(ctx context.Context, arg RearmScheduledSessionOperationParams) (int64, error) {
+	result, err := q.db.Exec(ctx, rearmScheduledSessionOperation, arg.ID, arg.NextFireAt, arg.LastError)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
 const releaseStaleScheduledSessionOperationClaims = `-- name: ReleaseStaleScheduledSessionOperationClaims :execrows
 UPDATE scheduled_session_operations
 SET status = 0, claimed_by = NULL, claimed_at = NULL
@@ -308,3 +458,44 @@ func (q *Queries) RequeueScheduledSessionOperation(ctx context.Context, arg Requ
 	}
 	return result.RowsAffected(), nil
 }
+
+const resumeScheduledSessionOperation = `-- name: ResumeScheduledSessionOperation :execrows
+UPDATE scheduled_session_operations
+SET status = 0, next_fire_at = COALESCE($2::timestamptz, next_fire_at)
+WHERE id = $1 AND status = 5
+`
+
+type ResumeScheduledSessionOperationParams struct {
+	ID         pgtype.UUID
+	NextFireAt pgtype.Timestamptz
+}
+
+// next_fire_at が NULL なら一時停止前の値のまま再開する。
+func (q *Queries) ResumeScheduledSessionOperation(ctx context.Context, arg ResumeScheduledSessionOperationParams) (int64, error) {
+	result, err := q.db.Exec(ctx, resumeScheduledSessionOperation, arg.ID, arg.NextFireAt)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const skipScheduledSessionOperationOccurrence = `-- name: SkipScheduledSessionOperationOccurrence :execrows
+UPDATE scheduled_session_operations
+SET next_fire_at = $2::timestamptz
+WHERE id = $1 AND status = 0 AND next_fire_at = $3::timestamptz
+`
+
+type SkipScheduledSessionOperationOccurrenceParams struct {
+	ID            pgtype.UUID
+	NextFireAt    pgtype.Timestamptz
+	CurrentFireAt pgtype.Timestamptz
+}
+
+// 次の 1 回を飛ばす。worker が同じ回を claim していたら (status / next_fire_at が変わっていたら) 何もしない。
+func (q *Queries) SkipScheduledSessionOperationOccurrence(ctx context.Context, arg SkipScheduledSessionOperationOccurrenceParams) (int64, error) {
+	result, err := q.db.Exec(ctx, skipScheduledSessionOperationOccurrence, arg.ID, arg.NextFireAt, arg.CurrentFireAt)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
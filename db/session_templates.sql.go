@@ -0,0 +1,156 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.30.0
+// source: session_templates.sql
+
+package db
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+const createSessionTemplate = `-- name: CreateSessionTemplate :one
+INSERT INTO session_templates (
+    id,
+    group_id,
+    name,
+    description,
+    parameters,
+    created_by
+) VALUES (
+    $1, $2, $3, $4, $5, $6
+) RETURNING id, group_id, name, description, parameters, created_by, created_at, updated_at
+`
+
+type CreateSessionTemplateParams struct {
+	ID          string
+	GroupID     string
+	Name        string
+	Description string
+	Parameters  []byte
+	CreatedBy   pgtype.Text
+}
+
+func (q *Queries) CreateSessionTemplate(ctx context.Context, arg CreateSessionTemplateParams) (SessionTemplate, error) {
+	row := q.db.QueryRow(ctx, createSessionTemplate,
+		arg.ID,
+		arg.GroupID,
+		arg.Name,
+		arg.Description,
+		arg.Parameters,
+		arg.CreatedBy,
+	)
+	var i SessionTemplate
+	err := row.Scan(
+		&i.ID,
+		&i.GroupID,
+		&i.Name,
+		&i.Description,
+		&i.Parameters,
+		&i.CreatedBy,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const deleteSessionTemplate = `-- name: DeleteSessionTemplate :exec
+DELETE FROM session_templates WHERE id = $1
+`
+
+func (q *Queries) DeleteSessionTemplate(ctx context.Context, id string) error {
+	_, err := q.db.Exec(ctx, deleteSessionTemplate, id)
+	return err
+}
+
+const getSessionTemplate = `-- name: GetSessionTemplate :one
+SELECT id, group_id, name, description, parameters, created_by, created_at, updated_at FROM session_templates WHERE id = $1 LIMIT 1
+`
+
+func (q *Queries) GetSessionTemplate(ctx context.Context, id string) (SessionTemplate, error) {
+	row := q.db.QueryRow(ctx, getSessionTemplate, id)
+	var i SessionTemplate
+	err := row.Scan(
+		&i.ID,
+		&i.GroupID,
+		&i.Name,
+		&i.Description,
+		&i.Parameters,
+		&i.CreatedBy,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const listSessionTemplatesByGroup = `-- name: ListSessionTemplatesByGroup :many
+SELECT id, group_id, name, description, parameters, created_by, created_at, updated_at FROM session_templates WHERE group_id = $1 ORDER BY name ASC
+`
+
+func (q *Queries) ListSessionTemplatesByGroup(ctx context.Context, groupID string) ([]SessionTemplate, error) {
+	rows, err := q.db.Query(ctx, listSessionTemplatesByGroup, groupID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []SessionTemplate
+	for rows.Next() {
+		var i SessionTemplate
+		if err := rows.Scan(
+			&i.ID,
+			&i.GroupID,
+			&i.Name,
+			&i.Description,
+			&i.Parameters,
+			&i.CreatedBy,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const updateSessionTemplate = `-- name: UpdateSessionTemplate :one
+UPDATE session_templates
+SET name = COALESCE($1, name),
+    description = COALESCE($2, description),
+    parameters = COALESCE($3, parameters)
+WHERE id = $4
+RETURNING id, group_id, name, description, parameters, created_by, created_at, updated_at
+`
+
+type UpdateSessionTemplateParams struct {
+	Name        pgtype.Text
+	Description pgtype.Text
+	Parameters  []byte
+	ID          string
+}
+
+func (q *Queries) UpdateSessionTemplate(ctx context.Context, arg UpdateSessionTemplateParams) (SessionTemplate, error) {
+	row := q.db.QueryRow(ctx, updateSessionTemplate,
+		arg.Name,
+		arg.Description,
+		arg.Parameters,
+		arg.ID,
+	)
+	var i SessionTemplate
+	err := row.Scan(
+		&i.ID,
+		&i.GroupID,
+		&i.Name,
+		&i.Description,
+		&i.Parameters,
+		&i.CreatedBy,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
@@ -0,0 +1,138 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.30.0
+// source: session_user_events.sql
+
+package db
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+const insertSessionUserEvent = `-- name: InsertSessionUserEvent :execrows
+INSERT INTO session_user_events (
+    session_id,
+    host_id,
+    event_id,
+    kind,
+    user_id,
+    user_name,
+    occurred_at
+) VALUES (
+    $1, $2, $3, $4, $5, $6, $7
+) ON CONFLICT (host_id, event_id) DO NOTHING
+`
+
+type InsertSessionUserEventParams struct {
+	SessionID  string
+	HostID     string
+	EventID    string
+	Kind       int16
+	UserID     string
+	UserName   string
+	OccurredAt pgtype.Timestamptz
+}
+
+// 再送された event は (host_id, event_id) の一意制約で捨てる.
+func (q *Queries) InsertSessionUserEvent(ctx context.Context, arg InsertSessionUserEventParams) (int64, error) {
+	result, err := q.db.Exec(ctx, insertSessionUserEvent,
+		arg.SessionID,
+		arg.HostID,
+		arg.EventID,
+		arg.Kind,
+		arg.UserID,
+		arg.UserName,
+		arg.OccurredAt,
+	)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const listSessionUserEvents = `-- name: ListSessionUserEvents :many
+SELECT e.id, e.session_id, e.host_id, e.event_id, e.kind, e.user_id, e.user_name, e.occurred_at FROM session_user_events e
+LEFT JOIN hosts h ON h.id = e.host_id
+WHERE e.session_id = $1::text
+  AND e.id > $2::bigint
+  AND h.account_id IS DISTINCT FROM e.user_id
+ORDER BY e.id ASC
+LIMIT $3::int
+`
+
+type ListSessionUserEventsParams struct {
+	SessionID string
+	AfterID   int64
+	RowLimit  int32
+}
+
+// セッションの出入りを id 順に after_id より後から返す.
+// ホスト自身のアカウント (hosts.account_id) の出入りは含めない.
+func (q *Queries) ListSessionUserEvents(ctx context.Context, arg ListSessionUserEventsParams) ([]SessionUserEvent, error) {
+	rows, err := q.db.Query(ctx, listSessionUserEvents, arg.SessionID, arg.AfterID, arg.RowLimit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []SessionUserEvent
+	for rows.Next() {
+		var i SessionUserEvent
+		if err := rows.Scan(
+			&i.ID,
+			&i.SessionID,
+			&i.HostID,
+			&i.EventID,
+			&i.Kind,
+			&i.UserID,
+			&i.UserName,
+			&i.OccurredAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listSessionUserEventsBySessionIDs = `-- name: ListSessionUserEventsBySessionIDs :many
+SELECT e.id, e.session_id, e.host_id, e.event_id, e.kind, e.user_id, e.user_name, e.occurred_at FROM session_user_events e
+LEFT JOIN hosts h ON h.id = e.host_id
+WHERE e.session_id = ANY($1::text[])
+  AND h.account_id IS DISTINCT FROM e.user_id
+ORDER BY e.occurred_at ASC, e.id ASC
+`
+
+// 集計用. 複数セッションの出入りを発生順に返す. ホスト自身のアカウントは含めない.
+func (q *Queries) ListSessionUserEventsBySessionIDs(ctx context.Context, sessionIds []string) ([]SessionUserEvent, error) {
+	rows, err := q.db.Query(ctx, listSessionUserEventsBySessionIDs, sessionIds)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []SessionUserEvent
+	for rows.Next() {
+		var i SessionUserEvent
+		if err := rows.Scan(
+			&i.ID,
+			&i.SessionID,
+			&i.HostID,
+			&i.EventID,
+			&i.Kind,
+			&i.UserID,
+			&i.UserName,
+			&i.OccurredAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
@@ -165,7 +165,7 @@ func (q *Queries) DowngradeSessionToUnknownIfRunning(ctx context.Context, id str
 }
 
 const getSession = `-- name: GetSession :one
-SELECT id, name, status, started_at, created_by, ended_at, host_id, startup_parameters, startup_parameters_schema_version, auto_upgrade, memo, created_at, updated_at, group_id FROM sessions WHERE id = $1 LIMIT 1
+SELECT id, name, status, started_at, created_by, ended_at, host_id, startup_parameters, startup_parameters_schema_version, auto_upgrade, memo, created_at, updated_at, group_id, restore_on_crash FROM sessions WHERE id = $1 LIMIT 1
 `
 
 func (q *Queries) GetSession(ctx context.Context, id string) (Session, error) {
@@ -186,6 +186,7 @@ func (q *Queries) GetSession(ctx context.Context, id string) (Session, error) {
 		&i.CreatedAt,
 		&i.UpdatedAt,
 		&i.GroupID,
+		&i.RestoreOnCrash,
 	)
 	return i, err
 }
@@ -250,7 +251,7 @@ func (q *Queries) InsertSessionFromEvent(ctx context.Context, arg InsertSessionF
 }
 
 const listSessions = `-- name: ListSessions :many
-SELECT id, name, status, started_at, created_by, ended_at, host_id, startup_parameters, startup_parameters_schema_version, auto_upgrade, memo, created_at, updated_at, group_id FROM sessions ORDER BY started_at DESC
+SELECT id, name, status, started_at, created_by, ended_at, host_id, startup_parameters, startup_parameters_schema_version, auto_upgrade, memo, created_at, updated_at, group_id, restore_on_crash FROM sessions ORDER BY started_at DESC
 `
 
 func (q *Queries) ListSessions(ctx context.Context) ([]Session, error) {
@@ -277,6 +278,67 @@ func (q *Queries) ListSessions(ctx context.Context) ([]Session, error) {
 			&i.CreatedAt,
 			&i.UpdatedAt,
 			&i.GroupID,
+			&i.RestoreOnCrash,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listSessionsActiveBetween = `-- name: ListSessionsActiveBetween :many
+SELECT id, name, status, started_at, created_by, ended_at, host_id, startup_parameters, startup_parameters_schema_version, auto_upgrade, memo, created_at, updated_at, group_id, restore_on_crash FROM sessions
+WHERE started_at IS NOT NULL
+  AND started_at < $1::timestamptz
+  AND (ended_at IS NULL OR ended_at > $2::timestamptz)
+  AND ($3::text IS NULL OR host_id = $3::text)
+  AND ($4::text[] IS NULL OR group_id = ANY($4::text[]))
+ORDER BY started_at ASC, id ASC
+`
+
+type ListSessionsActiveBetweenParams struct {
+	Until    pgtype.Timestamptz
+	Since    pgtype.Timestamptz
+	HostID   pgtype.Text
+	GroupIds []string
+}
+
+// [since, until) に開いていたセッション (開始済みで、終了していないか since 以降に終了).
+// host_id / group_ids の扱いは ListSessionsPaged と同じ.
+func (q *Queries) ListSessionsActiveBetween(ctx context.Context, arg ListSessionsActiveBetweenParams) ([]Session, error) {
+	rows, err := q.db.Query(ctx, listSessionsActiveBetween,
+		arg.Until,
+		arg.Since,
+		arg.HostID,
+		arg.GroupIds,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Session
+	for rows.Next() {
+		var i Session
+		if err := rows.Scan(
+			&i.ID,
+			&i.Name,
+			&i.Status,
+			&i.StartedAt,
+			&i.CreatedBy,
+			&i.EndedAt,
+			&i.HostID,
+			&i.StartupParameters,
+			&i.StartupParametersSchemaVersion,
+			&i.AutoUpgrade,
+			&i.Memo,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.GroupID,
+			&i.RestoreOnCrash,
 		); err != nil {
 			return nil, err
 		}
@@ -289,7 +351,7 @@ func (q *Queries) ListSessions(ctx context.Context) ([]Session, error) {
 }
 
 const listSessionsByHostAndStatus = `-- name: ListSessionsByHostAndStatus :many
-SELECT id, name, status, started_at, created_by, ended_at, host_id, startup_parameters, startup_parameters_schema_version, auto_upgrade, memo, created_at, updated_at, group_id FROM sessions WHERE host_id = $1 AND status = $2 ORDER BY started_at DESC
+SELECT id, name, status, started_at, created_by, ended_at, host_id, startup_parameters, startup_parameters_schema_version, auto_upgrade, memo, created_at, updated_at, group_id, restore_on_crash FROM sessions WHERE host_id = $1 AND status = $2 ORDER BY started_at DESC
 `
 
 type ListSessionsByHostAndStatusParams struct {
@@ -321,6 +383,7 @@ func (q *Queries) ListSessionsByHostAndStatus(ctx context.Context, arg ListSessi
 			&i.CreatedAt,
 			&i.UpdatedAt,
 			&i.GroupID,
+			&i.RestoreOnCrash,
 		); err != nil {
 			return nil, err
 		}
@@ -333,7 +396,7 @@ func (q *Queries) ListSessionsByHostAndStatus(ctx context.Context, arg ListSessi
 }
 
 const listSessionsByStatus = `-- name: ListSessionsByStatus :many
-SELECT id, name, status, started_at, created_by, ended_at, host_id, startup_parameters, startup_parameters_schema_version, auto_upgrade, memo, created_at, updated_at, group_id FROM sessions WHERE status = $1 ORDER BY started_at DESC
+SELECT id, name, status, started_at, created_by, ended_at, host_id, startup_parameters, startup_parameters_schema_version, auto_upgrade, memo, created_at, updated_at, group_id, restore_on_crash FROM sessions WHERE status = $1 ORDER BY started_at DESC
 `
 
 func (q *Queries) ListSessionsByStatus(ctx context.Context, status int32) ([]Session, error) {
@@ -360,6 +423,7 @@ func (q *Queries) ListSessionsByStatus(ctx context.Context, status int32) ([]Ses
 			&i.CreatedAt,
 			&i.UpdatedAt,
 			&i.GroupID,
+			&i.RestoreOnCrash,
 		); err != nil {
 			return nil, err
 		}
@@ -372,7 +436,7 @@ func (q *Queries) ListSessionsByStatus(ctx context.Context, status int32) ([]Ses
 }
 
 const listSessionsPaged = `-- name: ListSessionsPaged :many
-SELECT sessions.id, sessions.name, sessions.status, sessions.started_at, sessions.created_by, sessions.ended_at, sessions.host_id, sessions.startup_parameters, sessions.startup_parameters_schema_version, sessions.auto_upgrade, sessions.memo, sessions.created_at, sessions.updated_at, sessions.group_id, COUNT(*) OVER() AS total_count
+SELECT sessions.id, sessions.name, sessions.status, sessions.started_at, sessions.created_by, sessions.ended_at, sessions.host_id, sessions.startup_parameters, sessions.startup_parameters_schema_version, sessions.auto_upgrade, sessions.memo, sessions.created_at, sessions.updated_at, sessions.group_id, sessions.restore_on_crash, COUNT(*) OVER() AS total_count
 FROM sessions
 WHERE ($1::int IS NULL OR status = $1::int)
   AND ($2::text IS NULL OR host_id = $2::text)
@@ -428,6 +492,7 @@ func (q *Queries) ListSessionsPaged(ctx context.Context, arg ListSessionsPagedPa
 			&i.Session.CreatedAt,
 			&i.Session.UpdatedAt,
 			&i.Session.GroupID,
+			&i.Session.RestoreOnCrash,
 			&i.TotalCount,
 		); err != nil {
 			return nil, err
@@ -440,6 +505,100 @@ func (q *Queries) ListSessionsPaged(ctx context.Context, arg ListSessionsPagedPa
 	return items, nil
 }
 
+const listSessionsPendingRestore = `-- name: ListSessionsPendingRestore :many
+SELECT sessions.id, sessions.name, sessions.status, sessions.started_at, sessions.created_by, sessions.ended_at, sessions.host_id, sessions.startup_parameters, sessions.startup_parameters_schema_version, sessions.auto_upgrade, sessions.memo, sessions.created_at, sessions.updated_at, sessions.group_id, sessions.restore_on_crash FROM sessions
+JOIN hosts ON hosts.id = sessions.host_id
+WHERE sessions.restore_on_crash
+  AND sessions.status IN (0, 4)
+  AND hosts.status = 2
+ORDER BY sessions.started_at ASC NULLS LAST, sessions.id ASC
+`
+
+// 復元待ちの session (restore_on_crash で UNKNOWN / CRASHED) のうち、host が
+// RUNNING に戻っているもの。
+// 0 = SessionStatus_UNKNOWN, 4 = SessionStatus_CRASHED, 2 = HeadlessHostStatus_RUNNING
+func (q *Queries) ListSessionsPendingRestore(ctx context.Context) ([]Session, error) {
+	rows, err := q.db.Query(ctx, listSessionsPendingRestore)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Session
+	for rows.Next() {
+		var i Session
+		if err := rows.Scan(
+			&i.ID,
+			&i.Name,
+			&i.Status,
+			&i.StartedAt,
+			&i.CreatedBy,
+			&i.EndedAt,
+			&i.HostID,
+			&i.StartupParameters,
+			&i.StartupParametersSchemaVersion,
+			&i.AutoUpgrade,
+			&i.Memo,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.GroupID,
+			&i.RestoreOnCrash,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const markSessionsCrashedForRestore = `-- name: MarkSessionsCrashedForRestore :many
+UPDATE sessions SET status = 4
+WHERE host_id = $1 AND status = 2 AND restore_on_crash
+RETURNING id, name, status, started_at, created_by, ended_at, host_id, startup_parameters, startup_parameters_schema_version, auto_upgrade, memo, created_at, updated_at, group_id, restore_on_crash
+`
+
+// host の予期しない停止時に、restore_on_crash な RUNNING session を CRASHED にする。
+// 自動再起動 (HeadlessHostRestart) は RUNNING session を ENDED にするので、
+// それより先に呼んで復元対象として残す。
+// 2 = SessionStatus_RUNNING, 4 = SessionStatus_CRASHED
+func (q *Queries) MarkSessionsCrashedForRestore(ctx context.Context, hostID string) ([]Session, error) {
+	rows, err := q.db.Query(ctx, markSessionsCrashedForRestore, hostID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Session
+	for rows.Next() {
+		var i Session
+		if err := rows.Scan(
+			&i.ID,
+			&i.Name,
+			&i.Status,
+			&i.StartedAt,
+			&i.CreatedBy,
+			&i.EndedAt,
+			&i.HostID,
+			&i.StartupParameters,
+			&i.StartupParametersSchemaVersion,
+			&i.AutoUpgrade,
+			&i.Memo,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.GroupID,
+			&i.RestoreOnCrash,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const updateSessionAfterWorldSaved = `-- name: UpdateSessionAfterWorldSaved :exec
 UPDATE sessions
 SET startup_parameters = jsonb_set(
@@ -495,9 +654,10 @@ INSERT INTO sessions (
     startup_parameters_schema_version,
     auto_upgrade,
     memo,
-    group_id
+    group_id,
+    restore_on_crash
 ) VALUES (
-    $1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12
+    $1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13
 ) ON CONFLICT (id) DO UPDATE SET
     name = EXCLUDED.name,
     status = EXCLUDED.status,
@@ -508,8 +668,9 @@ INSERT INTO sessions (
     startup_parameters = EXCLUDED.startup_parameters,
     startup_parameters_schema_version = EXCLUDED.startup_parameters_schema_version,
     auto_upgrade = EXCLUDED.auto_upgrade,
-    memo = EXCLUDED.memo
-RETURNING id, name, status, started_at, created_by, ended_at, host_id, startup_parameters, startup_parameters_schema_version, auto_upgrade, memo, created_at, updated_at, group_id
+    memo = EXCLUDED.memo,
+    restore_on_crash = EXCLUDED.restore_on_crash
+RETURNING id, name, status, started_at, created_by, ended_at, host_id, startup_parameters, startup_parameters_schema_version, auto_upgrade, memo, created_at, updated_at, group_id, restore_on_crash
 `
 
 type UpsertSessionParams struct {
@@ -525,6 +686,7 @@ type UpsertSessionParams struct {
 	AutoUpgrade                    bool
 	Memo                           pgtype.Text
 	GroupID                        string
+	RestoreOnCrash                 bool
 }
 
 // group_id は INSERT 時のみ設定し、ON CONFLICT 時には更新しない (グループ移動は別 RPC で扱う)。
@@ -545,6 +707,7 @@ func (q *Queries) UpsertSession(ctx context.Context, arg UpsertSessionParams) (S
 		arg.AutoUpgrade,
 		arg.Memo,
 		arg.GroupID,
+		arg.RestoreOnCrash,
 	)
 	var i Session
 	err := row.Scan(
@@ -562,6 +725,7 @@ func (q *Queries) UpsertSession(ctx context.Context, arg UpsertSessionParams) (S
 		&i.CreatedAt,
 		&i.UpdatedAt,
 		&i.GroupID,
+		&i.RestoreOnCrash,
 	)
 	return i, err
 }
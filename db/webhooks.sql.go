@@ -0,0 +1,481 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.30.0
+// source: webhooks.sql
+
+package db
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+const claimDueWebhookDeliveries = `-- name: ClaimDueWebhookDeliveries :many
+UPDATE webhook_deliveries
+SET status = 1, claimed_at = NOW()
+WHERE id IN (
+    SELECT id FROM webhook_deliveries
+    WHERE status = 0 AND next_attempt_at <= NOW()
+    ORDER BY next_attempt_at
+    LIMIT $1::int
+    FOR UPDATE SKIP LOCKED
+)
+RETURNING id, subscription_id, event_type, body, status, attempts, next_attempt_at, claimed_at, last_status_code, last_error, delivered_at, created_at
+`
+
+// 1つのtxで原子的にclaim。FOR UPDATE SKIP LOCKED で他インスタンスとの競合を回避。
+// 送信予定時刻を過ぎた PENDING を古い順に最大 batch_size 件 SENDING に遷移して返す。
+func (q *Queries) ClaimDueWebhookDeliveries(ctx context.Context, batchSize int32) ([]WebhookDelivery, error) {
+	rows, err := q.db.Query(ctx, claimDueWebhookDeliveries, batchSize)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []WebhookDelivery
+	for rows.Next() {
+		var i WebhookDelivery
+		if err := rows.Scan(
+			&i.ID,
+			&i.SubscriptionID,
+			&i.EventType,
+			&i.Body,
+			&i.Status,
+			&i.Attempts,
+			&i.NextAttemptAt,
+			&i.ClaimedAt,
+			&i.LastStatusCode,
+			&i.LastError,
+			&i.DeliveredAt,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const createWebhookDelivery = `-- name: CreateWebhookDelivery :one
+INSERT INTO webhook_deliveries (
+    subscription_id,
+    event_type,
+    body
+) VALUES (
+    $1, $2, $3
+) RETURNING id, subscription_id, event_type, body, status, attempts, next_attempt_at, claimed_at, last_status_code, last_error, delivered_at, created_at
+`
+
+type CreateWebhookDeliveryParams struct {
+	SubscriptionID string
+	EventType      string
+	Body           []byte
+}
+
+func (q *Queries) CreateWebhookDelivery(ctx context.Context, arg CreateWebhookDeliveryParams) (WebhookDelivery, error) {
+	row := q.db.QueryRow(ctx, createWebhookDelivery, arg.SubscriptionID, arg.EventType, arg.Body)
+	var i WebhookDelivery
+	err := row.Scan(
+		&i.ID,
+		&i.SubscriptionID,
+		&i.EventType,
+		&i.Body,
+		&i.Status,
+		&i.Attempts,
+		&i.NextAttemptAt,
+		&i.ClaimedAt,
+		&i.LastStatusCode,
+		&i.LastError,
+		&i.DeliveredAt,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const createWebhookSubscription = `-- name: CreateWebhookSubscription :one
+INSERT INTO webhook_subscriptions (
+    id,
+    group_id,
+    name,
+    url,
+    format,
+    event_types,
+    secret,
+    enabled,
+    created_by
+) VALUES (
+    $1, $2, $3, $4, $5, $6, $7, $8, $9
+) RETURNING id, group_id, name, url, format, event_types, secret, enabled, created_by, created_at, updated_at
+`
+
+type CreateWebhookSubscriptionParams struct {
+	ID         string
+	GroupID    string
+	Name       string
+	Url        string
+	Format     string
+	EventTypes []string
+	Secret     string
+	Enabled    bool
+	CreatedBy  pgtype.Text
+}
+
+func (q *Queries) CreateWebhookSubscription(ctx context.Context, arg CreateWebhookSubscriptionParams) (WebhookSubscription, error) {
+	row := q.db.QueryRow(ctx, createWebhookSubscription,
+		arg.ID,
+		arg.GroupID,
+		arg.Name,
+		arg.Url,
+		arg.Format,
+		arg.EventTypes,
+		arg.Secret,
+		arg.Enabled,
+		arg.CreatedBy,
+	)
+	var i WebhookSubscription
+	err := row.Scan(
+		&i.ID,
+		&i.GroupID,
+		&i.Name,
+		&i.Url,
+		&i.Format,
+		&i.EventTypes,
+		&i.Secret,
+		&i.Enabled,
+		&i.CreatedBy,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const deleteFinishedWebhookDeliveriesBefore = `-- name: DeleteFinishedWebhookDeliveriesBefore :execrows
+DELETE FROM webhook_deliveries
+WHERE status IN (2, 3) AND created_at < $1
+`
+
+// 送信済み / 諦めた履歴を古いものから消す。
+func (q *Queries) DeleteFinishedWebhookDeliveriesBefore(ctx context.Context, before pgtype.Timestamptz) (int64, error) {
+	result, err := q.db.Exec(ctx, deleteFinishedWebhookDeliveriesBefore, before)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const deleteWebhookSubscription = `-- name: DeleteWebhookSubscription :exec
+DELETE FROM webhook_subscriptions WHERE id = $1
+`
+
+func (q *Queries) DeleteWebhookSubscription(ctx context.Context, id string) error {
+	_, err := q.db.Exec(ctx, deleteWebhookSubscription, id)
+	return err
+}
+
+const getWebhookSubscription = `-- name: GetWebhookSubscription :one
+SELECT id, group_id, name, url, format, event_types, secret, enabled, created_by, created_at, updated_at FROM webhook_subscriptions WHERE id = $1 LIMIT 1
+`
+
+func (q *Queries) GetWebhookSubscription(ctx context.Context, id string) (WebhookSubscription, error) {
+	row := q.db.QueryRow(ctx, getWebhookSubscription, id)
+	var i WebhookSubscription
+	err := row.Scan(
+		&i.ID,
+		&i.GroupID,
+		&i.Name,
+		&i.Url,
+		&i.Format,
+		&i.EventTypes,
+		&i.Secret,
+		&i.Enabled,
+		&i.CreatedBy,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const listEnabledWebhookSubscriptionsForEvent = `-- name: ListEnabledWebhookSubscriptionsForEvent :many
+SELECT id, group_id, name, url, format, event_types, secret, enabled, created_by, created_at, updated_at FROM webhook_subscriptions
+WHERE group_id = $1
+  AND enabled
+  AND (cardinality(event_types) = 0 OR $2::text = ANY(event_types))
+ORDER BY created_at ASC
+`
+
+type ListEnabledWebhookSubscriptionsForEventParams struct {
+	GroupID   string
+	EventType string
+}
+
+// event_types が空の subscription は全イベントを受け取る。
+func (q *Queries) ListEnabledWebhookSubscriptionsForEvent(ctx context.Context, arg ListEnabledWebhookSubscriptionsForEventParams) ([]WebhookSubscription, error) {
+	rows, err := q.db.Query(ctx, listEnabledWebhookSubscriptionsForEvent, arg.GroupID, arg.EventType)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []WebhookSubscription
+	for rows.Next() {
+		var i WebhookSubscription
+		if err := rows.Scan(
+			&i.ID,
+			&i.GroupID,
+			&i.Name,
+			&i.Url,
+			&i.Format,
+			&i.EventTypes,
+			&i.Secret,
+			&i.Enabled,
+			&i.CreatedBy,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listWebhookDeliveries = `-- name: ListWebhookDeliveries :many
+SELECT webhook_deliveries.id, webhook_deliveries.subscription_id, webhook_deliveries.event_type, webhook_deliveries.body, webhook_deliveries.status, webhook_deliveries.attempts, webhook_deliveries.next_attempt_at, webhook_deliveries.claimed_at, webhook_deliveries.last_status_code, webhook_deliveries.last_error, webhook_deliveries.delivered_at, webhook_deliveries.created_at, COUNT(*) OVER() AS total_count
+FROM webhook_deliveries
+WHERE subscription_id = $1
+ORDER BY created_at DESC, id DESC
+LIMIT $3 OFFSET $2
+`
+
+type ListWebhookDeliveriesParams struct {
+	SubscriptionID string
+	PageOffset     int32
+	PageSize       int32
+}
+
+type ListWebhookDeliveriesRow struct {
+	WebhookDelivery WebhookDelivery
+	TotalCount      int64
+}
+
+func (q *Queries) ListWebhookDeliveries(ctx context.Context, arg ListWebhookDeliveriesParams) ([]ListWebhookDeliveriesRow, error) {
+	rows, err := q.db.Query(ctx, listWebhookDeliveries, arg.SubscriptionID, arg.PageOffset, arg.PageSize)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListWebhookDeliveriesRow
+	for rows.Next() {
+		var i ListWebhookDeliveriesRow
+		if err := rows.Scan(
+			&i.WebhookDelivery.ID,
+			&i.WebhookDelivery.SubscriptionID,
+			&i.WebhookDelivery.EventType,
+			&i.WebhookDelivery.Body,
+			&i.WebhookDelivery.Status,
+			&i.WebhookDelivery.Attempts,
+			&i.WebhookDelivery.NextAttemptAt,
+			&i.WebhookDelivery.ClaimedAt,
+			&i.WebhookDelivery.LastStatusCode,
+			&i.WebhookDelivery.LastError,
+			&i.WebhookDelivery.DeliveredAt,
+			&i.WebhookDelivery.CreatedAt,
+			&i.TotalCount,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listWebhookSubscriptionsByGroup = `-- name: ListWebhookSubscriptionsByGroup :many
+SELECT id, group_id, name, url, format, event_types, secret, enabled, created_by, created_at, updated_at FROM webhook_subscriptions WHERE group_id = $1 ORDER BY created_at ASC
+`
+
+func (q *Queries) ListWebhookSubscriptionsByGroup(ctx context.Context, groupID string) ([]WebhookSubscription, error) {
+	rows, err := q.db.Query(ctx, listWebhookSubscriptionsByGroup, groupID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []WebhookSubscription
+	for rows.Next() {
+		var i WebhookSubscription
+		if err := rows.Scan(
+			&i.ID,
+			&i.GroupID,
+			&i.Name,
+			&i.Url,
+			&i.Format,
+			&i.EventTypes,
+			&i.Secret,
+			&i.Enabled,
+			&i.CreatedBy,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const markWebhookDeliveryFailed = `-- name: MarkWebhookDeliveryFailed :exec
+UPDATE webhook_deliveries
+SET status = 3,
+    attempts = attempts + 1,
+    last_status_code = $1,
+    last_error = $2::text,
+    claimed_at = NULL
+WHERE id = $3 AND status = 1
+`
+
+type MarkWebhookDeliveryFailedParams struct {
+	LastStatusCode pgtype.Int4
+	LastError      string
+	ID             int64
+}
+
+func (q *Queries) MarkWebhookDeliveryFailed(ctx context.Context, arg MarkWebhookDeliveryFailedParams) error {
+	_, err := q.db.Exec(ctx, markWebhookDeliveryFailed, arg.LastStatusCode, arg.LastError, arg.ID)
+	return err
+}
+
+const markWebhookDeliverySucceeded = `-- name: MarkWebhookDeliverySucceeded :exec
+UPDATE webhook_deliveries
+SET status = 2,
+    attempts = attempts + 1,
+    last_status_code = $1,
+    last_error = NULL,
+    claimed_at = NULL,
+    delivered_at = NOW()
+WHERE id = $2 AND status = 1
+`
+
+type MarkWebhookDeliverySucceededParams struct {
+	LastStatusCode pgtype.Int4
+	ID             int64
+}
+
+func (q *Queries) MarkWebhookDeliverySucceeded(ctx context.Context, arg MarkWebhookDeliverySucceededParams) error {
+	_, err := q.db.Exec(ctx, markWebhookDeliverySucceeded, arg.LastStatusCode, arg.ID)
+	return err
+}
+
+const releaseStaleWebhookDeliveryClaims = `-- name: ReleaseStaleWebhookDeliveryClaims :execrows
+UPDATE webhook_deliveries
+SET status = 0, claimed_at = NULL
+WHERE status = 1
+  AND claimed_at IS NOT NULL
+  AND claimed_at < NOW() - make_interval(secs => $1::int)
+`
+
+// 落ちた instance が残した SENDING 行を PENDING に戻す (クラッシュ救済)。
+func (q *Queries) ReleaseStaleWebhookDeliveryClaims(ctx context.Context, staleAfterSeconds int32) (int64, error) {
+	result, err := q.db.Exec(ctx, releaseStaleWebhookDeliveryClaims, staleAfterSeconds)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const retryWebhookDeliveryLater = `-- name: RetryWebhookDeliveryLater :exec
+UPDATE webhook_deliveries
+SET status = 0,
+    attempts = attempts + 1,
+    next_attempt_at = $1,
+    last_status_code = $2,
+    last_error = $3::text,
+    claimed_at = NULL
+WHERE id = $4 AND status = 1
+`
+
+type RetryWebhookDeliveryLaterParams struct {
+	NextAttemptAt  pgtype.Timestamptz
+	LastStatusCode pgtype.Int4
+	LastError      string
+	ID             int64
+}
+
+func (q *Queries) RetryWebhookDeliveryLater(ctx context.Context, arg RetryWebhookDeliveryLaterParams) error {
+	_, err := q.db.Exec(ctx, retryWebhookDeliveryLater,
+		arg.NextAttemptAt,
+		arg.LastStatusCode,
+		arg.LastError,
+		arg.ID,
+	)
+	return err
+}
+
+const updateWebhookSubscription = `-- name: UpdateWebhookSubscription :one
+UPDATE webhook_subscriptions
+SET name = COALESCE($1, name),
+    url = COALESCE($2, url),
+    format = COALESCE($3, format),
+    event_types = COALESCE($4::text[], event_types),
+    enabled = COALESCE($5, enabled)
+WHERE id = $6
+RETURNING id, group_id, name, url, format, event_types, secret, enabled, created_by, created_at, updated_at
+`
+
+type UpdateWebhookSubscriptionParams struct {
+	Name       pgtype.Text
+	Url        pgtype.Text
+	Format     pgtype.Text
+	EventTypes []string
+	Enabled    pgtype.Bool
+	ID         string
+}
+
+func (q *Queries) UpdateWebhookSubscription(ctx context.Context, arg UpdateWebhookSubscriptionParams) (WebhookSubscription, error) {
+	row := q.db.QueryRow(ctx, updateWebhookSubscription,
+		arg.Name,
+		arg.Url,
+		arg.Format,
+		arg.EventTypes,
+		arg.Enabled,
+		arg.ID,
+	)
+	var i WebhookSubscription
+	err := row.Scan(
+		&i.ID,
+		&i.GroupID,
+		&i.Name,
+		&i.Url,
+		&i.Format,
+		&i.EventTypes,
+		&i.Secret,
+		&i.Enabled,
+		&i.CreatedBy,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const updateWebhookSubscriptionSecret = `-- name: UpdateWebhookSubscriptionSecret :exec
+UPDATE webhook_subscriptions SET secret = $2 WHERE id = $1
+`
+
+type UpdateWebhookSubscriptionSecretParams struct {
+	ID     string
+	Secret string
+}
+
+func (q *Queries) UpdateWebhookSubscriptionSecret(ctx context.Context, arg UpdateWebhookSubscriptionSecretParams) error {
+	_, err := q.db.Exec(ctx, updateWebhookSubscriptionSecret, arg.ID, arg.Secret)
+	return err
+}
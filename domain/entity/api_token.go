@@ -0,0 +1,35 @@
+package entity
+
+import "time"
+
+// ApiToken はスクリプト / bot 向けの長期 API トークン.
+// 平文のトークンは発行時に一度だけ返し、保存するのはハッシュのみ.
+type ApiToken struct {
+	ID     string
+	UserID string
+	Name   string
+	// TokenHash は平文トークンの SHA-256 (hex).
+	TokenHash string
+	// TokenPrefix は一覧でトークンを見分けるための先頭部分.
+	TokenPrefix string
+	// GroupID が nil でなければそのグループの操作だけに制限する (system:* も使えない).
+	GroupID *string
+	// PermissionKeys が空でなければ、その key の操作だけに制限する.
+	// いずれの制限もユーザー自身が持つ権限を超えることはない.
+	PermissionKeys []string
+	ExpiresAt      *time.Time
+	LastUsedAt     *time.Time
+	RevokedAt      *time.Time
+	CreatedAt      time.Time
+}
+
+type ApiTokenList []*ApiToken
+
+// IsActive は失効も期限切れもしていなければ true.
+func (t *ApiToken) IsActive(now time.Time) bool {
+	if t.RevokedAt != nil {
+		return false
+	}
+
+	return t.ExpiresAt == nil || now.Before(*t.ExpiresAt)
+}
@@ -0,0 +1,47 @@
+package entity
+
+import (
+	"encoding/json"
+	"time"
+)
+
+// AuditResourceType は監査ログの操作対象リソースの種別.
+type AuditResourceType string
+
+const (
+	AuditResourceType_Host               AuditResourceType = "host"
+	AuditResourceType_Session            AuditResourceType = "session"
+	AuditResourceType_Account            AuditResourceType = "account"
+	AuditResourceType_Group              AuditResourceType = "group"
+	AuditResourceType_Role               AuditResourceType = "role"
+	AuditResourceType_User               AuditResourceType = "user"
+	AuditResourceType_ScheduledOperation AuditResourceType = "scheduled_operation"
+	AuditResourceType_Webhook            AuditResourceType = "webhook"
+	AuditResourceType_ApiToken           AuditResourceType = "api_token"
+	AuditResourceType_UserSession        AuditResourceType = "user_session"
+	AuditResourceType_HostTemplate       AuditResourceType = "host_template"
+	AuditResourceType_SessionTemplate    AuditResourceType = "session_template"
+)
+
+// AuditOutcome_OK は成功した操作の outcome. 失敗時は connect のエラーコード名が入る.
+const AuditOutcome_OK = "ok"
+
+// AuditEvent は変更系 RPC 1 回分の監査ログ.
+type AuditEvent struct {
+	ID         int64
+	OccurredAt time.Time
+	// UserID は実行ユーザー. 未認証の RPC (登録など) では nil.
+	UserID       *string
+	Procedure    string
+	ResourceType AuditResourceType
+	// ResourceID は作成系で ID がまだ決まっていない場合 nil.
+	ResourceID *string
+	// GroupID は権限判定の対象になったグループ. system 権限だけで許可される操作では nil.
+	GroupID        *string
+	RequestSummary json.RawMessage
+	Outcome        string
+	ErrorMessage   *string
+	RemoteAddr     *string
+}
+
+type AuditEventList []*AuditEvent
@@ -0,0 +1,28 @@
+package entity
+
+import "time"
+
+// DockerNode は headless host を起動できるリモートの Docker daemon.
+// controller 自身の Docker daemon は暗黙の local node として扱い、DockerNode としては登録しない.
+type DockerNode struct {
+	ID   string
+	Name string
+	// Endpoint は Docker daemon の接続先. tcp://host:2376 (TLS) または ssh://user@host.
+	Endpoint string
+	// RpcAddress は headless container の gRPC を dial するホスト名.
+	// 空なら Endpoint のホスト部分を使う.
+	RpcAddress string
+	// TLS 系は tcp endpoint 用の証明書パス (controller 側のファイルシステム上). 空なら TLS なし.
+	TLSCACertPath string
+	TLSCertPath   string
+	TLSKeyPath    string
+	// FluentdAddress は node 上のコンテナがログを送る fluentd. 空ならグローバル設定を使う.
+	FluentdAddress string
+	// MaxHosts は同時に配置できる host 数の上限. 0 は無制限.
+	MaxHosts  int32
+	Enabled   bool
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+type DockerNodeList []*DockerNode
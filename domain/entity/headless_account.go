@@ -1,11 +1,30 @@
 package entity
 
 type HeadlessAccount struct {
-	ResoniteID      string
-	Credential      string
-	Password        string
+	ResoniteID string
+	// Credentials は暗号化されたままのログイン情報. 平文が必要な箇所
+	// (コンテナ起動 / skyfrost へのログイン) で port.CredentialCipher を通して復号する.
+	Credentials     SealedCredentials
 	LastDisplayName *string
 	LastIconUrl     *string
 	GroupID         string
 	CreatedBy       *string
 }
+
+// SealedCredentials は headless_accounts に保存される暗号化済みの認証情報.
+// KeyID が空の行は暗号化導入前の平文 (LegacyCredential / LegacyPassword) で、
+// `brhcli accounts reencrypt` を実行するまでの間だけ存在する.
+type SealedCredentials struct {
+	KeyID      string
+	DataKey    []byte // KeyID の鍵で暗号化されたデータ鍵
+	Credential []byte
+	Password   []byte
+
+	LegacyCredential string
+	LegacyPassword   string
+}
+
+// IsLegacyPlaintext は暗号化されていない行なら true.
+func (c SealedCredentials) IsLegacyPlaintext() bool {
+	return c.KeyID == ""
+}
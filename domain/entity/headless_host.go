@@ -1,6 +1,10 @@
 package entity
 
-import headlessv1 "github.com/hantabaru1014/baru-reso-headless-controller/pbgen/headless/v1"
+import (
+	"time"
+
+	headlessv1 "github.com/hantabaru1014/baru-reso-headless-controller/pbgen/headless/v1"
+)
 
 type HeadlessHostStatus int32
 
@@ -31,6 +35,26 @@ const (
 	HostAutoUpdatePolicy_USERS_EMPTY HostAutoUpdatePolicy = 2
 )
 
+// HostAutoRestartPolicy はコントローラがクラッシュしたホストを自動で再起動するかどうか.
+type HostAutoRestartPolicy int32
+
+const (
+	HostAutoRestartPolicy_UNSPECIFIED HostAutoRestartPolicy = 0
+	HostAutoRestartPolicy_NEVER       HostAutoRestartPolicy = 1
+	HostAutoRestartPolicy_ON_CRASH    HostAutoRestartPolicy = 2
+	HostAutoRestartPolicy_ALWAYS      HostAutoRestartPolicy = 3
+)
+
+type HostRestartPolicy int32
+
+const (
+	HostRestartPolicy_UNSPECIFIED    HostRestartPolicy = 0
+	HostRestartPolicy_NO             HostRestartPolicy = 1
+	HostRestartPolicy_ON_FAILURE     HostRestartPolicy = 2
+	HostRestartPolicy_ALWAYS         HostRestartPolicy = 3
+	HostRestartPolicy_UNLESS_STOPPED HostRestartPolicy = 4
+)
+
 type HostAllowedAccessEntry struct {
 	Host        string
 	Ports       []int32
@@ -47,6 +71,25 @@ type HeadlessHostSettings struct {
 	StartWorlds                 []*headlessv1.WorldStartupParameters
 }
 
+type HostBindMount struct {
+	Source   string
+	Target   string
+	ReadOnly bool
+}
+
+// HostContainerSettings はホストのコンテナに適用するリソース制限と配置設定.
+// ゼロ値の項目は制限しない.
+type HostContainerSettings struct {
+	CPUs        float64
+	MemoryBytes int64
+	// MemorySwapBytes はメモリ + swap の上限. -1 で swap 無制限.
+	MemorySwapBytes   int64
+	CpusetCpus        string
+	RestartPolicy     HostRestartPolicy
+	RestartMaxRetries int32
+	BindMounts        []HostBindMount
+}
+
 type HeadlessHost struct {
 	ID               string
 	Name             string
@@ -62,6 +105,16 @@ type HeadlessHost struct {
 	InstanceId       int32
 	GroupID          string
 	CreatedBy        *string
+	// NodeID はホストが配置されている node. connector が node を持たない場合は空.
+	NodeID            string
+	ContainerSettings HostContainerSettings
+	AutoRestartPolicy HostAutoRestartPolicy
+	// AutoRestartMaxRetries は連続で自動再起動する上限. 0 ならサーバー設定の crash loop 判定回数.
+	AutoRestartMaxRetries int32
+	CrashCount            int32
+	LastCrashedAt         *time.Time
+	// AutoRestartSuspended は crash loop と判定されて自動再起動を止めている状態.
+	AutoRestartSuspended bool
 }
 
 type HeadlessHostList []*HeadlessHost
@@ -0,0 +1,31 @@
+package entity
+
+import "regexp"
+
+// HostLogLevel はホストのログ 1 行の重要度. 値の大小がそのまま重要度の順になる.
+type HostLogLevel int32
+
+const (
+	HostLogLevel_Unknown HostLogLevel = iota
+	HostLogLevel_Info
+	HostLogLevel_Warning
+	HostLogLevel_Error
+)
+
+var (
+	hostLogErrorPattern   = regexp.MustCompile(`(?i)\berror\b|exception\b`)
+	hostLogWarningPattern = regexp.MustCompile(`(?i)\bwarn(ing)?\b`)
+)
+
+// ClassifyHostLogLevel はログ 1 行のレベルを推定する. container_logs はレベルを
+// 持たないため、stderr への出力と本文に含まれる ERROR / Exception / WARNING などで判定する.
+func ClassifyHostLogLevel(isError bool, body string) HostLogLevel {
+	switch {
+	case isError || hostLogErrorPattern.MatchString(body):
+		return HostLogLevel_Error
+	case hostLogWarningPattern.MatchString(body):
+		return HostLogLevel_Warning
+	default:
+		return HostLogLevel_Info
+	}
+}
@@ -0,0 +1,29 @@
+package entity
+
+import (
+	"time"
+
+	headlessv1 "github.com/hantabaru1014/baru-reso-headless-controller/pbgen/headless/v1"
+)
+
+// HostTemplate はグループで共有するホストの起動設定.
+// アカウントとホスト名は含まず、起動時に指定する.
+type HostTemplate struct {
+	ID          string
+	GroupID     string
+	Name        string
+	Description string
+	// ImageTag が空なら起動時点の最新リリースを使う.
+	ImageTag              string
+	StartupConfig         *headlessv1.StartupConfig
+	AutoUpdatePolicy      HostAutoUpdatePolicy
+	Memo                  string
+	ContainerSettings     HostContainerSettings
+	AutoRestartPolicy     HostAutoRestartPolicy
+	AutoRestartMaxRetries int32
+	CreatedBy             *string
+	CreatedAt             time.Time
+	UpdatedAt             time.Time
+}
+
+type HostTemplateList []*HostTemplate
@@ -0,0 +1,49 @@
+package entity
+
+import "time"
+
+// MetricKind は記録するメトリクスの種類. TargetID が何を指すかも決まる.
+type MetricKind int16
+
+const (
+	MetricKind_Unknown MetricKind = 0
+	// MetricKind_HostFps はホストの FPS. RUNNING のホストだけ記録する.
+	MetricKind_HostFps MetricKind = 1
+	// MetricKind_HostRunning はホストが RUNNING なら 1, それ以外なら 0.
+	// 集計後の平均は稼働していた割合になる.
+	MetricKind_HostRunning MetricKind = 2
+	// MetricKind_HostUsers はホスト上の全セッションのユーザー数の合計.
+	MetricKind_HostUsers MetricKind = 3
+	// MetricKind_SessionUsers はセッションのユーザー数. TargetID は session id.
+	MetricKind_SessionUsers MetricKind = 4
+)
+
+// IsHostMetric は TargetID が host id の種類かどうか.
+func (k MetricKind) IsHostMetric() bool {
+	return k == MetricKind_HostFps || k == MetricKind_HostRunning || k == MetricKind_HostUsers
+}
+
+// MetricResolution は 1 バケットの幅.
+type MetricResolution int16
+
+const (
+	// MetricResolution_Raw はサンプル 1 つが 1 バケット.
+	MetricResolution_Raw    MetricResolution = 0
+	MetricResolution_Minute MetricResolution = 1
+	MetricResolution_Hour   MetricResolution = 2
+)
+
+// MetricSample は 1 系列の 1 バケット分の値.
+// raw では Avg / Min / Max はどれもサンプルの値で、Count は 1.
+type MetricSample struct {
+	Kind       MetricKind
+	TargetID   string
+	Resolution MetricResolution
+	BucketAt   time.Time
+	Avg        float64
+	Min        float64
+	Max        float64
+	Count      int32
+}
+
+type MetricSampleList []*MetricSample
@@ -0,0 +1,63 @@
+package entity
+
+import (
+	"time"
+
+	hdlctrlv1 "github.com/hantabaru1014/baru-reso-headless-controller/pbgen/hdlctrl/v1"
+)
+
+// NotificationScope は通知イベントの受信に必要な権限の種類.
+type NotificationScope string
+
+const (
+	// NotificationScope_None は権限不要 (識別子を持たない / 宛先限定済み) のイベント.
+	NotificationScope_None NotificationScope = ""
+	// NotificationScope_Host はホストの状態に関するイベント.
+	NotificationScope_Host NotificationScope = "host"
+	// NotificationScope_Session はセッションの状態に関するイベント.
+	NotificationScope_Session NotificationScope = "session"
+)
+
+// GatedNotificationScopes は権限を要する scope の一覧.
+var GatedNotificationScopes = []NotificationScope{
+	NotificationScope_Host,
+	NotificationScope_Session,
+}
+
+// PermKeys は scope のイベントを受信できる permission key 群 (いずれか 1 つで可).
+// write-implies-read: write 保持者も対応する read イベントを受信できる.
+func (s NotificationScope) PermKeys() []string {
+	switch s {
+	case NotificationScope_Host:
+		return []string{PermKey_HostRead, PermKey_HostWrite}
+	case NotificationScope_Session:
+		return []string{PermKey_SessionRead, PermKey_SessionWrite}
+	default:
+		return nil
+	}
+}
+
+// NotificationRecord は notification bus に publish されたイベントの履歴 1 件.
+type NotificationRecord struct {
+	// Seq は履歴の連番. Event.Id として配る.
+	Seq int64
+	// TargetUserID は PublishTo の宛先. nil なら全 subscriber 向け.
+	TargetUserID *string
+	HostID       *string
+	// GroupID は記録時点での HostID の所属グループ.
+	GroupID   *string
+	Scope     NotificationScope
+	Inbox     bool
+	Event     *hdlctrlv1.NotificationEvent
+	CreatedAt time.Time
+}
+
+type NotificationRecordList []*NotificationRecord
+
+// NotificationInboxItem は通知 inbox の 1 件と、閲覧 user にとっての既読状態.
+type NotificationInboxItem struct {
+	Record *NotificationRecord
+	Read   bool
+}
+
+type NotificationInboxItemList []*NotificationInboxItem
@@ -46,12 +46,15 @@ const (
 	PermKey_AccountUse           = "account:use"
 	PermKey_GroupMembersManage   = "group:members.manage"
 	PermKey_GroupEdit            = "group:edit"
+	PermKey_GroupAuditRead       = "group:audit.read"
+	PermKey_GroupWebhooksManage  = "group:webhooks.manage"
 	PermKey_SystemUserCreate     = "system:user.create"
 	PermKey_SystemUserDelete     = "system:user.delete"
 	PermKey_SystemUserList       = "system:user.list"
 	PermKey_SystemGroupList      = "system:group.list"
 	PermKey_SystemGroupManage    = "system:group.manage"
 	PermKey_SystemRoleManage     = "system:role.manage"
+	PermKey_SystemAuditRead      = "system:audit.read"
 )
 
 // Group は権限スコープ単位のグループ.
@@ -61,6 +64,8 @@ type Group struct {
 	Type      GroupType
 	CreatedAt time.Time
 	UpdatedAt time.Time
+	// LogRetentionDays はホストのログを DB に残す日数. nil なら全体の設定に従う.
+	LogRetentionDays *int32
 }
 
 type GroupList []*Group
@@ -110,12 +115,15 @@ var AllPermissionKeys = []PermissionKeyDef{
 	{Key: PermKey_AccountUse, Description: "Use an account to start a session, read / send contact DMs", Scope: RoleScope_Normal},
 	{Key: PermKey_GroupMembersManage, Description: "Manage members and group-local custom roles", Scope: RoleScope_Normal},
 	{Key: PermKey_GroupEdit, Description: "Edit group metadata (name etc.)", Scope: RoleScope_Normal},
+	{Key: PermKey_GroupAuditRead, Description: "View the audit log of the group", Scope: RoleScope_Normal},
+	{Key: PermKey_GroupWebhooksManage, Description: "Manage webhook notifications of the group and view their delivery history", Scope: RoleScope_Normal},
 	{Key: PermKey_SystemUserCreate, Description: "Create system user accounts", Scope: RoleScope_System},
 	{Key: PermKey_SystemUserDelete, Description: "Delete system user accounts", Scope: RoleScope_System},
 	{Key: PermKey_SystemUserList, Description: "List all system users", Scope: RoleScope_System},
 	{Key: PermKey_SystemGroupList, Description: "List all groups", Scope: RoleScope_System},
 	{Key: PermKey_SystemGroupManage, Description: "Manage any group (including personal), and personal role changes", Scope: RoleScope_System},
 	{Key: PermKey_SystemRoleManage, Description: "Manage global custom roles", Scope: RoleScope_System},
+	{Key: PermKey_SystemAuditRead, Description: "View the audit log of all groups and system operations", Scope: RoleScope_System},
 }
 
 // IsValidPermissionKey は AllPermissionKeys に含まれる key か検証する.
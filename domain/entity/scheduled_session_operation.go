@@ -21,6 +21,7 @@ const (
 	ScheduledTriggerType_UNKNOWN            ScheduledTriggerType = 0
 	ScheduledTriggerType_TIME               ScheduledTriggerType = 1
 	ScheduledTriggerType_SESSION_USER_COUNT ScheduledTriggerType = 2
+	ScheduledTriggerType_CRON               ScheduledTriggerType = 3
 )
 
 type ScheduledOperationStatus int32
@@ -31,6 +32,8 @@ const (
 	ScheduledOperationStatus_SUCCEEDED ScheduledOperationStatus = 2
 	ScheduledOperationStatus_FAILED    ScheduledOperationStatus = 3
 	ScheduledOperationStatus_CANCELED  ScheduledOperationStatus = 4
+	// PAUSED は繰り返し予約などを一時停止している状態. 再開すると PENDING に戻る.
+	ScheduledOperationStatus_PAUSED ScheduledOperationStatus = 5
 )
 
 type ScheduledSessionOperation struct {
@@ -53,3 +56,30 @@ type ScheduledSessionOperation struct {
 }
 
 type ScheduledSessionOperationList []*ScheduledSessionOperation
+
+type ScheduledOperationRunOutcome int32
+
+const (
+	ScheduledOperationRunOutcome_UNKNOWN   ScheduledOperationRunOutcome = 0
+	ScheduledOperationRunOutcome_SUCCEEDED ScheduledOperationRunOutcome = 1
+	ScheduledOperationRunOutcome_FAILED    ScheduledOperationRunOutcome = 2
+	// SKIPPED は利用者が回をスキップした.
+	ScheduledOperationRunOutcome_SKIPPED ScheduledOperationRunOutcome = 3
+	// MISSED は controller が停止していた等で発火時刻を大きく過ぎたため実行しなかった.
+	ScheduledOperationRunOutcome_MISSED ScheduledOperationRunOutcome = 4
+)
+
+// ScheduledOperationRun は予約操作の 1 回分の実行履歴.
+type ScheduledOperationRun struct {
+	ID           int64
+	OperationID  string
+	ScheduledFor time.Time
+	// StartedAt は action を実行しなかった回 (SKIPPED / MISSED) では nil.
+	StartedAt  *time.Time
+	FinishedAt time.Time
+	Outcome    ScheduledOperationRunOutcome
+	Error      *string
+	InstanceID *string
+}
+
+type ScheduledOperationRunList []*ScheduledOperationRun
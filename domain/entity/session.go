@@ -29,6 +29,8 @@ type Session struct {
 	Memo              string
 	CurrentState      *headlessv1.Session
 	GroupID           string
+	// RestoreOnCrash が true の session は host のクラッシュ後に自動で起動し直す.
+	RestoreOnCrash bool
 }
 
 type SessionList []*Session
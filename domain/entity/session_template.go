@@ -0,0 +1,22 @@
+package entity
+
+import (
+	"time"
+
+	headlessv1 "github.com/hantabaru1014/baru-reso-headless-controller/pbgen/headless/v1"
+)
+
+// SessionTemplate はグループで共有するセッションの起動設定.
+// StartWorld や予約起動で template_id を指定すると Parameters を元に起動する.
+type SessionTemplate struct {
+	ID          string
+	GroupID     string
+	Name        string
+	Description string
+	Parameters  *headlessv1.WorldStartupParameters
+	CreatedBy   *string
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+}
+
+type SessionTemplateList []*SessionTemplate
@@ -0,0 +1,27 @@
+package entity
+
+import "time"
+
+// SessionUserEventKind はセッションへの出入りの種別.
+type SessionUserEventKind int16
+
+const (
+	SessionUserEventKind_Unknown SessionUserEventKind = 0
+	SessionUserEventKind_Joined  SessionUserEventKind = 1
+	SessionUserEventKind_Left    SessionUserEventKind = 2
+)
+
+// SessionUserEvent はユーザーがセッションに入った/出たことの記録.
+type SessionUserEvent struct {
+	ID        int64
+	SessionID string
+	HostID    string
+	// EventID は元になった host event の id.
+	EventID    string
+	Kind       SessionUserEventKind
+	UserID     string
+	UserName   string
+	OccurredAt time.Time
+}
+
+type SessionUserEventList []*SessionUserEvent
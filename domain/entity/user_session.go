@@ -0,0 +1,26 @@
+package entity
+
+import "time"
+
+// UserSession はログインごとのセッション (refresh token の family).
+// 失効させると、そのセッションで発行した refresh token と access token が使えなくなる.
+type UserSession struct {
+	ID     string
+	UserID string
+	// RefreshGeneration は最新の refresh token の世代. refresh するたびに 1 進む.
+	RefreshGeneration int64
+	RotatedAt         time.Time
+	UserAgent         string
+	IPAddress         string
+	LastSeenAt        time.Time
+	ExpiresAt         time.Time
+	RevokedAt         *time.Time
+	CreatedAt         time.Time
+}
+
+type UserSessionList []*UserSession
+
+// IsActive は失効も期限切れもしていなければ true.
+func (s *UserSession) IsActive(now time.Time) bool {
+	return s.RevokedAt == nil && now.Before(s.ExpiresAt)
+}
@@ -0,0 +1,8 @@
+package entity
+
+// SealedSecret はエンベロープ暗号化された 1 つの秘密値 (TOTP の secret など).
+type SealedSecret struct {
+	KeyID      string
+	DataKey    []byte // KeyID の鍵で暗号化されたデータ鍵
+	Ciphertext []byte
+}
@@ -0,0 +1,100 @@
+package entity
+
+import (
+	"encoding/json"
+	"time"
+)
+
+// WebhookFormat は Webhook の送信ボディの形式.
+type WebhookFormat string
+
+const (
+	// WebhookFormat_Generic はイベントをそのまま JSON で送る.
+	WebhookFormat_Generic WebhookFormat = "generic"
+	// WebhookFormat_Discord は Discord の Incoming Webhook 向け (embeds).
+	WebhookFormat_Discord WebhookFormat = "discord"
+	// WebhookFormat_Slack は Slack の Incoming Webhook 向け (text + attachments).
+	WebhookFormat_Slack WebhookFormat = "slack"
+)
+
+var AllWebhookFormats = []WebhookFormat{
+	WebhookFormat_Generic,
+	WebhookFormat_Discord,
+	WebhookFormat_Slack,
+}
+
+// WebhookEventType は Webhook で購読できるイベントの種別.
+type WebhookEventType string
+
+const (
+	WebhookEventType_SessionStarted    WebhookEventType = "session.started"
+	WebhookEventType_SessionEnded      WebhookEventType = "session.ended"
+	WebhookEventType_SessionCrashed    WebhookEventType = "session.crashed"
+	WebhookEventType_SessionUserJoined WebhookEventType = "session.user_joined"
+	WebhookEventType_SessionUserLeft   WebhookEventType = "session.user_left"
+	WebhookEventType_HostCrashed       WebhookEventType = "host.crashed"
+	WebhookEventType_HostAutoRestart   WebhookEventType = "host.auto_restart"
+	WebhookEventType_JobSucceeded      WebhookEventType = "job.succeeded"
+	WebhookEventType_JobFailed         WebhookEventType = "job.failed"
+	// WebhookEventType_Ping は TestWebhook で送る疎通確認用. 購読の対象にはならない.
+	WebhookEventType_Ping WebhookEventType = "ping"
+)
+
+// AllWebhookEventTypes は購読可能なイベント種別の一覧 (ping を除く).
+var AllWebhookEventTypes = []WebhookEventType{
+	WebhookEventType_SessionStarted,
+	WebhookEventType_SessionEnded,
+	WebhookEventType_SessionCrashed,
+	WebhookEventType_SessionUserJoined,
+	WebhookEventType_SessionUserLeft,
+	WebhookEventType_HostCrashed,
+	WebhookEventType_HostAutoRestart,
+	WebhookEventType_JobSucceeded,
+	WebhookEventType_JobFailed,
+}
+
+// WebhookSubscription はグループに登録された Webhook 通知先.
+type WebhookSubscription struct {
+	ID      string
+	GroupID string
+	Name    string
+	URL     string
+	Format  WebhookFormat
+	// EventTypes が空なら全イベントを受け取る.
+	EventTypes []WebhookEventType
+	// Secret は HMAC-SHA256 署名鍵. 作成時とローテーション時にだけ利用者に見せる.
+	Secret    string
+	Enabled   bool
+	CreatedBy *string
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+type WebhookSubscriptionList []*WebhookSubscription
+
+type WebhookDeliveryStatus int32
+
+const (
+	WebhookDeliveryStatus_PENDING   WebhookDeliveryStatus = 0
+	WebhookDeliveryStatus_SENDING   WebhookDeliveryStatus = 1
+	WebhookDeliveryStatus_SUCCEEDED WebhookDeliveryStatus = 2
+	WebhookDeliveryStatus_FAILED    WebhookDeliveryStatus = 3
+)
+
+// WebhookDelivery は Webhook 1 件分の送信キュー / 履歴.
+type WebhookDelivery struct {
+	ID             int64
+	SubscriptionID string
+	EventType      WebhookEventType
+	// Body は enqueue 時点の format で整形済みの送信ボディ.
+	Body           json.RawMessage
+	Status         WebhookDeliveryStatus
+	Attempts       int32
+	NextAttemptAt  time.Time
+	LastStatusCode *int32
+	LastError      *string
+	DeliveredAt    *time.Time
+	CreatedAt      time.Time
+}
+
+type WebhookDeliveryList []*WebhookDelivery
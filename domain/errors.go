@@ -6,6 +6,7 @@ var (
 	ErrNotFound         = errors.New("not found")
 	ErrUnauthenticated  = errors.New("unauthenticated")
 	ErrPermissionDenied = errors.New("permission denied")
+	ErrInvalidArgument  = errors.New("invalid argument")
 )
 
 // SystemUserID は CLI / 内部 worker が「特定の利用者を持たない操作」を行うときの
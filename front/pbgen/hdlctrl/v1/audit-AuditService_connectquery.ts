@@ -0,0 +1,14 @@
+// @generated by protoc-gen-connect-query v2.0.1 with parameter "target=ts"
+// @generated from file hdlctrl/v1/audit.proto (package hdlctrl.v1, syntax proto3)
+/* eslint-disable */
+
+import { AuditService } from "./audit_pb";
+
+/**
+ * 新しい順に返す.
+ * group_id 指定時はそのグループへの group:audit.read が必要.
+ * 未指定時はグループに属さない system 操作も含めた全件が対象になり、system:audit.read が必要.
+ *
+ * @generated from rpc hdlctrl.v1.AuditService.ListAuditEvents
+ */
+export const listAuditEvents = AuditService.method.listAuditEvents;
@@ -0,0 +1,192 @@
+// @generated by protoc-gen-es v2.2.3 with parameter "target=ts"
+// @generated from file hdlctrl/v1/audit.proto (package hdlctrl.v1, syntax proto3)
+/* eslint-disable */
+
+import type { GenFile, GenMessage, GenService } from "@bufbuild/protobuf/codegenv1";
+import { fileDesc, messageDesc, serviceDesc } from "@bufbuild/protobuf/codegenv1";
+import type { Timestamp } from "@bufbuild/protobuf/wkt";
+import { file_google_protobuf_timestamp } from "@bufbuild/protobuf/wkt";
+import type { PageRequest, PageResponse } from "./controller_pb";
+import { file_hdlctrl_v1_controller } from "./controller_pb";
+import type { Message } from "@bufbuild/protobuf";
+
+/**
+ * Describes the file hdlctrl/v1/audit.proto.
+ */
+export const file_hdlctrl_v1_audit: GenFile = /*@__PURE__*/
+  fileDesc("ChZoZGxjdHJsL3YxL2F1ZGl0LnByb3RvEgpoZGxjdHJsLnYxIuUCCgpBdWRpdEV2ZW50EgoKAmlkGAEgASgDEi8KC29jY3VycmVkX2F0GAIgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcBIUCgd1c2VyX2lkGAMgASgJSACIAQESEQoJcHJvY2VkdXJlGAQgASgJEhUKDXJlc291cmNlX3R5cGUYBSABKAkSGAoLcmVzb3VyY2VfaWQYBiABKAlIAYgBARIVCghncm91cF9pZBgHIAEoCUgCiAEBEhcKD3JlcXVlc3Rfc3VtbWFyeRgIIAEoCRIPCgdvdXRjb21lGAkgASgJEhoKDWVycm9yX21lc3NhZ2UYCiABKAlIA4gBARIYCgtyZW1vdGVfYWRkchgLIAEoCUgEiAEBQgoKCF91c2VyX2lkQg4KDF9yZXNvdXJjZV9pZEILCglfZ3JvdXBfaWRCEAoOX2Vycm9yX21lc3NhZ2VCDgoMX3JlbW90ZV9hZGRyItECChZMaXN0QXVkaXRFdmVudHNSZXF1ZXN0EiUKBHBhZ2UYASABKAsyFy5oZGxjdHJsLnYxLlBhZ2VSZXF1ZXN0EhUKCGdyb3VwX2lkGAIgASgJSACIAQESFAoHdXNlcl9pZBgDIAEoCUgBiAEBEhoKDXJlc291cmNlX3R5cGUYBCABKAlIAogBARIYCgtyZXNvdXJjZV9pZBgFIAEoCUgDiAEBEi4KBXNpbmNlGAYgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcEgEiAEBEi4KBXVudGlsGAcgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcEgFiAEBQgsKCV9ncm91cF9pZEIKCghfdXNlcl9pZEIQCg5fcmVzb3VyY2VfdHlwZUIOCgxfcmVzb3VyY2VfaWRCCAoGX3NpbmNlQggKBl91bnRpbCJpChdMaXN0QXVkaXRFdmVudHNSZXNwb25zZRImCgZldmVudHMYASADKAsyFi5oZGxjdHJsLnYxLkF1ZGl0RXZlbnQSJgoEcGFnZRgCIAEoCzIYLmhkbGN0cmwudjEuUGFnZVJlc3BvbnNlMmoKDEF1ZGl0U2VydmljZRJaCg9MaXN0QXVkaXRFdmVudHMSIi5oZGxjdHJsLnYxLkxpc3RBdWRpdEV2ZW50c1JlcXVlc3QaIy5oZGxjdHJsLnYxLkxpc3RBdWRpdEV2ZW50c1Jlc3BvbnNlQrgBCg5jb20uaGRsY3RybC52MUIKQXVkaXRQcm90b1ABWlFnaXRodWIuY29tL2hhbnRhYmFydTEwMTQvYmFydS1yZXNvLWhlYWRsZXNzLWNvbnRyb2xsZXIvcGJnZW4vaGRsY3RybC92MTtoZGxjdHJsdjGiAgNIWFiqAgpIZGxjdHJsLlYxygIKSGRsY3RybFxWMeICFkhkbGN0cmxcVjFcR1BCTWV0YWRhdGHqAgtIZGxjdHJsOjpWMWIGcHJvdG8z", [file_google_protobuf_timestamp, file_hdlctrl_v1_controller]);
+
+/**
+ * @generated from message hdlctrl.v1.AuditEvent
+ */
+export type AuditEvent = Message<"hdlctrl.v1.AuditEvent"> & {
+  /**
+   * @generated from field: int64 id = 1;
+   */
+  id: bigint;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp occurred_at = 2;
+   */
+  occurredAt?: Timestamp;
+
+  /**
+   * 実行ユーザー. 未認証の RPC (登録など) では未設定.
+   *
+   * @generated from field: optional string user_id = 3;
+   */
+  userId?: string;
+
+  /**
+   * connect の procedure 名 (例: /hdlctrl.v1.ControllerService/StopSession).
+   *
+   * @generated from field: string procedure = 4;
+   */
+  procedure: string;
+
+  /**
+   * host / session / account / group / role / user / scheduled_operation / webhook.
+   *
+   * @generated from field: string resource_type = 5;
+   */
+  resourceType: string;
+
+  /**
+   * 作成系で ID がまだ決まっていない場合は未設定.
+   *
+   * @generated from field: optional string resource_id = 6;
+   */
+  resourceId?: string;
+
+  /**
+   * 権限判定の対象になったグループ. system 権限だけで許可される操作では未設定.
+   *
+   * @generated from field: optional string group_id = 7;
+   */
+  groupId?: string;
+
+  /**
+   * パスワード等を伏せたリクエストの JSON.
+   *
+   * @generated from field: string request_summary = 8;
+   */
+  requestSummary: string;
+
+  /**
+   * "ok" または connect のエラーコード名 (permission_denied 等).
+   *
+   * @generated from field: string outcome = 9;
+   */
+  outcome: string;
+
+  /**
+   * @generated from field: optional string error_message = 10;
+   */
+  errorMessage?: string;
+
+  /**
+   * @generated from field: optional string remote_addr = 11;
+   */
+  remoteAddr?: string;
+};
+
+/**
+ * Describes the message hdlctrl.v1.AuditEvent.
+ * Use `create(AuditEventSchema)` to create a new message.
+ */
+export const AuditEventSchema: GenMessage<AuditEvent> = /*@__PURE__*/
+  messageDesc(file_hdlctrl_v1_audit, 0);
+
+/**
+ * @generated from message hdlctrl.v1.ListAuditEventsRequest
+ */
+export type ListAuditEventsRequest = Message<"hdlctrl.v1.ListAuditEventsRequest"> & {
+  /**
+   * @generated from field: hdlctrl.v1.PageRequest page = 1;
+   */
+  page?: PageRequest;
+
+  /**
+   * @generated from field: optional string group_id = 2;
+   */
+  groupId?: string;
+
+  /**
+   * @generated from field: optional string user_id = 3;
+   */
+  userId?: string;
+
+  /**
+   * @generated from field: optional string resource_type = 4;
+   */
+  resourceType?: string;
+
+  /**
+   * @generated from field: optional string resource_id = 5;
+   */
+  resourceId?: string;
+
+  /**
+   * since 以降 (含む) / until より前 (含まない) に絞り込む.
+   *
+   * @generated from field: optional google.protobuf.Timestamp since = 6;
+   */
+  since?: Timestamp;
+
+  /**
+   * @generated from field: optional google.protobuf.Timestamp until = 7;
+   */
+  until?: Timestamp;
+};
+
+/**
+ * Describes the message hdlctrl.v1.ListAuditEventsRequest.
+ * Use `create(ListAuditEventsRequestSchema)` to create a new message.
+ */
+export const ListAuditEventsRequestSchema: GenMessage<ListAuditEventsRequest> = /*@__PURE__*/
+  messageDesc(file_hdlctrl_v1_audit, 1);
+
+/**
+ * @generated from message hdlctrl.v1.ListAuditEventsResponse
+ */
+export type ListAuditEventsResponse = Message<"hdlctrl.v1.ListAuditEventsResponse"> & {
+  /**
+   * @generated from field: repeated hdlctrl.v1.AuditEvent events = 1;
+   */
+  events: AuditEvent[];
+
+  /**
+   * @generated from field: hdlctrl.v1.PageResponse page = 2;
+   */
+  page?: PageResponse;
+};
+
+/**
+ * Describes the message hdlctrl.v1.ListAuditEventsResponse.
+ * Use `create(ListAuditEventsResponseSchema)` to create a new message.
+ */
+export const ListAuditEventsResponseSchema: GenMessage<ListAuditEventsResponse> = /*@__PURE__*/
+  messageDesc(file_hdlctrl_v1_audit, 2);
+
+/**
+ * 変更系 RPC の監査ログを閲覧する.
+ * 記録は各 service の interceptor が行う (参照系 RPC は記録しない).
+ *
+ * @generated from service hdlctrl.v1.AuditService
+ */
+export const AuditService: GenService<{
+  /**
+   * 新しい順に返す.
+   * group_id 指定時はそのグループへの group:audit.read が必要.
+   * 未指定時はグループに属さない system 操作も含めた全件が対象になり、system:audit.read が必要.
+   *
+   * @generated from rpc hdlctrl.v1.AuditService.ListAuditEvents
+   */
+  listAuditEvents: {
+    methodKind: "unary";
+    input: typeof ListAuditEventsRequestSchema;
+    output: typeof ListAuditEventsResponseSchema;
+  },
+}> = /*@__PURE__*/
+  serviceDesc(file_hdlctrl_v1_audit, 0);
+
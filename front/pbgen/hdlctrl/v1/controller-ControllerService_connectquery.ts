@@ -21,6 +21,20 @@ export const getHeadlessHost = ControllerService.method.getHeadlessHost;
  */
 export const getHeadlessHostLogs = ControllerService.method.getHeadlessHostLogs;
 
+/**
+ * 閲覧できる全ホスト・全インスタンスのログを本文で検索する
+ *
+ * @generated from rpc hdlctrl.v1.ControllerService.SearchHeadlessHostLogs
+ */
+export const searchHeadlessHostLogs = ControllerService.method.searchHeadlessHostLogs;
+
+/**
+ * インスタンスのログ全体 (または期間内) をファイルにして、ダウンロード用の URL を返す
+ *
+ * @generated from rpc hdlctrl.v1.ControllerService.PrepareHeadlessHostLogDownload
+ */
+export const prepareHeadlessHostLogDownload = ControllerService.method.prepareHeadlessHostLogDownload;
+
 /**
  * @generated from rpc hdlctrl.v1.ControllerService.ShutdownHeadlessHost
  */
@@ -71,6 +85,40 @@ export const deleteHeadlessHost = ControllerService.method.deleteHeadlessHost;
  */
 export const listHeadlessHostInstances = ControllerService.method.listHeadlessHostInstances;
 
+/**
+ * 既存ホストの設定をコピーして新しいホストを起動する
+ *
+ * @generated from rpc hdlctrl.v1.ControllerService.CloneHeadlessHost
+ */
+export const cloneHeadlessHost = ControllerService.method.cloneHeadlessHost;
+
+/**
+ * ホストテンプレート系
+ *
+ * @generated from rpc hdlctrl.v1.ControllerService.ListHostTemplates
+ */
+export const listHostTemplates = ControllerService.method.listHostTemplates;
+
+/**
+ * @generated from rpc hdlctrl.v1.ControllerService.GetHostTemplate
+ */
+export const getHostTemplate = ControllerService.method.getHostTemplate;
+
+/**
+ * @generated from rpc hdlctrl.v1.ControllerService.CreateHostTemplate
+ */
+export const createHostTemplate = ControllerService.method.createHostTemplate;
+
+/**
+ * @generated from rpc hdlctrl.v1.ControllerService.UpdateHostTemplate
+ */
+export const updateHostTemplate = ControllerService.method.updateHostTemplate;
+
+/**
+ * @generated from rpc hdlctrl.v1.ControllerService.DeleteHostTemplate
+ */
+export const deleteHostTemplate = ControllerService.method.deleteHostTemplate;
+
 /**
  * アカウント系
  *
@@ -239,6 +287,58 @@ export const banUser = ControllerService.method.banUser;
  */
 export const issueResoniteLinkConnection = ControllerService.method.issueResoniteLinkConnection;
 
+/**
+ * セッションへのユーザーの出入りの履歴と、そこから集計した出席・利用状況
+ *
+ * @generated from rpc hdlctrl.v1.ControllerService.ListSessionUserEvents
+ */
+export const listSessionUserEvents = ControllerService.method.listSessionUserEvents;
+
+/**
+ * @generated from rpc hdlctrl.v1.ControllerService.GetSessionAttendance
+ */
+export const getSessionAttendance = ControllerService.method.getSessionAttendance;
+
+/**
+ * @generated from rpc hdlctrl.v1.ControllerService.GetSessionUsageStats
+ */
+export const getSessionUsageStats = ControllerService.method.getSessionUsageStats;
+
+/**
+ * メトリクス系
+ * ホスト / セッションのメトリクスの時系列 (ダッシュボードのグラフ用)
+ *
+ * @generated from rpc hdlctrl.v1.ControllerService.GetMetricsSeries
+ */
+export const getMetricsSeries = ControllerService.method.getMetricsSeries;
+
+/**
+ * セッションテンプレート系
+ *
+ * @generated from rpc hdlctrl.v1.ControllerService.ListSessionTemplates
+ */
+export const listSessionTemplates = ControllerService.method.listSessionTemplates;
+
+/**
+ * @generated from rpc hdlctrl.v1.ControllerService.GetSessionTemplate
+ */
+export const getSessionTemplate = ControllerService.method.getSessionTemplate;
+
+/**
+ * @generated from rpc hdlctrl.v1.ControllerService.CreateSessionTemplate
+ */
+export const createSessionTemplate = ControllerService.method.createSessionTemplate;
+
+/**
+ * @generated from rpc hdlctrl.v1.ControllerService.UpdateSessionTemplate
+ */
+export const updateSessionTemplate = ControllerService.method.updateSessionTemplate;
+
+/**
+ * @generated from rpc hdlctrl.v1.ControllerService.DeleteSessionTemplate
+ */
+export const deleteSessionTemplate = ControllerService.method.deleteSessionTemplate;
+
 /**
  * 予約操作系
  *
@@ -255,3 +355,25 @@ export const listScheduledSessionOperations = ControllerService.method.listSched
  * @generated from rpc hdlctrl.v1.ControllerService.CancelScheduledSessionOperation
  */
 export const cancelScheduledSessionOperation = ControllerService.method.cancelScheduledSessionOperation;
+
+/**
+ * @generated from rpc hdlctrl.v1.ControllerService.PauseScheduledSessionOperation
+ */
+export const pauseScheduledSessionOperation = ControllerService.method.pauseScheduledSessionOperation;
+
+/**
+ * @generated from rpc hdlctrl.v1.ControllerService.ResumeScheduledSessionOperation
+ */
+export const resumeScheduledSessionOperation = ControllerService.method.resumeScheduledSessionOperation;
+
+/**
+ * 繰り返し予約の次の 1 回を飛ばす
+ *
+ * @generated from rpc hdlctrl.v1.ControllerService.SkipScheduledSessionOperationOccurrence
+ */
+export const skipScheduledSessionOperationOccurrence = ControllerService.method.skipScheduledSessionOperationOccurrence;
+
+/**
+ * @generated from rpc hdlctrl.v1.ControllerService.ListScheduledSessionOperationRuns
+ */
+export const listScheduledSessionOperationRuns = ControllerService.method.listScheduledSessionOperationRuns;
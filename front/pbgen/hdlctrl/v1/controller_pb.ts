@@ -14,7 +14,7 @@ import type { Message } from "@bufbuild/protobuf";
  * Describes the file hdlctrl/v1/controller.proto.
  */
 export const file_hdlctrl_v1_controller: GenFile = /*@__PURE__*/
-  fileDesc("ChtoZGxjdHJsL3YxL2NvbnRyb2xsZXIucHJvdG8SCmhkbGN0cmwudjEiNwohUmVmZXRjaEhlYWRsZXNzQWNjb3VudEluZm9SZXF1ZXN0EhIKCmFjY291bnRfaWQYASABKAkiJAoiUmVmZXRjaEhlYWRsZXNzQWNjb3VudEluZm9SZXNwb25zZSJJCiBVcGRhdGVIZWFkbGVzc0FjY291bnRJY29uUmVxdWVzdBISCgphY2NvdW50X2lkGAEgASgJEhEKCWljb25fZGF0YRgCIAEoDCI5CiFVcGRhdGVIZWFkbGVzc0FjY291bnRJY29uUmVzcG9uc2USFAoMbmV3X2ljb25fdXJsGAEgASgJIjoKJEdldEhlYWRsZXNzQWNjb3VudFN0b3JhZ2VJbmZvUmVxdWVzdBISCgphY2NvdW50X2lkGAEgASgJImAKJUdldEhlYWRsZXNzQWNjb3VudFN0b3JhZ2VJbmZvUmVzcG9uc2USGwoTc3RvcmFnZV9xdW90YV9ieXRlcxgBIAEoAxIaChJzdG9yYWdlX3VzZWRfYnl0ZXMYAiABKAMiYwonVXBkYXRlSGVhZGxlc3NBY2NvdW50Q3JlZGVudGlhbHNSZXF1ZXN0EhIKCmFjY291bnRfaWQYASABKAkSEgoKY3JlZGVudGlhbBgCIAEoCRIQCghwYXNzd29yZBgDIAEoCSIqCihVcGRhdGVIZWFkbGVzc0FjY291bnRDcmVkZW50aWFsc1Jlc3BvbnNlIjIKHERlbGV0ZUhlYWRsZXNzQWNjb3VudFJlcXVlc3QSEgoKYWNjb3VudF9pZBgBIAEoCSIfCh1EZWxldGVIZWFkbGVzc0FjY291bnRSZXNwb25zZSIsChlEZWxldGVIZWFkbGVzc0hvc3RSZXF1ZXN0Eg8KB2hvc3RfaWQYASABKAkiHAoaRGVsZXRlSGVhZGxlc3NIb3N0UmVzcG9uc2UiMwogTGlzdEhlYWRsZXNzSG9zdEluc3RhbmNlc1JlcXVlc3QSDwoHaG9zdF9pZBgBIAEoCSKaAgohTGlzdEhlYWRsZXNzSG9zdEluc3RhbmNlc1Jlc3BvbnNlEkkKCWluc3RhbmNlcxgBIAMoCzI2LmhkbGN0cmwudjEuTGlzdEhlYWRsZXNzSG9zdEluc3RhbmNlc1Jlc3BvbnNlLkluc3RhbmNlGqkBCghJbnN0YW5jZRITCgtpbnN0YW5jZV9pZBgBIAEoBRIwCgxmaXJzdF9sb2dfYXQYAiABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wEi8KC2xhc3RfbG9nX2F0GAMgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcBIRCglsb2dfY291bnQYBCABKAMSEgoKaXNfY3VycmVudBgFIAEoCCJfChZBbGxvd0hvc3RBY2Nlc3NSZXF1ZXN0Eg8KB2hvc3RfaWQYASABKAkSNAoHcmVxdWVzdBgCIAEoCzIjLmhlYWRsZXNzLnYxLkFsbG93SG9zdEFjY2Vzc1JlcXVlc3QiGQoXQWxsb3dIb3N0QWNjZXNzUmVzcG9uc2UiXQoVRGVueUhvc3RBY2Nlc3NSZXF1ZXN0Eg8KB2hvc3RfaWQYASABKAkSMwoHcmVxdWVzdBgCIAEoCzIiLmhlYWRsZXNzLnYxLkRlbnlIb3N0QWNjZXNzUmVxdWVzdCIYChZEZW55SG9zdEFjY2Vzc1Jlc3BvbnNlItkCChhTdGFydEhlYWRsZXNzSG9zdFJlcXVlc3QSDAoEbmFtZRgBIAEoCRIbChNoZWFkbGVzc19hY2NvdW50X2lkGAIgASgJEhYKCWltYWdlX3RhZxgDIAEoCUgAiAEBEjcKDnN0YXJ0dXBfY29uZmlnGAQgASgLMhouaGVhZGxlc3MudjEuU3RhcnR1cENvbmZpZ0gBiAEBEkkKEmF1dG9fdXBkYXRlX3BvbGljeRgFIAEoDjIoLmhkbGN0cmwudjEuSGVhZGxlc3NIb3N0QXV0b1VwZGF0ZVBvbGljeUgCiAEBEhEKBG1lbW8YBiABKAlIA4gBARIVCghncm91cF9pZBgHIAEoCUgEiAEBQgwKCl9pbWFnZV90YWdCEQoPX3N0YXJ0dXBfY29uZmlnQhUKE19hdXRvX3VwZGF0ZV9wb2xpY3lCBwoFX21lbW9CCwoJX2dyb3VwX2lkIjEKGVN0YXJ0SGVhZGxlc3NIb3N0UmVzcG9uc2USDgoGam9iX2lkGAIgASgJSgQIARACIm4KHENyZWF0ZUhlYWRsZXNzQWNjb3VudFJlcXVlc3QSEgoKY3JlZGVudGlhbBgCIAEoCRIQCghwYXNzd29yZBgDIAEoCRIVCghncm91cF9pZBgEIAEoCUgAiAEBQgsKCV9ncm91cF9pZEoECAEQAiIfCh1DcmVhdGVIZWFkbGVzc0FjY291bnRSZXNwb25zZSJoChtMaXN0SGVhZGxlc3NBY2NvdW50c1JlcXVlc3QSJQoEcGFnZRgBIAEoCzIXLmhkbGN0cmwudjEuUGFnZVJlcXVlc3QSFQoIZ3JvdXBfaWQYAiABKAlIAIgBAUILCglfZ3JvdXBfaWQidQocTGlzdEhlYWRsZXNzQWNjb3VudHNSZXNwb25zZRItCghhY2NvdW50cxgBIAMoCzIbLmhkbGN0cmwudjEuSGVhZGxlc3NBY2NvdW50EiYKBHBhZ2UYAiABKAsyGC5oZGxjdHJsLnYxLlBhZ2VSZXNwb25zZSIiCiBMaXN0SGVhZGxlc3NIb3N0SW1hZ2VUYWdzUmVxdWVzdCLUAQohTGlzdEhlYWRsZXNzSG9zdEltYWdlVGFnc1Jlc3BvbnNlEkoKBHRhZ3MYASADKAsyPC5oZGxjdHJsLnYxLkxpc3RIZWFkbGVzc0hvc3RJbWFnZVRhZ3NSZXNwb25zZS5Db250YWluZXJJbWFnZRpjCg5Db250YWluZXJJbWFnZRILCgN0YWcYASABKAkSGAoQcmVzb25pdGVfdmVyc2lvbhgCIAEoCRIVCg1pc19wcmVyZWxlYXNlGAMgASgIEhMKC2FwcF92ZXJzaW9uGAQgASgJIl4KG0FjY2VwdEZyaWVuZFJlcXVlc3RzUmVxdWVzdBIbChNoZWFkbGVzc19hY2NvdW50X2lkGAMgASgJEhYKDnRhcmdldF91c2VyX2lkGAQgASgJSgQIARACSgQIAhADIh4KHEFjY2VwdEZyaWVuZFJlcXVlc3RzUmVzcG9uc2UiPQoYR2V0RnJpZW5kUmVxdWVzdHNSZXF1ZXN0EhsKE2hlYWRsZXNzX2FjY291bnRfaWQYAiABKAlKBAgBEAIiTQoZR2V0RnJpZW5kUmVxdWVzdHNSZXNwb25zZRIwChJyZXF1ZXN0ZWRfY29udGFjdHMYASADKAsyFC5oZGxjdHJsLnYxLlVzZXJJbmZvIsABChpSZXN0YXJ0SGVhZGxlc3NIb3N0UmVxdWVzdBIPCgdob3N0X2lkGAEgASgJEhMKC3dpdGhfdXBkYXRlGAIgASgIEhsKDndpdGhfaW1hZ2VfdGFnGAMgASgJSACIAQESGgoSd2l0aF93b3JsZF9yZXN0YXJ0GAQgASgIEhwKD3RpbWVvdXRfc2Vjb25kcxgFIAEoBUgBiAEBQhEKD193aXRoX2ltYWdlX3RhZ0ISChBfdGltZW91dF9zZWNvbmRzIjMKG1Jlc3RhcnRIZWFkbGVzc0hvc3RSZXNwb25zZRIOCgZqb2JfaWQYAiABKAlKBAgBEAIiwwMKIVVwZGF0ZUhlYWRsZXNzSG9zdFNldHRpbmdzUmVxdWVzdBIPCgdob3N0X2lkGAEgASgJEhEKBG5hbWUYAiABKAlIAIgBARIWCgl0aWNrX3JhdGUYAyABKAJIAYgBARIrCh5tYXhfY29uY3VycmVudF9hc3NldF90cmFuc2ZlcnMYBCABKAVIAogBARIeChF1c2VybmFtZV9vdmVycmlkZRgFIAEoCUgDiAEBEh8KF3VwZGF0ZV9hdXRvX3NwYXduX2l0ZW1zGAYgASgIEhgKEGF1dG9fc3Bhd25faXRlbXMYByADKAkSGAoLdW5pdmVyc2VfaWQYCCABKAlIBIgBARJJChJhdXRvX3VwZGF0ZV9wb2xpY3kYCSABKA4yKC5oZGxjdHJsLnYxLkhlYWRsZXNzSG9zdEF1dG9VcGRhdGVQb2xpY3lIBYgBAUIHCgVfbmFtZUIMCgpfdGlja19yYXRlQiEKH19tYXhfY29uY3VycmVudF9hc3NldF90cmFuc2ZlcnNCFAoSX3VzZXJuYW1lX292ZXJyaWRlQg4KDF91bml2ZXJzZV9pZEIVChNfYXV0b191cGRhdGVfcG9saWN5IiQKIlVwZGF0ZUhlYWRsZXNzSG9zdFNldHRpbmdzUmVzcG9uc2UiLgobU2h1dGRvd25IZWFkbGVzc0hvc3RSZXF1ZXN0Eg8KB2hvc3RfaWQYASABKAkiLgocU2h1dGRvd25IZWFkbGVzc0hvc3RSZXNwb25zZRIOCgZqb2JfaWQYASABKAkiKgoXS2lsbEhlYWRsZXNzSG9zdFJlcXVlc3QSDwoHaG9zdF9pZBgBIAEoCSIaChhLaWxsSGVhZGxlc3NIb3N0UmVzcG9uc2UiogEKGkdldEhlYWRsZXNzSG9zdExvZ3NSZXF1ZXN0Eg8KB2hvc3RfaWQYASABKAkSEwoLaW5zdGFuY2VfaWQYBSABKAUSDQoFbGltaXQYBiABKAUSEwoJYmVmb3JlX2lkGAkgASgDSAASEgoIYWZ0ZXJfaWQYCiABKANIAEIICgZjdXJzb3JKBAgCEANKBAgDEARKBAgEEAVKBAgHEAhKBAgIEAki6wEKG0dldEhlYWRsZXNzSG9zdExvZ3NSZXNwb25zZRI5CgRsb2dzGAEgAygLMisuaGRsY3RybC52MS5HZXRIZWFkbGVzc0hvc3RMb2dzUmVzcG9uc2UuTG9nEhcKD2hhc19tb3JlX2JlZm9yZRgCIAEoCBIWCg5oYXNfbW9yZV9hZnRlchgDIAEoCBpgCgNMb2cSLQoJdGltZXN0YW1wGAEgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcBIQCghpc19lcnJvchgCIAEoCBIMCgRib2R5GAMgASgJEgoKAmlkGAQgASgDImAKFVNlYXJjaFVzZXJJbmZvUmVxdWVzdBIPCgdob3N0X2lkGAEgASgJEjYKCnBhcmFtZXRlcnMYAiABKAsyIi5oZWFkbGVzcy52MS5TZWFyY2hVc2VySW5mb1JlcXVlc3QiVAoPS2lja1VzZXJSZXF1ZXN0Eg8KB2hvc3RfaWQYASABKAkSMAoKcGFyYW1ldGVycxgCIAEoCzIcLmhlYWRsZXNzLnYxLktpY2tVc2VyUmVxdWVzdCISChBLaWNrVXNlclJlc3BvbnNlIlIKDkJhblVzZXJSZXF1ZXN0Eg8KB2hvc3RfaWQYASABKAkSLwoKcGFyYW1ldGVycxgCIAEoCzIbLmhlYWRsZXNzLnYxLkJhblVzZXJSZXF1ZXN0IhEKD0JhblVzZXJSZXNwb25zZSI4CiJJc3N1ZVJlc29uaXRlTGlua0Nvbm5lY3Rpb25SZXF1ZXN0EhIKCnNlc3Npb25faWQYASABKAkiZgojSXNzdWVSZXNvbml0ZUxpbmtDb25uZWN0aW9uUmVzcG9uc2USDwoHd3NfcGF0aBgBIAEoCRIuCgpleHBpcmVzX2F0GAIgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcCI1ChVGZXRjaFdvcmxkSW5mb1JlcXVlc3QSDwoHaG9zdF9pZBgBIAEoCRILCgN1cmwYAiABKAkiTwoTU2VhcmNoV29ybGRzUmVxdWVzdBINCgVxdWVyeRgBIAEoCRIVCg1mZWF0dXJlZF9vbmx5GAIgASgIEhIKCnBhZ2VfaW5kZXgYAyABKAUi+AEKFFNlYXJjaFdvcmxkc1Jlc3BvbnNlEj0KB3JlY29yZHMYASADKAsyLC5oZGxjdHJsLnYxLlNlYXJjaFdvcmxkc1Jlc3BvbnNlLldvcmxkUmVjb3JkEhAKCGhhc19tb3JlGAIgASgIGo4BCgtXb3JsZFJlY29yZBIKCgJpZBgBIAEoCRIQCghvd25lcl9pZBgCIAEoCRISCgpvd25lcl9uYW1lGAMgASgJEgwKBG5hbWUYBCABKAkSEwoLZGVzY3JpcHRpb24YBSABKAkSFQoNdGh1bWJuYWlsX3VybBgGIAEoCRITCgtpc19mZWF0dXJlZBgHIAEoCCI6ChNHZXRPd25Xb3JsZHNSZXF1ZXN0Eg8KB2hvc3RfaWQYASABKAkSEgoKcGFnZV9pbmRleBgCIAEoBSJnChRHZXRPd25Xb3JsZHNSZXNwb25zZRI9CgdyZWNvcmRzGAEgAygLMiwuaGRsY3RybC52MS5TZWFyY2hXb3JsZHNSZXNwb25zZS5Xb3JsZFJlY29yZBIQCghoYXNfbW9yZRgCIAEoCCJkChdMaXN0SGVhZGxlc3NIb3N0UmVxdWVzdBIlCgRwYWdlGAEgASgLMhcuaGRsY3RybC52MS5QYWdlUmVxdWVzdBIVCghncm91cF9pZBgCIAEoCUgAiAEBQgsKCV9ncm91cF9pZCJrChhMaXN0SGVhZGxlc3NIb3N0UmVzcG9uc2USJwoFaG9zdHMYASADKAsyGC5oZGxjdHJsLnYxLkhlYWRsZXNzSG9zdBImCgRwYWdlGAIgASgLMhguaGRsY3RybC52MS5QYWdlUmVzcG9uc2UiKQoWR2V0SGVhZGxlc3NIb3N0UmVxdWVzdBIPCgdob3N0X2lkGAEgASgJIkcKF0dldEhlYWRsZXNzSG9zdFJlc3BvbnNlEiYKBGhvc3QYASABKAsyGC5oZGxjdHJsLnYxLkhlYWRsZXNzSG9zdEoECAIQAyI3ChZBZGRIZWFkbGVzc0hvc3RSZXF1ZXN0EgwKBG5hbWUYASABKAkSDwoHYWRkcmVzcxgCIAEoCSJBChdBZGRIZWFkbGVzc0hvc3RSZXNwb25zZRImCgRob3N0GAEgASgLMhguaGRsY3RybC52MS5IZWFkbGVzc0hvc3QinAIKFVNlYXJjaFNlc3Npb25zUmVxdWVzdBJGCgpwYXJhbWV0ZXJzGAEgASgLMjIuaGRsY3RybC52MS5TZWFyY2hTZXNzaW9uc1JlcXVlc3QuU2VhcmNoUGFyYW1ldGVycxIlCgRwYWdlGAIgASgLMhcuaGRsY3RybC52MS5QYWdlUmVxdWVzdBqTAQoQU2VhcmNoUGFyYW1ldGVycxIUCgdob3N0X2lkGAEgASgJSACIAQESLgoGc3RhdHVzGAIgASgOMhkuaGRsY3RybC52MS5TZXNzaW9uU3RhdHVzSAGIAQESFQoIZ3JvdXBfaWQYAyABKAlIAogBAUIKCghfaG9zdF9pZEIJCgdfc3RhdHVzQgsKCV9ncm91cF9pZCJnChZTZWFyY2hTZXNzaW9uc1Jlc3BvbnNlEiUKCHNlc3Npb25zGAEgAygLMhMuaGRsY3RybC52MS5TZXNzaW9uEiYKBHBhZ2UYAiABKAsyGC5oZGxjdHJsLnYxLlBhZ2VSZXNwb25zZSJDChhHZXRTZXNzaW9uRGV0YWlsc1JlcXVlc3QSEwoHaG9zdF9pZBgBIAEoCUICGAESEgoKc2Vzc2lvbl9pZBgCIAEoCSJBChlHZXRTZXNzaW9uRGV0YWlsc1Jlc3BvbnNlEiQKB3Nlc3Npb24YASABKAsyEy5oZGxjdHJsLnYxLlNlc3Npb24ijwEKEVN0YXJ0V29ybGRSZXF1ZXN0Eg8KB2hvc3RfaWQYASABKAkSNwoKcGFyYW1ldGVycxgCIAEoCzIjLmhlYWRsZXNzLnYxLldvcmxkU3RhcnR1cFBhcmFtZXRlcnMSDAoEbWVtbxgDIAEoCRIVCghncm91cF9pZBgEIAEoCUgAiAEBQgsKCV9ncm91cF9pZCIqChJTdGFydFdvcmxkUmVzcG9uc2USDgoGam9iX2lkGAIgASgJSgQIARACIj0KElN0b3BTZXNzaW9uUmVxdWVzdBITCgdob3N0X2lkGAEgASgJQgIYARISCgpzZXNzaW9uX2lkGAIgASgJIiUKE1N0b3BTZXNzaW9uUmVzcG9uc2USDgoGam9iX2lkGAEgASgJIi8KGURlbGV0ZUVuZGVkU2Vzc2lvblJlcXVlc3QSEgoKc2Vzc2lvbl9pZBgBIAEoCSIcChpEZWxldGVFbmRlZFNlc3Npb25SZXNwb25zZSLqAQoXU2F2ZVNlc3Npb25Xb3JsZFJlcXVlc3QSEwoHaG9zdF9pZBgBIAEoCUICGAESEgoKc2Vzc2lvbl9pZBgCIAEoCRI/CglzYXZlX21vZGUYAyABKA4yLC5oZGxjdHJsLnYxLlNhdmVTZXNzaW9uV29ybGRSZXF1ZXN0LlNhdmVNb2RlImUKCFNhdmVNb2RlEhUKEVNBVkVfTU9ERV9VTktOT1dOEAASFwoTU0FWRV9NT0RFX09WRVJXUklURRABEhUKEVNBVkVfTU9ERV9TQVZFX0FTEAISEgoOU0FWRV9NT0RFX0NPUFkQAyJOChhTYXZlU2Vzc2lvbldvcmxkUmVzcG9uc2USHQoQc2F2ZWRfcmVjb3JkX3VybBgBIAEoCUgAiAEBQhMKEV9zYXZlZF9yZWNvcmRfdXJsImgKIlByZXBhcmVTZXNzaW9uV29ybGREb3dubG9hZFJlcXVlc3QSEgoKc2Vzc2lvbl9pZBgBIAEoCRIuCgZmb3JtYXQYAiABKA4yHi5oZWFkbGVzcy52MS5Xb3JsZEJpbmFyeUZvcm1hdCJNCiNQcmVwYXJlU2Vzc2lvbldvcmxkRG93bmxvYWRSZXNwb25zZRIUCgxkb3dubG9hZF91cmwYASABKAkSEAoIZmlsZW5hbWUYAiABKAkiaAoRSW52aXRlVXNlclJlcXVlc3QSDwoHaG9zdF9pZBgBIAEoCRISCgpzZXNzaW9uX2lkGAIgASgJEhEKB3VzZXJfaWQYAyABKAlIABITCgl1c2VyX25hbWUYBCABKAlIAEIGCgR1c2VyIhQKEkludml0ZVVzZXJSZXNwb25zZSJgChVVcGRhdGVVc2VyUm9sZVJlcXVlc3QSDwoHaG9zdF9pZBgBIAEoCRI2CgpwYXJhbWV0ZXJzGAIgASgLMiIuaGVhZGxlc3MudjEuVXBkYXRlVXNlclJvbGVSZXF1ZXN0IiYKFlVwZGF0ZVVzZXJSb2xlUmVzcG9uc2USDAoEcm9sZRgBIAEoCSJyCh5VcGRhdGVTZXNzaW9uUGFyYW1ldGVyc1JlcXVlc3QSDwoHaG9zdF9pZBgBIAEoCRI/CgpwYXJhbWV0ZXJzGAIgASgLMisuaGVhZGxlc3MudjEuVXBkYXRlU2Vzc2lvblBhcmFtZXRlcnNSZXF1ZXN0IiEKH1VwZGF0ZVNlc3Npb25QYXJhbWV0ZXJzUmVzcG9uc2UifwohVXBkYXRlU2Vzc2lvbkV4dHJhU2V0dGluZ3NSZXF1ZXN0EhIKCnNlc3Npb25faWQYASABKAkSGQoMYXV0b191cGdyYWRlGAIgASgISACIAQESEQoEbWVtbxgDIAEoCUgBiAEBQg8KDV9hdXRvX3VwZ3JhZGVCBwoFX21lbW8iJAoiVXBkYXRlU2Vzc2lvbkV4dHJhU2V0dGluZ3NSZXNwb25zZSJAChlMaXN0VXNlcnNJblNlc3Npb25SZXF1ZXN0Eg8KB2hvc3RfaWQYASABKAkSEgoKc2Vzc2lvbl9pZBgCIAEoCSJHChpMaXN0VXNlcnNJblNlc3Npb25SZXNwb25zZRIpCgV1c2VycxgBIAMoCzIaLmhlYWRsZXNzLnYxLlVzZXJJblNlc3Npb24iNAoLUGFnZVJlcXVlc3QSEgoKcGFnZV9pbmRleBgBIAEoBRIRCglwYWdlX3NpemUYAiABKAUiSgoMUGFnZVJlc3BvbnNlEhMKC3RvdGFsX2NvdW50GAEgASgFEhIKCnBhZ2VfaW5kZXgYAiABKAUSEQoJcGFnZV9zaXplGAMgASgFIocCChRIZWFkbGVzc0hvc3RTZXR0aW5ncxIYCgt1bml2ZXJzZV9pZBgBIAEoCUgAiAEBEhEKCXRpY2tfcmF0ZRgCIAEoAhImCh5tYXhfY29uY3VycmVudF9hc3NldF90cmFuc2ZlcnMYAyABKAUSHgoRdXNlcm5hbWVfb3ZlcnJpZGUYBCABKAlIAYgBARI6ChFhbGxvd2VkX3VybF9ob3N0cxgFIAMoCzIfLmhlYWRsZXNzLnYxLkFsbG93ZWRBY2Nlc3NFbnRyeRIYChBhdXRvX3NwYXduX2l0ZW1zGAYgAygJQg4KDF91bml2ZXJzZV9pZEIUChJfdXNlcm5hbWVfb3ZlcnJpZGUipgMKDEhlYWRsZXNzSG9zdBIKCgJpZBgBIAEoCRIMCgRuYW1lGAIgASgJEhgKEHJlc29uaXRlX3ZlcnNpb24YBCABKAkSEwoLYXBwX3ZlcnNpb24YCyABKAkSEgoKYWNjb3VudF9pZBgFIAEoCRIUCgxhY2NvdW50X25hbWUYBiABKAkSCwoDZnBzGAcgASgCEi4KBnN0YXR1cxgKIAEoDjIeLmhkbGN0cmwudjEuSGVhZGxlc3NIb3N0U3RhdHVzEkQKEmF1dG9fdXBkYXRlX3BvbGljeRgMIAEoDjIoLmhkbGN0cmwudjEuSGVhZGxlc3NIb3N0QXV0b1VwZGF0ZVBvbGljeRIMCgRtZW1vGA0gASgJEjcKDWhvc3Rfc2V0dGluZ3MYDiABKAsyIC5oZGxjdHJsLnYxLkhlYWRsZXNzSG9zdFNldHRpbmdzEhMKC2luc3RhbmNlX2lkGA8gASgFEhAKCGdyb3VwX2lkGBAgASgJEhcKCmNyZWF0ZWRfYnkYESABKAlIAIgBAUINCgtfY3JlYXRlZF9ieUoECAgQCUoECAkQCiLaAwoHU2Vzc2lvbhIKCgJpZBgBIAEoCRIMCgRuYW1lGAIgASgJEg8KB2hvc3RfaWQYAyABKAkSKQoGc3RhdHVzGAQgASgOMhkuaGRsY3RybC52MS5TZXNzaW9uU3RhdHVzEi4KCnN0YXJ0ZWRfYXQYBSABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wEjEKCGVuZGVkX2F0GAYgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcEgAiAEBEj8KEnN0YXJ0dXBfcGFyYW1ldGVycxgHIAEoCzIjLmhlYWRsZXNzLnYxLldvcmxkU3RhcnR1cFBhcmFtZXRlcnMSMAoNY3VycmVudF9zdGF0ZRgIIAEoCzIULmhlYWRsZXNzLnYxLlNlc3Npb25IAYgBARIZCghvd25lcl9pZBgJIAEoCUICGAFIAogBARIUCgxhdXRvX3VwZ3JhZGUYCiABKAgSDAoEbWVtbxgLIAEoCRIQCghncm91cF9pZBgMIAEoCRIXCgpjcmVhdGVkX2J5GA0gASgJSAOIAQFCCwoJX2VuZGVkX2F0QhAKDl9jdXJyZW50X3N0YXRlQgsKCV9vd25lcl9pZEINCgtfY3JlYXRlZF9ieSKBAQoPSGVhZGxlc3NBY2NvdW50Eg8KB3VzZXJfaWQYASABKAkSEQoJdXNlcl9uYW1lGAIgASgJEhAKCGljb25fdXJsGAMgASgJEhAKCGdyb3VwX2lkGAQgASgJEhcKCmNyZWF0ZWRfYnkYBSABKAlIAIgBAUINCgtfY3JlYXRlZF9ieSI2CghVc2VySW5mbxIKCgJpZBgBIAEoCRIMCgRuYW1lGAIgASgJEhAKCGljb25fdXJsGAMgASgJIi0KFkdldFJlc29uaXRlVXNlclJlcXVlc3QSEwoLcmVzb25pdGVfaWQYASABKAkiRQoXR2V0UmVzb25pdGVVc2VyUmVzcG9uc2USCgoCaWQYASABKAkSDAoEbmFtZRgCIAEoCRIQCghpY29uX3VybBgDIAEoCSJhChNMaXN0Q29udGFjdHNSZXF1ZXN0EhsKE2hlYWRsZXNzX2FjY291bnRfaWQYASABKAkSDQoFbGltaXQYAiABKAUSEwoGY3Vyc29yGAMgASgJSACIAQFCCQoHX2N1cnNvciJoChRMaXN0Q29udGFjdHNSZXNwb25zZRImCghjb250YWN0cxgBIAMoCzIULmhkbGN0cmwudjEuVXNlckluZm8SGAoLbmV4dF9jdXJzb3IYAiABKAlIAIgBAUIOCgxfbmV4dF9jdXJzb3IiqgEKGUdldENvbnRhY3RNZXNzYWdlc1JlcXVlc3QSGwoTaGVhZGxlc3NfYWNjb3VudF9pZBgBIAEoCRIXCg9jb250YWN0X3VzZXJfaWQYAiABKAkSDQoFbGltaXQYAyABKAUSFgoJYmVmb3JlX2lkGAQgASgJSACIAQESFQoIYWZ0ZXJfaWQYBSABKAlIAYgBAUIMCgpfYmVmb3JlX2lkQgsKCV9hZnRlcl9pZCJ7ChpHZXRDb250YWN0TWVzc2FnZXNSZXNwb25zZRIsCghtZXNzYWdlcxgBIAMoCzIaLmhkbGN0cmwudjEuQ29udGFjdE1lc3NhZ2USFwoPaGFzX21vcmVfYmVmb3JlGAIgASgIEhYKDmhhc19tb3JlX2FmdGVyGAMgASgIIukBCg5Db250YWN0TWVzc2FnZRIKCgJpZBgBIAEoCRIxCgR0eXBlGAIgASgOMiMuaGVhZGxlc3MudjEuQ29udGFjdENoYXRNZXNzYWdlVHlwZRIPCgdjb250ZW50GAMgASgJEi0KCXNlbmRfdGltZRgEIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXASMgoJcmVhZF90aW1lGAUgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcEgAiAEBEhYKDmlzX293bl9tZXNzYWdlGAYgASgIQgwKCl9yZWFkX3RpbWUiYgoZU2VuZENvbnRhY3RNZXNzYWdlUmVxdWVzdBIbChNoZWFkbGVzc19hY2NvdW50X2lkGAEgASgJEhcKD2NvbnRhY3RfdXNlcl9pZBgCIAEoCRIPCgdtZXNzYWdlGAMgASgJIhwKGlNlbmRDb250YWN0TWVzc2FnZVJlc3BvbnNlIqoCChJTY2hlZHVsZWRPcGVyYXRpb24SNgoNc3RhcnRfc2Vzc2lvbhgBIAEoCzIdLmhkbGN0cmwudjEuU3RhcnRXb3JsZFJlcXVlc3RIABI2CgxzdG9wX3Nlc3Npb24YAiABKAsyHi5oZGxjdHJsLnYxLlN0b3BTZXNzaW9uUmVxdWVzdEgAEkcKEXVwZGF0ZV9wYXJhbWV0ZXJzGAMgASgLMiouaGRsY3RybC52MS5VcGRhdGVTZXNzaW9uUGFyYW1ldGVyc1JlcXVlc3RIABJOChV1cGRhdGVfZXh0cmFfc2V0dGluZ3MYBCABKAsyLS5oZGxjdHJsLnYxLlVwZGF0ZVNlc3Npb25FeHRyYVNldHRpbmdzUmVxdWVzdEgAQgsKCW9wZXJhdGlvbiKJAQoQU2NoZWR1bGVkVHJpZ2dlchInCgR0aW1lGAEgASgLMhcuaGRsY3RybC52MS5UaW1lVHJpZ2dlckgAEkEKEnNlc3Npb25fdXNlcl9jb3VudBgCIAEoCzIjLmhkbGN0cmwudjEuU2Vzc2lvblVzZXJDb3VudFRyaWdnZXJIAEIJCgd0cmlnZ2VyIj8KC1RpbWVUcmlnZ2VyEjAKDHNjaGVkdWxlZF9hdBgBIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXAi7QEKF1Nlc3Npb25Vc2VyQ291bnRUcmlnZ2VyEhIKCnNlc3Npb25faWQYASABKAkSQgoKY29tcGFyYXRvchgCIAEoDjIuLmhkbGN0cmwudjEuU2Vzc2lvblVzZXJDb3VudFRyaWdnZXIuQ29tcGFyYXRvchIRCgl0aHJlc2hvbGQYAyABKAUiZwoKQ29tcGFyYXRvchIaChZDT01QQVJBVE9SX1VOU1BFQ0lGSUVEEAASHAoYQ09NUEFSQVRPUl9MRVNTX09SX0VRVUFMEAESHwobQ09NUEFSQVRPUl9HUkVBVEVSX09SX0VRVUFMEAIisQQKGVNjaGVkdWxlZFNlc3Npb25PcGVyYXRpb24SCgoCaWQYASABKAkSMQoJb3BlcmF0aW9uGAIgASgLMh4uaGRsY3RybC52MS5TY2hlZHVsZWRPcGVyYXRpb24SLQoHdHJpZ2dlchgDIAEoCzIcLmhkbGN0cmwudjEuU2NoZWR1bGVkVHJpZ2dlchIwCgxuZXh0X2ZpcmVfYXQYBCABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wEhQKB2hvc3RfaWQYBSABKAlIAIgBARIXCgpzZXNzaW9uX2lkGAYgASgJSAGIAQESNAoGc3RhdHVzGAcgASgOMiQuaGRsY3RybC52MS5TY2hlZHVsZWRPcGVyYXRpb25TdGF0dXMSFwoKbGFzdF9lcnJvchgIIAEoCUgCiAEBEjQKC2V4ZWN1dGVkX2F0GAkgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcEgDiAEBEhcKCmNyZWF0ZWRfYnkYCiABKAlIBIgBARIuCgpjcmVhdGVkX2F0GAsgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcBIuCgp1cGRhdGVkX2F0GAwgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcEIKCghfaG9zdF9pZEINCgtfc2Vzc2lvbl9pZEINCgtfbGFzdF9lcnJvckIOCgxfZXhlY3V0ZWRfYXRCDQoLX2NyZWF0ZWRfYnkiigEKJkNyZWF0ZVNjaGVkdWxlZFNlc3Npb25PcGVyYXRpb25SZXF1ZXN0EjEKCW9wZXJhdGlvbhgBIAEoCzIeLmhkbGN0cmwudjEuU2NoZWR1bGVkT3BlcmF0aW9uEi0KB3RyaWdnZXIYAiABKAsyHC5oZGxjdHJsLnYxLlNjaGVkdWxlZFRyaWdnZXIibQonQ3JlYXRlU2NoZWR1bGVkU2Vzc2lvbk9wZXJhdGlvblJlc3BvbnNlEkIKE3NjaGVkdWxlZF9vcGVyYXRpb24YASABKAsyJS5oZGxjdHJsLnYxLlNjaGVkdWxlZFNlc3Npb25PcGVyYXRpb24iggIKJUxpc3RTY2hlZHVsZWRTZXNzaW9uT3BlcmF0aW9uc1JlcXVlc3QSFwoKc2Vzc2lvbl9pZBgBIAEoCUgAiAEBEhQKB2hvc3RfaWQYAiABKAlIAYgBARI5CgZzdGF0dXMYAyABKA4yJC5oZGxjdHJsLnYxLlNjaGVkdWxlZE9wZXJhdGlvblN0YXR1c0gCiAEBEiUKBHBhZ2UYBCABKAsyFy5oZGxjdHJsLnYxLlBhZ2VSZXF1ZXN0EhUKCGdyb3VwX2lkGAUgASgJSAOIAQFCDQoLX3Nlc3Npb25faWRCCgoIX2hvc3RfaWRCCQoHX3N0YXR1c0ILCglfZ3JvdXBfaWQilQEKJkxpc3RTY2hlZHVsZWRTZXNzaW9uT3BlcmF0aW9uc1Jlc3BvbnNlEkMKFHNjaGVkdWxlZF9vcGVyYXRpb25zGAEgAygLMiUuaGRsY3RybC52MS5TY2hlZHVsZWRTZXNzaW9uT3BlcmF0aW9uEiYKBHBhZ2UYAiABKAsyGC5oZGxjdHJsLnYxLlBhZ2VSZXNwb25zZSI0CiZDYW5jZWxTY2hlZHVsZWRTZXNzaW9uT3BlcmF0aW9uUmVxdWVzdBIKCgJpZBgBIAEoCSIpCidDYW5jZWxTY2hlZHVsZWRTZXNzaW9uT3BlcmF0aW9uUmVzcG9uc2Uq4QEKEkhlYWRsZXNzSG9zdFN0YXR1cxIgChxIRUFETEVTU19IT1NUX1NUQVRVU19VTktOT1dOEAASIQodSEVBRExFU1NfSE9TVF9TVEFUVVNfU1RBUlRJTkcQARIgChxIRUFETEVTU19IT1NUX1NUQVRVU19SVU5OSU5HEAISIQodSEVBRExFU1NfSE9TVF9TVEFUVVNfU1RPUFBJTkcQAxIfChtIRUFETEVTU19IT1NUX1NUQVRVU19FWElURUQQBBIgChxIRUFETEVTU19IT1NUX1NUQVRVU19DUkFTSEVEEAUqmgEKDVNlc3Npb25TdGF0dXMSGgoWU0VTU0lPTl9TVEFUVVNfVU5LTk9XThAAEhsKF1NFU1NJT05fU1RBVFVTX1NUQVJUSU5HEAESGgoWU0VTU0lPTl9TVEFUVVNfUlVOTklORxACEhgKFFNFU1NJT05fU1RBVFVTX0VOREVEEAMSGgoWU0VTU0lPTl9TVEFUVVNfQ1JBU0hFRBAEKqoBChxIZWFkbGVzc0hvc3RBdXRvVXBkYXRlUG9saWN5EiwKKEhFQURMRVNTX0hPU1RfQVVUT19VUERBVEVfUE9MSUNZX1VOS05PV04QABIqCiZIRUFETEVTU19IT1NUX0FVVE9fVVBEQVRFX1BPTElDWV9ORVZFUhABEjAKLEhFQURMRVNTX0hPU1RfQVVUT19VUERBVEVfUE9MSUNZX1VTRVJTX0VNUFRZEAIqkAIKGFNjaGVkdWxlZE9wZXJhdGlvblN0YXR1cxIqCiZTQ0hFRFVMRURfT1BFUkFUSU9OX1NUQVRVU19VTlNQRUNJRklFRBAAEiYKIlNDSEVEVUxFRF9PUEVSQVRJT05fU1RBVFVTX1BFTkRJTkcQARImCiJTQ0hFRFVMRURfT1BFUkFUSU9OX1NUQVRVU19SVU5OSU5HEAISKAokU0NIRURVTEVEX09QRVJBVElPTl9TVEFUVVNfU1VDQ0VFREVEEAMSJQohU0NIRURVTEVEX09QRVJBVElPTl9TVEFUVVNfRkFJTEVEEAQSJwojU0NIRURVTEVEX09QRVJBVElPTl9TVEFUVVNfQ0FOQ0VMRUQQBTKRJwoRQ29udHJvbGxlclNlcnZpY2USXQoQTGlzdEhlYWRsZXNzSG9zdBIjLmhkbGN0cmwudjEuTGlzdEhlYWRsZXNzSG9zdFJlcXVlc3QaJC5oZGxjdHJsLnYxLkxpc3RIZWFkbGVzc0hvc3RSZXNwb25zZRJaCg9HZXRIZWFkbGVzc0hvc3QSIi5oZGxjdHJsLnYxLkdldEhlYWRsZXNzSG9zdFJlcXVlc3QaIy5oZGxjdHJsLnYxLkdldEhlYWRsZXNzSG9zdFJlc3BvbnNlEmYKE0dldEhlYWRsZXNzSG9zdExvZ3MSJi5oZGxjdHJsLnYxLkdldEhlYWRsZXNzSG9zdExvZ3NSZXF1ZXN0GicuaGRsY3RybC52MS5HZXRIZWFkbGVzc0hvc3RMb2dzUmVzcG9uc2USaQoUU2h1dGRvd25IZWFkbGVzc0hvc3QSJy5oZGxjdHJsLnYxLlNodXRkb3duSGVhZGxlc3NIb3N0UmVxdWVzdBooLmhkbGN0cmwudjEuU2h1dGRvd25IZWFkbGVzc0hvc3RSZXNwb25zZRJdChBLaWxsSGVhZGxlc3NIb3N0EiMuaGRsY3RybC52MS5LaWxsSGVhZGxlc3NIb3N0UmVxdWVzdBokLmhkbGN0cmwudjEuS2lsbEhlYWRsZXNzSG9zdFJlc3BvbnNlEnsKGlVwZGF0ZUhlYWRsZXNzSG9zdFNldHRpbmdzEi0uaGRsY3RybC52MS5VcGRhdGVIZWFkbGVzc0hvc3RTZXR0aW5nc1JlcXVlc3QaLi5oZGxjdHJsLnYxLlVwZGF0ZUhlYWRsZXNzSG9zdFNldHRpbmdzUmVzcG9uc2USZgoTUmVzdGFydEhlYWRsZXNzSG9zdBImLmhkbGN0cmwudjEuUmVzdGFydEhlYWRsZXNzSG9zdFJlcXVlc3QaJy5oZGxjdHJsLnYxLlJlc3RhcnRIZWFkbGVzc0hvc3RSZXNwb25zZRJgChFTdGFydEhlYWRsZXNzSG9zdBIkLmhkbGN0cmwudjEuU3RhcnRIZWFkbGVzc0hvc3RSZXF1ZXN0GiUuaGRsY3RybC52MS5TdGFydEhlYWRsZXNzSG9zdFJlc3BvbnNlEloKD0FsbG93SG9zdEFjY2VzcxIiLmhkbGN0cmwudjEuQWxsb3dIb3N0QWNjZXNzUmVxdWVzdBojLmhkbGN0cmwudjEuQWxsb3dIb3N0QWNjZXNzUmVzcG9uc2USVwoORGVueUhvc3RBY2Nlc3MSIS5oZGxjdHJsLnYxLkRlbnlIb3N0QWNjZXNzUmVxdWVzdBoiLmhkbGN0cmwudjEuRGVueUhvc3RBY2Nlc3NSZXNwb25zZRJ4ChlMaXN0SGVhZGxlc3NIb3N0SW1hZ2VUYWdzEiwuaGRsY3RybC52MS5MaXN0SGVhZGxlc3NIb3N0SW1hZ2VUYWdzUmVxdWVzdBotLmhkbGN0cmwudjEuTGlzdEhlYWRsZXNzSG9zdEltYWdlVGFnc1Jlc3BvbnNlEmMKEkRlbGV0ZUhlYWRsZXNzSG9zdBIlLmhkbGN0cmwudjEuRGVsZXRlSGVhZGxlc3NIb3N0UmVxdWVzdBomLmhkbGN0cmwudjEuRGVsZXRlSGVhZGxlc3NIb3N0UmVzcG9uc2USeAoZTGlzdEhlYWRsZXNzSG9zdEluc3RhbmNlcxIsLmhkbGN0cmwudjEuTGlzdEhlYWRsZXNzSG9zdEluc3RhbmNlc1JlcXVlc3QaLS5oZGxjdHJsLnYxLkxpc3RIZWFkbGVzc0hvc3RJbnN0YW5jZXNSZXNwb25zZRJsChVDcmVhdGVIZWFkbGVzc0FjY291bnQSKC5oZGxjdHJsLnYxLkNyZWF0ZUhlYWRsZXNzQWNjb3VudFJlcXVlc3QaKS5oZGxjdHJsLnYxLkNyZWF0ZUhlYWRsZXNzQWNjb3VudFJlc3BvbnNlEmkKFExpc3RIZWFkbGVzc0FjY291bnRzEicuaGRsY3RybC52MS5MaXN0SGVhZGxlc3NBY2NvdW50c1JlcXVlc3QaKC5oZGxjdHJsLnYxLkxpc3RIZWFkbGVzc0FjY291bnRzUmVzcG9uc2USbAoVRGVsZXRlSGVhZGxlc3NBY2NvdW50EiguaGRsY3RybC52MS5EZWxldGVIZWFkbGVzc0FjY291bnRSZXF1ZXN0GikuaGRsY3RybC52MS5EZWxldGVIZWFkbGVzc0FjY291bnRSZXNwb25zZRKNAQogVXBkYXRlSGVhZGxlc3NBY2NvdW50Q3JlZGVudGlhbHMSMy5oZGxjdHJsLnYxLlVwZGF0ZUhlYWRsZXNzQWNjb3VudENyZWRlbnRpYWxzUmVxdWVzdBo0LmhkbGN0cmwudjEuVXBkYXRlSGVhZGxlc3NBY2NvdW50Q3JlZGVudGlhbHNSZXNwb25zZRKEAQodR2V0SGVhZGxlc3NBY2NvdW50U3RvcmFnZUluZm8SMC5oZGxjdHJsLnYxLkdldEhlYWRsZXNzQWNjb3VudFN0b3JhZ2VJbmZvUmVxdWVzdBoxLmhkbGN0cmwudjEuR2V0SGVhZGxlc3NBY2NvdW50U3RvcmFnZUluZm9SZXNwb25zZRJ7ChpSZWZldGNoSGVhZGxlc3NBY2NvdW50SW5mbxItLmhkbGN0cmwudjEuUmVmZXRjaEhlYWRsZXNzQWNjb3VudEluZm9SZXF1ZXN0Gi4uaGRsY3RybC52MS5SZWZldGNoSGVhZGxlc3NBY2NvdW50SW5mb1Jlc3BvbnNlEngKGVVwZGF0ZUhlYWRsZXNzQWNjb3VudEljb24SLC5oZGxjdHJsLnYxLlVwZGF0ZUhlYWRsZXNzQWNjb3VudEljb25SZXF1ZXN0Gi0uaGRsY3RybC52MS5VcGRhdGVIZWFkbGVzc0FjY291bnRJY29uUmVzcG9uc2USWAoORmV0Y2hXb3JsZEluZm8SIS5oZGxjdHJsLnYxLkZldGNoV29ybGRJbmZvUmVxdWVzdBojLmhlYWRsZXNzLnYxLkZldGNoV29ybGRJbmZvUmVzcG9uc2USWAoOU2VhcmNoVXNlckluZm8SIS5oZGxjdHJsLnYxLlNlYXJjaFVzZXJJbmZvUmVxdWVzdBojLmhlYWRsZXNzLnYxLlNlYXJjaFVzZXJJbmZvUmVzcG9uc2USUQoMU2VhcmNoV29ybGRzEh8uaGRsY3RybC52MS5TZWFyY2hXb3JsZHNSZXF1ZXN0GiAuaGRsY3RybC52MS5TZWFyY2hXb3JsZHNSZXNwb25zZRJRCgxHZXRPd25Xb3JsZHMSHy5oZGxjdHJsLnYxLkdldE93bldvcmxkc1JlcXVlc3QaIC5oZGxjdHJsLnYxLkdldE93bldvcmxkc1Jlc3BvbnNlEloKD0dldFJlc29uaXRlVXNlchIiLmhkbGN0cmwudjEuR2V0UmVzb25pdGVVc2VyUmVxdWVzdBojLmhkbGN0cmwudjEuR2V0UmVzb25pdGVVc2VyUmVzcG9uc2USYAoRR2V0RnJpZW5kUmVxdWVzdHMSJC5oZGxjdHJsLnYxLkdldEZyaWVuZFJlcXVlc3RzUmVxdWVzdBolLmhkbGN0cmwudjEuR2V0RnJpZW5kUmVxdWVzdHNSZXNwb25zZRJpChRBY2NlcHRGcmllbmRSZXF1ZXN0cxInLmhkbGN0cmwudjEuQWNjZXB0RnJpZW5kUmVxdWVzdHNSZXF1ZXN0GiguaGRsY3RybC52MS5BY2NlcHRGcmllbmRSZXF1ZXN0c1Jlc3BvbnNlElEKDExpc3RDb250YWN0cxIfLmhkbGN0cmwudjEuTGlzdENvbnRhY3RzUmVxdWVzdBogLmhkbGN0cmwudjEuTGlzdENvbnRhY3RzUmVzcG9uc2USYwoSR2V0Q29udGFjdE1lc3NhZ2VzEiUuaGRsY3RybC52MS5HZXRDb250YWN0TWVzc2FnZXNSZXF1ZXN0GiYuaGRsY3RybC52MS5HZXRDb250YWN0TWVzc2FnZXNSZXNwb25zZRJjChJTZW5kQ29udGFjdE1lc3NhZ2USJS5oZGxjdHJsLnYxLlNlbmRDb250YWN0TWVzc2FnZVJlcXVlc3QaJi5oZGxjdHJsLnYxLlNlbmRDb250YWN0TWVzc2FnZVJlc3BvbnNlElcKDlNlYXJjaFNlc3Npb25zEiEuaGRsY3RybC52MS5TZWFyY2hTZXNzaW9uc1JlcXVlc3QaIi5oZGxjdHJsLnYxLlNlYXJjaFNlc3Npb25zUmVzcG9uc2USYAoRR2V0U2Vzc2lvbkRldGFpbHMSJC5oZGxjdHJsLnYxLkdldFNlc3Npb25EZXRhaWxzUmVxdWVzdBolLmhkbGN0cmwudjEuR2V0U2Vzc2lvbkRldGFpbHNSZXNwb25zZRJLCgpTdGFydFdvcmxkEh0uaGRsY3RybC52MS5TdGFydFdvcmxkUmVxdWVzdBoeLmhkbGN0cmwudjEuU3RhcnRXb3JsZFJlc3BvbnNlEk4KC1N0b3BTZXNzaW9uEh4uaGRsY3RybC52MS5TdG9wU2Vzc2lvblJlcXVlc3QaHy5oZGxjdHJsLnYxLlN0b3BTZXNzaW9uUmVzcG9uc2USYwoSRGVsZXRlRW5kZWRTZXNzaW9uEiUuaGRsY3RybC52MS5EZWxldGVFbmRlZFNlc3Npb25SZXF1ZXN0GiYuaGRsY3RybC52MS5EZWxldGVFbmRlZFNlc3Npb25SZXNwb25zZRJdChBTYXZlU2Vzc2lvbldvcmxkEiMuaGRsY3RybC52MS5TYXZlU2Vzc2lvbldvcmxkUmVxdWVzdBokLmhkbGN0cmwudjEuU2F2ZVNlc3Npb25Xb3JsZFJlc3BvbnNlEn4KG1ByZXBhcmVTZXNzaW9uV29ybGREb3dubG9hZBIuLmhkbGN0cmwudjEuUHJlcGFyZVNlc3Npb25Xb3JsZERvd25sb2FkUmVxdWVzdBovLmhkbGN0cmwudjEuUHJlcGFyZVNlc3Npb25Xb3JsZERvd25sb2FkUmVzcG9uc2USSwoKSW52aXRlVXNlchIdLmhkbGN0cmwudjEuSW52aXRlVXNlclJlcXVlc3QaHi5oZGxjdHJsLnYxLkludml0ZVVzZXJSZXNwb25zZRJXCg5VcGRhdGVVc2VyUm9sZRIhLmhkbGN0cmwudjEuVXBkYXRlVXNlclJvbGVSZXF1ZXN0GiIuaGRsY3RybC52MS5VcGRhdGVVc2VyUm9sZVJlc3BvbnNlEnIKF1VwZGF0ZVNlc3Npb25QYXJhbWV0ZXJzEiouaGRsY3RybC52MS5VcGRhdGVTZXNzaW9uUGFyYW1ldGVyc1JlcXVlc3QaKy5oZGxjdHJsLnYxLlVwZGF0ZVNlc3Npb25QYXJhbWV0ZXJzUmVzcG9uc2USewoaVXBkYXRlU2Vzc2lvbkV4dHJhU2V0dGluZ3MSLS5oZGxjdHJsLnYxLlVwZGF0ZVNlc3Npb25FeHRyYVNldHRpbmdzUmVxdWVzdBouLmhkbGN0cmwudjEuVXBkYXRlU2Vzc2lvbkV4dHJhU2V0dGluZ3NSZXNwb25zZRJjChJMaXN0VXNlcnNJblNlc3Npb24SJS5oZGxjdHJsLnYxLkxpc3RVc2Vyc0luU2Vzc2lvblJlcXVlc3QaJi5oZGxjdHJsLnYxLkxpc3RVc2Vyc0luU2Vzc2lvblJlc3BvbnNlEkUKCEtpY2tVc2VyEhsuaGRsY3RybC52MS5LaWNrVXNlclJlcXVlc3QaHC5oZGxjdHJsLnYxLktpY2tVc2VyUmVzcG9uc2USQgoHQmFuVXNlchIaLmhkbGN0cmwudjEuQmFuVXNlclJlcXVlc3QaGy5oZGxjdHJsLnYxLkJhblVzZXJSZXNwb25zZRJ+ChtJc3N1ZVJlc29uaXRlTGlua0Nvbm5lY3Rpb24SLi5oZGxjdHJsLnYxLklzc3VlUmVzb25pdGVMaW5rQ29ubmVjdGlvblJlcXVlc3QaLy5oZGxjdHJsLnYxLklzc3VlUmVzb25pdGVMaW5rQ29ubmVjdGlvblJlc3BvbnNlEooBCh9DcmVhdGVTY2hlZHVsZWRTZXNzaW9uT3BlcmF0aW9uEjIuaGRsY3RybC52MS5DcmVhdGVTY2hlZHVsZWRTZXNzaW9uT3BlcmF0aW9uUmVxdWVzdBozLmhkbGN0cmwudjEuQ3JlYXRlU2NoZWR1bGVkU2Vzc2lvbk9wZXJhdGlvblJlc3BvbnNlEocBCh5MaXN0U2NoZWR1bGVkU2Vzc2lvbk9wZXJhdGlvbnMSMS5oZGxjdHJsLnYxLkxpc3RTY2hlZHVsZWRTZXNzaW9uT3BlcmF0aW9uc1JlcXVlc3QaMi5oZGxjdHJsLnYxLkxpc3RTY2hlZHVsZWRTZXNzaW9uT3BlcmF0aW9uc1Jlc3BvbnNlEooBCh9DYW5jZWxTY2hlZHVsZWRTZXNzaW9uT3BlcmF0aW9uEjIuaGRsY3RybC52MS5DYW5jZWxTY2hlZHVsZWRTZXNzaW9uT3BlcmF0aW9uUmVxdWVzdBozLmhkbGN0cmwudjEuQ2FuY2VsU2NoZWR1bGVkU2Vzc2lvbk9wZXJhdGlvblJlc3BvbnNlQr0BCg5jb20uaGRsY3RybC52MUIPQ29udHJvbGxlclByb3RvUAFaUWdpdGh1Yi5jb20vaGFudGFiYXJ1MTAxNC9iYXJ1LXJlc28taGVhZGxlc3MtY29udHJvbGxlci9wYmdlbi9oZGxjdHJsL3YxO2hkbGN0cmx2MaICA0hYWKoCCkhkbGN0cmwuVjHKAgpIZGxjdHJsXFYx4gIWSGRsY3RybFxWMVxHUEJNZXRhZGF0YeoCC0hkbGN0cmw6OlYxYgZwcm90bzM", [file_google_protobuf_timestamp, file_headless_v1_headless]);
+  fileDesc("ChtoZGxjdHJsL3YxL2NvbnRyb2xsZXIucHJvdG8SCmhkbGN0cmwudjEiNwohUmVmZXRjaEhlYWRsZXNzQWNjb3VudEluZm9SZXF1ZXN0EhIKCmFjY291bnRfaWQYASABKAkiJAoiUmVmZXRjaEhlYWRsZXNzQWNjb3VudEluZm9SZXNwb25zZSJJCiBVcGRhdGVIZWFkbGVzc0FjY291bnRJY29uUmVxdWVzdBISCgphY2NvdW50X2lkGAEgASgJEhEKCWljb25fZGF0YRgCIAEoDCI5CiFVcGRhdGVIZWFkbGVzc0FjY291bnRJY29uUmVzcG9uc2USFAoMbmV3X2ljb25fdXJsGAEgASgJIjoKJEdldEhlYWRsZXNzQWNjb3VudFN0b3JhZ2VJbmZvUmVxdWVzdBISCgphY2NvdW50X2lkGAEgASgJImAKJUdldEhlYWRsZXNzQWNjb3VudFN0b3JhZ2VJbmZvUmVzcG9uc2USGwoTc3RvcmFnZV9xdW90YV9ieXRlcxgBIAEoAxIaChJzdG9yYWdlX3VzZWRfYnl0ZXMYAiABKAMiYwonVXBkYXRlSGVhZGxlc3NBY2NvdW50Q3JlZGVudGlhbHNSZXF1ZXN0EhIKCmFjY291bnRfaWQYASABKAkSEgoKY3JlZGVudGlhbBgCIAEoCRIQCghwYXNzd29yZBgDIAEoCSIqCihVcGRhdGVIZWFkbGVzc0FjY291bnRDcmVkZW50aWFsc1Jlc3BvbnNlIjIKHERlbGV0ZUhlYWRsZXNzQWNjb3VudFJlcXVlc3QSEgoKYWNjb3VudF9pZBgBIAEoCSIfCh1EZWxldGVIZWFkbGVzc0FjY291bnRSZXNwb25zZSIsChlEZWxldGVIZWFkbGVzc0hvc3RSZXF1ZXN0Eg8KB2hvc3RfaWQYASABKAkiHAoaRGVsZXRlSGVhZGxlc3NIb3N0UmVzcG9uc2UiMwogTGlzdEhlYWRsZXNzSG9zdEluc3RhbmNlc1JlcXVlc3QSDwoHaG9zdF9pZBgBIAEoCSKsAgohTGlzdEhlYWRsZXNzSG9zdEluc3RhbmNlc1Jlc3BvbnNlEkkKCWluc3RhbmNlcxgBIAMoCzI2LmhkbGN0cmwudjEuTGlzdEhlYWRsZXNzSG9zdEluc3RhbmNlc1Jlc3BvbnNlLkluc3RhbmNlGrsBCghJbnN0YW5jZRITCgtpbnN0YW5jZV9pZBgBIAEoBRIwCgxmaXJzdF9sb2dfYXQYAiABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wEi8KC2xhc3RfbG9nX2F0GAMgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcBIRCglsb2dfY291bnQYBCABKAMSEgoKaXNfY3VycmVudBgFIAEoCBIQCghhcmNoaXZlZBgGIAEoCCJfChZBbGxvd0hvc3RBY2Nlc3NSZXF1ZXN0Eg8KB2hvc3RfaWQYASABKAkSNAoHcmVxdWVzdBgCIAEoCzIjLmhlYWRsZXNzLnYxLkFsbG93SG9zdEFjY2Vzc1JlcXVlc3QiGQoXQWxsb3dIb3N0QWNjZXNzUmVzcG9uc2UiXQoVRGVueUhvc3RBY2Nlc3NSZXF1ZXN0Eg8KB2hvc3RfaWQYASABKAkSMwoHcmVxdWVzdBgCIAEoCzIiLmhlYWRsZXNzLnYxLkRlbnlIb3N0QWNjZXNzUmVxdWVzdCIYChZEZW55SG9zdEFjY2Vzc1Jlc3BvbnNlIrEFChhTdGFydEhlYWRsZXNzSG9zdFJlcXVlc3QSDAoEbmFtZRgBIAEoCRIbChNoZWFkbGVzc19hY2NvdW50X2lkGAIgASgJEhYKCWltYWdlX3RhZxgDIAEoCUgAiAEBEjcKDnN0YXJ0dXBfY29uZmlnGAQgASgLMhouaGVhZGxlc3MudjEuU3RhcnR1cENvbmZpZ0gBiAEBEkkKEmF1dG9fdXBkYXRlX3BvbGljeRgFIAEoDjIoLmhkbGN0cmwudjEuSGVhZGxlc3NIb3N0QXV0b1VwZGF0ZVBvbGljeUgCiAEBEhEKBG1lbW8YBiABKAlIA4gBARIVCghncm91cF9pZBgHIAEoCUgEiAEBEhQKB25vZGVfaWQYCCABKAlIBYgBARJKChJjb250YWluZXJfc2V0dGluZ3MYCSABKAsyKS5oZGxjdHJsLnYxLkhlYWRsZXNzSG9zdENvbnRhaW5lclNldHRpbmdzSAaIAQESSwoTYXV0b19yZXN0YXJ0X3BvbGljeRgKIAEoDjIpLmhkbGN0cmwudjEuSGVhZGxlc3NIb3N0QXV0b1Jlc3RhcnRQb2xpY3lIB4gBARIlChhhdXRvX3Jlc3RhcnRfbWF4X3JldHJpZXMYCyABKAVICIgBARIYCgt0ZW1wbGF0ZV9pZBgMIAEoCUgJiAEBQgwKCl9pbWFnZV90YWdCEQoPX3N0YXJ0dXBfY29uZmlnQhUKE19hdXRvX3VwZGF0ZV9wb2xpY3lCBwoFX21lbW9CCwoJX2dyb3VwX2lkQgoKCF9ub2RlX2lkQhUKE19jb250YWluZXJfc2V0dGluZ3NCFgoUX2F1dG9fcmVzdGFydF9wb2xpY3lCGwoZX2F1dG9fcmVzdGFydF9tYXhfcmV0cmllc0IOCgxfdGVtcGxhdGVfaWQiMQoZU3RhcnRIZWFkbGVzc0hvc3RSZXNwb25zZRIOCgZqb2JfaWQYAiABKAlKBAgBEAIi0AEKGENsb25lSGVhZGxlc3NIb3N0UmVxdWVzdBIWCg5zb3VyY2VfaG9zdF9pZBgBIAEoCRIRCgRuYW1lGAIgASgJSACIAQESIAoTaGVhZGxlc3NfYWNjb3VudF9pZBgDIAEoCUgBiAEBEhYKCWltYWdlX3RhZxgEIAEoCUgCiAEBEhQKB25vZGVfaWQYBSABKAlIA4gBAUIHCgVfbmFtZUIWChRfaGVhZGxlc3NfYWNjb3VudF9pZEIMCgpfaW1hZ2VfdGFnQgoKCF9ub2RlX2lkIisKGUNsb25lSGVhZGxlc3NIb3N0UmVzcG9uc2USDgoGam9iX2lkGAEgASgJIm4KHENyZWF0ZUhlYWRsZXNzQWNjb3VudFJlcXVlc3QSEgoKY3JlZGVudGlhbBgCIAEoCRIQCghwYXNzd29yZBgDIAEoCRIVCghncm91cF9pZBgEIAEoCUgAiAEBQgsKCV9ncm91cF9pZEoECAEQAiIfCh1DcmVhdGVIZWFkbGVzc0FjY291bnRSZXNwb25zZSJoChtMaXN0SGVhZGxlc3NBY2NvdW50c1JlcXVlc3QSJQoEcGFnZRgBIAEoCzIXLmhkbGN0cmwudjEuUGFnZVJlcXVlc3QSFQoIZ3JvdXBfaWQYAiABKAlIAIgBAUILCglfZ3JvdXBfaWQidQocTGlzdEhlYWRsZXNzQWNjb3VudHNSZXNwb25zZRItCghhY2NvdW50cxgBIAMoCzIbLmhkbGN0cmwudjEuSGVhZGxlc3NBY2NvdW50EiYKBHBhZ2UYAiABKAsyGC5oZGxjdHJsLnYxLlBhZ2VSZXNwb25zZSIiCiBMaXN0SGVhZGxlc3NIb3N0SW1hZ2VUYWdzUmVxdWVzdCLUAQohTGlzdEhlYWRsZXNzSG9zdEltYWdlVGFnc1Jlc3BvbnNlEkoKBHRhZ3MYASADKAsyPC5oZGxjdHJsLnYxLkxpc3RIZWFkbGVzc0hvc3RJbWFnZVRhZ3NSZXNwb25zZS5Db250YWluZXJJbWFnZRpjCg5Db250YWluZXJJbWFnZRILCgN0YWcYASABKAkSGAoQcmVzb25pdGVfdmVyc2lvbhgCIAEoCRIVCg1pc19wcmVyZWxlYXNlGAMgASgIEhMKC2FwcF92ZXJzaW9uGAQgASgJIl4KG0FjY2VwdEZyaWVuZFJlcXVlc3RzUmVxdWVzdBIbChNoZWFkbGVzc19hY2NvdW50X2lkGAMgASgJEhYKDnRhcmdldF91c2VyX2lkGAQgASgJSgQIARACSgQIAhADIh4KHEFjY2VwdEZyaWVuZFJlcXVlc3RzUmVzcG9uc2UiPQoYR2V0RnJpZW5kUmVxdWVzdHNSZXF1ZXN0EhsKE2hlYWRsZXNzX2FjY291bnRfaWQYAiABKAlKBAgBEAIiTQoZR2V0RnJpZW5kUmVxdWVzdHNSZXNwb25zZRIwChJyZXF1ZXN0ZWRfY29udGFjdHMYASADKAsyFC5oZGxjdHJsLnYxLlVzZXJJbmZvIsABChpSZXN0YXJ0SGVhZGxlc3NIb3N0UmVxdWVzdBIPCgdob3N0X2lkGAEgASgJEhMKC3dpdGhfdXBkYXRlGAIgASgIEhsKDndpdGhfaW1hZ2VfdGFnGAMgASgJSACIAQESGgoSd2l0aF93b3JsZF9yZXN0YXJ0GAQgASgIEhwKD3RpbWVvdXRfc2Vjb25kcxgFIAEoBUgBiAEBQhEKD193aXRoX2ltYWdlX3RhZ0ISChBfdGltZW91dF9zZWNvbmRzIjMKG1Jlc3RhcnRIZWFkbGVzc0hvc3RSZXNwb25zZRIOCgZqb2JfaWQYAiABKAlKBAgBEAIizwUKIVVwZGF0ZUhlYWRsZXNzSG9zdFNldHRpbmdzUmVxdWVzdBIPCgdob3N0X2lkGAEgASgJEhEKBG5hbWUYAiABKAlIAIgBARIWCgl0aWNrX3JhdGUYAyABKAJIAYgBARIrCh5tYXhfY29uY3VycmVudF9hc3NldF90cmFuc2ZlcnMYBCABKAVIAogBARIeChF1c2VybmFtZV9vdmVycmlkZRgFIAEoCUgDiAEBEh8KF3VwZGF0ZV9hdXRvX3NwYXduX2l0ZW1zGAYgASgIEhgKEGF1dG9fc3Bhd25faXRlbXMYByADKAkSGAoLdW5pdmVyc2VfaWQYCCABKAlIBIgBARJJChJhdXRvX3VwZGF0ZV9wb2xpY3kYCSABKA4yKC5oZGxjdHJsLnYxLkhlYWRsZXNzSG9zdEF1dG9VcGRhdGVQb2xpY3lIBYgBARJKChJjb250YWluZXJfc2V0dGluZ3MYCiABKAsyKS5oZGxjdHJsLnYxLkhlYWRsZXNzSG9zdENvbnRhaW5lclNldHRpbmdzSAaIAQESSwoTYXV0b19yZXN0YXJ0X3BvbGljeRgLIAEoDjIpLmhkbGN0cmwudjEuSGVhZGxlc3NIb3N0QXV0b1Jlc3RhcnRQb2xpY3lIB4gBARIlChhhdXRvX3Jlc3RhcnRfbWF4X3JldHJpZXMYDCABKAVICIgBAUIHCgVfbmFtZUIMCgpfdGlja19yYXRlQiEKH19tYXhfY29uY3VycmVudF9hc3NldF90cmFuc2ZlcnNCFAoSX3VzZXJuYW1lX292ZXJyaWRlQg4KDF91bml2ZXJzZV9pZEIVChNfYXV0b191cGRhdGVfcG9saWN5QhUKE19jb250YWluZXJfc2V0dGluZ3NCFgoUX2F1dG9fcmVzdGFydF9wb2xpY3lCGwoZX2F1dG9fcmVzdGFydF9tYXhfcmV0cmllcyIkCiJVcGRhdGVIZWFkbGVzc0hvc3RTZXR0aW5nc1Jlc3BvbnNlIi4KG1NodXRkb3duSGVhZGxlc3NIb3N0UmVxdWVzdBIPCgdob3N0X2lkGAEgASgJIi4KHFNodXRkb3duSGVhZGxlc3NIb3N0UmVzcG9uc2USDgoGam9iX2lkGAEgASgJIioKF0tpbGxIZWFkbGVzc0hvc3RSZXF1ZXN0Eg8KB2hvc3RfaWQYASABKAkiGgoYS2lsbEhlYWRsZXNzSG9zdFJlc3BvbnNlIqIBChpHZXRIZWFkbGVzc0hvc3RMb2dzUmVxdWVzdBIPCgdob3N0X2lkGAEgASgJEhMKC2luc3RhbmNlX2lkGAUgASgFEg0KBWxpbWl0GAYgASgFEhMKCWJlZm9yZV9pZBgJIAEoA0gAEhIKCGFmdGVyX2lkGAogASgDSABCCAoGY3Vyc29ySgQIAhADSgQIAxAESgQIBBAFSgQIBxAISgQICBAJIp0CChtHZXRIZWFkbGVzc0hvc3RMb2dzUmVzcG9uc2USOQoEbG9ncxgBIAMoCzIrLmhkbGN0cmwudjEuR2V0SGVhZGxlc3NIb3N0TG9nc1Jlc3BvbnNlLkxvZxIXCg9oYXNfbW9yZV9iZWZvcmUYAiABKAgSFgoOaGFzX21vcmVfYWZ0ZXIYAyABKAgakQEKA0xvZxItCgl0aW1lc3RhbXAYASABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wEhAKCGlzX2Vycm9yGAIgASgIEgwKBGJvZHkYAyABKAkSCgoCaWQYBCABKAMSLwoFbGV2ZWwYBSABKA4yIC5oZGxjdHJsLnYxLkhlYWRsZXNzSG9zdExvZ0xldmVsItABChtUYWlsSGVhZGxlc3NIb3N0TG9nc1JlcXVlc3QSDwoHaG9zdF9pZBgBIAEoCRITCgtpbnN0YW5jZV9pZBgCIAEoBRIPCgdiYWNrbG9nGAMgASgFEhUKCGFmdGVyX2lkGAQgASgDSACIAQESEAoIY29udGFpbnMYBSABKAkSDwoHcGF0dGVybhgGIAEoCRIzCgltaW5fbGV2ZWwYByABKA4yIC5oZGxjdHJsLnYxLkhlYWRsZXNzSG9zdExvZ0xldmVsQgsKCV9hZnRlcl9pZCKIAQocVGFpbEhlYWRsZXNzSG9zdExvZ3NSZXNwb25zZRI5CgRsb2dzGAEgAygLMisuaGRsY3RybC52MS5HZXRIZWFkbGVzc0hvc3RMb2dzUmVzcG9uc2UuTG9nEhMKC2luc3RhbmNlX2lkGAIgASgFEhgKEGJhY2tsb2dfY29tcGxldGUYAyABKAgi+gEKHVNlYXJjaEhlYWRsZXNzSG9zdExvZ3NSZXF1ZXN0Eg0KBXF1ZXJ5GAEgASgJEhUKCGdyb3VwX2lkGAIgASgJSACIAQESEAoIaG9zdF9pZHMYAyADKAkSLgoFc2luY2UYBCABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wSAGIAQESLgoFdW50aWwYBSABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wSAKIAQESDQoFbGltaXQYBiABKAUSEQoJYmVmb3JlX2lkGAcgASgDQgsKCV9ncm91cF9pZEIICgZfc2luY2VCCAoGX3VudGlsIvcBCh5TZWFyY2hIZWFkbGVzc0hvc3RMb2dzUmVzcG9uc2USQAoGZ3JvdXBzGAEgAygLMjAuaGRsY3RybC52MS5TZWFyY2hIZWFkbGVzc0hvc3RMb2dzUmVzcG9uc2UuR3JvdXASFgoObmV4dF9iZWZvcmVfaWQYAiABKAMaewoFR3JvdXASDwoHaG9zdF9pZBgBIAEoCRIRCglob3N0X25hbWUYAiABKAkSEwoLaW5zdGFuY2VfaWQYAyABKAUSOQoEbG9ncxgEIAMoCzIrLmhkbGN0cmwudjEuR2V0SGVhZGxlc3NIb3N0TG9nc1Jlc3BvbnNlLkxvZyL6AQolUHJlcGFyZUhlYWRsZXNzSG9zdExvZ0Rvd25sb2FkUmVxdWVzdBIPCgdob3N0X2lkGAEgASgJEhMKC2luc3RhbmNlX2lkGAIgASgFEjcKBmZvcm1hdBgDIAEoDjInLmhkbGN0cmwudjEuSGVhZGxlc3NIb3N0TG9nRXhwb3J0Rm9ybWF0Ei4KBXNpbmNlGAQgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcEgAiAEBEi4KBXVudGlsGAUgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcEgBiAEBQggKBl9zaW5jZUIICgZfdW50aWwiZAomUHJlcGFyZUhlYWRsZXNzSG9zdExvZ0Rvd25sb2FkUmVzcG9uc2USFAoMZG93bmxvYWRfdXJsGAEgASgJEhAKCGZpbGVuYW1lGAIgASgJEhIKCmxpbmVfY291bnQYAyABKAMiYAoVU2VhcmNoVXNlckluZm9SZXF1ZXN0Eg8KB2hvc3RfaWQYASABKAkSNgoKcGFyYW1ldGVycxgCIAEoCzIiLmhlYWRsZXNzLnYxLlNlYXJjaFVzZXJJbmZvUmVxdWVzdCJUCg9LaWNrVXNlclJlcXVlc3QSDwoHaG9zdF9pZBgBIAEoCRIwCgpwYXJhbWV0ZXJzGAIgASgLMhwuaGVhZGxlc3MudjEuS2lja1VzZXJSZXF1ZXN0IhIKEEtpY2tVc2VyUmVzcG9uc2UiUgoOQmFuVXNlclJlcXVlc3QSDwoHaG9zdF9pZBgBIAEoCRIvCgpwYXJhbWV0ZXJzGAIgASgLMhsuaGVhZGxlc3MudjEuQmFuVXNlclJlcXVlc3QiEQoPQmFuVXNlclJlc3BvbnNlIjgKIklzc3VlUmVzb25pdGVMaW5rQ29ubmVjdGlvblJlcXVlc3QSEgoKc2Vzc2lvbl9pZBgBIAEoCSJmCiNJc3N1ZVJlc29uaXRlTGlua0Nvbm5lY3Rpb25SZXNwb25zZRIPCgd3c19wYXRoGAEgASgJEi4KCmV4cGlyZXNfYXQYAiABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wIqMBChBTZXNzaW9uVXNlckV2ZW50EgoKAmlkGAEgASgDEi4KBGtpbmQYAiABKA4yIC5oZGxjdHJsLnYxLlNlc3Npb25Vc2VyRXZlbnRLaW5kEg8KB3VzZXJfaWQYAyABKAkSEQoJdXNlcl9uYW1lGAQgASgJEi8KC29jY3VycmVkX2F0GAUgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcCJTChxMaXN0U2Vzc2lvblVzZXJFdmVudHNSZXF1ZXN0EhIKCnNlc3Npb25faWQYASABKAkSEAoIYWZ0ZXJfaWQYAiABKAMSDQoFbGltaXQYAyABKAUiZAodTGlzdFNlc3Npb25Vc2VyRXZlbnRzUmVzcG9uc2USLAoGZXZlbnRzGAEgAygLMhwuaGRsY3RybC52MS5TZXNzaW9uVXNlckV2ZW50EhUKDW5leHRfYWZ0ZXJfaWQYAiABKAMi8QEKD1Nlc3Npb25BdHRlbmRlZRIPCgd1c2VyX2lkGAEgASgJEhEKCXVzZXJfbmFtZRgCIAEoCRIzCg9maXJzdF9qb2luZWRfYXQYAyABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wEjUKDGxhc3RfbGVmdF9hdBgEIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXBIAIgBARIYChBkdXJhdGlvbl9zZWNvbmRzGAUgASgDEhIKCmpvaW5fY291bnQYBiABKAUSDwoHcHJlc2VudBgHIAEoCEIPCg1fbGFzdF9sZWZ0X2F0IjEKG0dldFNlc3Npb25BdHRlbmRhbmNlUmVxdWVzdBISCgpzZXNzaW9uX2lkGAEgASgJItYBChxHZXRTZXNzaW9uQXR0ZW5kYW5jZVJlc3BvbnNlEi4KCWF0dGVuZGVlcxgBIAMoCzIbLmhkbGN0cmwudjEuU2Vzc2lvbkF0dGVuZGVlEhQKDHVuaXF1ZV91c2VycxgCIAEoBRISCgpwZWFrX3VzZXJzGAMgASgFEjAKB3BlYWtfYXQYBCABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wSACIAQESHgoWdG90YWxfZHVyYXRpb25fc2Vjb25kcxgFIAEoA0IKCghfcGVha19hdCKzAgobR2V0U2Vzc2lvblVzYWdlU3RhdHNSZXF1ZXN0EhUKCGdyb3VwX2lkGAEgASgJSACIAQESFAoHaG9zdF9pZBgCIAEoCUgBiAEBEikKBXNpbmNlGAMgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcBIpCgV1bnRpbBgEIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXASMQoIZ3JvdXBfYnkYBSABKA4yHy5oZGxjdHJsLnYxLlNlc3Npb25Vc2FnZUdyb3VwQnkSMgoIaW50ZXJ2YWwYBiABKA4yIC5oZGxjdHJsLnYxLlNlc3Npb25Vc2FnZUludGVydmFsEhEKCXRpbWVfem9uZRgHIAEoCUILCglfZ3JvdXBfaWRCCgoIX2hvc3RfaWQimgEKElNlc3Npb25Vc2FnZUJ1Y2tldBIpCgVzdGFydBgBIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXASEAoIc2Vzc2lvbnMYAiABKAUSFAoMdW5pcXVlX3VzZXJzGAMgASgFEhIKCnBlYWtfdXNlcnMYBCABKAUSHQoVdXNlcl9kdXJhdGlvbl9zZWNvbmRzGAUgASgDIpYBChJTZXNzaW9uVXNhZ2VTZXJpZXMSCwoDa2V5GAEgASgJEg0KBWxhYmVsGAIgASgJEi8KB2J1Y2tldHMYAyADKAsyHi5oZGxjdHJsLnYxLlNlc3Npb25Vc2FnZUJ1Y2tldBIUCgx1bmlxdWVfdXNlcnMYBCABKAUSHQoVdXNlcl9kdXJhdGlvbl9zZWNvbmRzGAUgASgDIk4KHEdldFNlc3Npb25Vc2FnZVN0YXRzUmVzcG9uc2USLgoGc2VyaWVzGAEgAygLMh4uaGRsY3RybC52MS5TZXNzaW9uVXNhZ2VTZXJpZXMi2gEKF0dldE1ldHJpY3NTZXJpZXNSZXF1ZXN0EiQKBGtpbmQYASABKA4yFi5oZGxjdHJsLnYxLk1ldHJpY0tpbmQSEQoJdGFyZ2V0X2lkGAIgASgJEikKBXNpbmNlGAMgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcBIpCgV1bnRpbBgEIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXASMAoKcmVzb2x1dGlvbhgFIAEoDjIcLmhkbGN0cmwudjEuTWV0cmljUmVzb2x1dGlvbiJyCgtNZXRyaWNQb2ludBImCgJhdBgBIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXASCwoDYXZnGAIgASgBEgsKA21pbhgDIAEoARILCgNtYXgYBCABKAESFAoMc2FtcGxlX2NvdW50GAUgASgFInUKGEdldE1ldHJpY3NTZXJpZXNSZXNwb25zZRIwCgpyZXNvbHV0aW9uGAEgASgOMhwuaGRsY3RybC52MS5NZXRyaWNSZXNvbHV0aW9uEicKBnBvaW50cxgCIAMoCzIXLmhkbGN0cmwudjEuTWV0cmljUG9pbnQiNQoVRmV0Y2hXb3JsZEluZm9SZXF1ZXN0Eg8KB2hvc3RfaWQYASABKAkSCwoDdXJsGAIgASgJIk8KE1NlYXJjaFdvcmxkc1JlcXVlc3QSDQoFcXVlcnkYASABKAkSFQoNZmVhdHVyZWRfb25seRgCIAEoCBISCgpwYWdlX2luZGV4GAMgASgFIvgBChRTZWFyY2hXb3JsZHNSZXNwb25zZRI9CgdyZWNvcmRzGAEgAygLMiwuaGRsY3RybC52MS5TZWFyY2hXb3JsZHNSZXNwb25zZS5Xb3JsZFJlY29yZBIQCghoYXNfbW9yZRgCIAEoCBqOAQoLV29ybGRSZWNvcmQSCgoCaWQYASABKAkSEAoIb3duZXJfaWQYAiABKAkSEgoKb3duZXJfbmFtZRgDIAEoCRIMCgRuYW1lGAQgASgJEhMKC2Rlc2NyaXB0aW9uGAUgASgJEhUKDXRodW1ibmFpbF91cmwYBiABKAkSEwoLaXNfZmVhdHVyZWQYByABKAgiOgoTR2V0T3duV29ybGRzUmVxdWVzdBIPCgdob3N0X2lkGAEgASgJEhIKCnBhZ2VfaW5kZXgYAiABKAUiZwoUR2V0T3duV29ybGRzUmVzcG9uc2USPQoHcmVjb3JkcxgBIAMoCzIsLmhkbGN0cmwudjEuU2VhcmNoV29ybGRzUmVzcG9uc2UuV29ybGRSZWNvcmQSEAoIaGFzX21vcmUYAiABKAgiZAoXTGlzdEhlYWRsZXNzSG9zdFJlcXVlc3QSJQoEcGFnZRgBIAEoCzIXLmhkbGN0cmwudjEuUGFnZVJlcXVlc3QSFQoIZ3JvdXBfaWQYAiABKAlIAIgBAUILCglfZ3JvdXBfaWQiawoYTGlzdEhlYWRsZXNzSG9zdFJlc3BvbnNlEicKBWhvc3RzGAEgAygLMhguaGRsY3RybC52MS5IZWFkbGVzc0hvc3QSJgoEcGFnZRgCIAEoCzIYLmhkbGN0cmwudjEuUGFnZVJlc3BvbnNlIikKFkdldEhlYWRsZXNzSG9zdFJlcXVlc3QSDwoHaG9zdF9pZBgBIAEoCSJHChdHZXRIZWFkbGVzc0hvc3RSZXNwb25zZRImCgRob3N0GAEgASgLMhguaGRsY3RybC52MS5IZWFkbGVzc0hvc3RKBAgCEAMiNwoWQWRkSGVhZGxlc3NIb3N0UmVxdWVzdBIMCgRuYW1lGAEgASgJEg8KB2FkZHJlc3MYAiABKAkiQQoXQWRkSGVhZGxlc3NIb3N0UmVzcG9uc2USJgoEaG9zdBgBIAEoCzIYLmhkbGN0cmwudjEuSGVhZGxlc3NIb3N0IpwCChVTZWFyY2hTZXNzaW9uc1JlcXVlc3QSRgoKcGFyYW1ldGVycxgBIAEoCzIyLmhkbGN0cmwudjEuU2VhcmNoU2Vzc2lvbnNSZXF1ZXN0LlNlYXJjaFBhcmFtZXRlcnMSJQoEcGFnZRgCIAEoCzIXLmhkbGN0cmwudjEuUGFnZVJlcXVlc3QakwEKEFNlYXJjaFBhcmFtZXRlcnMSFAoHaG9zdF9pZBgBIAEoCUgAiAEBEi4KBnN0YXR1cxgCIAEoDjIZLmhkbGN0cmwudjEuU2Vzc2lvblN0YXR1c0gBiAEBEhUKCGdyb3VwX2lkGAMgASgJSAKIAQFCCgoIX2hvc3RfaWRCCQoHX3N0YXR1c0ILCglfZ3JvdXBfaWQiZwoWU2VhcmNoU2Vzc2lvbnNSZXNwb25zZRIlCghzZXNzaW9ucxgBIAMoCzITLmhkbGN0cmwudjEuU2Vzc2lvbhImCgRwYWdlGAIgASgLMhguaGRsY3RybC52MS5QYWdlUmVzcG9uc2UiQwoYR2V0U2Vzc2lvbkRldGFpbHNSZXF1ZXN0EhMKB2hvc3RfaWQYASABKAlCAhgBEhIKCnNlc3Npb25faWQYAiABKAkiQQoZR2V0U2Vzc2lvbkRldGFpbHNSZXNwb25zZRIkCgdzZXNzaW9uGAEgASgLMhMuaGRsY3RybC52MS5TZXNzaW9uItIBChFTdGFydFdvcmxkUmVxdWVzdBIPCgdob3N0X2lkGAEgASgJEjcKCnBhcmFtZXRlcnMYAiABKAsyIy5oZWFkbGVzcy52MS5Xb3JsZFN0YXJ0dXBQYXJhbWV0ZXJzEgwKBG1lbW8YAyABKAkSFQoIZ3JvdXBfaWQYBCABKAlIAIgBARIYCgt0ZW1wbGF0ZV9pZBgFIAEoCUgBiAEBEhcKD292ZXJyaWRlX2ZpZWxkcxgGIAMoCUILCglfZ3JvdXBfaWRCDgoMX3RlbXBsYXRlX2lkIioKElN0YXJ0V29ybGRSZXNwb25zZRIOCgZqb2JfaWQYAiABKAlKBAgBEAIiPQoSU3RvcFNlc3Npb25SZXF1ZXN0EhMKB2hvc3RfaWQYASABKAlCAhgBEhIKCnNlc3Npb25faWQYAiABKAkiJQoTU3RvcFNlc3Npb25SZXNwb25zZRIOCgZqb2JfaWQYASABKAkiLwoZRGVsZXRlRW5kZWRTZXNzaW9uUmVxdWVzdBISCgpzZXNzaW9uX2lkGAEgASgJIhwKGkRlbGV0ZUVuZGVkU2Vzc2lvblJlc3BvbnNlIuoBChdTYXZlU2Vzc2lvbldvcmxkUmVxdWVzdBITCgdob3N0X2lkGAEgASgJQgIYARISCgpzZXNzaW9uX2lkGAIgASgJEj8KCXNhdmVfbW9kZRgDIAEoDjIsLmhkbGN0cmwudjEuU2F2ZVNlc3Npb25Xb3JsZFJlcXVlc3QuU2F2ZU1vZGUiZQoIU2F2ZU1vZGUSFQoRU0FWRV9NT0RFX1VOS05PV04QABIXChNTQVZFX01PREVfT1ZFUldSSVRFEAESFQoRU0FWRV9NT0RFX1NBVkVfQVMQAhISCg5TQVZFX01PREVfQ09QWRADIk4KGFNhdmVTZXNzaW9uV29ybGRSZXNwb25zZRIdChBzYXZlZF9yZWNvcmRfdXJsGAEgASgJSACIAQFCEwoRX3NhdmVkX3JlY29yZF91cmwiaAoiUHJlcGFyZVNlc3Npb25Xb3JsZERvd25sb2FkUmVxdWVzdBISCgpzZXNzaW9uX2lkGAEgASgJEi4KBmZvcm1hdBgCIAEoDjIeLmhlYWRsZXNzLnYxLldvcmxkQmluYXJ5Rm9ybWF0Ik0KI1ByZXBhcmVTZXNzaW9uV29ybGREb3dubG9hZFJlc3BvbnNlEhQKDGRvd25sb2FkX3VybBgBIAEoCRIQCghmaWxlbmFtZRgCIAEoCSJoChFJbnZpdGVVc2VyUmVxdWVzdBIPCgdob3N0X2lkGAEgASgJEhIKCnNlc3Npb25faWQYAiABKAkSEQoHdXNlcl9pZBgDIAEoCUgAEhMKCXVzZXJfbmFtZRgEIAEoCUgAQgYKBHVzZXIiFAoSSW52aXRlVXNlclJlc3BvbnNlImAKFVVwZGF0ZVVzZXJSb2xlUmVxdWVzdBIPCgdob3N0X2lkGAEgASgJEjYKCnBhcmFtZXRlcnMYAiABKAsyIi5oZWFkbGVzcy52MS5VcGRhdGVVc2VyUm9sZVJlcXVlc3QiJgoWVXBkYXRlVXNlclJvbGVSZXNwb25zZRIMCgRyb2xlGAEgASgJInIKHlVwZGF0ZVNlc3Npb25QYXJhbWV0ZXJzUmVxdWVzdBIPCgdob3N0X2lkGAEgASgJEj8KCnBhcmFtZXRlcnMYAiABKAsyKy5oZWFkbGVzcy52MS5VcGRhdGVTZXNzaW9uUGFyYW1ldGVyc1JlcXVlc3QiIQofVXBkYXRlU2Vzc2lvblBhcmFtZXRlcnNSZXNwb25zZSKzAQohVXBkYXRlU2Vzc2lvbkV4dHJhU2V0dGluZ3NSZXF1ZXN0EhIKCnNlc3Npb25faWQYASABKAkSGQoMYXV0b191cGdyYWRlGAIgASgISACIAQESEQoEbWVtbxgDIAEoCUgBiAEBEh0KEHJlc3RvcmVfb25fY3Jhc2gYBCABKAhIAogBAUIPCg1fYXV0b191cGdyYWRlQgcKBV9tZW1vQhMKEV9yZXN0b3JlX29uX2NyYXNoIiQKIlVwZGF0ZVNlc3Npb25FeHRyYVNldHRpbmdzUmVzcG9uc2UiQAoZTGlzdFVzZXJzSW5TZXNzaW9uUmVxdWVzdBIPCgdob3N0X2lkGAEgASgJEhIKCnNlc3Npb25faWQYAiABKAkiRwoaTGlzdFVzZXJzSW5TZXNzaW9uUmVzcG9uc2USKQoFdXNlcnMYASADKAsyGi5oZWFkbGVzcy52MS5Vc2VySW5TZXNzaW9uIpMCCg9TZXNzaW9uVGVtcGxhdGUSCgoCaWQYASABKAkSEAoIZ3JvdXBfaWQYAiABKAkSDAoEbmFtZRgDIAEoCRITCgtkZXNjcmlwdGlvbhgEIAEoCRI3CgpwYXJhbWV0ZXJzGAUgASgLMiMuaGVhZGxlc3MudjEuV29ybGRTdGFydHVwUGFyYW1ldGVycxIXCgpjcmVhdGVkX2J5GAYgASgJSACIAQESLgoKY3JlYXRlZF9hdBgHIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXASLgoKdXBkYXRlZF9hdBgIIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXBCDQoLX2NyZWF0ZWRfYnkiLwobTGlzdFNlc3Npb25UZW1wbGF0ZXNSZXF1ZXN0EhAKCGdyb3VwX2lkGAEgASgJIk4KHExpc3RTZXNzaW9uVGVtcGxhdGVzUmVzcG9uc2USLgoJdGVtcGxhdGVzGAEgAygLMhsuaGRsY3RybC52MS5TZXNzaW9uVGVtcGxhdGUiMAoZR2V0U2Vzc2lvblRlbXBsYXRlUmVxdWVzdBITCgt0ZW1wbGF0ZV9pZBgBIAEoCSJLChpHZXRTZXNzaW9uVGVtcGxhdGVSZXNwb25zZRItCgh0ZW1wbGF0ZRgBIAEoCzIbLmhkbGN0cmwudjEuU2Vzc2lvblRlbXBsYXRlIowBChxDcmVhdGVTZXNzaW9uVGVtcGxhdGVSZXF1ZXN0EhAKCGdyb3VwX2lkGAEgASgJEgwKBG5hbWUYAiABKAkSEwoLZGVzY3JpcHRpb24YAyABKAkSNwoKcGFyYW1ldGVycxgEIAEoCzIjLmhlYWRsZXNzLnYxLldvcmxkU3RhcnR1cFBhcmFtZXRlcnMiTgodQ3JlYXRlU2Vzc2lvblRlbXBsYXRlUmVzcG9uc2USLQoIdGVtcGxhdGUYASABKAsyGy5oZGxjdHJsLnYxLlNlc3Npb25UZW1wbGF0ZSKyAQocVXBkYXRlU2Vzc2lvblRlbXBsYXRlUmVxdWVzdBITCgt0ZW1wbGF0ZV9pZBgBIAEoCRIRCgRuYW1lGAIgASgJSACIAQESGAoLZGVzY3JpcHRpb24YAyABKAlIAYgBARI3CgpwYXJhbWV0ZXJzGAQgASgLMiMuaGVhZGxlc3MudjEuV29ybGRTdGFydHVwUGFyYW1ldGVyc0IHCgVfbmFtZUIOCgxfZGVzY3JpcHRpb24iTgodVXBkYXRlU2Vzc2lvblRlbXBsYXRlUmVzcG9uc2USLQoIdGVtcGxhdGUYASABKAsyGy5oZGxjdHJsLnYxLlNlc3Npb25UZW1wbGF0ZSIzChxEZWxldGVTZXNzaW9uVGVtcGxhdGVSZXF1ZXN0EhMKC3RlbXBsYXRlX2lkGAEgASgJIh8KHURlbGV0ZVNlc3Npb25UZW1wbGF0ZVJlc3BvbnNlIjQKC1BhZ2VSZXF1ZXN0EhIKCnBhZ2VfaW5kZXgYASABKAUSEQoJcGFnZV9zaXplGAIgASgFIkoKDFBhZ2VSZXNwb25zZRITCgt0b3RhbF9jb3VudBgBIAEoBRISCgpwYWdlX2luZGV4GAIgASgFEhEKCXBhZ2Vfc2l6ZRgDIAEoBSJKChVIZWFkbGVzc0hvc3RCaW5kTW91bnQSDgoGc291cmNlGAEgASgJEg4KBnRhcmdldBgCIAEoCRIRCglyZWFkX29ubHkYAyABKAgiowQKDEhvc3RUZW1wbGF0ZRIKCgJpZBgBIAEoCRIQCghncm91cF9pZBgCIAEoCRIMCgRuYW1lGAMgASgJEhMKC2Rlc2NyaXB0aW9uGAQgASgJEhEKCWltYWdlX3RhZxgFIAEoCRIyCg5zdGFydHVwX2NvbmZpZxgGIAEoCzIaLmhlYWRsZXNzLnYxLlN0YXJ0dXBDb25maWcSRAoSYXV0b191cGRhdGVfcG9saWN5GAcgASgOMiguaGRsY3RybC52MS5IZWFkbGVzc0hvc3RBdXRvVXBkYXRlUG9saWN5EgwKBG1lbW8YCCABKAkSRQoSY29udGFpbmVyX3NldHRpbmdzGAkgASgLMikuaGRsY3RybC52MS5IZWFkbGVzc0hvc3RDb250YWluZXJTZXR0aW5ncxJGChNhdXRvX3Jlc3RhcnRfcG9saWN5GAogASgOMikuaGRsY3RybC52MS5IZWFkbGVzc0hvc3RBdXRvUmVzdGFydFBvbGljeRIgChhhdXRvX3Jlc3RhcnRfbWF4X3JldHJpZXMYCyABKAUSFwoKY3JlYXRlZF9ieRgMIAEoCUgAiAEBEi4KCmNyZWF0ZWRfYXQYDSABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wEi4KCnVwZGF0ZWRfYXQYDiABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wQg0KC19jcmVhdGVkX2J5IiwKGExpc3RIb3N0VGVtcGxhdGVzUmVxdWVzdBIQCghncm91cF9pZBgBIAEoCSJIChlMaXN0SG9zdFRlbXBsYXRlc1Jlc3BvbnNlEisKCXRlbXBsYXRlcxgBIAMoCzIYLmhkbGN0cmwudjEuSG9zdFRlbXBsYXRlIi0KFkdldEhvc3RUZW1wbGF0ZVJlcXVlc3QSEwoLdGVtcGxhdGVfaWQYASABKAkiRQoXR2V0SG9zdFRlbXBsYXRlUmVzcG9uc2USKgoIdGVtcGxhdGUYASABKAsyGC5oZGxjdHJsLnYxLkhvc3RUZW1wbGF0ZSKcAwoZQ3JlYXRlSG9zdFRlbXBsYXRlUmVxdWVzdBIQCghncm91cF9pZBgBIAEoCRIMCgRuYW1lGAIgASgJEhMKC2Rlc2NyaXB0aW9uGAMgASgJEhEKCWltYWdlX3RhZxgEIAEoCRIyCg5zdGFydHVwX2NvbmZpZxgFIAEoCzIaLmhlYWRsZXNzLnYxLlN0YXJ0dXBDb25maWcSRAoSYXV0b191cGRhdGVfcG9saWN5GAYgASgOMiguaGRsY3RybC52MS5IZWFkbGVzc0hvc3RBdXRvVXBkYXRlUG9saWN5EgwKBG1lbW8YByABKAkSRQoSY29udGFpbmVyX3NldHRpbmdzGAggASgLMikuaGRsY3RybC52MS5IZWFkbGVzc0hvc3RDb250YWluZXJTZXR0aW5ncxJGChNhdXRvX3Jlc3RhcnRfcG9saWN5GAkgASgOMikuaGRsY3RybC52MS5IZWFkbGVzc0hvc3RBdXRvUmVzdGFydFBvbGljeRIgChhhdXRvX3Jlc3RhcnRfbWF4X3JldHJpZXMYCiABKAUiSAoaQ3JlYXRlSG9zdFRlbXBsYXRlUmVzcG9uc2USKgoIdGVtcGxhdGUYASABKAsyGC5oZGxjdHJsLnYxLkhvc3RUZW1wbGF0ZSLyBAoZVXBkYXRlSG9zdFRlbXBsYXRlUmVxdWVzdBITCgt0ZW1wbGF0ZV9pZBgBIAEoCRIRCgRuYW1lGAIgASgJSACIAQESGAoLZGVzY3JpcHRpb24YAyABKAlIAYgBARIWCglpbWFnZV90YWcYBCABKAlIAogBARI3Cg5zdGFydHVwX2NvbmZpZxgFIAEoCzIaLmhlYWRsZXNzLnYxLlN0YXJ0dXBDb25maWdIA4gBARJJChJhdXRvX3VwZGF0ZV9wb2xpY3kYBiABKA4yKC5oZGxjdHJsLnYxLkhlYWRsZXNzSG9zdEF1dG9VcGRhdGVQb2xpY3lIBIgBARIRCgRtZW1vGAcgASgJSAWIAQESSgoSY29udGFpbmVyX3NldHRpbmdzGAggASgLMikuaGRsY3RybC52MS5IZWFkbGVzc0hvc3RDb250YWluZXJTZXR0aW5nc0gGiAEBEksKE2F1dG9fcmVzdGFydF9wb2xpY3kYCSABKA4yKS5oZGxjdHJsLnYxLkhlYWRsZXNzSG9zdEF1dG9SZXN0YXJ0UG9saWN5SAeIAQESJQoYYXV0b19yZXN0YXJ0X21heF9yZXRyaWVzGAogASgFSAiIAQFCBwoFX25hbWVCDgoMX2Rlc2NyaXB0aW9uQgwKCl9pbWFnZV90YWdCEQoPX3N0YXJ0dXBfY29uZmlnQhUKE19hdXRvX3VwZGF0ZV9wb2xpY3lCBwoFX21lbW9CFQoTX2NvbnRhaW5lcl9zZXR0aW5nc0IWChRfYXV0b19yZXN0YXJ0X3BvbGljeUIbChlfYXV0b19yZXN0YXJ0X21heF9yZXRyaWVzIkgKGlVwZGF0ZUhvc3RUZW1wbGF0ZVJlc3BvbnNlEioKCHRlbXBsYXRlGAEgASgLMhguaGRsY3RybC52MS5Ib3N0VGVtcGxhdGUiMAoZRGVsZXRlSG9zdFRlbXBsYXRlUmVxdWVzdBITCgt0ZW1wbGF0ZV9pZBgBIAEoCSIcChpEZWxldGVIb3N0VGVtcGxhdGVSZXNwb25zZSKHAgodSGVhZGxlc3NIb3N0Q29udGFpbmVyU2V0dGluZ3MSDAoEY3B1cxgBIAEoARIUCgxtZW1vcnlfYnl0ZXMYAiABKAMSGQoRbWVtb3J5X3N3YXBfYnl0ZXMYAyABKAMSEwoLY3B1c2V0X2NwdXMYBCABKAkSPQoOcmVzdGFydF9wb2xpY3kYBSABKA4yJS5oZGxjdHJsLnYxLkhlYWRsZXNzSG9zdFJlc3RhcnRQb2xpY3kSGwoTcmVzdGFydF9tYXhfcmV0cmllcxgGIAEoBRI2CgtiaW5kX21vdW50cxgHIAMoCzIhLmhkbGN0cmwudjEuSGVhZGxlc3NIb3N0QmluZE1vdW50IocCChRIZWFkbGVzc0hvc3RTZXR0aW5ncxIYCgt1bml2ZXJzZV9pZBgBIAEoCUgAiAEBEhEKCXRpY2tfcmF0ZRgCIAEoAhImCh5tYXhfY29uY3VycmVudF9hc3NldF90cmFuc2ZlcnMYAyABKAUSHgoRdXNlcm5hbWVfb3ZlcnJpZGUYBCABKAlIAYgBARI6ChFhbGxvd2VkX3VybF9ob3N0cxgFIAMoCzIfLmhlYWRsZXNzLnYxLkFsbG93ZWRBY2Nlc3NFbnRyeRIYChBhdXRvX3NwYXduX2l0ZW1zGAYgAygJQg4KDF91bml2ZXJzZV9pZEIUChJfdXNlcm5hbWVfb3ZlcnJpZGUi6wUKDEhlYWRsZXNzSG9zdBIKCgJpZBgBIAEoCRIMCgRuYW1lGAIgASgJEhgKEHJlc29uaXRlX3ZlcnNpb24YBCABKAkSEwoLYXBwX3ZlcnNpb24YCyABKAkSEgoKYWNjb3VudF9pZBgFIAEoCRIUCgxhY2NvdW50X25hbWUYBiABKAkSCwoDZnBzGAcgASgCEi4KBnN0YXR1cxgKIAEoDjIeLmhkbGN0cmwudjEuSGVhZGxlc3NIb3N0U3RhdHVzEkQKEmF1dG9fdXBkYXRlX3BvbGljeRgMIAEoDjIoLmhkbGN0cmwudjEuSGVhZGxlc3NIb3N0QXV0b1VwZGF0ZVBvbGljeRIMCgRtZW1vGA0gASgJEjcKDWhvc3Rfc2V0dGluZ3MYDiABKAsyIC5oZGxjdHJsLnYxLkhlYWRsZXNzSG9zdFNldHRpbmdzEhMKC2luc3RhbmNlX2lkGA8gASgFEhAKCGdyb3VwX2lkGBAgASgJEhcKCmNyZWF0ZWRfYnkYESABKAlIAIgBARIPCgdub2RlX2lkGBIgASgJEkUKEmNvbnRhaW5lcl9zZXR0aW5ncxgTIAEoCzIpLmhkbGN0cmwudjEuSGVhZGxlc3NIb3N0Q29udGFpbmVyU2V0dGluZ3MSRgoTYXV0b19yZXN0YXJ0X3BvbGljeRgUIAEoDjIpLmhkbGN0cmwudjEuSGVhZGxlc3NIb3N0QXV0b1Jlc3RhcnRQb2xpY3kSIAoYYXV0b19yZXN0YXJ0X21heF9yZXRyaWVzGBUgASgFEhMKC2NyYXNoX2NvdW50GBYgASgFEjgKD2xhc3RfY3Jhc2hlZF9hdBgXIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXBIAYgBARIeChZhdXRvX3Jlc3RhcnRfc3VzcGVuZGVkGBggASgIQg0KC19jcmVhdGVkX2J5QhIKEF9sYXN0X2NyYXNoZWRfYXRKBAgIEAlKBAgJEAoi9AMKB1Nlc3Npb24SCgoCaWQYASABKAkSDAoEbmFtZRgCIAEoCRIPCgdob3N0X2lkGAMgASgJEikKBnN0YXR1cxgEIAEoDjIZLmhkbGN0cmwudjEuU2Vzc2lvblN0YXR1cxIuCgpzdGFydGVkX2F0GAUgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcBIxCghlbmRlZF9hdBgGIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXBIAIgBARI/ChJzdGFydHVwX3BhcmFtZXRlcnMYByABKAsyIy5oZWFkbGVzcy52MS5Xb3JsZFN0YXJ0dXBQYXJhbWV0ZXJzEjAKDWN1cnJlbnRfc3RhdGUYCCABKAsyFC5oZWFkbGVzcy52MS5TZXNzaW9uSAGIAQESGQoIb3duZXJfaWQYCSABKAlCAhgBSAKIAQESFAoMYXV0b191cGdyYWRlGAogASgIEgwKBG1lbW8YCyABKAkSEAoIZ3JvdXBfaWQYDCABKAkSFwoKY3JlYXRlZF9ieRgNIAEoCUgDiAEBEhgKEHJlc3RvcmVfb25fY3Jhc2gYDiABKAhCCwoJX2VuZGVkX2F0QhAKDl9jdXJyZW50X3N0YXRlQgsKCV9vd25lcl9pZEINCgtfY3JlYXRlZF9ieSKBAQoPSGVhZGxlc3NBY2NvdW50Eg8KB3VzZXJfaWQYASABKAkSEQoJdXNlcl9uYW1lGAIgASgJEhAKCGljb25fdXJsGAMgASgJEhAKCGdyb3VwX2lkGAQgASgJEhcKCmNyZWF0ZWRfYnkYBSABKAlIAIgBAUINCgtfY3JlYXRlZF9ieSI2CghVc2VySW5mbxIKCgJpZBgBIAEoCRIMCgRuYW1lGAIgASgJEhAKCGljb25fdXJsGAMgASgJIi0KFkdldFJlc29uaXRlVXNlclJlcXVlc3QSEwoLcmVzb25pdGVfaWQYASABKAkiRQoXR2V0UmVzb25pdGVVc2VyUmVzcG9uc2USCgoCaWQYASABKAkSDAoEbmFtZRgCIAEoCRIQCghpY29uX3VybBgDIAEoCSJhChNMaXN0Q29udGFjdHNSZXF1ZXN0EhsKE2hlYWRsZXNzX2FjY291bnRfaWQYASABKAkSDQoFbGltaXQYAiABKAUSEwoGY3Vyc29yGAMgASgJSACIAQFCCQoHX2N1cnNvciJoChRMaXN0Q29udGFjdHNSZXNwb25zZRImCghjb250YWN0cxgBIAMoCzIULmhkbGN0cmwudjEuVXNlckluZm8SGAoLbmV4dF9jdXJzb3IYAiABKAlIAIgBAUIOCgxfbmV4dF9jdXJzb3IiqgEKGUdldENvbnRhY3RNZXNzYWdlc1JlcXVlc3QSGwoTaGVhZGxlc3NfYWNjb3VudF9pZBgBIAEoCRIXCg9jb250YWN0X3VzZXJfaWQYAiABKAkSDQoFbGltaXQYAyABKAUSFgoJYmVmb3JlX2lkGAQgASgJSACIAQESFQoIYWZ0ZXJfaWQYBSABKAlIAYgBAUIMCgpfYmVmb3JlX2lkQgsKCV9hZnRlcl9pZCJ7ChpHZXRDb250YWN0TWVzc2FnZXNSZXNwb25zZRIsCghtZXNzYWdlcxgBIAMoCzIaLmhkbGN0cmwudjEuQ29udGFjdE1lc3NhZ2USFwoPaGFzX21vcmVfYmVmb3JlGAIgASgIEhYKDmhhc19tb3JlX2FmdGVyGAMgASgIIukBCg5Db250YWN0TWVzc2FnZRIKCgJpZBgBIAEoCRIxCgR0eXBlGAIgASgOMiMuaGVhZGxlc3MudjEuQ29udGFjdENoYXRNZXNzYWdlVHlwZRIPCgdjb250ZW50GAMgASgJEi0KCXNlbmRfdGltZRgEIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXASMgoJcmVhZF90aW1lGAUgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcEgAiAEBEhYKDmlzX293bl9tZXNzYWdlGAYgASgIQgwKCl9yZWFkX3RpbWUiYgoZU2VuZENvbnRhY3RNZXNzYWdlUmVxdWVzdBIbChNoZWFkbGVzc19hY2NvdW50X2lkGAEgASgJEhcKD2NvbnRhY3RfdXNlcl9pZBgCIAEoCRIPCgdtZXNzYWdlGAMgASgJIhwKGlNlbmRDb250YWN0TWVzc2FnZVJlc3BvbnNlIqoCChJTY2hlZHVsZWRPcGVyYXRpb24SNgoNc3RhcnRfc2Vzc2lvbhgBIAEoCzIdLmhkbGN0cmwudjEuU3RhcnRXb3JsZFJlcXVlc3RIABI2CgxzdG9wX3Nlc3Npb24YAiABKAsyHi5oZGxjdHJsLnYxLlN0b3BTZXNzaW9uUmVxdWVzdEgAEkcKEXVwZGF0ZV9wYXJhbWV0ZXJzGAMgASgLMiouaGRsY3RybC52MS5VcGRhdGVTZXNzaW9uUGFyYW1ldGVyc1JlcXVlc3RIABJOChV1cGRhdGVfZXh0cmFfc2V0dGluZ3MYBCABKAsyLS5oZGxjdHJsLnYxLlVwZGF0ZVNlc3Npb25FeHRyYVNldHRpbmdzUmVxdWVzdEgAQgsKCW9wZXJhdGlvbiKyAQoQU2NoZWR1bGVkVHJpZ2dlchInCgR0aW1lGAEgASgLMhcuaGRsY3RybC52MS5UaW1lVHJpZ2dlckgAEkEKEnNlc3Npb25fdXNlcl9jb3VudBgCIAEoCzIjLmhkbGN0cmwudjEuU2Vzc2lvblVzZXJDb3VudFRyaWdnZXJIABInCgRjcm9uGAMgASgLMhcuaGRsY3RybC52MS5Dcm9uVHJpZ2dlckgAQgkKB3RyaWdnZXIiPwoLVGltZVRyaWdnZXISMAoMc2NoZWR1bGVkX2F0GAEgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcCLtAQoXU2Vzc2lvblVzZXJDb3VudFRyaWdnZXISEgoKc2Vzc2lvbl9pZBgBIAEoCRJCCgpjb21wYXJhdG9yGAIgASgOMi4uaGRsY3RybC52MS5TZXNzaW9uVXNlckNvdW50VHJpZ2dlci5Db21wYXJhdG9yEhEKCXRocmVzaG9sZBgDIAEoBSJnCgpDb21wYXJhdG9yEhoKFkNPTVBBUkFUT1JfVU5TUEVDSUZJRUQQABIcChhDT01QQVJBVE9SX0xFU1NfT1JfRVFVQUwQARIfChtDT01QQVJBVE9SX0dSRUFURVJfT1JfRVFVQUwQAiIzCgtDcm9uVHJpZ2dlchISCgpleHByZXNzaW9uGAEgASgJEhAKCHRpbWV6b25lGAIgASgJIrEEChlTY2hlZHVsZWRTZXNzaW9uT3BlcmF0aW9uEgoKAmlkGAEgASgJEjEKCW9wZXJhdGlvbhgCIAEoCzIeLmhkbGN0cmwudjEuU2NoZWR1bGVkT3BlcmF0aW9uEi0KB3RyaWdnZXIYAyABKAsyHC5oZGxjdHJsLnYxLlNjaGVkdWxlZFRyaWdnZXISMAoMbmV4dF9maXJlX2F0GAQgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcBIUCgdob3N0X2lkGAUgASgJSACIAQESFwoKc2Vzc2lvbl9pZBgGIAEoCUgBiAEBEjQKBnN0YXR1cxgHIAEoDjIkLmhkbGN0cmwudjEuU2NoZWR1bGVkT3BlcmF0aW9uU3RhdHVzEhcKCmxhc3RfZXJyb3IYCCABKAlIAogBARI0CgtleGVjdXRlZF9hdBgJIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXBIA4gBARIXCgpjcmVhdGVkX2J5GAogASgJSASIAQESLgoKY3JlYXRlZF9hdBgLIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXASLgoKdXBkYXRlZF9hdBgMIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXBCCgoIX2hvc3RfaWRCDQoLX3Nlc3Npb25faWRCDQoLX2xhc3RfZXJyb3JCDgoMX2V4ZWN1dGVkX2F0Qg0KC19jcmVhdGVkX2J5IooBCiZDcmVhdGVTY2hlZHVsZWRTZXNzaW9uT3BlcmF0aW9uUmVxdWVzdBIxCglvcGVyYXRpb24YASABKAsyHi5oZGxjdHJsLnYxLlNjaGVkdWxlZE9wZXJhdGlvbhItCgd0cmlnZ2VyGAIgASgLMhwuaGRsY3RybC52MS5TY2hlZHVsZWRUcmlnZ2VyIm0KJ0NyZWF0ZVNjaGVkdWxlZFNlc3Npb25PcGVyYXRpb25SZXNwb25zZRJCChNzY2hlZHVsZWRfb3BlcmF0aW9uGAEgASgLMiUuaGRsY3RybC52MS5TY2hlZHVsZWRTZXNzaW9uT3BlcmF0aW9uIoICCiVMaXN0U2NoZWR1bGVkU2Vzc2lvbk9wZXJhdGlvbnNSZXF1ZXN0EhcKCnNlc3Npb25faWQYASABKAlIAIgBARIUCgdob3N0X2lkGAIgASgJSAGIAQESOQoGc3RhdHVzGAMgASgOMiQuaGRsY3RybC52MS5TY2hlZHVsZWRPcGVyYXRpb25TdGF0dXNIAogBARIlCgRwYWdlGAQgASgLMhcuaGRsY3RybC52MS5QYWdlUmVxdWVzdBIVCghncm91cF9pZBgFIAEoCUgDiAEBQg0KC19zZXNzaW9uX2lkQgoKCF9ob3N0X2lkQgkKB19zdGF0dXNCCwoJX2dyb3VwX2lkIpUBCiZMaXN0U2NoZWR1bGVkU2Vzc2lvbk9wZXJhdGlvbnNSZXNwb25zZRJDChRzY2hlZHVsZWRfb3BlcmF0aW9ucxgBIAMoCzIlLmhkbGN0cmwudjEuU2NoZWR1bGVkU2Vzc2lvbk9wZXJhdGlvbhImCgRwYWdlGAIgASgLMhguaGRsY3RybC52MS5QYWdlUmVzcG9uc2UiNAomQ2FuY2VsU2NoZWR1bGVkU2Vzc2lvbk9wZXJhdGlvblJlcXVlc3QSCgoCaWQYASABKAkiKQonQ2FuY2VsU2NoZWR1bGVkU2Vzc2lvbk9wZXJhdGlvblJlc3BvbnNlIjMKJVBhdXNlU2NoZWR1bGVkU2Vzc2lvbk9wZXJhdGlvblJlcXVlc3QSCgoCaWQYASABKAkibAomUGF1c2VTY2hlZHVsZWRTZXNzaW9uT3BlcmF0aW9uUmVzcG9uc2USQgoTc2NoZWR1bGVkX29wZXJhdGlvbhgBIAEoCzIlLmhkbGN0cmwudjEuU2NoZWR1bGVkU2Vzc2lvbk9wZXJhdGlvbiI0CiZSZXN1bWVTY2hlZHVsZWRTZXNzaW9uT3BlcmF0aW9uUmVxdWVzdBIKCgJpZBgBIAEoCSJtCidSZXN1bWVTY2hlZHVsZWRTZXNzaW9uT3BlcmF0aW9uUmVzcG9uc2USQgoTc2NoZWR1bGVkX29wZXJhdGlvbhgBIAEoCzIlLmhkbGN0cmwudjEuU2NoZWR1bGVkU2Vzc2lvbk9wZXJhdGlvbiI8Ci5Ta2lwU2NoZWR1bGVkU2Vzc2lvbk9wZXJhdGlvbk9jY3VycmVuY2VSZXF1ZXN0EgoKAmlkGAEgASgJInUKL1NraXBTY2hlZHVsZWRTZXNzaW9uT3BlcmF0aW9uT2NjdXJyZW5jZVJlc3BvbnNlEkIKE3NjaGVkdWxlZF9vcGVyYXRpb24YASABKAsyJS5oZGxjdHJsLnYxLlNjaGVkdWxlZFNlc3Npb25PcGVyYXRpb24izgIKFVNjaGVkdWxlZE9wZXJhdGlvblJ1bhIKCgJpZBgBIAEoAxIxCg1zY2hlZHVsZWRfZm9yGAIgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcBIzCgpzdGFydGVkX2F0GAMgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcEgAiAEBEi8KC2ZpbmlzaGVkX2F0GAQgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcBI5CgdvdXRjb21lGAUgASgOMiguaGRsY3RybC52MS5TY2hlZHVsZWRPcGVyYXRpb25SdW5PdXRjb21lEhIKBWVycm9yGAYgASgJSAGIAQESGAoLaW5zdGFuY2VfaWQYByABKAlIAogBAUINCgtfc3RhcnRlZF9hdEIICgZfZXJyb3JCDgoMX2luc3RhbmNlX2lkIl0KKExpc3RTY2hlZHVsZWRTZXNzaW9uT3BlcmF0aW9uUnVuc1JlcXVlc3QSCgoCaWQYASABKAkSJQoEcGFnZRgCIAEoCzIXLmhkbGN0cmwudjEuUGFnZVJlcXVlc3QihAEKKUxpc3RTY2hlZHVsZWRTZXNzaW9uT3BlcmF0aW9uUnVuc1Jlc3BvbnNlEi8KBHJ1bnMYASADKAsyIS5oZGxjdHJsLnYxLlNjaGVkdWxlZE9wZXJhdGlvblJ1bhImCgRwYWdlGAIgASgLMhguaGRsY3RybC52MS5QYWdlUmVzcG9uc2UqhQEKFFNlc3Npb25Vc2VyRXZlbnRLaW5kEicKI1NFU1NJT05fVVNFUl9FVkVOVF9LSU5EX1VOU1BFQ0lGSUVEEAASIgoeU0VTU0lPTl9VU0VSX0VWRU5UX0tJTkRfSk9JTkVEEAESIAocU0VTU0lPTl9VU0VSX0VWRU5UX0tJTkRfTEVGVBACKoABChNTZXNzaW9uVXNhZ2VHcm91cEJ5EiYKIlNFU1NJT05fVVNBR0VfR1JPVVBfQllfVU5TUEVDSUZJRUQQABIgChxTRVNTSU9OX1VTQUdFX0dST1VQX0JZX1dPUkxEEAESHwobU0VTU0lPTl9VU0FHRV9HUk9VUF9CWV9IT1NUEAIqoAEKFFNlc3Npb25Vc2FnZUludGVydmFsEiYKIlNFU1NJT05fVVNBR0VfSU5URVJWQUxfVU5TUEVDSUZJRUQQABIfChtTRVNTSU9OX1VTQUdFX0lOVEVSVkFMX0hPVVIQARIeChpTRVNTSU9OX1VTQUdFX0lOVEVSVkFMX0RBWRACEh8KG1NFU1NJT05fVVNBR0VfSU5URVJWQUxfV0VFSxADKpwBCgpNZXRyaWNLaW5kEhsKF01FVFJJQ19LSU5EX1VOU1BFQ0lGSUVEEAASGAoUTUVUUklDX0tJTkRfSE9TVF9GUFMQARIcChhNRVRSSUNfS0lORF9IT1NUX1JVTk5JTkcQAhIaChZNRVRSSUNfS0lORF9IT1NUX1VTRVJTEAMSHQoZTUVUUklDX0tJTkRfU0VTU0lPTl9VU0VSUxAEKooBChBNZXRyaWNSZXNvbHV0aW9uEiEKHU1FVFJJQ19SRVNPTFVUSU9OX1VOU1BFQ0lGSUVEEAASGQoVTUVUUklDX1JFU09MVVRJT05fUkFXEAESHAoYTUVUUklDX1JFU09MVVRJT05fTUlOVVRFEAISGgoWTUVUUklDX1JFU09MVVRJT05fSE9VUhADKuEBChJIZWFkbGVzc0hvc3RTdGF0dXMSIAocSEVBRExFU1NfSE9TVF9TVEFUVVNfVU5LTk9XThAAEiEKHUhFQURMRVNTX0hPU1RfU1RBVFVTX1NUQVJUSU5HEAESIAocSEVBRExFU1NfSE9TVF9TVEFUVVNfUlVOTklORxACEiEKHUhFQURMRVNTX0hPU1RfU1RBVFVTX1NUT1BQSU5HEAMSHwobSEVBRExFU1NfSE9TVF9TVEFUVVNfRVhJVEVEEAQSIAocSEVBRExFU1NfSE9TVF9TVEFUVVNfQ1JBU0hFRBAFKqUBChRIZWFkbGVzc0hvc3RMb2dMZXZlbBIjCh9IRUFETEVTU19IT1NUX0xPR19MRVZFTF9VTktOT1dOEAASIAocSEVBRExFU1NfSE9TVF9MT0dfTEVWRUxfSU5GTxABEiMKH0hFQURMRVNTX0hPU1RfTE9HX0xFVkVMX1dBUk5JTkcQAhIhCh1IRUFETEVTU19IT1NUX0xPR19MRVZFTF9FUlJPUhADKqQBChtIZWFkbGVzc0hvc3RMb2dFeHBvcnRGb3JtYXQSLworSEVBRExFU1NfSE9TVF9MT0dfRVhQT1JUX0ZPUk1BVF9VTlNQRUNJRklFRBAAEigKJEhFQURMRVNTX0hPU1RfTE9HX0VYUE9SVF9GT1JNQVRfVEVYVBABEioKJkhFQURMRVNTX0hPU1RfTE9HX0VYUE9SVF9GT1JNQVRfTkRKU09OEAIqmgEKDVNlc3Npb25TdGF0dXMSGgoWU0VTU0lPTl9TVEFUVVNfVU5LTk9XThAAEhsKF1NFU1NJT05fU1RBVFVTX1NUQVJUSU5HEAESGgoWU0VTU0lPTl9TVEFUVVNfUlVOTklORxACEhgKFFNFU1NJT05fU1RBVFVTX0VOREVEEAMSGgoWU0VTU0lPTl9TVEFUVVNfQ1JBU0hFRBAEKqoBChxIZWFkbGVzc0hvc3RBdXRvVXBkYXRlUG9saWN5EiwKKEhFQURMRVNTX0hPU1RfQVVUT19VUERBVEVfUE9MSUNZX1VOS05PV04QABIqCiZIRUFETEVTU19IT1NUX0FVVE9fVVBEQVRFX1BPTElDWV9ORVZFUhABEjAKLEhFQURMRVNTX0hPU1RfQVVUT19VUERBVEVfUE9MSUNZX1VTRVJTX0VNUFRZEAIq2QEKHUhlYWRsZXNzSG9zdEF1dG9SZXN0YXJ0UG9saWN5Ei0KKUhFQURMRVNTX0hPU1RfQVVUT19SRVNUQVJUX1BPTElDWV9VTktOT1dOEAASKwonSEVBRExFU1NfSE9TVF9BVVRPX1JFU1RBUlRfUE9MSUNZX05FVkVSEAESLgoqSEVBRExFU1NfSE9TVF9BVVRPX1JFU1RBUlRfUE9MSUNZX09OX0NSQVNIEAISLAooSEVBRExFU1NfSE9TVF9BVVRPX1JFU1RBUlRfUE9MSUNZX0FMV0FZUxADKvEBChlIZWFkbGVzc0hvc3RSZXN0YXJ0UG9saWN5EigKJEhFQURMRVNTX0hPU1RfUkVTVEFSVF9QT0xJQ1lfVU5LTk9XThAAEiMKH0hFQURMRVNTX0hPU1RfUkVTVEFSVF9QT0xJQ1lfTk8QARIrCidIRUFETEVTU19IT1NUX1JFU1RBUlRfUE9MSUNZX09OX0ZBSUxVUkUQAhInCiNIRUFETEVTU19IT1NUX1JFU1RBUlRfUE9MSUNZX0FMV0FZUxADEi8KK0hFQURMRVNTX0hPU1RfUkVTVEFSVF9QT0xJQ1lfVU5MRVNTX1NUT1BQRUQQBCq3AgoYU2NoZWR1bGVkT3BlcmF0aW9uU3RhdHVzEioKJlNDSEVEVUxFRF9PUEVSQVRJT05fU1RBVFVTX1VOU1BFQ0lGSUVEEAASJgoiU0NIRURVTEVEX09QRVJBVElPTl9TVEFUVVNfUEVORElORxABEiYKIlNDSEVEVUxFRF9PUEVSQVRJT05fU1RBVFVTX1JVTk5JTkcQAhIoCiRTQ0hFRFVMRURfT1BFUkFUSU9OX1NUQVRVU19TVUNDRUVERUQQAxIlCiFTQ0hFRFVMRURfT1BFUkFUSU9OX1NUQVRVU19GQUlMRUQQBBInCiNTQ0hFRFVMRURfT1BFUkFUSU9OX1NUQVRVU19DQU5DRUxFRBAFEiUKIVNDSEVEVUxFRF9PUEVSQVRJT05fU1RBVFVTX1BBVVNFRBAGKoMCChxTY2hlZHVsZWRPcGVyYXRpb25SdW5PdXRjb21lEi8KK1NDSEVEVUxFRF9PUEVSQVRJT05fUlVOX09VVENPTUVfVU5TUEVDSUZJRUQQABItCilTQ0hFRFVMRURfT1BFUkFUSU9OX1JVTl9PVVRDT01FX1NVQ0NFRURFRBABEioKJlNDSEVEVUxFRF9PUEVSQVRJT05fUlVOX09VVENPTUVfRkFJTEVEEAISKwonU0NIRURVTEVEX09QRVJBVElPTl9SVU5fT1VUQ09NRV9TS0lQUEVEEAMSKgomU0NIRURVTEVEX09QRVJBVElPTl9SVU5fT1VUQ09NRV9NSVNTRUQQBDLUOgoRQ29udHJvbGxlclNlcnZpY2USXQoQTGlzdEhlYWRsZXNzSG9zdBIjLmhkbGN0cmwudjEuTGlzdEhlYWRsZXNzSG9zdFJlcXVlc3QaJC5oZGxjdHJsLnYxLkxpc3RIZWFkbGVzc0hvc3RSZXNwb25zZRJaCg9HZXRIZWFkbGVzc0hvc3QSIi5oZGxjdHJsLnYxLkdldEhlYWRsZXNzSG9zdFJlcXVlc3QaIy5oZGxjdHJsLnYxLkdldEhlYWRsZXNzSG9zdFJlc3BvbnNlEmYKE0dldEhlYWRsZXNzSG9zdExvZ3MSJi5oZGxjdHJsLnYxLkdldEhlYWRsZXNzSG9zdExvZ3NSZXF1ZXN0GicuaGRsY3RybC52MS5HZXRIZWFkbGVzc0hvc3RMb2dzUmVzcG9uc2USawoUVGFpbEhlYWRsZXNzSG9zdExvZ3MSJy5oZGxjdHJsLnYxLlRhaWxIZWFkbGVzc0hvc3RMb2dzUmVxdWVzdBooLmhkbGN0cmwudjEuVGFpbEhlYWRsZXNzSG9zdExvZ3NSZXNwb25zZTABEm8KFlNlYXJjaEhlYWRsZXNzSG9zdExvZ3MSKS5oZGxjdHJsLnYxLlNlYXJjaEhlYWRsZXNzSG9zdExvZ3NSZXF1ZXN0GiouaGRsY3RybC52MS5TZWFyY2hIZWFkbGVzc0hvc3RMb2dzUmVzcG9uc2UShwEKHlByZXBhcmVIZWFkbGVzc0hvc3RMb2dEb3dubG9hZBIxLmhkbGN0cmwudjEuUHJlcGFyZUhlYWRsZXNzSG9zdExvZ0Rvd25sb2FkUmVxdWVzdBoyLmhkbGN0cmwudjEuUHJlcGFyZUhlYWRsZXNzSG9zdExvZ0Rvd25sb2FkUmVzcG9uc2USaQoUU2h1dGRvd25IZWFkbGVzc0hvc3QSJy5oZGxjdHJsLnYxLlNodXRkb3duSGVhZGxlc3NIb3N0UmVxdWVzdBooLmhkbGN0cmwudjEuU2h1dGRvd25IZWFkbGVzc0hvc3RSZXNwb25zZRJdChBLaWxsSGVhZGxlc3NIb3N0EiMuaGRsY3RybC52MS5LaWxsSGVhZGxlc3NIb3N0UmVxdWVzdBokLmhkbGN0cmwudjEuS2lsbEhlYWRsZXNzSG9zdFJlc3BvbnNlEnsKGlVwZGF0ZUhlYWRsZXNzSG9zdFNldHRpbmdzEi0uaGRsY3RybC52MS5VcGRhdGVIZWFkbGVzc0hvc3RTZXR0aW5nc1JlcXVlc3QaLi5oZGxjdHJsLnYxLlVwZGF0ZUhlYWRsZXNzSG9zdFNldHRpbmdzUmVzcG9uc2USZgoTUmVzdGFydEhlYWRsZXNzSG9zdBImLmhkbGN0cmwudjEuUmVzdGFydEhlYWRsZXNzSG9zdFJlcXVlc3QaJy5oZGxjdHJsLnYxLlJlc3RhcnRIZWFkbGVzc0hvc3RSZXNwb25zZRJgChFTdGFydEhlYWRsZXNzSG9zdBIkLmhkbGN0cmwudjEuU3RhcnRIZWFkbGVzc0hvc3RSZXF1ZXN0GiUuaGRsY3RybC52MS5TdGFydEhlYWRsZXNzSG9zdFJlc3BvbnNlEloKD0FsbG93SG9zdEFjY2VzcxIiLmhkbGN0cmwudjEuQWxsb3dIb3N0QWNjZXNzUmVxdWVzdBojLmhkbGN0cmwudjEuQWxsb3dIb3N0QWNjZXNzUmVzcG9uc2USVwoORGVueUhvc3RBY2Nlc3MSIS5oZGxjdHJsLnYxLkRlbnlIb3N0QWNjZXNzUmVxdWVzdBoiLmhkbGN0cmwudjEuRGVueUhvc3RBY2Nlc3NSZXNwb25zZRJ4ChlMaXN0SGVhZGxlc3NIb3N0SW1hZ2VUYWdzEiwuaGRsY3RybC52MS5MaXN0SGVhZGxlc3NIb3N0SW1hZ2VUYWdzUmVxdWVzdBotLmhkbGN0cmwudjEuTGlzdEhlYWRsZXNzSG9zdEltYWdlVGFnc1Jlc3BvbnNlEmMKEkRlbGV0ZUhlYWRsZXNzSG9zdBIlLmhkbGN0cmwudjEuRGVsZXRlSGVhZGxlc3NIb3N0UmVxdWVzdBomLmhkbGN0cmwudjEuRGVsZXRlSGVhZGxlc3NIb3N0UmVzcG9uc2USeAoZTGlzdEhlYWRsZXNzSG9zdEluc3RhbmNlcxIsLmhkbGN0cmwudjEuTGlzdEhlYWRsZXNzSG9zdEluc3RhbmNlc1JlcXVlc3QaLS5oZGxjdHJsLnYxLkxpc3RIZWFkbGVzc0hvc3RJbnN0YW5jZXNSZXNwb25zZRJgChFDbG9uZUhlYWRsZXNzSG9zdBIkLmhkbGN0cmwudjEuQ2xvbmVIZWFkbGVzc0hvc3RSZXF1ZXN0GiUuaGRsY3RybC52MS5DbG9uZUhlYWRsZXNzSG9zdFJlc3BvbnNlEmAKEUxpc3RIb3N0VGVtcGxhdGVzEiQuaGRsY3RybC52MS5MaXN0SG9zdFRlbXBsYXRlc1JlcXVlc3QaJS5oZGxjdHJsLnYxLkxpc3RIb3N0VGVtcGxhdGVzUmVzcG9uc2USWgoPR2V0SG9zdFRlbXBsYXRlEiIuaGRsY3RybC52MS5HZXRIb3N0VGVtcGxhdGVSZXF1ZXN0GiMuaGRsY3RybC52MS5HZXRIb3N0VGVtcGxhdGVSZXNwb25zZRJjChJDcmVhdGVIb3N0VGVtcGxhdGUSJS5oZGxjdHJsLnYxLkNyZWF0ZUhvc3RUZW1wbGF0ZVJlcXVlc3QaJi5oZGxjdHJsLnYxLkNyZWF0ZUhvc3RUZW1wbGF0ZVJlc3BvbnNlEmMKElVwZGF0ZUhvc3RUZW1wbGF0ZRIlLmhkbGN0cmwudjEuVXBkYXRlSG9zdFRlbXBsYXRlUmVxdWVzdBomLmhkbGN0cmwudjEuVXBkYXRlSG9zdFRlbXBsYXRlUmVzcG9uc2USYwoSRGVsZXRlSG9zdFRlbXBsYXRlEiUuaGRsY3RybC52MS5EZWxldGVIb3N0VGVtcGxhdGVSZXF1ZXN0GiYuaGRsY3RybC52MS5EZWxldGVIb3N0VGVtcGxhdGVSZXNwb25zZRJsChVDcmVhdGVIZWFkbGVzc0FjY291bnQSKC5oZGxjdHJsLnYxLkNyZWF0ZUhlYWRsZXNzQWNjb3VudFJlcXVlc3QaKS5oZGxjdHJsLnYxLkNyZWF0ZUhlYWRsZXNzQWNjb3VudFJlc3BvbnNlEmkKFExpc3RIZWFkbGVzc0FjY291bnRzEicuaGRsY3RybC52MS5MaXN0SGVhZGxlc3NBY2NvdW50c1JlcXVlc3QaKC5oZGxjdHJsLnYxLkxpc3RIZWFkbGVzc0FjY291bnRzUmVzcG9uc2USbAoVRGVsZXRlSGVhZGxlc3NBY2NvdW50EiguaGRsY3RybC52MS5EZWxldGVIZWFkbGVzc0FjY291bnRSZXF1ZXN0GikuaGRsY3RybC52MS5EZWxldGVIZWFkbGVzc0FjY291bnRSZXNwb25zZRKNAQogVXBkYXRlSGVhZGxlc3NBY2NvdW50Q3JlZGVudGlhbHMSMy5oZGxjdHJsLnYxLlVwZGF0ZUhlYWRsZXNzQWNjb3VudENyZWRlbnRpYWxzUmVxdWVzdBo0LmhkbGN0cmwudjEuVXBkYXRlSGVhZGxlc3NBY2NvdW50Q3JlZGVudGlhbHNSZXNwb25zZRKEAQodR2V0SGVhZGxlc3NBY2NvdW50U3RvcmFnZUluZm8SMC5oZGxjdHJsLnYxLkdldEhlYWRsZXNzQWNjb3VudFN0b3JhZ2VJbmZvUmVxdWVzdBoxLmhkbGN0cmwudjEuR2V0SGVhZGxlc3NBY2NvdW50U3RvcmFnZUluZm9SZXNwb25zZRJ7ChpSZWZldGNoSGVhZGxlc3NBY2NvdW50SW5mbxItLmhkbGN0cmwudjEuUmVmZXRjaEhlYWRsZXNzQWNjb3VudEluZm9SZXF1ZXN0Gi4uaGRsY3RybC52MS5SZWZldGNoSGVhZGxlc3NBY2NvdW50SW5mb1Jlc3BvbnNlEngKGVVwZGF0ZUhlYWRsZXNzQWNjb3VudEljb24SLC5oZGxjdHJsLnYxLlVwZGF0ZUhlYWRsZXNzQWNjb3VudEljb25SZXF1ZXN0Gi0uaGRsY3RybC52MS5VcGRhdGVIZWFkbGVzc0FjY291bnRJY29uUmVzcG9uc2USWAoORmV0Y2hXb3JsZEluZm8SIS5oZGxjdHJsLnYxLkZldGNoV29ybGRJbmZvUmVxdWVzdBojLmhlYWRsZXNzLnYxLkZldGNoV29ybGRJbmZvUmVzcG9uc2USWAoOU2VhcmNoVXNlckluZm8SIS5oZGxjdHJsLnYxLlNlYXJjaFVzZXJJbmZvUmVxdWVzdBojLmhlYWRsZXNzLnYxLlNlYXJjaFVzZXJJbmZvUmVzcG9uc2USUQoMU2VhcmNoV29ybGRzEh8uaGRsY3RybC52MS5TZWFyY2hXb3JsZHNSZXF1ZXN0GiAuaGRsY3RybC52MS5TZWFyY2hXb3JsZHNSZXNwb25zZRJRCgxHZXRPd25Xb3JsZHMSHy5oZGxjdHJsLnYxLkdldE93bldvcmxkc1JlcXVlc3QaIC5oZGxjdHJsLnYxLkdldE93bldvcmxkc1Jlc3BvbnNlEloKD0dldFJlc29uaXRlVXNlchIiLmhkbGN0cmwudjEuR2V0UmVzb25pdGVVc2VyUmVxdWVzdBojLmhkbGN0cmwudjEuR2V0UmVzb25pdGVVc2VyUmVzcG9uc2USYAoRR2V0RnJpZW5kUmVxdWVzdHMSJC5oZGxjdHJsLnYxLkdldEZyaWVuZFJlcXVlc3RzUmVxdWVzdBolLmhkbGN0cmwudjEuR2V0RnJpZW5kUmVxdWVzdHNSZXNwb25zZRJpChRBY2NlcHRGcmllbmRSZXF1ZXN0cxInLmhkbGN0cmwudjEuQWNjZXB0RnJpZW5kUmVxdWVzdHNSZXF1ZXN0GiguaGRsY3RybC52MS5BY2NlcHRGcmllbmRSZXF1ZXN0c1Jlc3BvbnNlElEKDExpc3RDb250YWN0cxIfLmhkbGN0cmwudjEuTGlzdENvbnRhY3RzUmVxdWVzdBogLmhkbGN0cmwudjEuTGlzdENvbnRhY3RzUmVzcG9uc2USYwoSR2V0Q29udGFjdE1lc3NhZ2VzEiUuaGRsY3RybC52MS5HZXRDb250YWN0TWVzc2FnZXNSZXF1ZXN0GiYuaGRsY3RybC52MS5HZXRDb250YWN0TWVzc2FnZXNSZXNwb25zZRJjChJTZW5kQ29udGFjdE1lc3NhZ2USJS5oZGxjdHJsLnYxLlNlbmRDb250YWN0TWVzc2FnZVJlcXVlc3QaJi5oZGxjdHJsLnYxLlNlbmRDb250YWN0TWVzc2FnZVJlc3BvbnNlElcKDlNlYXJjaFNlc3Npb25zEiEuaGRsY3RybC52MS5TZWFyY2hTZXNzaW9uc1JlcXVlc3QaIi5oZGxjdHJsLnYxLlNlYXJjaFNlc3Npb25zUmVzcG9uc2USYAoRR2V0U2Vzc2lvbkRldGFpbHMSJC5oZGxjdHJsLnYxLkdldFNlc3Npb25EZXRhaWxzUmVxdWVzdBolLmhkbGN0cmwudjEuR2V0U2Vzc2lvbkRldGFpbHNSZXNwb25zZRJLCgpTdGFydFdvcmxkEh0uaGRsY3RybC52MS5TdGFydFdvcmxkUmVxdWVzdBoeLmhkbGN0cmwudjEuU3RhcnRXb3JsZFJlc3BvbnNlEk4KC1N0b3BTZXNzaW9uEh4uaGRsY3RybC52MS5TdG9wU2Vzc2lvblJlcXVlc3QaHy5oZGxjdHJsLnYxLlN0b3BTZXNzaW9uUmVzcG9uc2USYwoSRGVsZXRlRW5kZWRTZXNzaW9uEiUuaGRsY3RybC52MS5EZWxldGVFbmRlZFNlc3Npb25SZXF1ZXN0GiYuaGRsY3RybC52MS5EZWxldGVFbmRlZFNlc3Npb25SZXNwb25zZRJdChBTYXZlU2Vzc2lvbldvcmxkEiMuaGRsY3RybC52MS5TYXZlU2Vzc2lvbldvcmxkUmVxdWVzdBokLmhkbGN0cmwudjEuU2F2ZVNlc3Npb25Xb3JsZFJlc3BvbnNlEn4KG1ByZXBhcmVTZXNzaW9uV29ybGREb3dubG9hZBIuLmhkbGN0cmwudjEuUHJlcGFyZVNlc3Npb25Xb3JsZERvd25sb2FkUmVxdWVzdBovLmhkbGN0cmwudjEuUHJlcGFyZVNlc3Npb25Xb3JsZERvd25sb2FkUmVzcG9uc2USSwoKSW52aXRlVXNlchIdLmhkbGN0cmwudjEuSW52aXRlVXNlclJlcXVlc3QaHi5oZGxjdHJsLnYxLkludml0ZVVzZXJSZXNwb25zZRJXCg5VcGRhdGVVc2VyUm9sZRIhLmhkbGN0cmwudjEuVXBkYXRlVXNlclJvbGVSZXF1ZXN0GiIuaGRsY3RybC52MS5VcGRhdGVVc2VyUm9sZVJlc3BvbnNlEnIKF1VwZGF0ZVNlc3Npb25QYXJhbWV0ZXJzEiouaGRsY3RybC52MS5VcGRhdGVTZXNzaW9uUGFyYW1ldGVyc1JlcXVlc3QaKy5oZGxjdHJsLnYxLlVwZGF0ZVNlc3Npb25QYXJhbWV0ZXJzUmVzcG9uc2USewoaVXBkYXRlU2Vzc2lvbkV4dHJhU2V0dGluZ3MSLS5oZGxjdHJsLnYxLlVwZGF0ZVNlc3Npb25FeHRyYVNldHRpbmdzUmVxdWVzdBouLmhkbGN0cmwudjEuVXBkYXRlU2Vzc2lvbkV4dHJhU2V0dGluZ3NSZXNwb25zZRJjChJMaXN0VXNlcnNJblNlc3Npb24SJS5oZGxjdHJsLnYxLkxpc3RVc2Vyc0luU2Vzc2lvblJlcXVlc3QaJi5oZGxjdHJsLnYxLkxpc3RVc2Vyc0luU2Vzc2lvblJlc3BvbnNlEkUKCEtpY2tVc2VyEhsuaGRsY3RybC52MS5LaWNrVXNlclJlcXVlc3QaHC5oZGxjdHJsLnYxLktpY2tVc2VyUmVzcG9uc2USQgoHQmFuVXNlchIaLmhkbGN0cmwudjEuQmFuVXNlclJlcXVlc3QaGy5oZGxjdHJsLnYxLkJhblVzZXJSZXNwb25zZRJ+ChtJc3N1ZVJlc29uaXRlTGlua0Nvbm5lY3Rpb24SLi5oZGxjdHJsLnYxLklzc3VlUmVzb25pdGVMaW5rQ29ubmVjdGlvblJlcXVlc3QaLy5oZGxjdHJsLnYxLklzc3VlUmVzb25pdGVMaW5rQ29ubmVjdGlvblJlc3BvbnNlEmwKFUxpc3RTZXNzaW9uVXNlckV2ZW50cxIoLmhkbGN0cmwudjEuTGlzdFNlc3Npb25Vc2VyRXZlbnRzUmVxdWVzdBopLmhkbGN0cmwudjEuTGlzdFNlc3Npb25Vc2VyRXZlbnRzUmVzcG9uc2USaQoUR2V0U2Vzc2lvbkF0dGVuZGFuY2USJy5oZGxjdHJsLnYxLkdldFNlc3Npb25BdHRlbmRhbmNlUmVxdWVzdBooLmhkbGN0cmwudjEuR2V0U2Vzc2lvbkF0dGVuZGFuY2VSZXNwb25zZRJpChRHZXRTZXNzaW9uVXNhZ2VTdGF0cxInLmhkbGN0cmwudjEuR2V0U2Vzc2lvblVzYWdlU3RhdHNSZXF1ZXN0GiguaGRsY3RybC52MS5HZXRTZXNzaW9uVXNhZ2VTdGF0c1Jlc3BvbnNlEl0KEEdldE1ldHJpY3NTZXJpZXMSIy5oZGxjdHJsLnYxLkdldE1ldHJpY3NTZXJpZXNSZXF1ZXN0GiQuaGRsY3RybC52MS5HZXRNZXRyaWNzU2VyaWVzUmVzcG9uc2USaQoUTGlzdFNlc3Npb25UZW1wbGF0ZXMSJy5oZGxjdHJsLnYxLkxpc3RTZXNzaW9uVGVtcGxhdGVzUmVxdWVzdBooLmhkbGN0cmwudjEuTGlzdFNlc3Npb25UZW1wbGF0ZXNSZXNwb25zZRJjChJHZXRTZXNzaW9uVGVtcGxhdGUSJS5oZGxjdHJsLnYxLkdldFNlc3Npb25UZW1wbGF0ZVJlcXVlc3QaJi5oZGxjdHJsLnYxLkdldFNlc3Npb25UZW1wbGF0ZVJlc3BvbnNlEmwKFUNyZWF0ZVNlc3Npb25UZW1wbGF0ZRIoLmhkbGN0cmwudjEuQ3JlYXRlU2Vzc2lvblRlbXBsYXRlUmVxdWVzdBopLmhkbGN0cmwudjEuQ3JlYXRlU2Vzc2lvblRlbXBsYXRlUmVzcG9uc2USbAoVVXBkYXRlU2Vzc2lvblRlbXBsYXRlEiguaGRsY3RybC52MS5VcGRhdGVTZXNzaW9uVGVtcGxhdGVSZXF1ZXN0GikuaGRsY3RybC52MS5VcGRhdGVTZXNzaW9uVGVtcGxhdGVSZXNwb25zZRJsChVEZWxldGVTZXNzaW9uVGVtcGxhdGUSKC5oZGxjdHJsLnYxLkRlbGV0ZVNlc3Npb25UZW1wbGF0ZVJlcXVlc3QaKS5oZGxjdHJsLnYxLkRlbGV0ZVNlc3Npb25UZW1wbGF0ZVJlc3BvbnNlEooBCh9DcmVhdGVTY2hlZHVsZWRTZXNzaW9uT3BlcmF0aW9uEjIuaGRsY3RybC52MS5DcmVhdGVTY2hlZHVsZWRTZXNzaW9uT3BlcmF0aW9uUmVxdWVzdBozLmhkbGN0cmwudjEuQ3JlYXRlU2NoZWR1bGVkU2Vzc2lvbk9wZXJhdGlvblJlc3BvbnNlEocBCh5MaXN0U2NoZWR1bGVkU2Vzc2lvbk9wZXJhdGlvbnMSMS5oZGxjdHJsLnYxLkxpc3RTY2hlZHVsZWRTZXNzaW9uT3BlcmF0aW9uc1JlcXVlc3QaMi5oZGxjdHJsLnYxLkxpc3RTY2hlZHVsZWRTZXNzaW9uT3BlcmF0aW9uc1Jlc3BvbnNlEooBCh9DYW5jZWxTY2hlZHVsZWRTZXNzaW9uT3BlcmF0aW9uEjIuaGRsY3RybC52MS5DYW5jZWxTY2hlZHVsZWRTZXNzaW9uT3BlcmF0aW9uUmVxdWVzdBozLmhkbGN0cmwudjEuQ2FuY2VsU2NoZWR1bGVkU2Vzc2lvbk9wZXJhdGlvblJlc3BvbnNlEocBCh5QYXVzZVNjaGVkdWxlZFNlc3Npb25PcGVyYXRpb24SMS5oZGxjdHJsLnYxLlBhdXNlU2NoZWR1bGVkU2Vzc2lvbk9wZXJhdGlvblJlcXVlc3QaMi5oZGxjdHJsLnYxLlBhdXNlU2NoZWR1bGVkU2Vzc2lvbk9wZXJhdGlvblJlc3BvbnNlEooBCh9SZXN1bWVTY2hlZHVsZWRTZXNzaW9uT3BlcmF0aW9uEjIuaGRsY3RybC52MS5SZXN1bWVTY2hlZHVsZWRTZXNzaW9uT3BlcmF0aW9uUmVxdWVzdBozLmhkbGN0cmwudjEuUmVzdW1lU2NoZWR1bGVkU2Vzc2lvbk9wZXJhdGlvblJlc3BvbnNlEqIBCidTa2lwU2NoZWR1bGVkU2Vzc2lvbk9wZXJhdGlvbk9jY3VycmVuY2USOi5oZGxjdHJsLnYxLlNraXBTY2hlZHVsZWRTZXNzaW9uT3BlcmF0aW9uT2NjdXJyZW5jZVJlcXVlc3QaOy5oZGxjdHJsLnYxLlNraXBTY2hlZHVsZWRTZXNzaW9uT3BlcmF0aW9uT2NjdXJyZW5jZVJlc3BvbnNlEpABCiFMaXN0U2NoZWR1bGVkU2Vzc2lvbk9wZXJhdGlvblJ1bnMSNC5oZGxjdHJsLnYxLkxpc3RTY2hlZHVsZWRTZXNzaW9uT3BlcmF0aW9uUnVuc1JlcXVlc3QaNS5oZGxjdHJsLnYxLkxpc3RTY2hlZHVsZWRTZXNzaW9uT3BlcmF0aW9uUnVuc1Jlc3BvbnNlQr0BCg5jb20uaGRsY3RybC52MUIPQ29udHJvbGxlclByb3RvUAFaUWdpdGh1Yi5jb20vaGFudGFiYXJ1MTAxNC9iYXJ1LXJlc28taGVhZGxlc3MtY29udHJvbGxlci9wYmdlbi9oZGxjdHJsL3YxO2hkbGN0cmx2MaICA0hYWKoCCkhkbGN0cmwuVjHKAgpIZGxjdHJsXFYx4gIWSGRsY3RybFxWMVxHUEJNZXRhZGF0YeoCC0hkbGN0cmw6OlYxYgZwcm90bzM", [file_google_protobuf_timestamp, file_headless_v1_headless]);
 
 /**
  * @generated from message hdlctrl.v1.RefetchHeadlessAccountInfoRequest
@@ -292,6 +292,13 @@ export type ListHeadlessHostInstancesResponse_Instance = Message<"hdlctrl.v1.Lis
    * @generated from field: bool is_current = 5;
    */
   isCurrent: boolean;
+
+  /**
+   * ログが保持期間を過ぎて blob store に退避されている. 読み方は変わらない
+   *
+   * @generated from field: bool archived = 6;
+   */
+  archived: boolean;
 };
 
 /**
@@ -412,6 +419,40 @@ export type StartHeadlessHostRequest = Message<"hdlctrl.v1.StartHeadlessHostRequ
    * @generated from field: optional string group_id = 7;
    */
   groupId?: string;
+
+  /**
+   * 起動先の docker node ID ("local" はコントローラ自身の Docker).
+   * 未指定なら空いている node が自動で選ばれる.
+   *
+   * @generated from field: optional string node_id = 8;
+   */
+  nodeId?: string;
+
+  /**
+   * コンテナのリソース制限など. 未指定なら無制限.
+   *
+   * @generated from field: optional hdlctrl.v1.HeadlessHostContainerSettings container_settings = 9;
+   */
+  containerSettings?: HeadlessHostContainerSettings;
+
+  /**
+   * @generated from field: optional hdlctrl.v1.HeadlessHostAutoRestartPolicy auto_restart_policy = 10;
+   */
+  autoRestartPolicy?: HeadlessHostAutoRestartPolicy;
+
+  /**
+   * @generated from field: optional int32 auto_restart_max_retries = 11;
+   */
+  autoRestartMaxRetries?: number;
+
+  /**
+   * 指定した場合、省略した項目 (image_tag / startup_config / auto_update_policy / memo /
+   * container_settings / auto_restart_policy / auto_restart_max_retries) をテンプレートから埋める.
+   * テンプレートはホストと同じグループのものである必要がある.
+   *
+   * @generated from field: optional string template_id = 12;
+   */
+  templateId?: string;
 };
 
 /**
@@ -441,6 +482,69 @@ export type StartHeadlessHostResponse = Message<"hdlctrl.v1.StartHeadlessHostRes
 export const StartHeadlessHostResponseSchema: GenMessage<StartHeadlessHostResponse> = /*@__PURE__*/
   messageDesc(file_hdlctrl_v1_controller, 19);
 
+/**
+ * ホスト設定 (HeadlessHostSettings)・自動更新ポリシー・メモ・コンテナ設定・自動再起動ポリシーを
+ * コピーする. start_worlds とイメージタグはコピーしない.
+ *
+ * @generated from message hdlctrl.v1.CloneHeadlessHostRequest
+ */
+export type CloneHeadlessHostRequest = Message<"hdlctrl.v1.CloneHeadlessHostRequest"> & {
+  /**
+   * @generated from field: string source_host_id = 1;
+   */
+  sourceHostId: string;
+
+  /**
+   * 未指定ならコピー元の名前に「のコピー」を付ける
+   *
+   * @generated from field: optional string name = 2;
+   */
+  name?: string;
+
+  /**
+   * 未指定ならコピー元と同じアカウント. コピー元と同じグループのアカウントである必要がある
+   *
+   * @generated from field: optional string headless_account_id = 3;
+   */
+  headlessAccountId?: string;
+
+  /**
+   * @generated from field: optional string image_tag = 4;
+   */
+  imageTag?: string;
+
+  /**
+   * @generated from field: optional string node_id = 5;
+   */
+  nodeId?: string;
+};
+
+/**
+ * Describes the message hdlctrl.v1.CloneHeadlessHostRequest.
+ * Use `create(CloneHeadlessHostRequestSchema)` to create a new message.
+ */
+export const CloneHeadlessHostRequestSchema: GenMessage<CloneHeadlessHostRequest> = /*@__PURE__*/
+  messageDesc(file_hdlctrl_v1_controller, 20);
+
+/**
+ * @generated from message hdlctrl.v1.CloneHeadlessHostResponse
+ */
+export type CloneHeadlessHostResponse = Message<"hdlctrl.v1.CloneHeadlessHostResponse"> & {
+  /**
+   * StartHeadlessHost と同じ非同期 job の ID
+   *
+   * @generated from field: string job_id = 1;
+   */
+  jobId: string;
+};
+
+/**
+ * Describes the message hdlctrl.v1.CloneHeadlessHostResponse.
+ * Use `create(CloneHeadlessHostResponseSchema)` to create a new message.
+ */
+export const CloneHeadlessHostResponseSchema: GenMessage<CloneHeadlessHostResponse> = /*@__PURE__*/
+  messageDesc(file_hdlctrl_v1_controller, 21);
+
 /**
  * @generated from message hdlctrl.v1.CreateHeadlessAccountRequest
  */
@@ -470,7 +574,7 @@ export type CreateHeadlessAccountRequest = Message<"hdlctrl.v1.CreateHeadlessAcc
  * Use `create(CreateHeadlessAccountRequestSchema)` to create a new message.
  */
 export const CreateHeadlessAccountRequestSchema: GenMessage<CreateHeadlessAccountRequest> = /*@__PURE__*/
-  messageDesc(file_hdlctrl_v1_controller, 20);
+  messageDesc(file_hdlctrl_v1_controller, 22);
 
 /**
  * @generated from message hdlctrl.v1.CreateHeadlessAccountResponse
@@ -483,7 +587,7 @@ export type CreateHeadlessAccountResponse = Message<"hdlctrl.v1.CreateHeadlessAc
  * Use `create(CreateHeadlessAccountResponseSchema)` to create a new message.
  */
 export const CreateHeadlessAccountResponseSchema: GenMessage<CreateHeadlessAccountResponse> = /*@__PURE__*/
-  messageDesc(file_hdlctrl_v1_controller, 21);
+  messageDesc(file_hdlctrl_v1_controller, 23);
 
 /**
  * @generated from message hdlctrl.v1.ListHeadlessAccountsRequest
@@ -509,7 +613,7 @@ export type ListHeadlessAccountsRequest = Message<"hdlctrl.v1.ListHeadlessAccoun
  * Use `create(ListHeadlessAccountsRequestSchema)` to create a new message.
  */
 export const ListHeadlessAccountsRequestSchema: GenMessage<ListHeadlessAccountsRequest> = /*@__PURE__*/
-  messageDesc(file_hdlctrl_v1_controller, 22);
+  messageDesc(file_hdlctrl_v1_controller, 24);
 
 /**
  * @generated from message hdlctrl.v1.ListHeadlessAccountsResponse
@@ -531,7 +635,7 @@ export type ListHeadlessAccountsResponse = Message<"hdlctrl.v1.ListHeadlessAccou
  * Use `create(ListHeadlessAccountsResponseSchema)` to create a new message.
  */
 export const ListHeadlessAccountsResponseSchema: GenMessage<ListHeadlessAccountsResponse> = /*@__PURE__*/
-  messageDesc(file_hdlctrl_v1_controller, 23);
+  messageDesc(file_hdlctrl_v1_controller, 25);
 
 /**
  * @generated from message hdlctrl.v1.ListHeadlessHostImageTagsRequest
@@ -544,7 +648,7 @@ export type ListHeadlessHostImageTagsRequest = Message<"hdlctrl.v1.ListHeadlessH
  * Use `create(ListHeadlessHostImageTagsRequestSchema)` to create a new message.
  */
 export const ListHeadlessHostImageTagsRequestSchema: GenMessage<ListHeadlessHostImageTagsRequest> = /*@__PURE__*/
-  messageDesc(file_hdlctrl_v1_controller, 24);
+  messageDesc(file_hdlctrl_v1_controller, 26);
 
 /**
  * @generated from message hdlctrl.v1.ListHeadlessHostImageTagsResponse
@@ -561,7 +665,7 @@ export type ListHeadlessHostImageTagsResponse = Message<"hdlctrl.v1.ListHeadless
  * Use `create(ListHeadlessHostImageTagsResponseSchema)` to create a new message.
  */
 export const ListHeadlessHostImageTagsResponseSchema: GenMessage<ListHeadlessHostImageTagsResponse> = /*@__PURE__*/
-  messageDesc(file_hdlctrl_v1_controller, 25);
+  messageDesc(file_hdlctrl_v1_controller, 27);
 
 /**
  * @generated from message hdlctrl.v1.ListHeadlessHostImageTagsResponse.ContainerImage
@@ -593,7 +697,7 @@ export type ListHeadlessHostImageTagsResponse_ContainerImage = Message<"hdlctrl.
  * Use `create(ListHeadlessHostImageTagsResponse_ContainerImageSchema)` to create a new message.
  */
 export const ListHeadlessHostImageTagsResponse_ContainerImageSchema: GenMessage<ListHeadlessHostImageTagsResponse_ContainerImage> = /*@__PURE__*/
-  messageDesc(file_hdlctrl_v1_controller, 25, 0);
+  messageDesc(file_hdlctrl_v1_controller, 27, 0);
 
 /**
  * @generated from message hdlctrl.v1.AcceptFriendRequestsRequest
@@ -615,7 +719,7 @@ export type AcceptFriendRequestsRequest = Message<"hdlctrl.v1.AcceptFriendReques
  * Use `create(AcceptFriendRequestsRequestSchema)` to create a new message.
  */
 export const AcceptFriendRequestsRequestSchema: GenMessage<AcceptFriendRequestsRequest> = /*@__PURE__*/
-  messageDesc(file_hdlctrl_v1_controller, 26);
+  messageDesc(file_hdlctrl_v1_controller, 28);
 
 /**
  * @generated from message hdlctrl.v1.AcceptFriendRequestsResponse
@@ -628,7 +732,7 @@ export type AcceptFriendRequestsResponse = Message<"hdlctrl.v1.AcceptFriendReque
  * Use `create(AcceptFriendRequestsResponseSchema)` to create a new message.
  */
 export const AcceptFriendRequestsResponseSchema: GenMessage<AcceptFriendRequestsResponse> = /*@__PURE__*/
-  messageDesc(file_hdlctrl_v1_controller, 27);
+  messageDesc(file_hdlctrl_v1_controller, 29);
 
 /**
  * @generated from message hdlctrl.v1.GetFriendRequestsRequest
@@ -645,7 +749,7 @@ export type GetFriendRequestsRequest = Message<"hdlctrl.v1.GetFriendRequestsRequ
  * Use `create(GetFriendRequestsRequestSchema)` to create a new message.
  */
 export const GetFriendRequestsRequestSchema: GenMessage<GetFriendRequestsRequest> = /*@__PURE__*/
-  messageDesc(file_hdlctrl_v1_controller, 28);
+  messageDesc(file_hdlctrl_v1_controller, 30);
 
 /**
  * @generated from message hdlctrl.v1.GetFriendRequestsResponse
@@ -662,7 +766,7 @@ export type GetFriendRequestsResponse = Message<"hdlctrl.v1.GetFriendRequestsRes
  * Use `create(GetFriendRequestsResponseSchema)` to create a new message.
  */
 export const GetFriendRequestsResponseSchema: GenMessage<GetFriendRequestsResponse> = /*@__PURE__*/
-  messageDesc(file_hdlctrl_v1_controller, 29);
+  messageDesc(file_hdlctrl_v1_controller, 31);
 
 /**
  * @generated from message hdlctrl.v1.RestartHeadlessHostRequest
@@ -699,7 +803,7 @@ export type RestartHeadlessHostRequest = Message<"hdlctrl.v1.RestartHeadlessHost
  * Use `create(RestartHeadlessHostRequestSchema)` to create a new message.
  */
 export const RestartHeadlessHostRequestSchema: GenMessage<RestartHeadlessHostRequest> = /*@__PURE__*/
-  messageDesc(file_hdlctrl_v1_controller, 30);
+  messageDesc(file_hdlctrl_v1_controller, 32);
 
 /**
  * @generated from message hdlctrl.v1.RestartHeadlessHostResponse
@@ -716,7 +820,7 @@ export type RestartHeadlessHostResponse = Message<"hdlctrl.v1.RestartHeadlessHos
  * Use `create(RestartHeadlessHostResponseSchema)` to create a new message.
  */
 export const RestartHeadlessHostResponseSchema: GenMessage<RestartHeadlessHostResponse> = /*@__PURE__*/
-  messageDesc(file_hdlctrl_v1_controller, 31);
+  messageDesc(file_hdlctrl_v1_controller, 33);
 
 /**
  * @generated from message hdlctrl.v1.UpdateHeadlessHostSettingsRequest
@@ -766,6 +870,25 @@ export type UpdateHeadlessHostSettingsRequest = Message<"hdlctrl.v1.UpdateHeadle
    * @generated from field: optional hdlctrl.v1.HeadlessHostAutoUpdatePolicy auto_update_policy = 9;
    */
   autoUpdatePolicy?: HeadlessHostAutoUpdatePolicy;
+
+  /**
+   * 指定すると丸ごと置き換える. 稼働中のコンテナには反映されず、次回の起動/再起動から適用される.
+   *
+   * @generated from field: optional hdlctrl.v1.HeadlessHostContainerSettings container_settings = 10;
+   */
+  containerSettings?: HeadlessHostContainerSettings;
+
+  /**
+   * 変更すると連続再起動回数がリセットされ、crash loop で止まっていた自動再起動も再開する.
+   *
+   * @generated from field: optional hdlctrl.v1.HeadlessHostAutoRestartPolicy auto_restart_policy = 11;
+   */
+  autoRestartPolicy?: HeadlessHostAutoRestartPolicy;
+
+  /**
+   * @generated from field: optional int32 auto_restart_max_retries = 12;
+   */
+  autoRestartMaxRetries?: number;
 };
 
 /**
@@ -773,7 +896,7 @@ export type UpdateHeadlessHostSettingsRequest = Message<"hdlctrl.v1.UpdateHeadle
  * Use `create(UpdateHeadlessHostSettingsRequestSchema)` to create a new message.
  */
 export const UpdateHeadlessHostSettingsRequestSchema: GenMessage<UpdateHeadlessHostSettingsRequest> = /*@__PURE__*/
-  messageDesc(file_hdlctrl_v1_controller, 32);
+  messageDesc(file_hdlctrl_v1_controller, 34);
 
 /**
  * @generated from message hdlctrl.v1.UpdateHeadlessHostSettingsResponse
@@ -786,7 +909,7 @@ export type UpdateHeadlessHostSettingsResponse = Message<"hdlctrl.v1.UpdateHeadl
  * Use `create(UpdateHeadlessHostSettingsResponseSchema)` to create a new message.
  */
 export const UpdateHeadlessHostSettingsResponseSchema: GenMessage<UpdateHeadlessHostSettingsResponse> = /*@__PURE__*/
-  messageDesc(file_hdlctrl_v1_controller, 33);
+  messageDesc(file_hdlctrl_v1_controller, 35);
 
 /**
  * @generated from message hdlctrl.v1.ShutdownHeadlessHostRequest
@@ -803,7 +926,7 @@ export type ShutdownHeadlessHostRequest = Message<"hdlctrl.v1.ShutdownHeadlessHo
  * Use `create(ShutdownHeadlessHostRequestSchema)` to create a new message.
  */
 export const ShutdownHeadlessHostRequestSchema: GenMessage<ShutdownHeadlessHostRequest> = /*@__PURE__*/
-  messageDesc(file_hdlctrl_v1_controller, 34);
+  messageDesc(file_hdlctrl_v1_controller, 36);
 
 /**
  * @generated from message hdlctrl.v1.ShutdownHeadlessHostResponse
@@ -820,7 +943,7 @@ export type ShutdownHeadlessHostResponse = Message<"hdlctrl.v1.ShutdownHeadlessH
  * Use `create(ShutdownHeadlessHostResponseSchema)` to create a new message.
  */
 export const ShutdownHeadlessHostResponseSchema: GenMessage<ShutdownHeadlessHostResponse> = /*@__PURE__*/
-  messageDesc(file_hdlctrl_v1_controller, 35);
+  messageDesc(file_hdlctrl_v1_controller, 37);
 
 /**
  * @generated from message hdlctrl.v1.KillHeadlessHostRequest
@@ -837,7 +960,7 @@ export type KillHeadlessHostRequest = Message<"hdlctrl.v1.KillHeadlessHostReques
  * Use `create(KillHeadlessHostRequestSchema)` to create a new message.
  */
 export const KillHeadlessHostRequestSchema: GenMessage<KillHeadlessHostRequest> = /*@__PURE__*/
-  messageDesc(file_hdlctrl_v1_controller, 36);
+  messageDesc(file_hdlctrl_v1_controller, 38);
 
 /**
  * @generated from message hdlctrl.v1.KillHeadlessHostResponse
@@ -850,7 +973,7 @@ export type KillHeadlessHostResponse = Message<"hdlctrl.v1.KillHeadlessHostRespo
  * Use `create(KillHeadlessHostResponseSchema)` to create a new message.
  */
 export const KillHeadlessHostResponseSchema: GenMessage<KillHeadlessHostResponse> = /*@__PURE__*/
-  messageDesc(file_hdlctrl_v1_controller, 37);
+  messageDesc(file_hdlctrl_v1_controller, 39);
 
 /**
  * @generated from message hdlctrl.v1.GetHeadlessHostLogsRequest
@@ -904,7 +1027,7 @@ export type GetHeadlessHostLogsRequest = Message<"hdlctrl.v1.GetHeadlessHostLogs
  * Use `create(GetHeadlessHostLogsRequestSchema)` to create a new message.
  */
 export const GetHeadlessHostLogsRequestSchema: GenMessage<GetHeadlessHostLogsRequest> = /*@__PURE__*/
-  messageDesc(file_hdlctrl_v1_controller, 38);
+  messageDesc(file_hdlctrl_v1_controller, 40);
 
 /**
  * @generated from message hdlctrl.v1.GetHeadlessHostLogsResponse
@@ -937,7 +1060,7 @@ export type GetHeadlessHostLogsResponse = Message<"hdlctrl.v1.GetHeadlessHostLog
  * Use `create(GetHeadlessHostLogsResponseSchema)` to create a new message.
  */
 export const GetHeadlessHostLogsResponseSchema: GenMessage<GetHeadlessHostLogsResponse> = /*@__PURE__*/
-  messageDesc(file_hdlctrl_v1_controller, 39);
+  messageDesc(file_hdlctrl_v1_controller, 41);
 
 /**
  * @generated from message hdlctrl.v1.GetHeadlessHostLogsResponse.Log
@@ -962,6 +1085,13 @@ export type GetHeadlessHostLogsResponse_Log = Message<"hdlctrl.v1.GetHeadlessHos
    * @generated from field: int64 id = 4;
    */
   id: bigint;
+
+  /**
+   * stream と本文から推定したレベル
+   *
+   * @generated from field: hdlctrl.v1.HeadlessHostLogLevel level = 5;
+   */
+  level: HeadlessHostLogLevel;
 };
 
 /**
@@ -969,1054 +1099,2499 @@ export type GetHeadlessHostLogsResponse_Log = Message<"hdlctrl.v1.GetHeadlessHos
  * Use `create(GetHeadlessHostLogsResponse_LogSchema)` to create a new message.
  */
 export const GetHeadlessHostLogsResponse_LogSchema: GenMessage<GetHeadlessHostLogsResponse_Log> = /*@__PURE__*/
-  messageDesc(file_hdlctrl_v1_controller, 39, 0);
+  messageDesc(file_hdlctrl_v1_controller, 41, 0);
 
 /**
- * @generated from message hdlctrl.v1.SearchUserInfoRequest
+ * @generated from message hdlctrl.v1.TailHeadlessHostLogsRequest
  */
-export type SearchUserInfoRequest = Message<"hdlctrl.v1.SearchUserInfoRequest"> & {
+export type TailHeadlessHostLogsRequest = Message<"hdlctrl.v1.TailHeadlessHostLogsRequest"> & {
   /**
    * @generated from field: string host_id = 1;
    */
   hostId: string;
 
   /**
-   * @generated from field: headless.v1.SearchUserInfoRequest parameters = 2;
+   * 対象インスタンスID. 0 なら現在のインスタンス
+   *
+   * @generated from field: int32 instance_id = 2;
    */
-  parameters?: SearchUserInfoRequest$1;
-};
+  instanceId: number;
 
-/**
- * Describes the message hdlctrl.v1.SearchUserInfoRequest.
- * Use `create(SearchUserInfoRequestSchema)` to create a new message.
- */
-export const SearchUserInfoRequestSchema: GenMessage<SearchUserInfoRequest> = /*@__PURE__*/
-  messageDesc(file_hdlctrl_v1_controller, 40);
+  /**
+   * 最初に送る直近のログの件数 (デフォルト100、最大1000). フィルタに一致したものだけ数える
+   *
+   * @generated from field: int32 backlog = 3;
+   */
+  backlog: number;
 
-/**
- * @generated from message hdlctrl.v1.KickUserRequest
- */
-export type KickUserRequest = Message<"hdlctrl.v1.KickUserRequest"> & {
   /**
-   * @generated from field: string host_id = 1;
+   * 再接続用. 指定するとバックログの代わりにこのIDより後のログから送る
+   *
+   * @generated from field: optional int64 after_id = 4;
    */
-  hostId: string;
+  afterId?: bigint;
 
   /**
-   * @generated from field: headless.v1.KickUserRequest parameters = 2;
+   * 本文にこの文字列を含むログだけ送る (大文字小文字を区別しない)
+   *
+   * @generated from field: string contains = 5;
    */
-  parameters?: KickUserRequest$1;
-};
+  contains: string;
 
-/**
- * Describes the message hdlctrl.v1.KickUserRequest.
- * Use `create(KickUserRequestSchema)` to create a new message.
- */
-export const KickUserRequestSchema: GenMessage<KickUserRequest> = /*@__PURE__*/
-  messageDesc(file_hdlctrl_v1_controller, 41);
+  /**
+   * 本文がこの正規表現 (RE2) に一致するログだけ送る
+   *
+   * @generated from field: string pattern = 6;
+   */
+  pattern: string;
 
-/**
- * @generated from message hdlctrl.v1.KickUserResponse
- */
-export type KickUserResponse = Message<"hdlctrl.v1.KickUserResponse"> & {
+  /**
+   * このレベル以上のログだけ送る. 未指定なら全レベル
+   *
+   * @generated from field: hdlctrl.v1.HeadlessHostLogLevel min_level = 7;
+   */
+  minLevel: HeadlessHostLogLevel;
 };
 
 /**
- * Describes the message hdlctrl.v1.KickUserResponse.
- * Use `create(KickUserResponseSchema)` to create a new message.
+ * Describes the message hdlctrl.v1.TailHeadlessHostLogsRequest.
+ * Use `create(TailHeadlessHostLogsRequestSchema)` to create a new message.
  */
-export const KickUserResponseSchema: GenMessage<KickUserResponse> = /*@__PURE__*/
+export const TailHeadlessHostLogsRequestSchema: GenMessage<TailHeadlessHostLogsRequest> = /*@__PURE__*/
   messageDesc(file_hdlctrl_v1_controller, 42);
 
 /**
- * @generated from message hdlctrl.v1.BanUserRequest
+ * @generated from message hdlctrl.v1.TailHeadlessHostLogsResponse
  */
-export type BanUserRequest = Message<"hdlctrl.v1.BanUserRequest"> & {
+export type TailHeadlessHostLogsResponse = Message<"hdlctrl.v1.TailHeadlessHostLogsResponse"> & {
   /**
-   * @generated from field: string host_id = 1;
+   * 時系列に並んだログ. keep-alive では空
+   *
+   * @generated from field: repeated hdlctrl.v1.GetHeadlessHostLogsResponse.Log logs = 1;
    */
-  hostId: string;
+  logs: GetHeadlessHostLogsResponse_Log[];
 
   /**
-   * @generated from field: headless.v1.BanUserRequest parameters = 2;
+   * 対象のインスタンスID
+   *
+   * @generated from field: int32 instance_id = 2;
    */
-  parameters?: BanUserRequest$1;
+  instanceId: number;
+
+  /**
+   * バックログを送り終えたメッセージで true. 以降は新しいログ
+   *
+   * @generated from field: bool backlog_complete = 3;
+   */
+  backlogComplete: boolean;
 };
 
 /**
- * Describes the message hdlctrl.v1.BanUserRequest.
- * Use `create(BanUserRequestSchema)` to create a new message.
+ * Describes the message hdlctrl.v1.TailHeadlessHostLogsResponse.
+ * Use `create(TailHeadlessHostLogsResponseSchema)` to create a new message.
  */
-export const BanUserRequestSchema: GenMessage<BanUserRequest> = /*@__PURE__*/
+export const TailHeadlessHostLogsResponseSchema: GenMessage<TailHeadlessHostLogsResponse> = /*@__PURE__*/
   messageDesc(file_hdlctrl_v1_controller, 43);
 
 /**
- * @generated from message hdlctrl.v1.BanUserResponse
+ * @generated from message hdlctrl.v1.SearchHeadlessHostLogsRequest
  */
-export type BanUserResponse = Message<"hdlctrl.v1.BanUserResponse"> & {
-};
+export type SearchHeadlessHostLogsRequest = Message<"hdlctrl.v1.SearchHeadlessHostLogsRequest"> & {
+  /**
+   * 本文に含まれる文字列 (大文字小文字を区別しない). 3 文字以上
+   *
+   * @generated from field: string query = 1;
+   */
+  query: string;
 
-/**
- * Describes the message hdlctrl.v1.BanUserResponse.
- * Use `create(BanUserResponseSchema)` to create a new message.
- */
-export const BanUserResponseSchema: GenMessage<BanUserResponse> = /*@__PURE__*/
-  messageDesc(file_hdlctrl_v1_controller, 44);
+  /**
+   * 指定したグループのホストのみを検索する.
+   * 未指定の場合は呼び出しユーザーが host:read を持つグループ群に絞り込む.
+   *
+   * @generated from field: optional string group_id = 2;
+   */
+  groupId?: string;
 
-/**
- * ResoniteLink (WebSocket) 接続用の短期トークン付きパスを発行する。
- * 返される ws_path は path + query のみ。クライアント (フロントエンド) は
- * 現在の origin を補完して完全な ws[s]://host/path?token=... を組み立てる。
- *
- * @generated from message hdlctrl.v1.IssueResoniteLinkConnectionRequest
- */
-export type IssueResoniteLinkConnectionRequest = Message<"hdlctrl.v1.IssueResoniteLinkConnectionRequest"> & {
   /**
-   * @generated from field: string session_id = 1;
+   * 指定したホストのみを検索する. 未指定なら全ホスト
+   *
+   * @generated from field: repeated string host_ids = 3;
    */
-  sessionId: string;
+  hostIds: string[];
+
+  /**
+   * since 以降 (含む) / until より前 (含まない) のログに絞り込む.
+   *
+   * @generated from field: optional google.protobuf.Timestamp since = 4;
+   */
+  since?: Timestamp;
+
+  /**
+   * @generated from field: optional google.protobuf.Timestamp until = 5;
+   */
+  until?: Timestamp;
+
+  /**
+   * 取得件数 (デフォルト100、最大500)
+   *
+   * @generated from field: int32 limit = 6;
+   */
+  limit: number;
+
+  /**
+   * ページングカーソル. 前回の next_before_id を指定すると続きを返す
+   *
+   * @generated from field: int64 before_id = 7;
+   */
+  beforeId: bigint;
 };
 
 /**
- * Describes the message hdlctrl.v1.IssueResoniteLinkConnectionRequest.
- * Use `create(IssueResoniteLinkConnectionRequestSchema)` to create a new message.
+ * Describes the message hdlctrl.v1.SearchHeadlessHostLogsRequest.
+ * Use `create(SearchHeadlessHostLogsRequestSchema)` to create a new message.
  */
-export const IssueResoniteLinkConnectionRequestSchema: GenMessage<IssueResoniteLinkConnectionRequest> = /*@__PURE__*/
-  messageDesc(file_hdlctrl_v1_controller, 45);
+export const SearchHeadlessHostLogsRequestSchema: GenMessage<SearchHeadlessHostLogsRequest> = /*@__PURE__*/
+  messageDesc(file_hdlctrl_v1_controller, 44);
 
 /**
- * @generated from message hdlctrl.v1.IssueResoniteLinkConnectionResponse
+ * @generated from message hdlctrl.v1.SearchHeadlessHostLogsResponse
  */
-export type IssueResoniteLinkConnectionResponse = Message<"hdlctrl.v1.IssueResoniteLinkConnectionResponse"> & {
+export type SearchHeadlessHostLogsResponse = Message<"hdlctrl.v1.SearchHeadlessHostLogsResponse"> & {
   /**
-   * @generated from field: string ws_path = 1;
+   * 一致したログを新しい順に並べたときに先に現れるものから並ぶ
+   *
+   * @generated from field: repeated hdlctrl.v1.SearchHeadlessHostLogsResponse.Group groups = 1;
    */
-  wsPath: string;
+  groups: SearchHeadlessHostLogsResponse_Group[];
 
   /**
-   * @generated from field: google.protobuf.Timestamp expires_at = 2;
+   * 続きがあれば次のページの before_id. なければ 0
+   *
+   * @generated from field: int64 next_before_id = 2;
    */
-  expiresAt?: Timestamp;
+  nextBeforeId: bigint;
 };
 
 /**
- * Describes the message hdlctrl.v1.IssueResoniteLinkConnectionResponse.
- * Use `create(IssueResoniteLinkConnectionResponseSchema)` to create a new message.
+ * Describes the message hdlctrl.v1.SearchHeadlessHostLogsResponse.
+ * Use `create(SearchHeadlessHostLogsResponseSchema)` to create a new message.
  */
-export const IssueResoniteLinkConnectionResponseSchema: GenMessage<IssueResoniteLinkConnectionResponse> = /*@__PURE__*/
-  messageDesc(file_hdlctrl_v1_controller, 46);
+export const SearchHeadlessHostLogsResponseSchema: GenMessage<SearchHeadlessHostLogsResponse> = /*@__PURE__*/
+  messageDesc(file_hdlctrl_v1_controller, 45);
 
 /**
- * @generated from message hdlctrl.v1.FetchWorldInfoRequest
+ * 1 つのホストの 1 インスタンス分の一致
+ *
+ * @generated from message hdlctrl.v1.SearchHeadlessHostLogsResponse.Group
  */
-export type FetchWorldInfoRequest = Message<"hdlctrl.v1.FetchWorldInfoRequest"> & {
+export type SearchHeadlessHostLogsResponse_Group = Message<"hdlctrl.v1.SearchHeadlessHostLogsResponse.Group"> & {
   /**
    * @generated from field: string host_id = 1;
    */
   hostId: string;
 
   /**
-   * @generated from field: string url = 2;
+   * @generated from field: string host_name = 2;
    */
-  url: string;
-};
-
-/**
- * Describes the message hdlctrl.v1.FetchWorldInfoRequest.
- * Use `create(FetchWorldInfoRequestSchema)` to create a new message.
- */
-export const FetchWorldInfoRequestSchema: GenMessage<FetchWorldInfoRequest> = /*@__PURE__*/
-  messageDesc(file_hdlctrl_v1_controller, 47);
-
-/**
- * @generated from message hdlctrl.v1.SearchWorldsRequest
- */
-export type SearchWorldsRequest = Message<"hdlctrl.v1.SearchWorldsRequest"> & {
-  /**
-   * @generated from field: string query = 1;
-   */
-  query: string;
+  hostName: string;
 
   /**
-   * @generated from field: bool featured_only = 2;
+   * @generated from field: int32 instance_id = 3;
    */
-  featuredOnly: boolean;
+  instanceId: number;
 
   /**
-   * @generated from field: int32 page_index = 3;
+   * 時系列に並んだログ
+   *
+   * @generated from field: repeated hdlctrl.v1.GetHeadlessHostLogsResponse.Log logs = 4;
    */
-  pageIndex: number;
+  logs: GetHeadlessHostLogsResponse_Log[];
 };
 
 /**
- * Describes the message hdlctrl.v1.SearchWorldsRequest.
- * Use `create(SearchWorldsRequestSchema)` to create a new message.
+ * Describes the message hdlctrl.v1.SearchHeadlessHostLogsResponse.Group.
+ * Use `create(SearchHeadlessHostLogsResponse_GroupSchema)` to create a new message.
  */
-export const SearchWorldsRequestSchema: GenMessage<SearchWorldsRequest> = /*@__PURE__*/
-  messageDesc(file_hdlctrl_v1_controller, 48);
+export const SearchHeadlessHostLogsResponse_GroupSchema: GenMessage<SearchHeadlessHostLogsResponse_Group> = /*@__PURE__*/
+  messageDesc(file_hdlctrl_v1_controller, 45, 0);
 
 /**
- * @generated from message hdlctrl.v1.SearchWorldsResponse
+ * @generated from message hdlctrl.v1.PrepareHeadlessHostLogDownloadRequest
  */
-export type SearchWorldsResponse = Message<"hdlctrl.v1.SearchWorldsResponse"> & {
+export type PrepareHeadlessHostLogDownloadRequest = Message<"hdlctrl.v1.PrepareHeadlessHostLogDownloadRequest"> & {
   /**
-   * @generated from field: repeated hdlctrl.v1.SearchWorldsResponse.WorldRecord records = 1;
+   * @generated from field: string host_id = 1;
    */
-  records: SearchWorldsResponse_WorldRecord[];
+  hostId: string;
 
   /**
-   * @generated from field: bool has_more = 2;
+   * 0 の場合は現在のインスタンス
+   *
+   * @generated from field: int32 instance_id = 2;
    */
-  hasMore: boolean;
-};
-
-/**
- * Describes the message hdlctrl.v1.SearchWorldsResponse.
- * Use `create(SearchWorldsResponseSchema)` to create a new message.
- */
-export const SearchWorldsResponseSchema: GenMessage<SearchWorldsResponse> = /*@__PURE__*/
-  messageDesc(file_hdlctrl_v1_controller, 49);
+  instanceId: number;
 
-/**
- * @generated from message hdlctrl.v1.SearchWorldsResponse.WorldRecord
- */
-export type SearchWorldsResponse_WorldRecord = Message<"hdlctrl.v1.SearchWorldsResponse.WorldRecord"> & {
   /**
-   * @generated from field: string id = 1;
+   * @generated from field: hdlctrl.v1.HeadlessHostLogExportFormat format = 3;
    */
-  id: string;
+  format: HeadlessHostLogExportFormat;
 
   /**
-   * @generated from field: string owner_id = 2;
+   * 期間 (since は含み、until は含まない). 未指定なら全体
+   *
+   * @generated from field: optional google.protobuf.Timestamp since = 4;
    */
-  ownerId: string;
+  since?: Timestamp;
 
   /**
-   * @generated from field: string owner_name = 3;
+   * @generated from field: optional google.protobuf.Timestamp until = 5;
    */
-  ownerName: string;
+  until?: Timestamp;
+};
 
-  /**
-   * @generated from field: string name = 4;
-   */
-  name: string;
+/**
+ * Describes the message hdlctrl.v1.PrepareHeadlessHostLogDownloadRequest.
+ * Use `create(PrepareHeadlessHostLogDownloadRequestSchema)` to create a new message.
+ */
+export const PrepareHeadlessHostLogDownloadRequestSchema: GenMessage<PrepareHeadlessHostLogDownloadRequest> = /*@__PURE__*/
+  messageDesc(file_hdlctrl_v1_controller, 46);
 
+/**
+ * @generated from message hdlctrl.v1.PrepareHeadlessHostLogDownloadResponse
+ */
+export type PrepareHeadlessHostLogDownloadResponse = Message<"hdlctrl.v1.PrepareHeadlessHostLogDownloadResponse"> & {
   /**
-   * @generated from field: string description = 5;
+   * ブラウザがそのままアクセス可能な相対 URL: /blobs/<uuid>
+   *
+   * @generated from field: string download_url = 1;
    */
-  description: string;
+  downloadUrl: string;
 
   /**
-   * @generated from field: string thumbnail_url = 6;
+   * @generated from field: string filename = 2;
    */
-  thumbnailUrl: string;
+  filename: string;
 
   /**
-   * @generated from field: bool is_featured = 7;
+   * ファイルに含まれるログの行数
+   *
+   * @generated from field: int64 line_count = 3;
    */
-  isFeatured: boolean;
+  lineCount: bigint;
 };
 
 /**
- * Describes the message hdlctrl.v1.SearchWorldsResponse.WorldRecord.
- * Use `create(SearchWorldsResponse_WorldRecordSchema)` to create a new message.
+ * Describes the message hdlctrl.v1.PrepareHeadlessHostLogDownloadResponse.
+ * Use `create(PrepareHeadlessHostLogDownloadResponseSchema)` to create a new message.
  */
-export const SearchWorldsResponse_WorldRecordSchema: GenMessage<SearchWorldsResponse_WorldRecord> = /*@__PURE__*/
-  messageDesc(file_hdlctrl_v1_controller, 49, 0);
+export const PrepareHeadlessHostLogDownloadResponseSchema: GenMessage<PrepareHeadlessHostLogDownloadResponse> = /*@__PURE__*/
+  messageDesc(file_hdlctrl_v1_controller, 47);
 
 /**
- * @generated from message hdlctrl.v1.GetOwnWorldsRequest
+ * @generated from message hdlctrl.v1.SearchUserInfoRequest
  */
-export type GetOwnWorldsRequest = Message<"hdlctrl.v1.GetOwnWorldsRequest"> & {
+export type SearchUserInfoRequest = Message<"hdlctrl.v1.SearchUserInfoRequest"> & {
   /**
    * @generated from field: string host_id = 1;
    */
   hostId: string;
 
   /**
-   * @generated from field: int32 page_index = 2;
+   * @generated from field: headless.v1.SearchUserInfoRequest parameters = 2;
    */
-  pageIndex: number;
+  parameters?: SearchUserInfoRequest$1;
 };
 
 /**
- * Describes the message hdlctrl.v1.GetOwnWorldsRequest.
- * Use `create(GetOwnWorldsRequestSchema)` to create a new message.
+ * Describes the message hdlctrl.v1.SearchUserInfoRequest.
+ * Use `create(SearchUserInfoRequestSchema)` to create a new message.
  */
-export const GetOwnWorldsRequestSchema: GenMessage<GetOwnWorldsRequest> = /*@__PURE__*/
-  messageDesc(file_hdlctrl_v1_controller, 50);
+export const SearchUserInfoRequestSchema: GenMessage<SearchUserInfoRequest> = /*@__PURE__*/
+  messageDesc(file_hdlctrl_v1_controller, 48);
 
 /**
- * @generated from message hdlctrl.v1.GetOwnWorldsResponse
+ * @generated from message hdlctrl.v1.KickUserRequest
  */
-export type GetOwnWorldsResponse = Message<"hdlctrl.v1.GetOwnWorldsResponse"> & {
+export type KickUserRequest = Message<"hdlctrl.v1.KickUserRequest"> & {
   /**
-   * @generated from field: repeated hdlctrl.v1.SearchWorldsResponse.WorldRecord records = 1;
+   * @generated from field: string host_id = 1;
    */
-  records: SearchWorldsResponse_WorldRecord[];
+  hostId: string;
 
   /**
-   * @generated from field: bool has_more = 2;
+   * @generated from field: headless.v1.KickUserRequest parameters = 2;
    */
-  hasMore: boolean;
+  parameters?: KickUserRequest$1;
 };
 
 /**
- * Describes the message hdlctrl.v1.GetOwnWorldsResponse.
- * Use `create(GetOwnWorldsResponseSchema)` to create a new message.
+ * Describes the message hdlctrl.v1.KickUserRequest.
+ * Use `create(KickUserRequestSchema)` to create a new message.
  */
-export const GetOwnWorldsResponseSchema: GenMessage<GetOwnWorldsResponse> = /*@__PURE__*/
-  messageDesc(file_hdlctrl_v1_controller, 51);
+export const KickUserRequestSchema: GenMessage<KickUserRequest> = /*@__PURE__*/
+  messageDesc(file_hdlctrl_v1_controller, 49);
 
 /**
- * @generated from message hdlctrl.v1.ListHeadlessHostRequest
+ * @generated from message hdlctrl.v1.KickUserResponse
  */
-export type ListHeadlessHostRequest = Message<"hdlctrl.v1.ListHeadlessHostRequest"> & {
-  /**
-   * @generated from field: hdlctrl.v1.PageRequest page = 1;
-   */
-  page?: PageRequest;
-
-  /**
-   * 指定したグループに所属するホストのみを返す.
-   * 未指定の場合は呼び出しユーザーが host:read を持つグループ群に絞り込む.
-   * system:group.list 保持者は未指定時に全件返却.
-   *
-   * @generated from field: optional string group_id = 2;
-   */
-  groupId?: string;
+export type KickUserResponse = Message<"hdlctrl.v1.KickUserResponse"> & {
 };
 
 /**
- * Describes the message hdlctrl.v1.ListHeadlessHostRequest.
- * Use `create(ListHeadlessHostRequestSchema)` to create a new message.
+ * Describes the message hdlctrl.v1.KickUserResponse.
+ * Use `create(KickUserResponseSchema)` to create a new message.
  */
-export const ListHeadlessHostRequestSchema: GenMessage<ListHeadlessHostRequest> = /*@__PURE__*/
-  messageDesc(file_hdlctrl_v1_controller, 52);
+export const KickUserResponseSchema: GenMessage<KickUserResponse> = /*@__PURE__*/
+  messageDesc(file_hdlctrl_v1_controller, 50);
 
 /**
- * @generated from message hdlctrl.v1.ListHeadlessHostResponse
+ * @generated from message hdlctrl.v1.BanUserRequest
  */
-export type ListHeadlessHostResponse = Message<"hdlctrl.v1.ListHeadlessHostResponse"> & {
+export type BanUserRequest = Message<"hdlctrl.v1.BanUserRequest"> & {
   /**
-   * @generated from field: repeated hdlctrl.v1.HeadlessHost hosts = 1;
+   * @generated from field: string host_id = 1;
    */
-  hosts: HeadlessHost[];
+  hostId: string;
 
   /**
-   * @generated from field: hdlctrl.v1.PageResponse page = 2;
+   * @generated from field: headless.v1.BanUserRequest parameters = 2;
    */
-  page?: PageResponse;
+  parameters?: BanUserRequest$1;
 };
 
 /**
- * Describes the message hdlctrl.v1.ListHeadlessHostResponse.
- * Use `create(ListHeadlessHostResponseSchema)` to create a new message.
+ * Describes the message hdlctrl.v1.BanUserRequest.
+ * Use `create(BanUserRequestSchema)` to create a new message.
  */
-export const ListHeadlessHostResponseSchema: GenMessage<ListHeadlessHostResponse> = /*@__PURE__*/
-  messageDesc(file_hdlctrl_v1_controller, 53);
+export const BanUserRequestSchema: GenMessage<BanUserRequest> = /*@__PURE__*/
+  messageDesc(file_hdlctrl_v1_controller, 51);
 
 /**
- * @generated from message hdlctrl.v1.GetHeadlessHostRequest
+ * @generated from message hdlctrl.v1.BanUserResponse
  */
-export type GetHeadlessHostRequest = Message<"hdlctrl.v1.GetHeadlessHostRequest"> & {
-  /**
-   * @generated from field: string host_id = 1;
-   */
-  hostId: string;
+export type BanUserResponse = Message<"hdlctrl.v1.BanUserResponse"> & {
 };
 
 /**
- * Describes the message hdlctrl.v1.GetHeadlessHostRequest.
- * Use `create(GetHeadlessHostRequestSchema)` to create a new message.
+ * Describes the message hdlctrl.v1.BanUserResponse.
+ * Use `create(BanUserResponseSchema)` to create a new message.
  */
-export const GetHeadlessHostRequestSchema: GenMessage<GetHeadlessHostRequest> = /*@__PURE__*/
-  messageDesc(file_hdlctrl_v1_controller, 54);
+export const BanUserResponseSchema: GenMessage<BanUserResponse> = /*@__PURE__*/
+  messageDesc(file_hdlctrl_v1_controller, 52);
 
 /**
- * @generated from message hdlctrl.v1.GetHeadlessHostResponse
+ * ResoniteLink (WebSocket) 接続用の短期トークン付きパスを発行する。
+ * 返される ws_path は path + query のみ。クライアント (フロントエンド) は
+ * 現在の origin を補完して完全な ws[s]://host/path?token=... を組み立てる。
+ *
+ * @generated from message hdlctrl.v1.IssueResoniteLinkConnectionRequest
  */
-export type GetHeadlessHostResponse = Message<"hdlctrl.v1.GetHeadlessHostResponse"> & {
+export type IssueResoniteLinkConnectionRequest = Message<"hdlctrl.v1.IssueResoniteLinkConnectionRequest"> & {
   /**
-   * @generated from field: hdlctrl.v1.HeadlessHost host = 1;
+   * @generated from field: string session_id = 1;
    */
-  host?: HeadlessHost;
+  sessionId: string;
 };
 
 /**
- * Describes the message hdlctrl.v1.GetHeadlessHostResponse.
- * Use `create(GetHeadlessHostResponseSchema)` to create a new message.
+ * Describes the message hdlctrl.v1.IssueResoniteLinkConnectionRequest.
+ * Use `create(IssueResoniteLinkConnectionRequestSchema)` to create a new message.
  */
-export const GetHeadlessHostResponseSchema: GenMessage<GetHeadlessHostResponse> = /*@__PURE__*/
-  messageDesc(file_hdlctrl_v1_controller, 55);
+export const IssueResoniteLinkConnectionRequestSchema: GenMessage<IssueResoniteLinkConnectionRequest> = /*@__PURE__*/
+  messageDesc(file_hdlctrl_v1_controller, 53);
 
 /**
- * @generated from message hdlctrl.v1.AddHeadlessHostRequest
+ * @generated from message hdlctrl.v1.IssueResoniteLinkConnectionResponse
  */
-export type AddHeadlessHostRequest = Message<"hdlctrl.v1.AddHeadlessHostRequest"> & {
+export type IssueResoniteLinkConnectionResponse = Message<"hdlctrl.v1.IssueResoniteLinkConnectionResponse"> & {
   /**
-   * @generated from field: string name = 1;
+   * @generated from field: string ws_path = 1;
    */
-  name: string;
+  wsPath: string;
 
   /**
-   * @generated from field: string address = 2;
+   * @generated from field: google.protobuf.Timestamp expires_at = 2;
    */
-  address: string;
+  expiresAt?: Timestamp;
 };
 
 /**
- * Describes the message hdlctrl.v1.AddHeadlessHostRequest.
- * Use `create(AddHeadlessHostRequestSchema)` to create a new message.
+ * Describes the message hdlctrl.v1.IssueResoniteLinkConnectionResponse.
+ * Use `create(IssueResoniteLinkConnectionResponseSchema)` to create a new message.
  */
-export const AddHeadlessHostRequestSchema: GenMessage<AddHeadlessHostRequest> = /*@__PURE__*/
-  messageDesc(file_hdlctrl_v1_controller, 56);
+export const IssueResoniteLinkConnectionResponseSchema: GenMessage<IssueResoniteLinkConnectionResponse> = /*@__PURE__*/
+  messageDesc(file_hdlctrl_v1_controller, 54);
 
 /**
- * @generated from message hdlctrl.v1.AddHeadlessHostResponse
+ * @generated from message hdlctrl.v1.SessionUserEvent
  */
-export type AddHeadlessHostResponse = Message<"hdlctrl.v1.AddHeadlessHostResponse"> & {
+export type SessionUserEvent = Message<"hdlctrl.v1.SessionUserEvent"> & {
   /**
-   * @generated from field: hdlctrl.v1.HeadlessHost host = 1;
+   * @generated from field: int64 id = 1;
    */
-  host?: HeadlessHost;
-};
+  id: bigint;
 
-/**
- * Describes the message hdlctrl.v1.AddHeadlessHostResponse.
- * Use `create(AddHeadlessHostResponseSchema)` to create a new message.
- */
-export const AddHeadlessHostResponseSchema: GenMessage<AddHeadlessHostResponse> = /*@__PURE__*/
-  messageDesc(file_hdlctrl_v1_controller, 57);
+  /**
+   * @generated from field: hdlctrl.v1.SessionUserEventKind kind = 2;
+   */
+  kind: SessionUserEventKind;
 
-/**
- * @generated from message hdlctrl.v1.SearchSessionsRequest
- */
-export type SearchSessionsRequest = Message<"hdlctrl.v1.SearchSessionsRequest"> & {
   /**
-   * @generated from field: hdlctrl.v1.SearchSessionsRequest.SearchParameters parameters = 1;
+   * @generated from field: string user_id = 3;
    */
-  parameters?: SearchSessionsRequest_SearchParameters;
+  userId: string;
 
   /**
-   * @generated from field: hdlctrl.v1.PageRequest page = 2;
+   * @generated from field: string user_name = 4;
    */
-  page?: PageRequest;
+  userName: string;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp occurred_at = 5;
+   */
+  occurredAt?: Timestamp;
 };
 
 /**
- * Describes the message hdlctrl.v1.SearchSessionsRequest.
- * Use `create(SearchSessionsRequestSchema)` to create a new message.
+ * Describes the message hdlctrl.v1.SessionUserEvent.
+ * Use `create(SessionUserEventSchema)` to create a new message.
  */
-export const SearchSessionsRequestSchema: GenMessage<SearchSessionsRequest> = /*@__PURE__*/
-  messageDesc(file_hdlctrl_v1_controller, 58);
+export const SessionUserEventSchema: GenMessage<SessionUserEvent> = /*@__PURE__*/
+  messageDesc(file_hdlctrl_v1_controller, 55);
 
 /**
- * @generated from message hdlctrl.v1.SearchSessionsRequest.SearchParameters
+ * @generated from message hdlctrl.v1.ListSessionUserEventsRequest
  */
-export type SearchSessionsRequest_SearchParameters = Message<"hdlctrl.v1.SearchSessionsRequest.SearchParameters"> & {
+export type ListSessionUserEventsRequest = Message<"hdlctrl.v1.ListSessionUserEventsRequest"> & {
   /**
-   * @generated from field: optional string host_id = 1;
+   * @generated from field: string session_id = 1;
    */
-  hostId?: string;
+  sessionId: string;
 
   /**
-   * @generated from field: optional hdlctrl.v1.SessionStatus status = 2;
+   * この ID より後の出入りを返す (ページング用). 0 なら最初から
+   *
+   * @generated from field: int64 after_id = 2;
    */
-  status?: SessionStatus;
+  afterId: bigint;
 
   /**
-   * 指定したグループに所属するセッションのみを返す.
-   * 未指定の場合は呼び出しユーザーが session:read を持つグループ群に絞り込む.
-   * system:group.list 保持者は未指定時に全件返却.
+   * 0 ならデフォルト (100). 最大 500
    *
-   * @generated from field: optional string group_id = 3;
+   * @generated from field: int32 limit = 3;
    */
-  groupId?: string;
+  limit: number;
 };
 
 /**
- * Describes the message hdlctrl.v1.SearchSessionsRequest.SearchParameters.
- * Use `create(SearchSessionsRequest_SearchParametersSchema)` to create a new message.
+ * Describes the message hdlctrl.v1.ListSessionUserEventsRequest.
+ * Use `create(ListSessionUserEventsRequestSchema)` to create a new message.
  */
-export const SearchSessionsRequest_SearchParametersSchema: GenMessage<SearchSessionsRequest_SearchParameters> = /*@__PURE__*/
-  messageDesc(file_hdlctrl_v1_controller, 58, 0);
+export const ListSessionUserEventsRequestSchema: GenMessage<ListSessionUserEventsRequest> = /*@__PURE__*/
+  messageDesc(file_hdlctrl_v1_controller, 56);
 
 /**
- * @generated from message hdlctrl.v1.SearchSessionsResponse
+ * @generated from message hdlctrl.v1.ListSessionUserEventsResponse
  */
-export type SearchSessionsResponse = Message<"hdlctrl.v1.SearchSessionsResponse"> & {
+export type ListSessionUserEventsResponse = Message<"hdlctrl.v1.ListSessionUserEventsResponse"> & {
   /**
-   * @generated from field: repeated hdlctrl.v1.Session sessions = 1;
+   * 古い順. ホスト自身のアカウントの出入りは含まない
+   *
+   * @generated from field: repeated hdlctrl.v1.SessionUserEvent events = 1;
    */
-  sessions: Session[];
+  events: SessionUserEvent[];
 
   /**
-   * @generated from field: hdlctrl.v1.PageResponse page = 2;
+   * 続きがあるときに after_id に指定する値. 続きがなければ 0
+   *
+   * @generated from field: int64 next_after_id = 2;
    */
-  page?: PageResponse;
+  nextAfterId: bigint;
 };
 
 /**
- * Describes the message hdlctrl.v1.SearchSessionsResponse.
- * Use `create(SearchSessionsResponseSchema)` to create a new message.
+ * Describes the message hdlctrl.v1.ListSessionUserEventsResponse.
+ * Use `create(ListSessionUserEventsResponseSchema)` to create a new message.
  */
-export const SearchSessionsResponseSchema: GenMessage<SearchSessionsResponse> = /*@__PURE__*/
+export const ListSessionUserEventsResponseSchema: GenMessage<ListSessionUserEventsResponse> = /*@__PURE__*/
+  messageDesc(file_hdlctrl_v1_controller, 57);
+
+/**
+ * @generated from message hdlctrl.v1.SessionAttendee
+ */
+export type SessionAttendee = Message<"hdlctrl.v1.SessionAttendee"> & {
+  /**
+   * @generated from field: string user_id = 1;
+   */
+  userId: string;
+
+  /**
+   * @generated from field: string user_name = 2;
+   */
+  userName: string;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp first_joined_at = 3;
+   */
+  firstJoinedAt?: Timestamp;
+
+  /**
+   * まだセッションに居るときは未設定
+   *
+   * @generated from field: optional google.protobuf.Timestamp last_left_at = 4;
+   */
+  lastLeftAt?: Timestamp;
+
+  /**
+   * 滞在時間の合計
+   *
+   * @generated from field: int64 duration_seconds = 5;
+   */
+  durationSeconds: bigint;
+
+  /**
+   * @generated from field: int32 join_count = 6;
+   */
+  joinCount: number;
+
+  /**
+   * @generated from field: bool present = 7;
+   */
+  present: boolean;
+};
+
+/**
+ * Describes the message hdlctrl.v1.SessionAttendee.
+ * Use `create(SessionAttendeeSchema)` to create a new message.
+ */
+export const SessionAttendeeSchema: GenMessage<SessionAttendee> = /*@__PURE__*/
+  messageDesc(file_hdlctrl_v1_controller, 58);
+
+/**
+ * @generated from message hdlctrl.v1.GetSessionAttendanceRequest
+ */
+export type GetSessionAttendanceRequest = Message<"hdlctrl.v1.GetSessionAttendanceRequest"> & {
+  /**
+   * @generated from field: string session_id = 1;
+   */
+  sessionId: string;
+};
+
+/**
+ * Describes the message hdlctrl.v1.GetSessionAttendanceRequest.
+ * Use `create(GetSessionAttendanceRequestSchema)` to create a new message.
+ */
+export const GetSessionAttendanceRequestSchema: GenMessage<GetSessionAttendanceRequest> = /*@__PURE__*/
   messageDesc(file_hdlctrl_v1_controller, 59);
 
 /**
- * @generated from message hdlctrl.v1.GetSessionDetailsRequest
+ * @generated from message hdlctrl.v1.GetSessionAttendanceResponse
  */
-export type GetSessionDetailsRequest = Message<"hdlctrl.v1.GetSessionDetailsRequest"> & {
+export type GetSessionAttendanceResponse = Message<"hdlctrl.v1.GetSessionAttendanceResponse"> & {
   /**
-   * 指定不要
+   * 滞在時間の長い順
    *
-   * @generated from field: string host_id = 1 [deprecated = true];
-   * @deprecated
+   * @generated from field: repeated hdlctrl.v1.SessionAttendee attendees = 1;
+   */
+  attendees: SessionAttendee[];
+
+  /**
+   * @generated from field: int32 unique_users = 2;
+   */
+  uniqueUsers: number;
+
+  /**
+   * @generated from field: int32 peak_users = 3;
+   */
+  peakUsers: number;
+
+  /**
+   * 最初に peak_users 人になった時刻. 誰も来ていなければ未設定
+   *
+   * @generated from field: optional google.protobuf.Timestamp peak_at = 4;
+   */
+  peakAt?: Timestamp;
+
+  /**
+   * @generated from field: int64 total_duration_seconds = 5;
+   */
+  totalDurationSeconds: bigint;
+};
+
+/**
+ * Describes the message hdlctrl.v1.GetSessionAttendanceResponse.
+ * Use `create(GetSessionAttendanceResponseSchema)` to create a new message.
+ */
+export const GetSessionAttendanceResponseSchema: GenMessage<GetSessionAttendanceResponse> = /*@__PURE__*/
+  messageDesc(file_hdlctrl_v1_controller, 60);
+
+/**
+ * @generated from message hdlctrl.v1.GetSessionUsageStatsRequest
+ */
+export type GetSessionUsageStatsRequest = Message<"hdlctrl.v1.GetSessionUsageStatsRequest"> & {
+  /**
+   * 未指定なら session:read を持つすべてのグループ
+   *
+   * @generated from field: optional string group_id = 1;
+   */
+  groupId?: string;
+
+  /**
+   * @generated from field: optional string host_id = 2;
+   */
+  hostId?: string;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp since = 3;
+   */
+  since?: Timestamp;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp until = 4;
+   */
+  until?: Timestamp;
+
+  /**
+   * @generated from field: hdlctrl.v1.SessionUsageGroupBy group_by = 5;
+   */
+  groupBy: SessionUsageGroupBy;
+
+  /**
+   * @generated from field: hdlctrl.v1.SessionUsageInterval interval = 6;
+   */
+  interval: SessionUsageInterval;
+
+  /**
+   * バケットの区切りに使う IANA タイムゾーン (例: Asia/Tokyo). 空なら UTC
+   *
+   * @generated from field: string time_zone = 7;
+   */
+  timeZone: string;
+};
+
+/**
+ * Describes the message hdlctrl.v1.GetSessionUsageStatsRequest.
+ * Use `create(GetSessionUsageStatsRequestSchema)` to create a new message.
+ */
+export const GetSessionUsageStatsRequestSchema: GenMessage<GetSessionUsageStatsRequest> = /*@__PURE__*/
+  messageDesc(file_hdlctrl_v1_controller, 61);
+
+/**
+ * @generated from message hdlctrl.v1.SessionUsageBucket
+ */
+export type SessionUsageBucket = Message<"hdlctrl.v1.SessionUsageBucket"> & {
+  /**
+   * @generated from field: google.protobuf.Timestamp start = 1;
+   */
+  start?: Timestamp;
+
+  /**
+   * バケット中に開いていたセッションの数
+   *
+   * @generated from field: int32 sessions = 2;
+   */
+  sessions: number;
+
+  /**
+   * @generated from field: int32 unique_users = 3;
+   */
+  uniqueUsers: number;
+
+  /**
+   * @generated from field: int32 peak_users = 4;
+   */
+  peakUsers: number;
+
+  /**
+   * @generated from field: int64 user_duration_seconds = 5;
+   */
+  userDurationSeconds: bigint;
+};
+
+/**
+ * Describes the message hdlctrl.v1.SessionUsageBucket.
+ * Use `create(SessionUsageBucketSchema)` to create a new message.
+ */
+export const SessionUsageBucketSchema: GenMessage<SessionUsageBucket> = /*@__PURE__*/
+  messageDesc(file_hdlctrl_v1_controller, 62);
+
+/**
+ * @generated from message hdlctrl.v1.SessionUsageSeries
+ */
+export type SessionUsageSeries = Message<"hdlctrl.v1.SessionUsageSeries"> & {
+  /**
+   * ワールドなら URL (プリセットなら "preset:" + 名前)、ホストならホスト ID
+   *
+   * @generated from field: string key = 1;
+   */
+  key: string;
+
+  /**
+   * @generated from field: string label = 2;
+   */
+  label: string;
+
+  /**
+   * @generated from field: repeated hdlctrl.v1.SessionUsageBucket buckets = 3;
+   */
+  buckets: SessionUsageBucket[];
+
+  /**
+   * @generated from field: int32 unique_users = 4;
+   */
+  uniqueUsers: number;
+
+  /**
+   * @generated from field: int64 user_duration_seconds = 5;
+   */
+  userDurationSeconds: bigint;
+};
+
+/**
+ * Describes the message hdlctrl.v1.SessionUsageSeries.
+ * Use `create(SessionUsageSeriesSchema)` to create a new message.
+ */
+export const SessionUsageSeriesSchema: GenMessage<SessionUsageSeries> = /*@__PURE__*/
+  messageDesc(file_hdlctrl_v1_controller, 63);
+
+/**
+ * @generated from message hdlctrl.v1.GetSessionUsageStatsResponse
+ */
+export type GetSessionUsageStatsResponse = Message<"hdlctrl.v1.GetSessionUsageStatsResponse"> & {
+  /**
+   * 滞在時間の合計が長い順
+   *
+   * @generated from field: repeated hdlctrl.v1.SessionUsageSeries series = 1;
+   */
+  series: SessionUsageSeries[];
+};
+
+/**
+ * Describes the message hdlctrl.v1.GetSessionUsageStatsResponse.
+ * Use `create(GetSessionUsageStatsResponseSchema)` to create a new message.
+ */
+export const GetSessionUsageStatsResponseSchema: GenMessage<GetSessionUsageStatsResponse> = /*@__PURE__*/
+  messageDesc(file_hdlctrl_v1_controller, 64);
+
+/**
+ * @generated from message hdlctrl.v1.GetMetricsSeriesRequest
+ */
+export type GetMetricsSeriesRequest = Message<"hdlctrl.v1.GetMetricsSeriesRequest"> & {
+  /**
+   * @generated from field: hdlctrl.v1.MetricKind kind = 1;
+   */
+  kind: MetricKind;
+
+  /**
+   * @generated from field: string target_id = 2;
+   */
+  targetId: string;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp since = 3;
+   */
+  since?: Timestamp;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp until = 4;
+   */
+  until?: Timestamp;
+
+  /**
+   * @generated from field: hdlctrl.v1.MetricResolution resolution = 5;
+   */
+  resolution: MetricResolution;
+};
+
+/**
+ * Describes the message hdlctrl.v1.GetMetricsSeriesRequest.
+ * Use `create(GetMetricsSeriesRequestSchema)` to create a new message.
+ */
+export const GetMetricsSeriesRequestSchema: GenMessage<GetMetricsSeriesRequest> = /*@__PURE__*/
+  messageDesc(file_hdlctrl_v1_controller, 65);
+
+/**
+ * @generated from message hdlctrl.v1.MetricPoint
+ */
+export type MetricPoint = Message<"hdlctrl.v1.MetricPoint"> & {
+  /**
+   * バケットの開始時刻
+   *
+   * @generated from field: google.protobuf.Timestamp at = 1;
+   */
+  at?: Timestamp;
+
+  /**
+   * @generated from field: double avg = 2;
+   */
+  avg: number;
+
+  /**
+   * @generated from field: double min = 3;
+   */
+  min: number;
+
+  /**
+   * @generated from field: double max = 4;
+   */
+  max: number;
+
+  /**
+   * バケットに含まれるサンプル数
+   *
+   * @generated from field: int32 sample_count = 5;
+   */
+  sampleCount: number;
+};
+
+/**
+ * Describes the message hdlctrl.v1.MetricPoint.
+ * Use `create(MetricPointSchema)` to create a new message.
+ */
+export const MetricPointSchema: GenMessage<MetricPoint> = /*@__PURE__*/
+  messageDesc(file_hdlctrl_v1_controller, 66);
+
+/**
+ * @generated from message hdlctrl.v1.GetMetricsSeriesResponse
+ */
+export type GetMetricsSeriesResponse = Message<"hdlctrl.v1.GetMetricsSeriesResponse"> & {
+  /**
+   * 実際に使った解像度
+   *
+   * @generated from field: hdlctrl.v1.MetricResolution resolution = 1;
+   */
+  resolution: MetricResolution;
+
+  /**
+   * 時刻順. 記録がない時間帯の点は含まない
+   *
+   * @generated from field: repeated hdlctrl.v1.MetricPoint points = 2;
+   */
+  points: MetricPoint[];
+};
+
+/**
+ * Describes the message hdlctrl.v1.GetMetricsSeriesResponse.
+ * Use `create(GetMetricsSeriesResponseSchema)` to create a new message.
+ */
+export const GetMetricsSeriesResponseSchema: GenMessage<GetMetricsSeriesResponse> = /*@__PURE__*/
+  messageDesc(file_hdlctrl_v1_controller, 67);
+
+/**
+ * @generated from message hdlctrl.v1.FetchWorldInfoRequest
+ */
+export type FetchWorldInfoRequest = Message<"hdlctrl.v1.FetchWorldInfoRequest"> & {
+  /**
+   * @generated from field: string host_id = 1;
    */
   hostId: string;
 
   /**
-   * @generated from field: string session_id = 2;
+   * @generated from field: string url = 2;
+   */
+  url: string;
+};
+
+/**
+ * Describes the message hdlctrl.v1.FetchWorldInfoRequest.
+ * Use `create(FetchWorldInfoRequestSchema)` to create a new message.
+ */
+export const FetchWorldInfoRequestSchema: GenMessage<FetchWorldInfoRequest> = /*@__PURE__*/
+  messageDesc(file_hdlctrl_v1_controller, 68);
+
+/**
+ * @generated from message hdlctrl.v1.SearchWorldsRequest
+ */
+export type SearchWorldsRequest = Message<"hdlctrl.v1.SearchWorldsRequest"> & {
+  /**
+   * @generated from field: string query = 1;
+   */
+  query: string;
+
+  /**
+   * @generated from field: bool featured_only = 2;
+   */
+  featuredOnly: boolean;
+
+  /**
+   * @generated from field: int32 page_index = 3;
+   */
+  pageIndex: number;
+};
+
+/**
+ * Describes the message hdlctrl.v1.SearchWorldsRequest.
+ * Use `create(SearchWorldsRequestSchema)` to create a new message.
+ */
+export const SearchWorldsRequestSchema: GenMessage<SearchWorldsRequest> = /*@__PURE__*/
+  messageDesc(file_hdlctrl_v1_controller, 69);
+
+/**
+ * @generated from message hdlctrl.v1.SearchWorldsResponse
+ */
+export type SearchWorldsResponse = Message<"hdlctrl.v1.SearchWorldsResponse"> & {
+  /**
+   * @generated from field: repeated hdlctrl.v1.SearchWorldsResponse.WorldRecord records = 1;
+   */
+  records: SearchWorldsResponse_WorldRecord[];
+
+  /**
+   * @generated from field: bool has_more = 2;
+   */
+  hasMore: boolean;
+};
+
+/**
+ * Describes the message hdlctrl.v1.SearchWorldsResponse.
+ * Use `create(SearchWorldsResponseSchema)` to create a new message.
+ */
+export const SearchWorldsResponseSchema: GenMessage<SearchWorldsResponse> = /*@__PURE__*/
+  messageDesc(file_hdlctrl_v1_controller, 70);
+
+/**
+ * @generated from message hdlctrl.v1.SearchWorldsResponse.WorldRecord
+ */
+export type SearchWorldsResponse_WorldRecord = Message<"hdlctrl.v1.SearchWorldsResponse.WorldRecord"> & {
+  /**
+   * @generated from field: string id = 1;
+   */
+  id: string;
+
+  /**
+   * @generated from field: string owner_id = 2;
+   */
+  ownerId: string;
+
+  /**
+   * @generated from field: string owner_name = 3;
+   */
+  ownerName: string;
+
+  /**
+   * @generated from field: string name = 4;
+   */
+  name: string;
+
+  /**
+   * @generated from field: string description = 5;
+   */
+  description: string;
+
+  /**
+   * @generated from field: string thumbnail_url = 6;
+   */
+  thumbnailUrl: string;
+
+  /**
+   * @generated from field: bool is_featured = 7;
+   */
+  isFeatured: boolean;
+};
+
+/**
+ * Describes the message hdlctrl.v1.SearchWorldsResponse.WorldRecord.
+ * Use `create(SearchWorldsResponse_WorldRecordSchema)` to create a new message.
+ */
+export const SearchWorldsResponse_WorldRecordSchema: GenMessage<SearchWorldsResponse_WorldRecord> = /*@__PURE__*/
+  messageDesc(file_hdlctrl_v1_controller, 70, 0);
+
+/**
+ * @generated from message hdlctrl.v1.GetOwnWorldsRequest
+ */
+export type GetOwnWorldsRequest = Message<"hdlctrl.v1.GetOwnWorldsRequest"> & {
+  /**
+   * @generated from field: string host_id = 1;
+   */
+  hostId: string;
+
+  /**
+   * @generated from field: int32 page_index = 2;
+   */
+  pageIndex: number;
+};
+
+/**
+ * Describes the message hdlctrl.v1.GetOwnWorldsRequest.
+ * Use `create(GetOwnWorldsRequestSchema)` to create a new message.
+ */
+export const GetOwnWorldsRequestSchema: GenMessage<GetOwnWorldsRequest> = /*@__PURE__*/
+  messageDesc(file_hdlctrl_v1_controller, 71);
+
+/**
+ * @generated from message hdlctrl.v1.GetOwnWorldsResponse
+ */
+export type GetOwnWorldsResponse = Message<"hdlctrl.v1.GetOwnWorldsResponse"> & {
+  /**
+   * @generated from field: repeated hdlctrl.v1.SearchWorldsResponse.WorldRecord records = 1;
+   */
+  records: SearchWorldsResponse_WorldRecord[];
+
+  /**
+   * @generated from field: bool has_more = 2;
+   */
+  hasMore: boolean;
+};
+
+/**
+ * Describes the message hdlctrl.v1.GetOwnWorldsResponse.
+ * Use `create(GetOwnWorldsResponseSchema)` to create a new message.
+ */
+export const GetOwnWorldsResponseSchema: GenMessage<GetOwnWorldsResponse> = /*@__PURE__*/
+  messageDesc(file_hdlctrl_v1_controller, 72);
+
+/**
+ * @generated from message hdlctrl.v1.ListHeadlessHostRequest
+ */
+export type ListHeadlessHostRequest = Message<"hdlctrl.v1.ListHeadlessHostRequest"> & {
+  /**
+   * @generated from field: hdlctrl.v1.PageRequest page = 1;
+   */
+  page?: PageRequest;
+
+  /**
+   * 指定したグループに所属するホストのみを返す.
+   * 未指定の場合は呼び出しユーザーが host:read を持つグループ群に絞り込む.
+   * system:group.list 保持者は未指定時に全件返却.
+   *
+   * @generated from field: optional string group_id = 2;
+   */
+  groupId?: string;
+};
+
+/**
+ * Describes the message hdlctrl.v1.ListHeadlessHostRequest.
+ * Use `create(ListHeadlessHostRequestSchema)` to create a new message.
+ */
+export const ListHeadlessHostRequestSchema: GenMessage<ListHeadlessHostRequest> = /*@__PURE__*/
+  messageDesc(file_hdlctrl_v1_controller, 73);
+
+/**
+ * @generated from message hdlctrl.v1.ListHeadlessHostResponse
+ */
+export type ListHeadlessHostResponse = Message<"hdlctrl.v1.ListHeadlessHostResponse"> & {
+  /**
+   * @generated from field: repeated hdlctrl.v1.HeadlessHost hosts = 1;
+   */
+  hosts: HeadlessHost[];
+
+  /**
+   * @generated from field: hdlctrl.v1.PageResponse page = 2;
+   */
+  page?: PageResponse;
+};
+
+/**
+ * Describes the message hdlctrl.v1.ListHeadlessHostResponse.
+ * Use `create(ListHeadlessHostResponseSchema)` to create a new message.
+ */
+export const ListHeadlessHostResponseSchema: GenMessage<ListHeadlessHostResponse> = /*@__PURE__*/
+  messageDesc(file_hdlctrl_v1_controller, 74);
+
+/**
+ * @generated from message hdlctrl.v1.GetHeadlessHostRequest
+ */
+export type GetHeadlessHostRequest = Message<"hdlctrl.v1.GetHeadlessHostRequest"> & {
+  /**
+   * @generated from field: string host_id = 1;
+   */
+  hostId: string;
+};
+
+/**
+ * Describes the message hdlctrl.v1.GetHeadlessHostRequest.
+ * Use `create(GetHeadlessHostRequestSchema)` to create a new message.
+ */
+export const GetHeadlessHostRequestSchema: GenMessage<GetHeadlessHostRequest> = /*@__PURE__*/
+  messageDesc(file_hdlctrl_v1_controller, 75);
+
+/**
+ * @generated from message hdlctrl.v1.GetHeadlessHostResponse
+ */
+export type GetHeadlessHostResponse = Message<"hdlctrl.v1.GetHeadlessHostResponse"> & {
+  /**
+   * @generated from field: hdlctrl.v1.HeadlessHost host = 1;
+   */
+  host?: HeadlessHost;
+};
+
+/**
+ * Describes the message hdlctrl.v1.GetHeadlessHostResponse.
+ * Use `create(GetHeadlessHostResponseSchema)` to create a new message.
+ */
+export const GetHeadlessHostResponseSchema: GenMessage<GetHeadlessHostResponse> = /*@__PURE__*/
+  messageDesc(file_hdlctrl_v1_controller, 76);
+
+/**
+ * @generated from message hdlctrl.v1.AddHeadlessHostRequest
+ */
+export type AddHeadlessHostRequest = Message<"hdlctrl.v1.AddHeadlessHostRequest"> & {
+  /**
+   * @generated from field: string name = 1;
+   */
+  name: string;
+
+  /**
+   * @generated from field: string address = 2;
+   */
+  address: string;
+};
+
+/**
+ * Describes the message hdlctrl.v1.AddHeadlessHostRequest.
+ * Use `create(AddHeadlessHostRequestSchema)` to create a new message.
+ */
+export const AddHeadlessHostRequestSchema: GenMessage<AddHeadlessHostRequest> = /*@__PURE__*/
+  messageDesc(file_hdlctrl_v1_controller, 77);
+
+/**
+ * @generated from message hdlctrl.v1.AddHeadlessHostResponse
+ */
+export type AddHeadlessHostResponse = Message<"hdlctrl.v1.AddHeadlessHostResponse"> & {
+  /**
+   * @generated from field: hdlctrl.v1.HeadlessHost host = 1;
+   */
+  host?: HeadlessHost;
+};
+
+/**
+ * Describes the message hdlctrl.v1.AddHeadlessHostResponse.
+ * Use `create(AddHeadlessHostResponseSchema)` to create a new message.
+ */
+export const AddHeadlessHostResponseSchema: GenMessage<AddHeadlessHostResponse> = /*@__PURE__*/
+  messageDesc(file_hdlctrl_v1_controller, 78);
+
+/**
+ * @generated from message hdlctrl.v1.SearchSessionsRequest
+ */
+export type SearchSessionsRequest = Message<"hdlctrl.v1.SearchSessionsRequest"> & {
+  /**
+   * @generated from field: hdlctrl.v1.SearchSessionsRequest.SearchParameters parameters = 1;
+   */
+  parameters?: SearchSessionsRequest_SearchParameters;
+
+  /**
+   * @generated from field: hdlctrl.v1.PageRequest page = 2;
+   */
+  page?: PageRequest;
+};
+
+/**
+ * Describes the message hdlctrl.v1.SearchSessionsRequest.
+ * Use `create(SearchSessionsRequestSchema)` to create a new message.
+ */
+export const SearchSessionsRequestSchema: GenMessage<SearchSessionsRequest> = /*@__PURE__*/
+  messageDesc(file_hdlctrl_v1_controller, 79);
+
+/**
+ * @generated from message hdlctrl.v1.SearchSessionsRequest.SearchParameters
+ */
+export type SearchSessionsRequest_SearchParameters = Message<"hdlctrl.v1.SearchSessionsRequest.SearchParameters"> & {
+  /**
+   * @generated from field: optional string host_id = 1;
+   */
+  hostId?: string;
+
+  /**
+   * @generated from field: optional hdlctrl.v1.SessionStatus status = 2;
+   */
+  status?: SessionStatus;
+
+  /**
+   * 指定したグループに所属するセッションのみを返す.
+   * 未指定の場合は呼び出しユーザーが session:read を持つグループ群に絞り込む.
+   * system:group.list 保持者は未指定時に全件返却.
+   *
+   * @generated from field: optional string group_id = 3;
+   */
+  groupId?: string;
+};
+
+/**
+ * Describes the message hdlctrl.v1.SearchSessionsRequest.SearchParameters.
+ * Use `create(SearchSessionsRequest_SearchParametersSchema)` to create a new message.
+ */
+export const SearchSessionsRequest_SearchParametersSchema: GenMessage<SearchSessionsRequest_SearchParameters> = /*@__PURE__*/
+  messageDesc(file_hdlctrl_v1_controller, 79, 0);
+
+/**
+ * @generated from message hdlctrl.v1.SearchSessionsResponse
+ */
+export type SearchSessionsResponse = Message<"hdlctrl.v1.SearchSessionsResponse"> & {
+  /**
+   * @generated from field: repeated hdlctrl.v1.Session sessions = 1;
+   */
+  sessions: Session[];
+
+  /**
+   * @generated from field: hdlctrl.v1.PageResponse page = 2;
+   */
+  page?: PageResponse;
+};
+
+/**
+ * Describes the message hdlctrl.v1.SearchSessionsResponse.
+ * Use `create(SearchSessionsResponseSchema)` to create a new message.
+ */
+export const SearchSessionsResponseSchema: GenMessage<SearchSessionsResponse> = /*@__PURE__*/
+  messageDesc(file_hdlctrl_v1_controller, 80);
+
+/**
+ * @generated from message hdlctrl.v1.GetSessionDetailsRequest
+ */
+export type GetSessionDetailsRequest = Message<"hdlctrl.v1.GetSessionDetailsRequest"> & {
+  /**
+   * 指定不要
+   *
+   * @generated from field: string host_id = 1 [deprecated = true];
+   * @deprecated
+   */
+  hostId: string;
+
+  /**
+   * @generated from field: string session_id = 2;
+   */
+  sessionId: string;
+};
+
+/**
+ * Describes the message hdlctrl.v1.GetSessionDetailsRequest.
+ * Use `create(GetSessionDetailsRequestSchema)` to create a new message.
+ */
+export const GetSessionDetailsRequestSchema: GenMessage<GetSessionDetailsRequest> = /*@__PURE__*/
+  messageDesc(file_hdlctrl_v1_controller, 81);
+
+/**
+ * @generated from message hdlctrl.v1.GetSessionDetailsResponse
+ */
+export type GetSessionDetailsResponse = Message<"hdlctrl.v1.GetSessionDetailsResponse"> & {
+  /**
+   * @generated from field: hdlctrl.v1.Session session = 1;
+   */
+  session?: Session;
+};
+
+/**
+ * Describes the message hdlctrl.v1.GetSessionDetailsResponse.
+ * Use `create(GetSessionDetailsResponseSchema)` to create a new message.
+ */
+export const GetSessionDetailsResponseSchema: GenMessage<GetSessionDetailsResponse> = /*@__PURE__*/
+  messageDesc(file_hdlctrl_v1_controller, 82);
+
+/**
+ * @generated from message hdlctrl.v1.StartWorldRequest
+ */
+export type StartWorldRequest = Message<"hdlctrl.v1.StartWorldRequest"> & {
+  /**
+   * @generated from field: string host_id = 1;
+   */
+  hostId: string;
+
+  /**
+   * @generated from field: headless.v1.WorldStartupParameters parameters = 2;
+   */
+  parameters?: WorldStartupParameters;
+
+  /**
+   * @generated from field: string memo = 3;
+   */
+  memo: string;
+
+  /**
+   * 起動するセッションの所属グループ. 未指定の場合は呼び出しユーザーの personal グループ.
+   * 指定する場合は host / account の group_id と一致する必要がある (同一グループ制約).
+   *
+   * @generated from field: optional string group_id = 4;
+   */
+  groupId?: string;
+
+  /**
+   * 指定した場合はテンプレートの parameters を元にし、parameters は上書き分として扱う.
+   * テンプレートはセッションと同じグループのものである必要がある.
+   *
+   * @generated from field: optional string template_id = 5;
+   */
+  templateId?: string;
+
+  /**
+   * template_id 指定時に parameters から上書きするフィールド名 (WorldStartupParameters の
+   * トップレベルのフィールド名). 空なら parameters で値が入っているフィールドをすべて上書きする.
+   * false / 0 / 空リストで上書きしたい場合はここに列挙する.
+   *
+   * @generated from field: repeated string override_fields = 6;
+   */
+  overrideFields: string[];
+};
+
+/**
+ * Describes the message hdlctrl.v1.StartWorldRequest.
+ * Use `create(StartWorldRequestSchema)` to create a new message.
+ */
+export const StartWorldRequestSchema: GenMessage<StartWorldRequest> = /*@__PURE__*/
+  messageDesc(file_hdlctrl_v1_controller, 83);
+
+/**
+ * @generated from message hdlctrl.v1.StartWorldResponse
+ */
+export type StartWorldResponse = Message<"hdlctrl.v1.StartWorldResponse"> & {
+  /**
+   * @generated from field: string job_id = 2;
+   */
+  jobId: string;
+};
+
+/**
+ * Describes the message hdlctrl.v1.StartWorldResponse.
+ * Use `create(StartWorldResponseSchema)` to create a new message.
+ */
+export const StartWorldResponseSchema: GenMessage<StartWorldResponse> = /*@__PURE__*/
+  messageDesc(file_hdlctrl_v1_controller, 84);
+
+/**
+ * @generated from message hdlctrl.v1.StopSessionRequest
+ */
+export type StopSessionRequest = Message<"hdlctrl.v1.StopSessionRequest"> & {
+  /**
+   * 指定不要
+   *
+   * @generated from field: string host_id = 1 [deprecated = true];
+   * @deprecated
+   */
+  hostId: string;
+
+  /**
+   * @generated from field: string session_id = 2;
+   */
+  sessionId: string;
+};
+
+/**
+ * Describes the message hdlctrl.v1.StopSessionRequest.
+ * Use `create(StopSessionRequestSchema)` to create a new message.
+ */
+export const StopSessionRequestSchema: GenMessage<StopSessionRequest> = /*@__PURE__*/
+  messageDesc(file_hdlctrl_v1_controller, 85);
+
+/**
+ * @generated from message hdlctrl.v1.StopSessionResponse
+ */
+export type StopSessionResponse = Message<"hdlctrl.v1.StopSessionResponse"> & {
+  /**
+   * @generated from field: string job_id = 1;
+   */
+  jobId: string;
+};
+
+/**
+ * Describes the message hdlctrl.v1.StopSessionResponse.
+ * Use `create(StopSessionResponseSchema)` to create a new message.
+ */
+export const StopSessionResponseSchema: GenMessage<StopSessionResponse> = /*@__PURE__*/
+  messageDesc(file_hdlctrl_v1_controller, 86);
+
+/**
+ * @generated from message hdlctrl.v1.DeleteEndedSessionRequest
+ */
+export type DeleteEndedSessionRequest = Message<"hdlctrl.v1.DeleteEndedSessionRequest"> & {
+  /**
+   * @generated from field: string session_id = 1;
+   */
+  sessionId: string;
+};
+
+/**
+ * Describes the message hdlctrl.v1.DeleteEndedSessionRequest.
+ * Use `create(DeleteEndedSessionRequestSchema)` to create a new message.
+ */
+export const DeleteEndedSessionRequestSchema: GenMessage<DeleteEndedSessionRequest> = /*@__PURE__*/
+  messageDesc(file_hdlctrl_v1_controller, 87);
+
+/**
+ * @generated from message hdlctrl.v1.DeleteEndedSessionResponse
+ */
+export type DeleteEndedSessionResponse = Message<"hdlctrl.v1.DeleteEndedSessionResponse"> & {
+};
+
+/**
+ * Describes the message hdlctrl.v1.DeleteEndedSessionResponse.
+ * Use `create(DeleteEndedSessionResponseSchema)` to create a new message.
+ */
+export const DeleteEndedSessionResponseSchema: GenMessage<DeleteEndedSessionResponse> = /*@__PURE__*/
+  messageDesc(file_hdlctrl_v1_controller, 88);
+
+/**
+ * @generated from message hdlctrl.v1.SaveSessionWorldRequest
+ */
+export type SaveSessionWorldRequest = Message<"hdlctrl.v1.SaveSessionWorldRequest"> & {
+  /**
+   * 指定不要
+   *
+   * @generated from field: string host_id = 1 [deprecated = true];
+   * @deprecated
+   */
+  hostId: string;
+
+  /**
+   * @generated from field: string session_id = 2;
+   */
+  sessionId: string;
+
+  /**
+   * @generated from field: hdlctrl.v1.SaveSessionWorldRequest.SaveMode save_mode = 3;
+   */
+  saveMode: SaveSessionWorldRequest_SaveMode;
+};
+
+/**
+ * Describes the message hdlctrl.v1.SaveSessionWorldRequest.
+ * Use `create(SaveSessionWorldRequestSchema)` to create a new message.
+ */
+export const SaveSessionWorldRequestSchema: GenMessage<SaveSessionWorldRequest> = /*@__PURE__*/
+  messageDesc(file_hdlctrl_v1_controller, 89);
+
+/**
+ * @generated from enum hdlctrl.v1.SaveSessionWorldRequest.SaveMode
+ */
+export enum SaveSessionWorldRequest_SaveMode {
+  /**
+   * @generated from enum value: SAVE_MODE_UNKNOWN = 0;
+   */
+  UNKNOWN = 0,
+
+  /**
+   * @generated from enum value: SAVE_MODE_OVERWRITE = 1;
+   */
+  OVERWRITE = 1,
+
+  /**
+   * @generated from enum value: SAVE_MODE_SAVE_AS = 2;
+   */
+  SAVE_AS = 2,
+
+  /**
+   * @generated from enum value: SAVE_MODE_COPY = 3;
+   */
+  COPY = 3,
+}
+
+/**
+ * Describes the enum hdlctrl.v1.SaveSessionWorldRequest.SaveMode.
+ */
+export const SaveSessionWorldRequest_SaveModeSchema: GenEnum<SaveSessionWorldRequest_SaveMode> = /*@__PURE__*/
+  enumDesc(file_hdlctrl_v1_controller, 89, 0);
+
+/**
+ * @generated from message hdlctrl.v1.SaveSessionWorldResponse
+ */
+export type SaveSessionWorldResponse = Message<"hdlctrl.v1.SaveSessionWorldResponse"> & {
+  /**
+   * @generated from field: optional string saved_record_url = 1;
+   */
+  savedRecordUrl?: string;
+};
+
+/**
+ * Describes the message hdlctrl.v1.SaveSessionWorldResponse.
+ * Use `create(SaveSessionWorldResponseSchema)` to create a new message.
+ */
+export const SaveSessionWorldResponseSchema: GenMessage<SaveSessionWorldResponse> = /*@__PURE__*/
+  messageDesc(file_hdlctrl_v1_controller, 90);
+
+/**
+ * @generated from message hdlctrl.v1.PrepareSessionWorldDownloadRequest
+ */
+export type PrepareSessionWorldDownloadRequest = Message<"hdlctrl.v1.PrepareSessionWorldDownloadRequest"> & {
+  /**
+   * @generated from field: string session_id = 1;
+   */
+  sessionId: string;
+
+  /**
+   * @generated from field: headless.v1.WorldBinaryFormat format = 2;
+   */
+  format: WorldBinaryFormat;
+};
+
+/**
+ * Describes the message hdlctrl.v1.PrepareSessionWorldDownloadRequest.
+ * Use `create(PrepareSessionWorldDownloadRequestSchema)` to create a new message.
+ */
+export const PrepareSessionWorldDownloadRequestSchema: GenMessage<PrepareSessionWorldDownloadRequest> = /*@__PURE__*/
+  messageDesc(file_hdlctrl_v1_controller, 91);
+
+/**
+ * @generated from message hdlctrl.v1.PrepareSessionWorldDownloadResponse
+ */
+export type PrepareSessionWorldDownloadResponse = Message<"hdlctrl.v1.PrepareSessionWorldDownloadResponse"> & {
+  /**
+   * ブラウザがそのままアクセス可能な相対 URL: /blobs/<uuid>
+   *
+   * @generated from field: string download_url = 1;
+   */
+  downloadUrl: string;
+
+  /**
+   * 推奨ファイル名 (ブラウザの Content-Disposition でも提供されるが、UI 表示用にも返す)
+   *
+   * @generated from field: string filename = 2;
+   */
+  filename: string;
+};
+
+/**
+ * Describes the message hdlctrl.v1.PrepareSessionWorldDownloadResponse.
+ * Use `create(PrepareSessionWorldDownloadResponseSchema)` to create a new message.
+ */
+export const PrepareSessionWorldDownloadResponseSchema: GenMessage<PrepareSessionWorldDownloadResponse> = /*@__PURE__*/
+  messageDesc(file_hdlctrl_v1_controller, 92);
+
+/**
+ * @generated from message hdlctrl.v1.InviteUserRequest
+ */
+export type InviteUserRequest = Message<"hdlctrl.v1.InviteUserRequest"> & {
+  /**
+   * @generated from field: string host_id = 1;
+   */
+  hostId: string;
+
+  /**
+   * @generated from field: string session_id = 2;
+   */
+  sessionId: string;
+
+  /**
+   * @generated from oneof hdlctrl.v1.InviteUserRequest.user
+   */
+  user: {
+    /**
+     * @generated from field: string user_id = 3;
+     */
+    value: string;
+    case: "userId";
+  } | {
+    /**
+     * @generated from field: string user_name = 4;
+     */
+    value: string;
+    case: "userName";
+  } | { case: undefined; value?: undefined };
+};
+
+/**
+ * Describes the message hdlctrl.v1.InviteUserRequest.
+ * Use `create(InviteUserRequestSchema)` to create a new message.
+ */
+export const InviteUserRequestSchema: GenMessage<InviteUserRequest> = /*@__PURE__*/
+  messageDesc(file_hdlctrl_v1_controller, 93);
+
+/**
+ * @generated from message hdlctrl.v1.InviteUserResponse
+ */
+export type InviteUserResponse = Message<"hdlctrl.v1.InviteUserResponse"> & {
+};
+
+/**
+ * Describes the message hdlctrl.v1.InviteUserResponse.
+ * Use `create(InviteUserResponseSchema)` to create a new message.
+ */
+export const InviteUserResponseSchema: GenMessage<InviteUserResponse> = /*@__PURE__*/
+  messageDesc(file_hdlctrl_v1_controller, 94);
+
+/**
+ * @generated from message hdlctrl.v1.UpdateUserRoleRequest
+ */
+export type UpdateUserRoleRequest = Message<"hdlctrl.v1.UpdateUserRoleRequest"> & {
+  /**
+   * @generated from field: string host_id = 1;
+   */
+  hostId: string;
+
+  /**
+   * @generated from field: headless.v1.UpdateUserRoleRequest parameters = 2;
+   */
+  parameters?: UpdateUserRoleRequest$1;
+};
+
+/**
+ * Describes the message hdlctrl.v1.UpdateUserRoleRequest.
+ * Use `create(UpdateUserRoleRequestSchema)` to create a new message.
+ */
+export const UpdateUserRoleRequestSchema: GenMessage<UpdateUserRoleRequest> = /*@__PURE__*/
+  messageDesc(file_hdlctrl_v1_controller, 95);
+
+/**
+ * @generated from message hdlctrl.v1.UpdateUserRoleResponse
+ */
+export type UpdateUserRoleResponse = Message<"hdlctrl.v1.UpdateUserRoleResponse"> & {
+  /**
+   * @generated from field: string role = 1;
+   */
+  role: string;
+};
+
+/**
+ * Describes the message hdlctrl.v1.UpdateUserRoleResponse.
+ * Use `create(UpdateUserRoleResponseSchema)` to create a new message.
+ */
+export const UpdateUserRoleResponseSchema: GenMessage<UpdateUserRoleResponse> = /*@__PURE__*/
+  messageDesc(file_hdlctrl_v1_controller, 96);
+
+/**
+ * @generated from message hdlctrl.v1.UpdateSessionParametersRequest
+ */
+export type UpdateSessionParametersRequest = Message<"hdlctrl.v1.UpdateSessionParametersRequest"> & {
+  /**
+   * @generated from field: string host_id = 1;
+   */
+  hostId: string;
+
+  /**
+   * @generated from field: headless.v1.UpdateSessionParametersRequest parameters = 2;
+   */
+  parameters?: UpdateSessionParametersRequest$1;
+};
+
+/**
+ * Describes the message hdlctrl.v1.UpdateSessionParametersRequest.
+ * Use `create(UpdateSessionParametersRequestSchema)` to create a new message.
+ */
+export const UpdateSessionParametersRequestSchema: GenMessage<UpdateSessionParametersRequest> = /*@__PURE__*/
+  messageDesc(file_hdlctrl_v1_controller, 97);
+
+/**
+ * @generated from message hdlctrl.v1.UpdateSessionParametersResponse
+ */
+export type UpdateSessionParametersResponse = Message<"hdlctrl.v1.UpdateSessionParametersResponse"> & {
+};
+
+/**
+ * Describes the message hdlctrl.v1.UpdateSessionParametersResponse.
+ * Use `create(UpdateSessionParametersResponseSchema)` to create a new message.
+ */
+export const UpdateSessionParametersResponseSchema: GenMessage<UpdateSessionParametersResponse> = /*@__PURE__*/
+  messageDesc(file_hdlctrl_v1_controller, 98);
+
+/**
+ * @generated from message hdlctrl.v1.UpdateSessionExtraSettingsRequest
+ */
+export type UpdateSessionExtraSettingsRequest = Message<"hdlctrl.v1.UpdateSessionExtraSettingsRequest"> & {
+  /**
+   * @generated from field: string session_id = 1;
+   */
+  sessionId: string;
+
+  /**
+   * @generated from field: optional bool auto_upgrade = 2;
+   */
+  autoUpgrade?: boolean;
+
+  /**
+   * @generated from field: optional string memo = 3;
+   */
+  memo?: string;
+
+  /**
+   * @generated from field: optional bool restore_on_crash = 4;
+   */
+  restoreOnCrash?: boolean;
+};
+
+/**
+ * Describes the message hdlctrl.v1.UpdateSessionExtraSettingsRequest.
+ * Use `create(UpdateSessionExtraSettingsRequestSchema)` to create a new message.
+ */
+export const UpdateSessionExtraSettingsRequestSchema: GenMessage<UpdateSessionExtraSettingsRequest> = /*@__PURE__*/
+  messageDesc(file_hdlctrl_v1_controller, 99);
+
+/**
+ * @generated from message hdlctrl.v1.UpdateSessionExtraSettingsResponse
+ */
+export type UpdateSessionExtraSettingsResponse = Message<"hdlctrl.v1.UpdateSessionExtraSettingsResponse"> & {
+};
+
+/**
+ * Describes the message hdlctrl.v1.UpdateSessionExtraSettingsResponse.
+ * Use `create(UpdateSessionExtraSettingsResponseSchema)` to create a new message.
+ */
+export const UpdateSessionExtraSettingsResponseSchema: GenMessage<UpdateSessionExtraSettingsResponse> = /*@__PURE__*/
+  messageDesc(file_hdlctrl_v1_controller, 100);
+
+/**
+ * @generated from message hdlctrl.v1.ListUsersInSessionRequest
+ */
+export type ListUsersInSessionRequest = Message<"hdlctrl.v1.ListUsersInSessionRequest"> & {
+  /**
+   * @generated from field: string host_id = 1;
+   */
+  hostId: string;
+
+  /**
+   * @generated from field: string session_id = 2;
+   */
+  sessionId: string;
+};
+
+/**
+ * Describes the message hdlctrl.v1.ListUsersInSessionRequest.
+ * Use `create(ListUsersInSessionRequestSchema)` to create a new message.
+ */
+export const ListUsersInSessionRequestSchema: GenMessage<ListUsersInSessionRequest> = /*@__PURE__*/
+  messageDesc(file_hdlctrl_v1_controller, 101);
+
+/**
+ * @generated from message hdlctrl.v1.ListUsersInSessionResponse
+ */
+export type ListUsersInSessionResponse = Message<"hdlctrl.v1.ListUsersInSessionResponse"> & {
+  /**
+   * @generated from field: repeated headless.v1.UserInSession users = 1;
+   */
+  users: UserInSession[];
+};
+
+/**
+ * Describes the message hdlctrl.v1.ListUsersInSessionResponse.
+ * Use `create(ListUsersInSessionResponseSchema)` to create a new message.
+ */
+export const ListUsersInSessionResponseSchema: GenMessage<ListUsersInSessionResponse> = /*@__PURE__*/
+  messageDesc(file_hdlctrl_v1_controller, 102);
+
+/**
+ * @generated from message hdlctrl.v1.SessionTemplate
+ */
+export type SessionTemplate = Message<"hdlctrl.v1.SessionTemplate"> & {
+  /**
+   * @generated from field: string id = 1;
+   */
+  id: string;
+
+  /**
+   * @generated from field: string group_id = 2;
+   */
+  groupId: string;
+
+  /**
+   * @generated from field: string name = 3;
+   */
+  name: string;
+
+  /**
+   * @generated from field: string description = 4;
+   */
+  description: string;
+
+  /**
+   * @generated from field: headless.v1.WorldStartupParameters parameters = 5;
+   */
+  parameters?: WorldStartupParameters;
+
+  /**
+   * @generated from field: optional string created_by = 6;
+   */
+  createdBy?: string;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp created_at = 7;
+   */
+  createdAt?: Timestamp;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp updated_at = 8;
+   */
+  updatedAt?: Timestamp;
+};
+
+/**
+ * Describes the message hdlctrl.v1.SessionTemplate.
+ * Use `create(SessionTemplateSchema)` to create a new message.
+ */
+export const SessionTemplateSchema: GenMessage<SessionTemplate> = /*@__PURE__*/
+  messageDesc(file_hdlctrl_v1_controller, 103);
+
+/**
+ * @generated from message hdlctrl.v1.ListSessionTemplatesRequest
+ */
+export type ListSessionTemplatesRequest = Message<"hdlctrl.v1.ListSessionTemplatesRequest"> & {
+  /**
+   * @generated from field: string group_id = 1;
+   */
+  groupId: string;
+};
+
+/**
+ * Describes the message hdlctrl.v1.ListSessionTemplatesRequest.
+ * Use `create(ListSessionTemplatesRequestSchema)` to create a new message.
+ */
+export const ListSessionTemplatesRequestSchema: GenMessage<ListSessionTemplatesRequest> = /*@__PURE__*/
+  messageDesc(file_hdlctrl_v1_controller, 104);
+
+/**
+ * @generated from message hdlctrl.v1.ListSessionTemplatesResponse
+ */
+export type ListSessionTemplatesResponse = Message<"hdlctrl.v1.ListSessionTemplatesResponse"> & {
+  /**
+   * @generated from field: repeated hdlctrl.v1.SessionTemplate templates = 1;
+   */
+  templates: SessionTemplate[];
+};
+
+/**
+ * Describes the message hdlctrl.v1.ListSessionTemplatesResponse.
+ * Use `create(ListSessionTemplatesResponseSchema)` to create a new message.
+ */
+export const ListSessionTemplatesResponseSchema: GenMessage<ListSessionTemplatesResponse> = /*@__PURE__*/
+  messageDesc(file_hdlctrl_v1_controller, 105);
+
+/**
+ * @generated from message hdlctrl.v1.GetSessionTemplateRequest
+ */
+export type GetSessionTemplateRequest = Message<"hdlctrl.v1.GetSessionTemplateRequest"> & {
+  /**
+   * @generated from field: string template_id = 1;
+   */
+  templateId: string;
+};
+
+/**
+ * Describes the message hdlctrl.v1.GetSessionTemplateRequest.
+ * Use `create(GetSessionTemplateRequestSchema)` to create a new message.
+ */
+export const GetSessionTemplateRequestSchema: GenMessage<GetSessionTemplateRequest> = /*@__PURE__*/
+  messageDesc(file_hdlctrl_v1_controller, 106);
+
+/**
+ * @generated from message hdlctrl.v1.GetSessionTemplateResponse
+ */
+export type GetSessionTemplateResponse = Message<"hdlctrl.v1.GetSessionTemplateResponse"> & {
+  /**
+   * @generated from field: hdlctrl.v1.SessionTemplate template = 1;
+   */
+  template?: SessionTemplate;
+};
+
+/**
+ * Describes the message hdlctrl.v1.GetSessionTemplateResponse.
+ * Use `create(GetSessionTemplateResponseSchema)` to create a new message.
+ */
+export const GetSessionTemplateResponseSchema: GenMessage<GetSessionTemplateResponse> = /*@__PURE__*/
+  messageDesc(file_hdlctrl_v1_controller, 107);
+
+/**
+ * @generated from message hdlctrl.v1.CreateSessionTemplateRequest
+ */
+export type CreateSessionTemplateRequest = Message<"hdlctrl.v1.CreateSessionTemplateRequest"> & {
+  /**
+   * @generated from field: string group_id = 1;
+   */
+  groupId: string;
+
+  /**
+   * グループ内で一意
+   *
+   * @generated from field: string name = 2;
+   */
+  name: string;
+
+  /**
+   * @generated from field: string description = 3;
+   */
+  description: string;
+
+  /**
+   * @generated from field: headless.v1.WorldStartupParameters parameters = 4;
    */
-  sessionId: string;
+  parameters?: WorldStartupParameters;
 };
 
 /**
- * Describes the message hdlctrl.v1.GetSessionDetailsRequest.
- * Use `create(GetSessionDetailsRequestSchema)` to create a new message.
+ * Describes the message hdlctrl.v1.CreateSessionTemplateRequest.
+ * Use `create(CreateSessionTemplateRequestSchema)` to create a new message.
  */
-export const GetSessionDetailsRequestSchema: GenMessage<GetSessionDetailsRequest> = /*@__PURE__*/
-  messageDesc(file_hdlctrl_v1_controller, 60);
+export const CreateSessionTemplateRequestSchema: GenMessage<CreateSessionTemplateRequest> = /*@__PURE__*/
+  messageDesc(file_hdlctrl_v1_controller, 108);
 
 /**
- * @generated from message hdlctrl.v1.GetSessionDetailsResponse
+ * @generated from message hdlctrl.v1.CreateSessionTemplateResponse
  */
-export type GetSessionDetailsResponse = Message<"hdlctrl.v1.GetSessionDetailsResponse"> & {
+export type CreateSessionTemplateResponse = Message<"hdlctrl.v1.CreateSessionTemplateResponse"> & {
   /**
-   * @generated from field: hdlctrl.v1.Session session = 1;
+   * @generated from field: hdlctrl.v1.SessionTemplate template = 1;
    */
-  session?: Session;
+  template?: SessionTemplate;
 };
 
 /**
- * Describes the message hdlctrl.v1.GetSessionDetailsResponse.
- * Use `create(GetSessionDetailsResponseSchema)` to create a new message.
+ * Describes the message hdlctrl.v1.CreateSessionTemplateResponse.
+ * Use `create(CreateSessionTemplateResponseSchema)` to create a new message.
  */
-export const GetSessionDetailsResponseSchema: GenMessage<GetSessionDetailsResponse> = /*@__PURE__*/
-  messageDesc(file_hdlctrl_v1_controller, 61);
+export const CreateSessionTemplateResponseSchema: GenMessage<CreateSessionTemplateResponse> = /*@__PURE__*/
+  messageDesc(file_hdlctrl_v1_controller, 109);
 
 /**
- * @generated from message hdlctrl.v1.StartWorldRequest
+ * 未指定のフィールドは変更しない. parameters は指定した場合まるごと置き換える.
+ *
+ * @generated from message hdlctrl.v1.UpdateSessionTemplateRequest
  */
-export type StartWorldRequest = Message<"hdlctrl.v1.StartWorldRequest"> & {
+export type UpdateSessionTemplateRequest = Message<"hdlctrl.v1.UpdateSessionTemplateRequest"> & {
   /**
-   * @generated from field: string host_id = 1;
+   * @generated from field: string template_id = 1;
    */
-  hostId: string;
+  templateId: string;
 
   /**
-   * @generated from field: headless.v1.WorldStartupParameters parameters = 2;
+   * @generated from field: optional string name = 2;
    */
-  parameters?: WorldStartupParameters;
+  name?: string;
 
   /**
-   * @generated from field: string memo = 3;
+   * @generated from field: optional string description = 3;
    */
-  memo: string;
+  description?: string;
 
   /**
-   * 起動するセッションの所属グループ. 未指定の場合は呼び出しユーザーの personal グループ.
-   * 指定する場合は host / account の group_id と一致する必要がある (同一グループ制約).
-   *
-   * @generated from field: optional string group_id = 4;
+   * @generated from field: headless.v1.WorldStartupParameters parameters = 4;
    */
-  groupId?: string;
+  parameters?: WorldStartupParameters;
 };
 
 /**
- * Describes the message hdlctrl.v1.StartWorldRequest.
- * Use `create(StartWorldRequestSchema)` to create a new message.
+ * Describes the message hdlctrl.v1.UpdateSessionTemplateRequest.
+ * Use `create(UpdateSessionTemplateRequestSchema)` to create a new message.
  */
-export const StartWorldRequestSchema: GenMessage<StartWorldRequest> = /*@__PURE__*/
-  messageDesc(file_hdlctrl_v1_controller, 62);
+export const UpdateSessionTemplateRequestSchema: GenMessage<UpdateSessionTemplateRequest> = /*@__PURE__*/
+  messageDesc(file_hdlctrl_v1_controller, 110);
 
 /**
- * @generated from message hdlctrl.v1.StartWorldResponse
+ * @generated from message hdlctrl.v1.UpdateSessionTemplateResponse
  */
-export type StartWorldResponse = Message<"hdlctrl.v1.StartWorldResponse"> & {
+export type UpdateSessionTemplateResponse = Message<"hdlctrl.v1.UpdateSessionTemplateResponse"> & {
   /**
-   * @generated from field: string job_id = 2;
+   * @generated from field: hdlctrl.v1.SessionTemplate template = 1;
    */
-  jobId: string;
+  template?: SessionTemplate;
 };
 
 /**
- * Describes the message hdlctrl.v1.StartWorldResponse.
- * Use `create(StartWorldResponseSchema)` to create a new message.
+ * Describes the message hdlctrl.v1.UpdateSessionTemplateResponse.
+ * Use `create(UpdateSessionTemplateResponseSchema)` to create a new message.
  */
-export const StartWorldResponseSchema: GenMessage<StartWorldResponse> = /*@__PURE__*/
-  messageDesc(file_hdlctrl_v1_controller, 63);
+export const UpdateSessionTemplateResponseSchema: GenMessage<UpdateSessionTemplateResponse> = /*@__PURE__*/
+  messageDesc(file_hdlctrl_v1_controller, 111);
 
 /**
- * @generated from message hdlctrl.v1.StopSessionRequest
+ * @generated from message hdlctrl.v1.DeleteSessionTemplateRequest
  */
-export type StopSessionRequest = Message<"hdlctrl.v1.StopSessionRequest"> & {
+export type DeleteSessionTemplateRequest = Message<"hdlctrl.v1.DeleteSessionTemplateRequest"> & {
   /**
-   * 指定不要
-   *
-   * @generated from field: string host_id = 1 [deprecated = true];
-   * @deprecated
-   */
-  hostId: string;
-
-  /**
-   * @generated from field: string session_id = 2;
+   * @generated from field: string template_id = 1;
    */
-  sessionId: string;
+  templateId: string;
 };
 
 /**
- * Describes the message hdlctrl.v1.StopSessionRequest.
- * Use `create(StopSessionRequestSchema)` to create a new message.
+ * Describes the message hdlctrl.v1.DeleteSessionTemplateRequest.
+ * Use `create(DeleteSessionTemplateRequestSchema)` to create a new message.
  */
-export const StopSessionRequestSchema: GenMessage<StopSessionRequest> = /*@__PURE__*/
-  messageDesc(file_hdlctrl_v1_controller, 64);
+export const DeleteSessionTemplateRequestSchema: GenMessage<DeleteSessionTemplateRequest> = /*@__PURE__*/
+  messageDesc(file_hdlctrl_v1_controller, 112);
 
 /**
- * @generated from message hdlctrl.v1.StopSessionResponse
+ * @generated from message hdlctrl.v1.DeleteSessionTemplateResponse
  */
-export type StopSessionResponse = Message<"hdlctrl.v1.StopSessionResponse"> & {
-  /**
-   * @generated from field: string job_id = 1;
-   */
-  jobId: string;
+export type DeleteSessionTemplateResponse = Message<"hdlctrl.v1.DeleteSessionTemplateResponse"> & {
 };
 
 /**
- * Describes the message hdlctrl.v1.StopSessionResponse.
- * Use `create(StopSessionResponseSchema)` to create a new message.
+ * Describes the message hdlctrl.v1.DeleteSessionTemplateResponse.
+ * Use `create(DeleteSessionTemplateResponseSchema)` to create a new message.
  */
-export const StopSessionResponseSchema: GenMessage<StopSessionResponse> = /*@__PURE__*/
-  messageDesc(file_hdlctrl_v1_controller, 65);
+export const DeleteSessionTemplateResponseSchema: GenMessage<DeleteSessionTemplateResponse> = /*@__PURE__*/
+  messageDesc(file_hdlctrl_v1_controller, 113);
 
 /**
- * @generated from message hdlctrl.v1.DeleteEndedSessionRequest
+ * 共通ページングメッセージ
+ * page_index は 0 始まり。page_size 未指定 (=0) はサーバー側でデフォルト値が適用される。
+ * 短時間で行の追加/削除が発生するとページ境界で重複/欠落が起こりうる (page_index ベース固有)。
+ *
+ * @generated from message hdlctrl.v1.PageRequest
  */
-export type DeleteEndedSessionRequest = Message<"hdlctrl.v1.DeleteEndedSessionRequest"> & {
+export type PageRequest = Message<"hdlctrl.v1.PageRequest"> & {
   /**
-   * @generated from field: string session_id = 1;
+   * @generated from field: int32 page_index = 1;
    */
-  sessionId: string;
+  pageIndex: number;
+
+  /**
+   * @generated from field: int32 page_size = 2;
+   */
+  pageSize: number;
 };
 
 /**
- * Describes the message hdlctrl.v1.DeleteEndedSessionRequest.
- * Use `create(DeleteEndedSessionRequestSchema)` to create a new message.
+ * Describes the message hdlctrl.v1.PageRequest.
+ * Use `create(PageRequestSchema)` to create a new message.
  */
-export const DeleteEndedSessionRequestSchema: GenMessage<DeleteEndedSessionRequest> = /*@__PURE__*/
-  messageDesc(file_hdlctrl_v1_controller, 66);
+export const PageRequestSchema: GenMessage<PageRequest> = /*@__PURE__*/
+  messageDesc(file_hdlctrl_v1_controller, 114);
 
 /**
- * @generated from message hdlctrl.v1.DeleteEndedSessionResponse
+ * total_count は全体件数。
+ * page_index / page_size はサーバーがバリデーション・クランプ・デフォルト適用後に実際に使用した値。
+ * クライアントは UI 状態の同期に利用できる。
+ *
+ * @generated from message hdlctrl.v1.PageResponse
  */
-export type DeleteEndedSessionResponse = Message<"hdlctrl.v1.DeleteEndedSessionResponse"> & {
+export type PageResponse = Message<"hdlctrl.v1.PageResponse"> & {
+  /**
+   * @generated from field: int32 total_count = 1;
+   */
+  totalCount: number;
+
+  /**
+   * @generated from field: int32 page_index = 2;
+   */
+  pageIndex: number;
+
+  /**
+   * @generated from field: int32 page_size = 3;
+   */
+  pageSize: number;
 };
 
 /**
- * Describes the message hdlctrl.v1.DeleteEndedSessionResponse.
- * Use `create(DeleteEndedSessionResponseSchema)` to create a new message.
+ * Describes the message hdlctrl.v1.PageResponse.
+ * Use `create(PageResponseSchema)` to create a new message.
  */
-export const DeleteEndedSessionResponseSchema: GenMessage<DeleteEndedSessionResponse> = /*@__PURE__*/
-  messageDesc(file_hdlctrl_v1_controller, 67);
+export const PageResponseSchema: GenMessage<PageResponse> = /*@__PURE__*/
+  messageDesc(file_hdlctrl_v1_controller, 115);
 
 /**
- * @generated from message hdlctrl.v1.SaveSessionWorldRequest
+ * @generated from message hdlctrl.v1.HeadlessHostBindMount
  */
-export type SaveSessionWorldRequest = Message<"hdlctrl.v1.SaveSessionWorldRequest"> & {
+export type HeadlessHostBindMount = Message<"hdlctrl.v1.HeadlessHostBindMount"> & {
   /**
-   * 指定不要
+   * コンテナを動かすマシン上の絶対パス. HOST_BIND_MOUNT_ALLOWED_SOURCES 配下のみ指定可能
    *
-   * @generated from field: string host_id = 1 [deprecated = true];
-   * @deprecated
+   * @generated from field: string source = 1;
    */
-  hostId: string;
+  source: string;
 
   /**
-   * @generated from field: string session_id = 2;
+   * コンテナ内の絶対パス
+   *
+   * @generated from field: string target = 2;
    */
-  sessionId: string;
+  target: string;
 
   /**
-   * @generated from field: hdlctrl.v1.SaveSessionWorldRequest.SaveMode save_mode = 3;
+   * @generated from field: bool read_only = 3;
    */
-  saveMode: SaveSessionWorldRequest_SaveMode;
+  readOnly: boolean;
 };
 
 /**
- * Describes the message hdlctrl.v1.SaveSessionWorldRequest.
- * Use `create(SaveSessionWorldRequestSchema)` to create a new message.
+ * Describes the message hdlctrl.v1.HeadlessHostBindMount.
+ * Use `create(HeadlessHostBindMountSchema)` to create a new message.
  */
-export const SaveSessionWorldRequestSchema: GenMessage<SaveSessionWorldRequest> = /*@__PURE__*/
-  messageDesc(file_hdlctrl_v1_controller, 68);
+export const HeadlessHostBindMountSchema: GenMessage<HeadlessHostBindMount> = /*@__PURE__*/
+  messageDesc(file_hdlctrl_v1_controller, 116);
 
 /**
- * @generated from enum hdlctrl.v1.SaveSessionWorldRequest.SaveMode
+ * @generated from message hdlctrl.v1.HostTemplate
  */
-export enum SaveSessionWorldRequest_SaveMode {
+export type HostTemplate = Message<"hdlctrl.v1.HostTemplate"> & {
   /**
-   * @generated from enum value: SAVE_MODE_UNKNOWN = 0;
+   * @generated from field: string id = 1;
    */
-  UNKNOWN = 0,
+  id: string;
 
   /**
-   * @generated from enum value: SAVE_MODE_OVERWRITE = 1;
+   * @generated from field: string group_id = 2;
    */
-  OVERWRITE = 1,
+  groupId: string;
 
   /**
-   * @generated from enum value: SAVE_MODE_SAVE_AS = 2;
+   * @generated from field: string name = 3;
    */
-  SAVE_AS = 2,
+  name: string;
 
   /**
-   * @generated from enum value: SAVE_MODE_COPY = 3;
+   * @generated from field: string description = 4;
    */
-  COPY = 3,
-}
-
-/**
- * Describes the enum hdlctrl.v1.SaveSessionWorldRequest.SaveMode.
- */
-export const SaveSessionWorldRequest_SaveModeSchema: GenEnum<SaveSessionWorldRequest_SaveMode> = /*@__PURE__*/
-  enumDesc(file_hdlctrl_v1_controller, 68, 0);
+  description: string;
 
-/**
- * @generated from message hdlctrl.v1.SaveSessionWorldResponse
- */
-export type SaveSessionWorldResponse = Message<"hdlctrl.v1.SaveSessionWorldResponse"> & {
   /**
-   * @generated from field: optional string saved_record_url = 1;
+   * 空なら起動時点の最新リリース
+   *
+   * @generated from field: string image_tag = 5;
    */
-  savedRecordUrl?: string;
-};
-
-/**
- * Describes the message hdlctrl.v1.SaveSessionWorldResponse.
- * Use `create(SaveSessionWorldResponseSchema)` to create a new message.
- */
-export const SaveSessionWorldResponseSchema: GenMessage<SaveSessionWorldResponse> = /*@__PURE__*/
-  messageDesc(file_hdlctrl_v1_controller, 69);
+  imageTag: string;
 
-/**
- * @generated from message hdlctrl.v1.PrepareSessionWorldDownloadRequest
- */
-export type PrepareSessionWorldDownloadRequest = Message<"hdlctrl.v1.PrepareSessionWorldDownloadRequest"> & {
   /**
-   * @generated from field: string session_id = 1;
+   * @generated from field: headless.v1.StartupConfig startup_config = 6;
    */
-  sessionId: string;
+  startupConfig?: StartupConfig;
 
   /**
-   * @generated from field: headless.v1.WorldBinaryFormat format = 2;
+   * @generated from field: hdlctrl.v1.HeadlessHostAutoUpdatePolicy auto_update_policy = 7;
    */
-  format: WorldBinaryFormat;
-};
+  autoUpdatePolicy: HeadlessHostAutoUpdatePolicy;
 
-/**
- * Describes the message hdlctrl.v1.PrepareSessionWorldDownloadRequest.
- * Use `create(PrepareSessionWorldDownloadRequestSchema)` to create a new message.
- */
-export const PrepareSessionWorldDownloadRequestSchema: GenMessage<PrepareSessionWorldDownloadRequest> = /*@__PURE__*/
-  messageDesc(file_hdlctrl_v1_controller, 70);
+  /**
+   * @generated from field: string memo = 8;
+   */
+  memo: string;
 
-/**
- * @generated from message hdlctrl.v1.PrepareSessionWorldDownloadResponse
- */
-export type PrepareSessionWorldDownloadResponse = Message<"hdlctrl.v1.PrepareSessionWorldDownloadResponse"> & {
   /**
-   * ブラウザがそのままアクセス可能な相対 URL: /blobs/<uuid>
-   *
-   * @generated from field: string download_url = 1;
+   * @generated from field: hdlctrl.v1.HeadlessHostContainerSettings container_settings = 9;
    */
-  downloadUrl: string;
+  containerSettings?: HeadlessHostContainerSettings;
 
   /**
-   * 推奨ファイル名 (ブラウザの Content-Disposition でも提供されるが、UI 表示用にも返す)
-   *
-   * @generated from field: string filename = 2;
+   * @generated from field: hdlctrl.v1.HeadlessHostAutoRestartPolicy auto_restart_policy = 10;
    */
-  filename: string;
-};
+  autoRestartPolicy: HeadlessHostAutoRestartPolicy;
 
-/**
- * Describes the message hdlctrl.v1.PrepareSessionWorldDownloadResponse.
- * Use `create(PrepareSessionWorldDownloadResponseSchema)` to create a new message.
- */
-export const PrepareSessionWorldDownloadResponseSchema: GenMessage<PrepareSessionWorldDownloadResponse> = /*@__PURE__*/
-  messageDesc(file_hdlctrl_v1_controller, 71);
+  /**
+   * @generated from field: int32 auto_restart_max_retries = 11;
+   */
+  autoRestartMaxRetries: number;
 
-/**
- * @generated from message hdlctrl.v1.InviteUserRequest
- */
-export type InviteUserRequest = Message<"hdlctrl.v1.InviteUserRequest"> & {
   /**
-   * @generated from field: string host_id = 1;
+   * @generated from field: optional string created_by = 12;
    */
-  hostId: string;
+  createdBy?: string;
 
   /**
-   * @generated from field: string session_id = 2;
+   * @generated from field: google.protobuf.Timestamp created_at = 13;
    */
-  sessionId: string;
+  createdAt?: Timestamp;
 
   /**
-   * @generated from oneof hdlctrl.v1.InviteUserRequest.user
+   * @generated from field: google.protobuf.Timestamp updated_at = 14;
    */
-  user: {
-    /**
-     * @generated from field: string user_id = 3;
-     */
-    value: string;
-    case: "userId";
-  } | {
-    /**
-     * @generated from field: string user_name = 4;
-     */
-    value: string;
-    case: "userName";
-  } | { case: undefined; value?: undefined };
+  updatedAt?: Timestamp;
 };
 
 /**
- * Describes the message hdlctrl.v1.InviteUserRequest.
- * Use `create(InviteUserRequestSchema)` to create a new message.
+ * Describes the message hdlctrl.v1.HostTemplate.
+ * Use `create(HostTemplateSchema)` to create a new message.
  */
-export const InviteUserRequestSchema: GenMessage<InviteUserRequest> = /*@__PURE__*/
-  messageDesc(file_hdlctrl_v1_controller, 72);
+export const HostTemplateSchema: GenMessage<HostTemplate> = /*@__PURE__*/
+  messageDesc(file_hdlctrl_v1_controller, 117);
 
 /**
- * @generated from message hdlctrl.v1.InviteUserResponse
+ * @generated from message hdlctrl.v1.ListHostTemplatesRequest
  */
-export type InviteUserResponse = Message<"hdlctrl.v1.InviteUserResponse"> & {
+export type ListHostTemplatesRequest = Message<"hdlctrl.v1.ListHostTemplatesRequest"> & {
+  /**
+   * @generated from field: string group_id = 1;
+   */
+  groupId: string;
 };
 
 /**
- * Describes the message hdlctrl.v1.InviteUserResponse.
- * Use `create(InviteUserResponseSchema)` to create a new message.
+ * Describes the message hdlctrl.v1.ListHostTemplatesRequest.
+ * Use `create(ListHostTemplatesRequestSchema)` to create a new message.
  */
-export const InviteUserResponseSchema: GenMessage<InviteUserResponse> = /*@__PURE__*/
-  messageDesc(file_hdlctrl_v1_controller, 73);
+export const ListHostTemplatesRequestSchema: GenMessage<ListHostTemplatesRequest> = /*@__PURE__*/
+  messageDesc(file_hdlctrl_v1_controller, 118);
 
 /**
- * @generated from message hdlctrl.v1.UpdateUserRoleRequest
+ * @generated from message hdlctrl.v1.ListHostTemplatesResponse
  */
-export type UpdateUserRoleRequest = Message<"hdlctrl.v1.UpdateUserRoleRequest"> & {
+export type ListHostTemplatesResponse = Message<"hdlctrl.v1.ListHostTemplatesResponse"> & {
   /**
-   * @generated from field: string host_id = 1;
+   * @generated from field: repeated hdlctrl.v1.HostTemplate templates = 1;
    */
-  hostId: string;
+  templates: HostTemplate[];
+};
 
+/**
+ * Describes the message hdlctrl.v1.ListHostTemplatesResponse.
+ * Use `create(ListHostTemplatesResponseSchema)` to create a new message.
+ */
+export const ListHostTemplatesResponseSchema: GenMessage<ListHostTemplatesResponse> = /*@__PURE__*/
+  messageDesc(file_hdlctrl_v1_controller, 119);
+
+/**
+ * @generated from message hdlctrl.v1.GetHostTemplateRequest
+ */
+export type GetHostTemplateRequest = Message<"hdlctrl.v1.GetHostTemplateRequest"> & {
   /**
-   * @generated from field: headless.v1.UpdateUserRoleRequest parameters = 2;
+   * @generated from field: string template_id = 1;
    */
-  parameters?: UpdateUserRoleRequest$1;
+  templateId: string;
 };
 
 /**
- * Describes the message hdlctrl.v1.UpdateUserRoleRequest.
- * Use `create(UpdateUserRoleRequestSchema)` to create a new message.
+ * Describes the message hdlctrl.v1.GetHostTemplateRequest.
+ * Use `create(GetHostTemplateRequestSchema)` to create a new message.
  */
-export const UpdateUserRoleRequestSchema: GenMessage<UpdateUserRoleRequest> = /*@__PURE__*/
-  messageDesc(file_hdlctrl_v1_controller, 74);
+export const GetHostTemplateRequestSchema: GenMessage<GetHostTemplateRequest> = /*@__PURE__*/
+  messageDesc(file_hdlctrl_v1_controller, 120);
 
 /**
- * @generated from message hdlctrl.v1.UpdateUserRoleResponse
+ * @generated from message hdlctrl.v1.GetHostTemplateResponse
  */
-export type UpdateUserRoleResponse = Message<"hdlctrl.v1.UpdateUserRoleResponse"> & {
+export type GetHostTemplateResponse = Message<"hdlctrl.v1.GetHostTemplateResponse"> & {
   /**
-   * @generated from field: string role = 1;
+   * @generated from field: hdlctrl.v1.HostTemplate template = 1;
    */
-  role: string;
+  template?: HostTemplate;
 };
 
 /**
- * Describes the message hdlctrl.v1.UpdateUserRoleResponse.
- * Use `create(UpdateUserRoleResponseSchema)` to create a new message.
+ * Describes the message hdlctrl.v1.GetHostTemplateResponse.
+ * Use `create(GetHostTemplateResponseSchema)` to create a new message.
  */
-export const UpdateUserRoleResponseSchema: GenMessage<UpdateUserRoleResponse> = /*@__PURE__*/
-  messageDesc(file_hdlctrl_v1_controller, 75);
+export const GetHostTemplateResponseSchema: GenMessage<GetHostTemplateResponse> = /*@__PURE__*/
+  messageDesc(file_hdlctrl_v1_controller, 121);
 
 /**
- * @generated from message hdlctrl.v1.UpdateSessionParametersRequest
+ * @generated from message hdlctrl.v1.CreateHostTemplateRequest
  */
-export type UpdateSessionParametersRequest = Message<"hdlctrl.v1.UpdateSessionParametersRequest"> & {
+export type CreateHostTemplateRequest = Message<"hdlctrl.v1.CreateHostTemplateRequest"> & {
   /**
-   * @generated from field: string host_id = 1;
+   * @generated from field: string group_id = 1;
    */
-  hostId: string;
+  groupId: string;
 
   /**
-   * @generated from field: headless.v1.UpdateSessionParametersRequest parameters = 2;
+   * グループ内で一意
+   *
+   * @generated from field: string name = 2;
    */
-  parameters?: UpdateSessionParametersRequest$1;
+  name: string;
+
+  /**
+   * @generated from field: string description = 3;
+   */
+  description: string;
+
+  /**
+   * @generated from field: string image_tag = 4;
+   */
+  imageTag: string;
+
+  /**
+   * @generated from field: headless.v1.StartupConfig startup_config = 5;
+   */
+  startupConfig?: StartupConfig;
+
+  /**
+   * @generated from field: hdlctrl.v1.HeadlessHostAutoUpdatePolicy auto_update_policy = 6;
+   */
+  autoUpdatePolicy: HeadlessHostAutoUpdatePolicy;
+
+  /**
+   * @generated from field: string memo = 7;
+   */
+  memo: string;
+
+  /**
+   * @generated from field: hdlctrl.v1.HeadlessHostContainerSettings container_settings = 8;
+   */
+  containerSettings?: HeadlessHostContainerSettings;
+
+  /**
+   * @generated from field: hdlctrl.v1.HeadlessHostAutoRestartPolicy auto_restart_policy = 9;
+   */
+  autoRestartPolicy: HeadlessHostAutoRestartPolicy;
+
+  /**
+   * @generated from field: int32 auto_restart_max_retries = 10;
+   */
+  autoRestartMaxRetries: number;
 };
 
 /**
- * Describes the message hdlctrl.v1.UpdateSessionParametersRequest.
- * Use `create(UpdateSessionParametersRequestSchema)` to create a new message.
+ * Describes the message hdlctrl.v1.CreateHostTemplateRequest.
+ * Use `create(CreateHostTemplateRequestSchema)` to create a new message.
  */
-export const UpdateSessionParametersRequestSchema: GenMessage<UpdateSessionParametersRequest> = /*@__PURE__*/
-  messageDesc(file_hdlctrl_v1_controller, 76);
+export const CreateHostTemplateRequestSchema: GenMessage<CreateHostTemplateRequest> = /*@__PURE__*/
+  messageDesc(file_hdlctrl_v1_controller, 122);
 
 /**
- * @generated from message hdlctrl.v1.UpdateSessionParametersResponse
+ * @generated from message hdlctrl.v1.CreateHostTemplateResponse
  */
-export type UpdateSessionParametersResponse = Message<"hdlctrl.v1.UpdateSessionParametersResponse"> & {
+export type CreateHostTemplateResponse = Message<"hdlctrl.v1.CreateHostTemplateResponse"> & {
+  /**
+   * @generated from field: hdlctrl.v1.HostTemplate template = 1;
+   */
+  template?: HostTemplate;
 };
 
 /**
- * Describes the message hdlctrl.v1.UpdateSessionParametersResponse.
- * Use `create(UpdateSessionParametersResponseSchema)` to create a new message.
+ * Describes the message hdlctrl.v1.CreateHostTemplateResponse.
+ * Use `create(CreateHostTemplateResponseSchema)` to create a new message.
  */
-export const UpdateSessionParametersResponseSchema: GenMessage<UpdateSessionParametersResponse> = /*@__PURE__*/
-  messageDesc(file_hdlctrl_v1_controller, 77);
+export const CreateHostTemplateResponseSchema: GenMessage<CreateHostTemplateResponse> = /*@__PURE__*/
+  messageDesc(file_hdlctrl_v1_controller, 123);
 
 /**
- * @generated from message hdlctrl.v1.UpdateSessionExtraSettingsRequest
+ * 未指定のフィールドは変更しない. startup_config / container_settings は指定した場合まるごと置き換える.
+ *
+ * @generated from message hdlctrl.v1.UpdateHostTemplateRequest
  */
-export type UpdateSessionExtraSettingsRequest = Message<"hdlctrl.v1.UpdateSessionExtraSettingsRequest"> & {
+export type UpdateHostTemplateRequest = Message<"hdlctrl.v1.UpdateHostTemplateRequest"> & {
   /**
-   * @generated from field: string session_id = 1;
+   * @generated from field: string template_id = 1;
    */
-  sessionId: string;
+  templateId: string;
 
   /**
-   * @generated from field: optional bool auto_upgrade = 2;
+   * @generated from field: optional string name = 2;
    */
-  autoUpgrade?: boolean;
+  name?: string;
 
   /**
-   * @generated from field: optional string memo = 3;
+   * @generated from field: optional string description = 3;
+   */
+  description?: string;
+
+  /**
+   * @generated from field: optional string image_tag = 4;
+   */
+  imageTag?: string;
+
+  /**
+   * @generated from field: optional headless.v1.StartupConfig startup_config = 5;
+   */
+  startupConfig?: StartupConfig;
+
+  /**
+   * @generated from field: optional hdlctrl.v1.HeadlessHostAutoUpdatePolicy auto_update_policy = 6;
+   */
+  autoUpdatePolicy?: HeadlessHostAutoUpdatePolicy;
+
+  /**
+   * @generated from field: optional string memo = 7;
    */
   memo?: string;
+
+  /**
+   * @generated from field: optional hdlctrl.v1.HeadlessHostContainerSettings container_settings = 8;
+   */
+  containerSettings?: HeadlessHostContainerSettings;
+
+  /**
+   * @generated from field: optional hdlctrl.v1.HeadlessHostAutoRestartPolicy auto_restart_policy = 9;
+   */
+  autoRestartPolicy?: HeadlessHostAutoRestartPolicy;
+
+  /**
+   * @generated from field: optional int32 auto_restart_max_retries = 10;
+   */
+  autoRestartMaxRetries?: number;
 };
 
 /**
- * Describes the message hdlctrl.v1.UpdateSessionExtraSettingsRequest.
- * Use `create(UpdateSessionExtraSettingsRequestSchema)` to create a new message.
+ * Describes the message hdlctrl.v1.UpdateHostTemplateRequest.
+ * Use `create(UpdateHostTemplateRequestSchema)` to create a new message.
  */
-export const UpdateSessionExtraSettingsRequestSchema: GenMessage<UpdateSessionExtraSettingsRequest> = /*@__PURE__*/
-  messageDesc(file_hdlctrl_v1_controller, 78);
+export const UpdateHostTemplateRequestSchema: GenMessage<UpdateHostTemplateRequest> = /*@__PURE__*/
+  messageDesc(file_hdlctrl_v1_controller, 124);
 
 /**
- * @generated from message hdlctrl.v1.UpdateSessionExtraSettingsResponse
+ * @generated from message hdlctrl.v1.UpdateHostTemplateResponse
  */
-export type UpdateSessionExtraSettingsResponse = Message<"hdlctrl.v1.UpdateSessionExtraSettingsResponse"> & {
+export type UpdateHostTemplateResponse = Message<"hdlctrl.v1.UpdateHostTemplateResponse"> & {
+  /**
+   * @generated from field: hdlctrl.v1.HostTemplate template = 1;
+   */
+  template?: HostTemplate;
 };
 
 /**
- * Describes the message hdlctrl.v1.UpdateSessionExtraSettingsResponse.
- * Use `create(UpdateSessionExtraSettingsResponseSchema)` to create a new message.
+ * Describes the message hdlctrl.v1.UpdateHostTemplateResponse.
+ * Use `create(UpdateHostTemplateResponseSchema)` to create a new message.
  */
-export const UpdateSessionExtraSettingsResponseSchema: GenMessage<UpdateSessionExtraSettingsResponse> = /*@__PURE__*/
-  messageDesc(file_hdlctrl_v1_controller, 79);
+export const UpdateHostTemplateResponseSchema: GenMessage<UpdateHostTemplateResponse> = /*@__PURE__*/
+  messageDesc(file_hdlctrl_v1_controller, 125);
 
 /**
- * @generated from message hdlctrl.v1.ListUsersInSessionRequest
+ * @generated from message hdlctrl.v1.DeleteHostTemplateRequest
  */
-export type ListUsersInSessionRequest = Message<"hdlctrl.v1.ListUsersInSessionRequest"> & {
-  /**
-   * @generated from field: string host_id = 1;
-   */
-  hostId: string;
-
+export type DeleteHostTemplateRequest = Message<"hdlctrl.v1.DeleteHostTemplateRequest"> & {
   /**
-   * @generated from field: string session_id = 2;
+   * @generated from field: string template_id = 1;
    */
-  sessionId: string;
+  templateId: string;
 };
 
 /**
- * Describes the message hdlctrl.v1.ListUsersInSessionRequest.
- * Use `create(ListUsersInSessionRequestSchema)` to create a new message.
+ * Describes the message hdlctrl.v1.DeleteHostTemplateRequest.
+ * Use `create(DeleteHostTemplateRequestSchema)` to create a new message.
  */
-export const ListUsersInSessionRequestSchema: GenMessage<ListUsersInSessionRequest> = /*@__PURE__*/
-  messageDesc(file_hdlctrl_v1_controller, 80);
+export const DeleteHostTemplateRequestSchema: GenMessage<DeleteHostTemplateRequest> = /*@__PURE__*/
+  messageDesc(file_hdlctrl_v1_controller, 126);
 
 /**
- * @generated from message hdlctrl.v1.ListUsersInSessionResponse
+ * @generated from message hdlctrl.v1.DeleteHostTemplateResponse
  */
-export type ListUsersInSessionResponse = Message<"hdlctrl.v1.ListUsersInSessionResponse"> & {
-  /**
-   * @generated from field: repeated headless.v1.UserInSession users = 1;
-   */
-  users: UserInSession[];
+export type DeleteHostTemplateResponse = Message<"hdlctrl.v1.DeleteHostTemplateResponse"> & {
 };
 
 /**
- * Describes the message hdlctrl.v1.ListUsersInSessionResponse.
- * Use `create(ListUsersInSessionResponseSchema)` to create a new message.
+ * Describes the message hdlctrl.v1.DeleteHostTemplateResponse.
+ * Use `create(DeleteHostTemplateResponseSchema)` to create a new message.
  */
-export const ListUsersInSessionResponseSchema: GenMessage<ListUsersInSessionResponse> = /*@__PURE__*/
-  messageDesc(file_hdlctrl_v1_controller, 81);
+export const DeleteHostTemplateResponseSchema: GenMessage<DeleteHostTemplateResponse> = /*@__PURE__*/
+  messageDesc(file_hdlctrl_v1_controller, 127);
 
 /**
- * 共通ページングメッセージ
- * page_index は 0 始まり。page_size 未指定 (=0) はサーバー側でデフォルト値が適用される。
- * 短時間で行の追加/削除が発生するとページ境界で重複/欠落が起こりうる (page_index ベース固有)。
+ * ホストのコンテナに適用するリソース制限と配置設定. 0 / 空の項目は制限なし.
  *
- * @generated from message hdlctrl.v1.PageRequest
+ * @generated from message hdlctrl.v1.HeadlessHostContainerSettings
  */
-export type PageRequest = Message<"hdlctrl.v1.PageRequest"> & {
+export type HeadlessHostContainerSettings = Message<"hdlctrl.v1.HeadlessHostContainerSettings"> & {
   /**
-   * @generated from field: int32 page_index = 1;
+   * CPU 使用量の上限 (コア数. 1.5 なら 1.5 コア分)
+   *
+   * @generated from field: double cpus = 1;
    */
-  pageIndex: number;
+  cpus: number;
 
   /**
-   * @generated from field: int32 page_size = 2;
+   * @generated from field: int64 memory_bytes = 2;
    */
-  pageSize: number;
-};
+  memoryBytes: bigint;
 
-/**
- * Describes the message hdlctrl.v1.PageRequest.
- * Use `create(PageRequestSchema)` to create a new message.
- */
-export const PageRequestSchema: GenMessage<PageRequest> = /*@__PURE__*/
-  messageDesc(file_hdlctrl_v1_controller, 82);
+  /**
+   * メモリ + swap の上限. -1 で swap 無制限. memory_bytes 以上である必要がある
+   *
+   * @generated from field: int64 memory_swap_bytes = 3;
+   */
+  memorySwapBytes: bigint;
 
-/**
- * total_count は全体件数。
- * page_index / page_size はサーバーがバリデーション・クランプ・デフォルト適用後に実際に使用した値。
- * クライアントは UI 状態の同期に利用できる。
- *
- * @generated from message hdlctrl.v1.PageResponse
- */
-export type PageResponse = Message<"hdlctrl.v1.PageResponse"> & {
   /**
-   * @generated from field: int32 total_count = 1;
+   * 使用する CPU の指定 (例: "0-3", "0,2")
+   *
+   * @generated from field: string cpuset_cpus = 4;
    */
-  totalCount: number;
+  cpusetCpus: string;
 
   /**
-   * @generated from field: int32 page_index = 2;
+   * @generated from field: hdlctrl.v1.HeadlessHostRestartPolicy restart_policy = 5;
    */
-  pageIndex: number;
+  restartPolicy: HeadlessHostRestartPolicy;
 
   /**
-   * @generated from field: int32 page_size = 3;
+   * @generated from field: int32 restart_max_retries = 6;
    */
-  pageSize: number;
+  restartMaxRetries: number;
+
+  /**
+   * @generated from field: repeated hdlctrl.v1.HeadlessHostBindMount bind_mounts = 7;
+   */
+  bindMounts: HeadlessHostBindMount[];
 };
 
 /**
- * Describes the message hdlctrl.v1.PageResponse.
- * Use `create(PageResponseSchema)` to create a new message.
+ * Describes the message hdlctrl.v1.HeadlessHostContainerSettings.
+ * Use `create(HeadlessHostContainerSettingsSchema)` to create a new message.
  */
-export const PageResponseSchema: GenMessage<PageResponse> = /*@__PURE__*/
-  messageDesc(file_hdlctrl_v1_controller, 83);
+export const HeadlessHostContainerSettingsSchema: GenMessage<HeadlessHostContainerSettings> = /*@__PURE__*/
+  messageDesc(file_hdlctrl_v1_controller, 128);
 
 /**
  * @generated from message hdlctrl.v1.HeadlessHostSettings
@@ -2058,7 +3633,7 @@ export type HeadlessHostSettings = Message<"hdlctrl.v1.HeadlessHostSettings"> &
  * Use `create(HeadlessHostSettingsSchema)` to create a new message.
  */
 export const HeadlessHostSettingsSchema: GenMessage<HeadlessHostSettings> = /*@__PURE__*/
-  messageDesc(file_hdlctrl_v1_controller, 84);
+  messageDesc(file_hdlctrl_v1_controller, 129);
 
 /**
  * @generated from message hdlctrl.v1.HeadlessHost
@@ -2138,6 +3713,49 @@ export type HeadlessHost = Message<"hdlctrl.v1.HeadlessHost"> & {
    * @generated from field: optional string created_by = 17;
    */
   createdBy?: string;
+
+  /**
+   * ホストが配置されている docker node ID. node を持たない connector では空.
+   *
+   * @generated from field: string node_id = 18;
+   */
+  nodeId: string;
+
+  /**
+   * @generated from field: hdlctrl.v1.HeadlessHostContainerSettings container_settings = 19;
+   */
+  containerSettings?: HeadlessHostContainerSettings;
+
+  /**
+   * @generated from field: hdlctrl.v1.HeadlessHostAutoRestartPolicy auto_restart_policy = 20;
+   */
+  autoRestartPolicy: HeadlessHostAutoRestartPolicy;
+
+  /**
+   * 0 ならサーバー設定の crash loop 判定回数まで
+   *
+   * @generated from field: int32 auto_restart_max_retries = 21;
+   */
+  autoRestartMaxRetries: number;
+
+  /**
+   * これまでにクラッシュした回数 (自動再起動の有無によらず数える)
+   *
+   * @generated from field: int32 crash_count = 22;
+   */
+  crashCount: number;
+
+  /**
+   * @generated from field: optional google.protobuf.Timestamp last_crashed_at = 23;
+   */
+  lastCrashedAt?: Timestamp;
+
+  /**
+   * crash loop と判定されて自動再起動を止めている
+   *
+   * @generated from field: bool auto_restart_suspended = 24;
+   */
+  autoRestartSuspended: boolean;
 };
 
 /**
@@ -2145,7 +3763,7 @@ export type HeadlessHost = Message<"hdlctrl.v1.HeadlessHost"> & {
  * Use `create(HeadlessHostSchema)` to create a new message.
  */
 export const HeadlessHostSchema: GenMessage<HeadlessHost> = /*@__PURE__*/
-  messageDesc(file_hdlctrl_v1_controller, 85);
+  messageDesc(file_hdlctrl_v1_controller, 130);
 
 /**
  * @generated from message hdlctrl.v1.Session
@@ -2224,6 +3842,13 @@ export type Session = Message<"hdlctrl.v1.Session"> & {
    * @generated from field: optional string created_by = 13;
    */
   createdBy?: string;
+
+  /**
+   * host がクラッシュした後、host が RUNNING に戻ったら同じ設定で起動し直す.
+   *
+   * @generated from field: bool restore_on_crash = 14;
+   */
+  restoreOnCrash: boolean;
 };
 
 /**
@@ -2231,7 +3856,7 @@ export type Session = Message<"hdlctrl.v1.Session"> & {
  * Use `create(SessionSchema)` to create a new message.
  */
 export const SessionSchema: GenMessage<Session> = /*@__PURE__*/
-  messageDesc(file_hdlctrl_v1_controller, 86);
+  messageDesc(file_hdlctrl_v1_controller, 131);
 
 /**
  * @generated from message hdlctrl.v1.HeadlessAccount
@@ -2273,7 +3898,7 @@ export type HeadlessAccount = Message<"hdlctrl.v1.HeadlessAccount"> & {
  * Use `create(HeadlessAccountSchema)` to create a new message.
  */
 export const HeadlessAccountSchema: GenMessage<HeadlessAccount> = /*@__PURE__*/
-  messageDesc(file_hdlctrl_v1_controller, 87);
+  messageDesc(file_hdlctrl_v1_controller, 132);
 
 /**
  * @generated from message hdlctrl.v1.UserInfo
@@ -2300,7 +3925,7 @@ export type UserInfo = Message<"hdlctrl.v1.UserInfo"> & {
  * Use `create(UserInfoSchema)` to create a new message.
  */
 export const UserInfoSchema: GenMessage<UserInfo> = /*@__PURE__*/
-  messageDesc(file_hdlctrl_v1_controller, 88);
+  messageDesc(file_hdlctrl_v1_controller, 133);
 
 /**
  * @generated from message hdlctrl.v1.GetResoniteUserRequest
@@ -2317,7 +3942,7 @@ export type GetResoniteUserRequest = Message<"hdlctrl.v1.GetResoniteUserRequest"
  * Use `create(GetResoniteUserRequestSchema)` to create a new message.
  */
 export const GetResoniteUserRequestSchema: GenMessage<GetResoniteUserRequest> = /*@__PURE__*/
-  messageDesc(file_hdlctrl_v1_controller, 89);
+  messageDesc(file_hdlctrl_v1_controller, 134);
 
 /**
  * @generated from message hdlctrl.v1.GetResoniteUserResponse
@@ -2344,7 +3969,7 @@ export type GetResoniteUserResponse = Message<"hdlctrl.v1.GetResoniteUserRespons
  * Use `create(GetResoniteUserResponseSchema)` to create a new message.
  */
 export const GetResoniteUserResponseSchema: GenMessage<GetResoniteUserResponse> = /*@__PURE__*/
-  messageDesc(file_hdlctrl_v1_controller, 90);
+  messageDesc(file_hdlctrl_v1_controller, 135);
 
 /**
  * コンタクト・チャット系メッセージ
@@ -2373,7 +3998,7 @@ export type ListContactsRequest = Message<"hdlctrl.v1.ListContactsRequest"> & {
  * Use `create(ListContactsRequestSchema)` to create a new message.
  */
 export const ListContactsRequestSchema: GenMessage<ListContactsRequest> = /*@__PURE__*/
-  messageDesc(file_hdlctrl_v1_controller, 91);
+  messageDesc(file_hdlctrl_v1_controller, 136);
 
 /**
  * @generated from message hdlctrl.v1.ListContactsResponse
@@ -2395,7 +4020,7 @@ export type ListContactsResponse = Message<"hdlctrl.v1.ListContactsResponse"> &
  * Use `create(ListContactsResponseSchema)` to create a new message.
  */
 export const ListContactsResponseSchema: GenMessage<ListContactsResponse> = /*@__PURE__*/
-  messageDesc(file_hdlctrl_v1_controller, 92);
+  messageDesc(file_hdlctrl_v1_controller, 137);
 
 /**
  * @generated from message hdlctrl.v1.GetContactMessagesRequest
@@ -2432,7 +4057,7 @@ export type GetContactMessagesRequest = Message<"hdlctrl.v1.GetContactMessagesRe
  * Use `create(GetContactMessagesRequestSchema)` to create a new message.
  */
 export const GetContactMessagesRequestSchema: GenMessage<GetContactMessagesRequest> = /*@__PURE__*/
-  messageDesc(file_hdlctrl_v1_controller, 93);
+  messageDesc(file_hdlctrl_v1_controller, 138);
 
 /**
  * @generated from message hdlctrl.v1.GetContactMessagesResponse
@@ -2459,7 +4084,7 @@ export type GetContactMessagesResponse = Message<"hdlctrl.v1.GetContactMessagesR
  * Use `create(GetContactMessagesResponseSchema)` to create a new message.
  */
 export const GetContactMessagesResponseSchema: GenMessage<GetContactMessagesResponse> = /*@__PURE__*/
-  messageDesc(file_hdlctrl_v1_controller, 94);
+  messageDesc(file_hdlctrl_v1_controller, 139);
 
 /**
  * @generated from message hdlctrl.v1.ContactMessage
@@ -2501,7 +4126,7 @@ export type ContactMessage = Message<"hdlctrl.v1.ContactMessage"> & {
  * Use `create(ContactMessageSchema)` to create a new message.
  */
 export const ContactMessageSchema: GenMessage<ContactMessage> = /*@__PURE__*/
-  messageDesc(file_hdlctrl_v1_controller, 95);
+  messageDesc(file_hdlctrl_v1_controller, 140);
 
 /**
  * @generated from message hdlctrl.v1.SendContactMessageRequest
@@ -2528,7 +4153,7 @@ export type SendContactMessageRequest = Message<"hdlctrl.v1.SendContactMessageRe
  * Use `create(SendContactMessageRequestSchema)` to create a new message.
  */
 export const SendContactMessageRequestSchema: GenMessage<SendContactMessageRequest> = /*@__PURE__*/
-  messageDesc(file_hdlctrl_v1_controller, 96);
+  messageDesc(file_hdlctrl_v1_controller, 141);
 
 /**
  * @generated from message hdlctrl.v1.SendContactMessageResponse
@@ -2541,7 +4166,7 @@ export type SendContactMessageResponse = Message<"hdlctrl.v1.SendContactMessageR
  * Use `create(SendContactMessageResponseSchema)` to create a new message.
  */
 export const SendContactMessageResponseSchema: GenMessage<SendContactMessageResponse> = /*@__PURE__*/
-  messageDesc(file_hdlctrl_v1_controller, 97);
+  messageDesc(file_hdlctrl_v1_controller, 142);
 
 /**
  * 予約する操作.
@@ -2584,7 +4209,7 @@ export type ScheduledOperation = Message<"hdlctrl.v1.ScheduledOperation"> & {
  * Use `create(ScheduledOperationSchema)` to create a new message.
  */
 export const ScheduledOperationSchema: GenMessage<ScheduledOperation> = /*@__PURE__*/
-  messageDesc(file_hdlctrl_v1_controller, 98);
+  messageDesc(file_hdlctrl_v1_controller, 143);
 
 /**
  * 発火条件.
@@ -2607,6 +4232,12 @@ export type ScheduledTrigger = Message<"hdlctrl.v1.ScheduledTrigger"> & {
      */
     value: SessionUserCountTrigger;
     case: "sessionUserCount";
+  } | {
+    /**
+     * @generated from field: hdlctrl.v1.CronTrigger cron = 3;
+     */
+    value: CronTrigger;
+    case: "cron";
   } | { case: undefined; value?: undefined };
 };
 
@@ -2615,7 +4246,7 @@ export type ScheduledTrigger = Message<"hdlctrl.v1.ScheduledTrigger"> & {
  * Use `create(ScheduledTriggerSchema)` to create a new message.
  */
 export const ScheduledTriggerSchema: GenMessage<ScheduledTrigger> = /*@__PURE__*/
-  messageDesc(file_hdlctrl_v1_controller, 99);
+  messageDesc(file_hdlctrl_v1_controller, 144);
 
 /**
  * @generated from message hdlctrl.v1.TimeTrigger
@@ -2632,7 +4263,7 @@ export type TimeTrigger = Message<"hdlctrl.v1.TimeTrigger"> & {
  * Use `create(TimeTriggerSchema)` to create a new message.
  */
 export const TimeTriggerSchema: GenMessage<TimeTrigger> = /*@__PURE__*/
-  messageDesc(file_hdlctrl_v1_controller, 100);
+  messageDesc(file_hdlctrl_v1_controller, 145);
 
 /**
  * セッションのユーザー数が閾値を満たした際に発火するトリガー.
@@ -2663,7 +4294,7 @@ export type SessionUserCountTrigger = Message<"hdlctrl.v1.SessionUserCountTrigge
  * Use `create(SessionUserCountTriggerSchema)` to create a new message.
  */
 export const SessionUserCountTriggerSchema: GenMessage<SessionUserCountTrigger> = /*@__PURE__*/
-  messageDesc(file_hdlctrl_v1_controller, 101);
+  messageDesc(file_hdlctrl_v1_controller, 146);
 
 /**
  * @generated from enum hdlctrl.v1.SessionUserCountTrigger.Comparator
@@ -2675,25 +4306,54 @@ export enum SessionUserCountTrigger_Comparator {
   UNSPECIFIED = 0,
 
   /**
-   * users_count <= threshold で発火
+   * users_count <= threshold で発火
+   *
+   * @generated from enum value: COMPARATOR_LESS_OR_EQUAL = 1;
+   */
+  LESS_OR_EQUAL = 1,
+
+  /**
+   * users_count >= threshold で発火
+   *
+   * @generated from enum value: COMPARATOR_GREATER_OR_EQUAL = 2;
+   */
+  GREATER_OR_EQUAL = 2,
+}
+
+/**
+ * Describes the enum hdlctrl.v1.SessionUserCountTrigger.Comparator.
+ */
+export const SessionUserCountTrigger_ComparatorSchema: GenEnum<SessionUserCountTrigger_Comparator> = /*@__PURE__*/
+  enumDesc(file_hdlctrl_v1_controller, 146, 0);
+
+/**
+ * cron 式 (5 フィールド) で繰り返し発火するトリガー.
+ * 実行後は SUCCEEDED / FAILED にならず、次回の発火時刻で PENDING に戻る.
+ *
+ * @generated from message hdlctrl.v1.CronTrigger
+ */
+export type CronTrigger = Message<"hdlctrl.v1.CronTrigger"> & {
+  /**
+   * 例: "0 21 * * FRI", "@daily"
    *
-   * @generated from enum value: COMPARATOR_LESS_OR_EQUAL = 1;
+   * @generated from field: string expression = 1;
    */
-  LESS_OR_EQUAL = 1,
+  expression: string;
 
   /**
-   * users_count >= threshold で発火
+   * IANA のタイムゾーン名 (例: "Asia/Tokyo"). 空なら UTC
    *
-   * @generated from enum value: COMPARATOR_GREATER_OR_EQUAL = 2;
+   * @generated from field: string timezone = 2;
    */
-  GREATER_OR_EQUAL = 2,
-}
+  timezone: string;
+};
 
 /**
- * Describes the enum hdlctrl.v1.SessionUserCountTrigger.Comparator.
+ * Describes the message hdlctrl.v1.CronTrigger.
+ * Use `create(CronTriggerSchema)` to create a new message.
  */
-export const SessionUserCountTrigger_ComparatorSchema: GenEnum<SessionUserCountTrigger_Comparator> = /*@__PURE__*/
-  enumDesc(file_hdlctrl_v1_controller, 101, 0);
+export const CronTriggerSchema: GenMessage<CronTrigger> = /*@__PURE__*/
+  messageDesc(file_hdlctrl_v1_controller, 147);
 
 /**
  * @generated from message hdlctrl.v1.ScheduledSessionOperation
@@ -2765,7 +4425,7 @@ export type ScheduledSessionOperation = Message<"hdlctrl.v1.ScheduledSessionOper
  * Use `create(ScheduledSessionOperationSchema)` to create a new message.
  */
 export const ScheduledSessionOperationSchema: GenMessage<ScheduledSessionOperation> = /*@__PURE__*/
-  messageDesc(file_hdlctrl_v1_controller, 102);
+  messageDesc(file_hdlctrl_v1_controller, 148);
 
 /**
  * @generated from message hdlctrl.v1.CreateScheduledSessionOperationRequest
@@ -2787,7 +4447,7 @@ export type CreateScheduledSessionOperationRequest = Message<"hdlctrl.v1.CreateS
  * Use `create(CreateScheduledSessionOperationRequestSchema)` to create a new message.
  */
 export const CreateScheduledSessionOperationRequestSchema: GenMessage<CreateScheduledSessionOperationRequest> = /*@__PURE__*/
-  messageDesc(file_hdlctrl_v1_controller, 103);
+  messageDesc(file_hdlctrl_v1_controller, 149);
 
 /**
  * @generated from message hdlctrl.v1.CreateScheduledSessionOperationResponse
@@ -2804,7 +4464,7 @@ export type CreateScheduledSessionOperationResponse = Message<"hdlctrl.v1.Create
  * Use `create(CreateScheduledSessionOperationResponseSchema)` to create a new message.
  */
 export const CreateScheduledSessionOperationResponseSchema: GenMessage<CreateScheduledSessionOperationResponse> = /*@__PURE__*/
-  messageDesc(file_hdlctrl_v1_controller, 104);
+  messageDesc(file_hdlctrl_v1_controller, 150);
 
 /**
  * @generated from message hdlctrl.v1.ListScheduledSessionOperationsRequest
@@ -2845,7 +4505,7 @@ export type ListScheduledSessionOperationsRequest = Message<"hdlctrl.v1.ListSche
  * Use `create(ListScheduledSessionOperationsRequestSchema)` to create a new message.
  */
 export const ListScheduledSessionOperationsRequestSchema: GenMessage<ListScheduledSessionOperationsRequest> = /*@__PURE__*/
-  messageDesc(file_hdlctrl_v1_controller, 105);
+  messageDesc(file_hdlctrl_v1_controller, 151);
 
 /**
  * @generated from message hdlctrl.v1.ListScheduledSessionOperationsResponse
@@ -2854,50 +4514,413 @@ export type ListScheduledSessionOperationsResponse = Message<"hdlctrl.v1.ListSch
   /**
    * @generated from field: repeated hdlctrl.v1.ScheduledSessionOperation scheduled_operations = 1;
    */
-  scheduledOperations: ScheduledSessionOperation[];
+  scheduledOperations: ScheduledSessionOperation[];
+
+  /**
+   * @generated from field: hdlctrl.v1.PageResponse page = 2;
+   */
+  page?: PageResponse;
+};
+
+/**
+ * Describes the message hdlctrl.v1.ListScheduledSessionOperationsResponse.
+ * Use `create(ListScheduledSessionOperationsResponseSchema)` to create a new message.
+ */
+export const ListScheduledSessionOperationsResponseSchema: GenMessage<ListScheduledSessionOperationsResponse> = /*@__PURE__*/
+  messageDesc(file_hdlctrl_v1_controller, 152);
+
+/**
+ * @generated from message hdlctrl.v1.CancelScheduledSessionOperationRequest
+ */
+export type CancelScheduledSessionOperationRequest = Message<"hdlctrl.v1.CancelScheduledSessionOperationRequest"> & {
+  /**
+   * @generated from field: string id = 1;
+   */
+  id: string;
+};
+
+/**
+ * Describes the message hdlctrl.v1.CancelScheduledSessionOperationRequest.
+ * Use `create(CancelScheduledSessionOperationRequestSchema)` to create a new message.
+ */
+export const CancelScheduledSessionOperationRequestSchema: GenMessage<CancelScheduledSessionOperationRequest> = /*@__PURE__*/
+  messageDesc(file_hdlctrl_v1_controller, 153);
+
+/**
+ * @generated from message hdlctrl.v1.CancelScheduledSessionOperationResponse
+ */
+export type CancelScheduledSessionOperationResponse = Message<"hdlctrl.v1.CancelScheduledSessionOperationResponse"> & {
+};
+
+/**
+ * Describes the message hdlctrl.v1.CancelScheduledSessionOperationResponse.
+ * Use `create(CancelScheduledSessionOperationResponseSchema)` to create a new message.
+ */
+export const CancelScheduledSessionOperationResponseSchema: GenMessage<CancelScheduledSessionOperationResponse> = /*@__PURE__*/
+  messageDesc(file_hdlctrl_v1_controller, 154);
+
+/**
+ * @generated from message hdlctrl.v1.PauseScheduledSessionOperationRequest
+ */
+export type PauseScheduledSessionOperationRequest = Message<"hdlctrl.v1.PauseScheduledSessionOperationRequest"> & {
+  /**
+   * @generated from field: string id = 1;
+   */
+  id: string;
+};
+
+/**
+ * Describes the message hdlctrl.v1.PauseScheduledSessionOperationRequest.
+ * Use `create(PauseScheduledSessionOperationRequestSchema)` to create a new message.
+ */
+export const PauseScheduledSessionOperationRequestSchema: GenMessage<PauseScheduledSessionOperationRequest> = /*@__PURE__*/
+  messageDesc(file_hdlctrl_v1_controller, 155);
+
+/**
+ * @generated from message hdlctrl.v1.PauseScheduledSessionOperationResponse
+ */
+export type PauseScheduledSessionOperationResponse = Message<"hdlctrl.v1.PauseScheduledSessionOperationResponse"> & {
+  /**
+   * @generated from field: hdlctrl.v1.ScheduledSessionOperation scheduled_operation = 1;
+   */
+  scheduledOperation?: ScheduledSessionOperation;
+};
+
+/**
+ * Describes the message hdlctrl.v1.PauseScheduledSessionOperationResponse.
+ * Use `create(PauseScheduledSessionOperationResponseSchema)` to create a new message.
+ */
+export const PauseScheduledSessionOperationResponseSchema: GenMessage<PauseScheduledSessionOperationResponse> = /*@__PURE__*/
+  messageDesc(file_hdlctrl_v1_controller, 156);
+
+/**
+ * @generated from message hdlctrl.v1.ResumeScheduledSessionOperationRequest
+ */
+export type ResumeScheduledSessionOperationRequest = Message<"hdlctrl.v1.ResumeScheduledSessionOperationRequest"> & {
+  /**
+   * @generated from field: string id = 1;
+   */
+  id: string;
+};
+
+/**
+ * Describes the message hdlctrl.v1.ResumeScheduledSessionOperationRequest.
+ * Use `create(ResumeScheduledSessionOperationRequestSchema)` to create a new message.
+ */
+export const ResumeScheduledSessionOperationRequestSchema: GenMessage<ResumeScheduledSessionOperationRequest> = /*@__PURE__*/
+  messageDesc(file_hdlctrl_v1_controller, 157);
+
+/**
+ * @generated from message hdlctrl.v1.ResumeScheduledSessionOperationResponse
+ */
+export type ResumeScheduledSessionOperationResponse = Message<"hdlctrl.v1.ResumeScheduledSessionOperationResponse"> & {
+  /**
+   * @generated from field: hdlctrl.v1.ScheduledSessionOperation scheduled_operation = 1;
+   */
+  scheduledOperation?: ScheduledSessionOperation;
+};
+
+/**
+ * Describes the message hdlctrl.v1.ResumeScheduledSessionOperationResponse.
+ * Use `create(ResumeScheduledSessionOperationResponseSchema)` to create a new message.
+ */
+export const ResumeScheduledSessionOperationResponseSchema: GenMessage<ResumeScheduledSessionOperationResponse> = /*@__PURE__*/
+  messageDesc(file_hdlctrl_v1_controller, 158);
+
+/**
+ * @generated from message hdlctrl.v1.SkipScheduledSessionOperationOccurrenceRequest
+ */
+export type SkipScheduledSessionOperationOccurrenceRequest = Message<"hdlctrl.v1.SkipScheduledSessionOperationOccurrenceRequest"> & {
+  /**
+   * @generated from field: string id = 1;
+   */
+  id: string;
+};
+
+/**
+ * Describes the message hdlctrl.v1.SkipScheduledSessionOperationOccurrenceRequest.
+ * Use `create(SkipScheduledSessionOperationOccurrenceRequestSchema)` to create a new message.
+ */
+export const SkipScheduledSessionOperationOccurrenceRequestSchema: GenMessage<SkipScheduledSessionOperationOccurrenceRequest> = /*@__PURE__*/
+  messageDesc(file_hdlctrl_v1_controller, 159);
+
+/**
+ * @generated from message hdlctrl.v1.SkipScheduledSessionOperationOccurrenceResponse
+ */
+export type SkipScheduledSessionOperationOccurrenceResponse = Message<"hdlctrl.v1.SkipScheduledSessionOperationOccurrenceResponse"> & {
+  /**
+   * @generated from field: hdlctrl.v1.ScheduledSessionOperation scheduled_operation = 1;
+   */
+  scheduledOperation?: ScheduledSessionOperation;
+};
+
+/**
+ * Describes the message hdlctrl.v1.SkipScheduledSessionOperationOccurrenceResponse.
+ * Use `create(SkipScheduledSessionOperationOccurrenceResponseSchema)` to create a new message.
+ */
+export const SkipScheduledSessionOperationOccurrenceResponseSchema: GenMessage<SkipScheduledSessionOperationOccurrenceResponse> = /*@__PURE__*/
+  messageDesc(file_hdlctrl_v1_controller, 160);
+
+/**
+ * 予約操作の 1 回分の実行履歴.
+ *
+ * @generated from message hdlctrl.v1.ScheduledOperationRun
+ */
+export type ScheduledOperationRun = Message<"hdlctrl.v1.ScheduledOperationRun"> & {
+  /**
+   * @generated from field: int64 id = 1;
+   */
+  id: bigint;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp scheduled_for = 2;
+   */
+  scheduledFor?: Timestamp;
+
+  /**
+   * SKIPPED / MISSED では未設定
+   *
+   * @generated from field: optional google.protobuf.Timestamp started_at = 3;
+   */
+  startedAt?: Timestamp;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp finished_at = 4;
+   */
+  finishedAt?: Timestamp;
+
+  /**
+   * @generated from field: hdlctrl.v1.ScheduledOperationRunOutcome outcome = 5;
+   */
+  outcome: ScheduledOperationRunOutcome;
+
+  /**
+   * @generated from field: optional string error = 6;
+   */
+  error?: string;
+
+  /**
+   * @generated from field: optional string instance_id = 7;
+   */
+  instanceId?: string;
+};
+
+/**
+ * Describes the message hdlctrl.v1.ScheduledOperationRun.
+ * Use `create(ScheduledOperationRunSchema)` to create a new message.
+ */
+export const ScheduledOperationRunSchema: GenMessage<ScheduledOperationRun> = /*@__PURE__*/
+  messageDesc(file_hdlctrl_v1_controller, 161);
+
+/**
+ * @generated from message hdlctrl.v1.ListScheduledSessionOperationRunsRequest
+ */
+export type ListScheduledSessionOperationRunsRequest = Message<"hdlctrl.v1.ListScheduledSessionOperationRunsRequest"> & {
+  /**
+   * @generated from field: string id = 1;
+   */
+  id: string;
+
+  /**
+   * @generated from field: hdlctrl.v1.PageRequest page = 2;
+   */
+  page?: PageRequest;
+};
+
+/**
+ * Describes the message hdlctrl.v1.ListScheduledSessionOperationRunsRequest.
+ * Use `create(ListScheduledSessionOperationRunsRequestSchema)` to create a new message.
+ */
+export const ListScheduledSessionOperationRunsRequestSchema: GenMessage<ListScheduledSessionOperationRunsRequest> = /*@__PURE__*/
+  messageDesc(file_hdlctrl_v1_controller, 162);
+
+/**
+ * @generated from message hdlctrl.v1.ListScheduledSessionOperationRunsResponse
+ */
+export type ListScheduledSessionOperationRunsResponse = Message<"hdlctrl.v1.ListScheduledSessionOperationRunsResponse"> & {
+  /**
+   * @generated from field: repeated hdlctrl.v1.ScheduledOperationRun runs = 1;
+   */
+  runs: ScheduledOperationRun[];
+
+  /**
+   * @generated from field: hdlctrl.v1.PageResponse page = 2;
+   */
+  page?: PageResponse;
+};
+
+/**
+ * Describes the message hdlctrl.v1.ListScheduledSessionOperationRunsResponse.
+ * Use `create(ListScheduledSessionOperationRunsResponseSchema)` to create a new message.
+ */
+export const ListScheduledSessionOperationRunsResponseSchema: GenMessage<ListScheduledSessionOperationRunsResponse> = /*@__PURE__*/
+  messageDesc(file_hdlctrl_v1_controller, 163);
+
+/**
+ * @generated from enum hdlctrl.v1.SessionUserEventKind
+ */
+export enum SessionUserEventKind {
+  /**
+   * @generated from enum value: SESSION_USER_EVENT_KIND_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * @generated from enum value: SESSION_USER_EVENT_KIND_JOINED = 1;
+   */
+  JOINED = 1,
+
+  /**
+   * @generated from enum value: SESSION_USER_EVENT_KIND_LEFT = 2;
+   */
+  LEFT = 2,
+}
+
+/**
+ * Describes the enum hdlctrl.v1.SessionUserEventKind.
+ */
+export const SessionUserEventKindSchema: GenEnum<SessionUserEventKind> = /*@__PURE__*/
+  enumDesc(file_hdlctrl_v1_controller, 0);
+
+/**
+ * @generated from enum hdlctrl.v1.SessionUsageGroupBy
+ */
+export enum SessionUsageGroupBy {
+  /**
+   * @generated from enum value: SESSION_USAGE_GROUP_BY_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * ワールド (URL またはプリセット) ごと
+   *
+   * @generated from enum value: SESSION_USAGE_GROUP_BY_WORLD = 1;
+   */
+  WORLD = 1,
+
+  /**
+   * @generated from enum value: SESSION_USAGE_GROUP_BY_HOST = 2;
+   */
+  HOST = 2,
+}
+
+/**
+ * Describes the enum hdlctrl.v1.SessionUsageGroupBy.
+ */
+export const SessionUsageGroupBySchema: GenEnum<SessionUsageGroupBy> = /*@__PURE__*/
+  enumDesc(file_hdlctrl_v1_controller, 1);
+
+/**
+ * @generated from enum hdlctrl.v1.SessionUsageInterval
+ */
+export enum SessionUsageInterval {
+  /**
+   * @generated from enum value: SESSION_USAGE_INTERVAL_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * @generated from enum value: SESSION_USAGE_INTERVAL_HOUR = 1;
+   */
+  HOUR = 1,
+
+  /**
+   * @generated from enum value: SESSION_USAGE_INTERVAL_DAY = 2;
+   */
+  DAY = 2,
+
+  /**
+   * 月曜始まり
+   *
+   * @generated from enum value: SESSION_USAGE_INTERVAL_WEEK = 3;
+   */
+  WEEK = 3,
+}
+
+/**
+ * Describes the enum hdlctrl.v1.SessionUsageInterval.
+ */
+export const SessionUsageIntervalSchema: GenEnum<SessionUsageInterval> = /*@__PURE__*/
+  enumDesc(file_hdlctrl_v1_controller, 2);
+
+/**
+ * @generated from enum hdlctrl.v1.MetricKind
+ */
+export enum MetricKind {
+  /**
+   * @generated from enum value: METRIC_KIND_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * target_id は host id. RUNNING の間だけ記録される
+   *
+   * @generated from enum value: METRIC_KIND_HOST_FPS = 1;
+   */
+  HOST_FPS = 1,
+
+  /**
+   * target_id は host id. RUNNING なら 1, それ以外なら 0 で、集計後の平均は稼働していた割合
+   *
+   * @generated from enum value: METRIC_KIND_HOST_RUNNING = 2;
+   */
+  HOST_RUNNING = 2,
+
+  /**
+   * target_id は host id. ホスト上の全セッションのユーザー数の合計
+   *
+   * @generated from enum value: METRIC_KIND_HOST_USERS = 3;
+   */
+  HOST_USERS = 3,
 
   /**
-   * @generated from field: hdlctrl.v1.PageResponse page = 2;
+   * target_id は session id
+   *
+   * @generated from enum value: METRIC_KIND_SESSION_USERS = 4;
    */
-  page?: PageResponse;
-};
+  SESSION_USERS = 4,
+}
 
 /**
- * Describes the message hdlctrl.v1.ListScheduledSessionOperationsResponse.
- * Use `create(ListScheduledSessionOperationsResponseSchema)` to create a new message.
+ * Describes the enum hdlctrl.v1.MetricKind.
  */
-export const ListScheduledSessionOperationsResponseSchema: GenMessage<ListScheduledSessionOperationsResponse> = /*@__PURE__*/
-  messageDesc(file_hdlctrl_v1_controller, 106);
+export const MetricKindSchema: GenEnum<MetricKind> = /*@__PURE__*/
+  enumDesc(file_hdlctrl_v1_controller, 3);
 
 /**
- * @generated from message hdlctrl.v1.CancelScheduledSessionOperationRequest
+ * @generated from enum hdlctrl.v1.MetricResolution
  */
-export type CancelScheduledSessionOperationRequest = Message<"hdlctrl.v1.CancelScheduledSessionOperationRequest"> & {
+export enum MetricResolution {
   /**
-   * @generated from field: string id = 1;
+   * リクエストでは範囲に合わせて自動で選ぶ
+   *
+   * @generated from enum value: METRIC_RESOLUTION_UNSPECIFIED = 0;
    */
-  id: string;
-};
+  UNSPECIFIED = 0,
 
-/**
- * Describes the message hdlctrl.v1.CancelScheduledSessionOperationRequest.
- * Use `create(CancelScheduledSessionOperationRequestSchema)` to create a new message.
- */
-export const CancelScheduledSessionOperationRequestSchema: GenMessage<CancelScheduledSessionOperationRequest> = /*@__PURE__*/
-  messageDesc(file_hdlctrl_v1_controller, 107);
+  /**
+   * 記録したサンプルそのまま
+   *
+   * @generated from enum value: METRIC_RESOLUTION_RAW = 1;
+   */
+  RAW = 1,
 
-/**
- * @generated from message hdlctrl.v1.CancelScheduledSessionOperationResponse
- */
-export type CancelScheduledSessionOperationResponse = Message<"hdlctrl.v1.CancelScheduledSessionOperationResponse"> & {
-};
+  /**
+   * @generated from enum value: METRIC_RESOLUTION_MINUTE = 2;
+   */
+  MINUTE = 2,
+
+  /**
+   * @generated from enum value: METRIC_RESOLUTION_HOUR = 3;
+   */
+  HOUR = 3,
+}
 
 /**
- * Describes the message hdlctrl.v1.CancelScheduledSessionOperationResponse.
- * Use `create(CancelScheduledSessionOperationResponseSchema)` to create a new message.
+ * Describes the enum hdlctrl.v1.MetricResolution.
  */
-export const CancelScheduledSessionOperationResponseSchema: GenMessage<CancelScheduledSessionOperationResponse> = /*@__PURE__*/
-  messageDesc(file_hdlctrl_v1_controller, 108);
+export const MetricResolutionSchema: GenEnum<MetricResolution> = /*@__PURE__*/
+  enumDesc(file_hdlctrl_v1_controller, 4);
 
 /**
  * @generated from enum hdlctrl.v1.HeadlessHostStatus
@@ -2938,7 +4961,71 @@ export enum HeadlessHostStatus {
  * Describes the enum hdlctrl.v1.HeadlessHostStatus.
  */
 export const HeadlessHostStatusSchema: GenEnum<HeadlessHostStatus> = /*@__PURE__*/
-  enumDesc(file_hdlctrl_v1_controller, 0);
+  enumDesc(file_hdlctrl_v1_controller, 5);
+
+/**
+ * ホストのログのレベル. container_logs はレベルを持たないため、stderr への出力や
+ * 本文の "ERROR" / "Exception" / "WARNING" などから推定する
+ *
+ * @generated from enum hdlctrl.v1.HeadlessHostLogLevel
+ */
+export enum HeadlessHostLogLevel {
+  /**
+   * @generated from enum value: HEADLESS_HOST_LOG_LEVEL_UNKNOWN = 0;
+   */
+  UNKNOWN = 0,
+
+  /**
+   * @generated from enum value: HEADLESS_HOST_LOG_LEVEL_INFO = 1;
+   */
+  INFO = 1,
+
+  /**
+   * @generated from enum value: HEADLESS_HOST_LOG_LEVEL_WARNING = 2;
+   */
+  WARNING = 2,
+
+  /**
+   * @generated from enum value: HEADLESS_HOST_LOG_LEVEL_ERROR = 3;
+   */
+  ERROR = 3,
+}
+
+/**
+ * Describes the enum hdlctrl.v1.HeadlessHostLogLevel.
+ */
+export const HeadlessHostLogLevelSchema: GenEnum<HeadlessHostLogLevel> = /*@__PURE__*/
+  enumDesc(file_hdlctrl_v1_controller, 6);
+
+/**
+ * @generated from enum hdlctrl.v1.HeadlessHostLogExportFormat
+ */
+export enum HeadlessHostLogExportFormat {
+  /**
+   * @generated from enum value: HEADLESS_HOST_LOG_EXPORT_FORMAT_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * 1 行に 1 ログ: "<RFC 3339 の時刻> [stdout|stderr] <本文>"
+   *
+   * @generated from enum value: HEADLESS_HOST_LOG_EXPORT_FORMAT_TEXT = 1;
+   */
+  TEXT = 1,
+
+  /**
+   * 1 行に 1 ログの JSON: {"id", "timestamp", "stream", "level", "log"}
+   *
+   * @generated from enum value: HEADLESS_HOST_LOG_EXPORT_FORMAT_NDJSON = 2;
+   */
+  NDJSON = 2,
+}
+
+/**
+ * Describes the enum hdlctrl.v1.HeadlessHostLogExportFormat.
+ */
+export const HeadlessHostLogExportFormatSchema: GenEnum<HeadlessHostLogExportFormat> = /*@__PURE__*/
+  enumDesc(file_hdlctrl_v1_controller, 7);
 
 /**
  * @generated from enum hdlctrl.v1.SessionStatus
@@ -2974,7 +5061,7 @@ export enum SessionStatus {
  * Describes the enum hdlctrl.v1.SessionStatus.
  */
 export const SessionStatusSchema: GenEnum<SessionStatus> = /*@__PURE__*/
-  enumDesc(file_hdlctrl_v1_controller, 1);
+  enumDesc(file_hdlctrl_v1_controller, 8);
 
 /**
  * @generated from enum hdlctrl.v1.HeadlessHostAutoUpdatePolicy
@@ -3004,7 +5091,87 @@ export enum HeadlessHostAutoUpdatePolicy {
  * Describes the enum hdlctrl.v1.HeadlessHostAutoUpdatePolicy.
  */
 export const HeadlessHostAutoUpdatePolicySchema: GenEnum<HeadlessHostAutoUpdatePolicy> = /*@__PURE__*/
-  enumDesc(file_hdlctrl_v1_controller, 2);
+  enumDesc(file_hdlctrl_v1_controller, 9);
+
+/**
+ * コントローラによるクラッシュ時の自動再起動. コンテナランタイムの再起動 (HeadlessHostRestartPolicy) と違い、
+ * ワールドを復元しつつ HeadlessHostRestart と同じ手順で再起動する.
+ *
+ * @generated from enum hdlctrl.v1.HeadlessHostAutoRestartPolicy
+ */
+export enum HeadlessHostAutoRestartPolicy {
+  /**
+   * 未指定 (NEVER と同じ)
+   *
+   * @generated from enum value: HEADLESS_HOST_AUTO_RESTART_POLICY_UNKNOWN = 0;
+   */
+  UNKNOWN = 0,
+
+  /**
+   * @generated from enum value: HEADLESS_HOST_AUTO_RESTART_POLICY_NEVER = 1;
+   */
+  NEVER = 1,
+
+  /**
+   * 異常終了時のみ再起動する. 連続回数は auto_restart_max_retries で制限
+   *
+   * @generated from enum value: HEADLESS_HOST_AUTO_RESTART_POLICY_ON_CRASH = 2;
+   */
+  ON_CRASH = 2,
+
+  /**
+   * 停止操作を経ずに終了した場合も再起動する
+   *
+   * @generated from enum value: HEADLESS_HOST_AUTO_RESTART_POLICY_ALWAYS = 3;
+   */
+  ALWAYS = 3,
+}
+
+/**
+ * Describes the enum hdlctrl.v1.HeadlessHostAutoRestartPolicy.
+ */
+export const HeadlessHostAutoRestartPolicySchema: GenEnum<HeadlessHostAutoRestartPolicy> = /*@__PURE__*/
+  enumDesc(file_hdlctrl_v1_controller, 10);
+
+/**
+ * @generated from enum hdlctrl.v1.HeadlessHostRestartPolicy
+ */
+export enum HeadlessHostRestartPolicy {
+  /**
+   * 未指定 (NO と同じ)
+   *
+   * @generated from enum value: HEADLESS_HOST_RESTART_POLICY_UNKNOWN = 0;
+   */
+  UNKNOWN = 0,
+
+  /**
+   * @generated from enum value: HEADLESS_HOST_RESTART_POLICY_NO = 1;
+   */
+  NO = 1,
+
+  /**
+   * 異常終了時のみ再起動する. 回数は restart_max_retries で制限
+   *
+   * @generated from enum value: HEADLESS_HOST_RESTART_POLICY_ON_FAILURE = 2;
+   */
+  ON_FAILURE = 2,
+
+  /**
+   * @generated from enum value: HEADLESS_HOST_RESTART_POLICY_ALWAYS = 3;
+   */
+  ALWAYS = 3,
+
+  /**
+   * @generated from enum value: HEADLESS_HOST_RESTART_POLICY_UNLESS_STOPPED = 4;
+   */
+  UNLESS_STOPPED = 4,
+}
+
+/**
+ * Describes the enum hdlctrl.v1.HeadlessHostRestartPolicy.
+ */
+export const HeadlessHostRestartPolicySchema: GenEnum<HeadlessHostRestartPolicy> = /*@__PURE__*/
+  enumDesc(file_hdlctrl_v1_controller, 11);
 
 /**
  * @generated from enum hdlctrl.v1.ScheduledOperationStatus
@@ -3039,13 +5206,58 @@ export enum ScheduledOperationStatus {
    * @generated from enum value: SCHEDULED_OPERATION_STATUS_CANCELED = 5;
    */
   CANCELED = 5,
+
+  /**
+   * @generated from enum value: SCHEDULED_OPERATION_STATUS_PAUSED = 6;
+   */
+  PAUSED = 6,
 }
 
 /**
  * Describes the enum hdlctrl.v1.ScheduledOperationStatus.
  */
 export const ScheduledOperationStatusSchema: GenEnum<ScheduledOperationStatus> = /*@__PURE__*/
-  enumDesc(file_hdlctrl_v1_controller, 3);
+  enumDesc(file_hdlctrl_v1_controller, 12);
+
+/**
+ * @generated from enum hdlctrl.v1.ScheduledOperationRunOutcome
+ */
+export enum ScheduledOperationRunOutcome {
+  /**
+   * @generated from enum value: SCHEDULED_OPERATION_RUN_OUTCOME_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * @generated from enum value: SCHEDULED_OPERATION_RUN_OUTCOME_SUCCEEDED = 1;
+   */
+  SUCCEEDED = 1,
+
+  /**
+   * @generated from enum value: SCHEDULED_OPERATION_RUN_OUTCOME_FAILED = 2;
+   */
+  FAILED = 2,
+
+  /**
+   * 利用者がスキップした
+   *
+   * @generated from enum value: SCHEDULED_OPERATION_RUN_OUTCOME_SKIPPED = 3;
+   */
+  SKIPPED = 3,
+
+  /**
+   * controller 停止中などで発火時刻を大きく過ぎたため実行しなかった
+   *
+   * @generated from enum value: SCHEDULED_OPERATION_RUN_OUTCOME_MISSED = 4;
+   */
+  MISSED = 4,
+}
+
+/**
+ * Describes the enum hdlctrl.v1.ScheduledOperationRunOutcome.
+ */
+export const ScheduledOperationRunOutcomeSchema: GenEnum<ScheduledOperationRunOutcome> = /*@__PURE__*/
+  enumDesc(file_hdlctrl_v1_controller, 13);
 
 /**
  * @generated from service hdlctrl.v1.ControllerService
@@ -3077,6 +5289,36 @@ export const ControllerService: GenService<{
     input: typeof GetHeadlessHostLogsRequestSchema;
     output: typeof GetHeadlessHostLogsResponseSchema;
   },
+  /**
+   * ホストのログを直近のバックログから送り、以降は追記され次第 push する
+   *
+   * @generated from rpc hdlctrl.v1.ControllerService.TailHeadlessHostLogs
+   */
+  tailHeadlessHostLogs: {
+    methodKind: "server_streaming";
+    input: typeof TailHeadlessHostLogsRequestSchema;
+    output: typeof TailHeadlessHostLogsResponseSchema;
+  },
+  /**
+   * 閲覧できる全ホスト・全インスタンスのログを本文で検索する
+   *
+   * @generated from rpc hdlctrl.v1.ControllerService.SearchHeadlessHostLogs
+   */
+  searchHeadlessHostLogs: {
+    methodKind: "unary";
+    input: typeof SearchHeadlessHostLogsRequestSchema;
+    output: typeof SearchHeadlessHostLogsResponseSchema;
+  },
+  /**
+   * インスタンスのログ全体 (または期間内) をファイルにして、ダウンロード用の URL を返す
+   *
+   * @generated from rpc hdlctrl.v1.ControllerService.PrepareHeadlessHostLogDownload
+   */
+  prepareHeadlessHostLogDownload: {
+    methodKind: "unary";
+    input: typeof PrepareHeadlessHostLogDownloadRequestSchema;
+    output: typeof PrepareHeadlessHostLogDownloadResponseSchema;
+  },
   /**
    * @generated from rpc hdlctrl.v1.ControllerService.ShutdownHeadlessHost
    */
@@ -3157,6 +5399,58 @@ export const ControllerService: GenService<{
     input: typeof ListHeadlessHostInstancesRequestSchema;
     output: typeof ListHeadlessHostInstancesResponseSchema;
   },
+  /**
+   * 既存ホストの設定をコピーして新しいホストを起動する
+   *
+   * @generated from rpc hdlctrl.v1.ControllerService.CloneHeadlessHost
+   */
+  cloneHeadlessHost: {
+    methodKind: "unary";
+    input: typeof CloneHeadlessHostRequestSchema;
+    output: typeof CloneHeadlessHostResponseSchema;
+  },
+  /**
+   * ホストテンプレート系
+   *
+   * @generated from rpc hdlctrl.v1.ControllerService.ListHostTemplates
+   */
+  listHostTemplates: {
+    methodKind: "unary";
+    input: typeof ListHostTemplatesRequestSchema;
+    output: typeof ListHostTemplatesResponseSchema;
+  },
+  /**
+   * @generated from rpc hdlctrl.v1.ControllerService.GetHostTemplate
+   */
+  getHostTemplate: {
+    methodKind: "unary";
+    input: typeof GetHostTemplateRequestSchema;
+    output: typeof GetHostTemplateResponseSchema;
+  },
+  /**
+   * @generated from rpc hdlctrl.v1.ControllerService.CreateHostTemplate
+   */
+  createHostTemplate: {
+    methodKind: "unary";
+    input: typeof CreateHostTemplateRequestSchema;
+    output: typeof CreateHostTemplateResponseSchema;
+  },
+  /**
+   * @generated from rpc hdlctrl.v1.ControllerService.UpdateHostTemplate
+   */
+  updateHostTemplate: {
+    methodKind: "unary";
+    input: typeof UpdateHostTemplateRequestSchema;
+    output: typeof UpdateHostTemplateResponseSchema;
+  },
+  /**
+   * @generated from rpc hdlctrl.v1.ControllerService.DeleteHostTemplate
+   */
+  deleteHostTemplate: {
+    methodKind: "unary";
+    input: typeof DeleteHostTemplateRequestSchema;
+    output: typeof DeleteHostTemplateResponseSchema;
+  },
   /**
    * アカウント系
    *
@@ -3421,6 +5715,85 @@ export const ControllerService: GenService<{
     input: typeof IssueResoniteLinkConnectionRequestSchema;
     output: typeof IssueResoniteLinkConnectionResponseSchema;
   },
+  /**
+   * セッションへのユーザーの出入りの履歴と、そこから集計した出席・利用状況
+   *
+   * @generated from rpc hdlctrl.v1.ControllerService.ListSessionUserEvents
+   */
+  listSessionUserEvents: {
+    methodKind: "unary";
+    input: typeof ListSessionUserEventsRequestSchema;
+    output: typeof ListSessionUserEventsResponseSchema;
+  },
+  /**
+   * @generated from rpc hdlctrl.v1.ControllerService.GetSessionAttendance
+   */
+  getSessionAttendance: {
+    methodKind: "unary";
+    input: typeof GetSessionAttendanceRequestSchema;
+    output: typeof GetSessionAttendanceResponseSchema;
+  },
+  /**
+   * @generated from rpc hdlctrl.v1.ControllerService.GetSessionUsageStats
+   */
+  getSessionUsageStats: {
+    methodKind: "unary";
+    input: typeof GetSessionUsageStatsRequestSchema;
+    output: typeof GetSessionUsageStatsResponseSchema;
+  },
+  /**
+   * メトリクス系
+   * ホスト / セッションのメトリクスの時系列 (ダッシュボードのグラフ用)
+   *
+   * @generated from rpc hdlctrl.v1.ControllerService.GetMetricsSeries
+   */
+  getMetricsSeries: {
+    methodKind: "unary";
+    input: typeof GetMetricsSeriesRequestSchema;
+    output: typeof GetMetricsSeriesResponseSchema;
+  },
+  /**
+   * セッションテンプレート系
+   *
+   * @generated from rpc hdlctrl.v1.ControllerService.ListSessionTemplates
+   */
+  listSessionTemplates: {
+    methodKind: "unary";
+    input: typeof ListSessionTemplatesRequestSchema;
+    output: typeof ListSessionTemplatesResponseSchema;
+  },
+  /**
+   * @generated from rpc hdlctrl.v1.ControllerService.GetSessionTemplate
+   */
+  getSessionTemplate: {
+    methodKind: "unary";
+    input: typeof GetSessionTemplateRequestSchema;
+    output: typeof GetSessionTemplateResponseSchema;
+  },
+  /**
+   * @generated from rpc hdlctrl.v1.ControllerService.CreateSessionTemplate
+   */
+  createSessionTemplate: {
+    methodKind: "unary";
+    input: typeof CreateSessionTemplateRequestSchema;
+    output: typeof CreateSessionTemplateResponseSchema;
+  },
+  /**
+   * @generated from rpc hdlctrl.v1.ControllerService.UpdateSessionTemplate
+   */
+  updateSessionTemplate: {
+    methodKind: "unary";
+    input: typeof UpdateSessionTemplateRequestSchema;
+    output: typeof UpdateSessionTemplateResponseSchema;
+  },
+  /**
+   * @generated from rpc hdlctrl.v1.ControllerService.DeleteSessionTemplate
+   */
+  deleteSessionTemplate: {
+    methodKind: "unary";
+    input: typeof DeleteSessionTemplateRequestSchema;
+    output: typeof DeleteSessionTemplateResponseSchema;
+  },
   /**
    * 予約操作系
    *
@@ -3447,6 +5820,40 @@ export const ControllerService: GenService<{
     input: typeof CancelScheduledSessionOperationRequestSchema;
     output: typeof CancelScheduledSessionOperationResponseSchema;
   },
+  /**
+   * @generated from rpc hdlctrl.v1.ControllerService.PauseScheduledSessionOperation
+   */
+  pauseScheduledSessionOperation: {
+    methodKind: "unary";
+    input: typeof PauseScheduledSessionOperationRequestSchema;
+    output: typeof PauseScheduledSessionOperationResponseSchema;
+  },
+  /**
+   * @generated from rpc hdlctrl.v1.ControllerService.ResumeScheduledSessionOperation
+   */
+  resumeScheduledSessionOperation: {
+    methodKind: "unary";
+    input: typeof ResumeScheduledSessionOperationRequestSchema;
+    output: typeof ResumeScheduledSessionOperationResponseSchema;
+  },
+  /**
+   * 繰り返し予約の次の 1 回を飛ばす
+   *
+   * @generated from rpc hdlctrl.v1.ControllerService.SkipScheduledSessionOperationOccurrence
+   */
+  skipScheduledSessionOperationOccurrence: {
+    methodKind: "unary";
+    input: typeof SkipScheduledSessionOperationOccurrenceRequestSchema;
+    output: typeof SkipScheduledSessionOperationOccurrenceResponseSchema;
+  },
+  /**
+   * @generated from rpc hdlctrl.v1.ControllerService.ListScheduledSessionOperationRuns
+   */
+  listScheduledSessionOperationRuns: {
+    methodKind: "unary";
+    input: typeof ListScheduledSessionOperationRunsRequestSchema;
+    output: typeof ListScheduledSessionOperationRunsResponseSchema;
+  },
 }> = /*@__PURE__*/
   serviceDesc(file_hdlctrl_v1_controller, 0);
 
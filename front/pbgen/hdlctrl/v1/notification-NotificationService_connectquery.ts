@@ -0,0 +1,17 @@
+// @generated by protoc-gen-connect-query v2.0.1 with parameter "target=ts"
+// @generated from file hdlctrl/v1/notification.proto (package hdlctrl.v1, syntax proto3)
+/* eslint-disable */
+
+import { NotificationService } from "./notification_pb";
+
+/**
+ * 通知 inbox. セッションの開始/終了・自動再起動・job 完了などを新しい順に返す.
+ *
+ * @generated from rpc hdlctrl.v1.NotificationService.ListNotifications
+ */
+export const listNotifications = NotificationService.method.listNotifications;
+
+/**
+ * @generated from rpc hdlctrl.v1.NotificationService.MarkNotificationsRead
+ */
+export const markNotificationsRead = NotificationService.method.markNotificationsRead;
@@ -6,18 +6,27 @@ import type { GenEnum, GenFile, GenMessage, GenService } from "@bufbuild/protobu
 import { enumDesc, fileDesc, messageDesc, serviceDesc } from "@bufbuild/protobuf/codegenv1";
 import type { Timestamp } from "@bufbuild/protobuf/wkt";
 import { file_google_protobuf_timestamp } from "@bufbuild/protobuf/wkt";
+import type { PageRequest, PageResponse } from "./controller_pb";
+import { file_hdlctrl_v1_controller } from "./controller_pb";
 import type { Message } from "@bufbuild/protobuf";
 
 /**
  * Describes the file hdlctrl/v1/notification.proto.
  */
 export const file_hdlctrl_v1_notification: GenFile = /*@__PURE__*/
-  fileDesc("Ch1oZGxjdHJsL3YxL25vdGlmaWNhdGlvbi5wcm90bxIKaGRsY3RybC52MSIfCh1TdWJzY3JpYmVOb3RpZmljYXRpb25zUmVxdWVzdCL2AwoRTm90aWZpY2F0aW9uRXZlbnQSCgoCaWQYASABKAkSLwoLb2NjdXJyZWRfYXQYAiABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wEisKCmtlZXBfYWxpdmUYAyABKAsyFS5oZGxjdHJsLnYxLktlZXBBbGl2ZUgAEjoKD3Nlc3Npb25fdXBkYXRlZBgKIAEoCzIfLmhkbGN0cmwudjEuU2Vzc2lvblVwZGF0ZWRFdmVudEgAEkMKFHNlc3Npb25fdXNlcl9jaGFuZ2VkGAsgASgLMiMuaGRsY3RybC52MS5TZXNzaW9uVXNlckNoYW5nZWRFdmVudEgAEj4KEXNlc3Npb25fbGlmZWN5Y2xlGAwgASgLMiEuaGRsY3RybC52MS5TZXNzaW9uTGlmZWN5Y2xlRXZlbnRIABI0Cgxob3N0X3VwZGF0ZWQYFCABKAsyHC5oZGxjdHJsLnYxLkhvc3RVcGRhdGVkRXZlbnRIABI9ChFob3N0X2xpc3RfY2hhbmdlZBgVIAEoCzIgLmhkbGN0cmwudjEuSG9zdExpc3RDaGFuZ2VkRXZlbnRIABI2Cg1qb2JfY29tcGxldGVkGFogASgLMh0uaGRsY3RybC52MS5Kb2JDb21wbGV0ZWRFdmVudEgAQgkKB3BheWxvYWQiCwoJS2VlcEFsaXZlIjoKE1Nlc3Npb25VcGRhdGVkRXZlbnQSEgoKc2Vzc2lvbl9pZBgBIAEoCRIPCgdob3N0X2lkGAIgASgJIscBChdTZXNzaW9uVXNlckNoYW5nZWRFdmVudBISCgpzZXNzaW9uX2lkGAEgASgJEg8KB2hvc3RfaWQYAiABKAkSNgoEa2luZBgDIAEoDjIoLmhkbGN0cmwudjEuU2Vzc2lvblVzZXJDaGFuZ2VkRXZlbnQuS2luZBIRCgl1c2VyX25hbWUYBCABKAkiPAoES2luZBIUChBLSU5EX1VOU1BFQ0lGSUVEEAASDwoLS0lORF9KT0lORUQQARINCglLSU5EX0xFRlQQAiKyAQoVU2Vzc2lvbkxpZmVjeWNsZUV2ZW50EhIKCnNlc3Npb25faWQYASABKAkSDwoHaG9zdF9pZBgCIAEoCRI0CgRraW5kGAMgASgOMiYuaGRsY3RybC52MS5TZXNzaW9uTGlmZWN5Y2xlRXZlbnQuS2luZCI+CgRLaW5kEhQKEEtJTkRfVU5TUEVDSUZJRUQQABIQCgxLSU5EX1NUQVJURUQQARIOCgpLSU5EX0VOREVEEAIiIwoQSG9zdFVwZGF0ZWRFdmVudBIPCgdob3N0X2lkGAEgASgJIhYKFEhvc3RMaXN0Q2hhbmdlZEV2ZW50IrwBChFKb2JDb21wbGV0ZWRFdmVudBIOCgZqb2JfaWQYASABKAkSMgoFbGV2ZWwYAiABKA4yIy5oZGxjdHJsLnYxLkpvYkNvbXBsZXRlZEV2ZW50LkxldmVsEg8KB21lc3NhZ2UYAyABKAkiUgoFTGV2ZWwSFQoRTEVWRUxfVU5TUEVDSUZJRUQQABIOCgpMRVZFTF9JTkZPEAESEQoNTEVWRUxfU1VDQ0VTUxACEg8KC0xFVkVMX0VSUk9SEAMyewoTTm90aWZpY2F0aW9uU2VydmljZRJkChZTdWJzY3JpYmVOb3RpZmljYXRpb25zEikuaGRsY3RybC52MS5TdWJzY3JpYmVOb3RpZmljYXRpb25zUmVxdWVzdBodLmhkbGN0cmwudjEuTm90aWZpY2F0aW9uRXZlbnQwAUK/AQoOY29tLmhkbGN0cmwudjFCEU5vdGlmaWNhdGlvblByb3RvUAFaUWdpdGh1Yi5jb20vaGFudGFiYXJ1MTAxNC9iYXJ1LXJlc28taGVhZGxlc3MtY29udHJvbGxlci9wYmdlbi9oZGxjdHJsL3YxO2hkbGN0cmx2MaICA0hYWKoCCkhkbGN0cmwuVjHKAgpIZGxjdHJsXFYx4gIWSGRsY3RybFxWMVxHUEJNZXRhZGF0YeoCC0hkbGN0cmw6OlYxYgZwcm90bzM", [file_google_protobuf_timestamp]);
+  fileDesc("Ch1oZGxjdHJsL3YxL25vdGlmaWNhdGlvbi5wcm90bxIKaGRsY3RybC52MSJNCh1TdWJzY3JpYmVOb3RpZmljYXRpb25zUmVxdWVzdBIaCg1sYXN0X2V2ZW50X2lkGAEgASgJSACIAQFCEAoOX2xhc3RfZXZlbnRfaWQi9QQKEU5vdGlmaWNhdGlvbkV2ZW50EgoKAmlkGAEgASgJEi8KC29jY3VycmVkX2F0GAIgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcBIrCgprZWVwX2FsaXZlGAMgASgLMhUuaGRsY3RybC52MS5LZWVwQWxpdmVIABI+ChFoaXN0b3J5X3RydW5jYXRlZBgEIAEoCzIhLmhkbGN0cmwudjEuSGlzdG9yeVRydW5jYXRlZEV2ZW50SAASOgoPc2Vzc2lvbl91cGRhdGVkGAogASgLMh8uaGRsY3RybC52MS5TZXNzaW9uVXBkYXRlZEV2ZW50SAASQwoUc2Vzc2lvbl91c2VyX2NoYW5nZWQYCyABKAsyIy5oZGxjdHJsLnYxLlNlc3Npb25Vc2VyQ2hhbmdlZEV2ZW50SAASPgoRc2Vzc2lvbl9saWZlY3ljbGUYDCABKAsyIS5oZGxjdHJsLnYxLlNlc3Npb25MaWZlY3ljbGVFdmVudEgAEjQKDGhvc3RfdXBkYXRlZBgUIAEoCzIcLmhkbGN0cmwudjEuSG9zdFVwZGF0ZWRFdmVudEgAEj0KEWhvc3RfbGlzdF9jaGFuZ2VkGBUgASgLMiAuaGRsY3RybC52MS5Ib3N0TGlzdENoYW5nZWRFdmVudEgAEj0KEWhvc3RfYXV0b19yZXN0YXJ0GBYgASgLMiAuaGRsY3RybC52MS5Ib3N0QXV0b1Jlc3RhcnRFdmVudEgAEjYKDWpvYl9jb21wbGV0ZWQYWiABKAsyHS5oZGxjdHJsLnYxLkpvYkNvbXBsZXRlZEV2ZW50SABCCQoHcGF5bG9hZCILCglLZWVwQWxpdmUiFwoVSGlzdG9yeVRydW5jYXRlZEV2ZW50IjoKE1Nlc3Npb25VcGRhdGVkRXZlbnQSEgoKc2Vzc2lvbl9pZBgBIAEoCRIPCgdob3N0X2lkGAIgASgJIscBChdTZXNzaW9uVXNlckNoYW5nZWRFdmVudBISCgpzZXNzaW9uX2lkGAEgASgJEg8KB2hvc3RfaWQYAiABKAkSNgoEa2luZBgDIAEoDjIoLmhkbGN0cmwudjEuU2Vzc2lvblVzZXJDaGFuZ2VkRXZlbnQuS2luZBIRCgl1c2VyX25hbWUYBCABKAkiPAoES2luZBIUChBLSU5EX1VOU1BFQ0lGSUVEEAASDwoLS0lORF9KT0lORUQQARINCglLSU5EX0xFRlQQAiKyAQoVU2Vzc2lvbkxpZmVjeWNsZUV2ZW50EhIKCnNlc3Npb25faWQYASABKAkSDwoHaG9zdF9pZBgCIAEoCRI0CgRraW5kGAMgASgOMiYuaGRsY3RybC52MS5TZXNzaW9uTGlmZWN5Y2xlRXZlbnQuS2luZCI+CgRLaW5kEhQKEEtJTkRfVU5TUEVDSUZJRUQQABIQCgxLSU5EX1NUQVJURUQQARIOCgpLSU5EX0VOREVEEAIiIwoQSG9zdFVwZGF0ZWRFdmVudBIPCgdob3N0X2lkGAEgASgJIhYKFEhvc3RMaXN0Q2hhbmdlZEV2ZW50IpsCChRIb3N0QXV0b1Jlc3RhcnRFdmVudBIPCgdob3N0X2lkGAEgASgJEjMKBGtpbmQYAiABKA4yJS5oZGxjdHJsLnYxLkhvc3RBdXRvUmVzdGFydEV2ZW50LktpbmQSDwoHYXR0ZW1wdBgDIAEoBRIyCgVsZXZlbBgEIAEoDjIjLmhkbGN0cmwudjEuSm9iQ29tcGxldGVkRXZlbnQuTGV2ZWwSDwoHbWVzc2FnZRgFIAEoCSJnCgRLaW5kEhQKEEtJTkRfVU5TUEVDSUZJRUQQABISCg5LSU5EX1NDSEVEVUxFRBABEhIKDktJTkRfUkVTVEFSVEVEEAISDwoLS0lORF9GQUlMRUQQAxIQCgxLSU5EX0dBVkVfVVAQBCK8AQoRSm9iQ29tcGxldGVkRXZlbnQSDgoGam9iX2lkGAEgASgJEjIKBWxldmVsGAIgASgOMiMuaGRsY3RybC52MS5Kb2JDb21wbGV0ZWRFdmVudC5MZXZlbBIPCgdtZXNzYWdlGAMgASgJIlIKBUxldmVsEhUKEUxFVkVMX1VOU1BFQ0lGSUVEEAASDgoKTEVWRUxfSU5GTxABEhEKDUxFVkVMX1NVQ0NFU1MQAhIPCgtMRVZFTF9FUlJPUhADIlYKGExpc3ROb3RpZmljYXRpb25zUmVxdWVzdBIlCgRwYWdlGAEgASgLMhcuaGRsY3RybC52MS5QYWdlUmVxdWVzdBITCgt1bnJlYWRfb25seRgCIAEoCCJTChVOb3RpZmljYXRpb25JbmJveEl0ZW0SLAoFZXZlbnQYASABKAsyHS5oZGxjdHJsLnYxLk5vdGlmaWNhdGlvbkV2ZW50EgwKBHJlYWQYAiABKAgiiwEKGUxpc3ROb3RpZmljYXRpb25zUmVzcG9uc2USMAoFaXRlbXMYASADKAsyIS5oZGxjdHJsLnYxLk5vdGlmaWNhdGlvbkluYm94SXRlbRImCgRwYWdlGAIgASgLMhguaGRsY3RybC52MS5QYWdlUmVzcG9uc2USFAoMdW5yZWFkX2NvdW50GAMgASgFIj4KHE1hcmtOb3RpZmljYXRpb25zUmVhZFJlcXVlc3QSEQoJZXZlbnRfaWRzGAEgAygJEgsKA2FsbBgCIAEoCCIfCh1NYXJrTm90aWZpY2F0aW9uc1JlYWRSZXNwb25zZTLLAgoTTm90aWZpY2F0aW9uU2VydmljZRJkChZTdWJzY3JpYmVOb3RpZmljYXRpb25zEikuaGRsY3RybC52MS5TdWJzY3JpYmVOb3RpZmljYXRpb25zUmVxdWVzdBodLmhkbGN0cmwudjEuTm90aWZpY2F0aW9uRXZlbnQwARJgChFMaXN0Tm90aWZpY2F0aW9ucxIkLmhkbGN0cmwudjEuTGlzdE5vdGlmaWNhdGlvbnNSZXF1ZXN0GiUuaGRsY3RybC52MS5MaXN0Tm90aWZpY2F0aW9uc1Jlc3BvbnNlEmwKFU1hcmtOb3RpZmljYXRpb25zUmVhZBIoLmhkbGN0cmwudjEuTWFya05vdGlmaWNhdGlvbnNSZWFkUmVxdWVzdBopLmhkbGN0cmwudjEuTWFya05vdGlmaWNhdGlvbnNSZWFkUmVzcG9uc2VCvwEKDmNvbS5oZGxjdHJsLnYxQhFOb3RpZmljYXRpb25Qcm90b1ABWlFnaXRodWIuY29tL2hhbnRhYmFydTEwMTQvYmFydS1yZXNvLWhlYWRsZXNzLWNvbnRyb2xsZXIvcGJnZW4vaGRsY3RybC92MTtoZGxjdHJsdjGiAgNIWFiqAgpIZGxjdHJsLlYxygIKSGRsY3RybFxWMeICFkhkbGN0cmxcVjFcR1BCTWV0YWRhdGHqAgtIZGxjdHJsOjpWMWIGcHJvdG8z", [file_google_protobuf_timestamp, file_hdlctrl_v1_controller]);
 
 /**
  * @generated from message hdlctrl.v1.SubscribeNotificationsRequest
  */
 export type SubscribeNotificationsRequest = Message<"hdlctrl.v1.SubscribeNotificationsRequest"> & {
+  /**
+   * 再接続時に、前回の stream で最後に受け取った NotificationEvent.id を指定すると
+   * それ以降のイベントを先に再送する. 再送しきれない場合は HistoryTruncatedEvent が届く.
+   *
+   * @generated from field: optional string last_event_id = 1;
+   */
+  lastEventId?: string;
 };
 
 /**
@@ -36,7 +45,8 @@ export const SubscribeNotificationsRequestSchema: GenMessage<SubscribeNotificati
  */
 export type NotificationEvent = Message<"hdlctrl.v1.NotificationEvent"> & {
   /**
-   * 将来的な resume 用 ULID. 初版では未使用.
+   * サーバが履歴に保存した順の連番. SubscribeNotificationsRequest.last_event_id に使う.
+   * KeepAlive / HistoryTruncated など履歴に残らないイベントでは空.
    *
    * @generated from field: string id = 1;
    */
@@ -59,6 +69,15 @@ export type NotificationEvent = Message<"hdlctrl.v1.NotificationEvent"> & {
      */
     value: KeepAlive;
     case: "keepAlive";
+  } | {
+    /**
+     * last_event_id 以降のイベントを全ては再送できなかった (履歴の削除など).
+     * クライアントは表示中のクエリを全て invalidate する.
+     *
+     * @generated from field: hdlctrl.v1.HistoryTruncatedEvent history_truncated = 4;
+     */
+    value: HistoryTruncatedEvent;
+    case: "historyTruncated";
   } | {
     /**
      * @generated from field: hdlctrl.v1.SessionUpdatedEvent session_updated = 10;
@@ -91,7 +110,13 @@ export type NotificationEvent = Message<"hdlctrl.v1.NotificationEvent"> & {
     case: "hostListChanged";
   } | {
     /**
-     * 将来の非同期 job 完了 toast 用. 初版では publish されない.
+     * @generated from field: hdlctrl.v1.HostAutoRestartEvent host_auto_restart = 22;
+     */
+    value: HostAutoRestartEvent;
+    case: "hostAutoRestart";
+  } | {
+    /**
+     * 非同期 job の完了 toast 用. job の投入元 user にだけ届く.
      *
      * @generated from field: hdlctrl.v1.JobCompletedEvent job_completed = 90;
      */
@@ -120,6 +145,19 @@ export type KeepAlive = Message<"hdlctrl.v1.KeepAlive"> & {
 export const KeepAliveSchema: GenMessage<KeepAlive> = /*@__PURE__*/
   messageDesc(file_hdlctrl_v1_notification, 2);
 
+/**
+ * @generated from message hdlctrl.v1.HistoryTruncatedEvent
+ */
+export type HistoryTruncatedEvent = Message<"hdlctrl.v1.HistoryTruncatedEvent"> & {
+};
+
+/**
+ * Describes the message hdlctrl.v1.HistoryTruncatedEvent.
+ * Use `create(HistoryTruncatedEventSchema)` to create a new message.
+ */
+export const HistoryTruncatedEventSchema: GenMessage<HistoryTruncatedEvent> = /*@__PURE__*/
+  messageDesc(file_hdlctrl_v1_notification, 3);
+
 /**
  * セッションの中身 (ワールド情報, パラメータ, ユーザー一覧の総数) が
  * 更新されたことを通知する. 受信側は session 詳細を再フェッチする.
@@ -143,7 +181,7 @@ export type SessionUpdatedEvent = Message<"hdlctrl.v1.SessionUpdatedEvent"> & {
  * Use `create(SessionUpdatedEventSchema)` to create a new message.
  */
 export const SessionUpdatedEventSchema: GenMessage<SessionUpdatedEvent> = /*@__PURE__*/
-  messageDesc(file_hdlctrl_v1_notification, 3);
+  messageDesc(file_hdlctrl_v1_notification, 4);
 
 /**
  * セッションへのユーザー入退室を通知する. user_name は toast 用に
@@ -178,7 +216,7 @@ export type SessionUserChangedEvent = Message<"hdlctrl.v1.SessionUserChangedEven
  * Use `create(SessionUserChangedEventSchema)` to create a new message.
  */
 export const SessionUserChangedEventSchema: GenMessage<SessionUserChangedEvent> = /*@__PURE__*/
-  messageDesc(file_hdlctrl_v1_notification, 4);
+  messageDesc(file_hdlctrl_v1_notification, 5);
 
 /**
  * @generated from enum hdlctrl.v1.SessionUserChangedEvent.Kind
@@ -204,7 +242,7 @@ export enum SessionUserChangedEvent_Kind {
  * Describes the enum hdlctrl.v1.SessionUserChangedEvent.Kind.
  */
 export const SessionUserChangedEvent_KindSchema: GenEnum<SessionUserChangedEvent_Kind> = /*@__PURE__*/
-  enumDesc(file_hdlctrl_v1_notification, 4, 0);
+  enumDesc(file_hdlctrl_v1_notification, 5, 0);
 
 /**
  * セッションが開始/終了したことを通知する. ホスト詳細・一覧の
@@ -234,7 +272,7 @@ export type SessionLifecycleEvent = Message<"hdlctrl.v1.SessionLifecycleEvent">
  * Use `create(SessionLifecycleEventSchema)` to create a new message.
  */
 export const SessionLifecycleEventSchema: GenMessage<SessionLifecycleEvent> = /*@__PURE__*/
-  messageDesc(file_hdlctrl_v1_notification, 5);
+  messageDesc(file_hdlctrl_v1_notification, 6);
 
 /**
  * @generated from enum hdlctrl.v1.SessionLifecycleEvent.Kind
@@ -260,7 +298,7 @@ export enum SessionLifecycleEvent_Kind {
  * Describes the enum hdlctrl.v1.SessionLifecycleEvent.Kind.
  */
 export const SessionLifecycleEvent_KindSchema: GenEnum<SessionLifecycleEvent_Kind> = /*@__PURE__*/
-  enumDesc(file_hdlctrl_v1_notification, 5, 0);
+  enumDesc(file_hdlctrl_v1_notification, 6, 0);
 
 /**
  * ホストの状態 (status, settings 等) が更新されたことを通知する.
@@ -279,7 +317,7 @@ export type HostUpdatedEvent = Message<"hdlctrl.v1.HostUpdatedEvent"> & {
  * Use `create(HostUpdatedEventSchema)` to create a new message.
  */
 export const HostUpdatedEventSchema: GenMessage<HostUpdatedEvent> = /*@__PURE__*/
-  messageDesc(file_hdlctrl_v1_notification, 6);
+  messageDesc(file_hdlctrl_v1_notification, 7);
 
 /**
  * ホスト一覧の構成自体が変わった (起動/削除) ことを通知する.
@@ -295,10 +333,89 @@ export type HostListChangedEvent = Message<"hdlctrl.v1.HostListChangedEvent"> &
  * Use `create(HostListChangedEventSchema)` to create a new message.
  */
 export const HostListChangedEventSchema: GenMessage<HostListChangedEvent> = /*@__PURE__*/
-  messageDesc(file_hdlctrl_v1_notification, 7);
+  messageDesc(file_hdlctrl_v1_notification, 8);
+
+/**
+ * クラッシュしたホストの自動再起動の経過を通知する. toast 用.
+ *
+ * @generated from message hdlctrl.v1.HostAutoRestartEvent
+ */
+export type HostAutoRestartEvent = Message<"hdlctrl.v1.HostAutoRestartEvent"> & {
+  /**
+   * @generated from field: string host_id = 1;
+   */
+  hostId: string;
+
+  /**
+   * @generated from field: hdlctrl.v1.HostAutoRestartEvent.Kind kind = 2;
+   */
+  kind: HostAutoRestartEvent_Kind;
+
+  /**
+   * 連続何回目の再起動か
+   *
+   * @generated from field: int32 attempt = 3;
+   */
+  attempt: number;
+
+  /**
+   * @generated from field: hdlctrl.v1.JobCompletedEvent.Level level = 4;
+   */
+  level: JobCompletedEvent_Level;
+
+  /**
+   * @generated from field: string message = 5;
+   */
+  message: string;
+};
+
+/**
+ * Describes the message hdlctrl.v1.HostAutoRestartEvent.
+ * Use `create(HostAutoRestartEventSchema)` to create a new message.
+ */
+export const HostAutoRestartEventSchema: GenMessage<HostAutoRestartEvent> = /*@__PURE__*/
+  messageDesc(file_hdlctrl_v1_notification, 9);
+
+/**
+ * @generated from enum hdlctrl.v1.HostAutoRestartEvent.Kind
+ */
+export enum HostAutoRestartEvent_Kind {
+  /**
+   * @generated from enum value: KIND_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * @generated from enum value: KIND_SCHEDULED = 1;
+   */
+  SCHEDULED = 1,
+
+  /**
+   * @generated from enum value: KIND_RESTARTED = 2;
+   */
+  RESTARTED = 2,
+
+  /**
+   * @generated from enum value: KIND_FAILED = 3;
+   */
+  FAILED = 3,
+
+  /**
+   * crash loop と判定して自動再起動を止めた
+   *
+   * @generated from enum value: KIND_GAVE_UP = 4;
+   */
+  GAVE_UP = 4,
+}
+
+/**
+ * Describes the enum hdlctrl.v1.HostAutoRestartEvent.Kind.
+ */
+export const HostAutoRestartEvent_KindSchema: GenEnum<HostAutoRestartEvent_Kind> = /*@__PURE__*/
+  enumDesc(file_hdlctrl_v1_notification, 9, 0);
 
 /**
- * 非同期 job の完了 toast 用. 初版では publish されない.
+ * 非同期 job の完了 toast 用.
  *
  * @generated from message hdlctrl.v1.JobCompletedEvent
  */
@@ -324,7 +441,7 @@ export type JobCompletedEvent = Message<"hdlctrl.v1.JobCompletedEvent"> & {
  * Use `create(JobCompletedEventSchema)` to create a new message.
  */
 export const JobCompletedEventSchema: GenMessage<JobCompletedEvent> = /*@__PURE__*/
-  messageDesc(file_hdlctrl_v1_notification, 8);
+  messageDesc(file_hdlctrl_v1_notification, 10);
 
 /**
  * @generated from enum hdlctrl.v1.JobCompletedEvent.Level
@@ -355,12 +472,125 @@ export enum JobCompletedEvent_Level {
  * Describes the enum hdlctrl.v1.JobCompletedEvent.Level.
  */
 export const JobCompletedEvent_LevelSchema: GenEnum<JobCompletedEvent_Level> = /*@__PURE__*/
-  enumDesc(file_hdlctrl_v1_notification, 8, 0);
+  enumDesc(file_hdlctrl_v1_notification, 10, 0);
+
+/**
+ * @generated from message hdlctrl.v1.ListNotificationsRequest
+ */
+export type ListNotificationsRequest = Message<"hdlctrl.v1.ListNotificationsRequest"> & {
+  /**
+   * @generated from field: hdlctrl.v1.PageRequest page = 1;
+   */
+  page?: PageRequest;
+
+  /**
+   * true なら未読のみ.
+   *
+   * @generated from field: bool unread_only = 2;
+   */
+  unreadOnly: boolean;
+};
+
+/**
+ * Describes the message hdlctrl.v1.ListNotificationsRequest.
+ * Use `create(ListNotificationsRequestSchema)` to create a new message.
+ */
+export const ListNotificationsRequestSchema: GenMessage<ListNotificationsRequest> = /*@__PURE__*/
+  messageDesc(file_hdlctrl_v1_notification, 11);
+
+/**
+ * @generated from message hdlctrl.v1.NotificationInboxItem
+ */
+export type NotificationInboxItem = Message<"hdlctrl.v1.NotificationInboxItem"> & {
+  /**
+   * @generated from field: hdlctrl.v1.NotificationEvent event = 1;
+   */
+  event?: NotificationEvent;
+
+  /**
+   * @generated from field: bool read = 2;
+   */
+  read: boolean;
+};
+
+/**
+ * Describes the message hdlctrl.v1.NotificationInboxItem.
+ * Use `create(NotificationInboxItemSchema)` to create a new message.
+ */
+export const NotificationInboxItemSchema: GenMessage<NotificationInboxItem> = /*@__PURE__*/
+  messageDesc(file_hdlctrl_v1_notification, 12);
+
+/**
+ * @generated from message hdlctrl.v1.ListNotificationsResponse
+ */
+export type ListNotificationsResponse = Message<"hdlctrl.v1.ListNotificationsResponse"> & {
+  /**
+   * @generated from field: repeated hdlctrl.v1.NotificationInboxItem items = 1;
+   */
+  items: NotificationInboxItem[];
+
+  /**
+   * @generated from field: hdlctrl.v1.PageResponse page = 2;
+   */
+  page?: PageResponse;
+
+  /**
+   * 閲覧できる範囲の未読件数 (unread_only に関係なく).
+   *
+   * @generated from field: int32 unread_count = 3;
+   */
+  unreadCount: number;
+};
+
+/**
+ * Describes the message hdlctrl.v1.ListNotificationsResponse.
+ * Use `create(ListNotificationsResponseSchema)` to create a new message.
+ */
+export const ListNotificationsResponseSchema: GenMessage<ListNotificationsResponse> = /*@__PURE__*/
+  messageDesc(file_hdlctrl_v1_notification, 13);
+
+/**
+ * @generated from message hdlctrl.v1.MarkNotificationsReadRequest
+ */
+export type MarkNotificationsReadRequest = Message<"hdlctrl.v1.MarkNotificationsReadRequest"> & {
+  /**
+   * @generated from field: repeated string event_ids = 1;
+   */
+  eventIds: string[];
+
+  /**
+   * true なら event_ids を無視して全て既読にする.
+   *
+   * @generated from field: bool all = 2;
+   */
+  all: boolean;
+};
+
+/**
+ * Describes the message hdlctrl.v1.MarkNotificationsReadRequest.
+ * Use `create(MarkNotificationsReadRequestSchema)` to create a new message.
+ */
+export const MarkNotificationsReadRequestSchema: GenMessage<MarkNotificationsReadRequest> = /*@__PURE__*/
+  messageDesc(file_hdlctrl_v1_notification, 14);
+
+/**
+ * @generated from message hdlctrl.v1.MarkNotificationsReadResponse
+ */
+export type MarkNotificationsReadResponse = Message<"hdlctrl.v1.MarkNotificationsReadResponse"> & {
+};
+
+/**
+ * Describes the message hdlctrl.v1.MarkNotificationsReadResponse.
+ * Use `create(MarkNotificationsReadResponseSchema)` to create a new message.
+ */
+export const MarkNotificationsReadResponseSchema: GenMessage<MarkNotificationsReadResponse> = /*@__PURE__*/
+  messageDesc(file_hdlctrl_v1_notification, 15);
 
 /**
  * NotificationService は server-streaming でフロントエンドに対して
  * イベントを push する. 認証済みクライアントは SubscribeNotifications を
  * 1 本張り、受信したイベントから関連する TanStack Query を invalidate する.
+ * publish されたイベントは一定期間サーバに残り、再接続時の再送と通知 inbox に使われる.
  *
  * @generated from service hdlctrl.v1.NotificationService
  */
@@ -373,6 +603,24 @@ export const NotificationService: GenService<{
     input: typeof SubscribeNotificationsRequestSchema;
     output: typeof NotificationEventSchema;
   },
+  /**
+   * 通知 inbox. セッションの開始/終了・自動再起動・job 完了などを新しい順に返す.
+   *
+   * @generated from rpc hdlctrl.v1.NotificationService.ListNotifications
+   */
+  listNotifications: {
+    methodKind: "unary";
+    input: typeof ListNotificationsRequestSchema;
+    output: typeof ListNotificationsResponseSchema;
+  },
+  /**
+   * @generated from rpc hdlctrl.v1.NotificationService.MarkNotificationsRead
+   */
+  markNotificationsRead: {
+    methodKind: "unary";
+    input: typeof MarkNotificationsReadRequestSchema;
+    output: typeof MarkNotificationsReadResponseSchema;
+  },
 }> = /*@__PURE__*/
   serviceDesc(file_hdlctrl_v1_notification, 0);
 
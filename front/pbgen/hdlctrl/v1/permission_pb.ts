@@ -12,7 +12,7 @@ import type { Message } from "@bufbuild/protobuf";
  * Describes the file hdlctrl/v1/permission.proto.
  */
 export const file_hdlctrl_v1_permission: GenFile = /*@__PURE__*/
-  fileDesc("ChtoZGxjdHJsL3YxL3Blcm1pc3Npb24ucHJvdG8SCmhkbGN0cmwudjEipgEKBUdyb3VwEgoKAmlkGAEgASgJEgwKBG5hbWUYAiABKAkSIwoEdHlwZRgDIAEoDjIVLmhkbGN0cmwudjEuR3JvdXBUeXBlEi4KCmNyZWF0ZWRfYXQYBCABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wEi4KCnVwZGF0ZWRfYXQYBSABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wIpQBCgtHcm91cE1lbWJlchIQCghncm91cF9pZBgBIAEoCRIPCgd1c2VyX2lkGAIgASgJEg8KB3JvbGVfaWQYAyABKAkSFQoIYWRkZWRfYnkYBCABKAlIAIgBARItCglqb2luZWRfYXQYBSABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wQgsKCV9hZGRlZF9ieSL3AQoEUm9sZRIKCgJpZBgBIAEoCRIVCghncm91cF9pZBgCIAEoCUgAiAEBEgwKBG5hbWUYAyABKAkSJAoFc2NvcGUYBCABKA4yFS5oZGxjdHJsLnYxLlJvbGVTY29wZRISCgppc19idWlsdGluGAUgASgIEhcKD3Blcm1pc3Npb25fa2V5cxgGIAMoCRIuCgpjcmVhdGVkX2F0GAcgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcBIuCgp1cGRhdGVkX2F0GAggASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcEILCglfZ3JvdXBfaWQiVwoNUGVybWlzc2lvbktleRILCgNrZXkYASABKAkSEwoLZGVzY3JpcHRpb24YAiABKAkSJAoFc2NvcGUYAyABKA4yFS5oZGxjdHJsLnYxLlJvbGVTY29wZSJOChBHcm91cFBlcm1pc3Npb25zEhAKCGdyb3VwX2lkGAEgASgJEg8KB3JvbGVfaWQYAiABKAkSFwoPcGVybWlzc2lvbl9rZXlzGAMgAygJIl0KDU15UGVybWlzc2lvbnMSLAoGZ3JvdXBzGAEgAygLMhwuaGRsY3RybC52MS5Hcm91cFBlcm1pc3Npb25zEh4KFnN5c3RlbV9wZXJtaXNzaW9uX2tleXMYAiADKAkiIQoRUGVybWlzc2lvbktleUxpc3QSDAoEa2V5cxgBIAMoCSIiChJDcmVhdGVHcm91cFJlcXVlc3QSDAoEbmFtZRgBIAEoCSI3ChNDcmVhdGVHcm91cFJlc3BvbnNlEiAKBWdyb3VwGAEgASgLMhEuaGRsY3RybC52MS5Hcm91cCIjCg9HZXRHcm91cFJlcXVlc3QSEAoIZ3JvdXBfaWQYASABKAkiNAoQR2V0R3JvdXBSZXNwb25zZRIgCgVncm91cBgBIAEoCzIRLmhkbGN0cmwudjEuR3JvdXAiEwoRTGlzdEdyb3Vwc1JlcXVlc3QiNwoSTGlzdEdyb3Vwc1Jlc3BvbnNlEiEKBmdyb3VwcxgBIAMoCzIRLmhkbGN0cmwudjEuR3JvdXAiQgoSVXBkYXRlR3JvdXBSZXF1ZXN0EhAKCGdyb3VwX2lkGAEgASgJEhEKBG5hbWUYAiABKAlIAIgBAUIHCgVfbmFtZSI3ChNVcGRhdGVHcm91cFJlc3BvbnNlEiAKBWdyb3VwGAEgASgLMhEuaGRsY3RybC52MS5Hcm91cCImChJEZWxldGVHcm91cFJlcXVlc3QSEAoIZ3JvdXBfaWQYASABKAkiFQoTRGVsZXRlR3JvdXBSZXNwb25zZSIrChdMaXN0R3JvdXBNZW1iZXJzUmVxdWVzdBIQCghncm91cF9pZBgBIAEoCSJEChhMaXN0R3JvdXBNZW1iZXJzUmVzcG9uc2USKAoHbWVtYmVycxgBIAMoCzIXLmhkbGN0cmwudjEuR3JvdXBNZW1iZXIiSwoVQWRkR3JvdXBNZW1iZXJSZXF1ZXN0EhAKCGdyb3VwX2lkGAEgASgJEg8KB3VzZXJfaWQYAiABKAkSDwoHcm9sZV9pZBgDIAEoCSJBChZBZGRHcm91cE1lbWJlclJlc3BvbnNlEicKBm1lbWJlchgBIAEoCzIXLmhkbGN0cmwudjEuR3JvdXBNZW1iZXIiPQoYUmVtb3ZlR3JvdXBNZW1iZXJSZXF1ZXN0EhAKCGdyb3VwX2lkGAEgASgJEg8KB3VzZXJfaWQYAiABKAkiGwoZUmVtb3ZlR3JvdXBNZW1iZXJSZXNwb25zZSJSChxVcGRhdGVHcm91cE1lbWJlclJvbGVSZXF1ZXN0EhAKCGdyb3VwX2lkGAEgASgJEg8KB3VzZXJfaWQYAiABKAkSDwoHcm9sZV9pZBgDIAEoCSJICh1VcGRhdGVHcm91cE1lbWJlclJvbGVSZXNwb25zZRInCgZtZW1iZXIYASABKAsyFy5oZGxjdHJsLnYxLkdyb3VwTWVtYmVyIjYKEExpc3RSb2xlc1JlcXVlc3QSFQoIZ3JvdXBfaWQYASABKAlIAIgBAUILCglfZ3JvdXBfaWQiNAoRTGlzdFJvbGVzUmVzcG9uc2USHwoFcm9sZXMYASADKAsyEC5oZGxjdHJsLnYxLlJvbGUihAEKEUNyZWF0ZVJvbGVSZXF1ZXN0EhUKCGdyb3VwX2lkGAEgASgJSACIAQESDAoEbmFtZRgCIAEoCRIkCgVzY29wZRgDIAEoDjIVLmhkbGN0cmwudjEuUm9sZVNjb3BlEhcKD3Blcm1pc3Npb25fa2V5cxgEIAMoCUILCglfZ3JvdXBfaWQiNAoSQ3JlYXRlUm9sZVJlc3BvbnNlEh4KBHJvbGUYASABKAsyEC5oZGxjdHJsLnYxLlJvbGUikQEKEVVwZGF0ZVJvbGVSZXF1ZXN0Eg8KB3JvbGVfaWQYASABKAkSEQoEbmFtZRgCIAEoCUgAiAEBEjsKD3Blcm1pc3Npb25fa2V5cxgDIAEoCzIdLmhkbGN0cmwudjEuUGVybWlzc2lvbktleUxpc3RIAYgBAUIHCgVfbmFtZUISChBfcGVybWlzc2lvbl9rZXlzIjQKElVwZGF0ZVJvbGVSZXNwb25zZRIeCgRyb2xlGAEgASgLMhAuaGRsY3RybC52MS5Sb2xlIiQKEURlbGV0ZVJvbGVSZXF1ZXN0Eg8KB3JvbGVfaWQYASABKAkiFAoSRGVsZXRlUm9sZVJlc3BvbnNlIj4KFkxpc3RQZXJtaXNzaW9uc1JlcXVlc3QSJAoFc2NvcGUYASABKA4yFS5oZGxjdHJsLnYxLlJvbGVTY29wZSJJChdMaXN0UGVybWlzc2lvbnNSZXNwb25zZRIuCgtwZXJtaXNzaW9ucxgBIAMoCzIZLmhkbGN0cmwudjEuUGVybWlzc2lvbktleSIZChdHZXRNeVBlcm1pc3Npb25zUmVxdWVzdCJKChhHZXRNeVBlcm1pc3Npb25zUmVzcG9uc2USLgoLcGVybWlzc2lvbnMYASABKAsyGS5oZGxjdHJsLnYxLk15UGVybWlzc2lvbnMqbgoJR3JvdXBUeXBlEhoKFkdST1VQX1RZUEVfVU5TUEVDSUZJRUQQABIXChNHUk9VUF9UWVBFX1BFUlNPTkFMEAESFQoRR1JPVVBfVFlQRV9OT1JNQUwQAhIVChFHUk9VUF9UWVBFX1NZU1RFTRADKlUKCVJvbGVTY29wZRIaChZST0xFX1NDT1BFX1VOU1BFQ0lGSUVEEAASFQoRUk9MRV9TQ09QRV9OT1JNQUwQARIVChFST0xFX1NDT1BFX1NZU1RFTRACMpoGCgxHcm91cFNlcnZpY2USTgoLQ3JlYXRlR3JvdXASHi5oZGxjdHJsLnYxLkNyZWF0ZUdyb3VwUmVxdWVzdBofLmhkbGN0cmwudjEuQ3JlYXRlR3JvdXBSZXNwb25zZRJFCghHZXRHcm91cBIbLmhkbGN0cmwudjEuR2V0R3JvdXBSZXF1ZXN0GhwuaGRsY3RybC52MS5HZXRHcm91cFJlc3BvbnNlEksKCkxpc3RHcm91cHMSHS5oZGxjdHJsLnYxLkxpc3RHcm91cHNSZXF1ZXN0Gh4uaGRsY3RybC52MS5MaXN0R3JvdXBzUmVzcG9uc2USTgoLVXBkYXRlR3JvdXASHi5oZGxjdHJsLnYxLlVwZGF0ZUdyb3VwUmVxdWVzdBofLmhkbGN0cmwudjEuVXBkYXRlR3JvdXBSZXNwb25zZRJOCgtEZWxldGVHcm91cBIeLmhkbGN0cmwudjEuRGVsZXRlR3JvdXBSZXF1ZXN0Gh8uaGRsY3RybC52MS5EZWxldGVHcm91cFJlc3BvbnNlEl0KEExpc3RHcm91cE1lbWJlcnMSIy5oZGxjdHJsLnYxLkxpc3RHcm91cE1lbWJlcnNSZXF1ZXN0GiQuaGRsY3RybC52MS5MaXN0R3JvdXBNZW1iZXJzUmVzcG9uc2USVwoOQWRkR3JvdXBNZW1iZXISIS5oZGxjdHJsLnYxLkFkZEdyb3VwTWVtYmVyUmVxdWVzdBoiLmhkbGN0cmwudjEuQWRkR3JvdXBNZW1iZXJSZXNwb25zZRJgChFSZW1vdmVHcm91cE1lbWJlchIkLmhkbGN0cmwudjEuUmVtb3ZlR3JvdXBNZW1iZXJSZXF1ZXN0GiUuaGRsY3RybC52MS5SZW1vdmVHcm91cE1lbWJlclJlc3BvbnNlEmwKFVVwZGF0ZUdyb3VwTWVtYmVyUm9sZRIoLmhkbGN0cmwudjEuVXBkYXRlR3JvdXBNZW1iZXJSb2xlUmVxdWVzdBopLmhkbGN0cmwudjEuVXBkYXRlR3JvdXBNZW1iZXJSb2xlUmVzcG9uc2Uy+QMKC1JvbGVTZXJ2aWNlEkgKCUxpc3RSb2xlcxIcLmhkbGN0cmwudjEuTGlzdFJvbGVzUmVxdWVzdBodLmhkbGN0cmwudjEuTGlzdFJvbGVzUmVzcG9uc2USSwoKQ3JlYXRlUm9sZRIdLmhkbGN0cmwudjEuQ3JlYXRlUm9sZVJlcXVlc3QaHi5oZGxjdHJsLnYxLkNyZWF0ZVJvbGVSZXNwb25zZRJLCgpVcGRhdGVSb2xlEh0uaGRsY3RybC52MS5VcGRhdGVSb2xlUmVxdWVzdBoeLmhkbGN0cmwudjEuVXBkYXRlUm9sZVJlc3BvbnNlEksKCkRlbGV0ZVJvbGUSHS5oZGxjdHJsLnYxLkRlbGV0ZVJvbGVSZXF1ZXN0Gh4uaGRsY3RybC52MS5EZWxldGVSb2xlUmVzcG9uc2USWgoPTGlzdFBlcm1pc3Npb25zEiIuaGRsY3RybC52MS5MaXN0UGVybWlzc2lvbnNSZXF1ZXN0GiMuaGRsY3RybC52MS5MaXN0UGVybWlzc2lvbnNSZXNwb25zZRJdChBHZXRNeVBlcm1pc3Npb25zEiMuaGRsY3RybC52MS5HZXRNeVBlcm1pc3Npb25zUmVxdWVzdBokLmhkbGN0cmwudjEuR2V0TXlQZXJtaXNzaW9uc1Jlc3BvbnNlQr0BCg5jb20uaGRsY3RybC52MUIPUGVybWlzc2lvblByb3RvUAFaUWdpdGh1Yi5jb20vaGFudGFiYXJ1MTAxNC9iYXJ1LXJlc28taGVhZGxlc3MtY29udHJvbGxlci9wYmdlbi9oZGxjdHJsL3YxO2hkbGN0cmx2MaICA0hYWKoCCkhkbGN0cmwuVjHKAgpIZGxjdHJsXFYx4gIWSGRsY3RybFxWMVxHUEJNZXRhZGF0YeoCC0hkbGN0cmw6OlYxYgZwcm90bzM", [file_google_protobuf_timestamp]);
+  fileDesc("ChtoZGxjdHJsL3YxL3Blcm1pc3Npb24ucHJvdG8SCmhkbGN0cmwudjEi3gEKBUdyb3VwEgoKAmlkGAEgASgJEgwKBG5hbWUYAiABKAkSIwoEdHlwZRgDIAEoDjIVLmhkbGN0cmwudjEuR3JvdXBUeXBlEi4KCmNyZWF0ZWRfYXQYBCABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wEi4KCnVwZGF0ZWRfYXQYBSABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wEh8KEmxvZ19yZXRlbnRpb25fZGF5cxgGIAEoBUgAiAEBQhUKE19sb2dfcmV0ZW50aW9uX2RheXMilAEKC0dyb3VwTWVtYmVyEhAKCGdyb3VwX2lkGAEgASgJEg8KB3VzZXJfaWQYAiABKAkSDwoHcm9sZV9pZBgDIAEoCRIVCghhZGRlZF9ieRgEIAEoCUgAiAEBEi0KCWpvaW5lZF9hdBgFIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXBCCwoJX2FkZGVkX2J5IvcBCgRSb2xlEgoKAmlkGAEgASgJEhUKCGdyb3VwX2lkGAIgASgJSACIAQESDAoEbmFtZRgDIAEoCRIkCgVzY29wZRgEIAEoDjIVLmhkbGN0cmwudjEuUm9sZVNjb3BlEhIKCmlzX2J1aWx0aW4YBSABKAgSFwoPcGVybWlzc2lvbl9rZXlzGAYgAygJEi4KCmNyZWF0ZWRfYXQYByABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wEi4KCnVwZGF0ZWRfYXQYCCABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wQgsKCV9ncm91cF9pZCJXCg1QZXJtaXNzaW9uS2V5EgsKA2tleRgBIAEoCRITCgtkZXNjcmlwdGlvbhgCIAEoCRIkCgVzY29wZRgDIAEoDjIVLmhkbGN0cmwudjEuUm9sZVNjb3BlIk4KEEdyb3VwUGVybWlzc2lvbnMSEAoIZ3JvdXBfaWQYASABKAkSDwoHcm9sZV9pZBgCIAEoCRIXCg9wZXJtaXNzaW9uX2tleXMYAyADKAkiXQoNTXlQZXJtaXNzaW9ucxIsCgZncm91cHMYASADKAsyHC5oZGxjdHJsLnYxLkdyb3VwUGVybWlzc2lvbnMSHgoWc3lzdGVtX3Blcm1pc3Npb25fa2V5cxgCIAMoCSIhChFQZXJtaXNzaW9uS2V5TGlzdBIMCgRrZXlzGAEgAygJIiIKEkNyZWF0ZUdyb3VwUmVxdWVzdBIMCgRuYW1lGAEgASgJIjcKE0NyZWF0ZUdyb3VwUmVzcG9uc2USIAoFZ3JvdXAYASABKAsyES5oZGxjdHJsLnYxLkdyb3VwIiMKD0dldEdyb3VwUmVxdWVzdBIQCghncm91cF9pZBgBIAEoCSI0ChBHZXRHcm91cFJlc3BvbnNlEiAKBWdyb3VwGAEgASgLMhEuaGRsY3RybC52MS5Hcm91cCITChFMaXN0R3JvdXBzUmVxdWVzdCI3ChJMaXN0R3JvdXBzUmVzcG9uc2USIQoGZ3JvdXBzGAEgAygLMhEuaGRsY3RybC52MS5Hcm91cCJ6ChJVcGRhdGVHcm91cFJlcXVlc3QSEAoIZ3JvdXBfaWQYASABKAkSEQoEbmFtZRgCIAEoCUgAiAEBEh8KEmxvZ19yZXRlbnRpb25fZGF5cxgDIAEoBUgBiAEBQgcKBV9uYW1lQhUKE19sb2dfcmV0ZW50aW9uX2RheXMiNwoTVXBkYXRlR3JvdXBSZXNwb25zZRIgCgVncm91cBgBIAEoCzIRLmhkbGN0cmwudjEuR3JvdXAiJgoSRGVsZXRlR3JvdXBSZXF1ZXN0EhAKCGdyb3VwX2lkGAEgASgJIhUKE0RlbGV0ZUdyb3VwUmVzcG9uc2UiKwoXTGlzdEdyb3VwTWVtYmVyc1JlcXVlc3QSEAoIZ3JvdXBfaWQYASABKAkiRAoYTGlzdEdyb3VwTWVtYmVyc1Jlc3BvbnNlEigKB21lbWJlcnMYASADKAsyFy5oZGxjdHJsLnYxLkdyb3VwTWVtYmVyIksKFUFkZEdyb3VwTWVtYmVyUmVxdWVzdBIQCghncm91cF9pZBgBIAEoCRIPCgd1c2VyX2lkGAIgASgJEg8KB3JvbGVfaWQYAyABKAkiQQoWQWRkR3JvdXBNZW1iZXJSZXNwb25zZRInCgZtZW1iZXIYASABKAsyFy5oZGxjdHJsLnYxLkdyb3VwTWVtYmVyIj0KGFJlbW92ZUdyb3VwTWVtYmVyUmVxdWVzdBIQCghncm91cF9pZBgBIAEoCRIPCgd1c2VyX2lkGAIgASgJIhsKGVJlbW92ZUdyb3VwTWVtYmVyUmVzcG9uc2UiUgocVXBkYXRlR3JvdXBNZW1iZXJSb2xlUmVxdWVzdBIQCghncm91cF9pZBgBIAEoCRIPCgd1c2VyX2lkGAIgASgJEg8KB3JvbGVfaWQYAyABKAkiSAodVXBkYXRlR3JvdXBNZW1iZXJSb2xlUmVzcG9uc2USJwoGbWVtYmVyGAEgASgLMhcuaGRsY3RybC52MS5Hcm91cE1lbWJlciI2ChBMaXN0Um9sZXNSZXF1ZXN0EhUKCGdyb3VwX2lkGAEgASgJSACIAQFCCwoJX2dyb3VwX2lkIjQKEUxpc3RSb2xlc1Jlc3BvbnNlEh8KBXJvbGVzGAEgAygLMhAuaGRsY3RybC52MS5Sb2xlIoQBChFDcmVhdGVSb2xlUmVxdWVzdBIVCghncm91cF9pZBgBIAEoCUgAiAEBEgwKBG5hbWUYAiABKAkSJAoFc2NvcGUYAyABKA4yFS5oZGxjdHJsLnYxLlJvbGVTY29wZRIXCg9wZXJtaXNzaW9uX2tleXMYBCADKAlCCwoJX2dyb3VwX2lkIjQKEkNyZWF0ZVJvbGVSZXNwb25zZRIeCgRyb2xlGAEgASgLMhAuaGRsY3RybC52MS5Sb2xlIpEBChFVcGRhdGVSb2xlUmVxdWVzdBIPCgdyb2xlX2lkGAEgASgJEhEKBG5hbWUYAiABKAlIAIgBARI7Cg9wZXJtaXNzaW9uX2tleXMYAyABKAsyHS5oZGxjdHJsLnYxLlBlcm1pc3Npb25LZXlMaXN0SAGIAQFCBwoFX25hbWVCEgoQX3Blcm1pc3Npb25fa2V5cyI0ChJVcGRhdGVSb2xlUmVzcG9uc2USHgoEcm9sZRgBIAEoCzIQLmhkbGN0cmwudjEuUm9sZSIkChFEZWxldGVSb2xlUmVxdWVzdBIPCgdyb2xlX2lkGAEgASgJIhQKEkRlbGV0ZVJvbGVSZXNwb25zZSI+ChZMaXN0UGVybWlzc2lvbnNSZXF1ZXN0EiQKBXNjb3BlGAEgASgOMhUuaGRsY3RybC52MS5Sb2xlU2NvcGUiSQoXTGlzdFBlcm1pc3Npb25zUmVzcG9uc2USLgoLcGVybWlzc2lvbnMYASADKAsyGS5oZGxjdHJsLnYxLlBlcm1pc3Npb25LZXkiGQoXR2V0TXlQZXJtaXNzaW9uc1JlcXVlc3QiSgoYR2V0TXlQZXJtaXNzaW9uc1Jlc3BvbnNlEi4KC3Blcm1pc3Npb25zGAEgASgLMhkuaGRsY3RybC52MS5NeVBlcm1pc3Npb25zKm4KCUdyb3VwVHlwZRIaChZHUk9VUF9UWVBFX1VOU1BFQ0lGSUVEEAASFwoTR1JPVVBfVFlQRV9QRVJTT05BTBABEhUKEUdST1VQX1RZUEVfTk9STUFMEAISFQoRR1JPVVBfVFlQRV9TWVNURU0QAypVCglSb2xlU2NvcGUSGgoWUk9MRV9TQ09QRV9VTlNQRUNJRklFRBAAEhUKEVJPTEVfU0NPUEVfTk9STUFMEAESFQoRUk9MRV9TQ09QRV9TWVNURU0QAjKaBgoMR3JvdXBTZXJ2aWNlEk4KC0NyZWF0ZUdyb3VwEh4uaGRsY3RybC52MS5DcmVhdGVHcm91cFJlcXVlc3QaHy5oZGxjdHJsLnYxLkNyZWF0ZUdyb3VwUmVzcG9uc2USRQoIR2V0R3JvdXASGy5oZGxjdHJsLnYxLkdldEdyb3VwUmVxdWVzdBocLmhkbGN0cmwudjEuR2V0R3JvdXBSZXNwb25zZRJLCgpMaXN0R3JvdXBzEh0uaGRsY3RybC52MS5MaXN0R3JvdXBzUmVxdWVzdBoeLmhkbGN0cmwudjEuTGlzdEdyb3Vwc1Jlc3BvbnNlEk4KC1VwZGF0ZUdyb3VwEh4uaGRsY3RybC52MS5VcGRhdGVHcm91cFJlcXVlc3QaHy5oZGxjdHJsLnYxLlVwZGF0ZUdyb3VwUmVzcG9uc2USTgoLRGVsZXRlR3JvdXASHi5oZGxjdHJsLnYxLkRlbGV0ZUdyb3VwUmVxdWVzdBofLmhkbGN0cmwudjEuRGVsZXRlR3JvdXBSZXNwb25zZRJdChBMaXN0R3JvdXBNZW1iZXJzEiMuaGRsY3RybC52MS5MaXN0R3JvdXBNZW1iZXJzUmVxdWVzdBokLmhkbGN0cmwudjEuTGlzdEdyb3VwTWVtYmVyc1Jlc3BvbnNlElcKDkFkZEdyb3VwTWVtYmVyEiEuaGRsY3RybC52MS5BZGRHcm91cE1lbWJlclJlcXVlc3QaIi5oZGxjdHJsLnYxLkFkZEdyb3VwTWVtYmVyUmVzcG9uc2USYAoRUmVtb3ZlR3JvdXBNZW1iZXISJC5oZGxjdHJsLnYxLlJlbW92ZUdyb3VwTWVtYmVyUmVxdWVzdBolLmhkbGN0cmwudjEuUmVtb3ZlR3JvdXBNZW1iZXJSZXNwb25zZRJsChVVcGRhdGVHcm91cE1lbWJlclJvbGUSKC5oZGxjdHJsLnYxLlVwZGF0ZUdyb3VwTWVtYmVyUm9sZVJlcXVlc3QaKS5oZGxjdHJsLnYxLlVwZGF0ZUdyb3VwTWVtYmVyUm9sZVJlc3BvbnNlMvkDCgtSb2xlU2VydmljZRJICglMaXN0Um9sZXMSHC5oZGxjdHJsLnYxLkxpc3RSb2xlc1JlcXVlc3QaHS5oZGxjdHJsLnYxLkxpc3RSb2xlc1Jlc3BvbnNlEksKCkNyZWF0ZVJvbGUSHS5oZGxjdHJsLnYxLkNyZWF0ZVJvbGVSZXF1ZXN0Gh4uaGRsY3RybC52MS5DcmVhdGVSb2xlUmVzcG9uc2USSwoKVXBkYXRlUm9sZRIdLmhkbGN0cmwudjEuVXBkYXRlUm9sZVJlcXVlc3QaHi5oZGxjdHJsLnYxLlVwZGF0ZVJvbGVSZXNwb25zZRJLCgpEZWxldGVSb2xlEh0uaGRsY3RybC52MS5EZWxldGVSb2xlUmVxdWVzdBoeLmhkbGN0cmwudjEuRGVsZXRlUm9sZVJlc3BvbnNlEloKD0xpc3RQZXJtaXNzaW9ucxIiLmhkbGN0cmwudjEuTGlzdFBlcm1pc3Npb25zUmVxdWVzdBojLmhkbGN0cmwudjEuTGlzdFBlcm1pc3Npb25zUmVzcG9uc2USXQoQR2V0TXlQZXJtaXNzaW9ucxIjLmhkbGN0cmwudjEuR2V0TXlQZXJtaXNzaW9uc1JlcXVlc3QaJC5oZGxjdHJsLnYxLkdldE15UGVybWlzc2lvbnNSZXNwb25zZUK9AQoOY29tLmhkbGN0cmwudjFCD1Blcm1pc3Npb25Qcm90b1ABWlFnaXRodWIuY29tL2hhbnRhYmFydTEwMTQvYmFydS1yZXNvLWhlYWRsZXNzLWNvbnRyb2xsZXIvcGJnZW4vaGRsY3RybC92MTtoZGxjdHJsdjGiAgNIWFiqAgpIZGxjdHJsLlYxygIKSGRsY3RybFxWMeICFkhkbGN0cmxcVjFcR1BCTWV0YWRhdGHqAgtIZGxjdHJsOjpWMWIGcHJvdG8z", [file_google_protobuf_timestamp]);
 
 /**
  * @generated from message hdlctrl.v1.Group
@@ -42,6 +42,13 @@ export type Group = Message<"hdlctrl.v1.Group"> & {
    * @generated from field: google.protobuf.Timestamp updated_at = 5;
    */
   updatedAt?: Timestamp;
+
+  /**
+   * ホストのログを DB に残す日数. 未設定ならサーバー全体の設定に従う
+   *
+   * @generated from field: optional int32 log_retention_days = 6;
+   */
+  logRetentionDays?: number;
 };
 
 /**
@@ -369,6 +376,13 @@ export type UpdateGroupRequest = Message<"hdlctrl.v1.UpdateGroupRequest"> & {
    * @generated from field: optional string name = 2;
    */
   name?: string;
+
+  /**
+   * 0 を指定すると設定を解除し、サーバー全体の設定に従う
+   *
+   * @generated from field: optional int32 log_retention_days = 3;
+   */
+  logRetentionDays?: number;
 };
 
 /**
@@ -21,6 +21,34 @@ export const validateRegistrationToken = UserService.method.validateRegistration
  */
 export const registerWithToken = UserService.method.registerWithToken;
 
+/**
+ * サインイン画面に出す選択肢 (SSO の有無)
+ *
+ * @generated from rpc hdlctrl.v1.UserService.GetLoginOptions
+ */
+export const getLoginOptions = UserService.method.getLoginOptions;
+
+/**
+ * OIDC (認可コード + PKCE) でのサインイン. Begin で得た URL に遷移し、
+ * IdP から戻ってきた code / state で Complete する
+ *
+ * @generated from rpc hdlctrl.v1.UserService.BeginOidcLogin
+ */
+export const beginOidcLogin = UserService.method.beginOidcLogin;
+
+/**
+ * @generated from rpc hdlctrl.v1.UserService.CompleteOidcLogin
+ */
+export const completeOidcLogin = UserService.method.completeOidcLogin;
+
+/**
+ * GetTokenByPassword が totp_challenge を返したときに、認証アプリのコードか
+ * リカバリーコードを送ってサインインを完了する
+ *
+ * @generated from rpc hdlctrl.v1.UserService.VerifyTotpLogin
+ */
+export const verifyTotpLogin = UserService.method.verifyTotpLogin;
+
 /**
  * 認証付きRPC
  *
@@ -63,3 +91,82 @@ export const createRegistrationToken = UserService.method.createRegistrationToke
  * @generated from rpc hdlctrl.v1.UserService.DeleteUser
  */
 export const deleteUser = UserService.method.deleteUser;
+
+/**
+ * API トークン (スクリプト / bot 向けの長期トークン). 自分のトークンのみ操作できる.
+ * 発行したトークンは Bearer ヘッダに付けて JWT の代わりに使える.
+ * API トークンで認証されたリクエストからは発行できない.
+ *
+ * @generated from rpc hdlctrl.v1.UserService.CreateApiToken
+ */
+export const createApiToken = UserService.method.createApiToken;
+
+/**
+ * 失効済み / 期限切れも含めて新しい順に返す.
+ *
+ * @generated from rpc hdlctrl.v1.UserService.ListApiTokens
+ */
+export const listApiTokens = UserService.method.listApiTokens;
+
+/**
+ * @generated from rpc hdlctrl.v1.UserService.RevokeApiToken
+ */
+export const revokeApiToken = UserService.method.revokeApiToken;
+
+/**
+ * 2 段階認証 (TOTP). 自分の設定のみ操作できる. API トークンからは操作できない.
+ *
+ * @generated from rpc hdlctrl.v1.UserService.GetTotpStatus
+ */
+export const getTotpStatus = UserService.method.getTotpStatus;
+
+/**
+ * 新しい secret を発行する. Confirm で最初のコードを確認するまでは有効にならない.
+ * 登録が必須でまだサインインできないユーザーは、access token の代わりに
+ * GetTokenByPassword で得た challenge を渡す.
+ *
+ * @generated from rpc hdlctrl.v1.UserService.BeginTotpEnrollment
+ */
+export const beginTotpEnrollment = UserService.method.beginTotpEnrollment;
+
+/**
+ * @generated from rpc hdlctrl.v1.UserService.ConfirmTotpEnrollment
+ */
+export const confirmTotpEnrollment = UserService.method.confirmTotpEnrollment;
+
+/**
+ * 必須のユーザーは無効にできない.
+ *
+ * @generated from rpc hdlctrl.v1.UserService.DisableTotp
+ */
+export const disableTotp = UserService.method.disableTotp;
+
+/**
+ * ログインセッション (サインインした端末). 自分のセッションのみ操作できる. API トークンからは操作できない.
+ * 有効なセッションを最後に使われた順に返す.
+ *
+ * @generated from rpc hdlctrl.v1.UserService.ListMySessions
+ */
+export const listMySessions = UserService.method.listMySessions;
+
+/**
+ * 失効させたセッションの access token / refresh token はすぐに使えなくなる.
+ * 今のセッションを指定するとサインアウトになる.
+ *
+ * @generated from rpc hdlctrl.v1.UserService.RevokeSession
+ */
+export const revokeSession = UserService.method.revokeSession;
+
+/**
+ * ログイン中のユーザーに IdP のアカウントを紐付ける. 以降はそのアカウントの SSO でサインインできる.
+ * Begin で得た URL に遷移し、IdP から戻ってきた code / state で Complete する.
+ * state は Begin を呼んだユーザーでしか Complete できない. API トークンからは操作できない.
+ *
+ * @generated from rpc hdlctrl.v1.UserService.BeginOidcLink
+ */
+export const beginOidcLink = UserService.method.beginOidcLink;
+
+/**
+ * @generated from rpc hdlctrl.v1.UserService.CompleteOidcLink
+ */
+export const completeOidcLink = UserService.method.completeOidcLink;
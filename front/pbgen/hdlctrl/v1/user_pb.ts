@@ -12,7 +12,7 @@ import type { Message } from "@bufbuild/protobuf";
  * Describes the file hdlctrl/v1/user.proto.
  */
 export const file_hdlctrl_v1_user: GenFile = /*@__PURE__*/
-  fileDesc("ChVoZGxjdHJsL3YxL3VzZXIucHJvdG8SCmhkbGN0cmwudjEiOAoQVG9rZW5TZXRSZXNwb25zZRINCgV0b2tlbhgBIAEoCRIVCg1yZWZyZXNoX3Rva2VuGAIgASgJIjkKGUdldFRva2VuQnlQYXNzd29yZFJlcXVlc3QSCgoCaWQYASABKAkSEAoIcGFzc3dvcmQYAiABKAkiFQoTUmVmcmVzaFRva2VuUmVxdWVzdCIxCiBWYWxpZGF0ZVJlZ2lzdHJhdGlvblRva2VuUmVxdWVzdBINCgV0b2tlbhgBIAEoCSJ1CiFWYWxpZGF0ZVJlZ2lzdHJhdGlvblRva2VuUmVzcG9uc2USDQoFdmFsaWQYASABKAgSEwoLcmVzb25pdGVfaWQYAiABKAkSGgoScmVzb25pdGVfdXNlcl9uYW1lGAMgASgJEhAKCGljb25fdXJsGAQgASgJImQKGFJlZ2lzdGVyV2l0aFRva2VuUmVxdWVzdBINCgV0b2tlbhgBIAEoCRIPCgd1c2VyX2lkGAIgASgJEhAKCHBhc3N3b3JkGAMgASgJSgQIBBAFUhBwZXJzb25hbF9yb2xlX2lkIkcKFUNoYW5nZVBhc3N3b3JkUmVxdWVzdBIYChBjdXJyZW50X3Bhc3N3b3JkGAEgASgJEhQKDG5ld19wYXNzd29yZBgCIAEoCSIYChZDaGFuZ2VQYXNzd29yZFJlc3BvbnNlIpkBCgRVc2VyEgoKAmlkGAEgASgJEhMKC3Jlc29uaXRlX2lkGAIgASgJEhAKCGljb25fdXJsGAMgASgJEi4KCmNyZWF0ZWRfYXQYBCABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wEi4KCnVwZGF0ZWRfYXQYBSABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wIhIKEExpc3RVc2Vyc1JlcXVlc3QiNAoRTGlzdFVzZXJzUmVzcG9uc2USHwoFdXNlcnMYASADKAsyEC5oZGxjdHJsLnYxLlVzZXIiIQoOR2V0VXNlclJlcXVlc3QSDwoHdXNlcl9pZBgBIAEoCSIxCg9HZXRVc2VyUmVzcG9uc2USHgoEdXNlchgBIAEoCzIQLmhkbGN0cmwudjEuVXNlciJpCh5DcmVhdGVSZWdpc3RyYXRpb25Ub2tlblJlcXVlc3QSEwoLcmVzb25pdGVfaWQYASABKAkSHQoQcGVyc29uYWxfcm9sZV9pZBgCIAEoCUgAiAEBQhMKEV9wZXJzb25hbF9yb2xlX2lkIo4BCh9DcmVhdGVSZWdpc3RyYXRpb25Ub2tlblJlc3BvbnNlEg0KBXRva2VuGAEgASgJEi4KCmV4cGlyZXNfYXQYAiABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wEhoKEnJlc29uaXRlX3VzZXJfbmFtZRgDIAEoCRIQCghpY29uX3VybBgEIAEoCSIkChFEZWxldGVVc2VyUmVxdWVzdBIPCgd1c2VyX2lkGAEgASgJIhQKEkRlbGV0ZVVzZXJSZXNwb25zZTLEBgoLVXNlclNlcnZpY2USWwoSR2V0VG9rZW5CeVBhc3N3b3JkEiUuaGRsY3RybC52MS5HZXRUb2tlbkJ5UGFzc3dvcmRSZXF1ZXN0GhwuaGRsY3RybC52MS5Ub2tlblNldFJlc3BvbnNlIgASegoZVmFsaWRhdGVSZWdpc3RyYXRpb25Ub2tlbhIsLmhkbGN0cmwudjEuVmFsaWRhdGVSZWdpc3RyYXRpb25Ub2tlblJlcXVlc3QaLS5oZGxjdHJsLnYxLlZhbGlkYXRlUmVnaXN0cmF0aW9uVG9rZW5SZXNwb25zZSIAElkKEVJlZ2lzdGVyV2l0aFRva2VuEiQuaGRsY3RybC52MS5SZWdpc3RlcldpdGhUb2tlblJlcXVlc3QaHC5oZGxjdHJsLnYxLlRva2VuU2V0UmVzcG9uc2UiABJPCgxSZWZyZXNoVG9rZW4SHy5oZGxjdHJsLnYxLlJlZnJlc2hUb2tlblJlcXVlc3QaHC5oZGxjdHJsLnYxLlRva2VuU2V0UmVzcG9uc2UiABJZCg5DaGFuZ2VQYXNzd29yZBIhLmhkbGN0cmwudjEuQ2hhbmdlUGFzc3dvcmRSZXF1ZXN0GiIuaGRsY3RybC52MS5DaGFuZ2VQYXNzd29yZFJlc3BvbnNlIgASSgoJTGlzdFVzZXJzEhwuaGRsY3RybC52MS5MaXN0VXNlcnNSZXF1ZXN0Gh0uaGRsY3RybC52MS5MaXN0VXNlcnNSZXNwb25zZSIAEkQKB0dldFVzZXISGi5oZGxjdHJsLnYxLkdldFVzZXJSZXF1ZXN0GhsuaGRsY3RybC52MS5HZXRVc2VyUmVzcG9uc2UiABJ0ChdDcmVhdGVSZWdpc3RyYXRpb25Ub2tlbhIqLmhkbGN0cmwudjEuQ3JlYXRlUmVnaXN0cmF0aW9uVG9rZW5SZXF1ZXN0GisuaGRsY3RybC52MS5DcmVhdGVSZWdpc3RyYXRpb25Ub2tlblJlc3BvbnNlIgASTQoKRGVsZXRlVXNlchIdLmhkbGN0cmwudjEuRGVsZXRlVXNlclJlcXVlc3QaHi5oZGxjdHJsLnYxLkRlbGV0ZVVzZXJSZXNwb25zZSIAQrcBCg5jb20uaGRsY3RybC52MUIJVXNlclByb3RvUAFaUWdpdGh1Yi5jb20vaGFudGFiYXJ1MTAxNC9iYXJ1LXJlc28taGVhZGxlc3MtY29udHJvbGxlci9wYmdlbi9oZGxjdHJsL3YxO2hkbGN0cmx2MaICA0hYWKoCCkhkbGN0cmwuVjHKAgpIZGxjdHJsXFYx4gIWSGRsY3RybFxWMVxHUEJNZXRhZGF0YeoCC0hkbGN0cmw6OlYxYgZwcm90bzM", [file_google_protobuf_timestamp]);
+  fileDesc("ChVoZGxjdHJsL3YxL3VzZXIucHJvdG8SCmhkbGN0cmwudjEicgoQVG9rZW5TZXRSZXNwb25zZRINCgV0b2tlbhgBIAEoCRIVCg1yZWZyZXNoX3Rva2VuGAIgASgJEhYKDnRvdHBfY2hhbGxlbmdlGAMgASgJEiAKGHRvdHBfZW5yb2xsbWVudF9yZXF1aXJlZBgEIAEoCCI5ChlHZXRUb2tlbkJ5UGFzc3dvcmRSZXF1ZXN0EgoKAmlkGAEgASgJEhAKCHBhc3N3b3JkGAIgASgJIhgKFkdldExvZ2luT3B0aW9uc1JlcXVlc3QiSwoXR2V0TG9naW5PcHRpb25zUmVzcG9uc2USFAoMb2lkY19lbmFibGVkGAEgASgIEhoKEm9pZGNfcHJvdmlkZXJfbmFtZRgCIAEoCSIXChVCZWdpbk9pZGNMb2dpblJlcXVlc3QiQgoWQmVnaW5PaWRjTG9naW5SZXNwb25zZRIZChFhdXRob3JpemF0aW9uX3VybBgBIAEoCRINCgVzdGF0ZRgCIAEoCSI3ChhDb21wbGV0ZU9pZGNMb2dpblJlcXVlc3QSDAoEY29kZRgBIAEoCRINCgVzdGF0ZRgCIAEoCSIWChRCZWdpbk9pZGNMaW5rUmVxdWVzdCI2ChdDb21wbGV0ZU9pZGNMaW5rUmVxdWVzdBIMCgRjb2RlGAEgASgJEg0KBXN0YXRlGAIgASgJIhoKGENvbXBsZXRlT2lkY0xpbmtSZXNwb25zZSI5ChZWZXJpZnlUb3RwTG9naW5SZXF1ZXN0EhEKCWNoYWxsZW5nZRgBIAEoCRIMCgRjb2RlGAIgASgJIhUKE1JlZnJlc2hUb2tlblJlcXVlc3QiMQogVmFsaWRhdGVSZWdpc3RyYXRpb25Ub2tlblJlcXVlc3QSDQoFdG9rZW4YASABKAkidQohVmFsaWRhdGVSZWdpc3RyYXRpb25Ub2tlblJlc3BvbnNlEg0KBXZhbGlkGAEgASgIEhMKC3Jlc29uaXRlX2lkGAIgASgJEhoKEnJlc29uaXRlX3VzZXJfbmFtZRgDIAEoCRIQCghpY29uX3VybBgEIAEoCSJkChhSZWdpc3RlcldpdGhUb2tlblJlcXVlc3QSDQoFdG9rZW4YASABKAkSDwoHdXNlcl9pZBgCIAEoCRIQCghwYXNzd29yZBgDIAEoCUoECAQQBVIQcGVyc29uYWxfcm9sZV9pZCJHChVDaGFuZ2VQYXNzd29yZFJlcXVlc3QSGAoQY3VycmVudF9wYXNzd29yZBgBIAEoCRIUCgxuZXdfcGFzc3dvcmQYAiABKAkiGAoWQ2hhbmdlUGFzc3dvcmRSZXNwb25zZSKZAQoEVXNlchIKCgJpZBgBIAEoCRITCgtyZXNvbml0ZV9pZBgCIAEoCRIQCghpY29uX3VybBgDIAEoCRIuCgpjcmVhdGVkX2F0GAQgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcBIuCgp1cGRhdGVkX2F0GAUgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcCISChBMaXN0VXNlcnNSZXF1ZXN0IjQKEUxpc3RVc2Vyc1Jlc3BvbnNlEh8KBXVzZXJzGAEgAygLMhAuaGRsY3RybC52MS5Vc2VyIiEKDkdldFVzZXJSZXF1ZXN0Eg8KB3VzZXJfaWQYASABKAkiMQoPR2V0VXNlclJlc3BvbnNlEh4KBHVzZXIYASABKAsyEC5oZGxjdHJsLnYxLlVzZXIiaQoeQ3JlYXRlUmVnaXN0cmF0aW9uVG9rZW5SZXF1ZXN0EhMKC3Jlc29uaXRlX2lkGAEgASgJEh0KEHBlcnNvbmFsX3JvbGVfaWQYAiABKAlIAIgBAUITChFfcGVyc29uYWxfcm9sZV9pZCKOAQofQ3JlYXRlUmVnaXN0cmF0aW9uVG9rZW5SZXNwb25zZRINCgV0b2tlbhgBIAEoCRIuCgpleHBpcmVzX2F0GAIgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcBIaChJyZXNvbml0ZV91c2VyX25hbWUYAyABKAkSEAoIaWNvbl91cmwYBCABKAkiJAoRRGVsZXRlVXNlclJlcXVlc3QSDwoHdXNlcl9pZBgBIAEoCSIUChJEZWxldGVVc2VyUmVzcG9uc2UiuQIKCEFwaVRva2VuEgoKAmlkGAEgASgJEgwKBG5hbWUYAiABKAkSFAoMdG9rZW5fcHJlZml4GAMgASgJEhUKCGdyb3VwX2lkGAQgASgJSACIAQESFwoPcGVybWlzc2lvbl9rZXlzGAUgAygJEi4KCmV4cGlyZXNfYXQYBiABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wEjAKDGxhc3RfdXNlZF9hdBgHIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXASLgoKcmV2b2tlZF9hdBgIIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXASLgoKY3JlYXRlZF9hdBgJIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXBCCwoJX2dyb3VwX2lkIpIBChVDcmVhdGVBcGlUb2tlblJlcXVlc3QSDAoEbmFtZRgBIAEoCRIVCghncm91cF9pZBgCIAEoCUgAiAEBEhcKD3Blcm1pc3Npb25fa2V5cxgDIAMoCRIuCgpleHBpcmVzX2F0GAQgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcEILCglfZ3JvdXBfaWQiUAoWQ3JlYXRlQXBpVG9rZW5SZXNwb25zZRInCglhcGlfdG9rZW4YASABKAsyFC5oZGxjdHJsLnYxLkFwaVRva2VuEg0KBXRva2VuGAIgASgJIhYKFExpc3RBcGlUb2tlbnNSZXF1ZXN0IkEKFUxpc3RBcGlUb2tlbnNSZXNwb25zZRIoCgphcGlfdG9rZW5zGAEgAygLMhQuaGRsY3RybC52MS5BcGlUb2tlbiIjChVSZXZva2VBcGlUb2tlblJlcXVlc3QSCgoCaWQYASABKAkiGAoWUmV2b2tlQXBpVG9rZW5SZXNwb25zZSIWChRHZXRUb3RwU3RhdHVzUmVxdWVzdCJcChVHZXRUb3RwU3RhdHVzUmVzcG9uc2USDwoHZW5hYmxlZBgBIAEoCBIQCghyZXF1aXJlZBgCIAEoCBIgChhyZW1haW5pbmdfcmVjb3ZlcnlfY29kZXMYAyABKAUiLwoaQmVnaW5Ub3RwRW5yb2xsbWVudFJlcXVlc3QSEQoJY2hhbGxlbmdlGAEgASgJIj4KG0JlZ2luVG90cEVucm9sbG1lbnRSZXNwb25zZRIOCgZzZWNyZXQYASABKAkSDwoHa2V5X3VyaRgCIAEoCSI/ChxDb25maXJtVG90cEVucm9sbG1lbnRSZXF1ZXN0EhEKCWNoYWxsZW5nZRgBIAEoCRIMCgRjb2RlGAIgASgJImUKHUNvbmZpcm1Ub3RwRW5yb2xsbWVudFJlc3BvbnNlEhYKDnJlY292ZXJ5X2NvZGVzGAEgAygJEiwKBnRva2VucxgCIAEoCzIcLmhkbGN0cmwudjEuVG9rZW5TZXRSZXNwb25zZSIiChJEaXNhYmxlVG90cFJlcXVlc3QSDAoEY29kZRgBIAEoCSIVChNEaXNhYmxlVG90cFJlc3BvbnNlIuQBCgtVc2VyU2Vzc2lvbhIKCgJpZBgBIAEoCRISCgp1c2VyX2FnZW50GAIgASgJEhIKCmlwX2FkZHJlc3MYAyABKAkSLgoKY3JlYXRlZF9hdBgEIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXASMAoMbGFzdF9zZWVuX2F0GAUgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcBIuCgpleHBpcmVzX2F0GAYgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcBIPCgdjdXJyZW50GAcgASgIIhcKFUxpc3RNeVNlc3Npb25zUmVxdWVzdCJDChZMaXN0TXlTZXNzaW9uc1Jlc3BvbnNlEikKCHNlc3Npb25zGAEgAygLMhcuaGRsY3RybC52MS5Vc2VyU2Vzc2lvbiIiChRSZXZva2VTZXNzaW9uUmVxdWVzdBIKCgJpZBgBIAEoCSIXChVSZXZva2VTZXNzaW9uUmVzcG9uc2UyrhEKC1VzZXJTZXJ2aWNlElsKEkdldFRva2VuQnlQYXNzd29yZBIlLmhkbGN0cmwudjEuR2V0VG9rZW5CeVBhc3N3b3JkUmVxdWVzdBocLmhkbGN0cmwudjEuVG9rZW5TZXRSZXNwb25zZSIAEnoKGVZhbGlkYXRlUmVnaXN0cmF0aW9uVG9rZW4SLC5oZGxjdHJsLnYxLlZhbGlkYXRlUmVnaXN0cmF0aW9uVG9rZW5SZXF1ZXN0Gi0uaGRsY3RybC52MS5WYWxpZGF0ZVJlZ2lzdHJhdGlvblRva2VuUmVzcG9uc2UiABJZChFSZWdpc3RlcldpdGhUb2tlbhIkLmhkbGN0cmwudjEuUmVnaXN0ZXJXaXRoVG9rZW5SZXF1ZXN0GhwuaGRsY3RybC52MS5Ub2tlblNldFJlc3BvbnNlIgASXAoPR2V0TG9naW5PcHRpb25zEiIuaGRsY3RybC52MS5HZXRMb2dpbk9wdGlvbnNSZXF1ZXN0GiMuaGRsY3RybC52MS5HZXRMb2dpbk9wdGlvbnNSZXNwb25zZSIAElkKDkJlZ2luT2lkY0xvZ2luEiEuaGRsY3RybC52MS5CZWdpbk9pZGNMb2dpblJlcXVlc3QaIi5oZGxjdHJsLnYxLkJlZ2luT2lkY0xvZ2luUmVzcG9uc2UiABJZChFDb21wbGV0ZU9pZGNMb2dpbhIkLmhkbGN0cmwudjEuQ29tcGxldGVPaWRjTG9naW5SZXF1ZXN0GhwuaGRsY3RybC52MS5Ub2tlblNldFJlc3BvbnNlIgASVQoPVmVyaWZ5VG90cExvZ2luEiIuaGRsY3RybC52MS5WZXJpZnlUb3RwTG9naW5SZXF1ZXN0GhwuaGRsY3RybC52MS5Ub2tlblNldFJlc3BvbnNlIgASTwoMUmVmcmVzaFRva2VuEh8uaGRsY3RybC52MS5SZWZyZXNoVG9rZW5SZXF1ZXN0GhwuaGRsY3RybC52MS5Ub2tlblNldFJlc3BvbnNlIgASWQoOQ2hhbmdlUGFzc3dvcmQSIS5oZGxjdHJsLnYxLkNoYW5nZVBhc3N3b3JkUmVxdWVzdBoiLmhkbGN0cmwudjEuQ2hhbmdlUGFzc3dvcmRSZXNwb25zZSIAEkoKCUxpc3RVc2VycxIcLmhkbGN0cmwudjEuTGlzdFVzZXJzUmVxdWVzdBodLmhkbGN0cmwudjEuTGlzdFVzZXJzUmVzcG9uc2UiABJECgdHZXRVc2VyEhouaGRsY3RybC52MS5HZXRVc2VyUmVxdWVzdBobLmhkbGN0cmwudjEuR2V0VXNlclJlc3BvbnNlIgASdAoXQ3JlYXRlUmVnaXN0cmF0aW9uVG9rZW4SKi5oZGxjdHJsLnYxLkNyZWF0ZVJlZ2lzdHJhdGlvblRva2VuUmVxdWVzdBorLmhkbGN0cmwudjEuQ3JlYXRlUmVnaXN0cmF0aW9uVG9rZW5SZXNwb25zZSIAEk0KCkRlbGV0ZVVzZXISHS5oZGxjdHJsLnYxLkRlbGV0ZVVzZXJSZXF1ZXN0Gh4uaGRsY3RybC52MS5EZWxldGVVc2VyUmVzcG9uc2UiABJZCg5DcmVhdGVBcGlUb2tlbhIhLmhkbGN0cmwudjEuQ3JlYXRlQXBpVG9rZW5SZXF1ZXN0GiIuaGRsY3RybC52MS5DcmVhdGVBcGlUb2tlblJlc3BvbnNlIgASVgoNTGlzdEFwaVRva2VucxIgLmhkbGN0cmwudjEuTGlzdEFwaVRva2Vuc1JlcXVlc3QaIS5oZGxjdHJsLnYxLkxpc3RBcGlUb2tlbnNSZXNwb25zZSIAElkKDlJldm9rZUFwaVRva2VuEiEuaGRsY3RybC52MS5SZXZva2VBcGlUb2tlblJlcXVlc3QaIi5oZGxjdHJsLnYxLlJldm9rZUFwaVRva2VuUmVzcG9uc2UiABJWCg1HZXRUb3RwU3RhdHVzEiAuaGRsY3RybC52MS5HZXRUb3RwU3RhdHVzUmVxdWVzdBohLmhkbGN0cmwudjEuR2V0VG90cFN0YXR1c1Jlc3BvbnNlIgASaAoTQmVnaW5Ub3RwRW5yb2xsbWVudBImLmhkbGN0cmwudjEuQmVnaW5Ub3RwRW5yb2xsbWVudFJlcXVlc3QaJy5oZGxjdHJsLnYxLkJlZ2luVG90cEVucm9sbG1lbnRSZXNwb25zZSIAEm4KFUNvbmZpcm1Ub3RwRW5yb2xsbWVudBIoLmhkbGN0cmwudjEuQ29uZmlybVRvdHBFbnJvbGxtZW50UmVxdWVzdBopLmhkbGN0cmwudjEuQ29uZmlybVRvdHBFbnJvbGxtZW50UmVzcG9uc2UiABJQCgtEaXNhYmxlVG90cBIeLmhkbGN0cmwudjEuRGlzYWJsZVRvdHBSZXF1ZXN0Gh8uaGRsY3RybC52MS5EaXNhYmxlVG90cFJlc3BvbnNlIgASWQoOTGlzdE15U2Vzc2lvbnMSIS5oZGxjdHJsLnYxLkxpc3RNeVNlc3Npb25zUmVxdWVzdBoiLmhkbGN0cmwudjEuTGlzdE15U2Vzc2lvbnNSZXNwb25zZSIAElYKDVJldm9rZVNlc3Npb24SIC5oZGxjdHJsLnYxLlJldm9rZVNlc3Npb25SZXF1ZXN0GiEuaGRsY3RybC52MS5SZXZva2VTZXNzaW9uUmVzcG9uc2UiABJXCg1CZWdpbk9pZGNMaW5rEiAuaGRsY3RybC52MS5CZWdpbk9pZGNMaW5rUmVxdWVzdBoiLmhkbGN0cmwudjEuQmVnaW5PaWRjTG9naW5SZXNwb25zZSIAEl8KEENvbXBsZXRlT2lkY0xpbmsSIy5oZGxjdHJsLnYxLkNvbXBsZXRlT2lkY0xpbmtSZXF1ZXN0GiQuaGRsY3RybC52MS5Db21wbGV0ZU9pZGNMaW5rUmVzcG9uc2UiAEK3AQoOY29tLmhkbGN0cmwudjFCCVVzZXJQcm90b1ABWlFnaXRodWIuY29tL2hhbnRhYmFydTEwMTQvYmFydS1yZXNvLWhlYWRsZXNzLWNvbnRyb2xsZXIvcGJnZW4vaGRsY3RybC92MTtoZGxjdHJsdjGiAgNIWFiqAgpIZGxjdHJsLlYxygIKSGRsY3RybFxWMeICFkhkbGN0cmxcVjFcR1BCTWV0YWRhdGHqAgtIZGxjdHJsOjpWMWIGcHJvdG8z", [file_google_protobuf_timestamp]);
 
 /**
  * @generated from message hdlctrl.v1.TokenSetResponse
@@ -27,6 +27,21 @@ export type TokenSetResponse = Message<"hdlctrl.v1.TokenSetResponse"> & {
    * @generated from field: string refresh_token = 2;
    */
   refreshToken: string;
+
+  /**
+   * 2 段階認証が必要なときだけ、token / refresh_token の代わりにセットされる.
+   * VerifyTotpLogin に渡してサインインを完了する
+   *
+   * @generated from field: string totp_challenge = 3;
+   */
+  totpChallenge: string;
+
+  /**
+   * true なら TOTP が未登録で、challenge を使って先に登録する必要がある
+   *
+   * @generated from field: bool totp_enrollment_required = 4;
+   */
+  totpEnrollmentRequired: boolean;
 };
 
 /**
@@ -58,6 +73,174 @@ export type GetTokenByPasswordRequest = Message<"hdlctrl.v1.GetTokenByPasswordRe
 export const GetTokenByPasswordRequestSchema: GenMessage<GetTokenByPasswordRequest> = /*@__PURE__*/
   messageDesc(file_hdlctrl_v1_user, 1);
 
+/**
+ * @generated from message hdlctrl.v1.GetLoginOptionsRequest
+ */
+export type GetLoginOptionsRequest = Message<"hdlctrl.v1.GetLoginOptionsRequest"> & {
+};
+
+/**
+ * Describes the message hdlctrl.v1.GetLoginOptionsRequest.
+ * Use `create(GetLoginOptionsRequestSchema)` to create a new message.
+ */
+export const GetLoginOptionsRequestSchema: GenMessage<GetLoginOptionsRequest> = /*@__PURE__*/
+  messageDesc(file_hdlctrl_v1_user, 2);
+
+/**
+ * @generated from message hdlctrl.v1.GetLoginOptionsResponse
+ */
+export type GetLoginOptionsResponse = Message<"hdlctrl.v1.GetLoginOptionsResponse"> & {
+  /**
+   * @generated from field: bool oidc_enabled = 1;
+   */
+  oidcEnabled: boolean;
+
+  /**
+   * サインインボタンに出す IdP の名前
+   *
+   * @generated from field: string oidc_provider_name = 2;
+   */
+  oidcProviderName: string;
+};
+
+/**
+ * Describes the message hdlctrl.v1.GetLoginOptionsResponse.
+ * Use `create(GetLoginOptionsResponseSchema)` to create a new message.
+ */
+export const GetLoginOptionsResponseSchema: GenMessage<GetLoginOptionsResponse> = /*@__PURE__*/
+  messageDesc(file_hdlctrl_v1_user, 3);
+
+/**
+ * @generated from message hdlctrl.v1.BeginOidcLoginRequest
+ */
+export type BeginOidcLoginRequest = Message<"hdlctrl.v1.BeginOidcLoginRequest"> & {
+};
+
+/**
+ * Describes the message hdlctrl.v1.BeginOidcLoginRequest.
+ * Use `create(BeginOidcLoginRequestSchema)` to create a new message.
+ */
+export const BeginOidcLoginRequestSchema: GenMessage<BeginOidcLoginRequest> = /*@__PURE__*/
+  messageDesc(file_hdlctrl_v1_user, 4);
+
+/**
+ * @generated from message hdlctrl.v1.BeginOidcLoginResponse
+ */
+export type BeginOidcLoginResponse = Message<"hdlctrl.v1.BeginOidcLoginResponse"> & {
+  /**
+   * @generated from field: string authorization_url = 1;
+   */
+  authorizationUrl: string;
+
+  /**
+   * コールバックで戻ってきた state がこれと一致することをクライアントで確認する
+   *
+   * @generated from field: string state = 2;
+   */
+  state: string;
+};
+
+/**
+ * Describes the message hdlctrl.v1.BeginOidcLoginResponse.
+ * Use `create(BeginOidcLoginResponseSchema)` to create a new message.
+ */
+export const BeginOidcLoginResponseSchema: GenMessage<BeginOidcLoginResponse> = /*@__PURE__*/
+  messageDesc(file_hdlctrl_v1_user, 5);
+
+/**
+ * @generated from message hdlctrl.v1.CompleteOidcLoginRequest
+ */
+export type CompleteOidcLoginRequest = Message<"hdlctrl.v1.CompleteOidcLoginRequest"> & {
+  /**
+   * @generated from field: string code = 1;
+   */
+  code: string;
+
+  /**
+   * @generated from field: string state = 2;
+   */
+  state: string;
+};
+
+/**
+ * Describes the message hdlctrl.v1.CompleteOidcLoginRequest.
+ * Use `create(CompleteOidcLoginRequestSchema)` to create a new message.
+ */
+export const CompleteOidcLoginRequestSchema: GenMessage<CompleteOidcLoginRequest> = /*@__PURE__*/
+  messageDesc(file_hdlctrl_v1_user, 6);
+
+/**
+ * @generated from message hdlctrl.v1.BeginOidcLinkRequest
+ */
+export type BeginOidcLinkRequest = Message<"hdlctrl.v1.BeginOidcLinkRequest"> & {
+};
+
+/**
+ * Describes the message hdlctrl.v1.BeginOidcLinkRequest.
+ * Use `create(BeginOidcLinkRequestSchema)` to create a new message.
+ */
+export const BeginOidcLinkRequestSchema: GenMessage<BeginOidcLinkRequest> = /*@__PURE__*/
+  messageDesc(file_hdlctrl_v1_user, 7);
+
+/**
+ * @generated from message hdlctrl.v1.CompleteOidcLinkRequest
+ */
+export type CompleteOidcLinkRequest = Message<"hdlctrl.v1.CompleteOidcLinkRequest"> & {
+  /**
+   * @generated from field: string code = 1;
+   */
+  code: string;
+
+  /**
+   * @generated from field: string state = 2;
+   */
+  state: string;
+};
+
+/**
+ * Describes the message hdlctrl.v1.CompleteOidcLinkRequest.
+ * Use `create(CompleteOidcLinkRequestSchema)` to create a new message.
+ */
+export const CompleteOidcLinkRequestSchema: GenMessage<CompleteOidcLinkRequest> = /*@__PURE__*/
+  messageDesc(file_hdlctrl_v1_user, 8);
+
+/**
+ * @generated from message hdlctrl.v1.CompleteOidcLinkResponse
+ */
+export type CompleteOidcLinkResponse = Message<"hdlctrl.v1.CompleteOidcLinkResponse"> & {
+};
+
+/**
+ * Describes the message hdlctrl.v1.CompleteOidcLinkResponse.
+ * Use `create(CompleteOidcLinkResponseSchema)` to create a new message.
+ */
+export const CompleteOidcLinkResponseSchema: GenMessage<CompleteOidcLinkResponse> = /*@__PURE__*/
+  messageDesc(file_hdlctrl_v1_user, 9);
+
+/**
+ * @generated from message hdlctrl.v1.VerifyTotpLoginRequest
+ */
+export type VerifyTotpLoginRequest = Message<"hdlctrl.v1.VerifyTotpLoginRequest"> & {
+  /**
+   * @generated from field: string challenge = 1;
+   */
+  challenge: string;
+
+  /**
+   * 6 桁のコードかリカバリーコード
+   *
+   * @generated from field: string code = 2;
+   */
+  code: string;
+};
+
+/**
+ * Describes the message hdlctrl.v1.VerifyTotpLoginRequest.
+ * Use `create(VerifyTotpLoginRequestSchema)` to create a new message.
+ */
+export const VerifyTotpLoginRequestSchema: GenMessage<VerifyTotpLoginRequest> = /*@__PURE__*/
+  messageDesc(file_hdlctrl_v1_user, 10);
+
 /**
  * 既に持っているトークンをheaderに付与してリクエストする
  *
@@ -71,7 +254,7 @@ export type RefreshTokenRequest = Message<"hdlctrl.v1.RefreshTokenRequest"> & {
  * Use `create(RefreshTokenRequestSchema)` to create a new message.
  */
 export const RefreshTokenRequestSchema: GenMessage<RefreshTokenRequest> = /*@__PURE__*/
-  messageDesc(file_hdlctrl_v1_user, 2);
+  messageDesc(file_hdlctrl_v1_user, 11);
 
 /**
  * @generated from message hdlctrl.v1.ValidateRegistrationTokenRequest
@@ -88,7 +271,7 @@ export type ValidateRegistrationTokenRequest = Message<"hdlctrl.v1.ValidateRegis
  * Use `create(ValidateRegistrationTokenRequestSchema)` to create a new message.
  */
 export const ValidateRegistrationTokenRequestSchema: GenMessage<ValidateRegistrationTokenRequest> = /*@__PURE__*/
-  messageDesc(file_hdlctrl_v1_user, 3);
+  messageDesc(file_hdlctrl_v1_user, 12);
 
 /**
  * @generated from message hdlctrl.v1.ValidateRegistrationTokenResponse
@@ -120,7 +303,7 @@ export type ValidateRegistrationTokenResponse = Message<"hdlctrl.v1.ValidateRegi
  * Use `create(ValidateRegistrationTokenResponseSchema)` to create a new message.
  */
 export const ValidateRegistrationTokenResponseSchema: GenMessage<ValidateRegistrationTokenResponse> = /*@__PURE__*/
-  messageDesc(file_hdlctrl_v1_user, 4);
+  messageDesc(file_hdlctrl_v1_user, 13);
 
 /**
  * @generated from message hdlctrl.v1.RegisterWithTokenRequest
@@ -147,7 +330,7 @@ export type RegisterWithTokenRequest = Message<"hdlctrl.v1.RegisterWithTokenRequ
  * Use `create(RegisterWithTokenRequestSchema)` to create a new message.
  */
 export const RegisterWithTokenRequestSchema: GenMessage<RegisterWithTokenRequest> = /*@__PURE__*/
-  messageDesc(file_hdlctrl_v1_user, 5);
+  messageDesc(file_hdlctrl_v1_user, 14);
 
 /**
  * @generated from message hdlctrl.v1.ChangePasswordRequest
@@ -169,7 +352,7 @@ export type ChangePasswordRequest = Message<"hdlctrl.v1.ChangePasswordRequest">
  * Use `create(ChangePasswordRequestSchema)` to create a new message.
  */
 export const ChangePasswordRequestSchema: GenMessage<ChangePasswordRequest> = /*@__PURE__*/
-  messageDesc(file_hdlctrl_v1_user, 6);
+  messageDesc(file_hdlctrl_v1_user, 15);
 
 /**
  * @generated from message hdlctrl.v1.ChangePasswordResponse
@@ -182,7 +365,7 @@ export type ChangePasswordResponse = Message<"hdlctrl.v1.ChangePasswordResponse"
  * Use `create(ChangePasswordResponseSchema)` to create a new message.
  */
 export const ChangePasswordResponseSchema: GenMessage<ChangePasswordResponse> = /*@__PURE__*/
-  messageDesc(file_hdlctrl_v1_user, 7);
+  messageDesc(file_hdlctrl_v1_user, 16);
 
 /**
  * システム上のユーザーアカウント.
@@ -221,7 +404,7 @@ export type User = Message<"hdlctrl.v1.User"> & {
  * Use `create(UserSchema)` to create a new message.
  */
 export const UserSchema: GenMessage<User> = /*@__PURE__*/
-  messageDesc(file_hdlctrl_v1_user, 8);
+  messageDesc(file_hdlctrl_v1_user, 17);
 
 /**
  * @generated from message hdlctrl.v1.ListUsersRequest
@@ -234,7 +417,7 @@ export type ListUsersRequest = Message<"hdlctrl.v1.ListUsersRequest"> & {
  * Use `create(ListUsersRequestSchema)` to create a new message.
  */
 export const ListUsersRequestSchema: GenMessage<ListUsersRequest> = /*@__PURE__*/
-  messageDesc(file_hdlctrl_v1_user, 9);
+  messageDesc(file_hdlctrl_v1_user, 18);
 
 /**
  * @generated from message hdlctrl.v1.ListUsersResponse
@@ -251,7 +434,7 @@ export type ListUsersResponse = Message<"hdlctrl.v1.ListUsersResponse"> & {
  * Use `create(ListUsersResponseSchema)` to create a new message.
  */
 export const ListUsersResponseSchema: GenMessage<ListUsersResponse> = /*@__PURE__*/
-  messageDesc(file_hdlctrl_v1_user, 10);
+  messageDesc(file_hdlctrl_v1_user, 19);
 
 /**
  * @generated from message hdlctrl.v1.GetUserRequest
@@ -268,7 +451,7 @@ export type GetUserRequest = Message<"hdlctrl.v1.GetUserRequest"> & {
  * Use `create(GetUserRequestSchema)` to create a new message.
  */
 export const GetUserRequestSchema: GenMessage<GetUserRequest> = /*@__PURE__*/
-  messageDesc(file_hdlctrl_v1_user, 11);
+  messageDesc(file_hdlctrl_v1_user, 20);
 
 /**
  * @generated from message hdlctrl.v1.GetUserResponse
@@ -285,7 +468,7 @@ export type GetUserResponse = Message<"hdlctrl.v1.GetUserResponse"> & {
  * Use `create(GetUserResponseSchema)` to create a new message.
  */
 export const GetUserResponseSchema: GenMessage<GetUserResponse> = /*@__PURE__*/
-  messageDesc(file_hdlctrl_v1_user, 12);
+  messageDesc(file_hdlctrl_v1_user, 21);
 
 /**
  * @generated from message hdlctrl.v1.CreateRegistrationTokenRequest
@@ -310,7 +493,7 @@ export type CreateRegistrationTokenRequest = Message<"hdlctrl.v1.CreateRegistrat
  * Use `create(CreateRegistrationTokenRequestSchema)` to create a new message.
  */
 export const CreateRegistrationTokenRequestSchema: GenMessage<CreateRegistrationTokenRequest> = /*@__PURE__*/
-  messageDesc(file_hdlctrl_v1_user, 13);
+  messageDesc(file_hdlctrl_v1_user, 22);
 
 /**
  * @generated from message hdlctrl.v1.CreateRegistrationTokenResponse
@@ -342,7 +525,7 @@ export type CreateRegistrationTokenResponse = Message<"hdlctrl.v1.CreateRegistra
  * Use `create(CreateRegistrationTokenResponseSchema)` to create a new message.
  */
 export const CreateRegistrationTokenResponseSchema: GenMessage<CreateRegistrationTokenResponse> = /*@__PURE__*/
-  messageDesc(file_hdlctrl_v1_user, 14);
+  messageDesc(file_hdlctrl_v1_user, 23);
 
 /**
  * @generated from message hdlctrl.v1.DeleteUserRequest
@@ -359,7 +542,7 @@ export type DeleteUserRequest = Message<"hdlctrl.v1.DeleteUserRequest"> & {
  * Use `create(DeleteUserRequestSchema)` to create a new message.
  */
 export const DeleteUserRequestSchema: GenMessage<DeleteUserRequest> = /*@__PURE__*/
-  messageDesc(file_hdlctrl_v1_user, 15);
+  messageDesc(file_hdlctrl_v1_user, 24);
 
 /**
  * @generated from message hdlctrl.v1.DeleteUserResponse
@@ -372,7 +555,472 @@ export type DeleteUserResponse = Message<"hdlctrl.v1.DeleteUserResponse"> & {
  * Use `create(DeleteUserResponseSchema)` to create a new message.
  */
 export const DeleteUserResponseSchema: GenMessage<DeleteUserResponse> = /*@__PURE__*/
-  messageDesc(file_hdlctrl_v1_user, 16);
+  messageDesc(file_hdlctrl_v1_user, 25);
+
+/**
+ * API トークン. トークンの平文は CreateApiTokenResponse でしか返さない.
+ *
+ * @generated from message hdlctrl.v1.ApiToken
+ */
+export type ApiToken = Message<"hdlctrl.v1.ApiToken"> & {
+  /**
+   * @generated from field: string id = 1;
+   */
+  id: string;
+
+  /**
+   * @generated from field: string name = 2;
+   */
+  name: string;
+
+  /**
+   * トークンを見分けるための先頭部分 (例: "brhc_AbC123").
+   *
+   * @generated from field: string token_prefix = 3;
+   */
+  tokenPrefix: string;
+
+  /**
+   * 指定されていればこのグループの操作だけに制限される.
+   *
+   * @generated from field: optional string group_id = 4;
+   */
+  groupId?: string;
+
+  /**
+   * 空でなければこの permission key の操作だけに制限される.
+   *
+   * @generated from field: repeated string permission_keys = 5;
+   */
+  permissionKeys: string[];
+
+  /**
+   * 未設定なら無期限.
+   *
+   * @generated from field: google.protobuf.Timestamp expires_at = 6;
+   */
+  expiresAt?: Timestamp;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp last_used_at = 7;
+   */
+  lastUsedAt?: Timestamp;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp revoked_at = 8;
+   */
+  revokedAt?: Timestamp;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp created_at = 9;
+   */
+  createdAt?: Timestamp;
+};
+
+/**
+ * Describes the message hdlctrl.v1.ApiToken.
+ * Use `create(ApiTokenSchema)` to create a new message.
+ */
+export const ApiTokenSchema: GenMessage<ApiToken> = /*@__PURE__*/
+  messageDesc(file_hdlctrl_v1_user, 26);
+
+/**
+ * @generated from message hdlctrl.v1.CreateApiTokenRequest
+ */
+export type CreateApiTokenRequest = Message<"hdlctrl.v1.CreateApiTokenRequest"> & {
+  /**
+   * @generated from field: string name = 1;
+   */
+  name: string;
+
+  /**
+   * @generated from field: optional string group_id = 2;
+   */
+  groupId?: string;
+
+  /**
+   * グループ指定時は system:* を含められない.
+   *
+   * @generated from field: repeated string permission_keys = 3;
+   */
+  permissionKeys: string[];
+
+  /**
+   * 未設定なら無期限.
+   *
+   * @generated from field: google.protobuf.Timestamp expires_at = 4;
+   */
+  expiresAt?: Timestamp;
+};
+
+/**
+ * Describes the message hdlctrl.v1.CreateApiTokenRequest.
+ * Use `create(CreateApiTokenRequestSchema)` to create a new message.
+ */
+export const CreateApiTokenRequestSchema: GenMessage<CreateApiTokenRequest> = /*@__PURE__*/
+  messageDesc(file_hdlctrl_v1_user, 27);
+
+/**
+ * @generated from message hdlctrl.v1.CreateApiTokenResponse
+ */
+export type CreateApiTokenResponse = Message<"hdlctrl.v1.CreateApiTokenResponse"> & {
+  /**
+   * @generated from field: hdlctrl.v1.ApiToken api_token = 1;
+   */
+  apiToken?: ApiToken;
+
+  /**
+   * トークンの平文. 再取得はできない.
+   *
+   * @generated from field: string token = 2;
+   */
+  token: string;
+};
+
+/**
+ * Describes the message hdlctrl.v1.CreateApiTokenResponse.
+ * Use `create(CreateApiTokenResponseSchema)` to create a new message.
+ */
+export const CreateApiTokenResponseSchema: GenMessage<CreateApiTokenResponse> = /*@__PURE__*/
+  messageDesc(file_hdlctrl_v1_user, 28);
+
+/**
+ * @generated from message hdlctrl.v1.ListApiTokensRequest
+ */
+export type ListApiTokensRequest = Message<"hdlctrl.v1.ListApiTokensRequest"> & {
+};
+
+/**
+ * Describes the message hdlctrl.v1.ListApiTokensRequest.
+ * Use `create(ListApiTokensRequestSchema)` to create a new message.
+ */
+export const ListApiTokensRequestSchema: GenMessage<ListApiTokensRequest> = /*@__PURE__*/
+  messageDesc(file_hdlctrl_v1_user, 29);
+
+/**
+ * @generated from message hdlctrl.v1.ListApiTokensResponse
+ */
+export type ListApiTokensResponse = Message<"hdlctrl.v1.ListApiTokensResponse"> & {
+  /**
+   * @generated from field: repeated hdlctrl.v1.ApiToken api_tokens = 1;
+   */
+  apiTokens: ApiToken[];
+};
+
+/**
+ * Describes the message hdlctrl.v1.ListApiTokensResponse.
+ * Use `create(ListApiTokensResponseSchema)` to create a new message.
+ */
+export const ListApiTokensResponseSchema: GenMessage<ListApiTokensResponse> = /*@__PURE__*/
+  messageDesc(file_hdlctrl_v1_user, 30);
+
+/**
+ * @generated from message hdlctrl.v1.RevokeApiTokenRequest
+ */
+export type RevokeApiTokenRequest = Message<"hdlctrl.v1.RevokeApiTokenRequest"> & {
+  /**
+   * @generated from field: string id = 1;
+   */
+  id: string;
+};
+
+/**
+ * Describes the message hdlctrl.v1.RevokeApiTokenRequest.
+ * Use `create(RevokeApiTokenRequestSchema)` to create a new message.
+ */
+export const RevokeApiTokenRequestSchema: GenMessage<RevokeApiTokenRequest> = /*@__PURE__*/
+  messageDesc(file_hdlctrl_v1_user, 31);
+
+/**
+ * @generated from message hdlctrl.v1.RevokeApiTokenResponse
+ */
+export type RevokeApiTokenResponse = Message<"hdlctrl.v1.RevokeApiTokenResponse"> & {
+};
+
+/**
+ * Describes the message hdlctrl.v1.RevokeApiTokenResponse.
+ * Use `create(RevokeApiTokenResponseSchema)` to create a new message.
+ */
+export const RevokeApiTokenResponseSchema: GenMessage<RevokeApiTokenResponse> = /*@__PURE__*/
+  messageDesc(file_hdlctrl_v1_user, 32);
+
+/**
+ * @generated from message hdlctrl.v1.GetTotpStatusRequest
+ */
+export type GetTotpStatusRequest = Message<"hdlctrl.v1.GetTotpStatusRequest"> & {
+};
+
+/**
+ * Describes the message hdlctrl.v1.GetTotpStatusRequest.
+ * Use `create(GetTotpStatusRequestSchema)` to create a new message.
+ */
+export const GetTotpStatusRequestSchema: GenMessage<GetTotpStatusRequest> = /*@__PURE__*/
+  messageDesc(file_hdlctrl_v1_user, 33);
+
+/**
+ * @generated from message hdlctrl.v1.GetTotpStatusResponse
+ */
+export type GetTotpStatusResponse = Message<"hdlctrl.v1.GetTotpStatusResponse"> & {
+  /**
+   * @generated from field: bool enabled = 1;
+   */
+  enabled: boolean;
+
+  /**
+   * true なら system scope のロールを持つため無効にできない
+   *
+   * @generated from field: bool required = 2;
+   */
+  required: boolean;
+
+  /**
+   * @generated from field: int32 remaining_recovery_codes = 3;
+   */
+  remainingRecoveryCodes: number;
+};
+
+/**
+ * Describes the message hdlctrl.v1.GetTotpStatusResponse.
+ * Use `create(GetTotpStatusResponseSchema)` to create a new message.
+ */
+export const GetTotpStatusResponseSchema: GenMessage<GetTotpStatusResponse> = /*@__PURE__*/
+  messageDesc(file_hdlctrl_v1_user, 34);
+
+/**
+ * @generated from message hdlctrl.v1.BeginTotpEnrollmentRequest
+ */
+export type BeginTotpEnrollmentRequest = Message<"hdlctrl.v1.BeginTotpEnrollmentRequest"> & {
+  /**
+   * サインイン前に登録するときだけ指定する
+   *
+   * @generated from field: string challenge = 1;
+   */
+  challenge: string;
+};
+
+/**
+ * Describes the message hdlctrl.v1.BeginTotpEnrollmentRequest.
+ * Use `create(BeginTotpEnrollmentRequestSchema)` to create a new message.
+ */
+export const BeginTotpEnrollmentRequestSchema: GenMessage<BeginTotpEnrollmentRequest> = /*@__PURE__*/
+  messageDesc(file_hdlctrl_v1_user, 35);
+
+/**
+ * @generated from message hdlctrl.v1.BeginTotpEnrollmentResponse
+ */
+export type BeginTotpEnrollmentResponse = Message<"hdlctrl.v1.BeginTotpEnrollmentResponse"> & {
+  /**
+   * 手入力用の base32 文字列
+   *
+   * @generated from field: string secret = 1;
+   */
+  secret: string;
+
+  /**
+   * QR コードにする otpauth:// URI
+   *
+   * @generated from field: string key_uri = 2;
+   */
+  keyUri: string;
+};
+
+/**
+ * Describes the message hdlctrl.v1.BeginTotpEnrollmentResponse.
+ * Use `create(BeginTotpEnrollmentResponseSchema)` to create a new message.
+ */
+export const BeginTotpEnrollmentResponseSchema: GenMessage<BeginTotpEnrollmentResponse> = /*@__PURE__*/
+  messageDesc(file_hdlctrl_v1_user, 36);
+
+/**
+ * @generated from message hdlctrl.v1.ConfirmTotpEnrollmentRequest
+ */
+export type ConfirmTotpEnrollmentRequest = Message<"hdlctrl.v1.ConfirmTotpEnrollmentRequest"> & {
+  /**
+   * @generated from field: string challenge = 1;
+   */
+  challenge: string;
+
+  /**
+   * @generated from field: string code = 2;
+   */
+  code: string;
+};
+
+/**
+ * Describes the message hdlctrl.v1.ConfirmTotpEnrollmentRequest.
+ * Use `create(ConfirmTotpEnrollmentRequestSchema)` to create a new message.
+ */
+export const ConfirmTotpEnrollmentRequestSchema: GenMessage<ConfirmTotpEnrollmentRequest> = /*@__PURE__*/
+  messageDesc(file_hdlctrl_v1_user, 37);
+
+/**
+ * @generated from message hdlctrl.v1.ConfirmTotpEnrollmentResponse
+ */
+export type ConfirmTotpEnrollmentResponse = Message<"hdlctrl.v1.ConfirmTotpEnrollmentResponse"> & {
+  /**
+   * リカバリーコード. 再取得はできない.
+   *
+   * @generated from field: repeated string recovery_codes = 1;
+   */
+  recoveryCodes: string[];
+
+  /**
+   * challenge で登録したときはそのままサインインできるようトークンを返す
+   *
+   * @generated from field: hdlctrl.v1.TokenSetResponse tokens = 2;
+   */
+  tokens?: TokenSetResponse;
+};
+
+/**
+ * Describes the message hdlctrl.v1.ConfirmTotpEnrollmentResponse.
+ * Use `create(ConfirmTotpEnrollmentResponseSchema)` to create a new message.
+ */
+export const ConfirmTotpEnrollmentResponseSchema: GenMessage<ConfirmTotpEnrollmentResponse> = /*@__PURE__*/
+  messageDesc(file_hdlctrl_v1_user, 38);
+
+/**
+ * @generated from message hdlctrl.v1.DisableTotpRequest
+ */
+export type DisableTotpRequest = Message<"hdlctrl.v1.DisableTotpRequest"> & {
+  /**
+   * 本人確認のための 6 桁のコードかリカバリーコード
+   *
+   * @generated from field: string code = 1;
+   */
+  code: string;
+};
+
+/**
+ * Describes the message hdlctrl.v1.DisableTotpRequest.
+ * Use `create(DisableTotpRequestSchema)` to create a new message.
+ */
+export const DisableTotpRequestSchema: GenMessage<DisableTotpRequest> = /*@__PURE__*/
+  messageDesc(file_hdlctrl_v1_user, 39);
+
+/**
+ * @generated from message hdlctrl.v1.DisableTotpResponse
+ */
+export type DisableTotpResponse = Message<"hdlctrl.v1.DisableTotpResponse"> & {
+};
+
+/**
+ * Describes the message hdlctrl.v1.DisableTotpResponse.
+ * Use `create(DisableTotpResponseSchema)` to create a new message.
+ */
+export const DisableTotpResponseSchema: GenMessage<DisableTotpResponse> = /*@__PURE__*/
+  messageDesc(file_hdlctrl_v1_user, 40);
+
+/**
+ * @generated from message hdlctrl.v1.UserSession
+ */
+export type UserSession = Message<"hdlctrl.v1.UserSession"> & {
+  /**
+   * @generated from field: string id = 1;
+   */
+  id: string;
+
+  /**
+   * @generated from field: string user_agent = 2;
+   */
+  userAgent: string;
+
+  /**
+   * 最後に refresh したときの接続元
+   *
+   * @generated from field: string ip_address = 3;
+   */
+  ipAddress: string;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp created_at = 4;
+   */
+  createdAt?: Timestamp;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp last_seen_at = 5;
+   */
+  lastSeenAt?: Timestamp;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp expires_at = 6;
+   */
+  expiresAt?: Timestamp;
+
+  /**
+   * このリクエストのセッションなら true
+   *
+   * @generated from field: bool current = 7;
+   */
+  current: boolean;
+};
+
+/**
+ * Describes the message hdlctrl.v1.UserSession.
+ * Use `create(UserSessionSchema)` to create a new message.
+ */
+export const UserSessionSchema: GenMessage<UserSession> = /*@__PURE__*/
+  messageDesc(file_hdlctrl_v1_user, 41);
+
+/**
+ * @generated from message hdlctrl.v1.ListMySessionsRequest
+ */
+export type ListMySessionsRequest = Message<"hdlctrl.v1.ListMySessionsRequest"> & {
+};
+
+/**
+ * Describes the message hdlctrl.v1.ListMySessionsRequest.
+ * Use `create(ListMySessionsRequestSchema)` to create a new message.
+ */
+export const ListMySessionsRequestSchema: GenMessage<ListMySessionsRequest> = /*@__PURE__*/
+  messageDesc(file_hdlctrl_v1_user, 42);
+
+/**
+ * @generated from message hdlctrl.v1.ListMySessionsResponse
+ */
+export type ListMySessionsResponse = Message<"hdlctrl.v1.ListMySessionsResponse"> & {
+  /**
+   * @generated from field: repeated hdlctrl.v1.UserSession sessions = 1;
+   */
+  sessions: UserSession[];
+};
+
+/**
+ * Describes the message hdlctrl.v1.ListMySessionsResponse.
+ * Use `create(ListMySessionsResponseSchema)` to create a new message.
+ */
+export const ListMySessionsResponseSchema: GenMessage<ListMySessionsResponse> = /*@__PURE__*/
+  messageDesc(file_hdlctrl_v1_user, 43);
+
+/**
+ * @generated from message hdlctrl.v1.RevokeSessionRequest
+ */
+export type RevokeSessionRequest = Message<"hdlctrl.v1.RevokeSessionRequest"> & {
+  /**
+   * @generated from field: string id = 1;
+   */
+  id: string;
+};
+
+/**
+ * Describes the message hdlctrl.v1.RevokeSessionRequest.
+ * Use `create(RevokeSessionRequestSchema)` to create a new message.
+ */
+export const RevokeSessionRequestSchema: GenMessage<RevokeSessionRequest> = /*@__PURE__*/
+  messageDesc(file_hdlctrl_v1_user, 44);
+
+/**
+ * @generated from message hdlctrl.v1.RevokeSessionResponse
+ */
+export type RevokeSessionResponse = Message<"hdlctrl.v1.RevokeSessionResponse"> & {
+};
+
+/**
+ * Describes the message hdlctrl.v1.RevokeSessionResponse.
+ * Use `create(RevokeSessionResponseSchema)` to create a new message.
+ */
+export const RevokeSessionResponseSchema: GenMessage<RevokeSessionResponse> = /*@__PURE__*/
+  messageDesc(file_hdlctrl_v1_user, 45);
 
 /**
  * @generated from service hdlctrl.v1.UserService
@@ -404,6 +1052,46 @@ export const UserService: GenService<{
     input: typeof RegisterWithTokenRequestSchema;
     output: typeof TokenSetResponseSchema;
   },
+  /**
+   * サインイン画面に出す選択肢 (SSO の有無)
+   *
+   * @generated from rpc hdlctrl.v1.UserService.GetLoginOptions
+   */
+  getLoginOptions: {
+    methodKind: "unary";
+    input: typeof GetLoginOptionsRequestSchema;
+    output: typeof GetLoginOptionsResponseSchema;
+  },
+  /**
+   * OIDC (認可コード + PKCE) でのサインイン. Begin で得た URL に遷移し、
+   * IdP から戻ってきた code / state で Complete する
+   *
+   * @generated from rpc hdlctrl.v1.UserService.BeginOidcLogin
+   */
+  beginOidcLogin: {
+    methodKind: "unary";
+    input: typeof BeginOidcLoginRequestSchema;
+    output: typeof BeginOidcLoginResponseSchema;
+  },
+  /**
+   * @generated from rpc hdlctrl.v1.UserService.CompleteOidcLogin
+   */
+  completeOidcLogin: {
+    methodKind: "unary";
+    input: typeof CompleteOidcLoginRequestSchema;
+    output: typeof TokenSetResponseSchema;
+  },
+  /**
+   * GetTokenByPassword が totp_challenge を返したときに、認証アプリのコードか
+   * リカバリーコードを送ってサインインを完了する
+   *
+   * @generated from rpc hdlctrl.v1.UserService.VerifyTotpLogin
+   */
+  verifyTotpLogin: {
+    methodKind: "unary";
+    input: typeof VerifyTotpLoginRequestSchema;
+    output: typeof TokenSetResponseSchema;
+  },
   /**
    * 認証付きRPC
    *
@@ -465,6 +1153,118 @@ export const UserService: GenService<{
     input: typeof DeleteUserRequestSchema;
     output: typeof DeleteUserResponseSchema;
   },
+  /**
+   * API トークン (スクリプト / bot 向けの長期トークン). 自分のトークンのみ操作できる.
+   * 発行したトークンは Bearer ヘッダに付けて JWT の代わりに使える.
+   * API トークンで認証されたリクエストからは発行できない.
+   *
+   * @generated from rpc hdlctrl.v1.UserService.CreateApiToken
+   */
+  createApiToken: {
+    methodKind: "unary";
+    input: typeof CreateApiTokenRequestSchema;
+    output: typeof CreateApiTokenResponseSchema;
+  },
+  /**
+   * 失効済み / 期限切れも含めて新しい順に返す.
+   *
+   * @generated from rpc hdlctrl.v1.UserService.ListApiTokens
+   */
+  listApiTokens: {
+    methodKind: "unary";
+    input: typeof ListApiTokensRequestSchema;
+    output: typeof ListApiTokensResponseSchema;
+  },
+  /**
+   * @generated from rpc hdlctrl.v1.UserService.RevokeApiToken
+   */
+  revokeApiToken: {
+    methodKind: "unary";
+    input: typeof RevokeApiTokenRequestSchema;
+    output: typeof RevokeApiTokenResponseSchema;
+  },
+  /**
+   * 2 段階認証 (TOTP). 自分の設定のみ操作できる. API トークンからは操作できない.
+   *
+   * @generated from rpc hdlctrl.v1.UserService.GetTotpStatus
+   */
+  getTotpStatus: {
+    methodKind: "unary";
+    input: typeof GetTotpStatusRequestSchema;
+    output: typeof GetTotpStatusResponseSchema;
+  },
+  /**
+   * 新しい secret を発行する. Confirm で最初のコードを確認するまでは有効にならない.
+   * 登録が必須でまだサインインできないユーザーは、access token の代わりに
+   * GetTokenByPassword で得た challenge を渡す.
+   *
+   * @generated from rpc hdlctrl.v1.UserService.BeginTotpEnrollment
+   */
+  beginTotpEnrollment: {
+    methodKind: "unary";
+    input: typeof BeginTotpEnrollmentRequestSchema;
+    output: typeof BeginTotpEnrollmentResponseSchema;
+  },
+  /**
+   * @generated from rpc hdlctrl.v1.UserService.ConfirmTotpEnrollment
+   */
+  confirmTotpEnrollment: {
+    methodKind: "unary";
+    input: typeof ConfirmTotpEnrollmentRequestSchema;
+    output: typeof ConfirmTotpEnrollmentResponseSchema;
+  },
+  /**
+   * 必須のユーザーは無効にできない.
+   *
+   * @generated from rpc hdlctrl.v1.UserService.DisableTotp
+   */
+  disableTotp: {
+    methodKind: "unary";
+    input: typeof DisableTotpRequestSchema;
+    output: typeof DisableTotpResponseSchema;
+  },
+  /**
+   * ログインセッション (サインインした端末). 自分のセッションのみ操作できる. API トークンからは操作できない.
+   * 有効なセッションを最後に使われた順に返す.
+   *
+   * @generated from rpc hdlctrl.v1.UserService.ListMySessions
+   */
+  listMySessions: {
+    methodKind: "unary";
+    input: typeof ListMySessionsRequestSchema;
+    output: typeof ListMySessionsResponseSchema;
+  },
+  /**
+   * 失効させたセッションの access token / refresh token はすぐに使えなくなる.
+   * 今のセッションを指定するとサインアウトになる.
+   *
+   * @generated from rpc hdlctrl.v1.UserService.RevokeSession
+   */
+  revokeSession: {
+    methodKind: "unary";
+    input: typeof RevokeSessionRequestSchema;
+    output: typeof RevokeSessionResponseSchema;
+  },
+  /**
+   * ログイン中のユーザーに IdP のアカウントを紐付ける. 以降はそのアカウントの SSO でサインインできる.
+   * Begin で得た URL に遷移し、IdP から戻ってきた code / state で Complete する.
+   * state は Begin を呼んだユーザーでしか Complete できない. API トークンからは操作できない.
+   *
+   * @generated from rpc hdlctrl.v1.UserService.BeginOidcLink
+   */
+  beginOidcLink: {
+    methodKind: "unary";
+    input: typeof BeginOidcLinkRequestSchema;
+    output: typeof BeginOidcLoginResponseSchema;
+  },
+  /**
+   * @generated from rpc hdlctrl.v1.UserService.CompleteOidcLink
+   */
+  completeOidcLink: {
+    methodKind: "unary";
+    input: typeof CompleteOidcLinkRequestSchema;
+    output: typeof CompleteOidcLinkResponseSchema;
+  },
 }> = /*@__PURE__*/
   serviceDesc(file_hdlctrl_v1_user, 0);
 
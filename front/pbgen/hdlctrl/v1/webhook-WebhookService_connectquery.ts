@@ -0,0 +1,46 @@
+// @generated by protoc-gen-connect-query v2.0.1 with parameter "target=ts"
+// @generated from file hdlctrl/v1/webhook.proto (package hdlctrl.v1, syntax proto3)
+/* eslint-disable */
+
+import { WebhookService } from "./webhook_pb";
+
+/**
+ * @generated from rpc hdlctrl.v1.WebhookService.ListWebhooks
+ */
+export const listWebhooks = WebhookService.method.listWebhooks;
+
+/**
+ * @generated from rpc hdlctrl.v1.WebhookService.CreateWebhook
+ */
+export const createWebhook = WebhookService.method.createWebhook;
+
+/**
+ * @generated from rpc hdlctrl.v1.WebhookService.UpdateWebhook
+ */
+export const updateWebhook = WebhookService.method.updateWebhook;
+
+/**
+ * @generated from rpc hdlctrl.v1.WebhookService.DeleteWebhook
+ */
+export const deleteWebhook = WebhookService.method.deleteWebhook;
+
+/**
+ * 署名鍵を作り直す. 新しい鍵はレスポンスでのみ返す.
+ *
+ * @generated from rpc hdlctrl.v1.WebhookService.RotateWebhookSecret
+ */
+export const rotateWebhookSecret = WebhookService.method.rotateWebhookSecret;
+
+/**
+ * 疎通確認用の ping イベントを送信キューに入れる. 結果は ListWebhookDeliveries で確認する.
+ *
+ * @generated from rpc hdlctrl.v1.WebhookService.TestWebhook
+ */
+export const testWebhook = WebhookService.method.testWebhook;
+
+/**
+ * 送信履歴を新しい順に返す.
+ *
+ * @generated from rpc hdlctrl.v1.WebhookService.ListWebhookDeliveries
+ */
+export const listWebhookDeliveries = WebhookService.method.listWebhookDeliveries;
@@ -0,0 +1,575 @@
+// @generated by protoc-gen-es v2.2.3 with parameter "target=ts"
+// @generated from file hdlctrl/v1/webhook.proto (package hdlctrl.v1, syntax proto3)
+/* eslint-disable */
+
+import type { GenEnum, GenFile, GenMessage, GenService } from "@bufbuild/protobuf/codegenv1";
+import { enumDesc, fileDesc, messageDesc, serviceDesc } from "@bufbuild/protobuf/codegenv1";
+import type { Timestamp } from "@bufbuild/protobuf/wkt";
+import { file_google_protobuf_timestamp } from "@bufbuild/protobuf/wkt";
+import type { PageRequest, PageResponse } from "./controller_pb";
+import { file_hdlctrl_v1_controller } from "./controller_pb";
+import type { Message } from "@bufbuild/protobuf";
+
+/**
+ * Describes the file hdlctrl/v1/webhook.proto.
+ */
+export const file_hdlctrl_v1_webhook: GenFile = /*@__PURE__*/
+  fileDesc("ChhoZGxjdHJsL3YxL3dlYmhvb2sucHJvdG8SCmhkbGN0cmwudjEigAIKB1dlYmhvb2sSCgoCaWQYASABKAkSEAoIZ3JvdXBfaWQYAiABKAkSDAoEbmFtZRgDIAEoCRILCgN1cmwYBCABKAkSDgoGZm9ybWF0GAUgASgJEhMKC2V2ZW50X3R5cGVzGAYgAygJEg8KB2VuYWJsZWQYByABKAgSFwoKY3JlYXRlZF9ieRgIIAEoCUgAiAEBEi4KCmNyZWF0ZWRfYXQYCSABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wEi4KCnVwZGF0ZWRfYXQYCiABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wQg0KC19jcmVhdGVkX2J5IisKFFdlYmhvb2tFdmVudFR5cGVMaXN0EhMKC2V2ZW50X3R5cGVzGAEgAygJIicKE0xpc3RXZWJob29rc1JlcXVlc3QSEAoIZ3JvdXBfaWQYASABKAkiPQoUTGlzdFdlYmhvb2tzUmVzcG9uc2USJQoId2ViaG9va3MYASADKAsyEy5oZGxjdHJsLnYxLldlYmhvb2siaAoUQ3JlYXRlV2ViaG9va1JlcXVlc3QSEAoIZ3JvdXBfaWQYASABKAkSDAoEbmFtZRgCIAEoCRILCgN1cmwYAyABKAkSDgoGZm9ybWF0GAQgASgJEhMKC2V2ZW50X3R5cGVzGAUgAygJIk0KFUNyZWF0ZVdlYmhvb2tSZXNwb25zZRIkCgd3ZWJob29rGAEgASgLMhMuaGRsY3RybC52MS5XZWJob29rEg4KBnNlY3JldBgCIAEoCSLmAQoUVXBkYXRlV2ViaG9va1JlcXVlc3QSCgoCaWQYASABKAkSEQoEbmFtZRgCIAEoCUgAiAEBEhAKA3VybBgDIAEoCUgBiAEBEhMKBmZvcm1hdBgEIAEoCUgCiAEBEjoKC2V2ZW50X3R5cGVzGAUgASgLMiAuaGRsY3RybC52MS5XZWJob29rRXZlbnRUeXBlTGlzdEgDiAEBEhQKB2VuYWJsZWQYBiABKAhIBIgBAUIHCgVfbmFtZUIGCgRfdXJsQgkKB19mb3JtYXRCDgoMX2V2ZW50X3R5cGVzQgoKCF9lbmFibGVkIj0KFVVwZGF0ZVdlYmhvb2tSZXNwb25zZRIkCgd3ZWJob29rGAEgASgLMhMuaGRsY3RybC52MS5XZWJob29rIiIKFERlbGV0ZVdlYmhvb2tSZXF1ZXN0EgoKAmlkGAEgASgJIhcKFURlbGV0ZVdlYmhvb2tSZXNwb25zZSIoChpSb3RhdGVXZWJob29rU2VjcmV0UmVxdWVzdBIKCgJpZBgBIAEoCSItChtSb3RhdGVXZWJob29rU2VjcmV0UmVzcG9uc2USDgoGc2VjcmV0GAEgASgJIiAKElRlc3RXZWJob29rUmVxdWVzdBIKCgJpZBgBIAEoCSJEChNUZXN0V2ViaG9va1Jlc3BvbnNlEi0KCGRlbGl2ZXJ5GAEgASgLMhsuaGRsY3RybC52MS5XZWJob29rRGVsaXZlcnkihwQKD1dlYmhvb2tEZWxpdmVyeRIKCgJpZBgBIAEoAxISCgp3ZWJob29rX2lkGAIgASgJEhIKCmV2ZW50X3R5cGUYAyABKAkSMgoGc3RhdHVzGAQgASgOMiIuaGRsY3RybC52MS5XZWJob29rRGVsaXZlcnkuU3RhdHVzEhAKCGF0dGVtcHRzGAUgASgFEjMKD25leHRfYXR0ZW1wdF9hdBgGIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXASHQoQbGFzdF9zdGF0dXNfY29kZRgHIAEoBUgAiAEBEhcKCmxhc3RfZXJyb3IYCCABKAlIAYgBARI1CgxkZWxpdmVyZWRfYXQYCSABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wSAKIAQESLgoKY3JlYXRlZF9hdBgKIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXAicQoGU3RhdHVzEhYKElNUQVRVU19VTlNQRUNJRklFRBAAEhIKDlNUQVRVU19QRU5ESU5HEAESEgoOU1RBVFVTX1NFTkRJTkcQAhIUChBTVEFUVVNfU1VDQ0VFREVEEAMSEQoNU1RBVFVTX0ZBSUxFRBAEQhMKEV9sYXN0X3N0YXR1c19jb2RlQg0KC19sYXN0X2Vycm9yQg8KDV9kZWxpdmVyZWRfYXQiWQocTGlzdFdlYmhvb2tEZWxpdmVyaWVzUmVxdWVzdBISCgp3ZWJob29rX2lkGAEgASgJEiUKBHBhZ2UYAiABKAsyFy5oZGxjdHJsLnYxLlBhZ2VSZXF1ZXN0IngKHUxpc3RXZWJob29rRGVsaXZlcmllc1Jlc3BvbnNlEi8KCmRlbGl2ZXJpZXMYASADKAsyGy5oZGxjdHJsLnYxLldlYmhvb2tEZWxpdmVyeRImCgRwYWdlGAIgASgLMhguaGRsY3RybC52MS5QYWdlUmVzcG9uc2UyiwUKDldlYmhvb2tTZXJ2aWNlElEKDExpc3RXZWJob29rcxIfLmhkbGN0cmwudjEuTGlzdFdlYmhvb2tzUmVxdWVzdBogLmhkbGN0cmwudjEuTGlzdFdlYmhvb2tzUmVzcG9uc2USVAoNQ3JlYXRlV2ViaG9vaxIgLmhkbGN0cmwudjEuQ3JlYXRlV2ViaG9va1JlcXVlc3QaIS5oZGxjdHJsLnYxLkNyZWF0ZVdlYmhvb2tSZXNwb25zZRJUCg1VcGRhdGVXZWJob29rEiAuaGRsY3RybC52MS5VcGRhdGVXZWJob29rUmVxdWVzdBohLmhkbGN0cmwudjEuVXBkYXRlV2ViaG9va1Jlc3BvbnNlElQKDURlbGV0ZVdlYmhvb2sSIC5oZGxjdHJsLnYxLkRlbGV0ZVdlYmhvb2tSZXF1ZXN0GiEuaGRsY3RybC52MS5EZWxldGVXZWJob29rUmVzcG9uc2USZgoTUm90YXRlV2ViaG9va1NlY3JldBImLmhkbGN0cmwudjEuUm90YXRlV2ViaG9va1NlY3JldFJlcXVlc3QaJy5oZGxjdHJsLnYxLlJvdGF0ZVdlYmhvb2tTZWNyZXRSZXNwb25zZRJOCgtUZXN0V2ViaG9vaxIeLmhkbGN0cmwudjEuVGVzdFdlYmhvb2tSZXF1ZXN0Gh8uaGRsY3RybC52MS5UZXN0V2ViaG9va1Jlc3BvbnNlEmwKFUxpc3RXZWJob29rRGVsaXZlcmllcxIoLmhkbGN0cmwudjEuTGlzdFdlYmhvb2tEZWxpdmVyaWVzUmVxdWVzdBopLmhkbGN0cmwudjEuTGlzdFdlYmhvb2tEZWxpdmVyaWVzUmVzcG9uc2VCugEKDmNvbS5oZGxjdHJsLnYxQgxXZWJob29rUHJvdG9QAVpRZ2l0aHViLmNvbS9oYW50YWJhcnUxMDE0L2JhcnUtcmVzby1oZWFkbGVzcy1jb250cm9sbGVyL3BiZ2VuL2hkbGN0cmwvdjE7aGRsY3RybHYxogIDSFhYqgIKSGRsY3RybC5WMcoCCkhkbGN0cmxcVjHiAhZIZGxjdHJsXFYxXEdQQk1ldGFkYXRh6gILSGRsY3RybDo6VjFiBnByb3RvMw", [file_google_protobuf_timestamp, file_hdlctrl_v1_controller]);
+
+/**
+ * @generated from message hdlctrl.v1.Webhook
+ */
+export type Webhook = Message<"hdlctrl.v1.Webhook"> & {
+  /**
+   * @generated from field: string id = 1;
+   */
+  id: string;
+
+  /**
+   * @generated from field: string group_id = 2;
+   */
+  groupId: string;
+
+  /**
+   * @generated from field: string name = 3;
+   */
+  name: string;
+
+  /**
+   * @generated from field: string url = 4;
+   */
+  url: string;
+
+  /**
+   * generic / discord / slack.
+   *
+   * @generated from field: string format = 5;
+   */
+  format: string;
+
+  /**
+   * 購読するイベント種別 (session.started 等). 空なら全イベント.
+   *
+   * @generated from field: repeated string event_types = 6;
+   */
+  eventTypes: string[];
+
+  /**
+   * @generated from field: bool enabled = 7;
+   */
+  enabled: boolean;
+
+  /**
+   * @generated from field: optional string created_by = 8;
+   */
+  createdBy?: string;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp created_at = 9;
+   */
+  createdAt?: Timestamp;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp updated_at = 10;
+   */
+  updatedAt?: Timestamp;
+};
+
+/**
+ * Describes the message hdlctrl.v1.Webhook.
+ * Use `create(WebhookSchema)` to create a new message.
+ */
+export const WebhookSchema: GenMessage<Webhook> = /*@__PURE__*/
+  messageDesc(file_hdlctrl_v1_webhook, 0);
+
+/**
+ * repeated フィールドの "明示的に指定したか" を表現するためのラッパー (PermissionKeyList と同様).
+ *
+ * @generated from message hdlctrl.v1.WebhookEventTypeList
+ */
+export type WebhookEventTypeList = Message<"hdlctrl.v1.WebhookEventTypeList"> & {
+  /**
+   * @generated from field: repeated string event_types = 1;
+   */
+  eventTypes: string[];
+};
+
+/**
+ * Describes the message hdlctrl.v1.WebhookEventTypeList.
+ * Use `create(WebhookEventTypeListSchema)` to create a new message.
+ */
+export const WebhookEventTypeListSchema: GenMessage<WebhookEventTypeList> = /*@__PURE__*/
+  messageDesc(file_hdlctrl_v1_webhook, 1);
+
+/**
+ * @generated from message hdlctrl.v1.ListWebhooksRequest
+ */
+export type ListWebhooksRequest = Message<"hdlctrl.v1.ListWebhooksRequest"> & {
+  /**
+   * @generated from field: string group_id = 1;
+   */
+  groupId: string;
+};
+
+/**
+ * Describes the message hdlctrl.v1.ListWebhooksRequest.
+ * Use `create(ListWebhooksRequestSchema)` to create a new message.
+ */
+export const ListWebhooksRequestSchema: GenMessage<ListWebhooksRequest> = /*@__PURE__*/
+  messageDesc(file_hdlctrl_v1_webhook, 2);
+
+/**
+ * @generated from message hdlctrl.v1.ListWebhooksResponse
+ */
+export type ListWebhooksResponse = Message<"hdlctrl.v1.ListWebhooksResponse"> & {
+  /**
+   * @generated from field: repeated hdlctrl.v1.Webhook webhooks = 1;
+   */
+  webhooks: Webhook[];
+};
+
+/**
+ * Describes the message hdlctrl.v1.ListWebhooksResponse.
+ * Use `create(ListWebhooksResponseSchema)` to create a new message.
+ */
+export const ListWebhooksResponseSchema: GenMessage<ListWebhooksResponse> = /*@__PURE__*/
+  messageDesc(file_hdlctrl_v1_webhook, 3);
+
+/**
+ * @generated from message hdlctrl.v1.CreateWebhookRequest
+ */
+export type CreateWebhookRequest = Message<"hdlctrl.v1.CreateWebhookRequest"> & {
+  /**
+   * @generated from field: string group_id = 1;
+   */
+  groupId: string;
+
+  /**
+   * @generated from field: string name = 2;
+   */
+  name: string;
+
+  /**
+   * @generated from field: string url = 3;
+   */
+  url: string;
+
+  /**
+   * @generated from field: string format = 4;
+   */
+  format: string;
+
+  /**
+   * @generated from field: repeated string event_types = 5;
+   */
+  eventTypes: string[];
+};
+
+/**
+ * Describes the message hdlctrl.v1.CreateWebhookRequest.
+ * Use `create(CreateWebhookRequestSchema)` to create a new message.
+ */
+export const CreateWebhookRequestSchema: GenMessage<CreateWebhookRequest> = /*@__PURE__*/
+  messageDesc(file_hdlctrl_v1_webhook, 4);
+
+/**
+ * @generated from message hdlctrl.v1.CreateWebhookResponse
+ */
+export type CreateWebhookResponse = Message<"hdlctrl.v1.CreateWebhookResponse"> & {
+  /**
+   * @generated from field: hdlctrl.v1.Webhook webhook = 1;
+   */
+  webhook?: Webhook;
+
+  /**
+   * HMAC-SHA256 署名鍵. 作成時にしか返さない.
+   *
+   * @generated from field: string secret = 2;
+   */
+  secret: string;
+};
+
+/**
+ * Describes the message hdlctrl.v1.CreateWebhookResponse.
+ * Use `create(CreateWebhookResponseSchema)` to create a new message.
+ */
+export const CreateWebhookResponseSchema: GenMessage<CreateWebhookResponse> = /*@__PURE__*/
+  messageDesc(file_hdlctrl_v1_webhook, 5);
+
+/**
+ * @generated from message hdlctrl.v1.UpdateWebhookRequest
+ */
+export type UpdateWebhookRequest = Message<"hdlctrl.v1.UpdateWebhookRequest"> & {
+  /**
+   * @generated from field: string id = 1;
+   */
+  id: string;
+
+  /**
+   * @generated from field: optional string name = 2;
+   */
+  name?: string;
+
+  /**
+   * @generated from field: optional string url = 3;
+   */
+  url?: string;
+
+  /**
+   * @generated from field: optional string format = 4;
+   */
+  format?: string;
+
+  /**
+   * 省略時は変更しない. 指定時は完全置換 (空配列なら全イベント).
+   *
+   * @generated from field: optional hdlctrl.v1.WebhookEventTypeList event_types = 5;
+   */
+  eventTypes?: WebhookEventTypeList;
+
+  /**
+   * @generated from field: optional bool enabled = 6;
+   */
+  enabled?: boolean;
+};
+
+/**
+ * Describes the message hdlctrl.v1.UpdateWebhookRequest.
+ * Use `create(UpdateWebhookRequestSchema)` to create a new message.
+ */
+export const UpdateWebhookRequestSchema: GenMessage<UpdateWebhookRequest> = /*@__PURE__*/
+  messageDesc(file_hdlctrl_v1_webhook, 6);
+
+/**
+ * @generated from message hdlctrl.v1.UpdateWebhookResponse
+ */
+export type UpdateWebhookResponse = Message<"hdlctrl.v1.UpdateWebhookResponse"> & {
+  /**
+   * @generated from field: hdlctrl.v1.Webhook webhook = 1;
+   */
+  webhook?: Webhook;
+};
+
+/**
+ * Describes the message hdlctrl.v1.UpdateWebhookResponse.
+ * Use `create(UpdateWebhookResponseSchema)` to create a new message.
+ */
+export const UpdateWebhookResponseSchema: GenMessage<UpdateWebhookResponse> = /*@__PURE__*/
+  messageDesc(file_hdlctrl_v1_webhook, 7);
+
+/**
+ * @generated from message hdlctrl.v1.DeleteWebhookRequest
+ */
+export type DeleteWebhookRequest = Message<"hdlctrl.v1.DeleteWebhookRequest"> & {
+  /**
+   * @generated from field: string id = 1;
+   */
+  id: string;
+};
+
+/**
+ * Describes the message hdlctrl.v1.DeleteWebhookRequest.
+ * Use `create(DeleteWebhookRequestSchema)` to create a new message.
+ */
+export const DeleteWebhookRequestSchema: GenMessage<DeleteWebhookRequest> = /*@__PURE__*/
+  messageDesc(file_hdlctrl_v1_webhook, 8);
+
+/**
+ * @generated from message hdlctrl.v1.DeleteWebhookResponse
+ */
+export type DeleteWebhookResponse = Message<"hdlctrl.v1.DeleteWebhookResponse"> & {
+};
+
+/**
+ * Describes the message hdlctrl.v1.DeleteWebhookResponse.
+ * Use `create(DeleteWebhookResponseSchema)` to create a new message.
+ */
+export const DeleteWebhookResponseSchema: GenMessage<DeleteWebhookResponse> = /*@__PURE__*/
+  messageDesc(file_hdlctrl_v1_webhook, 9);
+
+/**
+ * @generated from message hdlctrl.v1.RotateWebhookSecretRequest
+ */
+export type RotateWebhookSecretRequest = Message<"hdlctrl.v1.RotateWebhookSecretRequest"> & {
+  /**
+   * @generated from field: string id = 1;
+   */
+  id: string;
+};
+
+/**
+ * Describes the message hdlctrl.v1.RotateWebhookSecretRequest.
+ * Use `create(RotateWebhookSecretRequestSchema)` to create a new message.
+ */
+export const RotateWebhookSecretRequestSchema: GenMessage<RotateWebhookSecretRequest> = /*@__PURE__*/
+  messageDesc(file_hdlctrl_v1_webhook, 10);
+
+/**
+ * @generated from message hdlctrl.v1.RotateWebhookSecretResponse
+ */
+export type RotateWebhookSecretResponse = Message<"hdlctrl.v1.RotateWebhookSecretResponse"> & {
+  /**
+   * @generated from field: string secret = 1;
+   */
+  secret: string;
+};
+
+/**
+ * Describes the message hdlctrl.v1.RotateWebhookSecretResponse.
+ * Use `create(RotateWebhookSecretResponseSchema)` to create a new message.
+ */
+export const RotateWebhookSecretResponseSchema: GenMessage<RotateWebhookSecretResponse> = /*@__PURE__*/
+  messageDesc(file_hdlctrl_v1_webhook, 11);
+
+/**
+ * @generated from message hdlctrl.v1.TestWebhookRequest
+ */
+export type TestWebhookRequest = Message<"hdlctrl.v1.TestWebhookRequest"> & {
+  /**
+   * @generated from field: string id = 1;
+   */
+  id: string;
+};
+
+/**
+ * Describes the message hdlctrl.v1.TestWebhookRequest.
+ * Use `create(TestWebhookRequestSchema)` to create a new message.
+ */
+export const TestWebhookRequestSchema: GenMessage<TestWebhookRequest> = /*@__PURE__*/
+  messageDesc(file_hdlctrl_v1_webhook, 12);
+
+/**
+ * @generated from message hdlctrl.v1.TestWebhookResponse
+ */
+export type TestWebhookResponse = Message<"hdlctrl.v1.TestWebhookResponse"> & {
+  /**
+   * @generated from field: hdlctrl.v1.WebhookDelivery delivery = 1;
+   */
+  delivery?: WebhookDelivery;
+};
+
+/**
+ * Describes the message hdlctrl.v1.TestWebhookResponse.
+ * Use `create(TestWebhookResponseSchema)` to create a new message.
+ */
+export const TestWebhookResponseSchema: GenMessage<TestWebhookResponse> = /*@__PURE__*/
+  messageDesc(file_hdlctrl_v1_webhook, 13);
+
+/**
+ * @generated from message hdlctrl.v1.WebhookDelivery
+ */
+export type WebhookDelivery = Message<"hdlctrl.v1.WebhookDelivery"> & {
+  /**
+   * @generated from field: int64 id = 1;
+   */
+  id: bigint;
+
+  /**
+   * @generated from field: string webhook_id = 2;
+   */
+  webhookId: string;
+
+  /**
+   * @generated from field: string event_type = 3;
+   */
+  eventType: string;
+
+  /**
+   * @generated from field: hdlctrl.v1.WebhookDelivery.Status status = 4;
+   */
+  status: WebhookDelivery_Status;
+
+  /**
+   * @generated from field: int32 attempts = 5;
+   */
+  attempts: number;
+
+  /**
+   * PENDING の場合は次回の送信予定時刻.
+   *
+   * @generated from field: google.protobuf.Timestamp next_attempt_at = 6;
+   */
+  nextAttemptAt?: Timestamp;
+
+  /**
+   * 最後の応答の HTTP status. 接続できなかった場合は未設定.
+   *
+   * @generated from field: optional int32 last_status_code = 7;
+   */
+  lastStatusCode?: number;
+
+  /**
+   * @generated from field: optional string last_error = 8;
+   */
+  lastError?: string;
+
+  /**
+   * @generated from field: optional google.protobuf.Timestamp delivered_at = 9;
+   */
+  deliveredAt?: Timestamp;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp created_at = 10;
+   */
+  createdAt?: Timestamp;
+};
+
+/**
+ * Describes the message hdlctrl.v1.WebhookDelivery.
+ * Use `create(WebhookDeliverySchema)` to create a new message.
+ */
+export const WebhookDeliverySchema: GenMessage<WebhookDelivery> = /*@__PURE__*/
+  messageDesc(file_hdlctrl_v1_webhook, 14);
+
+/**
+ * @generated from enum hdlctrl.v1.WebhookDelivery.Status
+ */
+export enum WebhookDelivery_Status {
+  /**
+   * @generated from enum value: STATUS_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * @generated from enum value: STATUS_PENDING = 1;
+   */
+  PENDING = 1,
+
+  /**
+   * @generated from enum value: STATUS_SENDING = 2;
+   */
+  SENDING = 2,
+
+  /**
+   * @generated from enum value: STATUS_SUCCEEDED = 3;
+   */
+  SUCCEEDED = 3,
+
+  /**
+   * @generated from enum value: STATUS_FAILED = 4;
+   */
+  FAILED = 4,
+}
+
+/**
+ * Describes the enum hdlctrl.v1.WebhookDelivery.Status.
+ */
+export const WebhookDelivery_StatusSchema: GenEnum<WebhookDelivery_Status> = /*@__PURE__*/
+  enumDesc(file_hdlctrl_v1_webhook, 14, 0);
+
+/**
+ * @generated from message hdlctrl.v1.ListWebhookDeliveriesRequest
+ */
+export type ListWebhookDeliveriesRequest = Message<"hdlctrl.v1.ListWebhookDeliveriesRequest"> & {
+  /**
+   * @generated from field: string webhook_id = 1;
+   */
+  webhookId: string;
+
+  /**
+   * @generated from field: hdlctrl.v1.PageRequest page = 2;
+   */
+  page?: PageRequest;
+};
+
+/**
+ * Describes the message hdlctrl.v1.ListWebhookDeliveriesRequest.
+ * Use `create(ListWebhookDeliveriesRequestSchema)` to create a new message.
+ */
+export const ListWebhookDeliveriesRequestSchema: GenMessage<ListWebhookDeliveriesRequest> = /*@__PURE__*/
+  messageDesc(file_hdlctrl_v1_webhook, 15);
+
+/**
+ * @generated from message hdlctrl.v1.ListWebhookDeliveriesResponse
+ */
+export type ListWebhookDeliveriesResponse = Message<"hdlctrl.v1.ListWebhookDeliveriesResponse"> & {
+  /**
+   * @generated from field: repeated hdlctrl.v1.WebhookDelivery deliveries = 1;
+   */
+  deliveries: WebhookDelivery[];
+
+  /**
+   * @generated from field: hdlctrl.v1.PageResponse page = 2;
+   */
+  page?: PageResponse;
+};
+
+/**
+ * Describes the message hdlctrl.v1.ListWebhookDeliveriesResponse.
+ * Use `create(ListWebhookDeliveriesResponseSchema)` to create a new message.
+ */
+export const ListWebhookDeliveriesResponseSchema: GenMessage<ListWebhookDeliveriesResponse> = /*@__PURE__*/
+  messageDesc(file_hdlctrl_v1_webhook, 16);
+
+/**
+ * グループのイベントを外部 (Discord / Slack / 任意の HTTP エンドポイント) に通知する
+ * Webhook の管理. すべての RPC で対象グループの group:webhooks.manage が必要.
+ *
+ * @generated from service hdlctrl.v1.WebhookService
+ */
+export const WebhookService: GenService<{
+  /**
+   * @generated from rpc hdlctrl.v1.WebhookService.ListWebhooks
+   */
+  listWebhooks: {
+    methodKind: "unary";
+    input: typeof ListWebhooksRequestSchema;
+    output: typeof ListWebhooksResponseSchema;
+  },
+  /**
+   * @generated from rpc hdlctrl.v1.WebhookService.CreateWebhook
+   */
+  createWebhook: {
+    methodKind: "unary";
+    input: typeof CreateWebhookRequestSchema;
+    output: typeof CreateWebhookResponseSchema;
+  },
+  /**
+   * @generated from rpc hdlctrl.v1.WebhookService.UpdateWebhook
+   */
+  updateWebhook: {
+    methodKind: "unary";
+    input: typeof UpdateWebhookRequestSchema;
+    output: typeof UpdateWebhookResponseSchema;
+  },
+  /**
+   * @generated from rpc hdlctrl.v1.WebhookService.DeleteWebhook
+   */
+  deleteWebhook: {
+    methodKind: "unary";
+    input: typeof DeleteWebhookRequestSchema;
+    output: typeof DeleteWebhookResponseSchema;
+  },
+  /**
+   * 署名鍵を作り直す. 新しい鍵はレスポンスでのみ返す.
+   *
+   * @generated from rpc hdlctrl.v1.WebhookService.RotateWebhookSecret
+   */
+  rotateWebhookSecret: {
+    methodKind: "unary";
+    input: typeof RotateWebhookSecretRequestSchema;
+    output: typeof RotateWebhookSecretResponseSchema;
+  },
+  /**
+   * 疎通確認用の ping イベントを送信キューに入れる. 結果は ListWebhookDeliveries で確認する.
+   *
+   * @generated from rpc hdlctrl.v1.WebhookService.TestWebhook
+   */
+  testWebhook: {
+    methodKind: "unary";
+    input: typeof TestWebhookRequestSchema;
+    output: typeof TestWebhookResponseSchema;
+  },
+  /**
+   * 送信履歴を新しい順に返す.
+   *
+   * @generated from rpc hdlctrl.v1.WebhookService.ListWebhookDeliveries
+   */
+  listWebhookDeliveries: {
+    methodKind: "unary";
+    input: typeof ListWebhookDeliveriesRequestSchema;
+    output: typeof ListWebhookDeliveriesResponseSchema;
+  },
+}> = /*@__PURE__*/
+  serviceDesc(file_hdlctrl_v1_webhook, 0);
+
@@ -92,6 +92,32 @@ export default function GroupDetailPanel({ groupId }: { groupId: string }) {
           label="作成日時"
           value={formatTimestamp(group.createdAt)}
         />
+        <EditableTextField
+          label="ログの保持日数"
+          value={group.logRetentionDays?.toString() ?? ""}
+          type="number"
+          min={1}
+          placeholder="サーバーの設定に従う"
+          helperText="過ぎたホストのログはストレージに退避されます。空欄ならサーバーの設定に従います"
+          readonly={!hasPermission(group.id, PERMISSION_KEYS.GROUP_EDIT)}
+          onSave={async (v) => {
+            const days = v.trim() === "" ? 0 : Number(v);
+            if (!Number.isInteger(days) || days < 0) {
+              return { ok: false, error: "1 以上の整数を入力してください" };
+            }
+            try {
+              await mutateUpdate({ groupId: group.id, logRetentionDays: days });
+              toast.success("ログの保持日数を更新しました");
+              refetch();
+              return { ok: true };
+            } catch (e) {
+              return {
+                ok: false,
+                error: e instanceof Error ? e.message : "更新に失敗しました",
+              };
+            }
+          }}
+        />
       </div>
       {!canDelete &&
         group.type === GroupType.NORMAL &&
@@ -102,7 +128,7 @@ export default function GroupDetailPanel({ groupId }: { groupId: string }) {
         )}
       {group.type === GroupType.PERSONAL && (
         <p className="text-muted-foreground text-sm">
-          personal グループは削除・編集できません
+          personal グループは削除・名前の変更ができません
         </p>
       )}
     </div>
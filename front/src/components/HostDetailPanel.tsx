@@ -1,6 +1,7 @@
 import { useMutation, useQuery } from "@connectrpc/connect-query";
 import {
   allowHostAccess,
+  cloneHeadlessHost,
   deleteHeadlessHost,
   denyHostAccess,
   getHeadlessHost,
@@ -348,6 +349,8 @@ export default function HostDetailPanel({ hostId }: { hostId: string }) {
     useMutation(killHeadlessHost);
   const { mutateAsync: deleteHost, isPending: isPendingDelete } =
     useMutation(deleteHeadlessHost);
+  const { mutateAsync: cloneHost, isPending: isPendingClone } =
+    useMutation(cloneHeadlessHost);
 
   const settings = data?.host?.hostSettings;
 
@@ -409,6 +412,17 @@ export default function HostDetailPanel({ hostId }: { hostId: string }) {
     }
   };
 
+  const handleClone = async () => {
+    try {
+      await cloneHost({ sourceHostId: hostId });
+      toast.success("ホストの複製を開始しました");
+    } catch (e) {
+      toast.error(
+        e instanceof Error ? e.message : "ホストの複製に失敗しました",
+      );
+    }
+  };
+
   const handleDelete = async () => {
     try {
       await deleteHost({ hostId });
@@ -495,6 +509,12 @@ export default function HostDetailPanel({ hostId }: { hostId: string }) {
                 >
                   過去のインスタンス一覧
                 </DropdownMenuItem>
+                <DropdownMenuItem
+                  onClick={handleClone}
+                  disabled={isPending || isPendingClone}
+                >
+                  複製して起動
+                </DropdownMenuItem>
               </DropdownMenuContent>
             </DropdownMenu>
             <PastInstancesDialog
@@ -1,6 +1,8 @@
+import { createClient } from "@connectrpc/connect";
 import { callUnaryMethod, useTransport } from "@connectrpc/connect-query";
 import { useInfiniteQuery, useQueryClient } from "@tanstack/react-query";
 import { getHeadlessHostLogs } from "../../pbgen/hdlctrl/v1/controller-ControllerService_connectquery";
+import { ControllerService } from "../../pbgen/hdlctrl/v1/controller_pb";
 import { Card, CardContent, CardHeader } from "./ui/card";
 import { Button } from "./ui/button";
 import { useCallback, useEffect, useMemo, useRef, useState } from "react";
@@ -8,6 +10,8 @@ import { useVirtualizer } from "@tanstack/react-virtual";
 import { Download, Loader2 } from "lucide-react";
 import { toast } from "sonner";
 
+const tailRetryDelayMs = 2000;
+
 type PageParam = {
   direction: "before" | "after" | "init";
   cursorId?: bigint;
@@ -192,65 +196,67 @@ export default function HostLogViewer({
     tailing,
   ]);
 
-  // Tailing - 直接APIを呼び出して新しいログをマージ
+  // Tailing - TailHeadlessHostLogs で届いたログをマージ
   const logsRef = useRef(logs);
   logsRef.current = logs;
-  const isTailingFetchingRef = useRef(false);
 
   useEffect(() => {
-    if (!tailing) return;
+    if (!tailing || isPending) return;
 
-    const fetchNewLogs = async () => {
-      if (isTailingFetchingRef.current) return;
-      isTailingFetchingRef.current = true;
+    const controller = new AbortController();
 
-      const currentLogs = logsRef.current;
-      const lastLog = currentLogs[currentLogs.length - 1];
-      if (!lastLog) {
-        isTailingFetchingRef.current = false;
-        return;
-      }
+    void (async () => {
+      const client = createClient(ControllerService, transport);
 
-      try {
-        const response = await callUnaryMethod(transport, getHeadlessHostLogs, {
-          hostId,
-          instanceId,
-          limit: 100,
-          cursor: { case: "afterId" as const, value: lastLog.id },
-        });
+      while (!controller.signal.aborted) {
+        // 表示中の最後のログの続きから受け取る (再接続時も欠けない)
+        const currentLogs = logsRef.current;
+        let lastId = currentLogs[currentLogs.length - 1]?.id ?? 0n;
 
-        if (response.logs.length > 0) {
-          queryClient.setQueryData<typeof data>(
-            ["hostLogs", hostId, instanceId],
-            (old) => {
-              if (!old) return old;
-              return {
-                ...old,
-                pages: [
-                  ...old.pages,
-                  {
-                    logs: response.logs,
-                    hasMoreBefore: true,
-                    hasMoreAfter: response.hasMoreAfter,
-                    direction: "after" as const,
-                  },
-                ],
-                pageParams: [
-                  ...old.pageParams,
-                  { direction: "after", cursorId: lastLog.id } as PageParam,
-                ],
-              };
-            },
+        try {
+          const stream = client.tailHeadlessHostLogs(
+            { hostId, instanceId, afterId: lastId },
+            { signal: controller.signal },
           );
+          for await (const res of stream) {
+            if (res.logs.length === 0) continue;
+
+            const cursorId = lastId;
+            lastId = res.logs[res.logs.length - 1].id;
+            queryClient.setQueryData<typeof data>(
+              ["hostLogs", hostId, instanceId],
+              (old) => {
+                if (!old) return old;
+                return {
+                  ...old,
+                  pages: [
+                    ...old.pages,
+                    {
+                      logs: res.logs,
+                      hasMoreBefore: true,
+                      hasMoreAfter: false,
+                      direction: "after" as const,
+                    },
+                  ],
+                  pageParams: [
+                    ...old.pageParams,
+                    { direction: "after", cursorId } as PageParam,
+                  ],
+                };
+              },
+            );
+          }
+        } catch (e) {
+          if (controller.signal.aborted) break;
+          console.warn("log tail stream error; reconnecting", e);
         }
-      } finally {
-        isTailingFetchingRef.current = false;
+
+        await new Promise((resolve) => setTimeout(resolve, tailRetryDelayMs));
       }
-    };
+    })();
 
-    const timer = setInterval(fetchNewLogs, 2000);
-    return () => clearInterval(timer);
-  }, [tailing, transport, hostId, instanceId, queryClient]);
+    return () => controller.abort();
+  }, [tailing, isPending, transport, hostId, instanceId, queryClient]);
 
   const isLoading = isPending || isFetchingNextPage || isFetchingPreviousPage;
 
@@ -0,0 +1,152 @@
+import { useQuery } from "@connectrpc/connect-query";
+import { timestampDate, timestampFromDate } from "@bufbuild/protobuf/wkt";
+import { useMemo, useState } from "react";
+import { getMetricsSeries } from "../../pbgen/hdlctrl/v1/controller-ControllerService_connectquery";
+import {
+  MetricKind,
+  MetricPoint,
+  MetricResolution,
+} from "../../pbgen/hdlctrl/v1/controller_pb";
+import { SelectField } from "./base";
+import { RefetchButton } from "./base/RefetchButton";
+
+const HOUR = 60 * 60 * 1000;
+
+const rangeOptions = [
+  { id: "1h", value: HOUR, label: "1時間" },
+  { id: "24h", value: 24 * HOUR, label: "24時間" },
+  { id: "7d", value: 7 * 24 * HOUR, label: "7日間" },
+];
+
+const kindOptions = [
+  { id: "fps", value: MetricKind.HOST_FPS, label: "FPS" },
+  { id: "users", value: MetricKind.HOST_USERS, label: "ユーザー数" },
+];
+
+// 点の間がこれ以上空いていたら記録がなかったものとして線を切る
+const gapThreshold = (resolution: MetricResolution) => {
+  switch (resolution) {
+    case MetricResolution.HOUR:
+      return 3 * HOUR;
+    case MetricResolution.MINUTE:
+      return 3 * 60 * 1000;
+    default:
+      return 2 * 60 * 1000;
+  }
+};
+
+const WIDTH = 600;
+const HEIGHT = 160;
+
+function splitSegments(points: MetricPoint[], threshold: number) {
+  const segments: MetricPoint[][] = [];
+  let prev: number | undefined;
+  for (const p of points) {
+    const t = p.at ? timestampDate(p.at).getTime() : 0;
+    if (prev === undefined || t - prev > threshold) {
+      segments.push([]);
+    }
+    segments[segments.length - 1].push(p);
+    prev = t;
+  }
+  return segments;
+}
+
+export default function HostMetricsChart({ hostId }: { hostId: string }) {
+  const [rangeId, setRangeId] = useState("24h");
+  const [kindId, setKindId] = useState("fps");
+  const [until, setUntil] = useState(() => new Date());
+
+  const range = rangeOptions.find((o) => o.id === rangeId) ?? rangeOptions[1];
+  const kind = kindOptions.find((o) => o.id === kindId) ?? kindOptions[0];
+  const since = useMemo(
+    () => new Date(until.getTime() - range.value),
+    [until, range.value],
+  );
+
+  const { data, isPending } = useQuery(getMetricsSeries, {
+    kind: kind.value,
+    targetId: hostId,
+    since: timestampFromDate(since),
+    until: timestampFromDate(until),
+  });
+
+  const chart = useMemo(() => {
+    const points = data?.points ?? [];
+    const maxValue = Math.max(1, ...points.map((p) => p.max));
+    const x = (p: MetricPoint) =>
+      ((timestampDate(p.at!).getTime() - since.getTime()) /
+        (until.getTime() - since.getTime())) *
+      WIDTH;
+    const y = (v: number) => HEIGHT - (v / maxValue) * HEIGHT;
+
+    const segments = splitSegments(
+      points.filter((p) => p.at),
+      gapThreshold(data?.resolution ?? MetricResolution.RAW),
+    );
+
+    return {
+      maxValue,
+      segments: segments.map((seg) => ({
+        band: [
+          ...seg.map((p) => `${x(p)},${y(p.max)}`),
+          ...[...seg].reverse().map((p) => `${x(p)},${y(p.min)}`),
+        ].join(" "),
+        line: seg.map((p) => `${x(p)},${y(p.avg)}`).join(" "),
+      })),
+    };
+  }, [data, since, until]);
+
+  return (
+    <div className="space-y-2">
+      <div className="flex items-end gap-2">
+        <SelectField
+          label="項目"
+          options={kindOptions}
+          selectedId={kindId}
+          onChange={(o) => setKindId(o.id)}
+        />
+        <SelectField
+          label="期間"
+          options={rangeOptions}
+          selectedId={rangeId}
+          onChange={(o) => {
+            setRangeId(o.id);
+            setUntil(new Date());
+          }}
+        />
+        <RefetchButton
+          refetch={async () => setUntil(new Date())}
+          disabled={isPending}
+        />
+      </div>
+      <div className="flex gap-2 text-xs text-muted-foreground">
+        <span>最大 {chart.maxValue.toFixed(1)}</span>
+      </div>
+      {chart.segments.length === 0 ? (
+        <p className="text-sm text-muted-foreground">
+          この期間の記録はありません
+        </p>
+      ) : (
+        <svg
+          viewBox={`0 0 ${WIDTH} ${HEIGHT}`}
+          preserveAspectRatio="none"
+          className="w-full h-40 border rounded"
+        >
+          {chart.segments.map((seg, i) => (
+            <g key={i}>
+              <polygon points={seg.band} className="fill-primary/20" />
+              <polyline
+                points={seg.line}
+                fill="none"
+                strokeWidth={1.5}
+                vectorEffect="non-scaling-stroke"
+                className="stroke-primary"
+              />
+            </g>
+          ))}
+        </svg>
+      )}
+    </div>
+  );
+}
@@ -5,6 +5,7 @@ import { listGroups } from "../../pbgen/hdlctrl/v1/permission-GroupService_conne
 import {
   createScheduledSessionOperation,
   listHeadlessHost,
+  listSessionTemplates,
   startWorld,
 } from "../../pbgen/hdlctrl/v1/controller-ControllerService_connectquery";
 import {
@@ -24,6 +25,7 @@ import {
   searchParamsToFormValues,
   sessionFormSchema,
   SessionFormValues,
+  startupParamsToSearchParams,
 } from "../libs/sessionFormUtils";
 import { HeadlessHostStatus } from "../../pbgen/hdlctrl/v1/controller_pb";
 import { Controller, useForm } from "react-hook-form";
@@ -73,6 +75,7 @@ export default function NewSessionForm() {
     watch,
     setValue,
     getValues,
+    reset,
     trigger: validate,
     formState: { errors },
   } = useForm<SessionFormValues>({
@@ -85,6 +88,38 @@ export default function NewSessionForm() {
   });
 
   const hostId = watch("hostId");
+  const hostGroupId = hostList?.hosts.find((h) => h.id === hostId)?.groupId;
+  const { data: templateList } = useQuery(
+    listSessionTemplates,
+    { groupId: hostGroupId },
+    { enabled: !!hostGroupId },
+  );
+  const [templateId, setTemplateId] = useState("");
+  const templateOptions = useMemo(
+    () =>
+      templateList?.templates.map((t) => ({
+        id: t.id,
+        label: t.name,
+        value: t,
+      })) ?? [],
+    [templateList?.templates],
+  );
+
+  // テンプレートの内容でフォームを埋め直す. 起動時はフォームの値がそのまま送られる.
+  const applyTemplate = (id: string) => {
+    const template = templateList?.templates.find((t) => t.id === id);
+    if (!template) return;
+    setTemplateId(id);
+    reset({
+      ...DEFAULT_SESSION_FORM_VALUES,
+      ...removeUndefined(
+        searchParamsToFormValues(
+          startupParamsToSearchParams(template.parameters),
+        ),
+      ),
+      hostId,
+    });
+  };
 
   const [scheduleOpen, setScheduleOpen] = useState(false);
   const [scheduledAt, setScheduledAt] = useState(
@@ -231,6 +266,17 @@ export default function NewSessionForm() {
           )}
         />
 
+        {templateOptions.length > 0 && (
+          <SelectField
+            label="テンプレート"
+            options={templateOptions}
+            selectedId={templateId}
+            onChange={(option) => applyTemplate(option.id)}
+            helperText="選ぶとフォームの内容をテンプレートの設定で置き換えます"
+            minWidth="7rem"
+          />
+        )}
+
         <SessionStartupFields
           control={control}
           errors={errors}
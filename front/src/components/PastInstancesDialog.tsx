@@ -1,4 +1,4 @@
-import { useQuery } from "@connectrpc/connect-query";
+import { useMutation, useQuery } from "@connectrpc/connect-query";
 import { useState } from "react";
 import {
   Button,
@@ -9,7 +9,12 @@ import {
   DialogFooter,
   DialogClose,
 } from "./ui";
-import { listHeadlessHostInstances } from "../../pbgen/hdlctrl/v1/controller-ControllerService_connectquery";
+import {
+  listHeadlessHostInstances,
+  prepareHeadlessHostLogDownload,
+} from "../../pbgen/hdlctrl/v1/controller-ControllerService_connectquery";
+import { HeadlessHostLogExportFormat } from "../../pbgen/hdlctrl/v1/controller_pb";
+import { toast } from "sonner";
 import { ScrollBase } from "./base/ScrollBase";
 import HostLogViewer from "./HostLogViewer";
 
@@ -21,6 +26,25 @@ function InstanceLogDialog({
   instanceId: number;
 }) {
   const [open, setOpen] = useState(false);
+  const { mutateAsync: mutatePrepareDownload, isPending: isPendingDownload } =
+    useMutation(prepareHeadlessHostLogDownload);
+
+  const handleDownload = async (format: HeadlessHostLogExportFormat) => {
+    try {
+      const res = await mutatePrepareDownload({ hostId, instanceId, format });
+      const a = document.createElement("a");
+      a.href = res.downloadUrl;
+      a.download = res.filename;
+      document.body.appendChild(a);
+      a.click();
+      document.body.removeChild(a);
+      toast.success(
+        `ログのダウンロードを開始しました (${res.lineCount.toString()}件)`,
+      );
+    } catch (e) {
+      toast.error(`ログのダウンロード準備に失敗しました: ${e}`);
+    }
+  };
 
   return (
     <Dialog open={open} onOpenChange={setOpen}>
@@ -40,6 +64,20 @@ function InstanceLogDialog({
           />
         )}
         <DialogFooter>
+          <Button
+            variant="outline"
+            disabled={isPendingDownload}
+            onClick={() => handleDownload(HeadlessHostLogExportFormat.TEXT)}
+          >
+            テキストでダウンロード
+          </Button>
+          <Button
+            variant="outline"
+            disabled={isPendingDownload}
+            onClick={() => handleDownload(HeadlessHostLogExportFormat.NDJSON)}
+          >
+            NDJSON でダウンロード
+          </Button>
           <DialogClose asChild>
             <Button variant="outline">閉じる</Button>
           </DialogClose>
@@ -5,6 +5,7 @@ import {
   searchSessions,
 } from "../../pbgen/hdlctrl/v1/controller-ControllerService_connectquery";
 import {
+  CronTriggerSchema,
   HeadlessHostStatus,
   ScheduledOperationSchema,
   ScheduledTrigger,
@@ -33,6 +34,7 @@ import { zodResolver } from "@hookform/resolvers/zod";
 import { RadioGroupField, SelectField, TextField, TextareaField } from "./base";
 import {
   dateToTimestamp,
+  defaultCronTimezone,
   defaultScheduledAtInputValue,
   localDateTimeStringToDate,
   operationKindLabel,
@@ -74,6 +76,7 @@ const TRIGGER_OPTIONS: { label: string; value: TriggerKind }[] = [
     label: triggerKindLabel("SESSION_USER_COUNT"),
     value: "SESSION_USER_COUNT",
   },
+  { label: triggerKindLabel("CRON"), value: "CRON" },
 ];
 
 const COMPARATOR_OPTIONS: { id: UserCountComparator; label: string }[] = [
@@ -154,6 +157,8 @@ export default function ScheduledOperationForm({
       ? String(defaultUserCountThreshold)
       : "0",
   );
+  const [cronExpression, setCronExpression] = useState("0 21 * * FRI");
+  const [cronTimezone, setCronTimezone] = useState(defaultCronTimezone());
 
   const { options: sessionOptions, hasRunningSessions } =
     useSessionOptions(defaultSessionId);
@@ -182,6 +187,9 @@ export default function ScheduledOperationForm({
         },
       });
     }
+    if (trigger === "CRON") {
+      return buildCronTrigger();
+    }
     if (!monitorSessionId) {
       toast.error("監視対象セッションを選択してください");
       return null;
@@ -206,6 +214,22 @@ export default function ScheduledOperationForm({
     });
   };
 
+  const buildCronTrigger = (): ScheduledTrigger | null => {
+    if (!cronExpression.trim()) {
+      toast.error("cron 式を指定してください");
+      return null;
+    }
+    return create(ScheduledTriggerSchema, {
+      trigger: {
+        case: "cron",
+        value: create(CronTriggerSchema, {
+          expression: cronExpression.trim(),
+          timezone: cronTimezone.trim(),
+        }),
+      },
+    });
+  };
+
   return (
     <div className="space-y-6">
       <section className="space-y-3 rounded-md border p-4">
@@ -225,6 +249,21 @@ export default function ScheduledOperationForm({
             value={scheduledAt}
             onChange={(e) => setScheduledAt(e.target.value)}
           />
+        ) : trigger === "CRON" ? (
+          <div className="flex gap-2">
+            <TextField
+              label="cron 式"
+              helperText="分 時 日 月 曜日 (例: 0 21 * * FRI で毎週金曜 21:00). @daily なども可"
+              value={cronExpression}
+              onChange={(e) => setCronExpression(e.target.value)}
+            />
+            <TextField
+              label="タイムゾーン"
+              helperText="例: Asia/Tokyo. 空欄なら UTC"
+              value={cronTimezone}
+              onChange={(e) => setCronTimezone(e.target.value)}
+            />
+          </div>
         ) : (
           <div className="space-y-3">
             <SelectField
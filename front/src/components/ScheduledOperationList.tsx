@@ -2,6 +2,9 @@ import { useMutation, useQuery } from "@connectrpc/connect-query";
 import {
   cancelScheduledSessionOperation,
   listScheduledSessionOperations,
+  pauseScheduledSessionOperation,
+  resumeScheduledSessionOperation,
+  skipScheduledSessionOperationOccurrence,
 } from "../../pbgen/hdlctrl/v1/controller-ControllerService_connectquery";
 import {
   ScheduledOperation,
@@ -81,6 +84,45 @@ export default function ScheduledOperationList({ sessionId }: Props) {
     cancelScheduledSessionOperation,
   );
 
+  const { mutateAsync: pauseMutate, isPending: isPausePending } = useMutation(
+    pauseScheduledSessionOperation,
+  );
+  const { mutateAsync: resumeMutate, isPending: isResumePending } =
+    useMutation(resumeScheduledSessionOperation);
+  const { mutateAsync: skipMutate, isPending: isSkipPending } = useMutation(
+    skipScheduledSessionOperationOccurrence,
+  );
+
+  const handlePause = async (id: string) => {
+    try {
+      await pauseMutate({ id });
+      toast.success("予約を一時停止しました");
+      refetch();
+    } catch (e) {
+      toast.error(`一時停止失敗: ${(e as Error).message}`);
+    }
+  };
+
+  const handleResume = async (id: string) => {
+    try {
+      await resumeMutate({ id });
+      toast.success("予約を再開しました");
+      refetch();
+    } catch (e) {
+      toast.error(`再開失敗: ${(e as Error).message}`);
+    }
+  };
+
+  const handleSkip = async (id: string) => {
+    try {
+      await skipMutate({ id });
+      toast.success("次回の実行をスキップしました");
+      refetch();
+    } catch (e) {
+      toast.error(`スキップ失敗: ${(e as Error).message}`);
+    }
+  };
+
   const handleCancel = async (id: string) => {
     try {
       await cancelMutate({ id });
@@ -139,6 +181,21 @@ export default function ScheduledOperationList({ sessionId }: Props) {
               </span>
             );
           }
+          if (trig?.case === "cron") {
+            return (
+              <span className="inline-flex flex-col">
+                <span>
+                  <code>{trig.value.expression}</code>{" "}
+                  <span className="text-muted-foreground">
+                    ({trig.value.timezone || "UTC"})
+                  </span>
+                </span>
+                <span className="text-xs text-muted-foreground">
+                  次回: {formatScheduled(row.original.nextFireAt)}
+                </span>
+              </span>
+            );
+          }
           return "-";
         },
       },
@@ -155,20 +212,60 @@ export default function ScheduledOperationList({ sessionId }: Props) {
       {
         id: "actions",
         header: "",
-        cell: ({ row }) =>
-          row.original.status === ScheduledOperationStatus.PENDING ? (
-            <Button
-              variant="outline"
-              size="sm"
-              disabled={isCancelPending}
-              onClick={() => handleCancel(row.original.id)}
-            >
-              キャンセル
-            </Button>
-          ) : null,
+        cell: ({ row }) => {
+          const { id, status } = row.original;
+          const isCron = row.original.trigger?.trigger.case === "cron";
+          if (
+            status !== ScheduledOperationStatus.PENDING &&
+            status !== ScheduledOperationStatus.PAUSED
+          ) {
+            return null;
+          }
+          return (
+            <div className="flex gap-2">
+              {isCron && status === ScheduledOperationStatus.PENDING && (
+                <Button
+                  variant="outline"
+                  size="sm"
+                  disabled={isSkipPending}
+                  onClick={() => handleSkip(id)}
+                >
+                  次回をスキップ
+                </Button>
+              )}
+              {status === ScheduledOperationStatus.PENDING ? (
+                <Button
+                  variant="outline"
+                  size="sm"
+                  disabled={isPausePending}
+                  onClick={() => handlePause(id)}
+                >
+                  一時停止
+                </Button>
+              ) : (
+                <Button
+                  variant="outline"
+                  size="sm"
+                  disabled={isResumePending}
+                  onClick={() => handleResume(id)}
+                >
+                  再開
+                </Button>
+              )}
+              <Button
+                variant="outline"
+                size="sm"
+                disabled={isCancelPending}
+                onClick={() => handleCancel(id)}
+              >
+                キャンセル
+              </Button>
+            </div>
+          );
+        },
       },
     ],
-    [isCancelPending],
+    [isCancelPending, isPausePending, isResumePending, isSkipPending],
   );
 
   const newHref = sessionId
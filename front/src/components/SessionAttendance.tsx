@@ -0,0 +1,83 @@
+import { useQuery } from "@connectrpc/connect-query";
+import { ColumnDef } from "@tanstack/react-table";
+import { useMemo } from "react";
+import { getSessionAttendance } from "../../pbgen/hdlctrl/v1/controller-ControllerService_connectquery";
+import { SessionAttendee } from "../../pbgen/hdlctrl/v1/controller_pb";
+import { DataTable } from "./base";
+import { RefetchButton } from "./base/RefetchButton";
+import { formatTimestamp } from "../libs/datetimeUtils";
+
+function formatDuration(seconds: bigint) {
+  const s = Number(seconds);
+  const h = Math.floor(s / 3600);
+  const m = Math.floor((s % 3600) / 60);
+  return h > 0 ? `${h}時間${m}分` : `${m}分`;
+}
+
+export default function SessionAttendance({
+  sessionId,
+}: {
+  sessionId: string;
+}) {
+  const { data, isPending, refetch } = useQuery(getSessionAttendance, {
+    sessionId,
+  });
+
+  const columns: ColumnDef<SessionAttendee>[] = useMemo(
+    () => [
+      {
+        id: "user",
+        header: "ユーザー",
+        cell: ({ row }) => row.original.userName || row.original.userId,
+      },
+      {
+        id: "firstJoinedAt",
+        header: "最初に入った時刻",
+        cell: ({ row }) => formatTimestamp(row.original.firstJoinedAt),
+      },
+      {
+        id: "lastLeftAt",
+        header: "最後に出た時刻",
+        cell: ({ row }) =>
+          row.original.present
+            ? "滞在中"
+            : formatTimestamp(row.original.lastLeftAt),
+      },
+      {
+        id: "duration",
+        header: "滞在時間",
+        cell: ({ row }) => formatDuration(row.original.durationSeconds),
+      },
+      {
+        id: "joinCount",
+        header: "入室回数",
+        cell: ({ row }) => row.original.joinCount,
+      },
+    ],
+    [],
+  );
+
+  return (
+    <div className="space-y-2">
+      <div className="flex items-center justify-between">
+        <div className="text-sm text-muted-foreground space-x-4">
+          <span>参加者: {data?.uniqueUsers ?? 0}人</span>
+          <span>
+            最大同時接続: {data?.peakUsers ?? 0}人
+            {data?.peakAt && ` (${formatTimestamp(data.peakAt)})`}
+          </span>
+          <span>
+            延べ滞在時間:{" "}
+            {formatDuration(data?.totalDurationSeconds ?? BigInt(0))}
+          </span>
+        </div>
+        <RefetchButton refetch={refetch} />
+      </div>
+      <DataTable
+        columns={columns}
+        data={data?.attendees ?? []}
+        isLoading={isPending}
+      />
+    </div>
+  );
+}
@@ -0,0 +1,116 @@
+import { useEffect, useRef, useState } from "react";
+import { Copy, Loader2 } from "lucide-react";
+import { Alert, AlertDescription, Button } from "@/components/ui";
+import { ReadOnlyField, TextField } from "@/components/base";
+import type { BeginTotpEnrollmentResponse } from "../../pbgen/hdlctrl/v1/user_pb";
+
+const errorMessage = (error: unknown) =>
+  error instanceof Error ? error.message : "An unknown error occurred";
+
+/**
+ * 認証アプリへの TOTP 登録. secret の発行 → コードの確認 → リカバリーコードの表示 を行う.
+ * サインイン中の登録 (challenge 付き) と設定画面の両方から使う.
+ */
+export function TotpEnrollment({
+  begin,
+  confirm,
+  onDone,
+}: {
+  begin: () => Promise<BeginTotpEnrollmentResponse>;
+  // リカバリーコードを返す
+  confirm: (code: string) => Promise<string[]>;
+  onDone: () => void;
+}) {
+  const [enrollment, setEnrollment] = useState<BeginTotpEnrollmentResponse>();
+  const [recoveryCodes, setRecoveryCodes] = useState<string[]>();
+  const [code, setCode] = useState("");
+  const [error, setError] = useState<string>();
+  const [isLoading, setIsLoading] = useState(false);
+  const started = useRef(false);
+
+  useEffect(() => {
+    // StrictMode で 2 回呼ばれると secret が作り直されるため 1 回だけにする
+    if (started.current) return;
+    started.current = true;
+
+    begin()
+      .then(setEnrollment)
+      .catch((e) => setError(errorMessage(e)));
+  }, [begin]);
+
+  const onConfirm = async (e: React.FormEvent) => {
+    e.preventDefault();
+    setIsLoading(true);
+    setError(undefined);
+    try {
+      setRecoveryCodes(await confirm(code.trim()));
+    } catch (e) {
+      setError(errorMessage(e));
+    }
+    setIsLoading(false);
+  };
+
+  if (recoveryCodes) {
+    return (
+      <div className="space-y-4">
+        <p className="text-sm">
+          認証アプリを使えなくなったときのためのリカバリーコードです。それぞれ 1
+          回だけ使えます。この画面を閉じると再表示できないので、安全な場所に保存してください。
+        </p>
+        <pre className="rounded-md bg-muted p-4 text-sm font-mono grid grid-cols-2 gap-1">
+          {recoveryCodes.map((c) => (
+            <span key={c}>{c}</span>
+          ))}
+        </pre>
+        <div className="flex gap-2">
+          <Button
+            variant="outline"
+            onClick={() => navigator.clipboard.writeText(recoveryCodes.join("\n"))}
+          >
+            <Copy className="mr-2 h-4 w-4" />
+            コピー
+          </Button>
+          <Button onClick={onDone}>保存しました</Button>
+        </div>
+      </div>
+    );
+  }
+
+  return (
+    <form onSubmit={onConfirm} className="space-y-4">
+      {enrollment ? (
+        <>
+          <p className="text-sm">
+            認証アプリ (Google Authenticator など)
+            に次のキーを登録し、表示された 6 桁のコードを入力してください。
+          </p>
+          <ReadOnlyField label="キー" value={enrollment.secret} />
+          <p className="text-sm">
+            <a href={enrollment.keyUri} className="underline">
+              この端末の認証アプリで開く
+            </a>
+          </p>
+          <TextField
+            label="確認コード"
+            inputMode="numeric"
+            autoComplete="one-time-code"
+            value={code}
+            onChange={(e) => setCode(e.target.value)}
+            disabled={isLoading}
+          />
+        </>
+      ) : (
+        !error && <Loader2 className="h-4 w-4 animate-spin" />
+      )}
+      {error && (
+        <Alert variant="destructive">
+          <AlertDescription>{error}</AlertDescription>
+        </Alert>
+      )}
+      <Button type="submit" disabled={!enrollment || !code || isLoading}>
+        {isLoading && <Loader2 className="mr-2 h-4 w-4 animate-spin" />}
+        登録
+      </Button>
+    </form>
+  );
+}
@@ -3,9 +3,19 @@ import { sessionAtom, sessionRefreshTokenAtom } from "../atoms/sessionAtom";
 import { createConnectTransport } from "@connectrpc/connect-web";
 import { callUnaryMethod } from "@connectrpc/connect-query";
 import {
+  beginOidcLogin,
+  beginTotpEnrollment,
+  completeOidcLogin,
+  confirmTotpEnrollment,
   getTokenByPassword,
   refreshToken as refreshTokenRpc,
+  revokeSession,
+  verifyTotpLogin,
 } from "../../pbgen/hdlctrl/v1/user-UserService_connectquery";
+import type {
+  BeginOidcLoginResponse,
+  TokenSetResponse,
+} from "../../pbgen/hdlctrl/v1/user_pb";
 import { useCallback, useEffect, useMemo } from "react";
 import { jwtDecode, JwtPayload as DefaultJwtPayload } from "jwt-decode";
 
@@ -13,10 +23,25 @@ type JwtPayload = DefaultJwtPayload & {
   user_id: string;
   resonite_id: string;
   icon_url: string;
+  // ログインセッション ID. セッション導入前のトークンには無い
+  sid?: string;
 };
 
 const decodeJwt = (token: string) => jwtDecode<JwtPayload>(token);
 
+// IdP へ遷移する前に state を控えておき、戻ってきた state と照合する
+const oidcLoginStorageKey = "oidcLogin";
+
+type OidcLoginContext = {
+  state: string;
+  callbackUrl: string | null;
+  // true ならサインインではなく、ログイン中のユーザーへの紐付け
+  link?: boolean;
+};
+
+const errorMessage = (error: unknown) =>
+  error instanceof Error ? error.message : "An unknown error occurred";
+
 export const useAuth = (baseUrl: string) => {
   const [session, setSession] = useAtom(sessionAtom);
   const [refreshToken, setRefreshToken] = useAtom(sessionRefreshTokenAtom);
@@ -76,13 +101,14 @@ export const useAuth = (baseUrl: string) => {
       });
 
       if (response.status === 401 && refreshToken) {
+        // セッションが失効済みなら refresh も 401 になるので、サインアウト扱いにする
         const refreshResponse = await callUnaryMethod(
           transportWithRefreshToken,
           refreshTokenRpc,
           {},
-        );
+        ).catch(() => undefined);
 
-        if (refreshResponse.token) {
+        if (refreshResponse?.token) {
           const token = refreshResponse.token;
 
           setSession((prev) => prev && { ...prev, token });
@@ -110,6 +136,28 @@ export const useAuth = (baseUrl: string) => {
     ],
   );
 
+  const applyTokenSet = useCallback(
+    (response: TokenSetResponse) => {
+      if (!response.token) {
+        return false;
+      }
+
+      const payload = decodeJwt(response.token);
+      setSession({
+        token: response.token,
+        user: {
+          id: payload.user_id,
+          iconUrl: payload.icon_url,
+          resoniteId: payload.resonite_id,
+        },
+      });
+      setRefreshToken(response.refreshToken);
+
+      return true;
+    },
+    [setSession, setRefreshToken],
+  );
+
   const signIn = useCallback(
     async (id: string, password: string) => {
       try {
@@ -122,37 +170,175 @@ export const useAuth = (baseUrl: string) => {
           },
         );
 
-        if (response.token) {
-          const payload = decodeJwt(response.token);
-          setSession({
-            token: response.token,
-            user: {
-              id: payload.user_id,
-              iconUrl: payload.icon_url,
-              resoniteId: payload.resonite_id,
-            },
-          });
-          setRefreshToken(response.refreshToken);
+        // 2 段階認証が必要ならトークンの代わりに challenge が返る
+        if (response.totpChallenge) {
+          return {
+            ok: false,
+            totpChallenge: response.totpChallenge,
+            totpEnrollmentRequired: response.totpEnrollmentRequired,
+          };
+        }
 
+        if (applyTokenSet(response)) {
           return { ok: true };
         } else {
           return { ok: false, error: "Invalid credentials" };
         }
       } catch (error) {
-        if (error instanceof Error) {
-          return { ok: false, error: error.message };
+        return { ok: false, error: errorMessage(error) };
+      }
+    },
+    [applyTokenSet, transportWithRefreshToken],
+  );
+
+  const verifyTotpSignIn = useCallback(
+    async (challenge: string, code: string) => {
+      try {
+        const response = await callUnaryMethod(
+          transportWithRefreshToken,
+          verifyTotpLogin,
+          { challenge, code },
+        );
+        if (applyTokenSet(response)) {
+          return { ok: true };
         } else {
-          return { ok: false, error: "An unknown error occurred" };
+          return { ok: false, error: "Invalid code" };
         }
+      } catch (error) {
+        return { ok: false, error: errorMessage(error) };
       }
     },
-    [setSession, setRefreshToken, transportWithRefreshToken],
+    [applyTokenSet, transportWithRefreshToken],
+  );
+
+  // 2 段階認証が必須でまだ登録していないユーザーは、サインイン前に challenge で登録する
+  const beginTotpEnrollmentForSignIn = useCallback(
+    (challenge: string) =>
+      callUnaryMethod(transportWithRefreshToken, beginTotpEnrollment, {
+        challenge,
+      }),
+    [transportWithRefreshToken],
+  );
+
+  // 返ってきたトークンはリカバリーコードを見せ終わってから applyTokenSet する
+  const confirmTotpEnrollmentForSignIn = useCallback(
+    (challenge: string, code: string) =>
+      callUnaryMethod(transportWithRefreshToken, confirmTotpEnrollment, {
+        challenge,
+        code,
+      }),
+    [transportWithRefreshToken],
+  );
+
+  const beginOidcSignIn = useCallback(
+    async (callbackUrl: string | null) => {
+      try {
+        const response = await callUnaryMethod(
+          transportWithRefreshToken,
+          beginOidcLogin,
+          {},
+        );
+        const context: OidcLoginContext = {
+          state: response.state,
+          callbackUrl,
+        };
+        sessionStorage.setItem(oidcLoginStorageKey, JSON.stringify(context));
+        window.location.assign(response.authorizationUrl);
+
+        return { ok: true };
+      } catch (error) {
+        return { ok: false, error: errorMessage(error) };
+      }
+    },
+    [transportWithRefreshToken],
+  );
+
+  // BeginOidcLink の結果を受けて IdP に遷移する. 戻ってきたら completeOidcSignIn が紐付けを完了する
+  const redirectToOidcLink = useCallback(
+    (response: BeginOidcLoginResponse, callbackUrl: string) => {
+      const context: OidcLoginContext = {
+        state: response.state,
+        callbackUrl,
+        link: true,
+      };
+      sessionStorage.setItem(oidcLoginStorageKey, JSON.stringify(context));
+      window.location.assign(response.authorizationUrl);
+    },
+    [],
+  );
+
+  // 紐付けは access token が要るので、呼び出し側の (認証付き) transport で completeLink する
+  const completeOidcSignIn = useCallback(
+    async (
+      code: string,
+      state: string,
+      completeLink: (code: string, state: string) => Promise<unknown>,
+    ) => {
+      const stored = sessionStorage.getItem(oidcLoginStorageKey);
+      sessionStorage.removeItem(oidcLoginStorageKey);
+      const context = stored ? (JSON.parse(stored) as OidcLoginContext) : null;
+      if (!context || context.state !== state) {
+        return {
+          ok: false,
+          error: "サインインを開始したブラウザで操作してください",
+        };
+      }
+
+      try {
+        if (context.link) {
+          await completeLink(code, state);
+          return { ok: true, linked: true, callbackUrl: context.callbackUrl };
+        }
+
+        const response = await callUnaryMethod(
+          transportWithRefreshToken,
+          completeOidcLogin,
+          { code, state },
+        );
+        if (applyTokenSet(response)) {
+          return { ok: true, callbackUrl: context.callbackUrl };
+        } else {
+          return { ok: false, error: "Invalid credentials" };
+        }
+      } catch (error) {
+        return { ok: false, error: errorMessage(error) };
+      }
+    },
+    [applyTokenSet, transportWithRefreshToken],
   );
 
   const signOut = useCallback(() => {
+    // サーバー側のセッションも失効させ、refresh token を使えなくする. 失敗してもサインアウトは続ける
+    const sid = session && decodeJwt(session.token).sid;
+    if (session && sid) {
+      const token = session.token;
+      callUnaryMethod(
+        createConnectTransport({
+          baseUrl,
+          fetch: (input: RequestInfo | URL, init?: RequestInit) => {
+            const headers = new Headers(init?.headers);
+            headers.set("authorization", `Bearer ${token}`);
+            return fetch(input, { ...init, headers });
+          },
+        }),
+        revokeSession,
+        { id: sid },
+      ).catch(() => {});
+    }
     setSession(null);
     setRefreshToken(null);
-  }, [setSession, setRefreshToken]);
+  }, [baseUrl, session, setSession, setRefreshToken]);
 
-  return { configuredFetch, signIn, signOut };
+  return {
+    configuredFetch,
+    signIn,
+    verifyTotpSignIn,
+    beginTotpEnrollmentForSignIn,
+    confirmTotpEnrollmentForSignIn,
+    applyTokenSet,
+    beginOidcSignIn,
+    redirectToOidcLink,
+    completeOidcSignIn,
+    signOut,
+  };
 };
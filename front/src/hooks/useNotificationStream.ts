@@ -16,6 +16,7 @@ const maxBackoffMs = 30_000;
  * NotificationEvent を queryClient.invalidateQueries にマッピングする.
  *
  * ログイン中のみ動作. 切断時 exponential backoff (1s → 30s cap) で再接続.
+ * 再接続時は最後に受け取ったイベントの id を渡し、切断中のイベントを再送してもらう.
  * 認証エラーが返ったら session をクリアしてサインインに誘導する.
  */
 export function useNotificationStream(): void {
@@ -30,17 +31,19 @@ export function useNotificationStream(): void {
 
     const controller = new AbortController();
     let backoffMs = initialBackoffMs;
+    let lastEventId: string | undefined;
 
     void (async () => {
       const client = createClient(NotificationService, transport);
       while (!controller.signal.aborted) {
         try {
           const stream = client.subscribeNotifications(
-            {},
+            { lastEventId },
             { signal: controller.signal },
           );
           for await (const ev of stream) {
             backoffMs = initialBackoffMs;
+            if (ev.id) lastEventId = ev.id;
             dispatchNotification(queryClient, ev);
           }
         } catch (e) {
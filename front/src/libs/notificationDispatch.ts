@@ -95,6 +95,13 @@ export function dispatchNotification(
       break;
     }
 
+    case "historyTruncated": {
+      // 再接続までの間のイベントを全ては受け取れていないので、どのクエリが
+      // 古くなったか判断できない. connect-query 由来のクエリを全て無効化する.
+      void queryClient.invalidateQueries({ queryKey: ["connect-query"] });
+      break;
+    }
+
     case "keepAlive":
     case undefined:
     default:
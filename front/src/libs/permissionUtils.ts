@@ -15,12 +15,15 @@ export const PERMISSION_KEYS = {
   ACCOUNT_USE: "account:use",
   GROUP_MEMBERS_MANAGE: "group:members.manage",
   GROUP_EDIT: "group:edit",
+  GROUP_AUDIT_READ: "group:audit.read",
+  GROUP_WEBHOOKS_MANAGE: "group:webhooks.manage",
   SYSTEM_USER_CREATE: "system:user.create",
   SYSTEM_USER_DELETE: "system:user.delete",
   SYSTEM_USER_LIST: "system:user.list",
   SYSTEM_GROUP_LIST: "system:group.list",
   SYSTEM_GROUP_MANAGE: "system:group.manage",
   SYSTEM_ROLE_MANAGE: "system:role.manage",
+  SYSTEM_AUDIT_READ: "system:audit.read",
 } as const;
 
 export type PermissionKey =
@@ -76,6 +79,10 @@ export function permissionKeyToLabel(key: string): string {
       return "グループメンバー管理";
     case PERMISSION_KEYS.GROUP_EDIT:
       return "グループ編集";
+    case PERMISSION_KEYS.GROUP_AUDIT_READ:
+      return "監査ログ閲覧";
+    case PERMISSION_KEYS.GROUP_WEBHOOKS_MANAGE:
+      return "Webhook 管理";
     case PERMISSION_KEYS.SYSTEM_USER_CREATE:
       return "ユーザー作成";
     case PERMISSION_KEYS.SYSTEM_USER_DELETE:
@@ -88,6 +95,8 @@ export function permissionKeyToLabel(key: string): string {
       return "全グループ管理";
     case PERMISSION_KEYS.SYSTEM_ROLE_MANAGE:
       return "グローバルロール管理";
+    case PERMISSION_KEYS.SYSTEM_AUDIT_READ:
+      return "全監査ログ閲覧";
     default:
       return key;
   }
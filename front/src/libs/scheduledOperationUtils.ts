@@ -21,7 +21,7 @@ export const operationKindLabel = (k: OperationKind): string => {
   }
 };
 
-export type TriggerKind = "TIME" | "SESSION_USER_COUNT";
+export type TriggerKind = "TIME" | "SESSION_USER_COUNT" | "CRON";
 
 export const triggerKindLabel = (t: TriggerKind): string => {
   switch (t) {
@@ -29,9 +29,15 @@ export const triggerKindLabel = (t: TriggerKind): string => {
       return "指定日時";
     case "SESSION_USER_COUNT":
       return "セッションのユーザー数";
+    case "CRON":
+      return "繰り返し (cron)";
   }
 };
 
+/** cron trigger のタイムゾーンの初期値: ブラウザのタイムゾーン. */
+export const defaultCronTimezone = (): string =>
+  Intl.DateTimeFormat().resolvedOptions().timeZone ?? "UTC";
+
 export type UserCountComparator = "LESS_OR_EQUAL" | "GREATER_OR_EQUAL";
 
 export const userCountComparatorLabel = (c: UserCountComparator): string => {
@@ -57,6 +63,8 @@ export const scheduledOperationStatusToLabel = (
       return "失敗";
     case ScheduledOperationStatus.CANCELED:
       return "キャンセル";
+    case ScheduledOperationStatus.PAUSED:
+      return "一時停止中";
     default:
       return "不明";
   }
@@ -6,6 +6,7 @@ import App from "./App";
 import Layout from "./layouts/dashboard";
 import DashboardPage from "./pages";
 import SignInPage from "./pages/signin";
+import SignInOidcPage from "./pages/signinOidc";
 import RegisterPage from "./pages/register";
 import Sessions from "./pages/sessions";
 import SessionDetail from "./pages/sessions/detail";
@@ -147,6 +148,10 @@ const router = createBrowserRouter([
         path: "/sign-in",
         Component: SignInPage,
       },
+      {
+        path: "/sign-in/oidc",
+        Component: SignInOidcPage,
+      },
       {
         path: "/register/:token",
         Component: RegisterPage,
@@ -1,6 +1,7 @@
 import HostLogViewer from "../../components/HostLogViewer";
 import { useParams } from "react-router";
 import HostDetailPanel from "../../components/HostDetailPanel";
+import HostMetricsChart from "../../components/HostMetricsChart";
 import { useQuery } from "@connectrpc/connect-query";
 import { getHeadlessHost } from "../../../pbgen/hdlctrl/v1/controller-ControllerService_connectquery";
 import { HeadlessHostStatus } from "../../../pbgen/hdlctrl/v1/controller_pb";
@@ -20,6 +21,10 @@ export default function HostDetail() {
           <div className="w-full">
             <HostDetailPanel hostId={id} />
           </div>
+          <div className="w-full space-y-2 border-t pt-4">
+            <h2 className="text-lg font-semibold">メトリクス</h2>
+            <HostMetricsChart hostId={id} />
+          </div>
           <div className="w-full">
             {hostData?.host?.instanceId !== undefined && (
               <HostLogViewer
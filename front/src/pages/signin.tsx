@@ -1,10 +1,12 @@
 "use client";
-import { useState } from "react";
+import { useRef, useState } from "react";
 import { Navigate, useNavigate } from "react-router";
 import { useForm } from "react-hook-form";
 import { useAtom } from "jotai";
+import { useQuery } from "@connectrpc/connect-query";
 import { sessionAtom } from "../atoms/sessionAtom";
 import { useAuth } from "../hooks/useAuth";
+import { getLoginOptions } from "../../pbgen/hdlctrl/v1/user-UserService_connectquery";
 import {
   Button,
   Card,
@@ -13,24 +15,45 @@ import {
   CardTitle,
   Alert,
   AlertDescription,
+  Separator,
 } from "@/components/ui";
 import { Loader2 } from "lucide-react";
 import { TextField } from "@/components/base";
+import { TotpEnrollment } from "@/components/TotpEnrollment";
+import type { TokenSetResponse } from "../../pbgen/hdlctrl/v1/user_pb";
 
 interface SignInForm {
   email: string;
   password: string;
 }
 
+// パスワード認証後、2 段階認証のコード入力または (必須の場合) 登録に進む
+type SignInStep =
+  | { kind: "password" }
+  | { kind: "totp"; challenge: string }
+  | { kind: "enroll"; challenge: string };
+
 export default function SignIn() {
   const [session] = useAtom(sessionAtom);
   const navigate = useNavigate();
-  const { signIn } = useAuth("/");
+  const {
+    signIn,
+    verifyTotpSignIn,
+    beginTotpEnrollmentForSignIn,
+    confirmTotpEnrollmentForSignIn,
+    applyTokenSet,
+    beginOidcSignIn,
+  } = useAuth("/");
+  const { data: loginOptions } = useQuery(getLoginOptions, {});
   const query = new URLSearchParams(location.search);
   const queryCallbackUrl = query.get("callbackUrl");
 
   const [error, setError] = useState<string | undefined>();
   const [isLoading, setIsLoading] = useState(false);
+  const [step, setStep] = useState<SignInStep>({ kind: "password" });
+  const [totpCode, setTotpCode] = useState("");
+  // 登録完了時のトークンはリカバリーコードを確認してから反映する
+  const enrolledTokens = useRef<TokenSetResponse | undefined>(undefined);
 
   const {
     register,
@@ -47,6 +70,27 @@ export default function SignIn() {
     setError(undefined);
 
     const response = await signIn(data.email, data.password);
+    if (response.ok) {
+      navigate(queryCallbackUrl || "/", { replace: true });
+    } else if (response.totpChallenge) {
+      setStep({
+        kind: response.totpEnrollmentRequired ? "enroll" : "totp",
+        challenge: response.totpChallenge,
+      });
+    } else {
+      setError(response.error);
+    }
+    setIsLoading(false);
+  };
+
+  const onVerifyTotp = async (e: React.FormEvent) => {
+    e.preventDefault();
+    if (step.kind !== "totp") return;
+
+    setIsLoading(true);
+    setError(undefined);
+
+    const response = await verifyTotpSignIn(step.challenge, totpCode.trim());
     if (response.ok) {
       navigate(queryCallbackUrl || "/", { replace: true });
     } else {
@@ -55,6 +99,98 @@ export default function SignIn() {
     setIsLoading(false);
   };
 
+  const onEnrollmentDone = () => {
+    if (enrolledTokens.current && applyTokenSet(enrolledTokens.current)) {
+      navigate(queryCallbackUrl || "/", { replace: true });
+    }
+  };
+
+  if (step.kind === "enroll") {
+    return (
+      <div className="min-h-screen flex items-center justify-center bg-background p-4">
+        <Card className="w-full max-w-md">
+          <CardHeader className="space-y-1">
+            <CardTitle className="text-2xl font-bold text-center">
+              2 段階認証の設定
+            </CardTitle>
+          </CardHeader>
+          <CardContent>
+            <p className="text-sm mb-4">
+              このアカウントでは 2 段階認証が必須です。サインインを続けるには認証アプリを登録してください。
+            </p>
+            <TotpEnrollment
+              begin={() => beginTotpEnrollmentForSignIn(step.challenge)}
+              confirm={async (code) => {
+                const response = await confirmTotpEnrollmentForSignIn(
+                  step.challenge,
+                  code,
+                );
+                enrolledTokens.current = response.tokens;
+                return response.recoveryCodes;
+              }}
+              onDone={onEnrollmentDone}
+            />
+          </CardContent>
+        </Card>
+      </div>
+    );
+  }
+
+  if (step.kind === "totp") {
+    return (
+      <div className="min-h-screen flex items-center justify-center bg-background p-4">
+        <Card className="w-full max-w-md">
+          <CardHeader className="space-y-1">
+            <CardTitle className="text-2xl font-bold text-center">
+              2 段階認証
+            </CardTitle>
+          </CardHeader>
+          <CardContent>
+            <form onSubmit={onVerifyTotp} className="space-y-4">
+              <TextField
+                label="認証コード"
+                inputMode="numeric"
+                autoComplete="one-time-code"
+                autoFocus
+                value={totpCode}
+                onChange={(e) => setTotpCode(e.target.value)}
+                disabled={isLoading}
+              />
+              <p className="text-sm text-muted-foreground">
+                認証アプリのコード、またはリカバリーコードを入力してください。
+              </p>
+              {error && (
+                <Alert variant="destructive">
+                  <AlertDescription>{error}</AlertDescription>
+                </Alert>
+              )}
+              <Button
+                type="submit"
+                className="w-full"
+                disabled={isLoading || !totpCode}
+              >
+                {isLoading && <Loader2 className="mr-2 h-4 w-4 animate-spin" />}
+                確認
+              </Button>
+            </form>
+          </CardContent>
+        </Card>
+      </div>
+    );
+  }
+
+  const onOidcSignIn = async () => {
+    setIsLoading(true);
+    setError(undefined);
+
+    // 成功すると IdP へ遷移するのでローディング表示のままにする
+    const response = await beginOidcSignIn(queryCallbackUrl);
+    if (!response.ok) {
+      setError(response.error);
+      setIsLoading(false);
+    }
+  };
+
   return (
     <div className="min-h-screen flex items-center justify-center bg-background p-4">
       <Card className="w-full max-w-md">
@@ -94,6 +230,19 @@ export default function SignIn() {
               )}
             </Button>
           </form>
+          {loginOptions?.oidcEnabled && (
+            <>
+              <Separator className="my-4" />
+              <Button
+                variant="outline"
+                className="w-full"
+                disabled={isLoading}
+                onClick={onOidcSignIn}
+              >
+                {loginOptions.oidcProviderName} でサインイン
+              </Button>
+            </>
+          )}
         </CardContent>
       </Card>
     </div>
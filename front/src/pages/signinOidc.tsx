@@ -0,0 +1,87 @@
+import { useEffect, useRef, useState } from "react";
+import { useNavigate } from "react-router";
+import { useMutation } from "@connectrpc/connect-query";
+import { toast } from "sonner";
+import { useAuth } from "../hooks/useAuth";
+import { completeOidcLink } from "../../pbgen/hdlctrl/v1/user-UserService_connectquery";
+import {
+  Button,
+  Card,
+  CardContent,
+  CardHeader,
+  CardTitle,
+  Alert,
+  AlertDescription,
+} from "@/components/ui";
+import { Loader2 } from "lucide-react";
+
+// IdP からのリダイレクト先 (OIDC_REDIRECT_URL)
+export default function SignInOidc() {
+  const navigate = useNavigate();
+  const { completeOidcSignIn } = useAuth("/");
+  const { mutateAsync: completeLink } = useMutation(completeOidcLink);
+  const [error, setError] = useState<string | undefined>();
+  // code / state は 1 回しか使えないので StrictMode の二重実行でも 1 回だけ送る
+  const started = useRef(false);
+
+  useEffect(() => {
+    if (started.current) return;
+    started.current = true;
+
+    (async () => {
+      const query = new URLSearchParams(location.search);
+      const idpError = query.get("error");
+      if (idpError) {
+        setError(query.get("error_description") || idpError);
+        return;
+      }
+
+      const response = await completeOidcSignIn(
+        query.get("code") ?? "",
+        query.get("state") ?? "",
+        (code, state) => completeLink({ code, state }),
+      );
+      if (response.ok) {
+        if (response.linked) {
+          toast.success("アカウントを連携しました");
+        }
+        navigate(response.callbackUrl || "/", { replace: true });
+      } else {
+        setError(response.error);
+      }
+    })();
+  }, [completeOidcSignIn, completeLink, navigate]);
+
+  return (
+    <div className="min-h-screen flex items-center justify-center bg-background p-4">
+      <Card className="w-full max-w-md">
+        <CardHeader className="space-y-1">
+          <CardTitle className="text-2xl font-bold text-center">
+            サインイン
+          </CardTitle>
+        </CardHeader>
+        <CardContent>
+          {error ? (
+            <>
+              <Alert variant="destructive">
+                <AlertDescription>{error}</AlertDescription>
+              </Alert>
+              <Button
+                className="w-full mt-4"
+                variant="outline"
+                onClick={() => navigate("/sign-in", { replace: true })}
+              >
+                サインインページへ
+              </Button>
+            </>
+          ) : (
+            <div className="flex items-center justify-center">
+              <Loader2 className="h-8 w-8 animate-spin" />
+              <span className="ml-2">サインイン中...</span>
+            </div>
+          )}
+        </CardContent>
+      </Card>
+    </div>
+  );
+}
@@ -0,0 +1,60 @@
+import { useMutation, useQuery } from "@connectrpc/connect-query";
+import { Loader2 } from "lucide-react";
+import {
+  beginOidcLink,
+  getLoginOptions,
+} from "../../../pbgen/hdlctrl/v1/user-UserService_connectquery";
+import {
+  Alert,
+  AlertDescription,
+  Button,
+  Card,
+  CardContent,
+  CardDescription,
+  CardHeader,
+  CardTitle,
+} from "@/components/ui";
+import { useAuth } from "@/hooks/useAuth";
+
+export default function OidcLinkCard() {
+  const { data: options } = useQuery(getLoginOptions, {});
+  const begin = useMutation(beginOidcLink);
+  const { redirectToOidcLink } = useAuth("/");
+
+  if (!options?.oidcEnabled) {
+    return null;
+  }
+
+  const providerName = options.oidcProviderName || "SSO";
+
+  const onLink = async () => {
+    try {
+      redirectToOidcLink(await begin.mutateAsync({}), "/user-settings");
+    } catch {
+      // エラーはuseMutationが管理
+    }
+  };
+
+  return (
+    <Card>
+      <CardHeader>
+        <CardTitle>シングルサインオン</CardTitle>
+        <CardDescription>
+          {providerName}{" "}
+          のアカウントを連携すると、以降はそのアカウントでサインインできます。
+        </CardDescription>
+      </CardHeader>
+      <CardContent className="space-y-4">
+        {begin.error && (
+          <Alert variant="destructive">
+            <AlertDescription>{begin.error.message}</AlertDescription>
+          </Alert>
+        )}
+        <Button onClick={onLink} disabled={begin.isPending}>
+          {begin.isPending && <Loader2 className="mr-2 h-4 w-4 animate-spin" />}
+          {providerName} のアカウントを連携
+        </Button>
+      </CardContent>
+    </Card>
+  );
+}
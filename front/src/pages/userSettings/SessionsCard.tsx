@@ -0,0 +1,82 @@
+import { useMutation, useQuery } from "@connectrpc/connect-query";
+import { toast } from "sonner";
+import { Loader2 } from "lucide-react";
+import {
+  listMySessions,
+  revokeSession,
+} from "../../../pbgen/hdlctrl/v1/user-UserService_connectquery";
+import {
+  Badge,
+  Button,
+  Card,
+  CardContent,
+  CardDescription,
+  CardHeader,
+  CardTitle,
+} from "@/components/ui";
+import { formatTimestamp } from "../../libs/datetimeUtils";
+
+export default function SessionsCard() {
+  const { data, isPending, refetch } = useQuery(listMySessions, {});
+  const revoke = useMutation(revokeSession);
+
+  const onRevoke = async (id: string) => {
+    try {
+      await revoke.mutateAsync({ id });
+      toast.success("セッションをサインアウトさせました");
+      refetch();
+    } catch (e) {
+      toast.error(e instanceof Error ? e.message : "失効に失敗しました");
+    }
+  };
+
+  return (
+    <Card>
+      <CardHeader>
+        <CardTitle>ログイン中の端末</CardTitle>
+        <CardDescription>
+          心当たりのない端末はサインアウトさせてください。パスワードを変更すると、この端末以外は自動でサインアウトします。
+        </CardDescription>
+      </CardHeader>
+      <CardContent>
+        {isPending ? (
+          <Loader2 className="h-4 w-4 animate-spin" />
+        ) : (
+          <ul className="divide-y">
+            {data?.sessions.map((s) => (
+              <li
+                key={s.id}
+                className="flex items-center justify-between gap-4 py-3"
+              >
+                <div className="min-w-0 space-y-1 text-sm">
+                  <p className="truncate" title={s.userAgent}>
+                    {s.userAgent || "不明な端末"}
+                    {s.current && (
+                      <Badge variant="secondary" className="ml-2">
+                        この端末
+                      </Badge>
+                    )}
+                  </p>
+                  <p className="text-muted-foreground">
+                    {s.ipAddress} ・ 最終利用: {formatTimestamp(s.lastSeenAt)}{" "}
+                    ・ ログイン: {formatTimestamp(s.createdAt)}
+                  </p>
+                </div>
+                {!s.current && (
+                  <Button
+                    variant="outline"
+                    size="sm"
+                    onClick={() => onRevoke(s.id)}
+                    disabled={revoke.isPending}
+                  >
+                    サインアウト
+                  </Button>
+                )}
+              </li>
+            ))}
+          </ul>
+        )}
+      </CardContent>
+    </Card>
+  );
+}
@@ -0,0 +1,110 @@
+import { useState } from "react";
+import { useMutation, useQuery } from "@connectrpc/connect-query";
+import { toast } from "sonner";
+import { Loader2 } from "lucide-react";
+import {
+  beginTotpEnrollment,
+  confirmTotpEnrollment,
+  disableTotp,
+  getTotpStatus,
+} from "../../../pbgen/hdlctrl/v1/user-UserService_connectquery";
+import {
+  Alert,
+  AlertDescription,
+  Button,
+  Card,
+  CardContent,
+  CardDescription,
+  CardHeader,
+  CardTitle,
+} from "@/components/ui";
+import { TextField } from "@/components/base";
+import { TotpEnrollment } from "@/components/TotpEnrollment";
+
+export default function TotpSettingsCard() {
+  const { data: status, isPending, refetch } = useQuery(getTotpStatus, {});
+  const begin = useMutation(beginTotpEnrollment);
+  const confirm = useMutation(confirmTotpEnrollment);
+  const disable = useMutation(disableTotp);
+
+  const [enrolling, setEnrolling] = useState(false);
+  const [code, setCode] = useState("");
+
+  const onDisable = async (e: React.FormEvent) => {
+    e.preventDefault();
+    try {
+      await disable.mutateAsync({ code: code.trim() });
+      toast.success("2 段階認証を無効にしました");
+      setCode("");
+      refetch();
+    } catch {
+      // エラーはuseMutationが管理
+    }
+  };
+
+  const onEnrollmentDone = () => {
+    setEnrolling(false);
+    toast.success("2 段階認証を有効にしました");
+    refetch();
+  };
+
+  return (
+    <Card>
+      <CardHeader>
+        <CardTitle>2 段階認証</CardTitle>
+        <CardDescription>
+          パスワードでのサインイン時に認証アプリのコードを要求します。
+          {status?.required && " このアカウントでは 2 段階認証が必須です。"}
+        </CardDescription>
+      </CardHeader>
+      <CardContent>
+        {isPending ? (
+          <Loader2 className="h-4 w-4 animate-spin" />
+        ) : enrolling ? (
+          <TotpEnrollment
+            begin={() => begin.mutateAsync({})}
+            confirm={async (c) =>
+              (await confirm.mutateAsync({ code: c })).recoveryCodes
+            }
+            onDone={onEnrollmentDone}
+          />
+        ) : status?.enabled ? (
+          <form onSubmit={onDisable} className="space-y-4">
+            <p className="text-sm">
+              有効です。未使用のリカバリーコード: {status.remainingRecoveryCodes}
+            </p>
+            {!status.required && (
+              <>
+                <TextField
+                  label="認証コード"
+                  inputMode="numeric"
+                  autoComplete="one-time-code"
+                  value={code}
+                  onChange={(e) => setCode(e.target.value)}
+                  disabled={disable.isPending}
+                />
+                {disable.error && (
+                  <Alert variant="destructive">
+                    <AlertDescription>{disable.error.message}</AlertDescription>
+                  </Alert>
+                )}
+                <Button
+                  type="submit"
+                  variant="destructive"
+                  disabled={disable.isPending || !code}
+                >
+                  {disable.isPending && (
+                    <Loader2 className="mr-2 h-4 w-4 animate-spin" />
+                  )}
+                  無効にする
+                </Button>
+              </>
+            )}
+          </form>
+        ) : (
+          <Button onClick={() => setEnrolling(true)}>設定する</Button>
+        )}
+      </CardContent>
+    </Card>
+  );
+}
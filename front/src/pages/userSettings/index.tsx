@@ -16,6 +16,9 @@ import {
 } from "@/components/ui";
 import { TextField } from "@/components/base";
 import { Loader2, CheckCircle } from "lucide-react";
+import TotpSettingsCard from "./TotpSettingsCard";
+import SessionsCard from "./SessionsCard";
+import OidcLinkCard from "./OidcLinkCard";
 
 const passwordSchema = z
   .object({
@@ -60,7 +63,7 @@ export default function UserSettings() {
   };
 
   return (
-    <div className="container max-w-2xl mx-auto py-6">
+    <div className="container max-w-2xl mx-auto py-6 space-y-6">
       <Card>
         <CardHeader>
           <CardTitle>パスワード変更</CardTitle>
@@ -118,6 +121,9 @@ export default function UserSettings() {
           </form>
         </CardContent>
       </Card>
+      <TotpSettingsCard />
+      <OidcLinkCard />
+      <SessionsCard />
     </div>
   );
 }
@@ -3,6 +3,8 @@ package auth
 import (
 	"context"
 	"net/http"
+	"slices"
+	"strings"
 	"time"
 
 	"github.com/go-errors/errors"
@@ -19,6 +21,9 @@ const (
 	defaultTokenTTL    = 30 * time.Minute
 	defaultRefreshTTL  = 3 * 24 * time.Hour
 
+	// RefreshTokenTTL は refresh token の有効期間. ログインセッションは refresh するたびにこれだけ延びる.
+	RefreshTokenTTL = defaultRefreshTTL
+
 	// ResoniteLinkAudience は ResoniteLink 用 WebSocket 接続トークンの audience.
 	// 通常のアクセストークン (AuthClaims) との誤用を防ぐ.
 	ResoniteLinkAudience = "resonite-link-ws"
@@ -32,9 +37,101 @@ type AuthClaims struct {
 	UserID     string `json:"user_id"`
 	ResoniteID string `json:"resonite_id"`
 	IconUrl    string `json:"icon_url"`
+	// APIToken は API トークンで認証されたリクエストのときだけセットされる.
+	// JWT には載せない.
+	APIToken *APITokenScope `json:"-"`
+	// UserSessionID はトークンを発行したログインセッション (user_sessions) の ID.
+	// セッションを失効させると、このセッションのトークンは期限前でも使えなくなる.
+	UserSessionID string `json:"sid,omitempty"`
+	// RefreshGeneration は refresh token にだけ載せる世代番号. access token では 0.
+	RefreshGeneration int64 `json:"rgen,omitempty"`
 	jwt.RegisteredClaims
 }
 
+// APITokenPrefix は API トークンの接頭辞. Bearer の値がこれで始まれば JWT ではなく
+// API トークンとして検証する.
+const APITokenPrefix = "brhc_"
+
+// ErrInvalidAPIToken は API トークンが存在しない / 失効済み / 期限切れのときに
+// APITokenVerifier が返す. それ以外のエラー (DB 障害等) は Internal として扱う.
+var ErrInvalidAPIToken = errors.New("invalid api token")
+
+// APITokenScope は API トークンに付けられた制限.
+// ユーザー自身が持つ権限の範囲をさらに絞るだけで、権限を追加することはない.
+type APITokenScope struct {
+	TokenID string
+	// GroupID が空でなければそのグループの操作だけを許可する (system:* も不可).
+	GroupID string
+	// PermissionKeys が空でなければその key の操作だけを許可する.
+	PermissionKeys []string
+}
+
+// Allows は groupID に対する key の操作がトークンの制限内か判定する.
+// key が空ならグループの制限だけを見る. system 権限の判定では groupID を空にする.
+// nil (= API トークン以外の認証) なら常に true.
+func (s *APITokenScope) Allows(groupID, key string) bool {
+	if s == nil {
+		return true
+	}
+
+	if key != "" && len(s.PermissionKeys) > 0 && !slices.Contains(s.PermissionKeys, key) {
+		return false
+	}
+
+	if s.GroupID != "" {
+		if strings.HasPrefix(key, "system:") || groupID != s.GroupID {
+			return false
+		}
+	}
+
+	return true
+}
+
+// APITokenVerifier は API トークンを検証して claims を返す.
+// 無効なトークンには ErrInvalidAPIToken を wrap したエラーを返すこと.
+type APITokenVerifier interface {
+	VerifyAPIToken(ctx context.Context, token string) (*AuthClaims, error)
+}
+
+var apiTokenVerifier APITokenVerifier
+
+// SetAPITokenVerifier は auth interceptor が API トークンの検証に使う verifier を登録する.
+// 未登録の間は API トークンを全て拒否する.
+func SetAPITokenVerifier(v APITokenVerifier) {
+	apiTokenVerifier = v
+}
+
+// ErrInvalidUserSession はトークンのログインセッションが存在しない / 失効済み / 期限切れのときに
+// UserSessionVerifier が返す. それ以外のエラー (DB 障害等) は Internal として扱う.
+var ErrInvalidUserSession = errors.New("invalid user session")
+
+// UserSessionVerifier は JWT のログインセッションが有効か検証する.
+// 無効なセッションには ErrInvalidUserSession を wrap したエラーを返すこと.
+type UserSessionVerifier interface {
+	VerifyUserSession(ctx context.Context, claims *AuthClaims) error
+}
+
+var userSessionVerifier UserSessionVerifier
+
+// SetUserSessionVerifier は auth interceptor が JWT のセッション検証に使う verifier を登録する.
+// 未登録の間はセッションを検証しない.
+func SetUserSessionVerifier(v UserSessionVerifier) {
+	userSessionVerifier = v
+}
+
+// errRefreshTokenAsAccessToken は refresh token を access token として送られたときのエラー.
+var errRefreshTokenAsAccessToken = errors.New("refresh token cannot be used as an access token")
+
+// verifyUserSession はセッションに紐付いた JWT のセッションが失効していないか確認する.
+// API トークンとセッションを持たない JWT はそのまま通す.
+func verifyUserSession(ctx context.Context, claims *AuthClaims) error {
+	if claims.UserSessionID == "" || userSessionVerifier == nil {
+		return nil
+	}
+
+	return userSessionVerifier.VerifyUserSession(ctx, claims)
+}
+
 func GenerateToken(claims AuthClaims, tokenTTL time.Duration) (string, error) {
 	now := time.Now()
 	claims.ExpiresAt = jwt.NewNumericDate(now.Add(tokenTTL))
@@ -81,6 +178,8 @@ func parseJWT(tokenString string, claims jwt.Claims, opts ...jwt.ParserOption) e
 
 // GenerateTokensWithDefaultTTL generates a token and a refreshToken with default TTLs.
 // The token will expire in 30 minutes and the refreshToken will expire in 3 days.
+// The tokens are not bound to a login session, so the refreshToken cannot be
+// used with RefreshToken; logins use GenerateSessionTokens instead.
 func GenerateTokensWithDefaultTTL(claims AuthClaims) (string, string, error) {
 	token, err := GenerateToken(claims, defaultTokenTTL)
 	if err != nil {
@@ -95,6 +194,27 @@ func GenerateTokensWithDefaultTTL(claims AuthClaims) (string, string, error) {
 	return token, refreshToken, nil
 }
 
+// GenerateSessionTokens generates a token and a refreshToken for the login
+// session claims.UserSessionID. Only the refreshToken carries generation, which
+// the session store compares to detect reuse of a rotated refreshToken.
+func GenerateSessionTokens(claims AuthClaims, generation int64) (string, string, error) {
+	claims.RefreshGeneration = 0
+
+	token, err := GenerateToken(claims, defaultTokenTTL)
+	if err != nil {
+		return "", "", errors.Wrap(err, 0)
+	}
+
+	claims.RefreshGeneration = generation
+
+	refreshToken, err := GenerateToken(claims, RefreshTokenTTL)
+	if err != nil {
+		return "", "", errors.Wrap(err, 0)
+	}
+
+	return token, refreshToken, nil
+}
+
 func ParseToken(tokenString string) (*AuthClaims, error) {
 	claims := &AuthClaims{}
 	if err := parseJWT(tokenString, claims); err != nil {
@@ -108,32 +228,89 @@ type AuthClaimsContextKey string
 
 var AuthClaimsKey = AuthClaimsContextKey("claims")
 
-// validateAuthHeader は Authorization ヘッダから Bearer トークンを抽出して
-// claims をパースする. Unary/streaming 両方の interceptor から共有する.
-func validateAuthHeader(h http.Header) (*AuthClaims, error) {
+// bearerToken は Authorization ヘッダから Bearer トークンを取り出す.
+func bearerToken(h http.Header) (string, error) {
 	token := h.Get("Authorization")
 	if len(token) <= len("Bearer ") {
 		err := connect.NewError(connect.CodeUnauthenticated, errors.New("token required"))
 		err.Meta().Add("WWW-Authenticate", "Bearer realm=\"token_required\"")
 
+		return "", err
+	}
+
+	return token[len("Bearer "):], nil
+}
+
+func invalidTokenError(err error) error {
+	connectErr := connect.NewError(connect.CodeUnauthenticated, err)
+	connectErr.Meta().Add("WWW-Authenticate", "Bearer error=\"invalid_token\"")
+
+	return connectErr
+}
+
+// parseBearerToken は JWT / API トークンのどちらかとして検証する.
+func parseBearerToken(ctx context.Context, token string) (*AuthClaims, error) {
+	if !strings.HasPrefix(token, APITokenPrefix) {
+		return ParseToken(token)
+	}
+
+	if apiTokenVerifier == nil {
+		return nil, errors.WrapPrefix(ErrInvalidAPIToken, "api tokens are not enabled", 0)
+	}
+
+	return apiTokenVerifier.VerifyAPIToken(ctx, token)
+}
+
+// validateAuthHeader は Authorization ヘッダから Bearer トークンを抽出して
+// claims をパースする. Unary/streaming 両方の interceptor から共有する.
+// JWT に加えて API トークンも受け付ける.
+func validateAuthHeader(ctx context.Context, h http.Header) (*AuthClaims, error) {
+	token, err := bearerToken(h)
+	if err != nil {
 		return nil, err
 	}
 
-	claims, err := ParseToken(token[len("Bearer "):])
+	claims, err := parseBearerToken(ctx, token)
 	if err != nil {
-		connectErr := connect.NewError(connect.CodeUnauthenticated, err)
-		connectErr.Meta().Add("WWW-Authenticate", "Bearer error=\"invalid_token\"")
+		if strings.HasPrefix(token, APITokenPrefix) && !errors.Is(err, ErrInvalidAPIToken) {
+			return nil, connect.NewError(connect.CodeInternal, err)
+		}
+
+		return nil, invalidTokenError(err)
+	}
+
+	// refresh token を盗まれても、RefreshToken (世代を検証する) 以外では使えないようにする.
+	if claims.RefreshGeneration != 0 {
+		return nil, invalidTokenError(errors.Wrap(errRefreshTokenAsAccessToken, 0))
+	}
+
+	if err := verifyUserSession(ctx, claims); err != nil {
+		if !errors.Is(err, ErrInvalidUserSession) {
+			return nil, connect.NewError(connect.CodeInternal, err)
+		}
 
-		return nil, connectErr
+		return nil, invalidTokenError(err)
 	}
 
 	return claims, nil
 }
 
-// ValidateToken は Unary handler の AnyRequest からトークンを検証する.
-// 既存の RPC handler から直接呼ばれるため公開 API として残す.
+// ValidateToken は Unary handler の AnyRequest から JWT を検証する.
+// RefreshToken から呼ばれるため API トークンは受け付けない
+// (API トークンから制限の無い JWT を発行できてしまうため).
+// ログインセッションの検証は refresh token の世代と合わせて呼び出し側で行う.
 func ValidateToken(_ context.Context, req connect.AnyRequest) (*AuthClaims, error) {
-	return validateAuthHeader(req.Header())
+	token, err := bearerToken(req.Header())
+	if err != nil {
+		return nil, err
+	}
+
+	claims, err := ParseToken(token)
+	if err != nil {
+		return nil, invalidTokenError(err)
+	}
+
+	return claims, nil
 }
 
 func SetSuccessResponseHeader(res connect.AnyResponse) {
@@ -146,7 +323,7 @@ type authInterceptor struct{}
 
 func (authInterceptor) WrapUnary(next connect.UnaryFunc) connect.UnaryFunc {
 	return func(ctx context.Context, req connect.AnyRequest) (connect.AnyResponse, error) {
-		claims, err := validateAuthHeader(req.Header())
+		claims, err := validateAuthHeader(ctx, req.Header())
 		if err != nil {
 			return nil, err
 		}
@@ -168,7 +345,7 @@ func (authInterceptor) WrapStreamingClient(next connect.StreamingClientFunc) con
 
 func (authInterceptor) WrapStreamingHandler(next connect.StreamingHandlerFunc) connect.StreamingHandlerFunc {
 	return func(ctx context.Context, conn connect.StreamingHandlerConn) error {
-		claims, err := validateAuthHeader(conn.RequestHeader())
+		claims, err := validateAuthHeader(ctx, conn.RequestHeader())
 		if err != nil {
 			return err
 		}
@@ -184,16 +361,25 @@ func NewAuthInterceptor() connect.Interceptor {
 }
 
 // NewOptionalAuthInterceptor は認証情報があればコンテキストにセットするが、
-// なくてもエラーにしないインターセプター.
-func NewOptionalAuthInterceptor() connect.UnaryInterceptorFunc {
+// なくてもエラーにしないインターセプター. API トークンも受け付ける.
+// refresh token は refreshTokenProcedures (handler 側で ValidateToken する RefreshToken) 以外では拒否する.
+func NewOptionalAuthInterceptor(refreshTokenProcedures ...string) connect.UnaryInterceptorFunc {
 	return func(next connect.UnaryFunc) connect.UnaryFunc {
 		return func(ctx context.Context, req connect.AnyRequest) (connect.AnyResponse, error) {
 			token := req.Header().Get("Authorization")
 			if len(token) > len("Bearer ") {
 				token = token[len("Bearer "):]
 
-				claims, err := ParseToken(token)
-				if err == nil {
+				claims, err := parseBearerToken(ctx, token)
+				if err == nil && claims.RefreshGeneration != 0 {
+					if !slices.Contains(refreshTokenProcedures, req.Spec().Procedure) {
+						return nil, invalidTokenError(errors.Wrap(errRefreshTokenAsAccessToken, 0))
+					}
+
+					return next(ctx, req)
+				}
+
+				if err == nil && verifyUserSession(ctx, claims) == nil {
 					ctx = context.WithValue(ctx, AuthClaimsKey, claims)
 				}
 			}
@@ -536,3 +536,252 @@ func TestGetAuthClaimsFromContext(t *testing.T) {
 		require.Error(t, err)
 	})
 }
+
+// fakeAPITokenVerifier は token が一致したときだけ claims を返す.
+type fakeAPITokenVerifier struct {
+	token  string
+	claims *AuthClaims
+	err    error
+}
+
+func (f *fakeAPITokenVerifier) VerifyAPIToken(_ context.Context, token string) (*AuthClaims, error) {
+	if f.err != nil {
+		return nil, f.err
+	}
+
+	if token != f.token {
+		return nil, ErrInvalidAPIToken
+	}
+
+	return f.claims, nil
+}
+
+func TestAPIToken(t *testing.T) {
+	const token = APITokenPrefix + "valid"
+
+	verifier := &fakeAPITokenVerifier{
+		token:  token,
+		claims: &AuthClaims{UserID: "bot-owner", APIToken: &APITokenScope{TokenID: "tok1", GroupID: "g1"}},
+	}
+	SetAPITokenVerifier(verifier)
+	t.Cleanup(func() { SetAPITokenVerifier(nil) })
+
+	call := func(token string) (*AuthClaims, error) {
+		req := connect.NewRequest(&struct{}{})
+		req.Header().Set("Authorization", "Bearer "+token)
+
+		var got *AuthClaims
+
+		_, err := NewAuthInterceptor().WrapUnary(func(ctx context.Context, _ connect.AnyRequest) (connect.AnyResponse, error) {
+			got, _ = GetAuthClaimsFromContext(ctx)
+
+			return connect.NewResponse(&struct{}{}), nil
+		})(context.Background(), req)
+
+		return got, err
+	}
+
+	t.Run("成功: auth interceptor が API トークンを受け付ける", func(t *testing.T) {
+		claims, err := call(token)
+		require.NoError(t, err)
+		assert.Equal(t, "bot-owner", claims.UserID)
+		assert.Equal(t, "tok1", claims.APIToken.TokenID)
+	})
+
+	t.Run("失敗: 無効な API トークンは Unauthenticated", func(t *testing.T) {
+		_, err := call(APITokenPrefix + "unknown")
+		assert.Equal(t, connect.CodeUnauthenticated, connect.CodeOf(err))
+	})
+
+	t.Run("失敗: 検証中の内部エラーは Internal", func(t *testing.T) {
+		verifier.err = errors.New("db is down")
+		defer func() { verifier.err = nil }()
+
+		_, err := call(token)
+		assert.Equal(t, connect.CodeInternal, connect.CodeOf(err))
+	})
+
+	t.Run("失敗: ValidateToken (refresh 用) は API トークンを受け付けない", func(t *testing.T) {
+		req := connect.NewRequest(&struct{}{})
+		req.Header().Set("Authorization", "Bearer "+token)
+
+		_, err := ValidateToken(context.Background(), req)
+		assert.Equal(t, connect.CodeUnauthenticated, connect.CodeOf(err))
+	})
+
+	t.Run("失敗: verifier 未登録なら拒否", func(t *testing.T) {
+		SetAPITokenVerifier(nil)
+		defer SetAPITokenVerifier(verifier)
+
+		_, err := call(token)
+		assert.Equal(t, connect.CodeUnauthenticated, connect.CodeOf(err))
+	})
+
+	t.Run("API トークンの claims は JWT に載らない", func(t *testing.T) {
+		jwtToken, err := GenerateToken(*verifier.claims, time.Minute)
+		require.NoError(t, err)
+
+		claims, err := ParseToken(jwtToken)
+		require.NoError(t, err)
+		assert.Nil(t, claims.APIToken)
+	})
+}
+
+func TestAPITokenScope_Allows(t *testing.T) {
+	var unrestricted *APITokenScope
+	assert.True(t, unrestricted.Allows("g1", "system:user.delete"))
+
+	group := &APITokenScope{GroupID: "g1"}
+	assert.True(t, group.Allows("g1", "host:write"))
+	assert.True(t, group.Allows("g1", ""))
+	assert.False(t, group.Allows("g2", "host:read"))
+	assert.False(t, group.Allows("", "host:read"))
+	assert.False(t, group.Allows("", "system:group.list"))
+
+	keys := &APITokenScope{PermissionKeys: []string{"host:read", "system:group.list"}}
+	assert.True(t, keys.Allows("g2", "host:read"))
+	assert.True(t, keys.Allows("", "system:group.list"))
+	assert.True(t, keys.Allows("g2", ""))
+	assert.False(t, keys.Allows("g2", "host:write"))
+}
+
+func TestGenerateSessionTokens(t *testing.T) {
+	claims := AuthClaims{UserID: "test-user", UserSessionID: "sess1"}
+
+	token, refreshToken, err := GenerateSessionTokens(claims, 3)
+	require.NoError(t, err)
+
+	access, err := ParseToken(token)
+	require.NoError(t, err)
+	assert.Equal(t, "sess1", access.UserSessionID)
+	assert.Zero(t, access.RefreshGeneration, "access token には世代を載せない")
+
+	refresh, err := ParseToken(refreshToken)
+	require.NoError(t, err)
+	assert.Equal(t, "sess1", refresh.UserSessionID)
+	assert.Equal(t, int64(3), refresh.RefreshGeneration)
+	assert.WithinDuration(t, time.Now().Add(RefreshTokenTTL), refresh.ExpiresAt.Time, time.Minute)
+}
+
+// fakeUserSessionVerifier は revoked に含まれるセッションを拒否する.
+type fakeUserSessionVerifier struct {
+	revoked map[string]bool
+	err     error
+}
+
+func (f *fakeUserSessionVerifier) VerifyUserSession(_ context.Context, claims *AuthClaims) error {
+	if f.err != nil {
+		return f.err
+	}
+
+	if f.revoked[claims.UserSessionID] {
+		return ErrInvalidUserSession
+	}
+
+	return nil
+}
+
+func TestUserSessionVerifier(t *testing.T) {
+	verifier := &fakeUserSessionVerifier{revoked: map[string]bool{"revoked": true}}
+	SetUserSessionVerifier(verifier)
+	t.Cleanup(func() { SetUserSessionVerifier(nil) })
+
+	tokenFor := func(sessionID string) string {
+		token, _, err := GenerateSessionTokens(AuthClaims{UserID: "test-user", UserSessionID: sessionID}, 1)
+		require.NoError(t, err)
+
+		return token
+	}
+
+	call := func(token string) (*AuthClaims, error) {
+		req := connect.NewRequest(&struct{}{})
+		req.Header().Set("Authorization", "Bearer "+token)
+
+		var got *AuthClaims
+
+		_, err := NewAuthInterceptor().WrapUnary(func(ctx context.Context, _ connect.AnyRequest) (connect.AnyResponse, error) {
+			got, _ = GetAuthClaimsFromContext(ctx)
+
+			return connect.NewResponse(&struct{}{}), nil
+		})(context.Background(), req)
+
+		return got, err
+	}
+
+	t.Run("成功: 有効なセッションのトークンは通す", func(t *testing.T) {
+		claims, err := call(tokenFor("active"))
+		require.NoError(t, err)
+		assert.Equal(t, "active", claims.UserSessionID)
+	})
+
+	t.Run("成功: セッションを持たないトークンは検証しない", func(t *testing.T) {
+		token, _, err := GenerateTokensWithDefaultTTL(AuthClaims{UserID: "test-user"})
+		require.NoError(t, err)
+
+		_, err = call(token)
+		require.NoError(t, err)
+	})
+
+	t.Run("失敗: 失効したセッションのトークンは Unauthenticated", func(t *testing.T) {
+		_, err := call(tokenFor("revoked"))
+		assert.Equal(t, connect.CodeUnauthenticated, connect.CodeOf(err))
+	})
+
+	t.Run("失敗: 検証中の内部エラーは Internal", func(t *testing.T) {
+		verifier.err = errors.New("db is down")
+		defer func() { verifier.err = nil }()
+
+		_, err := call(tokenFor("active"))
+		assert.Equal(t, connect.CodeInternal, connect.CodeOf(err))
+	})
+
+	t.Run("optional interceptor は失効したセッションの claims をセットしない", func(t *testing.T) {
+		req := connect.NewRequest(&struct{}{})
+		req.Header().Set("Authorization", "Bearer "+tokenFor("revoked"))
+
+		var authenticated bool
+
+		_, err := NewOptionalAuthInterceptor()(func(ctx context.Context, _ connect.AnyRequest) (connect.AnyResponse, error) {
+			_, claimsErr := GetAuthClaimsFromContext(ctx)
+			authenticated = claimsErr == nil
+
+			return connect.NewResponse(&struct{}{}), nil
+		})(context.Background(), req)
+		require.NoError(t, err)
+		assert.False(t, authenticated)
+	})
+}
+
+func TestRefreshTokenAsAccessToken(t *testing.T) {
+	_, refreshToken, err := GenerateSessionTokens(AuthClaims{UserID: "test-user", UserSessionID: "sess1"}, 1)
+	require.NoError(t, err)
+
+	newRequest := func() *connect.Request[struct{}] {
+		req := connect.NewRequest(&struct{}{})
+		req.Header().Set("Authorization", "Bearer "+refreshToken)
+
+		return req
+	}
+
+	next := func(context.Context, connect.AnyRequest) (connect.AnyResponse, error) {
+		t.Fatal("should not reach here")
+
+		return nil, nil
+	}
+
+	t.Run("失敗: auth interceptor は refresh token を拒否する", func(t *testing.T) {
+		_, err := NewAuthInterceptor().WrapUnary(next)(context.Background(), newRequest())
+		assert.Equal(t, connect.CodeUnauthenticated, connect.CodeOf(err))
+	})
+
+	t.Run("失敗: optional interceptor も RefreshToken 以外では refresh token を拒否する", func(t *testing.T) {
+		_, err := NewOptionalAuthInterceptor("/hdlctrl.v1.UserService/RefreshToken")(next)(context.Background(), newRequest())
+		assert.Equal(t, connect.CodeUnauthenticated, connect.CodeOf(err))
+	})
+
+	t.Run("成功: ValidateToken (RefreshToken の handler) は refresh token を受け付ける", func(t *testing.T) {
+		claims, err := ValidateToken(context.Background(), newRequest())
+		require.NoError(t, err)
+		assert.Equal(t, int64(1), claims.RefreshGeneration)
+	})
+}
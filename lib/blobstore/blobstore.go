@@ -1,5 +1,6 @@
 // Package blobstore provides a small abstraction over an S3-compatible object
-// store (RustFS / MinIO) for storing user-facing download blobs.
+// store (RustFS / MinIO) for storing user-facing download blobs and archived
+// host logs.
 package blobstore
 
 import (
@@ -21,7 +22,7 @@ var ErrNotFound = errors.New("blob not found")
 type Client interface {
 	// EnsureBucket creates the configured bucket if it does not already exist
 	// and applies a lifecycle rule that expires objects after the configured
-	// number of days. Idempotent.
+	// number of days, if any. Idempotent.
 	EnsureBucket(ctx context.Context) error
 	// Upload stores the given reader's contents under key. filename is
 	// preserved as user metadata for later retrieval at download time.
@@ -29,6 +30,9 @@ type Client interface {
 	// GetObject opens the object identified by key. The caller must Close the
 	// returned ReadCloser. Returns ErrNotFound if the object does not exist.
 	GetObject(ctx context.Context, key string) (rc io.ReadCloser, length int64, contentType, filename string, err error)
+	// RemoveObject deletes the object identified by key. Removing an object
+	// that does not exist is not an error.
+	RemoveObject(ctx context.Context, key string) error
 }
 
 type MinioClient struct {
@@ -42,8 +46,16 @@ const (
 	lifecycleRuleID = "expire-old-blobs"
 )
 
-// NewMinioClient constructs a MinioClient from the given RustFS config.
+// NewMinioClient constructs a MinioClient for the world downloads bucket from
+// the given RustFS config.
 func NewMinioClient(cfg *config.RustFSConfig) (*MinioClient, error) {
+	return NewMinioClientForBucket(cfg, cfg.WorldDownloadsBucket, cfg.BlobTTLDays)
+}
+
+// NewMinioClientForBucket constructs a MinioClient for bucket on the RustFS
+// endpoint of cfg. Objects expire after ttlDays; a non-positive value keeps
+// them until they are removed.
+func NewMinioClientForBucket(cfg *config.RustFSConfig, bucket string, ttlDays int) (*MinioClient, error) {
 	client, err := minio.New(cfg.Endpoint, &minio.Options{
 		Creds:  credentials.NewStaticV4(cfg.AccessKey, cfg.SecretKey, ""),
 		Secure: cfg.UseSSL,
@@ -54,8 +66,8 @@ func NewMinioClient(cfg *config.RustFSConfig) (*MinioClient, error) {
 
 	return &MinioClient{
 		client: client,
-		bucket: cfg.WorldDownloadsBucket,
-		ttl:    cfg.BlobTTLDays,
+		bucket: bucket,
+		ttl:    ttlDays,
 	}, nil
 }
 
@@ -71,6 +83,10 @@ func (c *MinioClient) EnsureBucket(ctx context.Context) error {
 		}
 	}
 
+	if c.ttl <= 0 {
+		return nil
+	}
+
 	lc := lifecycle.NewConfiguration()
 	lc.Rules = []lifecycle.Rule{
 		{
@@ -121,6 +137,10 @@ func (c *MinioClient) GetObject(ctx context.Context, key string) (io.ReadCloser,
 	return obj, stat.Size, stat.ContentType, decodeFilenameMeta(stat.UserMetadata), nil
 }
 
+func (c *MinioClient) RemoveObject(ctx context.Context, key string) error {
+	return c.client.RemoveObject(ctx, c.bucket, key, minio.RemoveObjectOptions{})
+}
+
 // decodeFilenameMeta retrieves the URL-encoded filename metadata in a
 // case-insensitive manner — different S3-compatible servers normalize header
 // case differently.
@@ -73,6 +73,20 @@ func (mr *MockClientMockRecorder) GetObject(ctx, key any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetObject", reflect.TypeOf((*MockClient)(nil).GetObject), ctx, key)
 }
 
+// RemoveObject mocks base method.
+func (m *MockClient) RemoveObject(ctx context.Context, key string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveObject", ctx, key)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RemoveObject indicates an expected call of RemoveObject.
+func (mr *MockClientMockRecorder) RemoveObject(ctx, key any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveObject", reflect.TypeOf((*MockClient)(nil).RemoveObject), ctx, key)
+}
+
 // Upload mocks base method.
 func (m *MockClient) Upload(ctx context.Context, key string, reader io.Reader, size int64, filename, contentType string) error {
 	m.ctrl.T.Helper()
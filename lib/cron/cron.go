@@ -0,0 +1,262 @@
+// Package cron parses standard 5-field cron expressions and computes their
+// next occurrence in a given time zone.
+//
+// Fields are "minute hour day-of-month month day-of-week". Each field accepts
+// "*", single values, ranges ("1-5"), steps ("*/15", "10-40/10") and
+// comma-separated lists of those. Months and weekdays also accept three-letter
+// English names (JAN-DEC, SUN-SAT), and 7 is an alias for Sunday. The usual
+// macros (@yearly, @monthly, @weekly, @daily, @hourly) are supported.
+//
+// As in Vixie cron, when both day-of-month and day-of-week are restricted the
+// day matches if either of them does.
+package cron
+
+import (
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/go-errors/errors"
+)
+
+// searchYears bounds Next for expressions that can never match (e.g. Feb 30).
+const searchYears = 5
+
+var macros = map[string]string{
+	"@yearly":   "0 0 1 1 *",
+	"@annually": "0 0 1 1 *",
+	"@monthly":  "0 0 1 * *",
+	"@weekly":   "0 0 * * 0",
+	"@daily":    "0 0 * * *",
+	"@midnight": "0 0 * * *",
+	"@hourly":   "0 * * * *",
+}
+
+var monthNames = map[string]int{
+	"jan": 1, "feb": 2, "mar": 3, "apr": 4, "may": 5, "jun": 6,
+	"jul": 7, "aug": 8, "sep": 9, "oct": 10, "nov": 11, "dec": 12,
+}
+
+var weekdayNames = map[string]int{
+	"sun": 0, "mon": 1, "tue": 2, "wed": 3, "thu": 4, "fri": 5, "sat": 6,
+}
+
+// bits is a set of allowed values for one field.
+type bits uint64
+
+func (b bits) has(v int) bool {
+	return b&(1<<uint(v)) != 0
+}
+
+type fieldSpec struct {
+	name     string
+	min, max int
+	names    map[string]int
+}
+
+var fieldSpecs = [5]fieldSpec{
+	{name: "minute", min: 0, max: 59},
+	{name: "hour", min: 0, max: 23},
+	{name: "day of month", min: 1, max: 31},
+	{name: "month", min: 1, max: 12, names: monthNames},
+	// 7 is accepted here and folded into Sunday after parsing.
+	{name: "day of week", min: 0, max: 7, names: weekdayNames},
+}
+
+// Schedule is a parsed cron expression bound to a time zone.
+type Schedule struct {
+	expr   string
+	loc    *time.Location
+	minute bits
+	hour   bits
+	dom    bits
+	month  bits
+	dow    bits
+	// domAny / dowAny record whether the field started with "*", which
+	// decides how the two day fields combine.
+	domAny bool
+	dowAny bool
+}
+
+// Parse parses expr and evaluates it in loc. A nil loc means UTC.
+func Parse(expr string, loc *time.Location) (*Schedule, error) {
+	if loc == nil {
+		loc = time.UTC
+	}
+
+	normalized := strings.TrimSpace(expr)
+	if m, ok := macros[strings.ToLower(normalized)]; ok {
+		normalized = m
+	}
+
+	fields := strings.Fields(normalized)
+	if len(fields) != len(fieldSpecs) {
+		return nil, errors.Errorf("cron: expected 5 fields, got %d in %q", len(fields), expr)
+	}
+
+	var parsed [5]bits
+
+	for i, f := range fields {
+		b, err := parseField(f, fieldSpecs[i])
+		if err != nil {
+			return nil, errors.Errorf("cron: %s: %w", fieldSpecs[i].name, err)
+		}
+
+		parsed[i] = b
+	}
+
+	dow := parsed[4]
+	if dow.has(7) {
+		dow = dow&^(1<<7) | 1
+	}
+
+	return &Schedule{
+		expr:   strings.TrimSpace(expr),
+		loc:    loc,
+		minute: parsed[0],
+		hour:   parsed[1],
+		dom:    parsed[2],
+		month:  parsed[3],
+		dow:    dow,
+		domAny: strings.HasPrefix(fields[2], "*"),
+		dowAny: strings.HasPrefix(fields[4], "*"),
+	}, nil
+}
+
+func parseField(field string, spec fieldSpec) (bits, error) {
+	var b bits
+
+	for item := range strings.SplitSeq(field, ",") {
+		rangePart, stepPart, hasStep := strings.Cut(item, "/")
+
+		step := 1
+
+		if hasStep {
+			n, err := strconv.Atoi(stepPart)
+			if err != nil || n <= 0 {
+				return 0, errors.Errorf("invalid step %q", stepPart)
+			}
+
+			step = n
+		}
+
+		var lo, hi int
+
+		switch {
+		case rangePart == "*":
+			lo, hi = spec.min, spec.max
+		case strings.Contains(rangePart, "-"):
+			loPart, hiPart, _ := strings.Cut(rangePart, "-")
+
+			var err error
+			if lo, err = parseValue(loPart, spec); err != nil {
+				return 0, err
+			}
+
+			if hi, err = parseValue(hiPart, spec); err != nil {
+				return 0, err
+			}
+
+			if lo > hi {
+				return 0, errors.Errorf("invalid range %q", rangePart)
+			}
+		default:
+			v, err := parseValue(rangePart, spec)
+			if err != nil {
+				return 0, err
+			}
+
+			// "5/15" means "from 5 to the end, every 15".
+			lo, hi = v, v
+			if hasStep {
+				hi = spec.max
+			}
+		}
+
+		for v := lo; v <= hi; v += step {
+			b |= 1 << uint(v)
+		}
+	}
+
+	return b, nil
+}
+
+func parseValue(s string, spec fieldSpec) (int, error) {
+	if v, ok := spec.names[strings.ToLower(s)]; ok {
+		return v, nil
+	}
+
+	v, err := strconv.Atoi(s)
+	if err != nil {
+		return 0, errors.Errorf("invalid value %q", s)
+	}
+
+	if v < spec.min || v > spec.max {
+		return 0, errors.Errorf("value %d out of range %d-%d", v, spec.min, spec.max)
+	}
+
+	return v, nil
+}
+
+// String returns the expression as given to Parse.
+func (s *Schedule) String() string {
+	return s.expr
+}
+
+// Location returns the time zone the schedule is evaluated in.
+func (s *Schedule) Location() *time.Location {
+	return s.loc
+}
+
+// Next returns the first occurrence strictly after the given time, or the
+// zero time if there is none within the next few years.
+//
+// The search walks the wall clock of the schedule's time zone. A wall time
+// skipped by a DST transition fires at the shifted instant, and a wall time
+// repeated by a transition fires only once.
+func (s *Schedule) Next(after time.Time) time.Time {
+	local := after.In(s.loc)
+	// n is a wall-clock time; UTC is only used as a calendar without transitions.
+	n := time.Date(local.Year(), local.Month(), local.Day(), local.Hour(), local.Minute(), 0, 0, time.UTC).Add(time.Minute)
+	limit := n.AddDate(searchYears, 0, 0)
+
+	for n.Before(limit) {
+		switch {
+		case !s.month.has(int(n.Month())):
+			n = time.Date(n.Year(), n.Month()+1, 1, 0, 0, 0, 0, time.UTC)
+		case !s.dayMatches(n):
+			n = time.Date(n.Year(), n.Month(), n.Day()+1, 0, 0, 0, 0, time.UTC)
+		case !s.hour.has(n.Hour()):
+			n = n.Truncate(time.Hour).Add(time.Hour)
+		case !s.minute.has(n.Minute()):
+			n = n.Add(time.Minute)
+		default:
+			t := time.Date(n.Year(), n.Month(), n.Day(), n.Hour(), n.Minute(), 0, 0, s.loc)
+			if t.Hour() != n.Hour() || t.Minute() != n.Minute() {
+				// n falls into a gap. Use the instant the clock would have shown n
+				// with the offset in effect before the transition.
+				_, offset := t.Add(-24 * time.Hour).Zone()
+				t = n.Add(-time.Duration(offset) * time.Second).In(s.loc)
+			}
+
+			if t.After(after) {
+				return t
+			}
+
+			n = n.Add(time.Minute)
+		}
+	}
+
+	return time.Time{}
+}
+
+func (s *Schedule) dayMatches(n time.Time) bool {
+	domOK := s.dom.has(n.Day())
+	dowOK := s.dow.has(int(n.Weekday()))
+
+	if !s.domAny && !s.dowAny {
+		return domOK || dowOK
+	}
+
+	return domOK && dowOK
+}
@@ -0,0 +1,158 @@
+package cron
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParse(t *testing.T) {
+	t.Parallel()
+
+	valid := []string{
+		"* * * * *",
+		"*/15 9-17 * * mon-fri",
+		"0 20 * * 5",
+		"30 4 1,15 * *",
+		"0 0 * JAN,jul 7",
+		"5/10 * * * *",
+		"@weekly",
+		"@Daily",
+	}
+	for _, expr := range valid {
+		_, err := Parse(expr, nil)
+		assert.NoError(t, err, expr)
+	}
+
+	invalid := []string{
+		"",
+		"* * * *",
+		"* * * * * *",
+		"60 * * * *",
+		"* 24 * * *",
+		"* * 0 * *",
+		"* * * 13 *",
+		"* * * * 8",
+		"*/0 * * * *",
+		"10-5 * * * *",
+		"* * * foo *",
+		"@every 5m",
+	}
+	for _, expr := range invalid {
+		_, err := Parse(expr, nil)
+		assert.Error(t, err, expr)
+	}
+}
+
+func TestScheduleNext(t *testing.T) {
+	t.Parallel()
+
+	tokyo, err := time.LoadLocation("Asia/Tokyo")
+	require.NoError(t, err)
+
+	newYork, err := time.LoadLocation("America/New_York")
+	require.NoError(t, err)
+
+	tests := []struct {
+		name  string
+		expr  string
+		loc   *time.Location
+		after time.Time
+		want  []time.Time
+	}{
+		{
+			name:  "15 分ごと",
+			expr:  "*/15 * * * *",
+			loc:   time.UTC,
+			after: time.Date(2026, 7, 1, 10, 7, 30, 0, time.UTC),
+			want: []time.Time{
+				time.Date(2026, 7, 1, 10, 15, 0, 0, time.UTC),
+				time.Date(2026, 7, 1, 10, 30, 0, 0, time.UTC),
+			},
+		},
+		{
+			name:  "ちょうどの時刻は含まない",
+			expr:  "0 12 * * *",
+			loc:   time.UTC,
+			after: time.Date(2026, 7, 1, 12, 0, 0, 0, time.UTC),
+			want:  []time.Time{time.Date(2026, 7, 2, 12, 0, 0, 0, time.UTC)},
+		},
+		{
+			name:  "タイムゾーンの毎週金曜 20 時",
+			expr:  "0 20 * * fri",
+			loc:   tokyo,
+			after: time.Date(2026, 7, 1, 0, 0, 0, 0, time.UTC),
+			want: []time.Time{
+				time.Date(2026, 7, 3, 20, 0, 0, 0, tokyo),
+				time.Date(2026, 7, 10, 20, 0, 0, 0, tokyo),
+			},
+		},
+		{
+			name:  "日と曜日の両方を指定するとどちらかに一致すれば良い",
+			expr:  "0 0 13 * 5",
+			loc:   time.UTC,
+			after: time.Date(2026, 7, 1, 0, 0, 0, 0, time.UTC),
+			want: []time.Time{
+				time.Date(2026, 7, 3, 0, 0, 0, 0, time.UTC),
+				time.Date(2026, 7, 10, 0, 0, 0, 0, time.UTC),
+				time.Date(2026, 7, 13, 0, 0, 0, 0, time.UTC),
+				time.Date(2026, 7, 17, 0, 0, 0, 0, time.UTC),
+			},
+		},
+		{
+			name:  "月末日が無い月は飛ばす",
+			expr:  "0 0 31 * *",
+			loc:   time.UTC,
+			after: time.Date(2026, 4, 1, 0, 0, 0, 0, time.UTC),
+			want: []time.Time{
+				time.Date(2026, 5, 31, 0, 0, 0, 0, time.UTC),
+				time.Date(2026, 7, 31, 0, 0, 0, 0, time.UTC),
+			},
+		},
+		{
+			name:  "夏時間で存在しない時刻はずれた時刻に発火する",
+			expr:  "30 2 * * *",
+			loc:   newYork,
+			after: time.Date(2026, 3, 7, 12, 0, 0, 0, newYork),
+			want: []time.Time{
+				time.Date(2026, 3, 8, 3, 30, 0, 0, newYork),
+				time.Date(2026, 3, 9, 2, 30, 0, 0, newYork),
+			},
+		},
+		{
+			name:  "夏時間の終了で繰り返す時刻は 1 回だけ",
+			expr:  "30 1 * * *",
+			loc:   newYork,
+			after: time.Date(2026, 10, 31, 12, 0, 0, 0, newYork),
+			want: []time.Time{
+				time.Date(2026, 11, 1, 5, 30, 0, 0, time.UTC),
+				time.Date(2026, 11, 2, 1, 30, 0, 0, newYork),
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			s, err := Parse(tt.expr, tt.loc)
+			require.NoError(t, err)
+
+			at := tt.after
+			for _, want := range tt.want {
+				at = s.Next(at)
+				assert.True(t, want.Equal(at), "want %s, got %s", want, at)
+			}
+		})
+	}
+
+	t.Run("一致しない式は zero", func(t *testing.T) {
+		t.Parallel()
+
+		s, err := Parse("0 0 30 2 *", nil)
+		require.NoError(t, err)
+		assert.True(t, s.Next(time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)).IsZero())
+	})
+}
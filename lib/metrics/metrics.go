@@ -0,0 +1,120 @@
+// Package metrics holds the Prometheus collectors exported on /metrics.
+//
+// Counters and histograms that are updated where things happen (workers,
+// the notification bus) live here as package variables so instrumenting a
+// code path does not require threading a registry through constructors.
+// Gauges describing current state (hosts, sessions, queues) are gathered at
+// scrape time by collectors registered on Registry by the app package.
+package metrics
+
+import (
+	"crypto/subtle"
+	"net/http"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/collectors"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+)
+
+// Namespace prefixes every metric exported by the controller.
+const Namespace = "brhc"
+
+// Registry is the registry served by Handler. The default Prometheus
+// registry is not used so that libraries cannot add metrics behind our back.
+var Registry = prometheus.NewRegistry()
+
+var factory = promauto.With(Registry)
+
+func init() {
+	Registry.MustRegister(
+		collectors.NewGoCollector(),
+		collectors.NewProcessCollector(collectors.ProcessCollectorOpts{}),
+	)
+}
+
+var (
+	NotificationDroppedEvents = factory.NewCounter(prometheus.CounterOpts{
+		Namespace: Namespace,
+		Subsystem: "notification",
+		Name:      "dropped_events_total",
+		Help:      "Events dropped by the notification bus because a subscriber was too slow.",
+	})
+
+	// The host event stream counters are not labelled by host: hosts come
+	// and go, and the watcher already logs the host of every reconnect and
+	// reset.
+	HostEventStreamReconnects = factory.NewCounter(prometheus.CounterOpts{
+		Namespace: Namespace,
+		Subsystem: "host_event_stream",
+		Name:      "reconnects_total",
+		Help:      "Host event streams reopened after the previous stream of the same host ended.",
+	})
+
+	HostEventStreamResets = factory.NewCounter(prometheus.CounterOpts{
+		Namespace: Namespace,
+		Subsystem: "host_event_stream",
+		Name:      "resets_total",
+		Help:      "Host event streams whose resume point was lost (OutOfRange), dropping buffered events.",
+	})
+
+	AsyncJobQueueLatency = factory.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: Namespace,
+		Subsystem: "async_job",
+		Name:      "queue_latency_seconds",
+		Help:      "Time from enqueueing an async job until an executor claimed it.",
+		Buckets:   prometheus.ExponentialBuckets(0.5, 2, 12), //nolint:mnd // 0.5s .. ~17m
+	}, []string{"type"})
+
+	AsyncJobDuration = factory.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: Namespace,
+		Subsystem: "async_job",
+		Name:      "duration_seconds",
+		Help:      "Execution time of async jobs.",
+		Buckets:   prometheus.ExponentialBuckets(0.5, 2, 12), //nolint:mnd // 0.5s .. ~17m
+	}, []string{"type", "result"})
+
+	ScheduledOperationLag = factory.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: Namespace,
+		Subsystem: "scheduled_operation",
+		Name:      "lag_seconds",
+		Help:      "Time from a scheduled operation becoming due until an executor claimed it.",
+		Buckets:   prometheus.ExponentialBuckets(0.5, 2, 12), //nolint:mnd // 0.5s .. ~17m
+	}, []string{"type"})
+
+	ScheduledOperationDuration = factory.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: Namespace,
+		Subsystem: "scheduled_operation",
+		Name:      "duration_seconds",
+		Help:      "Execution time of scheduled operations that fired.",
+		Buckets:   prometheus.ExponentialBuckets(0.1, 2, 12), //nolint:mnd // 0.1s .. ~3m
+	}, []string{"type", "result"})
+)
+
+// Result label values for the duration histograms.
+const (
+	ResultSucceeded = "succeeded"
+	ResultFailed    = "failed"
+)
+
+// Handler serves Registry. If token is non-empty, requests must carry it as
+// a bearer token.
+func Handler(token string) http.Handler {
+	h := promhttp.HandlerFor(Registry, promhttp.HandlerOpts{Registry: Registry})
+	if token == "" {
+		return h
+	}
+
+	expected := []byte("Bearer " + token)
+
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if subtle.ConstantTimeCompare([]byte(r.Header.Get("Authorization")), expected) != 1 {
+			w.Header().Set("WWW-Authenticate", `Bearer realm="metrics"`)
+			http.Error(w, "unauthorized", http.StatusUnauthorized)
+
+			return
+		}
+
+		h.ServeHTTP(w, r)
+	})
+}
@@ -0,0 +1,47 @@
+package metrics
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestHandler(t *testing.T) {
+	serve := func(h http.Handler, authorization string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodGet, "/metrics", nil)
+		if authorization != "" {
+			req.Header.Set("Authorization", authorization)
+		}
+
+		rec := httptest.NewRecorder()
+		h.ServeHTTP(rec, req)
+
+		return rec
+	}
+
+	t.Run("成功: token 未設定なら認証なしで公開", func(t *testing.T) {
+		NotificationDroppedEvents.Inc()
+
+		rec := serve(Handler(""), "")
+		assert.Equal(t, http.StatusOK, rec.Code)
+		assert.Contains(t, rec.Body.String(), "brhc_notification_dropped_events_total")
+	})
+
+	t.Run("成功: 正しい bearer token", func(t *testing.T) {
+		rec := serve(Handler("secret"), "Bearer secret")
+		assert.Equal(t, http.StatusOK, rec.Code)
+	})
+
+	t.Run("失敗: token なし", func(t *testing.T) {
+		rec := serve(Handler("secret"), "")
+		assert.Equal(t, http.StatusUnauthorized, rec.Code)
+		assert.NotEmpty(t, rec.Header().Get("WWW-Authenticate"))
+	})
+
+	t.Run("失敗: token が違う", func(t *testing.T) {
+		rec := serve(Handler("secret"), "Bearer wrong")
+		assert.Equal(t, http.StatusUnauthorized, rec.Code)
+	})
+}
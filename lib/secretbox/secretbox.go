@@ -0,0 +1,219 @@
+// Package secretbox envelope-encrypts small secrets with keys taken from
+// the configuration.
+//
+// Every Seal generates a random data key, encrypts the plaintexts with it
+// (AES-256-GCM) and stores the data key wrapped by a key-encryption key
+// identified by its key ID. Rotating the key-encryption key only needs the
+// data keys to be re-wrapped (Rewrap); the ciphertexts stay as they are.
+// Keep the old keys in the keyring until every envelope has been re-wrapped.
+package secretbox
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"errors"
+	"fmt"
+)
+
+// KeySize is the required length of a key-encryption key.
+const KeySize = 32
+
+var (
+	ErrUnknownKey = errors.New("secretbox: unknown key id")
+	ErrDecrypt    = errors.New("secretbox: message authentication failed")
+)
+
+// Envelope is what Seal produces and Open consumes. All fields have to be
+// stored together.
+type Envelope struct {
+	// KeyID names the key-encryption key that wrapped DataKey.
+	KeyID string
+	// DataKey is the wrapped data key (nonce || ciphertext).
+	DataKey []byte
+	// Ciphertexts are the sealed plaintexts (nonce || ciphertext) in the
+	// order they were passed to Seal.
+	Ciphertexts [][]byte
+}
+
+// Keyring holds the key-encryption keys. New envelopes are always wrapped
+// with the primary key; the others are only used to open old envelopes.
+type Keyring struct {
+	primaryID string
+	keys      map[string]cipher.AEAD
+}
+
+// NewKeyring builds a keyring from key ID -> 32 byte key. primaryID must be
+// one of the keys.
+func NewKeyring(keys map[string][]byte, primaryID string) (*Keyring, error) {
+	if len(keys) == 0 {
+		return nil, errors.New("secretbox: no keys")
+	}
+
+	k := &Keyring{primaryID: primaryID, keys: make(map[string]cipher.AEAD, len(keys))}
+
+	for id, key := range keys {
+		if id == "" {
+			return nil, errors.New("secretbox: empty key id")
+		}
+
+		if len(key) != KeySize {
+			return nil, fmt.Errorf("secretbox: key %q must be %d bytes, got %d", id, KeySize, len(key))
+		}
+
+		aead, err := newAEAD(key)
+		if err != nil {
+			return nil, err
+		}
+
+		k.keys[id] = aead
+	}
+
+	if _, ok := k.keys[primaryID]; !ok {
+		return nil, fmt.Errorf("secretbox: primary key %q is not in the keyring", primaryID)
+	}
+
+	return k, nil
+}
+
+// PrimaryKeyID is the key new envelopes are wrapped with.
+func (k *Keyring) PrimaryKeyID() string {
+	return k.primaryID
+}
+
+// Seal encrypts plaintexts under a fresh data key. aad is authenticated but
+// not stored; Open has to be given the same value. Binding it to the owner
+// of the secret keeps a ciphertext from being copied onto another row.
+func (k *Keyring) Seal(aad []byte, plaintexts ...[]byte) (*Envelope, error) {
+	dataKey := make([]byte, KeySize)
+	if _, err := rand.Read(dataKey); err != nil {
+		return nil, fmt.Errorf("secretbox: %w", err)
+	}
+
+	dek, err := newAEAD(dataKey)
+	if err != nil {
+		return nil, err
+	}
+
+	env := &Envelope{KeyID: k.primaryID, Ciphertexts: make([][]byte, 0, len(plaintexts))}
+
+	for _, p := range plaintexts {
+		c, err := seal(dek, p, aad)
+		if err != nil {
+			return nil, err
+		}
+
+		env.Ciphertexts = append(env.Ciphertexts, c)
+	}
+
+	env.DataKey, err = seal(k.keys[k.primaryID], dataKey, wrapAAD(k.primaryID, aad))
+	if err != nil {
+		return nil, err
+	}
+
+	return env, nil
+}
+
+// Open decrypts every ciphertext of env.
+func (k *Keyring) Open(env *Envelope, aad []byte) ([][]byte, error) {
+	dataKey, err := k.unwrap(env, aad)
+	if err != nil {
+		return nil, err
+	}
+
+	dek, err := newAEAD(dataKey)
+	if err != nil {
+		return nil, err
+	}
+
+	out := make([][]byte, 0, len(env.Ciphertexts))
+
+	for _, c := range env.Ciphertexts {
+		p, err := open(dek, c, aad)
+		if err != nil {
+			return nil, err
+		}
+
+		out = append(out, p)
+	}
+
+	return out, nil
+}
+
+// Rewrap re-wraps the data key of env with the primary key. The ciphertexts
+// are reused as they are. An envelope already under the primary key is
+// returned unchanged.
+func (k *Keyring) Rewrap(env *Envelope, aad []byte) (*Envelope, error) {
+	if env.KeyID == k.primaryID {
+		return env, nil
+	}
+
+	dataKey, err := k.unwrap(env, aad)
+	if err != nil {
+		return nil, err
+	}
+
+	wrapped, err := seal(k.keys[k.primaryID], dataKey, wrapAAD(k.primaryID, aad))
+	if err != nil {
+		return nil, err
+	}
+
+	return &Envelope{KeyID: k.primaryID, DataKey: wrapped, Ciphertexts: env.Ciphertexts}, nil
+}
+
+// unwrap decrypts the data key of env with the key named by env.KeyID.
+func (k *Keyring) unwrap(env *Envelope, aad []byte) ([]byte, error) {
+	kek, ok := k.keys[env.KeyID]
+	if !ok {
+		return nil, fmt.Errorf("%w: %q", ErrUnknownKey, env.KeyID)
+	}
+
+	return open(kek, env.DataKey, wrapAAD(env.KeyID, aad))
+}
+
+// wrapAAD also binds the wrapped data key to the key ID it claims.
+func wrapAAD(keyID string, aad []byte) []byte {
+	out := make([]byte, 0, len(keyID)+1+len(aad))
+	out = append(out, keyID...)
+	out = append(out, 0)
+
+	return append(out, aad...)
+}
+
+func newAEAD(key []byte) (cipher.AEAD, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, fmt.Errorf("secretbox: %w", err)
+	}
+
+	aead, err := cipher.NewGCM(block)
+	if err != nil {
+		return nil, fmt.Errorf("secretbox: %w", err)
+	}
+
+	return aead, nil
+}
+
+func seal(aead cipher.AEAD, plaintext, aad []byte) ([]byte, error) {
+	nonce := make([]byte, aead.NonceSize(), aead.NonceSize()+len(plaintext)+aead.Overhead())
+	if _, err := rand.Read(nonce); err != nil {
+		return nil, fmt.Errorf("secretbox: %w", err)
+	}
+
+	return aead.Seal(nonce, nonce, plaintext, aad), nil
+}
+
+func open(aead cipher.AEAD, sealed, aad []byte) ([]byte, error) {
+	if len(sealed) < aead.NonceSize() {
+		return nil, ErrDecrypt
+	}
+
+	nonce, ciphertext := sealed[:aead.NonceSize()], sealed[aead.NonceSize():]
+
+	p, err := aead.Open(nil, nonce, ciphertext, aad)
+	if err != nil {
+		return nil, ErrDecrypt
+	}
+
+	return p, nil
+}
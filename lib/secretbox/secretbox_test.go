@@ -0,0 +1,113 @@
+package secretbox
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func testKey(b byte) []byte {
+	return bytes.Repeat([]byte{b}, KeySize)
+}
+
+func TestNewKeyring(t *testing.T) {
+	t.Run("primary が鍵に含まれなければエラー", func(t *testing.T) {
+		_, err := NewKeyring(map[string][]byte{"k1": testKey(1)}, "k2")
+		require.Error(t, err)
+	})
+
+	t.Run("鍵長が 32 byte でなければエラー", func(t *testing.T) {
+		_, err := NewKeyring(map[string][]byte{"k1": []byte("short")}, "k1")
+		require.Error(t, err)
+	})
+
+	t.Run("鍵が無ければエラー", func(t *testing.T) {
+		_, err := NewKeyring(nil, "")
+		require.Error(t, err)
+	})
+}
+
+func TestKeyring_SealOpen(t *testing.T) {
+	k, err := NewKeyring(map[string][]byte{"k1": testKey(1)}, "k1")
+	require.NoError(t, err)
+
+	aad := []byte("U-bot")
+
+	env, err := k.Seal(aad, []byte("bot@example.com"), []byte("hunter2"))
+	require.NoError(t, err)
+	assert.Equal(t, "k1", env.KeyID)
+	require.Len(t, env.Ciphertexts, 2)
+	assert.NotContains(t, string(env.Ciphertexts[1]), "hunter2")
+
+	t.Run("同じ aad で復号できる", func(t *testing.T) {
+		got, err := k.Open(env, aad)
+		require.NoError(t, err)
+		assert.Equal(t, [][]byte{[]byte("bot@example.com"), []byte("hunter2")}, got)
+	})
+
+	t.Run("aad が違えば復号できない", func(t *testing.T) {
+		_, err := k.Open(env, []byte("U-other"))
+		require.ErrorIs(t, err, ErrDecrypt)
+	})
+
+	t.Run("改ざんされた ciphertext は復号できない", func(t *testing.T) {
+		tampered := *env
+		tampered.Ciphertexts = [][]byte{env.Ciphertexts[0], append([]byte{}, env.Ciphertexts[1]...)}
+		tampered.Ciphertexts[1][len(tampered.Ciphertexts[1])-1] ^= 0xff
+
+		_, err := k.Open(&tampered, aad)
+		require.ErrorIs(t, err, ErrDecrypt)
+	})
+
+	t.Run("知らない鍵 ID は ErrUnknownKey", func(t *testing.T) {
+		other := *env
+		other.KeyID = "k9"
+
+		_, err := k.Open(&other, aad)
+		require.ErrorIs(t, err, ErrUnknownKey)
+	})
+}
+
+func TestKeyring_Rewrap(t *testing.T) {
+	aad := []byte("U-bot")
+
+	old, err := NewKeyring(map[string][]byte{"k1": testKey(1)}, "k1")
+	require.NoError(t, err)
+
+	env, err := old.Seal(aad, []byte("hunter2"))
+	require.NoError(t, err)
+
+	rotated, err := NewKeyring(map[string][]byte{"k1": testKey(1), "k2": testKey(2)}, "k2")
+	require.NoError(t, err)
+
+	t.Run("古い鍵の envelope も rotation 後の keyring で開ける", func(t *testing.T) {
+		got, err := rotated.Open(env, aad)
+		require.NoError(t, err)
+		assert.Equal(t, []byte("hunter2"), got[0])
+	})
+
+	t.Run("primary に掛け直すと新しい鍵だけで開ける", func(t *testing.T) {
+		rewrapped, err := rotated.Rewrap(env, aad)
+		require.NoError(t, err)
+		assert.Equal(t, "k2", rewrapped.KeyID)
+		assert.Equal(t, env.Ciphertexts, rewrapped.Ciphertexts)
+
+		onlyNew, err := NewKeyring(map[string][]byte{"k2": testKey(2)}, "k2")
+		require.NoError(t, err)
+
+		got, err := onlyNew.Open(rewrapped, aad)
+		require.NoError(t, err)
+		assert.Equal(t, []byte("hunter2"), got[0])
+	})
+
+	t.Run("primary の envelope はそのまま返す", func(t *testing.T) {
+		current, err := rotated.Seal(aad, []byte("x"))
+		require.NoError(t, err)
+
+		same, err := rotated.Rewrap(current, aad)
+		require.NoError(t, err)
+		assert.Same(t, current, same)
+	})
+}
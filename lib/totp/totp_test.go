@@ -0,0 +1,87 @@
+package totp
+
+import (
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// RFC 6238 Appendix B の SHA1 のテストベクタ (8 桁の下 6 桁).
+var rfcSecret = []byte("12345678901234567890")
+
+func TestCode(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		unix int64
+		code string
+	}{
+		{59, "287082"},
+		{1111111109, "081804"},
+		{1111111111, "050471"},
+		{1234567890, "005924"},
+		{2000000000, "279037"},
+	}
+
+	for _, c := range cases {
+		assert.Equal(t, c.code, Code(rfcSecret, Step(time.Unix(c.unix, 0))), "t=%d", c.unix)
+	}
+}
+
+func TestValidate(t *testing.T) {
+	t.Parallel()
+
+	now := time.Unix(1234567890, 0)
+	current := Step(now)
+
+	t.Run("現在と前後 1 ステップのコードを受け付ける", func(t *testing.T) {
+		t.Parallel()
+
+		for _, step := range []int64{current - 1, current, current + 1} {
+			got, err := Validate(rfcSecret, Code(rfcSecret, step), now, 0)
+			require.NoError(t, err)
+			assert.Equal(t, step, got)
+		}
+	})
+
+	t.Run("範囲外のステップのコードは拒否する", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := Validate(rfcSecret, Code(rfcSecret, current-2), now, 0)
+		require.ErrorIs(t, err, ErrInvalidCode)
+	})
+
+	t.Run("使用済みのステップ以前のコードは拒否する", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := Validate(rfcSecret, Code(rfcSecret, current), now, current)
+		require.ErrorIs(t, err, ErrInvalidCode)
+
+		got, err := Validate(rfcSecret, Code(rfcSecret, current+1), now, current)
+		require.NoError(t, err)
+		assert.Equal(t, current+1, got)
+	})
+
+	t.Run("桁数が違うコードは拒否する", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := Validate(rfcSecret, "12345", now, 0)
+		require.ErrorIs(t, err, ErrInvalidCode)
+	})
+}
+
+func TestKeyURI(t *testing.T) {
+	t.Parallel()
+
+	u, err := url.Parse(KeyURI("brhc", "alice", rfcSecret))
+	require.NoError(t, err)
+
+	assert.Equal(t, "otpauth", u.Scheme)
+	assert.Equal(t, "totp", u.Host)
+	assert.Equal(t, "/brhc:alice", u.Path)
+	assert.Equal(t, "GEZDGNBVGY3TQOJQGEZDGNBVGY3TQOJQ", u.Query().Get("secret"))
+	assert.Equal(t, "brhc", u.Query().Get("issuer"))
+}
@@ -0,0 +1,352 @@
+// Package webhook renders controller events into webhook payloads and
+// delivers them over HTTP.
+//
+// Three payload formats are supported: "generic" posts the Event as JSON,
+// "discord" and "slack" post a message suitable for the respective
+// incoming-webhook endpoints. Every request is signed with HMAC-SHA256 so
+// that generic receivers can verify it came from the controller.
+//
+// Webhook URLs are set by group members, so requests are never sent to
+// loopback, private or link-local addresses unless the operator allowed
+// the network explicitly (see CheckAddr).
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/netip"
+	"net/url"
+	"strconv"
+	"syscall"
+	"time"
+
+	"github.com/go-errors/errors"
+)
+
+const (
+	FormatGeneric = "generic"
+	FormatDiscord = "discord"
+	FormatSlack   = "slack"
+)
+
+// Headers set on every delivery.
+const (
+	HeaderEvent     = "X-Brhc-Event"
+	HeaderDelivery  = "X-Brhc-Delivery"
+	HeaderTimestamp = "X-Brhc-Timestamp"
+	HeaderSignature = "X-Brhc-Signature"
+)
+
+// Level tells receivers how loud to be about an event.
+type Level string
+
+const (
+	LevelInfo    Level = "info"
+	LevelSuccess Level = "success"
+	LevelError   Level = "error"
+)
+
+// Field is a labelled value shown in Discord embeds and Slack attachments.
+type Field struct {
+	Name  string `json:"name"`
+	Value string `json:"value"`
+}
+
+// Event is the format-independent description of something that happened.
+// The generic format posts it as is.
+type Event struct {
+	Type       string    `json:"type"`
+	OccurredAt time.Time `json:"occurred_at"`
+	GroupID    string    `json:"group_id"`
+	Level      Level     `json:"level"`
+	Title      string    `json:"title"`
+	Message    string    `json:"message,omitempty"`
+	// Data holds the IDs involved (host_id, session_id, job_id, ...).
+	Data map[string]string `json:"data,omitempty"`
+	// Fields are the human readable details. They are not part of the
+	// generic payload, which carries Data instead.
+	Fields []Field `json:"-"`
+}
+
+// Render builds the request body of ev for the given format.
+func Render(format string, ev Event) ([]byte, error) {
+	switch format {
+	case FormatGeneric:
+		return json.Marshal(ev)
+	case FormatDiscord:
+		return json.Marshal(discordPayload(ev))
+	case FormatSlack:
+		return json.Marshal(slackPayload(ev))
+	default:
+		return nil, errors.Errorf("unknown webhook format: %s", format)
+	}
+}
+
+// Discord embed colors / Slack attachment colors per level.
+var levelColors = map[Level]int{
+	LevelInfo:    0x3b82f6,
+	LevelSuccess: 0x22c55e,
+	LevelError:   0xef4444,
+}
+
+type discordEmbedField struct {
+	Name   string `json:"name"`
+	Value  string `json:"value"`
+	Inline bool   `json:"inline"`
+}
+
+type discordEmbed struct {
+	Title       string              `json:"title"`
+	Description string              `json:"description,omitempty"`
+	Color       int                 `json:"color"`
+	Timestamp   string              `json:"timestamp"`
+	Fields      []discordEmbedField `json:"fields,omitempty"`
+	Footer      struct {
+		Text string `json:"text"`
+	} `json:"footer"`
+}
+
+type discordMessage struct {
+	Embeds []discordEmbed `json:"embeds"`
+}
+
+func discordPayload(ev Event) discordMessage {
+	embed := discordEmbed{
+		Title:       ev.Title,
+		Description: ev.Message,
+		Color:       levelColors[ev.Level],
+		Timestamp:   ev.OccurredAt.UTC().Format(time.RFC3339),
+	}
+	embed.Footer.Text = ev.Type
+
+	for _, f := range ev.Fields {
+		embed.Fields = append(embed.Fields, discordEmbedField{Name: f.Name, Value: f.Value, Inline: true})
+	}
+
+	return discordMessage{Embeds: []discordEmbed{embed}}
+}
+
+type slackField struct {
+	Title string `json:"title"`
+	Value string `json:"value"`
+	Short bool   `json:"short"`
+}
+
+type slackAttachment struct {
+	Color  string       `json:"color"`
+	Text   string       `json:"text,omitempty"`
+	Fields []slackField `json:"fields,omitempty"`
+	Footer string       `json:"footer"`
+	TS     int64        `json:"ts"`
+}
+
+type slackMessage struct {
+	Text        string            `json:"text"`
+	Attachments []slackAttachment `json:"attachments"`
+}
+
+func slackPayload(ev Event) slackMessage {
+	att := slackAttachment{
+		Color:  fmt.Sprintf("#%06x", levelColors[ev.Level]),
+		Text:   ev.Message,
+		Footer: ev.Type,
+		TS:     ev.OccurredAt.Unix(),
+	}
+
+	for _, f := range ev.Fields {
+		att.Fields = append(att.Fields, slackField{Title: f.Name, Value: f.Value, Short: true})
+	}
+
+	return slackMessage{Text: ev.Title, Attachments: []slackAttachment{att}}
+}
+
+// Sign returns the signature header value for body sent at timestamp.
+// Receivers recompute HMAC-SHA256 over "<timestamp>.<body>" with the
+// shared secret and compare.
+func Sign(secret string, timestamp int64, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(strconv.FormatInt(timestamp, 10)))
+	mac.Write([]byte("."))
+	mac.Write(body)
+
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+// Request is one delivery attempt.
+type Request struct {
+	URL        string
+	Secret     string
+	EventType  string
+	DeliveryID string
+	Body       []byte
+}
+
+// StatusError is returned by Client.Send when the receiver answered with a
+// non-2xx status. The response body is deliberately not kept: it is shown
+// to group members in the delivery history, which would otherwise let them
+// read responses from hosts the controller can reach.
+type StatusError struct {
+	StatusCode int
+	// RetryAfter is the delay asked for by a 429/503 Retry-After header,
+	// zero if none was given.
+	RetryAfter time.Duration
+}
+
+func (e *StatusError) Error() string {
+	return fmt.Sprintf("webhook responded with status %d", e.StatusCode)
+}
+
+// Permanent reports whether retrying the same request cannot succeed
+// (4xx other than timeouts and rate limits).
+func (e *StatusError) Permanent() bool {
+	return e.StatusCode >= 400 && e.StatusCode < 500 &&
+		e.StatusCode != http.StatusRequestTimeout && e.StatusCode != http.StatusTooManyRequests
+}
+
+// maxDrainBodyBytes bounds how much of a response is read (and discarded)
+// so that the connection can be reused.
+const maxDrainBodyBytes = 64 << 10
+
+// ErrForbiddenDestination is returned when a webhook would be sent to an
+// address that is not allowed.
+var ErrForbiddenDestination = errors.New("webhook destination is not allowed")
+
+// sharedAddressSpace is the carrier-grade NAT range (RFC 6598), which also
+// hosts some cloud metadata endpoints and overlay networks.
+var sharedAddressSpace = netip.MustParsePrefix("100.64.0.0/10")
+
+// CheckAddr rejects loopback, private, link-local, shared, multicast and
+// unspecified addresses with ErrForbiddenDestination unless one of allowed
+// contains addr.
+func CheckAddr(addr netip.Addr, allowed []netip.Prefix) error {
+	addr = addr.Unmap()
+
+	for _, p := range allowed {
+		if p.Contains(addr) {
+			return nil
+		}
+	}
+
+	if addr.IsLoopback() || addr.IsPrivate() || addr.IsLinkLocalUnicast() || addr.IsLinkLocalMulticast() ||
+		addr.IsInterfaceLocalMulticast() || addr.IsMulticast() || addr.IsUnspecified() || sharedAddressSpace.Contains(addr) {
+		return errors.Errorf("%s: %w", addr, ErrForbiddenDestination)
+	}
+
+	return nil
+}
+
+// CheckURL resolves the host of rawURL and applies CheckAddr to every
+// address. It gives early feedback when a webhook is saved; Client checks
+// the address actually dialed again, so a host that later resolves
+// elsewhere is still refused.
+func CheckURL(ctx context.Context, rawURL string, allowed []netip.Prefix) error {
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return errors.Wrap(err, 0)
+	}
+
+	host := u.Hostname()
+	if addr, err := netip.ParseAddr(host); err == nil {
+		return CheckAddr(addr, allowed)
+	}
+
+	addrs, err := net.DefaultResolver.LookupNetIP(ctx, "ip", host)
+	if err != nil {
+		return errors.Wrap(err, 0)
+	}
+
+	for _, addr := range addrs {
+		if err := CheckAddr(addr, allowed); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Client posts webhook requests.
+type Client struct {
+	http *http.Client
+	now  func() time.Time
+}
+
+// NewClient returns a Client whose requests give up after timeout and are
+// only sent to addresses accepted by CheckAddr with allowed.
+func NewClient(timeout time.Duration, allowed []netip.Prefix) *Client {
+	dialer := &net.Dialer{
+		// Control runs after name resolution with the address about to be
+		// dialed, so DNS answers and redirects cannot bypass the check.
+		Control: func(_, address string, _ syscall.RawConn) error {
+			addrPort, err := netip.ParseAddrPort(address)
+			if err != nil {
+				return errors.Wrap(err, 0)
+			}
+
+			return CheckAddr(addrPort.Addr(), allowed)
+		},
+	}
+
+	transport := http.DefaultTransport.(*http.Transport).Clone() //nolint:forcetypeassert // always *http.Transport
+	// Through a proxy the dialed address would be the proxy's, not the receiver's.
+	transport.Proxy = nil
+	transport.DialContext = dialer.DialContext
+
+	return &Client{
+		http: &http.Client{Timeout: timeout, Transport: transport},
+		now:  time.Now,
+	}
+}
+
+// Send posts req. It returns the response status code (0 when no response
+// was received) and a *StatusError for non-2xx responses.
+func (c *Client) Send(ctx context.Context, req Request) (int, error) {
+	httpReq, err := http.NewRequestWithContext(ctx, http.MethodPost, req.URL, bytes.NewReader(req.Body))
+	if err != nil {
+		return 0, errors.Wrap(err, 0)
+	}
+
+	ts := c.now().Unix()
+
+	httpReq.Header.Set("Content-Type", "application/json")
+	httpReq.Header.Set("User-Agent", "baru-reso-headless-controller")
+	httpReq.Header.Set(HeaderEvent, req.EventType)
+	httpReq.Header.Set(HeaderDelivery, req.DeliveryID)
+	httpReq.Header.Set(HeaderTimestamp, strconv.FormatInt(ts, 10))
+	httpReq.Header.Set(HeaderSignature, Sign(req.Secret, ts, req.Body))
+
+	res, err := c.http.Do(httpReq)
+	if err != nil {
+		return 0, errors.Wrap(err, 0)
+	}
+	defer res.Body.Close() //nolint:errcheck // read-only
+
+	_, _ = io.Copy(io.Discard, io.LimitReader(res.Body, maxDrainBodyBytes))
+
+	if res.StatusCode >= 200 && res.StatusCode < 300 {
+		return res.StatusCode, nil
+	}
+
+	return res.StatusCode, &StatusError{
+		StatusCode: res.StatusCode,
+		RetryAfter: parseRetryAfter(res.Header.Get("Retry-After")),
+	}
+}
+
+// parseRetryAfter understands the delay-seconds form only; HTTP dates are
+// rare for webhook endpoints and fall back to the normal backoff.
+func parseRetryAfter(v string) time.Duration {
+	secs, err := strconv.Atoi(v)
+	if err != nil || secs < 0 {
+		return 0
+	}
+
+	return time.Duration(secs) * time.Second
+}
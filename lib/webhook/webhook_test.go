@@ -0,0 +1,224 @@
+package webhook
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/netip"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/go-errors/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var testEvent = Event{
+	Type:       "session.crashed",
+	OccurredAt: time.Date(2026, 7, 8, 12, 0, 0, 0, time.UTC),
+	GroupID:    "g-1",
+	Level:      LevelError,
+	Title:      "Session crashed",
+	Message:    "host went down",
+	Data:       map[string]string{"session_id": "S-1"},
+	Fields:     []Field{{Name: "Session", Value: "S-1"}},
+}
+
+func TestRender(t *testing.T) {
+	t.Run("generic: イベントを data 付きでそのまま送り fields は含めない", func(t *testing.T) {
+		body, err := Render(FormatGeneric, testEvent)
+		require.NoError(t, err)
+
+		var got map[string]any
+		require.NoError(t, json.Unmarshal(body, &got))
+		assert.Equal(t, "session.crashed", got["type"])
+		assert.Equal(t, "g-1", got["group_id"])
+		assert.Equal(t, "error", got["level"])
+		assert.Equal(t, map[string]any{"session_id": "S-1"}, got["data"])
+		assert.NotContains(t, got, "fields")
+	})
+
+	t.Run("discord: level の色の embed にする", func(t *testing.T) {
+		body, err := Render(FormatDiscord, testEvent)
+		require.NoError(t, err)
+
+		var got discordMessage
+		require.NoError(t, json.Unmarshal(body, &got))
+		require.Len(t, got.Embeds, 1)
+		embed := got.Embeds[0]
+		assert.Equal(t, "Session crashed", embed.Title)
+		assert.Equal(t, "host went down", embed.Description)
+		assert.Equal(t, levelColors[LevelError], embed.Color)
+		assert.Equal(t, "2026-07-08T12:00:00Z", embed.Timestamp)
+		assert.Equal(t, "session.crashed", embed.Footer.Text)
+		assert.Equal(t, []discordEmbedField{{Name: "Session", Value: "S-1", Inline: true}}, embed.Fields)
+	})
+
+	t.Run("slack: text と attachment にする", func(t *testing.T) {
+		body, err := Render(FormatSlack, testEvent)
+		require.NoError(t, err)
+
+		var got slackMessage
+		require.NoError(t, json.Unmarshal(body, &got))
+		assert.Equal(t, "Session crashed", got.Text)
+		require.Len(t, got.Attachments, 1)
+		att := got.Attachments[0]
+		assert.Equal(t, "#ef4444", att.Color)
+		assert.Equal(t, "host went down", att.Text)
+		assert.Equal(t, testEvent.OccurredAt.Unix(), att.TS)
+		assert.Equal(t, []slackField{{Title: "Session", Value: "S-1", Short: true}}, att.Fields)
+	})
+
+	t.Run("失敗: 未知の format", func(t *testing.T) {
+		_, err := Render("teams", testEvent)
+		assert.Error(t, err)
+	})
+}
+
+func TestSign(t *testing.T) {
+	// echo -n '1700000000.{"a":1}' | openssl dgst -sha256 -hmac secret
+	assert.Equal(t,
+		"sha256=49f24e537407743fa4a0242bb63b94b9a47ee99cbbe071ccd8a22550ae411686",
+		Sign("secret", 1700000000, []byte(`{"a":1}`)),
+	)
+	assert.NotEqual(t, Sign("secret", 1700000000, []byte(`{}`)), Sign("secret", 1700000001, []byte(`{}`)))
+	assert.NotEqual(t, Sign("secret", 1700000000, []byte(`{}`)), Sign("other", 1700000000, []byte(`{}`)))
+}
+
+// testServerNetworks は httptest のサーバー (loopback) への送信を許可する.
+var testServerNetworks = []netip.Prefix{netip.MustParsePrefix("127.0.0.0/8")}
+
+func TestCheckAddr(t *testing.T) {
+	for addr, forbidden := range map[string]bool{
+		"93.184.216.34":    false,
+		"2606:4700::1111":  false,
+		"127.0.0.1":        true,
+		"::1":              true,
+		"10.0.0.5":         true,
+		"172.17.0.1":       true,
+		"192.168.1.10":     true,
+		"169.254.169.254":  true,
+		"fe80::1":          true,
+		"fd00::1":          true,
+		"100.100.100.200":  true,
+		"0.0.0.0":          true,
+		"::ffff:127.0.0.1": true,
+	} {
+		err := CheckAddr(netip.MustParseAddr(addr), nil)
+		if forbidden {
+			require.ErrorIs(t, err, ErrForbiddenDestination, addr)
+		} else {
+			require.NoError(t, err, addr)
+		}
+	}
+
+	require.NoError(t, CheckAddr(netip.MustParseAddr("10.1.2.3"), []netip.Prefix{netip.MustParsePrefix("10.1.0.0/16")}))
+	require.ErrorIs(t, CheckAddr(netip.MustParseAddr("10.2.0.1"), []netip.Prefix{netip.MustParsePrefix("10.1.0.0/16")}), ErrForbiddenDestination)
+}
+
+func TestCheckURL(t *testing.T) {
+	require.ErrorIs(t, CheckURL(context.Background(), "http://127.0.0.1:8080/hook", nil), ErrForbiddenDestination)
+	require.ErrorIs(t, CheckURL(context.Background(), "http://[::1]/hook", nil), ErrForbiddenDestination)
+	require.ErrorIs(t, CheckURL(context.Background(), "http://localhost/hook", nil), ErrForbiddenDestination)
+	require.NoError(t, CheckURL(context.Background(), "http://127.0.0.1/hook", testServerNetworks))
+}
+
+func TestClient_Send(t *testing.T) {
+	t.Run("失敗: 許可していない loopback には送らない", func(t *testing.T) {
+		var called bool
+
+		srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+			called = true
+			w.WriteHeader(http.StatusOK)
+		}))
+		defer srv.Close()
+
+		status, err := NewClient(time.Second, nil).Send(context.Background(), Request{URL: srv.URL, Body: []byte(`{}`)})
+		require.ErrorIs(t, err, ErrForbiddenDestination)
+		assert.Zero(t, status)
+		assert.False(t, called)
+	})
+
+	t.Run("成功: 署名とヘッダを付けて POST する", func(t *testing.T) {
+		var got *http.Request
+
+		var gotBody []byte
+
+		srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			got = r
+			gotBody, _ = io.ReadAll(r.Body)
+			w.WriteHeader(http.StatusNoContent)
+		}))
+		defer srv.Close()
+
+		body := []byte(`{"type":"ping"}`)
+
+		status, err := NewClient(time.Second, testServerNetworks).Send(context.Background(), Request{
+			URL:        srv.URL,
+			Secret:     "s3cret",
+			EventType:  "ping",
+			DeliveryID: "42",
+			Body:       body,
+		})
+		require.NoError(t, err)
+		assert.Equal(t, http.StatusNoContent, status)
+
+		require.NotNil(t, got)
+		assert.Equal(t, http.MethodPost, got.Method)
+		assert.Equal(t, "application/json", got.Header.Get("Content-Type"))
+		assert.Equal(t, "ping", got.Header.Get(HeaderEvent))
+		assert.Equal(t, "42", got.Header.Get(HeaderDelivery))
+		assert.Equal(t, body, gotBody)
+
+		ts, err := strconv.ParseInt(got.Header.Get(HeaderTimestamp), 10, 64)
+		require.NoError(t, err)
+		assert.Equal(t, Sign("s3cret", ts, body), got.Header.Get(HeaderSignature))
+	})
+
+	t.Run("失敗: 2xx 以外は StatusError になる", func(t *testing.T) {
+		srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+			w.Header().Set("Retry-After", "120")
+			w.WriteHeader(http.StatusTooManyRequests)
+			_, _ = w.Write([]byte("  slow down  "))
+		}))
+		defer srv.Close()
+
+		status, err := NewClient(time.Second, testServerNetworks).Send(context.Background(), Request{URL: srv.URL, Body: []byte(`{}`)})
+		assert.Equal(t, http.StatusTooManyRequests, status)
+
+		var statusErr *StatusError
+		require.True(t, errors.As(err, &statusErr))
+		assert.Equal(t, 2*time.Minute, statusErr.RetryAfter)
+		assert.NotContains(t, err.Error(), "slow down", "応答本文は残さない")
+		assert.False(t, statusErr.Permanent())
+	})
+
+	t.Run("失敗: 接続できなければ status は 0", func(t *testing.T) {
+		srv := httptest.NewServer(http.NotFoundHandler())
+		srv.Close()
+
+		status, err := NewClient(time.Second, testServerNetworks).Send(context.Background(), Request{URL: srv.URL, Body: []byte(`{}`)})
+		require.Error(t, err)
+		assert.Zero(t, status)
+
+		var statusErr *StatusError
+		assert.False(t, errors.As(err, &statusErr))
+	})
+}
+
+func TestStatusError_Permanent(t *testing.T) {
+	for code, want := range map[int]bool{
+		http.StatusBadRequest:          true,
+		http.StatusNotFound:            true,
+		http.StatusGone:                true,
+		http.StatusRequestTimeout:      false,
+		http.StatusTooManyRequests:     false,
+		http.StatusInternalServerError: false,
+		http.StatusBadGateway:          false,
+	} {
+		assert.Equal(t, want, (&StatusError{StatusCode: code}).Permanent(), code)
+	}
+}
@@ -0,0 +1,418 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        (unknown)
+// source: hdlctrl/v1/audit.proto
+
+package hdlctrlv1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type AuditEvent struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	Id         int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	OccurredAt *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=occurred_at,json=occurredAt,proto3" json:"occurred_at,omitempty"`
+	// 実行ユーザー. 未認証の RPC (登録など) では未設定.
+	UserId *string `protobuf:"bytes,3,opt,name=user_id,json=userId,proto3,oneof" json:"user_id,omitempty"`
+	// connect の procedure 名 (例: /hdlctrl.v1.ControllerService/StopSession).
+	Procedure string `protobuf:"bytes,4,opt,name=procedure,proto3" json:"procedure,omitempty"`
+	// host / session / account / group / role / user / scheduled_operation / webhook.
+	ResourceType string `protobuf:"bytes,5,opt,name=resource_type,json=resourceType,proto3" json:"resource_type,omitempty"`
+	// 作成系で ID がまだ決まっていない場合は未設定.
+	ResourceId *string `protobuf:"bytes,6,opt,name=resource_id,json=resourceId,proto3,oneof" json:"resource_id,omitempty"`
+	// 権限判定の対象になったグループ. system 権限だけで許可される操作では未設定.
+	GroupId *string `protobuf:"bytes,7,opt,name=group_id,json=groupId,proto3,oneof" json:"group_id,omitempty"`
+	// パスワード等を伏せたリクエストの JSON.
+	RequestSummary string `protobuf:"bytes,8,opt,name=request_summary,json=requestSummary,proto3" json:"request_summary,omitempty"`
+	// "ok" または connect のエラーコード名 (permission_denied 等).
+	Outcome       string  `protobuf:"bytes,9,opt,name=outcome,proto3" json:"outcome,omitempty"`
+	ErrorMessage  *string `protobuf:"bytes,10,opt,name=error_message,json=errorMessage,proto3,oneof" json:"error_message,omitempty"`
+	RemoteAddr    *string `protobuf:"bytes,11,opt,name=remote_addr,json=remoteAddr,proto3,oneof" json:"remote_addr,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AuditEvent) Reset() {
+	*x = AuditEvent{}
+	mi := &file_hdlctrl_v1_audit_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AuditEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AuditEvent) ProtoMessage() {}
+
+func (x *AuditEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_hdlctrl_v1_audit_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AuditEvent.ProtoReflect.Descriptor instead.
+func (*AuditEvent) Descriptor() ([]byte, []int) {
+	return file_hdlctrl_v1_audit_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *AuditEvent) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *AuditEvent) GetOccurredAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.OccurredAt
+	}
+	return nil
+}
+
+func (x *AuditEvent) GetUserId() string {
+	if x != nil && x.UserId != nil {
+		return *x.UserId
+	}
+	return ""
+}
+
+func (x *AuditEvent) GetProcedure() string {
+	if x != nil {
+		return x.Procedure
+	}
+	return ""
+}
+
+func (x *AuditEvent) GetResourceType() string {
+	if x != nil {
+		return x.ResourceType
+	}
+	return ""
+}
+
+func (x *AuditEvent) GetResourceId() string {
+	if x != nil && x.ResourceId != nil {
+		return *x.ResourceId
+	}
+	return ""
+}
+
+func (x *AuditEvent) GetGroupId() string {
+	if x != nil && x.GroupId != nil {
+		return *x.GroupId
+	}
+	return ""
+}
+
+func (x *AuditEvent) GetRequestSummary() string {
+	if x != nil {
+		return x.RequestSummary
+	}
+	return ""
+}
+
+func (x *AuditEvent) GetOutcome() string {
+	if x != nil {
+		return x.Outcome
+	}
+	return ""
+}
+
+func (x *AuditEvent) GetErrorMessage() string {
+	if x != nil && x.ErrorMessage != nil {
+		return *x.ErrorMessage
+	}
+	return ""
+}
+
+func (x *AuditEvent) GetRemoteAddr() string {
+	if x != nil && x.RemoteAddr != nil {
+		return *x.RemoteAddr
+	}
+	return ""
+}
+
+type ListAuditEventsRequest struct {
+	state        protoimpl.MessageState `protogen:"open.v1"`
+	Page         *PageRequest           `protobuf:"bytes,1,opt,name=page,proto3" json:"page,omitempty"`
+	GroupId      *string                `protobuf:"bytes,2,opt,name=group_id,json=groupId,proto3,oneof" json:"group_id,omitempty"`
+	UserId       *string                `protobuf:"bytes,3,opt,name=user_id,json=userId,proto3,oneof" json:"user_id,omitempty"`
+	ResourceType *string                `protobuf:"bytes,4,opt,name=resource_type,json=resourceType,proto3,oneof" json:"resource_type,omitempty"`
+	ResourceId   *string                `protobuf:"bytes,5,opt,name=resource_id,json=resourceId,proto3,oneof" json:"resource_id,omitempty"`
+	// since 以降 (含む) / until より前 (含まない) に絞り込む.
+	Since         *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=since,proto3,oneof" json:"since,omitempty"`
+	Until         *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=until,proto3,oneof" json:"until,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListAuditEventsRequest) Reset() {
+	*x = ListAuditEventsRequest{}
+	mi := &file_hdlctrl_v1_audit_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAuditEventsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAuditEventsRequest) ProtoMessage() {}
+
+func (x *ListAuditEventsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_hdlctrl_v1_audit_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAuditEventsRequest.ProtoReflect.Descriptor instead.
+func (*ListAuditEventsRequest) Descriptor() ([]byte, []int) {
+	return file_hdlctrl_v1_audit_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ListAuditEventsRequest) GetPage() *PageRequest {
+	if x != nil {
+		return x.Page
+	}
+	return nil
+}
+
+func (x *ListAuditEventsRequest) GetGroupId() string {
+	if x != nil && x.GroupId != nil {
+		return *x.GroupId
+	}
+	return ""
+}
+
+func (x *ListAuditEventsRequest) GetUserId() string {
+	if x != nil && x.UserId != nil {
+		return *x.UserId
+	}
+	return ""
+}
+
+func (x *ListAuditEventsRequest) GetResourceType() string {
+	if x != nil && x.ResourceType != nil {
+		return *x.ResourceType
+	}
+	return ""
+}
+
+func (x *ListAuditEventsRequest) GetResourceId() string {
+	if x != nil && x.ResourceId != nil {
+		return *x.ResourceId
+	}
+	return ""
+}
+
+func (x *ListAuditEventsRequest) GetSince() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Since
+	}
+	return nil
+}
+
+func (x *ListAuditEventsRequest) GetUntil() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Until
+	}
+	return nil
+}
+
+type ListAuditEventsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Events        []*AuditEvent          `protobuf:"bytes,1,rep,name=events,proto3" json:"events,omitempty"`
+	Page          *PageResponse          `protobuf:"bytes,2,opt,name=page,proto3" json:"page,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListAuditEventsResponse) Reset() {
+	*x = ListAuditEventsResponse{}
+	mi := &file_hdlctrl_v1_audit_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAuditEventsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAuditEventsResponse) ProtoMessage() {}
+
+func (x *ListAuditEventsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_hdlctrl_v1_audit_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAuditEventsResponse.ProtoReflect.Descriptor instead.
+func (*ListAuditEventsResponse) Descriptor() ([]byte, []int) {
+	return file_hdlctrl_v1_audit_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ListAuditEventsResponse) GetEvents() []*AuditEvent {
+	if x != nil {
+		return x.Events
+	}
+	return nil
+}
+
+func (x *ListAuditEventsResponse) GetPage() *PageResponse {
+	if x != nil {
+		return x.Page
+	}
+	return nil
+}
+
+var File_hdlctrl_v1_audit_proto protoreflect.FileDescriptor
+
+const file_hdlctrl_v1_audit_proto_rawDesc = "" +
+	"\n" +
+	"\x16hdlctrl/v1/audit.proto\x12\n" +
+	"hdlctrl.v1\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x1bhdlctrl/v1/controller.proto\"\xde\x03\n" +
+	"\n" +
+	"AuditEvent\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12;\n" +
+	"\voccurred_at\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"occurredAt\x12\x1c\n" +
+	"\auser_id\x18\x03 \x01(\tH\x00R\x06userId\x88\x01\x01\x12\x1c\n" +
+	"\tprocedure\x18\x04 \x01(\tR\tprocedure\x12#\n" +
+	"\rresource_type\x18\x05 \x01(\tR\fresourceType\x12$\n" +
+	"\vresource_id\x18\x06 \x01(\tH\x01R\n" +
+	"resourceId\x88\x01\x01\x12\x1e\n" +
+	"\bgroup_id\x18\a \x01(\tH\x02R\agroupId\x88\x01\x01\x12'\n" +
+	"\x0frequest_summary\x18\b \x01(\tR\x0erequestSummary\x12\x18\n" +
+	"\aoutcome\x18\t \x01(\tR\aoutcome\x12(\n" +
+	"\rerror_message\x18\n" +
+	" \x01(\tH\x03R\ferrorMessage\x88\x01\x01\x12$\n" +
+	"\vremote_addr\x18\v \x01(\tH\x04R\n" +
+	"remoteAddr\x88\x01\x01B\n" +
+	"\n" +
+	"\b_user_idB\x0e\n" +
+	"\f_resource_idB\v\n" +
+	"\t_group_idB\x10\n" +
+	"\x0e_error_messageB\x0e\n" +
+	"\f_remote_addr\"\x90\x03\n" +
+	"\x16ListAuditEventsRequest\x12+\n" +
+	"\x04page\x18\x01 \x01(\v2\x17.hdlctrl.v1.PageRequestR\x04page\x12\x1e\n" +
+	"\bgroup_id\x18\x02 \x01(\tH\x00R\agroupId\x88\x01\x01\x12\x1c\n" +
+	"\auser_id\x18\x03 \x01(\tH\x01R\x06userId\x88\x01\x01\x12(\n" +
+	"\rresource_type\x18\x04 \x01(\tH\x02R\fresourceType\x88\x01\x01\x12$\n" +
+	"\vresource_id\x18\x05 \x01(\tH\x03R\n" +
+	"resourceId\x88\x01\x01\x125\n" +
+	"\x05since\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampH\x04R\x05since\x88\x01\x01\x125\n" +
+	"\x05until\x18\a \x01(\v2\x1a.google.protobuf.TimestampH\x05R\x05until\x88\x01\x01B\v\n" +
+	"\t_group_idB\n" +
+	"\n" +
+	"\b_user_idB\x10\n" +
+	"\x0e_resource_typeB\x0e\n" +
+	"\f_resource_idB\b\n" +
+	"\x06_sinceB\b\n" +
+	"\x06_until\"w\n" +
+	"\x17ListAuditEventsResponse\x12.\n" +
+	"\x06events\x18\x01 \x03(\v2\x16.hdlctrl.v1.AuditEventR\x06events\x12,\n" +
+	"\x04page\x18\x02 \x01(\v2\x18.hdlctrl.v1.PageResponseR\x04page2j\n" +
+	"\fAuditService\x12Z\n" +
+	"\x0fListAuditEvents\x12\".hdlctrl.v1.ListAuditEventsRequest\x1a#.hdlctrl.v1.ListAuditEventsResponseB\xb8\x01\n" +
+	"\x0ecom.hdlctrl.v1B\n" +
+	"AuditProtoP\x01ZQgithub.com/hantabaru1014/baru-reso-headless-controller/pbgen/hdlctrl/v1;hdlctrlv1\xa2\x02\x03HXX\xaa\x02\n" +
+	"Hdlctrl.V1\xca\x02\n" +
+	"Hdlctrl\\V1\xe2\x02\x16Hdlctrl\\V1\\GPBMetadata\xea\x02\vHdlctrl::V1b\x06proto3"
+
+var (
+	file_hdlctrl_v1_audit_proto_rawDescOnce sync.Once
+	file_hdlctrl_v1_audit_proto_rawDescData []byte
+)
+
+func file_hdlctrl_v1_audit_proto_rawDescGZIP() []byte {
+	file_hdlctrl_v1_audit_proto_rawDescOnce.Do(func() {
+		file_hdlctrl_v1_audit_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_hdlctrl_v1_audit_proto_rawDesc), len(file_hdlctrl_v1_audit_proto_rawDesc)))
+	})
+	return file_hdlctrl_v1_audit_proto_rawDescData
+}
+
+var file_hdlctrl_v1_audit_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
+var file_hdlctrl_v1_audit_proto_goTypes = []any{
+	(*AuditEvent)(nil),              // 0: hdlctrl.v1.AuditEvent
+	(*ListAuditEventsRequest)(nil),  // 1: hdlctrl.v1.ListAuditEventsRequest
+	(*ListAuditEventsResponse)(nil), // 2: hdlctrl.v1.ListAuditEventsResponse
+	(*timestamppb.Timestamp)(nil),   // 3: google.protobuf.Timestamp
+	(*PageRequest)(nil),             // 4: hdlctrl.v1.PageRequest
+	(*PageResponse)(nil),            // 5: hdlctrl.v1.PageResponse
+}
+var file_hdlctrl_v1_audit_proto_depIdxs = []int32{
+	3, // 0: hdlctrl.v1.AuditEvent.occurred_at:type_name -> google.protobuf.Timestamp
+	4, // 1: hdlctrl.v1.ListAuditEventsRequest.page:type_name -> hdlctrl.v1.PageRequest
+	3, // 2: hdlctrl.v1.ListAuditEventsRequest.since:type_name -> google.protobuf.Timestamp
+	3, // 3: hdlctrl.v1.ListAuditEventsRequest.until:type_name -> google.protobuf.Timestamp
+	0, // 4: hdlctrl.v1.ListAuditEventsResponse.events:type_name -> hdlctrl.v1.AuditEvent
+	5, // 5: hdlctrl.v1.ListAuditEventsResponse.page:type_name -> hdlctrl.v1.PageResponse
+	1, // 6: hdlctrl.v1.AuditService.ListAuditEvents:input_type -> hdlctrl.v1.ListAuditEventsRequest
+	2, // 7: hdlctrl.v1.AuditService.ListAuditEvents:output_type -> hdlctrl.v1.ListAuditEventsResponse
+	7, // [7:8] is the sub-list for method output_type
+	6, // [6:7] is the sub-list for method input_type
+	6, // [6:6] is the sub-list for extension type_name
+	6, // [6:6] is the sub-list for extension extendee
+	0, // [0:6] is the sub-list for field type_name
+}
+
+func init() { file_hdlctrl_v1_audit_proto_init() }
+func file_hdlctrl_v1_audit_proto_init() {
+	if File_hdlctrl_v1_audit_proto != nil {
+		return
+	}
+	file_hdlctrl_v1_controller_proto_init()
+	file_hdlctrl_v1_audit_proto_msgTypes[0].OneofWrappers = []any{}
+	file_hdlctrl_v1_audit_proto_msgTypes[1].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_hdlctrl_v1_audit_proto_rawDesc), len(file_hdlctrl_v1_audit_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   3,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_hdlctrl_v1_audit_proto_goTypes,
+		DependencyIndexes: file_hdlctrl_v1_audit_proto_depIdxs,
+		MessageInfos:      file_hdlctrl_v1_audit_proto_msgTypes,
+	}.Build()
+	File_hdlctrl_v1_audit_proto = out.File
+	file_hdlctrl_v1_audit_proto_goTypes = nil
+	file_hdlctrl_v1_audit_proto_depIdxs = nil
+}
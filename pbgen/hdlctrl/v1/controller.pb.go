@@ -23,6 +23,271 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+type SessionUserEventKind int32
+
+const (
+	SessionUserEventKind_SESSION_USER_EVENT_KIND_UNSPECIFIED SessionUserEventKind = 0
+	SessionUserEventKind_SESSION_USER_EVENT_KIND_JOINED      SessionUserEventKind = 1
+	SessionUserEventKind_SESSION_USER_EVENT_KIND_LEFT        SessionUserEventKind = 2
+)
+
+// Enum value maps for SessionUserEventKind.
+var (
+	SessionUserEventKind_name = map[int32]string{
+		0: "SESSION_USER_EVENT_KIND_UNSPECIFIED",
+		1: "SESSION_USER_EVENT_KIND_JOINED",
+		2: "SESSION_USER_EVENT_KIND_LEFT",
+	}
+	SessionUserEventKind_value = map[string]int32{
+		"SESSION_USER_EVENT_KIND_UNSPECIFIED": 0,
+		"SESSION_USER_EVENT_KIND_JOINED":      1,
+		"SESSION_USER_EVENT_KIND_LEFT":        2,
+	}
+)
+
+func (x SessionUserEventKind) Enum() *SessionUserEventKind {
+	p := new(SessionUserEventKind)
+	*p = x
+	return p
+}
+
+func (x SessionUserEventKind) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (SessionUserEventKind) Descriptor() protoreflect.EnumDescriptor {
+	return file_hdlctrl_v1_controller_proto_enumTypes[0].Descriptor()
+}
+
+func (SessionUserEventKind) Type() protoreflect.EnumType {
+	return &file_hdlctrl_v1_controller_proto_enumTypes[0]
+}
+
+func (x SessionUserEventKind) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use SessionUserEventKind.Descriptor instead.
+func (SessionUserEventKind) EnumDescriptor() ([]byte, []int) {
+	return file_hdlctrl_v1_controller_proto_rawDescGZIP(), []int{0}
+}
+
+type SessionUsageGroupBy int32
+
+const (
+	SessionUsageGroupBy_SESSION_USAGE_GROUP_BY_UNSPECIFIED SessionUsageGroupBy = 0
+	// ワールド (URL またはプリセット) ごと
+	SessionUsageGroupBy_SESSION_USAGE_GROUP_BY_WORLD SessionUsageGroupBy = 1
+	SessionUsageGroupBy_SESSION_USAGE_GROUP_BY_HOST  SessionUsageGroupBy = 2
+)
+
+// Enum value maps for SessionUsageGroupBy.
+var (
+	SessionUsageGroupBy_name = map[int32]string{
+		0: "SESSION_USAGE_GROUP_BY_UNSPECIFIED",
+		1: "SESSION_USAGE_GROUP_BY_WORLD",
+		2: "SESSION_USAGE_GROUP_BY_HOST",
+	}
+	SessionUsageGroupBy_value = map[string]int32{
+		"SESSION_USAGE_GROUP_BY_UNSPECIFIED": 0,
+		"SESSION_USAGE_GROUP_BY_WORLD":       1,
+		"SESSION_USAGE_GROUP_BY_HOST":        2,
+	}
+)
+
+func (x SessionUsageGroupBy) Enum() *SessionUsageGroupBy {
+	p := new(SessionUsageGroupBy)
+	*p = x
+	return p
+}
+
+func (x SessionUsageGroupBy) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (SessionUsageGroupBy) Descriptor() protoreflect.EnumDescriptor {
+	return file_hdlctrl_v1_controller_proto_enumTypes[1].Descriptor()
+}
+
+func (SessionUsageGroupBy) Type() protoreflect.EnumType {
+	return &file_hdlctrl_v1_controller_proto_enumTypes[1]
+}
+
+func (x SessionUsageGroupBy) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use SessionUsageGroupBy.Descriptor instead.
+func (SessionUsageGroupBy) EnumDescriptor() ([]byte, []int) {
+	return file_hdlctrl_v1_controller_proto_rawDescGZIP(), []int{1}
+}
+
+type SessionUsageInterval int32
+
+const (
+	SessionUsageInterval_SESSION_USAGE_INTERVAL_UNSPECIFIED SessionUsageInterval = 0
+	SessionUsageInterval_SESSION_USAGE_INTERVAL_HOUR        SessionUsageInterval = 1
+	SessionUsageInterval_SESSION_USAGE_INTERVAL_DAY         SessionUsageInterval = 2
+	// 月曜始まり
+	SessionUsageInterval_SESSION_USAGE_INTERVAL_WEEK SessionUsageInterval = 3
+)
+
+// Enum value maps for SessionUsageInterval.
+var (
+	SessionUsageInterval_name = map[int32]string{
+		0: "SESSION_USAGE_INTERVAL_UNSPECIFIED",
+		1: "SESSION_USAGE_INTERVAL_HOUR",
+		2: "SESSION_USAGE_INTERVAL_DAY",
+		3: "SESSION_USAGE_INTERVAL_WEEK",
+	}
+	SessionUsageInterval_value = map[string]int32{
+		"SESSION_USAGE_INTERVAL_UNSPECIFIED": 0,
+		"SESSION_USAGE_INTERVAL_HOUR":        1,
+		"SESSION_USAGE_INTERVAL_DAY":         2,
+		"SESSION_USAGE_INTERVAL_WEEK":        3,
+	}
+)
+
+func (x SessionUsageInterval) Enum() *SessionUsageInterval {
+	p := new(SessionUsageInterval)
+	*p = x
+	return p
+}
+
+func (x SessionUsageInterval) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (SessionUsageInterval) Descriptor() protoreflect.EnumDescriptor {
+	return file_hdlctrl_v1_controller_proto_enumTypes[2].Descriptor()
+}
+
+func (SessionUsageInterval) Type() protoreflect.EnumType {
+	return &file_hdlctrl_v1_controller_proto_enumTypes[2]
+}
+
+func (x SessionUsageInterval) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use SessionUsageInterval.Descriptor instead.
+func (SessionUsageInterval) EnumDescriptor() ([]byte, []int) {
+	return file_hdlctrl_v1_controller_proto_rawDescGZIP(), []int{2}
+}
+
+type MetricKind int32
+
+const (
+	MetricKind_METRIC_KIND_UNSPECIFIED MetricKind = 0
+	// target_id は host id. RUNNING の間だけ記録される
+	MetricKind_METRIC_KIND_HOST_FPS MetricKind = 1
+	// target_id は host id. RUNNING なら 1, それ以外なら 0 で、集計後の平均は稼働していた割合
+	MetricKind_METRIC_KIND_HOST_RUNNING MetricKind = 2
+	// target_id は host id. ホスト上の全セッションのユーザー数の合計
+	MetricKind_METRIC_KIND_HOST_USERS MetricKind = 3
+	// target_id は session id
+	MetricKind_METRIC_KIND_SESSION_USERS MetricKind = 4
+)
+
+// Enum value maps for MetricKind.
+var (
+	MetricKind_name = map[int32]string{
+		0: "METRIC_KIND_UNSPECIFIED",
+		1: "METRIC_KIND_HOST_FPS",
+		2: "METRIC_KIND_HOST_RUNNING",
+		3: "METRIC_KIND_HOST_USERS",
+		4: "METRIC_KIND_SESSION_USERS",
+	}
+	MetricKind_value = map[string]int32{
+		"METRIC_KIND_UNSPECIFIED":   0,
+		"METRIC_KIND_HOST_FPS":      1,
+		"METRIC_KIND_HOST_RUNNING":  2,
+		"METRIC_KIND_HOST_USERS":    3,
+		"METRIC_KIND_SESSION_USERS": 4,
+	}
+)
+
+func (x MetricKind) Enum() *MetricKind {
+	p := new(MetricKind)
+	*p = x
+	return p
+}
+
+func (x MetricKind) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (MetricKind) Descriptor() protoreflect.EnumDescriptor {
+	return file_hdlctrl_v1_controller_proto_enumTypes[3].Descriptor()
+}
+
+func (MetricKind) Type() protoreflect.EnumType {
+	return &file_hdlctrl_v1_controller_proto_enumTypes[3]
+}
+
+func (x MetricKind) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use MetricKind.Descriptor instead.
+func (MetricKind) EnumDescriptor() ([]byte, []int) {
+	return file_hdlctrl_v1_controller_proto_rawDescGZIP(), []int{3}
+}
+
+type MetricResolution int32
+
+const (
+	// リクエストでは範囲に合わせて自動で選ぶ
+	MetricResolution_METRIC_RESOLUTION_UNSPECIFIED MetricResolution = 0
+	// 記録したサンプルそのまま
+	MetricResolution_METRIC_RESOLUTION_RAW    MetricResolution = 1
+	MetricResolution_METRIC_RESOLUTION_MINUTE MetricResolution = 2
+	MetricResolution_METRIC_RESOLUTION_HOUR   MetricResolution = 3
+)
+
+// Enum value maps for MetricResolution.
+var (
+	MetricResolution_name = map[int32]string{
+		0: "METRIC_RESOLUTION_UNSPECIFIED",
+		1: "METRIC_RESOLUTION_RAW",
+		2: "METRIC_RESOLUTION_MINUTE",
+		3: "METRIC_RESOLUTION_HOUR",
+	}
+	MetricResolution_value = map[string]int32{
+		"METRIC_RESOLUTION_UNSPECIFIED": 0,
+		"METRIC_RESOLUTION_RAW":         1,
+		"METRIC_RESOLUTION_MINUTE":      2,
+		"METRIC_RESOLUTION_HOUR":        3,
+	}
+)
+
+func (x MetricResolution) Enum() *MetricResolution {
+	p := new(MetricResolution)
+	*p = x
+	return p
+}
+
+func (x MetricResolution) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (MetricResolution) Descriptor() protoreflect.EnumDescriptor {
+	return file_hdlctrl_v1_controller_proto_enumTypes[4].Descriptor()
+}
+
+func (MetricResolution) Type() protoreflect.EnumType {
+	return &file_hdlctrl_v1_controller_proto_enumTypes[4]
+}
+
+func (x MetricResolution) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use MetricResolution.Descriptor instead.
+func (MetricResolution) EnumDescriptor() ([]byte, []int) {
+	return file_hdlctrl_v1_controller_proto_rawDescGZIP(), []int{4}
+}
+
 type HeadlessHostStatus int32
 
 const (
@@ -65,11 +330,11 @@ func (x HeadlessHostStatus) String() string {
 }
 
 func (HeadlessHostStatus) Descriptor() protoreflect.EnumDescriptor {
-	return file_hdlctrl_v1_controller_proto_enumTypes[0].Descriptor()
+	return file_hdlctrl_v1_controller_proto_enumTypes[5].Descriptor()
 }
 
 func (HeadlessHostStatus) Type() protoreflect.EnumType {
-	return &file_hdlctrl_v1_controller_proto_enumTypes[0]
+	return &file_hdlctrl_v1_controller_proto_enumTypes[5]
 }
 
 func (x HeadlessHostStatus) Number() protoreflect.EnumNumber {
@@ -78,7 +343,112 @@ func (x HeadlessHostStatus) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use HeadlessHostStatus.Descriptor instead.
 func (HeadlessHostStatus) EnumDescriptor() ([]byte, []int) {
-	return file_hdlctrl_v1_controller_proto_rawDescGZIP(), []int{0}
+	return file_hdlctrl_v1_controller_proto_rawDescGZIP(), []int{5}
+}
+
+// ホストのログのレベル. container_logs はレベルを持たないため、stderr への出力や
+// 本文の "ERROR" / "Exception" / "WARNING" などから推定する
+type HeadlessHostLogLevel int32
+
+const (
+	HeadlessHostLogLevel_HEADLESS_HOST_LOG_LEVEL_UNKNOWN HeadlessHostLogLevel = 0
+	HeadlessHostLogLevel_HEADLESS_HOST_LOG_LEVEL_INFO    HeadlessHostLogLevel = 1
+	HeadlessHostLogLevel_HEADLESS_HOST_LOG_LEVEL_WARNING HeadlessHostLogLevel = 2
+	HeadlessHostLogLevel_HEADLESS_HOST_LOG_LEVEL_ERROR   HeadlessHostLogLevel = 3
+)
+
+// Enum value maps for HeadlessHostLogLevel.
+var (
+	HeadlessHostLogLevel_name = map[int32]string{
+		0: "HEADLESS_HOST_LOG_LEVEL_UNKNOWN",
+		1: "HEADLESS_HOST_LOG_LEVEL_INFO",
+		2: "HEADLESS_HOST_LOG_LEVEL_WARNING",
+		3: "HEADLESS_HOST_LOG_LEVEL_ERROR",
+	}
+	HeadlessHostLogLevel_value = map[string]int32{
+		"HEADLESS_HOST_LOG_LEVEL_UNKNOWN": 0,
+		"HEADLESS_HOST_LOG_LEVEL_INFO":    1,
+		"HEADLESS_HOST_LOG_LEVEL_WARNING": 2,
+		"HEADLESS_HOST_LOG_LEVEL_ERROR":   3,
+	}
+)
+
+func (x HeadlessHostLogLevel) Enum() *HeadlessHostLogLevel {
+	p := new(HeadlessHostLogLevel)
+	*p = x
+	return p
+}
+
+func (x HeadlessHostLogLevel) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (HeadlessHostLogLevel) Descriptor() protoreflect.EnumDescriptor {
+	return file_hdlctrl_v1_controller_proto_enumTypes[6].Descriptor()
+}
+
+func (HeadlessHostLogLevel) Type() protoreflect.EnumType {
+	return &file_hdlctrl_v1_controller_proto_enumTypes[6]
+}
+
+func (x HeadlessHostLogLevel) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use HeadlessHostLogLevel.Descriptor instead.
+func (HeadlessHostLogLevel) EnumDescriptor() ([]byte, []int) {
+	return file_hdlctrl_v1_controller_proto_rawDescGZIP(), []int{6}
+}
+
+type HeadlessHostLogExportFormat int32
+
+const (
+	HeadlessHostLogExportFormat_HEADLESS_HOST_LOG_EXPORT_FORMAT_UNSPECIFIED HeadlessHostLogExportFormat = 0
+	// 1 行に 1 ログ: "<RFC 3339 の時刻> [stdout|stderr] <本文>"
+	HeadlessHostLogExportFormat_HEADLESS_HOST_LOG_EXPORT_FORMAT_TEXT HeadlessHostLogExportFormat = 1
+	// 1 行に 1 ログの JSON: {"id", "timestamp", "stream", "level", "log"}
+	HeadlessHostLogExportFormat_HEADLESS_HOST_LOG_EXPORT_FORMAT_NDJSON HeadlessHostLogExportFormat = 2
+)
+
+// Enum value maps for HeadlessHostLogExportFormat.
+var (
+	HeadlessHostLogExportFormat_name = map[int32]string{
+		0: "HEADLESS_HOST_LOG_EXPORT_FORMAT_UNSPECIFIED",
+		1: "HEADLESS_HOST_LOG_EXPORT_FORMAT_TEXT",
+		2: "HEADLESS_HOST_LOG_EXPORT_FORMAT_NDJSON",
+	}
+	HeadlessHostLogExportFormat_value = map[string]int32{
+		"HEADLESS_HOST_LOG_EXPORT_FORMAT_UNSPECIFIED": 0,
+		"HEADLESS_HOST_LOG_EXPORT_FORMAT_TEXT":        1,
+		"HEADLESS_HOST_LOG_EXPORT_FORMAT_NDJSON":      2,
+	}
+)
+
+func (x HeadlessHostLogExportFormat) Enum() *HeadlessHostLogExportFormat {
+	p := new(HeadlessHostLogExportFormat)
+	*p = x
+	return p
+}
+
+func (x HeadlessHostLogExportFormat) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (HeadlessHostLogExportFormat) Descriptor() protoreflect.EnumDescriptor {
+	return file_hdlctrl_v1_controller_proto_enumTypes[7].Descriptor()
+}
+
+func (HeadlessHostLogExportFormat) Type() protoreflect.EnumType {
+	return &file_hdlctrl_v1_controller_proto_enumTypes[7]
+}
+
+func (x HeadlessHostLogExportFormat) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use HeadlessHostLogExportFormat.Descriptor instead.
+func (HeadlessHostLogExportFormat) EnumDescriptor() ([]byte, []int) {
+	return file_hdlctrl_v1_controller_proto_rawDescGZIP(), []int{7}
 }
 
 type SessionStatus int32
@@ -120,11 +490,11 @@ func (x SessionStatus) String() string {
 }
 
 func (SessionStatus) Descriptor() protoreflect.EnumDescriptor {
-	return file_hdlctrl_v1_controller_proto_enumTypes[1].Descriptor()
+	return file_hdlctrl_v1_controller_proto_enumTypes[8].Descriptor()
 }
 
 func (SessionStatus) Type() protoreflect.EnumType {
-	return &file_hdlctrl_v1_controller_proto_enumTypes[1]
+	return &file_hdlctrl_v1_controller_proto_enumTypes[8]
 }
 
 func (x SessionStatus) Number() protoreflect.EnumNumber {
@@ -133,7 +503,7 @@ func (x SessionStatus) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use SessionStatus.Descriptor instead.
 func (SessionStatus) EnumDescriptor() ([]byte, []int) {
-	return file_hdlctrl_v1_controller_proto_rawDescGZIP(), []int{1}
+	return file_hdlctrl_v1_controller_proto_rawDescGZIP(), []int{8}
 }
 
 type HeadlessHostAutoUpdatePolicy int32
@@ -169,11 +539,11 @@ func (x HeadlessHostAutoUpdatePolicy) String() string {
 }
 
 func (HeadlessHostAutoUpdatePolicy) Descriptor() protoreflect.EnumDescriptor {
-	return file_hdlctrl_v1_controller_proto_enumTypes[2].Descriptor()
+	return file_hdlctrl_v1_controller_proto_enumTypes[9].Descriptor()
 }
 
 func (HeadlessHostAutoUpdatePolicy) Type() protoreflect.EnumType {
-	return &file_hdlctrl_v1_controller_proto_enumTypes[2]
+	return &file_hdlctrl_v1_controller_proto_enumTypes[9]
 }
 
 func (x HeadlessHostAutoUpdatePolicy) Number() protoreflect.EnumNumber {
@@ -182,7 +552,116 @@ func (x HeadlessHostAutoUpdatePolicy) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use HeadlessHostAutoUpdatePolicy.Descriptor instead.
 func (HeadlessHostAutoUpdatePolicy) EnumDescriptor() ([]byte, []int) {
-	return file_hdlctrl_v1_controller_proto_rawDescGZIP(), []int{2}
+	return file_hdlctrl_v1_controller_proto_rawDescGZIP(), []int{9}
+}
+
+// コントローラによるクラッシュ時の自動再起動. コンテナランタイムの再起動 (HeadlessHostRestartPolicy) と違い、
+// ワールドを復元しつつ HeadlessHostRestart と同じ手順で再起動する.
+type HeadlessHostAutoRestartPolicy int32
+
+const (
+	HeadlessHostAutoRestartPolicy_HEADLESS_HOST_AUTO_RESTART_POLICY_UNKNOWN  HeadlessHostAutoRestartPolicy = 0 // 未指定 (NEVER と同じ)
+	HeadlessHostAutoRestartPolicy_HEADLESS_HOST_AUTO_RESTART_POLICY_NEVER    HeadlessHostAutoRestartPolicy = 1
+	HeadlessHostAutoRestartPolicy_HEADLESS_HOST_AUTO_RESTART_POLICY_ON_CRASH HeadlessHostAutoRestartPolicy = 2 // 異常終了時のみ再起動する. 連続回数は auto_restart_max_retries で制限
+	HeadlessHostAutoRestartPolicy_HEADLESS_HOST_AUTO_RESTART_POLICY_ALWAYS   HeadlessHostAutoRestartPolicy = 3 // 停止操作を経ずに終了した場合も再起動する
+)
+
+// Enum value maps for HeadlessHostAutoRestartPolicy.
+var (
+	HeadlessHostAutoRestartPolicy_name = map[int32]string{
+		0: "HEADLESS_HOST_AUTO_RESTART_POLICY_UNKNOWN",
+		1: "HEADLESS_HOST_AUTO_RESTART_POLICY_NEVER",
+		2: "HEADLESS_HOST_AUTO_RESTART_POLICY_ON_CRASH",
+		3: "HEADLESS_HOST_AUTO_RESTART_POLICY_ALWAYS",
+	}
+	HeadlessHostAutoRestartPolicy_value = map[string]int32{
+		"HEADLESS_HOST_AUTO_RESTART_POLICY_UNKNOWN":  0,
+		"HEADLESS_HOST_AUTO_RESTART_POLICY_NEVER":    1,
+		"HEADLESS_HOST_AUTO_RESTART_POLICY_ON_CRASH": 2,
+		"HEADLESS_HOST_AUTO_RESTART_POLICY_ALWAYS":   3,
+	}
+)
+
+func (x HeadlessHostAutoRestartPolicy) Enum() *HeadlessHostAutoRestartPolicy {
+	p := new(HeadlessHostAutoRestartPolicy)
+	*p = x
+	return p
+}
+
+func (x HeadlessHostAutoRestartPolicy) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (HeadlessHostAutoRestartPolicy) Descriptor() protoreflect.EnumDescriptor {
+	return file_hdlctrl_v1_controller_proto_enumTypes[10].Descriptor()
+}
+
+func (HeadlessHostAutoRestartPolicy) Type() protoreflect.EnumType {
+	return &file_hdlctrl_v1_controller_proto_enumTypes[10]
+}
+
+func (x HeadlessHostAutoRestartPolicy) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use HeadlessHostAutoRestartPolicy.Descriptor instead.
+func (HeadlessHostAutoRestartPolicy) EnumDescriptor() ([]byte, []int) {
+	return file_hdlctrl_v1_controller_proto_rawDescGZIP(), []int{10}
+}
+
+type HeadlessHostRestartPolicy int32
+
+const (
+	HeadlessHostRestartPolicy_HEADLESS_HOST_RESTART_POLICY_UNKNOWN        HeadlessHostRestartPolicy = 0 // 未指定 (NO と同じ)
+	HeadlessHostRestartPolicy_HEADLESS_HOST_RESTART_POLICY_NO             HeadlessHostRestartPolicy = 1
+	HeadlessHostRestartPolicy_HEADLESS_HOST_RESTART_POLICY_ON_FAILURE     HeadlessHostRestartPolicy = 2 // 異常終了時のみ再起動する. 回数は restart_max_retries で制限
+	HeadlessHostRestartPolicy_HEADLESS_HOST_RESTART_POLICY_ALWAYS         HeadlessHostRestartPolicy = 3
+	HeadlessHostRestartPolicy_HEADLESS_HOST_RESTART_POLICY_UNLESS_STOPPED HeadlessHostRestartPolicy = 4
+)
+
+// Enum value maps for HeadlessHostRestartPolicy.
+var (
+	HeadlessHostRestartPolicy_name = map[int32]string{
+		0: "HEADLESS_HOST_RESTART_POLICY_UNKNOWN",
+		1: "HEADLESS_HOST_RESTART_POLICY_NO",
+		2: "HEADLESS_HOST_RESTART_POLICY_ON_FAILURE",
+		3: "HEADLESS_HOST_RESTART_POLICY_ALWAYS",
+		4: "HEADLESS_HOST_RESTART_POLICY_UNLESS_STOPPED",
+	}
+	HeadlessHostRestartPolicy_value = map[string]int32{
+		"HEADLESS_HOST_RESTART_POLICY_UNKNOWN":        0,
+		"HEADLESS_HOST_RESTART_POLICY_NO":             1,
+		"HEADLESS_HOST_RESTART_POLICY_ON_FAILURE":     2,
+		"HEADLESS_HOST_RESTART_POLICY_ALWAYS":         3,
+		"HEADLESS_HOST_RESTART_POLICY_UNLESS_STOPPED": 4,
+	}
+)
+
+func (x HeadlessHostRestartPolicy) Enum() *HeadlessHostRestartPolicy {
+	p := new(HeadlessHostRestartPolicy)
+	*p = x
+	return p
+}
+
+func (x HeadlessHostRestartPolicy) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (HeadlessHostRestartPolicy) Descriptor() protoreflect.EnumDescriptor {
+	return file_hdlctrl_v1_controller_proto_enumTypes[11].Descriptor()
+}
+
+func (HeadlessHostRestartPolicy) Type() protoreflect.EnumType {
+	return &file_hdlctrl_v1_controller_proto_enumTypes[11]
+}
+
+func (x HeadlessHostRestartPolicy) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use HeadlessHostRestartPolicy.Descriptor instead.
+func (HeadlessHostRestartPolicy) EnumDescriptor() ([]byte, []int) {
+	return file_hdlctrl_v1_controller_proto_rawDescGZIP(), []int{11}
 }
 
 type ScheduledOperationStatus int32
@@ -194,6 +673,7 @@ const (
 	ScheduledOperationStatus_SCHEDULED_OPERATION_STATUS_SUCCEEDED   ScheduledOperationStatus = 3
 	ScheduledOperationStatus_SCHEDULED_OPERATION_STATUS_FAILED      ScheduledOperationStatus = 4
 	ScheduledOperationStatus_SCHEDULED_OPERATION_STATUS_CANCELED    ScheduledOperationStatus = 5
+	ScheduledOperationStatus_SCHEDULED_OPERATION_STATUS_PAUSED      ScheduledOperationStatus = 6
 )
 
 // Enum value maps for ScheduledOperationStatus.
@@ -205,6 +685,7 @@ var (
 		3: "SCHEDULED_OPERATION_STATUS_SUCCEEDED",
 		4: "SCHEDULED_OPERATION_STATUS_FAILED",
 		5: "SCHEDULED_OPERATION_STATUS_CANCELED",
+		6: "SCHEDULED_OPERATION_STATUS_PAUSED",
 	}
 	ScheduledOperationStatus_value = map[string]int32{
 		"SCHEDULED_OPERATION_STATUS_UNSPECIFIED": 0,
@@ -213,6 +694,7 @@ var (
 		"SCHEDULED_OPERATION_STATUS_SUCCEEDED":   3,
 		"SCHEDULED_OPERATION_STATUS_FAILED":      4,
 		"SCHEDULED_OPERATION_STATUS_CANCELED":    5,
+		"SCHEDULED_OPERATION_STATUS_PAUSED":      6,
 	}
 )
 
@@ -227,11 +709,11 @@ func (x ScheduledOperationStatus) String() string {
 }
 
 func (ScheduledOperationStatus) Descriptor() protoreflect.EnumDescriptor {
-	return file_hdlctrl_v1_controller_proto_enumTypes[3].Descriptor()
+	return file_hdlctrl_v1_controller_proto_enumTypes[12].Descriptor()
 }
 
 func (ScheduledOperationStatus) Type() protoreflect.EnumType {
-	return &file_hdlctrl_v1_controller_proto_enumTypes[3]
+	return &file_hdlctrl_v1_controller_proto_enumTypes[12]
 }
 
 func (x ScheduledOperationStatus) Number() protoreflect.EnumNumber {
@@ -240,7 +722,62 @@ func (x ScheduledOperationStatus) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use ScheduledOperationStatus.Descriptor instead.
 func (ScheduledOperationStatus) EnumDescriptor() ([]byte, []int) {
-	return file_hdlctrl_v1_controller_proto_rawDescGZIP(), []int{3}
+	return file_hdlctrl_v1_controller_proto_rawDescGZIP(), []int{12}
+}
+
+type ScheduledOperationRunOutcome int32
+
+const (
+	ScheduledOperationRunOutcome_SCHEDULED_OPERATION_RUN_OUTCOME_UNSPECIFIED ScheduledOperationRunOutcome = 0
+	ScheduledOperationRunOutcome_SCHEDULED_OPERATION_RUN_OUTCOME_SUCCEEDED   ScheduledOperationRunOutcome = 1
+	ScheduledOperationRunOutcome_SCHEDULED_OPERATION_RUN_OUTCOME_FAILED      ScheduledOperationRunOutcome = 2
+	ScheduledOperationRunOutcome_SCHEDULED_OPERATION_RUN_OUTCOME_SKIPPED     ScheduledOperationRunOutcome = 3 // 利用者がスキップした
+	ScheduledOperationRunOutcome_SCHEDULED_OPERATION_RUN_OUTCOME_MISSED      ScheduledOperationRunOutcome = 4 // controller 停止中などで発火時刻を大きく過ぎたため実行しなかった
+)
+
+// Enum value maps for ScheduledOperationRunOutcome.
+var (
+	ScheduledOperationRunOutcome_name = map[int32]string{
+		0: "SCHEDULED_OPERATION_RUN_OUTCOME_UNSPECIFIED",
+		1: "SCHEDULED_OPERATION_RUN_OUTCOME_SUCCEEDED",
+		2: "SCHEDULED_OPERATION_RUN_OUTCOME_FAILED",
+		3: "SCHEDULED_OPERATION_RUN_OUTCOME_SKIPPED",
+		4: "SCHEDULED_OPERATION_RUN_OUTCOME_MISSED",
+	}
+	ScheduledOperationRunOutcome_value = map[string]int32{
+		"SCHEDULED_OPERATION_RUN_OUTCOME_UNSPECIFIED": 0,
+		"SCHEDULED_OPERATION_RUN_OUTCOME_SUCCEEDED":   1,
+		"SCHEDULED_OPERATION_RUN_OUTCOME_FAILED":      2,
+		"SCHEDULED_OPERATION_RUN_OUTCOME_SKIPPED":     3,
+		"SCHEDULED_OPERATION_RUN_OUTCOME_MISSED":      4,
+	}
+)
+
+func (x ScheduledOperationRunOutcome) Enum() *ScheduledOperationRunOutcome {
+	p := new(ScheduledOperationRunOutcome)
+	*p = x
+	return p
+}
+
+func (x ScheduledOperationRunOutcome) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ScheduledOperationRunOutcome) Descriptor() protoreflect.EnumDescriptor {
+	return file_hdlctrl_v1_controller_proto_enumTypes[13].Descriptor()
+}
+
+func (ScheduledOperationRunOutcome) Type() protoreflect.EnumType {
+	return &file_hdlctrl_v1_controller_proto_enumTypes[13]
+}
+
+func (x ScheduledOperationRunOutcome) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ScheduledOperationRunOutcome.Descriptor instead.
+func (ScheduledOperationRunOutcome) EnumDescriptor() ([]byte, []int) {
+	return file_hdlctrl_v1_controller_proto_rawDescGZIP(), []int{13}
 }
 
 type SaveSessionWorldRequest_SaveMode int32
@@ -279,11 +816,11 @@ func (x SaveSessionWorldRequest_SaveMode) String() string {
 }
 
 func (SaveSessionWorldRequest_SaveMode) Descriptor() protoreflect.EnumDescriptor {
-	return file_hdlctrl_v1_controller_proto_enumTypes[4].Descriptor()
+	return file_hdlctrl_v1_controller_proto_enumTypes[14].Descriptor()
 }
 
 func (SaveSessionWorldRequest_SaveMode) Type() protoreflect.EnumType {
-	return &file_hdlctrl_v1_controller_proto_enumTypes[4]
+	return &file_hdlctrl_v1_controller_proto_enumTypes[14]
 }
 
 func (x SaveSessionWorldRequest_SaveMode) Number() protoreflect.EnumNumber {
@@ -292,7 +829,7 @@ func (x SaveSessionWorldRequest_SaveMode) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use SaveSessionWorldRequest_SaveMode.Descriptor instead.
 func (SaveSessionWorldRequest_SaveMode) EnumDescriptor() ([]byte, []int) {
-	return file_hdlctrl_v1_controller_proto_rawDescGZIP(), []int{68, 0}
+	return file_hdlctrl_v1_controller_proto_rawDescGZIP(), []int{89, 0}
 }
 
 type SessionUserCountTrigger_Comparator int32
@@ -328,11 +865,11 @@ func (x SessionUserCountTrigger_Comparator) String() string {
 }
 
 func (SessionUserCountTrigger_Comparator) Descriptor() protoreflect.EnumDescriptor {
-	return file_hdlctrl_v1_controller_proto_enumTypes[5].Descriptor()
+	return file_hdlctrl_v1_controller_proto_enumTypes[15].Descriptor()
 }
 
 func (SessionUserCountTrigger_Comparator) Type() protoreflect.EnumType {
-	return &file_hdlctrl_v1_controller_proto_enumTypes[5]
+	return &file_hdlctrl_v1_controller_proto_enumTypes[15]
 }
 
 func (x SessionUserCountTrigger_Comparator) Number() protoreflect.EnumNumber {
@@ -341,7 +878,7 @@ func (x SessionUserCountTrigger_Comparator) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use SessionUserCountTrigger_Comparator.Descriptor instead.
 func (SessionUserCountTrigger_Comparator) EnumDescriptor() ([]byte, []int) {
-	return file_hdlctrl_v1_controller_proto_rawDescGZIP(), []int{101, 0}
+	return file_hdlctrl_v1_controller_proto_rawDescGZIP(), []int{146, 0}
 }
 
 type RefetchHeadlessAccountInfoRequest struct {
@@ -1147,7 +1684,18 @@ type StartHeadlessHostRequest struct {
 	Memo              *string                       `protobuf:"bytes,6,opt,name=memo,proto3,oneof" json:"memo,omitempty"`
 	// 起動するホストの所属グループ. 未指定の場合は呼び出しユーザーの personal グループ.
 	// 指定する場合は account の group_id と一致する必要がある (同一グループ制約).
-	GroupId       *string `protobuf:"bytes,7,opt,name=group_id,json=groupId,proto3,oneof" json:"group_id,omitempty"`
+	GroupId *string `protobuf:"bytes,7,opt,name=group_id,json=groupId,proto3,oneof" json:"group_id,omitempty"`
+	// 起動先の docker node ID ("local" はコントローラ自身の Docker).
+	// 未指定なら空いている node が自動で選ばれる.
+	NodeId *string `protobuf:"bytes,8,opt,name=node_id,json=nodeId,proto3,oneof" json:"node_id,omitempty"`
+	// コンテナのリソース制限など. 未指定なら無制限.
+	ContainerSettings     *HeadlessHostContainerSettings `protobuf:"bytes,9,opt,name=container_settings,json=containerSettings,proto3,oneof" json:"container_settings,omitempty"`
+	AutoRestartPolicy     *HeadlessHostAutoRestartPolicy `protobuf:"varint,10,opt,name=auto_restart_policy,json=autoRestartPolicy,proto3,enum=hdlctrl.v1.HeadlessHostAutoRestartPolicy,oneof" json:"auto_restart_policy,omitempty"`
+	AutoRestartMaxRetries *int32                         `protobuf:"varint,11,opt,name=auto_restart_max_retries,json=autoRestartMaxRetries,proto3,oneof" json:"auto_restart_max_retries,omitempty"`
+	// 指定した場合、省略した項目 (image_tag / startup_config / auto_update_policy / memo /
+	// container_settings / auto_restart_policy / auto_restart_max_retries) をテンプレートから埋める.
+	// テンプレートはホストと同じグループのものである必要がある.
+	TemplateId    *string `protobuf:"bytes,12,opt,name=template_id,json=templateId,proto3,oneof" json:"template_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -1231,6 +1779,41 @@ func (x *StartHeadlessHostRequest) GetGroupId() string {
 	return ""
 }
 
+func (x *StartHeadlessHostRequest) GetNodeId() string {
+	if x != nil && x.NodeId != nil {
+		return *x.NodeId
+	}
+	return ""
+}
+
+func (x *StartHeadlessHostRequest) GetContainerSettings() *HeadlessHostContainerSettings {
+	if x != nil {
+		return x.ContainerSettings
+	}
+	return nil
+}
+
+func (x *StartHeadlessHostRequest) GetAutoRestartPolicy() HeadlessHostAutoRestartPolicy {
+	if x != nil && x.AutoRestartPolicy != nil {
+		return *x.AutoRestartPolicy
+	}
+	return HeadlessHostAutoRestartPolicy_HEADLESS_HOST_AUTO_RESTART_POLICY_UNKNOWN
+}
+
+func (x *StartHeadlessHostRequest) GetAutoRestartMaxRetries() int32 {
+	if x != nil && x.AutoRestartMaxRetries != nil {
+		return *x.AutoRestartMaxRetries
+	}
+	return 0
+}
+
+func (x *StartHeadlessHostRequest) GetTemplateId() string {
+	if x != nil && x.TemplateId != nil {
+		return *x.TemplateId
+	}
+	return ""
+}
+
 type StartHeadlessHostResponse struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// 非同期 job の ID. クライアントは notification.JobCompletedEvent でこの ID を
@@ -1277,6 +1860,131 @@ func (x *StartHeadlessHostResponse) GetJobId() string {
 	return ""
 }
 
+// ホスト設定 (HeadlessHostSettings)・自動更新ポリシー・メモ・コンテナ設定・自動再起動ポリシーを
+// コピーする. start_worlds とイメージタグはコピーしない.
+type CloneHeadlessHostRequest struct {
+	state        protoimpl.MessageState `protogen:"open.v1"`
+	SourceHostId string                 `protobuf:"bytes,1,opt,name=source_host_id,json=sourceHostId,proto3" json:"source_host_id,omitempty"`
+	// 未指定ならコピー元の名前に「のコピー」を付ける
+	Name *string `protobuf:"bytes,2,opt,name=name,proto3,oneof" json:"name,omitempty"`
+	// 未指定ならコピー元と同じアカウント. コピー元と同じグループのアカウントである必要がある
+	HeadlessAccountId *string `protobuf:"bytes,3,opt,name=headless_account_id,json=headlessAccountId,proto3,oneof" json:"headless_account_id,omitempty"`
+	ImageTag          *string `protobuf:"bytes,4,opt,name=image_tag,json=imageTag,proto3,oneof" json:"image_tag,omitempty"`
+	NodeId            *string `protobuf:"bytes,5,opt,name=node_id,json=nodeId,proto3,oneof" json:"node_id,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *CloneHeadlessHostRequest) Reset() {
+	*x = CloneHeadlessHostRequest{}
+	mi := &file_hdlctrl_v1_controller_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CloneHeadlessHostRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CloneHeadlessHostRequest) ProtoMessage() {}
+
+func (x *CloneHeadlessHostRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_hdlctrl_v1_controller_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CloneHeadlessHostRequest.ProtoReflect.Descriptor instead.
+func (*CloneHeadlessHostRequest) Descriptor() ([]byte, []int) {
+	return file_hdlctrl_v1_controller_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *CloneHeadlessHostRequest) GetSourceHostId() string {
+	if x != nil {
+		return x.SourceHostId
+	}
+	return ""
+}
+
+func (x *CloneHeadlessHostRequest) GetName() string {
+	if x != nil && x.Name != nil {
+		return *x.Name
+	}
+	return ""
+}
+
+func (x *CloneHeadlessHostRequest) GetHeadlessAccountId() string {
+	if x != nil && x.HeadlessAccountId != nil {
+		return *x.HeadlessAccountId
+	}
+	return ""
+}
+
+func (x *CloneHeadlessHostRequest) GetImageTag() string {
+	if x != nil && x.ImageTag != nil {
+		return *x.ImageTag
+	}
+	return ""
+}
+
+func (x *CloneHeadlessHostRequest) GetNodeId() string {
+	if x != nil && x.NodeId != nil {
+		return *x.NodeId
+	}
+	return ""
+}
+
+type CloneHeadlessHostResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// StartHeadlessHost と同じ非同期 job の ID
+	JobId         string `protobuf:"bytes,1,opt,name=job_id,json=jobId,proto3" json:"job_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CloneHeadlessHostResponse) Reset() {
+	*x = CloneHeadlessHostResponse{}
+	mi := &file_hdlctrl_v1_controller_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CloneHeadlessHostResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CloneHeadlessHostResponse) ProtoMessage() {}
+
+func (x *CloneHeadlessHostResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_hdlctrl_v1_controller_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CloneHeadlessHostResponse.ProtoReflect.Descriptor instead.
+func (*CloneHeadlessHostResponse) Descriptor() ([]byte, []int) {
+	return file_hdlctrl_v1_controller_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *CloneHeadlessHostResponse) GetJobId() string {
+	if x != nil {
+		return x.JobId
+	}
+	return ""
+}
+
 type CreateHeadlessAccountRequest struct {
 	state      protoimpl.MessageState `protogen:"open.v1"`
 	Credential string                 `protobuf:"bytes,2,opt,name=credential,proto3" json:"credential,omitempty"` // email or userId
@@ -1289,7 +1997,7 @@ type CreateHeadlessAccountRequest struct {
 
 func (x *CreateHeadlessAccountRequest) Reset() {
 	*x = CreateHeadlessAccountRequest{}
-	mi := &file_hdlctrl_v1_controller_proto_msgTypes[20]
+	mi := &file_hdlctrl_v1_controller_proto_msgTypes[22]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1301,7 +2009,7 @@ func (x *CreateHeadlessAccountRequest) String() string {
 func (*CreateHeadlessAccountRequest) ProtoMessage() {}
 
 func (x *CreateHeadlessAccountRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_hdlctrl_v1_controller_proto_msgTypes[20]
+	mi := &file_hdlctrl_v1_controller_proto_msgTypes[22]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1314,7 +2022,7 @@ func (x *CreateHeadlessAccountRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateHeadlessAccountRequest.ProtoReflect.Descriptor instead.
 func (*CreateHeadlessAccountRequest) Descriptor() ([]byte, []int) {
-	return file_hdlctrl_v1_controller_proto_rawDescGZIP(), []int{20}
+	return file_hdlctrl_v1_controller_proto_rawDescGZIP(), []int{22}
 }
 
 func (x *CreateHeadlessAccountRequest) GetCredential() string {
@@ -1346,7 +2054,7 @@ type CreateHeadlessAccountResponse struct {
 
 func (x *CreateHeadlessAccountResponse) Reset() {
 	*x = CreateHeadlessAccountResponse{}
-	mi := &file_hdlctrl_v1_controller_proto_msgTypes[21]
+	mi := &file_hdlctrl_v1_controller_proto_msgTypes[23]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1358,7 +2066,7 @@ func (x *CreateHeadlessAccountResponse) String() string {
 func (*CreateHeadlessAccountResponse) ProtoMessage() {}
 
 func (x *CreateHeadlessAccountResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_hdlctrl_v1_controller_proto_msgTypes[21]
+	mi := &file_hdlctrl_v1_controller_proto_msgTypes[23]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1371,7 +2079,7 @@ func (x *CreateHeadlessAccountResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateHeadlessAccountResponse.ProtoReflect.Descriptor instead.
 func (*CreateHeadlessAccountResponse) Descriptor() ([]byte, []int) {
-	return file_hdlctrl_v1_controller_proto_rawDescGZIP(), []int{21}
+	return file_hdlctrl_v1_controller_proto_rawDescGZIP(), []int{23}
 }
 
 type ListHeadlessAccountsRequest struct {
@@ -1387,7 +2095,7 @@ type ListHeadlessAccountsRequest struct {
 
 func (x *ListHeadlessAccountsRequest) Reset() {
 	*x = ListHeadlessAccountsRequest{}
-	mi := &file_hdlctrl_v1_controller_proto_msgTypes[22]
+	mi := &file_hdlctrl_v1_controller_proto_msgTypes[24]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1399,7 +2107,7 @@ func (x *ListHeadlessAccountsRequest) String() string {
 func (*ListHeadlessAccountsRequest) ProtoMessage() {}
 
 func (x *ListHeadlessAccountsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_hdlctrl_v1_controller_proto_msgTypes[22]
+	mi := &file_hdlctrl_v1_controller_proto_msgTypes[24]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1412,7 +2120,7 @@ func (x *ListHeadlessAccountsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListHeadlessAccountsRequest.ProtoReflect.Descriptor instead.
 func (*ListHeadlessAccountsRequest) Descriptor() ([]byte, []int) {
-	return file_hdlctrl_v1_controller_proto_rawDescGZIP(), []int{22}
+	return file_hdlctrl_v1_controller_proto_rawDescGZIP(), []int{24}
 }
 
 func (x *ListHeadlessAccountsRequest) GetPage() *PageRequest {
@@ -1439,7 +2147,7 @@ type ListHeadlessAccountsResponse struct {
 
 func (x *ListHeadlessAccountsResponse) Reset() {
 	*x = ListHeadlessAccountsResponse{}
-	mi := &file_hdlctrl_v1_controller_proto_msgTypes[23]
+	mi := &file_hdlctrl_v1_controller_proto_msgTypes[25]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1451,7 +2159,7 @@ func (x *ListHeadlessAccountsResponse) String() string {
 func (*ListHeadlessAccountsResponse) ProtoMessage() {}
 
 func (x *ListHeadlessAccountsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_hdlctrl_v1_controller_proto_msgTypes[23]
+	mi := &file_hdlctrl_v1_controller_proto_msgTypes[25]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1464,7 +2172,7 @@ func (x *ListHeadlessAccountsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListHeadlessAccountsResponse.ProtoReflect.Descriptor instead.
 func (*ListHeadlessAccountsResponse) Descriptor() ([]byte, []int) {
-	return file_hdlctrl_v1_controller_proto_rawDescGZIP(), []int{23}
+	return file_hdlctrl_v1_controller_proto_rawDescGZIP(), []int{25}
 }
 
 func (x *ListHeadlessAccountsResponse) GetAccounts() []*HeadlessAccount {
@@ -1489,7 +2197,7 @@ type ListHeadlessHostImageTagsRequest struct {
 
 func (x *ListHeadlessHostImageTagsRequest) Reset() {
 	*x = ListHeadlessHostImageTagsRequest{}
-	mi := &file_hdlctrl_v1_controller_proto_msgTypes[24]
+	mi := &file_hdlctrl_v1_controller_proto_msgTypes[26]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1501,7 +2209,7 @@ func (x *ListHeadlessHostImageTagsRequest) String() string {
 func (*ListHeadlessHostImageTagsRequest) ProtoMessage() {}
 
 func (x *ListHeadlessHostImageTagsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_hdlctrl_v1_controller_proto_msgTypes[24]
+	mi := &file_hdlctrl_v1_controller_proto_msgTypes[26]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1514,7 +2222,7 @@ func (x *ListHeadlessHostImageTagsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListHeadlessHostImageTagsRequest.ProtoReflect.Descriptor instead.
 func (*ListHeadlessHostImageTagsRequest) Descriptor() ([]byte, []int) {
-	return file_hdlctrl_v1_controller_proto_rawDescGZIP(), []int{24}
+	return file_hdlctrl_v1_controller_proto_rawDescGZIP(), []int{26}
 }
 
 type ListHeadlessHostImageTagsResponse struct {
@@ -1526,7 +2234,7 @@ type ListHeadlessHostImageTagsResponse struct {
 
 func (x *ListHeadlessHostImageTagsResponse) Reset() {
 	*x = ListHeadlessHostImageTagsResponse{}
-	mi := &file_hdlctrl_v1_controller_proto_msgTypes[25]
+	mi := &file_hdlctrl_v1_controller_proto_msgTypes[27]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1538,7 +2246,7 @@ func (x *ListHeadlessHostImageTagsResponse) String() string {
 func (*ListHeadlessHostImageTagsResponse) ProtoMessage() {}
 
 func (x *ListHeadlessHostImageTagsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_hdlctrl_v1_controller_proto_msgTypes[25]
+	mi := &file_hdlctrl_v1_controller_proto_msgTypes[27]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1551,7 +2259,7 @@ func (x *ListHeadlessHostImageTagsResponse) ProtoReflect() protoreflect.Message
 
 // Deprecated: Use ListHeadlessHostImageTagsResponse.ProtoReflect.Descriptor instead.
 func (*ListHeadlessHostImageTagsResponse) Descriptor() ([]byte, []int) {
-	return file_hdlctrl_v1_controller_proto_rawDescGZIP(), []int{25}
+	return file_hdlctrl_v1_controller_proto_rawDescGZIP(), []int{27}
 }
 
 func (x *ListHeadlessHostImageTagsResponse) GetTags() []*ListHeadlessHostImageTagsResponse_ContainerImage {
@@ -1571,7 +2279,7 @@ type AcceptFriendRequestsRequest struct {
 
 func (x *AcceptFriendRequestsRequest) Reset() {
 	*x = AcceptFriendRequestsRequest{}
-	mi := &file_hdlctrl_v1_controller_proto_msgTypes[26]
+	mi := &file_hdlctrl_v1_controller_proto_msgTypes[28]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1583,7 +2291,7 @@ func (x *AcceptFriendRequestsRequest) String() string {
 func (*AcceptFriendRequestsRequest) ProtoMessage() {}
 
 func (x *AcceptFriendRequestsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_hdlctrl_v1_controller_proto_msgTypes[26]
+	mi := &file_hdlctrl_v1_controller_proto_msgTypes[28]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1596,7 +2304,7 @@ func (x *AcceptFriendRequestsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AcceptFriendRequestsRequest.ProtoReflect.Descriptor instead.
 func (*AcceptFriendRequestsRequest) Descriptor() ([]byte, []int) {
-	return file_hdlctrl_v1_controller_proto_rawDescGZIP(), []int{26}
+	return file_hdlctrl_v1_controller_proto_rawDescGZIP(), []int{28}
 }
 
 func (x *AcceptFriendRequestsRequest) GetHeadlessAccountId() string {
@@ -1621,7 +2329,7 @@ type AcceptFriendRequestsResponse struct {
 
 func (x *AcceptFriendRequestsResponse) Reset() {
 	*x = AcceptFriendRequestsResponse{}
-	mi := &file_hdlctrl_v1_controller_proto_msgTypes[27]
+	mi := &file_hdlctrl_v1_controller_proto_msgTypes[29]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1633,7 +2341,7 @@ func (x *AcceptFriendRequestsResponse) String() string {
 func (*AcceptFriendRequestsResponse) ProtoMessage() {}
 
 func (x *AcceptFriendRequestsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_hdlctrl_v1_controller_proto_msgTypes[27]
+	mi := &file_hdlctrl_v1_controller_proto_msgTypes[29]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1646,7 +2354,7 @@ func (x *AcceptFriendRequestsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AcceptFriendRequestsResponse.ProtoReflect.Descriptor instead.
 func (*AcceptFriendRequestsResponse) Descriptor() ([]byte, []int) {
-	return file_hdlctrl_v1_controller_proto_rawDescGZIP(), []int{27}
+	return file_hdlctrl_v1_controller_proto_rawDescGZIP(), []int{29}
 }
 
 type GetFriendRequestsRequest struct {
@@ -1658,7 +2366,7 @@ type GetFriendRequestsRequest struct {
 
 func (x *GetFriendRequestsRequest) Reset() {
 	*x = GetFriendRequestsRequest{}
-	mi := &file_hdlctrl_v1_controller_proto_msgTypes[28]
+	mi := &file_hdlctrl_v1_controller_proto_msgTypes[30]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1670,7 +2378,7 @@ func (x *GetFriendRequestsRequest) String() string {
 func (*GetFriendRequestsRequest) ProtoMessage() {}
 
 func (x *GetFriendRequestsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_hdlctrl_v1_controller_proto_msgTypes[28]
+	mi := &file_hdlctrl_v1_controller_proto_msgTypes[30]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1683,7 +2391,7 @@ func (x *GetFriendRequestsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetFriendRequestsRequest.ProtoReflect.Descriptor instead.
 func (*GetFriendRequestsRequest) Descriptor() ([]byte, []int) {
-	return file_hdlctrl_v1_controller_proto_rawDescGZIP(), []int{28}
+	return file_hdlctrl_v1_controller_proto_rawDescGZIP(), []int{30}
 }
 
 func (x *GetFriendRequestsRequest) GetHeadlessAccountId() string {
@@ -1702,7 +2410,7 @@ type GetFriendRequestsResponse struct {
 
 func (x *GetFriendRequestsResponse) Reset() {
 	*x = GetFriendRequestsResponse{}
-	mi := &file_hdlctrl_v1_controller_proto_msgTypes[29]
+	mi := &file_hdlctrl_v1_controller_proto_msgTypes[31]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1714,7 +2422,7 @@ func (x *GetFriendRequestsResponse) String() string {
 func (*GetFriendRequestsResponse) ProtoMessage() {}
 
 func (x *GetFriendRequestsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_hdlctrl_v1_controller_proto_msgTypes[29]
+	mi := &file_hdlctrl_v1_controller_proto_msgTypes[31]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1727,7 +2435,7 @@ func (x *GetFriendRequestsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetFriendRequestsResponse.ProtoReflect.Descriptor instead.
 func (*GetFriendRequestsResponse) Descriptor() ([]byte, []int) {
-	return file_hdlctrl_v1_controller_proto_rawDescGZIP(), []int{29}
+	return file_hdlctrl_v1_controller_proto_rawDescGZIP(), []int{31}
 }
 
 func (x *GetFriendRequestsResponse) GetRequestedContacts() []*UserInfo {
@@ -1750,7 +2458,7 @@ type RestartHeadlessHostRequest struct {
 
 func (x *RestartHeadlessHostRequest) Reset() {
 	*x = RestartHeadlessHostRequest{}
-	mi := &file_hdlctrl_v1_controller_proto_msgTypes[30]
+	mi := &file_hdlctrl_v1_controller_proto_msgTypes[32]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1762,7 +2470,7 @@ func (x *RestartHeadlessHostRequest) String() string {
 func (*RestartHeadlessHostRequest) ProtoMessage() {}
 
 func (x *RestartHeadlessHostRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_hdlctrl_v1_controller_proto_msgTypes[30]
+	mi := &file_hdlctrl_v1_controller_proto_msgTypes[32]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1775,7 +2483,7 @@ func (x *RestartHeadlessHostRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RestartHeadlessHostRequest.ProtoReflect.Descriptor instead.
 func (*RestartHeadlessHostRequest) Descriptor() ([]byte, []int) {
-	return file_hdlctrl_v1_controller_proto_rawDescGZIP(), []int{30}
+	return file_hdlctrl_v1_controller_proto_rawDescGZIP(), []int{32}
 }
 
 func (x *RestartHeadlessHostRequest) GetHostId() string {
@@ -1822,7 +2530,7 @@ type RestartHeadlessHostResponse struct {
 
 func (x *RestartHeadlessHostResponse) Reset() {
 	*x = RestartHeadlessHostResponse{}
-	mi := &file_hdlctrl_v1_controller_proto_msgTypes[31]
+	mi := &file_hdlctrl_v1_controller_proto_msgTypes[33]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1834,7 +2542,7 @@ func (x *RestartHeadlessHostResponse) String() string {
 func (*RestartHeadlessHostResponse) ProtoMessage() {}
 
 func (x *RestartHeadlessHostResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_hdlctrl_v1_controller_proto_msgTypes[31]
+	mi := &file_hdlctrl_v1_controller_proto_msgTypes[33]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1847,7 +2555,7 @@ func (x *RestartHeadlessHostResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RestartHeadlessHostResponse.ProtoReflect.Descriptor instead.
 func (*RestartHeadlessHostResponse) Descriptor() ([]byte, []int) {
-	return file_hdlctrl_v1_controller_proto_rawDescGZIP(), []int{31}
+	return file_hdlctrl_v1_controller_proto_rawDescGZIP(), []int{33}
 }
 
 func (x *RestartHeadlessHostResponse) GetJobId() string {
@@ -1868,13 +2576,18 @@ type UpdateHeadlessHostSettingsRequest struct {
 	AutoSpawnItems              []string                      `protobuf:"bytes,7,rep,name=auto_spawn_items,json=autoSpawnItems,proto3" json:"auto_spawn_items,omitempty"`
 	UniverseId                  *string                       `protobuf:"bytes,8,opt,name=universe_id,json=universeId,proto3,oneof" json:"universe_id,omitempty"`
 	AutoUpdatePolicy            *HeadlessHostAutoUpdatePolicy `protobuf:"varint,9,opt,name=auto_update_policy,json=autoUpdatePolicy,proto3,enum=hdlctrl.v1.HeadlessHostAutoUpdatePolicy,oneof" json:"auto_update_policy,omitempty"`
-	unknownFields               protoimpl.UnknownFields
-	sizeCache                   protoimpl.SizeCache
+	// 指定すると丸ごと置き換える. 稼働中のコンテナには反映されず、次回の起動/再起動から適用される.
+	ContainerSettings *HeadlessHostContainerSettings `protobuf:"bytes,10,opt,name=container_settings,json=containerSettings,proto3,oneof" json:"container_settings,omitempty"`
+	// 変更すると連続再起動回数がリセットされ、crash loop で止まっていた自動再起動も再開する.
+	AutoRestartPolicy     *HeadlessHostAutoRestartPolicy `protobuf:"varint,11,opt,name=auto_restart_policy,json=autoRestartPolicy,proto3,enum=hdlctrl.v1.HeadlessHostAutoRestartPolicy,oneof" json:"auto_restart_policy,omitempty"`
+	AutoRestartMaxRetries *int32                         `protobuf:"varint,12,opt,name=auto_restart_max_retries,json=autoRestartMaxRetries,proto3,oneof" json:"auto_restart_max_retries,omitempty"`
+	unknownFields         protoimpl.UnknownFields
+	sizeCache             protoimpl.SizeCache
 }
 
 func (x *UpdateHeadlessHostSettingsRequest) Reset() {
 	*x = UpdateHeadlessHostSettingsRequest{}
-	mi := &file_hdlctrl_v1_controller_proto_msgTypes[32]
+	mi := &file_hdlctrl_v1_controller_proto_msgTypes[34]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1886,7 +2599,7 @@ func (x *UpdateHeadlessHostSettingsRequest) String() string {
 func (*UpdateHeadlessHostSettingsRequest) ProtoMessage() {}
 
 func (x *UpdateHeadlessHostSettingsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_hdlctrl_v1_controller_proto_msgTypes[32]
+	mi := &file_hdlctrl_v1_controller_proto_msgTypes[34]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1899,7 +2612,7 @@ func (x *UpdateHeadlessHostSettingsRequest) ProtoReflect() protoreflect.Message
 
 // Deprecated: Use UpdateHeadlessHostSettingsRequest.ProtoReflect.Descriptor instead.
 func (*UpdateHeadlessHostSettingsRequest) Descriptor() ([]byte, []int) {
-	return file_hdlctrl_v1_controller_proto_rawDescGZIP(), []int{32}
+	return file_hdlctrl_v1_controller_proto_rawDescGZIP(), []int{34}
 }
 
 func (x *UpdateHeadlessHostSettingsRequest) GetHostId() string {
@@ -1965,6 +2678,27 @@ func (x *UpdateHeadlessHostSettingsRequest) GetAutoUpdatePolicy() HeadlessHostAu
 	return HeadlessHostAutoUpdatePolicy_HEADLESS_HOST_AUTO_UPDATE_POLICY_UNKNOWN
 }
 
+func (x *UpdateHeadlessHostSettingsRequest) GetContainerSettings() *HeadlessHostContainerSettings {
+	if x != nil {
+		return x.ContainerSettings
+	}
+	return nil
+}
+
+func (x *UpdateHeadlessHostSettingsRequest) GetAutoRestartPolicy() HeadlessHostAutoRestartPolicy {
+	if x != nil && x.AutoRestartPolicy != nil {
+		return *x.AutoRestartPolicy
+	}
+	return HeadlessHostAutoRestartPolicy_HEADLESS_HOST_AUTO_RESTART_POLICY_UNKNOWN
+}
+
+func (x *UpdateHeadlessHostSettingsRequest) GetAutoRestartMaxRetries() int32 {
+	if x != nil && x.AutoRestartMaxRetries != nil {
+		return *x.AutoRestartMaxRetries
+	}
+	return 0
+}
+
 type UpdateHeadlessHostSettingsResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	unknownFields protoimpl.UnknownFields
@@ -1973,7 +2707,7 @@ type UpdateHeadlessHostSettingsResponse struct {
 
 func (x *UpdateHeadlessHostSettingsResponse) Reset() {
 	*x = UpdateHeadlessHostSettingsResponse{}
-	mi := &file_hdlctrl_v1_controller_proto_msgTypes[33]
+	mi := &file_hdlctrl_v1_controller_proto_msgTypes[35]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1985,7 +2719,7 @@ func (x *UpdateHeadlessHostSettingsResponse) String() string {
 func (*UpdateHeadlessHostSettingsResponse) ProtoMessage() {}
 
 func (x *UpdateHeadlessHostSettingsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_hdlctrl_v1_controller_proto_msgTypes[33]
+	mi := &file_hdlctrl_v1_controller_proto_msgTypes[35]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1998,7 +2732,7 @@ func (x *UpdateHeadlessHostSettingsResponse) ProtoReflect() protoreflect.Message
 
 // Deprecated: Use UpdateHeadlessHostSettingsResponse.ProtoReflect.Descriptor instead.
 func (*UpdateHeadlessHostSettingsResponse) Descriptor() ([]byte, []int) {
-	return file_hdlctrl_v1_controller_proto_rawDescGZIP(), []int{33}
+	return file_hdlctrl_v1_controller_proto_rawDescGZIP(), []int{35}
 }
 
 type ShutdownHeadlessHostRequest struct {
@@ -2010,7 +2744,7 @@ type ShutdownHeadlessHostRequest struct {
 
 func (x *ShutdownHeadlessHostRequest) Reset() {
 	*x = ShutdownHeadlessHostRequest{}
-	mi := &file_hdlctrl_v1_controller_proto_msgTypes[34]
+	mi := &file_hdlctrl_v1_controller_proto_msgTypes[36]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2022,7 +2756,7 @@ func (x *ShutdownHeadlessHostRequest) String() string {
 func (*ShutdownHeadlessHostRequest) ProtoMessage() {}
 
 func (x *ShutdownHeadlessHostRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_hdlctrl_v1_controller_proto_msgTypes[34]
+	mi := &file_hdlctrl_v1_controller_proto_msgTypes[36]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2035,7 +2769,7 @@ func (x *ShutdownHeadlessHostRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ShutdownHeadlessHostRequest.ProtoReflect.Descriptor instead.
 func (*ShutdownHeadlessHostRequest) Descriptor() ([]byte, []int) {
-	return file_hdlctrl_v1_controller_proto_rawDescGZIP(), []int{34}
+	return file_hdlctrl_v1_controller_proto_rawDescGZIP(), []int{36}
 }
 
 func (x *ShutdownHeadlessHostRequest) GetHostId() string {
@@ -2054,7 +2788,7 @@ type ShutdownHeadlessHostResponse struct {
 
 func (x *ShutdownHeadlessHostResponse) Reset() {
 	*x = ShutdownHeadlessHostResponse{}
-	mi := &file_hdlctrl_v1_controller_proto_msgTypes[35]
+	mi := &file_hdlctrl_v1_controller_proto_msgTypes[37]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2066,7 +2800,7 @@ func (x *ShutdownHeadlessHostResponse) String() string {
 func (*ShutdownHeadlessHostResponse) ProtoMessage() {}
 
 func (x *ShutdownHeadlessHostResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_hdlctrl_v1_controller_proto_msgTypes[35]
+	mi := &file_hdlctrl_v1_controller_proto_msgTypes[37]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2079,7 +2813,7 @@ func (x *ShutdownHeadlessHostResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ShutdownHeadlessHostResponse.ProtoReflect.Descriptor instead.
 func (*ShutdownHeadlessHostResponse) Descriptor() ([]byte, []int) {
-	return file_hdlctrl_v1_controller_proto_rawDescGZIP(), []int{35}
+	return file_hdlctrl_v1_controller_proto_rawDescGZIP(), []int{37}
 }
 
 func (x *ShutdownHeadlessHostResponse) GetJobId() string {
@@ -2098,7 +2832,7 @@ type KillHeadlessHostRequest struct {
 
 func (x *KillHeadlessHostRequest) Reset() {
 	*x = KillHeadlessHostRequest{}
-	mi := &file_hdlctrl_v1_controller_proto_msgTypes[36]
+	mi := &file_hdlctrl_v1_controller_proto_msgTypes[38]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2110,7 +2844,7 @@ func (x *KillHeadlessHostRequest) String() string {
 func (*KillHeadlessHostRequest) ProtoMessage() {}
 
 func (x *KillHeadlessHostRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_hdlctrl_v1_controller_proto_msgTypes[36]
+	mi := &file_hdlctrl_v1_controller_proto_msgTypes[38]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2123,7 +2857,7 @@ func (x *KillHeadlessHostRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use KillHeadlessHostRequest.ProtoReflect.Descriptor instead.
 func (*KillHeadlessHostRequest) Descriptor() ([]byte, []int) {
-	return file_hdlctrl_v1_controller_proto_rawDescGZIP(), []int{36}
+	return file_hdlctrl_v1_controller_proto_rawDescGZIP(), []int{38}
 }
 
 func (x *KillHeadlessHostRequest) GetHostId() string {
@@ -2141,7 +2875,7 @@ type KillHeadlessHostResponse struct {
 
 func (x *KillHeadlessHostResponse) Reset() {
 	*x = KillHeadlessHostResponse{}
-	mi := &file_hdlctrl_v1_controller_proto_msgTypes[37]
+	mi := &file_hdlctrl_v1_controller_proto_msgTypes[39]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2153,7 +2887,7 @@ func (x *KillHeadlessHostResponse) String() string {
 func (*KillHeadlessHostResponse) ProtoMessage() {}
 
 func (x *KillHeadlessHostResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_hdlctrl_v1_controller_proto_msgTypes[37]
+	mi := &file_hdlctrl_v1_controller_proto_msgTypes[39]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2166,7 +2900,7 @@ func (x *KillHeadlessHostResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use KillHeadlessHostResponse.ProtoReflect.Descriptor instead.
 func (*KillHeadlessHostResponse) Descriptor() ([]byte, []int) {
-	return file_hdlctrl_v1_controller_proto_rawDescGZIP(), []int{37}
+	return file_hdlctrl_v1_controller_proto_rawDescGZIP(), []int{39}
 }
 
 type GetHeadlessHostLogsRequest struct {
@@ -2189,7 +2923,7 @@ type GetHeadlessHostLogsRequest struct {
 
 func (x *GetHeadlessHostLogsRequest) Reset() {
 	*x = GetHeadlessHostLogsRequest{}
-	mi := &file_hdlctrl_v1_controller_proto_msgTypes[38]
+	mi := &file_hdlctrl_v1_controller_proto_msgTypes[40]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2201,7 +2935,7 @@ func (x *GetHeadlessHostLogsRequest) String() string {
 func (*GetHeadlessHostLogsRequest) ProtoMessage() {}
 
 func (x *GetHeadlessHostLogsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_hdlctrl_v1_controller_proto_msgTypes[38]
+	mi := &file_hdlctrl_v1_controller_proto_msgTypes[40]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2214,7 +2948,7 @@ func (x *GetHeadlessHostLogsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetHeadlessHostLogsRequest.ProtoReflect.Descriptor instead.
 func (*GetHeadlessHostLogsRequest) Descriptor() ([]byte, []int) {
-	return file_hdlctrl_v1_controller_proto_rawDescGZIP(), []int{38}
+	return file_hdlctrl_v1_controller_proto_rawDescGZIP(), []int{40}
 }
 
 func (x *GetHeadlessHostLogsRequest) GetHostId() string {
@@ -2295,7 +3029,7 @@ type GetHeadlessHostLogsResponse struct {
 
 func (x *GetHeadlessHostLogsResponse) Reset() {
 	*x = GetHeadlessHostLogsResponse{}
-	mi := &file_hdlctrl_v1_controller_proto_msgTypes[39]
+	mi := &file_hdlctrl_v1_controller_proto_msgTypes[41]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2307,7 +3041,7 @@ func (x *GetHeadlessHostLogsResponse) String() string {
 func (*GetHeadlessHostLogsResponse) ProtoMessage() {}
 
 func (x *GetHeadlessHostLogsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_hdlctrl_v1_controller_proto_msgTypes[39]
+	mi := &file_hdlctrl_v1_controller_proto_msgTypes[41]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2320,7 +3054,7 @@ func (x *GetHeadlessHostLogsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetHeadlessHostLogsResponse.ProtoReflect.Descriptor instead.
 func (*GetHeadlessHostLogsResponse) Descriptor() ([]byte, []int) {
-	return file_hdlctrl_v1_controller_proto_rawDescGZIP(), []int{39}
+	return file_hdlctrl_v1_controller_proto_rawDescGZIP(), []int{41}
 }
 
 func (x *GetHeadlessHostLogsResponse) GetLogs() []*GetHeadlessHostLogsResponse_Log {
@@ -2344,29 +3078,40 @@ func (x *GetHeadlessHostLogsResponse) GetHasMoreAfter() bool {
 	return false
 }
 
-type SearchUserInfoRequest struct {
-	state         protoimpl.MessageState    `protogen:"open.v1"`
-	HostId        string                    `protobuf:"bytes,1,opt,name=host_id,json=hostId,proto3" json:"host_id,omitempty"`
-	Parameters    *v1.SearchUserInfoRequest `protobuf:"bytes,2,opt,name=parameters,proto3" json:"parameters,omitempty"`
+type TailHeadlessHostLogsRequest struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	HostId string                 `protobuf:"bytes,1,opt,name=host_id,json=hostId,proto3" json:"host_id,omitempty"`
+	// 対象インスタンスID. 0 なら現在のインスタンス
+	InstanceId int32 `protobuf:"varint,2,opt,name=instance_id,json=instanceId,proto3" json:"instance_id,omitempty"`
+	// 最初に送る直近のログの件数 (デフォルト100、最大1000). フィルタに一致したものだけ数える
+	Backlog int32 `protobuf:"varint,3,opt,name=backlog,proto3" json:"backlog,omitempty"`
+	// 再接続用. 指定するとバックログの代わりにこのIDより後のログから送る
+	AfterId *int64 `protobuf:"varint,4,opt,name=after_id,json=afterId,proto3,oneof" json:"after_id,omitempty"`
+	// 本文にこの文字列を含むログだけ送る (大文字小文字を区別しない)
+	Contains string `protobuf:"bytes,5,opt,name=contains,proto3" json:"contains,omitempty"`
+	// 本文がこの正規表現 (RE2) に一致するログだけ送る
+	Pattern string `protobuf:"bytes,6,opt,name=pattern,proto3" json:"pattern,omitempty"`
+	// このレベル以上のログだけ送る. 未指定なら全レベル
+	MinLevel      HeadlessHostLogLevel `protobuf:"varint,7,opt,name=min_level,json=minLevel,proto3,enum=hdlctrl.v1.HeadlessHostLogLevel" json:"min_level,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *SearchUserInfoRequest) Reset() {
-	*x = SearchUserInfoRequest{}
-	mi := &file_hdlctrl_v1_controller_proto_msgTypes[40]
+func (x *TailHeadlessHostLogsRequest) Reset() {
+	*x = TailHeadlessHostLogsRequest{}
+	mi := &file_hdlctrl_v1_controller_proto_msgTypes[42]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SearchUserInfoRequest) String() string {
+func (x *TailHeadlessHostLogsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SearchUserInfoRequest) ProtoMessage() {}
+func (*TailHeadlessHostLogsRequest) ProtoMessage() {}
 
-func (x *SearchUserInfoRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_hdlctrl_v1_controller_proto_msgTypes[40]
+func (x *TailHeadlessHostLogsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_hdlctrl_v1_controller_proto_msgTypes[42]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2377,98 +3122,87 @@ func (x *SearchUserInfoRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SearchUserInfoRequest.ProtoReflect.Descriptor instead.
-func (*SearchUserInfoRequest) Descriptor() ([]byte, []int) {
-	return file_hdlctrl_v1_controller_proto_rawDescGZIP(), []int{40}
+// Deprecated: Use TailHeadlessHostLogsRequest.ProtoReflect.Descriptor instead.
+func (*TailHeadlessHostLogsRequest) Descriptor() ([]byte, []int) {
+	return file_hdlctrl_v1_controller_proto_rawDescGZIP(), []int{42}
 }
 
-func (x *SearchUserInfoRequest) GetHostId() string {
+func (x *TailHeadlessHostLogsRequest) GetHostId() string {
 	if x != nil {
 		return x.HostId
 	}
 	return ""
 }
 
-func (x *SearchUserInfoRequest) GetParameters() *v1.SearchUserInfoRequest {
+func (x *TailHeadlessHostLogsRequest) GetInstanceId() int32 {
 	if x != nil {
-		return x.Parameters
+		return x.InstanceId
 	}
-	return nil
-}
-
-type KickUserRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	HostId        string                 `protobuf:"bytes,1,opt,name=host_id,json=hostId,proto3" json:"host_id,omitempty"`
-	Parameters    *v1.KickUserRequest    `protobuf:"bytes,2,opt,name=parameters,proto3" json:"parameters,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	return 0
 }
 
-func (x *KickUserRequest) Reset() {
-	*x = KickUserRequest{}
-	mi := &file_hdlctrl_v1_controller_proto_msgTypes[41]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *TailHeadlessHostLogsRequest) GetBacklog() int32 {
+	if x != nil {
+		return x.Backlog
+	}
+	return 0
 }
 
-func (x *KickUserRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *TailHeadlessHostLogsRequest) GetAfterId() int64 {
+	if x != nil && x.AfterId != nil {
+		return *x.AfterId
+	}
+	return 0
 }
 
-func (*KickUserRequest) ProtoMessage() {}
-
-func (x *KickUserRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_hdlctrl_v1_controller_proto_msgTypes[41]
+func (x *TailHeadlessHostLogsRequest) GetContains() string {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.Contains
 	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use KickUserRequest.ProtoReflect.Descriptor instead.
-func (*KickUserRequest) Descriptor() ([]byte, []int) {
-	return file_hdlctrl_v1_controller_proto_rawDescGZIP(), []int{41}
+	return ""
 }
 
-func (x *KickUserRequest) GetHostId() string {
+func (x *TailHeadlessHostLogsRequest) GetPattern() string {
 	if x != nil {
-		return x.HostId
+		return x.Pattern
 	}
 	return ""
 }
 
-func (x *KickUserRequest) GetParameters() *v1.KickUserRequest {
+func (x *TailHeadlessHostLogsRequest) GetMinLevel() HeadlessHostLogLevel {
 	if x != nil {
-		return x.Parameters
+		return x.MinLevel
 	}
-	return nil
+	return HeadlessHostLogLevel_HEADLESS_HOST_LOG_LEVEL_UNKNOWN
 }
 
-type KickUserResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+type TailHeadlessHostLogsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 時系列に並んだログ. keep-alive では空
+	Logs []*GetHeadlessHostLogsResponse_Log `protobuf:"bytes,1,rep,name=logs,proto3" json:"logs,omitempty"`
+	// 対象のインスタンスID
+	InstanceId int32 `protobuf:"varint,2,opt,name=instance_id,json=instanceId,proto3" json:"instance_id,omitempty"`
+	// バックログを送り終えたメッセージで true. 以降は新しいログ
+	BacklogComplete bool `protobuf:"varint,3,opt,name=backlog_complete,json=backlogComplete,proto3" json:"backlog_complete,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
 }
 
-func (x *KickUserResponse) Reset() {
-	*x = KickUserResponse{}
-	mi := &file_hdlctrl_v1_controller_proto_msgTypes[42]
+func (x *TailHeadlessHostLogsResponse) Reset() {
+	*x = TailHeadlessHostLogsResponse{}
+	mi := &file_hdlctrl_v1_controller_proto_msgTypes[43]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *KickUserResponse) String() string {
+func (x *TailHeadlessHostLogsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*KickUserResponse) ProtoMessage() {}
+func (*TailHeadlessHostLogsResponse) ProtoMessage() {}
 
-func (x *KickUserResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_hdlctrl_v1_controller_proto_msgTypes[42]
+func (x *TailHeadlessHostLogsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_hdlctrl_v1_controller_proto_msgTypes[43]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2479,34 +3213,67 @@ func (x *KickUserResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use KickUserResponse.ProtoReflect.Descriptor instead.
-func (*KickUserResponse) Descriptor() ([]byte, []int) {
-	return file_hdlctrl_v1_controller_proto_rawDescGZIP(), []int{42}
+// Deprecated: Use TailHeadlessHostLogsResponse.ProtoReflect.Descriptor instead.
+func (*TailHeadlessHostLogsResponse) Descriptor() ([]byte, []int) {
+	return file_hdlctrl_v1_controller_proto_rawDescGZIP(), []int{43}
 }
 
-type BanUserRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	HostId        string                 `protobuf:"bytes,1,opt,name=host_id,json=hostId,proto3" json:"host_id,omitempty"`
-	Parameters    *v1.BanUserRequest     `protobuf:"bytes,2,opt,name=parameters,proto3" json:"parameters,omitempty"`
+func (x *TailHeadlessHostLogsResponse) GetLogs() []*GetHeadlessHostLogsResponse_Log {
+	if x != nil {
+		return x.Logs
+	}
+	return nil
+}
+
+func (x *TailHeadlessHostLogsResponse) GetInstanceId() int32 {
+	if x != nil {
+		return x.InstanceId
+	}
+	return 0
+}
+
+func (x *TailHeadlessHostLogsResponse) GetBacklogComplete() bool {
+	if x != nil {
+		return x.BacklogComplete
+	}
+	return false
+}
+
+type SearchHeadlessHostLogsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 本文に含まれる文字列 (大文字小文字を区別しない). 3 文字以上
+	Query string `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`
+	// 指定したグループのホストのみを検索する.
+	// 未指定の場合は呼び出しユーザーが host:read を持つグループ群に絞り込む.
+	GroupId *string `protobuf:"bytes,2,opt,name=group_id,json=groupId,proto3,oneof" json:"group_id,omitempty"`
+	// 指定したホストのみを検索する. 未指定なら全ホスト
+	HostIds []string `protobuf:"bytes,3,rep,name=host_ids,json=hostIds,proto3" json:"host_ids,omitempty"`
+	// since 以降 (含む) / until より前 (含まない) のログに絞り込む.
+	Since *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=since,proto3,oneof" json:"since,omitempty"`
+	Until *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=until,proto3,oneof" json:"until,omitempty"`
+	// 取得件数 (デフォルト100、最大500)
+	Limit int32 `protobuf:"varint,6,opt,name=limit,proto3" json:"limit,omitempty"`
+	// ページングカーソル. 前回の next_before_id を指定すると続きを返す
+	BeforeId      int64 `protobuf:"varint,7,opt,name=before_id,json=beforeId,proto3" json:"before_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *BanUserRequest) Reset() {
-	*x = BanUserRequest{}
-	mi := &file_hdlctrl_v1_controller_proto_msgTypes[43]
+func (x *SearchHeadlessHostLogsRequest) Reset() {
+	*x = SearchHeadlessHostLogsRequest{}
+	mi := &file_hdlctrl_v1_controller_proto_msgTypes[44]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *BanUserRequest) String() string {
+func (x *SearchHeadlessHostLogsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*BanUserRequest) ProtoMessage() {}
+func (*SearchHeadlessHostLogsRequest) ProtoMessage() {}
 
-func (x *BanUserRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_hdlctrl_v1_controller_proto_msgTypes[43]
+func (x *SearchHeadlessHostLogsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_hdlctrl_v1_controller_proto_msgTypes[44]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2517,85 +3284,84 @@ func (x *BanUserRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use BanUserRequest.ProtoReflect.Descriptor instead.
-func (*BanUserRequest) Descriptor() ([]byte, []int) {
-	return file_hdlctrl_v1_controller_proto_rawDescGZIP(), []int{43}
+// Deprecated: Use SearchHeadlessHostLogsRequest.ProtoReflect.Descriptor instead.
+func (*SearchHeadlessHostLogsRequest) Descriptor() ([]byte, []int) {
+	return file_hdlctrl_v1_controller_proto_rawDescGZIP(), []int{44}
 }
 
-func (x *BanUserRequest) GetHostId() string {
+func (x *SearchHeadlessHostLogsRequest) GetQuery() string {
 	if x != nil {
-		return x.HostId
+		return x.Query
 	}
 	return ""
 }
 
-func (x *BanUserRequest) GetParameters() *v1.BanUserRequest {
-	if x != nil {
-		return x.Parameters
+func (x *SearchHeadlessHostLogsRequest) GetGroupId() string {
+	if x != nil && x.GroupId != nil {
+		return *x.GroupId
 	}
-	return nil
+	return ""
 }
 
-type BanUserResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *SearchHeadlessHostLogsRequest) GetHostIds() []string {
+	if x != nil {
+		return x.HostIds
+	}
+	return nil
 }
 
-func (x *BanUserResponse) Reset() {
-	*x = BanUserResponse{}
-	mi := &file_hdlctrl_v1_controller_proto_msgTypes[44]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *SearchHeadlessHostLogsRequest) GetSince() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Since
+	}
+	return nil
 }
 
-func (x *BanUserResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *SearchHeadlessHostLogsRequest) GetUntil() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Until
+	}
+	return nil
 }
 
-func (*BanUserResponse) ProtoMessage() {}
-
-func (x *BanUserResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_hdlctrl_v1_controller_proto_msgTypes[44]
+func (x *SearchHeadlessHostLogsRequest) GetLimit() int32 {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.Limit
 	}
-	return mi.MessageOf(x)
+	return 0
 }
 
-// Deprecated: Use BanUserResponse.ProtoReflect.Descriptor instead.
-func (*BanUserResponse) Descriptor() ([]byte, []int) {
-	return file_hdlctrl_v1_controller_proto_rawDescGZIP(), []int{44}
+func (x *SearchHeadlessHostLogsRequest) GetBeforeId() int64 {
+	if x != nil {
+		return x.BeforeId
+	}
+	return 0
 }
 
-// ResoniteLink (WebSocket) 接続用の短期トークン付きパスを発行する。
-// 返される ws_path は path + query のみ。クライアント (フロントエンド) は
-// 現在の origin を補完して完全な ws[s]://host/path?token=... を組み立てる。
-type IssueResoniteLinkConnectionRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	SessionId     string                 `protobuf:"bytes,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+type SearchHeadlessHostLogsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 一致したログを新しい順に並べたときに先に現れるものから並ぶ
+	Groups []*SearchHeadlessHostLogsResponse_Group `protobuf:"bytes,1,rep,name=groups,proto3" json:"groups,omitempty"`
+	// 続きがあれば次のページの before_id. なければ 0
+	NextBeforeId  int64 `protobuf:"varint,2,opt,name=next_before_id,json=nextBeforeId,proto3" json:"next_before_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *IssueResoniteLinkConnectionRequest) Reset() {
-	*x = IssueResoniteLinkConnectionRequest{}
+func (x *SearchHeadlessHostLogsResponse) Reset() {
+	*x = SearchHeadlessHostLogsResponse{}
 	mi := &file_hdlctrl_v1_controller_proto_msgTypes[45]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *IssueResoniteLinkConnectionRequest) String() string {
+func (x *SearchHeadlessHostLogsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*IssueResoniteLinkConnectionRequest) ProtoMessage() {}
+func (*SearchHeadlessHostLogsResponse) ProtoMessage() {}
 
-func (x *IssueResoniteLinkConnectionRequest) ProtoReflect() protoreflect.Message {
+func (x *SearchHeadlessHostLogsResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_hdlctrl_v1_controller_proto_msgTypes[45]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2607,40 +3373,52 @@ func (x *IssueResoniteLinkConnectionRequest) ProtoReflect() protoreflect.Message
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use IssueResoniteLinkConnectionRequest.ProtoReflect.Descriptor instead.
-func (*IssueResoniteLinkConnectionRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use SearchHeadlessHostLogsResponse.ProtoReflect.Descriptor instead.
+func (*SearchHeadlessHostLogsResponse) Descriptor() ([]byte, []int) {
 	return file_hdlctrl_v1_controller_proto_rawDescGZIP(), []int{45}
 }
 
-func (x *IssueResoniteLinkConnectionRequest) GetSessionId() string {
+func (x *SearchHeadlessHostLogsResponse) GetGroups() []*SearchHeadlessHostLogsResponse_Group {
 	if x != nil {
-		return x.SessionId
+		return x.Groups
 	}
-	return ""
+	return nil
 }
 
-type IssueResoniteLinkConnectionResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	WsPath        string                 `protobuf:"bytes,1,opt,name=ws_path,json=wsPath,proto3" json:"ws_path,omitempty"`
-	ExpiresAt     *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+func (x *SearchHeadlessHostLogsResponse) GetNextBeforeId() int64 {
+	if x != nil {
+		return x.NextBeforeId
+	}
+	return 0
+}
+
+type PrepareHeadlessHostLogDownloadRequest struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	HostId string                 `protobuf:"bytes,1,opt,name=host_id,json=hostId,proto3" json:"host_id,omitempty"`
+	// 0 の場合は現在のインスタンス
+	InstanceId int32                       `protobuf:"varint,2,opt,name=instance_id,json=instanceId,proto3" json:"instance_id,omitempty"`
+	Format     HeadlessHostLogExportFormat `protobuf:"varint,3,opt,name=format,proto3,enum=hdlctrl.v1.HeadlessHostLogExportFormat" json:"format,omitempty"`
+	// 期間 (since は含み、until は含まない). 未指定なら全体
+	Since         *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=since,proto3,oneof" json:"since,omitempty"`
+	Until         *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=until,proto3,oneof" json:"until,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *IssueResoniteLinkConnectionResponse) Reset() {
-	*x = IssueResoniteLinkConnectionResponse{}
+func (x *PrepareHeadlessHostLogDownloadRequest) Reset() {
+	*x = PrepareHeadlessHostLogDownloadRequest{}
 	mi := &file_hdlctrl_v1_controller_proto_msgTypes[46]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *IssueResoniteLinkConnectionResponse) String() string {
+func (x *PrepareHeadlessHostLogDownloadRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*IssueResoniteLinkConnectionResponse) ProtoMessage() {}
+func (*PrepareHeadlessHostLogDownloadRequest) ProtoMessage() {}
 
-func (x *IssueResoniteLinkConnectionResponse) ProtoReflect() protoreflect.Message {
+func (x *PrepareHeadlessHostLogDownloadRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_hdlctrl_v1_controller_proto_msgTypes[46]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2652,47 +3430,71 @@ func (x *IssueResoniteLinkConnectionResponse) ProtoReflect() protoreflect.Messag
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use IssueResoniteLinkConnectionResponse.ProtoReflect.Descriptor instead.
-func (*IssueResoniteLinkConnectionResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use PrepareHeadlessHostLogDownloadRequest.ProtoReflect.Descriptor instead.
+func (*PrepareHeadlessHostLogDownloadRequest) Descriptor() ([]byte, []int) {
 	return file_hdlctrl_v1_controller_proto_rawDescGZIP(), []int{46}
 }
 
-func (x *IssueResoniteLinkConnectionResponse) GetWsPath() string {
+func (x *PrepareHeadlessHostLogDownloadRequest) GetHostId() string {
 	if x != nil {
-		return x.WsPath
+		return x.HostId
 	}
 	return ""
 }
 
-func (x *IssueResoniteLinkConnectionResponse) GetExpiresAt() *timestamppb.Timestamp {
+func (x *PrepareHeadlessHostLogDownloadRequest) GetInstanceId() int32 {
 	if x != nil {
-		return x.ExpiresAt
+		return x.InstanceId
+	}
+	return 0
+}
+
+func (x *PrepareHeadlessHostLogDownloadRequest) GetFormat() HeadlessHostLogExportFormat {
+	if x != nil {
+		return x.Format
+	}
+	return HeadlessHostLogExportFormat_HEADLESS_HOST_LOG_EXPORT_FORMAT_UNSPECIFIED
+}
+
+func (x *PrepareHeadlessHostLogDownloadRequest) GetSince() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Since
 	}
 	return nil
 }
 
-type FetchWorldInfoRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	HostId        string                 `protobuf:"bytes,1,opt,name=host_id,json=hostId,proto3" json:"host_id,omitempty"`
-	Url           string                 `protobuf:"bytes,2,opt,name=url,proto3" json:"url,omitempty"`
+func (x *PrepareHeadlessHostLogDownloadRequest) GetUntil() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Until
+	}
+	return nil
+}
+
+type PrepareHeadlessHostLogDownloadResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// ブラウザがそのままアクセス可能な相対 URL: /blobs/<uuid>
+	DownloadUrl string `protobuf:"bytes,1,opt,name=download_url,json=downloadUrl,proto3" json:"download_url,omitempty"`
+	Filename    string `protobuf:"bytes,2,opt,name=filename,proto3" json:"filename,omitempty"`
+	// ファイルに含まれるログの行数
+	LineCount     int64 `protobuf:"varint,3,opt,name=line_count,json=lineCount,proto3" json:"line_count,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *FetchWorldInfoRequest) Reset() {
-	*x = FetchWorldInfoRequest{}
+func (x *PrepareHeadlessHostLogDownloadResponse) Reset() {
+	*x = PrepareHeadlessHostLogDownloadResponse{}
 	mi := &file_hdlctrl_v1_controller_proto_msgTypes[47]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *FetchWorldInfoRequest) String() string {
+func (x *PrepareHeadlessHostLogDownloadResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*FetchWorldInfoRequest) ProtoMessage() {}
+func (*PrepareHeadlessHostLogDownloadResponse) ProtoMessage() {}
 
-func (x *FetchWorldInfoRequest) ProtoReflect() protoreflect.Message {
+func (x *PrepareHeadlessHostLogDownloadResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_hdlctrl_v1_controller_proto_msgTypes[47]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2704,48 +3506,54 @@ func (x *FetchWorldInfoRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use FetchWorldInfoRequest.ProtoReflect.Descriptor instead.
-func (*FetchWorldInfoRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use PrepareHeadlessHostLogDownloadResponse.ProtoReflect.Descriptor instead.
+func (*PrepareHeadlessHostLogDownloadResponse) Descriptor() ([]byte, []int) {
 	return file_hdlctrl_v1_controller_proto_rawDescGZIP(), []int{47}
 }
 
-func (x *FetchWorldInfoRequest) GetHostId() string {
+func (x *PrepareHeadlessHostLogDownloadResponse) GetDownloadUrl() string {
 	if x != nil {
-		return x.HostId
+		return x.DownloadUrl
 	}
 	return ""
 }
 
-func (x *FetchWorldInfoRequest) GetUrl() string {
+func (x *PrepareHeadlessHostLogDownloadResponse) GetFilename() string {
 	if x != nil {
-		return x.Url
+		return x.Filename
 	}
 	return ""
 }
 
-type SearchWorldsRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Query         string                 `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`
-	FeaturedOnly  bool                   `protobuf:"varint,2,opt,name=featured_only,json=featuredOnly,proto3" json:"featured_only,omitempty"`
-	PageIndex     int32                  `protobuf:"varint,3,opt,name=page_index,json=pageIndex,proto3" json:"page_index,omitempty"`
+func (x *PrepareHeadlessHostLogDownloadResponse) GetLineCount() int64 {
+	if x != nil {
+		return x.LineCount
+	}
+	return 0
+}
+
+type SearchUserInfoRequest struct {
+	state         protoimpl.MessageState    `protogen:"open.v1"`
+	HostId        string                    `protobuf:"bytes,1,opt,name=host_id,json=hostId,proto3" json:"host_id,omitempty"`
+	Parameters    *v1.SearchUserInfoRequest `protobuf:"bytes,2,opt,name=parameters,proto3" json:"parameters,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *SearchWorldsRequest) Reset() {
-	*x = SearchWorldsRequest{}
+func (x *SearchUserInfoRequest) Reset() {
+	*x = SearchUserInfoRequest{}
 	mi := &file_hdlctrl_v1_controller_proto_msgTypes[48]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SearchWorldsRequest) String() string {
+func (x *SearchUserInfoRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SearchWorldsRequest) ProtoMessage() {}
+func (*SearchUserInfoRequest) ProtoMessage() {}
 
-func (x *SearchWorldsRequest) ProtoReflect() protoreflect.Message {
+func (x *SearchUserInfoRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_hdlctrl_v1_controller_proto_msgTypes[48]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2757,54 +3565,47 @@ func (x *SearchWorldsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SearchWorldsRequest.ProtoReflect.Descriptor instead.
-func (*SearchWorldsRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use SearchUserInfoRequest.ProtoReflect.Descriptor instead.
+func (*SearchUserInfoRequest) Descriptor() ([]byte, []int) {
 	return file_hdlctrl_v1_controller_proto_rawDescGZIP(), []int{48}
 }
 
-func (x *SearchWorldsRequest) GetQuery() string {
+func (x *SearchUserInfoRequest) GetHostId() string {
 	if x != nil {
-		return x.Query
+		return x.HostId
 	}
 	return ""
 }
 
-func (x *SearchWorldsRequest) GetFeaturedOnly() bool {
-	if x != nil {
-		return x.FeaturedOnly
-	}
-	return false
-}
-
-func (x *SearchWorldsRequest) GetPageIndex() int32 {
+func (x *SearchUserInfoRequest) GetParameters() *v1.SearchUserInfoRequest {
 	if x != nil {
-		return x.PageIndex
+		return x.Parameters
 	}
-	return 0
+	return nil
 }
 
-type SearchWorldsResponse struct {
-	state         protoimpl.MessageState              `protogen:"open.v1"`
-	Records       []*SearchWorldsResponse_WorldRecord `protobuf:"bytes,1,rep,name=records,proto3" json:"records,omitempty"`
-	HasMore       bool                                `protobuf:"varint,2,opt,name=has_more,json=hasMore,proto3" json:"has_more,omitempty"`
+type KickUserRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	HostId        string                 `protobuf:"bytes,1,opt,name=host_id,json=hostId,proto3" json:"host_id,omitempty"`
+	Parameters    *v1.KickUserRequest    `protobuf:"bytes,2,opt,name=parameters,proto3" json:"parameters,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *SearchWorldsResponse) Reset() {
-	*x = SearchWorldsResponse{}
+func (x *KickUserRequest) Reset() {
+	*x = KickUserRequest{}
 	mi := &file_hdlctrl_v1_controller_proto_msgTypes[49]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SearchWorldsResponse) String() string {
+func (x *KickUserRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SearchWorldsResponse) ProtoMessage() {}
+func (*KickUserRequest) ProtoMessage() {}
 
-func (x *SearchWorldsResponse) ProtoReflect() protoreflect.Message {
+func (x *KickUserRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_hdlctrl_v1_controller_proto_msgTypes[49]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2816,47 +3617,45 @@ func (x *SearchWorldsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SearchWorldsResponse.ProtoReflect.Descriptor instead.
-func (*SearchWorldsResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use KickUserRequest.ProtoReflect.Descriptor instead.
+func (*KickUserRequest) Descriptor() ([]byte, []int) {
 	return file_hdlctrl_v1_controller_proto_rawDescGZIP(), []int{49}
 }
 
-func (x *SearchWorldsResponse) GetRecords() []*SearchWorldsResponse_WorldRecord {
+func (x *KickUserRequest) GetHostId() string {
 	if x != nil {
-		return x.Records
+		return x.HostId
 	}
-	return nil
+	return ""
 }
 
-func (x *SearchWorldsResponse) GetHasMore() bool {
+func (x *KickUserRequest) GetParameters() *v1.KickUserRequest {
 	if x != nil {
-		return x.HasMore
+		return x.Parameters
 	}
-	return false
+	return nil
 }
 
-type GetOwnWorldsRequest struct {
+type KickUserResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	HostId        string                 `protobuf:"bytes,1,opt,name=host_id,json=hostId,proto3" json:"host_id,omitempty"`
-	PageIndex     int32                  `protobuf:"varint,2,opt,name=page_index,json=pageIndex,proto3" json:"page_index,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetOwnWorldsRequest) Reset() {
-	*x = GetOwnWorldsRequest{}
+func (x *KickUserResponse) Reset() {
+	*x = KickUserResponse{}
 	mi := &file_hdlctrl_v1_controller_proto_msgTypes[50]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetOwnWorldsRequest) String() string {
+func (x *KickUserResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetOwnWorldsRequest) ProtoMessage() {}
+func (*KickUserResponse) ProtoMessage() {}
 
-func (x *GetOwnWorldsRequest) ProtoReflect() protoreflect.Message {
+func (x *KickUserResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_hdlctrl_v1_controller_proto_msgTypes[50]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2868,47 +3667,33 @@ func (x *GetOwnWorldsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetOwnWorldsRequest.ProtoReflect.Descriptor instead.
-func (*GetOwnWorldsRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use KickUserResponse.ProtoReflect.Descriptor instead.
+func (*KickUserResponse) Descriptor() ([]byte, []int) {
 	return file_hdlctrl_v1_controller_proto_rawDescGZIP(), []int{50}
 }
 
-func (x *GetOwnWorldsRequest) GetHostId() string {
-	if x != nil {
-		return x.HostId
-	}
-	return ""
-}
-
-func (x *GetOwnWorldsRequest) GetPageIndex() int32 {
-	if x != nil {
-		return x.PageIndex
-	}
-	return 0
-}
-
-type GetOwnWorldsResponse struct {
-	state         protoimpl.MessageState              `protogen:"open.v1"`
-	Records       []*SearchWorldsResponse_WorldRecord `protobuf:"bytes,1,rep,name=records,proto3" json:"records,omitempty"`
-	HasMore       bool                                `protobuf:"varint,2,opt,name=has_more,json=hasMore,proto3" json:"has_more,omitempty"`
+type BanUserRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	HostId        string                 `protobuf:"bytes,1,opt,name=host_id,json=hostId,proto3" json:"host_id,omitempty"`
+	Parameters    *v1.BanUserRequest     `protobuf:"bytes,2,opt,name=parameters,proto3" json:"parameters,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetOwnWorldsResponse) Reset() {
-	*x = GetOwnWorldsResponse{}
+func (x *BanUserRequest) Reset() {
+	*x = BanUserRequest{}
 	mi := &file_hdlctrl_v1_controller_proto_msgTypes[51]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetOwnWorldsResponse) String() string {
+func (x *BanUserRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetOwnWorldsResponse) ProtoMessage() {}
+func (*BanUserRequest) ProtoMessage() {}
 
-func (x *GetOwnWorldsResponse) ProtoReflect() protoreflect.Message {
+func (x *BanUserRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_hdlctrl_v1_controller_proto_msgTypes[51]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2920,50 +3705,45 @@ func (x *GetOwnWorldsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetOwnWorldsResponse.ProtoReflect.Descriptor instead.
-func (*GetOwnWorldsResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use BanUserRequest.ProtoReflect.Descriptor instead.
+func (*BanUserRequest) Descriptor() ([]byte, []int) {
 	return file_hdlctrl_v1_controller_proto_rawDescGZIP(), []int{51}
 }
 
-func (x *GetOwnWorldsResponse) GetRecords() []*SearchWorldsResponse_WorldRecord {
+func (x *BanUserRequest) GetHostId() string {
 	if x != nil {
-		return x.Records
+		return x.HostId
 	}
-	return nil
+	return ""
 }
 
-func (x *GetOwnWorldsResponse) GetHasMore() bool {
+func (x *BanUserRequest) GetParameters() *v1.BanUserRequest {
 	if x != nil {
-		return x.HasMore
+		return x.Parameters
 	}
-	return false
+	return nil
 }
 
-type ListHeadlessHostRequest struct {
-	state protoimpl.MessageState `protogen:"open.v1"`
-	Page  *PageRequest           `protobuf:"bytes,1,opt,name=page,proto3" json:"page,omitempty"`
-	// 指定したグループに所属するホストのみを返す.
-	// 未指定の場合は呼び出しユーザーが host:read を持つグループ群に絞り込む.
-	// system:group.list 保持者は未指定時に全件返却.
-	GroupId       *string `protobuf:"bytes,2,opt,name=group_id,json=groupId,proto3,oneof" json:"group_id,omitempty"`
+type BanUserResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListHeadlessHostRequest) Reset() {
-	*x = ListHeadlessHostRequest{}
+func (x *BanUserResponse) Reset() {
+	*x = BanUserResponse{}
 	mi := &file_hdlctrl_v1_controller_proto_msgTypes[52]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListHeadlessHostRequest) String() string {
+func (x *BanUserResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListHeadlessHostRequest) ProtoMessage() {}
+func (*BanUserResponse) ProtoMessage() {}
 
-func (x *ListHeadlessHostRequest) ProtoReflect() protoreflect.Message {
+func (x *BanUserResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_hdlctrl_v1_controller_proto_msgTypes[52]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2975,47 +3755,35 @@ func (x *ListHeadlessHostRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListHeadlessHostRequest.ProtoReflect.Descriptor instead.
-func (*ListHeadlessHostRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use BanUserResponse.ProtoReflect.Descriptor instead.
+func (*BanUserResponse) Descriptor() ([]byte, []int) {
 	return file_hdlctrl_v1_controller_proto_rawDescGZIP(), []int{52}
 }
 
-func (x *ListHeadlessHostRequest) GetPage() *PageRequest {
-	if x != nil {
-		return x.Page
-	}
-	return nil
-}
-
-func (x *ListHeadlessHostRequest) GetGroupId() string {
-	if x != nil && x.GroupId != nil {
-		return *x.GroupId
-	}
-	return ""
-}
-
-type ListHeadlessHostResponse struct {
+// ResoniteLink (WebSocket) 接続用の短期トークン付きパスを発行する。
+// 返される ws_path は path + query のみ。クライアント (フロントエンド) は
+// 現在の origin を補完して完全な ws[s]://host/path?token=... を組み立てる。
+type IssueResoniteLinkConnectionRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Hosts         []*HeadlessHost        `protobuf:"bytes,1,rep,name=hosts,proto3" json:"hosts,omitempty"`
-	Page          *PageResponse          `protobuf:"bytes,2,opt,name=page,proto3" json:"page,omitempty"`
+	SessionId     string                 `protobuf:"bytes,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListHeadlessHostResponse) Reset() {
-	*x = ListHeadlessHostResponse{}
+func (x *IssueResoniteLinkConnectionRequest) Reset() {
+	*x = IssueResoniteLinkConnectionRequest{}
 	mi := &file_hdlctrl_v1_controller_proto_msgTypes[53]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListHeadlessHostResponse) String() string {
+func (x *IssueResoniteLinkConnectionRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListHeadlessHostResponse) ProtoMessage() {}
+func (*IssueResoniteLinkConnectionRequest) ProtoMessage() {}
 
-func (x *ListHeadlessHostResponse) ProtoReflect() protoreflect.Message {
+func (x *IssueResoniteLinkConnectionRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_hdlctrl_v1_controller_proto_msgTypes[53]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3027,46 +3795,40 @@ func (x *ListHeadlessHostResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListHeadlessHostResponse.ProtoReflect.Descriptor instead.
-func (*ListHeadlessHostResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use IssueResoniteLinkConnectionRequest.ProtoReflect.Descriptor instead.
+func (*IssueResoniteLinkConnectionRequest) Descriptor() ([]byte, []int) {
 	return file_hdlctrl_v1_controller_proto_rawDescGZIP(), []int{53}
 }
 
-func (x *ListHeadlessHostResponse) GetHosts() []*HeadlessHost {
-	if x != nil {
-		return x.Hosts
-	}
-	return nil
-}
-
-func (x *ListHeadlessHostResponse) GetPage() *PageResponse {
+func (x *IssueResoniteLinkConnectionRequest) GetSessionId() string {
 	if x != nil {
-		return x.Page
+		return x.SessionId
 	}
-	return nil
+	return ""
 }
 
-type GetHeadlessHostRequest struct {
+type IssueResoniteLinkConnectionResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	HostId        string                 `protobuf:"bytes,1,opt,name=host_id,json=hostId,proto3" json:"host_id,omitempty"`
+	WsPath        string                 `protobuf:"bytes,1,opt,name=ws_path,json=wsPath,proto3" json:"ws_path,omitempty"`
+	ExpiresAt     *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetHeadlessHostRequest) Reset() {
-	*x = GetHeadlessHostRequest{}
+func (x *IssueResoniteLinkConnectionResponse) Reset() {
+	*x = IssueResoniteLinkConnectionResponse{}
 	mi := &file_hdlctrl_v1_controller_proto_msgTypes[54]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetHeadlessHostRequest) String() string {
+func (x *IssueResoniteLinkConnectionResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetHeadlessHostRequest) ProtoMessage() {}
+func (*IssueResoniteLinkConnectionResponse) ProtoMessage() {}
 
-func (x *GetHeadlessHostRequest) ProtoReflect() protoreflect.Message {
+func (x *IssueResoniteLinkConnectionResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_hdlctrl_v1_controller_proto_msgTypes[54]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3078,39 +3840,50 @@ func (x *GetHeadlessHostRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetHeadlessHostRequest.ProtoReflect.Descriptor instead.
-func (*GetHeadlessHostRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use IssueResoniteLinkConnectionResponse.ProtoReflect.Descriptor instead.
+func (*IssueResoniteLinkConnectionResponse) Descriptor() ([]byte, []int) {
 	return file_hdlctrl_v1_controller_proto_rawDescGZIP(), []int{54}
 }
 
-func (x *GetHeadlessHostRequest) GetHostId() string {
+func (x *IssueResoniteLinkConnectionResponse) GetWsPath() string {
 	if x != nil {
-		return x.HostId
+		return x.WsPath
 	}
 	return ""
 }
 
-type GetHeadlessHostResponse struct {
+func (x *IssueResoniteLinkConnectionResponse) GetExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
+}
+
+type SessionUserEvent struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Host          *HeadlessHost          `protobuf:"bytes,1,opt,name=host,proto3" json:"host,omitempty"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Kind          SessionUserEventKind   `protobuf:"varint,2,opt,name=kind,proto3,enum=hdlctrl.v1.SessionUserEventKind" json:"kind,omitempty"`
+	UserId        string                 `protobuf:"bytes,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	UserName      string                 `protobuf:"bytes,4,opt,name=user_name,json=userName,proto3" json:"user_name,omitempty"`
+	OccurredAt    *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=occurred_at,json=occurredAt,proto3" json:"occurred_at,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetHeadlessHostResponse) Reset() {
-	*x = GetHeadlessHostResponse{}
+func (x *SessionUserEvent) Reset() {
+	*x = SessionUserEvent{}
 	mi := &file_hdlctrl_v1_controller_proto_msgTypes[55]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetHeadlessHostResponse) String() string {
+func (x *SessionUserEvent) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetHeadlessHostResponse) ProtoMessage() {}
+func (*SessionUserEvent) ProtoMessage() {}
 
-func (x *GetHeadlessHostResponse) ProtoReflect() protoreflect.Message {
+func (x *SessionUserEvent) ProtoReflect() protoreflect.Message {
 	mi := &file_hdlctrl_v1_controller_proto_msgTypes[55]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3122,40 +3895,71 @@ func (x *GetHeadlessHostResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetHeadlessHostResponse.ProtoReflect.Descriptor instead.
-func (*GetHeadlessHostResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use SessionUserEvent.ProtoReflect.Descriptor instead.
+func (*SessionUserEvent) Descriptor() ([]byte, []int) {
 	return file_hdlctrl_v1_controller_proto_rawDescGZIP(), []int{55}
 }
 
-func (x *GetHeadlessHostResponse) GetHost() *HeadlessHost {
+func (x *SessionUserEvent) GetId() int64 {
 	if x != nil {
-		return x.Host
+		return x.Id
+	}
+	return 0
+}
+
+func (x *SessionUserEvent) GetKind() SessionUserEventKind {
+	if x != nil {
+		return x.Kind
+	}
+	return SessionUserEventKind_SESSION_USER_EVENT_KIND_UNSPECIFIED
+}
+
+func (x *SessionUserEvent) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *SessionUserEvent) GetUserName() string {
+	if x != nil {
+		return x.UserName
+	}
+	return ""
+}
+
+func (x *SessionUserEvent) GetOccurredAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.OccurredAt
 	}
 	return nil
 }
 
-type AddHeadlessHostRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Address       string                 `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+type ListSessionUserEventsRequest struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	SessionId string                 `protobuf:"bytes,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	// この ID より後の出入りを返す (ページング用). 0 なら最初から
+	AfterId int64 `protobuf:"varint,2,opt,name=after_id,json=afterId,proto3" json:"after_id,omitempty"`
+	// 0 ならデフォルト (100). 最大 500
+	Limit         int32 `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *AddHeadlessHostRequest) Reset() {
-	*x = AddHeadlessHostRequest{}
+func (x *ListSessionUserEventsRequest) Reset() {
+	*x = ListSessionUserEventsRequest{}
 	mi := &file_hdlctrl_v1_controller_proto_msgTypes[56]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *AddHeadlessHostRequest) String() string {
+func (x *ListSessionUserEventsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AddHeadlessHostRequest) ProtoMessage() {}
+func (*ListSessionUserEventsRequest) ProtoMessage() {}
 
-func (x *AddHeadlessHostRequest) ProtoReflect() protoreflect.Message {
+func (x *ListSessionUserEventsRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_hdlctrl_v1_controller_proto_msgTypes[56]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3167,46 +3971,56 @@ func (x *AddHeadlessHostRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AddHeadlessHostRequest.ProtoReflect.Descriptor instead.
-func (*AddHeadlessHostRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use ListSessionUserEventsRequest.ProtoReflect.Descriptor instead.
+func (*ListSessionUserEventsRequest) Descriptor() ([]byte, []int) {
 	return file_hdlctrl_v1_controller_proto_rawDescGZIP(), []int{56}
 }
 
-func (x *AddHeadlessHostRequest) GetName() string {
+func (x *ListSessionUserEventsRequest) GetSessionId() string {
 	if x != nil {
-		return x.Name
+		return x.SessionId
 	}
 	return ""
 }
 
-func (x *AddHeadlessHostRequest) GetAddress() string {
+func (x *ListSessionUserEventsRequest) GetAfterId() int64 {
 	if x != nil {
-		return x.Address
+		return x.AfterId
 	}
-	return ""
+	return 0
 }
 
-type AddHeadlessHostResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Host          *HeadlessHost          `protobuf:"bytes,1,opt,name=host,proto3" json:"host,omitempty"`
+func (x *ListSessionUserEventsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type ListSessionUserEventsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 古い順. ホスト自身のアカウントの出入りは含まない
+	Events []*SessionUserEvent `protobuf:"bytes,1,rep,name=events,proto3" json:"events,omitempty"`
+	// 続きがあるときに after_id に指定する値. 続きがなければ 0
+	NextAfterId   int64 `protobuf:"varint,2,opt,name=next_after_id,json=nextAfterId,proto3" json:"next_after_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *AddHeadlessHostResponse) Reset() {
-	*x = AddHeadlessHostResponse{}
+func (x *ListSessionUserEventsResponse) Reset() {
+	*x = ListSessionUserEventsResponse{}
 	mi := &file_hdlctrl_v1_controller_proto_msgTypes[57]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *AddHeadlessHostResponse) String() string {
+func (x *ListSessionUserEventsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AddHeadlessHostResponse) ProtoMessage() {}
+func (*ListSessionUserEventsResponse) ProtoMessage() {}
 
-func (x *AddHeadlessHostResponse) ProtoReflect() protoreflect.Message {
+func (x *ListSessionUserEventsResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_hdlctrl_v1_controller_proto_msgTypes[57]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3218,40 +4032,54 @@ func (x *AddHeadlessHostResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AddHeadlessHostResponse.ProtoReflect.Descriptor instead.
-func (*AddHeadlessHostResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use ListSessionUserEventsResponse.ProtoReflect.Descriptor instead.
+func (*ListSessionUserEventsResponse) Descriptor() ([]byte, []int) {
 	return file_hdlctrl_v1_controller_proto_rawDescGZIP(), []int{57}
 }
 
-func (x *AddHeadlessHostResponse) GetHost() *HeadlessHost {
+func (x *ListSessionUserEventsResponse) GetEvents() []*SessionUserEvent {
 	if x != nil {
-		return x.Host
+		return x.Events
 	}
 	return nil
 }
 
-type SearchSessionsRequest struct {
-	state         protoimpl.MessageState                  `protogen:"open.v1"`
-	Parameters    *SearchSessionsRequest_SearchParameters `protobuf:"bytes,1,opt,name=parameters,proto3" json:"parameters,omitempty"`
-	Page          *PageRequest                            `protobuf:"bytes,2,opt,name=page,proto3" json:"page,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *ListSessionUserEventsResponse) GetNextAfterId() int64 {
+	if x != nil {
+		return x.NextAfterId
+	}
+	return 0
 }
 
-func (x *SearchSessionsRequest) Reset() {
-	*x = SearchSessionsRequest{}
+type SessionAttendee struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	UserName      string                 `protobuf:"bytes,2,opt,name=user_name,json=userName,proto3" json:"user_name,omitempty"`
+	FirstJoinedAt *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=first_joined_at,json=firstJoinedAt,proto3" json:"first_joined_at,omitempty"`
+	// まだセッションに居るときは未設定
+	LastLeftAt *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=last_left_at,json=lastLeftAt,proto3,oneof" json:"last_left_at,omitempty"`
+	// 滞在時間の合計
+	DurationSeconds int64 `protobuf:"varint,5,opt,name=duration_seconds,json=durationSeconds,proto3" json:"duration_seconds,omitempty"`
+	JoinCount       int32 `protobuf:"varint,6,opt,name=join_count,json=joinCount,proto3" json:"join_count,omitempty"`
+	Present         bool  `protobuf:"varint,7,opt,name=present,proto3" json:"present,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *SessionAttendee) Reset() {
+	*x = SessionAttendee{}
 	mi := &file_hdlctrl_v1_controller_proto_msgTypes[58]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SearchSessionsRequest) String() string {
+func (x *SessionAttendee) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SearchSessionsRequest) ProtoMessage() {}
+func (*SessionAttendee) ProtoMessage() {}
 
-func (x *SearchSessionsRequest) ProtoReflect() protoreflect.Message {
+func (x *SessionAttendee) ProtoReflect() protoreflect.Message {
 	mi := &file_hdlctrl_v1_controller_proto_msgTypes[58]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3263,47 +4091,81 @@ func (x *SearchSessionsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SearchSessionsRequest.ProtoReflect.Descriptor instead.
-func (*SearchSessionsRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use SessionAttendee.ProtoReflect.Descriptor instead.
+func (*SessionAttendee) Descriptor() ([]byte, []int) {
 	return file_hdlctrl_v1_controller_proto_rawDescGZIP(), []int{58}
 }
 
-func (x *SearchSessionsRequest) GetParameters() *SearchSessionsRequest_SearchParameters {
+func (x *SessionAttendee) GetUserId() string {
 	if x != nil {
-		return x.Parameters
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *SessionAttendee) GetUserName() string {
+	if x != nil {
+		return x.UserName
+	}
+	return ""
+}
+
+func (x *SessionAttendee) GetFirstJoinedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.FirstJoinedAt
 	}
 	return nil
 }
 
-func (x *SearchSessionsRequest) GetPage() *PageRequest {
+func (x *SessionAttendee) GetLastLeftAt() *timestamppb.Timestamp {
 	if x != nil {
-		return x.Page
+		return x.LastLeftAt
 	}
 	return nil
 }
 
-type SearchSessionsResponse struct {
+func (x *SessionAttendee) GetDurationSeconds() int64 {
+	if x != nil {
+		return x.DurationSeconds
+	}
+	return 0
+}
+
+func (x *SessionAttendee) GetJoinCount() int32 {
+	if x != nil {
+		return x.JoinCount
+	}
+	return 0
+}
+
+func (x *SessionAttendee) GetPresent() bool {
+	if x != nil {
+		return x.Present
+	}
+	return false
+}
+
+type GetSessionAttendanceRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Sessions      []*Session             `protobuf:"bytes,1,rep,name=sessions,proto3" json:"sessions,omitempty"`
-	Page          *PageResponse          `protobuf:"bytes,2,opt,name=page,proto3" json:"page,omitempty"`
+	SessionId     string                 `protobuf:"bytes,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *SearchSessionsResponse) Reset() {
-	*x = SearchSessionsResponse{}
+func (x *GetSessionAttendanceRequest) Reset() {
+	*x = GetSessionAttendanceRequest{}
 	mi := &file_hdlctrl_v1_controller_proto_msgTypes[59]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SearchSessionsResponse) String() string {
+func (x *GetSessionAttendanceRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SearchSessionsResponse) ProtoMessage() {}
+func (*GetSessionAttendanceRequest) ProtoMessage() {}
 
-func (x *SearchSessionsResponse) ProtoReflect() protoreflect.Message {
+func (x *GetSessionAttendanceRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_hdlctrl_v1_controller_proto_msgTypes[59]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3315,48 +4177,45 @@ func (x *SearchSessionsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SearchSessionsResponse.ProtoReflect.Descriptor instead.
-func (*SearchSessionsResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetSessionAttendanceRequest.ProtoReflect.Descriptor instead.
+func (*GetSessionAttendanceRequest) Descriptor() ([]byte, []int) {
 	return file_hdlctrl_v1_controller_proto_rawDescGZIP(), []int{59}
 }
 
-func (x *SearchSessionsResponse) GetSessions() []*Session {
-	if x != nil {
-		return x.Sessions
-	}
-	return nil
-}
-
-func (x *SearchSessionsResponse) GetPage() *PageResponse {
+func (x *GetSessionAttendanceRequest) GetSessionId() string {
 	if x != nil {
-		return x.Page
+		return x.SessionId
 	}
-	return nil
+	return ""
 }
 
-type GetSessionDetailsRequest struct {
+type GetSessionAttendanceResponse struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// Deprecated: Marked as deprecated in hdlctrl/v1/controller.proto.
-	HostId        string `protobuf:"bytes,1,opt,name=host_id,json=hostId,proto3" json:"host_id,omitempty"` // 指定不要
-	SessionId     string `protobuf:"bytes,2,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *GetSessionDetailsRequest) Reset() {
-	*x = GetSessionDetailsRequest{}
+	// 滞在時間の長い順
+	Attendees   []*SessionAttendee `protobuf:"bytes,1,rep,name=attendees,proto3" json:"attendees,omitempty"`
+	UniqueUsers int32              `protobuf:"varint,2,opt,name=unique_users,json=uniqueUsers,proto3" json:"unique_users,omitempty"`
+	PeakUsers   int32              `protobuf:"varint,3,opt,name=peak_users,json=peakUsers,proto3" json:"peak_users,omitempty"`
+	// 最初に peak_users 人になった時刻. 誰も来ていなければ未設定
+	PeakAt               *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=peak_at,json=peakAt,proto3,oneof" json:"peak_at,omitempty"`
+	TotalDurationSeconds int64                  `protobuf:"varint,5,opt,name=total_duration_seconds,json=totalDurationSeconds,proto3" json:"total_duration_seconds,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *GetSessionAttendanceResponse) Reset() {
+	*x = GetSessionAttendanceResponse{}
 	mi := &file_hdlctrl_v1_controller_proto_msgTypes[60]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetSessionDetailsRequest) String() string {
+func (x *GetSessionAttendanceResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetSessionDetailsRequest) ProtoMessage() {}
+func (*GetSessionAttendanceResponse) ProtoMessage() {}
 
-func (x *GetSessionDetailsRequest) ProtoReflect() protoreflect.Message {
+func (x *GetSessionAttendanceResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_hdlctrl_v1_controller_proto_msgTypes[60]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3368,47 +4227,75 @@ func (x *GetSessionDetailsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetSessionDetailsRequest.ProtoReflect.Descriptor instead.
-func (*GetSessionDetailsRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetSessionAttendanceResponse.ProtoReflect.Descriptor instead.
+func (*GetSessionAttendanceResponse) Descriptor() ([]byte, []int) {
 	return file_hdlctrl_v1_controller_proto_rawDescGZIP(), []int{60}
 }
 
-// Deprecated: Marked as deprecated in hdlctrl/v1/controller.proto.
-func (x *GetSessionDetailsRequest) GetHostId() string {
+func (x *GetSessionAttendanceResponse) GetAttendees() []*SessionAttendee {
 	if x != nil {
-		return x.HostId
+		return x.Attendees
 	}
-	return ""
+	return nil
 }
 
-func (x *GetSessionDetailsRequest) GetSessionId() string {
+func (x *GetSessionAttendanceResponse) GetUniqueUsers() int32 {
 	if x != nil {
-		return x.SessionId
+		return x.UniqueUsers
 	}
-	return ""
+	return 0
 }
 
-type GetSessionDetailsResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Session       *Session               `protobuf:"bytes,1,opt,name=session,proto3" json:"session,omitempty"`
+func (x *GetSessionAttendanceResponse) GetPeakUsers() int32 {
+	if x != nil {
+		return x.PeakUsers
+	}
+	return 0
+}
+
+func (x *GetSessionAttendanceResponse) GetPeakAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.PeakAt
+	}
+	return nil
+}
+
+func (x *GetSessionAttendanceResponse) GetTotalDurationSeconds() int64 {
+	if x != nil {
+		return x.TotalDurationSeconds
+	}
+	return 0
+}
+
+type GetSessionUsageStatsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 未指定なら session:read を持つすべてのグループ
+	GroupId  *string                `protobuf:"bytes,1,opt,name=group_id,json=groupId,proto3,oneof" json:"group_id,omitempty"`
+	HostId   *string                `protobuf:"bytes,2,opt,name=host_id,json=hostId,proto3,oneof" json:"host_id,omitempty"`
+	Since    *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=since,proto3" json:"since,omitempty"`
+	Until    *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=until,proto3" json:"until,omitempty"`
+	GroupBy  SessionUsageGroupBy    `protobuf:"varint,5,opt,name=group_by,json=groupBy,proto3,enum=hdlctrl.v1.SessionUsageGroupBy" json:"group_by,omitempty"`
+	Interval SessionUsageInterval   `protobuf:"varint,6,opt,name=interval,proto3,enum=hdlctrl.v1.SessionUsageInterval" json:"interval,omitempty"`
+	// バケットの区切りに使う IANA タイムゾーン (例: Asia/Tokyo). 空なら UTC
+	TimeZone      string `protobuf:"bytes,7,opt,name=time_zone,json=timeZone,proto3" json:"time_zone,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetSessionDetailsResponse) Reset() {
-	*x = GetSessionDetailsResponse{}
+func (x *GetSessionUsageStatsRequest) Reset() {
+	*x = GetSessionUsageStatsRequest{}
 	mi := &file_hdlctrl_v1_controller_proto_msgTypes[61]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetSessionDetailsResponse) String() string {
+func (x *GetSessionUsageStatsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetSessionDetailsResponse) ProtoMessage() {}
+func (*GetSessionUsageStatsRequest) ProtoMessage() {}
 
-func (x *GetSessionDetailsResponse) ProtoReflect() protoreflect.Message {
+func (x *GetSessionUsageStatsRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_hdlctrl_v1_controller_proto_msgTypes[61]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3420,44 +4307,86 @@ func (x *GetSessionDetailsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetSessionDetailsResponse.ProtoReflect.Descriptor instead.
-func (*GetSessionDetailsResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetSessionUsageStatsRequest.ProtoReflect.Descriptor instead.
+func (*GetSessionUsageStatsRequest) Descriptor() ([]byte, []int) {
 	return file_hdlctrl_v1_controller_proto_rawDescGZIP(), []int{61}
 }
 
-func (x *GetSessionDetailsResponse) GetSession() *Session {
+func (x *GetSessionUsageStatsRequest) GetGroupId() string {
+	if x != nil && x.GroupId != nil {
+		return *x.GroupId
+	}
+	return ""
+}
+
+func (x *GetSessionUsageStatsRequest) GetHostId() string {
+	if x != nil && x.HostId != nil {
+		return *x.HostId
+	}
+	return ""
+}
+
+func (x *GetSessionUsageStatsRequest) GetSince() *timestamppb.Timestamp {
 	if x != nil {
-		return x.Session
+		return x.Since
 	}
 	return nil
 }
 
-type StartWorldRequest struct {
-	state      protoimpl.MessageState     `protogen:"open.v1"`
-	HostId     string                     `protobuf:"bytes,1,opt,name=host_id,json=hostId,proto3" json:"host_id,omitempty"`
-	Parameters *v1.WorldStartupParameters `protobuf:"bytes,2,opt,name=parameters,proto3" json:"parameters,omitempty"`
-	Memo       string                     `protobuf:"bytes,3,opt,name=memo,proto3" json:"memo,omitempty"`
-	// 起動するセッションの所属グループ. 未指定の場合は呼び出しユーザーの personal グループ.
-	// 指定する場合は host / account の group_id と一致する必要がある (同一グループ制約).
-	GroupId       *string `protobuf:"bytes,4,opt,name=group_id,json=groupId,proto3,oneof" json:"group_id,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *GetSessionUsageStatsRequest) GetUntil() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Until
+	}
+	return nil
 }
 
-func (x *StartWorldRequest) Reset() {
-	*x = StartWorldRequest{}
+func (x *GetSessionUsageStatsRequest) GetGroupBy() SessionUsageGroupBy {
+	if x != nil {
+		return x.GroupBy
+	}
+	return SessionUsageGroupBy_SESSION_USAGE_GROUP_BY_UNSPECIFIED
+}
+
+func (x *GetSessionUsageStatsRequest) GetInterval() SessionUsageInterval {
+	if x != nil {
+		return x.Interval
+	}
+	return SessionUsageInterval_SESSION_USAGE_INTERVAL_UNSPECIFIED
+}
+
+func (x *GetSessionUsageStatsRequest) GetTimeZone() string {
+	if x != nil {
+		return x.TimeZone
+	}
+	return ""
+}
+
+type SessionUsageBucket struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Start *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=start,proto3" json:"start,omitempty"`
+	// バケット中に開いていたセッションの数
+	Sessions            int32 `protobuf:"varint,2,opt,name=sessions,proto3" json:"sessions,omitempty"`
+	UniqueUsers         int32 `protobuf:"varint,3,opt,name=unique_users,json=uniqueUsers,proto3" json:"unique_users,omitempty"`
+	PeakUsers           int32 `protobuf:"varint,4,opt,name=peak_users,json=peakUsers,proto3" json:"peak_users,omitempty"`
+	UserDurationSeconds int64 `protobuf:"varint,5,opt,name=user_duration_seconds,json=userDurationSeconds,proto3" json:"user_duration_seconds,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *SessionUsageBucket) Reset() {
+	*x = SessionUsageBucket{}
 	mi := &file_hdlctrl_v1_controller_proto_msgTypes[62]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *StartWorldRequest) String() string {
+func (x *SessionUsageBucket) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*StartWorldRequest) ProtoMessage() {}
+func (*SessionUsageBucket) ProtoMessage() {}
 
-func (x *StartWorldRequest) ProtoReflect() protoreflect.Message {
+func (x *SessionUsageBucket) ProtoReflect() protoreflect.Message {
 	mi := &file_hdlctrl_v1_controller_proto_msgTypes[62]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3469,60 +4398,72 @@ func (x *StartWorldRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use StartWorldRequest.ProtoReflect.Descriptor instead.
-func (*StartWorldRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use SessionUsageBucket.ProtoReflect.Descriptor instead.
+func (*SessionUsageBucket) Descriptor() ([]byte, []int) {
 	return file_hdlctrl_v1_controller_proto_rawDescGZIP(), []int{62}
 }
 
-func (x *StartWorldRequest) GetHostId() string {
+func (x *SessionUsageBucket) GetStart() *timestamppb.Timestamp {
 	if x != nil {
-		return x.HostId
+		return x.Start
 	}
-	return ""
+	return nil
 }
 
-func (x *StartWorldRequest) GetParameters() *v1.WorldStartupParameters {
+func (x *SessionUsageBucket) GetSessions() int32 {
 	if x != nil {
-		return x.Parameters
+		return x.Sessions
 	}
-	return nil
+	return 0
 }
 
-func (x *StartWorldRequest) GetMemo() string {
+func (x *SessionUsageBucket) GetUniqueUsers() int32 {
 	if x != nil {
-		return x.Memo
+		return x.UniqueUsers
 	}
-	return ""
+	return 0
 }
 
-func (x *StartWorldRequest) GetGroupId() string {
-	if x != nil && x.GroupId != nil {
-		return *x.GroupId
+func (x *SessionUsageBucket) GetPeakUsers() int32 {
+	if x != nil {
+		return x.PeakUsers
 	}
-	return ""
+	return 0
 }
 
-type StartWorldResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	JobId         string                 `protobuf:"bytes,2,opt,name=job_id,json=jobId,proto3" json:"job_id,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *SessionUsageBucket) GetUserDurationSeconds() int64 {
+	if x != nil {
+		return x.UserDurationSeconds
+	}
+	return 0
 }
 
-func (x *StartWorldResponse) Reset() {
-	*x = StartWorldResponse{}
+type SessionUsageSeries struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// ワールドなら URL (プリセットなら "preset:" + 名前)、ホストならホスト ID
+	Key                 string                `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Label               string                `protobuf:"bytes,2,opt,name=label,proto3" json:"label,omitempty"`
+	Buckets             []*SessionUsageBucket `protobuf:"bytes,3,rep,name=buckets,proto3" json:"buckets,omitempty"`
+	UniqueUsers         int32                 `protobuf:"varint,4,opt,name=unique_users,json=uniqueUsers,proto3" json:"unique_users,omitempty"`
+	UserDurationSeconds int64                 `protobuf:"varint,5,opt,name=user_duration_seconds,json=userDurationSeconds,proto3" json:"user_duration_seconds,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *SessionUsageSeries) Reset() {
+	*x = SessionUsageSeries{}
 	mi := &file_hdlctrl_v1_controller_proto_msgTypes[63]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *StartWorldResponse) String() string {
+func (x *SessionUsageSeries) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*StartWorldResponse) ProtoMessage() {}
+func (*SessionUsageSeries) ProtoMessage() {}
 
-func (x *StartWorldResponse) ProtoReflect() protoreflect.Message {
+func (x *SessionUsageSeries) ProtoReflect() protoreflect.Message {
 	mi := &file_hdlctrl_v1_controller_proto_msgTypes[63]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3534,41 +4475,68 @@ func (x *StartWorldResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use StartWorldResponse.ProtoReflect.Descriptor instead.
-func (*StartWorldResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use SessionUsageSeries.ProtoReflect.Descriptor instead.
+func (*SessionUsageSeries) Descriptor() ([]byte, []int) {
 	return file_hdlctrl_v1_controller_proto_rawDescGZIP(), []int{63}
 }
 
-func (x *StartWorldResponse) GetJobId() string {
+func (x *SessionUsageSeries) GetKey() string {
 	if x != nil {
-		return x.JobId
+		return x.Key
 	}
 	return ""
 }
 
-type StopSessionRequest struct {
+func (x *SessionUsageSeries) GetLabel() string {
+	if x != nil {
+		return x.Label
+	}
+	return ""
+}
+
+func (x *SessionUsageSeries) GetBuckets() []*SessionUsageBucket {
+	if x != nil {
+		return x.Buckets
+	}
+	return nil
+}
+
+func (x *SessionUsageSeries) GetUniqueUsers() int32 {
+	if x != nil {
+		return x.UniqueUsers
+	}
+	return 0
+}
+
+func (x *SessionUsageSeries) GetUserDurationSeconds() int64 {
+	if x != nil {
+		return x.UserDurationSeconds
+	}
+	return 0
+}
+
+type GetSessionUsageStatsResponse struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// Deprecated: Marked as deprecated in hdlctrl/v1/controller.proto.
-	HostId        string `protobuf:"bytes,1,opt,name=host_id,json=hostId,proto3" json:"host_id,omitempty"` // 指定不要
-	SessionId     string `protobuf:"bytes,2,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	// 滞在時間の合計が長い順
+	Series        []*SessionUsageSeries `protobuf:"bytes,1,rep,name=series,proto3" json:"series,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *StopSessionRequest) Reset() {
-	*x = StopSessionRequest{}
+func (x *GetSessionUsageStatsResponse) Reset() {
+	*x = GetSessionUsageStatsResponse{}
 	mi := &file_hdlctrl_v1_controller_proto_msgTypes[64]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *StopSessionRequest) String() string {
+func (x *GetSessionUsageStatsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*StopSessionRequest) ProtoMessage() {}
+func (*GetSessionUsageStatsResponse) ProtoMessage() {}
 
-func (x *StopSessionRequest) ProtoReflect() protoreflect.Message {
+func (x *GetSessionUsageStatsResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_hdlctrl_v1_controller_proto_msgTypes[64]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3580,47 +4548,43 @@ func (x *StopSessionRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use StopSessionRequest.ProtoReflect.Descriptor instead.
-func (*StopSessionRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetSessionUsageStatsResponse.ProtoReflect.Descriptor instead.
+func (*GetSessionUsageStatsResponse) Descriptor() ([]byte, []int) {
 	return file_hdlctrl_v1_controller_proto_rawDescGZIP(), []int{64}
 }
 
-// Deprecated: Marked as deprecated in hdlctrl/v1/controller.proto.
-func (x *StopSessionRequest) GetHostId() string {
-	if x != nil {
-		return x.HostId
-	}
-	return ""
-}
-
-func (x *StopSessionRequest) GetSessionId() string {
+func (x *GetSessionUsageStatsResponse) GetSeries() []*SessionUsageSeries {
 	if x != nil {
-		return x.SessionId
+		return x.Series
 	}
-	return ""
+	return nil
 }
 
-type StopSessionResponse struct {
+type GetMetricsSeriesRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	JobId         string                 `protobuf:"bytes,1,opt,name=job_id,json=jobId,proto3" json:"job_id,omitempty"`
+	Kind          MetricKind             `protobuf:"varint,1,opt,name=kind,proto3,enum=hdlctrl.v1.MetricKind" json:"kind,omitempty"`
+	TargetId      string                 `protobuf:"bytes,2,opt,name=target_id,json=targetId,proto3" json:"target_id,omitempty"`
+	Since         *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=since,proto3" json:"since,omitempty"`
+	Until         *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=until,proto3" json:"until,omitempty"`
+	Resolution    MetricResolution       `protobuf:"varint,5,opt,name=resolution,proto3,enum=hdlctrl.v1.MetricResolution" json:"resolution,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *StopSessionResponse) Reset() {
-	*x = StopSessionResponse{}
+func (x *GetMetricsSeriesRequest) Reset() {
+	*x = GetMetricsSeriesRequest{}
 	mi := &file_hdlctrl_v1_controller_proto_msgTypes[65]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *StopSessionResponse) String() string {
+func (x *GetMetricsSeriesRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*StopSessionResponse) ProtoMessage() {}
+func (*GetMetricsSeriesRequest) ProtoMessage() {}
 
-func (x *StopSessionResponse) ProtoReflect() protoreflect.Message {
+func (x *GetMetricsSeriesRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_hdlctrl_v1_controller_proto_msgTypes[65]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3632,39 +4596,73 @@ func (x *StopSessionResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use StopSessionResponse.ProtoReflect.Descriptor instead.
-func (*StopSessionResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetMetricsSeriesRequest.ProtoReflect.Descriptor instead.
+func (*GetMetricsSeriesRequest) Descriptor() ([]byte, []int) {
 	return file_hdlctrl_v1_controller_proto_rawDescGZIP(), []int{65}
 }
 
-func (x *StopSessionResponse) GetJobId() string {
+func (x *GetMetricsSeriesRequest) GetKind() MetricKind {
 	if x != nil {
-		return x.JobId
+		return x.Kind
+	}
+	return MetricKind_METRIC_KIND_UNSPECIFIED
+}
+
+func (x *GetMetricsSeriesRequest) GetTargetId() string {
+	if x != nil {
+		return x.TargetId
 	}
 	return ""
 }
 
-type DeleteEndedSessionRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	SessionId     string                 `protobuf:"bytes,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+func (x *GetMetricsSeriesRequest) GetSince() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Since
+	}
+	return nil
+}
+
+func (x *GetMetricsSeriesRequest) GetUntil() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Until
+	}
+	return nil
+}
+
+func (x *GetMetricsSeriesRequest) GetResolution() MetricResolution {
+	if x != nil {
+		return x.Resolution
+	}
+	return MetricResolution_METRIC_RESOLUTION_UNSPECIFIED
+}
+
+type MetricPoint struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// バケットの開始時刻
+	At  *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=at,proto3" json:"at,omitempty"`
+	Avg float64                `protobuf:"fixed64,2,opt,name=avg,proto3" json:"avg,omitempty"`
+	Min float64                `protobuf:"fixed64,3,opt,name=min,proto3" json:"min,omitempty"`
+	Max float64                `protobuf:"fixed64,4,opt,name=max,proto3" json:"max,omitempty"`
+	// バケットに含まれるサンプル数
+	SampleCount   int32 `protobuf:"varint,5,opt,name=sample_count,json=sampleCount,proto3" json:"sample_count,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeleteEndedSessionRequest) Reset() {
-	*x = DeleteEndedSessionRequest{}
+func (x *MetricPoint) Reset() {
+	*x = MetricPoint{}
 	mi := &file_hdlctrl_v1_controller_proto_msgTypes[66]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteEndedSessionRequest) String() string {
+func (x *MetricPoint) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteEndedSessionRequest) ProtoMessage() {}
+func (*MetricPoint) ProtoMessage() {}
 
-func (x *DeleteEndedSessionRequest) ProtoReflect() protoreflect.Message {
+func (x *MetricPoint) ProtoReflect() protoreflect.Message {
 	mi := &file_hdlctrl_v1_controller_proto_msgTypes[66]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3676,38 +4674,70 @@ func (x *DeleteEndedSessionRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteEndedSessionRequest.ProtoReflect.Descriptor instead.
-func (*DeleteEndedSessionRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use MetricPoint.ProtoReflect.Descriptor instead.
+func (*MetricPoint) Descriptor() ([]byte, []int) {
 	return file_hdlctrl_v1_controller_proto_rawDescGZIP(), []int{66}
 }
 
-func (x *DeleteEndedSessionRequest) GetSessionId() string {
+func (x *MetricPoint) GetAt() *timestamppb.Timestamp {
 	if x != nil {
-		return x.SessionId
+		return x.At
 	}
-	return ""
+	return nil
 }
 
-type DeleteEndedSessionResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
+func (x *MetricPoint) GetAvg() float64 {
+	if x != nil {
+		return x.Avg
+	}
+	return 0
+}
+
+func (x *MetricPoint) GetMin() float64 {
+	if x != nil {
+		return x.Min
+	}
+	return 0
+}
+
+func (x *MetricPoint) GetMax() float64 {
+	if x != nil {
+		return x.Max
+	}
+	return 0
+}
+
+func (x *MetricPoint) GetSampleCount() int32 {
+	if x != nil {
+		return x.SampleCount
+	}
+	return 0
+}
+
+type GetMetricsSeriesResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 実際に使った解像度
+	Resolution MetricResolution `protobuf:"varint,1,opt,name=resolution,proto3,enum=hdlctrl.v1.MetricResolution" json:"resolution,omitempty"`
+	// 時刻順. 記録がない時間帯の点は含まない
+	Points        []*MetricPoint `protobuf:"bytes,2,rep,name=points,proto3" json:"points,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeleteEndedSessionResponse) Reset() {
-	*x = DeleteEndedSessionResponse{}
+func (x *GetMetricsSeriesResponse) Reset() {
+	*x = GetMetricsSeriesResponse{}
 	mi := &file_hdlctrl_v1_controller_proto_msgTypes[67]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteEndedSessionResponse) String() string {
+func (x *GetMetricsSeriesResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteEndedSessionResponse) ProtoMessage() {}
+func (*GetMetricsSeriesResponse) ProtoMessage() {}
 
-func (x *DeleteEndedSessionResponse) ProtoReflect() protoreflect.Message {
+func (x *GetMetricsSeriesResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_hdlctrl_v1_controller_proto_msgTypes[67]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3719,35 +4749,47 @@ func (x *DeleteEndedSessionResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteEndedSessionResponse.ProtoReflect.Descriptor instead.
-func (*DeleteEndedSessionResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetMetricsSeriesResponse.ProtoReflect.Descriptor instead.
+func (*GetMetricsSeriesResponse) Descriptor() ([]byte, []int) {
 	return file_hdlctrl_v1_controller_proto_rawDescGZIP(), []int{67}
 }
 
-type SaveSessionWorldRequest struct {
-	state protoimpl.MessageState `protogen:"open.v1"`
-	// Deprecated: Marked as deprecated in hdlctrl/v1/controller.proto.
-	HostId        string                           `protobuf:"bytes,1,opt,name=host_id,json=hostId,proto3" json:"host_id,omitempty"` // 指定不要
-	SessionId     string                           `protobuf:"bytes,2,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
-	SaveMode      SaveSessionWorldRequest_SaveMode `protobuf:"varint,3,opt,name=save_mode,json=saveMode,proto3,enum=hdlctrl.v1.SaveSessionWorldRequest_SaveMode" json:"save_mode,omitempty"`
+func (x *GetMetricsSeriesResponse) GetResolution() MetricResolution {
+	if x != nil {
+		return x.Resolution
+	}
+	return MetricResolution_METRIC_RESOLUTION_UNSPECIFIED
+}
+
+func (x *GetMetricsSeriesResponse) GetPoints() []*MetricPoint {
+	if x != nil {
+		return x.Points
+	}
+	return nil
+}
+
+type FetchWorldInfoRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	HostId        string                 `protobuf:"bytes,1,opt,name=host_id,json=hostId,proto3" json:"host_id,omitempty"`
+	Url           string                 `protobuf:"bytes,2,opt,name=url,proto3" json:"url,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *SaveSessionWorldRequest) Reset() {
-	*x = SaveSessionWorldRequest{}
+func (x *FetchWorldInfoRequest) Reset() {
+	*x = FetchWorldInfoRequest{}
 	mi := &file_hdlctrl_v1_controller_proto_msgTypes[68]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SaveSessionWorldRequest) String() string {
+func (x *FetchWorldInfoRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SaveSessionWorldRequest) ProtoMessage() {}
+func (*FetchWorldInfoRequest) ProtoMessage() {}
 
-func (x *SaveSessionWorldRequest) ProtoReflect() protoreflect.Message {
+func (x *FetchWorldInfoRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_hdlctrl_v1_controller_proto_msgTypes[68]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3759,54 +4801,48 @@ func (x *SaveSessionWorldRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SaveSessionWorldRequest.ProtoReflect.Descriptor instead.
-func (*SaveSessionWorldRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use FetchWorldInfoRequest.ProtoReflect.Descriptor instead.
+func (*FetchWorldInfoRequest) Descriptor() ([]byte, []int) {
 	return file_hdlctrl_v1_controller_proto_rawDescGZIP(), []int{68}
 }
 
-// Deprecated: Marked as deprecated in hdlctrl/v1/controller.proto.
-func (x *SaveSessionWorldRequest) GetHostId() string {
+func (x *FetchWorldInfoRequest) GetHostId() string {
 	if x != nil {
 		return x.HostId
 	}
 	return ""
 }
 
-func (x *SaveSessionWorldRequest) GetSessionId() string {
+func (x *FetchWorldInfoRequest) GetUrl() string {
 	if x != nil {
-		return x.SessionId
+		return x.Url
 	}
 	return ""
 }
 
-func (x *SaveSessionWorldRequest) GetSaveMode() SaveSessionWorldRequest_SaveMode {
-	if x != nil {
-		return x.SaveMode
-	}
-	return SaveSessionWorldRequest_SAVE_MODE_UNKNOWN
-}
-
-type SaveSessionWorldResponse struct {
-	state          protoimpl.MessageState `protogen:"open.v1"`
-	SavedRecordUrl *string                `protobuf:"bytes,1,opt,name=saved_record_url,json=savedRecordUrl,proto3,oneof" json:"saved_record_url,omitempty"`
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+type SearchWorldsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Query         string                 `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`
+	FeaturedOnly  bool                   `protobuf:"varint,2,opt,name=featured_only,json=featuredOnly,proto3" json:"featured_only,omitempty"`
+	PageIndex     int32                  `protobuf:"varint,3,opt,name=page_index,json=pageIndex,proto3" json:"page_index,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *SaveSessionWorldResponse) Reset() {
-	*x = SaveSessionWorldResponse{}
+func (x *SearchWorldsRequest) Reset() {
+	*x = SearchWorldsRequest{}
 	mi := &file_hdlctrl_v1_controller_proto_msgTypes[69]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SaveSessionWorldResponse) String() string {
+func (x *SearchWorldsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SaveSessionWorldResponse) ProtoMessage() {}
+func (*SearchWorldsRequest) ProtoMessage() {}
 
-func (x *SaveSessionWorldResponse) ProtoReflect() protoreflect.Message {
+func (x *SearchWorldsRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_hdlctrl_v1_controller_proto_msgTypes[69]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3818,40 +4854,54 @@ func (x *SaveSessionWorldResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SaveSessionWorldResponse.ProtoReflect.Descriptor instead.
-func (*SaveSessionWorldResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use SearchWorldsRequest.ProtoReflect.Descriptor instead.
+func (*SearchWorldsRequest) Descriptor() ([]byte, []int) {
 	return file_hdlctrl_v1_controller_proto_rawDescGZIP(), []int{69}
 }
 
-func (x *SaveSessionWorldResponse) GetSavedRecordUrl() string {
-	if x != nil && x.SavedRecordUrl != nil {
-		return *x.SavedRecordUrl
+func (x *SearchWorldsRequest) GetQuery() string {
+	if x != nil {
+		return x.Query
 	}
 	return ""
 }
 
-type PrepareSessionWorldDownloadRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	SessionId     string                 `protobuf:"bytes,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
-	Format        v1.WorldBinaryFormat   `protobuf:"varint,2,opt,name=format,proto3,enum=headless.v1.WorldBinaryFormat" json:"format,omitempty"`
+func (x *SearchWorldsRequest) GetFeaturedOnly() bool {
+	if x != nil {
+		return x.FeaturedOnly
+	}
+	return false
+}
+
+func (x *SearchWorldsRequest) GetPageIndex() int32 {
+	if x != nil {
+		return x.PageIndex
+	}
+	return 0
+}
+
+type SearchWorldsResponse struct {
+	state         protoimpl.MessageState              `protogen:"open.v1"`
+	Records       []*SearchWorldsResponse_WorldRecord `protobuf:"bytes,1,rep,name=records,proto3" json:"records,omitempty"`
+	HasMore       bool                                `protobuf:"varint,2,opt,name=has_more,json=hasMore,proto3" json:"has_more,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *PrepareSessionWorldDownloadRequest) Reset() {
-	*x = PrepareSessionWorldDownloadRequest{}
+func (x *SearchWorldsResponse) Reset() {
+	*x = SearchWorldsResponse{}
 	mi := &file_hdlctrl_v1_controller_proto_msgTypes[70]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *PrepareSessionWorldDownloadRequest) String() string {
+func (x *SearchWorldsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PrepareSessionWorldDownloadRequest) ProtoMessage() {}
+func (*SearchWorldsResponse) ProtoMessage() {}
 
-func (x *PrepareSessionWorldDownloadRequest) ProtoReflect() protoreflect.Message {
+func (x *SearchWorldsResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_hdlctrl_v1_controller_proto_msgTypes[70]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3863,49 +4913,47 @@ func (x *PrepareSessionWorldDownloadRequest) ProtoReflect() protoreflect.Message
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PrepareSessionWorldDownloadRequest.ProtoReflect.Descriptor instead.
-func (*PrepareSessionWorldDownloadRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use SearchWorldsResponse.ProtoReflect.Descriptor instead.
+func (*SearchWorldsResponse) Descriptor() ([]byte, []int) {
 	return file_hdlctrl_v1_controller_proto_rawDescGZIP(), []int{70}
 }
 
-func (x *PrepareSessionWorldDownloadRequest) GetSessionId() string {
+func (x *SearchWorldsResponse) GetRecords() []*SearchWorldsResponse_WorldRecord {
 	if x != nil {
-		return x.SessionId
+		return x.Records
 	}
-	return ""
+	return nil
 }
 
-func (x *PrepareSessionWorldDownloadRequest) GetFormat() v1.WorldBinaryFormat {
+func (x *SearchWorldsResponse) GetHasMore() bool {
 	if x != nil {
-		return x.Format
+		return x.HasMore
 	}
-	return v1.WorldBinaryFormat(0)
+	return false
 }
 
-type PrepareSessionWorldDownloadResponse struct {
-	state protoimpl.MessageState `protogen:"open.v1"`
-	// ブラウザがそのままアクセス可能な相対 URL: /blobs/<uuid>
-	DownloadUrl string `protobuf:"bytes,1,opt,name=download_url,json=downloadUrl,proto3" json:"download_url,omitempty"`
-	// 推奨ファイル名 (ブラウザの Content-Disposition でも提供されるが、UI 表示用にも返す)
-	Filename      string `protobuf:"bytes,2,opt,name=filename,proto3" json:"filename,omitempty"`
+type GetOwnWorldsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	HostId        string                 `protobuf:"bytes,1,opt,name=host_id,json=hostId,proto3" json:"host_id,omitempty"`
+	PageIndex     int32                  `protobuf:"varint,2,opt,name=page_index,json=pageIndex,proto3" json:"page_index,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *PrepareSessionWorldDownloadResponse) Reset() {
-	*x = PrepareSessionWorldDownloadResponse{}
+func (x *GetOwnWorldsRequest) Reset() {
+	*x = GetOwnWorldsRequest{}
 	mi := &file_hdlctrl_v1_controller_proto_msgTypes[71]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *PrepareSessionWorldDownloadResponse) String() string {
+func (x *GetOwnWorldsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PrepareSessionWorldDownloadResponse) ProtoMessage() {}
+func (*GetOwnWorldsRequest) ProtoMessage() {}
 
-func (x *PrepareSessionWorldDownloadResponse) ProtoReflect() protoreflect.Message {
+func (x *GetOwnWorldsRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_hdlctrl_v1_controller_proto_msgTypes[71]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3917,52 +4965,47 @@ func (x *PrepareSessionWorldDownloadResponse) ProtoReflect() protoreflect.Messag
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PrepareSessionWorldDownloadResponse.ProtoReflect.Descriptor instead.
-func (*PrepareSessionWorldDownloadResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetOwnWorldsRequest.ProtoReflect.Descriptor instead.
+func (*GetOwnWorldsRequest) Descriptor() ([]byte, []int) {
 	return file_hdlctrl_v1_controller_proto_rawDescGZIP(), []int{71}
 }
 
-func (x *PrepareSessionWorldDownloadResponse) GetDownloadUrl() string {
+func (x *GetOwnWorldsRequest) GetHostId() string {
 	if x != nil {
-		return x.DownloadUrl
+		return x.HostId
 	}
 	return ""
 }
 
-func (x *PrepareSessionWorldDownloadResponse) GetFilename() string {
+func (x *GetOwnWorldsRequest) GetPageIndex() int32 {
 	if x != nil {
-		return x.Filename
+		return x.PageIndex
 	}
-	return ""
+	return 0
 }
 
-type InviteUserRequest struct {
-	state     protoimpl.MessageState `protogen:"open.v1"`
-	HostId    string                 `protobuf:"bytes,1,opt,name=host_id,json=hostId,proto3" json:"host_id,omitempty"`
-	SessionId string                 `protobuf:"bytes,2,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
-	// Types that are valid to be assigned to User:
-	//
-	//	*InviteUserRequest_UserId
-	//	*InviteUserRequest_UserName
-	User          isInviteUserRequest_User `protobuf_oneof:"user"`
+type GetOwnWorldsResponse struct {
+	state         protoimpl.MessageState              `protogen:"open.v1"`
+	Records       []*SearchWorldsResponse_WorldRecord `protobuf:"bytes,1,rep,name=records,proto3" json:"records,omitempty"`
+	HasMore       bool                                `protobuf:"varint,2,opt,name=has_more,json=hasMore,proto3" json:"has_more,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *InviteUserRequest) Reset() {
-	*x = InviteUserRequest{}
+func (x *GetOwnWorldsResponse) Reset() {
+	*x = GetOwnWorldsResponse{}
 	mi := &file_hdlctrl_v1_controller_proto_msgTypes[72]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *InviteUserRequest) String() string {
+func (x *GetOwnWorldsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*InviteUserRequest) ProtoMessage() {}
+func (*GetOwnWorldsResponse) ProtoMessage() {}
 
-func (x *InviteUserRequest) ProtoReflect() protoreflect.Message {
+func (x *GetOwnWorldsResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_hdlctrl_v1_controller_proto_msgTypes[72]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3974,86 +5017,50 @@ func (x *InviteUserRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use InviteUserRequest.ProtoReflect.Descriptor instead.
-func (*InviteUserRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetOwnWorldsResponse.ProtoReflect.Descriptor instead.
+func (*GetOwnWorldsResponse) Descriptor() ([]byte, []int) {
 	return file_hdlctrl_v1_controller_proto_rawDescGZIP(), []int{72}
 }
 
-func (x *InviteUserRequest) GetHostId() string {
-	if x != nil {
-		return x.HostId
-	}
-	return ""
-}
-
-func (x *InviteUserRequest) GetSessionId() string {
-	if x != nil {
-		return x.SessionId
-	}
-	return ""
-}
-
-func (x *InviteUserRequest) GetUser() isInviteUserRequest_User {
+func (x *GetOwnWorldsResponse) GetRecords() []*SearchWorldsResponse_WorldRecord {
 	if x != nil {
-		return x.User
+		return x.Records
 	}
 	return nil
 }
 
-func (x *InviteUserRequest) GetUserId() string {
-	if x != nil {
-		if x, ok := x.User.(*InviteUserRequest_UserId); ok {
-			return x.UserId
-		}
-	}
-	return ""
-}
-
-func (x *InviteUserRequest) GetUserName() string {
+func (x *GetOwnWorldsResponse) GetHasMore() bool {
 	if x != nil {
-		if x, ok := x.User.(*InviteUserRequest_UserName); ok {
-			return x.UserName
-		}
+		return x.HasMore
 	}
-	return ""
-}
-
-type isInviteUserRequest_User interface {
-	isInviteUserRequest_User()
-}
-
-type InviteUserRequest_UserId struct {
-	UserId string `protobuf:"bytes,3,opt,name=user_id,json=userId,proto3,oneof"`
-}
-
-type InviteUserRequest_UserName struct {
-	UserName string `protobuf:"bytes,4,opt,name=user_name,json=userName,proto3,oneof"`
+	return false
 }
 
-func (*InviteUserRequest_UserId) isInviteUserRequest_User() {}
-
-func (*InviteUserRequest_UserName) isInviteUserRequest_User() {}
-
-type InviteUserResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
+type ListHeadlessHostRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Page  *PageRequest           `protobuf:"bytes,1,opt,name=page,proto3" json:"page,omitempty"`
+	// 指定したグループに所属するホストのみを返す.
+	// 未指定の場合は呼び出しユーザーが host:read を持つグループ群に絞り込む.
+	// system:group.list 保持者は未指定時に全件返却.
+	GroupId       *string `protobuf:"bytes,2,opt,name=group_id,json=groupId,proto3,oneof" json:"group_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *InviteUserResponse) Reset() {
-	*x = InviteUserResponse{}
+func (x *ListHeadlessHostRequest) Reset() {
+	*x = ListHeadlessHostRequest{}
 	mi := &file_hdlctrl_v1_controller_proto_msgTypes[73]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *InviteUserResponse) String() string {
+func (x *ListHeadlessHostRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*InviteUserResponse) ProtoMessage() {}
+func (*ListHeadlessHostRequest) ProtoMessage() {}
 
-func (x *InviteUserResponse) ProtoReflect() protoreflect.Message {
+func (x *ListHeadlessHostRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_hdlctrl_v1_controller_proto_msgTypes[73]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4065,33 +5072,47 @@ func (x *InviteUserResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use InviteUserResponse.ProtoReflect.Descriptor instead.
-func (*InviteUserResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use ListHeadlessHostRequest.ProtoReflect.Descriptor instead.
+func (*ListHeadlessHostRequest) Descriptor() ([]byte, []int) {
 	return file_hdlctrl_v1_controller_proto_rawDescGZIP(), []int{73}
 }
 
-type UpdateUserRoleRequest struct {
-	state         protoimpl.MessageState    `protogen:"open.v1"`
-	HostId        string                    `protobuf:"bytes,1,opt,name=host_id,json=hostId,proto3" json:"host_id,omitempty"`
-	Parameters    *v1.UpdateUserRoleRequest `protobuf:"bytes,2,opt,name=parameters,proto3" json:"parameters,omitempty"`
+func (x *ListHeadlessHostRequest) GetPage() *PageRequest {
+	if x != nil {
+		return x.Page
+	}
+	return nil
+}
+
+func (x *ListHeadlessHostRequest) GetGroupId() string {
+	if x != nil && x.GroupId != nil {
+		return *x.GroupId
+	}
+	return ""
+}
+
+type ListHeadlessHostResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Hosts         []*HeadlessHost        `protobuf:"bytes,1,rep,name=hosts,proto3" json:"hosts,omitempty"`
+	Page          *PageResponse          `protobuf:"bytes,2,opt,name=page,proto3" json:"page,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateUserRoleRequest) Reset() {
-	*x = UpdateUserRoleRequest{}
+func (x *ListHeadlessHostResponse) Reset() {
+	*x = ListHeadlessHostResponse{}
 	mi := &file_hdlctrl_v1_controller_proto_msgTypes[74]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateUserRoleRequest) String() string {
+func (x *ListHeadlessHostResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateUserRoleRequest) ProtoMessage() {}
+func (*ListHeadlessHostResponse) ProtoMessage() {}
 
-func (x *UpdateUserRoleRequest) ProtoReflect() protoreflect.Message {
+func (x *ListHeadlessHostResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_hdlctrl_v1_controller_proto_msgTypes[74]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4103,46 +5124,46 @@ func (x *UpdateUserRoleRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateUserRoleRequest.ProtoReflect.Descriptor instead.
-func (*UpdateUserRoleRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use ListHeadlessHostResponse.ProtoReflect.Descriptor instead.
+func (*ListHeadlessHostResponse) Descriptor() ([]byte, []int) {
 	return file_hdlctrl_v1_controller_proto_rawDescGZIP(), []int{74}
 }
 
-func (x *UpdateUserRoleRequest) GetHostId() string {
+func (x *ListHeadlessHostResponse) GetHosts() []*HeadlessHost {
 	if x != nil {
-		return x.HostId
+		return x.Hosts
 	}
-	return ""
+	return nil
 }
 
-func (x *UpdateUserRoleRequest) GetParameters() *v1.UpdateUserRoleRequest {
+func (x *ListHeadlessHostResponse) GetPage() *PageResponse {
 	if x != nil {
-		return x.Parameters
+		return x.Page
 	}
 	return nil
 }
 
-type UpdateUserRoleResponse struct {
+type GetHeadlessHostRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Role          string                 `protobuf:"bytes,1,opt,name=role,proto3" json:"role,omitempty"`
+	HostId        string                 `protobuf:"bytes,1,opt,name=host_id,json=hostId,proto3" json:"host_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateUserRoleResponse) Reset() {
-	*x = UpdateUserRoleResponse{}
+func (x *GetHeadlessHostRequest) Reset() {
+	*x = GetHeadlessHostRequest{}
 	mi := &file_hdlctrl_v1_controller_proto_msgTypes[75]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateUserRoleResponse) String() string {
+func (x *GetHeadlessHostRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateUserRoleResponse) ProtoMessage() {}
+func (*GetHeadlessHostRequest) ProtoMessage() {}
 
-func (x *UpdateUserRoleResponse) ProtoReflect() protoreflect.Message {
+func (x *GetHeadlessHostRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_hdlctrl_v1_controller_proto_msgTypes[75]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4154,40 +5175,39 @@ func (x *UpdateUserRoleResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateUserRoleResponse.ProtoReflect.Descriptor instead.
-func (*UpdateUserRoleResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetHeadlessHostRequest.ProtoReflect.Descriptor instead.
+func (*GetHeadlessHostRequest) Descriptor() ([]byte, []int) {
 	return file_hdlctrl_v1_controller_proto_rawDescGZIP(), []int{75}
 }
 
-func (x *UpdateUserRoleResponse) GetRole() string {
+func (x *GetHeadlessHostRequest) GetHostId() string {
 	if x != nil {
-		return x.Role
+		return x.HostId
 	}
 	return ""
 }
 
-type UpdateSessionParametersRequest struct {
-	state         protoimpl.MessageState             `protogen:"open.v1"`
-	HostId        string                             `protobuf:"bytes,1,opt,name=host_id,json=hostId,proto3" json:"host_id,omitempty"`
-	Parameters    *v1.UpdateSessionParametersRequest `protobuf:"bytes,2,opt,name=parameters,proto3" json:"parameters,omitempty"`
+type GetHeadlessHostResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Host          *HeadlessHost          `protobuf:"bytes,1,opt,name=host,proto3" json:"host,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateSessionParametersRequest) Reset() {
-	*x = UpdateSessionParametersRequest{}
+func (x *GetHeadlessHostResponse) Reset() {
+	*x = GetHeadlessHostResponse{}
 	mi := &file_hdlctrl_v1_controller_proto_msgTypes[76]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateSessionParametersRequest) String() string {
+func (x *GetHeadlessHostResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateSessionParametersRequest) ProtoMessage() {}
+func (*GetHeadlessHostResponse) ProtoMessage() {}
 
-func (x *UpdateSessionParametersRequest) ProtoReflect() protoreflect.Message {
+func (x *GetHeadlessHostResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_hdlctrl_v1_controller_proto_msgTypes[76]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4199,45 +5219,40 @@ func (x *UpdateSessionParametersRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateSessionParametersRequest.ProtoReflect.Descriptor instead.
-func (*UpdateSessionParametersRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetHeadlessHostResponse.ProtoReflect.Descriptor instead.
+func (*GetHeadlessHostResponse) Descriptor() ([]byte, []int) {
 	return file_hdlctrl_v1_controller_proto_rawDescGZIP(), []int{76}
 }
 
-func (x *UpdateSessionParametersRequest) GetHostId() string {
-	if x != nil {
-		return x.HostId
-	}
-	return ""
-}
-
-func (x *UpdateSessionParametersRequest) GetParameters() *v1.UpdateSessionParametersRequest {
+func (x *GetHeadlessHostResponse) GetHost() *HeadlessHost {
 	if x != nil {
-		return x.Parameters
+		return x.Host
 	}
 	return nil
 }
 
-type UpdateSessionParametersResponse struct {
+type AddHeadlessHostRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Address       string                 `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateSessionParametersResponse) Reset() {
-	*x = UpdateSessionParametersResponse{}
+func (x *AddHeadlessHostRequest) Reset() {
+	*x = AddHeadlessHostRequest{}
 	mi := &file_hdlctrl_v1_controller_proto_msgTypes[77]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateSessionParametersResponse) String() string {
+func (x *AddHeadlessHostRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateSessionParametersResponse) ProtoMessage() {}
+func (*AddHeadlessHostRequest) ProtoMessage() {}
 
-func (x *UpdateSessionParametersResponse) ProtoReflect() protoreflect.Message {
+func (x *AddHeadlessHostRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_hdlctrl_v1_controller_proto_msgTypes[77]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4249,34 +5264,46 @@ func (x *UpdateSessionParametersResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateSessionParametersResponse.ProtoReflect.Descriptor instead.
-func (*UpdateSessionParametersResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use AddHeadlessHostRequest.ProtoReflect.Descriptor instead.
+func (*AddHeadlessHostRequest) Descriptor() ([]byte, []int) {
 	return file_hdlctrl_v1_controller_proto_rawDescGZIP(), []int{77}
 }
 
-type UpdateSessionExtraSettingsRequest struct {
+func (x *AddHeadlessHostRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *AddHeadlessHostRequest) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+type AddHeadlessHostResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	SessionId     string                 `protobuf:"bytes,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
-	AutoUpgrade   *bool                  `protobuf:"varint,2,opt,name=auto_upgrade,json=autoUpgrade,proto3,oneof" json:"auto_upgrade,omitempty"`
-	Memo          *string                `protobuf:"bytes,3,opt,name=memo,proto3,oneof" json:"memo,omitempty"`
+	Host          *HeadlessHost          `protobuf:"bytes,1,opt,name=host,proto3" json:"host,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateSessionExtraSettingsRequest) Reset() {
-	*x = UpdateSessionExtraSettingsRequest{}
+func (x *AddHeadlessHostResponse) Reset() {
+	*x = AddHeadlessHostResponse{}
 	mi := &file_hdlctrl_v1_controller_proto_msgTypes[78]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateSessionExtraSettingsRequest) String() string {
+func (x *AddHeadlessHostResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateSessionExtraSettingsRequest) ProtoMessage() {}
+func (*AddHeadlessHostResponse) ProtoMessage() {}
 
-func (x *UpdateSessionExtraSettingsRequest) ProtoReflect() protoreflect.Message {
+func (x *AddHeadlessHostResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_hdlctrl_v1_controller_proto_msgTypes[78]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4288,52 +5315,40 @@ func (x *UpdateSessionExtraSettingsRequest) ProtoReflect() protoreflect.Message
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateSessionExtraSettingsRequest.ProtoReflect.Descriptor instead.
-func (*UpdateSessionExtraSettingsRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use AddHeadlessHostResponse.ProtoReflect.Descriptor instead.
+func (*AddHeadlessHostResponse) Descriptor() ([]byte, []int) {
 	return file_hdlctrl_v1_controller_proto_rawDescGZIP(), []int{78}
 }
 
-func (x *UpdateSessionExtraSettingsRequest) GetSessionId() string {
+func (x *AddHeadlessHostResponse) GetHost() *HeadlessHost {
 	if x != nil {
-		return x.SessionId
-	}
-	return ""
-}
-
-func (x *UpdateSessionExtraSettingsRequest) GetAutoUpgrade() bool {
-	if x != nil && x.AutoUpgrade != nil {
-		return *x.AutoUpgrade
-	}
-	return false
-}
-
-func (x *UpdateSessionExtraSettingsRequest) GetMemo() string {
-	if x != nil && x.Memo != nil {
-		return *x.Memo
+		return x.Host
 	}
-	return ""
+	return nil
 }
 
-type UpdateSessionExtraSettingsResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
+type SearchSessionsRequest struct {
+	state         protoimpl.MessageState                  `protogen:"open.v1"`
+	Parameters    *SearchSessionsRequest_SearchParameters `protobuf:"bytes,1,opt,name=parameters,proto3" json:"parameters,omitempty"`
+	Page          *PageRequest                            `protobuf:"bytes,2,opt,name=page,proto3" json:"page,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateSessionExtraSettingsResponse) Reset() {
-	*x = UpdateSessionExtraSettingsResponse{}
+func (x *SearchSessionsRequest) Reset() {
+	*x = SearchSessionsRequest{}
 	mi := &file_hdlctrl_v1_controller_proto_msgTypes[79]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateSessionExtraSettingsResponse) String() string {
+func (x *SearchSessionsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateSessionExtraSettingsResponse) ProtoMessage() {}
+func (*SearchSessionsRequest) ProtoMessage() {}
 
-func (x *UpdateSessionExtraSettingsResponse) ProtoReflect() protoreflect.Message {
+func (x *SearchSessionsRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_hdlctrl_v1_controller_proto_msgTypes[79]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4345,33 +5360,47 @@ func (x *UpdateSessionExtraSettingsResponse) ProtoReflect() protoreflect.Message
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateSessionExtraSettingsResponse.ProtoReflect.Descriptor instead.
-func (*UpdateSessionExtraSettingsResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use SearchSessionsRequest.ProtoReflect.Descriptor instead.
+func (*SearchSessionsRequest) Descriptor() ([]byte, []int) {
 	return file_hdlctrl_v1_controller_proto_rawDescGZIP(), []int{79}
 }
 
-type ListUsersInSessionRequest struct {
+func (x *SearchSessionsRequest) GetParameters() *SearchSessionsRequest_SearchParameters {
+	if x != nil {
+		return x.Parameters
+	}
+	return nil
+}
+
+func (x *SearchSessionsRequest) GetPage() *PageRequest {
+	if x != nil {
+		return x.Page
+	}
+	return nil
+}
+
+type SearchSessionsResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	HostId        string                 `protobuf:"bytes,1,opt,name=host_id,json=hostId,proto3" json:"host_id,omitempty"`
-	SessionId     string                 `protobuf:"bytes,2,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	Sessions      []*Session             `protobuf:"bytes,1,rep,name=sessions,proto3" json:"sessions,omitempty"`
+	Page          *PageResponse          `protobuf:"bytes,2,opt,name=page,proto3" json:"page,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListUsersInSessionRequest) Reset() {
-	*x = ListUsersInSessionRequest{}
+func (x *SearchSessionsResponse) Reset() {
+	*x = SearchSessionsResponse{}
 	mi := &file_hdlctrl_v1_controller_proto_msgTypes[80]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListUsersInSessionRequest) String() string {
+func (x *SearchSessionsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListUsersInSessionRequest) ProtoMessage() {}
+func (*SearchSessionsResponse) ProtoMessage() {}
 
-func (x *ListUsersInSessionRequest) ProtoReflect() protoreflect.Message {
+func (x *SearchSessionsResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_hdlctrl_v1_controller_proto_msgTypes[80]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4383,46 +5412,48 @@ func (x *ListUsersInSessionRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListUsersInSessionRequest.ProtoReflect.Descriptor instead.
-func (*ListUsersInSessionRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use SearchSessionsResponse.ProtoReflect.Descriptor instead.
+func (*SearchSessionsResponse) Descriptor() ([]byte, []int) {
 	return file_hdlctrl_v1_controller_proto_rawDescGZIP(), []int{80}
 }
 
-func (x *ListUsersInSessionRequest) GetHostId() string {
+func (x *SearchSessionsResponse) GetSessions() []*Session {
 	if x != nil {
-		return x.HostId
+		return x.Sessions
 	}
-	return ""
+	return nil
 }
 
-func (x *ListUsersInSessionRequest) GetSessionId() string {
+func (x *SearchSessionsResponse) GetPage() *PageResponse {
 	if x != nil {
-		return x.SessionId
+		return x.Page
 	}
-	return ""
+	return nil
 }
 
-type ListUsersInSessionResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Users         []*v1.UserInSession    `protobuf:"bytes,1,rep,name=users,proto3" json:"users,omitempty"`
+type GetSessionDetailsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Deprecated: Marked as deprecated in hdlctrl/v1/controller.proto.
+	HostId        string `protobuf:"bytes,1,opt,name=host_id,json=hostId,proto3" json:"host_id,omitempty"` // 指定不要
+	SessionId     string `protobuf:"bytes,2,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListUsersInSessionResponse) Reset() {
-	*x = ListUsersInSessionResponse{}
+func (x *GetSessionDetailsRequest) Reset() {
+	*x = GetSessionDetailsRequest{}
 	mi := &file_hdlctrl_v1_controller_proto_msgTypes[81]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListUsersInSessionResponse) String() string {
+func (x *GetSessionDetailsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListUsersInSessionResponse) ProtoMessage() {}
+func (*GetSessionDetailsRequest) ProtoMessage() {}
 
-func (x *ListUsersInSessionResponse) ProtoReflect() protoreflect.Message {
+func (x *GetSessionDetailsRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_hdlctrl_v1_controller_proto_msgTypes[81]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4434,43 +5465,47 @@ func (x *ListUsersInSessionResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListUsersInSessionResponse.ProtoReflect.Descriptor instead.
-func (*ListUsersInSessionResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetSessionDetailsRequest.ProtoReflect.Descriptor instead.
+func (*GetSessionDetailsRequest) Descriptor() ([]byte, []int) {
 	return file_hdlctrl_v1_controller_proto_rawDescGZIP(), []int{81}
 }
 
-func (x *ListUsersInSessionResponse) GetUsers() []*v1.UserInSession {
+// Deprecated: Marked as deprecated in hdlctrl/v1/controller.proto.
+func (x *GetSessionDetailsRequest) GetHostId() string {
 	if x != nil {
-		return x.Users
+		return x.HostId
 	}
-	return nil
+	return ""
 }
 
-// 共通ページングメッセージ
-// page_index は 0 始まり。page_size 未指定 (=0) はサーバー側でデフォルト値が適用される。
-// 短時間で行の追加/削除が発生するとページ境界で重複/欠落が起こりうる (page_index ベース固有)。
-type PageRequest struct {
+func (x *GetSessionDetailsRequest) GetSessionId() string {
+	if x != nil {
+		return x.SessionId
+	}
+	return ""
+}
+
+type GetSessionDetailsResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	PageIndex     int32                  `protobuf:"varint,1,opt,name=page_index,json=pageIndex,proto3" json:"page_index,omitempty"`
-	PageSize      int32                  `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	Session       *Session               `protobuf:"bytes,1,opt,name=session,proto3" json:"session,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *PageRequest) Reset() {
-	*x = PageRequest{}
+func (x *GetSessionDetailsResponse) Reset() {
+	*x = GetSessionDetailsResponse{}
 	mi := &file_hdlctrl_v1_controller_proto_msgTypes[82]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *PageRequest) String() string {
+func (x *GetSessionDetailsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PageRequest) ProtoMessage() {}
+func (*GetSessionDetailsResponse) ProtoMessage() {}
 
-func (x *PageRequest) ProtoReflect() protoreflect.Message {
+func (x *GetSessionDetailsResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_hdlctrl_v1_controller_proto_msgTypes[82]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4482,51 +5517,51 @@ func (x *PageRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PageRequest.ProtoReflect.Descriptor instead.
-func (*PageRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetSessionDetailsResponse.ProtoReflect.Descriptor instead.
+func (*GetSessionDetailsResponse) Descriptor() ([]byte, []int) {
 	return file_hdlctrl_v1_controller_proto_rawDescGZIP(), []int{82}
 }
 
-func (x *PageRequest) GetPageIndex() int32 {
-	if x != nil {
-		return x.PageIndex
-	}
-	return 0
-}
-
-func (x *PageRequest) GetPageSize() int32 {
+func (x *GetSessionDetailsResponse) GetSession() *Session {
 	if x != nil {
-		return x.PageSize
+		return x.Session
 	}
-	return 0
+	return nil
 }
 
-// total_count は全体件数。
-// page_index / page_size はサーバーがバリデーション・クランプ・デフォルト適用後に実際に使用した値。
-// クライアントは UI 状態の同期に利用できる。
-type PageResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	TotalCount    int32                  `protobuf:"varint,1,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
-	PageIndex     int32                  `protobuf:"varint,2,opt,name=page_index,json=pageIndex,proto3" json:"page_index,omitempty"`
-	PageSize      int32                  `protobuf:"varint,3,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+type StartWorldRequest struct {
+	state      protoimpl.MessageState     `protogen:"open.v1"`
+	HostId     string                     `protobuf:"bytes,1,opt,name=host_id,json=hostId,proto3" json:"host_id,omitempty"`
+	Parameters *v1.WorldStartupParameters `protobuf:"bytes,2,opt,name=parameters,proto3" json:"parameters,omitempty"`
+	Memo       string                     `protobuf:"bytes,3,opt,name=memo,proto3" json:"memo,omitempty"`
+	// 起動するセッションの所属グループ. 未指定の場合は呼び出しユーザーの personal グループ.
+	// 指定する場合は host / account の group_id と一致する必要がある (同一グループ制約).
+	GroupId *string `protobuf:"bytes,4,opt,name=group_id,json=groupId,proto3,oneof" json:"group_id,omitempty"`
+	// 指定した場合はテンプレートの parameters を元にし、parameters は上書き分として扱う.
+	// テンプレートはセッションと同じグループのものである必要がある.
+	TemplateId *string `protobuf:"bytes,5,opt,name=template_id,json=templateId,proto3,oneof" json:"template_id,omitempty"`
+	// template_id 指定時に parameters から上書きするフィールド名 (WorldStartupParameters の
+	// トップレベルのフィールド名). 空なら parameters で値が入っているフィールドをすべて上書きする.
+	// false / 0 / 空リストで上書きしたい場合はここに列挙する.
+	OverrideFields []string `protobuf:"bytes,6,rep,name=override_fields,json=overrideFields,proto3" json:"override_fields,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
-func (x *PageResponse) Reset() {
-	*x = PageResponse{}
+func (x *StartWorldRequest) Reset() {
+	*x = StartWorldRequest{}
 	mi := &file_hdlctrl_v1_controller_proto_msgTypes[83]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *PageResponse) String() string {
+func (x *StartWorldRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PageResponse) ProtoMessage() {}
+func (*StartWorldRequest) ProtoMessage() {}
 
-func (x *PageResponse) ProtoReflect() protoreflect.Message {
+func (x *StartWorldRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_hdlctrl_v1_controller_proto_msgTypes[83]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4538,59 +5573,3071 @@ func (x *PageResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PageResponse.ProtoReflect.Descriptor instead.
-func (*PageResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use StartWorldRequest.ProtoReflect.Descriptor instead.
+func (*StartWorldRequest) Descriptor() ([]byte, []int) {
 	return file_hdlctrl_v1_controller_proto_rawDescGZIP(), []int{83}
 }
 
-func (x *PageResponse) GetTotalCount() int32 {
+func (x *StartWorldRequest) GetHostId() string {
 	if x != nil {
-		return x.TotalCount
+		return x.HostId
 	}
-	return 0
+	return ""
 }
 
-func (x *PageResponse) GetPageIndex() int32 {
+func (x *StartWorldRequest) GetParameters() *v1.WorldStartupParameters {
 	if x != nil {
-		return x.PageIndex
+		return x.Parameters
 	}
-	return 0
+	return nil
 }
 
-func (x *PageResponse) GetPageSize() int32 {
+func (x *StartWorldRequest) GetMemo() string {
 	if x != nil {
-		return x.PageSize
+		return x.Memo
 	}
-	return 0
+	return ""
+}
+
+func (x *StartWorldRequest) GetGroupId() string {
+	if x != nil && x.GroupId != nil {
+		return *x.GroupId
+	}
+	return ""
+}
+
+func (x *StartWorldRequest) GetTemplateId() string {
+	if x != nil && x.TemplateId != nil {
+		return *x.TemplateId
+	}
+	return ""
+}
+
+func (x *StartWorldRequest) GetOverrideFields() []string {
+	if x != nil {
+		return x.OverrideFields
+	}
+	return nil
+}
+
+type StartWorldResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	JobId         string                 `protobuf:"bytes,2,opt,name=job_id,json=jobId,proto3" json:"job_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StartWorldResponse) Reset() {
+	*x = StartWorldResponse{}
+	mi := &file_hdlctrl_v1_controller_proto_msgTypes[84]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StartWorldResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StartWorldResponse) ProtoMessage() {}
+
+func (x *StartWorldResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_hdlctrl_v1_controller_proto_msgTypes[84]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StartWorldResponse.ProtoReflect.Descriptor instead.
+func (*StartWorldResponse) Descriptor() ([]byte, []int) {
+	return file_hdlctrl_v1_controller_proto_rawDescGZIP(), []int{84}
+}
+
+func (x *StartWorldResponse) GetJobId() string {
+	if x != nil {
+		return x.JobId
+	}
+	return ""
+}
+
+type StopSessionRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Deprecated: Marked as deprecated in hdlctrl/v1/controller.proto.
+	HostId        string `protobuf:"bytes,1,opt,name=host_id,json=hostId,proto3" json:"host_id,omitempty"` // 指定不要
+	SessionId     string `protobuf:"bytes,2,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StopSessionRequest) Reset() {
+	*x = StopSessionRequest{}
+	mi := &file_hdlctrl_v1_controller_proto_msgTypes[85]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StopSessionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StopSessionRequest) ProtoMessage() {}
+
+func (x *StopSessionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_hdlctrl_v1_controller_proto_msgTypes[85]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StopSessionRequest.ProtoReflect.Descriptor instead.
+func (*StopSessionRequest) Descriptor() ([]byte, []int) {
+	return file_hdlctrl_v1_controller_proto_rawDescGZIP(), []int{85}
+}
+
+// Deprecated: Marked as deprecated in hdlctrl/v1/controller.proto.
+func (x *StopSessionRequest) GetHostId() string {
+	if x != nil {
+		return x.HostId
+	}
+	return ""
+}
+
+func (x *StopSessionRequest) GetSessionId() string {
+	if x != nil {
+		return x.SessionId
+	}
+	return ""
+}
+
+type StopSessionResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	JobId         string                 `protobuf:"bytes,1,opt,name=job_id,json=jobId,proto3" json:"job_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StopSessionResponse) Reset() {
+	*x = StopSessionResponse{}
+	mi := &file_hdlctrl_v1_controller_proto_msgTypes[86]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StopSessionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StopSessionResponse) ProtoMessage() {}
+
+func (x *StopSessionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_hdlctrl_v1_controller_proto_msgTypes[86]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StopSessionResponse.ProtoReflect.Descriptor instead.
+func (*StopSessionResponse) Descriptor() ([]byte, []int) {
+	return file_hdlctrl_v1_controller_proto_rawDescGZIP(), []int{86}
+}
+
+func (x *StopSessionResponse) GetJobId() string {
+	if x != nil {
+		return x.JobId
+	}
+	return ""
+}
+
+type DeleteEndedSessionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SessionId     string                 `protobuf:"bytes,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteEndedSessionRequest) Reset() {
+	*x = DeleteEndedSessionRequest{}
+	mi := &file_hdlctrl_v1_controller_proto_msgTypes[87]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteEndedSessionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteEndedSessionRequest) ProtoMessage() {}
+
+func (x *DeleteEndedSessionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_hdlctrl_v1_controller_proto_msgTypes[87]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteEndedSessionRequest.ProtoReflect.Descriptor instead.
+func (*DeleteEndedSessionRequest) Descriptor() ([]byte, []int) {
+	return file_hdlctrl_v1_controller_proto_rawDescGZIP(), []int{87}
+}
+
+func (x *DeleteEndedSessionRequest) GetSessionId() string {
+	if x != nil {
+		return x.SessionId
+	}
+	return ""
+}
+
+type DeleteEndedSessionResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteEndedSessionResponse) Reset() {
+	*x = DeleteEndedSessionResponse{}
+	mi := &file_hdlctrl_v1_controller_proto_msgTypes[88]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteEndedSessionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteEndedSessionResponse) ProtoMessage() {}
+
+func (x *DeleteEndedSessionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_hdlctrl_v1_controller_proto_msgTypes[88]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteEndedSessionResponse.ProtoReflect.Descriptor instead.
+func (*DeleteEndedSessionResponse) Descriptor() ([]byte, []int) {
+	return file_hdlctrl_v1_controller_proto_rawDescGZIP(), []int{88}
+}
+
+type SaveSessionWorldRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Deprecated: Marked as deprecated in hdlctrl/v1/controller.proto.
+	HostId        string                           `protobuf:"bytes,1,opt,name=host_id,json=hostId,proto3" json:"host_id,omitempty"` // 指定不要
+	SessionId     string                           `protobuf:"bytes,2,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	SaveMode      SaveSessionWorldRequest_SaveMode `protobuf:"varint,3,opt,name=save_mode,json=saveMode,proto3,enum=hdlctrl.v1.SaveSessionWorldRequest_SaveMode" json:"save_mode,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SaveSessionWorldRequest) Reset() {
+	*x = SaveSessionWorldRequest{}
+	mi := &file_hdlctrl_v1_controller_proto_msgTypes[89]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SaveSessionWorldRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SaveSessionWorldRequest) ProtoMessage() {}
+
+func (x *SaveSessionWorldRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_hdlctrl_v1_controller_proto_msgTypes[89]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SaveSessionWorldRequest.ProtoReflect.Descriptor instead.
+func (*SaveSessionWorldRequest) Descriptor() ([]byte, []int) {
+	return file_hdlctrl_v1_controller_proto_rawDescGZIP(), []int{89}
+}
+
+// Deprecated: Marked as deprecated in hdlctrl/v1/controller.proto.
+func (x *SaveSessionWorldRequest) GetHostId() string {
+	if x != nil {
+		return x.HostId
+	}
+	return ""
+}
+
+func (x *SaveSessionWorldRequest) GetSessionId() string {
+	if x != nil {
+		return x.SessionId
+	}
+	return ""
+}
+
+func (x *SaveSessionWorldRequest) GetSaveMode() SaveSessionWorldRequest_SaveMode {
+	if x != nil {
+		return x.SaveMode
+	}
+	return SaveSessionWorldRequest_SAVE_MODE_UNKNOWN
+}
+
+type SaveSessionWorldResponse struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	SavedRecordUrl *string                `protobuf:"bytes,1,opt,name=saved_record_url,json=savedRecordUrl,proto3,oneof" json:"saved_record_url,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *SaveSessionWorldResponse) Reset() {
+	*x = SaveSessionWorldResponse{}
+	mi := &file_hdlctrl_v1_controller_proto_msgTypes[90]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SaveSessionWorldResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SaveSessionWorldResponse) ProtoMessage() {}
+
+func (x *SaveSessionWorldResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_hdlctrl_v1_controller_proto_msgTypes[90]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SaveSessionWorldResponse.ProtoReflect.Descriptor instead.
+func (*SaveSessionWorldResponse) Descriptor() ([]byte, []int) {
+	return file_hdlctrl_v1_controller_proto_rawDescGZIP(), []int{90}
+}
+
+func (x *SaveSessionWorldResponse) GetSavedRecordUrl() string {
+	if x != nil && x.SavedRecordUrl != nil {
+		return *x.SavedRecordUrl
+	}
+	return ""
+}
+
+type PrepareSessionWorldDownloadRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SessionId     string                 `protobuf:"bytes,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	Format        v1.WorldBinaryFormat   `protobuf:"varint,2,opt,name=format,proto3,enum=headless.v1.WorldBinaryFormat" json:"format,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PrepareSessionWorldDownloadRequest) Reset() {
+	*x = PrepareSessionWorldDownloadRequest{}
+	mi := &file_hdlctrl_v1_controller_proto_msgTypes[91]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PrepareSessionWorldDownloadRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PrepareSessionWorldDownloadRequest) ProtoMessage() {}
+
+func (x *PrepareSessionWorldDownloadRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_hdlctrl_v1_controller_proto_msgTypes[91]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PrepareSessionWorldDownloadRequest.ProtoReflect.Descriptor instead.
+func (*PrepareSessionWorldDownloadRequest) Descriptor() ([]byte, []int) {
+	return file_hdlctrl_v1_controller_proto_rawDescGZIP(), []int{91}
+}
+
+func (x *PrepareSessionWorldDownloadRequest) GetSessionId() string {
+	if x != nil {
+		return x.SessionId
+	}
+	return ""
+}
+
+func (x *PrepareSessionWorldDownloadRequest) GetFormat() v1.WorldBinaryFormat {
+	if x != nil {
+		return x.Format
+	}
+	return v1.WorldBinaryFormat(0)
+}
+
+type PrepareSessionWorldDownloadResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// ブラウザがそのままアクセス可能な相対 URL: /blobs/<uuid>
+	DownloadUrl string `protobuf:"bytes,1,opt,name=download_url,json=downloadUrl,proto3" json:"download_url,omitempty"`
+	// 推奨ファイル名 (ブラウザの Content-Disposition でも提供されるが、UI 表示用にも返す)
+	Filename      string `protobuf:"bytes,2,opt,name=filename,proto3" json:"filename,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PrepareSessionWorldDownloadResponse) Reset() {
+	*x = PrepareSessionWorldDownloadResponse{}
+	mi := &file_hdlctrl_v1_controller_proto_msgTypes[92]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PrepareSessionWorldDownloadResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PrepareSessionWorldDownloadResponse) ProtoMessage() {}
+
+func (x *PrepareSessionWorldDownloadResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_hdlctrl_v1_controller_proto_msgTypes[92]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PrepareSessionWorldDownloadResponse.ProtoReflect.Descriptor instead.
+func (*PrepareSessionWorldDownloadResponse) Descriptor() ([]byte, []int) {
+	return file_hdlctrl_v1_controller_proto_rawDescGZIP(), []int{92}
+}
+
+func (x *PrepareSessionWorldDownloadResponse) GetDownloadUrl() string {
+	if x != nil {
+		return x.DownloadUrl
+	}
+	return ""
+}
+
+func (x *PrepareSessionWorldDownloadResponse) GetFilename() string {
+	if x != nil {
+		return x.Filename
+	}
+	return ""
+}
+
+type InviteUserRequest struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	HostId    string                 `protobuf:"bytes,1,opt,name=host_id,json=hostId,proto3" json:"host_id,omitempty"`
+	SessionId string                 `protobuf:"bytes,2,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	// Types that are valid to be assigned to User:
+	//
+	//	*InviteUserRequest_UserId
+	//	*InviteUserRequest_UserName
+	User          isInviteUserRequest_User `protobuf_oneof:"user"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *InviteUserRequest) Reset() {
+	*x = InviteUserRequest{}
+	mi := &file_hdlctrl_v1_controller_proto_msgTypes[93]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InviteUserRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InviteUserRequest) ProtoMessage() {}
+
+func (x *InviteUserRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_hdlctrl_v1_controller_proto_msgTypes[93]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InviteUserRequest.ProtoReflect.Descriptor instead.
+func (*InviteUserRequest) Descriptor() ([]byte, []int) {
+	return file_hdlctrl_v1_controller_proto_rawDescGZIP(), []int{93}
+}
+
+func (x *InviteUserRequest) GetHostId() string {
+	if x != nil {
+		return x.HostId
+	}
+	return ""
+}
+
+func (x *InviteUserRequest) GetSessionId() string {
+	if x != nil {
+		return x.SessionId
+	}
+	return ""
+}
+
+func (x *InviteUserRequest) GetUser() isInviteUserRequest_User {
+	if x != nil {
+		return x.User
+	}
+	return nil
+}
+
+func (x *InviteUserRequest) GetUserId() string {
+	if x != nil {
+		if x, ok := x.User.(*InviteUserRequest_UserId); ok {
+			return x.UserId
+		}
+	}
+	return ""
+}
+
+func (x *InviteUserRequest) GetUserName() string {
+	if x != nil {
+		if x, ok := x.User.(*InviteUserRequest_UserName); ok {
+			return x.UserName
+		}
+	}
+	return ""
+}
+
+type isInviteUserRequest_User interface {
+	isInviteUserRequest_User()
+}
+
+type InviteUserRequest_UserId struct {
+	UserId string `protobuf:"bytes,3,opt,name=user_id,json=userId,proto3,oneof"`
+}
+
+type InviteUserRequest_UserName struct {
+	UserName string `protobuf:"bytes,4,opt,name=user_name,json=userName,proto3,oneof"`
+}
+
+func (*InviteUserRequest_UserId) isInviteUserRequest_User() {}
+
+func (*InviteUserRequest_UserName) isInviteUserRequest_User() {}
+
+type InviteUserResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *InviteUserResponse) Reset() {
+	*x = InviteUserResponse{}
+	mi := &file_hdlctrl_v1_controller_proto_msgTypes[94]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InviteUserResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InviteUserResponse) ProtoMessage() {}
+
+func (x *InviteUserResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_hdlctrl_v1_controller_proto_msgTypes[94]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InviteUserResponse.ProtoReflect.Descriptor instead.
+func (*InviteUserResponse) Descriptor() ([]byte, []int) {
+	return file_hdlctrl_v1_controller_proto_rawDescGZIP(), []int{94}
+}
+
+type UpdateUserRoleRequest struct {
+	state         protoimpl.MessageState    `protogen:"open.v1"`
+	HostId        string                    `protobuf:"bytes,1,opt,name=host_id,json=hostId,proto3" json:"host_id,omitempty"`
+	Parameters    *v1.UpdateUserRoleRequest `protobuf:"bytes,2,opt,name=parameters,proto3" json:"parameters,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateUserRoleRequest) Reset() {
+	*x = UpdateUserRoleRequest{}
+	mi := &file_hdlctrl_v1_controller_proto_msgTypes[95]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateUserRoleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateUserRoleRequest) ProtoMessage() {}
+
+func (x *UpdateUserRoleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_hdlctrl_v1_controller_proto_msgTypes[95]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateUserRoleRequest.ProtoReflect.Descriptor instead.
+func (*UpdateUserRoleRequest) Descriptor() ([]byte, []int) {
+	return file_hdlctrl_v1_controller_proto_rawDescGZIP(), []int{95}
+}
+
+func (x *UpdateUserRoleRequest) GetHostId() string {
+	if x != nil {
+		return x.HostId
+	}
+	return ""
+}
+
+func (x *UpdateUserRoleRequest) GetParameters() *v1.UpdateUserRoleRequest {
+	if x != nil {
+		return x.Parameters
+	}
+	return nil
+}
+
+type UpdateUserRoleResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Role          string                 `protobuf:"bytes,1,opt,name=role,proto3" json:"role,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateUserRoleResponse) Reset() {
+	*x = UpdateUserRoleResponse{}
+	mi := &file_hdlctrl_v1_controller_proto_msgTypes[96]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateUserRoleResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateUserRoleResponse) ProtoMessage() {}
+
+func (x *UpdateUserRoleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_hdlctrl_v1_controller_proto_msgTypes[96]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateUserRoleResponse.ProtoReflect.Descriptor instead.
+func (*UpdateUserRoleResponse) Descriptor() ([]byte, []int) {
+	return file_hdlctrl_v1_controller_proto_rawDescGZIP(), []int{96}
+}
+
+func (x *UpdateUserRoleResponse) GetRole() string {
+	if x != nil {
+		return x.Role
+	}
+	return ""
+}
+
+type UpdateSessionParametersRequest struct {
+	state         protoimpl.MessageState             `protogen:"open.v1"`
+	HostId        string                             `protobuf:"bytes,1,opt,name=host_id,json=hostId,proto3" json:"host_id,omitempty"`
+	Parameters    *v1.UpdateSessionParametersRequest `protobuf:"bytes,2,opt,name=parameters,proto3" json:"parameters,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateSessionParametersRequest) Reset() {
+	*x = UpdateSessionParametersRequest{}
+	mi := &file_hdlctrl_v1_controller_proto_msgTypes[97]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateSessionParametersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateSessionParametersRequest) ProtoMessage() {}
+
+func (x *UpdateSessionParametersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_hdlctrl_v1_controller_proto_msgTypes[97]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateSessionParametersRequest.ProtoReflect.Descriptor instead.
+func (*UpdateSessionParametersRequest) Descriptor() ([]byte, []int) {
+	return file_hdlctrl_v1_controller_proto_rawDescGZIP(), []int{97}
+}
+
+func (x *UpdateSessionParametersRequest) GetHostId() string {
+	if x != nil {
+		return x.HostId
+	}
+	return ""
+}
+
+func (x *UpdateSessionParametersRequest) GetParameters() *v1.UpdateSessionParametersRequest {
+	if x != nil {
+		return x.Parameters
+	}
+	return nil
+}
+
+type UpdateSessionParametersResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateSessionParametersResponse) Reset() {
+	*x = UpdateSessionParametersResponse{}
+	mi := &file_hdlctrl_v1_controller_proto_msgTypes[98]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateSessionParametersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateSessionParametersResponse) ProtoMessage() {}
+
+func (x *UpdateSessionParametersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_hdlctrl_v1_controller_proto_msgTypes[98]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateSessionParametersResponse.ProtoReflect.Descriptor instead.
+func (*UpdateSessionParametersResponse) Descriptor() ([]byte, []int) {
+	return file_hdlctrl_v1_controller_proto_rawDescGZIP(), []int{98}
+}
+
+type UpdateSessionExtraSettingsRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	SessionId      string                 `protobuf:"bytes,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	AutoUpgrade    *bool                  `protobuf:"varint,2,opt,name=auto_upgrade,json=autoUpgrade,proto3,oneof" json:"auto_upgrade,omitempty"`
+	Memo           *string                `protobuf:"bytes,3,opt,name=memo,proto3,oneof" json:"memo,omitempty"`
+	RestoreOnCrash *bool                  `protobuf:"varint,4,opt,name=restore_on_crash,json=restoreOnCrash,proto3,oneof" json:"restore_on_crash,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *UpdateSessionExtraSettingsRequest) Reset() {
+	*x = UpdateSessionExtraSettingsRequest{}
+	mi := &file_hdlctrl_v1_controller_proto_msgTypes[99]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateSessionExtraSettingsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateSessionExtraSettingsRequest) ProtoMessage() {}
+
+func (x *UpdateSessionExtraSettingsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_hdlctrl_v1_controller_proto_msgTypes[99]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateSessionExtraSettingsRequest.ProtoReflect.Descriptor instead.
+func (*UpdateSessionExtraSettingsRequest) Descriptor() ([]byte, []int) {
+	return file_hdlctrl_v1_controller_proto_rawDescGZIP(), []int{99}
+}
+
+func (x *UpdateSessionExtraSettingsRequest) GetSessionId() string {
+	if x != nil {
+		return x.SessionId
+	}
+	return ""
+}
+
+func (x *UpdateSessionExtraSettingsRequest) GetAutoUpgrade() bool {
+	if x != nil && x.AutoUpgrade != nil {
+		return *x.AutoUpgrade
+	}
+	return false
+}
+
+func (x *UpdateSessionExtraSettingsRequest) GetMemo() string {
+	if x != nil && x.Memo != nil {
+		return *x.Memo
+	}
+	return ""
+}
+
+func (x *UpdateSessionExtraSettingsRequest) GetRestoreOnCrash() bool {
+	if x != nil && x.RestoreOnCrash != nil {
+		return *x.RestoreOnCrash
+	}
+	return false
+}
+
+type UpdateSessionExtraSettingsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateSessionExtraSettingsResponse) Reset() {
+	*x = UpdateSessionExtraSettingsResponse{}
+	mi := &file_hdlctrl_v1_controller_proto_msgTypes[100]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateSessionExtraSettingsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateSessionExtraSettingsResponse) ProtoMessage() {}
+
+func (x *UpdateSessionExtraSettingsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_hdlctrl_v1_controller_proto_msgTypes[100]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateSessionExtraSettingsResponse.ProtoReflect.Descriptor instead.
+func (*UpdateSessionExtraSettingsResponse) Descriptor() ([]byte, []int) {
+	return file_hdlctrl_v1_controller_proto_rawDescGZIP(), []int{100}
+}
+
+type ListUsersInSessionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	HostId        string                 `protobuf:"bytes,1,opt,name=host_id,json=hostId,proto3" json:"host_id,omitempty"`
+	SessionId     string                 `protobuf:"bytes,2,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListUsersInSessionRequest) Reset() {
+	*x = ListUsersInSessionRequest{}
+	mi := &file_hdlctrl_v1_controller_proto_msgTypes[101]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListUsersInSessionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListUsersInSessionRequest) ProtoMessage() {}
+
+func (x *ListUsersInSessionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_hdlctrl_v1_controller_proto_msgTypes[101]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListUsersInSessionRequest.ProtoReflect.Descriptor instead.
+func (*ListUsersInSessionRequest) Descriptor() ([]byte, []int) {
+	return file_hdlctrl_v1_controller_proto_rawDescGZIP(), []int{101}
+}
+
+func (x *ListUsersInSessionRequest) GetHostId() string {
+	if x != nil {
+		return x.HostId
+	}
+	return ""
+}
+
+func (x *ListUsersInSessionRequest) GetSessionId() string {
+	if x != nil {
+		return x.SessionId
+	}
+	return ""
+}
+
+type ListUsersInSessionResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Users         []*v1.UserInSession    `protobuf:"bytes,1,rep,name=users,proto3" json:"users,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListUsersInSessionResponse) Reset() {
+	*x = ListUsersInSessionResponse{}
+	mi := &file_hdlctrl_v1_controller_proto_msgTypes[102]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListUsersInSessionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListUsersInSessionResponse) ProtoMessage() {}
+
+func (x *ListUsersInSessionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_hdlctrl_v1_controller_proto_msgTypes[102]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListUsersInSessionResponse.ProtoReflect.Descriptor instead.
+func (*ListUsersInSessionResponse) Descriptor() ([]byte, []int) {
+	return file_hdlctrl_v1_controller_proto_rawDescGZIP(), []int{102}
+}
+
+func (x *ListUsersInSessionResponse) GetUsers() []*v1.UserInSession {
+	if x != nil {
+		return x.Users
+	}
+	return nil
+}
+
+type SessionTemplate struct {
+	state         protoimpl.MessageState     `protogen:"open.v1"`
+	Id            string                     `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	GroupId       string                     `protobuf:"bytes,2,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
+	Name          string                     `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Description   string                     `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
+	Parameters    *v1.WorldStartupParameters `protobuf:"bytes,5,opt,name=parameters,proto3" json:"parameters,omitempty"`
+	CreatedBy     *string                    `protobuf:"bytes,6,opt,name=created_by,json=createdBy,proto3,oneof" json:"created_by,omitempty"`
+	CreatedAt     *timestamppb.Timestamp     `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt     *timestamppb.Timestamp     `protobuf:"bytes,8,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SessionTemplate) Reset() {
+	*x = SessionTemplate{}
+	mi := &file_hdlctrl_v1_controller_proto_msgTypes[103]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SessionTemplate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SessionTemplate) ProtoMessage() {}
+
+func (x *SessionTemplate) ProtoReflect() protoreflect.Message {
+	mi := &file_hdlctrl_v1_controller_proto_msgTypes[103]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SessionTemplate.ProtoReflect.Descriptor instead.
+func (*SessionTemplate) Descriptor() ([]byte, []int) {
+	return file_hdlctrl_v1_controller_proto_rawDescGZIP(), []int{103}
+}
+
+func (x *SessionTemplate) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *SessionTemplate) GetGroupId() string {
+	if x != nil {
+		return x.GroupId
+	}
+	return ""
+}
+
+func (x *SessionTemplate) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *SessionTemplate) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *SessionTemplate) GetParameters() *v1.WorldStartupParameters {
+	if x != nil {
+		return x.Parameters
+	}
+	return nil
+}
+
+func (x *SessionTemplate) GetCreatedBy() string {
+	if x != nil && x.CreatedBy != nil {
+		return *x.CreatedBy
+	}
+	return ""
+}
+
+func (x *SessionTemplate) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *SessionTemplate) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+type ListSessionTemplatesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	GroupId       string                 `protobuf:"bytes,1,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListSessionTemplatesRequest) Reset() {
+	*x = ListSessionTemplatesRequest{}
+	mi := &file_hdlctrl_v1_controller_proto_msgTypes[104]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListSessionTemplatesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSessionTemplatesRequest) ProtoMessage() {}
+
+func (x *ListSessionTemplatesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_hdlctrl_v1_controller_proto_msgTypes[104]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSessionTemplatesRequest.ProtoReflect.Descriptor instead.
+func (*ListSessionTemplatesRequest) Descriptor() ([]byte, []int) {
+	return file_hdlctrl_v1_controller_proto_rawDescGZIP(), []int{104}
+}
+
+func (x *ListSessionTemplatesRequest) GetGroupId() string {
+	if x != nil {
+		return x.GroupId
+	}
+	return ""
+}
+
+type ListSessionTemplatesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Templates     []*SessionTemplate     `protobuf:"bytes,1,rep,name=templates,proto3" json:"templates,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListSessionTemplatesResponse) Reset() {
+	*x = ListSessionTemplatesResponse{}
+	mi := &file_hdlctrl_v1_controller_proto_msgTypes[105]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListSessionTemplatesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSessionTemplatesResponse) ProtoMessage() {}
+
+func (x *ListSessionTemplatesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_hdlctrl_v1_controller_proto_msgTypes[105]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSessionTemplatesResponse.ProtoReflect.Descriptor instead.
+func (*ListSessionTemplatesResponse) Descriptor() ([]byte, []int) {
+	return file_hdlctrl_v1_controller_proto_rawDescGZIP(), []int{105}
+}
+
+func (x *ListSessionTemplatesResponse) GetTemplates() []*SessionTemplate {
+	if x != nil {
+		return x.Templates
+	}
+	return nil
+}
+
+type GetSessionTemplateRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TemplateId    string                 `protobuf:"bytes,1,opt,name=template_id,json=templateId,proto3" json:"template_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetSessionTemplateRequest) Reset() {
+	*x = GetSessionTemplateRequest{}
+	mi := &file_hdlctrl_v1_controller_proto_msgTypes[106]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSessionTemplateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSessionTemplateRequest) ProtoMessage() {}
+
+func (x *GetSessionTemplateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_hdlctrl_v1_controller_proto_msgTypes[106]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSessionTemplateRequest.ProtoReflect.Descriptor instead.
+func (*GetSessionTemplateRequest) Descriptor() ([]byte, []int) {
+	return file_hdlctrl_v1_controller_proto_rawDescGZIP(), []int{106}
+}
+
+func (x *GetSessionTemplateRequest) GetTemplateId() string {
+	if x != nil {
+		return x.TemplateId
+	}
+	return ""
+}
+
+type GetSessionTemplateResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Template      *SessionTemplate       `protobuf:"bytes,1,opt,name=template,proto3" json:"template,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetSessionTemplateResponse) Reset() {
+	*x = GetSessionTemplateResponse{}
+	mi := &file_hdlctrl_v1_controller_proto_msgTypes[107]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSessionTemplateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSessionTemplateResponse) ProtoMessage() {}
+
+func (x *GetSessionTemplateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_hdlctrl_v1_controller_proto_msgTypes[107]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSessionTemplateResponse.ProtoReflect.Descriptor instead.
+func (*GetSessionTemplateResponse) Descriptor() ([]byte, []int) {
+	return file_hdlctrl_v1_controller_proto_rawDescGZIP(), []int{107}
+}
+
+func (x *GetSessionTemplateResponse) GetTemplate() *SessionTemplate {
+	if x != nil {
+		return x.Template
+	}
+	return nil
+}
+
+type CreateSessionTemplateRequest struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	GroupId string                 `protobuf:"bytes,1,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
+	// グループ内で一意
+	Name          string                     `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Description   string                     `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	Parameters    *v1.WorldStartupParameters `protobuf:"bytes,4,opt,name=parameters,proto3" json:"parameters,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateSessionTemplateRequest) Reset() {
+	*x = CreateSessionTemplateRequest{}
+	mi := &file_hdlctrl_v1_controller_proto_msgTypes[108]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateSessionTemplateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateSessionTemplateRequest) ProtoMessage() {}
+
+func (x *CreateSessionTemplateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_hdlctrl_v1_controller_proto_msgTypes[108]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateSessionTemplateRequest.ProtoReflect.Descriptor instead.
+func (*CreateSessionTemplateRequest) Descriptor() ([]byte, []int) {
+	return file_hdlctrl_v1_controller_proto_rawDescGZIP(), []int{108}
+}
+
+func (x *CreateSessionTemplateRequest) GetGroupId() string {
+	if x != nil {
+		return x.GroupId
+	}
+	return ""
+}
+
+func (x *CreateSessionTemplateRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateSessionTemplateRequest) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *CreateSessionTemplateRequest) GetParameters() *v1.WorldStartupParameters {
+	if x != nil {
+		return x.Parameters
+	}
+	return nil
+}
+
+type CreateSessionTemplateResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Template      *SessionTemplate       `protobuf:"bytes,1,opt,name=template,proto3" json:"template,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateSessionTemplateResponse) Reset() {
+	*x = CreateSessionTemplateResponse{}
+	mi := &file_hdlctrl_v1_controller_proto_msgTypes[109]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateSessionTemplateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateSessionTemplateResponse) ProtoMessage() {}
+
+func (x *CreateSessionTemplateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_hdlctrl_v1_controller_proto_msgTypes[109]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateSessionTemplateResponse.ProtoReflect.Descriptor instead.
+func (*CreateSessionTemplateResponse) Descriptor() ([]byte, []int) {
+	return file_hdlctrl_v1_controller_proto_rawDescGZIP(), []int{109}
+}
+
+func (x *CreateSessionTemplateResponse) GetTemplate() *SessionTemplate {
+	if x != nil {
+		return x.Template
+	}
+	return nil
+}
+
+// 未指定のフィールドは変更しない. parameters は指定した場合まるごと置き換える.
+type UpdateSessionTemplateRequest struct {
+	state         protoimpl.MessageState     `protogen:"open.v1"`
+	TemplateId    string                     `protobuf:"bytes,1,opt,name=template_id,json=templateId,proto3" json:"template_id,omitempty"`
+	Name          *string                    `protobuf:"bytes,2,opt,name=name,proto3,oneof" json:"name,omitempty"`
+	Description   *string                    `protobuf:"bytes,3,opt,name=description,proto3,oneof" json:"description,omitempty"`
+	Parameters    *v1.WorldStartupParameters `protobuf:"bytes,4,opt,name=parameters,proto3" json:"parameters,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateSessionTemplateRequest) Reset() {
+	*x = UpdateSessionTemplateRequest{}
+	mi := &file_hdlctrl_v1_controller_proto_msgTypes[110]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateSessionTemplateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateSessionTemplateRequest) ProtoMessage() {}
+
+func (x *UpdateSessionTemplateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_hdlctrl_v1_controller_proto_msgTypes[110]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateSessionTemplateRequest.ProtoReflect.Descriptor instead.
+func (*UpdateSessionTemplateRequest) Descriptor() ([]byte, []int) {
+	return file_hdlctrl_v1_controller_proto_rawDescGZIP(), []int{110}
+}
+
+func (x *UpdateSessionTemplateRequest) GetTemplateId() string {
+	if x != nil {
+		return x.TemplateId
+	}
+	return ""
+}
+
+func (x *UpdateSessionTemplateRequest) GetName() string {
+	if x != nil && x.Name != nil {
+		return *x.Name
+	}
+	return ""
+}
+
+func (x *UpdateSessionTemplateRequest) GetDescription() string {
+	if x != nil && x.Description != nil {
+		return *x.Description
+	}
+	return ""
+}
+
+func (x *UpdateSessionTemplateRequest) GetParameters() *v1.WorldStartupParameters {
+	if x != nil {
+		return x.Parameters
+	}
+	return nil
+}
+
+type UpdateSessionTemplateResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Template      *SessionTemplate       `protobuf:"bytes,1,opt,name=template,proto3" json:"template,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateSessionTemplateResponse) Reset() {
+	*x = UpdateSessionTemplateResponse{}
+	mi := &file_hdlctrl_v1_controller_proto_msgTypes[111]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateSessionTemplateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateSessionTemplateResponse) ProtoMessage() {}
+
+func (x *UpdateSessionTemplateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_hdlctrl_v1_controller_proto_msgTypes[111]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateSessionTemplateResponse.ProtoReflect.Descriptor instead.
+func (*UpdateSessionTemplateResponse) Descriptor() ([]byte, []int) {
+	return file_hdlctrl_v1_controller_proto_rawDescGZIP(), []int{111}
+}
+
+func (x *UpdateSessionTemplateResponse) GetTemplate() *SessionTemplate {
+	if x != nil {
+		return x.Template
+	}
+	return nil
+}
+
+type DeleteSessionTemplateRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TemplateId    string                 `protobuf:"bytes,1,opt,name=template_id,json=templateId,proto3" json:"template_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteSessionTemplateRequest) Reset() {
+	*x = DeleteSessionTemplateRequest{}
+	mi := &file_hdlctrl_v1_controller_proto_msgTypes[112]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteSessionTemplateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteSessionTemplateRequest) ProtoMessage() {}
+
+func (x *DeleteSessionTemplateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_hdlctrl_v1_controller_proto_msgTypes[112]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteSessionTemplateRequest.ProtoReflect.Descriptor instead.
+func (*DeleteSessionTemplateRequest) Descriptor() ([]byte, []int) {
+	return file_hdlctrl_v1_controller_proto_rawDescGZIP(), []int{112}
+}
+
+func (x *DeleteSessionTemplateRequest) GetTemplateId() string {
+	if x != nil {
+		return x.TemplateId
+	}
+	return ""
+}
+
+type DeleteSessionTemplateResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteSessionTemplateResponse) Reset() {
+	*x = DeleteSessionTemplateResponse{}
+	mi := &file_hdlctrl_v1_controller_proto_msgTypes[113]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteSessionTemplateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteSessionTemplateResponse) ProtoMessage() {}
+
+func (x *DeleteSessionTemplateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_hdlctrl_v1_controller_proto_msgTypes[113]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteSessionTemplateResponse.ProtoReflect.Descriptor instead.
+func (*DeleteSessionTemplateResponse) Descriptor() ([]byte, []int) {
+	return file_hdlctrl_v1_controller_proto_rawDescGZIP(), []int{113}
+}
+
+// 共通ページングメッセージ
+// page_index は 0 始まり。page_size 未指定 (=0) はサーバー側でデフォルト値が適用される。
+// 短時間で行の追加/削除が発生するとページ境界で重複/欠落が起こりうる (page_index ベース固有)。
+type PageRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PageIndex     int32                  `protobuf:"varint,1,opt,name=page_index,json=pageIndex,proto3" json:"page_index,omitempty"`
+	PageSize      int32                  `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PageRequest) Reset() {
+	*x = PageRequest{}
+	mi := &file_hdlctrl_v1_controller_proto_msgTypes[114]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PageRequest) ProtoMessage() {}
+
+func (x *PageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_hdlctrl_v1_controller_proto_msgTypes[114]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PageRequest.ProtoReflect.Descriptor instead.
+func (*PageRequest) Descriptor() ([]byte, []int) {
+	return file_hdlctrl_v1_controller_proto_rawDescGZIP(), []int{114}
+}
+
+func (x *PageRequest) GetPageIndex() int32 {
+	if x != nil {
+		return x.PageIndex
+	}
+	return 0
+}
+
+func (x *PageRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+// total_count は全体件数。
+// page_index / page_size はサーバーがバリデーション・クランプ・デフォルト適用後に実際に使用した値。
+// クライアントは UI 状態の同期に利用できる。
+type PageResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TotalCount    int32                  `protobuf:"varint,1,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
+	PageIndex     int32                  `protobuf:"varint,2,opt,name=page_index,json=pageIndex,proto3" json:"page_index,omitempty"`
+	PageSize      int32                  `protobuf:"varint,3,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PageResponse) Reset() {
+	*x = PageResponse{}
+	mi := &file_hdlctrl_v1_controller_proto_msgTypes[115]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PageResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PageResponse) ProtoMessage() {}
+
+func (x *PageResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_hdlctrl_v1_controller_proto_msgTypes[115]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PageResponse.ProtoReflect.Descriptor instead.
+func (*PageResponse) Descriptor() ([]byte, []int) {
+	return file_hdlctrl_v1_controller_proto_rawDescGZIP(), []int{115}
+}
+
+func (x *PageResponse) GetTotalCount() int32 {
+	if x != nil {
+		return x.TotalCount
+	}
+	return 0
+}
+
+func (x *PageResponse) GetPageIndex() int32 {
+	if x != nil {
+		return x.PageIndex
+	}
+	return 0
+}
+
+func (x *PageResponse) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+type HeadlessHostBindMount struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// コンテナを動かすマシン上の絶対パス. HOST_BIND_MOUNT_ALLOWED_SOURCES 配下のみ指定可能
+	Source string `protobuf:"bytes,1,opt,name=source,proto3" json:"source,omitempty"`
+	// コンテナ内の絶対パス
+	Target        string `protobuf:"bytes,2,opt,name=target,proto3" json:"target,omitempty"`
+	ReadOnly      bool   `protobuf:"varint,3,opt,name=read_only,json=readOnly,proto3" json:"read_only,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *HeadlessHostBindMount) Reset() {
+	*x = HeadlessHostBindMount{}
+	mi := &file_hdlctrl_v1_controller_proto_msgTypes[116]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HeadlessHostBindMount) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HeadlessHostBindMount) ProtoMessage() {}
+
+func (x *HeadlessHostBindMount) ProtoReflect() protoreflect.Message {
+	mi := &file_hdlctrl_v1_controller_proto_msgTypes[116]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HeadlessHostBindMount.ProtoReflect.Descriptor instead.
+func (*HeadlessHostBindMount) Descriptor() ([]byte, []int) {
+	return file_hdlctrl_v1_controller_proto_rawDescGZIP(), []int{116}
+}
+
+func (x *HeadlessHostBindMount) GetSource() string {
+	if x != nil {
+		return x.Source
+	}
+	return ""
+}
+
+func (x *HeadlessHostBindMount) GetTarget() string {
+	if x != nil {
+		return x.Target
+	}
+	return ""
+}
+
+func (x *HeadlessHostBindMount) GetReadOnly() bool {
+	if x != nil {
+		return x.ReadOnly
+	}
+	return false
+}
+
+type HostTemplate struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	Id          string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	GroupId     string                 `protobuf:"bytes,2,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
+	Name        string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Description string                 `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
+	// 空なら起動時点の最新リリース
+	ImageTag              string                         `protobuf:"bytes,5,opt,name=image_tag,json=imageTag,proto3" json:"image_tag,omitempty"`
+	StartupConfig         *v1.StartupConfig              `protobuf:"bytes,6,opt,name=startup_config,json=startupConfig,proto3" json:"startup_config,omitempty"`
+	AutoUpdatePolicy      HeadlessHostAutoUpdatePolicy   `protobuf:"varint,7,opt,name=auto_update_policy,json=autoUpdatePolicy,proto3,enum=hdlctrl.v1.HeadlessHostAutoUpdatePolicy" json:"auto_update_policy,omitempty"`
+	Memo                  string                         `protobuf:"bytes,8,opt,name=memo,proto3" json:"memo,omitempty"`
+	ContainerSettings     *HeadlessHostContainerSettings `protobuf:"bytes,9,opt,name=container_settings,json=containerSettings,proto3" json:"container_settings,omitempty"`
+	AutoRestartPolicy     HeadlessHostAutoRestartPolicy  `protobuf:"varint,10,opt,name=auto_restart_policy,json=autoRestartPolicy,proto3,enum=hdlctrl.v1.HeadlessHostAutoRestartPolicy" json:"auto_restart_policy,omitempty"`
+	AutoRestartMaxRetries int32                          `protobuf:"varint,11,opt,name=auto_restart_max_retries,json=autoRestartMaxRetries,proto3" json:"auto_restart_max_retries,omitempty"`
+	CreatedBy             *string                        `protobuf:"bytes,12,opt,name=created_by,json=createdBy,proto3,oneof" json:"created_by,omitempty"`
+	CreatedAt             *timestamppb.Timestamp         `protobuf:"bytes,13,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt             *timestamppb.Timestamp         `protobuf:"bytes,14,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields         protoimpl.UnknownFields
+	sizeCache             protoimpl.SizeCache
+}
+
+func (x *HostTemplate) Reset() {
+	*x = HostTemplate{}
+	mi := &file_hdlctrl_v1_controller_proto_msgTypes[117]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HostTemplate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HostTemplate) ProtoMessage() {}
+
+func (x *HostTemplate) ProtoReflect() protoreflect.Message {
+	mi := &file_hdlctrl_v1_controller_proto_msgTypes[117]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HostTemplate.ProtoReflect.Descriptor instead.
+func (*HostTemplate) Descriptor() ([]byte, []int) {
+	return file_hdlctrl_v1_controller_proto_rawDescGZIP(), []int{117}
+}
+
+func (x *HostTemplate) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *HostTemplate) GetGroupId() string {
+	if x != nil {
+		return x.GroupId
+	}
+	return ""
+}
+
+func (x *HostTemplate) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *HostTemplate) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *HostTemplate) GetImageTag() string {
+	if x != nil {
+		return x.ImageTag
+	}
+	return ""
+}
+
+func (x *HostTemplate) GetStartupConfig() *v1.StartupConfig {
+	if x != nil {
+		return x.StartupConfig
+	}
+	return nil
+}
+
+func (x *HostTemplate) GetAutoUpdatePolicy() HeadlessHostAutoUpdatePolicy {
+	if x != nil {
+		return x.AutoUpdatePolicy
+	}
+	return HeadlessHostAutoUpdatePolicy_HEADLESS_HOST_AUTO_UPDATE_POLICY_UNKNOWN
+}
+
+func (x *HostTemplate) GetMemo() string {
+	if x != nil {
+		return x.Memo
+	}
+	return ""
+}
+
+func (x *HostTemplate) GetContainerSettings() *HeadlessHostContainerSettings {
+	if x != nil {
+		return x.ContainerSettings
+	}
+	return nil
+}
+
+func (x *HostTemplate) GetAutoRestartPolicy() HeadlessHostAutoRestartPolicy {
+	if x != nil {
+		return x.AutoRestartPolicy
+	}
+	return HeadlessHostAutoRestartPolicy_HEADLESS_HOST_AUTO_RESTART_POLICY_UNKNOWN
+}
+
+func (x *HostTemplate) GetAutoRestartMaxRetries() int32 {
+	if x != nil {
+		return x.AutoRestartMaxRetries
+	}
+	return 0
+}
+
+func (x *HostTemplate) GetCreatedBy() string {
+	if x != nil && x.CreatedBy != nil {
+		return *x.CreatedBy
+	}
+	return ""
+}
+
+func (x *HostTemplate) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *HostTemplate) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+type ListHostTemplatesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	GroupId       string                 `protobuf:"bytes,1,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListHostTemplatesRequest) Reset() {
+	*x = ListHostTemplatesRequest{}
+	mi := &file_hdlctrl_v1_controller_proto_msgTypes[118]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListHostTemplatesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListHostTemplatesRequest) ProtoMessage() {}
+
+func (x *ListHostTemplatesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_hdlctrl_v1_controller_proto_msgTypes[118]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListHostTemplatesRequest.ProtoReflect.Descriptor instead.
+func (*ListHostTemplatesRequest) Descriptor() ([]byte, []int) {
+	return file_hdlctrl_v1_controller_proto_rawDescGZIP(), []int{118}
+}
+
+func (x *ListHostTemplatesRequest) GetGroupId() string {
+	if x != nil {
+		return x.GroupId
+	}
+	return ""
+}
+
+type ListHostTemplatesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Templates     []*HostTemplate        `protobuf:"bytes,1,rep,name=templates,proto3" json:"templates,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListHostTemplatesResponse) Reset() {
+	*x = ListHostTemplatesResponse{}
+	mi := &file_hdlctrl_v1_controller_proto_msgTypes[119]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListHostTemplatesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListHostTemplatesResponse) ProtoMessage() {}
+
+func (x *ListHostTemplatesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_hdlctrl_v1_controller_proto_msgTypes[119]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListHostTemplatesResponse.ProtoReflect.Descriptor instead.
+func (*ListHostTemplatesResponse) Descriptor() ([]byte, []int) {
+	return file_hdlctrl_v1_controller_proto_rawDescGZIP(), []int{119}
+}
+
+func (x *ListHostTemplatesResponse) GetTemplates() []*HostTemplate {
+	if x != nil {
+		return x.Templates
+	}
+	return nil
+}
+
+type GetHostTemplateRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TemplateId    string                 `protobuf:"bytes,1,opt,name=template_id,json=templateId,proto3" json:"template_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetHostTemplateRequest) Reset() {
+	*x = GetHostTemplateRequest{}
+	mi := &file_hdlctrl_v1_controller_proto_msgTypes[120]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetHostTemplateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetHostTemplateRequest) ProtoMessage() {}
+
+func (x *GetHostTemplateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_hdlctrl_v1_controller_proto_msgTypes[120]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetHostTemplateRequest.ProtoReflect.Descriptor instead.
+func (*GetHostTemplateRequest) Descriptor() ([]byte, []int) {
+	return file_hdlctrl_v1_controller_proto_rawDescGZIP(), []int{120}
+}
+
+func (x *GetHostTemplateRequest) GetTemplateId() string {
+	if x != nil {
+		return x.TemplateId
+	}
+	return ""
+}
+
+type GetHostTemplateResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Template      *HostTemplate          `protobuf:"bytes,1,opt,name=template,proto3" json:"template,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetHostTemplateResponse) Reset() {
+	*x = GetHostTemplateResponse{}
+	mi := &file_hdlctrl_v1_controller_proto_msgTypes[121]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetHostTemplateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetHostTemplateResponse) ProtoMessage() {}
+
+func (x *GetHostTemplateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_hdlctrl_v1_controller_proto_msgTypes[121]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetHostTemplateResponse.ProtoReflect.Descriptor instead.
+func (*GetHostTemplateResponse) Descriptor() ([]byte, []int) {
+	return file_hdlctrl_v1_controller_proto_rawDescGZIP(), []int{121}
+}
+
+func (x *GetHostTemplateResponse) GetTemplate() *HostTemplate {
+	if x != nil {
+		return x.Template
+	}
+	return nil
+}
+
+type CreateHostTemplateRequest struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	GroupId string                 `protobuf:"bytes,1,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
+	// グループ内で一意
+	Name                  string                         `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Description           string                         `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	ImageTag              string                         `protobuf:"bytes,4,opt,name=image_tag,json=imageTag,proto3" json:"image_tag,omitempty"`
+	StartupConfig         *v1.StartupConfig              `protobuf:"bytes,5,opt,name=startup_config,json=startupConfig,proto3" json:"startup_config,omitempty"`
+	AutoUpdatePolicy      HeadlessHostAutoUpdatePolicy   `protobuf:"varint,6,opt,name=auto_update_policy,json=autoUpdatePolicy,proto3,enum=hdlctrl.v1.HeadlessHostAutoUpdatePolicy" json:"auto_update_policy,omitempty"`
+	Memo                  string                         `protobuf:"bytes,7,opt,name=memo,proto3" json:"memo,omitempty"`
+	ContainerSettings     *HeadlessHostContainerSettings `protobuf:"bytes,8,opt,name=container_settings,json=containerSettings,proto3" json:"container_settings,omitempty"`
+	AutoRestartPolicy     HeadlessHostAutoRestartPolicy  `protobuf:"varint,9,opt,name=auto_restart_policy,json=autoRestartPolicy,proto3,enum=hdlctrl.v1.HeadlessHostAutoRestartPolicy" json:"auto_restart_policy,omitempty"`
+	AutoRestartMaxRetries int32                          `protobuf:"varint,10,opt,name=auto_restart_max_retries,json=autoRestartMaxRetries,proto3" json:"auto_restart_max_retries,omitempty"`
+	unknownFields         protoimpl.UnknownFields
+	sizeCache             protoimpl.SizeCache
+}
+
+func (x *CreateHostTemplateRequest) Reset() {
+	*x = CreateHostTemplateRequest{}
+	mi := &file_hdlctrl_v1_controller_proto_msgTypes[122]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateHostTemplateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateHostTemplateRequest) ProtoMessage() {}
+
+func (x *CreateHostTemplateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_hdlctrl_v1_controller_proto_msgTypes[122]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateHostTemplateRequest.ProtoReflect.Descriptor instead.
+func (*CreateHostTemplateRequest) Descriptor() ([]byte, []int) {
+	return file_hdlctrl_v1_controller_proto_rawDescGZIP(), []int{122}
+}
+
+func (x *CreateHostTemplateRequest) GetGroupId() string {
+	if x != nil {
+		return x.GroupId
+	}
+	return ""
+}
+
+func (x *CreateHostTemplateRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateHostTemplateRequest) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *CreateHostTemplateRequest) GetImageTag() string {
+	if x != nil {
+		return x.ImageTag
+	}
+	return ""
+}
+
+func (x *CreateHostTemplateRequest) GetStartupConfig() *v1.StartupConfig {
+	if x != nil {
+		return x.StartupConfig
+	}
+	return nil
+}
+
+func (x *CreateHostTemplateRequest) GetAutoUpdatePolicy() HeadlessHostAutoUpdatePolicy {
+	if x != nil {
+		return x.AutoUpdatePolicy
+	}
+	return HeadlessHostAutoUpdatePolicy_HEADLESS_HOST_AUTO_UPDATE_POLICY_UNKNOWN
+}
+
+func (x *CreateHostTemplateRequest) GetMemo() string {
+	if x != nil {
+		return x.Memo
+	}
+	return ""
+}
+
+func (x *CreateHostTemplateRequest) GetContainerSettings() *HeadlessHostContainerSettings {
+	if x != nil {
+		return x.ContainerSettings
+	}
+	return nil
+}
+
+func (x *CreateHostTemplateRequest) GetAutoRestartPolicy() HeadlessHostAutoRestartPolicy {
+	if x != nil {
+		return x.AutoRestartPolicy
+	}
+	return HeadlessHostAutoRestartPolicy_HEADLESS_HOST_AUTO_RESTART_POLICY_UNKNOWN
+}
+
+func (x *CreateHostTemplateRequest) GetAutoRestartMaxRetries() int32 {
+	if x != nil {
+		return x.AutoRestartMaxRetries
+	}
+	return 0
+}
+
+type CreateHostTemplateResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Template      *HostTemplate          `protobuf:"bytes,1,opt,name=template,proto3" json:"template,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateHostTemplateResponse) Reset() {
+	*x = CreateHostTemplateResponse{}
+	mi := &file_hdlctrl_v1_controller_proto_msgTypes[123]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateHostTemplateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateHostTemplateResponse) ProtoMessage() {}
+
+func (x *CreateHostTemplateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_hdlctrl_v1_controller_proto_msgTypes[123]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateHostTemplateResponse.ProtoReflect.Descriptor instead.
+func (*CreateHostTemplateResponse) Descriptor() ([]byte, []int) {
+	return file_hdlctrl_v1_controller_proto_rawDescGZIP(), []int{123}
+}
+
+func (x *CreateHostTemplateResponse) GetTemplate() *HostTemplate {
+	if x != nil {
+		return x.Template
+	}
+	return nil
+}
+
+// 未指定のフィールドは変更しない. startup_config / container_settings は指定した場合まるごと置き換える.
+type UpdateHostTemplateRequest struct {
+	state                 protoimpl.MessageState         `protogen:"open.v1"`
+	TemplateId            string                         `protobuf:"bytes,1,opt,name=template_id,json=templateId,proto3" json:"template_id,omitempty"`
+	Name                  *string                        `protobuf:"bytes,2,opt,name=name,proto3,oneof" json:"name,omitempty"`
+	Description           *string                        `protobuf:"bytes,3,opt,name=description,proto3,oneof" json:"description,omitempty"`
+	ImageTag              *string                        `protobuf:"bytes,4,opt,name=image_tag,json=imageTag,proto3,oneof" json:"image_tag,omitempty"`
+	StartupConfig         *v1.StartupConfig              `protobuf:"bytes,5,opt,name=startup_config,json=startupConfig,proto3,oneof" json:"startup_config,omitempty"`
+	AutoUpdatePolicy      *HeadlessHostAutoUpdatePolicy  `protobuf:"varint,6,opt,name=auto_update_policy,json=autoUpdatePolicy,proto3,enum=hdlctrl.v1.HeadlessHostAutoUpdatePolicy,oneof" json:"auto_update_policy,omitempty"`
+	Memo                  *string                        `protobuf:"bytes,7,opt,name=memo,proto3,oneof" json:"memo,omitempty"`
+	ContainerSettings     *HeadlessHostContainerSettings `protobuf:"bytes,8,opt,name=container_settings,json=containerSettings,proto3,oneof" json:"container_settings,omitempty"`
+	AutoRestartPolicy     *HeadlessHostAutoRestartPolicy `protobuf:"varint,9,opt,name=auto_restart_policy,json=autoRestartPolicy,proto3,enum=hdlctrl.v1.HeadlessHostAutoRestartPolicy,oneof" json:"auto_restart_policy,omitempty"`
+	AutoRestartMaxRetries *int32                         `protobuf:"varint,10,opt,name=auto_restart_max_retries,json=autoRestartMaxRetries,proto3,oneof" json:"auto_restart_max_retries,omitempty"`
+	unknownFields         protoimpl.UnknownFields
+	sizeCache             protoimpl.SizeCache
+}
+
+func (x *UpdateHostTemplateRequest) Reset() {
+	*x = UpdateHostTemplateRequest{}
+	mi := &file_hdlctrl_v1_controller_proto_msgTypes[124]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateHostTemplateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateHostTemplateRequest) ProtoMessage() {}
+
+func (x *UpdateHostTemplateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_hdlctrl_v1_controller_proto_msgTypes[124]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateHostTemplateRequest.ProtoReflect.Descriptor instead.
+func (*UpdateHostTemplateRequest) Descriptor() ([]byte, []int) {
+	return file_hdlctrl_v1_controller_proto_rawDescGZIP(), []int{124}
+}
+
+func (x *UpdateHostTemplateRequest) GetTemplateId() string {
+	if x != nil {
+		return x.TemplateId
+	}
+	return ""
+}
+
+func (x *UpdateHostTemplateRequest) GetName() string {
+	if x != nil && x.Name != nil {
+		return *x.Name
+	}
+	return ""
+}
+
+func (x *UpdateHostTemplateRequest) GetDescription() string {
+	if x != nil && x.Description != nil {
+		return *x.Description
+	}
+	return ""
+}
+
+func (x *UpdateHostTemplateRequest) GetImageTag() string {
+	if x != nil && x.ImageTag != nil {
+		return *x.ImageTag
+	}
+	return ""
+}
+
+func (x *UpdateHostTemplateRequest) GetStartupConfig() *v1.StartupConfig {
+	if x != nil {
+		return x.StartupConfig
+	}
+	return nil
+}
+
+func (x *UpdateHostTemplateRequest) GetAutoUpdatePolicy() HeadlessHostAutoUpdatePolicy {
+	if x != nil && x.AutoUpdatePolicy != nil {
+		return *x.AutoUpdatePolicy
+	}
+	return HeadlessHostAutoUpdatePolicy_HEADLESS_HOST_AUTO_UPDATE_POLICY_UNKNOWN
+}
+
+func (x *UpdateHostTemplateRequest) GetMemo() string {
+	if x != nil && x.Memo != nil {
+		return *x.Memo
+	}
+	return ""
+}
+
+func (x *UpdateHostTemplateRequest) GetContainerSettings() *HeadlessHostContainerSettings {
+	if x != nil {
+		return x.ContainerSettings
+	}
+	return nil
+}
+
+func (x *UpdateHostTemplateRequest) GetAutoRestartPolicy() HeadlessHostAutoRestartPolicy {
+	if x != nil && x.AutoRestartPolicy != nil {
+		return *x.AutoRestartPolicy
+	}
+	return HeadlessHostAutoRestartPolicy_HEADLESS_HOST_AUTO_RESTART_POLICY_UNKNOWN
+}
+
+func (x *UpdateHostTemplateRequest) GetAutoRestartMaxRetries() int32 {
+	if x != nil && x.AutoRestartMaxRetries != nil {
+		return *x.AutoRestartMaxRetries
+	}
+	return 0
+}
+
+type UpdateHostTemplateResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Template      *HostTemplate          `protobuf:"bytes,1,opt,name=template,proto3" json:"template,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateHostTemplateResponse) Reset() {
+	*x = UpdateHostTemplateResponse{}
+	mi := &file_hdlctrl_v1_controller_proto_msgTypes[125]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateHostTemplateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateHostTemplateResponse) ProtoMessage() {}
+
+func (x *UpdateHostTemplateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_hdlctrl_v1_controller_proto_msgTypes[125]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateHostTemplateResponse.ProtoReflect.Descriptor instead.
+func (*UpdateHostTemplateResponse) Descriptor() ([]byte, []int) {
+	return file_hdlctrl_v1_controller_proto_rawDescGZIP(), []int{125}
+}
+
+func (x *UpdateHostTemplateResponse) GetTemplate() *HostTemplate {
+	if x != nil {
+		return x.Template
+	}
+	return nil
+}
+
+type DeleteHostTemplateRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TemplateId    string                 `protobuf:"bytes,1,opt,name=template_id,json=templateId,proto3" json:"template_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteHostTemplateRequest) Reset() {
+	*x = DeleteHostTemplateRequest{}
+	mi := &file_hdlctrl_v1_controller_proto_msgTypes[126]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteHostTemplateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteHostTemplateRequest) ProtoMessage() {}
+
+func (x *DeleteHostTemplateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_hdlctrl_v1_controller_proto_msgTypes[126]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteHostTemplateRequest.ProtoReflect.Descriptor instead.
+func (*DeleteHostTemplateRequest) Descriptor() ([]byte, []int) {
+	return file_hdlctrl_v1_controller_proto_rawDescGZIP(), []int{126}
+}
+
+func (x *DeleteHostTemplateRequest) GetTemplateId() string {
+	if x != nil {
+		return x.TemplateId
+	}
+	return ""
+}
+
+type DeleteHostTemplateResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteHostTemplateResponse) Reset() {
+	*x = DeleteHostTemplateResponse{}
+	mi := &file_hdlctrl_v1_controller_proto_msgTypes[127]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteHostTemplateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteHostTemplateResponse) ProtoMessage() {}
+
+func (x *DeleteHostTemplateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_hdlctrl_v1_controller_proto_msgTypes[127]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteHostTemplateResponse.ProtoReflect.Descriptor instead.
+func (*DeleteHostTemplateResponse) Descriptor() ([]byte, []int) {
+	return file_hdlctrl_v1_controller_proto_rawDescGZIP(), []int{127}
+}
+
+// ホストのコンテナに適用するリソース制限と配置設定. 0 / 空の項目は制限なし.
+type HeadlessHostContainerSettings struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// CPU 使用量の上限 (コア数. 1.5 なら 1.5 コア分)
+	Cpus        float64 `protobuf:"fixed64,1,opt,name=cpus,proto3" json:"cpus,omitempty"`
+	MemoryBytes int64   `protobuf:"varint,2,opt,name=memory_bytes,json=memoryBytes,proto3" json:"memory_bytes,omitempty"`
+	// メモリ + swap の上限. -1 で swap 無制限. memory_bytes 以上である必要がある
+	MemorySwapBytes int64 `protobuf:"varint,3,opt,name=memory_swap_bytes,json=memorySwapBytes,proto3" json:"memory_swap_bytes,omitempty"`
+	// 使用する CPU の指定 (例: "0-3", "0,2")
+	CpusetCpus        string                    `protobuf:"bytes,4,opt,name=cpuset_cpus,json=cpusetCpus,proto3" json:"cpuset_cpus,omitempty"`
+	RestartPolicy     HeadlessHostRestartPolicy `protobuf:"varint,5,opt,name=restart_policy,json=restartPolicy,proto3,enum=hdlctrl.v1.HeadlessHostRestartPolicy" json:"restart_policy,omitempty"`
+	RestartMaxRetries int32                     `protobuf:"varint,6,opt,name=restart_max_retries,json=restartMaxRetries,proto3" json:"restart_max_retries,omitempty"`
+	BindMounts        []*HeadlessHostBindMount  `protobuf:"bytes,7,rep,name=bind_mounts,json=bindMounts,proto3" json:"bind_mounts,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *HeadlessHostContainerSettings) Reset() {
+	*x = HeadlessHostContainerSettings{}
+	mi := &file_hdlctrl_v1_controller_proto_msgTypes[128]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HeadlessHostContainerSettings) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HeadlessHostContainerSettings) ProtoMessage() {}
+
+func (x *HeadlessHostContainerSettings) ProtoReflect() protoreflect.Message {
+	mi := &file_hdlctrl_v1_controller_proto_msgTypes[128]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HeadlessHostContainerSettings.ProtoReflect.Descriptor instead.
+func (*HeadlessHostContainerSettings) Descriptor() ([]byte, []int) {
+	return file_hdlctrl_v1_controller_proto_rawDescGZIP(), []int{128}
+}
+
+func (x *HeadlessHostContainerSettings) GetCpus() float64 {
+	if x != nil {
+		return x.Cpus
+	}
+	return 0
+}
+
+func (x *HeadlessHostContainerSettings) GetMemoryBytes() int64 {
+	if x != nil {
+		return x.MemoryBytes
+	}
+	return 0
+}
+
+func (x *HeadlessHostContainerSettings) GetMemorySwapBytes() int64 {
+	if x != nil {
+		return x.MemorySwapBytes
+	}
+	return 0
+}
+
+func (x *HeadlessHostContainerSettings) GetCpusetCpus() string {
+	if x != nil {
+		return x.CpusetCpus
+	}
+	return ""
+}
+
+func (x *HeadlessHostContainerSettings) GetRestartPolicy() HeadlessHostRestartPolicy {
+	if x != nil {
+		return x.RestartPolicy
+	}
+	return HeadlessHostRestartPolicy_HEADLESS_HOST_RESTART_POLICY_UNKNOWN
+}
+
+func (x *HeadlessHostContainerSettings) GetRestartMaxRetries() int32 {
+	if x != nil {
+		return x.RestartMaxRetries
+	}
+	return 0
+}
+
+func (x *HeadlessHostContainerSettings) GetBindMounts() []*HeadlessHostBindMount {
+	if x != nil {
+		return x.BindMounts
+	}
+	return nil
+}
+
+type HeadlessHostSettings struct {
+	state                       protoimpl.MessageState   `protogen:"open.v1"`
+	UniverseId                  *string                  `protobuf:"bytes,1,opt,name=universe_id,json=universeId,proto3,oneof" json:"universe_id,omitempty"`
+	TickRate                    float32                  `protobuf:"fixed32,2,opt,name=tick_rate,json=tickRate,proto3" json:"tick_rate,omitempty"`
+	MaxConcurrentAssetTransfers int32                    `protobuf:"varint,3,opt,name=max_concurrent_asset_transfers,json=maxConcurrentAssetTransfers,proto3" json:"max_concurrent_asset_transfers,omitempty"`
+	UsernameOverride            *string                  `protobuf:"bytes,4,opt,name=username_override,json=usernameOverride,proto3,oneof" json:"username_override,omitempty"`
+	AllowedUrlHosts             []*v1.AllowedAccessEntry `protobuf:"bytes,5,rep,name=allowed_url_hosts,json=allowedUrlHosts,proto3" json:"allowed_url_hosts,omitempty"`
+	AutoSpawnItems              []string                 `protobuf:"bytes,6,rep,name=auto_spawn_items,json=autoSpawnItems,proto3" json:"auto_spawn_items,omitempty"`
+	unknownFields               protoimpl.UnknownFields
+	sizeCache                   protoimpl.SizeCache
+}
+
+func (x *HeadlessHostSettings) Reset() {
+	*x = HeadlessHostSettings{}
+	mi := &file_hdlctrl_v1_controller_proto_msgTypes[129]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HeadlessHostSettings) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HeadlessHostSettings) ProtoMessage() {}
+
+func (x *HeadlessHostSettings) ProtoReflect() protoreflect.Message {
+	mi := &file_hdlctrl_v1_controller_proto_msgTypes[129]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HeadlessHostSettings.ProtoReflect.Descriptor instead.
+func (*HeadlessHostSettings) Descriptor() ([]byte, []int) {
+	return file_hdlctrl_v1_controller_proto_rawDescGZIP(), []int{129}
+}
+
+func (x *HeadlessHostSettings) GetUniverseId() string {
+	if x != nil && x.UniverseId != nil {
+		return *x.UniverseId
+	}
+	return ""
+}
+
+func (x *HeadlessHostSettings) GetTickRate() float32 {
+	if x != nil {
+		return x.TickRate
+	}
+	return 0
+}
+
+func (x *HeadlessHostSettings) GetMaxConcurrentAssetTransfers() int32 {
+	if x != nil {
+		return x.MaxConcurrentAssetTransfers
+	}
+	return 0
+}
+
+func (x *HeadlessHostSettings) GetUsernameOverride() string {
+	if x != nil && x.UsernameOverride != nil {
+		return *x.UsernameOverride
+	}
+	return ""
+}
+
+func (x *HeadlessHostSettings) GetAllowedUrlHosts() []*v1.AllowedAccessEntry {
+	if x != nil {
+		return x.AllowedUrlHosts
+	}
+	return nil
+}
+
+func (x *HeadlessHostSettings) GetAutoSpawnItems() []string {
+	if x != nil {
+		return x.AutoSpawnItems
+	}
+	return nil
+}
+
+type HeadlessHost struct {
+	state            protoimpl.MessageState       `protogen:"open.v1"`
+	Id               string                       `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name             string                       `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	ResoniteVersion  string                       `protobuf:"bytes,4,opt,name=resonite_version,json=resoniteVersion,proto3" json:"resonite_version,omitempty"`
+	AppVersion       string                       `protobuf:"bytes,11,opt,name=app_version,json=appVersion,proto3" json:"app_version,omitempty"`
+	AccountId        string                       `protobuf:"bytes,5,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
+	AccountName      string                       `protobuf:"bytes,6,opt,name=account_name,json=accountName,proto3" json:"account_name,omitempty"`
+	Fps              float32                      `protobuf:"fixed32,7,opt,name=fps,proto3" json:"fps,omitempty"`
+	Status           HeadlessHostStatus           `protobuf:"varint,10,opt,name=status,proto3,enum=hdlctrl.v1.HeadlessHostStatus" json:"status,omitempty"`
+	AutoUpdatePolicy HeadlessHostAutoUpdatePolicy `protobuf:"varint,12,opt,name=auto_update_policy,json=autoUpdatePolicy,proto3,enum=hdlctrl.v1.HeadlessHostAutoUpdatePolicy" json:"auto_update_policy,omitempty"`
+	Memo             string                       `protobuf:"bytes,13,opt,name=memo,proto3" json:"memo,omitempty"`
+	HostSettings     *HeadlessHostSettings        `protobuf:"bytes,14,opt,name=host_settings,json=hostSettings,proto3" json:"host_settings,omitempty"`
+	InstanceId       int32                        `protobuf:"varint,15,opt,name=instance_id,json=instanceId,proto3" json:"instance_id,omitempty"`
+	// 所属グループ ID. 権限判定の基準となる.
+	GroupId string `protobuf:"bytes,16,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
+	// 作成者 user_id. 権限とは独立した記録用途.
+	// ユーザー削除等で参照先が消えうるため nullable.
+	CreatedBy *string `protobuf:"bytes,17,opt,name=created_by,json=createdBy,proto3,oneof" json:"created_by,omitempty"`
+	// ホストが配置されている docker node ID. node を持たない connector では空.
+	NodeId            string                         `protobuf:"bytes,18,opt,name=node_id,json=nodeId,proto3" json:"node_id,omitempty"`
+	ContainerSettings *HeadlessHostContainerSettings `protobuf:"bytes,19,opt,name=container_settings,json=containerSettings,proto3" json:"container_settings,omitempty"`
+	AutoRestartPolicy HeadlessHostAutoRestartPolicy  `protobuf:"varint,20,opt,name=auto_restart_policy,json=autoRestartPolicy,proto3,enum=hdlctrl.v1.HeadlessHostAutoRestartPolicy" json:"auto_restart_policy,omitempty"`
+	// 0 ならサーバー設定の crash loop 判定回数まで
+	AutoRestartMaxRetries int32 `protobuf:"varint,21,opt,name=auto_restart_max_retries,json=autoRestartMaxRetries,proto3" json:"auto_restart_max_retries,omitempty"`
+	// これまでにクラッシュした回数 (自動再起動の有無によらず数える)
+	CrashCount    int32                  `protobuf:"varint,22,opt,name=crash_count,json=crashCount,proto3" json:"crash_count,omitempty"`
+	LastCrashedAt *timestamppb.Timestamp `protobuf:"bytes,23,opt,name=last_crashed_at,json=lastCrashedAt,proto3,oneof" json:"last_crashed_at,omitempty"`
+	// crash loop と判定されて自動再起動を止めている
+	AutoRestartSuspended bool `protobuf:"varint,24,opt,name=auto_restart_suspended,json=autoRestartSuspended,proto3" json:"auto_restart_suspended,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *HeadlessHost) Reset() {
+	*x = HeadlessHost{}
+	mi := &file_hdlctrl_v1_controller_proto_msgTypes[130]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HeadlessHost) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HeadlessHost) ProtoMessage() {}
+
+func (x *HeadlessHost) ProtoReflect() protoreflect.Message {
+	mi := &file_hdlctrl_v1_controller_proto_msgTypes[130]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HeadlessHost.ProtoReflect.Descriptor instead.
+func (*HeadlessHost) Descriptor() ([]byte, []int) {
+	return file_hdlctrl_v1_controller_proto_rawDescGZIP(), []int{130}
+}
+
+func (x *HeadlessHost) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *HeadlessHost) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *HeadlessHost) GetResoniteVersion() string {
+	if x != nil {
+		return x.ResoniteVersion
+	}
+	return ""
+}
+
+func (x *HeadlessHost) GetAppVersion() string {
+	if x != nil {
+		return x.AppVersion
+	}
+	return ""
+}
+
+func (x *HeadlessHost) GetAccountId() string {
+	if x != nil {
+		return x.AccountId
+	}
+	return ""
+}
+
+func (x *HeadlessHost) GetAccountName() string {
+	if x != nil {
+		return x.AccountName
+	}
+	return ""
+}
+
+func (x *HeadlessHost) GetFps() float32 {
+	if x != nil {
+		return x.Fps
+	}
+	return 0
+}
+
+func (x *HeadlessHost) GetStatus() HeadlessHostStatus {
+	if x != nil {
+		return x.Status
+	}
+	return HeadlessHostStatus_HEADLESS_HOST_STATUS_UNKNOWN
+}
+
+func (x *HeadlessHost) GetAutoUpdatePolicy() HeadlessHostAutoUpdatePolicy {
+	if x != nil {
+		return x.AutoUpdatePolicy
+	}
+	return HeadlessHostAutoUpdatePolicy_HEADLESS_HOST_AUTO_UPDATE_POLICY_UNKNOWN
+}
+
+func (x *HeadlessHost) GetMemo() string {
+	if x != nil {
+		return x.Memo
+	}
+	return ""
+}
+
+func (x *HeadlessHost) GetHostSettings() *HeadlessHostSettings {
+	if x != nil {
+		return x.HostSettings
+	}
+	return nil
+}
+
+func (x *HeadlessHost) GetInstanceId() int32 {
+	if x != nil {
+		return x.InstanceId
+	}
+	return 0
+}
+
+func (x *HeadlessHost) GetGroupId() string {
+	if x != nil {
+		return x.GroupId
+	}
+	return ""
+}
+
+func (x *HeadlessHost) GetCreatedBy() string {
+	if x != nil && x.CreatedBy != nil {
+		return *x.CreatedBy
+	}
+	return ""
+}
+
+func (x *HeadlessHost) GetNodeId() string {
+	if x != nil {
+		return x.NodeId
+	}
+	return ""
+}
+
+func (x *HeadlessHost) GetContainerSettings() *HeadlessHostContainerSettings {
+	if x != nil {
+		return x.ContainerSettings
+	}
+	return nil
+}
+
+func (x *HeadlessHost) GetAutoRestartPolicy() HeadlessHostAutoRestartPolicy {
+	if x != nil {
+		return x.AutoRestartPolicy
+	}
+	return HeadlessHostAutoRestartPolicy_HEADLESS_HOST_AUTO_RESTART_POLICY_UNKNOWN
+}
+
+func (x *HeadlessHost) GetAutoRestartMaxRetries() int32 {
+	if x != nil {
+		return x.AutoRestartMaxRetries
+	}
+	return 0
+}
+
+func (x *HeadlessHost) GetCrashCount() int32 {
+	if x != nil {
+		return x.CrashCount
+	}
+	return 0
+}
+
+func (x *HeadlessHost) GetLastCrashedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastCrashedAt
+	}
+	return nil
+}
+
+func (x *HeadlessHost) GetAutoRestartSuspended() bool {
+	if x != nil {
+		return x.AutoRestartSuspended
+	}
+	return false
+}
+
+type Session struct {
+	state             protoimpl.MessageState     `protogen:"open.v1"`
+	Id                string                     `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name              string                     `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	HostId            string                     `protobuf:"bytes,3,opt,name=host_id,json=hostId,proto3" json:"host_id,omitempty"`
+	Status            SessionStatus              `protobuf:"varint,4,opt,name=status,proto3,enum=hdlctrl.v1.SessionStatus" json:"status,omitempty"`
+	StartedAt         *timestamppb.Timestamp     `protobuf:"bytes,5,opt,name=started_at,json=startedAt,proto3" json:"started_at,omitempty"`
+	EndedAt           *timestamppb.Timestamp     `protobuf:"bytes,6,opt,name=ended_at,json=endedAt,proto3,oneof" json:"ended_at,omitempty"`
+	StartupParameters *v1.WorldStartupParameters `protobuf:"bytes,7,opt,name=startup_parameters,json=startupParameters,proto3" json:"startup_parameters,omitempty"`
+	CurrentState      *v1.Session                `protobuf:"bytes,8,opt,name=current_state,json=currentState,proto3,oneof" json:"current_state,omitempty"`
+	// 旧: 作成者 user_id. 権限システム導入に伴い created_by に統合.
+	// 移行期間中は created_by と同値を返す.
+	//
+	// Deprecated: Marked as deprecated in hdlctrl/v1/controller.proto.
+	OwnerId     *string `protobuf:"bytes,9,opt,name=owner_id,json=ownerId,proto3,oneof" json:"owner_id,omitempty"`
+	AutoUpgrade bool    `protobuf:"varint,10,opt,name=auto_upgrade,json=autoUpgrade,proto3" json:"auto_upgrade,omitempty"`
+	Memo        string  `protobuf:"bytes,11,opt,name=memo,proto3" json:"memo,omitempty"`
+	// 所属グループ ID. 権限判定の基準となる.
+	// host.group_id == account.group_id == session.group_id (同一グループ制約).
+	GroupId string `protobuf:"bytes,12,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
+	// 作成者 user_id. 権限とは独立した記録用途.
+	CreatedBy *string `protobuf:"bytes,13,opt,name=created_by,json=createdBy,proto3,oneof" json:"created_by,omitempty"`
+	// host がクラッシュした後、host が RUNNING に戻ったら同じ設定で起動し直す.
+	RestoreOnCrash bool `protobuf:"varint,14,opt,name=restore_on_crash,json=restoreOnCrash,proto3" json:"restore_on_crash,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *Session) Reset() {
+	*x = Session{}
+	mi := &file_hdlctrl_v1_controller_proto_msgTypes[131]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Session) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Session) ProtoMessage() {}
+
+func (x *Session) ProtoReflect() protoreflect.Message {
+	mi := &file_hdlctrl_v1_controller_proto_msgTypes[131]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Session.ProtoReflect.Descriptor instead.
+func (*Session) Descriptor() ([]byte, []int) {
+	return file_hdlctrl_v1_controller_proto_rawDescGZIP(), []int{131}
+}
+
+func (x *Session) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Session) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Session) GetHostId() string {
+	if x != nil {
+		return x.HostId
+	}
+	return ""
+}
+
+func (x *Session) GetStatus() SessionStatus {
+	if x != nil {
+		return x.Status
+	}
+	return SessionStatus_SESSION_STATUS_UNKNOWN
+}
+
+func (x *Session) GetStartedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartedAt
+	}
+	return nil
+}
+
+func (x *Session) GetEndedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndedAt
+	}
+	return nil
+}
+
+func (x *Session) GetStartupParameters() *v1.WorldStartupParameters {
+	if x != nil {
+		return x.StartupParameters
+	}
+	return nil
+}
+
+func (x *Session) GetCurrentState() *v1.Session {
+	if x != nil {
+		return x.CurrentState
+	}
+	return nil
+}
+
+// Deprecated: Marked as deprecated in hdlctrl/v1/controller.proto.
+func (x *Session) GetOwnerId() string {
+	if x != nil && x.OwnerId != nil {
+		return *x.OwnerId
+	}
+	return ""
+}
+
+func (x *Session) GetAutoUpgrade() bool {
+	if x != nil {
+		return x.AutoUpgrade
+	}
+	return false
+}
+
+func (x *Session) GetMemo() string {
+	if x != nil {
+		return x.Memo
+	}
+	return ""
+}
+
+func (x *Session) GetGroupId() string {
+	if x != nil {
+		return x.GroupId
+	}
+	return ""
 }
 
-type HeadlessHostSettings struct {
-	state                       protoimpl.MessageState   `protogen:"open.v1"`
-	UniverseId                  *string                  `protobuf:"bytes,1,opt,name=universe_id,json=universeId,proto3,oneof" json:"universe_id,omitempty"`
-	TickRate                    float32                  `protobuf:"fixed32,2,opt,name=tick_rate,json=tickRate,proto3" json:"tick_rate,omitempty"`
-	MaxConcurrentAssetTransfers int32                    `protobuf:"varint,3,opt,name=max_concurrent_asset_transfers,json=maxConcurrentAssetTransfers,proto3" json:"max_concurrent_asset_transfers,omitempty"`
-	UsernameOverride            *string                  `protobuf:"bytes,4,opt,name=username_override,json=usernameOverride,proto3,oneof" json:"username_override,omitempty"`
-	AllowedUrlHosts             []*v1.AllowedAccessEntry `protobuf:"bytes,5,rep,name=allowed_url_hosts,json=allowedUrlHosts,proto3" json:"allowed_url_hosts,omitempty"`
-	AutoSpawnItems              []string                 `protobuf:"bytes,6,rep,name=auto_spawn_items,json=autoSpawnItems,proto3" json:"auto_spawn_items,omitempty"`
-	unknownFields               protoimpl.UnknownFields
-	sizeCache                   protoimpl.SizeCache
+func (x *Session) GetCreatedBy() string {
+	if x != nil && x.CreatedBy != nil {
+		return *x.CreatedBy
+	}
+	return ""
 }
 
-func (x *HeadlessHostSettings) Reset() {
-	*x = HeadlessHostSettings{}
-	mi := &file_hdlctrl_v1_controller_proto_msgTypes[84]
+func (x *Session) GetRestoreOnCrash() bool {
+	if x != nil {
+		return x.RestoreOnCrash
+	}
+	return false
+}
+
+type HeadlessAccount struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	UserId   string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	UserName string                 `protobuf:"bytes,2,opt,name=user_name,json=userName,proto3" json:"user_name,omitempty"`
+	IconUrl  string                 `protobuf:"bytes,3,opt,name=icon_url,json=iconUrl,proto3" json:"icon_url,omitempty"`
+	// 所属グループ ID. 権限判定の基準となる.
+	GroupId string `protobuf:"bytes,4,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
+	// 作成者 user_id. 権限とは独立した記録用途.
+	// 既存データは nullable, 新規作成時は呼び出しユーザーが入る.
+	CreatedBy     *string `protobuf:"bytes,5,opt,name=created_by,json=createdBy,proto3,oneof" json:"created_by,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *HeadlessAccount) Reset() {
+	*x = HeadlessAccount{}
+	mi := &file_hdlctrl_v1_controller_proto_msgTypes[132]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *HeadlessHostSettings) String() string {
+func (x *HeadlessAccount) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*HeadlessHostSettings) ProtoMessage() {}
+func (*HeadlessAccount) ProtoMessage() {}
 
-func (x *HeadlessHostSettings) ProtoReflect() protoreflect.Message {
-	mi := &file_hdlctrl_v1_controller_proto_msgTypes[84]
+func (x *HeadlessAccount) ProtoReflect() protoreflect.Message {
+	mi := &file_hdlctrl_v1_controller_proto_msgTypes[132]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4601,91 +8648,70 @@ func (x *HeadlessHostSettings) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use HeadlessHostSettings.ProtoReflect.Descriptor instead.
-func (*HeadlessHostSettings) Descriptor() ([]byte, []int) {
-	return file_hdlctrl_v1_controller_proto_rawDescGZIP(), []int{84}
+// Deprecated: Use HeadlessAccount.ProtoReflect.Descriptor instead.
+func (*HeadlessAccount) Descriptor() ([]byte, []int) {
+	return file_hdlctrl_v1_controller_proto_rawDescGZIP(), []int{132}
 }
 
-func (x *HeadlessHostSettings) GetUniverseId() string {
-	if x != nil && x.UniverseId != nil {
-		return *x.UniverseId
+func (x *HeadlessAccount) GetUserId() string {
+	if x != nil {
+		return x.UserId
 	}
 	return ""
 }
 
-func (x *HeadlessHostSettings) GetTickRate() float32 {
+func (x *HeadlessAccount) GetUserName() string {
 	if x != nil {
-		return x.TickRate
+		return x.UserName
 	}
-	return 0
+	return ""
 }
 
-func (x *HeadlessHostSettings) GetMaxConcurrentAssetTransfers() int32 {
+func (x *HeadlessAccount) GetIconUrl() string {
 	if x != nil {
-		return x.MaxConcurrentAssetTransfers
-	}
-	return 0
-}
-
-func (x *HeadlessHostSettings) GetUsernameOverride() string {
-	if x != nil && x.UsernameOverride != nil {
-		return *x.UsernameOverride
+		return x.IconUrl
 	}
 	return ""
 }
 
-func (x *HeadlessHostSettings) GetAllowedUrlHosts() []*v1.AllowedAccessEntry {
+func (x *HeadlessAccount) GetGroupId() string {
 	if x != nil {
-		return x.AllowedUrlHosts
+		return x.GroupId
 	}
-	return nil
+	return ""
 }
 
-func (x *HeadlessHostSettings) GetAutoSpawnItems() []string {
-	if x != nil {
-		return x.AutoSpawnItems
+func (x *HeadlessAccount) GetCreatedBy() string {
+	if x != nil && x.CreatedBy != nil {
+		return *x.CreatedBy
 	}
-	return nil
+	return ""
 }
 
-type HeadlessHost struct {
-	state            protoimpl.MessageState       `protogen:"open.v1"`
-	Id               string                       `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Name             string                       `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	ResoniteVersion  string                       `protobuf:"bytes,4,opt,name=resonite_version,json=resoniteVersion,proto3" json:"resonite_version,omitempty"`
-	AppVersion       string                       `protobuf:"bytes,11,opt,name=app_version,json=appVersion,proto3" json:"app_version,omitempty"`
-	AccountId        string                       `protobuf:"bytes,5,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
-	AccountName      string                       `protobuf:"bytes,6,opt,name=account_name,json=accountName,proto3" json:"account_name,omitempty"`
-	Fps              float32                      `protobuf:"fixed32,7,opt,name=fps,proto3" json:"fps,omitempty"`
-	Status           HeadlessHostStatus           `protobuf:"varint,10,opt,name=status,proto3,enum=hdlctrl.v1.HeadlessHostStatus" json:"status,omitempty"`
-	AutoUpdatePolicy HeadlessHostAutoUpdatePolicy `protobuf:"varint,12,opt,name=auto_update_policy,json=autoUpdatePolicy,proto3,enum=hdlctrl.v1.HeadlessHostAutoUpdatePolicy" json:"auto_update_policy,omitempty"`
-	Memo             string                       `protobuf:"bytes,13,opt,name=memo,proto3" json:"memo,omitempty"`
-	HostSettings     *HeadlessHostSettings        `protobuf:"bytes,14,opt,name=host_settings,json=hostSettings,proto3" json:"host_settings,omitempty"`
-	InstanceId       int32                        `protobuf:"varint,15,opt,name=instance_id,json=instanceId,proto3" json:"instance_id,omitempty"`
-	// 所属グループ ID. 権限判定の基準となる.
-	GroupId string `protobuf:"bytes,16,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
-	// 作成者 user_id. 権限とは独立した記録用途.
-	// ユーザー削除等で参照先が消えうるため nullable.
-	CreatedBy     *string `protobuf:"bytes,17,opt,name=created_by,json=createdBy,proto3,oneof" json:"created_by,omitempty"`
+type UserInfo struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	IconUrl       string                 `protobuf:"bytes,3,opt,name=icon_url,json=iconUrl,proto3" json:"icon_url,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *HeadlessHost) Reset() {
-	*x = HeadlessHost{}
-	mi := &file_hdlctrl_v1_controller_proto_msgTypes[85]
+func (x *UserInfo) Reset() {
+	*x = UserInfo{}
+	mi := &file_hdlctrl_v1_controller_proto_msgTypes[133]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *HeadlessHost) String() string {
+func (x *UserInfo) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*HeadlessHost) ProtoMessage() {}
+func (*UserInfo) ProtoMessage() {}
 
-func (x *HeadlessHost) ProtoReflect() protoreflect.Message {
-	mi := &file_hdlctrl_v1_controller_proto_msgTypes[85]
+func (x *UserInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_hdlctrl_v1_controller_proto_msgTypes[133]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4696,150 +8722,220 @@ func (x *HeadlessHost) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use HeadlessHost.ProtoReflect.Descriptor instead.
-func (*HeadlessHost) Descriptor() ([]byte, []int) {
-	return file_hdlctrl_v1_controller_proto_rawDescGZIP(), []int{85}
+// Deprecated: Use UserInfo.ProtoReflect.Descriptor instead.
+func (*UserInfo) Descriptor() ([]byte, []int) {
+	return file_hdlctrl_v1_controller_proto_rawDescGZIP(), []int{133}
 }
 
-func (x *HeadlessHost) GetId() string {
+func (x *UserInfo) GetId() string {
 	if x != nil {
 		return x.Id
 	}
 	return ""
 }
 
-func (x *HeadlessHost) GetName() string {
+func (x *UserInfo) GetName() string {
 	if x != nil {
 		return x.Name
 	}
 	return ""
 }
 
-func (x *HeadlessHost) GetResoniteVersion() string {
+func (x *UserInfo) GetIconUrl() string {
 	if x != nil {
-		return x.ResoniteVersion
+		return x.IconUrl
 	}
 	return ""
 }
 
-func (x *HeadlessHost) GetAppVersion() string {
+type GetResoniteUserRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ResoniteId    string                 `protobuf:"bytes,1,opt,name=resonite_id,json=resoniteId,proto3" json:"resonite_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetResoniteUserRequest) Reset() {
+	*x = GetResoniteUserRequest{}
+	mi := &file_hdlctrl_v1_controller_proto_msgTypes[134]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetResoniteUserRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetResoniteUserRequest) ProtoMessage() {}
+
+func (x *GetResoniteUserRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_hdlctrl_v1_controller_proto_msgTypes[134]
 	if x != nil {
-		return x.AppVersion
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *HeadlessHost) GetAccountId() string {
+// Deprecated: Use GetResoniteUserRequest.ProtoReflect.Descriptor instead.
+func (*GetResoniteUserRequest) Descriptor() ([]byte, []int) {
+	return file_hdlctrl_v1_controller_proto_rawDescGZIP(), []int{134}
+}
+
+func (x *GetResoniteUserRequest) GetResoniteId() string {
 	if x != nil {
-		return x.AccountId
+		return x.ResoniteId
 	}
 	return ""
 }
 
-func (x *HeadlessHost) GetAccountName() string {
+type GetResoniteUserResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	IconUrl       string                 `protobuf:"bytes,3,opt,name=icon_url,json=iconUrl,proto3" json:"icon_url,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetResoniteUserResponse) Reset() {
+	*x = GetResoniteUserResponse{}
+	mi := &file_hdlctrl_v1_controller_proto_msgTypes[135]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetResoniteUserResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetResoniteUserResponse) ProtoMessage() {}
+
+func (x *GetResoniteUserResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_hdlctrl_v1_controller_proto_msgTypes[135]
 	if x != nil {
-		return x.AccountName
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetResoniteUserResponse.ProtoReflect.Descriptor instead.
+func (*GetResoniteUserResponse) Descriptor() ([]byte, []int) {
+	return file_hdlctrl_v1_controller_proto_rawDescGZIP(), []int{135}
+}
+
+func (x *GetResoniteUserResponse) GetId() string {
+	if x != nil {
+		return x.Id
 	}
 	return ""
 }
 
-func (x *HeadlessHost) GetFps() float32 {
+func (x *GetResoniteUserResponse) GetName() string {
 	if x != nil {
-		return x.Fps
+		return x.Name
 	}
-	return 0
+	return ""
 }
 
-func (x *HeadlessHost) GetStatus() HeadlessHostStatus {
+func (x *GetResoniteUserResponse) GetIconUrl() string {
 	if x != nil {
-		return x.Status
+		return x.IconUrl
 	}
-	return HeadlessHostStatus_HEADLESS_HOST_STATUS_UNKNOWN
+	return ""
 }
 
-func (x *HeadlessHost) GetAutoUpdatePolicy() HeadlessHostAutoUpdatePolicy {
+// コンタクト・チャット系メッセージ
+type ListContactsRequest struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	HeadlessAccountId string                 `protobuf:"bytes,1,opt,name=headless_account_id,json=headlessAccountId,proto3" json:"headless_account_id,omitempty"`
+	Limit             int32                  `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	Cursor            *string                `protobuf:"bytes,3,opt,name=cursor,proto3,oneof" json:"cursor,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *ListContactsRequest) Reset() {
+	*x = ListContactsRequest{}
+	mi := &file_hdlctrl_v1_controller_proto_msgTypes[136]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListContactsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListContactsRequest) ProtoMessage() {}
+
+func (x *ListContactsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_hdlctrl_v1_controller_proto_msgTypes[136]
 	if x != nil {
-		return x.AutoUpdatePolicy
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return HeadlessHostAutoUpdatePolicy_HEADLESS_HOST_AUTO_UPDATE_POLICY_UNKNOWN
+	return mi.MessageOf(x)
 }
 
-func (x *HeadlessHost) GetMemo() string {
-	if x != nil {
-		return x.Memo
-	}
-	return ""
+// Deprecated: Use ListContactsRequest.ProtoReflect.Descriptor instead.
+func (*ListContactsRequest) Descriptor() ([]byte, []int) {
+	return file_hdlctrl_v1_controller_proto_rawDescGZIP(), []int{136}
 }
 
-func (x *HeadlessHost) GetHostSettings() *HeadlessHostSettings {
+func (x *ListContactsRequest) GetHeadlessAccountId() string {
 	if x != nil {
-		return x.HostSettings
+		return x.HeadlessAccountId
 	}
-	return nil
+	return ""
 }
 
-func (x *HeadlessHost) GetInstanceId() int32 {
+func (x *ListContactsRequest) GetLimit() int32 {
 	if x != nil {
-		return x.InstanceId
+		return x.Limit
 	}
 	return 0
 }
 
-func (x *HeadlessHost) GetGroupId() string {
-	if x != nil {
-		return x.GroupId
-	}
-	return ""
-}
-
-func (x *HeadlessHost) GetCreatedBy() string {
-	if x != nil && x.CreatedBy != nil {
-		return *x.CreatedBy
+func (x *ListContactsRequest) GetCursor() string {
+	if x != nil && x.Cursor != nil {
+		return *x.Cursor
 	}
 	return ""
 }
 
-type Session struct {
-	state             protoimpl.MessageState     `protogen:"open.v1"`
-	Id                string                     `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Name              string                     `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	HostId            string                     `protobuf:"bytes,3,opt,name=host_id,json=hostId,proto3" json:"host_id,omitempty"`
-	Status            SessionStatus              `protobuf:"varint,4,opt,name=status,proto3,enum=hdlctrl.v1.SessionStatus" json:"status,omitempty"`
-	StartedAt         *timestamppb.Timestamp     `protobuf:"bytes,5,opt,name=started_at,json=startedAt,proto3" json:"started_at,omitempty"`
-	EndedAt           *timestamppb.Timestamp     `protobuf:"bytes,6,opt,name=ended_at,json=endedAt,proto3,oneof" json:"ended_at,omitempty"`
-	StartupParameters *v1.WorldStartupParameters `protobuf:"bytes,7,opt,name=startup_parameters,json=startupParameters,proto3" json:"startup_parameters,omitempty"`
-	CurrentState      *v1.Session                `protobuf:"bytes,8,opt,name=current_state,json=currentState,proto3,oneof" json:"current_state,omitempty"`
-	// 旧: 作成者 user_id. 権限システム導入に伴い created_by に統合.
-	// 移行期間中は created_by と同値を返す.
-	//
-	// Deprecated: Marked as deprecated in hdlctrl/v1/controller.proto.
-	OwnerId     *string `protobuf:"bytes,9,opt,name=owner_id,json=ownerId,proto3,oneof" json:"owner_id,omitempty"`
-	AutoUpgrade bool    `protobuf:"varint,10,opt,name=auto_upgrade,json=autoUpgrade,proto3" json:"auto_upgrade,omitempty"`
-	Memo        string  `protobuf:"bytes,11,opt,name=memo,proto3" json:"memo,omitempty"`
-	// 所属グループ ID. 権限判定の基準となる.
-	// host.group_id == account.group_id == session.group_id (同一グループ制約).
-	GroupId string `protobuf:"bytes,12,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
-	// 作成者 user_id. 権限とは独立した記録用途.
-	CreatedBy     *string `protobuf:"bytes,13,opt,name=created_by,json=createdBy,proto3,oneof" json:"created_by,omitempty"`
+type ListContactsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Contacts      []*UserInfo            `protobuf:"bytes,1,rep,name=contacts,proto3" json:"contacts,omitempty"`
+	NextCursor    *string                `protobuf:"bytes,2,opt,name=next_cursor,json=nextCursor,proto3,oneof" json:"next_cursor,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Session) Reset() {
-	*x = Session{}
-	mi := &file_hdlctrl_v1_controller_proto_msgTypes[86]
+func (x *ListContactsResponse) Reset() {
+	*x = ListContactsResponse{}
+	mi := &file_hdlctrl_v1_controller_proto_msgTypes[137]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Session) String() string {
+func (x *ListContactsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Session) ProtoMessage() {}
+func (*ListContactsResponse) ProtoMessage() {}
 
-func (x *Session) ProtoReflect() protoreflect.Message {
-	mi := &file_hdlctrl_v1_controller_proto_msgTypes[86]
+func (x *ListContactsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_hdlctrl_v1_controller_proto_msgTypes[137]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4850,132 +8946,188 @@ func (x *Session) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Session.ProtoReflect.Descriptor instead.
-func (*Session) Descriptor() ([]byte, []int) {
-	return file_hdlctrl_v1_controller_proto_rawDescGZIP(), []int{86}
+// Deprecated: Use ListContactsResponse.ProtoReflect.Descriptor instead.
+func (*ListContactsResponse) Descriptor() ([]byte, []int) {
+	return file_hdlctrl_v1_controller_proto_rawDescGZIP(), []int{137}
 }
 
-func (x *Session) GetId() string {
+func (x *ListContactsResponse) GetContacts() []*UserInfo {
 	if x != nil {
-		return x.Id
+		return x.Contacts
 	}
-	return ""
+	return nil
 }
 
-func (x *Session) GetName() string {
-	if x != nil {
-		return x.Name
+func (x *ListContactsResponse) GetNextCursor() string {
+	if x != nil && x.NextCursor != nil {
+		return *x.NextCursor
 	}
 	return ""
 }
 
-func (x *Session) GetHostId() string {
-	if x != nil {
-		return x.HostId
-	}
-	return ""
+type GetContactMessagesRequest struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	HeadlessAccountId string                 `protobuf:"bytes,1,opt,name=headless_account_id,json=headlessAccountId,proto3" json:"headless_account_id,omitempty"`
+	ContactUserId     string                 `protobuf:"bytes,2,opt,name=contact_user_id,json=contactUserId,proto3" json:"contact_user_id,omitempty"`
+	Limit             int32                  `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+	BeforeId          *string                `protobuf:"bytes,4,opt,name=before_id,json=beforeId,proto3,oneof" json:"before_id,omitempty"`
+	AfterId           *string                `protobuf:"bytes,5,opt,name=after_id,json=afterId,proto3,oneof" json:"after_id,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
 }
 
-func (x *Session) GetStatus() SessionStatus {
+func (x *GetContactMessagesRequest) Reset() {
+	*x = GetContactMessagesRequest{}
+	mi := &file_hdlctrl_v1_controller_proto_msgTypes[138]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetContactMessagesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetContactMessagesRequest) ProtoMessage() {}
+
+func (x *GetContactMessagesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_hdlctrl_v1_controller_proto_msgTypes[138]
 	if x != nil {
-		return x.Status
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return SessionStatus_SESSION_STATUS_UNKNOWN
+	return mi.MessageOf(x)
 }
 
-func (x *Session) GetStartedAt() *timestamppb.Timestamp {
+// Deprecated: Use GetContactMessagesRequest.ProtoReflect.Descriptor instead.
+func (*GetContactMessagesRequest) Descriptor() ([]byte, []int) {
+	return file_hdlctrl_v1_controller_proto_rawDescGZIP(), []int{138}
+}
+
+func (x *GetContactMessagesRequest) GetHeadlessAccountId() string {
 	if x != nil {
-		return x.StartedAt
+		return x.HeadlessAccountId
 	}
-	return nil
+	return ""
 }
 
-func (x *Session) GetEndedAt() *timestamppb.Timestamp {
+func (x *GetContactMessagesRequest) GetContactUserId() string {
 	if x != nil {
-		return x.EndedAt
+		return x.ContactUserId
 	}
-	return nil
+	return ""
 }
 
-func (x *Session) GetStartupParameters() *v1.WorldStartupParameters {
+func (x *GetContactMessagesRequest) GetLimit() int32 {
 	if x != nil {
-		return x.StartupParameters
+		return x.Limit
 	}
-	return nil
+	return 0
 }
 
-func (x *Session) GetCurrentState() *v1.Session {
-	if x != nil {
-		return x.CurrentState
+func (x *GetContactMessagesRequest) GetBeforeId() string {
+	if x != nil && x.BeforeId != nil {
+		return *x.BeforeId
 	}
-	return nil
+	return ""
 }
 
-// Deprecated: Marked as deprecated in hdlctrl/v1/controller.proto.
-func (x *Session) GetOwnerId() string {
-	if x != nil && x.OwnerId != nil {
-		return *x.OwnerId
+func (x *GetContactMessagesRequest) GetAfterId() string {
+	if x != nil && x.AfterId != nil {
+		return *x.AfterId
 	}
 	return ""
 }
 
-func (x *Session) GetAutoUpgrade() bool {
+type GetContactMessagesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Messages      []*ContactMessage      `protobuf:"bytes,1,rep,name=messages,proto3" json:"messages,omitempty"`
+	HasMoreBefore bool                   `protobuf:"varint,2,opt,name=has_more_before,json=hasMoreBefore,proto3" json:"has_more_before,omitempty"`
+	HasMoreAfter  bool                   `protobuf:"varint,3,opt,name=has_more_after,json=hasMoreAfter,proto3" json:"has_more_after,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetContactMessagesResponse) Reset() {
+	*x = GetContactMessagesResponse{}
+	mi := &file_hdlctrl_v1_controller_proto_msgTypes[139]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetContactMessagesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetContactMessagesResponse) ProtoMessage() {}
+
+func (x *GetContactMessagesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_hdlctrl_v1_controller_proto_msgTypes[139]
 	if x != nil {
-		return x.AutoUpgrade
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return false
+	return mi.MessageOf(x)
 }
 
-func (x *Session) GetMemo() string {
+// Deprecated: Use GetContactMessagesResponse.ProtoReflect.Descriptor instead.
+func (*GetContactMessagesResponse) Descriptor() ([]byte, []int) {
+	return file_hdlctrl_v1_controller_proto_rawDescGZIP(), []int{139}
+}
+
+func (x *GetContactMessagesResponse) GetMessages() []*ContactMessage {
 	if x != nil {
-		return x.Memo
+		return x.Messages
 	}
-	return ""
+	return nil
 }
 
-func (x *Session) GetGroupId() string {
+func (x *GetContactMessagesResponse) GetHasMoreBefore() bool {
 	if x != nil {
-		return x.GroupId
+		return x.HasMoreBefore
 	}
-	return ""
+	return false
 }
 
-func (x *Session) GetCreatedBy() string {
-	if x != nil && x.CreatedBy != nil {
-		return *x.CreatedBy
+func (x *GetContactMessagesResponse) GetHasMoreAfter() bool {
+	if x != nil {
+		return x.HasMoreAfter
 	}
-	return ""
+	return false
 }
 
-type HeadlessAccount struct {
-	state    protoimpl.MessageState `protogen:"open.v1"`
-	UserId   string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	UserName string                 `protobuf:"bytes,2,opt,name=user_name,json=userName,proto3" json:"user_name,omitempty"`
-	IconUrl  string                 `protobuf:"bytes,3,opt,name=icon_url,json=iconUrl,proto3" json:"icon_url,omitempty"`
-	// 所属グループ ID. 権限判定の基準となる.
-	GroupId string `protobuf:"bytes,4,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
-	// 作成者 user_id. 権限とは独立した記録用途.
-	// 既存データは nullable, 新規作成時は呼び出しユーザーが入る.
-	CreatedBy     *string `protobuf:"bytes,5,opt,name=created_by,json=createdBy,proto3,oneof" json:"created_by,omitempty"`
+type ContactMessage struct {
+	state         protoimpl.MessageState    `protogen:"open.v1"`
+	Id            string                    `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Type          v1.ContactChatMessageType `protobuf:"varint,2,opt,name=type,proto3,enum=headless.v1.ContactChatMessageType" json:"type,omitempty"`
+	Content       string                    `protobuf:"bytes,3,opt,name=content,proto3" json:"content,omitempty"`
+	SendTime      *timestamppb.Timestamp    `protobuf:"bytes,4,opt,name=send_time,json=sendTime,proto3" json:"send_time,omitempty"`
+	ReadTime      *timestamppb.Timestamp    `protobuf:"bytes,5,opt,name=read_time,json=readTime,proto3,oneof" json:"read_time,omitempty"`
+	IsOwnMessage  bool                      `protobuf:"varint,6,opt,name=is_own_message,json=isOwnMessage,proto3" json:"is_own_message,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *HeadlessAccount) Reset() {
-	*x = HeadlessAccount{}
-	mi := &file_hdlctrl_v1_controller_proto_msgTypes[87]
+func (x *ContactMessage) Reset() {
+	*x = ContactMessage{}
+	mi := &file_hdlctrl_v1_controller_proto_msgTypes[140]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *HeadlessAccount) String() string {
+func (x *ContactMessage) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*HeadlessAccount) ProtoMessage() {}
+func (*ContactMessage) ProtoMessage() {}
 
-func (x *HeadlessAccount) ProtoReflect() protoreflect.Message {
-	mi := &file_hdlctrl_v1_controller_proto_msgTypes[87]
+func (x *ContactMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_hdlctrl_v1_controller_proto_msgTypes[140]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4986,70 +9138,77 @@ func (x *HeadlessAccount) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use HeadlessAccount.ProtoReflect.Descriptor instead.
-func (*HeadlessAccount) Descriptor() ([]byte, []int) {
-	return file_hdlctrl_v1_controller_proto_rawDescGZIP(), []int{87}
+// Deprecated: Use ContactMessage.ProtoReflect.Descriptor instead.
+func (*ContactMessage) Descriptor() ([]byte, []int) {
+	return file_hdlctrl_v1_controller_proto_rawDescGZIP(), []int{140}
+}
+
+func (x *ContactMessage) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
 }
 
-func (x *HeadlessAccount) GetUserId() string {
+func (x *ContactMessage) GetType() v1.ContactChatMessageType {
 	if x != nil {
-		return x.UserId
+		return x.Type
 	}
-	return ""
+	return v1.ContactChatMessageType(0)
 }
 
-func (x *HeadlessAccount) GetUserName() string {
+func (x *ContactMessage) GetContent() string {
 	if x != nil {
-		return x.UserName
+		return x.Content
 	}
 	return ""
 }
 
-func (x *HeadlessAccount) GetIconUrl() string {
+func (x *ContactMessage) GetSendTime() *timestamppb.Timestamp {
 	if x != nil {
-		return x.IconUrl
+		return x.SendTime
 	}
-	return ""
+	return nil
 }
 
-func (x *HeadlessAccount) GetGroupId() string {
+func (x *ContactMessage) GetReadTime() *timestamppb.Timestamp {
 	if x != nil {
-		return x.GroupId
+		return x.ReadTime
 	}
-	return ""
+	return nil
 }
 
-func (x *HeadlessAccount) GetCreatedBy() string {
-	if x != nil && x.CreatedBy != nil {
-		return *x.CreatedBy
+func (x *ContactMessage) GetIsOwnMessage() bool {
+	if x != nil {
+		return x.IsOwnMessage
 	}
-	return ""
+	return false
 }
 
-type UserInfo struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	IconUrl       string                 `protobuf:"bytes,3,opt,name=icon_url,json=iconUrl,proto3" json:"icon_url,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+type SendContactMessageRequest struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	HeadlessAccountId string                 `protobuf:"bytes,1,opt,name=headless_account_id,json=headlessAccountId,proto3" json:"headless_account_id,omitempty"`
+	ContactUserId     string                 `protobuf:"bytes,2,opt,name=contact_user_id,json=contactUserId,proto3" json:"contact_user_id,omitempty"`
+	Message           string                 `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
 }
 
-func (x *UserInfo) Reset() {
-	*x = UserInfo{}
-	mi := &file_hdlctrl_v1_controller_proto_msgTypes[88]
+func (x *SendContactMessageRequest) Reset() {
+	*x = SendContactMessageRequest{}
+	mi := &file_hdlctrl_v1_controller_proto_msgTypes[141]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UserInfo) String() string {
+func (x *SendContactMessageRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UserInfo) ProtoMessage() {}
+func (*SendContactMessageRequest) ProtoMessage() {}
 
-func (x *UserInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_hdlctrl_v1_controller_proto_msgTypes[88]
+func (x *SendContactMessageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_hdlctrl_v1_controller_proto_msgTypes[141]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5060,54 +9219,53 @@ func (x *UserInfo) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UserInfo.ProtoReflect.Descriptor instead.
-func (*UserInfo) Descriptor() ([]byte, []int) {
-	return file_hdlctrl_v1_controller_proto_rawDescGZIP(), []int{88}
+// Deprecated: Use SendContactMessageRequest.ProtoReflect.Descriptor instead.
+func (*SendContactMessageRequest) Descriptor() ([]byte, []int) {
+	return file_hdlctrl_v1_controller_proto_rawDescGZIP(), []int{141}
 }
 
-func (x *UserInfo) GetId() string {
+func (x *SendContactMessageRequest) GetHeadlessAccountId() string {
 	if x != nil {
-		return x.Id
+		return x.HeadlessAccountId
 	}
 	return ""
 }
 
-func (x *UserInfo) GetName() string {
+func (x *SendContactMessageRequest) GetContactUserId() string {
 	if x != nil {
-		return x.Name
+		return x.ContactUserId
 	}
 	return ""
 }
 
-func (x *UserInfo) GetIconUrl() string {
+func (x *SendContactMessageRequest) GetMessage() string {
 	if x != nil {
-		return x.IconUrl
+		return x.Message
 	}
 	return ""
 }
 
-type GetResoniteUserRequest struct {
+type SendContactMessageResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	ResoniteId    string                 `protobuf:"bytes,1,opt,name=resonite_id,json=resoniteId,proto3" json:"resonite_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetResoniteUserRequest) Reset() {
-	*x = GetResoniteUserRequest{}
-	mi := &file_hdlctrl_v1_controller_proto_msgTypes[89]
+func (x *SendContactMessageResponse) Reset() {
+	*x = SendContactMessageResponse{}
+	mi := &file_hdlctrl_v1_controller_proto_msgTypes[142]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetResoniteUserRequest) String() string {
+func (x *SendContactMessageResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetResoniteUserRequest) ProtoMessage() {}
+func (*SendContactMessageResponse) ProtoMessage() {}
 
-func (x *GetResoniteUserRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_hdlctrl_v1_controller_proto_msgTypes[89]
+func (x *SendContactMessageResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_hdlctrl_v1_controller_proto_msgTypes[142]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5118,42 +9276,40 @@ func (x *GetResoniteUserRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetResoniteUserRequest.ProtoReflect.Descriptor instead.
-func (*GetResoniteUserRequest) Descriptor() ([]byte, []int) {
-	return file_hdlctrl_v1_controller_proto_rawDescGZIP(), []int{89}
-}
-
-func (x *GetResoniteUserRequest) GetResoniteId() string {
-	if x != nil {
-		return x.ResoniteId
-	}
-	return ""
+// Deprecated: Use SendContactMessageResponse.ProtoReflect.Descriptor instead.
+func (*SendContactMessageResponse) Descriptor() ([]byte, []int) {
+	return file_hdlctrl_v1_controller_proto_rawDescGZIP(), []int{142}
 }
 
-type GetResoniteUserResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	IconUrl       string                 `protobuf:"bytes,3,opt,name=icon_url,json=iconUrl,proto3" json:"icon_url,omitempty"`
+// 予約する操作.
+type ScheduledOperation struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Types that are valid to be assigned to Operation:
+	//
+	//	*ScheduledOperation_StartSession
+	//	*ScheduledOperation_StopSession
+	//	*ScheduledOperation_UpdateParameters
+	//	*ScheduledOperation_UpdateExtraSettings
+	Operation     isScheduledOperation_Operation `protobuf_oneof:"operation"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetResoniteUserResponse) Reset() {
-	*x = GetResoniteUserResponse{}
-	mi := &file_hdlctrl_v1_controller_proto_msgTypes[90]
+func (x *ScheduledOperation) Reset() {
+	*x = ScheduledOperation{}
+	mi := &file_hdlctrl_v1_controller_proto_msgTypes[143]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetResoniteUserResponse) String() string {
+func (x *ScheduledOperation) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetResoniteUserResponse) ProtoMessage() {}
+func (*ScheduledOperation) ProtoMessage() {}
 
-func (x *GetResoniteUserResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_hdlctrl_v1_controller_proto_msgTypes[90]
+func (x *ScheduledOperation) ProtoReflect() protoreflect.Message {
+	mi := &file_hdlctrl_v1_controller_proto_msgTypes[143]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5164,116 +9320,203 @@ func (x *GetResoniteUserResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetResoniteUserResponse.ProtoReflect.Descriptor instead.
-func (*GetResoniteUserResponse) Descriptor() ([]byte, []int) {
-	return file_hdlctrl_v1_controller_proto_rawDescGZIP(), []int{90}
+// Deprecated: Use ScheduledOperation.ProtoReflect.Descriptor instead.
+func (*ScheduledOperation) Descriptor() ([]byte, []int) {
+	return file_hdlctrl_v1_controller_proto_rawDescGZIP(), []int{143}
 }
 
-func (x *GetResoniteUserResponse) GetId() string {
+func (x *ScheduledOperation) GetOperation() isScheduledOperation_Operation {
 	if x != nil {
-		return x.Id
+		return x.Operation
 	}
-	return ""
+	return nil
 }
 
-func (x *GetResoniteUserResponse) GetName() string {
+func (x *ScheduledOperation) GetStartSession() *StartWorldRequest {
 	if x != nil {
-		return x.Name
+		if x, ok := x.Operation.(*ScheduledOperation_StartSession); ok {
+			return x.StartSession
+		}
 	}
-	return ""
+	return nil
 }
 
-func (x *GetResoniteUserResponse) GetIconUrl() string {
+func (x *ScheduledOperation) GetStopSession() *StopSessionRequest {
 	if x != nil {
-		return x.IconUrl
+		if x, ok := x.Operation.(*ScheduledOperation_StopSession); ok {
+			return x.StopSession
+		}
 	}
-	return ""
+	return nil
 }
 
-// コンタクト・チャット系メッセージ
-type ListContactsRequest struct {
-	state             protoimpl.MessageState `protogen:"open.v1"`
-	HeadlessAccountId string                 `protobuf:"bytes,1,opt,name=headless_account_id,json=headlessAccountId,proto3" json:"headless_account_id,omitempty"`
-	Limit             int32                  `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
-	Cursor            *string                `protobuf:"bytes,3,opt,name=cursor,proto3,oneof" json:"cursor,omitempty"`
-	unknownFields     protoimpl.UnknownFields
-	sizeCache         protoimpl.SizeCache
+func (x *ScheduledOperation) GetUpdateParameters() *UpdateSessionParametersRequest {
+	if x != nil {
+		if x, ok := x.Operation.(*ScheduledOperation_UpdateParameters); ok {
+			return x.UpdateParameters
+		}
+	}
+	return nil
 }
 
-func (x *ListContactsRequest) Reset() {
-	*x = ListContactsRequest{}
-	mi := &file_hdlctrl_v1_controller_proto_msgTypes[91]
+func (x *ScheduledOperation) GetUpdateExtraSettings() *UpdateSessionExtraSettingsRequest {
+	if x != nil {
+		if x, ok := x.Operation.(*ScheduledOperation_UpdateExtraSettings); ok {
+			return x.UpdateExtraSettings
+		}
+	}
+	return nil
+}
+
+type isScheduledOperation_Operation interface {
+	isScheduledOperation_Operation()
+}
+
+type ScheduledOperation_StartSession struct {
+	StartSession *StartWorldRequest `protobuf:"bytes,1,opt,name=start_session,json=startSession,proto3,oneof"`
+}
+
+type ScheduledOperation_StopSession struct {
+	StopSession *StopSessionRequest `protobuf:"bytes,2,opt,name=stop_session,json=stopSession,proto3,oneof"`
+}
+
+type ScheduledOperation_UpdateParameters struct {
+	UpdateParameters *UpdateSessionParametersRequest `protobuf:"bytes,3,opt,name=update_parameters,json=updateParameters,proto3,oneof"`
+}
+
+type ScheduledOperation_UpdateExtraSettings struct {
+	UpdateExtraSettings *UpdateSessionExtraSettingsRequest `protobuf:"bytes,4,opt,name=update_extra_settings,json=updateExtraSettings,proto3,oneof"`
+}
+
+func (*ScheduledOperation_StartSession) isScheduledOperation_Operation() {}
+
+func (*ScheduledOperation_StopSession) isScheduledOperation_Operation() {}
+
+func (*ScheduledOperation_UpdateParameters) isScheduledOperation_Operation() {}
+
+func (*ScheduledOperation_UpdateExtraSettings) isScheduledOperation_Operation() {}
+
+// 発火条件.
+type ScheduledTrigger struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Types that are valid to be assigned to Trigger:
+	//
+	//	*ScheduledTrigger_Time
+	//	*ScheduledTrigger_SessionUserCount
+	//	*ScheduledTrigger_Cron
+	Trigger       isScheduledTrigger_Trigger `protobuf_oneof:"trigger"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ScheduledTrigger) Reset() {
+	*x = ScheduledTrigger{}
+	mi := &file_hdlctrl_v1_controller_proto_msgTypes[144]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListContactsRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *ScheduledTrigger) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScheduledTrigger) ProtoMessage() {}
+
+func (x *ScheduledTrigger) ProtoReflect() protoreflect.Message {
+	mi := &file_hdlctrl_v1_controller_proto_msgTypes[144]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ScheduledTrigger.ProtoReflect.Descriptor instead.
+func (*ScheduledTrigger) Descriptor() ([]byte, []int) {
+	return file_hdlctrl_v1_controller_proto_rawDescGZIP(), []int{144}
+}
+
+func (x *ScheduledTrigger) GetTrigger() isScheduledTrigger_Trigger {
+	if x != nil {
+		return x.Trigger
+	}
+	return nil
+}
+
+func (x *ScheduledTrigger) GetTime() *TimeTrigger {
+	if x != nil {
+		if x, ok := x.Trigger.(*ScheduledTrigger_Time); ok {
+			return x.Time
+		}
+	}
+	return nil
+}
+
+func (x *ScheduledTrigger) GetSessionUserCount() *SessionUserCountTrigger {
+	if x != nil {
+		if x, ok := x.Trigger.(*ScheduledTrigger_SessionUserCount); ok {
+			return x.SessionUserCount
+		}
+	}
+	return nil
 }
 
-func (*ListContactsRequest) ProtoMessage() {}
-
-func (x *ListContactsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_hdlctrl_v1_controller_proto_msgTypes[91]
+func (x *ScheduledTrigger) GetCron() *CronTrigger {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
+		if x, ok := x.Trigger.(*ScheduledTrigger_Cron); ok {
+			return x.Cron
 		}
-		return ms
 	}
-	return mi.MessageOf(x)
+	return nil
 }
 
-// Deprecated: Use ListContactsRequest.ProtoReflect.Descriptor instead.
-func (*ListContactsRequest) Descriptor() ([]byte, []int) {
-	return file_hdlctrl_v1_controller_proto_rawDescGZIP(), []int{91}
+type isScheduledTrigger_Trigger interface {
+	isScheduledTrigger_Trigger()
 }
 
-func (x *ListContactsRequest) GetHeadlessAccountId() string {
-	if x != nil {
-		return x.HeadlessAccountId
-	}
-	return ""
+type ScheduledTrigger_Time struct {
+	Time *TimeTrigger `protobuf:"bytes,1,opt,name=time,proto3,oneof"`
 }
 
-func (x *ListContactsRequest) GetLimit() int32 {
-	if x != nil {
-		return x.Limit
-	}
-	return 0
+type ScheduledTrigger_SessionUserCount struct {
+	SessionUserCount *SessionUserCountTrigger `protobuf:"bytes,2,opt,name=session_user_count,json=sessionUserCount,proto3,oneof"`
 }
 
-func (x *ListContactsRequest) GetCursor() string {
-	if x != nil && x.Cursor != nil {
-		return *x.Cursor
-	}
-	return ""
+type ScheduledTrigger_Cron struct {
+	Cron *CronTrigger `protobuf:"bytes,3,opt,name=cron,proto3,oneof"`
 }
 
-type ListContactsResponse struct {
+func (*ScheduledTrigger_Time) isScheduledTrigger_Trigger() {}
+
+func (*ScheduledTrigger_SessionUserCount) isScheduledTrigger_Trigger() {}
+
+func (*ScheduledTrigger_Cron) isScheduledTrigger_Trigger() {}
+
+type TimeTrigger struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Contacts      []*UserInfo            `protobuf:"bytes,1,rep,name=contacts,proto3" json:"contacts,omitempty"`
-	NextCursor    *string                `protobuf:"bytes,2,opt,name=next_cursor,json=nextCursor,proto3,oneof" json:"next_cursor,omitempty"`
+	ScheduledAt   *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=scheduled_at,json=scheduledAt,proto3" json:"scheduled_at,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListContactsResponse) Reset() {
-	*x = ListContactsResponse{}
-	mi := &file_hdlctrl_v1_controller_proto_msgTypes[92]
+func (x *TimeTrigger) Reset() {
+	*x = TimeTrigger{}
+	mi := &file_hdlctrl_v1_controller_proto_msgTypes[145]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListContactsResponse) String() string {
+func (x *TimeTrigger) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListContactsResponse) ProtoMessage() {}
+func (*TimeTrigger) ProtoMessage() {}
 
-func (x *ListContactsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_hdlctrl_v1_controller_proto_msgTypes[92]
+func (x *TimeTrigger) ProtoReflect() protoreflect.Message {
+	mi := &file_hdlctrl_v1_controller_proto_msgTypes[145]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5284,51 +9527,45 @@ func (x *ListContactsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListContactsResponse.ProtoReflect.Descriptor instead.
-func (*ListContactsResponse) Descriptor() ([]byte, []int) {
-	return file_hdlctrl_v1_controller_proto_rawDescGZIP(), []int{92}
+// Deprecated: Use TimeTrigger.ProtoReflect.Descriptor instead.
+func (*TimeTrigger) Descriptor() ([]byte, []int) {
+	return file_hdlctrl_v1_controller_proto_rawDescGZIP(), []int{145}
 }
 
-func (x *ListContactsResponse) GetContacts() []*UserInfo {
+func (x *TimeTrigger) GetScheduledAt() *timestamppb.Timestamp {
 	if x != nil {
-		return x.Contacts
+		return x.ScheduledAt
 	}
 	return nil
 }
 
-func (x *ListContactsResponse) GetNextCursor() string {
-	if x != nil && x.NextCursor != nil {
-		return *x.NextCursor
-	}
-	return ""
-}
-
-type GetContactMessagesRequest struct {
-	state             protoimpl.MessageState `protogen:"open.v1"`
-	HeadlessAccountId string                 `protobuf:"bytes,1,opt,name=headless_account_id,json=headlessAccountId,proto3" json:"headless_account_id,omitempty"`
-	ContactUserId     string                 `protobuf:"bytes,2,opt,name=contact_user_id,json=contactUserId,proto3" json:"contact_user_id,omitempty"`
-	Limit             int32                  `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
-	BeforeId          *string                `protobuf:"bytes,4,opt,name=before_id,json=beforeId,proto3,oneof" json:"before_id,omitempty"`
-	AfterId           *string                `protobuf:"bytes,5,opt,name=after_id,json=afterId,proto3,oneof" json:"after_id,omitempty"`
-	unknownFields     protoimpl.UnknownFields
-	sizeCache         protoimpl.SizeCache
+// セッションのユーザー数が閾値を満たした際に発火するトリガー.
+// SessionStateCache が保持する current_state.users_count を監視する.
+// session が cache に存在しない (=未起動 / 既終了) 場合は発火しない.
+type SessionUserCountTrigger struct {
+	state         protoimpl.MessageState             `protogen:"open.v1"`
+	SessionId     string                             `protobuf:"bytes,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	Comparator    SessionUserCountTrigger_Comparator `protobuf:"varint,2,opt,name=comparator,proto3,enum=hdlctrl.v1.SessionUserCountTrigger_Comparator" json:"comparator,omitempty"`
+	Threshold     int32                              `protobuf:"varint,3,opt,name=threshold,proto3" json:"threshold,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetContactMessagesRequest) Reset() {
-	*x = GetContactMessagesRequest{}
-	mi := &file_hdlctrl_v1_controller_proto_msgTypes[93]
+func (x *SessionUserCountTrigger) Reset() {
+	*x = SessionUserCountTrigger{}
+	mi := &file_hdlctrl_v1_controller_proto_msgTypes[146]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetContactMessagesRequest) String() string {
+func (x *SessionUserCountTrigger) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetContactMessagesRequest) ProtoMessage() {}
+func (*SessionUserCountTrigger) ProtoMessage() {}
 
-func (x *GetContactMessagesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_hdlctrl_v1_controller_proto_msgTypes[93]
+func (x *SessionUserCountTrigger) ProtoReflect() protoreflect.Message {
+	mi := &file_hdlctrl_v1_controller_proto_msgTypes[146]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5339,70 +9576,57 @@ func (x *GetContactMessagesRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetContactMessagesRequest.ProtoReflect.Descriptor instead.
-func (*GetContactMessagesRequest) Descriptor() ([]byte, []int) {
-	return file_hdlctrl_v1_controller_proto_rawDescGZIP(), []int{93}
+// Deprecated: Use SessionUserCountTrigger.ProtoReflect.Descriptor instead.
+func (*SessionUserCountTrigger) Descriptor() ([]byte, []int) {
+	return file_hdlctrl_v1_controller_proto_rawDescGZIP(), []int{146}
 }
 
-func (x *GetContactMessagesRequest) GetHeadlessAccountId() string {
+func (x *SessionUserCountTrigger) GetSessionId() string {
 	if x != nil {
-		return x.HeadlessAccountId
+		return x.SessionId
 	}
 	return ""
 }
 
-func (x *GetContactMessagesRequest) GetContactUserId() string {
+func (x *SessionUserCountTrigger) GetComparator() SessionUserCountTrigger_Comparator {
 	if x != nil {
-		return x.ContactUserId
+		return x.Comparator
 	}
-	return ""
+	return SessionUserCountTrigger_COMPARATOR_UNSPECIFIED
 }
 
-func (x *GetContactMessagesRequest) GetLimit() int32 {
+func (x *SessionUserCountTrigger) GetThreshold() int32 {
 	if x != nil {
-		return x.Limit
+		return x.Threshold
 	}
 	return 0
 }
 
-func (x *GetContactMessagesRequest) GetBeforeId() string {
-	if x != nil && x.BeforeId != nil {
-		return *x.BeforeId
-	}
-	return ""
-}
-
-func (x *GetContactMessagesRequest) GetAfterId() string {
-	if x != nil && x.AfterId != nil {
-		return *x.AfterId
-	}
-	return ""
-}
-
-type GetContactMessagesResponse struct {
+// cron 式 (5 フィールド) で繰り返し発火するトリガー.
+// 実行後は SUCCEEDED / FAILED にならず、次回の発火時刻で PENDING に戻る.
+type CronTrigger struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Messages      []*ContactMessage      `protobuf:"bytes,1,rep,name=messages,proto3" json:"messages,omitempty"`
-	HasMoreBefore bool                   `protobuf:"varint,2,opt,name=has_more_before,json=hasMoreBefore,proto3" json:"has_more_before,omitempty"`
-	HasMoreAfter  bool                   `protobuf:"varint,3,opt,name=has_more_after,json=hasMoreAfter,proto3" json:"has_more_after,omitempty"`
+	Expression    string                 `protobuf:"bytes,1,opt,name=expression,proto3" json:"expression,omitempty"` // 例: "0 21 * * FRI", "@daily"
+	Timezone      string                 `protobuf:"bytes,2,opt,name=timezone,proto3" json:"timezone,omitempty"`     // IANA のタイムゾーン名 (例: "Asia/Tokyo"). 空なら UTC
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetContactMessagesResponse) Reset() {
-	*x = GetContactMessagesResponse{}
-	mi := &file_hdlctrl_v1_controller_proto_msgTypes[94]
+func (x *CronTrigger) Reset() {
+	*x = CronTrigger{}
+	mi := &file_hdlctrl_v1_controller_proto_msgTypes[147]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetContactMessagesResponse) String() string {
+func (x *CronTrigger) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetContactMessagesResponse) ProtoMessage() {}
+func (*CronTrigger) ProtoMessage() {}
 
-func (x *GetContactMessagesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_hdlctrl_v1_controller_proto_msgTypes[94]
+func (x *CronTrigger) ProtoReflect() protoreflect.Message {
+	mi := &file_hdlctrl_v1_controller_proto_msgTypes[147]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5413,59 +9637,58 @@ func (x *GetContactMessagesResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetContactMessagesResponse.ProtoReflect.Descriptor instead.
-func (*GetContactMessagesResponse) Descriptor() ([]byte, []int) {
-	return file_hdlctrl_v1_controller_proto_rawDescGZIP(), []int{94}
-}
-
-func (x *GetContactMessagesResponse) GetMessages() []*ContactMessage {
-	if x != nil {
-		return x.Messages
-	}
-	return nil
+// Deprecated: Use CronTrigger.ProtoReflect.Descriptor instead.
+func (*CronTrigger) Descriptor() ([]byte, []int) {
+	return file_hdlctrl_v1_controller_proto_rawDescGZIP(), []int{147}
 }
 
-func (x *GetContactMessagesResponse) GetHasMoreBefore() bool {
+func (x *CronTrigger) GetExpression() string {
 	if x != nil {
-		return x.HasMoreBefore
+		return x.Expression
 	}
-	return false
+	return ""
 }
 
-func (x *GetContactMessagesResponse) GetHasMoreAfter() bool {
+func (x *CronTrigger) GetTimezone() string {
 	if x != nil {
-		return x.HasMoreAfter
+		return x.Timezone
 	}
-	return false
+	return ""
 }
 
-type ContactMessage struct {
-	state         protoimpl.MessageState    `protogen:"open.v1"`
-	Id            string                    `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Type          v1.ContactChatMessageType `protobuf:"varint,2,opt,name=type,proto3,enum=headless.v1.ContactChatMessageType" json:"type,omitempty"`
-	Content       string                    `protobuf:"bytes,3,opt,name=content,proto3" json:"content,omitempty"`
-	SendTime      *timestamppb.Timestamp    `protobuf:"bytes,4,opt,name=send_time,json=sendTime,proto3" json:"send_time,omitempty"`
-	ReadTime      *timestamppb.Timestamp    `protobuf:"bytes,5,opt,name=read_time,json=readTime,proto3,oneof" json:"read_time,omitempty"`
-	IsOwnMessage  bool                      `protobuf:"varint,6,opt,name=is_own_message,json=isOwnMessage,proto3" json:"is_own_message,omitempty"`
+type ScheduledSessionOperation struct {
+	state         protoimpl.MessageState   `protogen:"open.v1"`
+	Id            string                   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Operation     *ScheduledOperation      `protobuf:"bytes,2,opt,name=operation,proto3" json:"operation,omitempty"`
+	Trigger       *ScheduledTrigger        `protobuf:"bytes,3,opt,name=trigger,proto3" json:"trigger,omitempty"`
+	NextFireAt    *timestamppb.Timestamp   `protobuf:"bytes,4,opt,name=next_fire_at,json=nextFireAt,proto3" json:"next_fire_at,omitempty"`
+	HostId        *string                  `protobuf:"bytes,5,opt,name=host_id,json=hostId,proto3,oneof" json:"host_id,omitempty"`
+	SessionId     *string                  `protobuf:"bytes,6,opt,name=session_id,json=sessionId,proto3,oneof" json:"session_id,omitempty"`
+	Status        ScheduledOperationStatus `protobuf:"varint,7,opt,name=status,proto3,enum=hdlctrl.v1.ScheduledOperationStatus" json:"status,omitempty"`
+	LastError     *string                  `protobuf:"bytes,8,opt,name=last_error,json=lastError,proto3,oneof" json:"last_error,omitempty"`
+	ExecutedAt    *timestamppb.Timestamp   `protobuf:"bytes,9,opt,name=executed_at,json=executedAt,proto3,oneof" json:"executed_at,omitempty"`
+	CreatedBy     *string                  `protobuf:"bytes,10,opt,name=created_by,json=createdBy,proto3,oneof" json:"created_by,omitempty"`
+	CreatedAt     *timestamppb.Timestamp   `protobuf:"bytes,11,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt     *timestamppb.Timestamp   `protobuf:"bytes,12,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ContactMessage) Reset() {
-	*x = ContactMessage{}
-	mi := &file_hdlctrl_v1_controller_proto_msgTypes[95]
+func (x *ScheduledSessionOperation) Reset() {
+	*x = ScheduledSessionOperation{}
+	mi := &file_hdlctrl_v1_controller_proto_msgTypes[148]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ContactMessage) String() string {
+func (x *ScheduledSessionOperation) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ContactMessage) ProtoMessage() {}
+func (*ScheduledSessionOperation) ProtoMessage() {}
 
-func (x *ContactMessage) ProtoReflect() protoreflect.Message {
-	mi := &file_hdlctrl_v1_controller_proto_msgTypes[95]
+func (x *ScheduledSessionOperation) ProtoReflect() protoreflect.Message {
+	mi := &file_hdlctrl_v1_controller_proto_msgTypes[148]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5476,77 +9699,118 @@ func (x *ContactMessage) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ContactMessage.ProtoReflect.Descriptor instead.
-func (*ContactMessage) Descriptor() ([]byte, []int) {
-	return file_hdlctrl_v1_controller_proto_rawDescGZIP(), []int{95}
+// Deprecated: Use ScheduledSessionOperation.ProtoReflect.Descriptor instead.
+func (*ScheduledSessionOperation) Descriptor() ([]byte, []int) {
+	return file_hdlctrl_v1_controller_proto_rawDescGZIP(), []int{148}
 }
 
-func (x *ContactMessage) GetId() string {
+func (x *ScheduledSessionOperation) GetId() string {
 	if x != nil {
 		return x.Id
 	}
 	return ""
 }
 
-func (x *ContactMessage) GetType() v1.ContactChatMessageType {
+func (x *ScheduledSessionOperation) GetOperation() *ScheduledOperation {
+	if x != nil {
+		return x.Operation
+	}
+	return nil
+}
+
+func (x *ScheduledSessionOperation) GetTrigger() *ScheduledTrigger {
+	if x != nil {
+		return x.Trigger
+	}
+	return nil
+}
+
+func (x *ScheduledSessionOperation) GetNextFireAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.NextFireAt
+	}
+	return nil
+}
+
+func (x *ScheduledSessionOperation) GetHostId() string {
+	if x != nil && x.HostId != nil {
+		return *x.HostId
+	}
+	return ""
+}
+
+func (x *ScheduledSessionOperation) GetSessionId() string {
+	if x != nil && x.SessionId != nil {
+		return *x.SessionId
+	}
+	return ""
+}
+
+func (x *ScheduledSessionOperation) GetStatus() ScheduledOperationStatus {
 	if x != nil {
-		return x.Type
+		return x.Status
 	}
-	return v1.ContactChatMessageType(0)
+	return ScheduledOperationStatus_SCHEDULED_OPERATION_STATUS_UNSPECIFIED
 }
 
-func (x *ContactMessage) GetContent() string {
-	if x != nil {
-		return x.Content
+func (x *ScheduledSessionOperation) GetLastError() string {
+	if x != nil && x.LastError != nil {
+		return *x.LastError
 	}
 	return ""
 }
 
-func (x *ContactMessage) GetSendTime() *timestamppb.Timestamp {
+func (x *ScheduledSessionOperation) GetExecutedAt() *timestamppb.Timestamp {
 	if x != nil {
-		return x.SendTime
+		return x.ExecutedAt
 	}
 	return nil
 }
 
-func (x *ContactMessage) GetReadTime() *timestamppb.Timestamp {
+func (x *ScheduledSessionOperation) GetCreatedBy() string {
+	if x != nil && x.CreatedBy != nil {
+		return *x.CreatedBy
+	}
+	return ""
+}
+
+func (x *ScheduledSessionOperation) GetCreatedAt() *timestamppb.Timestamp {
 	if x != nil {
-		return x.ReadTime
+		return x.CreatedAt
 	}
 	return nil
 }
 
-func (x *ContactMessage) GetIsOwnMessage() bool {
+func (x *ScheduledSessionOperation) GetUpdatedAt() *timestamppb.Timestamp {
 	if x != nil {
-		return x.IsOwnMessage
+		return x.UpdatedAt
 	}
-	return false
+	return nil
 }
 
-type SendContactMessageRequest struct {
-	state             protoimpl.MessageState `protogen:"open.v1"`
-	HeadlessAccountId string                 `protobuf:"bytes,1,opt,name=headless_account_id,json=headlessAccountId,proto3" json:"headless_account_id,omitempty"`
-	ContactUserId     string                 `protobuf:"bytes,2,opt,name=contact_user_id,json=contactUserId,proto3" json:"contact_user_id,omitempty"`
-	Message           string                 `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
-	unknownFields     protoimpl.UnknownFields
-	sizeCache         protoimpl.SizeCache
+type CreateScheduledSessionOperationRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Operation     *ScheduledOperation    `protobuf:"bytes,1,opt,name=operation,proto3" json:"operation,omitempty"`
+	Trigger       *ScheduledTrigger      `protobuf:"bytes,2,opt,name=trigger,proto3" json:"trigger,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *SendContactMessageRequest) Reset() {
-	*x = SendContactMessageRequest{}
-	mi := &file_hdlctrl_v1_controller_proto_msgTypes[96]
+func (x *CreateScheduledSessionOperationRequest) Reset() {
+	*x = CreateScheduledSessionOperationRequest{}
+	mi := &file_hdlctrl_v1_controller_proto_msgTypes[149]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SendContactMessageRequest) String() string {
+func (x *CreateScheduledSessionOperationRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SendContactMessageRequest) ProtoMessage() {}
+func (*CreateScheduledSessionOperationRequest) ProtoMessage() {}
 
-func (x *SendContactMessageRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_hdlctrl_v1_controller_proto_msgTypes[96]
+func (x *CreateScheduledSessionOperationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_hdlctrl_v1_controller_proto_msgTypes[149]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5557,53 +9821,47 @@ func (x *SendContactMessageRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SendContactMessageRequest.ProtoReflect.Descriptor instead.
-func (*SendContactMessageRequest) Descriptor() ([]byte, []int) {
-	return file_hdlctrl_v1_controller_proto_rawDescGZIP(), []int{96}
-}
-
-func (x *SendContactMessageRequest) GetHeadlessAccountId() string {
-	if x != nil {
-		return x.HeadlessAccountId
-	}
-	return ""
+// Deprecated: Use CreateScheduledSessionOperationRequest.ProtoReflect.Descriptor instead.
+func (*CreateScheduledSessionOperationRequest) Descriptor() ([]byte, []int) {
+	return file_hdlctrl_v1_controller_proto_rawDescGZIP(), []int{149}
 }
 
-func (x *SendContactMessageRequest) GetContactUserId() string {
+func (x *CreateScheduledSessionOperationRequest) GetOperation() *ScheduledOperation {
 	if x != nil {
-		return x.ContactUserId
+		return x.Operation
 	}
-	return ""
+	return nil
 }
 
-func (x *SendContactMessageRequest) GetMessage() string {
+func (x *CreateScheduledSessionOperationRequest) GetTrigger() *ScheduledTrigger {
 	if x != nil {
-		return x.Message
+		return x.Trigger
 	}
-	return ""
+	return nil
 }
 
-type SendContactMessageResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+type CreateScheduledSessionOperationResponse struct {
+	state              protoimpl.MessageState     `protogen:"open.v1"`
+	ScheduledOperation *ScheduledSessionOperation `protobuf:"bytes,1,opt,name=scheduled_operation,json=scheduledOperation,proto3" json:"scheduled_operation,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
 }
 
-func (x *SendContactMessageResponse) Reset() {
-	*x = SendContactMessageResponse{}
-	mi := &file_hdlctrl_v1_controller_proto_msgTypes[97]
+func (x *CreateScheduledSessionOperationResponse) Reset() {
+	*x = CreateScheduledSessionOperationResponse{}
+	mi := &file_hdlctrl_v1_controller_proto_msgTypes[150]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SendContactMessageResponse) String() string {
+func (x *CreateScheduledSessionOperationResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SendContactMessageResponse) ProtoMessage() {}
+func (*CreateScheduledSessionOperationResponse) ProtoMessage() {}
 
-func (x *SendContactMessageResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_hdlctrl_v1_controller_proto_msgTypes[97]
+func (x *CreateScheduledSessionOperationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_hdlctrl_v1_controller_proto_msgTypes[150]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5614,40 +9872,47 @@ func (x *SendContactMessageResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SendContactMessageResponse.ProtoReflect.Descriptor instead.
-func (*SendContactMessageResponse) Descriptor() ([]byte, []int) {
-	return file_hdlctrl_v1_controller_proto_rawDescGZIP(), []int{97}
+// Deprecated: Use CreateScheduledSessionOperationResponse.ProtoReflect.Descriptor instead.
+func (*CreateScheduledSessionOperationResponse) Descriptor() ([]byte, []int) {
+	return file_hdlctrl_v1_controller_proto_rawDescGZIP(), []int{150}
 }
 
-// 予約する操作.
-type ScheduledOperation struct {
-	state protoimpl.MessageState `protogen:"open.v1"`
-	// Types that are valid to be assigned to Operation:
-	//
-	//	*ScheduledOperation_StartSession
-	//	*ScheduledOperation_StopSession
-	//	*ScheduledOperation_UpdateParameters
-	//	*ScheduledOperation_UpdateExtraSettings
-	Operation     isScheduledOperation_Operation `protobuf_oneof:"operation"`
+func (x *CreateScheduledSessionOperationResponse) GetScheduledOperation() *ScheduledSessionOperation {
+	if x != nil {
+		return x.ScheduledOperation
+	}
+	return nil
+}
+
+type ListScheduledSessionOperationsRequest struct {
+	state     protoimpl.MessageState    `protogen:"open.v1"`
+	SessionId *string                   `protobuf:"bytes,1,opt,name=session_id,json=sessionId,proto3,oneof" json:"session_id,omitempty"`
+	HostId    *string                   `protobuf:"bytes,2,opt,name=host_id,json=hostId,proto3,oneof" json:"host_id,omitempty"`
+	Status    *ScheduledOperationStatus `protobuf:"varint,3,opt,name=status,proto3,enum=hdlctrl.v1.ScheduledOperationStatus,oneof" json:"status,omitempty"`
+	Page      *PageRequest              `protobuf:"bytes,4,opt,name=page,proto3" json:"page,omitempty"`
+	// group_id 指定で対象 host/session がそのグループに所属する op のみに絞る.
+	// 未指定 + (session_id, host_id) も未指定なら、caller が session:read を持つ
+	// 全グループの op を返す.
+	GroupId       *string `protobuf:"bytes,5,opt,name=group_id,json=groupId,proto3,oneof" json:"group_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ScheduledOperation) Reset() {
-	*x = ScheduledOperation{}
-	mi := &file_hdlctrl_v1_controller_proto_msgTypes[98]
+func (x *ListScheduledSessionOperationsRequest) Reset() {
+	*x = ListScheduledSessionOperationsRequest{}
+	mi := &file_hdlctrl_v1_controller_proto_msgTypes[151]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ScheduledOperation) String() string {
+func (x *ListScheduledSessionOperationsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ScheduledOperation) ProtoMessage() {}
+func (*ListScheduledSessionOperationsRequest) ProtoMessage() {}
 
-func (x *ScheduledOperation) ProtoReflect() protoreflect.Message {
-	mi := &file_hdlctrl_v1_controller_proto_msgTypes[98]
+func (x *ListScheduledSessionOperationsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_hdlctrl_v1_controller_proto_msgTypes[151]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5658,109 +9923,120 @@ func (x *ScheduledOperation) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ScheduledOperation.ProtoReflect.Descriptor instead.
-func (*ScheduledOperation) Descriptor() ([]byte, []int) {
-	return file_hdlctrl_v1_controller_proto_rawDescGZIP(), []int{98}
+// Deprecated: Use ListScheduledSessionOperationsRequest.ProtoReflect.Descriptor instead.
+func (*ListScheduledSessionOperationsRequest) Descriptor() ([]byte, []int) {
+	return file_hdlctrl_v1_controller_proto_rawDescGZIP(), []int{151}
 }
 
-func (x *ScheduledOperation) GetOperation() isScheduledOperation_Operation {
-	if x != nil {
-		return x.Operation
+func (x *ListScheduledSessionOperationsRequest) GetSessionId() string {
+	if x != nil && x.SessionId != nil {
+		return *x.SessionId
 	}
-	return nil
+	return ""
 }
 
-func (x *ScheduledOperation) GetStartSession() *StartWorldRequest {
-	if x != nil {
-		if x, ok := x.Operation.(*ScheduledOperation_StartSession); ok {
-			return x.StartSession
-		}
+func (x *ListScheduledSessionOperationsRequest) GetHostId() string {
+	if x != nil && x.HostId != nil {
+		return *x.HostId
 	}
-	return nil
+	return ""
 }
 
-func (x *ScheduledOperation) GetStopSession() *StopSessionRequest {
-	if x != nil {
-		if x, ok := x.Operation.(*ScheduledOperation_StopSession); ok {
-			return x.StopSession
-		}
+func (x *ListScheduledSessionOperationsRequest) GetStatus() ScheduledOperationStatus {
+	if x != nil && x.Status != nil {
+		return *x.Status
 	}
-	return nil
+	return ScheduledOperationStatus_SCHEDULED_OPERATION_STATUS_UNSPECIFIED
 }
 
-func (x *ScheduledOperation) GetUpdateParameters() *UpdateSessionParametersRequest {
+func (x *ListScheduledSessionOperationsRequest) GetPage() *PageRequest {
 	if x != nil {
-		if x, ok := x.Operation.(*ScheduledOperation_UpdateParameters); ok {
-			return x.UpdateParameters
-		}
+		return x.Page
 	}
 	return nil
 }
 
-func (x *ScheduledOperation) GetUpdateExtraSettings() *UpdateSessionExtraSettingsRequest {
-	if x != nil {
-		if x, ok := x.Operation.(*ScheduledOperation_UpdateExtraSettings); ok {
-			return x.UpdateExtraSettings
-		}
+func (x *ListScheduledSessionOperationsRequest) GetGroupId() string {
+	if x != nil && x.GroupId != nil {
+		return *x.GroupId
 	}
-	return nil
+	return ""
 }
 
-type isScheduledOperation_Operation interface {
-	isScheduledOperation_Operation()
+type ListScheduledSessionOperationsResponse struct {
+	state               protoimpl.MessageState       `protogen:"open.v1"`
+	ScheduledOperations []*ScheduledSessionOperation `protobuf:"bytes,1,rep,name=scheduled_operations,json=scheduledOperations,proto3" json:"scheduled_operations,omitempty"`
+	Page                *PageResponse                `protobuf:"bytes,2,opt,name=page,proto3" json:"page,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
 }
 
-type ScheduledOperation_StartSession struct {
-	StartSession *StartWorldRequest `protobuf:"bytes,1,opt,name=start_session,json=startSession,proto3,oneof"`
+func (x *ListScheduledSessionOperationsResponse) Reset() {
+	*x = ListScheduledSessionOperationsResponse{}
+	mi := &file_hdlctrl_v1_controller_proto_msgTypes[152]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-type ScheduledOperation_StopSession struct {
-	StopSession *StopSessionRequest `protobuf:"bytes,2,opt,name=stop_session,json=stopSession,proto3,oneof"`
+func (x *ListScheduledSessionOperationsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-type ScheduledOperation_UpdateParameters struct {
-	UpdateParameters *UpdateSessionParametersRequest `protobuf:"bytes,3,opt,name=update_parameters,json=updateParameters,proto3,oneof"`
-}
+func (*ListScheduledSessionOperationsResponse) ProtoMessage() {}
 
-type ScheduledOperation_UpdateExtraSettings struct {
-	UpdateExtraSettings *UpdateSessionExtraSettingsRequest `protobuf:"bytes,4,opt,name=update_extra_settings,json=updateExtraSettings,proto3,oneof"`
+func (x *ListScheduledSessionOperationsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_hdlctrl_v1_controller_proto_msgTypes[152]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
 }
 
-func (*ScheduledOperation_StartSession) isScheduledOperation_Operation() {}
-
-func (*ScheduledOperation_StopSession) isScheduledOperation_Operation() {}
-
-func (*ScheduledOperation_UpdateParameters) isScheduledOperation_Operation() {}
+// Deprecated: Use ListScheduledSessionOperationsResponse.ProtoReflect.Descriptor instead.
+func (*ListScheduledSessionOperationsResponse) Descriptor() ([]byte, []int) {
+	return file_hdlctrl_v1_controller_proto_rawDescGZIP(), []int{152}
+}
 
-func (*ScheduledOperation_UpdateExtraSettings) isScheduledOperation_Operation() {}
+func (x *ListScheduledSessionOperationsResponse) GetScheduledOperations() []*ScheduledSessionOperation {
+	if x != nil {
+		return x.ScheduledOperations
+	}
+	return nil
+}
 
-// 発火条件.
-type ScheduledTrigger struct {
-	state protoimpl.MessageState `protogen:"open.v1"`
-	// Types that are valid to be assigned to Trigger:
-	//
-	//	*ScheduledTrigger_Time
-	//	*ScheduledTrigger_SessionUserCount
-	Trigger       isScheduledTrigger_Trigger `protobuf_oneof:"trigger"`
+func (x *ListScheduledSessionOperationsResponse) GetPage() *PageResponse {
+	if x != nil {
+		return x.Page
+	}
+	return nil
+}
+
+type CancelScheduledSessionOperationRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ScheduledTrigger) Reset() {
-	*x = ScheduledTrigger{}
-	mi := &file_hdlctrl_v1_controller_proto_msgTypes[99]
+func (x *CancelScheduledSessionOperationRequest) Reset() {
+	*x = CancelScheduledSessionOperationRequest{}
+	mi := &file_hdlctrl_v1_controller_proto_msgTypes[153]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ScheduledTrigger) String() string {
+func (x *CancelScheduledSessionOperationRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ScheduledTrigger) ProtoMessage() {}
+func (*CancelScheduledSessionOperationRequest) ProtoMessage() {}
 
-func (x *ScheduledTrigger) ProtoReflect() protoreflect.Message {
-	mi := &file_hdlctrl_v1_controller_proto_msgTypes[99]
+func (x *CancelScheduledSessionOperationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_hdlctrl_v1_controller_proto_msgTypes[153]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5771,74 +10047,76 @@ func (x *ScheduledTrigger) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ScheduledTrigger.ProtoReflect.Descriptor instead.
-func (*ScheduledTrigger) Descriptor() ([]byte, []int) {
-	return file_hdlctrl_v1_controller_proto_rawDescGZIP(), []int{99}
+// Deprecated: Use CancelScheduledSessionOperationRequest.ProtoReflect.Descriptor instead.
+func (*CancelScheduledSessionOperationRequest) Descriptor() ([]byte, []int) {
+	return file_hdlctrl_v1_controller_proto_rawDescGZIP(), []int{153}
 }
 
-func (x *ScheduledTrigger) GetTrigger() isScheduledTrigger_Trigger {
+func (x *CancelScheduledSessionOperationRequest) GetId() string {
 	if x != nil {
-		return x.Trigger
+		return x.Id
 	}
-	return nil
+	return ""
 }
 
-func (x *ScheduledTrigger) GetTime() *TimeTrigger {
-	if x != nil {
-		if x, ok := x.Trigger.(*ScheduledTrigger_Time); ok {
-			return x.Time
-		}
-	}
-	return nil
+type CancelScheduledSessionOperationResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ScheduledTrigger) GetSessionUserCount() *SessionUserCountTrigger {
-	if x != nil {
-		if x, ok := x.Trigger.(*ScheduledTrigger_SessionUserCount); ok {
-			return x.SessionUserCount
-		}
-	}
-	return nil
+func (x *CancelScheduledSessionOperationResponse) Reset() {
+	*x = CancelScheduledSessionOperationResponse{}
+	mi := &file_hdlctrl_v1_controller_proto_msgTypes[154]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-type isScheduledTrigger_Trigger interface {
-	isScheduledTrigger_Trigger()
+func (x *CancelScheduledSessionOperationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-type ScheduledTrigger_Time struct {
-	Time *TimeTrigger `protobuf:"bytes,1,opt,name=time,proto3,oneof"`
-}
+func (*CancelScheduledSessionOperationResponse) ProtoMessage() {}
 
-type ScheduledTrigger_SessionUserCount struct {
-	SessionUserCount *SessionUserCountTrigger `protobuf:"bytes,2,opt,name=session_user_count,json=sessionUserCount,proto3,oneof"`
+func (x *CancelScheduledSessionOperationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_hdlctrl_v1_controller_proto_msgTypes[154]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
 }
 
-func (*ScheduledTrigger_Time) isScheduledTrigger_Trigger() {}
-
-func (*ScheduledTrigger_SessionUserCount) isScheduledTrigger_Trigger() {}
+// Deprecated: Use CancelScheduledSessionOperationResponse.ProtoReflect.Descriptor instead.
+func (*CancelScheduledSessionOperationResponse) Descriptor() ([]byte, []int) {
+	return file_hdlctrl_v1_controller_proto_rawDescGZIP(), []int{154}
+}
 
-type TimeTrigger struct {
+type PauseScheduledSessionOperationRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	ScheduledAt   *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=scheduled_at,json=scheduledAt,proto3" json:"scheduled_at,omitempty"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *TimeTrigger) Reset() {
-	*x = TimeTrigger{}
-	mi := &file_hdlctrl_v1_controller_proto_msgTypes[100]
+func (x *PauseScheduledSessionOperationRequest) Reset() {
+	*x = PauseScheduledSessionOperationRequest{}
+	mi := &file_hdlctrl_v1_controller_proto_msgTypes[155]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *TimeTrigger) String() string {
+func (x *PauseScheduledSessionOperationRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TimeTrigger) ProtoMessage() {}
+func (*PauseScheduledSessionOperationRequest) ProtoMessage() {}
 
-func (x *TimeTrigger) ProtoReflect() protoreflect.Message {
-	mi := &file_hdlctrl_v1_controller_proto_msgTypes[100]
+func (x *PauseScheduledSessionOperationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_hdlctrl_v1_controller_proto_msgTypes[155]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5849,45 +10127,40 @@ func (x *TimeTrigger) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use TimeTrigger.ProtoReflect.Descriptor instead.
-func (*TimeTrigger) Descriptor() ([]byte, []int) {
-	return file_hdlctrl_v1_controller_proto_rawDescGZIP(), []int{100}
+// Deprecated: Use PauseScheduledSessionOperationRequest.ProtoReflect.Descriptor instead.
+func (*PauseScheduledSessionOperationRequest) Descriptor() ([]byte, []int) {
+	return file_hdlctrl_v1_controller_proto_rawDescGZIP(), []int{155}
 }
 
-func (x *TimeTrigger) GetScheduledAt() *timestamppb.Timestamp {
+func (x *PauseScheduledSessionOperationRequest) GetId() string {
 	if x != nil {
-		return x.ScheduledAt
+		return x.Id
 	}
-	return nil
+	return ""
 }
 
-// セッションのユーザー数が閾値を満たした際に発火するトリガー.
-// SessionStateCache が保持する current_state.users_count を監視する.
-// session が cache に存在しない (=未起動 / 既終了) 場合は発火しない.
-type SessionUserCountTrigger struct {
-	state         protoimpl.MessageState             `protogen:"open.v1"`
-	SessionId     string                             `protobuf:"bytes,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
-	Comparator    SessionUserCountTrigger_Comparator `protobuf:"varint,2,opt,name=comparator,proto3,enum=hdlctrl.v1.SessionUserCountTrigger_Comparator" json:"comparator,omitempty"`
-	Threshold     int32                              `protobuf:"varint,3,opt,name=threshold,proto3" json:"threshold,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+type PauseScheduledSessionOperationResponse struct {
+	state              protoimpl.MessageState     `protogen:"open.v1"`
+	ScheduledOperation *ScheduledSessionOperation `protobuf:"bytes,1,opt,name=scheduled_operation,json=scheduledOperation,proto3" json:"scheduled_operation,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
 }
 
-func (x *SessionUserCountTrigger) Reset() {
-	*x = SessionUserCountTrigger{}
-	mi := &file_hdlctrl_v1_controller_proto_msgTypes[101]
+func (x *PauseScheduledSessionOperationResponse) Reset() {
+	*x = PauseScheduledSessionOperationResponse{}
+	mi := &file_hdlctrl_v1_controller_proto_msgTypes[156]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SessionUserCountTrigger) String() string {
+func (x *PauseScheduledSessionOperationResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SessionUserCountTrigger) ProtoMessage() {}
+func (*PauseScheduledSessionOperationResponse) ProtoMessage() {}
 
-func (x *SessionUserCountTrigger) ProtoReflect() protoreflect.Message {
-	mi := &file_hdlctrl_v1_controller_proto_msgTypes[101]
+func (x *PauseScheduledSessionOperationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_hdlctrl_v1_controller_proto_msgTypes[156]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5898,65 +10171,40 @@ func (x *SessionUserCountTrigger) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SessionUserCountTrigger.ProtoReflect.Descriptor instead.
-func (*SessionUserCountTrigger) Descriptor() ([]byte, []int) {
-	return file_hdlctrl_v1_controller_proto_rawDescGZIP(), []int{101}
-}
-
-func (x *SessionUserCountTrigger) GetSessionId() string {
-	if x != nil {
-		return x.SessionId
-	}
-	return ""
-}
-
-func (x *SessionUserCountTrigger) GetComparator() SessionUserCountTrigger_Comparator {
-	if x != nil {
-		return x.Comparator
-	}
-	return SessionUserCountTrigger_COMPARATOR_UNSPECIFIED
+// Deprecated: Use PauseScheduledSessionOperationResponse.ProtoReflect.Descriptor instead.
+func (*PauseScheduledSessionOperationResponse) Descriptor() ([]byte, []int) {
+	return file_hdlctrl_v1_controller_proto_rawDescGZIP(), []int{156}
 }
 
-func (x *SessionUserCountTrigger) GetThreshold() int32 {
+func (x *PauseScheduledSessionOperationResponse) GetScheduledOperation() *ScheduledSessionOperation {
 	if x != nil {
-		return x.Threshold
+		return x.ScheduledOperation
 	}
-	return 0
+	return nil
 }
 
-type ScheduledSessionOperation struct {
-	state         protoimpl.MessageState   `protogen:"open.v1"`
-	Id            string                   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Operation     *ScheduledOperation      `protobuf:"bytes,2,opt,name=operation,proto3" json:"operation,omitempty"`
-	Trigger       *ScheduledTrigger        `protobuf:"bytes,3,opt,name=trigger,proto3" json:"trigger,omitempty"`
-	NextFireAt    *timestamppb.Timestamp   `protobuf:"bytes,4,opt,name=next_fire_at,json=nextFireAt,proto3" json:"next_fire_at,omitempty"`
-	HostId        *string                  `protobuf:"bytes,5,opt,name=host_id,json=hostId,proto3,oneof" json:"host_id,omitempty"`
-	SessionId     *string                  `protobuf:"bytes,6,opt,name=session_id,json=sessionId,proto3,oneof" json:"session_id,omitempty"`
-	Status        ScheduledOperationStatus `protobuf:"varint,7,opt,name=status,proto3,enum=hdlctrl.v1.ScheduledOperationStatus" json:"status,omitempty"`
-	LastError     *string                  `protobuf:"bytes,8,opt,name=last_error,json=lastError,proto3,oneof" json:"last_error,omitempty"`
-	ExecutedAt    *timestamppb.Timestamp   `protobuf:"bytes,9,opt,name=executed_at,json=executedAt,proto3,oneof" json:"executed_at,omitempty"`
-	CreatedBy     *string                  `protobuf:"bytes,10,opt,name=created_by,json=createdBy,proto3,oneof" json:"created_by,omitempty"`
-	CreatedAt     *timestamppb.Timestamp   `protobuf:"bytes,11,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	UpdatedAt     *timestamppb.Timestamp   `protobuf:"bytes,12,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+type ResumeScheduledSessionOperationRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ScheduledSessionOperation) Reset() {
-	*x = ScheduledSessionOperation{}
-	mi := &file_hdlctrl_v1_controller_proto_msgTypes[102]
+func (x *ResumeScheduledSessionOperationRequest) Reset() {
+	*x = ResumeScheduledSessionOperationRequest{}
+	mi := &file_hdlctrl_v1_controller_proto_msgTypes[157]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ScheduledSessionOperation) String() string {
+func (x *ResumeScheduledSessionOperationRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ScheduledSessionOperation) ProtoMessage() {}
+func (*ResumeScheduledSessionOperationRequest) ProtoMessage() {}
 
-func (x *ScheduledSessionOperation) ProtoReflect() protoreflect.Message {
-	mi := &file_hdlctrl_v1_controller_proto_msgTypes[102]
+func (x *ResumeScheduledSessionOperationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_hdlctrl_v1_controller_proto_msgTypes[157]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5967,118 +10215,84 @@ func (x *ScheduledSessionOperation) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ScheduledSessionOperation.ProtoReflect.Descriptor instead.
-func (*ScheduledSessionOperation) Descriptor() ([]byte, []int) {
-	return file_hdlctrl_v1_controller_proto_rawDescGZIP(), []int{102}
+// Deprecated: Use ResumeScheduledSessionOperationRequest.ProtoReflect.Descriptor instead.
+func (*ResumeScheduledSessionOperationRequest) Descriptor() ([]byte, []int) {
+	return file_hdlctrl_v1_controller_proto_rawDescGZIP(), []int{157}
 }
 
-func (x *ScheduledSessionOperation) GetId() string {
+func (x *ResumeScheduledSessionOperationRequest) GetId() string {
 	if x != nil {
 		return x.Id
 	}
 	return ""
 }
 
-func (x *ScheduledSessionOperation) GetOperation() *ScheduledOperation {
-	if x != nil {
-		return x.Operation
-	}
-	return nil
-}
-
-func (x *ScheduledSessionOperation) GetTrigger() *ScheduledTrigger {
-	if x != nil {
-		return x.Trigger
-	}
-	return nil
-}
-
-func (x *ScheduledSessionOperation) GetNextFireAt() *timestamppb.Timestamp {
-	if x != nil {
-		return x.NextFireAt
-	}
-	return nil
-}
-
-func (x *ScheduledSessionOperation) GetHostId() string {
-	if x != nil && x.HostId != nil {
-		return *x.HostId
-	}
-	return ""
+type ResumeScheduledSessionOperationResponse struct {
+	state              protoimpl.MessageState     `protogen:"open.v1"`
+	ScheduledOperation *ScheduledSessionOperation `protobuf:"bytes,1,opt,name=scheduled_operation,json=scheduledOperation,proto3" json:"scheduled_operation,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
 }
 
-func (x *ScheduledSessionOperation) GetSessionId() string {
-	if x != nil && x.SessionId != nil {
-		return *x.SessionId
-	}
-	return ""
+func (x *ResumeScheduledSessionOperationResponse) Reset() {
+	*x = ResumeScheduledSessionOperationResponse{}
+	mi := &file_hdlctrl_v1_controller_proto_msgTypes[158]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *ScheduledSessionOperation) GetStatus() ScheduledOperationStatus {
-	if x != nil {
-		return x.Status
-	}
-	return ScheduledOperationStatus_SCHEDULED_OPERATION_STATUS_UNSPECIFIED
+func (x *ResumeScheduledSessionOperationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *ScheduledSessionOperation) GetLastError() string {
-	if x != nil && x.LastError != nil {
-		return *x.LastError
-	}
-	return ""
-}
+func (*ResumeScheduledSessionOperationResponse) ProtoMessage() {}
 
-func (x *ScheduledSessionOperation) GetExecutedAt() *timestamppb.Timestamp {
+func (x *ResumeScheduledSessionOperationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_hdlctrl_v1_controller_proto_msgTypes[158]
 	if x != nil {
-		return x.ExecutedAt
-	}
-	return nil
-}
-
-func (x *ScheduledSessionOperation) GetCreatedBy() string {
-	if x != nil && x.CreatedBy != nil {
-		return *x.CreatedBy
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *ScheduledSessionOperation) GetCreatedAt() *timestamppb.Timestamp {
-	if x != nil {
-		return x.CreatedAt
-	}
-	return nil
+// Deprecated: Use ResumeScheduledSessionOperationResponse.ProtoReflect.Descriptor instead.
+func (*ResumeScheduledSessionOperationResponse) Descriptor() ([]byte, []int) {
+	return file_hdlctrl_v1_controller_proto_rawDescGZIP(), []int{158}
 }
 
-func (x *ScheduledSessionOperation) GetUpdatedAt() *timestamppb.Timestamp {
+func (x *ResumeScheduledSessionOperationResponse) GetScheduledOperation() *ScheduledSessionOperation {
 	if x != nil {
-		return x.UpdatedAt
+		return x.ScheduledOperation
 	}
 	return nil
 }
 
-type CreateScheduledSessionOperationRequest struct {
+type SkipScheduledSessionOperationOccurrenceRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Operation     *ScheduledOperation    `protobuf:"bytes,1,opt,name=operation,proto3" json:"operation,omitempty"`
-	Trigger       *ScheduledTrigger      `protobuf:"bytes,2,opt,name=trigger,proto3" json:"trigger,omitempty"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateScheduledSessionOperationRequest) Reset() {
-	*x = CreateScheduledSessionOperationRequest{}
-	mi := &file_hdlctrl_v1_controller_proto_msgTypes[103]
+func (x *SkipScheduledSessionOperationOccurrenceRequest) Reset() {
+	*x = SkipScheduledSessionOperationOccurrenceRequest{}
+	mi := &file_hdlctrl_v1_controller_proto_msgTypes[159]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateScheduledSessionOperationRequest) String() string {
+func (x *SkipScheduledSessionOperationOccurrenceRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateScheduledSessionOperationRequest) ProtoMessage() {}
+func (*SkipScheduledSessionOperationOccurrenceRequest) ProtoMessage() {}
 
-func (x *CreateScheduledSessionOperationRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_hdlctrl_v1_controller_proto_msgTypes[103]
+func (x *SkipScheduledSessionOperationOccurrenceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_hdlctrl_v1_controller_proto_msgTypes[159]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6089,47 +10303,40 @@ func (x *CreateScheduledSessionOperationRequest) ProtoReflect() protoreflect.Mes
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateScheduledSessionOperationRequest.ProtoReflect.Descriptor instead.
-func (*CreateScheduledSessionOperationRequest) Descriptor() ([]byte, []int) {
-	return file_hdlctrl_v1_controller_proto_rawDescGZIP(), []int{103}
-}
-
-func (x *CreateScheduledSessionOperationRequest) GetOperation() *ScheduledOperation {
-	if x != nil {
-		return x.Operation
-	}
-	return nil
+// Deprecated: Use SkipScheduledSessionOperationOccurrenceRequest.ProtoReflect.Descriptor instead.
+func (*SkipScheduledSessionOperationOccurrenceRequest) Descriptor() ([]byte, []int) {
+	return file_hdlctrl_v1_controller_proto_rawDescGZIP(), []int{159}
 }
 
-func (x *CreateScheduledSessionOperationRequest) GetTrigger() *ScheduledTrigger {
+func (x *SkipScheduledSessionOperationOccurrenceRequest) GetId() string {
 	if x != nil {
-		return x.Trigger
+		return x.Id
 	}
-	return nil
+	return ""
 }
 
-type CreateScheduledSessionOperationResponse struct {
+type SkipScheduledSessionOperationOccurrenceResponse struct {
 	state              protoimpl.MessageState     `protogen:"open.v1"`
 	ScheduledOperation *ScheduledSessionOperation `protobuf:"bytes,1,opt,name=scheduled_operation,json=scheduledOperation,proto3" json:"scheduled_operation,omitempty"`
 	unknownFields      protoimpl.UnknownFields
 	sizeCache          protoimpl.SizeCache
 }
 
-func (x *CreateScheduledSessionOperationResponse) Reset() {
-	*x = CreateScheduledSessionOperationResponse{}
-	mi := &file_hdlctrl_v1_controller_proto_msgTypes[104]
+func (x *SkipScheduledSessionOperationOccurrenceResponse) Reset() {
+	*x = SkipScheduledSessionOperationOccurrenceResponse{}
+	mi := &file_hdlctrl_v1_controller_proto_msgTypes[160]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateScheduledSessionOperationResponse) String() string {
+func (x *SkipScheduledSessionOperationOccurrenceResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateScheduledSessionOperationResponse) ProtoMessage() {}
+func (*SkipScheduledSessionOperationOccurrenceResponse) ProtoMessage() {}
 
-func (x *CreateScheduledSessionOperationResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_hdlctrl_v1_controller_proto_msgTypes[104]
+func (x *SkipScheduledSessionOperationOccurrenceResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_hdlctrl_v1_controller_proto_msgTypes[160]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6140,47 +10347,47 @@ func (x *CreateScheduledSessionOperationResponse) ProtoReflect() protoreflect.Me
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateScheduledSessionOperationResponse.ProtoReflect.Descriptor instead.
-func (*CreateScheduledSessionOperationResponse) Descriptor() ([]byte, []int) {
-	return file_hdlctrl_v1_controller_proto_rawDescGZIP(), []int{104}
+// Deprecated: Use SkipScheduledSessionOperationOccurrenceResponse.ProtoReflect.Descriptor instead.
+func (*SkipScheduledSessionOperationOccurrenceResponse) Descriptor() ([]byte, []int) {
+	return file_hdlctrl_v1_controller_proto_rawDescGZIP(), []int{160}
 }
 
-func (x *CreateScheduledSessionOperationResponse) GetScheduledOperation() *ScheduledSessionOperation {
+func (x *SkipScheduledSessionOperationOccurrenceResponse) GetScheduledOperation() *ScheduledSessionOperation {
 	if x != nil {
 		return x.ScheduledOperation
 	}
 	return nil
 }
 
-type ListScheduledSessionOperationsRequest struct {
-	state     protoimpl.MessageState    `protogen:"open.v1"`
-	SessionId *string                   `protobuf:"bytes,1,opt,name=session_id,json=sessionId,proto3,oneof" json:"session_id,omitempty"`
-	HostId    *string                   `protobuf:"bytes,2,opt,name=host_id,json=hostId,proto3,oneof" json:"host_id,omitempty"`
-	Status    *ScheduledOperationStatus `protobuf:"varint,3,opt,name=status,proto3,enum=hdlctrl.v1.ScheduledOperationStatus,oneof" json:"status,omitempty"`
-	Page      *PageRequest              `protobuf:"bytes,4,opt,name=page,proto3" json:"page,omitempty"`
-	// group_id 指定で対象 host/session がそのグループに所属する op のみに絞る.
-	// 未指定 + (session_id, host_id) も未指定なら、caller が session:read を持つ
-	// 全グループの op を返す.
-	GroupId       *string `protobuf:"bytes,5,opt,name=group_id,json=groupId,proto3,oneof" json:"group_id,omitempty"`
+// 予約操作の 1 回分の実行履歴.
+type ScheduledOperationRun struct {
+	state         protoimpl.MessageState       `protogen:"open.v1"`
+	Id            int64                        `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	ScheduledFor  *timestamppb.Timestamp       `protobuf:"bytes,2,opt,name=scheduled_for,json=scheduledFor,proto3" json:"scheduled_for,omitempty"`
+	StartedAt     *timestamppb.Timestamp       `protobuf:"bytes,3,opt,name=started_at,json=startedAt,proto3,oneof" json:"started_at,omitempty"` // SKIPPED / MISSED では未設定
+	FinishedAt    *timestamppb.Timestamp       `protobuf:"bytes,4,opt,name=finished_at,json=finishedAt,proto3" json:"finished_at,omitempty"`
+	Outcome       ScheduledOperationRunOutcome `protobuf:"varint,5,opt,name=outcome,proto3,enum=hdlctrl.v1.ScheduledOperationRunOutcome" json:"outcome,omitempty"`
+	Error         *string                      `protobuf:"bytes,6,opt,name=error,proto3,oneof" json:"error,omitempty"`
+	InstanceId    *string                      `protobuf:"bytes,7,opt,name=instance_id,json=instanceId,proto3,oneof" json:"instance_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListScheduledSessionOperationsRequest) Reset() {
-	*x = ListScheduledSessionOperationsRequest{}
-	mi := &file_hdlctrl_v1_controller_proto_msgTypes[105]
+func (x *ScheduledOperationRun) Reset() {
+	*x = ScheduledOperationRun{}
+	mi := &file_hdlctrl_v1_controller_proto_msgTypes[161]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListScheduledSessionOperationsRequest) String() string {
+func (x *ScheduledOperationRun) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListScheduledSessionOperationsRequest) ProtoMessage() {}
+func (*ScheduledOperationRun) ProtoMessage() {}
 
-func (x *ListScheduledSessionOperationsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_hdlctrl_v1_controller_proto_msgTypes[105]
+func (x *ScheduledOperationRun) ProtoReflect() protoreflect.Message {
+	mi := &file_hdlctrl_v1_controller_proto_msgTypes[161]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6191,69 +10398,83 @@ func (x *ListScheduledSessionOperationsRequest) ProtoReflect() protoreflect.Mess
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListScheduledSessionOperationsRequest.ProtoReflect.Descriptor instead.
-func (*ListScheduledSessionOperationsRequest) Descriptor() ([]byte, []int) {
-	return file_hdlctrl_v1_controller_proto_rawDescGZIP(), []int{105}
+// Deprecated: Use ScheduledOperationRun.ProtoReflect.Descriptor instead.
+func (*ScheduledOperationRun) Descriptor() ([]byte, []int) {
+	return file_hdlctrl_v1_controller_proto_rawDescGZIP(), []int{161}
 }
 
-func (x *ListScheduledSessionOperationsRequest) GetSessionId() string {
-	if x != nil && x.SessionId != nil {
-		return *x.SessionId
+func (x *ScheduledOperationRun) GetId() int64 {
+	if x != nil {
+		return x.Id
 	}
-	return ""
+	return 0
 }
 
-func (x *ListScheduledSessionOperationsRequest) GetHostId() string {
-	if x != nil && x.HostId != nil {
-		return *x.HostId
+func (x *ScheduledOperationRun) GetScheduledFor() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ScheduledFor
 	}
-	return ""
+	return nil
 }
 
-func (x *ListScheduledSessionOperationsRequest) GetStatus() ScheduledOperationStatus {
-	if x != nil && x.Status != nil {
-		return *x.Status
+func (x *ScheduledOperationRun) GetStartedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartedAt
 	}
-	return ScheduledOperationStatus_SCHEDULED_OPERATION_STATUS_UNSPECIFIED
+	return nil
 }
 
-func (x *ListScheduledSessionOperationsRequest) GetPage() *PageRequest {
+func (x *ScheduledOperationRun) GetFinishedAt() *timestamppb.Timestamp {
 	if x != nil {
-		return x.Page
+		return x.FinishedAt
 	}
 	return nil
 }
 
-func (x *ListScheduledSessionOperationsRequest) GetGroupId() string {
-	if x != nil && x.GroupId != nil {
-		return *x.GroupId
+func (x *ScheduledOperationRun) GetOutcome() ScheduledOperationRunOutcome {
+	if x != nil {
+		return x.Outcome
+	}
+	return ScheduledOperationRunOutcome_SCHEDULED_OPERATION_RUN_OUTCOME_UNSPECIFIED
+}
+
+func (x *ScheduledOperationRun) GetError() string {
+	if x != nil && x.Error != nil {
+		return *x.Error
 	}
 	return ""
 }
 
-type ListScheduledSessionOperationsResponse struct {
-	state               protoimpl.MessageState       `protogen:"open.v1"`
-	ScheduledOperations []*ScheduledSessionOperation `protobuf:"bytes,1,rep,name=scheduled_operations,json=scheduledOperations,proto3" json:"scheduled_operations,omitempty"`
-	Page                *PageResponse                `protobuf:"bytes,2,opt,name=page,proto3" json:"page,omitempty"`
-	unknownFields       protoimpl.UnknownFields
-	sizeCache           protoimpl.SizeCache
+func (x *ScheduledOperationRun) GetInstanceId() string {
+	if x != nil && x.InstanceId != nil {
+		return *x.InstanceId
+	}
+	return ""
 }
 
-func (x *ListScheduledSessionOperationsResponse) Reset() {
-	*x = ListScheduledSessionOperationsResponse{}
-	mi := &file_hdlctrl_v1_controller_proto_msgTypes[106]
+type ListScheduledSessionOperationRunsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Page          *PageRequest           `protobuf:"bytes,2,opt,name=page,proto3" json:"page,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListScheduledSessionOperationRunsRequest) Reset() {
+	*x = ListScheduledSessionOperationRunsRequest{}
+	mi := &file_hdlctrl_v1_controller_proto_msgTypes[162]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListScheduledSessionOperationsResponse) String() string {
+func (x *ListScheduledSessionOperationRunsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListScheduledSessionOperationsResponse) ProtoMessage() {}
+func (*ListScheduledSessionOperationRunsRequest) ProtoMessage() {}
 
-func (x *ListScheduledSessionOperationsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_hdlctrl_v1_controller_proto_msgTypes[106]
+func (x *ListScheduledSessionOperationRunsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_hdlctrl_v1_controller_proto_msgTypes[162]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6264,47 +10485,48 @@ func (x *ListScheduledSessionOperationsResponse) ProtoReflect() protoreflect.Mes
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListScheduledSessionOperationsResponse.ProtoReflect.Descriptor instead.
-func (*ListScheduledSessionOperationsResponse) Descriptor() ([]byte, []int) {
-	return file_hdlctrl_v1_controller_proto_rawDescGZIP(), []int{106}
+// Deprecated: Use ListScheduledSessionOperationRunsRequest.ProtoReflect.Descriptor instead.
+func (*ListScheduledSessionOperationRunsRequest) Descriptor() ([]byte, []int) {
+	return file_hdlctrl_v1_controller_proto_rawDescGZIP(), []int{162}
 }
 
-func (x *ListScheduledSessionOperationsResponse) GetScheduledOperations() []*ScheduledSessionOperation {
+func (x *ListScheduledSessionOperationRunsRequest) GetId() string {
 	if x != nil {
-		return x.ScheduledOperations
+		return x.Id
 	}
-	return nil
+	return ""
 }
 
-func (x *ListScheduledSessionOperationsResponse) GetPage() *PageResponse {
+func (x *ListScheduledSessionOperationRunsRequest) GetPage() *PageRequest {
 	if x != nil {
 		return x.Page
 	}
 	return nil
 }
 
-type CancelScheduledSessionOperationRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+type ListScheduledSessionOperationRunsResponse struct {
+	state         protoimpl.MessageState   `protogen:"open.v1"`
+	Runs          []*ScheduledOperationRun `protobuf:"bytes,1,rep,name=runs,proto3" json:"runs,omitempty"`
+	Page          *PageResponse            `protobuf:"bytes,2,opt,name=page,proto3" json:"page,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CancelScheduledSessionOperationRequest) Reset() {
-	*x = CancelScheduledSessionOperationRequest{}
-	mi := &file_hdlctrl_v1_controller_proto_msgTypes[107]
+func (x *ListScheduledSessionOperationRunsResponse) Reset() {
+	*x = ListScheduledSessionOperationRunsResponse{}
+	mi := &file_hdlctrl_v1_controller_proto_msgTypes[163]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CancelScheduledSessionOperationRequest) String() string {
+func (x *ListScheduledSessionOperationRunsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CancelScheduledSessionOperationRequest) ProtoMessage() {}
+func (*ListScheduledSessionOperationRunsResponse) ProtoMessage() {}
 
-func (x *CancelScheduledSessionOperationRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_hdlctrl_v1_controller_proto_msgTypes[107]
+func (x *ListScheduledSessionOperationRunsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_hdlctrl_v1_controller_proto_msgTypes[163]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6315,68 +10537,41 @@ func (x *CancelScheduledSessionOperationRequest) ProtoReflect() protoreflect.Mes
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CancelScheduledSessionOperationRequest.ProtoReflect.Descriptor instead.
-func (*CancelScheduledSessionOperationRequest) Descriptor() ([]byte, []int) {
-	return file_hdlctrl_v1_controller_proto_rawDescGZIP(), []int{107}
+// Deprecated: Use ListScheduledSessionOperationRunsResponse.ProtoReflect.Descriptor instead.
+func (*ListScheduledSessionOperationRunsResponse) Descriptor() ([]byte, []int) {
+	return file_hdlctrl_v1_controller_proto_rawDescGZIP(), []int{163}
 }
 
-func (x *CancelScheduledSessionOperationRequest) GetId() string {
+func (x *ListScheduledSessionOperationRunsResponse) GetRuns() []*ScheduledOperationRun {
 	if x != nil {
-		return x.Id
+		return x.Runs
 	}
-	return ""
-}
-
-type CancelScheduledSessionOperationResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *CancelScheduledSessionOperationResponse) Reset() {
-	*x = CancelScheduledSessionOperationResponse{}
-	mi := &file_hdlctrl_v1_controller_proto_msgTypes[108]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
-}
-
-func (x *CancelScheduledSessionOperationResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
+	return nil
 }
 
-func (*CancelScheduledSessionOperationResponse) ProtoMessage() {}
-
-func (x *CancelScheduledSessionOperationResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_hdlctrl_v1_controller_proto_msgTypes[108]
+func (x *ListScheduledSessionOperationRunsResponse) GetPage() *PageResponse {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.Page
 	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use CancelScheduledSessionOperationResponse.ProtoReflect.Descriptor instead.
-func (*CancelScheduledSessionOperationResponse) Descriptor() ([]byte, []int) {
-	return file_hdlctrl_v1_controller_proto_rawDescGZIP(), []int{108}
+	return nil
 }
 
 type ListHeadlessHostInstancesResponse_Instance struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	InstanceId    int32                  `protobuf:"varint,1,opt,name=instance_id,json=instanceId,proto3" json:"instance_id,omitempty"`
-	FirstLogAt    *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=first_log_at,json=firstLogAt,proto3" json:"first_log_at,omitempty"`
-	LastLogAt     *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=last_log_at,json=lastLogAt,proto3" json:"last_log_at,omitempty"`
-	LogCount      int64                  `protobuf:"varint,4,opt,name=log_count,json=logCount,proto3" json:"log_count,omitempty"`
-	IsCurrent     bool                   `protobuf:"varint,5,opt,name=is_current,json=isCurrent,proto3" json:"is_current,omitempty"`
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	InstanceId int32                  `protobuf:"varint,1,opt,name=instance_id,json=instanceId,proto3" json:"instance_id,omitempty"`
+	FirstLogAt *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=first_log_at,json=firstLogAt,proto3" json:"first_log_at,omitempty"`
+	LastLogAt  *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=last_log_at,json=lastLogAt,proto3" json:"last_log_at,omitempty"`
+	LogCount   int64                  `protobuf:"varint,4,opt,name=log_count,json=logCount,proto3" json:"log_count,omitempty"`
+	IsCurrent  bool                   `protobuf:"varint,5,opt,name=is_current,json=isCurrent,proto3" json:"is_current,omitempty"`
+	// ログが保持期間を過ぎて blob store に退避されている. 読み方は変わらない
+	Archived      bool `protobuf:"varint,6,opt,name=archived,proto3" json:"archived,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *ListHeadlessHostInstancesResponse_Instance) Reset() {
 	*x = ListHeadlessHostInstancesResponse_Instance{}
-	mi := &file_hdlctrl_v1_controller_proto_msgTypes[109]
+	mi := &file_hdlctrl_v1_controller_proto_msgTypes[164]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -6388,7 +10583,7 @@ func (x *ListHeadlessHostInstancesResponse_Instance) String() string {
 func (*ListHeadlessHostInstancesResponse_Instance) ProtoMessage() {}
 
 func (x *ListHeadlessHostInstancesResponse_Instance) ProtoReflect() protoreflect.Message {
-	mi := &file_hdlctrl_v1_controller_proto_msgTypes[109]
+	mi := &file_hdlctrl_v1_controller_proto_msgTypes[164]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6439,6 +10634,13 @@ func (x *ListHeadlessHostInstancesResponse_Instance) GetIsCurrent() bool {
 	return false
 }
 
+func (x *ListHeadlessHostInstancesResponse_Instance) GetArchived() bool {
+	if x != nil {
+		return x.Archived
+	}
+	return false
+}
+
 type ListHeadlessHostImageTagsResponse_ContainerImage struct {
 	state           protoimpl.MessageState `protogen:"open.v1"`
 	Tag             string                 `protobuf:"bytes,1,opt,name=tag,proto3" json:"tag,omitempty"`
@@ -6451,7 +10653,7 @@ type ListHeadlessHostImageTagsResponse_ContainerImage struct {
 
 func (x *ListHeadlessHostImageTagsResponse_ContainerImage) Reset() {
 	*x = ListHeadlessHostImageTagsResponse_ContainerImage{}
-	mi := &file_hdlctrl_v1_controller_proto_msgTypes[110]
+	mi := &file_hdlctrl_v1_controller_proto_msgTypes[165]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -6463,7 +10665,7 @@ func (x *ListHeadlessHostImageTagsResponse_ContainerImage) String() string {
 func (*ListHeadlessHostImageTagsResponse_ContainerImage) ProtoMessage() {}
 
 func (x *ListHeadlessHostImageTagsResponse_ContainerImage) ProtoReflect() protoreflect.Message {
-	mi := &file_hdlctrl_v1_controller_proto_msgTypes[110]
+	mi := &file_hdlctrl_v1_controller_proto_msgTypes[165]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6476,7 +10678,7 @@ func (x *ListHeadlessHostImageTagsResponse_ContainerImage) ProtoReflect() protor
 
 // Deprecated: Use ListHeadlessHostImageTagsResponse_ContainerImage.ProtoReflect.Descriptor instead.
 func (*ListHeadlessHostImageTagsResponse_ContainerImage) Descriptor() ([]byte, []int) {
-	return file_hdlctrl_v1_controller_proto_rawDescGZIP(), []int{25, 0}
+	return file_hdlctrl_v1_controller_proto_rawDescGZIP(), []int{27, 0}
 }
 
 func (x *ListHeadlessHostImageTagsResponse_ContainerImage) GetTag() string {
@@ -6508,18 +10710,20 @@ func (x *ListHeadlessHostImageTagsResponse_ContainerImage) GetAppVersion() strin
 }
 
 type GetHeadlessHostLogsResponse_Log struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Timestamp     *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
-	IsError       bool                   `protobuf:"varint,2,opt,name=is_error,json=isError,proto3" json:"is_error,omitempty"`
-	Body          string                 `protobuf:"bytes,3,opt,name=body,proto3" json:"body,omitempty"`
-	Id            int64                  `protobuf:"varint,4,opt,name=id,proto3" json:"id,omitempty"`
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	Timestamp *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	IsError   bool                   `protobuf:"varint,2,opt,name=is_error,json=isError,proto3" json:"is_error,omitempty"`
+	Body      string                 `protobuf:"bytes,3,opt,name=body,proto3" json:"body,omitempty"`
+	Id        int64                  `protobuf:"varint,4,opt,name=id,proto3" json:"id,omitempty"`
+	// stream と本文から推定したレベル
+	Level         HeadlessHostLogLevel `protobuf:"varint,5,opt,name=level,proto3,enum=hdlctrl.v1.HeadlessHostLogLevel" json:"level,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *GetHeadlessHostLogsResponse_Log) Reset() {
 	*x = GetHeadlessHostLogsResponse_Log{}
-	mi := &file_hdlctrl_v1_controller_proto_msgTypes[111]
+	mi := &file_hdlctrl_v1_controller_proto_msgTypes[166]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -6531,7 +10735,7 @@ func (x *GetHeadlessHostLogsResponse_Log) String() string {
 func (*GetHeadlessHostLogsResponse_Log) ProtoMessage() {}
 
 func (x *GetHeadlessHostLogsResponse_Log) ProtoReflect() protoreflect.Message {
-	mi := &file_hdlctrl_v1_controller_proto_msgTypes[111]
+	mi := &file_hdlctrl_v1_controller_proto_msgTypes[166]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6544,7 +10748,7 @@ func (x *GetHeadlessHostLogsResponse_Log) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetHeadlessHostLogsResponse_Log.ProtoReflect.Descriptor instead.
 func (*GetHeadlessHostLogsResponse_Log) Descriptor() ([]byte, []int) {
-	return file_hdlctrl_v1_controller_proto_rawDescGZIP(), []int{39, 0}
+	return file_hdlctrl_v1_controller_proto_rawDescGZIP(), []int{41, 0}
 }
 
 func (x *GetHeadlessHostLogsResponse_Log) GetTimestamp() *timestamppb.Timestamp {
@@ -6575,6 +10779,83 @@ func (x *GetHeadlessHostLogsResponse_Log) GetId() int64 {
 	return 0
 }
 
+func (x *GetHeadlessHostLogsResponse_Log) GetLevel() HeadlessHostLogLevel {
+	if x != nil {
+		return x.Level
+	}
+	return HeadlessHostLogLevel_HEADLESS_HOST_LOG_LEVEL_UNKNOWN
+}
+
+// 1 つのホストの 1 インスタンス分の一致
+type SearchHeadlessHostLogsResponse_Group struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	HostId     string                 `protobuf:"bytes,1,opt,name=host_id,json=hostId,proto3" json:"host_id,omitempty"`
+	HostName   string                 `protobuf:"bytes,2,opt,name=host_name,json=hostName,proto3" json:"host_name,omitempty"`
+	InstanceId int32                  `protobuf:"varint,3,opt,name=instance_id,json=instanceId,proto3" json:"instance_id,omitempty"`
+	// 時系列に並んだログ
+	Logs          []*GetHeadlessHostLogsResponse_Log `protobuf:"bytes,4,rep,name=logs,proto3" json:"logs,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchHeadlessHostLogsResponse_Group) Reset() {
+	*x = SearchHeadlessHostLogsResponse_Group{}
+	mi := &file_hdlctrl_v1_controller_proto_msgTypes[167]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchHeadlessHostLogsResponse_Group) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchHeadlessHostLogsResponse_Group) ProtoMessage() {}
+
+func (x *SearchHeadlessHostLogsResponse_Group) ProtoReflect() protoreflect.Message {
+	mi := &file_hdlctrl_v1_controller_proto_msgTypes[167]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchHeadlessHostLogsResponse_Group.ProtoReflect.Descriptor instead.
+func (*SearchHeadlessHostLogsResponse_Group) Descriptor() ([]byte, []int) {
+	return file_hdlctrl_v1_controller_proto_rawDescGZIP(), []int{45, 0}
+}
+
+func (x *SearchHeadlessHostLogsResponse_Group) GetHostId() string {
+	if x != nil {
+		return x.HostId
+	}
+	return ""
+}
+
+func (x *SearchHeadlessHostLogsResponse_Group) GetHostName() string {
+	if x != nil {
+		return x.HostName
+	}
+	return ""
+}
+
+func (x *SearchHeadlessHostLogsResponse_Group) GetInstanceId() int32 {
+	if x != nil {
+		return x.InstanceId
+	}
+	return 0
+}
+
+func (x *SearchHeadlessHostLogsResponse_Group) GetLogs() []*GetHeadlessHostLogsResponse_Log {
+	if x != nil {
+		return x.Logs
+	}
+	return nil
+}
+
 type SearchWorldsResponse_WorldRecord struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
@@ -6590,7 +10871,7 @@ type SearchWorldsResponse_WorldRecord struct {
 
 func (x *SearchWorldsResponse_WorldRecord) Reset() {
 	*x = SearchWorldsResponse_WorldRecord{}
-	mi := &file_hdlctrl_v1_controller_proto_msgTypes[112]
+	mi := &file_hdlctrl_v1_controller_proto_msgTypes[168]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -6602,7 +10883,7 @@ func (x *SearchWorldsResponse_WorldRecord) String() string {
 func (*SearchWorldsResponse_WorldRecord) ProtoMessage() {}
 
 func (x *SearchWorldsResponse_WorldRecord) ProtoReflect() protoreflect.Message {
-	mi := &file_hdlctrl_v1_controller_proto_msgTypes[112]
+	mi := &file_hdlctrl_v1_controller_proto_msgTypes[168]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6615,7 +10896,7 @@ func (x *SearchWorldsResponse_WorldRecord) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SearchWorldsResponse_WorldRecord.ProtoReflect.Descriptor instead.
 func (*SearchWorldsResponse_WorldRecord) Descriptor() ([]byte, []int) {
-	return file_hdlctrl_v1_controller_proto_rawDescGZIP(), []int{49, 0}
+	return file_hdlctrl_v1_controller_proto_rawDescGZIP(), []int{70, 0}
 }
 
 func (x *SearchWorldsResponse_WorldRecord) GetId() string {
@@ -6681,7 +10962,7 @@ type SearchSessionsRequest_SearchParameters struct {
 
 func (x *SearchSessionsRequest_SearchParameters) Reset() {
 	*x = SearchSessionsRequest_SearchParameters{}
-	mi := &file_hdlctrl_v1_controller_proto_msgTypes[113]
+	mi := &file_hdlctrl_v1_controller_proto_msgTypes[169]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -6693,7 +10974,7 @@ func (x *SearchSessionsRequest_SearchParameters) String() string {
 func (*SearchSessionsRequest_SearchParameters) ProtoMessage() {}
 
 func (x *SearchSessionsRequest_SearchParameters) ProtoReflect() protoreflect.Message {
-	mi := &file_hdlctrl_v1_controller_proto_msgTypes[113]
+	mi := &file_hdlctrl_v1_controller_proto_msgTypes[169]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6706,7 +10987,7 @@ func (x *SearchSessionsRequest_SearchParameters) ProtoReflect() protoreflect.Mes
 
 // Deprecated: Use SearchSessionsRequest_SearchParameters.ProtoReflect.Descriptor instead.
 func (*SearchSessionsRequest_SearchParameters) Descriptor() ([]byte, []int) {
-	return file_hdlctrl_v1_controller_proto_rawDescGZIP(), []int{58, 0}
+	return file_hdlctrl_v1_controller_proto_rawDescGZIP(), []int{79, 0}
 }
 
 func (x *SearchSessionsRequest_SearchParameters) GetHostId() string {
@@ -6769,9 +11050,9 @@ const file_hdlctrl_v1_controller_proto_rawDesc = "" +
 	"\ahost_id\x18\x01 \x01(\tR\x06hostId\"\x1c\n" +
 	"\x1aDeleteHeadlessHostResponse\";\n" +
 	" ListHeadlessHostInstancesRequest\x12\x17\n" +
-	"\ahost_id\x18\x01 \x01(\tR\x06hostId\"\xdd\x02\n" +
+	"\ahost_id\x18\x01 \x01(\tR\x06hostId\"\xf9\x02\n" +
 	"!ListHeadlessHostInstancesResponse\x12T\n" +
-	"\tinstances\x18\x01 \x03(\v26.hdlctrl.v1.ListHeadlessHostInstancesResponse.InstanceR\tinstances\x1a\xe1\x01\n" +
+	"\tinstances\x18\x01 \x03(\v26.hdlctrl.v1.ListHeadlessHostInstancesResponse.InstanceR\tinstances\x1a\xfd\x01\n" +
 	"\bInstance\x12\x1f\n" +
 	"\vinstance_id\x18\x01 \x01(\x05R\n" +
 	"instanceId\x12<\n" +
@@ -6780,7 +11061,8 @@ const file_hdlctrl_v1_controller_proto_rawDesc = "" +
 	"\vlast_log_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\tlastLogAt\x12\x1b\n" +
 	"\tlog_count\x18\x04 \x01(\x03R\blogCount\x12\x1d\n" +
 	"\n" +
-	"is_current\x18\x05 \x01(\bR\tisCurrent\"p\n" +
+	"is_current\x18\x05 \x01(\bR\tisCurrent\x12\x1a\n" +
+	"\barchived\x18\x06 \x01(\bR\barchived\"p\n" +
 	"\x16AllowHostAccessRequest\x12\x17\n" +
 	"\ahost_id\x18\x01 \x01(\tR\x06hostId\x12=\n" +
 	"\arequest\x18\x02 \x01(\v2#.headless.v1.AllowHostAccessRequestR\arequest\"\x19\n" +
@@ -6788,7 +11070,7 @@ const file_hdlctrl_v1_controller_proto_rawDesc = "" +
 	"\x15DenyHostAccessRequest\x12\x17\n" +
 	"\ahost_id\x18\x01 \x01(\tR\x06hostId\x12<\n" +
 	"\arequest\x18\x02 \x01(\v2\".headless.v1.DenyHostAccessRequestR\arequest\"\x18\n" +
-	"\x16DenyHostAccessResponse\"\xac\x03\n" +
+	"\x16DenyHostAccessResponse\"\xd5\x06\n" +
 	"\x18StartHeadlessHostRequest\x12\x12\n" +
 	"\x04name\x18\x01 \x01(\tR\x04name\x12.\n" +
 	"\x13headless_account_id\x18\x02 \x01(\tR\x11headlessAccountId\x12 \n" +
@@ -6796,15 +11078,42 @@ const file_hdlctrl_v1_controller_proto_rawDesc = "" +
 	"\x0estartup_config\x18\x04 \x01(\v2\x1a.headless.v1.StartupConfigH\x01R\rstartupConfig\x88\x01\x01\x12[\n" +
 	"\x12auto_update_policy\x18\x05 \x01(\x0e2(.hdlctrl.v1.HeadlessHostAutoUpdatePolicyH\x02R\x10autoUpdatePolicy\x88\x01\x01\x12\x17\n" +
 	"\x04memo\x18\x06 \x01(\tH\x03R\x04memo\x88\x01\x01\x12\x1e\n" +
-	"\bgroup_id\x18\a \x01(\tH\x04R\agroupId\x88\x01\x01B\f\n" +
+	"\bgroup_id\x18\a \x01(\tH\x04R\agroupId\x88\x01\x01\x12\x1c\n" +
+	"\anode_id\x18\b \x01(\tH\x05R\x06nodeId\x88\x01\x01\x12]\n" +
+	"\x12container_settings\x18\t \x01(\v2).hdlctrl.v1.HeadlessHostContainerSettingsH\x06R\x11containerSettings\x88\x01\x01\x12^\n" +
+	"\x13auto_restart_policy\x18\n" +
+	" \x01(\x0e2).hdlctrl.v1.HeadlessHostAutoRestartPolicyH\aR\x11autoRestartPolicy\x88\x01\x01\x12<\n" +
+	"\x18auto_restart_max_retries\x18\v \x01(\x05H\bR\x15autoRestartMaxRetries\x88\x01\x01\x12$\n" +
+	"\vtemplate_id\x18\f \x01(\tH\tR\n" +
+	"templateId\x88\x01\x01B\f\n" +
 	"\n" +
 	"_image_tagB\x11\n" +
 	"\x0f_startup_configB\x15\n" +
 	"\x13_auto_update_policyB\a\n" +
 	"\x05_memoB\v\n" +
-	"\t_group_id\"8\n" +
+	"\t_group_idB\n" +
+	"\n" +
+	"\b_node_idB\x15\n" +
+	"\x13_container_settingsB\x16\n" +
+	"\x14_auto_restart_policyB\x1b\n" +
+	"\x19_auto_restart_max_retriesB\x0e\n" +
+	"\f_template_id\"8\n" +
 	"\x19StartHeadlessHostResponse\x12\x15\n" +
-	"\x06job_id\x18\x02 \x01(\tR\x05jobIdJ\x04\b\x01\x10\x02\"\x8d\x01\n" +
+	"\x06job_id\x18\x02 \x01(\tR\x05jobIdJ\x04\b\x01\x10\x02\"\x89\x02\n" +
+	"\x18CloneHeadlessHostRequest\x12$\n" +
+	"\x0esource_host_id\x18\x01 \x01(\tR\fsourceHostId\x12\x17\n" +
+	"\x04name\x18\x02 \x01(\tH\x00R\x04name\x88\x01\x01\x123\n" +
+	"\x13headless_account_id\x18\x03 \x01(\tH\x01R\x11headlessAccountId\x88\x01\x01\x12 \n" +
+	"\timage_tag\x18\x04 \x01(\tH\x02R\bimageTag\x88\x01\x01\x12\x1c\n" +
+	"\anode_id\x18\x05 \x01(\tH\x03R\x06nodeId\x88\x01\x01B\a\n" +
+	"\x05_nameB\x16\n" +
+	"\x14_headless_account_idB\f\n" +
+	"\n" +
+	"_image_tagB\n" +
+	"\n" +
+	"\b_node_id\"2\n" +
+	"\x19CloneHeadlessHostResponse\x12\x15\n" +
+	"\x06job_id\x18\x01 \x01(\tR\x05jobId\"\x8d\x01\n" +
 	"\x1cCreateHeadlessAccountRequest\x12\x1e\n" +
 	"\n" +
 	"credential\x18\x02 \x01(\tR\n" +
@@ -6847,7 +11156,7 @@ const file_hdlctrl_v1_controller_proto_rawDesc = "" +
 	"\x0f_with_image_tagB\x12\n" +
 	"\x10_timeout_seconds\":\n" +
 	"\x1bRestartHeadlessHostResponse\x12\x15\n" +
-	"\x06job_id\x18\x02 \x01(\tR\x05jobIdJ\x04\b\x01\x10\x02\"\xce\x04\n" +
+	"\x06job_id\x18\x02 \x01(\tR\x05jobIdJ\x04\b\x01\x10\x02\"\x97\a\n" +
 	"!UpdateHeadlessHostSettingsRequest\x12\x17\n" +
 	"\ahost_id\x18\x01 \x01(\tR\x06hostId\x12\x17\n" +
 	"\x04name\x18\x02 \x01(\tH\x00R\x04name\x88\x01\x01\x12 \n" +
@@ -6858,14 +11167,21 @@ const file_hdlctrl_v1_controller_proto_rawDesc = "" +
 	"\x10auto_spawn_items\x18\a \x03(\tR\x0eautoSpawnItems\x12$\n" +
 	"\vuniverse_id\x18\b \x01(\tH\x04R\n" +
 	"universeId\x88\x01\x01\x12[\n" +
-	"\x12auto_update_policy\x18\t \x01(\x0e2(.hdlctrl.v1.HeadlessHostAutoUpdatePolicyH\x05R\x10autoUpdatePolicy\x88\x01\x01B\a\n" +
+	"\x12auto_update_policy\x18\t \x01(\x0e2(.hdlctrl.v1.HeadlessHostAutoUpdatePolicyH\x05R\x10autoUpdatePolicy\x88\x01\x01\x12]\n" +
+	"\x12container_settings\x18\n" +
+	" \x01(\v2).hdlctrl.v1.HeadlessHostContainerSettingsH\x06R\x11containerSettings\x88\x01\x01\x12^\n" +
+	"\x13auto_restart_policy\x18\v \x01(\x0e2).hdlctrl.v1.HeadlessHostAutoRestartPolicyH\aR\x11autoRestartPolicy\x88\x01\x01\x12<\n" +
+	"\x18auto_restart_max_retries\x18\f \x01(\x05H\bR\x15autoRestartMaxRetries\x88\x01\x01B\a\n" +
 	"\x05_nameB\f\n" +
 	"\n" +
 	"_tick_rateB!\n" +
 	"\x1f_max_concurrent_asset_transfersB\x14\n" +
 	"\x12_username_overrideB\x0e\n" +
 	"\f_universe_idB\x15\n" +
-	"\x13_auto_update_policy\"$\n" +
+	"\x13_auto_update_policyB\x15\n" +
+	"\x13_container_settingsB\x16\n" +
+	"\x14_auto_restart_policyB\x1b\n" +
+	"\x19_auto_restart_max_retries\"$\n" +
 	"\"UpdateHeadlessHostSettingsResponse\"6\n" +
 	"\x1bShutdownHeadlessHostRequest\x12\x17\n" +
 	"\ahost_id\x18\x01 \x01(\tR\x06hostId\"5\n" +
@@ -6882,16 +11198,66 @@ const file_hdlctrl_v1_controller_proto_rawDesc = "" +
 	"\tbefore_id\x18\t \x01(\x03H\x00R\bbeforeId\x12\x1b\n" +
 	"\bafter_id\x18\n" +
 	" \x01(\x03H\x00R\aafterIdB\b\n" +
-	"\x06cursorJ\x04\b\x02\x10\x03J\x04\b\x03\x10\x04J\x04\b\x04\x10\x05J\x04\b\a\x10\bJ\x04\b\b\x10\t\"\xac\x02\n" +
+	"\x06cursorJ\x04\b\x02\x10\x03J\x04\b\x03\x10\x04J\x04\b\x04\x10\x05J\x04\b\a\x10\bJ\x04\b\b\x10\t\"\xe5\x02\n" +
 	"\x1bGetHeadlessHostLogsResponse\x12?\n" +
 	"\x04logs\x18\x01 \x03(\v2+.hdlctrl.v1.GetHeadlessHostLogsResponse.LogR\x04logs\x12&\n" +
 	"\x0fhas_more_before\x18\x02 \x01(\bR\rhasMoreBefore\x12$\n" +
-	"\x0ehas_more_after\x18\x03 \x01(\bR\fhasMoreAfter\x1a~\n" +
+	"\x0ehas_more_after\x18\x03 \x01(\bR\fhasMoreAfter\x1a\xb6\x01\n" +
 	"\x03Log\x128\n" +
 	"\ttimestamp\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\ttimestamp\x12\x19\n" +
 	"\bis_error\x18\x02 \x01(\bR\aisError\x12\x12\n" +
 	"\x04body\x18\x03 \x01(\tR\x04body\x12\x0e\n" +
-	"\x02id\x18\x04 \x01(\x03R\x02id\"t\n" +
+	"\x02id\x18\x04 \x01(\x03R\x02id\x126\n" +
+	"\x05level\x18\x05 \x01(\x0e2 .hdlctrl.v1.HeadlessHostLogLevelR\x05level\"\x93\x02\n" +
+	"\x1bTailHeadlessHostLogsRequest\x12\x17\n" +
+	"\ahost_id\x18\x01 \x01(\tR\x06hostId\x12\x1f\n" +
+	"\vinstance_id\x18\x02 \x01(\x05R\n" +
+	"instanceId\x12\x18\n" +
+	"\abacklog\x18\x03 \x01(\x05R\abacklog\x12\x1e\n" +
+	"\bafter_id\x18\x04 \x01(\x03H\x00R\aafterId\x88\x01\x01\x12\x1a\n" +
+	"\bcontains\x18\x05 \x01(\tR\bcontains\x12\x18\n" +
+	"\apattern\x18\x06 \x01(\tR\apattern\x12=\n" +
+	"\tmin_level\x18\a \x01(\x0e2 .hdlctrl.v1.HeadlessHostLogLevelR\bminLevelB\v\n" +
+	"\t_after_id\"\xab\x01\n" +
+	"\x1cTailHeadlessHostLogsResponse\x12?\n" +
+	"\x04logs\x18\x01 \x03(\v2+.hdlctrl.v1.GetHeadlessHostLogsResponse.LogR\x04logs\x12\x1f\n" +
+	"\vinstance_id\x18\x02 \x01(\x05R\n" +
+	"instanceId\x12)\n" +
+	"\x10backlog_complete\x18\x03 \x01(\bR\x0fbacklogComplete\"\xb2\x02\n" +
+	"\x1dSearchHeadlessHostLogsRequest\x12\x14\n" +
+	"\x05query\x18\x01 \x01(\tR\x05query\x12\x1e\n" +
+	"\bgroup_id\x18\x02 \x01(\tH\x00R\agroupId\x88\x01\x01\x12\x19\n" +
+	"\bhost_ids\x18\x03 \x03(\tR\ahostIds\x125\n" +
+	"\x05since\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampH\x01R\x05since\x88\x01\x01\x125\n" +
+	"\x05until\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampH\x02R\x05until\x88\x01\x01\x12\x14\n" +
+	"\x05limit\x18\x06 \x01(\x05R\x05limit\x12\x1b\n" +
+	"\tbefore_id\x18\a \x01(\x03R\bbeforeIdB\v\n" +
+	"\t_group_idB\b\n" +
+	"\x06_sinceB\b\n" +
+	"\x06_until\"\xb2\x02\n" +
+	"\x1eSearchHeadlessHostLogsResponse\x12H\n" +
+	"\x06groups\x18\x01 \x03(\v20.hdlctrl.v1.SearchHeadlessHostLogsResponse.GroupR\x06groups\x12$\n" +
+	"\x0enext_before_id\x18\x02 \x01(\x03R\fnextBeforeId\x1a\x9f\x01\n" +
+	"\x05Group\x12\x17\n" +
+	"\ahost_id\x18\x01 \x01(\tR\x06hostId\x12\x1b\n" +
+	"\thost_name\x18\x02 \x01(\tR\bhostName\x12\x1f\n" +
+	"\vinstance_id\x18\x03 \x01(\x05R\n" +
+	"instanceId\x12?\n" +
+	"\x04logs\x18\x04 \x03(\v2+.hdlctrl.v1.GetHeadlessHostLogsResponse.LogR\x04logs\"\xa4\x02\n" +
+	"%PrepareHeadlessHostLogDownloadRequest\x12\x17\n" +
+	"\ahost_id\x18\x01 \x01(\tR\x06hostId\x12\x1f\n" +
+	"\vinstance_id\x18\x02 \x01(\x05R\n" +
+	"instanceId\x12?\n" +
+	"\x06format\x18\x03 \x01(\x0e2'.hdlctrl.v1.HeadlessHostLogExportFormatR\x06format\x125\n" +
+	"\x05since\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampH\x00R\x05since\x88\x01\x01\x125\n" +
+	"\x05until\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampH\x01R\x05until\x88\x01\x01B\b\n" +
+	"\x06_sinceB\b\n" +
+	"\x06_until\"\x86\x01\n" +
+	"&PrepareHeadlessHostLogDownloadResponse\x12!\n" +
+	"\fdownload_url\x18\x01 \x01(\tR\vdownloadUrl\x12\x1a\n" +
+	"\bfilename\x18\x02 \x01(\tR\bfilename\x12\x1d\n" +
+	"\n" +
+	"line_count\x18\x03 \x01(\x03R\tlineCount\"t\n" +
 	"\x15SearchUserInfoRequest\x12\x17\n" +
 	"\ahost_id\x18\x01 \x01(\tR\x06hostId\x12B\n" +
 	"\n" +
@@ -6915,7 +11281,90 @@ const file_hdlctrl_v1_controller_proto_rawDesc = "" +
 	"#IssueResoniteLinkConnectionResponse\x12\x17\n" +
 	"\aws_path\x18\x01 \x01(\tR\x06wsPath\x129\n" +
 	"\n" +
-	"expires_at\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\texpiresAt\"B\n" +
+	"expires_at\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\texpiresAt\"\xcb\x01\n" +
+	"\x10SessionUserEvent\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x124\n" +
+	"\x04kind\x18\x02 \x01(\x0e2 .hdlctrl.v1.SessionUserEventKindR\x04kind\x12\x17\n" +
+	"\auser_id\x18\x03 \x01(\tR\x06userId\x12\x1b\n" +
+	"\tuser_name\x18\x04 \x01(\tR\buserName\x12;\n" +
+	"\voccurred_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"occurredAt\"n\n" +
+	"\x1cListSessionUserEventsRequest\x12\x1d\n" +
+	"\n" +
+	"session_id\x18\x01 \x01(\tR\tsessionId\x12\x19\n" +
+	"\bafter_id\x18\x02 \x01(\x03R\aafterId\x12\x14\n" +
+	"\x05limit\x18\x03 \x01(\x05R\x05limit\"y\n" +
+	"\x1dListSessionUserEventsResponse\x124\n" +
+	"\x06events\x18\x01 \x03(\v2\x1c.hdlctrl.v1.SessionUserEventR\x06events\x12\"\n" +
+	"\rnext_after_id\x18\x02 \x01(\x03R\vnextAfterId\"\xc3\x02\n" +
+	"\x0fSessionAttendee\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x1b\n" +
+	"\tuser_name\x18\x02 \x01(\tR\buserName\x12B\n" +
+	"\x0ffirst_joined_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\rfirstJoinedAt\x12A\n" +
+	"\flast_left_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampH\x00R\n" +
+	"lastLeftAt\x88\x01\x01\x12)\n" +
+	"\x10duration_seconds\x18\x05 \x01(\x03R\x0fdurationSeconds\x12\x1d\n" +
+	"\n" +
+	"join_count\x18\x06 \x01(\x05R\tjoinCount\x12\x18\n" +
+	"\apresent\x18\a \x01(\bR\apresentB\x0f\n" +
+	"\r_last_left_at\"<\n" +
+	"\x1bGetSessionAttendanceRequest\x12\x1d\n" +
+	"\n" +
+	"session_id\x18\x01 \x01(\tR\tsessionId\"\x97\x02\n" +
+	"\x1cGetSessionAttendanceResponse\x129\n" +
+	"\tattendees\x18\x01 \x03(\v2\x1b.hdlctrl.v1.SessionAttendeeR\tattendees\x12!\n" +
+	"\funique_users\x18\x02 \x01(\x05R\vuniqueUsers\x12\x1d\n" +
+	"\n" +
+	"peak_users\x18\x03 \x01(\x05R\tpeakUsers\x128\n" +
+	"\apeak_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampH\x00R\x06peakAt\x88\x01\x01\x124\n" +
+	"\x16total_duration_seconds\x18\x05 \x01(\x03R\x14totalDurationSecondsB\n" +
+	"\n" +
+	"\b_peak_at\"\xef\x02\n" +
+	"\x1bGetSessionUsageStatsRequest\x12\x1e\n" +
+	"\bgroup_id\x18\x01 \x01(\tH\x00R\agroupId\x88\x01\x01\x12\x1c\n" +
+	"\ahost_id\x18\x02 \x01(\tH\x01R\x06hostId\x88\x01\x01\x120\n" +
+	"\x05since\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\x05since\x120\n" +
+	"\x05until\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\x05until\x12:\n" +
+	"\bgroup_by\x18\x05 \x01(\x0e2\x1f.hdlctrl.v1.SessionUsageGroupByR\agroupBy\x12<\n" +
+	"\binterval\x18\x06 \x01(\x0e2 .hdlctrl.v1.SessionUsageIntervalR\binterval\x12\x1b\n" +
+	"\ttime_zone\x18\a \x01(\tR\btimeZoneB\v\n" +
+	"\t_group_idB\n" +
+	"\n" +
+	"\b_host_id\"\xd8\x01\n" +
+	"\x12SessionUsageBucket\x120\n" +
+	"\x05start\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\x05start\x12\x1a\n" +
+	"\bsessions\x18\x02 \x01(\x05R\bsessions\x12!\n" +
+	"\funique_users\x18\x03 \x01(\x05R\vuniqueUsers\x12\x1d\n" +
+	"\n" +
+	"peak_users\x18\x04 \x01(\x05R\tpeakUsers\x122\n" +
+	"\x15user_duration_seconds\x18\x05 \x01(\x03R\x13userDurationSeconds\"\xcd\x01\n" +
+	"\x12SessionUsageSeries\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05label\x18\x02 \x01(\tR\x05label\x128\n" +
+	"\abuckets\x18\x03 \x03(\v2\x1e.hdlctrl.v1.SessionUsageBucketR\abuckets\x12!\n" +
+	"\funique_users\x18\x04 \x01(\x05R\vuniqueUsers\x122\n" +
+	"\x15user_duration_seconds\x18\x05 \x01(\x03R\x13userDurationSeconds\"V\n" +
+	"\x1cGetSessionUsageStatsResponse\x126\n" +
+	"\x06series\x18\x01 \x03(\v2\x1e.hdlctrl.v1.SessionUsageSeriesR\x06series\"\x84\x02\n" +
+	"\x17GetMetricsSeriesRequest\x12*\n" +
+	"\x04kind\x18\x01 \x01(\x0e2\x16.hdlctrl.v1.MetricKindR\x04kind\x12\x1b\n" +
+	"\ttarget_id\x18\x02 \x01(\tR\btargetId\x120\n" +
+	"\x05since\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\x05since\x120\n" +
+	"\x05until\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\x05until\x12<\n" +
+	"\n" +
+	"resolution\x18\x05 \x01(\x0e2\x1c.hdlctrl.v1.MetricResolutionR\n" +
+	"resolution\"\x92\x01\n" +
+	"\vMetricPoint\x12*\n" +
+	"\x02at\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\x02at\x12\x10\n" +
+	"\x03avg\x18\x02 \x01(\x01R\x03avg\x12\x10\n" +
+	"\x03min\x18\x03 \x01(\x01R\x03min\x12\x10\n" +
+	"\x03max\x18\x04 \x01(\x01R\x03max\x12!\n" +
+	"\fsample_count\x18\x05 \x01(\x05R\vsampleCount\"\x89\x01\n" +
+	"\x18GetMetricsSeriesResponse\x12<\n" +
+	"\n" +
+	"resolution\x18\x01 \x01(\x0e2\x1c.hdlctrl.v1.MetricResolutionR\n" +
+	"resolution\x12/\n" +
+	"\x06points\x18\x02 \x03(\v2\x17.hdlctrl.v1.MetricPointR\x06points\"B\n" +
 	"\x15FetchWorldInfoRequest\x12\x17\n" +
 	"\ahost_id\x18\x01 \x01(\tR\x06hostId\x12\x10\n" +
 	"\x03url\x18\x02 \x01(\tR\x03url\"o\n" +
@@ -6981,15 +11430,19 @@ const file_hdlctrl_v1_controller_proto_rawDesc = "" +
 	"\n" +
 	"session_id\x18\x02 \x01(\tR\tsessionId\"J\n" +
 	"\x19GetSessionDetailsResponse\x12-\n" +
-	"\asession\x18\x01 \x01(\v2\x13.hdlctrl.v1.SessionR\asession\"\xb2\x01\n" +
+	"\asession\x18\x01 \x01(\v2\x13.hdlctrl.v1.SessionR\asession\"\x91\x02\n" +
 	"\x11StartWorldRequest\x12\x17\n" +
 	"\ahost_id\x18\x01 \x01(\tR\x06hostId\x12C\n" +
 	"\n" +
 	"parameters\x18\x02 \x01(\v2#.headless.v1.WorldStartupParametersR\n" +
 	"parameters\x12\x12\n" +
 	"\x04memo\x18\x03 \x01(\tR\x04memo\x12\x1e\n" +
-	"\bgroup_id\x18\x04 \x01(\tH\x00R\agroupId\x88\x01\x01B\v\n" +
-	"\t_group_id\"1\n" +
+	"\bgroup_id\x18\x04 \x01(\tH\x00R\agroupId\x88\x01\x01\x12$\n" +
+	"\vtemplate_id\x18\x05 \x01(\tH\x01R\n" +
+	"templateId\x88\x01\x01\x12'\n" +
+	"\x0foverride_fields\x18\x06 \x03(\tR\x0eoverrideFieldsB\v\n" +
+	"\t_group_idB\x0e\n" +
+	"\f_template_id\"1\n" +
 	"\x12StartWorldResponse\x12\x15\n" +
 	"\x06job_id\x18\x02 \x01(\tR\x05jobIdJ\x04\b\x01\x10\x02\"P\n" +
 	"\x12StopSessionRequest\x12\x1b\n" +
@@ -7042,21 +11495,72 @@ const file_hdlctrl_v1_controller_proto_rawDesc = "" +
 	"\n" +
 	"parameters\x18\x02 \x01(\v2+.headless.v1.UpdateSessionParametersRequestR\n" +
 	"parameters\"!\n" +
-	"\x1fUpdateSessionParametersResponse\"\x9d\x01\n" +
+	"\x1fUpdateSessionParametersResponse\"\xe1\x01\n" +
 	"!UpdateSessionExtraSettingsRequest\x12\x1d\n" +
 	"\n" +
 	"session_id\x18\x01 \x01(\tR\tsessionId\x12&\n" +
 	"\fauto_upgrade\x18\x02 \x01(\bH\x00R\vautoUpgrade\x88\x01\x01\x12\x17\n" +
-	"\x04memo\x18\x03 \x01(\tH\x01R\x04memo\x88\x01\x01B\x0f\n" +
+	"\x04memo\x18\x03 \x01(\tH\x01R\x04memo\x88\x01\x01\x12-\n" +
+	"\x10restore_on_crash\x18\x04 \x01(\bH\x02R\x0erestoreOnCrash\x88\x01\x01B\x0f\n" +
 	"\r_auto_upgradeB\a\n" +
-	"\x05_memo\"$\n" +
+	"\x05_memoB\x13\n" +
+	"\x11_restore_on_crash\"$\n" +
 	"\"UpdateSessionExtraSettingsResponse\"S\n" +
 	"\x19ListUsersInSessionRequest\x12\x17\n" +
 	"\ahost_id\x18\x01 \x01(\tR\x06hostId\x12\x1d\n" +
 	"\n" +
 	"session_id\x18\x02 \x01(\tR\tsessionId\"N\n" +
 	"\x1aListUsersInSessionResponse\x120\n" +
-	"\x05users\x18\x01 \x03(\v2\x1a.headless.v1.UserInSessionR\x05users\"I\n" +
+	"\x05users\x18\x01 \x03(\v2\x1a.headless.v1.UserInSessionR\x05users\"\xe0\x02\n" +
+	"\x0fSessionTemplate\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x19\n" +
+	"\bgroup_id\x18\x02 \x01(\tR\agroupId\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x12 \n" +
+	"\vdescription\x18\x04 \x01(\tR\vdescription\x12C\n" +
+	"\n" +
+	"parameters\x18\x05 \x01(\v2#.headless.v1.WorldStartupParametersR\n" +
+	"parameters\x12\"\n" +
+	"\n" +
+	"created_by\x18\x06 \x01(\tH\x00R\tcreatedBy\x88\x01\x01\x129\n" +
+	"\n" +
+	"created_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAtB\r\n" +
+	"\v_created_by\"8\n" +
+	"\x1bListSessionTemplatesRequest\x12\x19\n" +
+	"\bgroup_id\x18\x01 \x01(\tR\agroupId\"Y\n" +
+	"\x1cListSessionTemplatesResponse\x129\n" +
+	"\ttemplates\x18\x01 \x03(\v2\x1b.hdlctrl.v1.SessionTemplateR\ttemplates\"<\n" +
+	"\x19GetSessionTemplateRequest\x12\x1f\n" +
+	"\vtemplate_id\x18\x01 \x01(\tR\n" +
+	"templateId\"U\n" +
+	"\x1aGetSessionTemplateResponse\x127\n" +
+	"\btemplate\x18\x01 \x01(\v2\x1b.hdlctrl.v1.SessionTemplateR\btemplate\"\xb4\x01\n" +
+	"\x1cCreateSessionTemplateRequest\x12\x19\n" +
+	"\bgroup_id\x18\x01 \x01(\tR\agroupId\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12 \n" +
+	"\vdescription\x18\x03 \x01(\tR\vdescription\x12C\n" +
+	"\n" +
+	"parameters\x18\x04 \x01(\v2#.headless.v1.WorldStartupParametersR\n" +
+	"parameters\"X\n" +
+	"\x1dCreateSessionTemplateResponse\x127\n" +
+	"\btemplate\x18\x01 \x01(\v2\x1b.hdlctrl.v1.SessionTemplateR\btemplate\"\xdd\x01\n" +
+	"\x1cUpdateSessionTemplateRequest\x12\x1f\n" +
+	"\vtemplate_id\x18\x01 \x01(\tR\n" +
+	"templateId\x12\x17\n" +
+	"\x04name\x18\x02 \x01(\tH\x00R\x04name\x88\x01\x01\x12%\n" +
+	"\vdescription\x18\x03 \x01(\tH\x01R\vdescription\x88\x01\x01\x12C\n" +
+	"\n" +
+	"parameters\x18\x04 \x01(\v2#.headless.v1.WorldStartupParametersR\n" +
+	"parametersB\a\n" +
+	"\x05_nameB\x0e\n" +
+	"\f_description\"X\n" +
+	"\x1dUpdateSessionTemplateResponse\x127\n" +
+	"\btemplate\x18\x01 \x01(\v2\x1b.hdlctrl.v1.SessionTemplateR\btemplate\"?\n" +
+	"\x1cDeleteSessionTemplateRequest\x12\x1f\n" +
+	"\vtemplate_id\x18\x01 \x01(\tR\n" +
+	"templateId\"\x1f\n" +
+	"\x1dDeleteSessionTemplateResponse\"I\n" +
 	"\vPageRequest\x12\x1d\n" +
 	"\n" +
 	"page_index\x18\x01 \x01(\x05R\tpageIndex\x12\x1b\n" +
@@ -7066,7 +11570,93 @@ const file_hdlctrl_v1_controller_proto_rawDesc = "" +
 	"totalCount\x12\x1d\n" +
 	"\n" +
 	"page_index\x18\x02 \x01(\x05R\tpageIndex\x12\x1b\n" +
-	"\tpage_size\x18\x03 \x01(\x05R\bpageSize\"\xed\x02\n" +
+	"\tpage_size\x18\x03 \x01(\x05R\bpageSize\"d\n" +
+	"\x15HeadlessHostBindMount\x12\x16\n" +
+	"\x06source\x18\x01 \x01(\tR\x06source\x12\x16\n" +
+	"\x06target\x18\x02 \x01(\tR\x06target\x12\x1b\n" +
+	"\tread_only\x18\x03 \x01(\bR\breadOnly\"\xd2\x05\n" +
+	"\fHostTemplate\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x19\n" +
+	"\bgroup_id\x18\x02 \x01(\tR\agroupId\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x12 \n" +
+	"\vdescription\x18\x04 \x01(\tR\vdescription\x12\x1b\n" +
+	"\timage_tag\x18\x05 \x01(\tR\bimageTag\x12A\n" +
+	"\x0estartup_config\x18\x06 \x01(\v2\x1a.headless.v1.StartupConfigR\rstartupConfig\x12V\n" +
+	"\x12auto_update_policy\x18\a \x01(\x0e2(.hdlctrl.v1.HeadlessHostAutoUpdatePolicyR\x10autoUpdatePolicy\x12\x12\n" +
+	"\x04memo\x18\b \x01(\tR\x04memo\x12X\n" +
+	"\x12container_settings\x18\t \x01(\v2).hdlctrl.v1.HeadlessHostContainerSettingsR\x11containerSettings\x12Y\n" +
+	"\x13auto_restart_policy\x18\n" +
+	" \x01(\x0e2).hdlctrl.v1.HeadlessHostAutoRestartPolicyR\x11autoRestartPolicy\x127\n" +
+	"\x18auto_restart_max_retries\x18\v \x01(\x05R\x15autoRestartMaxRetries\x12\"\n" +
+	"\n" +
+	"created_by\x18\f \x01(\tH\x00R\tcreatedBy\x88\x01\x01\x129\n" +
+	"\n" +
+	"created_at\x18\r \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\x0e \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAtB\r\n" +
+	"\v_created_by\"5\n" +
+	"\x18ListHostTemplatesRequest\x12\x19\n" +
+	"\bgroup_id\x18\x01 \x01(\tR\agroupId\"S\n" +
+	"\x19ListHostTemplatesResponse\x126\n" +
+	"\ttemplates\x18\x01 \x03(\v2\x18.hdlctrl.v1.HostTemplateR\ttemplates\"9\n" +
+	"\x16GetHostTemplateRequest\x12\x1f\n" +
+	"\vtemplate_id\x18\x01 \x01(\tR\n" +
+	"templateId\"O\n" +
+	"\x17GetHostTemplateResponse\x124\n" +
+	"\btemplate\x18\x01 \x01(\v2\x18.hdlctrl.v1.HostTemplateR\btemplate\"\xa6\x04\n" +
+	"\x19CreateHostTemplateRequest\x12\x19\n" +
+	"\bgroup_id\x18\x01 \x01(\tR\agroupId\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12 \n" +
+	"\vdescription\x18\x03 \x01(\tR\vdescription\x12\x1b\n" +
+	"\timage_tag\x18\x04 \x01(\tR\bimageTag\x12A\n" +
+	"\x0estartup_config\x18\x05 \x01(\v2\x1a.headless.v1.StartupConfigR\rstartupConfig\x12V\n" +
+	"\x12auto_update_policy\x18\x06 \x01(\x0e2(.hdlctrl.v1.HeadlessHostAutoUpdatePolicyR\x10autoUpdatePolicy\x12\x12\n" +
+	"\x04memo\x18\a \x01(\tR\x04memo\x12X\n" +
+	"\x12container_settings\x18\b \x01(\v2).hdlctrl.v1.HeadlessHostContainerSettingsR\x11containerSettings\x12Y\n" +
+	"\x13auto_restart_policy\x18\t \x01(\x0e2).hdlctrl.v1.HeadlessHostAutoRestartPolicyR\x11autoRestartPolicy\x127\n" +
+	"\x18auto_restart_max_retries\x18\n" +
+	" \x01(\x05R\x15autoRestartMaxRetries\"R\n" +
+	"\x1aCreateHostTemplateResponse\x124\n" +
+	"\btemplate\x18\x01 \x01(\v2\x18.hdlctrl.v1.HostTemplateR\btemplate\"\xff\x05\n" +
+	"\x19UpdateHostTemplateRequest\x12\x1f\n" +
+	"\vtemplate_id\x18\x01 \x01(\tR\n" +
+	"templateId\x12\x17\n" +
+	"\x04name\x18\x02 \x01(\tH\x00R\x04name\x88\x01\x01\x12%\n" +
+	"\vdescription\x18\x03 \x01(\tH\x01R\vdescription\x88\x01\x01\x12 \n" +
+	"\timage_tag\x18\x04 \x01(\tH\x02R\bimageTag\x88\x01\x01\x12F\n" +
+	"\x0estartup_config\x18\x05 \x01(\v2\x1a.headless.v1.StartupConfigH\x03R\rstartupConfig\x88\x01\x01\x12[\n" +
+	"\x12auto_update_policy\x18\x06 \x01(\x0e2(.hdlctrl.v1.HeadlessHostAutoUpdatePolicyH\x04R\x10autoUpdatePolicy\x88\x01\x01\x12\x17\n" +
+	"\x04memo\x18\a \x01(\tH\x05R\x04memo\x88\x01\x01\x12]\n" +
+	"\x12container_settings\x18\b \x01(\v2).hdlctrl.v1.HeadlessHostContainerSettingsH\x06R\x11containerSettings\x88\x01\x01\x12^\n" +
+	"\x13auto_restart_policy\x18\t \x01(\x0e2).hdlctrl.v1.HeadlessHostAutoRestartPolicyH\aR\x11autoRestartPolicy\x88\x01\x01\x12<\n" +
+	"\x18auto_restart_max_retries\x18\n" +
+	" \x01(\x05H\bR\x15autoRestartMaxRetries\x88\x01\x01B\a\n" +
+	"\x05_nameB\x0e\n" +
+	"\f_descriptionB\f\n" +
+	"\n" +
+	"_image_tagB\x11\n" +
+	"\x0f_startup_configB\x15\n" +
+	"\x13_auto_update_policyB\a\n" +
+	"\x05_memoB\x15\n" +
+	"\x13_container_settingsB\x16\n" +
+	"\x14_auto_restart_policyB\x1b\n" +
+	"\x19_auto_restart_max_retries\"R\n" +
+	"\x1aUpdateHostTemplateResponse\x124\n" +
+	"\btemplate\x18\x01 \x01(\v2\x18.hdlctrl.v1.HostTemplateR\btemplate\"<\n" +
+	"\x19DeleteHostTemplateRequest\x12\x1f\n" +
+	"\vtemplate_id\x18\x01 \x01(\tR\n" +
+	"templateId\"\x1c\n" +
+	"\x1aDeleteHostTemplateResponse\"\xe5\x02\n" +
+	"\x1dHeadlessHostContainerSettings\x12\x12\n" +
+	"\x04cpus\x18\x01 \x01(\x01R\x04cpus\x12!\n" +
+	"\fmemory_bytes\x18\x02 \x01(\x03R\vmemoryBytes\x12*\n" +
+	"\x11memory_swap_bytes\x18\x03 \x01(\x03R\x0fmemorySwapBytes\x12\x1f\n" +
+	"\vcpuset_cpus\x18\x04 \x01(\tR\n" +
+	"cpusetCpus\x12L\n" +
+	"\x0erestart_policy\x18\x05 \x01(\x0e2%.hdlctrl.v1.HeadlessHostRestartPolicyR\rrestartPolicy\x12.\n" +
+	"\x13restart_max_retries\x18\x06 \x01(\x05R\x11restartMaxRetries\x12B\n" +
+	"\vbind_mounts\x18\a \x03(\v2!.hdlctrl.v1.HeadlessHostBindMountR\n" +
+	"bindMounts\"\xed\x02\n" +
 	"\x14HeadlessHostSettings\x12$\n" +
 	"\vuniverse_id\x18\x01 \x01(\tH\x00R\n" +
 	"universeId\x88\x01\x01\x12\x1b\n" +
@@ -7076,7 +11666,7 @@ const file_hdlctrl_v1_controller_proto_rawDesc = "" +
 	"\x11allowed_url_hosts\x18\x05 \x03(\v2\x1f.headless.v1.AllowedAccessEntryR\x0fallowedUrlHosts\x12(\n" +
 	"\x10auto_spawn_items\x18\x06 \x03(\tR\x0eautoSpawnItemsB\x0e\n" +
 	"\f_universe_idB\x14\n" +
-	"\x12_username_override\"\xb8\x04\n" +
+	"\x12_username_override\"\xf3\a\n" +
 	"\fHeadlessHost\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
 	"\x04name\x18\x02 \x01(\tR\x04name\x12)\n" +
@@ -7096,9 +11686,18 @@ const file_hdlctrl_v1_controller_proto_rawDesc = "" +
 	"instanceId\x12\x19\n" +
 	"\bgroup_id\x18\x10 \x01(\tR\agroupId\x12\"\n" +
 	"\n" +
-	"created_by\x18\x11 \x01(\tH\x00R\tcreatedBy\x88\x01\x01B\r\n" +
-	"\v_created_byJ\x04\b\b\x10\tJ\x04\b\t\x10\n" +
-	"\"\xd9\x04\n" +
+	"created_by\x18\x11 \x01(\tH\x00R\tcreatedBy\x88\x01\x01\x12\x17\n" +
+	"\anode_id\x18\x12 \x01(\tR\x06nodeId\x12X\n" +
+	"\x12container_settings\x18\x13 \x01(\v2).hdlctrl.v1.HeadlessHostContainerSettingsR\x11containerSettings\x12Y\n" +
+	"\x13auto_restart_policy\x18\x14 \x01(\x0e2).hdlctrl.v1.HeadlessHostAutoRestartPolicyR\x11autoRestartPolicy\x127\n" +
+	"\x18auto_restart_max_retries\x18\x15 \x01(\x05R\x15autoRestartMaxRetries\x12\x1f\n" +
+	"\vcrash_count\x18\x16 \x01(\x05R\n" +
+	"crashCount\x12G\n" +
+	"\x0flast_crashed_at\x18\x17 \x01(\v2\x1a.google.protobuf.TimestampH\x01R\rlastCrashedAt\x88\x01\x01\x124\n" +
+	"\x16auto_restart_suspended\x18\x18 \x01(\bR\x14autoRestartSuspendedB\r\n" +
+	"\v_created_byB\x12\n" +
+	"\x10_last_crashed_atJ\x04\b\b\x10\tJ\x04\b\t\x10\n" +
+	"\"\x83\x05\n" +
 	"\aSession\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
 	"\x04name\x18\x02 \x01(\tR\x04name\x12\x17\n" +
@@ -7115,7 +11714,8 @@ const file_hdlctrl_v1_controller_proto_rawDesc = "" +
 	"\x04memo\x18\v \x01(\tR\x04memo\x12\x19\n" +
 	"\bgroup_id\x18\f \x01(\tR\agroupId\x12\"\n" +
 	"\n" +
-	"created_by\x18\r \x01(\tH\x03R\tcreatedBy\x88\x01\x01B\v\n" +
+	"created_by\x18\r \x01(\tH\x03R\tcreatedBy\x88\x01\x01\x12(\n" +
+	"\x10restore_on_crash\x18\x0e \x01(\bR\x0erestoreOnCrashB\v\n" +
 	"\t_ended_atB\x10\n" +
 	"\x0e_current_stateB\v\n" +
 	"\t_owner_idB\r\n" +
@@ -7181,10 +11781,11 @@ const file_hdlctrl_v1_controller_proto_rawDesc = "" +
 	"\fstop_session\x18\x02 \x01(\v2\x1e.hdlctrl.v1.StopSessionRequestH\x00R\vstopSession\x12Y\n" +
 	"\x11update_parameters\x18\x03 \x01(\v2*.hdlctrl.v1.UpdateSessionParametersRequestH\x00R\x10updateParameters\x12c\n" +
 	"\x15update_extra_settings\x18\x04 \x01(\v2-.hdlctrl.v1.UpdateSessionExtraSettingsRequestH\x00R\x13updateExtraSettingsB\v\n" +
-	"\toperation\"\xa1\x01\n" +
+	"\toperation\"\xd0\x01\n" +
 	"\x10ScheduledTrigger\x12-\n" +
 	"\x04time\x18\x01 \x01(\v2\x17.hdlctrl.v1.TimeTriggerH\x00R\x04time\x12S\n" +
-	"\x12session_user_count\x18\x02 \x01(\v2#.hdlctrl.v1.SessionUserCountTriggerH\x00R\x10sessionUserCountB\t\n" +
+	"\x12session_user_count\x18\x02 \x01(\v2#.hdlctrl.v1.SessionUserCountTriggerH\x00R\x10sessionUserCount\x12-\n" +
+	"\x04cron\x18\x03 \x01(\v2\x17.hdlctrl.v1.CronTriggerH\x00R\x04cronB\t\n" +
 	"\atrigger\"L\n" +
 	"\vTimeTrigger\x12=\n" +
 	"\fscheduled_at\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\vscheduledAt\"\x8f\x02\n" +
@@ -7199,7 +11800,12 @@ const file_hdlctrl_v1_controller_proto_rawDesc = "" +
 	"Comparator\x12\x1a\n" +
 	"\x16COMPARATOR_UNSPECIFIED\x10\x00\x12\x1c\n" +
 	"\x18COMPARATOR_LESS_OR_EQUAL\x10\x01\x12\x1f\n" +
-	"\x1bCOMPARATOR_GREATER_OR_EQUAL\x10\x02\"\xa8\x05\n" +
+	"\x1bCOMPARATOR_GREATER_OR_EQUAL\x10\x02\"I\n" +
+	"\vCronTrigger\x12\x1e\n" +
+	"\n" +
+	"expression\x18\x01 \x01(\tR\n" +
+	"expression\x12\x1a\n" +
+	"\btimezone\x18\x02 \x01(\tR\btimezone\"\xa8\x05\n" +
 	"\x19ScheduledSessionOperation\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12<\n" +
 	"\toperation\x18\x02 \x01(\v2\x1e.hdlctrl.v1.ScheduledOperationR\toperation\x126\n" +
@@ -7249,14 +11855,80 @@ const file_hdlctrl_v1_controller_proto_rawDesc = "" +
 	"\x04page\x18\x02 \x01(\v2\x18.hdlctrl.v1.PageResponseR\x04page\"8\n" +
 	"&CancelScheduledSessionOperationRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\")\n" +
-	"'CancelScheduledSessionOperationResponse*\xe1\x01\n" +
+	"'CancelScheduledSessionOperationResponse\"7\n" +
+	"%PauseScheduledSessionOperationRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"\x80\x01\n" +
+	"&PauseScheduledSessionOperationResponse\x12V\n" +
+	"\x13scheduled_operation\x18\x01 \x01(\v2%.hdlctrl.v1.ScheduledSessionOperationR\x12scheduledOperation\"8\n" +
+	"&ResumeScheduledSessionOperationRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"\x81\x01\n" +
+	"'ResumeScheduledSessionOperationResponse\x12V\n" +
+	"\x13scheduled_operation\x18\x01 \x01(\v2%.hdlctrl.v1.ScheduledSessionOperationR\x12scheduledOperation\"@\n" +
+	".SkipScheduledSessionOperationOccurrenceRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"\x89\x01\n" +
+	"/SkipScheduledSessionOperationOccurrenceResponse\x12V\n" +
+	"\x13scheduled_operation\x18\x01 \x01(\v2%.hdlctrl.v1.ScheduledSessionOperationR\x12scheduledOperation\"\x93\x03\n" +
+	"\x15ScheduledOperationRun\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12?\n" +
+	"\rscheduled_for\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\fscheduledFor\x12>\n" +
+	"\n" +
+	"started_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampH\x00R\tstartedAt\x88\x01\x01\x12;\n" +
+	"\vfinished_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"finishedAt\x12B\n" +
+	"\aoutcome\x18\x05 \x01(\x0e2(.hdlctrl.v1.ScheduledOperationRunOutcomeR\aoutcome\x12\x19\n" +
+	"\x05error\x18\x06 \x01(\tH\x01R\x05error\x88\x01\x01\x12$\n" +
+	"\vinstance_id\x18\a \x01(\tH\x02R\n" +
+	"instanceId\x88\x01\x01B\r\n" +
+	"\v_started_atB\b\n" +
+	"\x06_errorB\x0e\n" +
+	"\f_instance_id\"g\n" +
+	"(ListScheduledSessionOperationRunsRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12+\n" +
+	"\x04page\x18\x02 \x01(\v2\x17.hdlctrl.v1.PageRequestR\x04page\"\x90\x01\n" +
+	")ListScheduledSessionOperationRunsResponse\x125\n" +
+	"\x04runs\x18\x01 \x03(\v2!.hdlctrl.v1.ScheduledOperationRunR\x04runs\x12,\n" +
+	"\x04page\x18\x02 \x01(\v2\x18.hdlctrl.v1.PageResponseR\x04page*\x85\x01\n" +
+	"\x14SessionUserEventKind\x12'\n" +
+	"#SESSION_USER_EVENT_KIND_UNSPECIFIED\x10\x00\x12\"\n" +
+	"\x1eSESSION_USER_EVENT_KIND_JOINED\x10\x01\x12 \n" +
+	"\x1cSESSION_USER_EVENT_KIND_LEFT\x10\x02*\x80\x01\n" +
+	"\x13SessionUsageGroupBy\x12&\n" +
+	"\"SESSION_USAGE_GROUP_BY_UNSPECIFIED\x10\x00\x12 \n" +
+	"\x1cSESSION_USAGE_GROUP_BY_WORLD\x10\x01\x12\x1f\n" +
+	"\x1bSESSION_USAGE_GROUP_BY_HOST\x10\x02*\xa0\x01\n" +
+	"\x14SessionUsageInterval\x12&\n" +
+	"\"SESSION_USAGE_INTERVAL_UNSPECIFIED\x10\x00\x12\x1f\n" +
+	"\x1bSESSION_USAGE_INTERVAL_HOUR\x10\x01\x12\x1e\n" +
+	"\x1aSESSION_USAGE_INTERVAL_DAY\x10\x02\x12\x1f\n" +
+	"\x1bSESSION_USAGE_INTERVAL_WEEK\x10\x03*\x9c\x01\n" +
+	"\n" +
+	"MetricKind\x12\x1b\n" +
+	"\x17METRIC_KIND_UNSPECIFIED\x10\x00\x12\x18\n" +
+	"\x14METRIC_KIND_HOST_FPS\x10\x01\x12\x1c\n" +
+	"\x18METRIC_KIND_HOST_RUNNING\x10\x02\x12\x1a\n" +
+	"\x16METRIC_KIND_HOST_USERS\x10\x03\x12\x1d\n" +
+	"\x19METRIC_KIND_SESSION_USERS\x10\x04*\x8a\x01\n" +
+	"\x10MetricResolution\x12!\n" +
+	"\x1dMETRIC_RESOLUTION_UNSPECIFIED\x10\x00\x12\x19\n" +
+	"\x15METRIC_RESOLUTION_RAW\x10\x01\x12\x1c\n" +
+	"\x18METRIC_RESOLUTION_MINUTE\x10\x02\x12\x1a\n" +
+	"\x16METRIC_RESOLUTION_HOUR\x10\x03*\xe1\x01\n" +
 	"\x12HeadlessHostStatus\x12 \n" +
 	"\x1cHEADLESS_HOST_STATUS_UNKNOWN\x10\x00\x12!\n" +
 	"\x1dHEADLESS_HOST_STATUS_STARTING\x10\x01\x12 \n" +
 	"\x1cHEADLESS_HOST_STATUS_RUNNING\x10\x02\x12!\n" +
 	"\x1dHEADLESS_HOST_STATUS_STOPPING\x10\x03\x12\x1f\n" +
 	"\x1bHEADLESS_HOST_STATUS_EXITED\x10\x04\x12 \n" +
-	"\x1cHEADLESS_HOST_STATUS_CRASHED\x10\x05*\x9a\x01\n" +
+	"\x1cHEADLESS_HOST_STATUS_CRASHED\x10\x05*\xa5\x01\n" +
+	"\x14HeadlessHostLogLevel\x12#\n" +
+	"\x1fHEADLESS_HOST_LOG_LEVEL_UNKNOWN\x10\x00\x12 \n" +
+	"\x1cHEADLESS_HOST_LOG_LEVEL_INFO\x10\x01\x12#\n" +
+	"\x1fHEADLESS_HOST_LOG_LEVEL_WARNING\x10\x02\x12!\n" +
+	"\x1dHEADLESS_HOST_LOG_LEVEL_ERROR\x10\x03*\xa4\x01\n" +
+	"\x1bHeadlessHostLogExportFormat\x12/\n" +
+	"+HEADLESS_HOST_LOG_EXPORT_FORMAT_UNSPECIFIED\x10\x00\x12(\n" +
+	"$HEADLESS_HOST_LOG_EXPORT_FORMAT_TEXT\x10\x01\x12*\n" +
+	"&HEADLESS_HOST_LOG_EXPORT_FORMAT_NDJSON\x10\x02*\x9a\x01\n" +
 	"\rSessionStatus\x12\x1a\n" +
 	"\x16SESSION_STATUS_UNKNOWN\x10\x00\x12\x1b\n" +
 	"\x17SESSION_STATUS_STARTING\x10\x01\x12\x1a\n" +
@@ -7266,18 +11938,39 @@ const file_hdlctrl_v1_controller_proto_rawDesc = "" +
 	"\x1cHeadlessHostAutoUpdatePolicy\x12,\n" +
 	"(HEADLESS_HOST_AUTO_UPDATE_POLICY_UNKNOWN\x10\x00\x12*\n" +
 	"&HEADLESS_HOST_AUTO_UPDATE_POLICY_NEVER\x10\x01\x120\n" +
-	",HEADLESS_HOST_AUTO_UPDATE_POLICY_USERS_EMPTY\x10\x02*\x90\x02\n" +
+	",HEADLESS_HOST_AUTO_UPDATE_POLICY_USERS_EMPTY\x10\x02*\xd9\x01\n" +
+	"\x1dHeadlessHostAutoRestartPolicy\x12-\n" +
+	")HEADLESS_HOST_AUTO_RESTART_POLICY_UNKNOWN\x10\x00\x12+\n" +
+	"'HEADLESS_HOST_AUTO_RESTART_POLICY_NEVER\x10\x01\x12.\n" +
+	"*HEADLESS_HOST_AUTO_RESTART_POLICY_ON_CRASH\x10\x02\x12,\n" +
+	"(HEADLESS_HOST_AUTO_RESTART_POLICY_ALWAYS\x10\x03*\xf1\x01\n" +
+	"\x19HeadlessHostRestartPolicy\x12(\n" +
+	"$HEADLESS_HOST_RESTART_POLICY_UNKNOWN\x10\x00\x12#\n" +
+	"\x1fHEADLESS_HOST_RESTART_POLICY_NO\x10\x01\x12+\n" +
+	"'HEADLESS_HOST_RESTART_POLICY_ON_FAILURE\x10\x02\x12'\n" +
+	"#HEADLESS_HOST_RESTART_POLICY_ALWAYS\x10\x03\x12/\n" +
+	"+HEADLESS_HOST_RESTART_POLICY_UNLESS_STOPPED\x10\x04*\xb7\x02\n" +
 	"\x18ScheduledOperationStatus\x12*\n" +
 	"&SCHEDULED_OPERATION_STATUS_UNSPECIFIED\x10\x00\x12&\n" +
 	"\"SCHEDULED_OPERATION_STATUS_PENDING\x10\x01\x12&\n" +
 	"\"SCHEDULED_OPERATION_STATUS_RUNNING\x10\x02\x12(\n" +
 	"$SCHEDULED_OPERATION_STATUS_SUCCEEDED\x10\x03\x12%\n" +
 	"!SCHEDULED_OPERATION_STATUS_FAILED\x10\x04\x12'\n" +
-	"#SCHEDULED_OPERATION_STATUS_CANCELED\x10\x052\x91'\n" +
+	"#SCHEDULED_OPERATION_STATUS_CANCELED\x10\x05\x12%\n" +
+	"!SCHEDULED_OPERATION_STATUS_PAUSED\x10\x06*\x83\x02\n" +
+	"\x1cScheduledOperationRunOutcome\x12/\n" +
+	"+SCHEDULED_OPERATION_RUN_OUTCOME_UNSPECIFIED\x10\x00\x12-\n" +
+	")SCHEDULED_OPERATION_RUN_OUTCOME_SUCCEEDED\x10\x01\x12*\n" +
+	"&SCHEDULED_OPERATION_RUN_OUTCOME_FAILED\x10\x02\x12+\n" +
+	"'SCHEDULED_OPERATION_RUN_OUTCOME_SKIPPED\x10\x03\x12*\n" +
+	"&SCHEDULED_OPERATION_RUN_OUTCOME_MISSED\x10\x042\xd4:\n" +
 	"\x11ControllerService\x12]\n" +
 	"\x10ListHeadlessHost\x12#.hdlctrl.v1.ListHeadlessHostRequest\x1a$.hdlctrl.v1.ListHeadlessHostResponse\x12Z\n" +
 	"\x0fGetHeadlessHost\x12\".hdlctrl.v1.GetHeadlessHostRequest\x1a#.hdlctrl.v1.GetHeadlessHostResponse\x12f\n" +
-	"\x13GetHeadlessHostLogs\x12&.hdlctrl.v1.GetHeadlessHostLogsRequest\x1a'.hdlctrl.v1.GetHeadlessHostLogsResponse\x12i\n" +
+	"\x13GetHeadlessHostLogs\x12&.hdlctrl.v1.GetHeadlessHostLogsRequest\x1a'.hdlctrl.v1.GetHeadlessHostLogsResponse\x12k\n" +
+	"\x14TailHeadlessHostLogs\x12'.hdlctrl.v1.TailHeadlessHostLogsRequest\x1a(.hdlctrl.v1.TailHeadlessHostLogsResponse0\x01\x12o\n" +
+	"\x16SearchHeadlessHostLogs\x12).hdlctrl.v1.SearchHeadlessHostLogsRequest\x1a*.hdlctrl.v1.SearchHeadlessHostLogsResponse\x12\x87\x01\n" +
+	"\x1ePrepareHeadlessHostLogDownload\x121.hdlctrl.v1.PrepareHeadlessHostLogDownloadRequest\x1a2.hdlctrl.v1.PrepareHeadlessHostLogDownloadResponse\x12i\n" +
 	"\x14ShutdownHeadlessHost\x12'.hdlctrl.v1.ShutdownHeadlessHostRequest\x1a(.hdlctrl.v1.ShutdownHeadlessHostResponse\x12]\n" +
 	"\x10KillHeadlessHost\x12#.hdlctrl.v1.KillHeadlessHostRequest\x1a$.hdlctrl.v1.KillHeadlessHostResponse\x12{\n" +
 	"\x1aUpdateHeadlessHostSettings\x12-.hdlctrl.v1.UpdateHeadlessHostSettingsRequest\x1a..hdlctrl.v1.UpdateHeadlessHostSettingsResponse\x12f\n" +
@@ -7287,7 +11980,13 @@ const file_hdlctrl_v1_controller_proto_rawDesc = "" +
 	"\x0eDenyHostAccess\x12!.hdlctrl.v1.DenyHostAccessRequest\x1a\".hdlctrl.v1.DenyHostAccessResponse\x12x\n" +
 	"\x19ListHeadlessHostImageTags\x12,.hdlctrl.v1.ListHeadlessHostImageTagsRequest\x1a-.hdlctrl.v1.ListHeadlessHostImageTagsResponse\x12c\n" +
 	"\x12DeleteHeadlessHost\x12%.hdlctrl.v1.DeleteHeadlessHostRequest\x1a&.hdlctrl.v1.DeleteHeadlessHostResponse\x12x\n" +
-	"\x19ListHeadlessHostInstances\x12,.hdlctrl.v1.ListHeadlessHostInstancesRequest\x1a-.hdlctrl.v1.ListHeadlessHostInstancesResponse\x12l\n" +
+	"\x19ListHeadlessHostInstances\x12,.hdlctrl.v1.ListHeadlessHostInstancesRequest\x1a-.hdlctrl.v1.ListHeadlessHostInstancesResponse\x12`\n" +
+	"\x11CloneHeadlessHost\x12$.hdlctrl.v1.CloneHeadlessHostRequest\x1a%.hdlctrl.v1.CloneHeadlessHostResponse\x12`\n" +
+	"\x11ListHostTemplates\x12$.hdlctrl.v1.ListHostTemplatesRequest\x1a%.hdlctrl.v1.ListHostTemplatesResponse\x12Z\n" +
+	"\x0fGetHostTemplate\x12\".hdlctrl.v1.GetHostTemplateRequest\x1a#.hdlctrl.v1.GetHostTemplateResponse\x12c\n" +
+	"\x12CreateHostTemplate\x12%.hdlctrl.v1.CreateHostTemplateRequest\x1a&.hdlctrl.v1.CreateHostTemplateResponse\x12c\n" +
+	"\x12UpdateHostTemplate\x12%.hdlctrl.v1.UpdateHostTemplateRequest\x1a&.hdlctrl.v1.UpdateHostTemplateResponse\x12c\n" +
+	"\x12DeleteHostTemplate\x12%.hdlctrl.v1.DeleteHostTemplateRequest\x1a&.hdlctrl.v1.DeleteHostTemplateResponse\x12l\n" +
 	"\x15CreateHeadlessAccount\x12(.hdlctrl.v1.CreateHeadlessAccountRequest\x1a).hdlctrl.v1.CreateHeadlessAccountResponse\x12i\n" +
 	"\x14ListHeadlessAccounts\x12'.hdlctrl.v1.ListHeadlessAccountsRequest\x1a(.hdlctrl.v1.ListHeadlessAccountsResponse\x12l\n" +
 	"\x15DeleteHeadlessAccount\x12(.hdlctrl.v1.DeleteHeadlessAccountRequest\x1a).hdlctrl.v1.DeleteHeadlessAccountResponse\x12\x8d\x01\n" +
@@ -7321,10 +12020,23 @@ const file_hdlctrl_v1_controller_proto_rawDesc = "" +
 	"\x12ListUsersInSession\x12%.hdlctrl.v1.ListUsersInSessionRequest\x1a&.hdlctrl.v1.ListUsersInSessionResponse\x12E\n" +
 	"\bKickUser\x12\x1b.hdlctrl.v1.KickUserRequest\x1a\x1c.hdlctrl.v1.KickUserResponse\x12B\n" +
 	"\aBanUser\x12\x1a.hdlctrl.v1.BanUserRequest\x1a\x1b.hdlctrl.v1.BanUserResponse\x12~\n" +
-	"\x1bIssueResoniteLinkConnection\x12..hdlctrl.v1.IssueResoniteLinkConnectionRequest\x1a/.hdlctrl.v1.IssueResoniteLinkConnectionResponse\x12\x8a\x01\n" +
+	"\x1bIssueResoniteLinkConnection\x12..hdlctrl.v1.IssueResoniteLinkConnectionRequest\x1a/.hdlctrl.v1.IssueResoniteLinkConnectionResponse\x12l\n" +
+	"\x15ListSessionUserEvents\x12(.hdlctrl.v1.ListSessionUserEventsRequest\x1a).hdlctrl.v1.ListSessionUserEventsResponse\x12i\n" +
+	"\x14GetSessionAttendance\x12'.hdlctrl.v1.GetSessionAttendanceRequest\x1a(.hdlctrl.v1.GetSessionAttendanceResponse\x12i\n" +
+	"\x14GetSessionUsageStats\x12'.hdlctrl.v1.GetSessionUsageStatsRequest\x1a(.hdlctrl.v1.GetSessionUsageStatsResponse\x12]\n" +
+	"\x10GetMetricsSeries\x12#.hdlctrl.v1.GetMetricsSeriesRequest\x1a$.hdlctrl.v1.GetMetricsSeriesResponse\x12i\n" +
+	"\x14ListSessionTemplates\x12'.hdlctrl.v1.ListSessionTemplatesRequest\x1a(.hdlctrl.v1.ListSessionTemplatesResponse\x12c\n" +
+	"\x12GetSessionTemplate\x12%.hdlctrl.v1.GetSessionTemplateRequest\x1a&.hdlctrl.v1.GetSessionTemplateResponse\x12l\n" +
+	"\x15CreateSessionTemplate\x12(.hdlctrl.v1.CreateSessionTemplateRequest\x1a).hdlctrl.v1.CreateSessionTemplateResponse\x12l\n" +
+	"\x15UpdateSessionTemplate\x12(.hdlctrl.v1.UpdateSessionTemplateRequest\x1a).hdlctrl.v1.UpdateSessionTemplateResponse\x12l\n" +
+	"\x15DeleteSessionTemplate\x12(.hdlctrl.v1.DeleteSessionTemplateRequest\x1a).hdlctrl.v1.DeleteSessionTemplateResponse\x12\x8a\x01\n" +
 	"\x1fCreateScheduledSessionOperation\x122.hdlctrl.v1.CreateScheduledSessionOperationRequest\x1a3.hdlctrl.v1.CreateScheduledSessionOperationResponse\x12\x87\x01\n" +
 	"\x1eListScheduledSessionOperations\x121.hdlctrl.v1.ListScheduledSessionOperationsRequest\x1a2.hdlctrl.v1.ListScheduledSessionOperationsResponse\x12\x8a\x01\n" +
-	"\x1fCancelScheduledSessionOperation\x122.hdlctrl.v1.CancelScheduledSessionOperationRequest\x1a3.hdlctrl.v1.CancelScheduledSessionOperationResponseB\xbd\x01\n" +
+	"\x1fCancelScheduledSessionOperation\x122.hdlctrl.v1.CancelScheduledSessionOperationRequest\x1a3.hdlctrl.v1.CancelScheduledSessionOperationResponse\x12\x87\x01\n" +
+	"\x1ePauseScheduledSessionOperation\x121.hdlctrl.v1.PauseScheduledSessionOperationRequest\x1a2.hdlctrl.v1.PauseScheduledSessionOperationResponse\x12\x8a\x01\n" +
+	"\x1fResumeScheduledSessionOperation\x122.hdlctrl.v1.ResumeScheduledSessionOperationRequest\x1a3.hdlctrl.v1.ResumeScheduledSessionOperationResponse\x12\xa2\x01\n" +
+	"'SkipScheduledSessionOperationOccurrence\x12:.hdlctrl.v1.SkipScheduledSessionOperationOccurrenceRequest\x1a;.hdlctrl.v1.SkipScheduledSessionOperationOccurrenceResponse\x12\x90\x01\n" +
+	"!ListScheduledSessionOperationRuns\x124.hdlctrl.v1.ListScheduledSessionOperationRunsRequest\x1a5.hdlctrl.v1.ListScheduledSessionOperationRunsResponseB\xbd\x01\n" +
 	"\x0ecom.hdlctrl.v1B\x0fControllerProtoP\x01ZQgithub.com/hantabaru1014/baru-reso-headless-controller/pbgen/hdlctrl/v1;hdlctrlv1\xa2\x02\x03HXX\xaa\x02\n" +
 	"Hdlctrl.V1\xca\x02\n" +
 	"Hdlctrl\\V1\xe2\x02\x16Hdlctrl\\V1\\GPBMetadata\xea\x02\vHdlctrl::V1b\x06proto3"
@@ -7341,323 +12053,511 @@ func file_hdlctrl_v1_controller_proto_rawDescGZIP() []byte {
 	return file_hdlctrl_v1_controller_proto_rawDescData
 }
 
-var file_hdlctrl_v1_controller_proto_enumTypes = make([]protoimpl.EnumInfo, 6)
-var file_hdlctrl_v1_controller_proto_msgTypes = make([]protoimpl.MessageInfo, 114)
+var file_hdlctrl_v1_controller_proto_enumTypes = make([]protoimpl.EnumInfo, 16)
+var file_hdlctrl_v1_controller_proto_msgTypes = make([]protoimpl.MessageInfo, 170)
 var file_hdlctrl_v1_controller_proto_goTypes = []any{
-	(HeadlessHostStatus)(0),                                  // 0: hdlctrl.v1.HeadlessHostStatus
-	(SessionStatus)(0),                                       // 1: hdlctrl.v1.SessionStatus
-	(HeadlessHostAutoUpdatePolicy)(0),                        // 2: hdlctrl.v1.HeadlessHostAutoUpdatePolicy
-	(ScheduledOperationStatus)(0),                            // 3: hdlctrl.v1.ScheduledOperationStatus
-	(SaveSessionWorldRequest_SaveMode)(0),                    // 4: hdlctrl.v1.SaveSessionWorldRequest.SaveMode
-	(SessionUserCountTrigger_Comparator)(0),                  // 5: hdlctrl.v1.SessionUserCountTrigger.Comparator
-	(*RefetchHeadlessAccountInfoRequest)(nil),                // 6: hdlctrl.v1.RefetchHeadlessAccountInfoRequest
-	(*RefetchHeadlessAccountInfoResponse)(nil),               // 7: hdlctrl.v1.RefetchHeadlessAccountInfoResponse
-	(*UpdateHeadlessAccountIconRequest)(nil),                 // 8: hdlctrl.v1.UpdateHeadlessAccountIconRequest
-	(*UpdateHeadlessAccountIconResponse)(nil),                // 9: hdlctrl.v1.UpdateHeadlessAccountIconResponse
-	(*GetHeadlessAccountStorageInfoRequest)(nil),             // 10: hdlctrl.v1.GetHeadlessAccountStorageInfoRequest
-	(*GetHeadlessAccountStorageInfoResponse)(nil),            // 11: hdlctrl.v1.GetHeadlessAccountStorageInfoResponse
-	(*UpdateHeadlessAccountCredentialsRequest)(nil),          // 12: hdlctrl.v1.UpdateHeadlessAccountCredentialsRequest
-	(*UpdateHeadlessAccountCredentialsResponse)(nil),         // 13: hdlctrl.v1.UpdateHeadlessAccountCredentialsResponse
-	(*DeleteHeadlessAccountRequest)(nil),                     // 14: hdlctrl.v1.DeleteHeadlessAccountRequest
-	(*DeleteHeadlessAccountResponse)(nil),                    // 15: hdlctrl.v1.DeleteHeadlessAccountResponse
-	(*DeleteHeadlessHostRequest)(nil),                        // 16: hdlctrl.v1.DeleteHeadlessHostRequest
-	(*DeleteHeadlessHostResponse)(nil),                       // 17: hdlctrl.v1.DeleteHeadlessHostResponse
-	(*ListHeadlessHostInstancesRequest)(nil),                 // 18: hdlctrl.v1.ListHeadlessHostInstancesRequest
-	(*ListHeadlessHostInstancesResponse)(nil),                // 19: hdlctrl.v1.ListHeadlessHostInstancesResponse
-	(*AllowHostAccessRequest)(nil),                           // 20: hdlctrl.v1.AllowHostAccessRequest
-	(*AllowHostAccessResponse)(nil),                          // 21: hdlctrl.v1.AllowHostAccessResponse
-	(*DenyHostAccessRequest)(nil),                            // 22: hdlctrl.v1.DenyHostAccessRequest
-	(*DenyHostAccessResponse)(nil),                           // 23: hdlctrl.v1.DenyHostAccessResponse
-	(*StartHeadlessHostRequest)(nil),                         // 24: hdlctrl.v1.StartHeadlessHostRequest
-	(*StartHeadlessHostResponse)(nil),                        // 25: hdlctrl.v1.StartHeadlessHostResponse
-	(*CreateHeadlessAccountRequest)(nil),                     // 26: hdlctrl.v1.CreateHeadlessAccountRequest
-	(*CreateHeadlessAccountResponse)(nil),                    // 27: hdlctrl.v1.CreateHeadlessAccountResponse
-	(*ListHeadlessAccountsRequest)(nil),                      // 28: hdlctrl.v1.ListHeadlessAccountsRequest
-	(*ListHeadlessAccountsResponse)(nil),                     // 29: hdlctrl.v1.ListHeadlessAccountsResponse
-	(*ListHeadlessHostImageTagsRequest)(nil),                 // 30: hdlctrl.v1.ListHeadlessHostImageTagsRequest
-	(*ListHeadlessHostImageTagsResponse)(nil),                // 31: hdlctrl.v1.ListHeadlessHostImageTagsResponse
-	(*AcceptFriendRequestsRequest)(nil),                      // 32: hdlctrl.v1.AcceptFriendRequestsRequest
-	(*AcceptFriendRequestsResponse)(nil),                     // 33: hdlctrl.v1.AcceptFriendRequestsResponse
-	(*GetFriendRequestsRequest)(nil),                         // 34: hdlctrl.v1.GetFriendRequestsRequest
-	(*GetFriendRequestsResponse)(nil),                        // 35: hdlctrl.v1.GetFriendRequestsResponse
-	(*RestartHeadlessHostRequest)(nil),                       // 36: hdlctrl.v1.RestartHeadlessHostRequest
-	(*RestartHeadlessHostResponse)(nil),                      // 37: hdlctrl.v1.RestartHeadlessHostResponse
-	(*UpdateHeadlessHostSettingsRequest)(nil),                // 38: hdlctrl.v1.UpdateHeadlessHostSettingsRequest
-	(*UpdateHeadlessHostSettingsResponse)(nil),               // 39: hdlctrl.v1.UpdateHeadlessHostSettingsResponse
-	(*ShutdownHeadlessHostRequest)(nil),                      // 40: hdlctrl.v1.ShutdownHeadlessHostRequest
-	(*ShutdownHeadlessHostResponse)(nil),                     // 41: hdlctrl.v1.ShutdownHeadlessHostResponse
-	(*KillHeadlessHostRequest)(nil),                          // 42: hdlctrl.v1.KillHeadlessHostRequest
-	(*KillHeadlessHostResponse)(nil),                         // 43: hdlctrl.v1.KillHeadlessHostResponse
-	(*GetHeadlessHostLogsRequest)(nil),                       // 44: hdlctrl.v1.GetHeadlessHostLogsRequest
-	(*GetHeadlessHostLogsResponse)(nil),                      // 45: hdlctrl.v1.GetHeadlessHostLogsResponse
-	(*SearchUserInfoRequest)(nil),                            // 46: hdlctrl.v1.SearchUserInfoRequest
-	(*KickUserRequest)(nil),                                  // 47: hdlctrl.v1.KickUserRequest
-	(*KickUserResponse)(nil),                                 // 48: hdlctrl.v1.KickUserResponse
-	(*BanUserRequest)(nil),                                   // 49: hdlctrl.v1.BanUserRequest
-	(*BanUserResponse)(nil),                                  // 50: hdlctrl.v1.BanUserResponse
-	(*IssueResoniteLinkConnectionRequest)(nil),               // 51: hdlctrl.v1.IssueResoniteLinkConnectionRequest
-	(*IssueResoniteLinkConnectionResponse)(nil),              // 52: hdlctrl.v1.IssueResoniteLinkConnectionResponse
-	(*FetchWorldInfoRequest)(nil),                            // 53: hdlctrl.v1.FetchWorldInfoRequest
-	(*SearchWorldsRequest)(nil),                              // 54: hdlctrl.v1.SearchWorldsRequest
-	(*SearchWorldsResponse)(nil),                             // 55: hdlctrl.v1.SearchWorldsResponse
-	(*GetOwnWorldsRequest)(nil),                              // 56: hdlctrl.v1.GetOwnWorldsRequest
-	(*GetOwnWorldsResponse)(nil),                             // 57: hdlctrl.v1.GetOwnWorldsResponse
-	(*ListHeadlessHostRequest)(nil),                          // 58: hdlctrl.v1.ListHeadlessHostRequest
-	(*ListHeadlessHostResponse)(nil),                         // 59: hdlctrl.v1.ListHeadlessHostResponse
-	(*GetHeadlessHostRequest)(nil),                           // 60: hdlctrl.v1.GetHeadlessHostRequest
-	(*GetHeadlessHostResponse)(nil),                          // 61: hdlctrl.v1.GetHeadlessHostResponse
-	(*AddHeadlessHostRequest)(nil),                           // 62: hdlctrl.v1.AddHeadlessHostRequest
-	(*AddHeadlessHostResponse)(nil),                          // 63: hdlctrl.v1.AddHeadlessHostResponse
-	(*SearchSessionsRequest)(nil),                            // 64: hdlctrl.v1.SearchSessionsRequest
-	(*SearchSessionsResponse)(nil),                           // 65: hdlctrl.v1.SearchSessionsResponse
-	(*GetSessionDetailsRequest)(nil),                         // 66: hdlctrl.v1.GetSessionDetailsRequest
-	(*GetSessionDetailsResponse)(nil),                        // 67: hdlctrl.v1.GetSessionDetailsResponse
-	(*StartWorldRequest)(nil),                                // 68: hdlctrl.v1.StartWorldRequest
-	(*StartWorldResponse)(nil),                               // 69: hdlctrl.v1.StartWorldResponse
-	(*StopSessionRequest)(nil),                               // 70: hdlctrl.v1.StopSessionRequest
-	(*StopSessionResponse)(nil),                              // 71: hdlctrl.v1.StopSessionResponse
-	(*DeleteEndedSessionRequest)(nil),                        // 72: hdlctrl.v1.DeleteEndedSessionRequest
-	(*DeleteEndedSessionResponse)(nil),                       // 73: hdlctrl.v1.DeleteEndedSessionResponse
-	(*SaveSessionWorldRequest)(nil),                          // 74: hdlctrl.v1.SaveSessionWorldRequest
-	(*SaveSessionWorldResponse)(nil),                         // 75: hdlctrl.v1.SaveSessionWorldResponse
-	(*PrepareSessionWorldDownloadRequest)(nil),               // 76: hdlctrl.v1.PrepareSessionWorldDownloadRequest
-	(*PrepareSessionWorldDownloadResponse)(nil),              // 77: hdlctrl.v1.PrepareSessionWorldDownloadResponse
-	(*InviteUserRequest)(nil),                                // 78: hdlctrl.v1.InviteUserRequest
-	(*InviteUserResponse)(nil),                               // 79: hdlctrl.v1.InviteUserResponse
-	(*UpdateUserRoleRequest)(nil),                            // 80: hdlctrl.v1.UpdateUserRoleRequest
-	(*UpdateUserRoleResponse)(nil),                           // 81: hdlctrl.v1.UpdateUserRoleResponse
-	(*UpdateSessionParametersRequest)(nil),                   // 82: hdlctrl.v1.UpdateSessionParametersRequest
-	(*UpdateSessionParametersResponse)(nil),                  // 83: hdlctrl.v1.UpdateSessionParametersResponse
-	(*UpdateSessionExtraSettingsRequest)(nil),                // 84: hdlctrl.v1.UpdateSessionExtraSettingsRequest
-	(*UpdateSessionExtraSettingsResponse)(nil),               // 85: hdlctrl.v1.UpdateSessionExtraSettingsResponse
-	(*ListUsersInSessionRequest)(nil),                        // 86: hdlctrl.v1.ListUsersInSessionRequest
-	(*ListUsersInSessionResponse)(nil),                       // 87: hdlctrl.v1.ListUsersInSessionResponse
-	(*PageRequest)(nil),                                      // 88: hdlctrl.v1.PageRequest
-	(*PageResponse)(nil),                                     // 89: hdlctrl.v1.PageResponse
-	(*HeadlessHostSettings)(nil),                             // 90: hdlctrl.v1.HeadlessHostSettings
-	(*HeadlessHost)(nil),                                     // 91: hdlctrl.v1.HeadlessHost
-	(*Session)(nil),                                          // 92: hdlctrl.v1.Session
-	(*HeadlessAccount)(nil),                                  // 93: hdlctrl.v1.HeadlessAccount
-	(*UserInfo)(nil),                                         // 94: hdlctrl.v1.UserInfo
-	(*GetResoniteUserRequest)(nil),                           // 95: hdlctrl.v1.GetResoniteUserRequest
-	(*GetResoniteUserResponse)(nil),                          // 96: hdlctrl.v1.GetResoniteUserResponse
-	(*ListContactsRequest)(nil),                              // 97: hdlctrl.v1.ListContactsRequest
-	(*ListContactsResponse)(nil),                             // 98: hdlctrl.v1.ListContactsResponse
-	(*GetContactMessagesRequest)(nil),                        // 99: hdlctrl.v1.GetContactMessagesRequest
-	(*GetContactMessagesResponse)(nil),                       // 100: hdlctrl.v1.GetContactMessagesResponse
-	(*ContactMessage)(nil),                                   // 101: hdlctrl.v1.ContactMessage
-	(*SendContactMessageRequest)(nil),                        // 102: hdlctrl.v1.SendContactMessageRequest
-	(*SendContactMessageResponse)(nil),                       // 103: hdlctrl.v1.SendContactMessageResponse
-	(*ScheduledOperation)(nil),                               // 104: hdlctrl.v1.ScheduledOperation
-	(*ScheduledTrigger)(nil),                                 // 105: hdlctrl.v1.ScheduledTrigger
-	(*TimeTrigger)(nil),                                      // 106: hdlctrl.v1.TimeTrigger
-	(*SessionUserCountTrigger)(nil),                          // 107: hdlctrl.v1.SessionUserCountTrigger
-	(*ScheduledSessionOperation)(nil),                        // 108: hdlctrl.v1.ScheduledSessionOperation
-	(*CreateScheduledSessionOperationRequest)(nil),           // 109: hdlctrl.v1.CreateScheduledSessionOperationRequest
-	(*CreateScheduledSessionOperationResponse)(nil),          // 110: hdlctrl.v1.CreateScheduledSessionOperationResponse
-	(*ListScheduledSessionOperationsRequest)(nil),            // 111: hdlctrl.v1.ListScheduledSessionOperationsRequest
-	(*ListScheduledSessionOperationsResponse)(nil),           // 112: hdlctrl.v1.ListScheduledSessionOperationsResponse
-	(*CancelScheduledSessionOperationRequest)(nil),           // 113: hdlctrl.v1.CancelScheduledSessionOperationRequest
-	(*CancelScheduledSessionOperationResponse)(nil),          // 114: hdlctrl.v1.CancelScheduledSessionOperationResponse
-	(*ListHeadlessHostInstancesResponse_Instance)(nil),       // 115: hdlctrl.v1.ListHeadlessHostInstancesResponse.Instance
-	(*ListHeadlessHostImageTagsResponse_ContainerImage)(nil), // 116: hdlctrl.v1.ListHeadlessHostImageTagsResponse.ContainerImage
-	(*GetHeadlessHostLogsResponse_Log)(nil),                  // 117: hdlctrl.v1.GetHeadlessHostLogsResponse.Log
-	(*SearchWorldsResponse_WorldRecord)(nil),                 // 118: hdlctrl.v1.SearchWorldsResponse.WorldRecord
-	(*SearchSessionsRequest_SearchParameters)(nil),           // 119: hdlctrl.v1.SearchSessionsRequest.SearchParameters
-	(*v1.AllowHostAccessRequest)(nil),                        // 120: headless.v1.AllowHostAccessRequest
-	(*v1.DenyHostAccessRequest)(nil),                         // 121: headless.v1.DenyHostAccessRequest
-	(*v1.StartupConfig)(nil),                                 // 122: headless.v1.StartupConfig
-	(*v1.SearchUserInfoRequest)(nil),                         // 123: headless.v1.SearchUserInfoRequest
-	(*v1.KickUserRequest)(nil),                               // 124: headless.v1.KickUserRequest
-	(*v1.BanUserRequest)(nil),                                // 125: headless.v1.BanUserRequest
-	(*timestamppb.Timestamp)(nil),                            // 126: google.protobuf.Timestamp
-	(*v1.WorldStartupParameters)(nil),                        // 127: headless.v1.WorldStartupParameters
-	(v1.WorldBinaryFormat)(0),                                // 128: headless.v1.WorldBinaryFormat
-	(*v1.UpdateUserRoleRequest)(nil),                         // 129: headless.v1.UpdateUserRoleRequest
-	(*v1.UpdateSessionParametersRequest)(nil),                // 130: headless.v1.UpdateSessionParametersRequest
-	(*v1.UserInSession)(nil),                                 // 131: headless.v1.UserInSession
-	(*v1.AllowedAccessEntry)(nil),                            // 132: headless.v1.AllowedAccessEntry
-	(*v1.Session)(nil),                                       // 133: headless.v1.Session
-	(v1.ContactChatMessageType)(0),                           // 134: headless.v1.ContactChatMessageType
-	(*v1.FetchWorldInfoResponse)(nil),                        // 135: headless.v1.FetchWorldInfoResponse
-	(*v1.SearchUserInfoResponse)(nil),                        // 136: headless.v1.SearchUserInfoResponse
+	(SessionUserEventKind)(0),                                // 0: hdlctrl.v1.SessionUserEventKind
+	(SessionUsageGroupBy)(0),                                 // 1: hdlctrl.v1.SessionUsageGroupBy
+	(SessionUsageInterval)(0),                                // 2: hdlctrl.v1.SessionUsageInterval
+	(MetricKind)(0),                                          // 3: hdlctrl.v1.MetricKind
+	(MetricResolution)(0),                                    // 4: hdlctrl.v1.MetricResolution
+	(HeadlessHostStatus)(0),                                  // 5: hdlctrl.v1.HeadlessHostStatus
+	(HeadlessHostLogLevel)(0),                                // 6: hdlctrl.v1.HeadlessHostLogLevel
+	(HeadlessHostLogExportFormat)(0),                         // 7: hdlctrl.v1.HeadlessHostLogExportFormat
+	(SessionStatus)(0),                                       // 8: hdlctrl.v1.SessionStatus
+	(HeadlessHostAutoUpdatePolicy)(0),                        // 9: hdlctrl.v1.HeadlessHostAutoUpdatePolicy
+	(HeadlessHostAutoRestartPolicy)(0),                       // 10: hdlctrl.v1.HeadlessHostAutoRestartPolicy
+	(HeadlessHostRestartPolicy)(0),                           // 11: hdlctrl.v1.HeadlessHostRestartPolicy
+	(ScheduledOperationStatus)(0),                            // 12: hdlctrl.v1.ScheduledOperationStatus
+	(ScheduledOperationRunOutcome)(0),                        // 13: hdlctrl.v1.ScheduledOperationRunOutcome
+	(SaveSessionWorldRequest_SaveMode)(0),                    // 14: hdlctrl.v1.SaveSessionWorldRequest.SaveMode
+	(SessionUserCountTrigger_Comparator)(0),                  // 15: hdlctrl.v1.SessionUserCountTrigger.Comparator
+	(*RefetchHeadlessAccountInfoRequest)(nil),                // 16: hdlctrl.v1.RefetchHeadlessAccountInfoRequest
+	(*RefetchHeadlessAccountInfoResponse)(nil),               // 17: hdlctrl.v1.RefetchHeadlessAccountInfoResponse
+	(*UpdateHeadlessAccountIconRequest)(nil),                 // 18: hdlctrl.v1.UpdateHeadlessAccountIconRequest
+	(*UpdateHeadlessAccountIconResponse)(nil),                // 19: hdlctrl.v1.UpdateHeadlessAccountIconResponse
+	(*GetHeadlessAccountStorageInfoRequest)(nil),             // 20: hdlctrl.v1.GetHeadlessAccountStorageInfoRequest
+	(*GetHeadlessAccountStorageInfoResponse)(nil),            // 21: hdlctrl.v1.GetHeadlessAccountStorageInfoResponse
+	(*UpdateHeadlessAccountCredentialsRequest)(nil),          // 22: hdlctrl.v1.UpdateHeadlessAccountCredentialsRequest
+	(*UpdateHeadlessAccountCredentialsResponse)(nil),         // 23: hdlctrl.v1.UpdateHeadlessAccountCredentialsResponse
+	(*DeleteHeadlessAccountRequest)(nil),                     // 24: hdlctrl.v1.DeleteHeadlessAccountRequest
+	(*DeleteHeadlessAccountResponse)(nil),                    // 25: hdlctrl.v1.DeleteHeadlessAccountResponse
+	(*DeleteHeadlessHostRequest)(nil),                        // 26: hdlctrl.v1.DeleteHeadlessHostRequest
+	(*DeleteHeadlessHostResponse)(nil),                       // 27: hdlctrl.v1.DeleteHeadlessHostResponse
+	(*ListHeadlessHostInstancesRequest)(nil),                 // 28: hdlctrl.v1.ListHeadlessHostInstancesRequest
+	(*ListHeadlessHostInstancesResponse)(nil),                // 29: hdlctrl.v1.ListHeadlessHostInstancesResponse
+	(*AllowHostAccessRequest)(nil),                           // 30: hdlctrl.v1.AllowHostAccessRequest
+	(*AllowHostAccessResponse)(nil),                          // 31: hdlctrl.v1.AllowHostAccessResponse
+	(*DenyHostAccessRequest)(nil),                            // 32: hdlctrl.v1.DenyHostAccessRequest
+	(*DenyHostAccessResponse)(nil),                           // 33: hdlctrl.v1.DenyHostAccessResponse
+	(*StartHeadlessHostRequest)(nil),                         // 34: hdlctrl.v1.StartHeadlessHostRequest
+	(*StartHeadlessHostResponse)(nil),                        // 35: hdlctrl.v1.StartHeadlessHostResponse
+	(*CloneHeadlessHostRequest)(nil),                         // 36: hdlctrl.v1.CloneHeadlessHostRequest
+	(*CloneHeadlessHostResponse)(nil),                        // 37: hdlctrl.v1.CloneHeadlessHostResponse
+	(*CreateHeadlessAccountRequest)(nil),                     // 38: hdlctrl.v1.CreateHeadlessAccountRequest
+	(*CreateHeadlessAccountResponse)(nil),                    // 39: hdlctrl.v1.CreateHeadlessAccountResponse
+	(*ListHeadlessAccountsRequest)(nil),                      // 40: hdlctrl.v1.ListHeadlessAccountsRequest
+	(*ListHeadlessAccountsResponse)(nil),                     // 41: hdlctrl.v1.ListHeadlessAccountsResponse
+	(*ListHeadlessHostImageTagsRequest)(nil),                 // 42: hdlctrl.v1.ListHeadlessHostImageTagsRequest
+	(*ListHeadlessHostImageTagsResponse)(nil),                // 43: hdlctrl.v1.ListHeadlessHostImageTagsResponse
+	(*AcceptFriendRequestsRequest)(nil),                      // 44: hdlctrl.v1.AcceptFriendRequestsRequest
+	(*AcceptFriendRequestsResponse)(nil),                     // 45: hdlctrl.v1.AcceptFriendRequestsResponse
+	(*GetFriendRequestsRequest)(nil),                         // 46: hdlctrl.v1.GetFriendRequestsRequest
+	(*GetFriendRequestsResponse)(nil),                        // 47: hdlctrl.v1.GetFriendRequestsResponse
+	(*RestartHeadlessHostRequest)(nil),                       // 48: hdlctrl.v1.RestartHeadlessHostRequest
+	(*RestartHeadlessHostResponse)(nil),                      // 49: hdlctrl.v1.RestartHeadlessHostResponse
+	(*UpdateHeadlessHostSettingsRequest)(nil),                // 50: hdlctrl.v1.UpdateHeadlessHostSettingsRequest
+	(*UpdateHeadlessHostSettingsResponse)(nil),               // 51: hdlctrl.v1.UpdateHeadlessHostSettingsResponse
+	(*ShutdownHeadlessHostRequest)(nil),                      // 52: hdlctrl.v1.ShutdownHeadlessHostRequest
+	(*ShutdownHeadlessHostResponse)(nil),                     // 53: hdlctrl.v1.ShutdownHeadlessHostResponse
+	(*KillHeadlessHostRequest)(nil),                          // 54: hdlctrl.v1.KillHeadlessHostRequest
+	(*KillHeadlessHostResponse)(nil),                         // 55: hdlctrl.v1.KillHeadlessHostResponse
+	(*GetHeadlessHostLogsRequest)(nil),                       // 56: hdlctrl.v1.GetHeadlessHostLogsRequest
+	(*GetHeadlessHostLogsResponse)(nil),                      // 57: hdlctrl.v1.GetHeadlessHostLogsResponse
+	(*TailHeadlessHostLogsRequest)(nil),                      // 58: hdlctrl.v1.TailHeadlessHostLogsRequest
+	(*TailHeadlessHostLogsResponse)(nil),                     // 59: hdlctrl.v1.TailHeadlessHostLogsResponse
+	(*SearchHeadlessHostLogsRequest)(nil),                    // 60: hdlctrl.v1.SearchHeadlessHostLogsRequest
+	(*SearchHeadlessHostLogsResponse)(nil),                   // 61: hdlctrl.v1.SearchHeadlessHostLogsResponse
+	(*PrepareHeadlessHostLogDownloadRequest)(nil),            // 62: hdlctrl.v1.PrepareHeadlessHostLogDownloadRequest
+	(*PrepareHeadlessHostLogDownloadResponse)(nil),           // 63: hdlctrl.v1.PrepareHeadlessHostLogDownloadResponse
+	(*SearchUserInfoRequest)(nil),                            // 64: hdlctrl.v1.SearchUserInfoRequest
+	(*KickUserRequest)(nil),                                  // 65: hdlctrl.v1.KickUserRequest
+	(*KickUserResponse)(nil),                                 // 66: hdlctrl.v1.KickUserResponse
+	(*BanUserRequest)(nil),                                   // 67: hdlctrl.v1.BanUserRequest
+	(*BanUserResponse)(nil),                                  // 68: hdlctrl.v1.BanUserResponse
+	(*IssueResoniteLinkConnectionRequest)(nil),               // 69: hdlctrl.v1.IssueResoniteLinkConnectionRequest
+	(*IssueResoniteLinkConnectionResponse)(nil),              // 70: hdlctrl.v1.IssueResoniteLinkConnectionResponse
+	(*SessionUserEvent)(nil),                                 // 71: hdlctrl.v1.SessionUserEvent
+	(*ListSessionUserEventsRequest)(nil),                     // 72: hdlctrl.v1.ListSessionUserEventsRequest
+	(*ListSessionUserEventsResponse)(nil),                    // 73: hdlctrl.v1.ListSessionUserEventsResponse
+	(*SessionAttendee)(nil),                                  // 74: hdlctrl.v1.SessionAttendee
+	(*GetSessionAttendanceRequest)(nil),                      // 75: hdlctrl.v1.GetSessionAttendanceRequest
+	(*GetSessionAttendanceResponse)(nil),                     // 76: hdlctrl.v1.GetSessionAttendanceResponse
+	(*GetSessionUsageStatsRequest)(nil),                      // 77: hdlctrl.v1.GetSessionUsageStatsRequest
+	(*SessionUsageBucket)(nil),                               // 78: hdlctrl.v1.SessionUsageBucket
+	(*SessionUsageSeries)(nil),                               // 79: hdlctrl.v1.SessionUsageSeries
+	(*GetSessionUsageStatsResponse)(nil),                     // 80: hdlctrl.v1.GetSessionUsageStatsResponse
+	(*GetMetricsSeriesRequest)(nil),                          // 81: hdlctrl.v1.GetMetricsSeriesRequest
+	(*MetricPoint)(nil),                                      // 82: hdlctrl.v1.MetricPoint
+	(*GetMetricsSeriesResponse)(nil),                         // 83: hdlctrl.v1.GetMetricsSeriesResponse
+	(*FetchWorldInfoRequest)(nil),                            // 84: hdlctrl.v1.FetchWorldInfoRequest
+	(*SearchWorldsRequest)(nil),                              // 85: hdlctrl.v1.SearchWorldsRequest
+	(*SearchWorldsResponse)(nil),                             // 86: hdlctrl.v1.SearchWorldsResponse
+	(*GetOwnWorldsRequest)(nil),                              // 87: hdlctrl.v1.GetOwnWorldsRequest
+	(*GetOwnWorldsResponse)(nil),                             // 88: hdlctrl.v1.GetOwnWorldsResponse
+	(*ListHeadlessHostRequest)(nil),                          // 89: hdlctrl.v1.ListHeadlessHostRequest
+	(*ListHeadlessHostResponse)(nil),                         // 90: hdlctrl.v1.ListHeadlessHostResponse
+	(*GetHeadlessHostRequest)(nil),                           // 91: hdlctrl.v1.GetHeadlessHostRequest
+	(*GetHeadlessHostResponse)(nil),                          // 92: hdlctrl.v1.GetHeadlessHostResponse
+	(*AddHeadlessHostRequest)(nil),                           // 93: hdlctrl.v1.AddHeadlessHostRequest
+	(*AddHeadlessHostResponse)(nil),                          // 94: hdlctrl.v1.AddHeadlessHostResponse
+	(*SearchSessionsRequest)(nil),                            // 95: hdlctrl.v1.SearchSessionsRequest
+	(*SearchSessionsResponse)(nil),                           // 96: hdlctrl.v1.SearchSessionsResponse
+	(*GetSessionDetailsRequest)(nil),                         // 97: hdlctrl.v1.GetSessionDetailsRequest
+	(*GetSessionDetailsResponse)(nil),                        // 98: hdlctrl.v1.GetSessionDetailsResponse
+	(*StartWorldRequest)(nil),                                // 99: hdlctrl.v1.StartWorldRequest
+	(*StartWorldResponse)(nil),                               // 100: hdlctrl.v1.StartWorldResponse
+	(*StopSessionRequest)(nil),                               // 101: hdlctrl.v1.StopSessionRequest
+	(*StopSessionResponse)(nil),                              // 102: hdlctrl.v1.StopSessionResponse
+	(*DeleteEndedSessionRequest)(nil),                        // 103: hdlctrl.v1.DeleteEndedSessionRequest
+	(*DeleteEndedSessionResponse)(nil),                       // 104: hdlctrl.v1.DeleteEndedSessionResponse
+	(*SaveSessionWorldRequest)(nil),                          // 105: hdlctrl.v1.SaveSessionWorldRequest
+	(*SaveSessionWorldResponse)(nil),                         // 106: hdlctrl.v1.SaveSessionWorldResponse
+	(*PrepareSessionWorldDownloadRequest)(nil),               // 107: hdlctrl.v1.PrepareSessionWorldDownloadRequest
+	(*PrepareSessionWorldDownloadResponse)(nil),              // 108: hdlctrl.v1.PrepareSessionWorldDownloadResponse
+	(*InviteUserRequest)(nil),                                // 109: hdlctrl.v1.InviteUserRequest
+	(*InviteUserResponse)(nil),                               // 110: hdlctrl.v1.InviteUserResponse
+	(*UpdateUserRoleRequest)(nil),                            // 111: hdlctrl.v1.UpdateUserRoleRequest
+	(*UpdateUserRoleResponse)(nil),                           // 112: hdlctrl.v1.UpdateUserRoleResponse
+	(*UpdateSessionParametersRequest)(nil),                   // 113: hdlctrl.v1.UpdateSessionParametersRequest
+	(*UpdateSessionParametersResponse)(nil),                  // 114: hdlctrl.v1.UpdateSessionParametersResponse
+	(*UpdateSessionExtraSettingsRequest)(nil),                // 115: hdlctrl.v1.UpdateSessionExtraSettingsRequest
+	(*UpdateSessionExtraSettingsResponse)(nil),               // 116: hdlctrl.v1.UpdateSessionExtraSettingsResponse
+	(*ListUsersInSessionRequest)(nil),                        // 117: hdlctrl.v1.ListUsersInSessionRequest
+	(*ListUsersInSessionResponse)(nil),                       // 118: hdlctrl.v1.ListUsersInSessionResponse
+	(*SessionTemplate)(nil),                                  // 119: hdlctrl.v1.SessionTemplate
+	(*ListSessionTemplatesRequest)(nil),                      // 120: hdlctrl.v1.ListSessionTemplatesRequest
+	(*ListSessionTemplatesResponse)(nil),                     // 121: hdlctrl.v1.ListSessionTemplatesResponse
+	(*GetSessionTemplateRequest)(nil),                        // 122: hdlctrl.v1.GetSessionTemplateRequest
+	(*GetSessionTemplateResponse)(nil),                       // 123: hdlctrl.v1.GetSessionTemplateResponse
+	(*CreateSessionTemplateRequest)(nil),                     // 124: hdlctrl.v1.CreateSessionTemplateRequest
+	(*CreateSessionTemplateResponse)(nil),                    // 125: hdlctrl.v1.CreateSessionTemplateResponse
+	(*UpdateSessionTemplateRequest)(nil),                     // 126: hdlctrl.v1.UpdateSessionTemplateRequest
+	(*UpdateSessionTemplateResponse)(nil),                    // 127: hdlctrl.v1.UpdateSessionTemplateResponse
+	(*DeleteSessionTemplateRequest)(nil),                     // 128: hdlctrl.v1.DeleteSessionTemplateRequest
+	(*DeleteSessionTemplateResponse)(nil),                    // 129: hdlctrl.v1.DeleteSessionTemplateResponse
+	(*PageRequest)(nil),                                      // 130: hdlctrl.v1.PageRequest
+	(*PageResponse)(nil),                                     // 131: hdlctrl.v1.PageResponse
+	(*HeadlessHostBindMount)(nil),                            // 132: hdlctrl.v1.HeadlessHostBindMount
+	(*HostTemplate)(nil),                                     // 133: hdlctrl.v1.HostTemplate
+	(*ListHostTemplatesRequest)(nil),                         // 134: hdlctrl.v1.ListHostTemplatesRequest
+	(*ListHostTemplatesResponse)(nil),                        // 135: hdlctrl.v1.ListHostTemplatesResponse
+	(*GetHostTemplateRequest)(nil),                           // 136: hdlctrl.v1.GetHostTemplateRequest
+	(*GetHostTemplateResponse)(nil),                          // 137: hdlctrl.v1.GetHostTemplateResponse
+	(*CreateHostTemplateRequest)(nil),                        // 138: hdlctrl.v1.CreateHostTemplateRequest
+	(*CreateHostTemplateResponse)(nil),                       // 139: hdlctrl.v1.CreateHostTemplateResponse
+	(*UpdateHostTemplateRequest)(nil),                        // 140: hdlctrl.v1.UpdateHostTemplateRequest
+	(*UpdateHostTemplateResponse)(nil),                       // 141: hdlctrl.v1.UpdateHostTemplateResponse
+	(*DeleteHostTemplateRequest)(nil),                        // 142: hdlctrl.v1.DeleteHostTemplateRequest
+	(*DeleteHostTemplateResponse)(nil),                       // 143: hdlctrl.v1.DeleteHostTemplateResponse
+	(*HeadlessHostContainerSettings)(nil),                    // 144: hdlctrl.v1.HeadlessHostContainerSettings
+	(*HeadlessHostSettings)(nil),                             // 145: hdlctrl.v1.HeadlessHostSettings
+	(*HeadlessHost)(nil),                                     // 146: hdlctrl.v1.HeadlessHost
+	(*Session)(nil),                                          // 147: hdlctrl.v1.Session
+	(*HeadlessAccount)(nil),                                  // 148: hdlctrl.v1.HeadlessAccount
+	(*UserInfo)(nil),                                         // 149: hdlctrl.v1.UserInfo
+	(*GetResoniteUserRequest)(nil),                           // 150: hdlctrl.v1.GetResoniteUserRequest
+	(*GetResoniteUserResponse)(nil),                          // 151: hdlctrl.v1.GetResoniteUserResponse
+	(*ListContactsRequest)(nil),                              // 152: hdlctrl.v1.ListContactsRequest
+	(*ListContactsResponse)(nil),                             // 153: hdlctrl.v1.ListContactsResponse
+	(*GetContactMessagesRequest)(nil),                        // 154: hdlctrl.v1.GetContactMessagesRequest
+	(*GetContactMessagesResponse)(nil),                       // 155: hdlctrl.v1.GetContactMessagesResponse
+	(*ContactMessage)(nil),                                   // 156: hdlctrl.v1.ContactMessage
+	(*SendContactMessageRequest)(nil),                        // 157: hdlctrl.v1.SendContactMessageRequest
+	(*SendContactMessageResponse)(nil),                       // 158: hdlctrl.v1.SendContactMessageResponse
+	(*ScheduledOperation)(nil),                               // 159: hdlctrl.v1.ScheduledOperation
+	(*ScheduledTrigger)(nil),                                 // 160: hdlctrl.v1.ScheduledTrigger
+	(*TimeTrigger)(nil),                                      // 161: hdlctrl.v1.TimeTrigger
+	(*SessionUserCountTrigger)(nil),                          // 162: hdlctrl.v1.SessionUserCountTrigger
+	(*CronTrigger)(nil),                                      // 163: hdlctrl.v1.CronTrigger
+	(*ScheduledSessionOperation)(nil),                        // 164: hdlctrl.v1.ScheduledSessionOperation
+	(*CreateScheduledSessionOperationRequest)(nil),           // 165: hdlctrl.v1.CreateScheduledSessionOperationRequest
+	(*CreateScheduledSessionOperationResponse)(nil),          // 166: hdlctrl.v1.CreateScheduledSessionOperationResponse
+	(*ListScheduledSessionOperationsRequest)(nil),            // 167: hdlctrl.v1.ListScheduledSessionOperationsRequest
+	(*ListScheduledSessionOperationsResponse)(nil),           // 168: hdlctrl.v1.ListScheduledSessionOperationsResponse
+	(*CancelScheduledSessionOperationRequest)(nil),           // 169: hdlctrl.v1.CancelScheduledSessionOperationRequest
+	(*CancelScheduledSessionOperationResponse)(nil),          // 170: hdlctrl.v1.CancelScheduledSessionOperationResponse
+	(*PauseScheduledSessionOperationRequest)(nil),            // 171: hdlctrl.v1.PauseScheduledSessionOperationRequest
+	(*PauseScheduledSessionOperationResponse)(nil),           // 172: hdlctrl.v1.PauseScheduledSessionOperationResponse
+	(*ResumeScheduledSessionOperationRequest)(nil),           // 173: hdlctrl.v1.ResumeScheduledSessionOperationRequest
+	(*ResumeScheduledSessionOperationResponse)(nil),          // 174: hdlctrl.v1.ResumeScheduledSessionOperationResponse
+	(*SkipScheduledSessionOperationOccurrenceRequest)(nil),   // 175: hdlctrl.v1.SkipScheduledSessionOperationOccurrenceRequest
+	(*SkipScheduledSessionOperationOccurrenceResponse)(nil),  // 176: hdlctrl.v1.SkipScheduledSessionOperationOccurrenceResponse
+	(*ScheduledOperationRun)(nil),                            // 177: hdlctrl.v1.ScheduledOperationRun
+	(*ListScheduledSessionOperationRunsRequest)(nil),         // 178: hdlctrl.v1.ListScheduledSessionOperationRunsRequest
+	(*ListScheduledSessionOperationRunsResponse)(nil),        // 179: hdlctrl.v1.ListScheduledSessionOperationRunsResponse
+	(*ListHeadlessHostInstancesResponse_Instance)(nil),       // 180: hdlctrl.v1.ListHeadlessHostInstancesResponse.Instance
+	(*ListHeadlessHostImageTagsResponse_ContainerImage)(nil), // 181: hdlctrl.v1.ListHeadlessHostImageTagsResponse.ContainerImage
+	(*GetHeadlessHostLogsResponse_Log)(nil),                  // 182: hdlctrl.v1.GetHeadlessHostLogsResponse.Log
+	(*SearchHeadlessHostLogsResponse_Group)(nil),             // 183: hdlctrl.v1.SearchHeadlessHostLogsResponse.Group
+	(*SearchWorldsResponse_WorldRecord)(nil),                 // 184: hdlctrl.v1.SearchWorldsResponse.WorldRecord
+	(*SearchSessionsRequest_SearchParameters)(nil),           // 185: hdlctrl.v1.SearchSessionsRequest.SearchParameters
+	(*v1.AllowHostAccessRequest)(nil),                        // 186: headless.v1.AllowHostAccessRequest
+	(*v1.DenyHostAccessRequest)(nil),                         // 187: headless.v1.DenyHostAccessRequest
+	(*v1.StartupConfig)(nil),                                 // 188: headless.v1.StartupConfig
+	(*timestamppb.Timestamp)(nil),                            // 189: google.protobuf.Timestamp
+	(*v1.SearchUserInfoRequest)(nil),                         // 190: headless.v1.SearchUserInfoRequest
+	(*v1.KickUserRequest)(nil),                               // 191: headless.v1.KickUserRequest
+	(*v1.BanUserRequest)(nil),                                // 192: headless.v1.BanUserRequest
+	(*v1.WorldStartupParameters)(nil),                        // 193: headless.v1.WorldStartupParameters
+	(v1.WorldBinaryFormat)(0),                                // 194: headless.v1.WorldBinaryFormat
+	(*v1.UpdateUserRoleRequest)(nil),                         // 195: headless.v1.UpdateUserRoleRequest
+	(*v1.UpdateSessionParametersRequest)(nil),                // 196: headless.v1.UpdateSessionParametersRequest
+	(*v1.UserInSession)(nil),                                 // 197: headless.v1.UserInSession
+	(*v1.AllowedAccessEntry)(nil),                            // 198: headless.v1.AllowedAccessEntry
+	(*v1.Session)(nil),                                       // 199: headless.v1.Session
+	(v1.ContactChatMessageType)(0),                           // 200: headless.v1.ContactChatMessageType
+	(*v1.FetchWorldInfoResponse)(nil),                        // 201: headless.v1.FetchWorldInfoResponse
+	(*v1.SearchUserInfoResponse)(nil),                        // 202: headless.v1.SearchUserInfoResponse
 }
 var file_hdlctrl_v1_controller_proto_depIdxs = []int32{
-	115, // 0: hdlctrl.v1.ListHeadlessHostInstancesResponse.instances:type_name -> hdlctrl.v1.ListHeadlessHostInstancesResponse.Instance
-	120, // 1: hdlctrl.v1.AllowHostAccessRequest.request:type_name -> headless.v1.AllowHostAccessRequest
-	121, // 2: hdlctrl.v1.DenyHostAccessRequest.request:type_name -> headless.v1.DenyHostAccessRequest
-	122, // 3: hdlctrl.v1.StartHeadlessHostRequest.startup_config:type_name -> headless.v1.StartupConfig
-	2,   // 4: hdlctrl.v1.StartHeadlessHostRequest.auto_update_policy:type_name -> hdlctrl.v1.HeadlessHostAutoUpdatePolicy
-	88,  // 5: hdlctrl.v1.ListHeadlessAccountsRequest.page:type_name -> hdlctrl.v1.PageRequest
-	93,  // 6: hdlctrl.v1.ListHeadlessAccountsResponse.accounts:type_name -> hdlctrl.v1.HeadlessAccount
-	89,  // 7: hdlctrl.v1.ListHeadlessAccountsResponse.page:type_name -> hdlctrl.v1.PageResponse
-	116, // 8: hdlctrl.v1.ListHeadlessHostImageTagsResponse.tags:type_name -> hdlctrl.v1.ListHeadlessHostImageTagsResponse.ContainerImage
-	94,  // 9: hdlctrl.v1.GetFriendRequestsResponse.requested_contacts:type_name -> hdlctrl.v1.UserInfo
-	2,   // 10: hdlctrl.v1.UpdateHeadlessHostSettingsRequest.auto_update_policy:type_name -> hdlctrl.v1.HeadlessHostAutoUpdatePolicy
-	117, // 11: hdlctrl.v1.GetHeadlessHostLogsResponse.logs:type_name -> hdlctrl.v1.GetHeadlessHostLogsResponse.Log
-	123, // 12: hdlctrl.v1.SearchUserInfoRequest.parameters:type_name -> headless.v1.SearchUserInfoRequest
-	124, // 13: hdlctrl.v1.KickUserRequest.parameters:type_name -> headless.v1.KickUserRequest
-	125, // 14: hdlctrl.v1.BanUserRequest.parameters:type_name -> headless.v1.BanUserRequest
-	126, // 15: hdlctrl.v1.IssueResoniteLinkConnectionResponse.expires_at:type_name -> google.protobuf.Timestamp
-	118, // 16: hdlctrl.v1.SearchWorldsResponse.records:type_name -> hdlctrl.v1.SearchWorldsResponse.WorldRecord
-	118, // 17: hdlctrl.v1.GetOwnWorldsResponse.records:type_name -> hdlctrl.v1.SearchWorldsResponse.WorldRecord
-	88,  // 18: hdlctrl.v1.ListHeadlessHostRequest.page:type_name -> hdlctrl.v1.PageRequest
-	91,  // 19: hdlctrl.v1.ListHeadlessHostResponse.hosts:type_name -> hdlctrl.v1.HeadlessHost
-	89,  // 20: hdlctrl.v1.ListHeadlessHostResponse.page:type_name -> hdlctrl.v1.PageResponse
-	91,  // 21: hdlctrl.v1.GetHeadlessHostResponse.host:type_name -> hdlctrl.v1.HeadlessHost
-	91,  // 22: hdlctrl.v1.AddHeadlessHostResponse.host:type_name -> hdlctrl.v1.HeadlessHost
-	119, // 23: hdlctrl.v1.SearchSessionsRequest.parameters:type_name -> hdlctrl.v1.SearchSessionsRequest.SearchParameters
-	88,  // 24: hdlctrl.v1.SearchSessionsRequest.page:type_name -> hdlctrl.v1.PageRequest
-	92,  // 25: hdlctrl.v1.SearchSessionsResponse.sessions:type_name -> hdlctrl.v1.Session
-	89,  // 26: hdlctrl.v1.SearchSessionsResponse.page:type_name -> hdlctrl.v1.PageResponse
-	92,  // 27: hdlctrl.v1.GetSessionDetailsResponse.session:type_name -> hdlctrl.v1.Session
-	127, // 28: hdlctrl.v1.StartWorldRequest.parameters:type_name -> headless.v1.WorldStartupParameters
-	4,   // 29: hdlctrl.v1.SaveSessionWorldRequest.save_mode:type_name -> hdlctrl.v1.SaveSessionWorldRequest.SaveMode
-	128, // 30: hdlctrl.v1.PrepareSessionWorldDownloadRequest.format:type_name -> headless.v1.WorldBinaryFormat
-	129, // 31: hdlctrl.v1.UpdateUserRoleRequest.parameters:type_name -> headless.v1.UpdateUserRoleRequest
-	130, // 32: hdlctrl.v1.UpdateSessionParametersRequest.parameters:type_name -> headless.v1.UpdateSessionParametersRequest
-	131, // 33: hdlctrl.v1.ListUsersInSessionResponse.users:type_name -> headless.v1.UserInSession
-	132, // 34: hdlctrl.v1.HeadlessHostSettings.allowed_url_hosts:type_name -> headless.v1.AllowedAccessEntry
-	0,   // 35: hdlctrl.v1.HeadlessHost.status:type_name -> hdlctrl.v1.HeadlessHostStatus
-	2,   // 36: hdlctrl.v1.HeadlessHost.auto_update_policy:type_name -> hdlctrl.v1.HeadlessHostAutoUpdatePolicy
-	90,  // 37: hdlctrl.v1.HeadlessHost.host_settings:type_name -> hdlctrl.v1.HeadlessHostSettings
-	1,   // 38: hdlctrl.v1.Session.status:type_name -> hdlctrl.v1.SessionStatus
-	126, // 39: hdlctrl.v1.Session.started_at:type_name -> google.protobuf.Timestamp
-	126, // 40: hdlctrl.v1.Session.ended_at:type_name -> google.protobuf.Timestamp
-	127, // 41: hdlctrl.v1.Session.startup_parameters:type_name -> headless.v1.WorldStartupParameters
-	133, // 42: hdlctrl.v1.Session.current_state:type_name -> headless.v1.Session
-	94,  // 43: hdlctrl.v1.ListContactsResponse.contacts:type_name -> hdlctrl.v1.UserInfo
-	101, // 44: hdlctrl.v1.GetContactMessagesResponse.messages:type_name -> hdlctrl.v1.ContactMessage
-	134, // 45: hdlctrl.v1.ContactMessage.type:type_name -> headless.v1.ContactChatMessageType
-	126, // 46: hdlctrl.v1.ContactMessage.send_time:type_name -> google.protobuf.Timestamp
-	126, // 47: hdlctrl.v1.ContactMessage.read_time:type_name -> google.protobuf.Timestamp
-	68,  // 48: hdlctrl.v1.ScheduledOperation.start_session:type_name -> hdlctrl.v1.StartWorldRequest
-	70,  // 49: hdlctrl.v1.ScheduledOperation.stop_session:type_name -> hdlctrl.v1.StopSessionRequest
-	82,  // 50: hdlctrl.v1.ScheduledOperation.update_parameters:type_name -> hdlctrl.v1.UpdateSessionParametersRequest
-	84,  // 51: hdlctrl.v1.ScheduledOperation.update_extra_settings:type_name -> hdlctrl.v1.UpdateSessionExtraSettingsRequest
-	106, // 52: hdlctrl.v1.ScheduledTrigger.time:type_name -> hdlctrl.v1.TimeTrigger
-	107, // 53: hdlctrl.v1.ScheduledTrigger.session_user_count:type_name -> hdlctrl.v1.SessionUserCountTrigger
-	126, // 54: hdlctrl.v1.TimeTrigger.scheduled_at:type_name -> google.protobuf.Timestamp
-	5,   // 55: hdlctrl.v1.SessionUserCountTrigger.comparator:type_name -> hdlctrl.v1.SessionUserCountTrigger.Comparator
-	104, // 56: hdlctrl.v1.ScheduledSessionOperation.operation:type_name -> hdlctrl.v1.ScheduledOperation
-	105, // 57: hdlctrl.v1.ScheduledSessionOperation.trigger:type_name -> hdlctrl.v1.ScheduledTrigger
-	126, // 58: hdlctrl.v1.ScheduledSessionOperation.next_fire_at:type_name -> google.protobuf.Timestamp
-	3,   // 59: hdlctrl.v1.ScheduledSessionOperation.status:type_name -> hdlctrl.v1.ScheduledOperationStatus
-	126, // 60: hdlctrl.v1.ScheduledSessionOperation.executed_at:type_name -> google.protobuf.Timestamp
-	126, // 61: hdlctrl.v1.ScheduledSessionOperation.created_at:type_name -> google.protobuf.Timestamp
-	126, // 62: hdlctrl.v1.ScheduledSessionOperation.updated_at:type_name -> google.protobuf.Timestamp
-	104, // 63: hdlctrl.v1.CreateScheduledSessionOperationRequest.operation:type_name -> hdlctrl.v1.ScheduledOperation
-	105, // 64: hdlctrl.v1.CreateScheduledSessionOperationRequest.trigger:type_name -> hdlctrl.v1.ScheduledTrigger
-	108, // 65: hdlctrl.v1.CreateScheduledSessionOperationResponse.scheduled_operation:type_name -> hdlctrl.v1.ScheduledSessionOperation
-	3,   // 66: hdlctrl.v1.ListScheduledSessionOperationsRequest.status:type_name -> hdlctrl.v1.ScheduledOperationStatus
-	88,  // 67: hdlctrl.v1.ListScheduledSessionOperationsRequest.page:type_name -> hdlctrl.v1.PageRequest
-	108, // 68: hdlctrl.v1.ListScheduledSessionOperationsResponse.scheduled_operations:type_name -> hdlctrl.v1.ScheduledSessionOperation
-	89,  // 69: hdlctrl.v1.ListScheduledSessionOperationsResponse.page:type_name -> hdlctrl.v1.PageResponse
-	126, // 70: hdlctrl.v1.ListHeadlessHostInstancesResponse.Instance.first_log_at:type_name -> google.protobuf.Timestamp
-	126, // 71: hdlctrl.v1.ListHeadlessHostInstancesResponse.Instance.last_log_at:type_name -> google.protobuf.Timestamp
-	126, // 72: hdlctrl.v1.GetHeadlessHostLogsResponse.Log.timestamp:type_name -> google.protobuf.Timestamp
-	1,   // 73: hdlctrl.v1.SearchSessionsRequest.SearchParameters.status:type_name -> hdlctrl.v1.SessionStatus
-	58,  // 74: hdlctrl.v1.ControllerService.ListHeadlessHost:input_type -> hdlctrl.v1.ListHeadlessHostRequest
-	60,  // 75: hdlctrl.v1.ControllerService.GetHeadlessHost:input_type -> hdlctrl.v1.GetHeadlessHostRequest
-	44,  // 76: hdlctrl.v1.ControllerService.GetHeadlessHostLogs:input_type -> hdlctrl.v1.GetHeadlessHostLogsRequest
-	40,  // 77: hdlctrl.v1.ControllerService.ShutdownHeadlessHost:input_type -> hdlctrl.v1.ShutdownHeadlessHostRequest
-	42,  // 78: hdlctrl.v1.ControllerService.KillHeadlessHost:input_type -> hdlctrl.v1.KillHeadlessHostRequest
-	38,  // 79: hdlctrl.v1.ControllerService.UpdateHeadlessHostSettings:input_type -> hdlctrl.v1.UpdateHeadlessHostSettingsRequest
-	36,  // 80: hdlctrl.v1.ControllerService.RestartHeadlessHost:input_type -> hdlctrl.v1.RestartHeadlessHostRequest
-	24,  // 81: hdlctrl.v1.ControllerService.StartHeadlessHost:input_type -> hdlctrl.v1.StartHeadlessHostRequest
-	20,  // 82: hdlctrl.v1.ControllerService.AllowHostAccess:input_type -> hdlctrl.v1.AllowHostAccessRequest
-	22,  // 83: hdlctrl.v1.ControllerService.DenyHostAccess:input_type -> hdlctrl.v1.DenyHostAccessRequest
-	30,  // 84: hdlctrl.v1.ControllerService.ListHeadlessHostImageTags:input_type -> hdlctrl.v1.ListHeadlessHostImageTagsRequest
-	16,  // 85: hdlctrl.v1.ControllerService.DeleteHeadlessHost:input_type -> hdlctrl.v1.DeleteHeadlessHostRequest
-	18,  // 86: hdlctrl.v1.ControllerService.ListHeadlessHostInstances:input_type -> hdlctrl.v1.ListHeadlessHostInstancesRequest
-	26,  // 87: hdlctrl.v1.ControllerService.CreateHeadlessAccount:input_type -> hdlctrl.v1.CreateHeadlessAccountRequest
-	28,  // 88: hdlctrl.v1.ControllerService.ListHeadlessAccounts:input_type -> hdlctrl.v1.ListHeadlessAccountsRequest
-	14,  // 89: hdlctrl.v1.ControllerService.DeleteHeadlessAccount:input_type -> hdlctrl.v1.DeleteHeadlessAccountRequest
-	12,  // 90: hdlctrl.v1.ControllerService.UpdateHeadlessAccountCredentials:input_type -> hdlctrl.v1.UpdateHeadlessAccountCredentialsRequest
-	10,  // 91: hdlctrl.v1.ControllerService.GetHeadlessAccountStorageInfo:input_type -> hdlctrl.v1.GetHeadlessAccountStorageInfoRequest
-	6,   // 92: hdlctrl.v1.ControllerService.RefetchHeadlessAccountInfo:input_type -> hdlctrl.v1.RefetchHeadlessAccountInfoRequest
-	8,   // 93: hdlctrl.v1.ControllerService.UpdateHeadlessAccountIcon:input_type -> hdlctrl.v1.UpdateHeadlessAccountIconRequest
-	53,  // 94: hdlctrl.v1.ControllerService.FetchWorldInfo:input_type -> hdlctrl.v1.FetchWorldInfoRequest
-	46,  // 95: hdlctrl.v1.ControllerService.SearchUserInfo:input_type -> hdlctrl.v1.SearchUserInfoRequest
-	54,  // 96: hdlctrl.v1.ControllerService.SearchWorlds:input_type -> hdlctrl.v1.SearchWorldsRequest
-	56,  // 97: hdlctrl.v1.ControllerService.GetOwnWorlds:input_type -> hdlctrl.v1.GetOwnWorldsRequest
-	95,  // 98: hdlctrl.v1.ControllerService.GetResoniteUser:input_type -> hdlctrl.v1.GetResoniteUserRequest
-	34,  // 99: hdlctrl.v1.ControllerService.GetFriendRequests:input_type -> hdlctrl.v1.GetFriendRequestsRequest
-	32,  // 100: hdlctrl.v1.ControllerService.AcceptFriendRequests:input_type -> hdlctrl.v1.AcceptFriendRequestsRequest
-	97,  // 101: hdlctrl.v1.ControllerService.ListContacts:input_type -> hdlctrl.v1.ListContactsRequest
-	99,  // 102: hdlctrl.v1.ControllerService.GetContactMessages:input_type -> hdlctrl.v1.GetContactMessagesRequest
-	102, // 103: hdlctrl.v1.ControllerService.SendContactMessage:input_type -> hdlctrl.v1.SendContactMessageRequest
-	64,  // 104: hdlctrl.v1.ControllerService.SearchSessions:input_type -> hdlctrl.v1.SearchSessionsRequest
-	66,  // 105: hdlctrl.v1.ControllerService.GetSessionDetails:input_type -> hdlctrl.v1.GetSessionDetailsRequest
-	68,  // 106: hdlctrl.v1.ControllerService.StartWorld:input_type -> hdlctrl.v1.StartWorldRequest
-	70,  // 107: hdlctrl.v1.ControllerService.StopSession:input_type -> hdlctrl.v1.StopSessionRequest
-	72,  // 108: hdlctrl.v1.ControllerService.DeleteEndedSession:input_type -> hdlctrl.v1.DeleteEndedSessionRequest
-	74,  // 109: hdlctrl.v1.ControllerService.SaveSessionWorld:input_type -> hdlctrl.v1.SaveSessionWorldRequest
-	76,  // 110: hdlctrl.v1.ControllerService.PrepareSessionWorldDownload:input_type -> hdlctrl.v1.PrepareSessionWorldDownloadRequest
-	78,  // 111: hdlctrl.v1.ControllerService.InviteUser:input_type -> hdlctrl.v1.InviteUserRequest
-	80,  // 112: hdlctrl.v1.ControllerService.UpdateUserRole:input_type -> hdlctrl.v1.UpdateUserRoleRequest
-	82,  // 113: hdlctrl.v1.ControllerService.UpdateSessionParameters:input_type -> hdlctrl.v1.UpdateSessionParametersRequest
-	84,  // 114: hdlctrl.v1.ControllerService.UpdateSessionExtraSettings:input_type -> hdlctrl.v1.UpdateSessionExtraSettingsRequest
-	86,  // 115: hdlctrl.v1.ControllerService.ListUsersInSession:input_type -> hdlctrl.v1.ListUsersInSessionRequest
-	47,  // 116: hdlctrl.v1.ControllerService.KickUser:input_type -> hdlctrl.v1.KickUserRequest
-	49,  // 117: hdlctrl.v1.ControllerService.BanUser:input_type -> hdlctrl.v1.BanUserRequest
-	51,  // 118: hdlctrl.v1.ControllerService.IssueResoniteLinkConnection:input_type -> hdlctrl.v1.IssueResoniteLinkConnectionRequest
-	109, // 119: hdlctrl.v1.ControllerService.CreateScheduledSessionOperation:input_type -> hdlctrl.v1.CreateScheduledSessionOperationRequest
-	111, // 120: hdlctrl.v1.ControllerService.ListScheduledSessionOperations:input_type -> hdlctrl.v1.ListScheduledSessionOperationsRequest
-	113, // 121: hdlctrl.v1.ControllerService.CancelScheduledSessionOperation:input_type -> hdlctrl.v1.CancelScheduledSessionOperationRequest
-	59,  // 122: hdlctrl.v1.ControllerService.ListHeadlessHost:output_type -> hdlctrl.v1.ListHeadlessHostResponse
-	61,  // 123: hdlctrl.v1.ControllerService.GetHeadlessHost:output_type -> hdlctrl.v1.GetHeadlessHostResponse
-	45,  // 124: hdlctrl.v1.ControllerService.GetHeadlessHostLogs:output_type -> hdlctrl.v1.GetHeadlessHostLogsResponse
-	41,  // 125: hdlctrl.v1.ControllerService.ShutdownHeadlessHost:output_type -> hdlctrl.v1.ShutdownHeadlessHostResponse
-	43,  // 126: hdlctrl.v1.ControllerService.KillHeadlessHost:output_type -> hdlctrl.v1.KillHeadlessHostResponse
-	39,  // 127: hdlctrl.v1.ControllerService.UpdateHeadlessHostSettings:output_type -> hdlctrl.v1.UpdateHeadlessHostSettingsResponse
-	37,  // 128: hdlctrl.v1.ControllerService.RestartHeadlessHost:output_type -> hdlctrl.v1.RestartHeadlessHostResponse
-	25,  // 129: hdlctrl.v1.ControllerService.StartHeadlessHost:output_type -> hdlctrl.v1.StartHeadlessHostResponse
-	21,  // 130: hdlctrl.v1.ControllerService.AllowHostAccess:output_type -> hdlctrl.v1.AllowHostAccessResponse
-	23,  // 131: hdlctrl.v1.ControllerService.DenyHostAccess:output_type -> hdlctrl.v1.DenyHostAccessResponse
-	31,  // 132: hdlctrl.v1.ControllerService.ListHeadlessHostImageTags:output_type -> hdlctrl.v1.ListHeadlessHostImageTagsResponse
-	17,  // 133: hdlctrl.v1.ControllerService.DeleteHeadlessHost:output_type -> hdlctrl.v1.DeleteHeadlessHostResponse
-	19,  // 134: hdlctrl.v1.ControllerService.ListHeadlessHostInstances:output_type -> hdlctrl.v1.ListHeadlessHostInstancesResponse
-	27,  // 135: hdlctrl.v1.ControllerService.CreateHeadlessAccount:output_type -> hdlctrl.v1.CreateHeadlessAccountResponse
-	29,  // 136: hdlctrl.v1.ControllerService.ListHeadlessAccounts:output_type -> hdlctrl.v1.ListHeadlessAccountsResponse
-	15,  // 137: hdlctrl.v1.ControllerService.DeleteHeadlessAccount:output_type -> hdlctrl.v1.DeleteHeadlessAccountResponse
-	13,  // 138: hdlctrl.v1.ControllerService.UpdateHeadlessAccountCredentials:output_type -> hdlctrl.v1.UpdateHeadlessAccountCredentialsResponse
-	11,  // 139: hdlctrl.v1.ControllerService.GetHeadlessAccountStorageInfo:output_type -> hdlctrl.v1.GetHeadlessAccountStorageInfoResponse
-	7,   // 140: hdlctrl.v1.ControllerService.RefetchHeadlessAccountInfo:output_type -> hdlctrl.v1.RefetchHeadlessAccountInfoResponse
-	9,   // 141: hdlctrl.v1.ControllerService.UpdateHeadlessAccountIcon:output_type -> hdlctrl.v1.UpdateHeadlessAccountIconResponse
-	135, // 142: hdlctrl.v1.ControllerService.FetchWorldInfo:output_type -> headless.v1.FetchWorldInfoResponse
-	136, // 143: hdlctrl.v1.ControllerService.SearchUserInfo:output_type -> headless.v1.SearchUserInfoResponse
-	55,  // 144: hdlctrl.v1.ControllerService.SearchWorlds:output_type -> hdlctrl.v1.SearchWorldsResponse
-	57,  // 145: hdlctrl.v1.ControllerService.GetOwnWorlds:output_type -> hdlctrl.v1.GetOwnWorldsResponse
-	96,  // 146: hdlctrl.v1.ControllerService.GetResoniteUser:output_type -> hdlctrl.v1.GetResoniteUserResponse
-	35,  // 147: hdlctrl.v1.ControllerService.GetFriendRequests:output_type -> hdlctrl.v1.GetFriendRequestsResponse
-	33,  // 148: hdlctrl.v1.ControllerService.AcceptFriendRequests:output_type -> hdlctrl.v1.AcceptFriendRequestsResponse
-	98,  // 149: hdlctrl.v1.ControllerService.ListContacts:output_type -> hdlctrl.v1.ListContactsResponse
-	100, // 150: hdlctrl.v1.ControllerService.GetContactMessages:output_type -> hdlctrl.v1.GetContactMessagesResponse
-	103, // 151: hdlctrl.v1.ControllerService.SendContactMessage:output_type -> hdlctrl.v1.SendContactMessageResponse
-	65,  // 152: hdlctrl.v1.ControllerService.SearchSessions:output_type -> hdlctrl.v1.SearchSessionsResponse
-	67,  // 153: hdlctrl.v1.ControllerService.GetSessionDetails:output_type -> hdlctrl.v1.GetSessionDetailsResponse
-	69,  // 154: hdlctrl.v1.ControllerService.StartWorld:output_type -> hdlctrl.v1.StartWorldResponse
-	71,  // 155: hdlctrl.v1.ControllerService.StopSession:output_type -> hdlctrl.v1.StopSessionResponse
-	73,  // 156: hdlctrl.v1.ControllerService.DeleteEndedSession:output_type -> hdlctrl.v1.DeleteEndedSessionResponse
-	75,  // 157: hdlctrl.v1.ControllerService.SaveSessionWorld:output_type -> hdlctrl.v1.SaveSessionWorldResponse
-	77,  // 158: hdlctrl.v1.ControllerService.PrepareSessionWorldDownload:output_type -> hdlctrl.v1.PrepareSessionWorldDownloadResponse
-	79,  // 159: hdlctrl.v1.ControllerService.InviteUser:output_type -> hdlctrl.v1.InviteUserResponse
-	81,  // 160: hdlctrl.v1.ControllerService.UpdateUserRole:output_type -> hdlctrl.v1.UpdateUserRoleResponse
-	83,  // 161: hdlctrl.v1.ControllerService.UpdateSessionParameters:output_type -> hdlctrl.v1.UpdateSessionParametersResponse
-	85,  // 162: hdlctrl.v1.ControllerService.UpdateSessionExtraSettings:output_type -> hdlctrl.v1.UpdateSessionExtraSettingsResponse
-	87,  // 163: hdlctrl.v1.ControllerService.ListUsersInSession:output_type -> hdlctrl.v1.ListUsersInSessionResponse
-	48,  // 164: hdlctrl.v1.ControllerService.KickUser:output_type -> hdlctrl.v1.KickUserResponse
-	50,  // 165: hdlctrl.v1.ControllerService.BanUser:output_type -> hdlctrl.v1.BanUserResponse
-	52,  // 166: hdlctrl.v1.ControllerService.IssueResoniteLinkConnection:output_type -> hdlctrl.v1.IssueResoniteLinkConnectionResponse
-	110, // 167: hdlctrl.v1.ControllerService.CreateScheduledSessionOperation:output_type -> hdlctrl.v1.CreateScheduledSessionOperationResponse
-	112, // 168: hdlctrl.v1.ControllerService.ListScheduledSessionOperations:output_type -> hdlctrl.v1.ListScheduledSessionOperationsResponse
-	114, // 169: hdlctrl.v1.ControllerService.CancelScheduledSessionOperation:output_type -> hdlctrl.v1.CancelScheduledSessionOperationResponse
-	122, // [122:170] is the sub-list for method output_type
-	74,  // [74:122] is the sub-list for method input_type
-	74,  // [74:74] is the sub-list for extension type_name
-	74,  // [74:74] is the sub-list for extension extendee
-	0,   // [0:74] is the sub-list for field type_name
+	180, // 0: hdlctrl.v1.ListHeadlessHostInstancesResponse.instances:type_name -> hdlctrl.v1.ListHeadlessHostInstancesResponse.Instance
+	186, // 1: hdlctrl.v1.AllowHostAccessRequest.request:type_name -> headless.v1.AllowHostAccessRequest
+	187, // 2: hdlctrl.v1.DenyHostAccessRequest.request:type_name -> headless.v1.DenyHostAccessRequest
+	188, // 3: hdlctrl.v1.StartHeadlessHostRequest.startup_config:type_name -> headless.v1.StartupConfig
+	9,   // 4: hdlctrl.v1.StartHeadlessHostRequest.auto_update_policy:type_name -> hdlctrl.v1.HeadlessHostAutoUpdatePolicy
+	144, // 5: hdlctrl.v1.StartHeadlessHostRequest.container_settings:type_name -> hdlctrl.v1.HeadlessHostContainerSettings
+	10,  // 6: hdlctrl.v1.StartHeadlessHostRequest.auto_restart_policy:type_name -> hdlctrl.v1.HeadlessHostAutoRestartPolicy
+	130, // 7: hdlctrl.v1.ListHeadlessAccountsRequest.page:type_name -> hdlctrl.v1.PageRequest
+	148, // 8: hdlctrl.v1.ListHeadlessAccountsResponse.accounts:type_name -> hdlctrl.v1.HeadlessAccount
+	131, // 9: hdlctrl.v1.ListHeadlessAccountsResponse.page:type_name -> hdlctrl.v1.PageResponse
+	181, // 10: hdlctrl.v1.ListHeadlessHostImageTagsResponse.tags:type_name -> hdlctrl.v1.ListHeadlessHostImageTagsResponse.ContainerImage
+	149, // 11: hdlctrl.v1.GetFriendRequestsResponse.requested_contacts:type_name -> hdlctrl.v1.UserInfo
+	9,   // 12: hdlctrl.v1.UpdateHeadlessHostSettingsRequest.auto_update_policy:type_name -> hdlctrl.v1.HeadlessHostAutoUpdatePolicy
+	144, // 13: hdlctrl.v1.UpdateHeadlessHostSettingsRequest.container_settings:type_name -> hdlctrl.v1.HeadlessHostContainerSettings
+	10,  // 14: hdlctrl.v1.UpdateHeadlessHostSettingsRequest.auto_restart_policy:type_name -> hdlctrl.v1.HeadlessHostAutoRestartPolicy
+	182, // 15: hdlctrl.v1.GetHeadlessHostLogsResponse.logs:type_name -> hdlctrl.v1.GetHeadlessHostLogsResponse.Log
+	6,   // 16: hdlctrl.v1.TailHeadlessHostLogsRequest.min_level:type_name -> hdlctrl.v1.HeadlessHostLogLevel
+	182, // 17: hdlctrl.v1.TailHeadlessHostLogsResponse.logs:type_name -> hdlctrl.v1.GetHeadlessHostLogsResponse.Log
+	189, // 18: hdlctrl.v1.SearchHeadlessHostLogsRequest.since:type_name -> google.protobuf.Timestamp
+	189, // 19: hdlctrl.v1.SearchHeadlessHostLogsRequest.until:type_name -> google.protobuf.Timestamp
+	183, // 20: hdlctrl.v1.SearchHeadlessHostLogsResponse.groups:type_name -> hdlctrl.v1.SearchHeadlessHostLogsResponse.Group
+	7,   // 21: hdlctrl.v1.PrepareHeadlessHostLogDownloadRequest.format:type_name -> hdlctrl.v1.HeadlessHostLogExportFormat
+	189, // 22: hdlctrl.v1.PrepareHeadlessHostLogDownloadRequest.since:type_name -> google.protobuf.Timestamp
+	189, // 23: hdlctrl.v1.PrepareHeadlessHostLogDownloadRequest.until:type_name -> google.protobuf.Timestamp
+	190, // 24: hdlctrl.v1.SearchUserInfoRequest.parameters:type_name -> headless.v1.SearchUserInfoRequest
+	191, // 25: hdlctrl.v1.KickUserRequest.parameters:type_name -> headless.v1.KickUserRequest
+	192, // 26: hdlctrl.v1.BanUserRequest.parameters:type_name -> headless.v1.BanUserRequest
+	189, // 27: hdlctrl.v1.IssueResoniteLinkConnectionResponse.expires_at:type_name -> google.protobuf.Timestamp
+	0,   // 28: hdlctrl.v1.SessionUserEvent.kind:type_name -> hdlctrl.v1.SessionUserEventKind
+	189, // 29: hdlctrl.v1.SessionUserEvent.occurred_at:type_name -> google.protobuf.Timestamp
+	71,  // 30: hdlctrl.v1.ListSessionUserEventsResponse.events:type_name -> hdlctrl.v1.SessionUserEvent
+	189, // 31: hdlctrl.v1.SessionAttendee.first_joined_at:type_name -> google.protobuf.Timestamp
+	189, // 32: hdlctrl.v1.SessionAttendee.last_left_at:type_name -> google.protobuf.Timestamp
+	74,  // 33: hdlctrl.v1.GetSessionAttendanceResponse.attendees:type_name -> hdlctrl.v1.SessionAttendee
+	189, // 34: hdlctrl.v1.GetSessionAttendanceResponse.peak_at:type_name -> google.protobuf.Timestamp
+	189, // 35: hdlctrl.v1.GetSessionUsageStatsRequest.since:type_name -> google.protobuf.Timestamp
+	189, // 36: hdlctrl.v1.GetSessionUsageStatsRequest.until:type_name -> google.protobuf.Timestamp
+	1,   // 37: hdlctrl.v1.GetSessionUsageStatsRequest.group_by:type_name -> hdlctrl.v1.SessionUsageGroupBy
+	2,   // 38: hdlctrl.v1.GetSessionUsageStatsRequest.interval:type_name -> hdlctrl.v1.SessionUsageInterval
+	189, // 39: hdlctrl.v1.SessionUsageBucket.start:type_name -> google.protobuf.Timestamp
+	78,  // 40: hdlctrl.v1.SessionUsageSeries.buckets:type_name -> hdlctrl.v1.SessionUsageBucket
+	79,  // 41: hdlctrl.v1.GetSessionUsageStatsResponse.series:type_name -> hdlctrl.v1.SessionUsageSeries
+	3,   // 42: hdlctrl.v1.GetMetricsSeriesRequest.kind:type_name -> hdlctrl.v1.MetricKind
+	189, // 43: hdlctrl.v1.GetMetricsSeriesRequest.since:type_name -> google.protobuf.Timestamp
+	189, // 44: hdlctrl.v1.GetMetricsSeriesRequest.until:type_name -> google.protobuf.Timestamp
+	4,   // 45: hdlctrl.v1.GetMetricsSeriesRequest.resolution:type_name -> hdlctrl.v1.MetricResolution
+	189, // 46: hdlctrl.v1.MetricPoint.at:type_name -> google.protobuf.Timestamp
+	4,   // 47: hdlctrl.v1.GetMetricsSeriesResponse.resolution:type_name -> hdlctrl.v1.MetricResolution
+	82,  // 48: hdlctrl.v1.GetMetricsSeriesResponse.points:type_name -> hdlctrl.v1.MetricPoint
+	184, // 49: hdlctrl.v1.SearchWorldsResponse.records:type_name -> hdlctrl.v1.SearchWorldsResponse.WorldRecord
+	184, // 50: hdlctrl.v1.GetOwnWorldsResponse.records:type_name -> hdlctrl.v1.SearchWorldsResponse.WorldRecord
+	130, // 51: hdlctrl.v1.ListHeadlessHostRequest.page:type_name -> hdlctrl.v1.PageRequest
+	146, // 52: hdlctrl.v1.ListHeadlessHostResponse.hosts:type_name -> hdlctrl.v1.HeadlessHost
+	131, // 53: hdlctrl.v1.ListHeadlessHostResponse.page:type_name -> hdlctrl.v1.PageResponse
+	146, // 54: hdlctrl.v1.GetHeadlessHostResponse.host:type_name -> hdlctrl.v1.HeadlessHost
+	146, // 55: hdlctrl.v1.AddHeadlessHostResponse.host:type_name -> hdlctrl.v1.HeadlessHost
+	185, // 56: hdlctrl.v1.SearchSessionsRequest.parameters:type_name -> hdlctrl.v1.SearchSessionsRequest.SearchParameters
+	130, // 57: hdlctrl.v1.SearchSessionsRequest.page:type_name -> hdlctrl.v1.PageRequest
+	147, // 58: hdlctrl.v1.SearchSessionsResponse.sessions:type_name -> hdlctrl.v1.Session
+	131, // 59: hdlctrl.v1.SearchSessionsResponse.page:type_name -> hdlctrl.v1.PageResponse
+	147, // 60: hdlctrl.v1.GetSessionDetailsResponse.session:type_name -> hdlctrl.v1.Session
+	193, // 61: hdlctrl.v1.StartWorldRequest.parameters:type_name -> headless.v1.WorldStartupParameters
+	14,  // 62: hdlctrl.v1.SaveSessionWorldRequest.save_mode:type_name -> hdlctrl.v1.SaveSessionWorldRequest.SaveMode
+	194, // 63: hdlctrl.v1.PrepareSessionWorldDownloadRequest.format:type_name -> headless.v1.WorldBinaryFormat
+	195, // 64: hdlctrl.v1.UpdateUserRoleRequest.parameters:type_name -> headless.v1.UpdateUserRoleRequest
+	196, // 65: hdlctrl.v1.UpdateSessionParametersRequest.parameters:type_name -> headless.v1.UpdateSessionParametersRequest
+	197, // 66: hdlctrl.v1.ListUsersInSessionResponse.users:type_name -> headless.v1.UserInSession
+	193, // 67: hdlctrl.v1.SessionTemplate.parameters:type_name -> headless.v1.WorldStartupParameters
+	189, // 68: hdlctrl.v1.SessionTemplate.created_at:type_name -> google.protobuf.Timestamp
+	189, // 69: hdlctrl.v1.SessionTemplate.updated_at:type_name -> google.protobuf.Timestamp
+	119, // 70: hdlctrl.v1.ListSessionTemplatesResponse.templates:type_name -> hdlctrl.v1.SessionTemplate
+	119, // 71: hdlctrl.v1.GetSessionTemplateResponse.template:type_name -> hdlctrl.v1.SessionTemplate
+	193, // 72: hdlctrl.v1.CreateSessionTemplateRequest.parameters:type_name -> headless.v1.WorldStartupParameters
+	119, // 73: hdlctrl.v1.CreateSessionTemplateResponse.template:type_name -> hdlctrl.v1.SessionTemplate
+	193, // 74: hdlctrl.v1.UpdateSessionTemplateRequest.parameters:type_name -> headless.v1.WorldStartupParameters
+	119, // 75: hdlctrl.v1.UpdateSessionTemplateResponse.template:type_name -> hdlctrl.v1.SessionTemplate
+	188, // 76: hdlctrl.v1.HostTemplate.startup_config:type_name -> headless.v1.StartupConfig
+	9,   // 77: hdlctrl.v1.HostTemplate.auto_update_policy:type_name -> hdlctrl.v1.HeadlessHostAutoUpdatePolicy
+	144, // 78: hdlctrl.v1.HostTemplate.container_settings:type_name -> hdlctrl.v1.HeadlessHostContainerSettings
+	10,  // 79: hdlctrl.v1.HostTemplate.auto_restart_policy:type_name -> hdlctrl.v1.HeadlessHostAutoRestartPolicy
+	189, // 80: hdlctrl.v1.HostTemplate.created_at:type_name -> google.protobuf.Timestamp
+	189, // 81: hdlctrl.v1.HostTemplate.updated_at:type_name -> google.protobuf.Timestamp
+	133, // 82: hdlctrl.v1.ListHostTemplatesResponse.templates:type_name -> hdlctrl.v1.HostTemplate
+	133, // 83: hdlctrl.v1.GetHostTemplateResponse.template:type_name -> hdlctrl.v1.HostTemplate
+	188, // 84: hdlctrl.v1.CreateHostTemplateRequest.startup_config:type_name -> headless.v1.StartupConfig
+	9,   // 85: hdlctrl.v1.CreateHostTemplateRequest.auto_update_policy:type_name -> hdlctrl.v1.HeadlessHostAutoUpdatePolicy
+	144, // 86: hdlctrl.v1.CreateHostTemplateRequest.container_settings:type_name -> hdlctrl.v1.HeadlessHostContainerSettings
+	10,  // 87: hdlctrl.v1.CreateHostTemplateRequest.auto_restart_policy:type_name -> hdlctrl.v1.HeadlessHostAutoRestartPolicy
+	133, // 88: hdlctrl.v1.CreateHostTemplateResponse.template:type_name -> hdlctrl.v1.HostTemplate
+	188, // 89: hdlctrl.v1.UpdateHostTemplateRequest.startup_config:type_name -> headless.v1.StartupConfig
+	9,   // 90: hdlctrl.v1.UpdateHostTemplateRequest.auto_update_policy:type_name -> hdlctrl.v1.HeadlessHostAutoUpdatePolicy
+	144, // 91: hdlctrl.v1.UpdateHostTemplateRequest.container_settings:type_name -> hdlctrl.v1.HeadlessHostContainerSettings
+	10,  // 92: hdlctrl.v1.UpdateHostTemplateRequest.auto_restart_policy:type_name -> hdlctrl.v1.HeadlessHostAutoRestartPolicy
+	133, // 93: hdlctrl.v1.UpdateHostTemplateResponse.template:type_name -> hdlctrl.v1.HostTemplate
+	11,  // 94: hdlctrl.v1.HeadlessHostContainerSettings.restart_policy:type_name -> hdlctrl.v1.HeadlessHostRestartPolicy
+	132, // 95: hdlctrl.v1.HeadlessHostContainerSettings.bind_mounts:type_name -> hdlctrl.v1.HeadlessHostBindMount
+	198, // 96: hdlctrl.v1.HeadlessHostSettings.allowed_url_hosts:type_name -> headless.v1.AllowedAccessEntry
+	5,   // 97: hdlctrl.v1.HeadlessHost.status:type_name -> hdlctrl.v1.HeadlessHostStatus
+	9,   // 98: hdlctrl.v1.HeadlessHost.auto_update_policy:type_name -> hdlctrl.v1.HeadlessHostAutoUpdatePolicy
+	145, // 99: hdlctrl.v1.HeadlessHost.host_settings:type_name -> hdlctrl.v1.HeadlessHostSettings
+	144, // 100: hdlctrl.v1.HeadlessHost.container_settings:type_name -> hdlctrl.v1.HeadlessHostContainerSettings
+	10,  // 101: hdlctrl.v1.HeadlessHost.auto_restart_policy:type_name -> hdlctrl.v1.HeadlessHostAutoRestartPolicy
+	189, // 102: hdlctrl.v1.HeadlessHost.last_crashed_at:type_name -> google.protobuf.Timestamp
+	8,   // 103: hdlctrl.v1.Session.status:type_name -> hdlctrl.v1.SessionStatus
+	189, // 104: hdlctrl.v1.Session.started_at:type_name -> google.protobuf.Timestamp
+	189, // 105: hdlctrl.v1.Session.ended_at:type_name -> google.protobuf.Timestamp
+	193, // 106: hdlctrl.v1.Session.startup_parameters:type_name -> headless.v1.WorldStartupParameters
+	199, // 107: hdlctrl.v1.Session.current_state:type_name -> headless.v1.Session
+	149, // 108: hdlctrl.v1.ListContactsResponse.contacts:type_name -> hdlctrl.v1.UserInfo
+	156, // 109: hdlctrl.v1.GetContactMessagesResponse.messages:type_name -> hdlctrl.v1.ContactMessage
+	200, // 110: hdlctrl.v1.ContactMessage.type:type_name -> headless.v1.ContactChatMessageType
+	189, // 111: hdlctrl.v1.ContactMessage.send_time:type_name -> google.protobuf.Timestamp
+	189, // 112: hdlctrl.v1.ContactMessage.read_time:type_name -> google.protobuf.Timestamp
+	99,  // 113: hdlctrl.v1.ScheduledOperation.start_session:type_name -> hdlctrl.v1.StartWorldRequest
+	101, // 114: hdlctrl.v1.ScheduledOperation.stop_session:type_name -> hdlctrl.v1.StopSessionRequest
+	113, // 115: hdlctrl.v1.ScheduledOperation.update_parameters:type_name -> hdlctrl.v1.UpdateSessionParametersRequest
+	115, // 116: hdlctrl.v1.ScheduledOperation.update_extra_settings:type_name -> hdlctrl.v1.UpdateSessionExtraSettingsRequest
+	161, // 117: hdlctrl.v1.ScheduledTrigger.time:type_name -> hdlctrl.v1.TimeTrigger
+	162, // 118: hdlctrl.v1.ScheduledTrigger.session_user_count:type_name -> hdlctrl.v1.SessionUserCountTrigger
+	163, // 119: hdlctrl.v1.ScheduledTrigger.cron:type_name -> hdlctrl.v1.CronTrigger
+	189, // 120: hdlctrl.v1.TimeTrigger.scheduled_at:type_name -> google.protobuf.Timestamp
+	15,  // 121: hdlctrl.v1.SessionUserCountTrigger.comparator:type_name -> hdlctrl.v1.SessionUserCountTrigger.Comparator
+	159, // 122: hdlctrl.v1.ScheduledSessionOperation.operation:type_name -> hdlctrl.v1.ScheduledOperation
+	160, // 123: hdlctrl.v1.ScheduledSessionOperation.trigger:type_name -> hdlctrl.v1.ScheduledTrigger
+	189, // 124: hdlctrl.v1.ScheduledSessionOperation.next_fire_at:type_name -> google.protobuf.Timestamp
+	12,  // 125: hdlctrl.v1.ScheduledSessionOperation.status:type_name -> hdlctrl.v1.ScheduledOperationStatus
+	189, // 126: hdlctrl.v1.ScheduledSessionOperation.executed_at:type_name -> google.protobuf.Timestamp
+	189, // 127: hdlctrl.v1.ScheduledSessionOperation.created_at:type_name -> google.protobuf.Timestamp
+	189, // 128: hdlctrl.v1.ScheduledSessionOperation.updated_at:type_name -> google.protobuf.Timestamp
+	159, // 129: hdlctrl.v1.CreateScheduledSessionOperationRequest.operation:type_name -> hdlctrl.v1.ScheduledOperation
+	160, // 130: hdlctrl.v1.CreateScheduledSessionOperationRequest.trigger:type_name -> hdlctrl.v1.ScheduledTrigger
+	164, // 131: hdlctrl.v1.CreateScheduledSessionOperationResponse.scheduled_operation:type_name -> hdlctrl.v1.ScheduledSessionOperation
+	12,  // 132: hdlctrl.v1.ListScheduledSessionOperationsRequest.status:type_name -> hdlctrl.v1.ScheduledOperationStatus
+	130, // 133: hdlctrl.v1.ListScheduledSessionOperationsRequest.page:type_name -> hdlctrl.v1.PageRequest
+	164, // 134: hdlctrl.v1.ListScheduledSessionOperationsResponse.scheduled_operations:type_name -> hdlctrl.v1.ScheduledSessionOperation
+	131, // 135: hdlctrl.v1.ListScheduledSessionOperationsResponse.page:type_name -> hdlctrl.v1.PageResponse
+	164, // 136: hdlctrl.v1.PauseScheduledSessionOperationResponse.scheduled_operation:type_name -> hdlctrl.v1.ScheduledSessionOperation
+	164, // 137: hdlctrl.v1.ResumeScheduledSessionOperationResponse.scheduled_operation:type_name -> hdlctrl.v1.ScheduledSessionOperation
+	164, // 138: hdlctrl.v1.SkipScheduledSessionOperationOccurrenceResponse.scheduled_operation:type_name -> hdlctrl.v1.ScheduledSessionOperation
+	189, // 139: hdlctrl.v1.ScheduledOperationRun.scheduled_for:type_name -> google.protobuf.Timestamp
+	189, // 140: hdlctrl.v1.ScheduledOperationRun.started_at:type_name -> google.protobuf.Timestamp
+	189, // 141: hdlctrl.v1.ScheduledOperationRun.finished_at:type_name -> google.protobuf.Timestamp
+	13,  // 142: hdlctrl.v1.ScheduledOperationRun.outcome:type_name -> hdlctrl.v1.ScheduledOperationRunOutcome
+	130, // 143: hdlctrl.v1.ListScheduledSessionOperationRunsRequest.page:type_name -> hdlctrl.v1.PageRequest
+	177, // 144: hdlctrl.v1.ListScheduledSessionOperationRunsResponse.runs:type_name -> hdlctrl.v1.ScheduledOperationRun
+	131, // 145: hdlctrl.v1.ListScheduledSessionOperationRunsResponse.page:type_name -> hdlctrl.v1.PageResponse
+	189, // 146: hdlctrl.v1.ListHeadlessHostInstancesResponse.Instance.first_log_at:type_name -> google.protobuf.Timestamp
+	189, // 147: hdlctrl.v1.ListHeadlessHostInstancesResponse.Instance.last_log_at:type_name -> google.protobuf.Timestamp
+	189, // 148: hdlctrl.v1.GetHeadlessHostLogsResponse.Log.timestamp:type_name -> google.protobuf.Timestamp
+	6,   // 149: hdlctrl.v1.GetHeadlessHostLogsResponse.Log.level:type_name -> hdlctrl.v1.HeadlessHostLogLevel
+	182, // 150: hdlctrl.v1.SearchHeadlessHostLogsResponse.Group.logs:type_name -> hdlctrl.v1.GetHeadlessHostLogsResponse.Log
+	8,   // 151: hdlctrl.v1.SearchSessionsRequest.SearchParameters.status:type_name -> hdlctrl.v1.SessionStatus
+	89,  // 152: hdlctrl.v1.ControllerService.ListHeadlessHost:input_type -> hdlctrl.v1.ListHeadlessHostRequest
+	91,  // 153: hdlctrl.v1.ControllerService.GetHeadlessHost:input_type -> hdlctrl.v1.GetHeadlessHostRequest
+	56,  // 154: hdlctrl.v1.ControllerService.GetHeadlessHostLogs:input_type -> hdlctrl.v1.GetHeadlessHostLogsRequest
+	58,  // 155: hdlctrl.v1.ControllerService.TailHeadlessHostLogs:input_type -> hdlctrl.v1.TailHeadlessHostLogsRequest
+	60,  // 156: hdlctrl.v1.ControllerService.SearchHeadlessHostLogs:input_type -> hdlctrl.v1.SearchHeadlessHostLogsRequest
+	62,  // 157: hdlctrl.v1.ControllerService.PrepareHeadlessHostLogDownload:input_type -> hdlctrl.v1.PrepareHeadlessHostLogDownloadRequest
+	52,  // 158: hdlctrl.v1.ControllerService.ShutdownHeadlessHost:input_type -> hdlctrl.v1.ShutdownHeadlessHostRequest
+	54,  // 159: hdlctrl.v1.ControllerService.KillHeadlessHost:input_type -> hdlctrl.v1.KillHeadlessHostRequest
+	50,  // 160: hdlctrl.v1.ControllerService.UpdateHeadlessHostSettings:input_type -> hdlctrl.v1.UpdateHeadlessHostSettingsRequest
+	48,  // 161: hdlctrl.v1.ControllerService.RestartHeadlessHost:input_type -> hdlctrl.v1.RestartHeadlessHostRequest
+	34,  // 162: hdlctrl.v1.ControllerService.StartHeadlessHost:input_type -> hdlctrl.v1.StartHeadlessHostRequest
+	30,  // 163: hdlctrl.v1.ControllerService.AllowHostAccess:input_type -> hdlctrl.v1.AllowHostAccessRequest
+	32,  // 164: hdlctrl.v1.ControllerService.DenyHostAccess:input_type -> hdlctrl.v1.DenyHostAccessRequest
+	42,  // 165: hdlctrl.v1.ControllerService.ListHeadlessHostImageTags:input_type -> hdlctrl.v1.ListHeadlessHostImageTagsRequest
+	26,  // 166: hdlctrl.v1.ControllerService.DeleteHeadlessHost:input_type -> hdlctrl.v1.DeleteHeadlessHostRequest
+	28,  // 167: hdlctrl.v1.ControllerService.ListHeadlessHostInstances:input_type -> hdlctrl.v1.ListHeadlessHostInstancesRequest
+	36,  // 168: hdlctrl.v1.ControllerService.CloneHeadlessHost:input_type -> hdlctrl.v1.CloneHeadlessHostRequest
+	134, // 169: hdlctrl.v1.ControllerService.ListHostTemplates:input_type -> hdlctrl.v1.ListHostTemplatesRequest
+	136, // 170: hdlctrl.v1.ControllerService.GetHostTemplate:input_type -> hdlctrl.v1.GetHostTemplateRequest
+	138, // 171: hdlctrl.v1.ControllerService.CreateHostTemplate:input_type -> hdlctrl.v1.CreateHostTemplateRequest
+	140, // 172: hdlctrl.v1.ControllerService.UpdateHostTemplate:input_type -> hdlctrl.v1.UpdateHostTemplateRequest
+	142, // 173: hdlctrl.v1.ControllerService.DeleteHostTemplate:input_type -> hdlctrl.v1.DeleteHostTemplateRequest
+	38,  // 174: hdlctrl.v1.ControllerService.CreateHeadlessAccount:input_type -> hdlctrl.v1.CreateHeadlessAccountRequest
+	40,  // 175: hdlctrl.v1.ControllerService.ListHeadlessAccounts:input_type -> hdlctrl.v1.ListHeadlessAccountsRequest
+	24,  // 176: hdlctrl.v1.ControllerService.DeleteHeadlessAccount:input_type -> hdlctrl.v1.DeleteHeadlessAccountRequest
+	22,  // 177: hdlctrl.v1.ControllerService.UpdateHeadlessAccountCredentials:input_type -> hdlctrl.v1.UpdateHeadlessAccountCredentialsRequest
+	20,  // 178: hdlctrl.v1.ControllerService.GetHeadlessAccountStorageInfo:input_type -> hdlctrl.v1.GetHeadlessAccountStorageInfoRequest
+	16,  // 179: hdlctrl.v1.ControllerService.RefetchHeadlessAccountInfo:input_type -> hdlctrl.v1.RefetchHeadlessAccountInfoRequest
+	18,  // 180: hdlctrl.v1.ControllerService.UpdateHeadlessAccountIcon:input_type -> hdlctrl.v1.UpdateHeadlessAccountIconRequest
+	84,  // 181: hdlctrl.v1.ControllerService.FetchWorldInfo:input_type -> hdlctrl.v1.FetchWorldInfoRequest
+	64,  // 182: hdlctrl.v1.ControllerService.SearchUserInfo:input_type -> hdlctrl.v1.SearchUserInfoRequest
+	85,  // 183: hdlctrl.v1.ControllerService.SearchWorlds:input_type -> hdlctrl.v1.SearchWorldsRequest
+	87,  // 184: hdlctrl.v1.ControllerService.GetOwnWorlds:input_type -> hdlctrl.v1.GetOwnWorldsRequest
+	150, // 185: hdlctrl.v1.ControllerService.GetResoniteUser:input_type -> hdlctrl.v1.GetResoniteUserRequest
+	46,  // 186: hdlctrl.v1.ControllerService.GetFriendRequests:input_type -> hdlctrl.v1.GetFriendRequestsRequest
+	44,  // 187: hdlctrl.v1.ControllerService.AcceptFriendRequests:input_type -> hdlctrl.v1.AcceptFriendRequestsRequest
+	152, // 188: hdlctrl.v1.ControllerService.ListContacts:input_type -> hdlctrl.v1.ListContactsRequest
+	154, // 189: hdlctrl.v1.ControllerService.GetContactMessages:input_type -> hdlctrl.v1.GetContactMessagesRequest
+	157, // 190: hdlctrl.v1.ControllerService.SendContactMessage:input_type -> hdlctrl.v1.SendContactMessageRequest
+	95,  // 191: hdlctrl.v1.ControllerService.SearchSessions:input_type -> hdlctrl.v1.SearchSessionsRequest
+	97,  // 192: hdlctrl.v1.ControllerService.GetSessionDetails:input_type -> hdlctrl.v1.GetSessionDetailsRequest
+	99,  // 193: hdlctrl.v1.ControllerService.StartWorld:input_type -> hdlctrl.v1.StartWorldRequest
+	101, // 194: hdlctrl.v1.ControllerService.StopSession:input_type -> hdlctrl.v1.StopSessionRequest
+	103, // 195: hdlctrl.v1.ControllerService.DeleteEndedSession:input_type -> hdlctrl.v1.DeleteEndedSessionRequest
+	105, // 196: hdlctrl.v1.ControllerService.SaveSessionWorld:input_type -> hdlctrl.v1.SaveSessionWorldRequest
+	107, // 197: hdlctrl.v1.ControllerService.PrepareSessionWorldDownload:input_type -> hdlctrl.v1.PrepareSessionWorldDownloadRequest
+	109, // 198: hdlctrl.v1.ControllerService.InviteUser:input_type -> hdlctrl.v1.InviteUserRequest
+	111, // 199: hdlctrl.v1.ControllerService.UpdateUserRole:input_type -> hdlctrl.v1.UpdateUserRoleRequest
+	113, // 200: hdlctrl.v1.ControllerService.UpdateSessionParameters:input_type -> hdlctrl.v1.UpdateSessionParametersRequest
+	115, // 201: hdlctrl.v1.ControllerService.UpdateSessionExtraSettings:input_type -> hdlctrl.v1.UpdateSessionExtraSettingsRequest
+	117, // 202: hdlctrl.v1.ControllerService.ListUsersInSession:input_type -> hdlctrl.v1.ListUsersInSessionRequest
+	65,  // 203: hdlctrl.v1.ControllerService.KickUser:input_type -> hdlctrl.v1.KickUserRequest
+	67,  // 204: hdlctrl.v1.ControllerService.BanUser:input_type -> hdlctrl.v1.BanUserRequest
+	69,  // 205: hdlctrl.v1.ControllerService.IssueResoniteLinkConnection:input_type -> hdlctrl.v1.IssueResoniteLinkConnectionRequest
+	72,  // 206: hdlctrl.v1.ControllerService.ListSessionUserEvents:input_type -> hdlctrl.v1.ListSessionUserEventsRequest
+	75,  // 207: hdlctrl.v1.ControllerService.GetSessionAttendance:input_type -> hdlctrl.v1.GetSessionAttendanceRequest
+	77,  // 208: hdlctrl.v1.ControllerService.GetSessionUsageStats:input_type -> hdlctrl.v1.GetSessionUsageStatsRequest
+	81,  // 209: hdlctrl.v1.ControllerService.GetMetricsSeries:input_type -> hdlctrl.v1.GetMetricsSeriesRequest
+	120, // 210: hdlctrl.v1.ControllerService.ListSessionTemplates:input_type -> hdlctrl.v1.ListSessionTemplatesRequest
+	122, // 211: hdlctrl.v1.ControllerService.GetSessionTemplate:input_type -> hdlctrl.v1.GetSessionTemplateRequest
+	124, // 212: hdlctrl.v1.ControllerService.CreateSessionTemplate:input_type -> hdlctrl.v1.CreateSessionTemplateRequest
+	126, // 213: hdlctrl.v1.ControllerService.UpdateSessionTemplate:input_type -> hdlctrl.v1.UpdateSessionTemplateRequest
+	128, // 214: hdlctrl.v1.ControllerService.DeleteSessionTemplate:input_type -> hdlctrl.v1.DeleteSessionTemplateRequest
+	165, // 215: hdlctrl.v1.ControllerService.CreateScheduledSessionOperation:input_type -> hdlctrl.v1.CreateScheduledSessionOperationRequest
+	167, // 216: hdlctrl.v1.ControllerService.ListScheduledSessionOperations:input_type -> hdlctrl.v1.ListScheduledSessionOperationsRequest
+	169, // 217: hdlctrl.v1.ControllerService.CancelScheduledSessionOperation:input_type -> hdlctrl.v1.CancelScheduledSessionOperationRequest
+	171, // 218: hdlctrl.v1.ControllerService.PauseScheduledSessionOperation:input_type -> hdlctrl.v1.PauseScheduledSessionOperationRequest
+	173, // 219: hdlctrl.v1.ControllerService.ResumeScheduledSessionOperation:input_type -> hdlctrl.v1.ResumeScheduledSessionOperationRequest
+	175, // 220: hdlctrl.v1.ControllerService.SkipScheduledSessionOperationOccurrence:input_type -> hdlctrl.v1.SkipScheduledSessionOperationOccurrenceRequest
+	178, // 221: hdlctrl.v1.ControllerService.ListScheduledSessionOperationRuns:input_type -> hdlctrl.v1.ListScheduledSessionOperationRunsRequest
+	90,  // 222: hdlctrl.v1.ControllerService.ListHeadlessHost:output_type -> hdlctrl.v1.ListHeadlessHostResponse
+	92,  // 223: hdlctrl.v1.ControllerService.GetHeadlessHost:output_type -> hdlctrl.v1.GetHeadlessHostResponse
+	57,  // 224: hdlctrl.v1.ControllerService.GetHeadlessHostLogs:output_type -> hdlctrl.v1.GetHeadlessHostLogsResponse
+	59,  // 225: hdlctrl.v1.ControllerService.TailHeadlessHostLogs:output_type -> hdlctrl.v1.TailHeadlessHostLogsResponse
+	61,  // 226: hdlctrl.v1.ControllerService.SearchHeadlessHostLogs:output_type -> hdlctrl.v1.SearchHeadlessHostLogsResponse
+	63,  // 227: hdlctrl.v1.ControllerService.PrepareHeadlessHostLogDownload:output_type -> hdlctrl.v1.PrepareHeadlessHostLogDownloadResponse
+	53,  // 228: hdlctrl.v1.ControllerService.ShutdownHeadlessHost:output_type -> hdlctrl.v1.ShutdownHeadlessHostResponse
+	55,  // 229: hdlctrl.v1.ControllerService.KillHeadlessHost:output_type -> hdlctrl.v1.KillHeadlessHostResponse
+	51,  // 230: hdlctrl.v1.ControllerService.UpdateHeadlessHostSettings:output_type -> hdlctrl.v1.UpdateHeadlessHostSettingsResponse
+	49,  // 231: hdlctrl.v1.ControllerService.RestartHeadlessHost:output_type -> hdlctrl.v1.RestartHeadlessHostResponse
+	35,  // 232: hdlctrl.v1.ControllerService.StartHeadlessHost:output_type -> hdlctrl.v1.StartHeadlessHostResponse
+	31,  // 233: hdlctrl.v1.ControllerService.AllowHostAccess:output_type -> hdlctrl.v1.AllowHostAccessResponse
+	33,  // 234: hdlctrl.v1.ControllerService.DenyHostAccess:output_type -> hdlctrl.v1.DenyHostAccessResponse
+	43,  // 235: hdlctrl.v1.ControllerService.ListHeadlessHostImageTags:output_type -> hdlctrl.v1.ListHeadlessHostImageTagsResponse
+	27,  // 236: hdlctrl.v1.ControllerService.DeleteHeadlessHost:output_type -> hdlctrl.v1.DeleteHeadlessHostResponse
+	29,  // 237: hdlctrl.v1.ControllerService.ListHeadlessHostInstances:output_type -> hdlctrl.v1.ListHeadlessHostInstancesResponse
+	37,  // 238: hdlctrl.v1.ControllerService.CloneHeadlessHost:output_type -> hdlctrl.v1.CloneHeadlessHostResponse
+	135, // 239: hdlctrl.v1.ControllerService.ListHostTemplates:output_type -> hdlctrl.v1.ListHostTemplatesResponse
+	137, // 240: hdlctrl.v1.ControllerService.GetHostTemplate:output_type -> hdlctrl.v1.GetHostTemplateResponse
+	139, // 241: hdlctrl.v1.ControllerService.CreateHostTemplate:output_type -> hdlctrl.v1.CreateHostTemplateResponse
+	141, // 242: hdlctrl.v1.ControllerService.UpdateHostTemplate:output_type -> hdlctrl.v1.UpdateHostTemplateResponse
+	143, // 243: hdlctrl.v1.ControllerService.DeleteHostTemplate:output_type -> hdlctrl.v1.DeleteHostTemplateResponse
+	39,  // 244: hdlctrl.v1.ControllerService.CreateHeadlessAccount:output_type -> hdlctrl.v1.CreateHeadlessAccountResponse
+	41,  // 245: hdlctrl.v1.ControllerService.ListHeadlessAccounts:output_type -> hdlctrl.v1.ListHeadlessAccountsResponse
+	25,  // 246: hdlctrl.v1.ControllerService.DeleteHeadlessAccount:output_type -> hdlctrl.v1.DeleteHeadlessAccountResponse
+	23,  // 247: hdlctrl.v1.ControllerService.UpdateHeadlessAccountCredentials:output_type -> hdlctrl.v1.UpdateHeadlessAccountCredentialsResponse
+	21,  // 248: hdlctrl.v1.ControllerService.GetHeadlessAccountStorageInfo:output_type -> hdlctrl.v1.GetHeadlessAccountStorageInfoResponse
+	17,  // 249: hdlctrl.v1.ControllerService.RefetchHeadlessAccountInfo:output_type -> hdlctrl.v1.RefetchHeadlessAccountInfoResponse
+	19,  // 250: hdlctrl.v1.ControllerService.UpdateHeadlessAccountIcon:output_type -> hdlctrl.v1.UpdateHeadlessAccountIconResponse
+	201, // 251: hdlctrl.v1.ControllerService.FetchWorldInfo:output_type -> headless.v1.FetchWorldInfoResponse
+	202, // 252: hdlctrl.v1.ControllerService.SearchUserInfo:output_type -> headless.v1.SearchUserInfoResponse
+	86,  // 253: hdlctrl.v1.ControllerService.SearchWorlds:output_type -> hdlctrl.v1.SearchWorldsResponse
+	88,  // 254: hdlctrl.v1.ControllerService.GetOwnWorlds:output_type -> hdlctrl.v1.GetOwnWorldsResponse
+	151, // 255: hdlctrl.v1.ControllerService.GetResoniteUser:output_type -> hdlctrl.v1.GetResoniteUserResponse
+	47,  // 256: hdlctrl.v1.ControllerService.GetFriendRequests:output_type -> hdlctrl.v1.GetFriendRequestsResponse
+	45,  // 257: hdlctrl.v1.ControllerService.AcceptFriendRequests:output_type -> hdlctrl.v1.AcceptFriendRequestsResponse
+	153, // 258: hdlctrl.v1.ControllerService.ListContacts:output_type -> hdlctrl.v1.ListContactsResponse
+	155, // 259: hdlctrl.v1.ControllerService.GetContactMessages:output_type -> hdlctrl.v1.GetContactMessagesResponse
+	158, // 260: hdlctrl.v1.ControllerService.SendContactMessage:output_type -> hdlctrl.v1.SendContactMessageResponse
+	96,  // 261: hdlctrl.v1.ControllerService.SearchSessions:output_type -> hdlctrl.v1.SearchSessionsResponse
+	98,  // 262: hdlctrl.v1.ControllerService.GetSessionDetails:output_type -> hdlctrl.v1.GetSessionDetailsResponse
+	100, // 263: hdlctrl.v1.ControllerService.StartWorld:output_type -> hdlctrl.v1.StartWorldResponse
+	102, // 264: hdlctrl.v1.ControllerService.StopSession:output_type -> hdlctrl.v1.StopSessionResponse
+	104, // 265: hdlctrl.v1.ControllerService.DeleteEndedSession:output_type -> hdlctrl.v1.DeleteEndedSessionResponse
+	106, // 266: hdlctrl.v1.ControllerService.SaveSessionWorld:output_type -> hdlctrl.v1.SaveSessionWorldResponse
+	108, // 267: hdlctrl.v1.ControllerService.PrepareSessionWorldDownload:output_type -> hdlctrl.v1.PrepareSessionWorldDownloadResponse
+	110, // 268: hdlctrl.v1.ControllerService.InviteUser:output_type -> hdlctrl.v1.InviteUserResponse
+	112, // 269: hdlctrl.v1.ControllerService.UpdateUserRole:output_type -> hdlctrl.v1.UpdateUserRoleResponse
+	114, // 270: hdlctrl.v1.ControllerService.UpdateSessionParameters:output_type -> hdlctrl.v1.UpdateSessionParametersResponse
+	116, // 271: hdlctrl.v1.ControllerService.UpdateSessionExtraSettings:output_type -> hdlctrl.v1.UpdateSessionExtraSettingsResponse
+	118, // 272: hdlctrl.v1.ControllerService.ListUsersInSession:output_type -> hdlctrl.v1.ListUsersInSessionResponse
+	66,  // 273: hdlctrl.v1.ControllerService.KickUser:output_type -> hdlctrl.v1.KickUserResponse
+	68,  // 274: hdlctrl.v1.ControllerService.BanUser:output_type -> hdlctrl.v1.BanUserResponse
+	70,  // 275: hdlctrl.v1.ControllerService.IssueResoniteLinkConnection:output_type -> hdlctrl.v1.IssueResoniteLinkConnectionResponse
+	73,  // 276: hdlctrl.v1.ControllerService.ListSessionUserEvents:output_type -> hdlctrl.v1.ListSessionUserEventsResponse
+	76,  // 277: hdlctrl.v1.ControllerService.GetSessionAttendance:output_type -> hdlctrl.v1.GetSessionAttendanceResponse
+	80,  // 278: hdlctrl.v1.ControllerService.GetSessionUsageStats:output_type -> hdlctrl.v1.GetSessionUsageStatsResponse
+	83,  // 279: hdlctrl.v1.ControllerService.GetMetricsSeries:output_type -> hdlctrl.v1.GetMetricsSeriesResponse
+	121, // 280: hdlctrl.v1.ControllerService.ListSessionTemplates:output_type -> hdlctrl.v1.ListSessionTemplatesResponse
+	123, // 281: hdlctrl.v1.ControllerService.GetSessionTemplate:output_type -> hdlctrl.v1.GetSessionTemplateResponse
+	125, // 282: hdlctrl.v1.ControllerService.CreateSessionTemplate:output_type -> hdlctrl.v1.CreateSessionTemplateResponse
+	127, // 283: hdlctrl.v1.ControllerService.UpdateSessionTemplate:output_type -> hdlctrl.v1.UpdateSessionTemplateResponse
+	129, // 284: hdlctrl.v1.ControllerService.DeleteSessionTemplate:output_type -> hdlctrl.v1.DeleteSessionTemplateResponse
+	166, // 285: hdlctrl.v1.ControllerService.CreateScheduledSessionOperation:output_type -> hdlctrl.v1.CreateScheduledSessionOperationResponse
+	168, // 286: hdlctrl.v1.ControllerService.ListScheduledSessionOperations:output_type -> hdlctrl.v1.ListScheduledSessionOperationsResponse
+	170, // 287: hdlctrl.v1.ControllerService.CancelScheduledSessionOperation:output_type -> hdlctrl.v1.CancelScheduledSessionOperationResponse
+	172, // 288: hdlctrl.v1.ControllerService.PauseScheduledSessionOperation:output_type -> hdlctrl.v1.PauseScheduledSessionOperationResponse
+	174, // 289: hdlctrl.v1.ControllerService.ResumeScheduledSessionOperation:output_type -> hdlctrl.v1.ResumeScheduledSessionOperationResponse
+	176, // 290: hdlctrl.v1.ControllerService.SkipScheduledSessionOperationOccurrence:output_type -> hdlctrl.v1.SkipScheduledSessionOperationOccurrenceResponse
+	179, // 291: hdlctrl.v1.ControllerService.ListScheduledSessionOperationRuns:output_type -> hdlctrl.v1.ListScheduledSessionOperationRunsResponse
+	222, // [222:292] is the sub-list for method output_type
+	152, // [152:222] is the sub-list for method input_type
+	152, // [152:152] is the sub-list for extension type_name
+	152, // [152:152] is the sub-list for extension extendee
+	0,   // [0:152] is the sub-list for field type_name
 }
 
 func init() { file_hdlctrl_v1_controller_proto_init() }
@@ -7668,48 +12568,61 @@ func file_hdlctrl_v1_controller_proto_init() {
 	file_hdlctrl_v1_controller_proto_msgTypes[18].OneofWrappers = []any{}
 	file_hdlctrl_v1_controller_proto_msgTypes[20].OneofWrappers = []any{}
 	file_hdlctrl_v1_controller_proto_msgTypes[22].OneofWrappers = []any{}
-	file_hdlctrl_v1_controller_proto_msgTypes[30].OneofWrappers = []any{}
+	file_hdlctrl_v1_controller_proto_msgTypes[24].OneofWrappers = []any{}
 	file_hdlctrl_v1_controller_proto_msgTypes[32].OneofWrappers = []any{}
-	file_hdlctrl_v1_controller_proto_msgTypes[38].OneofWrappers = []any{
+	file_hdlctrl_v1_controller_proto_msgTypes[34].OneofWrappers = []any{}
+	file_hdlctrl_v1_controller_proto_msgTypes[40].OneofWrappers = []any{
 		(*GetHeadlessHostLogsRequest_BeforeId)(nil),
 		(*GetHeadlessHostLogsRequest_AfterId)(nil),
 	}
-	file_hdlctrl_v1_controller_proto_msgTypes[52].OneofWrappers = []any{}
-	file_hdlctrl_v1_controller_proto_msgTypes[62].OneofWrappers = []any{}
-	file_hdlctrl_v1_controller_proto_msgTypes[69].OneofWrappers = []any{}
-	file_hdlctrl_v1_controller_proto_msgTypes[72].OneofWrappers = []any{
+	file_hdlctrl_v1_controller_proto_msgTypes[42].OneofWrappers = []any{}
+	file_hdlctrl_v1_controller_proto_msgTypes[44].OneofWrappers = []any{}
+	file_hdlctrl_v1_controller_proto_msgTypes[46].OneofWrappers = []any{}
+	file_hdlctrl_v1_controller_proto_msgTypes[58].OneofWrappers = []any{}
+	file_hdlctrl_v1_controller_proto_msgTypes[60].OneofWrappers = []any{}
+	file_hdlctrl_v1_controller_proto_msgTypes[61].OneofWrappers = []any{}
+	file_hdlctrl_v1_controller_proto_msgTypes[73].OneofWrappers = []any{}
+	file_hdlctrl_v1_controller_proto_msgTypes[83].OneofWrappers = []any{}
+	file_hdlctrl_v1_controller_proto_msgTypes[90].OneofWrappers = []any{}
+	file_hdlctrl_v1_controller_proto_msgTypes[93].OneofWrappers = []any{
 		(*InviteUserRequest_UserId)(nil),
 		(*InviteUserRequest_UserName)(nil),
 	}
-	file_hdlctrl_v1_controller_proto_msgTypes[78].OneofWrappers = []any{}
-	file_hdlctrl_v1_controller_proto_msgTypes[84].OneofWrappers = []any{}
-	file_hdlctrl_v1_controller_proto_msgTypes[85].OneofWrappers = []any{}
-	file_hdlctrl_v1_controller_proto_msgTypes[86].OneofWrappers = []any{}
-	file_hdlctrl_v1_controller_proto_msgTypes[87].OneofWrappers = []any{}
-	file_hdlctrl_v1_controller_proto_msgTypes[91].OneofWrappers = []any{}
-	file_hdlctrl_v1_controller_proto_msgTypes[92].OneofWrappers = []any{}
-	file_hdlctrl_v1_controller_proto_msgTypes[93].OneofWrappers = []any{}
-	file_hdlctrl_v1_controller_proto_msgTypes[95].OneofWrappers = []any{}
-	file_hdlctrl_v1_controller_proto_msgTypes[98].OneofWrappers = []any{
+	file_hdlctrl_v1_controller_proto_msgTypes[99].OneofWrappers = []any{}
+	file_hdlctrl_v1_controller_proto_msgTypes[103].OneofWrappers = []any{}
+	file_hdlctrl_v1_controller_proto_msgTypes[110].OneofWrappers = []any{}
+	file_hdlctrl_v1_controller_proto_msgTypes[117].OneofWrappers = []any{}
+	file_hdlctrl_v1_controller_proto_msgTypes[124].OneofWrappers = []any{}
+	file_hdlctrl_v1_controller_proto_msgTypes[129].OneofWrappers = []any{}
+	file_hdlctrl_v1_controller_proto_msgTypes[130].OneofWrappers = []any{}
+	file_hdlctrl_v1_controller_proto_msgTypes[131].OneofWrappers = []any{}
+	file_hdlctrl_v1_controller_proto_msgTypes[132].OneofWrappers = []any{}
+	file_hdlctrl_v1_controller_proto_msgTypes[136].OneofWrappers = []any{}
+	file_hdlctrl_v1_controller_proto_msgTypes[137].OneofWrappers = []any{}
+	file_hdlctrl_v1_controller_proto_msgTypes[138].OneofWrappers = []any{}
+	file_hdlctrl_v1_controller_proto_msgTypes[140].OneofWrappers = []any{}
+	file_hdlctrl_v1_controller_proto_msgTypes[143].OneofWrappers = []any{
 		(*ScheduledOperation_StartSession)(nil),
 		(*ScheduledOperation_StopSession)(nil),
 		(*ScheduledOperation_UpdateParameters)(nil),
 		(*ScheduledOperation_UpdateExtraSettings)(nil),
 	}
-	file_hdlctrl_v1_controller_proto_msgTypes[99].OneofWrappers = []any{
+	file_hdlctrl_v1_controller_proto_msgTypes[144].OneofWrappers = []any{
 		(*ScheduledTrigger_Time)(nil),
 		(*ScheduledTrigger_SessionUserCount)(nil),
+		(*ScheduledTrigger_Cron)(nil),
 	}
-	file_hdlctrl_v1_controller_proto_msgTypes[102].OneofWrappers = []any{}
-	file_hdlctrl_v1_controller_proto_msgTypes[105].OneofWrappers = []any{}
-	file_hdlctrl_v1_controller_proto_msgTypes[113].OneofWrappers = []any{}
+	file_hdlctrl_v1_controller_proto_msgTypes[148].OneofWrappers = []any{}
+	file_hdlctrl_v1_controller_proto_msgTypes[151].OneofWrappers = []any{}
+	file_hdlctrl_v1_controller_proto_msgTypes[161].OneofWrappers = []any{}
+	file_hdlctrl_v1_controller_proto_msgTypes[169].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_hdlctrl_v1_controller_proto_rawDesc), len(file_hdlctrl_v1_controller_proto_rawDesc)),
-			NumEnums:      6,
-			NumMessages:   114,
+			NumEnums:      16,
+			NumMessages:   170,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -20,54 +20,76 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	ControllerService_ListHeadlessHost_FullMethodName                 = "/hdlctrl.v1.ControllerService/ListHeadlessHost"
-	ControllerService_GetHeadlessHost_FullMethodName                  = "/hdlctrl.v1.ControllerService/GetHeadlessHost"
-	ControllerService_GetHeadlessHostLogs_FullMethodName              = "/hdlctrl.v1.ControllerService/GetHeadlessHostLogs"
-	ControllerService_ShutdownHeadlessHost_FullMethodName             = "/hdlctrl.v1.ControllerService/ShutdownHeadlessHost"
-	ControllerService_KillHeadlessHost_FullMethodName                 = "/hdlctrl.v1.ControllerService/KillHeadlessHost"
-	ControllerService_UpdateHeadlessHostSettings_FullMethodName       = "/hdlctrl.v1.ControllerService/UpdateHeadlessHostSettings"
-	ControllerService_RestartHeadlessHost_FullMethodName              = "/hdlctrl.v1.ControllerService/RestartHeadlessHost"
-	ControllerService_StartHeadlessHost_FullMethodName                = "/hdlctrl.v1.ControllerService/StartHeadlessHost"
-	ControllerService_AllowHostAccess_FullMethodName                  = "/hdlctrl.v1.ControllerService/AllowHostAccess"
-	ControllerService_DenyHostAccess_FullMethodName                   = "/hdlctrl.v1.ControllerService/DenyHostAccess"
-	ControllerService_ListHeadlessHostImageTags_FullMethodName        = "/hdlctrl.v1.ControllerService/ListHeadlessHostImageTags"
-	ControllerService_DeleteHeadlessHost_FullMethodName               = "/hdlctrl.v1.ControllerService/DeleteHeadlessHost"
-	ControllerService_ListHeadlessHostInstances_FullMethodName        = "/hdlctrl.v1.ControllerService/ListHeadlessHostInstances"
-	ControllerService_CreateHeadlessAccount_FullMethodName            = "/hdlctrl.v1.ControllerService/CreateHeadlessAccount"
-	ControllerService_ListHeadlessAccounts_FullMethodName             = "/hdlctrl.v1.ControllerService/ListHeadlessAccounts"
-	ControllerService_DeleteHeadlessAccount_FullMethodName            = "/hdlctrl.v1.ControllerService/DeleteHeadlessAccount"
-	ControllerService_UpdateHeadlessAccountCredentials_FullMethodName = "/hdlctrl.v1.ControllerService/UpdateHeadlessAccountCredentials"
-	ControllerService_GetHeadlessAccountStorageInfo_FullMethodName    = "/hdlctrl.v1.ControllerService/GetHeadlessAccountStorageInfo"
-	ControllerService_RefetchHeadlessAccountInfo_FullMethodName       = "/hdlctrl.v1.ControllerService/RefetchHeadlessAccountInfo"
-	ControllerService_UpdateHeadlessAccountIcon_FullMethodName        = "/hdlctrl.v1.ControllerService/UpdateHeadlessAccountIcon"
-	ControllerService_FetchWorldInfo_FullMethodName                   = "/hdlctrl.v1.ControllerService/FetchWorldInfo"
-	ControllerService_SearchUserInfo_FullMethodName                   = "/hdlctrl.v1.ControllerService/SearchUserInfo"
-	ControllerService_SearchWorlds_FullMethodName                     = "/hdlctrl.v1.ControllerService/SearchWorlds"
-	ControllerService_GetOwnWorlds_FullMethodName                     = "/hdlctrl.v1.ControllerService/GetOwnWorlds"
-	ControllerService_GetResoniteUser_FullMethodName                  = "/hdlctrl.v1.ControllerService/GetResoniteUser"
-	ControllerService_GetFriendRequests_FullMethodName                = "/hdlctrl.v1.ControllerService/GetFriendRequests"
-	ControllerService_AcceptFriendRequests_FullMethodName             = "/hdlctrl.v1.ControllerService/AcceptFriendRequests"
-	ControllerService_ListContacts_FullMethodName                     = "/hdlctrl.v1.ControllerService/ListContacts"
-	ControllerService_GetContactMessages_FullMethodName               = "/hdlctrl.v1.ControllerService/GetContactMessages"
-	ControllerService_SendContactMessage_FullMethodName               = "/hdlctrl.v1.ControllerService/SendContactMessage"
-	ControllerService_SearchSessions_FullMethodName                   = "/hdlctrl.v1.ControllerService/SearchSessions"
-	ControllerService_GetSessionDetails_FullMethodName                = "/hdlctrl.v1.ControllerService/GetSessionDetails"
-	ControllerService_StartWorld_FullMethodName                       = "/hdlctrl.v1.ControllerService/StartWorld"
-	ControllerService_StopSession_FullMethodName                      = "/hdlctrl.v1.ControllerService/StopSession"
-	ControllerService_DeleteEndedSession_FullMethodName               = "/hdlctrl.v1.ControllerService/DeleteEndedSession"
-	ControllerService_SaveSessionWorld_FullMethodName                 = "/hdlctrl.v1.ControllerService/SaveSessionWorld"
-	ControllerService_PrepareSessionWorldDownload_FullMethodName      = "/hdlctrl.v1.ControllerService/PrepareSessionWorldDownload"
-	ControllerService_InviteUser_FullMethodName                       = "/hdlctrl.v1.ControllerService/InviteUser"
-	ControllerService_UpdateUserRole_FullMethodName                   = "/hdlctrl.v1.ControllerService/UpdateUserRole"
-	ControllerService_UpdateSessionParameters_FullMethodName          = "/hdlctrl.v1.ControllerService/UpdateSessionParameters"
-	ControllerService_UpdateSessionExtraSettings_FullMethodName       = "/hdlctrl.v1.ControllerService/UpdateSessionExtraSettings"
-	ControllerService_ListUsersInSession_FullMethodName               = "/hdlctrl.v1.ControllerService/ListUsersInSession"
-	ControllerService_KickUser_FullMethodName                         = "/hdlctrl.v1.ControllerService/KickUser"
-	ControllerService_BanUser_FullMethodName                          = "/hdlctrl.v1.ControllerService/BanUser"
-	ControllerService_IssueResoniteLinkConnection_FullMethodName      = "/hdlctrl.v1.ControllerService/IssueResoniteLinkConnection"
-	ControllerService_CreateScheduledSessionOperation_FullMethodName  = "/hdlctrl.v1.ControllerService/CreateScheduledSessionOperation"
-	ControllerService_ListScheduledSessionOperations_FullMethodName   = "/hdlctrl.v1.ControllerService/ListScheduledSessionOperations"
-	ControllerService_CancelScheduledSessionOperation_FullMethodName  = "/hdlctrl.v1.ControllerService/CancelScheduledSessionOperation"
+	ControllerService_ListHeadlessHost_FullMethodName                        = "/hdlctrl.v1.ControllerService/ListHeadlessHost"
+	ControllerService_GetHeadlessHost_FullMethodName                         = "/hdlctrl.v1.ControllerService/GetHeadlessHost"
+	ControllerService_GetHeadlessHostLogs_FullMethodName                     = "/hdlctrl.v1.ControllerService/GetHeadlessHostLogs"
+	ControllerService_TailHeadlessHostLogs_FullMethodName                    = "/hdlctrl.v1.ControllerService/TailHeadlessHostLogs"
+	ControllerService_SearchHeadlessHostLogs_FullMethodName                  = "/hdlctrl.v1.ControllerService/SearchHeadlessHostLogs"
+	ControllerService_PrepareHeadlessHostLogDownload_FullMethodName          = "/hdlctrl.v1.ControllerService/PrepareHeadlessHostLogDownload"
+	ControllerService_ShutdownHeadlessHost_FullMethodName                    = "/hdlctrl.v1.ControllerService/ShutdownHeadlessHost"
+	ControllerService_KillHeadlessHost_FullMethodName                        = "/hdlctrl.v1.ControllerService/KillHeadlessHost"
+	ControllerService_UpdateHeadlessHostSettings_FullMethodName              = "/hdlctrl.v1.ControllerService/UpdateHeadlessHostSettings"
+	ControllerService_RestartHeadlessHost_FullMethodName                     = "/hdlctrl.v1.ControllerService/RestartHeadlessHost"
+	ControllerService_StartHeadlessHost_FullMethodName                       = "/hdlctrl.v1.ControllerService/StartHeadlessHost"
+	ControllerService_AllowHostAccess_FullMethodName                         = "/hdlctrl.v1.ControllerService/AllowHostAccess"
+	ControllerService_DenyHostAccess_FullMethodName                          = "/hdlctrl.v1.ControllerService/DenyHostAccess"
+	ControllerService_ListHeadlessHostImageTags_FullMethodName               = "/hdlctrl.v1.ControllerService/ListHeadlessHostImageTags"
+	ControllerService_DeleteHeadlessHost_FullMethodName                      = "/hdlctrl.v1.ControllerService/DeleteHeadlessHost"
+	ControllerService_ListHeadlessHostInstances_FullMethodName               = "/hdlctrl.v1.ControllerService/ListHeadlessHostInstances"
+	ControllerService_CloneHeadlessHost_FullMethodName                       = "/hdlctrl.v1.ControllerService/CloneHeadlessHost"
+	ControllerService_ListHostTemplates_FullMethodName                       = "/hdlctrl.v1.ControllerService/ListHostTemplates"
+	ControllerService_GetHostTemplate_FullMethodName                         = "/hdlctrl.v1.ControllerService/GetHostTemplate"
+	ControllerService_CreateHostTemplate_FullMethodName                      = "/hdlctrl.v1.ControllerService/CreateHostTemplate"
+	ControllerService_UpdateHostTemplate_FullMethodName                      = "/hdlctrl.v1.ControllerService/UpdateHostTemplate"
+	ControllerService_DeleteHostTemplate_FullMethodName                      = "/hdlctrl.v1.ControllerService/DeleteHostTemplate"
+	ControllerService_CreateHeadlessAccount_FullMethodName                   = "/hdlctrl.v1.ControllerService/CreateHeadlessAccount"
+	ControllerService_ListHeadlessAccounts_FullMethodName                    = "/hdlctrl.v1.ControllerService/ListHeadlessAccounts"
+	ControllerService_DeleteHeadlessAccount_FullMethodName                   = "/hdlctrl.v1.ControllerService/DeleteHeadlessAccount"
+	ControllerService_UpdateHeadlessAccountCredentials_FullMethodName        = "/hdlctrl.v1.ControllerService/UpdateHeadlessAccountCredentials"
+	ControllerService_GetHeadlessAccountStorageInfo_FullMethodName           = "/hdlctrl.v1.ControllerService/GetHeadlessAccountStorageInfo"
+	ControllerService_RefetchHeadlessAccountInfo_FullMethodName              = "/hdlctrl.v1.ControllerService/RefetchHeadlessAccountInfo"
+	ControllerService_UpdateHeadlessAccountIcon_FullMethodName               = "/hdlctrl.v1.ControllerService/UpdateHeadlessAccountIcon"
+	ControllerService_FetchWorldInfo_FullMethodName                          = "/hdlctrl.v1.ControllerService/FetchWorldInfo"
+	ControllerService_SearchUserInfo_FullMethodName                          = "/hdlctrl.v1.ControllerService/SearchUserInfo"
+	ControllerService_SearchWorlds_FullMethodName                            = "/hdlctrl.v1.ControllerService/SearchWorlds"
+	ControllerService_GetOwnWorlds_FullMethodName                            = "/hdlctrl.v1.ControllerService/GetOwnWorlds"
+	ControllerService_GetResoniteUser_FullMethodName                         = "/hdlctrl.v1.ControllerService/GetResoniteUser"
+	ControllerService_GetFriendRequests_FullMethodName                       = "/hdlctrl.v1.ControllerService/GetFriendRequests"
+	ControllerService_AcceptFriendRequests_FullMethodName                    = "/hdlctrl.v1.ControllerService/AcceptFriendRequests"
+	ControllerService_ListContacts_FullMethodName                            = "/hdlctrl.v1.ControllerService/ListContacts"
+	ControllerService_GetContactMessages_FullMethodName                      = "/hdlctrl.v1.ControllerService/GetContactMessages"
+	ControllerService_SendContactMessage_FullMethodName                      = "/hdlctrl.v1.ControllerService/SendContactMessage"
+	ControllerService_SearchSessions_FullMethodName                          = "/hdlctrl.v1.ControllerService/SearchSessions"
+	ControllerService_GetSessionDetails_FullMethodName                       = "/hdlctrl.v1.ControllerService/GetSessionDetails"
+	ControllerService_StartWorld_FullMethodName                              = "/hdlctrl.v1.ControllerService/StartWorld"
+	ControllerService_StopSession_FullMethodName                             = "/hdlctrl.v1.ControllerService/StopSession"
+	ControllerService_DeleteEndedSession_FullMethodName                      = "/hdlctrl.v1.ControllerService/DeleteEndedSession"
+	ControllerService_SaveSessionWorld_FullMethodName                        = "/hdlctrl.v1.ControllerService/SaveSessionWorld"
+	ControllerService_PrepareSessionWorldDownload_FullMethodName             = "/hdlctrl.v1.ControllerService/PrepareSessionWorldDownload"
+	ControllerService_InviteUser_FullMethodName                              = "/hdlctrl.v1.ControllerService/InviteUser"
+	ControllerService_UpdateUserRole_FullMethodName                          = "/hdlctrl.v1.ControllerService/UpdateUserRole"
+	ControllerService_UpdateSessionParameters_FullMethodName                 = "/hdlctrl.v1.ControllerService/UpdateSessionParameters"
+	ControllerService_UpdateSessionExtraSettings_FullMethodName              = "/hdlctrl.v1.ControllerService/UpdateSessionExtraSettings"
+	ControllerService_ListUsersInSession_FullMethodName                      = "/hdlctrl.v1.ControllerService/ListUsersInSession"
+	ControllerService_KickUser_FullMethodName                                = "/hdlctrl.v1.ControllerService/KickUser"
+	ControllerService_BanUser_FullMethodName                                 = "/hdlctrl.v1.ControllerService/BanUser"
+	ControllerService_IssueResoniteLinkConnection_FullMethodName             = "/hdlctrl.v1.ControllerService/IssueResoniteLinkConnection"
+	ControllerService_ListSessionUserEvents_FullMethodName                   = "/hdlctrl.v1.ControllerService/ListSessionUserEvents"
+	ControllerService_GetSessionAttendance_FullMethodName                    = "/hdlctrl.v1.ControllerService/GetSessionAttendance"
+	ControllerService_GetSessionUsageStats_FullMethodName                    = "/hdlctrl.v1.ControllerService/GetSessionUsageStats"
+	ControllerService_GetMetricsSeries_FullMethodName                        = "/hdlctrl.v1.ControllerService/GetMetricsSeries"
+	ControllerService_ListSessionTemplates_FullMethodName                    = "/hdlctrl.v1.ControllerService/ListSessionTemplates"
+	ControllerService_GetSessionTemplate_FullMethodName                      = "/hdlctrl.v1.ControllerService/GetSessionTemplate"
+	ControllerService_CreateSessionTemplate_FullMethodName                   = "/hdlctrl.v1.ControllerService/CreateSessionTemplate"
+	ControllerService_UpdateSessionTemplate_FullMethodName                   = "/hdlctrl.v1.ControllerService/UpdateSessionTemplate"
+	ControllerService_DeleteSessionTemplate_FullMethodName                   = "/hdlctrl.v1.ControllerService/DeleteSessionTemplate"
+	ControllerService_CreateScheduledSessionOperation_FullMethodName         = "/hdlctrl.v1.ControllerService/CreateScheduledSessionOperation"
+	ControllerService_ListScheduledSessionOperations_FullMethodName          = "/hdlctrl.v1.ControllerService/ListScheduledSessionOperations"
+	ControllerService_CancelScheduledSessionOperation_FullMethodName         = "/hdlctrl.v1.ControllerService/CancelScheduledSessionOperation"
+	ControllerService_PauseScheduledSessionOperation_FullMethodName          = "/hdlctrl.v1.ControllerService/PauseScheduledSessionOperation"
+	ControllerService_ResumeScheduledSessionOperation_FullMethodName         = "/hdlctrl.v1.ControllerService/ResumeScheduledSessionOperation"
+	ControllerService_SkipScheduledSessionOperationOccurrence_FullMethodName = "/hdlctrl.v1.ControllerService/SkipScheduledSessionOperationOccurrence"
+	ControllerService_ListScheduledSessionOperationRuns_FullMethodName       = "/hdlctrl.v1.ControllerService/ListScheduledSessionOperationRuns"
 )
 
 // ControllerServiceClient is the client API for ControllerService service.
@@ -78,6 +100,12 @@ type ControllerServiceClient interface {
 	ListHeadlessHost(ctx context.Context, in *ListHeadlessHostRequest, opts ...grpc.CallOption) (*ListHeadlessHostResponse, error)
 	GetHeadlessHost(ctx context.Context, in *GetHeadlessHostRequest, opts ...grpc.CallOption) (*GetHeadlessHostResponse, error)
 	GetHeadlessHostLogs(ctx context.Context, in *GetHeadlessHostLogsRequest, opts ...grpc.CallOption) (*GetHeadlessHostLogsResponse, error)
+	// ホストのログを直近のバックログから送り、以降は追記され次第 push する
+	TailHeadlessHostLogs(ctx context.Context, in *TailHeadlessHostLogsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[TailHeadlessHostLogsResponse], error)
+	// 閲覧できる全ホスト・全インスタンスのログを本文で検索する
+	SearchHeadlessHostLogs(ctx context.Context, in *SearchHeadlessHostLogsRequest, opts ...grpc.CallOption) (*SearchHeadlessHostLogsResponse, error)
+	// インスタンスのログ全体 (または期間内) をファイルにして、ダウンロード用の URL を返す
+	PrepareHeadlessHostLogDownload(ctx context.Context, in *PrepareHeadlessHostLogDownloadRequest, opts ...grpc.CallOption) (*PrepareHeadlessHostLogDownloadResponse, error)
 	ShutdownHeadlessHost(ctx context.Context, in *ShutdownHeadlessHostRequest, opts ...grpc.CallOption) (*ShutdownHeadlessHostResponse, error)
 	KillHeadlessHost(ctx context.Context, in *KillHeadlessHostRequest, opts ...grpc.CallOption) (*KillHeadlessHostResponse, error)
 	UpdateHeadlessHostSettings(ctx context.Context, in *UpdateHeadlessHostSettingsRequest, opts ...grpc.CallOption) (*UpdateHeadlessHostSettingsResponse, error)
@@ -88,6 +116,14 @@ type ControllerServiceClient interface {
 	ListHeadlessHostImageTags(ctx context.Context, in *ListHeadlessHostImageTagsRequest, opts ...grpc.CallOption) (*ListHeadlessHostImageTagsResponse, error)
 	DeleteHeadlessHost(ctx context.Context, in *DeleteHeadlessHostRequest, opts ...grpc.CallOption) (*DeleteHeadlessHostResponse, error)
 	ListHeadlessHostInstances(ctx context.Context, in *ListHeadlessHostInstancesRequest, opts ...grpc.CallOption) (*ListHeadlessHostInstancesResponse, error)
+	// 既存ホストの設定をコピーして新しいホストを起動する
+	CloneHeadlessHost(ctx context.Context, in *CloneHeadlessHostRequest, opts ...grpc.CallOption) (*CloneHeadlessHostResponse, error)
+	// ホストテンプレート系
+	ListHostTemplates(ctx context.Context, in *ListHostTemplatesRequest, opts ...grpc.CallOption) (*ListHostTemplatesResponse, error)
+	GetHostTemplate(ctx context.Context, in *GetHostTemplateRequest, opts ...grpc.CallOption) (*GetHostTemplateResponse, error)
+	CreateHostTemplate(ctx context.Context, in *CreateHostTemplateRequest, opts ...grpc.CallOption) (*CreateHostTemplateResponse, error)
+	UpdateHostTemplate(ctx context.Context, in *UpdateHostTemplateRequest, opts ...grpc.CallOption) (*UpdateHostTemplateResponse, error)
+	DeleteHostTemplate(ctx context.Context, in *DeleteHostTemplateRequest, opts ...grpc.CallOption) (*DeleteHostTemplateResponse, error)
 	// アカウント系
 	CreateHeadlessAccount(ctx context.Context, in *CreateHeadlessAccountRequest, opts ...grpc.CallOption) (*CreateHeadlessAccountResponse, error)
 	ListHeadlessAccounts(ctx context.Context, in *ListHeadlessAccountsRequest, opts ...grpc.CallOption) (*ListHeadlessAccountsResponse, error)
@@ -124,10 +160,28 @@ type ControllerServiceClient interface {
 	KickUser(ctx context.Context, in *KickUserRequest, opts ...grpc.CallOption) (*KickUserResponse, error)
 	BanUser(ctx context.Context, in *BanUserRequest, opts ...grpc.CallOption) (*BanUserResponse, error)
 	IssueResoniteLinkConnection(ctx context.Context, in *IssueResoniteLinkConnectionRequest, opts ...grpc.CallOption) (*IssueResoniteLinkConnectionResponse, error)
+	// セッションへのユーザーの出入りの履歴と、そこから集計した出席・利用状況
+	ListSessionUserEvents(ctx context.Context, in *ListSessionUserEventsRequest, opts ...grpc.CallOption) (*ListSessionUserEventsResponse, error)
+	GetSessionAttendance(ctx context.Context, in *GetSessionAttendanceRequest, opts ...grpc.CallOption) (*GetSessionAttendanceResponse, error)
+	GetSessionUsageStats(ctx context.Context, in *GetSessionUsageStatsRequest, opts ...grpc.CallOption) (*GetSessionUsageStatsResponse, error)
+	// メトリクス系
+	// ホスト / セッションのメトリクスの時系列 (ダッシュボードのグラフ用)
+	GetMetricsSeries(ctx context.Context, in *GetMetricsSeriesRequest, opts ...grpc.CallOption) (*GetMetricsSeriesResponse, error)
+	// セッションテンプレート系
+	ListSessionTemplates(ctx context.Context, in *ListSessionTemplatesRequest, opts ...grpc.CallOption) (*ListSessionTemplatesResponse, error)
+	GetSessionTemplate(ctx context.Context, in *GetSessionTemplateRequest, opts ...grpc.CallOption) (*GetSessionTemplateResponse, error)
+	CreateSessionTemplate(ctx context.Context, in *CreateSessionTemplateRequest, opts ...grpc.CallOption) (*CreateSessionTemplateResponse, error)
+	UpdateSessionTemplate(ctx context.Context, in *UpdateSessionTemplateRequest, opts ...grpc.CallOption) (*UpdateSessionTemplateResponse, error)
+	DeleteSessionTemplate(ctx context.Context, in *DeleteSessionTemplateRequest, opts ...grpc.CallOption) (*DeleteSessionTemplateResponse, error)
 	// 予約操作系
 	CreateScheduledSessionOperation(ctx context.Context, in *CreateScheduledSessionOperationRequest, opts ...grpc.CallOption) (*CreateScheduledSessionOperationResponse, error)
 	ListScheduledSessionOperations(ctx context.Context, in *ListScheduledSessionOperationsRequest, opts ...grpc.CallOption) (*ListScheduledSessionOperationsResponse, error)
 	CancelScheduledSessionOperation(ctx context.Context, in *CancelScheduledSessionOperationRequest, opts ...grpc.CallOption) (*CancelScheduledSessionOperationResponse, error)
+	PauseScheduledSessionOperation(ctx context.Context, in *PauseScheduledSessionOperationRequest, opts ...grpc.CallOption) (*PauseScheduledSessionOperationResponse, error)
+	ResumeScheduledSessionOperation(ctx context.Context, in *ResumeScheduledSessionOperationRequest, opts ...grpc.CallOption) (*ResumeScheduledSessionOperationResponse, error)
+	// 繰り返し予約の次の 1 回を飛ばす
+	SkipScheduledSessionOperationOccurrence(ctx context.Context, in *SkipScheduledSessionOperationOccurrenceRequest, opts ...grpc.CallOption) (*SkipScheduledSessionOperationOccurrenceResponse, error)
+	ListScheduledSessionOperationRuns(ctx context.Context, in *ListScheduledSessionOperationRunsRequest, opts ...grpc.CallOption) (*ListScheduledSessionOperationRunsResponse, error)
 }
 
 type controllerServiceClient struct {
@@ -168,6 +222,45 @@ func (c *controllerServiceClient) GetHeadlessHostLogs(ctx context.Context, in *G
 	return out, nil
 }
 
+func (c *controllerServiceClient) TailHeadlessHostLogs(ctx context.Context, in *TailHeadlessHostLogsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[TailHeadlessHostLogsResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &ControllerService_ServiceDesc.Streams[0], ControllerService_TailHeadlessHostLogs_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[TailHeadlessHostLogsRequest, TailHeadlessHostLogsResponse]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type ControllerService_TailHeadlessHostLogsClient = grpc.ServerStreamingClient[TailHeadlessHostLogsResponse]
+
+func (c *controllerServiceClient) SearchHeadlessHostLogs(ctx context.Context, in *SearchHeadlessHostLogsRequest, opts ...grpc.CallOption) (*SearchHeadlessHostLogsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SearchHeadlessHostLogsResponse)
+	err := c.cc.Invoke(ctx, ControllerService_SearchHeadlessHostLogs_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *controllerServiceClient) PrepareHeadlessHostLogDownload(ctx context.Context, in *PrepareHeadlessHostLogDownloadRequest, opts ...grpc.CallOption) (*PrepareHeadlessHostLogDownloadResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PrepareHeadlessHostLogDownloadResponse)
+	err := c.cc.Invoke(ctx, ControllerService_PrepareHeadlessHostLogDownload_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *controllerServiceClient) ShutdownHeadlessHost(ctx context.Context, in *ShutdownHeadlessHostRequest, opts ...grpc.CallOption) (*ShutdownHeadlessHostResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(ShutdownHeadlessHostResponse)
@@ -268,6 +361,66 @@ func (c *controllerServiceClient) ListHeadlessHostInstances(ctx context.Context,
 	return out, nil
 }
 
+func (c *controllerServiceClient) CloneHeadlessHost(ctx context.Context, in *CloneHeadlessHostRequest, opts ...grpc.CallOption) (*CloneHeadlessHostResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CloneHeadlessHostResponse)
+	err := c.cc.Invoke(ctx, ControllerService_CloneHeadlessHost_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *controllerServiceClient) ListHostTemplates(ctx context.Context, in *ListHostTemplatesRequest, opts ...grpc.CallOption) (*ListHostTemplatesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListHostTemplatesResponse)
+	err := c.cc.Invoke(ctx, ControllerService_ListHostTemplates_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *controllerServiceClient) GetHostTemplate(ctx context.Context, in *GetHostTemplateRequest, opts ...grpc.CallOption) (*GetHostTemplateResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetHostTemplateResponse)
+	err := c.cc.Invoke(ctx, ControllerService_GetHostTemplate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *controllerServiceClient) CreateHostTemplate(ctx context.Context, in *CreateHostTemplateRequest, opts ...grpc.CallOption) (*CreateHostTemplateResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateHostTemplateResponse)
+	err := c.cc.Invoke(ctx, ControllerService_CreateHostTemplate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *controllerServiceClient) UpdateHostTemplate(ctx context.Context, in *UpdateHostTemplateRequest, opts ...grpc.CallOption) (*UpdateHostTemplateResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateHostTemplateResponse)
+	err := c.cc.Invoke(ctx, ControllerService_UpdateHostTemplate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *controllerServiceClient) DeleteHostTemplate(ctx context.Context, in *DeleteHostTemplateRequest, opts ...grpc.CallOption) (*DeleteHostTemplateResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteHostTemplateResponse)
+	err := c.cc.Invoke(ctx, ControllerService_DeleteHostTemplate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *controllerServiceClient) CreateHeadlessAccount(ctx context.Context, in *CreateHeadlessAccountRequest, opts ...grpc.CallOption) (*CreateHeadlessAccountResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(CreateHeadlessAccountResponse)
@@ -588,6 +741,96 @@ func (c *controllerServiceClient) IssueResoniteLinkConnection(ctx context.Contex
 	return out, nil
 }
 
+func (c *controllerServiceClient) ListSessionUserEvents(ctx context.Context, in *ListSessionUserEventsRequest, opts ...grpc.CallOption) (*ListSessionUserEventsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListSessionUserEventsResponse)
+	err := c.cc.Invoke(ctx, ControllerService_ListSessionUserEvents_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *controllerServiceClient) GetSessionAttendance(ctx context.Context, in *GetSessionAttendanceRequest, opts ...grpc.CallOption) (*GetSessionAttendanceResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetSessionAttendanceResponse)
+	err := c.cc.Invoke(ctx, ControllerService_GetSessionAttendance_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *controllerServiceClient) GetSessionUsageStats(ctx context.Context, in *GetSessionUsageStatsRequest, opts ...grpc.CallOption) (*GetSessionUsageStatsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetSessionUsageStatsResponse)
+	err := c.cc.Invoke(ctx, ControllerService_GetSessionUsageStats_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *controllerServiceClient) GetMetricsSeries(ctx context.Context, in *GetMetricsSeriesRequest, opts ...grpc.CallOption) (*GetMetricsSeriesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetMetricsSeriesResponse)
+	err := c.cc.Invoke(ctx, ControllerService_GetMetricsSeries_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *controllerServiceClient) ListSessionTemplates(ctx context.Context, in *ListSessionTemplatesRequest, opts ...grpc.CallOption) (*ListSessionTemplatesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListSessionTemplatesResponse)
+	err := c.cc.Invoke(ctx, ControllerService_ListSessionTemplates_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *controllerServiceClient) GetSessionTemplate(ctx context.Context, in *GetSessionTemplateRequest, opts ...grpc.CallOption) (*GetSessionTemplateResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetSessionTemplateResponse)
+	err := c.cc.Invoke(ctx, ControllerService_GetSessionTemplate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *controllerServiceClient) CreateSessionTemplate(ctx context.Context, in *CreateSessionTemplateRequest, opts ...grpc.CallOption) (*CreateSessionTemplateResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateSessionTemplateResponse)
+	err := c.cc.Invoke(ctx, ControllerService_CreateSessionTemplate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *controllerServiceClient) UpdateSessionTemplate(ctx context.Context, in *UpdateSessionTemplateRequest, opts ...grpc.CallOption) (*UpdateSessionTemplateResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateSessionTemplateResponse)
+	err := c.cc.Invoke(ctx, ControllerService_UpdateSessionTemplate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *controllerServiceClient) DeleteSessionTemplate(ctx context.Context, in *DeleteSessionTemplateRequest, opts ...grpc.CallOption) (*DeleteSessionTemplateResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteSessionTemplateResponse)
+	err := c.cc.Invoke(ctx, ControllerService_DeleteSessionTemplate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *controllerServiceClient) CreateScheduledSessionOperation(ctx context.Context, in *CreateScheduledSessionOperationRequest, opts ...grpc.CallOption) (*CreateScheduledSessionOperationResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(CreateScheduledSessionOperationResponse)
@@ -618,6 +861,46 @@ func (c *controllerServiceClient) CancelScheduledSessionOperation(ctx context.Co
 	return out, nil
 }
 
+func (c *controllerServiceClient) PauseScheduledSessionOperation(ctx context.Context, in *PauseScheduledSessionOperationRequest, opts ...grpc.CallOption) (*PauseScheduledSessionOperationResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PauseScheduledSessionOperationResponse)
+	err := c.cc.Invoke(ctx, ControllerService_PauseScheduledSessionOperation_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *controllerServiceClient) ResumeScheduledSessionOperation(ctx context.Context, in *ResumeScheduledSessionOperationRequest, opts ...grpc.CallOption) (*ResumeScheduledSessionOperationResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ResumeScheduledSessionOperationResponse)
+	err := c.cc.Invoke(ctx, ControllerService_ResumeScheduledSessionOperation_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *controllerServiceClient) SkipScheduledSessionOperationOccurrence(ctx context.Context, in *SkipScheduledSessionOperationOccurrenceRequest, opts ...grpc.CallOption) (*SkipScheduledSessionOperationOccurrenceResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SkipScheduledSessionOperationOccurrenceResponse)
+	err := c.cc.Invoke(ctx, ControllerService_SkipScheduledSessionOperationOccurrence_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *controllerServiceClient) ListScheduledSessionOperationRuns(ctx context.Context, in *ListScheduledSessionOperationRunsRequest, opts ...grpc.CallOption) (*ListScheduledSessionOperationRunsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListScheduledSessionOperationRunsResponse)
+	err := c.cc.Invoke(ctx, ControllerService_ListScheduledSessionOperationRuns_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // ControllerServiceServer is the server API for ControllerService service.
 // All implementations must embed UnimplementedControllerServiceServer
 // for forward compatibility.
@@ -626,6 +909,12 @@ type ControllerServiceServer interface {
 	ListHeadlessHost(context.Context, *ListHeadlessHostRequest) (*ListHeadlessHostResponse, error)
 	GetHeadlessHost(context.Context, *GetHeadlessHostRequest) (*GetHeadlessHostResponse, error)
 	GetHeadlessHostLogs(context.Context, *GetHeadlessHostLogsRequest) (*GetHeadlessHostLogsResponse, error)
+	// ホストのログを直近のバックログから送り、以降は追記され次第 push する
+	TailHeadlessHostLogs(*TailHeadlessHostLogsRequest, grpc.ServerStreamingServer[TailHeadlessHostLogsResponse]) error
+	// 閲覧できる全ホスト・全インスタンスのログを本文で検索する
+	SearchHeadlessHostLogs(context.Context, *SearchHeadlessHostLogsRequest) (*SearchHeadlessHostLogsResponse, error)
+	// インスタンスのログ全体 (または期間内) をファイルにして、ダウンロード用の URL を返す
+	PrepareHeadlessHostLogDownload(context.Context, *PrepareHeadlessHostLogDownloadRequest) (*PrepareHeadlessHostLogDownloadResponse, error)
 	ShutdownHeadlessHost(context.Context, *ShutdownHeadlessHostRequest) (*ShutdownHeadlessHostResponse, error)
 	KillHeadlessHost(context.Context, *KillHeadlessHostRequest) (*KillHeadlessHostResponse, error)
 	UpdateHeadlessHostSettings(context.Context, *UpdateHeadlessHostSettingsRequest) (*UpdateHeadlessHostSettingsResponse, error)
@@ -636,6 +925,14 @@ type ControllerServiceServer interface {
 	ListHeadlessHostImageTags(context.Context, *ListHeadlessHostImageTagsRequest) (*ListHeadlessHostImageTagsResponse, error)
 	DeleteHeadlessHost(context.Context, *DeleteHeadlessHostRequest) (*DeleteHeadlessHostResponse, error)
 	ListHeadlessHostInstances(context.Context, *ListHeadlessHostInstancesRequest) (*ListHeadlessHostInstancesResponse, error)
+	// 既存ホストの設定をコピーして新しいホストを起動する
+	CloneHeadlessHost(context.Context, *CloneHeadlessHostRequest) (*CloneHeadlessHostResponse, error)
+	// ホストテンプレート系
+	ListHostTemplates(context.Context, *ListHostTemplatesRequest) (*ListHostTemplatesResponse, error)
+	GetHostTemplate(context.Context, *GetHostTemplateRequest) (*GetHostTemplateResponse, error)
+	CreateHostTemplate(context.Context, *CreateHostTemplateRequest) (*CreateHostTemplateResponse, error)
+	UpdateHostTemplate(context.Context, *UpdateHostTemplateRequest) (*UpdateHostTemplateResponse, error)
+	DeleteHostTemplate(context.Context, *DeleteHostTemplateRequest) (*DeleteHostTemplateResponse, error)
 	// アカウント系
 	CreateHeadlessAccount(context.Context, *CreateHeadlessAccountRequest) (*CreateHeadlessAccountResponse, error)
 	ListHeadlessAccounts(context.Context, *ListHeadlessAccountsRequest) (*ListHeadlessAccountsResponse, error)
@@ -672,10 +969,28 @@ type ControllerServiceServer interface {
 	KickUser(context.Context, *KickUserRequest) (*KickUserResponse, error)
 	BanUser(context.Context, *BanUserRequest) (*BanUserResponse, error)
 	IssueResoniteLinkConnection(context.Context, *IssueResoniteLinkConnectionRequest) (*IssueResoniteLinkConnectionResponse, error)
+	// セッションへのユーザーの出入りの履歴と、そこから集計した出席・利用状況
+	ListSessionUserEvents(context.Context, *ListSessionUserEventsRequest) (*ListSessionUserEventsResponse, error)
+	GetSessionAttendance(context.Context, *GetSessionAttendanceRequest) (*GetSessionAttendanceResponse, error)
+	GetSessionUsageStats(context.Context, *GetSessionUsageStatsRequest) (*GetSessionUsageStatsResponse, error)
+	// メトリクス系
+	// ホスト / セッションのメトリクスの時系列 (ダッシュボードのグラフ用)
+	GetMetricsSeries(context.Context, *GetMetricsSeriesRequest) (*GetMetricsSeriesResponse, error)
+	// セッションテンプレート系
+	ListSessionTemplates(context.Context, *ListSessionTemplatesRequest) (*ListSessionTemplatesResponse, error)
+	GetSessionTemplate(context.Context, *GetSessionTemplateRequest) (*GetSessionTemplateResponse, error)
+	CreateSessionTemplate(context.Context, *CreateSessionTemplateRequest) (*CreateSessionTemplateResponse, error)
+	UpdateSessionTemplate(context.Context, *UpdateSessionTemplateRequest) (*UpdateSessionTemplateResponse, error)
+	DeleteSessionTemplate(context.Context, *DeleteSessionTemplateRequest) (*DeleteSessionTemplateResponse, error)
 	// 予約操作系
 	CreateScheduledSessionOperation(context.Context, *CreateScheduledSessionOperationRequest) (*CreateScheduledSessionOperationResponse, error)
 	ListScheduledSessionOperations(context.Context, *ListScheduledSessionOperationsRequest) (*ListScheduledSessionOperationsResponse, error)
 	CancelScheduledSessionOperation(context.Context, *CancelScheduledSessionOperationRequest) (*CancelScheduledSessionOperationResponse, error)
+	PauseScheduledSessionOperation(context.Context, *PauseScheduledSessionOperationRequest) (*PauseScheduledSessionOperationResponse, error)
+	ResumeScheduledSessionOperation(context.Context, *ResumeScheduledSessionOperationRequest) (*ResumeScheduledSessionOperationResponse, error)
+	// 繰り返し予約の次の 1 回を飛ばす
+	SkipScheduledSessionOperationOccurrence(context.Context, *SkipScheduledSessionOperationOccurrenceRequest) (*SkipScheduledSessionOperationOccurrenceResponse, error)
+	ListScheduledSessionOperationRuns(context.Context, *ListScheduledSessionOperationRunsRequest) (*ListScheduledSessionOperationRunsResponse, error)
 	mustEmbedUnimplementedControllerServiceServer()
 }
 
@@ -695,6 +1010,15 @@ func (UnimplementedControllerServiceServer) GetHeadlessHost(context.Context, *Ge
 func (UnimplementedControllerServiceServer) GetHeadlessHostLogs(context.Context, *GetHeadlessHostLogsRequest) (*GetHeadlessHostLogsResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method GetHeadlessHostLogs not implemented")
 }
+func (UnimplementedControllerServiceServer) TailHeadlessHostLogs(*TailHeadlessHostLogsRequest, grpc.ServerStreamingServer[TailHeadlessHostLogsResponse]) error {
+	return status.Error(codes.Unimplemented, "method TailHeadlessHostLogs not implemented")
+}
+func (UnimplementedControllerServiceServer) SearchHeadlessHostLogs(context.Context, *SearchHeadlessHostLogsRequest) (*SearchHeadlessHostLogsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method SearchHeadlessHostLogs not implemented")
+}
+func (UnimplementedControllerServiceServer) PrepareHeadlessHostLogDownload(context.Context, *PrepareHeadlessHostLogDownloadRequest) (*PrepareHeadlessHostLogDownloadResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method PrepareHeadlessHostLogDownload not implemented")
+}
 func (UnimplementedControllerServiceServer) ShutdownHeadlessHost(context.Context, *ShutdownHeadlessHostRequest) (*ShutdownHeadlessHostResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method ShutdownHeadlessHost not implemented")
 }
@@ -725,6 +1049,24 @@ func (UnimplementedControllerServiceServer) DeleteHeadlessHost(context.Context,
 func (UnimplementedControllerServiceServer) ListHeadlessHostInstances(context.Context, *ListHeadlessHostInstancesRequest) (*ListHeadlessHostInstancesResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method ListHeadlessHostInstances not implemented")
 }
+func (UnimplementedControllerServiceServer) CloneHeadlessHost(context.Context, *CloneHeadlessHostRequest) (*CloneHeadlessHostResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method CloneHeadlessHost not implemented")
+}
+func (UnimplementedControllerServiceServer) ListHostTemplates(context.Context, *ListHostTemplatesRequest) (*ListHostTemplatesResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListHostTemplates not implemented")
+}
+func (UnimplementedControllerServiceServer) GetHostTemplate(context.Context, *GetHostTemplateRequest) (*GetHostTemplateResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetHostTemplate not implemented")
+}
+func (UnimplementedControllerServiceServer) CreateHostTemplate(context.Context, *CreateHostTemplateRequest) (*CreateHostTemplateResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method CreateHostTemplate not implemented")
+}
+func (UnimplementedControllerServiceServer) UpdateHostTemplate(context.Context, *UpdateHostTemplateRequest) (*UpdateHostTemplateResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method UpdateHostTemplate not implemented")
+}
+func (UnimplementedControllerServiceServer) DeleteHostTemplate(context.Context, *DeleteHostTemplateRequest) (*DeleteHostTemplateResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method DeleteHostTemplate not implemented")
+}
 func (UnimplementedControllerServiceServer) CreateHeadlessAccount(context.Context, *CreateHeadlessAccountRequest) (*CreateHeadlessAccountResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method CreateHeadlessAccount not implemented")
 }
@@ -821,6 +1163,33 @@ func (UnimplementedControllerServiceServer) BanUser(context.Context, *BanUserReq
 func (UnimplementedControllerServiceServer) IssueResoniteLinkConnection(context.Context, *IssueResoniteLinkConnectionRequest) (*IssueResoniteLinkConnectionResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method IssueResoniteLinkConnection not implemented")
 }
+func (UnimplementedControllerServiceServer) ListSessionUserEvents(context.Context, *ListSessionUserEventsRequest) (*ListSessionUserEventsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListSessionUserEvents not implemented")
+}
+func (UnimplementedControllerServiceServer) GetSessionAttendance(context.Context, *GetSessionAttendanceRequest) (*GetSessionAttendanceResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetSessionAttendance not implemented")
+}
+func (UnimplementedControllerServiceServer) GetSessionUsageStats(context.Context, *GetSessionUsageStatsRequest) (*GetSessionUsageStatsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetSessionUsageStats not implemented")
+}
+func (UnimplementedControllerServiceServer) GetMetricsSeries(context.Context, *GetMetricsSeriesRequest) (*GetMetricsSeriesResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetMetricsSeries not implemented")
+}
+func (UnimplementedControllerServiceServer) ListSessionTemplates(context.Context, *ListSessionTemplatesRequest) (*ListSessionTemplatesResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListSessionTemplates not implemented")
+}
+func (UnimplementedControllerServiceServer) GetSessionTemplate(context.Context, *GetSessionTemplateRequest) (*GetSessionTemplateResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetSessionTemplate not implemented")
+}
+func (UnimplementedControllerServiceServer) CreateSessionTemplate(context.Context, *CreateSessionTemplateRequest) (*CreateSessionTemplateResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method CreateSessionTemplate not implemented")
+}
+func (UnimplementedControllerServiceServer) UpdateSessionTemplate(context.Context, *UpdateSessionTemplateRequest) (*UpdateSessionTemplateResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method UpdateSessionTemplate not implemented")
+}
+func (UnimplementedControllerServiceServer) DeleteSessionTemplate(context.Context, *DeleteSessionTemplateRequest) (*DeleteSessionTemplateResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method DeleteSessionTemplate not implemented")
+}
 func (UnimplementedControllerServiceServer) CreateScheduledSessionOperation(context.Context, *CreateScheduledSessionOperationRequest) (*CreateScheduledSessionOperationResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method CreateScheduledSessionOperation not implemented")
 }
@@ -830,6 +1199,18 @@ func (UnimplementedControllerServiceServer) ListScheduledSessionOperations(conte
 func (UnimplementedControllerServiceServer) CancelScheduledSessionOperation(context.Context, *CancelScheduledSessionOperationRequest) (*CancelScheduledSessionOperationResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method CancelScheduledSessionOperation not implemented")
 }
+func (UnimplementedControllerServiceServer) PauseScheduledSessionOperation(context.Context, *PauseScheduledSessionOperationRequest) (*PauseScheduledSessionOperationResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method PauseScheduledSessionOperation not implemented")
+}
+func (UnimplementedControllerServiceServer) ResumeScheduledSessionOperation(context.Context, *ResumeScheduledSessionOperationRequest) (*ResumeScheduledSessionOperationResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ResumeScheduledSessionOperation not implemented")
+}
+func (UnimplementedControllerServiceServer) SkipScheduledSessionOperationOccurrence(context.Context, *SkipScheduledSessionOperationOccurrenceRequest) (*SkipScheduledSessionOperationOccurrenceResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method SkipScheduledSessionOperationOccurrence not implemented")
+}
+func (UnimplementedControllerServiceServer) ListScheduledSessionOperationRuns(context.Context, *ListScheduledSessionOperationRunsRequest) (*ListScheduledSessionOperationRunsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListScheduledSessionOperationRuns not implemented")
+}
 func (UnimplementedControllerServiceServer) mustEmbedUnimplementedControllerServiceServer() {}
 func (UnimplementedControllerServiceServer) testEmbeddedByValue()                           {}
 
@@ -905,6 +1286,53 @@ func _ControllerService_GetHeadlessHostLogs_Handler(srv interface{}, ctx context
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ControllerService_TailHeadlessHostLogs_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(TailHeadlessHostLogsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ControllerServiceServer).TailHeadlessHostLogs(m, &grpc.GenericServerStream[TailHeadlessHostLogsRequest, TailHeadlessHostLogsResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type ControllerService_TailHeadlessHostLogsServer = grpc.ServerStreamingServer[TailHeadlessHostLogsResponse]
+
+func _ControllerService_SearchHeadlessHostLogs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchHeadlessHostLogsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ControllerServiceServer).SearchHeadlessHostLogs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ControllerService_SearchHeadlessHostLogs_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ControllerServiceServer).SearchHeadlessHostLogs(ctx, req.(*SearchHeadlessHostLogsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ControllerService_PrepareHeadlessHostLogDownload_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PrepareHeadlessHostLogDownloadRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ControllerServiceServer).PrepareHeadlessHostLogDownload(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ControllerService_PrepareHeadlessHostLogDownload_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ControllerServiceServer).PrepareHeadlessHostLogDownload(ctx, req.(*PrepareHeadlessHostLogDownloadRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _ControllerService_ShutdownHeadlessHost_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ShutdownHeadlessHostRequest)
 	if err := dec(in); err != nil {
@@ -1085,6 +1513,114 @@ func _ControllerService_ListHeadlessHostInstances_Handler(srv interface{}, ctx c
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ControllerService_CloneHeadlessHost_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CloneHeadlessHostRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ControllerServiceServer).CloneHeadlessHost(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ControllerService_CloneHeadlessHost_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ControllerServiceServer).CloneHeadlessHost(ctx, req.(*CloneHeadlessHostRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ControllerService_ListHostTemplates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListHostTemplatesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ControllerServiceServer).ListHostTemplates(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ControllerService_ListHostTemplates_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ControllerServiceServer).ListHostTemplates(ctx, req.(*ListHostTemplatesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ControllerService_GetHostTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetHostTemplateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ControllerServiceServer).GetHostTemplate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ControllerService_GetHostTemplate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ControllerServiceServer).GetHostTemplate(ctx, req.(*GetHostTemplateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ControllerService_CreateHostTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateHostTemplateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ControllerServiceServer).CreateHostTemplate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ControllerService_CreateHostTemplate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ControllerServiceServer).CreateHostTemplate(ctx, req.(*CreateHostTemplateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ControllerService_UpdateHostTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateHostTemplateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ControllerServiceServer).UpdateHostTemplate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ControllerService_UpdateHostTemplate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ControllerServiceServer).UpdateHostTemplate(ctx, req.(*UpdateHostTemplateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ControllerService_DeleteHostTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteHostTemplateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ControllerServiceServer).DeleteHostTemplate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ControllerService_DeleteHostTemplate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ControllerServiceServer).DeleteHostTemplate(ctx, req.(*DeleteHostTemplateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _ControllerService_CreateHeadlessAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(CreateHeadlessAccountRequest)
 	if err := dec(in); err != nil {
@@ -1661,6 +2197,168 @@ func _ControllerService_IssueResoniteLinkConnection_Handler(srv interface{}, ctx
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ControllerService_ListSessionUserEvents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListSessionUserEventsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ControllerServiceServer).ListSessionUserEvents(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ControllerService_ListSessionUserEvents_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ControllerServiceServer).ListSessionUserEvents(ctx, req.(*ListSessionUserEventsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ControllerService_GetSessionAttendance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSessionAttendanceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ControllerServiceServer).GetSessionAttendance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ControllerService_GetSessionAttendance_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ControllerServiceServer).GetSessionAttendance(ctx, req.(*GetSessionAttendanceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ControllerService_GetSessionUsageStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSessionUsageStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ControllerServiceServer).GetSessionUsageStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ControllerService_GetSessionUsageStats_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ControllerServiceServer).GetSessionUsageStats(ctx, req.(*GetSessionUsageStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ControllerService_GetMetricsSeries_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetMetricsSeriesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ControllerServiceServer).GetMetricsSeries(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ControllerService_GetMetricsSeries_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ControllerServiceServer).GetMetricsSeries(ctx, req.(*GetMetricsSeriesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ControllerService_ListSessionTemplates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListSessionTemplatesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ControllerServiceServer).ListSessionTemplates(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ControllerService_ListSessionTemplates_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ControllerServiceServer).ListSessionTemplates(ctx, req.(*ListSessionTemplatesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ControllerService_GetSessionTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSessionTemplateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ControllerServiceServer).GetSessionTemplate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ControllerService_GetSessionTemplate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ControllerServiceServer).GetSessionTemplate(ctx, req.(*GetSessionTemplateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ControllerService_CreateSessionTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateSessionTemplateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ControllerServiceServer).CreateSessionTemplate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ControllerService_CreateSessionTemplate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ControllerServiceServer).CreateSessionTemplate(ctx, req.(*CreateSessionTemplateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ControllerService_UpdateSessionTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateSessionTemplateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ControllerServiceServer).UpdateSessionTemplate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ControllerService_UpdateSessionTemplate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ControllerServiceServer).UpdateSessionTemplate(ctx, req.(*UpdateSessionTemplateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ControllerService_DeleteSessionTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteSessionTemplateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ControllerServiceServer).DeleteSessionTemplate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ControllerService_DeleteSessionTemplate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ControllerServiceServer).DeleteSessionTemplate(ctx, req.(*DeleteSessionTemplateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _ControllerService_CreateScheduledSessionOperation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(CreateScheduledSessionOperationRequest)
 	if err := dec(in); err != nil {
@@ -1715,6 +2413,78 @@ func _ControllerService_CancelScheduledSessionOperation_Handler(srv interface{},
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ControllerService_PauseScheduledSessionOperation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PauseScheduledSessionOperationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ControllerServiceServer).PauseScheduledSessionOperation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ControllerService_PauseScheduledSessionOperation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ControllerServiceServer).PauseScheduledSessionOperation(ctx, req.(*PauseScheduledSessionOperationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ControllerService_ResumeScheduledSessionOperation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResumeScheduledSessionOperationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ControllerServiceServer).ResumeScheduledSessionOperation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ControllerService_ResumeScheduledSessionOperation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ControllerServiceServer).ResumeScheduledSessionOperation(ctx, req.(*ResumeScheduledSessionOperationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ControllerService_SkipScheduledSessionOperationOccurrence_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SkipScheduledSessionOperationOccurrenceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ControllerServiceServer).SkipScheduledSessionOperationOccurrence(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ControllerService_SkipScheduledSessionOperationOccurrence_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ControllerServiceServer).SkipScheduledSessionOperationOccurrence(ctx, req.(*SkipScheduledSessionOperationOccurrenceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ControllerService_ListScheduledSessionOperationRuns_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListScheduledSessionOperationRunsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ControllerServiceServer).ListScheduledSessionOperationRuns(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ControllerService_ListScheduledSessionOperationRuns_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ControllerServiceServer).ListScheduledSessionOperationRuns(ctx, req.(*ListScheduledSessionOperationRunsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // ControllerService_ServiceDesc is the grpc.ServiceDesc for ControllerService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -1734,6 +2504,14 @@ var ControllerService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetHeadlessHostLogs",
 			Handler:    _ControllerService_GetHeadlessHostLogs_Handler,
 		},
+		{
+			MethodName: "SearchHeadlessHostLogs",
+			Handler:    _ControllerService_SearchHeadlessHostLogs_Handler,
+		},
+		{
+			MethodName: "PrepareHeadlessHostLogDownload",
+			Handler:    _ControllerService_PrepareHeadlessHostLogDownload_Handler,
+		},
 		{
 			MethodName: "ShutdownHeadlessHost",
 			Handler:    _ControllerService_ShutdownHeadlessHost_Handler,
@@ -1774,6 +2552,30 @@ var ControllerService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ListHeadlessHostInstances",
 			Handler:    _ControllerService_ListHeadlessHostInstances_Handler,
 		},
+		{
+			MethodName: "CloneHeadlessHost",
+			Handler:    _ControllerService_CloneHeadlessHost_Handler,
+		},
+		{
+			MethodName: "ListHostTemplates",
+			Handler:    _ControllerService_ListHostTemplates_Handler,
+		},
+		{
+			MethodName: "GetHostTemplate",
+			Handler:    _ControllerService_GetHostTemplate_Handler,
+		},
+		{
+			MethodName: "CreateHostTemplate",
+			Handler:    _ControllerService_CreateHostTemplate_Handler,
+		},
+		{
+			MethodName: "UpdateHostTemplate",
+			Handler:    _ControllerService_UpdateHostTemplate_Handler,
+		},
+		{
+			MethodName: "DeleteHostTemplate",
+			Handler:    _ControllerService_DeleteHostTemplate_Handler,
+		},
 		{
 			MethodName: "CreateHeadlessAccount",
 			Handler:    _ControllerService_CreateHeadlessAccount_Handler,
@@ -1902,6 +2704,42 @@ var ControllerService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "IssueResoniteLinkConnection",
 			Handler:    _ControllerService_IssueResoniteLinkConnection_Handler,
 		},
+		{
+			MethodName: "ListSessionUserEvents",
+			Handler:    _ControllerService_ListSessionUserEvents_Handler,
+		},
+		{
+			MethodName: "GetSessionAttendance",
+			Handler:    _ControllerService_GetSessionAttendance_Handler,
+		},
+		{
+			MethodName: "GetSessionUsageStats",
+			Handler:    _ControllerService_GetSessionUsageStats_Handler,
+		},
+		{
+			MethodName: "GetMetricsSeries",
+			Handler:    _ControllerService_GetMetricsSeries_Handler,
+		},
+		{
+			MethodName: "ListSessionTemplates",
+			Handler:    _ControllerService_ListSessionTemplates_Handler,
+		},
+		{
+			MethodName: "GetSessionTemplate",
+			Handler:    _ControllerService_GetSessionTemplate_Handler,
+		},
+		{
+			MethodName: "CreateSessionTemplate",
+			Handler:    _ControllerService_CreateSessionTemplate_Handler,
+		},
+		{
+			MethodName: "UpdateSessionTemplate",
+			Handler:    _ControllerService_UpdateSessionTemplate_Handler,
+		},
+		{
+			MethodName: "DeleteSessionTemplate",
+			Handler:    _ControllerService_DeleteSessionTemplate_Handler,
+		},
 		{
 			MethodName: "CreateScheduledSessionOperation",
 			Handler:    _ControllerService_CreateScheduledSessionOperation_Handler,
@@ -1914,7 +2752,29 @@ var ControllerService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "CancelScheduledSessionOperation",
 			Handler:    _ControllerService_CancelScheduledSessionOperation_Handler,
 		},
+		{
+			MethodName: "PauseScheduledSessionOperation",
+			Handler:    _ControllerService_PauseScheduledSessionOperation_Handler,
+		},
+		{
+			MethodName: "ResumeScheduledSessionOperation",
+			Handler:    _ControllerService_ResumeScheduledSessionOperation_Handler,
+		},
+		{
+			MethodName: "SkipScheduledSessionOperationOccurrence",
+			Handler:    _ControllerService_SkipScheduledSessionOperationOccurrence_Handler,
+		},
+		{
+			MethodName: "ListScheduledSessionOperationRuns",
+			Handler:    _ControllerService_ListScheduledSessionOperationRuns_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "TailHeadlessHostLogs",
+			Handler:       _ControllerService_TailHeadlessHostLogs_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "hdlctrl/v1/controller.proto",
 }
@@ -43,6 +43,15 @@ const (
 	// ControllerServiceGetHeadlessHostLogsProcedure is the fully-qualified name of the
 	// ControllerService's GetHeadlessHostLogs RPC.
 	ControllerServiceGetHeadlessHostLogsProcedure = "/hdlctrl.v1.ControllerService/GetHeadlessHostLogs"
+	// ControllerServiceTailHeadlessHostLogsProcedure is the fully-qualified name of the
+	// ControllerService's TailHeadlessHostLogs RPC.
+	ControllerServiceTailHeadlessHostLogsProcedure = "/hdlctrl.v1.ControllerService/TailHeadlessHostLogs"
+	// ControllerServiceSearchHeadlessHostLogsProcedure is the fully-qualified name of the
+	// ControllerService's SearchHeadlessHostLogs RPC.
+	ControllerServiceSearchHeadlessHostLogsProcedure = "/hdlctrl.v1.ControllerService/SearchHeadlessHostLogs"
+	// ControllerServicePrepareHeadlessHostLogDownloadProcedure is the fully-qualified name of the
+	// ControllerService's PrepareHeadlessHostLogDownload RPC.
+	ControllerServicePrepareHeadlessHostLogDownloadProcedure = "/hdlctrl.v1.ControllerService/PrepareHeadlessHostLogDownload"
 	// ControllerServiceShutdownHeadlessHostProcedure is the fully-qualified name of the
 	// ControllerService's ShutdownHeadlessHost RPC.
 	ControllerServiceShutdownHeadlessHostProcedure = "/hdlctrl.v1.ControllerService/ShutdownHeadlessHost"
@@ -73,6 +82,24 @@ const (
 	// ControllerServiceListHeadlessHostInstancesProcedure is the fully-qualified name of the
 	// ControllerService's ListHeadlessHostInstances RPC.
 	ControllerServiceListHeadlessHostInstancesProcedure = "/hdlctrl.v1.ControllerService/ListHeadlessHostInstances"
+	// ControllerServiceCloneHeadlessHostProcedure is the fully-qualified name of the
+	// ControllerService's CloneHeadlessHost RPC.
+	ControllerServiceCloneHeadlessHostProcedure = "/hdlctrl.v1.ControllerService/CloneHeadlessHost"
+	// ControllerServiceListHostTemplatesProcedure is the fully-qualified name of the
+	// ControllerService's ListHostTemplates RPC.
+	ControllerServiceListHostTemplatesProcedure = "/hdlctrl.v1.ControllerService/ListHostTemplates"
+	// ControllerServiceGetHostTemplateProcedure is the fully-qualified name of the ControllerService's
+	// GetHostTemplate RPC.
+	ControllerServiceGetHostTemplateProcedure = "/hdlctrl.v1.ControllerService/GetHostTemplate"
+	// ControllerServiceCreateHostTemplateProcedure is the fully-qualified name of the
+	// ControllerService's CreateHostTemplate RPC.
+	ControllerServiceCreateHostTemplateProcedure = "/hdlctrl.v1.ControllerService/CreateHostTemplate"
+	// ControllerServiceUpdateHostTemplateProcedure is the fully-qualified name of the
+	// ControllerService's UpdateHostTemplate RPC.
+	ControllerServiceUpdateHostTemplateProcedure = "/hdlctrl.v1.ControllerService/UpdateHostTemplate"
+	// ControllerServiceDeleteHostTemplateProcedure is the fully-qualified name of the
+	// ControllerService's DeleteHostTemplate RPC.
+	ControllerServiceDeleteHostTemplateProcedure = "/hdlctrl.v1.ControllerService/DeleteHostTemplate"
 	// ControllerServiceCreateHeadlessAccountProcedure is the fully-qualified name of the
 	// ControllerService's CreateHeadlessAccount RPC.
 	ControllerServiceCreateHeadlessAccountProcedure = "/hdlctrl.v1.ControllerService/CreateHeadlessAccount"
@@ -169,6 +196,33 @@ const (
 	// ControllerServiceIssueResoniteLinkConnectionProcedure is the fully-qualified name of the
 	// ControllerService's IssueResoniteLinkConnection RPC.
 	ControllerServiceIssueResoniteLinkConnectionProcedure = "/hdlctrl.v1.ControllerService/IssueResoniteLinkConnection"
+	// ControllerServiceListSessionUserEventsProcedure is the fully-qualified name of the
+	// ControllerService's ListSessionUserEvents RPC.
+	ControllerServiceListSessionUserEventsProcedure = "/hdlctrl.v1.ControllerService/ListSessionUserEvents"
+	// ControllerServiceGetSessionAttendanceProcedure is the fully-qualified name of the
+	// ControllerService's GetSessionAttendance RPC.
+	ControllerServiceGetSessionAttendanceProcedure = "/hdlctrl.v1.ControllerService/GetSessionAttendance"
+	// ControllerServiceGetSessionUsageStatsProcedure is the fully-qualified name of the
+	// ControllerService's GetSessionUsageStats RPC.
+	ControllerServiceGetSessionUsageStatsProcedure = "/hdlctrl.v1.ControllerService/GetSessionUsageStats"
+	// ControllerServiceGetMetricsSeriesProcedure is the fully-qualified name of the ControllerService's
+	// GetMetricsSeries RPC.
+	ControllerServiceGetMetricsSeriesProcedure = "/hdlctrl.v1.ControllerService/GetMetricsSeries"
+	// ControllerServiceListSessionTemplatesProcedure is the fully-qualified name of the
+	// ControllerService's ListSessionTemplates RPC.
+	ControllerServiceListSessionTemplatesProcedure = "/hdlctrl.v1.ControllerService/ListSessionTemplates"
+	// ControllerServiceGetSessionTemplateProcedure is the fully-qualified name of the
+	// ControllerService's GetSessionTemplate RPC.
+	ControllerServiceGetSessionTemplateProcedure = "/hdlctrl.v1.ControllerService/GetSessionTemplate"
+	// ControllerServiceCreateSessionTemplateProcedure is the fully-qualified name of the
+	// ControllerService's CreateSessionTemplate RPC.
+	ControllerServiceCreateSessionTemplateProcedure = "/hdlctrl.v1.ControllerService/CreateSessionTemplate"
+	// ControllerServiceUpdateSessionTemplateProcedure is the fully-qualified name of the
+	// ControllerService's UpdateSessionTemplate RPC.
+	ControllerServiceUpdateSessionTemplateProcedure = "/hdlctrl.v1.ControllerService/UpdateSessionTemplate"
+	// ControllerServiceDeleteSessionTemplateProcedure is the fully-qualified name of the
+	// ControllerService's DeleteSessionTemplate RPC.
+	ControllerServiceDeleteSessionTemplateProcedure = "/hdlctrl.v1.ControllerService/DeleteSessionTemplate"
 	// ControllerServiceCreateScheduledSessionOperationProcedure is the fully-qualified name of the
 	// ControllerService's CreateScheduledSessionOperation RPC.
 	ControllerServiceCreateScheduledSessionOperationProcedure = "/hdlctrl.v1.ControllerService/CreateScheduledSessionOperation"
@@ -178,6 +232,18 @@ const (
 	// ControllerServiceCancelScheduledSessionOperationProcedure is the fully-qualified name of the
 	// ControllerService's CancelScheduledSessionOperation RPC.
 	ControllerServiceCancelScheduledSessionOperationProcedure = "/hdlctrl.v1.ControllerService/CancelScheduledSessionOperation"
+	// ControllerServicePauseScheduledSessionOperationProcedure is the fully-qualified name of the
+	// ControllerService's PauseScheduledSessionOperation RPC.
+	ControllerServicePauseScheduledSessionOperationProcedure = "/hdlctrl.v1.ControllerService/PauseScheduledSessionOperation"
+	// ControllerServiceResumeScheduledSessionOperationProcedure is the fully-qualified name of the
+	// ControllerService's ResumeScheduledSessionOperation RPC.
+	ControllerServiceResumeScheduledSessionOperationProcedure = "/hdlctrl.v1.ControllerService/ResumeScheduledSessionOperation"
+	// ControllerServiceSkipScheduledSessionOperationOccurrenceProcedure is the fully-qualified name of
+	// the ControllerService's SkipScheduledSessionOperationOccurrence RPC.
+	ControllerServiceSkipScheduledSessionOperationOccurrenceProcedure = "/hdlctrl.v1.ControllerService/SkipScheduledSessionOperationOccurrence"
+	// ControllerServiceListScheduledSessionOperationRunsProcedure is the fully-qualified name of the
+	// ControllerService's ListScheduledSessionOperationRuns RPC.
+	ControllerServiceListScheduledSessionOperationRunsProcedure = "/hdlctrl.v1.ControllerService/ListScheduledSessionOperationRuns"
 )
 
 // ControllerServiceClient is a client for the hdlctrl.v1.ControllerService service.
@@ -186,6 +252,12 @@ type ControllerServiceClient interface {
 	ListHeadlessHost(context.Context, *connect.Request[v1.ListHeadlessHostRequest]) (*connect.Response[v1.ListHeadlessHostResponse], error)
 	GetHeadlessHost(context.Context, *connect.Request[v1.GetHeadlessHostRequest]) (*connect.Response[v1.GetHeadlessHostResponse], error)
 	GetHeadlessHostLogs(context.Context, *connect.Request[v1.GetHeadlessHostLogsRequest]) (*connect.Response[v1.GetHeadlessHostLogsResponse], error)
+	// ホストのログを直近のバックログから送り、以降は追記され次第 push する
+	TailHeadlessHostLogs(context.Context, *connect.Request[v1.TailHeadlessHostLogsRequest]) (*connect.ServerStreamForClient[v1.TailHeadlessHostLogsResponse], error)
+	// 閲覧できる全ホスト・全インスタンスのログを本文で検索する
+	SearchHeadlessHostLogs(context.Context, *connect.Request[v1.SearchHeadlessHostLogsRequest]) (*connect.Response[v1.SearchHeadlessHostLogsResponse], error)
+	// インスタンスのログ全体 (または期間内) をファイルにして、ダウンロード用の URL を返す
+	PrepareHeadlessHostLogDownload(context.Context, *connect.Request[v1.PrepareHeadlessHostLogDownloadRequest]) (*connect.Response[v1.PrepareHeadlessHostLogDownloadResponse], error)
 	ShutdownHeadlessHost(context.Context, *connect.Request[v1.ShutdownHeadlessHostRequest]) (*connect.Response[v1.ShutdownHeadlessHostResponse], error)
 	KillHeadlessHost(context.Context, *connect.Request[v1.KillHeadlessHostRequest]) (*connect.Response[v1.KillHeadlessHostResponse], error)
 	UpdateHeadlessHostSettings(context.Context, *connect.Request[v1.UpdateHeadlessHostSettingsRequest]) (*connect.Response[v1.UpdateHeadlessHostSettingsResponse], error)
@@ -196,6 +268,14 @@ type ControllerServiceClient interface {
 	ListHeadlessHostImageTags(context.Context, *connect.Request[v1.ListHeadlessHostImageTagsRequest]) (*connect.Response[v1.ListHeadlessHostImageTagsResponse], error)
 	DeleteHeadlessHost(context.Context, *connect.Request[v1.DeleteHeadlessHostRequest]) (*connect.Response[v1.DeleteHeadlessHostResponse], error)
 	ListHeadlessHostInstances(context.Context, *connect.Request[v1.ListHeadlessHostInstancesRequest]) (*connect.Response[v1.ListHeadlessHostInstancesResponse], error)
+	// 既存ホストの設定をコピーして新しいホストを起動する
+	CloneHeadlessHost(context.Context, *connect.Request[v1.CloneHeadlessHostRequest]) (*connect.Response[v1.CloneHeadlessHostResponse], error)
+	// ホストテンプレート系
+	ListHostTemplates(context.Context, *connect.Request[v1.ListHostTemplatesRequest]) (*connect.Response[v1.ListHostTemplatesResponse], error)
+	GetHostTemplate(context.Context, *connect.Request[v1.GetHostTemplateRequest]) (*connect.Response[v1.GetHostTemplateResponse], error)
+	CreateHostTemplate(context.Context, *connect.Request[v1.CreateHostTemplateRequest]) (*connect.Response[v1.CreateHostTemplateResponse], error)
+	UpdateHostTemplate(context.Context, *connect.Request[v1.UpdateHostTemplateRequest]) (*connect.Response[v1.UpdateHostTemplateResponse], error)
+	DeleteHostTemplate(context.Context, *connect.Request[v1.DeleteHostTemplateRequest]) (*connect.Response[v1.DeleteHostTemplateResponse], error)
 	// アカウント系
 	CreateHeadlessAccount(context.Context, *connect.Request[v1.CreateHeadlessAccountRequest]) (*connect.Response[v1.CreateHeadlessAccountResponse], error)
 	ListHeadlessAccounts(context.Context, *connect.Request[v1.ListHeadlessAccountsRequest]) (*connect.Response[v1.ListHeadlessAccountsResponse], error)
@@ -232,10 +312,28 @@ type ControllerServiceClient interface {
 	KickUser(context.Context, *connect.Request[v1.KickUserRequest]) (*connect.Response[v1.KickUserResponse], error)
 	BanUser(context.Context, *connect.Request[v1.BanUserRequest]) (*connect.Response[v1.BanUserResponse], error)
 	IssueResoniteLinkConnection(context.Context, *connect.Request[v1.IssueResoniteLinkConnectionRequest]) (*connect.Response[v1.IssueResoniteLinkConnectionResponse], error)
+	// セッションへのユーザーの出入りの履歴と、そこから集計した出席・利用状況
+	ListSessionUserEvents(context.Context, *connect.Request[v1.ListSessionUserEventsRequest]) (*connect.Response[v1.ListSessionUserEventsResponse], error)
+	GetSessionAttendance(context.Context, *connect.Request[v1.GetSessionAttendanceRequest]) (*connect.Response[v1.GetSessionAttendanceResponse], error)
+	GetSessionUsageStats(context.Context, *connect.Request[v1.GetSessionUsageStatsRequest]) (*connect.Response[v1.GetSessionUsageStatsResponse], error)
+	// メトリクス系
+	// ホスト / セッションのメトリクスの時系列 (ダッシュボードのグラフ用)
+	GetMetricsSeries(context.Context, *connect.Request[v1.GetMetricsSeriesRequest]) (*connect.Response[v1.GetMetricsSeriesResponse], error)
+	// セッションテンプレート系
+	ListSessionTemplates(context.Context, *connect.Request[v1.ListSessionTemplatesRequest]) (*connect.Response[v1.ListSessionTemplatesResponse], error)
+	GetSessionTemplate(context.Context, *connect.Request[v1.GetSessionTemplateRequest]) (*connect.Response[v1.GetSessionTemplateResponse], error)
+	CreateSessionTemplate(context.Context, *connect.Request[v1.CreateSessionTemplateRequest]) (*connect.Response[v1.CreateSessionTemplateResponse], error)
+	UpdateSessionTemplate(context.Context, *connect.Request[v1.UpdateSessionTemplateRequest]) (*connect.Response[v1.UpdateSessionTemplateResponse], error)
+	DeleteSessionTemplate(context.Context, *connect.Request[v1.DeleteSessionTemplateRequest]) (*connect.Response[v1.DeleteSessionTemplateResponse], error)
 	// 予約操作系
 	CreateScheduledSessionOperation(context.Context, *connect.Request[v1.CreateScheduledSessionOperationRequest]) (*connect.Response[v1.CreateScheduledSessionOperationResponse], error)
 	ListScheduledSessionOperations(context.Context, *connect.Request[v1.ListScheduledSessionOperationsRequest]) (*connect.Response[v1.ListScheduledSessionOperationsResponse], error)
 	CancelScheduledSessionOperation(context.Context, *connect.Request[v1.CancelScheduledSessionOperationRequest]) (*connect.Response[v1.CancelScheduledSessionOperationResponse], error)
+	PauseScheduledSessionOperation(context.Context, *connect.Request[v1.PauseScheduledSessionOperationRequest]) (*connect.Response[v1.PauseScheduledSessionOperationResponse], error)
+	ResumeScheduledSessionOperation(context.Context, *connect.Request[v1.ResumeScheduledSessionOperationRequest]) (*connect.Response[v1.ResumeScheduledSessionOperationResponse], error)
+	// 繰り返し予約の次の 1 回を飛ばす
+	SkipScheduledSessionOperationOccurrence(context.Context, *connect.Request[v1.SkipScheduledSessionOperationOccurrenceRequest]) (*connect.Response[v1.SkipScheduledSessionOperationOccurrenceResponse], error)
+	ListScheduledSessionOperationRuns(context.Context, *connect.Request[v1.ListScheduledSessionOperationRunsRequest]) (*connect.Response[v1.ListScheduledSessionOperationRunsResponse], error)
 }
 
 // NewControllerServiceClient constructs a client for the hdlctrl.v1.ControllerService service. By
@@ -267,6 +365,24 @@ func NewControllerServiceClient(httpClient connect.HTTPClient, baseURL string, o
 			connect.WithSchema(controllerServiceMethods.ByName("GetHeadlessHostLogs")),
 			connect.WithClientOptions(opts...),
 		),
+		tailHeadlessHostLogs: connect.NewClient[v1.TailHeadlessHostLogsRequest, v1.TailHeadlessHostLogsResponse](
+			httpClient,
+			baseURL+ControllerServiceTailHeadlessHostLogsProcedure,
+			connect.WithSchema(controllerServiceMethods.ByName("TailHeadlessHostLogs")),
+			connect.WithClientOptions(opts...),
+		),
+		searchHeadlessHostLogs: connect.NewClient[v1.SearchHeadlessHostLogsRequest, v1.SearchHeadlessHostLogsResponse](
+			httpClient,
+			baseURL+ControllerServiceSearchHeadlessHostLogsProcedure,
+			connect.WithSchema(controllerServiceMethods.ByName("SearchHeadlessHostLogs")),
+			connect.WithClientOptions(opts...),
+		),
+		prepareHeadlessHostLogDownload: connect.NewClient[v1.PrepareHeadlessHostLogDownloadRequest, v1.PrepareHeadlessHostLogDownloadResponse](
+			httpClient,
+			baseURL+ControllerServicePrepareHeadlessHostLogDownloadProcedure,
+			connect.WithSchema(controllerServiceMethods.ByName("PrepareHeadlessHostLogDownload")),
+			connect.WithClientOptions(opts...),
+		),
 		shutdownHeadlessHost: connect.NewClient[v1.ShutdownHeadlessHostRequest, v1.ShutdownHeadlessHostResponse](
 			httpClient,
 			baseURL+ControllerServiceShutdownHeadlessHostProcedure,
@@ -327,6 +443,42 @@ func NewControllerServiceClient(httpClient connect.HTTPClient, baseURL string, o
 			connect.WithSchema(controllerServiceMethods.ByName("ListHeadlessHostInstances")),
 			connect.WithClientOptions(opts...),
 		),
+		cloneHeadlessHost: connect.NewClient[v1.CloneHeadlessHostRequest, v1.CloneHeadlessHostResponse](
+			httpClient,
+			baseURL+ControllerServiceCloneHeadlessHostProcedure,
+			connect.WithSchema(controllerServiceMethods.ByName("CloneHeadlessHost")),
+			connect.WithClientOptions(opts...),
+		),
+		listHostTemplates: connect.NewClient[v1.ListHostTemplatesRequest, v1.ListHostTemplatesResponse](
+			httpClient,
+			baseURL+ControllerServiceListHostTemplatesProcedure,
+			connect.WithSchema(controllerServiceMethods.ByName("ListHostTemplates")),
+			connect.WithClientOptions(opts...),
+		),
+		getHostTemplate: connect.NewClient[v1.GetHostTemplateRequest, v1.GetHostTemplateResponse](
+			httpClient,
+			baseURL+ControllerServiceGetHostTemplateProcedure,
+			connect.WithSchema(controllerServiceMethods.ByName("GetHostTemplate")),
+			connect.WithClientOptions(opts...),
+		),
+		createHostTemplate: connect.NewClient[v1.CreateHostTemplateRequest, v1.CreateHostTemplateResponse](
+			httpClient,
+			baseURL+ControllerServiceCreateHostTemplateProcedure,
+			connect.WithSchema(controllerServiceMethods.ByName("CreateHostTemplate")),
+			connect.WithClientOptions(opts...),
+		),
+		updateHostTemplate: connect.NewClient[v1.UpdateHostTemplateRequest, v1.UpdateHostTemplateResponse](
+			httpClient,
+			baseURL+ControllerServiceUpdateHostTemplateProcedure,
+			connect.WithSchema(controllerServiceMethods.ByName("UpdateHostTemplate")),
+			connect.WithClientOptions(opts...),
+		),
+		deleteHostTemplate: connect.NewClient[v1.DeleteHostTemplateRequest, v1.DeleteHostTemplateResponse](
+			httpClient,
+			baseURL+ControllerServiceDeleteHostTemplateProcedure,
+			connect.WithSchema(controllerServiceMethods.ByName("DeleteHostTemplate")),
+			connect.WithClientOptions(opts...),
+		),
 		createHeadlessAccount: connect.NewClient[v1.CreateHeadlessAccountRequest, v1.CreateHeadlessAccountResponse](
 			httpClient,
 			baseURL+ControllerServiceCreateHeadlessAccountProcedure,
@@ -519,6 +671,60 @@ func NewControllerServiceClient(httpClient connect.HTTPClient, baseURL string, o
 			connect.WithSchema(controllerServiceMethods.ByName("IssueResoniteLinkConnection")),
 			connect.WithClientOptions(opts...),
 		),
+		listSessionUserEvents: connect.NewClient[v1.ListSessionUserEventsRequest, v1.ListSessionUserEventsResponse](
+			httpClient,
+			baseURL+ControllerServiceListSessionUserEventsProcedure,
+			connect.WithSchema(controllerServiceMethods.ByName("ListSessionUserEvents")),
+			connect.WithClientOptions(opts...),
+		),
+		getSessionAttendance: connect.NewClient[v1.GetSessionAttendanceRequest, v1.GetSessionAttendanceResponse](
+			httpClient,
+			baseURL+ControllerServiceGetSessionAttendanceProcedure,
+			connect.WithSchema(controllerServiceMethods.ByName("GetSessionAttendance")),
+			connect.WithClientOptions(opts...),
+		),
+		getSessionUsageStats: connect.NewClient[v1.GetSessionUsageStatsRequest, v1.GetSessionUsageStatsResponse](
+			httpClient,
+			baseURL+ControllerServiceGetSessionUsageStatsProcedure,
+			connect.WithSchema(controllerServiceMethods.ByName("GetSessionUsageStats")),
+			connect.WithClientOptions(opts...),
+		),
+		getMetricsSeries: connect.NewClient[v1.GetMetricsSeriesRequest, v1.GetMetricsSeriesResponse](
+			httpClient,
+			baseURL+ControllerServiceGetMetricsSeriesProcedure,
+			connect.WithSchema(controllerServiceMethods.ByName("GetMetricsSeries")),
+			connect.WithClientOptions(opts...),
+		),
+		listSessionTemplates: connect.NewClient[v1.ListSessionTemplatesRequest, v1.ListSessionTemplatesResponse](
+			httpClient,
+			baseURL+ControllerServiceListSessionTemplatesProcedure,
+			connect.WithSchema(controllerServiceMethods.ByName("ListSessionTemplates")),
+			connect.WithClientOptions(opts...),
+		),
+		getSessionTemplate: connect.NewClient[v1.GetSessionTemplateRequest, v1.GetSessionTemplateResponse](
+			httpClient,
+			baseURL+ControllerServiceGetSessionTemplateProcedure,
+			connect.WithSchema(controllerServiceMethods.ByName("GetSessionTemplate")),
+			connect.WithClientOptions(opts...),
+		),
+		createSessionTemplate: connect.NewClient[v1.CreateSessionTemplateRequest, v1.CreateSessionTemplateResponse](
+			httpClient,
+			baseURL+ControllerServiceCreateSessionTemplateProcedure,
+			connect.WithSchema(controllerServiceMethods.ByName("CreateSessionTemplate")),
+			connect.WithClientOptions(opts...),
+		),
+		updateSessionTemplate: connect.NewClient[v1.UpdateSessionTemplateRequest, v1.UpdateSessionTemplateResponse](
+			httpClient,
+			baseURL+ControllerServiceUpdateSessionTemplateProcedure,
+			connect.WithSchema(controllerServiceMethods.ByName("UpdateSessionTemplate")),
+			connect.WithClientOptions(opts...),
+		),
+		deleteSessionTemplate: connect.NewClient[v1.DeleteSessionTemplateRequest, v1.DeleteSessionTemplateResponse](
+			httpClient,
+			baseURL+ControllerServiceDeleteSessionTemplateProcedure,
+			connect.WithSchema(controllerServiceMethods.ByName("DeleteSessionTemplate")),
+			connect.WithClientOptions(opts...),
+		),
 		createScheduledSessionOperation: connect.NewClient[v1.CreateScheduledSessionOperationRequest, v1.CreateScheduledSessionOperationResponse](
 			httpClient,
 			baseURL+ControllerServiceCreateScheduledSessionOperationProcedure,
@@ -537,59 +743,105 @@ func NewControllerServiceClient(httpClient connect.HTTPClient, baseURL string, o
 			connect.WithSchema(controllerServiceMethods.ByName("CancelScheduledSessionOperation")),
 			connect.WithClientOptions(opts...),
 		),
+		pauseScheduledSessionOperation: connect.NewClient[v1.PauseScheduledSessionOperationRequest, v1.PauseScheduledSessionOperationResponse](
+			httpClient,
+			baseURL+ControllerServicePauseScheduledSessionOperationProcedure,
+			connect.WithSchema(controllerServiceMethods.ByName("PauseScheduledSessionOperation")),
+			connect.WithClientOptions(opts...),
+		),
+		resumeScheduledSessionOperation: connect.NewClient[v1.ResumeScheduledSessionOperationRequest, v1.ResumeScheduledSessionOperationResponse](
+			httpClient,
+			baseURL+ControllerServiceResumeScheduledSessionOperationProcedure,
+			connect.WithSchema(controllerServiceMethods.ByName("ResumeScheduledSessionOperation")),
+			connect.WithClientOptions(opts...),
+		),
+		skipScheduledSessionOperationOccurrence: connect.NewClient[v1.SkipScheduledSessionOperationOccurrenceRequest, v1.SkipScheduledSessionOperationOccurrenceResponse](
+			httpClient,
+			baseURL+ControllerServiceSkipScheduledSessionOperationOccurrenceProcedure,
+			connect.WithSchema(controllerServiceMethods.ByName("SkipScheduledSessionOperationOccurrence")),
+			connect.WithClientOptions(opts...),
+		),
+		listScheduledSessionOperationRuns: connect.NewClient[v1.ListScheduledSessionOperationRunsRequest, v1.ListScheduledSessionOperationRunsResponse](
+			httpClient,
+			baseURL+ControllerServiceListScheduledSessionOperationRunsProcedure,
+			connect.WithSchema(controllerServiceMethods.ByName("ListScheduledSessionOperationRuns")),
+			connect.WithClientOptions(opts...),
+		),
 	}
 }
 
 // controllerServiceClient implements ControllerServiceClient.
 type controllerServiceClient struct {
-	listHeadlessHost                 *connect.Client[v1.ListHeadlessHostRequest, v1.ListHeadlessHostResponse]
-	getHeadlessHost                  *connect.Client[v1.GetHeadlessHostRequest, v1.GetHeadlessHostResponse]
-	getHeadlessHostLogs              *connect.Client[v1.GetHeadlessHostLogsRequest, v1.GetHeadlessHostLogsResponse]
-	shutdownHeadlessHost             *connect.Client[v1.ShutdownHeadlessHostRequest, v1.ShutdownHeadlessHostResponse]
-	killHeadlessHost                 *connect.Client[v1.KillHeadlessHostRequest, v1.KillHeadlessHostResponse]
-	updateHeadlessHostSettings       *connect.Client[v1.UpdateHeadlessHostSettingsRequest, v1.UpdateHeadlessHostSettingsResponse]
-	restartHeadlessHost              *connect.Client[v1.RestartHeadlessHostRequest, v1.RestartHeadlessHostResponse]
-	startHeadlessHost                *connect.Client[v1.StartHeadlessHostRequest, v1.StartHeadlessHostResponse]
-	allowHostAccess                  *connect.Client[v1.AllowHostAccessRequest, v1.AllowHostAccessResponse]
-	denyHostAccess                   *connect.Client[v1.DenyHostAccessRequest, v1.DenyHostAccessResponse]
-	listHeadlessHostImageTags        *connect.Client[v1.ListHeadlessHostImageTagsRequest, v1.ListHeadlessHostImageTagsResponse]
-	deleteHeadlessHost               *connect.Client[v1.DeleteHeadlessHostRequest, v1.DeleteHeadlessHostResponse]
-	listHeadlessHostInstances        *connect.Client[v1.ListHeadlessHostInstancesRequest, v1.ListHeadlessHostInstancesResponse]
-	createHeadlessAccount            *connect.Client[v1.CreateHeadlessAccountRequest, v1.CreateHeadlessAccountResponse]
-	listHeadlessAccounts             *connect.Client[v1.ListHeadlessAccountsRequest, v1.ListHeadlessAccountsResponse]
-	deleteHeadlessAccount            *connect.Client[v1.DeleteHeadlessAccountRequest, v1.DeleteHeadlessAccountResponse]
-	updateHeadlessAccountCredentials *connect.Client[v1.UpdateHeadlessAccountCredentialsRequest, v1.UpdateHeadlessAccountCredentialsResponse]
-	getHeadlessAccountStorageInfo    *connect.Client[v1.GetHeadlessAccountStorageInfoRequest, v1.GetHeadlessAccountStorageInfoResponse]
-	refetchHeadlessAccountInfo       *connect.Client[v1.RefetchHeadlessAccountInfoRequest, v1.RefetchHeadlessAccountInfoResponse]
-	updateHeadlessAccountIcon        *connect.Client[v1.UpdateHeadlessAccountIconRequest, v1.UpdateHeadlessAccountIconResponse]
-	fetchWorldInfo                   *connect.Client[v1.FetchWorldInfoRequest, v11.FetchWorldInfoResponse]
-	searchUserInfo                   *connect.Client[v1.SearchUserInfoRequest, v11.SearchUserInfoResponse]
-	searchWorlds                     *connect.Client[v1.SearchWorldsRequest, v1.SearchWorldsResponse]
-	getOwnWorlds                     *connect.Client[v1.GetOwnWorldsRequest, v1.GetOwnWorldsResponse]
-	getResoniteUser                  *connect.Client[v1.GetResoniteUserRequest, v1.GetResoniteUserResponse]
-	getFriendRequests                *connect.Client[v1.GetFriendRequestsRequest, v1.GetFriendRequestsResponse]
-	acceptFriendRequests             *connect.Client[v1.AcceptFriendRequestsRequest, v1.AcceptFriendRequestsResponse]
-	listContacts                     *connect.Client[v1.ListContactsRequest, v1.ListContactsResponse]
-	getContactMessages               *connect.Client[v1.GetContactMessagesRequest, v1.GetContactMessagesResponse]
-	sendContactMessage               *connect.Client[v1.SendContactMessageRequest, v1.SendContactMessageResponse]
-	searchSessions                   *connect.Client[v1.SearchSessionsRequest, v1.SearchSessionsResponse]
-	getSessionDetails                *connect.Client[v1.GetSessionDetailsRequest, v1.GetSessionDetailsResponse]
-	startWorld                       *connect.Client[v1.StartWorldRequest, v1.StartWorldResponse]
-	stopSession                      *connect.Client[v1.StopSessionRequest, v1.StopSessionResponse]
-	deleteEndedSession               *connect.Client[v1.DeleteEndedSessionRequest, v1.DeleteEndedSessionResponse]
-	saveSessionWorld                 *connect.Client[v1.SaveSessionWorldRequest, v1.SaveSessionWorldResponse]
-	prepareSessionWorldDownload      *connect.Client[v1.PrepareSessionWorldDownloadRequest, v1.PrepareSessionWorldDownloadResponse]
-	inviteUser                       *connect.Client[v1.InviteUserRequest, v1.InviteUserResponse]
-	updateUserRole                   *connect.Client[v1.UpdateUserRoleRequest, v1.UpdateUserRoleResponse]
-	updateSessionParameters          *connect.Client[v1.UpdateSessionParametersRequest, v1.UpdateSessionParametersResponse]
-	updateSessionExtraSettings       *connect.Client[v1.UpdateSessionExtraSettingsRequest, v1.UpdateSessionExtraSettingsResponse]
-	listUsersInSession               *connect.Client[v1.ListUsersInSessionRequest, v1.ListUsersInSessionResponse]
-	kickUser                         *connect.Client[v1.KickUserRequest, v1.KickUserResponse]
-	banUser                          *connect.Client[v1.BanUserRequest, v1.BanUserResponse]
-	issueResoniteLinkConnection      *connect.Client[v1.IssueResoniteLinkConnectionRequest, v1.IssueResoniteLinkConnectionResponse]
-	createScheduledSessionOperation  *connect.Client[v1.CreateScheduledSessionOperationRequest, v1.CreateScheduledSessionOperationResponse]
-	listScheduledSessionOperations   *connect.Client[v1.ListScheduledSessionOperationsRequest, v1.ListScheduledSessionOperationsResponse]
-	cancelScheduledSessionOperation  *connect.Client[v1.CancelScheduledSessionOperationRequest, v1.CancelScheduledSessionOperationResponse]
+	listHeadlessHost                        *connect.Client[v1.ListHeadlessHostRequest, v1.ListHeadlessHostResponse]
+	getHeadlessHost                         *connect.Client[v1.GetHeadlessHostRequest, v1.GetHeadlessHostResponse]
+	getHeadlessHostLogs                     *connect.Client[v1.GetHeadlessHostLogsRequest, v1.GetHeadlessHostLogsResponse]
+	tailHeadlessHostLogs                    *connect.Client[v1.TailHeadlessHostLogsRequest, v1.TailHeadlessHostLogsResponse]
+	searchHeadlessHostLogs                  *connect.Client[v1.SearchHeadlessHostLogsRequest, v1.SearchHeadlessHostLogsResponse]
+	prepareHeadlessHostLogDownload          *connect.Client[v1.PrepareHeadlessHostLogDownloadRequest, v1.PrepareHeadlessHostLogDownloadResponse]
+	shutdownHeadlessHost                    *connect.Client[v1.ShutdownHeadlessHostRequest, v1.ShutdownHeadlessHostResponse]
+	killHeadlessHost                        *connect.Client[v1.KillHeadlessHostRequest, v1.KillHeadlessHostResponse]
+	updateHeadlessHostSettings              *connect.Client[v1.UpdateHeadlessHostSettingsRequest, v1.UpdateHeadlessHostSettingsResponse]
+	restartHeadlessHost                     *connect.Client[v1.RestartHeadlessHostRequest, v1.RestartHeadlessHostResponse]
+	startHeadlessHost                       *connect.Client[v1.StartHeadlessHostRequest, v1.StartHeadlessHostResponse]
+	allowHostAccess                         *connect.Client[v1.AllowHostAccessRequest, v1.AllowHostAccessResponse]
+	denyHostAccess                          *connect.Client[v1.DenyHostAccessRequest, v1.DenyHostAccessResponse]
+	listHeadlessHostImageTags               *connect.Client[v1.ListHeadlessHostImageTagsRequest, v1.ListHeadlessHostImageTagsResponse]
+	deleteHeadlessHost                      *connect.Client[v1.DeleteHeadlessHostRequest, v1.DeleteHeadlessHostResponse]
+	listHeadlessHostInstances               *connect.Client[v1.ListHeadlessHostInstancesRequest, v1.ListHeadlessHostInstancesResponse]
+	cloneHeadlessHost                       *connect.Client[v1.CloneHeadlessHostRequest, v1.CloneHeadlessHostResponse]
+	listHostTemplates                       *connect.Client[v1.ListHostTemplatesRequest, v1.ListHostTemplatesResponse]
+	getHostTemplate                         *connect.Client[v1.GetHostTemplateRequest, v1.GetHostTemplateResponse]
+	createHostTemplate                      *connect.Client[v1.CreateHostTemplateRequest, v1.CreateHostTemplateResponse]
+	updateHostTemplate                      *connect.Client[v1.UpdateHostTemplateRequest, v1.UpdateHostTemplateResponse]
+	deleteHostTemplate                      *connect.Client[v1.DeleteHostTemplateRequest, v1.DeleteHostTemplateResponse]
+	createHeadlessAccount                   *connect.Client[v1.CreateHeadlessAccountRequest, v1.CreateHeadlessAccountResponse]
+	listHeadlessAccounts                    *connect.Client[v1.ListHeadlessAccountsRequest, v1.ListHeadlessAccountsResponse]
+	deleteHeadlessAccount                   *connect.Client[v1.DeleteHeadlessAccountRequest, v1.DeleteHeadlessAccountResponse]
+	updateHeadlessAccountCredentials        *connect.Client[v1.UpdateHeadlessAccountCredentialsRequest, v1.UpdateHeadlessAccountCredentialsResponse]
+	getHeadlessAccountStorageInfo           *connect.Client[v1.GetHeadlessAccountStorageInfoRequest, v1.GetHeadlessAccountStorageInfoResponse]
+	refetchHeadlessAccountInfo              *connect.Client[v1.RefetchHeadlessAccountInfoRequest, v1.RefetchHeadlessAccountInfoResponse]
+	updateHeadlessAccountIcon               *connect.Client[v1.UpdateHeadlessAccountIconRequest, v1.UpdateHeadlessAccountIconResponse]
+	fetchWorldInfo                          *connect.Client[v1.FetchWorldInfoRequest, v11.FetchWorldInfoResponse]
+	searchUserInfo                          *connect.Client[v1.SearchUserInfoRequest, v11.SearchUserInfoResponse]
+	searchWorlds                            *connect.Client[v1.SearchWorldsRequest, v1.SearchWorldsResponse]
+	getOwnWorlds                            *connect.Client[v1.GetOwnWorldsRequest, v1.GetOwnWorldsResponse]
+	getResoniteUser                         *connect.Client[v1.GetResoniteUserRequest, v1.GetResoniteUserResponse]
+	getFriendRequests                       *connect.Client[v1.GetFriendRequestsRequest, v1.GetFriendRequestsResponse]
+	acceptFriendRequests                    *connect.Client[v1.AcceptFriendRequestsRequest, v1.AcceptFriendRequestsResponse]
+	listContacts                            *connect.Client[v1.ListContactsRequest, v1.ListContactsResponse]
+	getContactMessages                      *connect.Client[v1.GetContactMessagesRequest, v1.GetContactMessagesResponse]
+	sendContactMessage                      *connect.Client[v1.SendContactMessageRequest, v1.SendContactMessageResponse]
+	searchSessions                          *connect.Client[v1.SearchSessionsRequest, v1.SearchSessionsResponse]
+	getSessionDetails                       *connect.Client[v1.GetSessionDetailsRequest, v1.GetSessionDetailsResponse]
+	startWorld                              *connect.Client[v1.StartWorldRequest, v1.StartWorldResponse]
+	stopSession                             *connect.Client[v1.StopSessionRequest, v1.StopSessionResponse]
+	deleteEndedSession                      *connect.Client[v1.DeleteEndedSessionRequest, v1.DeleteEndedSessionResponse]
+	saveSessionWorld                        *connect.Client[v1.SaveSessionWorldRequest, v1.SaveSessionWorldResponse]
+	prepareSessionWorldDownload             *connect.Client[v1.PrepareSessionWorldDownloadRequest, v1.PrepareSessionWorldDownloadResponse]
+	inviteUser                              *connect.Client[v1.InviteUserRequest, v1.InviteUserResponse]
+	updateUserRole                          *connect.Client[v1.UpdateUserRoleRequest, v1.UpdateUserRoleResponse]
+	updateSessionParameters                 *connect.Client[v1.UpdateSessionParametersRequest, v1.UpdateSessionParametersResponse]
+	updateSessionExtraSettings              *connect.Client[v1.UpdateSessionExtraSettingsRequest, v1.UpdateSessionExtraSettingsResponse]
+	listUsersInSession                      *connect.Client[v1.ListUsersInSessionRequest, v1.ListUsersInSessionResponse]
+	kickUser                                *connect.Client[v1.KickUserRequest, v1.KickUserResponse]
+	banUser                                 *connect.Client[v1.BanUserRequest, v1.BanUserResponse]
+	issueResoniteLinkConnection             *connect.Client[v1.IssueResoniteLinkConnectionRequest, v1.IssueResoniteLinkConnectionResponse]
+	listSessionUserEvents                   *connect.Client[v1.ListSessionUserEventsRequest, v1.ListSessionUserEventsResponse]
+	getSessionAttendance                    *connect.Client[v1.GetSessionAttendanceRequest, v1.GetSessionAttendanceResponse]
+	getSessionUsageStats                    *connect.Client[v1.GetSessionUsageStatsRequest, v1.GetSessionUsageStatsResponse]
+	getMetricsSeries                        *connect.Client[v1.GetMetricsSeriesRequest, v1.GetMetricsSeriesResponse]
+	listSessionTemplates                    *connect.Client[v1.ListSessionTemplatesRequest, v1.ListSessionTemplatesResponse]
+	getSessionTemplate                      *connect.Client[v1.GetSessionTemplateRequest, v1.GetSessionTemplateResponse]
+	createSessionTemplate                   *connect.Client[v1.CreateSessionTemplateRequest, v1.CreateSessionTemplateResponse]
+	updateSessionTemplate                   *connect.Client[v1.UpdateSessionTemplateRequest, v1.UpdateSessionTemplateResponse]
+	deleteSessionTemplate                   *connect.Client[v1.DeleteSessionTemplateRequest, v1.DeleteSessionTemplateResponse]
+	createScheduledSessionOperation         *connect.Client[v1.CreateScheduledSessionOperationRequest, v1.CreateScheduledSessionOperationResponse]
+	listScheduledSessionOperations          *connect.Client[v1.ListScheduledSessionOperationsRequest, v1.ListScheduledSessionOperationsResponse]
+	cancelScheduledSessionOperation         *connect.Client[v1.CancelScheduledSessionOperationRequest, v1.CancelScheduledSessionOperationResponse]
+	pauseScheduledSessionOperation          *connect.Client[v1.PauseScheduledSessionOperationRequest, v1.PauseScheduledSessionOperationResponse]
+	resumeScheduledSessionOperation         *connect.Client[v1.ResumeScheduledSessionOperationRequest, v1.ResumeScheduledSessionOperationResponse]
+	skipScheduledSessionOperationOccurrence *connect.Client[v1.SkipScheduledSessionOperationOccurrenceRequest, v1.SkipScheduledSessionOperationOccurrenceResponse]
+	listScheduledSessionOperationRuns       *connect.Client[v1.ListScheduledSessionOperationRunsRequest, v1.ListScheduledSessionOperationRunsResponse]
 }
 
 // ListHeadlessHost calls hdlctrl.v1.ControllerService.ListHeadlessHost.
@@ -607,6 +859,21 @@ func (c *controllerServiceClient) GetHeadlessHostLogs(ctx context.Context, req *
 	return c.getHeadlessHostLogs.CallUnary(ctx, req)
 }
 
+// TailHeadlessHostLogs calls hdlctrl.v1.ControllerService.TailHeadlessHostLogs.
+func (c *controllerServiceClient) TailHeadlessHostLogs(ctx context.Context, req *connect.Request[v1.TailHeadlessHostLogsRequest]) (*connect.ServerStreamForClient[v1.TailHeadlessHostLogsResponse], error) {
+	return c.tailHeadlessHostLogs.CallServerStream(ctx, req)
+}
+
+// SearchHeadlessHostLogs calls hdlctrl.v1.ControllerService.SearchHeadlessHostLogs.
+func (c *controllerServiceClient) SearchHeadlessHostLogs(ctx context.Context, req *connect.Request[v1.SearchHeadlessHostLogsRequest]) (*connect.Response[v1.SearchHeadlessHostLogsResponse], error) {
+	return c.searchHeadlessHostLogs.CallUnary(ctx, req)
+}
+
+// PrepareHeadlessHostLogDownload calls hdlctrl.v1.ControllerService.PrepareHeadlessHostLogDownload.
+func (c *controllerServiceClient) PrepareHeadlessHostLogDownload(ctx context.Context, req *connect.Request[v1.PrepareHeadlessHostLogDownloadRequest]) (*connect.Response[v1.PrepareHeadlessHostLogDownloadResponse], error) {
+	return c.prepareHeadlessHostLogDownload.CallUnary(ctx, req)
+}
+
 // ShutdownHeadlessHost calls hdlctrl.v1.ControllerService.ShutdownHeadlessHost.
 func (c *controllerServiceClient) ShutdownHeadlessHost(ctx context.Context, req *connect.Request[v1.ShutdownHeadlessHostRequest]) (*connect.Response[v1.ShutdownHeadlessHostResponse], error) {
 	return c.shutdownHeadlessHost.CallUnary(ctx, req)
@@ -657,6 +924,36 @@ func (c *controllerServiceClient) ListHeadlessHostInstances(ctx context.Context,
 	return c.listHeadlessHostInstances.CallUnary(ctx, req)
 }
 
+// CloneHeadlessHost calls hdlctrl.v1.ControllerService.CloneHeadlessHost.
+func (c *controllerServiceClient) CloneHeadlessHost(ctx context.Context, req *connect.Request[v1.CloneHeadlessHostRequest]) (*connect.Response[v1.CloneHeadlessHostResponse], error) {
+	return c.cloneHeadlessHost.CallUnary(ctx, req)
+}
+
+// ListHostTemplates calls hdlctrl.v1.ControllerService.ListHostTemplates.
+func (c *controllerServiceClient) ListHostTemplates(ctx context.Context, req *connect.Request[v1.ListHostTemplatesRequest]) (*connect.Response[v1.ListHostTemplatesResponse], error) {
+	return c.listHostTemplates.CallUnary(ctx, req)
+}
+
+// GetHostTemplate calls hdlctrl.v1.ControllerService.GetHostTemplate.
+func (c *controllerServiceClient) GetHostTemplate(ctx context.Context, req *connect.Request[v1.GetHostTemplateRequest]) (*connect.Response[v1.GetHostTemplateResponse], error) {
+	return c.getHostTemplate.CallUnary(ctx, req)
+}
+
+// CreateHostTemplate calls hdlctrl.v1.ControllerService.CreateHostTemplate.
+func (c *controllerServiceClient) CreateHostTemplate(ctx context.Context, req *connect.Request[v1.CreateHostTemplateRequest]) (*connect.Response[v1.CreateHostTemplateResponse], error) {
+	return c.createHostTemplate.CallUnary(ctx, req)
+}
+
+// UpdateHostTemplate calls hdlctrl.v1.ControllerService.UpdateHostTemplate.
+func (c *controllerServiceClient) UpdateHostTemplate(ctx context.Context, req *connect.Request[v1.UpdateHostTemplateRequest]) (*connect.Response[v1.UpdateHostTemplateResponse], error) {
+	return c.updateHostTemplate.CallUnary(ctx, req)
+}
+
+// DeleteHostTemplate calls hdlctrl.v1.ControllerService.DeleteHostTemplate.
+func (c *controllerServiceClient) DeleteHostTemplate(ctx context.Context, req *connect.Request[v1.DeleteHostTemplateRequest]) (*connect.Response[v1.DeleteHostTemplateResponse], error) {
+	return c.deleteHostTemplate.CallUnary(ctx, req)
+}
+
 // CreateHeadlessAccount calls hdlctrl.v1.ControllerService.CreateHeadlessAccount.
 func (c *controllerServiceClient) CreateHeadlessAccount(ctx context.Context, req *connect.Request[v1.CreateHeadlessAccountRequest]) (*connect.Response[v1.CreateHeadlessAccountResponse], error) {
 	return c.createHeadlessAccount.CallUnary(ctx, req)
@@ -818,6 +1115,51 @@ func (c *controllerServiceClient) IssueResoniteLinkConnection(ctx context.Contex
 	return c.issueResoniteLinkConnection.CallUnary(ctx, req)
 }
 
+// ListSessionUserEvents calls hdlctrl.v1.ControllerService.ListSessionUserEvents.
+func (c *controllerServiceClient) ListSessionUserEvents(ctx context.Context, req *connect.Request[v1.ListSessionUserEventsRequest]) (*connect.Response[v1.ListSessionUserEventsResponse], error) {
+	return c.listSessionUserEvents.CallUnary(ctx, req)
+}
+
+// GetSessionAttendance calls hdlctrl.v1.ControllerService.GetSessionAttendance.
+func (c *controllerServiceClient) GetSessionAttendance(ctx context.Context, req *connect.Request[v1.GetSessionAttendanceRequest]) (*connect.Response[v1.GetSessionAttendanceResponse], error) {
+	return c.getSessionAttendance.CallUnary(ctx, req)
+}
+
+// GetSessionUsageStats calls hdlctrl.v1.ControllerService.GetSessionUsageStats.
+func (c *controllerServiceClient) GetSessionUsageStats(ctx context.Context, req *connect.Request[v1.GetSessionUsageStatsRequest]) (*connect.Response[v1.GetSessionUsageStatsResponse], error) {
+	return c.getSessionUsageStats.CallUnary(ctx, req)
+}
+
+// GetMetricsSeries calls hdlctrl.v1.ControllerService.GetMetricsSeries.
+func (c *controllerServiceClient) GetMetricsSeries(ctx context.Context, req *connect.Request[v1.GetMetricsSeriesRequest]) (*connect.Response[v1.GetMetricsSeriesResponse], error) {
+	return c.getMetricsSeries.CallUnary(ctx, req)
+}
+
+// ListSessionTemplates calls hdlctrl.v1.ControllerService.ListSessionTemplates.
+func (c *controllerServiceClient) ListSessionTemplates(ctx context.Context, req *connect.Request[v1.ListSessionTemplatesRequest]) (*connect.Response[v1.ListSessionTemplatesResponse], error) {
+	return c.listSessionTemplates.CallUnary(ctx, req)
+}
+
+// GetSessionTemplate calls hdlctrl.v1.ControllerService.GetSessionTemplate.
+func (c *controllerServiceClient) GetSessionTemplate(ctx context.Context, req *connect.Request[v1.GetSessionTemplateRequest]) (*connect.Response[v1.GetSessionTemplateResponse], error) {
+	return c.getSessionTemplate.CallUnary(ctx, req)
+}
+
+// CreateSessionTemplate calls hdlctrl.v1.ControllerService.CreateSessionTemplate.
+func (c *controllerServiceClient) CreateSessionTemplate(ctx context.Context, req *connect.Request[v1.CreateSessionTemplateRequest]) (*connect.Response[v1.CreateSessionTemplateResponse], error) {
+	return c.createSessionTemplate.CallUnary(ctx, req)
+}
+
+// UpdateSessionTemplate calls hdlctrl.v1.ControllerService.UpdateSessionTemplate.
+func (c *controllerServiceClient) UpdateSessionTemplate(ctx context.Context, req *connect.Request[v1.UpdateSessionTemplateRequest]) (*connect.Response[v1.UpdateSessionTemplateResponse], error) {
+	return c.updateSessionTemplate.CallUnary(ctx, req)
+}
+
+// DeleteSessionTemplate calls hdlctrl.v1.ControllerService.DeleteSessionTemplate.
+func (c *controllerServiceClient) DeleteSessionTemplate(ctx context.Context, req *connect.Request[v1.DeleteSessionTemplateRequest]) (*connect.Response[v1.DeleteSessionTemplateResponse], error) {
+	return c.deleteSessionTemplate.CallUnary(ctx, req)
+}
+
 // CreateScheduledSessionOperation calls
 // hdlctrl.v1.ControllerService.CreateScheduledSessionOperation.
 func (c *controllerServiceClient) CreateScheduledSessionOperation(ctx context.Context, req *connect.Request[v1.CreateScheduledSessionOperationRequest]) (*connect.Response[v1.CreateScheduledSessionOperationResponse], error) {
@@ -835,12 +1177,41 @@ func (c *controllerServiceClient) CancelScheduledSessionOperation(ctx context.Co
 	return c.cancelScheduledSessionOperation.CallUnary(ctx, req)
 }
 
+// PauseScheduledSessionOperation calls hdlctrl.v1.ControllerService.PauseScheduledSessionOperation.
+func (c *controllerServiceClient) PauseScheduledSessionOperation(ctx context.Context, req *connect.Request[v1.PauseScheduledSessionOperationRequest]) (*connect.Response[v1.PauseScheduledSessionOperationResponse], error) {
+	return c.pauseScheduledSessionOperation.CallUnary(ctx, req)
+}
+
+// ResumeScheduledSessionOperation calls
+// hdlctrl.v1.ControllerService.ResumeScheduledSessionOperation.
+func (c *controllerServiceClient) ResumeScheduledSessionOperation(ctx context.Context, req *connect.Request[v1.ResumeScheduledSessionOperationRequest]) (*connect.Response[v1.ResumeScheduledSessionOperationResponse], error) {
+	return c.resumeScheduledSessionOperation.CallUnary(ctx, req)
+}
+
+// SkipScheduledSessionOperationOccurrence calls
+// hdlctrl.v1.ControllerService.SkipScheduledSessionOperationOccurrence.
+func (c *controllerServiceClient) SkipScheduledSessionOperationOccurrence(ctx context.Context, req *connect.Request[v1.SkipScheduledSessionOperationOccurrenceRequest]) (*connect.Response[v1.SkipScheduledSessionOperationOccurrenceResponse], error) {
+	return c.skipScheduledSessionOperationOccurrence.CallUnary(ctx, req)
+}
+
+// ListScheduledSessionOperationRuns calls
+// hdlctrl.v1.ControllerService.ListScheduledSessionOperationRuns.
+func (c *controllerServiceClient) ListScheduledSessionOperationRuns(ctx context.Context, req *connect.Request[v1.ListScheduledSessionOperationRunsRequest]) (*connect.Response[v1.ListScheduledSessionOperationRunsResponse], error) {
+	return c.listScheduledSessionOperationRuns.CallUnary(ctx, req)
+}
+
 // ControllerServiceHandler is an implementation of the hdlctrl.v1.ControllerService service.
 type ControllerServiceHandler interface {
 	// ホスト系
 	ListHeadlessHost(context.Context, *connect.Request[v1.ListHeadlessHostRequest]) (*connect.Response[v1.ListHeadlessHostResponse], error)
 	GetHeadlessHost(context.Context, *connect.Request[v1.GetHeadlessHostRequest]) (*connect.Response[v1.GetHeadlessHostResponse], error)
 	GetHeadlessHostLogs(context.Context, *connect.Request[v1.GetHeadlessHostLogsRequest]) (*connect.Response[v1.GetHeadlessHostLogsResponse], error)
+	// ホストのログを直近のバックログから送り、以降は追記され次第 push する
+	TailHeadlessHostLogs(context.Context, *connect.Request[v1.TailHeadlessHostLogsRequest], *connect.ServerStream[v1.TailHeadlessHostLogsResponse]) error
+	// 閲覧できる全ホスト・全インスタンスのログを本文で検索する
+	SearchHeadlessHostLogs(context.Context, *connect.Request[v1.SearchHeadlessHostLogsRequest]) (*connect.Response[v1.SearchHeadlessHostLogsResponse], error)
+	// インスタンスのログ全体 (または期間内) をファイルにして、ダウンロード用の URL を返す
+	PrepareHeadlessHostLogDownload(context.Context, *connect.Request[v1.PrepareHeadlessHostLogDownloadRequest]) (*connect.Response[v1.PrepareHeadlessHostLogDownloadResponse], error)
 	ShutdownHeadlessHost(context.Context, *connect.Request[v1.ShutdownHeadlessHostRequest]) (*connect.Response[v1.ShutdownHeadlessHostResponse], error)
 	KillHeadlessHost(context.Context, *connect.Request[v1.KillHeadlessHostRequest]) (*connect.Response[v1.KillHeadlessHostResponse], error)
 	UpdateHeadlessHostSettings(context.Context, *connect.Request[v1.UpdateHeadlessHostSettingsRequest]) (*connect.Response[v1.UpdateHeadlessHostSettingsResponse], error)
@@ -851,6 +1222,14 @@ type ControllerServiceHandler interface {
 	ListHeadlessHostImageTags(context.Context, *connect.Request[v1.ListHeadlessHostImageTagsRequest]) (*connect.Response[v1.ListHeadlessHostImageTagsResponse], error)
 	DeleteHeadlessHost(context.Context, *connect.Request[v1.DeleteHeadlessHostRequest]) (*connect.Response[v1.DeleteHeadlessHostResponse], error)
 	ListHeadlessHostInstances(context.Context, *connect.Request[v1.ListHeadlessHostInstancesRequest]) (*connect.Response[v1.ListHeadlessHostInstancesResponse], error)
+	// 既存ホストの設定をコピーして新しいホストを起動する
+	CloneHeadlessHost(context.Context, *connect.Request[v1.CloneHeadlessHostRequest]) (*connect.Response[v1.CloneHeadlessHostResponse], error)
+	// ホストテンプレート系
+	ListHostTemplates(context.Context, *connect.Request[v1.ListHostTemplatesRequest]) (*connect.Response[v1.ListHostTemplatesResponse], error)
+	GetHostTemplate(context.Context, *connect.Request[v1.GetHostTemplateRequest]) (*connect.Response[v1.GetHostTemplateResponse], error)
+	CreateHostTemplate(context.Context, *connect.Request[v1.CreateHostTemplateRequest]) (*connect.Response[v1.CreateHostTemplateResponse], error)
+	UpdateHostTemplate(context.Context, *connect.Request[v1.UpdateHostTemplateRequest]) (*connect.Response[v1.UpdateHostTemplateResponse], error)
+	DeleteHostTemplate(context.Context, *connect.Request[v1.DeleteHostTemplateRequest]) (*connect.Response[v1.DeleteHostTemplateResponse], error)
 	// アカウント系
 	CreateHeadlessAccount(context.Context, *connect.Request[v1.CreateHeadlessAccountRequest]) (*connect.Response[v1.CreateHeadlessAccountResponse], error)
 	ListHeadlessAccounts(context.Context, *connect.Request[v1.ListHeadlessAccountsRequest]) (*connect.Response[v1.ListHeadlessAccountsResponse], error)
@@ -887,10 +1266,28 @@ type ControllerServiceHandler interface {
 	KickUser(context.Context, *connect.Request[v1.KickUserRequest]) (*connect.Response[v1.KickUserResponse], error)
 	BanUser(context.Context, *connect.Request[v1.BanUserRequest]) (*connect.Response[v1.BanUserResponse], error)
 	IssueResoniteLinkConnection(context.Context, *connect.Request[v1.IssueResoniteLinkConnectionRequest]) (*connect.Response[v1.IssueResoniteLinkConnectionResponse], error)
+	// セッションへのユーザーの出入りの履歴と、そこから集計した出席・利用状況
+	ListSessionUserEvents(context.Context, *connect.Request[v1.ListSessionUserEventsRequest]) (*connect.Response[v1.ListSessionUserEventsResponse], error)
+	GetSessionAttendance(context.Context, *connect.Request[v1.GetSessionAttendanceRequest]) (*connect.Response[v1.GetSessionAttendanceResponse], error)
+	GetSessionUsageStats(context.Context, *connect.Request[v1.GetSessionUsageStatsRequest]) (*connect.Response[v1.GetSessionUsageStatsResponse], error)
+	// メトリクス系
+	// ホスト / セッションのメトリクスの時系列 (ダッシュボードのグラフ用)
+	GetMetricsSeries(context.Context, *connect.Request[v1.GetMetricsSeriesRequest]) (*connect.Response[v1.GetMetricsSeriesResponse], error)
+	// セッションテンプレート系
+	ListSessionTemplates(context.Context, *connect.Request[v1.ListSessionTemplatesRequest]) (*connect.Response[v1.ListSessionTemplatesResponse], error)
+	GetSessionTemplate(context.Context, *connect.Request[v1.GetSessionTemplateRequest]) (*connect.Response[v1.GetSessionTemplateResponse], error)
+	CreateSessionTemplate(context.Context, *connect.Request[v1.CreateSessionTemplateRequest]) (*connect.Response[v1.CreateSessionTemplateResponse], error)
+	UpdateSessionTemplate(context.Context, *connect.Request[v1.UpdateSessionTemplateRequest]) (*connect.Response[v1.UpdateSessionTemplateResponse], error)
+	DeleteSessionTemplate(context.Context, *connect.Request[v1.DeleteSessionTemplateRequest]) (*connect.Response[v1.DeleteSessionTemplateResponse], error)
 	// 予約操作系
 	CreateScheduledSessionOperation(context.Context, *connect.Request[v1.CreateScheduledSessionOperationRequest]) (*connect.Response[v1.CreateScheduledSessionOperationResponse], error)
 	ListScheduledSessionOperations(context.Context, *connect.Request[v1.ListScheduledSessionOperationsRequest]) (*connect.Response[v1.ListScheduledSessionOperationsResponse], error)
 	CancelScheduledSessionOperation(context.Context, *connect.Request[v1.CancelScheduledSessionOperationRequest]) (*connect.Response[v1.CancelScheduledSessionOperationResponse], error)
+	PauseScheduledSessionOperation(context.Context, *connect.Request[v1.PauseScheduledSessionOperationRequest]) (*connect.Response[v1.PauseScheduledSessionOperationResponse], error)
+	ResumeScheduledSessionOperation(context.Context, *connect.Request[v1.ResumeScheduledSessionOperationRequest]) (*connect.Response[v1.ResumeScheduledSessionOperationResponse], error)
+	// 繰り返し予約の次の 1 回を飛ばす
+	SkipScheduledSessionOperationOccurrence(context.Context, *connect.Request[v1.SkipScheduledSessionOperationOccurrenceRequest]) (*connect.Response[v1.SkipScheduledSessionOperationOccurrenceResponse], error)
+	ListScheduledSessionOperationRuns(context.Context, *connect.Request[v1.ListScheduledSessionOperationRunsRequest]) (*connect.Response[v1.ListScheduledSessionOperationRunsResponse], error)
 }
 
 // NewControllerServiceHandler builds an HTTP handler from the service implementation. It returns
@@ -918,6 +1315,24 @@ func NewControllerServiceHandler(svc ControllerServiceHandler, opts ...connect.H
 		connect.WithSchema(controllerServiceMethods.ByName("GetHeadlessHostLogs")),
 		connect.WithHandlerOptions(opts...),
 	)
+	controllerServiceTailHeadlessHostLogsHandler := connect.NewServerStreamHandler(
+		ControllerServiceTailHeadlessHostLogsProcedure,
+		svc.TailHeadlessHostLogs,
+		connect.WithSchema(controllerServiceMethods.ByName("TailHeadlessHostLogs")),
+		connect.WithHandlerOptions(opts...),
+	)
+	controllerServiceSearchHeadlessHostLogsHandler := connect.NewUnaryHandler(
+		ControllerServiceSearchHeadlessHostLogsProcedure,
+		svc.SearchHeadlessHostLogs,
+		connect.WithSchema(controllerServiceMethods.ByName("SearchHeadlessHostLogs")),
+		connect.WithHandlerOptions(opts...),
+	)
+	controllerServicePrepareHeadlessHostLogDownloadHandler := connect.NewUnaryHandler(
+		ControllerServicePrepareHeadlessHostLogDownloadProcedure,
+		svc.PrepareHeadlessHostLogDownload,
+		connect.WithSchema(controllerServiceMethods.ByName("PrepareHeadlessHostLogDownload")),
+		connect.WithHandlerOptions(opts...),
+	)
 	controllerServiceShutdownHeadlessHostHandler := connect.NewUnaryHandler(
 		ControllerServiceShutdownHeadlessHostProcedure,
 		svc.ShutdownHeadlessHost,
@@ -978,6 +1393,42 @@ func NewControllerServiceHandler(svc ControllerServiceHandler, opts ...connect.H
 		connect.WithSchema(controllerServiceMethods.ByName("ListHeadlessHostInstances")),
 		connect.WithHandlerOptions(opts...),
 	)
+	controllerServiceCloneHeadlessHostHandler := connect.NewUnaryHandler(
+		ControllerServiceCloneHeadlessHostProcedure,
+		svc.CloneHeadlessHost,
+		connect.WithSchema(controllerServiceMethods.ByName("CloneHeadlessHost")),
+		connect.WithHandlerOptions(opts...),
+	)
+	controllerServiceListHostTemplatesHandler := connect.NewUnaryHandler(
+		ControllerServiceListHostTemplatesProcedure,
+		svc.ListHostTemplates,
+		connect.WithSchema(controllerServiceMethods.ByName("ListHostTemplates")),
+		connect.WithHandlerOptions(opts...),
+	)
+	controllerServiceGetHostTemplateHandler := connect.NewUnaryHandler(
+		ControllerServiceGetHostTemplateProcedure,
+		svc.GetHostTemplate,
+		connect.WithSchema(controllerServiceMethods.ByName("GetHostTemplate")),
+		connect.WithHandlerOptions(opts...),
+	)
+	controllerServiceCreateHostTemplateHandler := connect.NewUnaryHandler(
+		ControllerServiceCreateHostTemplateProcedure,
+		svc.CreateHostTemplate,
+		connect.WithSchema(controllerServiceMethods.ByName("CreateHostTemplate")),
+		connect.WithHandlerOptions(opts...),
+	)
+	controllerServiceUpdateHostTemplateHandler := connect.NewUnaryHandler(
+		ControllerServiceUpdateHostTemplateProcedure,
+		svc.UpdateHostTemplate,
+		connect.WithSchema(controllerServiceMethods.ByName("UpdateHostTemplate")),
+		connect.WithHandlerOptions(opts...),
+	)
+	controllerServiceDeleteHostTemplateHandler := connect.NewUnaryHandler(
+		ControllerServiceDeleteHostTemplateProcedure,
+		svc.DeleteHostTemplate,
+		connect.WithSchema(controllerServiceMethods.ByName("DeleteHostTemplate")),
+		connect.WithHandlerOptions(opts...),
+	)
 	controllerServiceCreateHeadlessAccountHandler := connect.NewUnaryHandler(
 		ControllerServiceCreateHeadlessAccountProcedure,
 		svc.CreateHeadlessAccount,
@@ -1170,6 +1621,60 @@ func NewControllerServiceHandler(svc ControllerServiceHandler, opts ...connect.H
 		connect.WithSchema(controllerServiceMethods.ByName("IssueResoniteLinkConnection")),
 		connect.WithHandlerOptions(opts...),
 	)
+	controllerServiceListSessionUserEventsHandler := connect.NewUnaryHandler(
+		ControllerServiceListSessionUserEventsProcedure,
+		svc.ListSessionUserEvents,
+		connect.WithSchema(controllerServiceMethods.ByName("ListSessionUserEvents")),
+		connect.WithHandlerOptions(opts...),
+	)
+	controllerServiceGetSessionAttendanceHandler := connect.NewUnaryHandler(
+		ControllerServiceGetSessionAttendanceProcedure,
+		svc.GetSessionAttendance,
+		connect.WithSchema(controllerServiceMethods.ByName("GetSessionAttendance")),
+		connect.WithHandlerOptions(opts...),
+	)
+	controllerServiceGetSessionUsageStatsHandler := connect.NewUnaryHandler(
+		ControllerServiceGetSessionUsageStatsProcedure,
+		svc.GetSessionUsageStats,
+		connect.WithSchema(controllerServiceMethods.ByName("GetSessionUsageStats")),
+		connect.WithHandlerOptions(opts...),
+	)
+	controllerServiceGetMetricsSeriesHandler := connect.NewUnaryHandler(
+		ControllerServiceGetMetricsSeriesProcedure,
+		svc.GetMetricsSeries,
+		connect.WithSchema(controllerServiceMethods.ByName("GetMetricsSeries")),
+		connect.WithHandlerOptions(opts...),
+	)
+	controllerServiceListSessionTemplatesHandler := connect.NewUnaryHandler(
+		ControllerServiceListSessionTemplatesProcedure,
+		svc.ListSessionTemplates,
+		connect.WithSchema(controllerServiceMethods.ByName("ListSessionTemplates")),
+		connect.WithHandlerOptions(opts...),
+	)
+	controllerServiceGetSessionTemplateHandler := connect.NewUnaryHandler(
+		ControllerServiceGetSessionTemplateProcedure,
+		svc.GetSessionTemplate,
+		connect.WithSchema(controllerServiceMethods.ByName("GetSessionTemplate")),
+		connect.WithHandlerOptions(opts...),
+	)
+	controllerServiceCreateSessionTemplateHandler := connect.NewUnaryHandler(
+		ControllerServiceCreateSessionTemplateProcedure,
+		svc.CreateSessionTemplate,
+		connect.WithSchema(controllerServiceMethods.ByName("CreateSessionTemplate")),
+		connect.WithHandlerOptions(opts...),
+	)
+	controllerServiceUpdateSessionTemplateHandler := connect.NewUnaryHandler(
+		ControllerServiceUpdateSessionTemplateProcedure,
+		svc.UpdateSessionTemplate,
+		connect.WithSchema(controllerServiceMethods.ByName("UpdateSessionTemplate")),
+		connect.WithHandlerOptions(opts...),
+	)
+	controllerServiceDeleteSessionTemplateHandler := connect.NewUnaryHandler(
+		ControllerServiceDeleteSessionTemplateProcedure,
+		svc.DeleteSessionTemplate,
+		connect.WithSchema(controllerServiceMethods.ByName("DeleteSessionTemplate")),
+		connect.WithHandlerOptions(opts...),
+	)
 	controllerServiceCreateScheduledSessionOperationHandler := connect.NewUnaryHandler(
 		ControllerServiceCreateScheduledSessionOperationProcedure,
 		svc.CreateScheduledSessionOperation,
@@ -1188,6 +1693,30 @@ func NewControllerServiceHandler(svc ControllerServiceHandler, opts ...connect.H
 		connect.WithSchema(controllerServiceMethods.ByName("CancelScheduledSessionOperation")),
 		connect.WithHandlerOptions(opts...),
 	)
+	controllerServicePauseScheduledSessionOperationHandler := connect.NewUnaryHandler(
+		ControllerServicePauseScheduledSessionOperationProcedure,
+		svc.PauseScheduledSessionOperation,
+		connect.WithSchema(controllerServiceMethods.ByName("PauseScheduledSessionOperation")),
+		connect.WithHandlerOptions(opts...),
+	)
+	controllerServiceResumeScheduledSessionOperationHandler := connect.NewUnaryHandler(
+		ControllerServiceResumeScheduledSessionOperationProcedure,
+		svc.ResumeScheduledSessionOperation,
+		connect.WithSchema(controllerServiceMethods.ByName("ResumeScheduledSessionOperation")),
+		connect.WithHandlerOptions(opts...),
+	)
+	controllerServiceSkipScheduledSessionOperationOccurrenceHandler := connect.NewUnaryHandler(
+		ControllerServiceSkipScheduledSessionOperationOccurrenceProcedure,
+		svc.SkipScheduledSessionOperationOccurrence,
+		connect.WithSchema(controllerServiceMethods.ByName("SkipScheduledSessionOperationOccurrence")),
+		connect.WithHandlerOptions(opts...),
+	)
+	controllerServiceListScheduledSessionOperationRunsHandler := connect.NewUnaryHandler(
+		ControllerServiceListScheduledSessionOperationRunsProcedure,
+		svc.ListScheduledSessionOperationRuns,
+		connect.WithSchema(controllerServiceMethods.ByName("ListScheduledSessionOperationRuns")),
+		connect.WithHandlerOptions(opts...),
+	)
 	return "/hdlctrl.v1.ControllerService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		switch r.URL.Path {
 		case ControllerServiceListHeadlessHostProcedure:
@@ -1196,6 +1725,12 @@ func NewControllerServiceHandler(svc ControllerServiceHandler, opts ...connect.H
 			controllerServiceGetHeadlessHostHandler.ServeHTTP(w, r)
 		case ControllerServiceGetHeadlessHostLogsProcedure:
 			controllerServiceGetHeadlessHostLogsHandler.ServeHTTP(w, r)
+		case ControllerServiceTailHeadlessHostLogsProcedure:
+			controllerServiceTailHeadlessHostLogsHandler.ServeHTTP(w, r)
+		case ControllerServiceSearchHeadlessHostLogsProcedure:
+			controllerServiceSearchHeadlessHostLogsHandler.ServeHTTP(w, r)
+		case ControllerServicePrepareHeadlessHostLogDownloadProcedure:
+			controllerServicePrepareHeadlessHostLogDownloadHandler.ServeHTTP(w, r)
 		case ControllerServiceShutdownHeadlessHostProcedure:
 			controllerServiceShutdownHeadlessHostHandler.ServeHTTP(w, r)
 		case ControllerServiceKillHeadlessHostProcedure:
@@ -1216,6 +1751,18 @@ func NewControllerServiceHandler(svc ControllerServiceHandler, opts ...connect.H
 			controllerServiceDeleteHeadlessHostHandler.ServeHTTP(w, r)
 		case ControllerServiceListHeadlessHostInstancesProcedure:
 			controllerServiceListHeadlessHostInstancesHandler.ServeHTTP(w, r)
+		case ControllerServiceCloneHeadlessHostProcedure:
+			controllerServiceCloneHeadlessHostHandler.ServeHTTP(w, r)
+		case ControllerServiceListHostTemplatesProcedure:
+			controllerServiceListHostTemplatesHandler.ServeHTTP(w, r)
+		case ControllerServiceGetHostTemplateProcedure:
+			controllerServiceGetHostTemplateHandler.ServeHTTP(w, r)
+		case ControllerServiceCreateHostTemplateProcedure:
+			controllerServiceCreateHostTemplateHandler.ServeHTTP(w, r)
+		case ControllerServiceUpdateHostTemplateProcedure:
+			controllerServiceUpdateHostTemplateHandler.ServeHTTP(w, r)
+		case ControllerServiceDeleteHostTemplateProcedure:
+			controllerServiceDeleteHostTemplateHandler.ServeHTTP(w, r)
 		case ControllerServiceCreateHeadlessAccountProcedure:
 			controllerServiceCreateHeadlessAccountHandler.ServeHTTP(w, r)
 		case ControllerServiceListHeadlessAccountsProcedure:
@@ -1280,12 +1827,38 @@ func NewControllerServiceHandler(svc ControllerServiceHandler, opts ...connect.H
 			controllerServiceBanUserHandler.ServeHTTP(w, r)
 		case ControllerServiceIssueResoniteLinkConnectionProcedure:
 			controllerServiceIssueResoniteLinkConnectionHandler.ServeHTTP(w, r)
+		case ControllerServiceListSessionUserEventsProcedure:
+			controllerServiceListSessionUserEventsHandler.ServeHTTP(w, r)
+		case ControllerServiceGetSessionAttendanceProcedure:
+			controllerServiceGetSessionAttendanceHandler.ServeHTTP(w, r)
+		case ControllerServiceGetSessionUsageStatsProcedure:
+			controllerServiceGetSessionUsageStatsHandler.ServeHTTP(w, r)
+		case ControllerServiceGetMetricsSeriesProcedure:
+			controllerServiceGetMetricsSeriesHandler.ServeHTTP(w, r)
+		case ControllerServiceListSessionTemplatesProcedure:
+			controllerServiceListSessionTemplatesHandler.ServeHTTP(w, r)
+		case ControllerServiceGetSessionTemplateProcedure:
+			controllerServiceGetSessionTemplateHandler.ServeHTTP(w, r)
+		case ControllerServiceCreateSessionTemplateProcedure:
+			controllerServiceCreateSessionTemplateHandler.ServeHTTP(w, r)
+		case ControllerServiceUpdateSessionTemplateProcedure:
+			controllerServiceUpdateSessionTemplateHandler.ServeHTTP(w, r)
+		case ControllerServiceDeleteSessionTemplateProcedure:
+			controllerServiceDeleteSessionTemplateHandler.ServeHTTP(w, r)
 		case ControllerServiceCreateScheduledSessionOperationProcedure:
 			controllerServiceCreateScheduledSessionOperationHandler.ServeHTTP(w, r)
 		case ControllerServiceListScheduledSessionOperationsProcedure:
 			controllerServiceListScheduledSessionOperationsHandler.ServeHTTP(w, r)
 		case ControllerServiceCancelScheduledSessionOperationProcedure:
 			controllerServiceCancelScheduledSessionOperationHandler.ServeHTTP(w, r)
+		case ControllerServicePauseScheduledSessionOperationProcedure:
+			controllerServicePauseScheduledSessionOperationHandler.ServeHTTP(w, r)
+		case ControllerServiceResumeScheduledSessionOperationProcedure:
+			controllerServiceResumeScheduledSessionOperationHandler.ServeHTTP(w, r)
+		case ControllerServiceSkipScheduledSessionOperationOccurrenceProcedure:
+			controllerServiceSkipScheduledSessionOperationOccurrenceHandler.ServeHTTP(w, r)
+		case ControllerServiceListScheduledSessionOperationRunsProcedure:
+			controllerServiceListScheduledSessionOperationRunsHandler.ServeHTTP(w, r)
 		default:
 			http.NotFound(w, r)
 		}
@@ -1307,6 +1880,18 @@ func (UnimplementedControllerServiceHandler) GetHeadlessHostLogs(context.Context
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("hdlctrl.v1.ControllerService.GetHeadlessHostLogs is not implemented"))
 }
 
+func (UnimplementedControllerServiceHandler) TailHeadlessHostLogs(context.Context, *connect.Request[v1.TailHeadlessHostLogsRequest], *connect.ServerStream[v1.TailHeadlessHostLogsResponse]) error {
+	return connect.NewError(connect.CodeUnimplemented, errors.New("hdlctrl.v1.ControllerService.TailHeadlessHostLogs is not implemented"))
+}
+
+func (UnimplementedControllerServiceHandler) SearchHeadlessHostLogs(context.Context, *connect.Request[v1.SearchHeadlessHostLogsRequest]) (*connect.Response[v1.SearchHeadlessHostLogsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("hdlctrl.v1.ControllerService.SearchHeadlessHostLogs is not implemented"))
+}
+
+func (UnimplementedControllerServiceHandler) PrepareHeadlessHostLogDownload(context.Context, *connect.Request[v1.PrepareHeadlessHostLogDownloadRequest]) (*connect.Response[v1.PrepareHeadlessHostLogDownloadResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("hdlctrl.v1.ControllerService.PrepareHeadlessHostLogDownload is not implemented"))
+}
+
 func (UnimplementedControllerServiceHandler) ShutdownHeadlessHost(context.Context, *connect.Request[v1.ShutdownHeadlessHostRequest]) (*connect.Response[v1.ShutdownHeadlessHostResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("hdlctrl.v1.ControllerService.ShutdownHeadlessHost is not implemented"))
 }
@@ -1347,6 +1932,30 @@ func (UnimplementedControllerServiceHandler) ListHeadlessHostInstances(context.C
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("hdlctrl.v1.ControllerService.ListHeadlessHostInstances is not implemented"))
 }
 
+func (UnimplementedControllerServiceHandler) CloneHeadlessHost(context.Context, *connect.Request[v1.CloneHeadlessHostRequest]) (*connect.Response[v1.CloneHeadlessHostResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("hdlctrl.v1.ControllerService.CloneHeadlessHost is not implemented"))
+}
+
+func (UnimplementedControllerServiceHandler) ListHostTemplates(context.Context, *connect.Request[v1.ListHostTemplatesRequest]) (*connect.Response[v1.ListHostTemplatesResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("hdlctrl.v1.ControllerService.ListHostTemplates is not implemented"))
+}
+
+func (UnimplementedControllerServiceHandler) GetHostTemplate(context.Context, *connect.Request[v1.GetHostTemplateRequest]) (*connect.Response[v1.GetHostTemplateResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("hdlctrl.v1.ControllerService.GetHostTemplate is not implemented"))
+}
+
+func (UnimplementedControllerServiceHandler) CreateHostTemplate(context.Context, *connect.Request[v1.CreateHostTemplateRequest]) (*connect.Response[v1.CreateHostTemplateResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("hdlctrl.v1.ControllerService.CreateHostTemplate is not implemented"))
+}
+
+func (UnimplementedControllerServiceHandler) UpdateHostTemplate(context.Context, *connect.Request[v1.UpdateHostTemplateRequest]) (*connect.Response[v1.UpdateHostTemplateResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("hdlctrl.v1.ControllerService.UpdateHostTemplate is not implemented"))
+}
+
+func (UnimplementedControllerServiceHandler) DeleteHostTemplate(context.Context, *connect.Request[v1.DeleteHostTemplateRequest]) (*connect.Response[v1.DeleteHostTemplateResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("hdlctrl.v1.ControllerService.DeleteHostTemplate is not implemented"))
+}
+
 func (UnimplementedControllerServiceHandler) CreateHeadlessAccount(context.Context, *connect.Request[v1.CreateHeadlessAccountRequest]) (*connect.Response[v1.CreateHeadlessAccountResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("hdlctrl.v1.ControllerService.CreateHeadlessAccount is not implemented"))
 }
@@ -1475,6 +2084,42 @@ func (UnimplementedControllerServiceHandler) IssueResoniteLinkConnection(context
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("hdlctrl.v1.ControllerService.IssueResoniteLinkConnection is not implemented"))
 }
 
+func (UnimplementedControllerServiceHandler) ListSessionUserEvents(context.Context, *connect.Request[v1.ListSessionUserEventsRequest]) (*connect.Response[v1.ListSessionUserEventsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("hdlctrl.v1.ControllerService.ListSessionUserEvents is not implemented"))
+}
+
+func (UnimplementedControllerServiceHandler) GetSessionAttendance(context.Context, *connect.Request[v1.GetSessionAttendanceRequest]) (*connect.Response[v1.GetSessionAttendanceResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("hdlctrl.v1.ControllerService.GetSessionAttendance is not implemented"))
+}
+
+func (UnimplementedControllerServiceHandler) GetSessionUsageStats(context.Context, *connect.Request[v1.GetSessionUsageStatsRequest]) (*connect.Response[v1.GetSessionUsageStatsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("hdlctrl.v1.ControllerService.GetSessionUsageStats is not implemented"))
+}
+
+func (UnimplementedControllerServiceHandler) GetMetricsSeries(context.Context, *connect.Request[v1.GetMetricsSeriesRequest]) (*connect.Response[v1.GetMetricsSeriesResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("hdlctrl.v1.ControllerService.GetMetricsSeries is not implemented"))
+}
+
+func (UnimplementedControllerServiceHandler) ListSessionTemplates(context.Context, *connect.Request[v1.ListSessionTemplatesRequest]) (*connect.Response[v1.ListSessionTemplatesResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("hdlctrl.v1.ControllerService.ListSessionTemplates is not implemented"))
+}
+
+func (UnimplementedControllerServiceHandler) GetSessionTemplate(context.Context, *connect.Request[v1.GetSessionTemplateRequest]) (*connect.Response[v1.GetSessionTemplateResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("hdlctrl.v1.ControllerService.GetSessionTemplate is not implemented"))
+}
+
+func (UnimplementedControllerServiceHandler) CreateSessionTemplate(context.Context, *connect.Request[v1.CreateSessionTemplateRequest]) (*connect.Response[v1.CreateSessionTemplateResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("hdlctrl.v1.ControllerService.CreateSessionTemplate is not implemented"))
+}
+
+func (UnimplementedControllerServiceHandler) UpdateSessionTemplate(context.Context, *connect.Request[v1.UpdateSessionTemplateRequest]) (*connect.Response[v1.UpdateSessionTemplateResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("hdlctrl.v1.ControllerService.UpdateSessionTemplate is not implemented"))
+}
+
+func (UnimplementedControllerServiceHandler) DeleteSessionTemplate(context.Context, *connect.Request[v1.DeleteSessionTemplateRequest]) (*connect.Response[v1.DeleteSessionTemplateResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("hdlctrl.v1.ControllerService.DeleteSessionTemplate is not implemented"))
+}
+
 func (UnimplementedControllerServiceHandler) CreateScheduledSessionOperation(context.Context, *connect.Request[v1.CreateScheduledSessionOperationRequest]) (*connect.Response[v1.CreateScheduledSessionOperationResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("hdlctrl.v1.ControllerService.CreateScheduledSessionOperation is not implemented"))
 }
@@ -1486,3 +2131,19 @@ func (UnimplementedControllerServiceHandler) ListScheduledSessionOperations(cont
 func (UnimplementedControllerServiceHandler) CancelScheduledSessionOperation(context.Context, *connect.Request[v1.CancelScheduledSessionOperationRequest]) (*connect.Response[v1.CancelScheduledSessionOperationResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("hdlctrl.v1.ControllerService.CancelScheduledSessionOperation is not implemented"))
 }
+
+func (UnimplementedControllerServiceHandler) PauseScheduledSessionOperation(context.Context, *connect.Request[v1.PauseScheduledSessionOperationRequest]) (*connect.Response[v1.PauseScheduledSessionOperationResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("hdlctrl.v1.ControllerService.PauseScheduledSessionOperation is not implemented"))
+}
+
+func (UnimplementedControllerServiceHandler) ResumeScheduledSessionOperation(context.Context, *connect.Request[v1.ResumeScheduledSessionOperationRequest]) (*connect.Response[v1.ResumeScheduledSessionOperationResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("hdlctrl.v1.ControllerService.ResumeScheduledSessionOperation is not implemented"))
+}
+
+func (UnimplementedControllerServiceHandler) SkipScheduledSessionOperationOccurrence(context.Context, *connect.Request[v1.SkipScheduledSessionOperationOccurrenceRequest]) (*connect.Response[v1.SkipScheduledSessionOperationOccurrenceResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("hdlctrl.v1.ControllerService.SkipScheduledSessionOperationOccurrence is not implemented"))
+}
+
+func (UnimplementedControllerServiceHandler) ListScheduledSessionOperationRuns(context.Context, *connect.Request[v1.ListScheduledSessionOperationRunsRequest]) (*connect.Response[v1.ListScheduledSessionOperationRunsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("hdlctrl.v1.ControllerService.ListScheduledSessionOperationRuns is not implemented"))
+}
@@ -36,11 +36,20 @@ const (
 	// NotificationServiceSubscribeNotificationsProcedure is the fully-qualified name of the
 	// NotificationService's SubscribeNotifications RPC.
 	NotificationServiceSubscribeNotificationsProcedure = "/hdlctrl.v1.NotificationService/SubscribeNotifications"
+	// NotificationServiceListNotificationsProcedure is the fully-qualified name of the
+	// NotificationService's ListNotifications RPC.
+	NotificationServiceListNotificationsProcedure = "/hdlctrl.v1.NotificationService/ListNotifications"
+	// NotificationServiceMarkNotificationsReadProcedure is the fully-qualified name of the
+	// NotificationService's MarkNotificationsRead RPC.
+	NotificationServiceMarkNotificationsReadProcedure = "/hdlctrl.v1.NotificationService/MarkNotificationsRead"
 )
 
 // NotificationServiceClient is a client for the hdlctrl.v1.NotificationService service.
 type NotificationServiceClient interface {
 	SubscribeNotifications(context.Context, *connect.Request[v1.SubscribeNotificationsRequest]) (*connect.ServerStreamForClient[v1.NotificationEvent], error)
+	// 通知 inbox. セッションの開始/終了・自動再起動・job 完了などを新しい順に返す.
+	ListNotifications(context.Context, *connect.Request[v1.ListNotificationsRequest]) (*connect.Response[v1.ListNotificationsResponse], error)
+	MarkNotificationsRead(context.Context, *connect.Request[v1.MarkNotificationsReadRequest]) (*connect.Response[v1.MarkNotificationsReadResponse], error)
 }
 
 // NewNotificationServiceClient constructs a client for the hdlctrl.v1.NotificationService service.
@@ -60,12 +69,26 @@ func NewNotificationServiceClient(httpClient connect.HTTPClient, baseURL string,
 			connect.WithSchema(notificationServiceMethods.ByName("SubscribeNotifications")),
 			connect.WithClientOptions(opts...),
 		),
+		listNotifications: connect.NewClient[v1.ListNotificationsRequest, v1.ListNotificationsResponse](
+			httpClient,
+			baseURL+NotificationServiceListNotificationsProcedure,
+			connect.WithSchema(notificationServiceMethods.ByName("ListNotifications")),
+			connect.WithClientOptions(opts...),
+		),
+		markNotificationsRead: connect.NewClient[v1.MarkNotificationsReadRequest, v1.MarkNotificationsReadResponse](
+			httpClient,
+			baseURL+NotificationServiceMarkNotificationsReadProcedure,
+			connect.WithSchema(notificationServiceMethods.ByName("MarkNotificationsRead")),
+			connect.WithClientOptions(opts...),
+		),
 	}
 }
 
 // notificationServiceClient implements NotificationServiceClient.
 type notificationServiceClient struct {
 	subscribeNotifications *connect.Client[v1.SubscribeNotificationsRequest, v1.NotificationEvent]
+	listNotifications      *connect.Client[v1.ListNotificationsRequest, v1.ListNotificationsResponse]
+	markNotificationsRead  *connect.Client[v1.MarkNotificationsReadRequest, v1.MarkNotificationsReadResponse]
 }
 
 // SubscribeNotifications calls hdlctrl.v1.NotificationService.SubscribeNotifications.
@@ -73,9 +96,22 @@ func (c *notificationServiceClient) SubscribeNotifications(ctx context.Context,
 	return c.subscribeNotifications.CallServerStream(ctx, req)
 }
 
+// ListNotifications calls hdlctrl.v1.NotificationService.ListNotifications.
+func (c *notificationServiceClient) ListNotifications(ctx context.Context, req *connect.Request[v1.ListNotificationsRequest]) (*connect.Response[v1.ListNotificationsResponse], error) {
+	return c.listNotifications.CallUnary(ctx, req)
+}
+
+// MarkNotificationsRead calls hdlctrl.v1.NotificationService.MarkNotificationsRead.
+func (c *notificationServiceClient) MarkNotificationsRead(ctx context.Context, req *connect.Request[v1.MarkNotificationsReadRequest]) (*connect.Response[v1.MarkNotificationsReadResponse], error) {
+	return c.markNotificationsRead.CallUnary(ctx, req)
+}
+
 // NotificationServiceHandler is an implementation of the hdlctrl.v1.NotificationService service.
 type NotificationServiceHandler interface {
 	SubscribeNotifications(context.Context, *connect.Request[v1.SubscribeNotificationsRequest], *connect.ServerStream[v1.NotificationEvent]) error
+	// 通知 inbox. セッションの開始/終了・自動再起動・job 完了などを新しい順に返す.
+	ListNotifications(context.Context, *connect.Request[v1.ListNotificationsRequest]) (*connect.Response[v1.ListNotificationsResponse], error)
+	MarkNotificationsRead(context.Context, *connect.Request[v1.MarkNotificationsReadRequest]) (*connect.Response[v1.MarkNotificationsReadResponse], error)
 }
 
 // NewNotificationServiceHandler builds an HTTP handler from the service implementation. It returns
@@ -91,10 +127,26 @@ func NewNotificationServiceHandler(svc NotificationServiceHandler, opts ...conne
 		connect.WithSchema(notificationServiceMethods.ByName("SubscribeNotifications")),
 		connect.WithHandlerOptions(opts...),
 	)
+	notificationServiceListNotificationsHandler := connect.NewUnaryHandler(
+		NotificationServiceListNotificationsProcedure,
+		svc.ListNotifications,
+		connect.WithSchema(notificationServiceMethods.ByName("ListNotifications")),
+		connect.WithHandlerOptions(opts...),
+	)
+	notificationServiceMarkNotificationsReadHandler := connect.NewUnaryHandler(
+		NotificationServiceMarkNotificationsReadProcedure,
+		svc.MarkNotificationsRead,
+		connect.WithSchema(notificationServiceMethods.ByName("MarkNotificationsRead")),
+		connect.WithHandlerOptions(opts...),
+	)
 	return "/hdlctrl.v1.NotificationService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		switch r.URL.Path {
 		case NotificationServiceSubscribeNotificationsProcedure:
 			notificationServiceSubscribeNotificationsHandler.ServeHTTP(w, r)
+		case NotificationServiceListNotificationsProcedure:
+			notificationServiceListNotificationsHandler.ServeHTTP(w, r)
+		case NotificationServiceMarkNotificationsReadProcedure:
+			notificationServiceMarkNotificationsReadHandler.ServeHTTP(w, r)
 		default:
 			http.NotFound(w, r)
 		}
@@ -107,3 +159,11 @@ type UnimplementedNotificationServiceHandler struct{}
 func (UnimplementedNotificationServiceHandler) SubscribeNotifications(context.Context, *connect.Request[v1.SubscribeNotificationsRequest], *connect.ServerStream[v1.NotificationEvent]) error {
 	return connect.NewError(connect.CodeUnimplemented, errors.New("hdlctrl.v1.NotificationService.SubscribeNotifications is not implemented"))
 }
+
+func (UnimplementedNotificationServiceHandler) ListNotifications(context.Context, *connect.Request[v1.ListNotificationsRequest]) (*connect.Response[v1.ListNotificationsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("hdlctrl.v1.NotificationService.ListNotifications is not implemented"))
+}
+
+func (UnimplementedNotificationServiceHandler) MarkNotificationsRead(context.Context, *connect.Request[v1.MarkNotificationsReadRequest]) (*connect.Response[v1.MarkNotificationsReadResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("hdlctrl.v1.NotificationService.MarkNotificationsRead is not implemented"))
+}
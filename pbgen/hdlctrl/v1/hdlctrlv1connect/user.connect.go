@@ -42,6 +42,18 @@ const (
 	// UserServiceRegisterWithTokenProcedure is the fully-qualified name of the UserService's
 	// RegisterWithToken RPC.
 	UserServiceRegisterWithTokenProcedure = "/hdlctrl.v1.UserService/RegisterWithToken"
+	// UserServiceGetLoginOptionsProcedure is the fully-qualified name of the UserService's
+	// GetLoginOptions RPC.
+	UserServiceGetLoginOptionsProcedure = "/hdlctrl.v1.UserService/GetLoginOptions"
+	// UserServiceBeginOidcLoginProcedure is the fully-qualified name of the UserService's
+	// BeginOidcLogin RPC.
+	UserServiceBeginOidcLoginProcedure = "/hdlctrl.v1.UserService/BeginOidcLogin"
+	// UserServiceCompleteOidcLoginProcedure is the fully-qualified name of the UserService's
+	// CompleteOidcLogin RPC.
+	UserServiceCompleteOidcLoginProcedure = "/hdlctrl.v1.UserService/CompleteOidcLogin"
+	// UserServiceVerifyTotpLoginProcedure is the fully-qualified name of the UserService's
+	// VerifyTotpLogin RPC.
+	UserServiceVerifyTotpLoginProcedure = "/hdlctrl.v1.UserService/VerifyTotpLogin"
 	// UserServiceRefreshTokenProcedure is the fully-qualified name of the UserService's RefreshToken
 	// RPC.
 	UserServiceRefreshTokenProcedure = "/hdlctrl.v1.UserService/RefreshToken"
@@ -57,6 +69,38 @@ const (
 	UserServiceCreateRegistrationTokenProcedure = "/hdlctrl.v1.UserService/CreateRegistrationToken"
 	// UserServiceDeleteUserProcedure is the fully-qualified name of the UserService's DeleteUser RPC.
 	UserServiceDeleteUserProcedure = "/hdlctrl.v1.UserService/DeleteUser"
+	// UserServiceCreateApiTokenProcedure is the fully-qualified name of the UserService's
+	// CreateApiToken RPC.
+	UserServiceCreateApiTokenProcedure = "/hdlctrl.v1.UserService/CreateApiToken"
+	// UserServiceListApiTokensProcedure is the fully-qualified name of the UserService's ListApiTokens
+	// RPC.
+	UserServiceListApiTokensProcedure = "/hdlctrl.v1.UserService/ListApiTokens"
+	// UserServiceRevokeApiTokenProcedure is the fully-qualified name of the UserService's
+	// RevokeApiToken RPC.
+	UserServiceRevokeApiTokenProcedure = "/hdlctrl.v1.UserService/RevokeApiToken"
+	// UserServiceGetTotpStatusProcedure is the fully-qualified name of the UserService's GetTotpStatus
+	// RPC.
+	UserServiceGetTotpStatusProcedure = "/hdlctrl.v1.UserService/GetTotpStatus"
+	// UserServiceBeginTotpEnrollmentProcedure is the fully-qualified name of the UserService's
+	// BeginTotpEnrollment RPC.
+	UserServiceBeginTotpEnrollmentProcedure = "/hdlctrl.v1.UserService/BeginTotpEnrollment"
+	// UserServiceConfirmTotpEnrollmentProcedure is the fully-qualified name of the UserService's
+	// ConfirmTotpEnrollment RPC.
+	UserServiceConfirmTotpEnrollmentProcedure = "/hdlctrl.v1.UserService/ConfirmTotpEnrollment"
+	// UserServiceDisableTotpProcedure is the fully-qualified name of the UserService's DisableTotp RPC.
+	UserServiceDisableTotpProcedure = "/hdlctrl.v1.UserService/DisableTotp"
+	// UserServiceListMySessionsProcedure is the fully-qualified name of the UserService's
+	// ListMySessions RPC.
+	UserServiceListMySessionsProcedure = "/hdlctrl.v1.UserService/ListMySessions"
+	// UserServiceRevokeSessionProcedure is the fully-qualified name of the UserService's RevokeSession
+	// RPC.
+	UserServiceRevokeSessionProcedure = "/hdlctrl.v1.UserService/RevokeSession"
+	// UserServiceBeginOidcLinkProcedure is the fully-qualified name of the UserService's BeginOidcLink
+	// RPC.
+	UserServiceBeginOidcLinkProcedure = "/hdlctrl.v1.UserService/BeginOidcLink"
+	// UserServiceCompleteOidcLinkProcedure is the fully-qualified name of the UserService's
+	// CompleteOidcLink RPC.
+	UserServiceCompleteOidcLinkProcedure = "/hdlctrl.v1.UserService/CompleteOidcLink"
 )
 
 // UserServiceClient is a client for the hdlctrl.v1.UserService service.
@@ -65,6 +109,15 @@ type UserServiceClient interface {
 	GetTokenByPassword(context.Context, *connect.Request[v1.GetTokenByPasswordRequest]) (*connect.Response[v1.TokenSetResponse], error)
 	ValidateRegistrationToken(context.Context, *connect.Request[v1.ValidateRegistrationTokenRequest]) (*connect.Response[v1.ValidateRegistrationTokenResponse], error)
 	RegisterWithToken(context.Context, *connect.Request[v1.RegisterWithTokenRequest]) (*connect.Response[v1.TokenSetResponse], error)
+	// サインイン画面に出す選択肢 (SSO の有無)
+	GetLoginOptions(context.Context, *connect.Request[v1.GetLoginOptionsRequest]) (*connect.Response[v1.GetLoginOptionsResponse], error)
+	// OIDC (認可コード + PKCE) でのサインイン. Begin で得た URL に遷移し、
+	// IdP から戻ってきた code / state で Complete する
+	BeginOidcLogin(context.Context, *connect.Request[v1.BeginOidcLoginRequest]) (*connect.Response[v1.BeginOidcLoginResponse], error)
+	CompleteOidcLogin(context.Context, *connect.Request[v1.CompleteOidcLoginRequest]) (*connect.Response[v1.TokenSetResponse], error)
+	// GetTokenByPassword が totp_challenge を返したときに、認証アプリのコードか
+	// リカバリーコードを送ってサインインを完了する
+	VerifyTotpLogin(context.Context, *connect.Request[v1.VerifyTotpLoginRequest]) (*connect.Response[v1.TokenSetResponse], error)
 	// 認証付きRPC
 	RefreshToken(context.Context, *connect.Request[v1.RefreshTokenRequest]) (*connect.Response[v1.TokenSetResponse], error)
 	ChangePassword(context.Context, *connect.Request[v1.ChangePasswordRequest]) (*connect.Response[v1.ChangePasswordResponse], error)
@@ -79,6 +132,33 @@ type UserServiceClient interface {
 	CreateRegistrationToken(context.Context, *connect.Request[v1.CreateRegistrationTokenRequest]) (*connect.Response[v1.CreateRegistrationTokenResponse], error)
 	// 指定 user_id のユーザーを削除する. 自分自身は削除できない.
 	DeleteUser(context.Context, *connect.Request[v1.DeleteUserRequest]) (*connect.Response[v1.DeleteUserResponse], error)
+	// API トークン (スクリプト / bot 向けの長期トークン). 自分のトークンのみ操作できる.
+	// 発行したトークンは Bearer ヘッダに付けて JWT の代わりに使える.
+	// API トークンで認証されたリクエストからは発行できない.
+	CreateApiToken(context.Context, *connect.Request[v1.CreateApiTokenRequest]) (*connect.Response[v1.CreateApiTokenResponse], error)
+	// 失効済み / 期限切れも含めて新しい順に返す.
+	ListApiTokens(context.Context, *connect.Request[v1.ListApiTokensRequest]) (*connect.Response[v1.ListApiTokensResponse], error)
+	RevokeApiToken(context.Context, *connect.Request[v1.RevokeApiTokenRequest]) (*connect.Response[v1.RevokeApiTokenResponse], error)
+	// 2 段階認証 (TOTP). 自分の設定のみ操作できる. API トークンからは操作できない.
+	GetTotpStatus(context.Context, *connect.Request[v1.GetTotpStatusRequest]) (*connect.Response[v1.GetTotpStatusResponse], error)
+	// 新しい secret を発行する. Confirm で最初のコードを確認するまでは有効にならない.
+	// 登録が必須でまだサインインできないユーザーは、access token の代わりに
+	// GetTokenByPassword で得た challenge を渡す.
+	BeginTotpEnrollment(context.Context, *connect.Request[v1.BeginTotpEnrollmentRequest]) (*connect.Response[v1.BeginTotpEnrollmentResponse], error)
+	ConfirmTotpEnrollment(context.Context, *connect.Request[v1.ConfirmTotpEnrollmentRequest]) (*connect.Response[v1.ConfirmTotpEnrollmentResponse], error)
+	// 必須のユーザーは無効にできない.
+	DisableTotp(context.Context, *connect.Request[v1.DisableTotpRequest]) (*connect.Response[v1.DisableTotpResponse], error)
+	// ログインセッション (サインインした端末). 自分のセッションのみ操作できる. API トークンからは操作できない.
+	// 有効なセッションを最後に使われた順に返す.
+	ListMySessions(context.Context, *connect.Request[v1.ListMySessionsRequest]) (*connect.Response[v1.ListMySessionsResponse], error)
+	// 失効させたセッションの access token / refresh token はすぐに使えなくなる.
+	// 今のセッションを指定するとサインアウトになる.
+	RevokeSession(context.Context, *connect.Request[v1.RevokeSessionRequest]) (*connect.Response[v1.RevokeSessionResponse], error)
+	// ログイン中のユーザーに IdP のアカウントを紐付ける. 以降はそのアカウントの SSO でサインインできる.
+	// Begin で得た URL に遷移し、IdP から戻ってきた code / state で Complete する.
+	// state は Begin を呼んだユーザーでしか Complete できない. API トークンからは操作できない.
+	BeginOidcLink(context.Context, *connect.Request[v1.BeginOidcLinkRequest]) (*connect.Response[v1.BeginOidcLoginResponse], error)
+	CompleteOidcLink(context.Context, *connect.Request[v1.CompleteOidcLinkRequest]) (*connect.Response[v1.CompleteOidcLinkResponse], error)
 }
 
 // NewUserServiceClient constructs a client for the hdlctrl.v1.UserService service. By default, it
@@ -110,6 +190,30 @@ func NewUserServiceClient(httpClient connect.HTTPClient, baseURL string, opts ..
 			connect.WithSchema(userServiceMethods.ByName("RegisterWithToken")),
 			connect.WithClientOptions(opts...),
 		),
+		getLoginOptions: connect.NewClient[v1.GetLoginOptionsRequest, v1.GetLoginOptionsResponse](
+			httpClient,
+			baseURL+UserServiceGetLoginOptionsProcedure,
+			connect.WithSchema(userServiceMethods.ByName("GetLoginOptions")),
+			connect.WithClientOptions(opts...),
+		),
+		beginOidcLogin: connect.NewClient[v1.BeginOidcLoginRequest, v1.BeginOidcLoginResponse](
+			httpClient,
+			baseURL+UserServiceBeginOidcLoginProcedure,
+			connect.WithSchema(userServiceMethods.ByName("BeginOidcLogin")),
+			connect.WithClientOptions(opts...),
+		),
+		completeOidcLogin: connect.NewClient[v1.CompleteOidcLoginRequest, v1.TokenSetResponse](
+			httpClient,
+			baseURL+UserServiceCompleteOidcLoginProcedure,
+			connect.WithSchema(userServiceMethods.ByName("CompleteOidcLogin")),
+			connect.WithClientOptions(opts...),
+		),
+		verifyTotpLogin: connect.NewClient[v1.VerifyTotpLoginRequest, v1.TokenSetResponse](
+			httpClient,
+			baseURL+UserServiceVerifyTotpLoginProcedure,
+			connect.WithSchema(userServiceMethods.ByName("VerifyTotpLogin")),
+			connect.WithClientOptions(opts...),
+		),
 		refreshToken: connect.NewClient[v1.RefreshTokenRequest, v1.TokenSetResponse](
 			httpClient,
 			baseURL+UserServiceRefreshTokenProcedure,
@@ -146,6 +250,72 @@ func NewUserServiceClient(httpClient connect.HTTPClient, baseURL string, opts ..
 			connect.WithSchema(userServiceMethods.ByName("DeleteUser")),
 			connect.WithClientOptions(opts...),
 		),
+		createApiToken: connect.NewClient[v1.CreateApiTokenRequest, v1.CreateApiTokenResponse](
+			httpClient,
+			baseURL+UserServiceCreateApiTokenProcedure,
+			connect.WithSchema(userServiceMethods.ByName("CreateApiToken")),
+			connect.WithClientOptions(opts...),
+		),
+		listApiTokens: connect.NewClient[v1.ListApiTokensRequest, v1.ListApiTokensResponse](
+			httpClient,
+			baseURL+UserServiceListApiTokensProcedure,
+			connect.WithSchema(userServiceMethods.ByName("ListApiTokens")),
+			connect.WithClientOptions(opts...),
+		),
+		revokeApiToken: connect.NewClient[v1.RevokeApiTokenRequest, v1.RevokeApiTokenResponse](
+			httpClient,
+			baseURL+UserServiceRevokeApiTokenProcedure,
+			connect.WithSchema(userServiceMethods.ByName("RevokeApiToken")),
+			connect.WithClientOptions(opts...),
+		),
+		getTotpStatus: connect.NewClient[v1.GetTotpStatusRequest, v1.GetTotpStatusResponse](
+			httpClient,
+			baseURL+UserServiceGetTotpStatusProcedure,
+			connect.WithSchema(userServiceMethods.ByName("GetTotpStatus")),
+			connect.WithClientOptions(opts...),
+		),
+		beginTotpEnrollment: connect.NewClient[v1.BeginTotpEnrollmentRequest, v1.BeginTotpEnrollmentResponse](
+			httpClient,
+			baseURL+UserServiceBeginTotpEnrollmentProcedure,
+			connect.WithSchema(userServiceMethods.ByName("BeginTotpEnrollment")),
+			connect.WithClientOptions(opts...),
+		),
+		confirmTotpEnrollment: connect.NewClient[v1.ConfirmTotpEnrollmentRequest, v1.ConfirmTotpEnrollmentResponse](
+			httpClient,
+			baseURL+UserServiceConfirmTotpEnrollmentProcedure,
+			connect.WithSchema(userServiceMethods.ByName("ConfirmTotpEnrollment")),
+			connect.WithClientOptions(opts...),
+		),
+		disableTotp: connect.NewClient[v1.DisableTotpRequest, v1.DisableTotpResponse](
+			httpClient,
+			baseURL+UserServiceDisableTotpProcedure,
+			connect.WithSchema(userServiceMethods.ByName("DisableTotp")),
+			connect.WithClientOptions(opts...),
+		),
+		listMySessions: connect.NewClient[v1.ListMySessionsRequest, v1.ListMySessionsResponse](
+			httpClient,
+			baseURL+UserServiceListMySessionsProcedure,
+			connect.WithSchema(userServiceMethods.ByName("ListMySessions")),
+			connect.WithClientOptions(opts...),
+		),
+		revokeSession: connect.NewClient[v1.RevokeSessionRequest, v1.RevokeSessionResponse](
+			httpClient,
+			baseURL+UserServiceRevokeSessionProcedure,
+			connect.WithSchema(userServiceMethods.ByName("RevokeSession")),
+			connect.WithClientOptions(opts...),
+		),
+		beginOidcLink: connect.NewClient[v1.BeginOidcLinkRequest, v1.BeginOidcLoginResponse](
+			httpClient,
+			baseURL+UserServiceBeginOidcLinkProcedure,
+			connect.WithSchema(userServiceMethods.ByName("BeginOidcLink")),
+			connect.WithClientOptions(opts...),
+		),
+		completeOidcLink: connect.NewClient[v1.CompleteOidcLinkRequest, v1.CompleteOidcLinkResponse](
+			httpClient,
+			baseURL+UserServiceCompleteOidcLinkProcedure,
+			connect.WithSchema(userServiceMethods.ByName("CompleteOidcLink")),
+			connect.WithClientOptions(opts...),
+		),
 	}
 }
 
@@ -154,12 +324,27 @@ type userServiceClient struct {
 	getTokenByPassword        *connect.Client[v1.GetTokenByPasswordRequest, v1.TokenSetResponse]
 	validateRegistrationToken *connect.Client[v1.ValidateRegistrationTokenRequest, v1.ValidateRegistrationTokenResponse]
 	registerWithToken         *connect.Client[v1.RegisterWithTokenRequest, v1.TokenSetResponse]
+	getLoginOptions           *connect.Client[v1.GetLoginOptionsRequest, v1.GetLoginOptionsResponse]
+	beginOidcLogin            *connect.Client[v1.BeginOidcLoginRequest, v1.BeginOidcLoginResponse]
+	completeOidcLogin         *connect.Client[v1.CompleteOidcLoginRequest, v1.TokenSetResponse]
+	verifyTotpLogin           *connect.Client[v1.VerifyTotpLoginRequest, v1.TokenSetResponse]
 	refreshToken              *connect.Client[v1.RefreshTokenRequest, v1.TokenSetResponse]
 	changePassword            *connect.Client[v1.ChangePasswordRequest, v1.ChangePasswordResponse]
 	listUsers                 *connect.Client[v1.ListUsersRequest, v1.ListUsersResponse]
 	getUser                   *connect.Client[v1.GetUserRequest, v1.GetUserResponse]
 	createRegistrationToken   *connect.Client[v1.CreateRegistrationTokenRequest, v1.CreateRegistrationTokenResponse]
 	deleteUser                *connect.Client[v1.DeleteUserRequest, v1.DeleteUserResponse]
+	createApiToken            *connect.Client[v1.CreateApiTokenRequest, v1.CreateApiTokenResponse]
+	listApiTokens             *connect.Client[v1.ListApiTokensRequest, v1.ListApiTokensResponse]
+	revokeApiToken            *connect.Client[v1.RevokeApiTokenRequest, v1.RevokeApiTokenResponse]
+	getTotpStatus             *connect.Client[v1.GetTotpStatusRequest, v1.GetTotpStatusResponse]
+	beginTotpEnrollment       *connect.Client[v1.BeginTotpEnrollmentRequest, v1.BeginTotpEnrollmentResponse]
+	confirmTotpEnrollment     *connect.Client[v1.ConfirmTotpEnrollmentRequest, v1.ConfirmTotpEnrollmentResponse]
+	disableTotp               *connect.Client[v1.DisableTotpRequest, v1.DisableTotpResponse]
+	listMySessions            *connect.Client[v1.ListMySessionsRequest, v1.ListMySessionsResponse]
+	revokeSession             *connect.Client[v1.RevokeSessionRequest, v1.RevokeSessionResponse]
+	beginOidcLink             *connect.Client[v1.BeginOidcLinkRequest, v1.BeginOidcLoginResponse]
+	completeOidcLink          *connect.Client[v1.CompleteOidcLinkRequest, v1.CompleteOidcLinkResponse]
 }
 
 // GetTokenByPassword calls hdlctrl.v1.UserService.GetTokenByPassword.
@@ -177,6 +362,26 @@ func (c *userServiceClient) RegisterWithToken(ctx context.Context, req *connect.
 	return c.registerWithToken.CallUnary(ctx, req)
 }
 
+// GetLoginOptions calls hdlctrl.v1.UserService.GetLoginOptions.
+func (c *userServiceClient) GetLoginOptions(ctx context.Context, req *connect.Request[v1.GetLoginOptionsRequest]) (*connect.Response[v1.GetLoginOptionsResponse], error) {
+	return c.getLoginOptions.CallUnary(ctx, req)
+}
+
+// BeginOidcLogin calls hdlctrl.v1.UserService.BeginOidcLogin.
+func (c *userServiceClient) BeginOidcLogin(ctx context.Context, req *connect.Request[v1.BeginOidcLoginRequest]) (*connect.Response[v1.BeginOidcLoginResponse], error) {
+	return c.beginOidcLogin.CallUnary(ctx, req)
+}
+
+// CompleteOidcLogin calls hdlctrl.v1.UserService.CompleteOidcLogin.
+func (c *userServiceClient) CompleteOidcLogin(ctx context.Context, req *connect.Request[v1.CompleteOidcLoginRequest]) (*connect.Response[v1.TokenSetResponse], error) {
+	return c.completeOidcLogin.CallUnary(ctx, req)
+}
+
+// VerifyTotpLogin calls hdlctrl.v1.UserService.VerifyTotpLogin.
+func (c *userServiceClient) VerifyTotpLogin(ctx context.Context, req *connect.Request[v1.VerifyTotpLoginRequest]) (*connect.Response[v1.TokenSetResponse], error) {
+	return c.verifyTotpLogin.CallUnary(ctx, req)
+}
+
 // RefreshToken calls hdlctrl.v1.UserService.RefreshToken.
 func (c *userServiceClient) RefreshToken(ctx context.Context, req *connect.Request[v1.RefreshTokenRequest]) (*connect.Response[v1.TokenSetResponse], error) {
 	return c.refreshToken.CallUnary(ctx, req)
@@ -207,12 +412,76 @@ func (c *userServiceClient) DeleteUser(ctx context.Context, req *connect.Request
 	return c.deleteUser.CallUnary(ctx, req)
 }
 
+// CreateApiToken calls hdlctrl.v1.UserService.CreateApiToken.
+func (c *userServiceClient) CreateApiToken(ctx context.Context, req *connect.Request[v1.CreateApiTokenRequest]) (*connect.Response[v1.CreateApiTokenResponse], error) {
+	return c.createApiToken.CallUnary(ctx, req)
+}
+
+// ListApiTokens calls hdlctrl.v1.UserService.ListApiTokens.
+func (c *userServiceClient) ListApiTokens(ctx context.Context, req *connect.Request[v1.ListApiTokensRequest]) (*connect.Response[v1.ListApiTokensResponse], error) {
+	return c.listApiTokens.CallUnary(ctx, req)
+}
+
+// RevokeApiToken calls hdlctrl.v1.UserService.RevokeApiToken.
+func (c *userServiceClient) RevokeApiToken(ctx context.Context, req *connect.Request[v1.RevokeApiTokenRequest]) (*connect.Response[v1.RevokeApiTokenResponse], error) {
+	return c.revokeApiToken.CallUnary(ctx, req)
+}
+
+// GetTotpStatus calls hdlctrl.v1.UserService.GetTotpStatus.
+func (c *userServiceClient) GetTotpStatus(ctx context.Context, req *connect.Request[v1.GetTotpStatusRequest]) (*connect.Response[v1.GetTotpStatusResponse], error) {
+	return c.getTotpStatus.CallUnary(ctx, req)
+}
+
+// BeginTotpEnrollment calls hdlctrl.v1.UserService.BeginTotpEnrollment.
+func (c *userServiceClient) BeginTotpEnrollment(ctx context.Context, req *connect.Request[v1.BeginTotpEnrollmentRequest]) (*connect.Response[v1.BeginTotpEnrollmentResponse], error) {
+	return c.beginTotpEnrollment.CallUnary(ctx, req)
+}
+
+// ConfirmTotpEnrollment calls hdlctrl.v1.UserService.ConfirmTotpEnrollment.
+func (c *userServiceClient) ConfirmTotpEnrollment(ctx context.Context, req *connect.Request[v1.ConfirmTotpEnrollmentRequest]) (*connect.Response[v1.ConfirmTotpEnrollmentResponse], error) {
+	return c.confirmTotpEnrollment.CallUnary(ctx, req)
+}
+
+// DisableTotp calls hdlctrl.v1.UserService.DisableTotp.
+func (c *userServiceClient) DisableTotp(ctx context.Context, req *connect.Request[v1.DisableTotpRequest]) (*connect.Response[v1.DisableTotpResponse], error) {
+	return c.disableTotp.CallUnary(ctx, req)
+}
+
+// ListMySessions calls hdlctrl.v1.UserService.ListMySessions.
+func (c *userServiceClient) ListMySessions(ctx context.Context, req *connect.Request[v1.ListMySessionsRequest]) (*connect.Response[v1.ListMySessionsResponse], error) {
+	return c.listMySessions.CallUnary(ctx, req)
+}
+
+// RevokeSession calls hdlctrl.v1.UserService.RevokeSession.
+func (c *userServiceClient) RevokeSession(ctx context.Context, req *connect.Request[v1.RevokeSessionRequest]) (*connect.Response[v1.RevokeSessionResponse], error) {
+	return c.revokeSession.CallUnary(ctx, req)
+}
+
+// BeginOidcLink calls hdlctrl.v1.UserService.BeginOidcLink.
+func (c *userServiceClient) BeginOidcLink(ctx context.Context, req *connect.Request[v1.BeginOidcLinkRequest]) (*connect.Response[v1.BeginOidcLoginResponse], error) {
+	return c.beginOidcLink.CallUnary(ctx, req)
+}
+
+// CompleteOidcLink calls hdlctrl.v1.UserService.CompleteOidcLink.
+func (c *userServiceClient) CompleteOidcLink(ctx context.Context, req *connect.Request[v1.CompleteOidcLinkRequest]) (*connect.Response[v1.CompleteOidcLinkResponse], error) {
+	return c.completeOidcLink.CallUnary(ctx, req)
+}
+
 // UserServiceHandler is an implementation of the hdlctrl.v1.UserService service.
 type UserServiceHandler interface {
 	// 認証なしRPC
 	GetTokenByPassword(context.Context, *connect.Request[v1.GetTokenByPasswordRequest]) (*connect.Response[v1.TokenSetResponse], error)
 	ValidateRegistrationToken(context.Context, *connect.Request[v1.ValidateRegistrationTokenRequest]) (*connect.Response[v1.ValidateRegistrationTokenResponse], error)
 	RegisterWithToken(context.Context, *connect.Request[v1.RegisterWithTokenRequest]) (*connect.Response[v1.TokenSetResponse], error)
+	// サインイン画面に出す選択肢 (SSO の有無)
+	GetLoginOptions(context.Context, *connect.Request[v1.GetLoginOptionsRequest]) (*connect.Response[v1.GetLoginOptionsResponse], error)
+	// OIDC (認可コード + PKCE) でのサインイン. Begin で得た URL に遷移し、
+	// IdP から戻ってきた code / state で Complete する
+	BeginOidcLogin(context.Context, *connect.Request[v1.BeginOidcLoginRequest]) (*connect.Response[v1.BeginOidcLoginResponse], error)
+	CompleteOidcLogin(context.Context, *connect.Request[v1.CompleteOidcLoginRequest]) (*connect.Response[v1.TokenSetResponse], error)
+	// GetTokenByPassword が totp_challenge を返したときに、認証アプリのコードか
+	// リカバリーコードを送ってサインインを完了する
+	VerifyTotpLogin(context.Context, *connect.Request[v1.VerifyTotpLoginRequest]) (*connect.Response[v1.TokenSetResponse], error)
 	// 認証付きRPC
 	RefreshToken(context.Context, *connect.Request[v1.RefreshTokenRequest]) (*connect.Response[v1.TokenSetResponse], error)
 	ChangePassword(context.Context, *connect.Request[v1.ChangePasswordRequest]) (*connect.Response[v1.ChangePasswordResponse], error)
@@ -227,6 +496,33 @@ type UserServiceHandler interface {
 	CreateRegistrationToken(context.Context, *connect.Request[v1.CreateRegistrationTokenRequest]) (*connect.Response[v1.CreateRegistrationTokenResponse], error)
 	// 指定 user_id のユーザーを削除する. 自分自身は削除できない.
 	DeleteUser(context.Context, *connect.Request[v1.DeleteUserRequest]) (*connect.Response[v1.DeleteUserResponse], error)
+	// API トークン (スクリプト / bot 向けの長期トークン). 自分のトークンのみ操作できる.
+	// 発行したトークンは Bearer ヘッダに付けて JWT の代わりに使える.
+	// API トークンで認証されたリクエストからは発行できない.
+	CreateApiToken(context.Context, *connect.Request[v1.CreateApiTokenRequest]) (*connect.Response[v1.CreateApiTokenResponse], error)
+	// 失効済み / 期限切れも含めて新しい順に返す.
+	ListApiTokens(context.Context, *connect.Request[v1.ListApiTokensRequest]) (*connect.Response[v1.ListApiTokensResponse], error)
+	RevokeApiToken(context.Context, *connect.Request[v1.RevokeApiTokenRequest]) (*connect.Response[v1.RevokeApiTokenResponse], error)
+	// 2 段階認証 (TOTP). 自分の設定のみ操作できる. API トークンからは操作できない.
+	GetTotpStatus(context.Context, *connect.Request[v1.GetTotpStatusRequest]) (*connect.Response[v1.GetTotpStatusResponse], error)
+	// 新しい secret を発行する. Confirm で最初のコードを確認するまでは有効にならない.
+	// 登録が必須でまだサインインできないユーザーは、access token の代わりに
+	// GetTokenByPassword で得た challenge を渡す.
+	BeginTotpEnrollment(context.Context, *connect.Request[v1.BeginTotpEnrollmentRequest]) (*connect.Response[v1.BeginTotpEnrollmentResponse], error)
+	ConfirmTotpEnrollment(context.Context, *connect.Request[v1.ConfirmTotpEnrollmentRequest]) (*connect.Response[v1.ConfirmTotpEnrollmentResponse], error)
+	// 必須のユーザーは無効にできない.
+	DisableTotp(context.Context, *connect.Request[v1.DisableTotpRequest]) (*connect.Response[v1.DisableTotpResponse], error)
+	// ログインセッション (サインインした端末). 自分のセッションのみ操作できる. API トークンからは操作できない.
+	// 有効なセッションを最後に使われた順に返す.
+	ListMySessions(context.Context, *connect.Request[v1.ListMySessionsRequest]) (*connect.Response[v1.ListMySessionsResponse], error)
+	// 失効させたセッションの access token / refresh token はすぐに使えなくなる.
+	// 今のセッションを指定するとサインアウトになる.
+	RevokeSession(context.Context, *connect.Request[v1.RevokeSessionRequest]) (*connect.Response[v1.RevokeSessionResponse], error)
+	// ログイン中のユーザーに IdP のアカウントを紐付ける. 以降はそのアカウントの SSO でサインインできる.
+	// Begin で得た URL に遷移し、IdP から戻ってきた code / state で Complete する.
+	// state は Begin を呼んだユーザーでしか Complete できない. API トークンからは操作できない.
+	BeginOidcLink(context.Context, *connect.Request[v1.BeginOidcLinkRequest]) (*connect.Response[v1.BeginOidcLoginResponse], error)
+	CompleteOidcLink(context.Context, *connect.Request[v1.CompleteOidcLinkRequest]) (*connect.Response[v1.CompleteOidcLinkResponse], error)
 }
 
 // NewUserServiceHandler builds an HTTP handler from the service implementation. It returns the path
@@ -254,6 +550,30 @@ func NewUserServiceHandler(svc UserServiceHandler, opts ...connect.HandlerOption
 		connect.WithSchema(userServiceMethods.ByName("RegisterWithToken")),
 		connect.WithHandlerOptions(opts...),
 	)
+	userServiceGetLoginOptionsHandler := connect.NewUnaryHandler(
+		UserServiceGetLoginOptionsProcedure,
+		svc.GetLoginOptions,
+		connect.WithSchema(userServiceMethods.ByName("GetLoginOptions")),
+		connect.WithHandlerOptions(opts...),
+	)
+	userServiceBeginOidcLoginHandler := connect.NewUnaryHandler(
+		UserServiceBeginOidcLoginProcedure,
+		svc.BeginOidcLogin,
+		connect.WithSchema(userServiceMethods.ByName("BeginOidcLogin")),
+		connect.WithHandlerOptions(opts...),
+	)
+	userServiceCompleteOidcLoginHandler := connect.NewUnaryHandler(
+		UserServiceCompleteOidcLoginProcedure,
+		svc.CompleteOidcLogin,
+		connect.WithSchema(userServiceMethods.ByName("CompleteOidcLogin")),
+		connect.WithHandlerOptions(opts...),
+	)
+	userServiceVerifyTotpLoginHandler := connect.NewUnaryHandler(
+		UserServiceVerifyTotpLoginProcedure,
+		svc.VerifyTotpLogin,
+		connect.WithSchema(userServiceMethods.ByName("VerifyTotpLogin")),
+		connect.WithHandlerOptions(opts...),
+	)
 	userServiceRefreshTokenHandler := connect.NewUnaryHandler(
 		UserServiceRefreshTokenProcedure,
 		svc.RefreshToken,
@@ -290,6 +610,72 @@ func NewUserServiceHandler(svc UserServiceHandler, opts ...connect.HandlerOption
 		connect.WithSchema(userServiceMethods.ByName("DeleteUser")),
 		connect.WithHandlerOptions(opts...),
 	)
+	userServiceCreateApiTokenHandler := connect.NewUnaryHandler(
+		UserServiceCreateApiTokenProcedure,
+		svc.CreateApiToken,
+		connect.WithSchema(userServiceMethods.ByName("CreateApiToken")),
+		connect.WithHandlerOptions(opts...),
+	)
+	userServiceListApiTokensHandler := connect.NewUnaryHandler(
+		UserServiceListApiTokensProcedure,
+		svc.ListApiTokens,
+		connect.WithSchema(userServiceMethods.ByName("ListApiTokens")),
+		connect.WithHandlerOptions(opts...),
+	)
+	userServiceRevokeApiTokenHandler := connect.NewUnaryHandler(
+		UserServiceRevokeApiTokenProcedure,
+		svc.RevokeApiToken,
+		connect.WithSchema(userServiceMethods.ByName("RevokeApiToken")),
+		connect.WithHandlerOptions(opts...),
+	)
+	userServiceGetTotpStatusHandler := connect.NewUnaryHandler(
+		UserServiceGetTotpStatusProcedure,
+		svc.GetTotpStatus,
+		connect.WithSchema(userServiceMethods.ByName("GetTotpStatus")),
+		connect.WithHandlerOptions(opts...),
+	)
+	userServiceBeginTotpEnrollmentHandler := connect.NewUnaryHandler(
+		UserServiceBeginTotpEnrollmentProcedure,
+		svc.BeginTotpEnrollment,
+		connect.WithSchema(userServiceMethods.ByName("BeginTotpEnrollment")),
+		connect.WithHandlerOptions(opts...),
+	)
+	userServiceConfirmTotpEnrollmentHandler := connect.NewUnaryHandler(
+		UserServiceConfirmTotpEnrollmentProcedure,
+		svc.ConfirmTotpEnrollment,
+		connect.WithSchema(userServiceMethods.ByName("ConfirmTotpEnrollment")),
+		connect.WithHandlerOptions(opts...),
+	)
+	userServiceDisableTotpHandler := connect.NewUnaryHandler(
+		UserServiceDisableTotpProcedure,
+		svc.DisableTotp,
+		connect.WithSchema(userServiceMethods.ByName("DisableTotp")),
+		connect.WithHandlerOptions(opts...),
+	)
+	userServiceListMySessionsHandler := connect.NewUnaryHandler(
+		UserServiceListMySessionsProcedure,
+		svc.ListMySessions,
+		connect.WithSchema(userServiceMethods.ByName("ListMySessions")),
+		connect.WithHandlerOptions(opts...),
+	)
+	userServiceRevokeSessionHandler := connect.NewUnaryHandler(
+		UserServiceRevokeSessionProcedure,
+		svc.RevokeSession,
+		connect.WithSchema(userServiceMethods.ByName("RevokeSession")),
+		connect.WithHandlerOptions(opts...),
+	)
+	userServiceBeginOidcLinkHandler := connect.NewUnaryHandler(
+		UserServiceBeginOidcLinkProcedure,
+		svc.BeginOidcLink,
+		connect.WithSchema(userServiceMethods.ByName("BeginOidcLink")),
+		connect.WithHandlerOptions(opts...),
+	)
+	userServiceCompleteOidcLinkHandler := connect.NewUnaryHandler(
+		UserServiceCompleteOidcLinkProcedure,
+		svc.CompleteOidcLink,
+		connect.WithSchema(userServiceMethods.ByName("CompleteOidcLink")),
+		connect.WithHandlerOptions(opts...),
+	)
 	return "/hdlctrl.v1.UserService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		switch r.URL.Path {
 		case UserServiceGetTokenByPasswordProcedure:
@@ -298,6 +684,14 @@ func NewUserServiceHandler(svc UserServiceHandler, opts ...connect.HandlerOption
 			userServiceValidateRegistrationTokenHandler.ServeHTTP(w, r)
 		case UserServiceRegisterWithTokenProcedure:
 			userServiceRegisterWithTokenHandler.ServeHTTP(w, r)
+		case UserServiceGetLoginOptionsProcedure:
+			userServiceGetLoginOptionsHandler.ServeHTTP(w, r)
+		case UserServiceBeginOidcLoginProcedure:
+			userServiceBeginOidcLoginHandler.ServeHTTP(w, r)
+		case UserServiceCompleteOidcLoginProcedure:
+			userServiceCompleteOidcLoginHandler.ServeHTTP(w, r)
+		case UserServiceVerifyTotpLoginProcedure:
+			userServiceVerifyTotpLoginHandler.ServeHTTP(w, r)
 		case UserServiceRefreshTokenProcedure:
 			userServiceRefreshTokenHandler.ServeHTTP(w, r)
 		case UserServiceChangePasswordProcedure:
@@ -310,6 +704,28 @@ func NewUserServiceHandler(svc UserServiceHandler, opts ...connect.HandlerOption
 			userServiceCreateRegistrationTokenHandler.ServeHTTP(w, r)
 		case UserServiceDeleteUserProcedure:
 			userServiceDeleteUserHandler.ServeHTTP(w, r)
+		case UserServiceCreateApiTokenProcedure:
+			userServiceCreateApiTokenHandler.ServeHTTP(w, r)
+		case UserServiceListApiTokensProcedure:
+			userServiceListApiTokensHandler.ServeHTTP(w, r)
+		case UserServiceRevokeApiTokenProcedure:
+			userServiceRevokeApiTokenHandler.ServeHTTP(w, r)
+		case UserServiceGetTotpStatusProcedure:
+			userServiceGetTotpStatusHandler.ServeHTTP(w, r)
+		case UserServiceBeginTotpEnrollmentProcedure:
+			userServiceBeginTotpEnrollmentHandler.ServeHTTP(w, r)
+		case UserServiceConfirmTotpEnrollmentProcedure:
+			userServiceConfirmTotpEnrollmentHandler.ServeHTTP(w, r)
+		case UserServiceDisableTotpProcedure:
+			userServiceDisableTotpHandler.ServeHTTP(w, r)
+		case UserServiceListMySessionsProcedure:
+			userServiceListMySessionsHandler.ServeHTTP(w, r)
+		case UserServiceRevokeSessionProcedure:
+			userServiceRevokeSessionHandler.ServeHTTP(w, r)
+		case UserServiceBeginOidcLinkProcedure:
+			userServiceBeginOidcLinkHandler.ServeHTTP(w, r)
+		case UserServiceCompleteOidcLinkProcedure:
+			userServiceCompleteOidcLinkHandler.ServeHTTP(w, r)
 		default:
 			http.NotFound(w, r)
 		}
@@ -331,6 +747,22 @@ func (UnimplementedUserServiceHandler) RegisterWithToken(context.Context, *conne
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("hdlctrl.v1.UserService.RegisterWithToken is not implemented"))
 }
 
+func (UnimplementedUserServiceHandler) GetLoginOptions(context.Context, *connect.Request[v1.GetLoginOptionsRequest]) (*connect.Response[v1.GetLoginOptionsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("hdlctrl.v1.UserService.GetLoginOptions is not implemented"))
+}
+
+func (UnimplementedUserServiceHandler) BeginOidcLogin(context.Context, *connect.Request[v1.BeginOidcLoginRequest]) (*connect.Response[v1.BeginOidcLoginResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("hdlctrl.v1.UserService.BeginOidcLogin is not implemented"))
+}
+
+func (UnimplementedUserServiceHandler) CompleteOidcLogin(context.Context, *connect.Request[v1.CompleteOidcLoginRequest]) (*connect.Response[v1.TokenSetResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("hdlctrl.v1.UserService.CompleteOidcLogin is not implemented"))
+}
+
+func (UnimplementedUserServiceHandler) VerifyTotpLogin(context.Context, *connect.Request[v1.VerifyTotpLoginRequest]) (*connect.Response[v1.TokenSetResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("hdlctrl.v1.UserService.VerifyTotpLogin is not implemented"))
+}
+
 func (UnimplementedUserServiceHandler) RefreshToken(context.Context, *connect.Request[v1.RefreshTokenRequest]) (*connect.Response[v1.TokenSetResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("hdlctrl.v1.UserService.RefreshToken is not implemented"))
 }
@@ -354,3 +786,47 @@ func (UnimplementedUserServiceHandler) CreateRegistrationToken(context.Context,
 func (UnimplementedUserServiceHandler) DeleteUser(context.Context, *connect.Request[v1.DeleteUserRequest]) (*connect.Response[v1.DeleteUserResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("hdlctrl.v1.UserService.DeleteUser is not implemented"))
 }
+
+func (UnimplementedUserServiceHandler) CreateApiToken(context.Context, *connect.Request[v1.CreateApiTokenRequest]) (*connect.Response[v1.CreateApiTokenResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("hdlctrl.v1.UserService.CreateApiToken is not implemented"))
+}
+
+func (UnimplementedUserServiceHandler) ListApiTokens(context.Context, *connect.Request[v1.ListApiTokensRequest]) (*connect.Response[v1.ListApiTokensResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("hdlctrl.v1.UserService.ListApiTokens is not implemented"))
+}
+
+func (UnimplementedUserServiceHandler) RevokeApiToken(context.Context, *connect.Request[v1.RevokeApiTokenRequest]) (*connect.Response[v1.RevokeApiTokenResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("hdlctrl.v1.UserService.RevokeApiToken is not implemented"))
+}
+
+func (UnimplementedUserServiceHandler) GetTotpStatus(context.Context, *connect.Request[v1.GetTotpStatusRequest]) (*connect.Response[v1.GetTotpStatusResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("hdlctrl.v1.UserService.GetTotpStatus is not implemented"))
+}
+
+func (UnimplementedUserServiceHandler) BeginTotpEnrollment(context.Context, *connect.Request[v1.BeginTotpEnrollmentRequest]) (*connect.Response[v1.BeginTotpEnrollmentResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("hdlctrl.v1.UserService.BeginTotpEnrollment is not implemented"))
+}
+
+func (UnimplementedUserServiceHandler) ConfirmTotpEnrollment(context.Context, *connect.Request[v1.ConfirmTotpEnrollmentRequest]) (*connect.Response[v1.ConfirmTotpEnrollmentResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("hdlctrl.v1.UserService.ConfirmTotpEnrollment is not implemented"))
+}
+
+func (UnimplementedUserServiceHandler) DisableTotp(context.Context, *connect.Request[v1.DisableTotpRequest]) (*connect.Response[v1.DisableTotpResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("hdlctrl.v1.UserService.DisableTotp is not implemented"))
+}
+
+func (UnimplementedUserServiceHandler) ListMySessions(context.Context, *connect.Request[v1.ListMySessionsRequest]) (*connect.Response[v1.ListMySessionsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("hdlctrl.v1.UserService.ListMySessions is not implemented"))
+}
+
+func (UnimplementedUserServiceHandler) RevokeSession(context.Context, *connect.Request[v1.RevokeSessionRequest]) (*connect.Response[v1.RevokeSessionResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("hdlctrl.v1.UserService.RevokeSession is not implemented"))
+}
+
+func (UnimplementedUserServiceHandler) BeginOidcLink(context.Context, *connect.Request[v1.BeginOidcLinkRequest]) (*connect.Response[v1.BeginOidcLoginResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("hdlctrl.v1.UserService.BeginOidcLink is not implemented"))
+}
+
+func (UnimplementedUserServiceHandler) CompleteOidcLink(context.Context, *connect.Request[v1.CompleteOidcLinkRequest]) (*connect.Response[v1.CompleteOidcLinkResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("hdlctrl.v1.UserService.CompleteOidcLink is not implemented"))
+}
@@ -68,7 +68,7 @@ func (x SessionUserChangedEvent_Kind) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use SessionUserChangedEvent_Kind.Descriptor instead.
 func (SessionUserChangedEvent_Kind) EnumDescriptor() ([]byte, []int) {
-	return file_hdlctrl_v1_notification_proto_rawDescGZIP(), []int{4, 0}
+	return file_hdlctrl_v1_notification_proto_rawDescGZIP(), []int{5, 0}
 }
 
 type SessionLifecycleEvent_Kind int32
@@ -117,7 +117,63 @@ func (x SessionLifecycleEvent_Kind) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use SessionLifecycleEvent_Kind.Descriptor instead.
 func (SessionLifecycleEvent_Kind) EnumDescriptor() ([]byte, []int) {
-	return file_hdlctrl_v1_notification_proto_rawDescGZIP(), []int{5, 0}
+	return file_hdlctrl_v1_notification_proto_rawDescGZIP(), []int{6, 0}
+}
+
+type HostAutoRestartEvent_Kind int32
+
+const (
+	HostAutoRestartEvent_KIND_UNSPECIFIED HostAutoRestartEvent_Kind = 0
+	HostAutoRestartEvent_KIND_SCHEDULED   HostAutoRestartEvent_Kind = 1
+	HostAutoRestartEvent_KIND_RESTARTED   HostAutoRestartEvent_Kind = 2
+	HostAutoRestartEvent_KIND_FAILED      HostAutoRestartEvent_Kind = 3
+	// crash loop と判定して自動再起動を止めた
+	HostAutoRestartEvent_KIND_GAVE_UP HostAutoRestartEvent_Kind = 4
+)
+
+// Enum value maps for HostAutoRestartEvent_Kind.
+var (
+	HostAutoRestartEvent_Kind_name = map[int32]string{
+		0: "KIND_UNSPECIFIED",
+		1: "KIND_SCHEDULED",
+		2: "KIND_RESTARTED",
+		3: "KIND_FAILED",
+		4: "KIND_GAVE_UP",
+	}
+	HostAutoRestartEvent_Kind_value = map[string]int32{
+		"KIND_UNSPECIFIED": 0,
+		"KIND_SCHEDULED":   1,
+		"KIND_RESTARTED":   2,
+		"KIND_FAILED":      3,
+		"KIND_GAVE_UP":     4,
+	}
+)
+
+func (x HostAutoRestartEvent_Kind) Enum() *HostAutoRestartEvent_Kind {
+	p := new(HostAutoRestartEvent_Kind)
+	*p = x
+	return p
+}
+
+func (x HostAutoRestartEvent_Kind) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (HostAutoRestartEvent_Kind) Descriptor() protoreflect.EnumDescriptor {
+	return file_hdlctrl_v1_notification_proto_enumTypes[2].Descriptor()
+}
+
+func (HostAutoRestartEvent_Kind) Type() protoreflect.EnumType {
+	return &file_hdlctrl_v1_notification_proto_enumTypes[2]
+}
+
+func (x HostAutoRestartEvent_Kind) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use HostAutoRestartEvent_Kind.Descriptor instead.
+func (HostAutoRestartEvent_Kind) EnumDescriptor() ([]byte, []int) {
+	return file_hdlctrl_v1_notification_proto_rawDescGZIP(), []int{9, 0}
 }
 
 type JobCompletedEvent_Level int32
@@ -156,11 +212,11 @@ func (x JobCompletedEvent_Level) String() string {
 }
 
 func (JobCompletedEvent_Level) Descriptor() protoreflect.EnumDescriptor {
-	return file_hdlctrl_v1_notification_proto_enumTypes[2].Descriptor()
+	return file_hdlctrl_v1_notification_proto_enumTypes[3].Descriptor()
 }
 
 func (JobCompletedEvent_Level) Type() protoreflect.EnumType {
-	return &file_hdlctrl_v1_notification_proto_enumTypes[2]
+	return &file_hdlctrl_v1_notification_proto_enumTypes[3]
 }
 
 func (x JobCompletedEvent_Level) Number() protoreflect.EnumNumber {
@@ -169,11 +225,14 @@ func (x JobCompletedEvent_Level) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use JobCompletedEvent_Level.Descriptor instead.
 func (JobCompletedEvent_Level) EnumDescriptor() ([]byte, []int) {
-	return file_hdlctrl_v1_notification_proto_rawDescGZIP(), []int{8, 0}
+	return file_hdlctrl_v1_notification_proto_rawDescGZIP(), []int{10, 0}
 }
 
 type SubscribeNotificationsRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 再接続時に、前回の stream で最後に受け取った NotificationEvent.id を指定すると
+	// それ以降のイベントを先に再送する. 再送しきれない場合は HistoryTruncatedEvent が届く.
+	LastEventId   *string `protobuf:"bytes,1,opt,name=last_event_id,json=lastEventId,proto3,oneof" json:"last_event_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -208,22 +267,32 @@ func (*SubscribeNotificationsRequest) Descriptor() ([]byte, []int) {
 	return file_hdlctrl_v1_notification_proto_rawDescGZIP(), []int{0}
 }
 
+func (x *SubscribeNotificationsRequest) GetLastEventId() string {
+	if x != nil && x.LastEventId != nil {
+		return *x.LastEventId
+	}
+	return ""
+}
+
 // NotificationEvent はフロントエンドの個別ページ/コンポーネントが
 // 「自身の表示に関係するイベントか」を判定し、必要なクエリを invalidate
 // するための最小情報のみ運ぶ. データ本体は送らない (再フェッチ前提).
 type NotificationEvent struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// 将来的な resume 用 ULID. 初版では未使用.
+	// サーバが履歴に保存した順の連番. SubscribeNotificationsRequest.last_event_id に使う.
+	// KeepAlive / HistoryTruncated など履歴に残らないイベントでは空.
 	Id         string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 	OccurredAt *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=occurred_at,json=occurredAt,proto3" json:"occurred_at,omitempty"`
 	// Types that are valid to be assigned to Payload:
 	//
 	//	*NotificationEvent_KeepAlive
+	//	*NotificationEvent_HistoryTruncated
 	//	*NotificationEvent_SessionUpdated
 	//	*NotificationEvent_SessionUserChanged
 	//	*NotificationEvent_SessionLifecycle
 	//	*NotificationEvent_HostUpdated
 	//	*NotificationEvent_HostListChanged
+	//	*NotificationEvent_HostAutoRestart
 	//	*NotificationEvent_JobCompleted
 	Payload       isNotificationEvent_Payload `protobuf_oneof:"payload"`
 	unknownFields protoimpl.UnknownFields
@@ -290,6 +359,15 @@ func (x *NotificationEvent) GetKeepAlive() *KeepAlive {
 	return nil
 }
 
+func (x *NotificationEvent) GetHistoryTruncated() *HistoryTruncatedEvent {
+	if x != nil {
+		if x, ok := x.Payload.(*NotificationEvent_HistoryTruncated); ok {
+			return x.HistoryTruncated
+		}
+	}
+	return nil
+}
+
 func (x *NotificationEvent) GetSessionUpdated() *SessionUpdatedEvent {
 	if x != nil {
 		if x, ok := x.Payload.(*NotificationEvent_SessionUpdated); ok {
@@ -335,6 +413,15 @@ func (x *NotificationEvent) GetHostListChanged() *HostListChangedEvent {
 	return nil
 }
 
+func (x *NotificationEvent) GetHostAutoRestart() *HostAutoRestartEvent {
+	if x != nil {
+		if x, ok := x.Payload.(*NotificationEvent_HostAutoRestart); ok {
+			return x.HostAutoRestart
+		}
+	}
+	return nil
+}
+
 func (x *NotificationEvent) GetJobCompleted() *JobCompletedEvent {
 	if x != nil {
 		if x, ok := x.Payload.(*NotificationEvent_JobCompleted); ok {
@@ -354,6 +441,12 @@ type NotificationEvent_KeepAlive struct {
 	KeepAlive *KeepAlive `protobuf:"bytes,3,opt,name=keep_alive,json=keepAlive,proto3,oneof"`
 }
 
+type NotificationEvent_HistoryTruncated struct {
+	// last_event_id 以降のイベントを全ては再送できなかった (履歴の削除など).
+	// クライアントは表示中のクエリを全て invalidate する.
+	HistoryTruncated *HistoryTruncatedEvent `protobuf:"bytes,4,opt,name=history_truncated,json=historyTruncated,proto3,oneof"`
+}
+
 type NotificationEvent_SessionUpdated struct {
 	SessionUpdated *SessionUpdatedEvent `protobuf:"bytes,10,opt,name=session_updated,json=sessionUpdated,proto3,oneof"`
 }
@@ -374,13 +467,19 @@ type NotificationEvent_HostListChanged struct {
 	HostListChanged *HostListChangedEvent `protobuf:"bytes,21,opt,name=host_list_changed,json=hostListChanged,proto3,oneof"`
 }
 
+type NotificationEvent_HostAutoRestart struct {
+	HostAutoRestart *HostAutoRestartEvent `protobuf:"bytes,22,opt,name=host_auto_restart,json=hostAutoRestart,proto3,oneof"`
+}
+
 type NotificationEvent_JobCompleted struct {
-	// 将来の非同期 job 完了 toast 用. 初版では publish されない.
+	// 非同期 job の完了 toast 用. job の投入元 user にだけ届く.
 	JobCompleted *JobCompletedEvent `protobuf:"bytes,90,opt,name=job_completed,json=jobCompleted,proto3,oneof"`
 }
 
 func (*NotificationEvent_KeepAlive) isNotificationEvent_Payload() {}
 
+func (*NotificationEvent_HistoryTruncated) isNotificationEvent_Payload() {}
+
 func (*NotificationEvent_SessionUpdated) isNotificationEvent_Payload() {}
 
 func (*NotificationEvent_SessionUserChanged) isNotificationEvent_Payload() {}
@@ -391,6 +490,8 @@ func (*NotificationEvent_HostUpdated) isNotificationEvent_Payload() {}
 
 func (*NotificationEvent_HostListChanged) isNotificationEvent_Payload() {}
 
+func (*NotificationEvent_HostAutoRestart) isNotificationEvent_Payload() {}
+
 func (*NotificationEvent_JobCompleted) isNotificationEvent_Payload() {}
 
 type KeepAlive struct {
@@ -429,6 +530,42 @@ func (*KeepAlive) Descriptor() ([]byte, []int) {
 	return file_hdlctrl_v1_notification_proto_rawDescGZIP(), []int{2}
 }
 
+type HistoryTruncatedEvent struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *HistoryTruncatedEvent) Reset() {
+	*x = HistoryTruncatedEvent{}
+	mi := &file_hdlctrl_v1_notification_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HistoryTruncatedEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HistoryTruncatedEvent) ProtoMessage() {}
+
+func (x *HistoryTruncatedEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_hdlctrl_v1_notification_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HistoryTruncatedEvent.ProtoReflect.Descriptor instead.
+func (*HistoryTruncatedEvent) Descriptor() ([]byte, []int) {
+	return file_hdlctrl_v1_notification_proto_rawDescGZIP(), []int{3}
+}
+
 // セッションの中身 (ワールド情報, パラメータ, ユーザー一覧の総数) が
 // 更新されたことを通知する. 受信側は session 詳細を再フェッチする.
 type SessionUpdatedEvent struct {
@@ -441,7 +578,7 @@ type SessionUpdatedEvent struct {
 
 func (x *SessionUpdatedEvent) Reset() {
 	*x = SessionUpdatedEvent{}
-	mi := &file_hdlctrl_v1_notification_proto_msgTypes[3]
+	mi := &file_hdlctrl_v1_notification_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -453,7 +590,7 @@ func (x *SessionUpdatedEvent) String() string {
 func (*SessionUpdatedEvent) ProtoMessage() {}
 
 func (x *SessionUpdatedEvent) ProtoReflect() protoreflect.Message {
-	mi := &file_hdlctrl_v1_notification_proto_msgTypes[3]
+	mi := &file_hdlctrl_v1_notification_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -466,7 +603,7 @@ func (x *SessionUpdatedEvent) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SessionUpdatedEvent.ProtoReflect.Descriptor instead.
 func (*SessionUpdatedEvent) Descriptor() ([]byte, []int) {
-	return file_hdlctrl_v1_notification_proto_rawDescGZIP(), []int{3}
+	return file_hdlctrl_v1_notification_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *SessionUpdatedEvent) GetSessionId() string {
@@ -497,7 +634,7 @@ type SessionUserChangedEvent struct {
 
 func (x *SessionUserChangedEvent) Reset() {
 	*x = SessionUserChangedEvent{}
-	mi := &file_hdlctrl_v1_notification_proto_msgTypes[4]
+	mi := &file_hdlctrl_v1_notification_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -509,7 +646,7 @@ func (x *SessionUserChangedEvent) String() string {
 func (*SessionUserChangedEvent) ProtoMessage() {}
 
 func (x *SessionUserChangedEvent) ProtoReflect() protoreflect.Message {
-	mi := &file_hdlctrl_v1_notification_proto_msgTypes[4]
+	mi := &file_hdlctrl_v1_notification_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -522,7 +659,7 @@ func (x *SessionUserChangedEvent) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SessionUserChangedEvent.ProtoReflect.Descriptor instead.
 func (*SessionUserChangedEvent) Descriptor() ([]byte, []int) {
-	return file_hdlctrl_v1_notification_proto_rawDescGZIP(), []int{4}
+	return file_hdlctrl_v1_notification_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *SessionUserChangedEvent) GetSessionId() string {
@@ -566,7 +703,7 @@ type SessionLifecycleEvent struct {
 
 func (x *SessionLifecycleEvent) Reset() {
 	*x = SessionLifecycleEvent{}
-	mi := &file_hdlctrl_v1_notification_proto_msgTypes[5]
+	mi := &file_hdlctrl_v1_notification_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -578,7 +715,7 @@ func (x *SessionLifecycleEvent) String() string {
 func (*SessionLifecycleEvent) ProtoMessage() {}
 
 func (x *SessionLifecycleEvent) ProtoReflect() protoreflect.Message {
-	mi := &file_hdlctrl_v1_notification_proto_msgTypes[5]
+	mi := &file_hdlctrl_v1_notification_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -591,7 +728,7 @@ func (x *SessionLifecycleEvent) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SessionLifecycleEvent.ProtoReflect.Descriptor instead.
 func (*SessionLifecycleEvent) Descriptor() ([]byte, []int) {
-	return file_hdlctrl_v1_notification_proto_rawDescGZIP(), []int{5}
+	return file_hdlctrl_v1_notification_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *SessionLifecycleEvent) GetSessionId() string {
@@ -625,7 +762,7 @@ type HostUpdatedEvent struct {
 
 func (x *HostUpdatedEvent) Reset() {
 	*x = HostUpdatedEvent{}
-	mi := &file_hdlctrl_v1_notification_proto_msgTypes[6]
+	mi := &file_hdlctrl_v1_notification_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -637,7 +774,7 @@ func (x *HostUpdatedEvent) String() string {
 func (*HostUpdatedEvent) ProtoMessage() {}
 
 func (x *HostUpdatedEvent) ProtoReflect() protoreflect.Message {
-	mi := &file_hdlctrl_v1_notification_proto_msgTypes[6]
+	mi := &file_hdlctrl_v1_notification_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -650,7 +787,7 @@ func (x *HostUpdatedEvent) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use HostUpdatedEvent.ProtoReflect.Descriptor instead.
 func (*HostUpdatedEvent) Descriptor() ([]byte, []int) {
-	return file_hdlctrl_v1_notification_proto_rawDescGZIP(), []int{6}
+	return file_hdlctrl_v1_notification_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *HostUpdatedEvent) GetHostId() string {
@@ -670,7 +807,7 @@ type HostListChangedEvent struct {
 
 func (x *HostListChangedEvent) Reset() {
 	*x = HostListChangedEvent{}
-	mi := &file_hdlctrl_v1_notification_proto_msgTypes[7]
+	mi := &file_hdlctrl_v1_notification_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -682,7 +819,7 @@ func (x *HostListChangedEvent) String() string {
 func (*HostListChangedEvent) ProtoMessage() {}
 
 func (x *HostListChangedEvent) ProtoReflect() protoreflect.Message {
-	mi := &file_hdlctrl_v1_notification_proto_msgTypes[7]
+	mi := &file_hdlctrl_v1_notification_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -695,10 +832,88 @@ func (x *HostListChangedEvent) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use HostListChangedEvent.ProtoReflect.Descriptor instead.
 func (*HostListChangedEvent) Descriptor() ([]byte, []int) {
-	return file_hdlctrl_v1_notification_proto_rawDescGZIP(), []int{7}
+	return file_hdlctrl_v1_notification_proto_rawDescGZIP(), []int{8}
+}
+
+// クラッシュしたホストの自動再起動の経過を通知する. toast 用.
+type HostAutoRestartEvent struct {
+	state  protoimpl.MessageState    `protogen:"open.v1"`
+	HostId string                    `protobuf:"bytes,1,opt,name=host_id,json=hostId,proto3" json:"host_id,omitempty"`
+	Kind   HostAutoRestartEvent_Kind `protobuf:"varint,2,opt,name=kind,proto3,enum=hdlctrl.v1.HostAutoRestartEvent_Kind" json:"kind,omitempty"`
+	// 連続何回目の再起動か
+	Attempt       int32                   `protobuf:"varint,3,opt,name=attempt,proto3" json:"attempt,omitempty"`
+	Level         JobCompletedEvent_Level `protobuf:"varint,4,opt,name=level,proto3,enum=hdlctrl.v1.JobCompletedEvent_Level" json:"level,omitempty"`
+	Message       string                  `protobuf:"bytes,5,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *HostAutoRestartEvent) Reset() {
+	*x = HostAutoRestartEvent{}
+	mi := &file_hdlctrl_v1_notification_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HostAutoRestartEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-// 非同期 job の完了 toast 用. 初版では publish されない.
+func (*HostAutoRestartEvent) ProtoMessage() {}
+
+func (x *HostAutoRestartEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_hdlctrl_v1_notification_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HostAutoRestartEvent.ProtoReflect.Descriptor instead.
+func (*HostAutoRestartEvent) Descriptor() ([]byte, []int) {
+	return file_hdlctrl_v1_notification_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *HostAutoRestartEvent) GetHostId() string {
+	if x != nil {
+		return x.HostId
+	}
+	return ""
+}
+
+func (x *HostAutoRestartEvent) GetKind() HostAutoRestartEvent_Kind {
+	if x != nil {
+		return x.Kind
+	}
+	return HostAutoRestartEvent_KIND_UNSPECIFIED
+}
+
+func (x *HostAutoRestartEvent) GetAttempt() int32 {
+	if x != nil {
+		return x.Attempt
+	}
+	return 0
+}
+
+func (x *HostAutoRestartEvent) GetLevel() JobCompletedEvent_Level {
+	if x != nil {
+		return x.Level
+	}
+	return JobCompletedEvent_LEVEL_UNSPECIFIED
+}
+
+func (x *HostAutoRestartEvent) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+// 非同期 job の完了 toast 用.
 type JobCompletedEvent struct {
 	state         protoimpl.MessageState  `protogen:"open.v1"`
 	JobId         string                  `protobuf:"bytes,1,opt,name=job_id,json=jobId,proto3" json:"job_id,omitempty"`
@@ -710,7 +925,7 @@ type JobCompletedEvent struct {
 
 func (x *JobCompletedEvent) Reset() {
 	*x = JobCompletedEvent{}
-	mi := &file_hdlctrl_v1_notification_proto_msgTypes[8]
+	mi := &file_hdlctrl_v1_notification_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -722,7 +937,7 @@ func (x *JobCompletedEvent) String() string {
 func (*JobCompletedEvent) ProtoMessage() {}
 
 func (x *JobCompletedEvent) ProtoReflect() protoreflect.Message {
-	mi := &file_hdlctrl_v1_notification_proto_msgTypes[8]
+	mi := &file_hdlctrl_v1_notification_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -735,7 +950,7 @@ func (x *JobCompletedEvent) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use JobCompletedEvent.ProtoReflect.Descriptor instead.
 func (*JobCompletedEvent) Descriptor() ([]byte, []int) {
-	return file_hdlctrl_v1_notification_proto_rawDescGZIP(), []int{8}
+	return file_hdlctrl_v1_notification_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *JobCompletedEvent) GetJobId() string {
@@ -759,28 +974,288 @@ func (x *JobCompletedEvent) GetMessage() string {
 	return ""
 }
 
+type ListNotificationsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Page  *PageRequest           `protobuf:"bytes,1,opt,name=page,proto3" json:"page,omitempty"`
+	// true なら未読のみ.
+	UnreadOnly    bool `protobuf:"varint,2,opt,name=unread_only,json=unreadOnly,proto3" json:"unread_only,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListNotificationsRequest) Reset() {
+	*x = ListNotificationsRequest{}
+	mi := &file_hdlctrl_v1_notification_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListNotificationsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListNotificationsRequest) ProtoMessage() {}
+
+func (x *ListNotificationsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_hdlctrl_v1_notification_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListNotificationsRequest.ProtoReflect.Descriptor instead.
+func (*ListNotificationsRequest) Descriptor() ([]byte, []int) {
+	return file_hdlctrl_v1_notification_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *ListNotificationsRequest) GetPage() *PageRequest {
+	if x != nil {
+		return x.Page
+	}
+	return nil
+}
+
+func (x *ListNotificationsRequest) GetUnreadOnly() bool {
+	if x != nil {
+		return x.UnreadOnly
+	}
+	return false
+}
+
+type NotificationInboxItem struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Event         *NotificationEvent     `protobuf:"bytes,1,opt,name=event,proto3" json:"event,omitempty"`
+	Read          bool                   `protobuf:"varint,2,opt,name=read,proto3" json:"read,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *NotificationInboxItem) Reset() {
+	*x = NotificationInboxItem{}
+	mi := &file_hdlctrl_v1_notification_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NotificationInboxItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NotificationInboxItem) ProtoMessage() {}
+
+func (x *NotificationInboxItem) ProtoReflect() protoreflect.Message {
+	mi := &file_hdlctrl_v1_notification_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NotificationInboxItem.ProtoReflect.Descriptor instead.
+func (*NotificationInboxItem) Descriptor() ([]byte, []int) {
+	return file_hdlctrl_v1_notification_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *NotificationInboxItem) GetEvent() *NotificationEvent {
+	if x != nil {
+		return x.Event
+	}
+	return nil
+}
+
+func (x *NotificationInboxItem) GetRead() bool {
+	if x != nil {
+		return x.Read
+	}
+	return false
+}
+
+type ListNotificationsResponse struct {
+	state protoimpl.MessageState   `protogen:"open.v1"`
+	Items []*NotificationInboxItem `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	Page  *PageResponse            `protobuf:"bytes,2,opt,name=page,proto3" json:"page,omitempty"`
+	// 閲覧できる範囲の未読件数 (unread_only に関係なく).
+	UnreadCount   int32 `protobuf:"varint,3,opt,name=unread_count,json=unreadCount,proto3" json:"unread_count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListNotificationsResponse) Reset() {
+	*x = ListNotificationsResponse{}
+	mi := &file_hdlctrl_v1_notification_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListNotificationsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListNotificationsResponse) ProtoMessage() {}
+
+func (x *ListNotificationsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_hdlctrl_v1_notification_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListNotificationsResponse.ProtoReflect.Descriptor instead.
+func (*ListNotificationsResponse) Descriptor() ([]byte, []int) {
+	return file_hdlctrl_v1_notification_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *ListNotificationsResponse) GetItems() []*NotificationInboxItem {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *ListNotificationsResponse) GetPage() *PageResponse {
+	if x != nil {
+		return x.Page
+	}
+	return nil
+}
+
+func (x *ListNotificationsResponse) GetUnreadCount() int32 {
+	if x != nil {
+		return x.UnreadCount
+	}
+	return 0
+}
+
+type MarkNotificationsReadRequest struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	EventIds []string               `protobuf:"bytes,1,rep,name=event_ids,json=eventIds,proto3" json:"event_ids,omitempty"`
+	// true なら event_ids を無視して全て既読にする.
+	All           bool `protobuf:"varint,2,opt,name=all,proto3" json:"all,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MarkNotificationsReadRequest) Reset() {
+	*x = MarkNotificationsReadRequest{}
+	mi := &file_hdlctrl_v1_notification_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MarkNotificationsReadRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MarkNotificationsReadRequest) ProtoMessage() {}
+
+func (x *MarkNotificationsReadRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_hdlctrl_v1_notification_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MarkNotificationsReadRequest.ProtoReflect.Descriptor instead.
+func (*MarkNotificationsReadRequest) Descriptor() ([]byte, []int) {
+	return file_hdlctrl_v1_notification_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *MarkNotificationsReadRequest) GetEventIds() []string {
+	if x != nil {
+		return x.EventIds
+	}
+	return nil
+}
+
+func (x *MarkNotificationsReadRequest) GetAll() bool {
+	if x != nil {
+		return x.All
+	}
+	return false
+}
+
+type MarkNotificationsReadResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MarkNotificationsReadResponse) Reset() {
+	*x = MarkNotificationsReadResponse{}
+	mi := &file_hdlctrl_v1_notification_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MarkNotificationsReadResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MarkNotificationsReadResponse) ProtoMessage() {}
+
+func (x *MarkNotificationsReadResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_hdlctrl_v1_notification_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MarkNotificationsReadResponse.ProtoReflect.Descriptor instead.
+func (*MarkNotificationsReadResponse) Descriptor() ([]byte, []int) {
+	return file_hdlctrl_v1_notification_proto_rawDescGZIP(), []int{15}
+}
+
 var File_hdlctrl_v1_notification_proto protoreflect.FileDescriptor
 
 const file_hdlctrl_v1_notification_proto_rawDesc = "" +
 	"\n" +
 	"\x1dhdlctrl/v1/notification.proto\x12\n" +
-	"hdlctrl.v1\x1a\x1fgoogle/protobuf/timestamp.proto\"\x1f\n" +
-	"\x1dSubscribeNotificationsRequest\"\xf3\x04\n" +
+	"hdlctrl.v1\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x1bhdlctrl/v1/controller.proto\"Z\n" +
+	"\x1dSubscribeNotificationsRequest\x12'\n" +
+	"\rlast_event_id\x18\x01 \x01(\tH\x00R\vlastEventId\x88\x01\x01B\x10\n" +
+	"\x0e_last_event_id\"\x95\x06\n" +
 	"\x11NotificationEvent\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12;\n" +
 	"\voccurred_at\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
 	"occurredAt\x126\n" +
 	"\n" +
-	"keep_alive\x18\x03 \x01(\v2\x15.hdlctrl.v1.KeepAliveH\x00R\tkeepAlive\x12J\n" +
+	"keep_alive\x18\x03 \x01(\v2\x15.hdlctrl.v1.KeepAliveH\x00R\tkeepAlive\x12P\n" +
+	"\x11history_truncated\x18\x04 \x01(\v2!.hdlctrl.v1.HistoryTruncatedEventH\x00R\x10historyTruncated\x12J\n" +
 	"\x0fsession_updated\x18\n" +
 	" \x01(\v2\x1f.hdlctrl.v1.SessionUpdatedEventH\x00R\x0esessionUpdated\x12W\n" +
 	"\x14session_user_changed\x18\v \x01(\v2#.hdlctrl.v1.SessionUserChangedEventH\x00R\x12sessionUserChanged\x12P\n" +
 	"\x11session_lifecycle\x18\f \x01(\v2!.hdlctrl.v1.SessionLifecycleEventH\x00R\x10sessionLifecycle\x12A\n" +
 	"\fhost_updated\x18\x14 \x01(\v2\x1c.hdlctrl.v1.HostUpdatedEventH\x00R\vhostUpdated\x12N\n" +
-	"\x11host_list_changed\x18\x15 \x01(\v2 .hdlctrl.v1.HostListChangedEventH\x00R\x0fhostListChanged\x12D\n" +
+	"\x11host_list_changed\x18\x15 \x01(\v2 .hdlctrl.v1.HostListChangedEventH\x00R\x0fhostListChanged\x12N\n" +
+	"\x11host_auto_restart\x18\x16 \x01(\v2 .hdlctrl.v1.HostAutoRestartEventH\x00R\x0fhostAutoRestart\x12D\n" +
 	"\rjob_completed\x18Z \x01(\v2\x1d.hdlctrl.v1.JobCompletedEventH\x00R\fjobCompletedB\t\n" +
 	"\apayload\"\v\n" +
-	"\tKeepAlive\"M\n" +
+	"\tKeepAlive\"\x17\n" +
+	"\x15HistoryTruncatedEvent\"M\n" +
 	"\x13SessionUpdatedEvent\x12\x1d\n" +
 	"\n" +
 	"session_id\x18\x01 \x01(\tR\tsessionId\x12\x17\n" +
@@ -807,7 +1282,19 @@ const file_hdlctrl_v1_notification_proto_rawDesc = "" +
 	"KIND_ENDED\x10\x02\"+\n" +
 	"\x10HostUpdatedEvent\x12\x17\n" +
 	"\ahost_id\x18\x01 \x01(\tR\x06hostId\"\x16\n" +
-	"\x14HostListChangedEvent\"\xd3\x01\n" +
+	"\x14HostListChangedEvent\"\xc2\x02\n" +
+	"\x14HostAutoRestartEvent\x12\x17\n" +
+	"\ahost_id\x18\x01 \x01(\tR\x06hostId\x129\n" +
+	"\x04kind\x18\x02 \x01(\x0e2%.hdlctrl.v1.HostAutoRestartEvent.KindR\x04kind\x12\x18\n" +
+	"\aattempt\x18\x03 \x01(\x05R\aattempt\x129\n" +
+	"\x05level\x18\x04 \x01(\x0e2#.hdlctrl.v1.JobCompletedEvent.LevelR\x05level\x12\x18\n" +
+	"\amessage\x18\x05 \x01(\tR\amessage\"g\n" +
+	"\x04Kind\x12\x14\n" +
+	"\x10KIND_UNSPECIFIED\x10\x00\x12\x12\n" +
+	"\x0eKIND_SCHEDULED\x10\x01\x12\x12\n" +
+	"\x0eKIND_RESTARTED\x10\x02\x12\x0f\n" +
+	"\vKIND_FAILED\x10\x03\x12\x10\n" +
+	"\fKIND_GAVE_UP\x10\x04\"\xd3\x01\n" +
 	"\x11JobCompletedEvent\x12\x15\n" +
 	"\x06job_id\x18\x01 \x01(\tR\x05jobId\x129\n" +
 	"\x05level\x18\x02 \x01(\x0e2#.hdlctrl.v1.JobCompletedEvent.LevelR\x05level\x12\x18\n" +
@@ -817,9 +1304,26 @@ const file_hdlctrl_v1_notification_proto_rawDesc = "" +
 	"\n" +
 	"LEVEL_INFO\x10\x01\x12\x11\n" +
 	"\rLEVEL_SUCCESS\x10\x02\x12\x0f\n" +
-	"\vLEVEL_ERROR\x10\x032{\n" +
+	"\vLEVEL_ERROR\x10\x03\"h\n" +
+	"\x18ListNotificationsRequest\x12+\n" +
+	"\x04page\x18\x01 \x01(\v2\x17.hdlctrl.v1.PageRequestR\x04page\x12\x1f\n" +
+	"\vunread_only\x18\x02 \x01(\bR\n" +
+	"unreadOnly\"`\n" +
+	"\x15NotificationInboxItem\x123\n" +
+	"\x05event\x18\x01 \x01(\v2\x1d.hdlctrl.v1.NotificationEventR\x05event\x12\x12\n" +
+	"\x04read\x18\x02 \x01(\bR\x04read\"\xa5\x01\n" +
+	"\x19ListNotificationsResponse\x127\n" +
+	"\x05items\x18\x01 \x03(\v2!.hdlctrl.v1.NotificationInboxItemR\x05items\x12,\n" +
+	"\x04page\x18\x02 \x01(\v2\x18.hdlctrl.v1.PageResponseR\x04page\x12!\n" +
+	"\funread_count\x18\x03 \x01(\x05R\vunreadCount\"M\n" +
+	"\x1cMarkNotificationsReadRequest\x12\x1b\n" +
+	"\tevent_ids\x18\x01 \x03(\tR\beventIds\x12\x10\n" +
+	"\x03all\x18\x02 \x01(\bR\x03all\"\x1f\n" +
+	"\x1dMarkNotificationsReadResponse2\xcb\x02\n" +
 	"\x13NotificationService\x12d\n" +
-	"\x16SubscribeNotifications\x12).hdlctrl.v1.SubscribeNotificationsRequest\x1a\x1d.hdlctrl.v1.NotificationEvent0\x01B\xbf\x01\n" +
+	"\x16SubscribeNotifications\x12).hdlctrl.v1.SubscribeNotificationsRequest\x1a\x1d.hdlctrl.v1.NotificationEvent0\x01\x12`\n" +
+	"\x11ListNotifications\x12$.hdlctrl.v1.ListNotificationsRequest\x1a%.hdlctrl.v1.ListNotificationsResponse\x12l\n" +
+	"\x15MarkNotificationsRead\x12(.hdlctrl.v1.MarkNotificationsReadRequest\x1a).hdlctrl.v1.MarkNotificationsReadResponseB\xbf\x01\n" +
 	"\x0ecom.hdlctrl.v1B\x11NotificationProtoP\x01ZQgithub.com/hantabaru1014/baru-reso-headless-controller/pbgen/hdlctrl/v1;hdlctrlv1\xa2\x02\x03HXX\xaa\x02\n" +
 	"Hdlctrl.V1\xca\x02\n" +
 	"Hdlctrl\\V1\xe2\x02\x16Hdlctrl\\V1\\GPBMetadata\xea\x02\vHdlctrl::V1b\x06proto3"
@@ -836,42 +1340,64 @@ func file_hdlctrl_v1_notification_proto_rawDescGZIP() []byte {
 	return file_hdlctrl_v1_notification_proto_rawDescData
 }
 
-var file_hdlctrl_v1_notification_proto_enumTypes = make([]protoimpl.EnumInfo, 3)
-var file_hdlctrl_v1_notification_proto_msgTypes = make([]protoimpl.MessageInfo, 9)
+var file_hdlctrl_v1_notification_proto_enumTypes = make([]protoimpl.EnumInfo, 4)
+var file_hdlctrl_v1_notification_proto_msgTypes = make([]protoimpl.MessageInfo, 16)
 var file_hdlctrl_v1_notification_proto_goTypes = []any{
 	(SessionUserChangedEvent_Kind)(0),     // 0: hdlctrl.v1.SessionUserChangedEvent.Kind
 	(SessionLifecycleEvent_Kind)(0),       // 1: hdlctrl.v1.SessionLifecycleEvent.Kind
-	(JobCompletedEvent_Level)(0),          // 2: hdlctrl.v1.JobCompletedEvent.Level
-	(*SubscribeNotificationsRequest)(nil), // 3: hdlctrl.v1.SubscribeNotificationsRequest
-	(*NotificationEvent)(nil),             // 4: hdlctrl.v1.NotificationEvent
-	(*KeepAlive)(nil),                     // 5: hdlctrl.v1.KeepAlive
-	(*SessionUpdatedEvent)(nil),           // 6: hdlctrl.v1.SessionUpdatedEvent
-	(*SessionUserChangedEvent)(nil),       // 7: hdlctrl.v1.SessionUserChangedEvent
-	(*SessionLifecycleEvent)(nil),         // 8: hdlctrl.v1.SessionLifecycleEvent
-	(*HostUpdatedEvent)(nil),              // 9: hdlctrl.v1.HostUpdatedEvent
-	(*HostListChangedEvent)(nil),          // 10: hdlctrl.v1.HostListChangedEvent
-	(*JobCompletedEvent)(nil),             // 11: hdlctrl.v1.JobCompletedEvent
-	(*timestamppb.Timestamp)(nil),         // 12: google.protobuf.Timestamp
+	(HostAutoRestartEvent_Kind)(0),        // 2: hdlctrl.v1.HostAutoRestartEvent.Kind
+	(JobCompletedEvent_Level)(0),          // 3: hdlctrl.v1.JobCompletedEvent.Level
+	(*SubscribeNotificationsRequest)(nil), // 4: hdlctrl.v1.SubscribeNotificationsRequest
+	(*NotificationEvent)(nil),             // 5: hdlctrl.v1.NotificationEvent
+	(*KeepAlive)(nil),                     // 6: hdlctrl.v1.KeepAlive
+	(*HistoryTruncatedEvent)(nil),         // 7: hdlctrl.v1.HistoryTruncatedEvent
+	(*SessionUpdatedEvent)(nil),           // 8: hdlctrl.v1.SessionUpdatedEvent
+	(*SessionUserChangedEvent)(nil),       // 9: hdlctrl.v1.SessionUserChangedEvent
+	(*SessionLifecycleEvent)(nil),         // 10: hdlctrl.v1.SessionLifecycleEvent
+	(*HostUpdatedEvent)(nil),              // 11: hdlctrl.v1.HostUpdatedEvent
+	(*HostListChangedEvent)(nil),          // 12: hdlctrl.v1.HostListChangedEvent
+	(*HostAutoRestartEvent)(nil),          // 13: hdlctrl.v1.HostAutoRestartEvent
+	(*JobCompletedEvent)(nil),             // 14: hdlctrl.v1.JobCompletedEvent
+	(*ListNotificationsRequest)(nil),      // 15: hdlctrl.v1.ListNotificationsRequest
+	(*NotificationInboxItem)(nil),         // 16: hdlctrl.v1.NotificationInboxItem
+	(*ListNotificationsResponse)(nil),     // 17: hdlctrl.v1.ListNotificationsResponse
+	(*MarkNotificationsReadRequest)(nil),  // 18: hdlctrl.v1.MarkNotificationsReadRequest
+	(*MarkNotificationsReadResponse)(nil), // 19: hdlctrl.v1.MarkNotificationsReadResponse
+	(*timestamppb.Timestamp)(nil),         // 20: google.protobuf.Timestamp
+	(*PageRequest)(nil),                   // 21: hdlctrl.v1.PageRequest
+	(*PageResponse)(nil),                  // 22: hdlctrl.v1.PageResponse
 }
 var file_hdlctrl_v1_notification_proto_depIdxs = []int32{
-	12, // 0: hdlctrl.v1.NotificationEvent.occurred_at:type_name -> google.protobuf.Timestamp
-	5,  // 1: hdlctrl.v1.NotificationEvent.keep_alive:type_name -> hdlctrl.v1.KeepAlive
-	6,  // 2: hdlctrl.v1.NotificationEvent.session_updated:type_name -> hdlctrl.v1.SessionUpdatedEvent
-	7,  // 3: hdlctrl.v1.NotificationEvent.session_user_changed:type_name -> hdlctrl.v1.SessionUserChangedEvent
-	8,  // 4: hdlctrl.v1.NotificationEvent.session_lifecycle:type_name -> hdlctrl.v1.SessionLifecycleEvent
-	9,  // 5: hdlctrl.v1.NotificationEvent.host_updated:type_name -> hdlctrl.v1.HostUpdatedEvent
-	10, // 6: hdlctrl.v1.NotificationEvent.host_list_changed:type_name -> hdlctrl.v1.HostListChangedEvent
-	11, // 7: hdlctrl.v1.NotificationEvent.job_completed:type_name -> hdlctrl.v1.JobCompletedEvent
-	0,  // 8: hdlctrl.v1.SessionUserChangedEvent.kind:type_name -> hdlctrl.v1.SessionUserChangedEvent.Kind
-	1,  // 9: hdlctrl.v1.SessionLifecycleEvent.kind:type_name -> hdlctrl.v1.SessionLifecycleEvent.Kind
-	2,  // 10: hdlctrl.v1.JobCompletedEvent.level:type_name -> hdlctrl.v1.JobCompletedEvent.Level
-	3,  // 11: hdlctrl.v1.NotificationService.SubscribeNotifications:input_type -> hdlctrl.v1.SubscribeNotificationsRequest
-	4,  // 12: hdlctrl.v1.NotificationService.SubscribeNotifications:output_type -> hdlctrl.v1.NotificationEvent
-	12, // [12:13] is the sub-list for method output_type
-	11, // [11:12] is the sub-list for method input_type
-	11, // [11:11] is the sub-list for extension type_name
-	11, // [11:11] is the sub-list for extension extendee
-	0,  // [0:11] is the sub-list for field type_name
+	20, // 0: hdlctrl.v1.NotificationEvent.occurred_at:type_name -> google.protobuf.Timestamp
+	6,  // 1: hdlctrl.v1.NotificationEvent.keep_alive:type_name -> hdlctrl.v1.KeepAlive
+	7,  // 2: hdlctrl.v1.NotificationEvent.history_truncated:type_name -> hdlctrl.v1.HistoryTruncatedEvent
+	8,  // 3: hdlctrl.v1.NotificationEvent.session_updated:type_name -> hdlctrl.v1.SessionUpdatedEvent
+	9,  // 4: hdlctrl.v1.NotificationEvent.session_user_changed:type_name -> hdlctrl.v1.SessionUserChangedEvent
+	10, // 5: hdlctrl.v1.NotificationEvent.session_lifecycle:type_name -> hdlctrl.v1.SessionLifecycleEvent
+	11, // 6: hdlctrl.v1.NotificationEvent.host_updated:type_name -> hdlctrl.v1.HostUpdatedEvent
+	12, // 7: hdlctrl.v1.NotificationEvent.host_list_changed:type_name -> hdlctrl.v1.HostListChangedEvent
+	13, // 8: hdlctrl.v1.NotificationEvent.host_auto_restart:type_name -> hdlctrl.v1.HostAutoRestartEvent
+	14, // 9: hdlctrl.v1.NotificationEvent.job_completed:type_name -> hdlctrl.v1.JobCompletedEvent
+	0,  // 10: hdlctrl.v1.SessionUserChangedEvent.kind:type_name -> hdlctrl.v1.SessionUserChangedEvent.Kind
+	1,  // 11: hdlctrl.v1.SessionLifecycleEvent.kind:type_name -> hdlctrl.v1.SessionLifecycleEvent.Kind
+	2,  // 12: hdlctrl.v1.HostAutoRestartEvent.kind:type_name -> hdlctrl.v1.HostAutoRestartEvent.Kind
+	3,  // 13: hdlctrl.v1.HostAutoRestartEvent.level:type_name -> hdlctrl.v1.JobCompletedEvent.Level
+	3,  // 14: hdlctrl.v1.JobCompletedEvent.level:type_name -> hdlctrl.v1.JobCompletedEvent.Level
+	21, // 15: hdlctrl.v1.ListNotificationsRequest.page:type_name -> hdlctrl.v1.PageRequest
+	5,  // 16: hdlctrl.v1.NotificationInboxItem.event:type_name -> hdlctrl.v1.NotificationEvent
+	16, // 17: hdlctrl.v1.ListNotificationsResponse.items:type_name -> hdlctrl.v1.NotificationInboxItem
+	22, // 18: hdlctrl.v1.ListNotificationsResponse.page:type_name -> hdlctrl.v1.PageResponse
+	4,  // 19: hdlctrl.v1.NotificationService.SubscribeNotifications:input_type -> hdlctrl.v1.SubscribeNotificationsRequest
+	15, // 20: hdlctrl.v1.NotificationService.ListNotifications:input_type -> hdlctrl.v1.ListNotificationsRequest
+	18, // 21: hdlctrl.v1.NotificationService.MarkNotificationsRead:input_type -> hdlctrl.v1.MarkNotificationsReadRequest
+	5,  // 22: hdlctrl.v1.NotificationService.SubscribeNotifications:output_type -> hdlctrl.v1.NotificationEvent
+	17, // 23: hdlctrl.v1.NotificationService.ListNotifications:output_type -> hdlctrl.v1.ListNotificationsResponse
+	19, // 24: hdlctrl.v1.NotificationService.MarkNotificationsRead:output_type -> hdlctrl.v1.MarkNotificationsReadResponse
+	22, // [22:25] is the sub-list for method output_type
+	19, // [19:22] is the sub-list for method input_type
+	19, // [19:19] is the sub-list for extension type_name
+	19, // [19:19] is the sub-list for extension extendee
+	0,  // [0:19] is the sub-list for field type_name
 }
 
 func init() { file_hdlctrl_v1_notification_proto_init() }
@@ -879,13 +1405,17 @@ func file_hdlctrl_v1_notification_proto_init() {
 	if File_hdlctrl_v1_notification_proto != nil {
 		return
 	}
+	file_hdlctrl_v1_controller_proto_init()
+	file_hdlctrl_v1_notification_proto_msgTypes[0].OneofWrappers = []any{}
 	file_hdlctrl_v1_notification_proto_msgTypes[1].OneofWrappers = []any{
 		(*NotificationEvent_KeepAlive)(nil),
+		(*NotificationEvent_HistoryTruncated)(nil),
 		(*NotificationEvent_SessionUpdated)(nil),
 		(*NotificationEvent_SessionUserChanged)(nil),
 		(*NotificationEvent_SessionLifecycle)(nil),
 		(*NotificationEvent_HostUpdated)(nil),
 		(*NotificationEvent_HostListChanged)(nil),
+		(*NotificationEvent_HostAutoRestart)(nil),
 		(*NotificationEvent_JobCompleted)(nil),
 	}
 	type x struct{}
@@ -893,8 +1423,8 @@ func file_hdlctrl_v1_notification_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_hdlctrl_v1_notification_proto_rawDesc), len(file_hdlctrl_v1_notification_proto_rawDesc)),
-			NumEnums:      3,
-			NumMessages:   9,
+			NumEnums:      4,
+			NumMessages:   16,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
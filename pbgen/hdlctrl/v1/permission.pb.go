@@ -131,14 +131,16 @@ func (RoleScope) EnumDescriptor() ([]byte, []int) {
 }
 
 type Group struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	Type          GroupType              `protobuf:"varint,3,opt,name=type,proto3,enum=hdlctrl.v1.GroupType" json:"type,omitempty"`
-	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	Id        string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name      string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Type      GroupType              `protobuf:"varint,3,opt,name=type,proto3,enum=hdlctrl.v1.GroupType" json:"type,omitempty"`
+	CreatedAt *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	// ホストのログを DB に残す日数. 未設定ならサーバー全体の設定に従う
+	LogRetentionDays *int32 `protobuf:"varint,6,opt,name=log_retention_days,json=logRetentionDays,proto3,oneof" json:"log_retention_days,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
 }
 
 func (x *Group) Reset() {
@@ -206,6 +208,13 @@ func (x *Group) GetUpdatedAt() *timestamppb.Timestamp {
 	return nil
 }
 
+func (x *Group) GetLogRetentionDays() int32 {
+	if x != nil && x.LogRetentionDays != nil {
+		return *x.LogRetentionDays
+	}
+	return 0
+}
+
 type GroupMember struct {
 	state   protoimpl.MessageState `protogen:"open.v1"`
 	GroupId string                 `protobuf:"bytes,1,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
@@ -869,11 +878,13 @@ func (x *ListGroupsResponse) GetGroups() []*Group {
 }
 
 type UpdateGroupRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	GroupId       string                 `protobuf:"bytes,1,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
-	Name          *string                `protobuf:"bytes,2,opt,name=name,proto3,oneof" json:"name,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	GroupId string                 `protobuf:"bytes,1,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
+	Name    *string                `protobuf:"bytes,2,opt,name=name,proto3,oneof" json:"name,omitempty"`
+	// 0 を指定すると設定を解除し、サーバー全体の設定に従う
+	LogRetentionDays *int32 `protobuf:"varint,3,opt,name=log_retention_days,json=logRetentionDays,proto3,oneof" json:"log_retention_days,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
 }
 
 func (x *UpdateGroupRequest) Reset() {
@@ -920,6 +931,13 @@ func (x *UpdateGroupRequest) GetName() string {
 	return ""
 }
 
+func (x *UpdateGroupRequest) GetLogRetentionDays() int32 {
+	if x != nil && x.LogRetentionDays != nil {
+		return *x.LogRetentionDays
+	}
+	return 0
+}
+
 type UpdateGroupResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Group         *Group                 `protobuf:"bytes,1,opt,name=group,proto3" json:"group,omitempty"`
@@ -1991,7 +2009,7 @@ var File_hdlctrl_v1_permission_proto protoreflect.FileDescriptor
 const file_hdlctrl_v1_permission_proto_rawDesc = "" +
 	"\n" +
 	"\x1bhdlctrl/v1/permission.proto\x12\n" +
-	"hdlctrl.v1\x1a\x1fgoogle/protobuf/timestamp.proto\"\xcc\x01\n" +
+	"hdlctrl.v1\x1a\x1fgoogle/protobuf/timestamp.proto\"\x96\x02\n" +
 	"\x05Group\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
 	"\x04name\x18\x02 \x01(\tR\x04name\x12)\n" +
@@ -1999,7 +2017,9 @@ const file_hdlctrl_v1_permission_proto_rawDesc = "" +
 	"\n" +
 	"created_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
 	"\n" +
-	"updated_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"\xc0\x01\n" +
+	"updated_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x121\n" +
+	"\x12log_retention_days\x18\x06 \x01(\x05H\x00R\x10logRetentionDays\x88\x01\x01B\x15\n" +
+	"\x13_log_retention_days\"\xc0\x01\n" +
 	"\vGroupMember\x12\x19\n" +
 	"\bgroup_id\x18\x01 \x01(\tR\agroupId\x12\x17\n" +
 	"\auser_id\x18\x02 \x01(\tR\x06userId\x12\x17\n" +
@@ -2043,11 +2063,13 @@ const file_hdlctrl_v1_permission_proto_rawDesc = "" +
 	"\x05group\x18\x01 \x01(\v2\x11.hdlctrl.v1.GroupR\x05group\"\x13\n" +
 	"\x11ListGroupsRequest\"?\n" +
 	"\x12ListGroupsResponse\x12)\n" +
-	"\x06groups\x18\x01 \x03(\v2\x11.hdlctrl.v1.GroupR\x06groups\"Q\n" +
+	"\x06groups\x18\x01 \x03(\v2\x11.hdlctrl.v1.GroupR\x06groups\"\x9b\x01\n" +
 	"\x12UpdateGroupRequest\x12\x19\n" +
 	"\bgroup_id\x18\x01 \x01(\tR\agroupId\x12\x17\n" +
-	"\x04name\x18\x02 \x01(\tH\x00R\x04name\x88\x01\x01B\a\n" +
-	"\x05_name\">\n" +
+	"\x04name\x18\x02 \x01(\tH\x00R\x04name\x88\x01\x01\x121\n" +
+	"\x12log_retention_days\x18\x03 \x01(\x05H\x01R\x10logRetentionDays\x88\x01\x01B\a\n" +
+	"\x05_nameB\x15\n" +
+	"\x13_log_retention_days\">\n" +
 	"\x13UpdateGroupResponse\x12'\n" +
 	"\x05group\x18\x01 \x01(\v2\x11.hdlctrl.v1.GroupR\x05group\"/\n" +
 	"\x12DeleteGroupRequest\x12\x19\n" +
@@ -2261,6 +2283,7 @@ func file_hdlctrl_v1_permission_proto_init() {
 	if File_hdlctrl_v1_permission_proto != nil {
 		return
 	}
+	file_hdlctrl_v1_permission_proto_msgTypes[0].OneofWrappers = []any{}
 	file_hdlctrl_v1_permission_proto_msgTypes[1].OneofWrappers = []any{}
 	file_hdlctrl_v1_permission_proto_msgTypes[2].OneofWrappers = []any{}
 	file_hdlctrl_v1_permission_proto_msgTypes[13].OneofWrappers = []any{}
@@ -23,11 +23,16 @@ const (
 )
 
 type TokenSetResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Token         string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
-	RefreshToken  string                 `protobuf:"bytes,2,opt,name=refresh_token,json=refreshToken,proto3" json:"refresh_token,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state        protoimpl.MessageState `protogen:"open.v1"`
+	Token        string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	RefreshToken string                 `protobuf:"bytes,2,opt,name=refresh_token,json=refreshToken,proto3" json:"refresh_token,omitempty"`
+	// 2 段階認証が必要なときだけ、token / refresh_token の代わりにセットされる.
+	// VerifyTotpLogin に渡してサインインを完了する
+	TotpChallenge string `protobuf:"bytes,3,opt,name=totp_challenge,json=totpChallenge,proto3" json:"totp_challenge,omitempty"`
+	// true なら TOTP が未登録で、challenge を使って先に登録する必要がある
+	TotpEnrollmentRequired bool `protobuf:"varint,4,opt,name=totp_enrollment_required,json=totpEnrollmentRequired,proto3" json:"totp_enrollment_required,omitempty"`
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
 }
 
 func (x *TokenSetResponse) Reset() {
@@ -74,6 +79,20 @@ func (x *TokenSetResponse) GetRefreshToken() string {
 	return ""
 }
 
+func (x *TokenSetResponse) GetTotpChallenge() string {
+	if x != nil {
+		return x.TotpChallenge
+	}
+	return ""
+}
+
+func (x *TokenSetResponse) GetTotpEnrollmentRequired() bool {
+	if x != nil {
+		return x.TotpEnrollmentRequired
+	}
+	return false
+}
+
 type GetTokenByPasswordRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
@@ -126,27 +145,26 @@ func (x *GetTokenByPasswordRequest) GetPassword() string {
 	return ""
 }
 
-// 既に持っているトークンをheaderに付与してリクエストする
-type RefreshTokenRequest struct {
+type GetLoginOptionsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *RefreshTokenRequest) Reset() {
-	*x = RefreshTokenRequest{}
+func (x *GetLoginOptionsRequest) Reset() {
+	*x = GetLoginOptionsRequest{}
 	mi := &file_hdlctrl_v1_user_proto_msgTypes[2]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *RefreshTokenRequest) String() string {
+func (x *GetLoginOptionsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RefreshTokenRequest) ProtoMessage() {}
+func (*GetLoginOptionsRequest) ProtoMessage() {}
 
-func (x *RefreshTokenRequest) ProtoReflect() protoreflect.Message {
+func (x *GetLoginOptionsRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_hdlctrl_v1_user_proto_msgTypes[2]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -158,32 +176,34 @@ func (x *RefreshTokenRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RefreshTokenRequest.ProtoReflect.Descriptor instead.
-func (*RefreshTokenRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetLoginOptionsRequest.ProtoReflect.Descriptor instead.
+func (*GetLoginOptionsRequest) Descriptor() ([]byte, []int) {
 	return file_hdlctrl_v1_user_proto_rawDescGZIP(), []int{2}
 }
 
-type ValidateRegistrationTokenRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Token         string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+type GetLoginOptionsResponse struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	OidcEnabled bool                   `protobuf:"varint,1,opt,name=oidc_enabled,json=oidcEnabled,proto3" json:"oidc_enabled,omitempty"`
+	// サインインボタンに出す IdP の名前
+	OidcProviderName string `protobuf:"bytes,2,opt,name=oidc_provider_name,json=oidcProviderName,proto3" json:"oidc_provider_name,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
 }
 
-func (x *ValidateRegistrationTokenRequest) Reset() {
-	*x = ValidateRegistrationTokenRequest{}
+func (x *GetLoginOptionsResponse) Reset() {
+	*x = GetLoginOptionsResponse{}
 	mi := &file_hdlctrl_v1_user_proto_msgTypes[3]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ValidateRegistrationTokenRequest) String() string {
+func (x *GetLoginOptionsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ValidateRegistrationTokenRequest) ProtoMessage() {}
+func (*GetLoginOptionsResponse) ProtoMessage() {}
 
-func (x *ValidateRegistrationTokenRequest) ProtoReflect() protoreflect.Message {
+func (x *GetLoginOptionsResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_hdlctrl_v1_user_proto_msgTypes[3]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -195,42 +215,45 @@ func (x *ValidateRegistrationTokenRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ValidateRegistrationTokenRequest.ProtoReflect.Descriptor instead.
-func (*ValidateRegistrationTokenRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetLoginOptionsResponse.ProtoReflect.Descriptor instead.
+func (*GetLoginOptionsResponse) Descriptor() ([]byte, []int) {
 	return file_hdlctrl_v1_user_proto_rawDescGZIP(), []int{3}
 }
 
-func (x *ValidateRegistrationTokenRequest) GetToken() string {
+func (x *GetLoginOptionsResponse) GetOidcEnabled() bool {
 	if x != nil {
-		return x.Token
+		return x.OidcEnabled
+	}
+	return false
+}
+
+func (x *GetLoginOptionsResponse) GetOidcProviderName() string {
+	if x != nil {
+		return x.OidcProviderName
 	}
 	return ""
 }
 
-type ValidateRegistrationTokenResponse struct {
-	state            protoimpl.MessageState `protogen:"open.v1"`
-	Valid            bool                   `protobuf:"varint,1,opt,name=valid,proto3" json:"valid,omitempty"`
-	ResoniteId       string                 `protobuf:"bytes,2,opt,name=resonite_id,json=resoniteId,proto3" json:"resonite_id,omitempty"`
-	ResoniteUserName string                 `protobuf:"bytes,3,opt,name=resonite_user_name,json=resoniteUserName,proto3" json:"resonite_user_name,omitempty"`
-	IconUrl          string                 `protobuf:"bytes,4,opt,name=icon_url,json=iconUrl,proto3" json:"icon_url,omitempty"`
-	unknownFields    protoimpl.UnknownFields
-	sizeCache        protoimpl.SizeCache
+type BeginOidcLoginRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ValidateRegistrationTokenResponse) Reset() {
-	*x = ValidateRegistrationTokenResponse{}
+func (x *BeginOidcLoginRequest) Reset() {
+	*x = BeginOidcLoginRequest{}
 	mi := &file_hdlctrl_v1_user_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ValidateRegistrationTokenResponse) String() string {
+func (x *BeginOidcLoginRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ValidateRegistrationTokenResponse) ProtoMessage() {}
+func (*BeginOidcLoginRequest) ProtoMessage() {}
 
-func (x *ValidateRegistrationTokenResponse) ProtoReflect() protoreflect.Message {
+func (x *BeginOidcLoginRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_hdlctrl_v1_user_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -242,62 +265,34 @@ func (x *ValidateRegistrationTokenResponse) ProtoReflect() protoreflect.Message
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ValidateRegistrationTokenResponse.ProtoReflect.Descriptor instead.
-func (*ValidateRegistrationTokenResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use BeginOidcLoginRequest.ProtoReflect.Descriptor instead.
+func (*BeginOidcLoginRequest) Descriptor() ([]byte, []int) {
 	return file_hdlctrl_v1_user_proto_rawDescGZIP(), []int{4}
 }
 
-func (x *ValidateRegistrationTokenResponse) GetValid() bool {
-	if x != nil {
-		return x.Valid
-	}
-	return false
-}
-
-func (x *ValidateRegistrationTokenResponse) GetResoniteId() string {
-	if x != nil {
-		return x.ResoniteId
-	}
-	return ""
-}
-
-func (x *ValidateRegistrationTokenResponse) GetResoniteUserName() string {
-	if x != nil {
-		return x.ResoniteUserName
-	}
-	return ""
-}
-
-func (x *ValidateRegistrationTokenResponse) GetIconUrl() string {
-	if x != nil {
-		return x.IconUrl
-	}
-	return ""
-}
-
-type RegisterWithTokenRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Token         string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
-	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	Password      string                 `protobuf:"bytes,3,opt,name=password,proto3" json:"password,omitempty"`
+type BeginOidcLoginResponse struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	AuthorizationUrl string                 `protobuf:"bytes,1,opt,name=authorization_url,json=authorizationUrl,proto3" json:"authorization_url,omitempty"`
+	// コールバックで戻ってきた state がこれと一致することをクライアントで確認する
+	State         string `protobuf:"bytes,2,opt,name=state,proto3" json:"state,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *RegisterWithTokenRequest) Reset() {
-	*x = RegisterWithTokenRequest{}
+func (x *BeginOidcLoginResponse) Reset() {
+	*x = BeginOidcLoginResponse{}
 	mi := &file_hdlctrl_v1_user_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *RegisterWithTokenRequest) String() string {
+func (x *BeginOidcLoginResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RegisterWithTokenRequest) ProtoMessage() {}
+func (*BeginOidcLoginResponse) ProtoMessage() {}
 
-func (x *RegisterWithTokenRequest) ProtoReflect() protoreflect.Message {
+func (x *BeginOidcLoginResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_hdlctrl_v1_user_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -309,54 +304,47 @@ func (x *RegisterWithTokenRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RegisterWithTokenRequest.ProtoReflect.Descriptor instead.
-func (*RegisterWithTokenRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use BeginOidcLoginResponse.ProtoReflect.Descriptor instead.
+func (*BeginOidcLoginResponse) Descriptor() ([]byte, []int) {
 	return file_hdlctrl_v1_user_proto_rawDescGZIP(), []int{5}
 }
 
-func (x *RegisterWithTokenRequest) GetToken() string {
-	if x != nil {
-		return x.Token
-	}
-	return ""
-}
-
-func (x *RegisterWithTokenRequest) GetUserId() string {
+func (x *BeginOidcLoginResponse) GetAuthorizationUrl() string {
 	if x != nil {
-		return x.UserId
+		return x.AuthorizationUrl
 	}
 	return ""
 }
 
-func (x *RegisterWithTokenRequest) GetPassword() string {
+func (x *BeginOidcLoginResponse) GetState() string {
 	if x != nil {
-		return x.Password
+		return x.State
 	}
 	return ""
 }
 
-type ChangePasswordRequest struct {
-	state           protoimpl.MessageState `protogen:"open.v1"`
-	CurrentPassword string                 `protobuf:"bytes,1,opt,name=current_password,json=currentPassword,proto3" json:"current_password,omitempty"`
-	NewPassword     string                 `protobuf:"bytes,2,opt,name=new_password,json=newPassword,proto3" json:"new_password,omitempty"`
-	unknownFields   protoimpl.UnknownFields
-	sizeCache       protoimpl.SizeCache
+type CompleteOidcLoginRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Code          string                 `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`
+	State         string                 `protobuf:"bytes,2,opt,name=state,proto3" json:"state,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ChangePasswordRequest) Reset() {
-	*x = ChangePasswordRequest{}
+func (x *CompleteOidcLoginRequest) Reset() {
+	*x = CompleteOidcLoginRequest{}
 	mi := &file_hdlctrl_v1_user_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ChangePasswordRequest) String() string {
+func (x *CompleteOidcLoginRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ChangePasswordRequest) ProtoMessage() {}
+func (*CompleteOidcLoginRequest) ProtoMessage() {}
 
-func (x *ChangePasswordRequest) ProtoReflect() protoreflect.Message {
+func (x *CompleteOidcLoginRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_hdlctrl_v1_user_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -368,45 +356,45 @@ func (x *ChangePasswordRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ChangePasswordRequest.ProtoReflect.Descriptor instead.
-func (*ChangePasswordRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use CompleteOidcLoginRequest.ProtoReflect.Descriptor instead.
+func (*CompleteOidcLoginRequest) Descriptor() ([]byte, []int) {
 	return file_hdlctrl_v1_user_proto_rawDescGZIP(), []int{6}
 }
 
-func (x *ChangePasswordRequest) GetCurrentPassword() string {
+func (x *CompleteOidcLoginRequest) GetCode() string {
 	if x != nil {
-		return x.CurrentPassword
+		return x.Code
 	}
 	return ""
 }
 
-func (x *ChangePasswordRequest) GetNewPassword() string {
+func (x *CompleteOidcLoginRequest) GetState() string {
 	if x != nil {
-		return x.NewPassword
+		return x.State
 	}
 	return ""
 }
 
-type ChangePasswordResponse struct {
+type BeginOidcLinkRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ChangePasswordResponse) Reset() {
-	*x = ChangePasswordResponse{}
+func (x *BeginOidcLinkRequest) Reset() {
+	*x = BeginOidcLinkRequest{}
 	mi := &file_hdlctrl_v1_user_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ChangePasswordResponse) String() string {
+func (x *BeginOidcLinkRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ChangePasswordResponse) ProtoMessage() {}
+func (*BeginOidcLinkRequest) ProtoMessage() {}
 
-func (x *ChangePasswordResponse) ProtoReflect() protoreflect.Message {
+func (x *BeginOidcLinkRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_hdlctrl_v1_user_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -418,37 +406,33 @@ func (x *ChangePasswordResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ChangePasswordResponse.ProtoReflect.Descriptor instead.
-func (*ChangePasswordResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use BeginOidcLinkRequest.ProtoReflect.Descriptor instead.
+func (*BeginOidcLinkRequest) Descriptor() ([]byte, []int) {
 	return file_hdlctrl_v1_user_proto_rawDescGZIP(), []int{7}
 }
 
-// システム上のユーザーアカウント.
-type User struct {
+type CompleteOidcLinkRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	ResoniteId    string                 `protobuf:"bytes,2,opt,name=resonite_id,json=resoniteId,proto3" json:"resonite_id,omitempty"`
-	IconUrl       string                 `protobuf:"bytes,3,opt,name=icon_url,json=iconUrl,proto3" json:"icon_url,omitempty"`
-	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	Code          string                 `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`
+	State         string                 `protobuf:"bytes,2,opt,name=state,proto3" json:"state,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *User) Reset() {
-	*x = User{}
+func (x *CompleteOidcLinkRequest) Reset() {
+	*x = CompleteOidcLinkRequest{}
 	mi := &file_hdlctrl_v1_user_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *User) String() string {
+func (x *CompleteOidcLinkRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*User) ProtoMessage() {}
+func (*CompleteOidcLinkRequest) ProtoMessage() {}
 
-func (x *User) ProtoReflect() protoreflect.Message {
+func (x *CompleteOidcLinkRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_hdlctrl_v1_user_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -460,66 +444,45 @@ func (x *User) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use User.ProtoReflect.Descriptor instead.
-func (*User) Descriptor() ([]byte, []int) {
+// Deprecated: Use CompleteOidcLinkRequest.ProtoReflect.Descriptor instead.
+func (*CompleteOidcLinkRequest) Descriptor() ([]byte, []int) {
 	return file_hdlctrl_v1_user_proto_rawDescGZIP(), []int{8}
 }
 
-func (x *User) GetId() string {
-	if x != nil {
-		return x.Id
-	}
-	return ""
-}
-
-func (x *User) GetResoniteId() string {
+func (x *CompleteOidcLinkRequest) GetCode() string {
 	if x != nil {
-		return x.ResoniteId
+		return x.Code
 	}
 	return ""
 }
 
-func (x *User) GetIconUrl() string {
+func (x *CompleteOidcLinkRequest) GetState() string {
 	if x != nil {
-		return x.IconUrl
+		return x.State
 	}
 	return ""
 }
 
-func (x *User) GetCreatedAt() *timestamppb.Timestamp {
-	if x != nil {
-		return x.CreatedAt
-	}
-	return nil
-}
-
-func (x *User) GetUpdatedAt() *timestamppb.Timestamp {
-	if x != nil {
-		return x.UpdatedAt
-	}
-	return nil
-}
-
-type ListUsersRequest struct {
+type CompleteOidcLinkResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListUsersRequest) Reset() {
-	*x = ListUsersRequest{}
+func (x *CompleteOidcLinkResponse) Reset() {
+	*x = CompleteOidcLinkResponse{}
 	mi := &file_hdlctrl_v1_user_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListUsersRequest) String() string {
+func (x *CompleteOidcLinkResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListUsersRequest) ProtoMessage() {}
+func (*CompleteOidcLinkResponse) ProtoMessage() {}
 
-func (x *ListUsersRequest) ProtoReflect() protoreflect.Message {
+func (x *CompleteOidcLinkResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_hdlctrl_v1_user_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -531,32 +494,34 @@ func (x *ListUsersRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListUsersRequest.ProtoReflect.Descriptor instead.
-func (*ListUsersRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use CompleteOidcLinkResponse.ProtoReflect.Descriptor instead.
+func (*CompleteOidcLinkResponse) Descriptor() ([]byte, []int) {
 	return file_hdlctrl_v1_user_proto_rawDescGZIP(), []int{9}
 }
 
-type ListUsersResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Users         []*User                `protobuf:"bytes,1,rep,name=users,proto3" json:"users,omitempty"`
+type VerifyTotpLoginRequest struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	Challenge string                 `protobuf:"bytes,1,opt,name=challenge,proto3" json:"challenge,omitempty"`
+	// 6 桁のコードかリカバリーコード
+	Code          string `protobuf:"bytes,2,opt,name=code,proto3" json:"code,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListUsersResponse) Reset() {
-	*x = ListUsersResponse{}
+func (x *VerifyTotpLoginRequest) Reset() {
+	*x = VerifyTotpLoginRequest{}
 	mi := &file_hdlctrl_v1_user_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListUsersResponse) String() string {
+func (x *VerifyTotpLoginRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListUsersResponse) ProtoMessage() {}
+func (*VerifyTotpLoginRequest) ProtoMessage() {}
 
-func (x *ListUsersResponse) ProtoReflect() protoreflect.Message {
+func (x *VerifyTotpLoginRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_hdlctrl_v1_user_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -568,39 +533,46 @@ func (x *ListUsersResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListUsersResponse.ProtoReflect.Descriptor instead.
-func (*ListUsersResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use VerifyTotpLoginRequest.ProtoReflect.Descriptor instead.
+func (*VerifyTotpLoginRequest) Descriptor() ([]byte, []int) {
 	return file_hdlctrl_v1_user_proto_rawDescGZIP(), []int{10}
 }
 
-func (x *ListUsersResponse) GetUsers() []*User {
+func (x *VerifyTotpLoginRequest) GetChallenge() string {
 	if x != nil {
-		return x.Users
+		return x.Challenge
 	}
-	return nil
+	return ""
 }
 
-type GetUserRequest struct {
+func (x *VerifyTotpLoginRequest) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+// 既に持っているトークンをheaderに付与してリクエストする
+type RefreshTokenRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetUserRequest) Reset() {
-	*x = GetUserRequest{}
+func (x *RefreshTokenRequest) Reset() {
+	*x = RefreshTokenRequest{}
 	mi := &file_hdlctrl_v1_user_proto_msgTypes[11]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetUserRequest) String() string {
+func (x *RefreshTokenRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetUserRequest) ProtoMessage() {}
+func (*RefreshTokenRequest) ProtoMessage() {}
 
-func (x *GetUserRequest) ProtoReflect() protoreflect.Message {
+func (x *RefreshTokenRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_hdlctrl_v1_user_proto_msgTypes[11]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -612,39 +584,32 @@ func (x *GetUserRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetUserRequest.ProtoReflect.Descriptor instead.
-func (*GetUserRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use RefreshTokenRequest.ProtoReflect.Descriptor instead.
+func (*RefreshTokenRequest) Descriptor() ([]byte, []int) {
 	return file_hdlctrl_v1_user_proto_rawDescGZIP(), []int{11}
 }
 
-func (x *GetUserRequest) GetUserId() string {
-	if x != nil {
-		return x.UserId
-	}
-	return ""
-}
-
-type GetUserResponse struct {
+type ValidateRegistrationTokenRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	User          *User                  `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
+	Token         string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetUserResponse) Reset() {
-	*x = GetUserResponse{}
+func (x *ValidateRegistrationTokenRequest) Reset() {
+	*x = ValidateRegistrationTokenRequest{}
 	mi := &file_hdlctrl_v1_user_proto_msgTypes[12]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetUserResponse) String() string {
+func (x *ValidateRegistrationTokenRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetUserResponse) ProtoMessage() {}
+func (*ValidateRegistrationTokenRequest) ProtoMessage() {}
 
-func (x *GetUserResponse) ProtoReflect() protoreflect.Message {
+func (x *ValidateRegistrationTokenRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_hdlctrl_v1_user_proto_msgTypes[12]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -656,43 +621,1296 @@ func (x *GetUserResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetUserResponse.ProtoReflect.Descriptor instead.
-func (*GetUserResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use ValidateRegistrationTokenRequest.ProtoReflect.Descriptor instead.
+func (*ValidateRegistrationTokenRequest) Descriptor() ([]byte, []int) {
 	return file_hdlctrl_v1_user_proto_rawDescGZIP(), []int{12}
 }
 
-func (x *GetUserResponse) GetUser() *User {
+func (x *ValidateRegistrationTokenRequest) GetToken() string {
 	if x != nil {
-		return x.User
+		return x.Token
 	}
-	return nil
+	return ""
+}
+
+type ValidateRegistrationTokenResponse struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Valid            bool                   `protobuf:"varint,1,opt,name=valid,proto3" json:"valid,omitempty"`
+	ResoniteId       string                 `protobuf:"bytes,2,opt,name=resonite_id,json=resoniteId,proto3" json:"resonite_id,omitempty"`
+	ResoniteUserName string                 `protobuf:"bytes,3,opt,name=resonite_user_name,json=resoniteUserName,proto3" json:"resonite_user_name,omitempty"`
+	IconUrl          string                 `protobuf:"bytes,4,opt,name=icon_url,json=iconUrl,proto3" json:"icon_url,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *ValidateRegistrationTokenResponse) Reset() {
+	*x = ValidateRegistrationTokenResponse{}
+	mi := &file_hdlctrl_v1_user_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ValidateRegistrationTokenResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ValidateRegistrationTokenResponse) ProtoMessage() {}
+
+func (x *ValidateRegistrationTokenResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_hdlctrl_v1_user_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ValidateRegistrationTokenResponse.ProtoReflect.Descriptor instead.
+func (*ValidateRegistrationTokenResponse) Descriptor() ([]byte, []int) {
+	return file_hdlctrl_v1_user_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *ValidateRegistrationTokenResponse) GetValid() bool {
+	if x != nil {
+		return x.Valid
+	}
+	return false
+}
+
+func (x *ValidateRegistrationTokenResponse) GetResoniteId() string {
+	if x != nil {
+		return x.ResoniteId
+	}
+	return ""
+}
+
+func (x *ValidateRegistrationTokenResponse) GetResoniteUserName() string {
+	if x != nil {
+		return x.ResoniteUserName
+	}
+	return ""
+}
+
+func (x *ValidateRegistrationTokenResponse) GetIconUrl() string {
+	if x != nil {
+		return x.IconUrl
+	}
+	return ""
+}
+
+type RegisterWithTokenRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Token         string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Password      string                 `protobuf:"bytes,3,opt,name=password,proto3" json:"password,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RegisterWithTokenRequest) Reset() {
+	*x = RegisterWithTokenRequest{}
+	mi := &file_hdlctrl_v1_user_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RegisterWithTokenRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RegisterWithTokenRequest) ProtoMessage() {}
+
+func (x *RegisterWithTokenRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_hdlctrl_v1_user_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RegisterWithTokenRequest.ProtoReflect.Descriptor instead.
+func (*RegisterWithTokenRequest) Descriptor() ([]byte, []int) {
+	return file_hdlctrl_v1_user_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *RegisterWithTokenRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *RegisterWithTokenRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *RegisterWithTokenRequest) GetPassword() string {
+	if x != nil {
+		return x.Password
+	}
+	return ""
+}
+
+type ChangePasswordRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	CurrentPassword string                 `protobuf:"bytes,1,opt,name=current_password,json=currentPassword,proto3" json:"current_password,omitempty"`
+	NewPassword     string                 `protobuf:"bytes,2,opt,name=new_password,json=newPassword,proto3" json:"new_password,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *ChangePasswordRequest) Reset() {
+	*x = ChangePasswordRequest{}
+	mi := &file_hdlctrl_v1_user_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ChangePasswordRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChangePasswordRequest) ProtoMessage() {}
+
+func (x *ChangePasswordRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_hdlctrl_v1_user_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChangePasswordRequest.ProtoReflect.Descriptor instead.
+func (*ChangePasswordRequest) Descriptor() ([]byte, []int) {
+	return file_hdlctrl_v1_user_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *ChangePasswordRequest) GetCurrentPassword() string {
+	if x != nil {
+		return x.CurrentPassword
+	}
+	return ""
+}
+
+func (x *ChangePasswordRequest) GetNewPassword() string {
+	if x != nil {
+		return x.NewPassword
+	}
+	return ""
+}
+
+type ChangePasswordResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ChangePasswordResponse) Reset() {
+	*x = ChangePasswordResponse{}
+	mi := &file_hdlctrl_v1_user_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ChangePasswordResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChangePasswordResponse) ProtoMessage() {}
+
+func (x *ChangePasswordResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_hdlctrl_v1_user_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChangePasswordResponse.ProtoReflect.Descriptor instead.
+func (*ChangePasswordResponse) Descriptor() ([]byte, []int) {
+	return file_hdlctrl_v1_user_proto_rawDescGZIP(), []int{16}
+}
+
+// システム上のユーザーアカウント.
+type User struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	ResoniteId    string                 `protobuf:"bytes,2,opt,name=resonite_id,json=resoniteId,proto3" json:"resonite_id,omitempty"`
+	IconUrl       string                 `protobuf:"bytes,3,opt,name=icon_url,json=iconUrl,proto3" json:"icon_url,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *User) Reset() {
+	*x = User{}
+	mi := &file_hdlctrl_v1_user_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *User) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*User) ProtoMessage() {}
+
+func (x *User) ProtoReflect() protoreflect.Message {
+	mi := &file_hdlctrl_v1_user_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use User.ProtoReflect.Descriptor instead.
+func (*User) Descriptor() ([]byte, []int) {
+	return file_hdlctrl_v1_user_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *User) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *User) GetResoniteId() string {
+	if x != nil {
+		return x.ResoniteId
+	}
+	return ""
+}
+
+func (x *User) GetIconUrl() string {
+	if x != nil {
+		return x.IconUrl
+	}
+	return ""
+}
+
+func (x *User) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *User) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+type ListUsersRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListUsersRequest) Reset() {
+	*x = ListUsersRequest{}
+	mi := &file_hdlctrl_v1_user_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListUsersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListUsersRequest) ProtoMessage() {}
+
+func (x *ListUsersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_hdlctrl_v1_user_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListUsersRequest.ProtoReflect.Descriptor instead.
+func (*ListUsersRequest) Descriptor() ([]byte, []int) {
+	return file_hdlctrl_v1_user_proto_rawDescGZIP(), []int{18}
+}
+
+type ListUsersResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Users         []*User                `protobuf:"bytes,1,rep,name=users,proto3" json:"users,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListUsersResponse) Reset() {
+	*x = ListUsersResponse{}
+	mi := &file_hdlctrl_v1_user_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListUsersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListUsersResponse) ProtoMessage() {}
+
+func (x *ListUsersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_hdlctrl_v1_user_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListUsersResponse.ProtoReflect.Descriptor instead.
+func (*ListUsersResponse) Descriptor() ([]byte, []int) {
+	return file_hdlctrl_v1_user_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *ListUsersResponse) GetUsers() []*User {
+	if x != nil {
+		return x.Users
+	}
+	return nil
+}
+
+type GetUserRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetUserRequest) Reset() {
+	*x = GetUserRequest{}
+	mi := &file_hdlctrl_v1_user_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetUserRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUserRequest) ProtoMessage() {}
+
+func (x *GetUserRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_hdlctrl_v1_user_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUserRequest.ProtoReflect.Descriptor instead.
+func (*GetUserRequest) Descriptor() ([]byte, []int) {
+	return file_hdlctrl_v1_user_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *GetUserRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type GetUserResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	User          *User                  `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetUserResponse) Reset() {
+	*x = GetUserResponse{}
+	mi := &file_hdlctrl_v1_user_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetUserResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUserResponse) ProtoMessage() {}
+
+func (x *GetUserResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_hdlctrl_v1_user_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUserResponse.ProtoReflect.Descriptor instead.
+func (*GetUserResponse) Descriptor() ([]byte, []int) {
+	return file_hdlctrl_v1_user_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *GetUserResponse) GetUser() *User {
+	if x != nil {
+		return x.User
+	}
+	return nil
+}
+
+type CreateRegistrationTokenRequest struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	ResoniteId string                 `protobuf:"bytes,1,opt,name=resonite_id,json=resoniteId,proto3" json:"resonite_id,omitempty"`
+	// 個人グループに付与するロールID. 未指定なら seed-admin が付与される.
+	// トークンと一緒に DB に保存され、RegisterWithToken 時に lookup される.
+	PersonalRoleId *string `protobuf:"bytes,2,opt,name=personal_role_id,json=personalRoleId,proto3,oneof" json:"personal_role_id,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *CreateRegistrationTokenRequest) Reset() {
+	*x = CreateRegistrationTokenRequest{}
+	mi := &file_hdlctrl_v1_user_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateRegistrationTokenRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateRegistrationTokenRequest) ProtoMessage() {}
+
+func (x *CreateRegistrationTokenRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_hdlctrl_v1_user_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateRegistrationTokenRequest.ProtoReflect.Descriptor instead.
+func (*CreateRegistrationTokenRequest) Descriptor() ([]byte, []int) {
+	return file_hdlctrl_v1_user_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *CreateRegistrationTokenRequest) GetResoniteId() string {
+	if x != nil {
+		return x.ResoniteId
+	}
+	return ""
+}
+
+func (x *CreateRegistrationTokenRequest) GetPersonalRoleId() string {
+	if x != nil && x.PersonalRoleId != nil {
+		return *x.PersonalRoleId
+	}
+	return ""
+}
+
+type CreateRegistrationTokenResponse struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Token            string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	ExpiresAt        *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	ResoniteUserName string                 `protobuf:"bytes,3,opt,name=resonite_user_name,json=resoniteUserName,proto3" json:"resonite_user_name,omitempty"`
+	IconUrl          string                 `protobuf:"bytes,4,opt,name=icon_url,json=iconUrl,proto3" json:"icon_url,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *CreateRegistrationTokenResponse) Reset() {
+	*x = CreateRegistrationTokenResponse{}
+	mi := &file_hdlctrl_v1_user_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateRegistrationTokenResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateRegistrationTokenResponse) ProtoMessage() {}
+
+func (x *CreateRegistrationTokenResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_hdlctrl_v1_user_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateRegistrationTokenResponse.ProtoReflect.Descriptor instead.
+func (*CreateRegistrationTokenResponse) Descriptor() ([]byte, []int) {
+	return file_hdlctrl_v1_user_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *CreateRegistrationTokenResponse) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *CreateRegistrationTokenResponse) GetExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
+}
+
+func (x *CreateRegistrationTokenResponse) GetResoniteUserName() string {
+	if x != nil {
+		return x.ResoniteUserName
+	}
+	return ""
+}
+
+func (x *CreateRegistrationTokenResponse) GetIconUrl() string {
+	if x != nil {
+		return x.IconUrl
+	}
+	return ""
+}
+
+type DeleteUserRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteUserRequest) Reset() {
+	*x = DeleteUserRequest{}
+	mi := &file_hdlctrl_v1_user_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteUserRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteUserRequest) ProtoMessage() {}
+
+func (x *DeleteUserRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_hdlctrl_v1_user_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteUserRequest.ProtoReflect.Descriptor instead.
+func (*DeleteUserRequest) Descriptor() ([]byte, []int) {
+	return file_hdlctrl_v1_user_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *DeleteUserRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type DeleteUserResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteUserResponse) Reset() {
+	*x = DeleteUserResponse{}
+	mi := &file_hdlctrl_v1_user_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteUserResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteUserResponse) ProtoMessage() {}
+
+func (x *DeleteUserResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_hdlctrl_v1_user_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteUserResponse.ProtoReflect.Descriptor instead.
+func (*DeleteUserResponse) Descriptor() ([]byte, []int) {
+	return file_hdlctrl_v1_user_proto_rawDescGZIP(), []int{25}
+}
+
+// API トークン. トークンの平文は CreateApiTokenResponse でしか返さない.
+type ApiToken struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name  string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// トークンを見分けるための先頭部分 (例: "brhc_AbC123").
+	TokenPrefix string `protobuf:"bytes,3,opt,name=token_prefix,json=tokenPrefix,proto3" json:"token_prefix,omitempty"`
+	// 指定されていればこのグループの操作だけに制限される.
+	GroupId *string `protobuf:"bytes,4,opt,name=group_id,json=groupId,proto3,oneof" json:"group_id,omitempty"`
+	// 空でなければこの permission key の操作だけに制限される.
+	PermissionKeys []string `protobuf:"bytes,5,rep,name=permission_keys,json=permissionKeys,proto3" json:"permission_keys,omitempty"`
+	// 未設定なら無期限.
+	ExpiresAt     *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	LastUsedAt    *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=last_used_at,json=lastUsedAt,proto3" json:"last_used_at,omitempty"`
+	RevokedAt     *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=revoked_at,json=revokedAt,proto3" json:"revoked_at,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ApiToken) Reset() {
+	*x = ApiToken{}
+	mi := &file_hdlctrl_v1_user_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ApiToken) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApiToken) ProtoMessage() {}
+
+func (x *ApiToken) ProtoReflect() protoreflect.Message {
+	mi := &file_hdlctrl_v1_user_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApiToken.ProtoReflect.Descriptor instead.
+func (*ApiToken) Descriptor() ([]byte, []int) {
+	return file_hdlctrl_v1_user_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *ApiToken) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ApiToken) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ApiToken) GetTokenPrefix() string {
+	if x != nil {
+		return x.TokenPrefix
+	}
+	return ""
+}
+
+func (x *ApiToken) GetGroupId() string {
+	if x != nil && x.GroupId != nil {
+		return *x.GroupId
+	}
+	return ""
+}
+
+func (x *ApiToken) GetPermissionKeys() []string {
+	if x != nil {
+		return x.PermissionKeys
+	}
+	return nil
+}
+
+func (x *ApiToken) GetExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
+}
+
+func (x *ApiToken) GetLastUsedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastUsedAt
+	}
+	return nil
+}
+
+func (x *ApiToken) GetRevokedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.RevokedAt
+	}
+	return nil
+}
+
+func (x *ApiToken) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+type CreateApiTokenRequest struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Name    string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	GroupId *string                `protobuf:"bytes,2,opt,name=group_id,json=groupId,proto3,oneof" json:"group_id,omitempty"`
+	// グループ指定時は system:* を含められない.
+	PermissionKeys []string `protobuf:"bytes,3,rep,name=permission_keys,json=permissionKeys,proto3" json:"permission_keys,omitempty"`
+	// 未設定なら無期限.
+	ExpiresAt     *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateApiTokenRequest) Reset() {
+	*x = CreateApiTokenRequest{}
+	mi := &file_hdlctrl_v1_user_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateApiTokenRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateApiTokenRequest) ProtoMessage() {}
+
+func (x *CreateApiTokenRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_hdlctrl_v1_user_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateApiTokenRequest.ProtoReflect.Descriptor instead.
+func (*CreateApiTokenRequest) Descriptor() ([]byte, []int) {
+	return file_hdlctrl_v1_user_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *CreateApiTokenRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateApiTokenRequest) GetGroupId() string {
+	if x != nil && x.GroupId != nil {
+		return *x.GroupId
+	}
+	return ""
+}
+
+func (x *CreateApiTokenRequest) GetPermissionKeys() []string {
+	if x != nil {
+		return x.PermissionKeys
+	}
+	return nil
+}
+
+func (x *CreateApiTokenRequest) GetExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
+}
+
+type CreateApiTokenResponse struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	ApiToken *ApiToken              `protobuf:"bytes,1,opt,name=api_token,json=apiToken,proto3" json:"api_token,omitempty"`
+	// トークンの平文. 再取得はできない.
+	Token         string `protobuf:"bytes,2,opt,name=token,proto3" json:"token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateApiTokenResponse) Reset() {
+	*x = CreateApiTokenResponse{}
+	mi := &file_hdlctrl_v1_user_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateApiTokenResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateApiTokenResponse) ProtoMessage() {}
+
+func (x *CreateApiTokenResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_hdlctrl_v1_user_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateApiTokenResponse.ProtoReflect.Descriptor instead.
+func (*CreateApiTokenResponse) Descriptor() ([]byte, []int) {
+	return file_hdlctrl_v1_user_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *CreateApiTokenResponse) GetApiToken() *ApiToken {
+	if x != nil {
+		return x.ApiToken
+	}
+	return nil
+}
+
+func (x *CreateApiTokenResponse) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+type ListApiTokensRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListApiTokensRequest) Reset() {
+	*x = ListApiTokensRequest{}
+	mi := &file_hdlctrl_v1_user_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListApiTokensRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListApiTokensRequest) ProtoMessage() {}
+
+func (x *ListApiTokensRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_hdlctrl_v1_user_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListApiTokensRequest.ProtoReflect.Descriptor instead.
+func (*ListApiTokensRequest) Descriptor() ([]byte, []int) {
+	return file_hdlctrl_v1_user_proto_rawDescGZIP(), []int{29}
+}
+
+type ListApiTokensResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ApiTokens     []*ApiToken            `protobuf:"bytes,1,rep,name=api_tokens,json=apiTokens,proto3" json:"api_tokens,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListApiTokensResponse) Reset() {
+	*x = ListApiTokensResponse{}
+	mi := &file_hdlctrl_v1_user_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListApiTokensResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListApiTokensResponse) ProtoMessage() {}
+
+func (x *ListApiTokensResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_hdlctrl_v1_user_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListApiTokensResponse.ProtoReflect.Descriptor instead.
+func (*ListApiTokensResponse) Descriptor() ([]byte, []int) {
+	return file_hdlctrl_v1_user_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *ListApiTokensResponse) GetApiTokens() []*ApiToken {
+	if x != nil {
+		return x.ApiTokens
+	}
+	return nil
+}
+
+type RevokeApiTokenRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RevokeApiTokenRequest) Reset() {
+	*x = RevokeApiTokenRequest{}
+	mi := &file_hdlctrl_v1_user_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevokeApiTokenRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeApiTokenRequest) ProtoMessage() {}
+
+func (x *RevokeApiTokenRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_hdlctrl_v1_user_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokeApiTokenRequest.ProtoReflect.Descriptor instead.
+func (*RevokeApiTokenRequest) Descriptor() ([]byte, []int) {
+	return file_hdlctrl_v1_user_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *RevokeApiTokenRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type RevokeApiTokenResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RevokeApiTokenResponse) Reset() {
+	*x = RevokeApiTokenResponse{}
+	mi := &file_hdlctrl_v1_user_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevokeApiTokenResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeApiTokenResponse) ProtoMessage() {}
+
+func (x *RevokeApiTokenResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_hdlctrl_v1_user_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokeApiTokenResponse.ProtoReflect.Descriptor instead.
+func (*RevokeApiTokenResponse) Descriptor() ([]byte, []int) {
+	return file_hdlctrl_v1_user_proto_rawDescGZIP(), []int{32}
+}
+
+type GetTotpStatusRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTotpStatusRequest) Reset() {
+	*x = GetTotpStatusRequest{}
+	mi := &file_hdlctrl_v1_user_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTotpStatusRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTotpStatusRequest) ProtoMessage() {}
+
+func (x *GetTotpStatusRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_hdlctrl_v1_user_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTotpStatusRequest.ProtoReflect.Descriptor instead.
+func (*GetTotpStatusRequest) Descriptor() ([]byte, []int) {
+	return file_hdlctrl_v1_user_proto_rawDescGZIP(), []int{33}
+}
+
+type GetTotpStatusResponse struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Enabled bool                   `protobuf:"varint,1,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	// true なら system scope のロールを持つため無効にできない
+	Required               bool  `protobuf:"varint,2,opt,name=required,proto3" json:"required,omitempty"`
+	RemainingRecoveryCodes int32 `protobuf:"varint,3,opt,name=remaining_recovery_codes,json=remainingRecoveryCodes,proto3" json:"remaining_recovery_codes,omitempty"`
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *GetTotpStatusResponse) Reset() {
+	*x = GetTotpStatusResponse{}
+	mi := &file_hdlctrl_v1_user_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTotpStatusResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTotpStatusResponse) ProtoMessage() {}
+
+func (x *GetTotpStatusResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_hdlctrl_v1_user_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTotpStatusResponse.ProtoReflect.Descriptor instead.
+func (*GetTotpStatusResponse) Descriptor() ([]byte, []int) {
+	return file_hdlctrl_v1_user_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *GetTotpStatusResponse) GetEnabled() bool {
+	if x != nil {
+		return x.Enabled
+	}
+	return false
+}
+
+func (x *GetTotpStatusResponse) GetRequired() bool {
+	if x != nil {
+		return x.Required
+	}
+	return false
+}
+
+func (x *GetTotpStatusResponse) GetRemainingRecoveryCodes() int32 {
+	if x != nil {
+		return x.RemainingRecoveryCodes
+	}
+	return 0
+}
+
+type BeginTotpEnrollmentRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// サインイン前に登録するときだけ指定する
+	Challenge     string `protobuf:"bytes,1,opt,name=challenge,proto3" json:"challenge,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BeginTotpEnrollmentRequest) Reset() {
+	*x = BeginTotpEnrollmentRequest{}
+	mi := &file_hdlctrl_v1_user_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BeginTotpEnrollmentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BeginTotpEnrollmentRequest) ProtoMessage() {}
+
+func (x *BeginTotpEnrollmentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_hdlctrl_v1_user_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BeginTotpEnrollmentRequest.ProtoReflect.Descriptor instead.
+func (*BeginTotpEnrollmentRequest) Descriptor() ([]byte, []int) {
+	return file_hdlctrl_v1_user_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *BeginTotpEnrollmentRequest) GetChallenge() string {
+	if x != nil {
+		return x.Challenge
+	}
+	return ""
+}
+
+type BeginTotpEnrollmentResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 手入力用の base32 文字列
+	Secret string `protobuf:"bytes,1,opt,name=secret,proto3" json:"secret,omitempty"`
+	// QR コードにする otpauth:// URI
+	KeyUri        string `protobuf:"bytes,2,opt,name=key_uri,json=keyUri,proto3" json:"key_uri,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BeginTotpEnrollmentResponse) Reset() {
+	*x = BeginTotpEnrollmentResponse{}
+	mi := &file_hdlctrl_v1_user_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BeginTotpEnrollmentResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BeginTotpEnrollmentResponse) ProtoMessage() {}
+
+func (x *BeginTotpEnrollmentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_hdlctrl_v1_user_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BeginTotpEnrollmentResponse.ProtoReflect.Descriptor instead.
+func (*BeginTotpEnrollmentResponse) Descriptor() ([]byte, []int) {
+	return file_hdlctrl_v1_user_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *BeginTotpEnrollmentResponse) GetSecret() string {
+	if x != nil {
+		return x.Secret
+	}
+	return ""
+}
+
+func (x *BeginTotpEnrollmentResponse) GetKeyUri() string {
+	if x != nil {
+		return x.KeyUri
+	}
+	return ""
 }
 
-type CreateRegistrationTokenRequest struct {
-	state      protoimpl.MessageState `protogen:"open.v1"`
-	ResoniteId string                 `protobuf:"bytes,1,opt,name=resonite_id,json=resoniteId,proto3" json:"resonite_id,omitempty"`
-	// 個人グループに付与するロールID. 未指定なら seed-admin が付与される.
-	// トークンと一緒に DB に保存され、RegisterWithToken 時に lookup される.
-	PersonalRoleId *string `protobuf:"bytes,2,opt,name=personal_role_id,json=personalRoleId,proto3,oneof" json:"personal_role_id,omitempty"`
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+type ConfirmTotpEnrollmentRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Challenge     string                 `protobuf:"bytes,1,opt,name=challenge,proto3" json:"challenge,omitempty"`
+	Code          string                 `protobuf:"bytes,2,opt,name=code,proto3" json:"code,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateRegistrationTokenRequest) Reset() {
-	*x = CreateRegistrationTokenRequest{}
-	mi := &file_hdlctrl_v1_user_proto_msgTypes[13]
+func (x *ConfirmTotpEnrollmentRequest) Reset() {
+	*x = ConfirmTotpEnrollmentRequest{}
+	mi := &file_hdlctrl_v1_user_proto_msgTypes[37]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateRegistrationTokenRequest) String() string {
+func (x *ConfirmTotpEnrollmentRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateRegistrationTokenRequest) ProtoMessage() {}
+func (*ConfirmTotpEnrollmentRequest) ProtoMessage() {}
 
-func (x *CreateRegistrationTokenRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_hdlctrl_v1_user_proto_msgTypes[13]
+func (x *ConfirmTotpEnrollmentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_hdlctrl_v1_user_proto_msgTypes[37]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -703,50 +1921,50 @@ func (x *CreateRegistrationTokenRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateRegistrationTokenRequest.ProtoReflect.Descriptor instead.
-func (*CreateRegistrationTokenRequest) Descriptor() ([]byte, []int) {
-	return file_hdlctrl_v1_user_proto_rawDescGZIP(), []int{13}
+// Deprecated: Use ConfirmTotpEnrollmentRequest.ProtoReflect.Descriptor instead.
+func (*ConfirmTotpEnrollmentRequest) Descriptor() ([]byte, []int) {
+	return file_hdlctrl_v1_user_proto_rawDescGZIP(), []int{37}
 }
 
-func (x *CreateRegistrationTokenRequest) GetResoniteId() string {
+func (x *ConfirmTotpEnrollmentRequest) GetChallenge() string {
 	if x != nil {
-		return x.ResoniteId
+		return x.Challenge
 	}
 	return ""
 }
 
-func (x *CreateRegistrationTokenRequest) GetPersonalRoleId() string {
-	if x != nil && x.PersonalRoleId != nil {
-		return *x.PersonalRoleId
+func (x *ConfirmTotpEnrollmentRequest) GetCode() string {
+	if x != nil {
+		return x.Code
 	}
 	return ""
 }
 
-type CreateRegistrationTokenResponse struct {
-	state            protoimpl.MessageState `protogen:"open.v1"`
-	Token            string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
-	ExpiresAt        *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
-	ResoniteUserName string                 `protobuf:"bytes,3,opt,name=resonite_user_name,json=resoniteUserName,proto3" json:"resonite_user_name,omitempty"`
-	IconUrl          string                 `protobuf:"bytes,4,opt,name=icon_url,json=iconUrl,proto3" json:"icon_url,omitempty"`
-	unknownFields    protoimpl.UnknownFields
-	sizeCache        protoimpl.SizeCache
+type ConfirmTotpEnrollmentResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// リカバリーコード. 再取得はできない.
+	RecoveryCodes []string `protobuf:"bytes,1,rep,name=recovery_codes,json=recoveryCodes,proto3" json:"recovery_codes,omitempty"`
+	// challenge で登録したときはそのままサインインできるようトークンを返す
+	Tokens        *TokenSetResponse `protobuf:"bytes,2,opt,name=tokens,proto3" json:"tokens,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateRegistrationTokenResponse) Reset() {
-	*x = CreateRegistrationTokenResponse{}
-	mi := &file_hdlctrl_v1_user_proto_msgTypes[14]
+func (x *ConfirmTotpEnrollmentResponse) Reset() {
+	*x = ConfirmTotpEnrollmentResponse{}
+	mi := &file_hdlctrl_v1_user_proto_msgTypes[38]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateRegistrationTokenResponse) String() string {
+func (x *ConfirmTotpEnrollmentResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateRegistrationTokenResponse) ProtoMessage() {}
+func (*ConfirmTotpEnrollmentResponse) ProtoMessage() {}
 
-func (x *CreateRegistrationTokenResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_hdlctrl_v1_user_proto_msgTypes[14]
+func (x *ConfirmTotpEnrollmentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_hdlctrl_v1_user_proto_msgTypes[38]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -757,61 +1975,221 @@ func (x *CreateRegistrationTokenResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateRegistrationTokenResponse.ProtoReflect.Descriptor instead.
-func (*CreateRegistrationTokenResponse) Descriptor() ([]byte, []int) {
-	return file_hdlctrl_v1_user_proto_rawDescGZIP(), []int{14}
+// Deprecated: Use ConfirmTotpEnrollmentResponse.ProtoReflect.Descriptor instead.
+func (*ConfirmTotpEnrollmentResponse) Descriptor() ([]byte, []int) {
+	return file_hdlctrl_v1_user_proto_rawDescGZIP(), []int{38}
 }
 
-func (x *CreateRegistrationTokenResponse) GetToken() string {
+func (x *ConfirmTotpEnrollmentResponse) GetRecoveryCodes() []string {
 	if x != nil {
-		return x.Token
+		return x.RecoveryCodes
 	}
-	return ""
+	return nil
 }
 
-func (x *CreateRegistrationTokenResponse) GetExpiresAt() *timestamppb.Timestamp {
+func (x *ConfirmTotpEnrollmentResponse) GetTokens() *TokenSetResponse {
 	if x != nil {
-		return x.ExpiresAt
+		return x.Tokens
 	}
 	return nil
 }
 
-func (x *CreateRegistrationTokenResponse) GetResoniteUserName() string {
+type DisableTotpRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 本人確認のための 6 桁のコードかリカバリーコード
+	Code          string `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DisableTotpRequest) Reset() {
+	*x = DisableTotpRequest{}
+	mi := &file_hdlctrl_v1_user_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DisableTotpRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DisableTotpRequest) ProtoMessage() {}
+
+func (x *DisableTotpRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_hdlctrl_v1_user_proto_msgTypes[39]
 	if x != nil {
-		return x.ResoniteUserName
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DisableTotpRequest.ProtoReflect.Descriptor instead.
+func (*DisableTotpRequest) Descriptor() ([]byte, []int) {
+	return file_hdlctrl_v1_user_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *DisableTotpRequest) GetCode() string {
+	if x != nil {
+		return x.Code
 	}
 	return ""
 }
 
-func (x *CreateRegistrationTokenResponse) GetIconUrl() string {
+type DisableTotpResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DisableTotpResponse) Reset() {
+	*x = DisableTotpResponse{}
+	mi := &file_hdlctrl_v1_user_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DisableTotpResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DisableTotpResponse) ProtoMessage() {}
+
+func (x *DisableTotpResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_hdlctrl_v1_user_proto_msgTypes[40]
 	if x != nil {
-		return x.IconUrl
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DisableTotpResponse.ProtoReflect.Descriptor instead.
+func (*DisableTotpResponse) Descriptor() ([]byte, []int) {
+	return file_hdlctrl_v1_user_proto_rawDescGZIP(), []int{40}
+}
+
+type UserSession struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	Id        string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	UserAgent string                 `protobuf:"bytes,2,opt,name=user_agent,json=userAgent,proto3" json:"user_agent,omitempty"`
+	// 最後に refresh したときの接続元
+	IpAddress  string                 `protobuf:"bytes,3,opt,name=ip_address,json=ipAddress,proto3" json:"ip_address,omitempty"`
+	CreatedAt  *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	LastSeenAt *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=last_seen_at,json=lastSeenAt,proto3" json:"last_seen_at,omitempty"`
+	ExpiresAt  *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	// このリクエストのセッションなら true
+	Current       bool `protobuf:"varint,7,opt,name=current,proto3" json:"current,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UserSession) Reset() {
+	*x = UserSession{}
+	mi := &file_hdlctrl_v1_user_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UserSession) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserSession) ProtoMessage() {}
+
+func (x *UserSession) ProtoReflect() protoreflect.Message {
+	mi := &file_hdlctrl_v1_user_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserSession.ProtoReflect.Descriptor instead.
+func (*UserSession) Descriptor() ([]byte, []int) {
+	return file_hdlctrl_v1_user_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *UserSession) GetId() string {
+	if x != nil {
+		return x.Id
 	}
 	return ""
 }
 
-type DeleteUserRequest struct {
+func (x *UserSession) GetUserAgent() string {
+	if x != nil {
+		return x.UserAgent
+	}
+	return ""
+}
+
+func (x *UserSession) GetIpAddress() string {
+	if x != nil {
+		return x.IpAddress
+	}
+	return ""
+}
+
+func (x *UserSession) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *UserSession) GetLastSeenAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastSeenAt
+	}
+	return nil
+}
+
+func (x *UserSession) GetExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
+}
+
+func (x *UserSession) GetCurrent() bool {
+	if x != nil {
+		return x.Current
+	}
+	return false
+}
+
+type ListMySessionsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeleteUserRequest) Reset() {
-	*x = DeleteUserRequest{}
-	mi := &file_hdlctrl_v1_user_proto_msgTypes[15]
+func (x *ListMySessionsRequest) Reset() {
+	*x = ListMySessionsRequest{}
+	mi := &file_hdlctrl_v1_user_proto_msgTypes[42]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteUserRequest) String() string {
+func (x *ListMySessionsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteUserRequest) ProtoMessage() {}
+func (*ListMySessionsRequest) ProtoMessage() {}
 
-func (x *DeleteUserRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_hdlctrl_v1_user_proto_msgTypes[15]
+func (x *ListMySessionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_hdlctrl_v1_user_proto_msgTypes[42]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -822,39 +2200,120 @@ func (x *DeleteUserRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteUserRequest.ProtoReflect.Descriptor instead.
-func (*DeleteUserRequest) Descriptor() ([]byte, []int) {
-	return file_hdlctrl_v1_user_proto_rawDescGZIP(), []int{15}
+// Deprecated: Use ListMySessionsRequest.ProtoReflect.Descriptor instead.
+func (*ListMySessionsRequest) Descriptor() ([]byte, []int) {
+	return file_hdlctrl_v1_user_proto_rawDescGZIP(), []int{42}
 }
 
-func (x *DeleteUserRequest) GetUserId() string {
+type ListMySessionsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Sessions      []*UserSession         `protobuf:"bytes,1,rep,name=sessions,proto3" json:"sessions,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListMySessionsResponse) Reset() {
+	*x = ListMySessionsResponse{}
+	mi := &file_hdlctrl_v1_user_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListMySessionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListMySessionsResponse) ProtoMessage() {}
+
+func (x *ListMySessionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_hdlctrl_v1_user_proto_msgTypes[43]
 	if x != nil {
-		return x.UserId
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListMySessionsResponse.ProtoReflect.Descriptor instead.
+func (*ListMySessionsResponse) Descriptor() ([]byte, []int) {
+	return file_hdlctrl_v1_user_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *ListMySessionsResponse) GetSessions() []*UserSession {
+	if x != nil {
+		return x.Sessions
+	}
+	return nil
+}
+
+type RevokeSessionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RevokeSessionRequest) Reset() {
+	*x = RevokeSessionRequest{}
+	mi := &file_hdlctrl_v1_user_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevokeSessionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeSessionRequest) ProtoMessage() {}
+
+func (x *RevokeSessionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_hdlctrl_v1_user_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokeSessionRequest.ProtoReflect.Descriptor instead.
+func (*RevokeSessionRequest) Descriptor() ([]byte, []int) {
+	return file_hdlctrl_v1_user_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *RevokeSessionRequest) GetId() string {
+	if x != nil {
+		return x.Id
 	}
 	return ""
 }
 
-type DeleteUserResponse struct {
+type RevokeSessionResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeleteUserResponse) Reset() {
-	*x = DeleteUserResponse{}
-	mi := &file_hdlctrl_v1_user_proto_msgTypes[16]
+func (x *RevokeSessionResponse) Reset() {
+	*x = RevokeSessionResponse{}
+	mi := &file_hdlctrl_v1_user_proto_msgTypes[45]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteUserResponse) String() string {
+func (x *RevokeSessionResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteUserResponse) ProtoMessage() {}
+func (*RevokeSessionResponse) ProtoMessage() {}
 
-func (x *DeleteUserResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_hdlctrl_v1_user_proto_msgTypes[16]
+func (x *RevokeSessionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_hdlctrl_v1_user_proto_msgTypes[45]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -865,9 +2324,9 @@ func (x *DeleteUserResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteUserResponse.ProtoReflect.Descriptor instead.
-func (*DeleteUserResponse) Descriptor() ([]byte, []int) {
-	return file_hdlctrl_v1_user_proto_rawDescGZIP(), []int{16}
+// Deprecated: Use RevokeSessionResponse.ProtoReflect.Descriptor instead.
+func (*RevokeSessionResponse) Descriptor() ([]byte, []int) {
+	return file_hdlctrl_v1_user_proto_rawDescGZIP(), []int{45}
 }
 
 var File_hdlctrl_v1_user_proto protoreflect.FileDescriptor
@@ -875,13 +2334,34 @@ var File_hdlctrl_v1_user_proto protoreflect.FileDescriptor
 const file_hdlctrl_v1_user_proto_rawDesc = "" +
 	"\n" +
 	"\x15hdlctrl/v1/user.proto\x12\n" +
-	"hdlctrl.v1\x1a\x1fgoogle/protobuf/timestamp.proto\"M\n" +
+	"hdlctrl.v1\x1a\x1fgoogle/protobuf/timestamp.proto\"\xae\x01\n" +
 	"\x10TokenSetResponse\x12\x14\n" +
 	"\x05token\x18\x01 \x01(\tR\x05token\x12#\n" +
-	"\rrefresh_token\x18\x02 \x01(\tR\frefreshToken\"G\n" +
+	"\rrefresh_token\x18\x02 \x01(\tR\frefreshToken\x12%\n" +
+	"\x0etotp_challenge\x18\x03 \x01(\tR\rtotpChallenge\x128\n" +
+	"\x18totp_enrollment_required\x18\x04 \x01(\bR\x16totpEnrollmentRequired\"G\n" +
 	"\x19GetTokenByPasswordRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1a\n" +
-	"\bpassword\x18\x02 \x01(\tR\bpassword\"\x15\n" +
+	"\bpassword\x18\x02 \x01(\tR\bpassword\"\x18\n" +
+	"\x16GetLoginOptionsRequest\"j\n" +
+	"\x17GetLoginOptionsResponse\x12!\n" +
+	"\foidc_enabled\x18\x01 \x01(\bR\voidcEnabled\x12,\n" +
+	"\x12oidc_provider_name\x18\x02 \x01(\tR\x10oidcProviderName\"\x17\n" +
+	"\x15BeginOidcLoginRequest\"[\n" +
+	"\x16BeginOidcLoginResponse\x12+\n" +
+	"\x11authorization_url\x18\x01 \x01(\tR\x10authorizationUrl\x12\x14\n" +
+	"\x05state\x18\x02 \x01(\tR\x05state\"D\n" +
+	"\x18CompleteOidcLoginRequest\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\tR\x04code\x12\x14\n" +
+	"\x05state\x18\x02 \x01(\tR\x05state\"\x16\n" +
+	"\x14BeginOidcLinkRequest\"C\n" +
+	"\x17CompleteOidcLinkRequest\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\tR\x04code\x12\x14\n" +
+	"\x05state\x18\x02 \x01(\tR\x05state\"\x1a\n" +
+	"\x18CompleteOidcLinkResponse\"J\n" +
+	"\x16VerifyTotpLoginRequest\x12\x1c\n" +
+	"\tchallenge\x18\x01 \x01(\tR\tchallenge\x12\x12\n" +
+	"\x04code\x18\x02 \x01(\tR\x04code\"\x15\n" +
 	"\x13RefreshTokenRequest\"8\n" +
 	" ValidateRegistrationTokenRequest\x12\x14\n" +
 	"\x05token\x18\x01 \x01(\tR\x05token\"\xa3\x01\n" +
@@ -928,18 +2408,103 @@ const file_hdlctrl_v1_user_proto_rawDesc = "" +
 	"\bicon_url\x18\x04 \x01(\tR\aiconUrl\",\n" +
 	"\x11DeleteUserRequest\x12\x17\n" +
 	"\auser_id\x18\x01 \x01(\tR\x06userId\"\x14\n" +
-	"\x12DeleteUserResponse2\xc4\x06\n" +
+	"\x12DeleteUserResponse\"\x96\x03\n" +
+	"\bApiToken\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12!\n" +
+	"\ftoken_prefix\x18\x03 \x01(\tR\vtokenPrefix\x12\x1e\n" +
+	"\bgroup_id\x18\x04 \x01(\tH\x00R\agroupId\x88\x01\x01\x12'\n" +
+	"\x0fpermission_keys\x18\x05 \x03(\tR\x0epermissionKeys\x129\n" +
+	"\n" +
+	"expires_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\texpiresAt\x12<\n" +
+	"\flast_used_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"lastUsedAt\x129\n" +
+	"\n" +
+	"revoked_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\trevokedAt\x129\n" +
+	"\n" +
+	"created_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAtB\v\n" +
+	"\t_group_id\"\xbc\x01\n" +
+	"\x15CreateApiTokenRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x1e\n" +
+	"\bgroup_id\x18\x02 \x01(\tH\x00R\agroupId\x88\x01\x01\x12'\n" +
+	"\x0fpermission_keys\x18\x03 \x03(\tR\x0epermissionKeys\x129\n" +
+	"\n" +
+	"expires_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\texpiresAtB\v\n" +
+	"\t_group_id\"a\n" +
+	"\x16CreateApiTokenResponse\x121\n" +
+	"\tapi_token\x18\x01 \x01(\v2\x14.hdlctrl.v1.ApiTokenR\bapiToken\x12\x14\n" +
+	"\x05token\x18\x02 \x01(\tR\x05token\"\x16\n" +
+	"\x14ListApiTokensRequest\"L\n" +
+	"\x15ListApiTokensResponse\x123\n" +
+	"\n" +
+	"api_tokens\x18\x01 \x03(\v2\x14.hdlctrl.v1.ApiTokenR\tapiTokens\"'\n" +
+	"\x15RevokeApiTokenRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"\x18\n" +
+	"\x16RevokeApiTokenResponse\"\x16\n" +
+	"\x14GetTotpStatusRequest\"\x87\x01\n" +
+	"\x15GetTotpStatusResponse\x12\x18\n" +
+	"\aenabled\x18\x01 \x01(\bR\aenabled\x12\x1a\n" +
+	"\brequired\x18\x02 \x01(\bR\brequired\x128\n" +
+	"\x18remaining_recovery_codes\x18\x03 \x01(\x05R\x16remainingRecoveryCodes\":\n" +
+	"\x1aBeginTotpEnrollmentRequest\x12\x1c\n" +
+	"\tchallenge\x18\x01 \x01(\tR\tchallenge\"N\n" +
+	"\x1bBeginTotpEnrollmentResponse\x12\x16\n" +
+	"\x06secret\x18\x01 \x01(\tR\x06secret\x12\x17\n" +
+	"\akey_uri\x18\x02 \x01(\tR\x06keyUri\"P\n" +
+	"\x1cConfirmTotpEnrollmentRequest\x12\x1c\n" +
+	"\tchallenge\x18\x01 \x01(\tR\tchallenge\x12\x12\n" +
+	"\x04code\x18\x02 \x01(\tR\x04code\"|\n" +
+	"\x1dConfirmTotpEnrollmentResponse\x12%\n" +
+	"\x0erecovery_codes\x18\x01 \x03(\tR\rrecoveryCodes\x124\n" +
+	"\x06tokens\x18\x02 \x01(\v2\x1c.hdlctrl.v1.TokenSetResponseR\x06tokens\"(\n" +
+	"\x12DisableTotpRequest\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\tR\x04code\"\x15\n" +
+	"\x13DisableTotpResponse\"\xa9\x02\n" +
+	"\vUserSession\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1d\n" +
+	"\n" +
+	"user_agent\x18\x02 \x01(\tR\tuserAgent\x12\x1d\n" +
+	"\n" +
+	"ip_address\x18\x03 \x01(\tR\tipAddress\x129\n" +
+	"\n" +
+	"created_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x12<\n" +
+	"\flast_seen_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"lastSeenAt\x129\n" +
+	"\n" +
+	"expires_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\texpiresAt\x12\x18\n" +
+	"\acurrent\x18\a \x01(\bR\acurrent\"\x17\n" +
+	"\x15ListMySessionsRequest\"M\n" +
+	"\x16ListMySessionsResponse\x123\n" +
+	"\bsessions\x18\x01 \x03(\v2\x17.hdlctrl.v1.UserSessionR\bsessions\"&\n" +
+	"\x14RevokeSessionRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"\x17\n" +
+	"\x15RevokeSessionResponse2\xae\x11\n" +
 	"\vUserService\x12[\n" +
 	"\x12GetTokenByPassword\x12%.hdlctrl.v1.GetTokenByPasswordRequest\x1a\x1c.hdlctrl.v1.TokenSetResponse\"\x00\x12z\n" +
 	"\x19ValidateRegistrationToken\x12,.hdlctrl.v1.ValidateRegistrationTokenRequest\x1a-.hdlctrl.v1.ValidateRegistrationTokenResponse\"\x00\x12Y\n" +
-	"\x11RegisterWithToken\x12$.hdlctrl.v1.RegisterWithTokenRequest\x1a\x1c.hdlctrl.v1.TokenSetResponse\"\x00\x12O\n" +
+	"\x11RegisterWithToken\x12$.hdlctrl.v1.RegisterWithTokenRequest\x1a\x1c.hdlctrl.v1.TokenSetResponse\"\x00\x12\\\n" +
+	"\x0fGetLoginOptions\x12\".hdlctrl.v1.GetLoginOptionsRequest\x1a#.hdlctrl.v1.GetLoginOptionsResponse\"\x00\x12Y\n" +
+	"\x0eBeginOidcLogin\x12!.hdlctrl.v1.BeginOidcLoginRequest\x1a\".hdlctrl.v1.BeginOidcLoginResponse\"\x00\x12Y\n" +
+	"\x11CompleteOidcLogin\x12$.hdlctrl.v1.CompleteOidcLoginRequest\x1a\x1c.hdlctrl.v1.TokenSetResponse\"\x00\x12U\n" +
+	"\x0fVerifyTotpLogin\x12\".hdlctrl.v1.VerifyTotpLoginRequest\x1a\x1c.hdlctrl.v1.TokenSetResponse\"\x00\x12O\n" +
 	"\fRefreshToken\x12\x1f.hdlctrl.v1.RefreshTokenRequest\x1a\x1c.hdlctrl.v1.TokenSetResponse\"\x00\x12Y\n" +
 	"\x0eChangePassword\x12!.hdlctrl.v1.ChangePasswordRequest\x1a\".hdlctrl.v1.ChangePasswordResponse\"\x00\x12J\n" +
 	"\tListUsers\x12\x1c.hdlctrl.v1.ListUsersRequest\x1a\x1d.hdlctrl.v1.ListUsersResponse\"\x00\x12D\n" +
 	"\aGetUser\x12\x1a.hdlctrl.v1.GetUserRequest\x1a\x1b.hdlctrl.v1.GetUserResponse\"\x00\x12t\n" +
 	"\x17CreateRegistrationToken\x12*.hdlctrl.v1.CreateRegistrationTokenRequest\x1a+.hdlctrl.v1.CreateRegistrationTokenResponse\"\x00\x12M\n" +
 	"\n" +
-	"DeleteUser\x12\x1d.hdlctrl.v1.DeleteUserRequest\x1a\x1e.hdlctrl.v1.DeleteUserResponse\"\x00B\xb7\x01\n" +
+	"DeleteUser\x12\x1d.hdlctrl.v1.DeleteUserRequest\x1a\x1e.hdlctrl.v1.DeleteUserResponse\"\x00\x12Y\n" +
+	"\x0eCreateApiToken\x12!.hdlctrl.v1.CreateApiTokenRequest\x1a\".hdlctrl.v1.CreateApiTokenResponse\"\x00\x12V\n" +
+	"\rListApiTokens\x12 .hdlctrl.v1.ListApiTokensRequest\x1a!.hdlctrl.v1.ListApiTokensResponse\"\x00\x12Y\n" +
+	"\x0eRevokeApiToken\x12!.hdlctrl.v1.RevokeApiTokenRequest\x1a\".hdlctrl.v1.RevokeApiTokenResponse\"\x00\x12V\n" +
+	"\rGetTotpStatus\x12 .hdlctrl.v1.GetTotpStatusRequest\x1a!.hdlctrl.v1.GetTotpStatusResponse\"\x00\x12h\n" +
+	"\x13BeginTotpEnrollment\x12&.hdlctrl.v1.BeginTotpEnrollmentRequest\x1a'.hdlctrl.v1.BeginTotpEnrollmentResponse\"\x00\x12n\n" +
+	"\x15ConfirmTotpEnrollment\x12(.hdlctrl.v1.ConfirmTotpEnrollmentRequest\x1a).hdlctrl.v1.ConfirmTotpEnrollmentResponse\"\x00\x12P\n" +
+	"\vDisableTotp\x12\x1e.hdlctrl.v1.DisableTotpRequest\x1a\x1f.hdlctrl.v1.DisableTotpResponse\"\x00\x12Y\n" +
+	"\x0eListMySessions\x12!.hdlctrl.v1.ListMySessionsRequest\x1a\".hdlctrl.v1.ListMySessionsResponse\"\x00\x12V\n" +
+	"\rRevokeSession\x12 .hdlctrl.v1.RevokeSessionRequest\x1a!.hdlctrl.v1.RevokeSessionResponse\"\x00\x12W\n" +
+	"\rBeginOidcLink\x12 .hdlctrl.v1.BeginOidcLinkRequest\x1a\".hdlctrl.v1.BeginOidcLoginResponse\"\x00\x12_\n" +
+	"\x10CompleteOidcLink\x12#.hdlctrl.v1.CompleteOidcLinkRequest\x1a$.hdlctrl.v1.CompleteOidcLinkResponse\"\x00B\xb7\x01\n" +
 	"\x0ecom.hdlctrl.v1B\tUserProtoP\x01ZQgithub.com/hantabaru1014/baru-reso-headless-controller/pbgen/hdlctrl/v1;hdlctrlv1\xa2\x02\x03HXX\xaa\x02\n" +
 	"Hdlctrl.V1\xca\x02\n" +
 	"Hdlctrl\\V1\xe2\x02\x16Hdlctrl\\V1\\GPBMetadata\xea\x02\vHdlctrl::V1b\x06proto3"
@@ -956,56 +2521,127 @@ func file_hdlctrl_v1_user_proto_rawDescGZIP() []byte {
 	return file_hdlctrl_v1_user_proto_rawDescData
 }
 
-var file_hdlctrl_v1_user_proto_msgTypes = make([]protoimpl.MessageInfo, 17)
+var file_hdlctrl_v1_user_proto_msgTypes = make([]protoimpl.MessageInfo, 46)
 var file_hdlctrl_v1_user_proto_goTypes = []any{
 	(*TokenSetResponse)(nil),                  // 0: hdlctrl.v1.TokenSetResponse
 	(*GetTokenByPasswordRequest)(nil),         // 1: hdlctrl.v1.GetTokenByPasswordRequest
-	(*RefreshTokenRequest)(nil),               // 2: hdlctrl.v1.RefreshTokenRequest
-	(*ValidateRegistrationTokenRequest)(nil),  // 3: hdlctrl.v1.ValidateRegistrationTokenRequest
-	(*ValidateRegistrationTokenResponse)(nil), // 4: hdlctrl.v1.ValidateRegistrationTokenResponse
-	(*RegisterWithTokenRequest)(nil),          // 5: hdlctrl.v1.RegisterWithTokenRequest
-	(*ChangePasswordRequest)(nil),             // 6: hdlctrl.v1.ChangePasswordRequest
-	(*ChangePasswordResponse)(nil),            // 7: hdlctrl.v1.ChangePasswordResponse
-	(*User)(nil),                              // 8: hdlctrl.v1.User
-	(*ListUsersRequest)(nil),                  // 9: hdlctrl.v1.ListUsersRequest
-	(*ListUsersResponse)(nil),                 // 10: hdlctrl.v1.ListUsersResponse
-	(*GetUserRequest)(nil),                    // 11: hdlctrl.v1.GetUserRequest
-	(*GetUserResponse)(nil),                   // 12: hdlctrl.v1.GetUserResponse
-	(*CreateRegistrationTokenRequest)(nil),    // 13: hdlctrl.v1.CreateRegistrationTokenRequest
-	(*CreateRegistrationTokenResponse)(nil),   // 14: hdlctrl.v1.CreateRegistrationTokenResponse
-	(*DeleteUserRequest)(nil),                 // 15: hdlctrl.v1.DeleteUserRequest
-	(*DeleteUserResponse)(nil),                // 16: hdlctrl.v1.DeleteUserResponse
-	(*timestamppb.Timestamp)(nil),             // 17: google.protobuf.Timestamp
+	(*GetLoginOptionsRequest)(nil),            // 2: hdlctrl.v1.GetLoginOptionsRequest
+	(*GetLoginOptionsResponse)(nil),           // 3: hdlctrl.v1.GetLoginOptionsResponse
+	(*BeginOidcLoginRequest)(nil),             // 4: hdlctrl.v1.BeginOidcLoginRequest
+	(*BeginOidcLoginResponse)(nil),            // 5: hdlctrl.v1.BeginOidcLoginResponse
+	(*CompleteOidcLoginRequest)(nil),          // 6: hdlctrl.v1.CompleteOidcLoginRequest
+	(*BeginOidcLinkRequest)(nil),              // 7: hdlctrl.v1.BeginOidcLinkRequest
+	(*CompleteOidcLinkRequest)(nil),           // 8: hdlctrl.v1.CompleteOidcLinkRequest
+	(*CompleteOidcLinkResponse)(nil),          // 9: hdlctrl.v1.CompleteOidcLinkResponse
+	(*VerifyTotpLoginRequest)(nil),            // 10: hdlctrl.v1.VerifyTotpLoginRequest
+	(*RefreshTokenRequest)(nil),               // 11: hdlctrl.v1.RefreshTokenRequest
+	(*ValidateRegistrationTokenRequest)(nil),  // 12: hdlctrl.v1.ValidateRegistrationTokenRequest
+	(*ValidateRegistrationTokenResponse)(nil), // 13: hdlctrl.v1.ValidateRegistrationTokenResponse
+	(*RegisterWithTokenRequest)(nil),          // 14: hdlctrl.v1.RegisterWithTokenRequest
+	(*ChangePasswordRequest)(nil),             // 15: hdlctrl.v1.ChangePasswordRequest
+	(*ChangePasswordResponse)(nil),            // 16: hdlctrl.v1.ChangePasswordResponse
+	(*User)(nil),                              // 17: hdlctrl.v1.User
+	(*ListUsersRequest)(nil),                  // 18: hdlctrl.v1.ListUsersRequest
+	(*ListUsersResponse)(nil),                 // 19: hdlctrl.v1.ListUsersResponse
+	(*GetUserRequest)(nil),                    // 20: hdlctrl.v1.GetUserRequest
+	(*GetUserResponse)(nil),                   // 21: hdlctrl.v1.GetUserResponse
+	(*CreateRegistrationTokenRequest)(nil),    // 22: hdlctrl.v1.CreateRegistrationTokenRequest
+	(*CreateRegistrationTokenResponse)(nil),   // 23: hdlctrl.v1.CreateRegistrationTokenResponse
+	(*DeleteUserRequest)(nil),                 // 24: hdlctrl.v1.DeleteUserRequest
+	(*DeleteUserResponse)(nil),                // 25: hdlctrl.v1.DeleteUserResponse
+	(*ApiToken)(nil),                          // 26: hdlctrl.v1.ApiToken
+	(*CreateApiTokenRequest)(nil),             // 27: hdlctrl.v1.CreateApiTokenRequest
+	(*CreateApiTokenResponse)(nil),            // 28: hdlctrl.v1.CreateApiTokenResponse
+	(*ListApiTokensRequest)(nil),              // 29: hdlctrl.v1.ListApiTokensRequest
+	(*ListApiTokensResponse)(nil),             // 30: hdlctrl.v1.ListApiTokensResponse
+	(*RevokeApiTokenRequest)(nil),             // 31: hdlctrl.v1.RevokeApiTokenRequest
+	(*RevokeApiTokenResponse)(nil),            // 32: hdlctrl.v1.RevokeApiTokenResponse
+	(*GetTotpStatusRequest)(nil),              // 33: hdlctrl.v1.GetTotpStatusRequest
+	(*GetTotpStatusResponse)(nil),             // 34: hdlctrl.v1.GetTotpStatusResponse
+	(*BeginTotpEnrollmentRequest)(nil),        // 35: hdlctrl.v1.BeginTotpEnrollmentRequest
+	(*BeginTotpEnrollmentResponse)(nil),       // 36: hdlctrl.v1.BeginTotpEnrollmentResponse
+	(*ConfirmTotpEnrollmentRequest)(nil),      // 37: hdlctrl.v1.ConfirmTotpEnrollmentRequest
+	(*ConfirmTotpEnrollmentResponse)(nil),     // 38: hdlctrl.v1.ConfirmTotpEnrollmentResponse
+	(*DisableTotpRequest)(nil),                // 39: hdlctrl.v1.DisableTotpRequest
+	(*DisableTotpResponse)(nil),               // 40: hdlctrl.v1.DisableTotpResponse
+	(*UserSession)(nil),                       // 41: hdlctrl.v1.UserSession
+	(*ListMySessionsRequest)(nil),             // 42: hdlctrl.v1.ListMySessionsRequest
+	(*ListMySessionsResponse)(nil),            // 43: hdlctrl.v1.ListMySessionsResponse
+	(*RevokeSessionRequest)(nil),              // 44: hdlctrl.v1.RevokeSessionRequest
+	(*RevokeSessionResponse)(nil),             // 45: hdlctrl.v1.RevokeSessionResponse
+	(*timestamppb.Timestamp)(nil),             // 46: google.protobuf.Timestamp
 }
 var file_hdlctrl_v1_user_proto_depIdxs = []int32{
-	17, // 0: hdlctrl.v1.User.created_at:type_name -> google.protobuf.Timestamp
-	17, // 1: hdlctrl.v1.User.updated_at:type_name -> google.protobuf.Timestamp
-	8,  // 2: hdlctrl.v1.ListUsersResponse.users:type_name -> hdlctrl.v1.User
-	8,  // 3: hdlctrl.v1.GetUserResponse.user:type_name -> hdlctrl.v1.User
-	17, // 4: hdlctrl.v1.CreateRegistrationTokenResponse.expires_at:type_name -> google.protobuf.Timestamp
-	1,  // 5: hdlctrl.v1.UserService.GetTokenByPassword:input_type -> hdlctrl.v1.GetTokenByPasswordRequest
-	3,  // 6: hdlctrl.v1.UserService.ValidateRegistrationToken:input_type -> hdlctrl.v1.ValidateRegistrationTokenRequest
-	5,  // 7: hdlctrl.v1.UserService.RegisterWithToken:input_type -> hdlctrl.v1.RegisterWithTokenRequest
-	2,  // 8: hdlctrl.v1.UserService.RefreshToken:input_type -> hdlctrl.v1.RefreshTokenRequest
-	6,  // 9: hdlctrl.v1.UserService.ChangePassword:input_type -> hdlctrl.v1.ChangePasswordRequest
-	9,  // 10: hdlctrl.v1.UserService.ListUsers:input_type -> hdlctrl.v1.ListUsersRequest
-	11, // 11: hdlctrl.v1.UserService.GetUser:input_type -> hdlctrl.v1.GetUserRequest
-	13, // 12: hdlctrl.v1.UserService.CreateRegistrationToken:input_type -> hdlctrl.v1.CreateRegistrationTokenRequest
-	15, // 13: hdlctrl.v1.UserService.DeleteUser:input_type -> hdlctrl.v1.DeleteUserRequest
-	0,  // 14: hdlctrl.v1.UserService.GetTokenByPassword:output_type -> hdlctrl.v1.TokenSetResponse
-	4,  // 15: hdlctrl.v1.UserService.ValidateRegistrationToken:output_type -> hdlctrl.v1.ValidateRegistrationTokenResponse
-	0,  // 16: hdlctrl.v1.UserService.RegisterWithToken:output_type -> hdlctrl.v1.TokenSetResponse
-	0,  // 17: hdlctrl.v1.UserService.RefreshToken:output_type -> hdlctrl.v1.TokenSetResponse
-	7,  // 18: hdlctrl.v1.UserService.ChangePassword:output_type -> hdlctrl.v1.ChangePasswordResponse
-	10, // 19: hdlctrl.v1.UserService.ListUsers:output_type -> hdlctrl.v1.ListUsersResponse
-	12, // 20: hdlctrl.v1.UserService.GetUser:output_type -> hdlctrl.v1.GetUserResponse
-	14, // 21: hdlctrl.v1.UserService.CreateRegistrationToken:output_type -> hdlctrl.v1.CreateRegistrationTokenResponse
-	16, // 22: hdlctrl.v1.UserService.DeleteUser:output_type -> hdlctrl.v1.DeleteUserResponse
-	14, // [14:23] is the sub-list for method output_type
-	5,  // [5:14] is the sub-list for method input_type
-	5,  // [5:5] is the sub-list for extension type_name
-	5,  // [5:5] is the sub-list for extension extendee
-	0,  // [0:5] is the sub-list for field type_name
+	46, // 0: hdlctrl.v1.User.created_at:type_name -> google.protobuf.Timestamp
+	46, // 1: hdlctrl.v1.User.updated_at:type_name -> google.protobuf.Timestamp
+	17, // 2: hdlctrl.v1.ListUsersResponse.users:type_name -> hdlctrl.v1.User
+	17, // 3: hdlctrl.v1.GetUserResponse.user:type_name -> hdlctrl.v1.User
+	46, // 4: hdlctrl.v1.CreateRegistrationTokenResponse.expires_at:type_name -> google.protobuf.Timestamp
+	46, // 5: hdlctrl.v1.ApiToken.expires_at:type_name -> google.protobuf.Timestamp
+	46, // 6: hdlctrl.v1.ApiToken.last_used_at:type_name -> google.protobuf.Timestamp
+	46, // 7: hdlctrl.v1.ApiToken.revoked_at:type_name -> google.protobuf.Timestamp
+	46, // 8: hdlctrl.v1.ApiToken.created_at:type_name -> google.protobuf.Timestamp
+	46, // 9: hdlctrl.v1.CreateApiTokenRequest.expires_at:type_name -> google.protobuf.Timestamp
+	26, // 10: hdlctrl.v1.CreateApiTokenResponse.api_token:type_name -> hdlctrl.v1.ApiToken
+	26, // 11: hdlctrl.v1.ListApiTokensResponse.api_tokens:type_name -> hdlctrl.v1.ApiToken
+	0,  // 12: hdlctrl.v1.ConfirmTotpEnrollmentResponse.tokens:type_name -> hdlctrl.v1.TokenSetResponse
+	46, // 13: hdlctrl.v1.UserSession.created_at:type_name -> google.protobuf.Timestamp
+	46, // 14: hdlctrl.v1.UserSession.last_seen_at:type_name -> google.protobuf.Timestamp
+	46, // 15: hdlctrl.v1.UserSession.expires_at:type_name -> google.protobuf.Timestamp
+	41, // 16: hdlctrl.v1.ListMySessionsResponse.sessions:type_name -> hdlctrl.v1.UserSession
+	1,  // 17: hdlctrl.v1.UserService.GetTokenByPassword:input_type -> hdlctrl.v1.GetTokenByPasswordRequest
+	12, // 18: hdlctrl.v1.UserService.ValidateRegistrationToken:input_type -> hdlctrl.v1.ValidateRegistrationTokenRequest
+	14, // 19: hdlctrl.v1.UserService.RegisterWithToken:input_type -> hdlctrl.v1.RegisterWithTokenRequest
+	2,  // 20: hdlctrl.v1.UserService.GetLoginOptions:input_type -> hdlctrl.v1.GetLoginOptionsRequest
+	4,  // 21: hdlctrl.v1.UserService.BeginOidcLogin:input_type -> hdlctrl.v1.BeginOidcLoginRequest
+	6,  // 22: hdlctrl.v1.UserService.CompleteOidcLogin:input_type -> hdlctrl.v1.CompleteOidcLoginRequest
+	10, // 23: hdlctrl.v1.UserService.VerifyTotpLogin:input_type -> hdlctrl.v1.VerifyTotpLoginRequest
+	11, // 24: hdlctrl.v1.UserService.RefreshToken:input_type -> hdlctrl.v1.RefreshTokenRequest
+	15, // 25: hdlctrl.v1.UserService.ChangePassword:input_type -> hdlctrl.v1.ChangePasswordRequest
+	18, // 26: hdlctrl.v1.UserService.ListUsers:input_type -> hdlctrl.v1.ListUsersRequest
+	20, // 27: hdlctrl.v1.UserService.GetUser:input_type -> hdlctrl.v1.GetUserRequest
+	22, // 28: hdlctrl.v1.UserService.CreateRegistrationToken:input_type -> hdlctrl.v1.CreateRegistrationTokenRequest
+	24, // 29: hdlctrl.v1.UserService.DeleteUser:input_type -> hdlctrl.v1.DeleteUserRequest
+	27, // 30: hdlctrl.v1.UserService.CreateApiToken:input_type -> hdlctrl.v1.CreateApiTokenRequest
+	29, // 31: hdlctrl.v1.UserService.ListApiTokens:input_type -> hdlctrl.v1.ListApiTokensRequest
+	31, // 32: hdlctrl.v1.UserService.RevokeApiToken:input_type -> hdlctrl.v1.RevokeApiTokenRequest
+	33, // 33: hdlctrl.v1.UserService.GetTotpStatus:input_type -> hdlctrl.v1.GetTotpStatusRequest
+	35, // 34: hdlctrl.v1.UserService.BeginTotpEnrollment:input_type -> hdlctrl.v1.BeginTotpEnrollmentRequest
+	37, // 35: hdlctrl.v1.UserService.ConfirmTotpEnrollment:input_type -> hdlctrl.v1.ConfirmTotpEnrollmentRequest
+	39, // 36: hdlctrl.v1.UserService.DisableTotp:input_type -> hdlctrl.v1.DisableTotpRequest
+	42, // 37: hdlctrl.v1.UserService.ListMySessions:input_type -> hdlctrl.v1.ListMySessionsRequest
+	44, // 38: hdlctrl.v1.UserService.RevokeSession:input_type -> hdlctrl.v1.RevokeSessionRequest
+	7,  // 39: hdlctrl.v1.UserService.BeginOidcLink:input_type -> hdlctrl.v1.BeginOidcLinkRequest
+	8,  // 40: hdlctrl.v1.UserService.CompleteOidcLink:input_type -> hdlctrl.v1.CompleteOidcLinkRequest
+	0,  // 41: hdlctrl.v1.UserService.GetTokenByPassword:output_type -> hdlctrl.v1.TokenSetResponse
+	13, // 42: hdlctrl.v1.UserService.ValidateRegistrationToken:output_type -> hdlctrl.v1.ValidateRegistrationTokenResponse
+	0,  // 43: hdlctrl.v1.UserService.RegisterWithToken:output_type -> hdlctrl.v1.TokenSetResponse
+	3,  // 44: hdlctrl.v1.UserService.GetLoginOptions:output_type -> hdlctrl.v1.GetLoginOptionsResponse
+	5,  // 45: hdlctrl.v1.UserService.BeginOidcLogin:output_type -> hdlctrl.v1.BeginOidcLoginResponse
+	0,  // 46: hdlctrl.v1.UserService.CompleteOidcLogin:output_type -> hdlctrl.v1.TokenSetResponse
+	0,  // 47: hdlctrl.v1.UserService.VerifyTotpLogin:output_type -> hdlctrl.v1.TokenSetResponse
+	0,  // 48: hdlctrl.v1.UserService.RefreshToken:output_type -> hdlctrl.v1.TokenSetResponse
+	16, // 49: hdlctrl.v1.UserService.ChangePassword:output_type -> hdlctrl.v1.ChangePasswordResponse
+	19, // 50: hdlctrl.v1.UserService.ListUsers:output_type -> hdlctrl.v1.ListUsersResponse
+	21, // 51: hdlctrl.v1.UserService.GetUser:output_type -> hdlctrl.v1.GetUserResponse
+	23, // 52: hdlctrl.v1.UserService.CreateRegistrationToken:output_type -> hdlctrl.v1.CreateRegistrationTokenResponse
+	25, // 53: hdlctrl.v1.UserService.DeleteUser:output_type -> hdlctrl.v1.DeleteUserResponse
+	28, // 54: hdlctrl.v1.UserService.CreateApiToken:output_type -> hdlctrl.v1.CreateApiTokenResponse
+	30, // 55: hdlctrl.v1.UserService.ListApiTokens:output_type -> hdlctrl.v1.ListApiTokensResponse
+	32, // 56: hdlctrl.v1.UserService.RevokeApiToken:output_type -> hdlctrl.v1.RevokeApiTokenResponse
+	34, // 57: hdlctrl.v1.UserService.GetTotpStatus:output_type -> hdlctrl.v1.GetTotpStatusResponse
+	36, // 58: hdlctrl.v1.UserService.BeginTotpEnrollment:output_type -> hdlctrl.v1.BeginTotpEnrollmentResponse
+	38, // 59: hdlctrl.v1.UserService.ConfirmTotpEnrollment:output_type -> hdlctrl.v1.ConfirmTotpEnrollmentResponse
+	40, // 60: hdlctrl.v1.UserService.DisableTotp:output_type -> hdlctrl.v1.DisableTotpResponse
+	43, // 61: hdlctrl.v1.UserService.ListMySessions:output_type -> hdlctrl.v1.ListMySessionsResponse
+	45, // 62: hdlctrl.v1.UserService.RevokeSession:output_type -> hdlctrl.v1.RevokeSessionResponse
+	5,  // 63: hdlctrl.v1.UserService.BeginOidcLink:output_type -> hdlctrl.v1.BeginOidcLoginResponse
+	9,  // 64: hdlctrl.v1.UserService.CompleteOidcLink:output_type -> hdlctrl.v1.CompleteOidcLinkResponse
+	41, // [41:65] is the sub-list for method output_type
+	17, // [17:41] is the sub-list for method input_type
+	17, // [17:17] is the sub-list for extension type_name
+	17, // [17:17] is the sub-list for extension extendee
+	0,  // [0:17] is the sub-list for field type_name
 }
 
 func init() { file_hdlctrl_v1_user_proto_init() }
@@ -1013,14 +2649,16 @@ func file_hdlctrl_v1_user_proto_init() {
 	if File_hdlctrl_v1_user_proto != nil {
 		return
 	}
-	file_hdlctrl_v1_user_proto_msgTypes[13].OneofWrappers = []any{}
+	file_hdlctrl_v1_user_proto_msgTypes[22].OneofWrappers = []any{}
+	file_hdlctrl_v1_user_proto_msgTypes[26].OneofWrappers = []any{}
+	file_hdlctrl_v1_user_proto_msgTypes[27].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_hdlctrl_v1_user_proto_rawDesc), len(file_hdlctrl_v1_user_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   17,
+			NumMessages:   46,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
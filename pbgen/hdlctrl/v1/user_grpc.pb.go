@@ -22,12 +22,27 @@ const (
 	UserService_GetTokenByPassword_FullMethodName        = "/hdlctrl.v1.UserService/GetTokenByPassword"
 	UserService_ValidateRegistrationToken_FullMethodName = "/hdlctrl.v1.UserService/ValidateRegistrationToken"
 	UserService_RegisterWithToken_FullMethodName         = "/hdlctrl.v1.UserService/RegisterWithToken"
+	UserService_GetLoginOptions_FullMethodName           = "/hdlctrl.v1.UserService/GetLoginOptions"
+	UserService_BeginOidcLogin_FullMethodName            = "/hdlctrl.v1.UserService/BeginOidcLogin"
+	UserService_CompleteOidcLogin_FullMethodName         = "/hdlctrl.v1.UserService/CompleteOidcLogin"
+	UserService_VerifyTotpLogin_FullMethodName           = "/hdlctrl.v1.UserService/VerifyTotpLogin"
 	UserService_RefreshToken_FullMethodName              = "/hdlctrl.v1.UserService/RefreshToken"
 	UserService_ChangePassword_FullMethodName            = "/hdlctrl.v1.UserService/ChangePassword"
 	UserService_ListUsers_FullMethodName                 = "/hdlctrl.v1.UserService/ListUsers"
 	UserService_GetUser_FullMethodName                   = "/hdlctrl.v1.UserService/GetUser"
 	UserService_CreateRegistrationToken_FullMethodName   = "/hdlctrl.v1.UserService/CreateRegistrationToken"
 	UserService_DeleteUser_FullMethodName                = "/hdlctrl.v1.UserService/DeleteUser"
+	UserService_CreateApiToken_FullMethodName            = "/hdlctrl.v1.UserService/CreateApiToken"
+	UserService_ListApiTokens_FullMethodName             = "/hdlctrl.v1.UserService/ListApiTokens"
+	UserService_RevokeApiToken_FullMethodName            = "/hdlctrl.v1.UserService/RevokeApiToken"
+	UserService_GetTotpStatus_FullMethodName             = "/hdlctrl.v1.UserService/GetTotpStatus"
+	UserService_BeginTotpEnrollment_FullMethodName       = "/hdlctrl.v1.UserService/BeginTotpEnrollment"
+	UserService_ConfirmTotpEnrollment_FullMethodName     = "/hdlctrl.v1.UserService/ConfirmTotpEnrollment"
+	UserService_DisableTotp_FullMethodName               = "/hdlctrl.v1.UserService/DisableTotp"
+	UserService_ListMySessions_FullMethodName            = "/hdlctrl.v1.UserService/ListMySessions"
+	UserService_RevokeSession_FullMethodName             = "/hdlctrl.v1.UserService/RevokeSession"
+	UserService_BeginOidcLink_FullMethodName             = "/hdlctrl.v1.UserService/BeginOidcLink"
+	UserService_CompleteOidcLink_FullMethodName          = "/hdlctrl.v1.UserService/CompleteOidcLink"
 )
 
 // UserServiceClient is the client API for UserService service.
@@ -38,6 +53,15 @@ type UserServiceClient interface {
 	GetTokenByPassword(ctx context.Context, in *GetTokenByPasswordRequest, opts ...grpc.CallOption) (*TokenSetResponse, error)
 	ValidateRegistrationToken(ctx context.Context, in *ValidateRegistrationTokenRequest, opts ...grpc.CallOption) (*ValidateRegistrationTokenResponse, error)
 	RegisterWithToken(ctx context.Context, in *RegisterWithTokenRequest, opts ...grpc.CallOption) (*TokenSetResponse, error)
+	// サインイン画面に出す選択肢 (SSO の有無)
+	GetLoginOptions(ctx context.Context, in *GetLoginOptionsRequest, opts ...grpc.CallOption) (*GetLoginOptionsResponse, error)
+	// OIDC (認可コード + PKCE) でのサインイン. Begin で得た URL に遷移し、
+	// IdP から戻ってきた code / state で Complete する
+	BeginOidcLogin(ctx context.Context, in *BeginOidcLoginRequest, opts ...grpc.CallOption) (*BeginOidcLoginResponse, error)
+	CompleteOidcLogin(ctx context.Context, in *CompleteOidcLoginRequest, opts ...grpc.CallOption) (*TokenSetResponse, error)
+	// GetTokenByPassword が totp_challenge を返したときに、認証アプリのコードか
+	// リカバリーコードを送ってサインインを完了する
+	VerifyTotpLogin(ctx context.Context, in *VerifyTotpLoginRequest, opts ...grpc.CallOption) (*TokenSetResponse, error)
 	// 認証付きRPC
 	RefreshToken(ctx context.Context, in *RefreshTokenRequest, opts ...grpc.CallOption) (*TokenSetResponse, error)
 	ChangePassword(ctx context.Context, in *ChangePasswordRequest, opts ...grpc.CallOption) (*ChangePasswordResponse, error)
@@ -52,6 +76,33 @@ type UserServiceClient interface {
 	CreateRegistrationToken(ctx context.Context, in *CreateRegistrationTokenRequest, opts ...grpc.CallOption) (*CreateRegistrationTokenResponse, error)
 	// 指定 user_id のユーザーを削除する. 自分自身は削除できない.
 	DeleteUser(ctx context.Context, in *DeleteUserRequest, opts ...grpc.CallOption) (*DeleteUserResponse, error)
+	// API トークン (スクリプト / bot 向けの長期トークン). 自分のトークンのみ操作できる.
+	// 発行したトークンは Bearer ヘッダに付けて JWT の代わりに使える.
+	// API トークンで認証されたリクエストからは発行できない.
+	CreateApiToken(ctx context.Context, in *CreateApiTokenRequest, opts ...grpc.CallOption) (*CreateApiTokenResponse, error)
+	// 失効済み / 期限切れも含めて新しい順に返す.
+	ListApiTokens(ctx context.Context, in *ListApiTokensRequest, opts ...grpc.CallOption) (*ListApiTokensResponse, error)
+	RevokeApiToken(ctx context.Context, in *RevokeApiTokenRequest, opts ...grpc.CallOption) (*RevokeApiTokenResponse, error)
+	// 2 段階認証 (TOTP). 自分の設定のみ操作できる. API トークンからは操作できない.
+	GetTotpStatus(ctx context.Context, in *GetTotpStatusRequest, opts ...grpc.CallOption) (*GetTotpStatusResponse, error)
+	// 新しい secret を発行する. Confirm で最初のコードを確認するまでは有効にならない.
+	// 登録が必須でまだサインインできないユーザーは、access token の代わりに
+	// GetTokenByPassword で得た challenge を渡す.
+	BeginTotpEnrollment(ctx context.Context, in *BeginTotpEnrollmentRequest, opts ...grpc.CallOption) (*BeginTotpEnrollmentResponse, error)
+	ConfirmTotpEnrollment(ctx context.Context, in *ConfirmTotpEnrollmentRequest, opts ...grpc.CallOption) (*ConfirmTotpEnrollmentResponse, error)
+	// 必須のユーザーは無効にできない.
+	DisableTotp(ctx context.Context, in *DisableTotpRequest, opts ...grpc.CallOption) (*DisableTotpResponse, error)
+	// ログインセッション (サインインした端末). 自分のセッションのみ操作できる. API トークンからは操作できない.
+	// 有効なセッションを最後に使われた順に返す.
+	ListMySessions(ctx context.Context, in *ListMySessionsRequest, opts ...grpc.CallOption) (*ListMySessionsResponse, error)
+	// 失効させたセッションの access token / refresh token はすぐに使えなくなる.
+	// 今のセッションを指定するとサインアウトになる.
+	RevokeSession(ctx context.Context, in *RevokeSessionRequest, opts ...grpc.CallOption) (*RevokeSessionResponse, error)
+	// ログイン中のユーザーに IdP のアカウントを紐付ける. 以降はそのアカウントの SSO でサインインできる.
+	// Begin で得た URL に遷移し、IdP から戻ってきた code / state で Complete する.
+	// state は Begin を呼んだユーザーでしか Complete できない. API トークンからは操作できない.
+	BeginOidcLink(ctx context.Context, in *BeginOidcLinkRequest, opts ...grpc.CallOption) (*BeginOidcLoginResponse, error)
+	CompleteOidcLink(ctx context.Context, in *CompleteOidcLinkRequest, opts ...grpc.CallOption) (*CompleteOidcLinkResponse, error)
 }
 
 type userServiceClient struct {
@@ -92,6 +143,46 @@ func (c *userServiceClient) RegisterWithToken(ctx context.Context, in *RegisterW
 	return out, nil
 }
 
+func (c *userServiceClient) GetLoginOptions(ctx context.Context, in *GetLoginOptionsRequest, opts ...grpc.CallOption) (*GetLoginOptionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetLoginOptionsResponse)
+	err := c.cc.Invoke(ctx, UserService_GetLoginOptions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) BeginOidcLogin(ctx context.Context, in *BeginOidcLoginRequest, opts ...grpc.CallOption) (*BeginOidcLoginResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BeginOidcLoginResponse)
+	err := c.cc.Invoke(ctx, UserService_BeginOidcLogin_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) CompleteOidcLogin(ctx context.Context, in *CompleteOidcLoginRequest, opts ...grpc.CallOption) (*TokenSetResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TokenSetResponse)
+	err := c.cc.Invoke(ctx, UserService_CompleteOidcLogin_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) VerifyTotpLogin(ctx context.Context, in *VerifyTotpLoginRequest, opts ...grpc.CallOption) (*TokenSetResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TokenSetResponse)
+	err := c.cc.Invoke(ctx, UserService_VerifyTotpLogin_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *userServiceClient) RefreshToken(ctx context.Context, in *RefreshTokenRequest, opts ...grpc.CallOption) (*TokenSetResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(TokenSetResponse)
@@ -152,6 +243,116 @@ func (c *userServiceClient) DeleteUser(ctx context.Context, in *DeleteUserReques
 	return out, nil
 }
 
+func (c *userServiceClient) CreateApiToken(ctx context.Context, in *CreateApiTokenRequest, opts ...grpc.CallOption) (*CreateApiTokenResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateApiTokenResponse)
+	err := c.cc.Invoke(ctx, UserService_CreateApiToken_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) ListApiTokens(ctx context.Context, in *ListApiTokensRequest, opts ...grpc.CallOption) (*ListApiTokensResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListApiTokensResponse)
+	err := c.cc.Invoke(ctx, UserService_ListApiTokens_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) RevokeApiToken(ctx context.Context, in *RevokeApiTokenRequest, opts ...grpc.CallOption) (*RevokeApiTokenResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RevokeApiTokenResponse)
+	err := c.cc.Invoke(ctx, UserService_RevokeApiToken_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) GetTotpStatus(ctx context.Context, in *GetTotpStatusRequest, opts ...grpc.CallOption) (*GetTotpStatusResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetTotpStatusResponse)
+	err := c.cc.Invoke(ctx, UserService_GetTotpStatus_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) BeginTotpEnrollment(ctx context.Context, in *BeginTotpEnrollmentRequest, opts ...grpc.CallOption) (*BeginTotpEnrollmentResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BeginTotpEnrollmentResponse)
+	err := c.cc.Invoke(ctx, UserService_BeginTotpEnrollment_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) ConfirmTotpEnrollment(ctx context.Context, in *ConfirmTotpEnrollmentRequest, opts ...grpc.CallOption) (*ConfirmTotpEnrollmentResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ConfirmTotpEnrollmentResponse)
+	err := c.cc.Invoke(ctx, UserService_ConfirmTotpEnrollment_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) DisableTotp(ctx context.Context, in *DisableTotpRequest, opts ...grpc.CallOption) (*DisableTotpResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DisableTotpResponse)
+	err := c.cc.Invoke(ctx, UserService_DisableTotp_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) ListMySessions(ctx context.Context, in *ListMySessionsRequest, opts ...grpc.CallOption) (*ListMySessionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListMySessionsResponse)
+	err := c.cc.Invoke(ctx, UserService_ListMySessions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) RevokeSession(ctx context.Context, in *RevokeSessionRequest, opts ...grpc.CallOption) (*RevokeSessionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RevokeSessionResponse)
+	err := c.cc.Invoke(ctx, UserService_RevokeSession_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) BeginOidcLink(ctx context.Context, in *BeginOidcLinkRequest, opts ...grpc.CallOption) (*BeginOidcLoginResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BeginOidcLoginResponse)
+	err := c.cc.Invoke(ctx, UserService_BeginOidcLink_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) CompleteOidcLink(ctx context.Context, in *CompleteOidcLinkRequest, opts ...grpc.CallOption) (*CompleteOidcLinkResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CompleteOidcLinkResponse)
+	err := c.cc.Invoke(ctx, UserService_CompleteOidcLink_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // UserServiceServer is the server API for UserService service.
 // All implementations must embed UnimplementedUserServiceServer
 // for forward compatibility.
@@ -160,6 +361,15 @@ type UserServiceServer interface {
 	GetTokenByPassword(context.Context, *GetTokenByPasswordRequest) (*TokenSetResponse, error)
 	ValidateRegistrationToken(context.Context, *ValidateRegistrationTokenRequest) (*ValidateRegistrationTokenResponse, error)
 	RegisterWithToken(context.Context, *RegisterWithTokenRequest) (*TokenSetResponse, error)
+	// サインイン画面に出す選択肢 (SSO の有無)
+	GetLoginOptions(context.Context, *GetLoginOptionsRequest) (*GetLoginOptionsResponse, error)
+	// OIDC (認可コード + PKCE) でのサインイン. Begin で得た URL に遷移し、
+	// IdP から戻ってきた code / state で Complete する
+	BeginOidcLogin(context.Context, *BeginOidcLoginRequest) (*BeginOidcLoginResponse, error)
+	CompleteOidcLogin(context.Context, *CompleteOidcLoginRequest) (*TokenSetResponse, error)
+	// GetTokenByPassword が totp_challenge を返したときに、認証アプリのコードか
+	// リカバリーコードを送ってサインインを完了する
+	VerifyTotpLogin(context.Context, *VerifyTotpLoginRequest) (*TokenSetResponse, error)
 	// 認証付きRPC
 	RefreshToken(context.Context, *RefreshTokenRequest) (*TokenSetResponse, error)
 	ChangePassword(context.Context, *ChangePasswordRequest) (*ChangePasswordResponse, error)
@@ -174,6 +384,33 @@ type UserServiceServer interface {
 	CreateRegistrationToken(context.Context, *CreateRegistrationTokenRequest) (*CreateRegistrationTokenResponse, error)
 	// 指定 user_id のユーザーを削除する. 自分自身は削除できない.
 	DeleteUser(context.Context, *DeleteUserRequest) (*DeleteUserResponse, error)
+	// API トークン (スクリプト / bot 向けの長期トークン). 自分のトークンのみ操作できる.
+	// 発行したトークンは Bearer ヘッダに付けて JWT の代わりに使える.
+	// API トークンで認証されたリクエストからは発行できない.
+	CreateApiToken(context.Context, *CreateApiTokenRequest) (*CreateApiTokenResponse, error)
+	// 失効済み / 期限切れも含めて新しい順に返す.
+	ListApiTokens(context.Context, *ListApiTokensRequest) (*ListApiTokensResponse, error)
+	RevokeApiToken(context.Context, *RevokeApiTokenRequest) (*RevokeApiTokenResponse, error)
+	// 2 段階認証 (TOTP). 自分の設定のみ操作できる. API トークンからは操作できない.
+	GetTotpStatus(context.Context, *GetTotpStatusRequest) (*GetTotpStatusResponse, error)
+	// 新しい secret を発行する. Confirm で最初のコードを確認するまでは有効にならない.
+	// 登録が必須でまだサインインできないユーザーは、access token の代わりに
+	// GetTokenByPassword で得た challenge を渡す.
+	BeginTotpEnrollment(context.Context, *BeginTotpEnrollmentRequest) (*BeginTotpEnrollmentResponse, error)
+	ConfirmTotpEnrollment(context.Context, *ConfirmTotpEnrollmentRequest) (*ConfirmTotpEnrollmentResponse, error)
+	// 必須のユーザーは無効にできない.
+	DisableTotp(context.Context, *DisableTotpRequest) (*DisableTotpResponse, error)
+	// ログインセッション (サインインした端末). 自分のセッションのみ操作できる. API トークンからは操作できない.
+	// 有効なセッションを最後に使われた順に返す.
+	ListMySessions(context.Context, *ListMySessionsRequest) (*ListMySessionsResponse, error)
+	// 失効させたセッションの access token / refresh token はすぐに使えなくなる.
+	// 今のセッションを指定するとサインアウトになる.
+	RevokeSession(context.Context, *RevokeSessionRequest) (*RevokeSessionResponse, error)
+	// ログイン中のユーザーに IdP のアカウントを紐付ける. 以降はそのアカウントの SSO でサインインできる.
+	// Begin で得た URL に遷移し、IdP から戻ってきた code / state で Complete する.
+	// state は Begin を呼んだユーザーでしか Complete できない. API トークンからは操作できない.
+	BeginOidcLink(context.Context, *BeginOidcLinkRequest) (*BeginOidcLoginResponse, error)
+	CompleteOidcLink(context.Context, *CompleteOidcLinkRequest) (*CompleteOidcLinkResponse, error)
 	mustEmbedUnimplementedUserServiceServer()
 }
 
@@ -193,6 +430,18 @@ func (UnimplementedUserServiceServer) ValidateRegistrationToken(context.Context,
 func (UnimplementedUserServiceServer) RegisterWithToken(context.Context, *RegisterWithTokenRequest) (*TokenSetResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method RegisterWithToken not implemented")
 }
+func (UnimplementedUserServiceServer) GetLoginOptions(context.Context, *GetLoginOptionsRequest) (*GetLoginOptionsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetLoginOptions not implemented")
+}
+func (UnimplementedUserServiceServer) BeginOidcLogin(context.Context, *BeginOidcLoginRequest) (*BeginOidcLoginResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method BeginOidcLogin not implemented")
+}
+func (UnimplementedUserServiceServer) CompleteOidcLogin(context.Context, *CompleteOidcLoginRequest) (*TokenSetResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method CompleteOidcLogin not implemented")
+}
+func (UnimplementedUserServiceServer) VerifyTotpLogin(context.Context, *VerifyTotpLoginRequest) (*TokenSetResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method VerifyTotpLogin not implemented")
+}
 func (UnimplementedUserServiceServer) RefreshToken(context.Context, *RefreshTokenRequest) (*TokenSetResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method RefreshToken not implemented")
 }
@@ -211,6 +460,39 @@ func (UnimplementedUserServiceServer) CreateRegistrationToken(context.Context, *
 func (UnimplementedUserServiceServer) DeleteUser(context.Context, *DeleteUserRequest) (*DeleteUserResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method DeleteUser not implemented")
 }
+func (UnimplementedUserServiceServer) CreateApiToken(context.Context, *CreateApiTokenRequest) (*CreateApiTokenResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method CreateApiToken not implemented")
+}
+func (UnimplementedUserServiceServer) ListApiTokens(context.Context, *ListApiTokensRequest) (*ListApiTokensResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListApiTokens not implemented")
+}
+func (UnimplementedUserServiceServer) RevokeApiToken(context.Context, *RevokeApiTokenRequest) (*RevokeApiTokenResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method RevokeApiToken not implemented")
+}
+func (UnimplementedUserServiceServer) GetTotpStatus(context.Context, *GetTotpStatusRequest) (*GetTotpStatusResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetTotpStatus not implemented")
+}
+func (UnimplementedUserServiceServer) BeginTotpEnrollment(context.Context, *BeginTotpEnrollmentRequest) (*BeginTotpEnrollmentResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method BeginTotpEnrollment not implemented")
+}
+func (UnimplementedUserServiceServer) ConfirmTotpEnrollment(context.Context, *ConfirmTotpEnrollmentRequest) (*ConfirmTotpEnrollmentResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ConfirmTotpEnrollment not implemented")
+}
+func (UnimplementedUserServiceServer) DisableTotp(context.Context, *DisableTotpRequest) (*DisableTotpResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method DisableTotp not implemented")
+}
+func (UnimplementedUserServiceServer) ListMySessions(context.Context, *ListMySessionsRequest) (*ListMySessionsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListMySessions not implemented")
+}
+func (UnimplementedUserServiceServer) RevokeSession(context.Context, *RevokeSessionRequest) (*RevokeSessionResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method RevokeSession not implemented")
+}
+func (UnimplementedUserServiceServer) BeginOidcLink(context.Context, *BeginOidcLinkRequest) (*BeginOidcLoginResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method BeginOidcLink not implemented")
+}
+func (UnimplementedUserServiceServer) CompleteOidcLink(context.Context, *CompleteOidcLinkRequest) (*CompleteOidcLinkResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method CompleteOidcLink not implemented")
+}
 func (UnimplementedUserServiceServer) mustEmbedUnimplementedUserServiceServer() {}
 func (UnimplementedUserServiceServer) testEmbeddedByValue()                     {}
 
@@ -286,6 +568,78 @@ func _UserService_RegisterWithToken_Handler(srv interface{}, ctx context.Context
 	return interceptor(ctx, in, info, handler)
 }
 
+func _UserService_GetLoginOptions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetLoginOptionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).GetLoginOptions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_GetLoginOptions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).GetLoginOptions(ctx, req.(*GetLoginOptionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_BeginOidcLogin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BeginOidcLoginRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).BeginOidcLogin(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_BeginOidcLogin_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).BeginOidcLogin(ctx, req.(*BeginOidcLoginRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_CompleteOidcLogin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CompleteOidcLoginRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).CompleteOidcLogin(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_CompleteOidcLogin_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).CompleteOidcLogin(ctx, req.(*CompleteOidcLoginRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_VerifyTotpLogin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VerifyTotpLoginRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).VerifyTotpLogin(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_VerifyTotpLogin_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).VerifyTotpLogin(ctx, req.(*VerifyTotpLoginRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _UserService_RefreshToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(RefreshTokenRequest)
 	if err := dec(in); err != nil {
@@ -394,6 +748,204 @@ func _UserService_DeleteUser_Handler(srv interface{}, ctx context.Context, dec f
 	return interceptor(ctx, in, info, handler)
 }
 
+func _UserService_CreateApiToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateApiTokenRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).CreateApiToken(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_CreateApiToken_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).CreateApiToken(ctx, req.(*CreateApiTokenRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_ListApiTokens_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListApiTokensRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).ListApiTokens(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_ListApiTokens_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).ListApiTokens(ctx, req.(*ListApiTokensRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_RevokeApiToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevokeApiTokenRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).RevokeApiToken(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_RevokeApiToken_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).RevokeApiToken(ctx, req.(*RevokeApiTokenRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_GetTotpStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTotpStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).GetTotpStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_GetTotpStatus_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).GetTotpStatus(ctx, req.(*GetTotpStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_BeginTotpEnrollment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BeginTotpEnrollmentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).BeginTotpEnrollment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_BeginTotpEnrollment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).BeginTotpEnrollment(ctx, req.(*BeginTotpEnrollmentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_ConfirmTotpEnrollment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ConfirmTotpEnrollmentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).ConfirmTotpEnrollment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_ConfirmTotpEnrollment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).ConfirmTotpEnrollment(ctx, req.(*ConfirmTotpEnrollmentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_DisableTotp_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DisableTotpRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).DisableTotp(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_DisableTotp_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).DisableTotp(ctx, req.(*DisableTotpRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_ListMySessions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListMySessionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).ListMySessions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_ListMySessions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).ListMySessions(ctx, req.(*ListMySessionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_RevokeSession_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevokeSessionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).RevokeSession(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_RevokeSession_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).RevokeSession(ctx, req.(*RevokeSessionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_BeginOidcLink_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BeginOidcLinkRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).BeginOidcLink(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_BeginOidcLink_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).BeginOidcLink(ctx, req.(*BeginOidcLinkRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_CompleteOidcLink_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CompleteOidcLinkRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).CompleteOidcLink(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_CompleteOidcLink_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).CompleteOidcLink(ctx, req.(*CompleteOidcLinkRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // UserService_ServiceDesc is the grpc.ServiceDesc for UserService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -413,6 +965,22 @@ var UserService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "RegisterWithToken",
 			Handler:    _UserService_RegisterWithToken_Handler,
 		},
+		{
+			MethodName: "GetLoginOptions",
+			Handler:    _UserService_GetLoginOptions_Handler,
+		},
+		{
+			MethodName: "BeginOidcLogin",
+			Handler:    _UserService_BeginOidcLogin_Handler,
+		},
+		{
+			MethodName: "CompleteOidcLogin",
+			Handler:    _UserService_CompleteOidcLogin_Handler,
+		},
+		{
+			MethodName: "VerifyTotpLogin",
+			Handler:    _UserService_VerifyTotpLogin_Handler,
+		},
 		{
 			MethodName: "RefreshToken",
 			Handler:    _UserService_RefreshToken_Handler,
@@ -437,6 +1005,50 @@ var UserService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteUser",
 			Handler:    _UserService_DeleteUser_Handler,
 		},
+		{
+			MethodName: "CreateApiToken",
+			Handler:    _UserService_CreateApiToken_Handler,
+		},
+		{
+			MethodName: "ListApiTokens",
+			Handler:    _UserService_ListApiTokens_Handler,
+		},
+		{
+			MethodName: "RevokeApiToken",
+			Handler:    _UserService_RevokeApiToken_Handler,
+		},
+		{
+			MethodName: "GetTotpStatus",
+			Handler:    _UserService_GetTotpStatus_Handler,
+		},
+		{
+			MethodName: "BeginTotpEnrollment",
+			Handler:    _UserService_BeginTotpEnrollment_Handler,
+		},
+		{
+			MethodName: "ConfirmTotpEnrollment",
+			Handler:    _UserService_ConfirmTotpEnrollment_Handler,
+		},
+		{
+			MethodName: "DisableTotp",
+			Handler:    _UserService_DisableTotp_Handler,
+		},
+		{
+			MethodName: "ListMySessions",
+			Handler:    _UserService_ListMySessions_Handler,
+		},
+		{
+			MethodName: "RevokeSession",
+			Handler:    _UserService_RevokeSession_Handler,
+		},
+		{
+			MethodName: "BeginOidcLink",
+			Handler:    _UserService_BeginOidcLink_Handler,
+		},
+		{
+			MethodName: "CompleteOidcLink",
+			Handler:    _UserService_CompleteOidcLink_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "hdlctrl/v1/user.proto",
@@ -111,18 +111,36 @@ else
   echo "   RustFS関連の設定は既に存在します"
 fi
 
-echo "4. データベースマイグレーションを実行中..."
+# ヘッドレスアカウントの認証情報の暗号化鍵を追加 (未設定の場合のみ)
+echo "4. 認証情報の暗号化鍵を確認中..."
+NEEDS_CREDENTIAL_KEY_SETUP=false
+if ! grep -q "^CREDENTIAL_ENCRYPTION_KEYS=" .env 2>/dev/null; then
+  cat >> .env << EOF
+
+# ヘッドレスアカウントの認証情報の暗号化鍵 (紛失すると登録済みのアカウントを再登録する必要があります)
+CREDENTIAL_ENCRYPTION_KEYS="k1:$(openssl rand -base64 32)"
+EOF
+  echo "   CREDENTIAL_ENCRYPTION_KEYSを.envに追加しました"
+  NEEDS_CREDENTIAL_KEY_SETUP=true
+else
+  echo "   CREDENTIAL_ENCRYPTION_KEYSは既に存在します"
+fi
+
+echo "5. データベースマイグレーションを実行中..."
 ./brhcli migrate
 
+echo "6. 平文で保存されているアカウントの認証情報を暗号化中..."
+./brhcli accounts reencrypt
+
 # container_logsテーブルが新規作成された場合のみ、fluentbit関連のセットアップを実行
 if [ "$NEEDS_CONTAINER_LOGS_SETUP" = "true" ]; then
-  echo "5. fluentbitユーザーのパスワードを設定中..."
+  echo "7. fluentbitユーザーのパスワードを設定中..."
   FLUENTBIT_PGSQL_PASSWORD="$(openssl rand -base64 32)"
   docker compose -f docker-compose.db.yml exec -T db psql -U postgres -d brhcdb -c "ALTER USER fluentbit WITH PASSWORD '${FLUENTBIT_PGSQL_PASSWORD}';"
   echo "FLUENTBIT_PGPASSWORD=\"${FLUENTBIT_PGSQL_PASSWORD}\"" >> .env
   echo "   FLUENTBIT_PGPASSWORDを.envに追加しました"
 else
-  echo "5. container_logsテーブルは既に存在するため、fluentbit関連のセットアップをスキップします"
+  echo "7. container_logsテーブルは既に存在するため、fluentbit関連のセットアップをスキップします"
 
   # FLUENTBIT_PGPASSWORDが.envに存在しない場合は警告
   if ! grep -q "^FLUENTBIT_PGPASSWORD=" .env 2>/dev/null; then
@@ -133,7 +151,7 @@ else
 fi
 
 # fluentdコンテナの再起動
-echo "6. fluentdコンテナを再起動中..."
+echo "8. fluentdコンテナを再起動中..."
 if docker compose -f docker-compose.db.yml ps fluentd 2>/dev/null | grep -q "Up"; then
   docker compose -f docker-compose.db.yml restart fluentd
   echo "   fluentdコンテナを再起動しました"
@@ -143,7 +161,7 @@ else
 fi
 
 # rustfsコンテナの起動
-echo "7. rustfsコンテナを起動中..."
+echo "9. rustfsコンテナを起動中..."
 if docker compose -f docker-compose.db.yml ps rustfs 2>/dev/null | grep -q "Up"; then
   echo "   rustfsコンテナは既に起動しています"
 else
@@ -164,5 +182,9 @@ echo "- fluentdコンテナを再起動"
 if [ "$NEEDS_RUSTFS_SETUP" = "true" ]; then
   echo "- RustFS関連の設定を.envに追加"
 fi
+if [ "$NEEDS_CREDENTIAL_KEY_SETUP" = "true" ]; then
+  echo "- CREDENTIAL_ENCRYPTION_KEYSを.envに追加"
+fi
+echo "- アカウントの認証情報を暗号化"
 echo "- rustfsコンテナを起動"
 echo ""
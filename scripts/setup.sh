@@ -17,6 +17,7 @@ curl -o "brhcli" -L https://github.com/hantabaru1014/baru-reso-headless-controll
 chmod a+x brhcli
 
 JWT_SECRET="$(openssl rand -base64 32)"
+CREDENTIAL_ENCRYPTION_KEY="$(openssl rand -base64 32)"
 POSTGRES_PASSWORD="$(openssl rand -base64 32)"
 FLUENTBIT_PGSQL_PASSWORD="$(openssl rand -base64 32)"
 RUSTFS_ACCESS_KEY="$(openssl rand -hex 8)"
@@ -50,6 +51,7 @@ DB_URL=${DB_URL:-"postgres://postgres:$(echo $POSTGRES_PASSWORD | jq -Rr @uri)@l
 
 cat > .env << EOF
 JWT_SECRET="${JWT_SECRET}"
+CREDENTIAL_ENCRYPTION_KEYS="k1:${CREDENTIAL_ENCRYPTION_KEY}"
 HEADLESS_IMAGE_NAME="${HEADLESS_IMAGE_NAME}"
 HEADLESS_REGISTRY_AUTH="${HEADLESS_REGISTRY_AUTH}"
 GHCR_AUTH_TOKEN="${GHCR_AUTH_TOKEN}"
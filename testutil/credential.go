@@ -0,0 +1,48 @@
+package testutil
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/hantabaru1014/baru-reso-headless-controller/adapter"
+	"github.com/hantabaru1014/baru-reso-headless-controller/db"
+	"github.com/hantabaru1014/baru-reso-headless-controller/lib/secretbox"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+// NewTestCredentialCipher は固定鍵の CredentialCipher を返す. CreateTestHeadlessAccount が
+// 暗号化に使う鍵と同じなので、テスト対象の usecase にはこれを渡す.
+func NewTestCredentialCipher() *adapter.CredentialCipher {
+	return adapter.NewCredentialCipher(testKeyring())
+}
+
+// NewTestTOTPSecretCipher は NewTestCredentialCipher と同じ固定鍵の TOTPSecretCipher を返す.
+func NewTestTOTPSecretCipher() *adapter.TOTPSecretCipher {
+	return adapter.NewTOTPSecretCipher(testKeyring())
+}
+
+func testKeyring() *secretbox.Keyring {
+	keyring, err := secretbox.NewKeyring(map[string][]byte{"test": bytes.Repeat([]byte{0x42}, secretbox.KeySize)}, "test")
+	if err != nil {
+		panic(err)
+	}
+
+	return keyring
+}
+
+func sealedAccountParams(t *testing.T, resoniteID, credential, password string) db.CreateHeadlessAccountParams {
+	t.Helper()
+
+	sealed, err := NewTestCredentialCipher().Seal(resoniteID, credential, password)
+	if err != nil {
+		t.Fatalf("failed to seal test credentials: %v", err)
+	}
+
+	return db.CreateHeadlessAccountParams{
+		ResoniteID:          resoniteID,
+		CredentialKeyID:     pgtype.Text{String: sealed.KeyID, Valid: true},
+		CredentialDataKey:   sealed.DataKey,
+		EncryptedCredential: sealed.Credential,
+		EncryptedPassword:   sealed.Password,
+	}
+}
@@ -55,15 +55,12 @@ func CreateTestHeadlessAccount(t *testing.T, queries *db.Queries, resoniteID, cr
 	displayName := pgtype.Text{String: "Test Headless Account", Valid: true}
 	iconURL := pgtype.Text{String: "https://example.com/headless-icon.png", Valid: true}
 
-	err := queries.CreateHeadlessAccount(t.Context(), db.CreateHeadlessAccountParams{
-		ResoniteID:      resoniteID,
-		Credential:      credential,
-		Password:        password,
-		LastDisplayName: displayName,
-		LastIconUrl:     iconURL,
-		GroupID:         entity.MigratedPrePermissionGroupID,
-		CreatedBy:       pgtype.Text{Valid: false},
-	})
+	params := sealedAccountParams(t, resoniteID, credential, password)
+	params.LastDisplayName = displayName
+	params.LastIconUrl = iconURL
+	params.GroupID = entity.MigratedPrePermissionGroupID
+
+	err := queries.CreateHeadlessAccount(t.Context(), params)
 	if err != nil {
 		t.Fatalf("failed to create test headless account: %v", err)
 	}
@@ -98,6 +95,7 @@ func CreateTestHeadlessHost(t *testing.T, queries *db.Queries, accountID, name s
 		AutoUpdatePolicy:               int32(entity.HostAutoUpdatePolicy_UNSPECIFIED),
 		Memo:                           pgtype.Text{Valid: false},
 		InstanceCount:                  1,
+		ContainerSettings:              []byte("{}"),
 		StartedAt: pgtype.Timestamptz{
 			Valid: true,
 			Time:  time.Now(),
@@ -300,6 +298,7 @@ func CreateTestHeadlessHostInGroup(t *testing.T, queries *db.Queries, accountID,
 		AutoUpdatePolicy:               int32(entity.HostAutoUpdatePolicy_UNSPECIFIED),
 		Memo:                           pgtype.Text{Valid: false},
 		InstanceCount:                  1,
+		ContainerSettings:              []byte("{}"),
 		StartedAt: pgtype.Timestamptz{
 			Valid: true,
 			Time:  time.Now(),
@@ -321,15 +320,12 @@ func CreateTestHeadlessAccountInGroup(t *testing.T, queries *db.Queries, resonit
 	displayName := pgtype.Text{String: "Test Headless Account", Valid: true}
 	iconURL := pgtype.Text{String: "https://example.com/headless-icon.png", Valid: true}
 
-	err := queries.CreateHeadlessAccount(t.Context(), db.CreateHeadlessAccountParams{
-		ResoniteID:      resoniteID,
-		Credential:      credential,
-		Password:        password,
-		LastDisplayName: displayName,
-		LastIconUrl:     iconURL,
-		GroupID:         groupID,
-		CreatedBy:       pgtype.Text{Valid: false},
-	})
+	params := sealedAccountParams(t, resoniteID, credential, password)
+	params.LastDisplayName = displayName
+	params.LastIconUrl = iconURL
+	params.GroupID = groupID
+
+	err := queries.CreateHeadlessAccount(t.Context(), params)
 	if err != nil {
 		t.Fatalf("failed to create test headless account in group %q: %v", groupID, err)
 	}
@@ -0,0 +1,247 @@
+package testutil
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/dchest/uniuri"
+	"github.com/go-jose/go-jose/v4"
+	"github.com/go-jose/go-jose/v4/jwt"
+)
+
+// MockOIDCProvider is a minimal OpenID Connect provider for tests. It serves
+// discovery, JWKS, an authorization endpoint that signs in whoever was set
+// with SetUser without showing a page, and a token endpoint that checks the
+// PKCE verifier.
+type MockOIDCProvider struct {
+	Server   *httptest.Server
+	ClientID string
+
+	key *rsa.PrivateKey
+
+	mu      sync.Mutex
+	subject string
+	claims  map[string]any
+	codes   map[string]mockOIDCCode
+}
+
+type mockOIDCCode struct {
+	subject       string
+	claims        map[string]any
+	nonce         string
+	codeChallenge string
+	redirectURI   string
+}
+
+// NewMockOIDCProvider starts a mock provider that is closed when the test ends.
+func NewMockOIDCProvider(t *testing.T, clientID string) *MockOIDCProvider {
+	t.Helper()
+
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatalf("failed to generate oidc signing key: %v", err)
+	}
+
+	p := &MockOIDCProvider{
+		ClientID: clientID,
+		key:      key,
+		codes:    map[string]mockOIDCCode{},
+	}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /.well-known/openid-configuration", p.handleDiscovery)
+	mux.HandleFunc("GET /jwks", p.handleJWKS)
+	mux.HandleFunc("GET /authorize", p.handleAuthorize)
+	mux.HandleFunc("POST /token", p.handleToken)
+
+	p.Server = httptest.NewServer(mux)
+	t.Cleanup(p.Server.Close)
+
+	return p
+}
+
+// Issuer returns the issuer URL to configure the client with.
+func (p *MockOIDCProvider) Issuer() string {
+	return p.Server.URL
+}
+
+// SetUser sets the account the next authorization signs in as.
+func (p *MockOIDCProvider) SetUser(subject string, claims map[string]any) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.subject = subject
+	p.claims = claims
+}
+
+// Authorize follows authURL like a browser would and returns the code and
+// state the provider redirected back with.
+func (p *MockOIDCProvider) Authorize(t *testing.T, authURL string) (code, state string) {
+	t.Helper()
+
+	client := &http.Client{
+		CheckRedirect: func(*http.Request, []*http.Request) error {
+			return http.ErrUseLastResponse
+		},
+	}
+
+	res, err := client.Get(authURL) //nolint:noctx // test helper
+	if err != nil {
+		t.Fatalf("failed to call authorization endpoint: %v", err)
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode != http.StatusFound {
+		t.Fatalf("authorization endpoint returned %d", res.StatusCode)
+	}
+
+	loc, err := url.Parse(res.Header.Get("Location"))
+	if err != nil {
+		t.Fatalf("invalid redirect location: %v", err)
+	}
+
+	return loc.Query().Get("code"), loc.Query().Get("state")
+}
+
+func (p *MockOIDCProvider) handleDiscovery(w http.ResponseWriter, _ *http.Request) {
+	writeJSON(w, map[string]any{
+		"issuer":                                p.Issuer(),
+		"authorization_endpoint":                p.Issuer() + "/authorize",
+		"token_endpoint":                        p.Issuer() + "/token",
+		"jwks_uri":                              p.Issuer() + "/jwks",
+		"response_types_supported":              []string{"code"},
+		"subject_types_supported":               []string{"public"},
+		"id_token_signing_alg_values_supported": []string{"RS256"},
+		"code_challenge_methods_supported":      []string{"S256"},
+	})
+}
+
+func (p *MockOIDCProvider) handleJWKS(w http.ResponseWriter, _ *http.Request) {
+	writeJSON(w, jose.JSONWebKeySet{Keys: []jose.JSONWebKey{{
+		Key:       &p.key.PublicKey,
+		KeyID:     "test",
+		Algorithm: string(jose.RS256),
+		Use:       "sig",
+	}}})
+}
+
+func (p *MockOIDCProvider) handleAuthorize(w http.ResponseWriter, r *http.Request) {
+	q := r.URL.Query()
+	if q.Get("client_id") != p.ClientID || q.Get("response_type") != "code" || q.Get("code_challenge_method") != "S256" {
+		http.Error(w, "invalid authorization request", http.StatusBadRequest)
+
+		return
+	}
+
+	p.mu.Lock()
+	if p.subject == "" {
+		p.mu.Unlock()
+		http.Error(w, "no user is set", http.StatusForbidden)
+
+		return
+	}
+
+	code := uniuri.NewLen(32) //nolint:mnd // test value
+	p.codes[code] = mockOIDCCode{
+		subject:       p.subject,
+		claims:        p.claims,
+		nonce:         q.Get("nonce"),
+		codeChallenge: q.Get("code_challenge"),
+		redirectURI:   q.Get("redirect_uri"),
+	}
+	p.mu.Unlock()
+
+	redirect, err := url.Parse(q.Get("redirect_uri"))
+	if err != nil {
+		http.Error(w, "invalid redirect_uri", http.StatusBadRequest)
+
+		return
+	}
+
+	rq := redirect.Query()
+	rq.Set("code", code)
+	rq.Set("state", q.Get("state"))
+	redirect.RawQuery = rq.Encode()
+
+	http.Redirect(w, r, redirect.String(), http.StatusFound)
+}
+
+func (p *MockOIDCProvider) handleToken(w http.ResponseWriter, r *http.Request) {
+	if err := r.ParseForm(); err != nil {
+		http.Error(w, "invalid form", http.StatusBadRequest)
+
+		return
+	}
+
+	p.mu.Lock()
+	c, ok := p.codes[r.PostForm.Get("code")]
+	delete(p.codes, r.PostForm.Get("code"))
+	p.mu.Unlock()
+
+	clientID := r.PostForm.Get("client_id")
+	if id, _, ok := r.BasicAuth(); ok {
+		clientID = id
+	}
+
+	sum := sha256.Sum256([]byte(r.PostForm.Get("code_verifier")))
+	if !ok || clientID != p.ClientID || r.PostForm.Get("redirect_uri") != c.redirectURI ||
+		base64.RawURLEncoding.EncodeToString(sum[:]) != c.codeChallenge {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusBadRequest)
+		_, _ = w.Write([]byte(`{"error":"invalid_grant"}`))
+
+		return
+	}
+
+	idToken, err := p.signIDToken(c)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+
+		return
+	}
+
+	writeJSON(w, map[string]any{
+		"access_token": uniuri.NewLen(32), //nolint:mnd // test value
+		"token_type":   "Bearer",
+		"expires_in":   3600, //nolint:mnd // test value
+		"id_token":     idToken,
+	})
+}
+
+func (p *MockOIDCProvider) signIDToken(c mockOIDCCode) (string, error) {
+	signer, err := jose.NewSigner(
+		jose.SigningKey{Algorithm: jose.RS256, Key: p.key},
+		(&jose.SignerOptions{}).WithType("JWT").WithHeader("kid", "test"),
+	)
+	if err != nil {
+		return "", err
+	}
+
+	now := time.Now()
+
+	return jwt.Signed(signer).
+		Claims(jwt.Claims{
+			Issuer:   p.Issuer(),
+			Subject:  c.subject,
+			Audience: jwt.Audience{p.ClientID},
+			IssuedAt: jwt.NewNumericDate(now),
+			Expiry:   jwt.NewNumericDate(now.Add(time.Hour)),
+		}).
+		Claims(map[string]any{"nonce": c.nonce}).
+		Claims(c.claims).
+		Serialize()
+}
+
+func writeJSON(w http.ResponseWriter, v any) {
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(v)
+}
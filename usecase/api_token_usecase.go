@@ -0,0 +1,181 @@
+package usecase
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"log/slog"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/dchest/uniuri"
+	"github.com/go-errors/errors"
+	"github.com/hantabaru1014/baru-reso-headless-controller/domain"
+	"github.com/hantabaru1014/baru-reso-headless-controller/domain/entity"
+	"github.com/hantabaru1014/baru-reso-headless-controller/lib/auth"
+	"github.com/hantabaru1014/baru-reso-headless-controller/usecase/port"
+)
+
+const (
+	// apiTokenSecretLength は接頭辞を除いたトークンの文字数 (英数字 62 種).
+	apiTokenSecretLength = 40
+	// apiTokenDisplayPrefixLength は一覧表示用に保存するトークン先頭の文字数 (接頭辞込み).
+	apiTokenDisplayPrefixLength = len(auth.APITokenPrefix) + 6
+)
+
+var _ auth.APITokenVerifier = (*ApiTokenUsecase)(nil)
+
+// ApiTokenUsecase はスクリプト / bot 向けの長期 API トークンの発行と検証を提供する.
+// トークンは発行したユーザー本人の権限で動き、グループ / permission key で更に絞れる.
+// 権限要件: 自分のトークンの操作のみ (特別な権限は不要).
+type ApiTokenUsecase struct {
+	repo      port.ApiTokenRepository
+	groupRepo port.GroupRepository
+}
+
+func NewApiTokenUsecase(repo port.ApiTokenRepository, groupRepo port.GroupRepository) *ApiTokenUsecase {
+	return &ApiTokenUsecase{repo: repo, groupRepo: groupRepo}
+}
+
+type CreateApiTokenParams struct {
+	Name string
+	// GroupID が nil でなければトークンをそのグループに制限する.
+	GroupID *string
+	// PermissionKeys が空でなければトークンをその key に制限する.
+	PermissionKeys []string
+	// ExpiresAt が nil なら無期限.
+	ExpiresAt *time.Time
+}
+
+// CreateApiToken は caller 自身の API トークンを発行する. 返り値の平文トークンは
+// ここでしか取得できない.
+// API トークンで認証されたリクエストからは発行できない (制限の緩いトークンを作れてしまうため).
+func (u *ApiTokenUsecase) CreateApiToken(ctx context.Context, params CreateApiTokenParams) (*entity.ApiToken, string, error) {
+	claims, err := auth.GetAuthClaimsFromContext(ctx)
+	if err != nil || claims.UserID == "" {
+		return nil, "", errors.Wrap(domain.ErrUnauthenticated, 0)
+	}
+
+	if claims.APIToken != nil {
+		return nil, "", errors.Errorf("api tokens cannot be created with an api token: %w", domain.ErrPermissionDenied)
+	}
+
+	if err := u.validateCreateParams(ctx, &params); err != nil {
+		return nil, "", err
+	}
+
+	plain := auth.APITokenPrefix + uniuri.NewLen(apiTokenSecretLength)
+	token := &entity.ApiToken{
+		ID:             uniuri.New(),
+		UserID:         claims.UserID,
+		Name:           params.Name,
+		TokenHash:      hashApiToken(plain),
+		TokenPrefix:    plain[:apiTokenDisplayPrefixLength],
+		GroupID:        params.GroupID,
+		PermissionKeys: params.PermissionKeys,
+		ExpiresAt:      params.ExpiresAt,
+	}
+	if err := u.repo.Create(ctx, token); err != nil {
+		return nil, "", err
+	}
+
+	return token, plain, nil
+}
+
+// ListApiTokens は caller 自身のトークンを失効済みも含めて新しい順に返す.
+func (u *ApiTokenUsecase) ListApiTokens(ctx context.Context) (entity.ApiTokenList, error) {
+	userID, err := CurrentUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return u.repo.ListByUser(ctx, userID)
+}
+
+// RevokeApiToken は caller 自身のトークンを失効させる. 漏洩に気付いた bot が自分で
+// 失効できるよう、API トークンからの呼び出しも許可する.
+func (u *ApiTokenUsecase) RevokeApiToken(ctx context.Context, id string) error {
+	userID, err := CurrentUserID(ctx)
+	if err != nil {
+		return err
+	}
+
+	return u.repo.Revoke(ctx, userID, id)
+}
+
+// VerifyAPIToken implements auth.APITokenVerifier.
+func (u *ApiTokenUsecase) VerifyAPIToken(ctx context.Context, plain string) (*auth.AuthClaims, error) {
+	token, err := u.repo.GetByHash(ctx, hashApiToken(plain))
+	if err != nil {
+		if errors.Is(err, domain.ErrNotFound) {
+			return nil, errors.Wrap(auth.ErrInvalidAPIToken, 0)
+		}
+
+		return nil, err
+	}
+
+	if !token.IsActive(time.Now()) {
+		return nil, errors.WrapPrefix(auth.ErrInvalidAPIToken, "revoked or expired", 0)
+	}
+
+	// last_used_at は参考情報なので、更新に失敗してもリクエストは通す.
+	if err := u.repo.TouchLastUsed(ctx, token.ID); err != nil {
+		slog.Warn("failed to update api token last_used_at", "tokenId", token.ID, "error", err)
+	}
+
+	scope := &auth.APITokenScope{
+		TokenID:        token.ID,
+		PermissionKeys: token.PermissionKeys,
+	}
+	if token.GroupID != nil {
+		scope.GroupID = *token.GroupID
+	}
+
+	return &auth.AuthClaims{UserID: token.UserID, APIToken: scope}, nil
+}
+
+func (u *ApiTokenUsecase) validateCreateParams(ctx context.Context, params *CreateApiTokenParams) error {
+	params.Name = strings.TrimSpace(params.Name)
+	if params.Name == "" {
+		return errors.Errorf("api token name is required: %w", domain.ErrInvalidArgument)
+	}
+
+	slices.Sort(params.PermissionKeys)
+	params.PermissionKeys = slices.Compact(params.PermissionKeys)
+
+	for _, k := range params.PermissionKeys {
+		if !entity.IsValidPermissionKey(k) {
+			return errors.Errorf("unknown permission key %q: %w", k, domain.ErrInvalidArgument)
+		}
+
+		// グループに制限したトークンでは system:* は使えないので、指定は誤り.
+		if params.GroupID != nil && strings.HasPrefix(k, "system:") {
+			return errors.Errorf("system permission %q cannot be used with a group-restricted token: %w", k, domain.ErrInvalidArgument)
+		}
+	}
+
+	if params.GroupID != nil {
+		if _, err := u.groupRepo.Get(ctx, *params.GroupID); err != nil {
+			if errors.Is(err, domain.ErrNotFound) {
+				return errors.Errorf("group %q not found: %w", *params.GroupID, domain.ErrInvalidArgument)
+			}
+
+			return err
+		}
+	}
+
+	if params.ExpiresAt != nil && !params.ExpiresAt.After(time.Now()) {
+		return errors.Errorf("expires_at must be in the future: %w", domain.ErrInvalidArgument)
+	}
+
+	return nil
+}
+
+// hashApiToken は API トークンの保存用ダイジェスト (SHA-256 hex) を返す.
+// トークンは十分な長さの乱数なので、bcrypt ではなくハッシュで引けるようにする.
+func hashApiToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+
+	return hex.EncodeToString(sum[:])
+}
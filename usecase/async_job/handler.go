@@ -6,6 +6,7 @@ import (
 	"fmt"
 
 	"github.com/go-errors/errors"
+	"github.com/hantabaru1014/baru-reso-headless-controller/adapter/converter"
 	"github.com/hantabaru1014/baru-reso-headless-controller/domain/entity"
 	hdlctrlv1 "github.com/hantabaru1014/baru-reso-headless-controller/pbgen/hdlctrl/v1"
 	headlessv1 "github.com/hantabaru1014/baru-reso-headless-controller/pbgen/headless/v1"
@@ -104,6 +105,17 @@ func (d *Dispatcher) startHost(ctx context.Context, job *entity.AsyncJob) (JobRe
 		params.Memo = req.GetMemo()
 	}
 
+	if req.NodeId != nil {
+		params.NodeID = req.GetNodeId()
+	}
+
+	if req.ContainerSettings != nil {
+		params.ContainerSettings = *converter.HostContainerSettingsProtoToEntity(req.GetContainerSettings())
+	}
+
+	params.AutoRestartPolicy = entity.HostAutoRestartPolicy(req.GetAutoRestartPolicy())
+	params.AutoRestartMaxRetries = req.GetAutoRestartMaxRetries()
+
 	hostID, err := d.host.HeadlessHostStart(ctx, params, job.CreatedBy)
 	if err != nil {
 		return JobResult{}, "", err
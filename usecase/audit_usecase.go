@@ -0,0 +1,55 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/go-errors/errors"
+	"github.com/hantabaru1014/baru-reso-headless-controller/domain/entity"
+	"github.com/hantabaru1014/baru-reso-headless-controller/usecase/port"
+)
+
+// auditExportBatchSize は Export が 1 回の query で読む件数.
+const auditExportBatchSize = 500
+
+// AuditUsecase は変更系 RPC の監査ログの記録と閲覧を提供する.
+// 閲覧の権限チェックは RPC の permission interceptor で行う.
+type AuditUsecase struct {
+	repo port.AuditEventRepository
+}
+
+func NewAuditUsecase(repo port.AuditEventRepository) *AuditUsecase {
+	return &AuditUsecase{repo: repo}
+}
+
+func (u *AuditUsecase) Record(ctx context.Context, event *entity.AuditEvent) error {
+	return u.repo.Create(ctx, event)
+}
+
+func (u *AuditUsecase) List(ctx context.Context, filter port.AuditEventFilter, pageIndex, pageSize int32) (*port.AuditEventListResult, error) {
+	return u.repo.List(ctx, filter, pageIndex, pageSize)
+}
+
+// Export は filter に一致する event を古い順にすべて fn へ渡す.
+// fn がエラーを返すとそこで中断する.
+func (u *AuditUsecase) Export(ctx context.Context, filter port.AuditEventFilter, fn func(*entity.AuditEvent) error) error {
+	var afterID int64
+
+	for {
+		events, err := u.repo.ListAfter(ctx, filter, afterID, auditExportBatchSize)
+		if err != nil {
+			return err
+		}
+
+		for _, e := range events {
+			if err := fn(e); err != nil {
+				return errors.Wrap(err, 0)
+			}
+
+			afterID = e.ID
+		}
+
+		if len(events) < auditExportBatchSize {
+			return nil
+		}
+	}
+}
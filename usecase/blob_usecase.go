@@ -1,16 +1,21 @@
 package usecase
 
 import (
+	"bufio"
 	"context"
+	"encoding/json"
 	stderrors "errors"
 	"fmt"
 	"io"
 	"log/slog"
 	"os"
 	"regexp"
+	"time"
 
 	"github.com/go-errors/errors"
 	"github.com/google/uuid"
+	"github.com/hantabaru1014/baru-reso-headless-controller/domain"
+	"github.com/hantabaru1014/baru-reso-headless-controller/domain/entity"
 	"github.com/hantabaru1014/baru-reso-headless-controller/lib/blobstore"
 	headlessv1 "github.com/hantabaru1014/baru-reso-headless-controller/pbgen/headless/v1"
 	"github.com/hantabaru1014/baru-reso-headless-controller/usecase/port"
@@ -109,6 +114,157 @@ func (u *BlobUsecase) PrepareSessionWorldDownload(ctx context.Context, sessionID
 	return "/blobs/" + key, filename, nil
 }
 
+// HostLogExportFormat is the file format of a host log download.
+type HostLogExportFormat int
+
+const (
+	HostLogExportFormat_Text HostLogExportFormat = iota + 1
+	HostLogExportFormat_NDJSON
+)
+
+type HostLogDownloadParams struct {
+	HostID string
+	// InstanceID selects the host instance; 0 means the current one.
+	InstanceID int32
+	Format     HostLogExportFormat
+	Since      *time.Time // inclusive
+	Until      *time.Time // exclusive
+}
+
+type HostLogDownload struct {
+	URL       string
+	Filename  string
+	LineCount int64
+}
+
+// exportedHostLogLine is one line of the NDJSON export.
+type exportedHostLogLine struct {
+	ID        int64     `json:"id"`
+	Timestamp time.Time `json:"timestamp"`
+	Stream    string    `json:"stream"`
+	Level     string    `json:"level"`
+	Log       string    `json:"log"`
+}
+
+var hostLogLevelNames = map[entity.HostLogLevel]string{
+	entity.HostLogLevel_Unknown: "unknown",
+	entity.HostLogLevel_Info:    "info",
+	entity.HostLogLevel_Warning: "warning",
+	entity.HostLogLevel_Error:   "error",
+}
+
+// PrepareHostLogDownload writes every log line of one host instance, or
+// those within [Since, Until), into a local temp file in the requested
+// format, uploads it to the blob store under a fresh UUID, and returns the
+// relative download URL. Archived logs are included.
+func (u *BlobUsecase) PrepareHostLogDownload(ctx context.Context, params HostLogDownloadParams) (*HostLogDownload, error) {
+	var ext, contentType string
+
+	switch params.Format {
+	case HostLogExportFormat_Text:
+		ext, contentType = "log", "text/plain; charset=utf-8"
+	case HostLogExportFormat_NDJSON:
+		ext, contentType = "ndjson", "application/x-ndjson"
+	default:
+		return nil, errors.Errorf("unknown log export format: %w", domain.ErrInvalidArgument)
+	}
+
+	if params.Since != nil && params.Until != nil && !params.Since.Before(*params.Until) {
+		return nil, errors.Errorf("since must be before until: %w", domain.ErrInvalidArgument)
+	}
+
+	host, err := u.hostRepo.Find(ctx, params.HostID, port.HeadlessHostFetchOptions{})
+	if err != nil {
+		return nil, errors.Wrap(err, 0)
+	}
+
+	instanceID := params.InstanceID
+	if instanceID == 0 {
+		instanceID = host.InstanceId
+	}
+
+	tmpFile, err := os.CreateTemp("", "host-log-download-*")
+	if err != nil {
+		return nil, errors.Wrap(err, 0)
+	}
+
+	defer func() {
+		_ = tmpFile.Close()
+		_ = os.Remove(tmpFile.Name())
+	}()
+
+	w := bufio.NewWriter(tmpFile)
+	enc := json.NewEncoder(w)
+
+	var lineCount int64
+
+	err = u.hostRepo.ScanLogs(ctx, host.ID, instanceID, func(logs port.LogLineList) error {
+		for _, l := range logs {
+			ts := time.Unix(l.Timestamp, 0).UTC()
+			if (params.Since != nil && ts.Before(*params.Since)) || (params.Until != nil && !ts.Before(*params.Until)) {
+				continue
+			}
+
+			stream := "stdout"
+			if l.IsError {
+				stream = "stderr"
+			}
+
+			var err error
+			if params.Format == HostLogExportFormat_NDJSON {
+				err = enc.Encode(exportedHostLogLine{
+					ID:        l.ID,
+					Timestamp: ts,
+					Stream:    stream,
+					Level:     hostLogLevelNames[l.Level],
+					Log:       l.Body,
+				})
+			} else {
+				_, err = fmt.Fprintf(w, "%s [%s] %s\n", ts.Format(time.RFC3339), stream, l.Body)
+			}
+
+			if err != nil {
+				return err
+			}
+
+			lineCount++
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, 0)
+	}
+
+	if err := w.Flush(); err != nil {
+		return nil, errors.Wrap(err, 0)
+	}
+
+	stat, err := tmpFile.Stat()
+	if err != nil {
+		return nil, errors.Wrap(err, 0)
+	}
+
+	if _, err := tmpFile.Seek(0, io.SeekStart); err != nil {
+		return nil, errors.Wrap(err, 0)
+	}
+
+	name := host.Name
+	if name == "" {
+		name = host.ID
+	}
+
+	filename := fmt.Sprintf("%s-%d.%s", sanitizeFilename(name), instanceID, ext)
+	key := uuid.NewString()
+
+	if err := u.blob.Upload(ctx, key, tmpFile, stat.Size(), filename, contentType); err != nil {
+		slog.Error("failed to upload host log download blob", "error", err, "hostId", host.ID)
+		return nil, errors.Wrap(err, 0)
+	}
+
+	return &HostLogDownload{URL: "/blobs/" + key, Filename: filename, LineCount: lineCount}, nil
+}
+
 var unsafeFilenameChars = regexp.MustCompile(`[<>:"/\\|?*\x00-\x1f]`)
 
 func sanitizeFilename(name string) string {
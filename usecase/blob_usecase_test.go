@@ -0,0 +1,114 @@
+package usecase
+
+import (
+	"bytes"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/hantabaru1014/baru-reso-headless-controller/domain"
+	"github.com/hantabaru1014/baru-reso-headless-controller/domain/entity"
+	blobstoremock "github.com/hantabaru1014/baru-reso-headless-controller/lib/blobstore/mock"
+	"github.com/hantabaru1014/baru-reso-headless-controller/usecase/port"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestBlobUsecase_PrepareHostLogDownload(t *testing.T) {
+	t.Parallel()
+
+	base := time.Date(2026, 7, 1, 12, 0, 0, 0, time.UTC)
+	at := func(id int64, minutes int, isError bool, body string) *port.LogLine {
+		return &port.LogLine{ID: id, Timestamp: base.Add(time.Duration(minutes) * time.Minute).Unix(), IsError: isError, Body: body, Level: entity.ClassifyHostLogLevel(isError, body)}
+	}
+	logs := port.LogLineList{
+		at(1, 0, false, "starting"),
+		at(2, 1, true, "Exception: boom"),
+		at(3, 2, false, "world saved"),
+	}
+
+	upload := func(t *testing.T, blob *blobstoremock.MockClient, content *bytes.Buffer, filename, contentType *string) {
+		t.Helper()
+
+		blob.EXPECT().Upload(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ any, _ string, r io.Reader, size int64, name, ct string) error {
+				n, err := io.Copy(content, r)
+				require.NoError(t, err)
+				assert.Equal(t, size, n)
+				*filename, *contentType = name, ct
+
+				return nil
+			})
+	}
+
+	t.Run("テキスト形式では現在のインスタンスの全ログを古い順に書き出す", func(t *testing.T) {
+		t.Parallel()
+
+		blob := blobstoremock.NewMockClient(gomock.NewController(t))
+		uc := NewBlobUsecase(nil, &stubLogHostRepo{instanceID: 3, logs: logs}, blob)
+
+		var content bytes.Buffer
+		var filename, contentType string
+		upload(t, blob, &content, &filename, &contentType)
+
+		download, err := uc.PrepareHostLogDownload(t.Context(), HostLogDownloadParams{HostID: "host-a", Format: HostLogExportFormat_Text})
+		require.NoError(t, err)
+
+		assert.Regexp(t, `^/blobs/[0-9a-f-]{36}$`, download.URL)
+		assert.Equal(t, int64(3), download.LineCount)
+		assert.Equal(t, "host-a-3.log", download.Filename)
+		assert.Equal(t, filename, download.Filename)
+		assert.Equal(t, "text/plain; charset=utf-8", contentType)
+		assert.Equal(t,
+			"2026-07-01T12:00:00Z [stdout] starting\n"+
+				"2026-07-01T12:01:00Z [stderr] Exception: boom\n"+
+				"2026-07-01T12:02:00Z [stdout] world saved\n",
+			content.String())
+	})
+
+	t.Run("NDJSON 形式では期間で絞り込む", func(t *testing.T) {
+		t.Parallel()
+
+		blob := blobstoremock.NewMockClient(gomock.NewController(t))
+		uc := NewBlobUsecase(nil, &stubLogHostRepo{instanceID: 3, logs: logs}, blob)
+
+		var content bytes.Buffer
+		var filename, contentType string
+		upload(t, blob, &content, &filename, &contentType)
+
+		since, until := base.Add(time.Minute), base.Add(2*time.Minute)
+		download, err := uc.PrepareHostLogDownload(t.Context(), HostLogDownloadParams{
+			HostID:     "host-a",
+			InstanceID: 2,
+			Format:     HostLogExportFormat_NDJSON,
+			Since:      &since,
+			Until:      &until,
+		})
+		require.NoError(t, err)
+
+		assert.Equal(t, int64(1), download.LineCount)
+		assert.Equal(t, "host-a-2.ndjson", download.Filename)
+		assert.Equal(t, "application/x-ndjson", contentType)
+		assert.JSONEq(t,
+			`{"id":2,"timestamp":"2026-07-01T12:01:00Z","stream":"stderr","level":"error","log":"Exception: boom"}`,
+			content.String())
+	})
+
+	t.Run("不正な形式や期間はアップロードせずに弾く", func(t *testing.T) {
+		t.Parallel()
+
+		uc := NewBlobUsecase(nil, &stubLogHostRepo{logs: logs}, blobstoremock.NewMockClient(gomock.NewController(t)))
+
+		_, err := uc.PrepareHostLogDownload(t.Context(), HostLogDownloadParams{HostID: "host-a"})
+		require.ErrorIs(t, err, domain.ErrInvalidArgument)
+
+		_, err = uc.PrepareHostLogDownload(t.Context(), HostLogDownloadParams{
+			HostID: "host-a",
+			Format: HostLogExportFormat_Text,
+			Since:  &base,
+			Until:  &base,
+		})
+		require.ErrorIs(t, err, domain.ErrInvalidArgument)
+	})
+}
@@ -0,0 +1,758 @@
+package desired_state
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"reflect"
+	"slices"
+	"time"
+
+	"github.com/go-errors/errors"
+	"github.com/hantabaru1014/baru-reso-headless-controller/adapter/converter"
+	"github.com/hantabaru1014/baru-reso-headless-controller/domain"
+	"github.com/hantabaru1014/baru-reso-headless-controller/domain/entity"
+	headlessv1 "github.com/hantabaru1014/baru-reso-headless-controller/pbgen/headless/v1"
+	"github.com/hantabaru1014/baru-reso-headless-controller/usecase"
+	"github.com/hantabaru1014/baru-reso-headless-controller/usecase/port"
+	"github.com/hantabaru1014/baru-reso-headless-controller/usecase/scheduled_op/actions"
+	"github.com/hantabaru1014/baru-reso-headless-controller/usecase/scheduled_op/triggers"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+)
+
+// Snapshot は差分計算に使う現在の状態. Reconciler が usecase 経由で集める.
+type Snapshot struct {
+	Groups entity.GroupList
+	// Members は group ID ごとのメンバー.
+	Members map[string]entity.GroupMemberList
+	Hosts   entity.HeadlessHostList
+	// Accounts は Resonite ID ごとのヘッドレスアカウント.
+	Accounts map[string]*entity.HeadlessAccount
+	// Sessions は host ID ごとの起動中 / 稼働中のセッション.
+	Sessions map[string]entity.SessionList
+	// ScheduledStarts は host ID ごとの未実行の時刻指定起動予約.
+	ScheduledStarts map[string][]*ScheduledStart
+	Now             time.Time
+}
+
+// ScheduledStart は既存の予約. Spec の Host / Group は空.
+type ScheduledStart struct {
+	ID   string
+	Spec *ScheduledStartSpec
+}
+
+type ChangeKind string
+
+const (
+	ChangeKind_CREATE ChangeKind = "create"
+	ChangeKind_UPDATE ChangeKind = "update"
+	ChangeKind_DELETE ChangeKind = "delete"
+	ChangeKind_START  ChangeKind = "start"
+	ChangeKind_STOP   ChangeKind = "stop"
+)
+
+// Change は plan の 1 操作. exec は Reconciler.Apply から順に呼ばれる.
+type Change struct {
+	Kind    ChangeKind
+	Target  string
+	Details []string
+	exec    func(ctx context.Context, r *Reconciler) error
+}
+
+type Plan struct {
+	Changes []*Change
+	// Warnings は反映しないが利用者に知らせたい差分.
+	Warnings []string
+}
+
+type PlanOptions struct {
+	// Prune が true なら構成ファイルに無いメンバー・セッション・予約を削除 / 停止 / キャンセルする.
+	// ホストは削除しない.
+	Prune bool
+}
+
+func (p *Plan) IsEmpty() bool {
+	return len(p.Changes) == 0
+}
+
+// Write は plan を人が読む形式で書き出す.
+func (p *Plan) Write(w io.Writer) {
+	for _, c := range p.Changes {
+		fmt.Fprintf(w, "%s %s %s\n", changeSymbols[c.Kind], c.Kind, c.Target)
+
+		for _, d := range c.Details {
+			fmt.Fprintf(w, "    %s\n", d)
+		}
+	}
+
+	for _, msg := range p.Warnings {
+		fmt.Fprintf(w, "! %s\n", msg)
+	}
+}
+
+var changeSymbols = map[ChangeKind]string{
+	ChangeKind_CREATE: "+",
+	ChangeKind_UPDATE: "~",
+	ChangeKind_DELETE: "-",
+	ChangeKind_START:  ">",
+	ChangeKind_STOP:   "x",
+}
+
+// ref は plan 作成時点では未作成のグループ / ホストの ID を、作成後の change に渡す.
+type ref struct {
+	id string
+}
+
+type planner struct {
+	spec  *Spec
+	snap  *Snapshot
+	opts  PlanOptions
+	plan  *Plan
+	group map[string]*ref
+	host  map[string]*ref
+}
+
+// BuildPlan は spec と snap の差分から plan を作る. snap は変更しない.
+func BuildPlan(spec *Spec, snap *Snapshot, opts PlanOptions) (*Plan, error) {
+	p := &planner{
+		spec:  spec,
+		snap:  snap,
+		opts:  opts,
+		plan:  &Plan{},
+		group: map[string]*ref{},
+		host:  map[string]*ref{},
+	}
+
+	for _, g := range spec.Groups {
+		if err := p.planGroup(g); err != nil {
+			return nil, err
+		}
+	}
+
+	for _, h := range spec.Hosts {
+		if err := p.planHost(h); err != nil {
+			return nil, err
+		}
+	}
+
+	p.planScheduled()
+
+	return p.plan, nil
+}
+
+func (p *planner) add(kind ChangeKind, target string, details []string, exec func(ctx context.Context, r *Reconciler) error) {
+	p.plan.Changes = append(p.plan.Changes, &Change{Kind: kind, Target: target, Details: details, exec: exec})
+}
+
+func (p *planner) warn(format string, args ...any) {
+	p.plan.Warnings = append(p.plan.Warnings, fmt.Sprintf(format, args...))
+}
+
+// findGroup はグループ ID またはグループ名で既存のグループを探す. 見つからなければ nil.
+func (p *planner) findGroup(nameOrID string) (*entity.Group, error) {
+	var found *entity.Group
+
+	for _, g := range p.snap.Groups {
+		if g.ID == nameOrID {
+			return g, nil
+		}
+
+		if g.Name == nameOrID {
+			if found != nil {
+				return nil, invalidf("group name %q is ambiguous; use the group ID", nameOrID)
+			}
+
+			found = g
+		}
+	}
+
+	return found, nil
+}
+
+// groupRef は spec で宣言したグループ、または既存のグループの ref を返す.
+func (p *planner) groupRef(nameOrID string) (*ref, error) {
+	if r, ok := p.group[nameOrID]; ok {
+		return r, nil
+	}
+
+	g, err := p.findGroup(nameOrID)
+	if err != nil {
+		return nil, err
+	}
+
+	if g == nil {
+		return nil, errors.Errorf("group %q not found: %w", nameOrID, domain.ErrNotFound)
+	}
+
+	r := &ref{id: g.ID}
+	p.group[nameOrID] = r
+
+	return r, nil
+}
+
+func (p *planner) planGroup(g *GroupSpec) error {
+	existing, err := p.findGroup(g.Name)
+	if err != nil {
+		return err
+	}
+
+	gr := &ref{}
+	p.group[g.Name] = gr
+
+	if existing != nil {
+		gr.id = existing.ID
+	} else {
+		name := g.Name
+		p.add(ChangeKind_CREATE, "group "+name, nil, func(ctx context.Context, r *Reconciler) error {
+			created, err := r.guc.CreateGroup(ctx, name, domain.SystemUserID)
+			if err != nil {
+				return err
+			}
+
+			gr.id = created.ID
+
+			return nil
+		})
+	}
+
+	if g.Members == nil {
+		return nil
+	}
+
+	var current entity.GroupMemberList
+	if existing != nil {
+		current = p.snap.Members[existing.ID]
+	}
+
+	declared := map[string]bool{}
+
+	for _, m := range g.Members {
+		declared[m.User] = true
+		target := "member " + g.Name + "/" + m.User
+		userID, roleID := m.User, m.Role
+
+		idx := slices.IndexFunc(current, func(cm *entity.GroupMember) bool { return cm.UserID == m.User })
+		if idx < 0 {
+			p.add(ChangeKind_CREATE, target, []string{"role: " + roleID}, func(ctx context.Context, r *Reconciler) error {
+				addedBy := domain.SystemUserID
+				_, err := r.guc.AddGroupMember(ctx, gr.id, userID, roleID, &addedBy)
+
+				return err
+			})
+
+			continue
+		}
+
+		if current[idx].RoleID != m.Role {
+			p.add(ChangeKind_UPDATE, target, []string{fmt.Sprintf("role: %s -> %s", current[idx].RoleID, roleID)}, func(ctx context.Context, r *Reconciler) error {
+				_, err := r.guc.UpdateGroupMemberRole(ctx, gr.id, userID, roleID)
+
+				return err
+			})
+		}
+	}
+
+	for _, cm := range current {
+		// グループ作成者として登録される system user は管理対象外.
+		if declared[cm.UserID] || cm.UserID == domain.SystemUserID {
+			continue
+		}
+
+		target := "member " + g.Name + "/" + cm.UserID
+		if !p.opts.Prune {
+			p.warn("%s is not declared (use --prune to remove)", target)
+
+			continue
+		}
+
+		userID := cm.UserID
+		p.add(ChangeKind_DELETE, target, nil, func(ctx context.Context, r *Reconciler) error {
+			return r.guc.RemoveGroupMember(ctx, gr.id, userID)
+		})
+	}
+
+	return nil
+}
+
+func (p *planner) planHost(h *HostSpec) error {
+	key := hostKey(h.Group, h.Name)
+	target := "host " + key
+
+	gr, err := p.groupRef(h.Group)
+	if err != nil {
+		return errors.WrapPrefix(err, target, 0)
+	}
+
+	account := p.snap.Accounts[h.Account]
+	if account == nil {
+		return errors.Errorf("%s: headless account %q not found: %w", target, h.Account, domain.ErrNotFound)
+	}
+
+	// 新規作成するグループにはまだアカウントが無いので、ホストは次回以降の apply で作る.
+	if gr.id == "" {
+		return invalidf("%s: group %q does not exist yet; register a headless account to it first", target, h.Group)
+	}
+
+	if account.GroupID != gr.id {
+		return invalidf("%s: headless account %q belongs to another group", target, h.Account)
+	}
+
+	var existing *entity.HeadlessHost
+
+	for _, host := range p.snap.Hosts {
+		if host.GroupID != gr.id || host.Name != h.Name {
+			continue
+		}
+
+		if existing != nil {
+			return invalidf("%s: multiple hosts have the same name in the group", target)
+		}
+
+		existing = host
+	}
+
+	hr := &ref{}
+	p.host[key] = hr
+
+	if existing == nil {
+		if h.desiredState() == HostState_STOPPED {
+			p.warn("%s does not exist and is declared as stopped; skipped", target)
+
+			return nil
+		}
+
+		params, err := h.startParams(account)
+		if err != nil {
+			return errors.WrapPrefix(err, target, 0)
+		}
+
+		details := []string{"account: " + h.Account}
+		if len(h.StartWorlds) > 0 {
+			details = append(details, fmt.Sprintf("start_worlds: %d", len(h.StartWorlds)))
+		}
+
+		p.add(ChangeKind_CREATE, target, details, func(ctx context.Context, r *Reconciler) error {
+			params.GroupID = gr.id
+			userID := domain.SystemUserID
+
+			id, err := r.hhuc.HeadlessHostStart(ctx, params, &userID)
+			if err != nil {
+				return err
+			}
+
+			hr.id = id
+
+			return nil
+		})
+
+		return nil
+	}
+
+	hr.id = existing.ID
+
+	if existing.AccountId != h.Account {
+		p.warn("%s uses account %s; the account is only applied when the host is created", target, existing.AccountId)
+	}
+
+	if err := p.planHostUpdate(h, existing, target); err != nil {
+		return err
+	}
+
+	running := existing.Status == entity.HeadlessHostStatus_RUNNING || existing.Status == entity.HeadlessHostStatus_STARTING
+
+	switch {
+	case h.desiredState() == HostState_RUNNING && !running:
+		hostID := existing.ID
+		p.add(ChangeKind_START, target, nil, func(ctx context.Context, r *Reconciler) error {
+			return r.hhuc.HeadlessHostRestart(ctx, hostID, nil, true, r.hostStartTimeoutSeconds)
+		})
+
+		if h.StartWorlds != nil {
+			p.warn("sessions of %s are reconciled on the next apply after it starts", target)
+		}
+	case h.desiredState() == HostState_STOPPED && running:
+		hostID := existing.ID
+		p.add(ChangeKind_STOP, target, nil, func(ctx context.Context, r *Reconciler) error {
+			return r.hhuc.HeadlessHostShutdown(ctx, hostID)
+		})
+	case h.desiredState() == HostState_RUNNING && existing.Status == entity.HeadlessHostStatus_RUNNING:
+		p.planSessions(h, existing, key)
+	}
+
+	return nil
+}
+
+// planHostUpdate は spec で指定された項目のうち既存ホストと異なるものをまとめて 1 つの change にする.
+func (p *planner) planHostUpdate(h *HostSpec, host *entity.HeadlessHost, target string) error {
+	var (
+		details []string
+		steps   []func(ctx context.Context, r *Reconciler) error
+	)
+
+	hostID := host.ID
+
+	if policy, _ := parseAutoUpdatePolicy(h.AutoUpdatePolicy); policy != entity.HostAutoUpdatePolicy_UNSPECIFIED && policy != host.AutoUpdatePolicy {
+		details = append(details, fmt.Sprintf("auto_update_policy: %s -> %s", autoUpdatePolicyNames[host.AutoUpdatePolicy], h.AutoUpdatePolicy))
+		steps = append(steps, func(ctx context.Context, r *Reconciler) error {
+			return r.hhuc.HeadlessHostUpdateAutoUpdatePolicy(ctx, hostID, policy)
+		})
+	}
+
+	restartPolicy, _ := parseAutoRestartPolicy(h.AutoRestartPolicy)
+	if restartPolicy == entity.HostAutoRestartPolicy_UNSPECIFIED {
+		restartPolicy = host.AutoRestartPolicy
+	}
+
+	maxRetries := h.AutoRestartMaxRetries
+	if maxRetries == 0 {
+		maxRetries = host.AutoRestartMaxRetries
+	}
+
+	if restartPolicy != host.AutoRestartPolicy || maxRetries != host.AutoRestartMaxRetries {
+		details = append(details, fmt.Sprintf("auto_restart: %s/%d -> %s/%d",
+			autoRestartPolicyNames[host.AutoRestartPolicy], host.AutoRestartMaxRetries, autoRestartPolicyNames[restartPolicy], maxRetries))
+		steps = append(steps, func(ctx context.Context, r *Reconciler) error {
+			return r.hhuc.HeadlessHostUpdateAutoRestartPolicy(ctx, hostID, restartPolicy, maxRetries)
+		})
+	}
+
+	if h.Container != nil {
+		container, err := h.Container.toEntity()
+		if err != nil {
+			return errors.WrapPrefix(err, target, 0)
+		}
+
+		if !containerSettingsEqual(container, host.ContainerSettings) {
+			details = append(details, "container settings (applied on the next restart)")
+			steps = append(steps, func(ctx context.Context, r *Reconciler) error {
+				return r.hhuc.HeadlessHostUpdateContainerSettings(ctx, hostID, &container)
+			})
+		}
+	}
+
+	update, universeID, settingsDetails := h.Settings.diff(&host.HostSettings)
+	if len(settingsDetails) > 0 {
+		details = append(details, settingsDetails...)
+		steps = append(steps, func(ctx context.Context, r *Reconciler) error {
+			return r.hhuc.HeadlessHostUpdateHostSettings(ctx, hostID, update, universeID)
+		})
+	}
+
+	if len(steps) == 0 {
+		return nil
+	}
+
+	p.add(ChangeKind_UPDATE, target, details, func(ctx context.Context, r *Reconciler) error {
+		for _, step := range steps {
+			if err := step(ctx, r); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+
+	return nil
+}
+
+func (p *planner) planSessions(h *HostSpec, host *entity.HeadlessHost, key string) {
+	if h.StartWorlds == nil {
+		return
+	}
+
+	sessions := p.snap.Sessions[host.ID]
+	declared := map[string]bool{}
+
+	for _, w := range h.StartWorlds {
+		name := w.GetName()
+		declared[name] = true
+
+		if slices.ContainsFunc(sessions, func(s *entity.Session) bool { return sessionName(s) == name }) {
+			continue
+		}
+
+		hostID := host.ID
+		params := proto.CloneOf(w.WorldStartupParameters)
+		p.add(ChangeKind_START, "session "+key+"/"+name, nil, func(ctx context.Context, r *Reconciler) error {
+			userID := domain.SystemUserID
+			_, err := r.suc.StartSession(ctx, hostID, "", &userID, params, nil)
+
+			return err
+		})
+	}
+
+	for _, s := range sessions {
+		name := sessionName(s)
+		if declared[name] {
+			continue
+		}
+
+		target := "session " + key + "/" + name
+		if !p.opts.Prune {
+			p.warn("%s is not declared (use --prune to stop)", target)
+
+			continue
+		}
+
+		sessionID := s.ID
+		p.add(ChangeKind_STOP, target, nil, func(ctx context.Context, r *Reconciler) error {
+			return r.suc.StopSession(ctx, sessionID)
+		})
+	}
+}
+
+func (p *planner) planScheduled() {
+	matched := map[string]bool{}
+
+	for _, op := range p.spec.ScheduledOperations {
+		key := hostKey(op.Group, op.Host)
+		hr := p.host[key]
+		target := fmt.Sprintf("scheduled start %s@%s", key, op.At.UTC().Format(time.RFC3339))
+
+		if hr == nil {
+			// ホストが stopped 宣言で作成されない場合.
+			p.warn("%s is skipped because the host is not created", target)
+
+			continue
+		}
+
+		want := scheduledStartKey(op)
+
+		idx := slices.IndexFunc(p.snap.ScheduledStarts[hr.id], func(s *ScheduledStart) bool {
+			return !matched[s.ID] && scheduledStartKey(s.Spec) == want
+		})
+		if hr.id != "" && idx >= 0 {
+			matched[p.snap.ScheduledStarts[hr.id][idx].ID] = true
+
+			continue
+		}
+
+		if !op.At.After(p.snap.Now) {
+			p.warn("%s is in the past; skipped", target)
+
+			continue
+		}
+
+		gr := p.group[op.Group]
+		spec := *op
+		p.add(ChangeKind_CREATE, target, nil, func(ctx context.Context, r *Reconciler) error {
+			return r.createScheduledStart(ctx, hr.id, gr.id, &spec)
+		})
+	}
+
+	for _, h := range p.spec.Hosts {
+		key := hostKey(h.Group, h.Name)
+
+		hr := p.host[key]
+		if hr == nil || hr.id == "" {
+			continue
+		}
+
+		for _, s := range p.snap.ScheduledStarts[hr.id] {
+			if matched[s.ID] {
+				continue
+			}
+
+			target := fmt.Sprintf("scheduled start %s@%s", key, s.Spec.At.UTC().Format(time.RFC3339))
+			if !p.opts.Prune {
+				p.warn("%s (%s) is not declared (use --prune to cancel)", target, s.ID)
+
+				continue
+			}
+
+			opID := s.ID
+			p.add(ChangeKind_DELETE, target, nil, func(ctx context.Context, r *Reconciler) error {
+				return r.sou.Cancel(ctx, opID)
+			})
+		}
+	}
+}
+
+// startParams は新規ホストの起動パラメータを作る. GroupID は実行時に埋める.
+func (h *HostSpec) startParams(account *entity.HeadlessAccount) (port.HeadlessHostStartParams, error) {
+	allowed, err := h.Settings.allowedURLHosts()
+	if err != nil {
+		return port.HeadlessHostStartParams{}, err
+	}
+
+	startWorlds := make([]*headlessv1.WorldStartupParameters, 0, len(h.StartWorlds))
+	for _, w := range h.StartWorlds {
+		startWorlds = append(startWorlds, proto.CloneOf(w.WorldStartupParameters))
+	}
+
+	startupConfig := converter.HeadlessHostSettingsToStartupConfigProto(&entity.HeadlessHostSettings{
+		UniverseID:                  h.Settings.UniverseID,
+		TickRate:                    h.Settings.TickRate,
+		MaxConcurrentAssetTransfers: h.Settings.MaxConcurrentAssetTransfers,
+		UsernameOverride:            h.Settings.UsernameOverride,
+		AllowedUrlHosts:             allowed,
+		AutoSpawnItems:              h.Settings.AutoSpawnItems,
+		StartWorlds:                 startWorlds,
+	})
+	// 0 はヘッドレス側の既定値に任せる.
+	if h.Settings.TickRate == 0 {
+		startupConfig.TickRate = nil
+	}
+
+	if h.Settings.MaxConcurrentAssetTransfers == 0 {
+		startupConfig.MaxConcurrentAssetTransfers = nil
+	}
+
+	params := port.HeadlessHostStartParams{
+		Name:              h.Name,
+		ContainerImageTag: h.ImageTag,
+		HeadlessAccount:   *account,
+		StartupConfig:     startupConfig,
+		Memo:              h.Memo,
+		NodeID:            h.Node,
+	}
+
+	params.AutoUpdatePolicy, _ = parseAutoUpdatePolicy(h.AutoUpdatePolicy)
+	params.AutoRestartPolicy, _ = parseAutoRestartPolicy(h.AutoRestartPolicy)
+	params.AutoRestartMaxRetries = h.AutoRestartMaxRetries
+
+	if h.Container != nil {
+		params.ContainerSettings, err = h.Container.toEntity()
+		if err != nil {
+			return port.HeadlessHostStartParams{}, err
+		}
+	}
+
+	return params, nil
+}
+
+// diff は既存のホスト設定と異なる項目の更新リクエストを返す. allowed_url_hosts は作成時のみ.
+func (s *HostSettingsSpec) diff(current *entity.HeadlessHostSettings) (*headlessv1.UpdateHostSettingsRequest, *string, []string) {
+	update := &headlessv1.UpdateHostSettingsRequest{}
+
+	var (
+		universeID *string
+		details    []string
+	)
+
+	if s.TickRate != 0 && s.TickRate != current.TickRate {
+		update.TickRate = proto.Float32(s.TickRate)
+		details = append(details, fmt.Sprintf("tick_rate: %v -> %v", current.TickRate, s.TickRate))
+	}
+
+	if s.MaxConcurrentAssetTransfers != 0 && s.MaxConcurrentAssetTransfers != current.MaxConcurrentAssetTransfers {
+		update.MaxConcurrentAssetTransfers = proto.Int32(s.MaxConcurrentAssetTransfers)
+		details = append(details, fmt.Sprintf("max_concurrent_asset_transfers: %d -> %d", current.MaxConcurrentAssetTransfers, s.MaxConcurrentAssetTransfers))
+	}
+
+	if s.UsernameOverride != nil && *s.UsernameOverride != derefString(current.UsernameOverride) {
+		update.UsernameOverride = proto.String(*s.UsernameOverride)
+		details = append(details, fmt.Sprintf("username_override: %q -> %q", derefString(current.UsernameOverride), *s.UsernameOverride))
+	}
+
+	if s.AutoSpawnItems != nil && !slices.Equal(s.AutoSpawnItems, current.AutoSpawnItems) {
+		update.UpdateAutoSpawnItems = true
+		update.AutoSpawnItems = s.AutoSpawnItems
+		details = append(details, fmt.Sprintf("auto_spawn_items: %d -> %d items", len(current.AutoSpawnItems), len(s.AutoSpawnItems)))
+	}
+
+	if s.UniverseID != nil && *s.UniverseID != derefString(current.UniverseID) {
+		universeID = s.UniverseID
+		details = append(details, fmt.Sprintf("universe_id: %q -> %q (applied on the next restart)", derefString(current.UniverseID), *s.UniverseID))
+	}
+
+	return update, universeID, details
+}
+
+func containerSettingsEqual(a, b entity.HostContainerSettings) bool {
+	if len(a.BindMounts) == 0 {
+		a.BindMounts = nil
+	}
+
+	if len(b.BindMounts) == 0 {
+		b.BindMounts = nil
+	}
+
+	return reflect.DeepEqual(a, b)
+}
+
+// scheduledStartKey は予約の同一性を判定するキー. パラメータは protojson を正規化して比較する.
+func scheduledStartKey(s *ScheduledStartSpec) string {
+	params := "{}"
+
+	if s.Parameters != nil && s.Parameters.WorldStartupParameters != nil {
+		if b, err := protojson.Marshal(s.Parameters.WorldStartupParameters); err == nil {
+			var raw any
+			if json.Unmarshal(b, &raw) == nil {
+				if normalized, err := json.Marshal(raw); err == nil {
+					params = string(normalized)
+				}
+			}
+		}
+	}
+
+	return fmt.Sprintf("%s|%q|%q|%s", s.At.UTC().Format(time.RFC3339Nano), s.Memo, s.TemplateID, params)
+}
+
+// sessionName は start world の name と突き合わせるセッション名.
+func sessionName(s *entity.Session) string {
+	if name := s.StartupParameters.GetName(); name != "" {
+		return name
+	}
+
+	return s.Name
+}
+
+func derefString(s *string) string {
+	if s == nil {
+		return ""
+	}
+
+	return *s
+}
+
+// decodeScheduledStart は時刻指定の START_SESSION 予約だけを ScheduledStart にする. それ以外は nil.
+func decodeScheduledStart(sou *usecase.ScheduledSessionOperationUsecase, op *entity.ScheduledSessionOperation) (*ScheduledStart, error) {
+	if op.OperationType != entity.ScheduledOperationType_START_SESSION || op.TriggerType != entity.ScheduledTriggerType_TIME {
+		return nil, nil //nolint:nilnil // 管理対象外の予約
+	}
+
+	act, err := sou.DecodeAction(op.OperationType, op.OperationPayload)
+	if err != nil {
+		return nil, err
+	}
+
+	trig, err := sou.DecodeTrigger(op.TriggerType, op.TriggerConfig)
+	if err != nil {
+		return nil, err
+	}
+
+	start, ok := act.(*actions.StartSessionAction)
+	if !ok {
+		return nil, nil //nolint:nilnil // 管理対象外の予約
+	}
+
+	at, ok := trig.(*triggers.TimeTrigger)
+	if !ok {
+		return nil, nil //nolint:nilnil // 管理対象外の予約
+	}
+
+	spec := &ScheduledStartSpec{At: at.ScheduledAt}
+	if start.Memo != nil {
+		spec.Memo = *start.Memo
+	}
+
+	if start.TemplateID != nil {
+		spec.TemplateID = *start.TemplateID
+	}
+
+	if len(start.StartupParamsJSON) > 0 {
+		params := &headlessv1.WorldStartupParameters{}
+		if err := protojson.Unmarshal(start.StartupParamsJSON, params); err != nil {
+			return nil, errors.WrapPrefix(err, "decode startup parameters", 0)
+		}
+
+		if !proto.Equal(params, &headlessv1.WorldStartupParameters{}) {
+			spec.Parameters = &WorldParams{WorldStartupParameters: params}
+		}
+	}
+
+	return &ScheduledStart{ID: op.ID, Spec: spec}, nil
+}
@@ -0,0 +1,280 @@
+package desired_state
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/hantabaru1014/baru-reso-headless-controller/domain"
+	"github.com/hantabaru1014/baru-reso-headless-controller/domain/entity"
+	headlessv1 "github.com/hantabaru1014/baru-reso-headless-controller/pbgen/headless/v1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+)
+
+const testSpecYAML = `
+groups:
+  - name: team
+    members:
+      - user: alice
+        role: admin
+      - user: bob
+        role: viewer
+hosts:
+  - name: lobby
+    group: team
+    account: U-acc
+    auto_update_policy: users_empty
+    settings:
+      tick_rate: 60
+    start_worlds:
+      - name: Lobby
+        max_users: 16
+      - name: Event
+scheduled_operations:
+  - host: lobby
+    group: team
+    at: 2026-11-01T12:00:00Z
+    memo: weekly
+`
+
+func TestLoadSpec(t *testing.T) {
+	t.Parallel()
+
+	t.Run("構成ファイルを読み込める", func(t *testing.T) {
+		t.Parallel()
+
+		spec, err := LoadSpec(strings.NewReader(testSpecYAML))
+		require.NoError(t, err)
+
+		require.Len(t, spec.Groups, 1)
+		assert.Len(t, spec.Groups[0].Members, 2)
+		require.Len(t, spec.Hosts, 1)
+		assert.Equal(t, HostState_RUNNING, spec.Hosts[0].desiredState())
+		require.Len(t, spec.Hosts[0].StartWorlds, 2)
+		assert.Equal(t, int32(16), spec.Hosts[0].StartWorlds[0].GetMaxUsers())
+		require.Len(t, spec.ScheduledOperations, 1)
+		assert.Equal(t, time.Date(2026, 11, 1, 12, 0, 0, 0, time.UTC), spec.ScheduledOperations[0].At.UTC())
+	})
+
+	t.Run("members が空リストなら管理対象として扱う", func(t *testing.T) {
+		t.Parallel()
+
+		spec, err := LoadSpec(strings.NewReader("groups:\n  - name: a\n    members: []\n  - name: b\n"))
+		require.NoError(t, err)
+
+		assert.NotNil(t, spec.Groups[0].Members)
+		assert.Nil(t, spec.Groups[1].Members)
+	})
+
+	t.Run("書き出したものを読み直せる", func(t *testing.T) {
+		t.Parallel()
+
+		spec, err := LoadSpec(strings.NewReader(testSpecYAML))
+		require.NoError(t, err)
+
+		var buf bytes.Buffer
+		require.NoError(t, WriteSpec(&buf, spec))
+
+		again, err := LoadSpec(&buf)
+		require.NoError(t, err)
+		assert.Equal(t, scheduledStartKey(spec.ScheduledOperations[0]), scheduledStartKey(again.ScheduledOperations[0]))
+		assert.True(t, proto.Equal(spec.Hosts[0].StartWorlds[0].WorldStartupParameters, again.Hosts[0].StartWorlds[0].WorldStartupParameters))
+	})
+
+	invalid := map[string]string{
+		"未知のキー":          "hosts:\n  - name: h\n    group: g\n    account: a\n    unknown: 1\n",
+		"グループ名の重複":       "groups:\n  - name: a\n  - name: a\n",
+		"不明な policy":     "hosts:\n  - name: h\n    group: g\n    account: a\n    auto_update_policy: sometimes\n",
+		"宣言されていないホストの予約": "scheduled_operations:\n  - host: h\n    group: g\n    at: 2026-11-01T12:00:00Z\n",
+	}
+	for name, src := range invalid {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := LoadSpec(strings.NewReader(src))
+			assert.ErrorIs(t, err, domain.ErrInvalidArgument)
+		})
+	}
+}
+
+func testSnapshot() *Snapshot {
+	settings := entity.HeadlessHostSettings{TickRate: 30}
+
+	return &Snapshot{
+		Groups: entity.GroupList{
+			{ID: "g-team", Name: "team", Type: entity.GroupType_Normal},
+		},
+		Members: map[string]entity.GroupMemberList{
+			"g-team": {
+				{GroupID: "g-team", UserID: domain.SystemUserID, RoleID: "admin"},
+				{GroupID: "g-team", UserID: "alice", RoleID: "editor"},
+				{GroupID: "g-team", UserID: "carol", RoleID: "viewer"},
+			},
+		},
+		Hosts: entity.HeadlessHostList{
+			{
+				ID:                "h-lobby",
+				Name:              "lobby",
+				GroupID:           "g-team",
+				AccountId:         "U-acc",
+				Status:            entity.HeadlessHostStatus_RUNNING,
+				HostSettings:      settings,
+				AutoUpdatePolicy:  entity.HostAutoUpdatePolicy_NEVER,
+				AutoRestartPolicy: entity.HostAutoRestartPolicy_NEVER,
+			},
+		},
+		Accounts: map[string]*entity.HeadlessAccount{
+			"U-acc": {ResoniteID: "U-acc", GroupID: "g-team"},
+		},
+		Sessions: map[string]entity.SessionList{
+			"h-lobby": {
+				{ID: "s-lobby", Name: "Lobby", StartupParameters: &headlessv1.WorldStartupParameters{Name: proto.String("Lobby")}},
+				{ID: "s-old", Name: "Old"},
+			},
+		},
+		ScheduledStarts: map[string][]*ScheduledStart{
+			"h-lobby": {
+				{ID: "op-weekly", Spec: &ScheduledStartSpec{At: time.Date(2026, 11, 1, 12, 0, 0, 0, time.UTC), Memo: "weekly"}},
+				{ID: "op-stale", Spec: &ScheduledStartSpec{At: time.Date(2026, 12, 1, 12, 0, 0, 0, time.UTC)}},
+			},
+		},
+		Now: time.Date(2026, 10, 1, 0, 0, 0, 0, time.UTC),
+	}
+}
+
+func changeTargets(plan *Plan) []string {
+	targets := make([]string, 0, len(plan.Changes))
+	for _, c := range plan.Changes {
+		targets = append(targets, string(c.Kind)+" "+c.Target)
+	}
+
+	return targets
+}
+
+func TestBuildPlan(t *testing.T) {
+	t.Parallel()
+
+	t.Run("差分だけを change にする", func(t *testing.T) {
+		t.Parallel()
+
+		spec, err := LoadSpec(strings.NewReader(testSpecYAML))
+		require.NoError(t, err)
+
+		plan, err := BuildPlan(spec, testSnapshot(), PlanOptions{})
+		require.NoError(t, err)
+
+		assert.Equal(t, []string{
+			"update member team/alice",
+			"create member team/bob",
+			"update host team/lobby",
+			"start session team/lobby/Event",
+		}, changeTargets(plan))
+		assert.Equal(t, []string{
+			"auto_update_policy: never -> users_empty",
+			"tick_rate: 30 -> 60",
+		}, plan.Changes[2].Details)
+
+		// prune しない場合は削除対象を警告にとどめる
+		assert.Len(t, plan.Warnings, 3)
+	})
+
+	t.Run("prune なら宣言に無いものを削除・停止・キャンセルする", func(t *testing.T) {
+		t.Parallel()
+
+		spec, err := LoadSpec(strings.NewReader(testSpecYAML))
+		require.NoError(t, err)
+
+		plan, err := BuildPlan(spec, testSnapshot(), PlanOptions{Prune: true})
+		require.NoError(t, err)
+
+		targets := changeTargets(plan)
+		assert.Contains(t, targets, "delete member team/carol")
+		assert.Contains(t, targets, "stop session team/lobby/Old")
+		assert.Contains(t, targets, "delete scheduled start team/lobby@2026-12-01T12:00:00Z")
+		assert.NotContains(t, targets, "delete member team/"+domain.SystemUserID)
+		assert.Empty(t, plan.Warnings)
+	})
+
+	t.Run("一致していれば plan は空", func(t *testing.T) {
+		t.Parallel()
+
+		spec := &Spec{
+			Groups: []*GroupSpec{{Name: "team", Members: []*MemberSpec{{User: "alice", Role: "editor"}, {User: "carol", Role: "viewer"}}}},
+			Hosts: []*HostSpec{{
+				Name: "lobby", Group: "team", Account: "U-acc",
+				StartWorlds: []*WorldParams{
+					{WorldStartupParameters: &headlessv1.WorldStartupParameters{Name: proto.String("Lobby")}},
+					{WorldStartupParameters: &headlessv1.WorldStartupParameters{Name: proto.String("Old")}},
+				},
+			}},
+			ScheduledOperations: []*ScheduledStartSpec{
+				{Host: "lobby", Group: "team", At: time.Date(2026, 11, 1, 12, 0, 0, 0, time.UTC), Memo: "weekly"},
+				{Host: "lobby", Group: "team", At: time.Date(2026, 12, 1, 12, 0, 0, 0, time.UTC)},
+			},
+		}
+
+		plan, err := BuildPlan(spec, testSnapshot(), PlanOptions{Prune: true})
+		require.NoError(t, err)
+		assert.True(t, plan.IsEmpty(), "%v", changeTargets(plan))
+		assert.Empty(t, plan.Warnings)
+	})
+
+	t.Run("存在しないホストは作成し、停止中のホストは起動する", func(t *testing.T) {
+		t.Parallel()
+
+		snap := testSnapshot()
+		snap.Hosts[0].Status = entity.HeadlessHostStatus_EXITED
+
+		spec := &Spec{
+			Hosts: []*HostSpec{
+				{Name: "lobby", Group: "team", Account: "U-acc"},
+				{Name: "new", Group: "g-team", Account: "U-acc"},
+				{Name: "parked", Group: "team", Account: "U-acc", State: HostState_STOPPED},
+			},
+			ScheduledOperations: []*ScheduledStartSpec{
+				{Host: "new", Group: "g-team", At: time.Date(2026, 11, 2, 0, 0, 0, 0, time.UTC)},
+				{Host: "lobby", Group: "team", At: time.Date(2026, 9, 1, 0, 0, 0, 0, time.UTC)},
+			},
+		}
+
+		plan, err := BuildPlan(spec, snap, PlanOptions{})
+		require.NoError(t, err)
+
+		assert.Equal(t, []string{
+			"start host team/lobby",
+			"create host g-team/new",
+			"create scheduled start g-team/new@2026-11-02T00:00:00Z",
+		}, changeTargets(plan))
+
+		warnings := strings.Join(plan.Warnings, "\n")
+		assert.Contains(t, warnings, "host team/parked does not exist")
+		assert.Contains(t, warnings, "is in the past")
+	})
+
+	t.Run("別グループのアカウントはエラー", func(t *testing.T) {
+		t.Parallel()
+
+		snap := testSnapshot()
+		snap.Groups = append(snap.Groups, &entity.Group{ID: "g-other", Name: "other"})
+
+		spec := &Spec{Hosts: []*HostSpec{{Name: "h", Group: "other", Account: "U-acc"}}}
+
+		_, err := BuildPlan(spec, snap, PlanOptions{})
+		assert.ErrorIs(t, err, domain.ErrInvalidArgument)
+	})
+
+	t.Run("名前が重複するグループはエラー", func(t *testing.T) {
+		t.Parallel()
+
+		snap := testSnapshot()
+		snap.Groups = append(snap.Groups, &entity.Group{ID: "g-team2", Name: "team"})
+
+		spec := &Spec{Hosts: []*HostSpec{{Name: "lobby", Group: "team", Account: "U-acc"}}}
+
+		_, err := BuildPlan(spec, snap, PlanOptions{})
+		assert.ErrorIs(t, err, domain.ErrInvalidArgument)
+	})
+}
@@ -0,0 +1,380 @@
+package desired_state
+
+import (
+	"cmp"
+	"context"
+	"slices"
+	"time"
+
+	"github.com/go-errors/errors"
+	"github.com/hantabaru1014/baru-reso-headless-controller/domain"
+	"github.com/hantabaru1014/baru-reso-headless-controller/domain/entity"
+	"github.com/hantabaru1014/baru-reso-headless-controller/usecase"
+	"github.com/hantabaru1014/baru-reso-headless-controller/usecase/scheduled_op/actions"
+	"github.com/hantabaru1014/baru-reso-headless-controller/usecase/scheduled_op/triggers"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+)
+
+// defaultHostStartTimeoutSeconds は停止中のホストを起動するときの待ち時間.
+const defaultHostStartTimeoutSeconds = 600
+
+// Reconciler は構成ファイルと現在の状態の差分を計算し、既存の usecase 経由で反映する.
+// 権限チェックは各 usecase に任せるので、ctx の実行主体が持つ権限の範囲でしか反映できない.
+type Reconciler struct {
+	guc  *usecase.GroupUsecase
+	hhuc *usecase.HeadlessHostUsecase
+	hauc *usecase.HeadlessAccountUsecase
+	suc  *usecase.SessionUsecase
+	sou  *usecase.ScheduledSessionOperationUsecase
+
+	hostStartTimeoutSeconds int
+}
+
+func NewReconciler(
+	guc *usecase.GroupUsecase,
+	hhuc *usecase.HeadlessHostUsecase,
+	hauc *usecase.HeadlessAccountUsecase,
+	suc *usecase.SessionUsecase,
+	sou *usecase.ScheduledSessionOperationUsecase,
+) *Reconciler {
+	return &Reconciler{
+		guc:                     guc,
+		hhuc:                    hhuc,
+		hauc:                    hauc,
+		suc:                     suc,
+		sou:                     sou,
+		hostStartTimeoutSeconds: defaultHostStartTimeoutSeconds,
+	}
+}
+
+// Plan は現在の状態を読み込み、spec との差分を plan にする. 状態は変更しない.
+func (r *Reconciler) Plan(ctx context.Context, spec *Spec, opts PlanOptions) (*Plan, error) {
+	snap, err := r.loadSnapshot(ctx, spec)
+	if err != nil {
+		return nil, err
+	}
+
+	return BuildPlan(spec, snap, opts)
+}
+
+// Apply は plan の change を順に実行する. 失敗した時点で止め、それまでの change は戻さない.
+// onDone は change を 1 つ実行し終えるたびに呼ばれる.
+func (r *Reconciler) Apply(ctx context.Context, plan *Plan, onDone func(*Change)) error {
+	for _, c := range plan.Changes {
+		if err := c.exec(ctx, r); err != nil {
+			return errors.WrapPrefix(err, string(c.Kind)+" "+c.Target, 0)
+		}
+
+		if onDone != nil {
+			onDone(c)
+		}
+	}
+
+	return nil
+}
+
+// Export は現在の状態を構成ファイルの形にする.
+// personal / system グループ自体は出力しない (そこに属するホストは出力する).
+func (r *Reconciler) Export(ctx context.Context) (*Spec, error) {
+	groups, err := r.guc.ListGroupsForUser(ctx, domain.SystemUserID)
+	if err != nil {
+		return nil, errors.Wrap(err, 0)
+	}
+
+	hosts, err := r.hhuc.HeadlessHostList(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	groupNames := exportGroupNames(groups)
+	spec := &Spec{}
+
+	for _, g := range groups {
+		if g.Type != entity.GroupType_Normal {
+			continue
+		}
+
+		members, err := r.guc.ListGroupMembers(ctx, g.ID)
+		if err != nil {
+			return nil, errors.Wrap(err, 0)
+		}
+
+		gs := &GroupSpec{Name: groupNames[g.ID], Members: []*MemberSpec{}}
+
+		for _, m := range members {
+			if m.UserID == domain.SystemUserID {
+				continue
+			}
+
+			gs.Members = append(gs.Members, &MemberSpec{User: m.UserID, Role: m.RoleID})
+		}
+
+		slices.SortFunc(gs.Members, func(a, b *MemberSpec) int { return cmp.Compare(a.User, b.User) })
+		spec.Groups = append(spec.Groups, gs)
+	}
+
+	slices.SortFunc(spec.Groups, func(a, b *GroupSpec) int { return cmp.Compare(a.Name, b.Name) })
+
+	for _, h := range hosts {
+		hs, err := r.exportHost(ctx, h, groupNames[h.GroupID])
+		if err != nil {
+			return nil, err
+		}
+
+		spec.Hosts = append(spec.Hosts, hs)
+
+		starts, err := r.listScheduledStarts(ctx, h.ID)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, s := range starts {
+			s.Spec.Host = h.Name
+			s.Spec.Group = groupNames[h.GroupID]
+			spec.ScheduledOperations = append(spec.ScheduledOperations, s.Spec)
+		}
+	}
+
+	slices.SortFunc(spec.Hosts, func(a, b *HostSpec) int {
+		return cmp.Compare(hostKey(a.Group, a.Name), hostKey(b.Group, b.Name))
+	})
+	slices.SortFunc(spec.ScheduledOperations, func(a, b *ScheduledStartSpec) int {
+		return cmp.Or(cmp.Compare(hostKey(a.Group, a.Host), hostKey(b.Group, b.Host)), a.At.Compare(b.At))
+	})
+
+	return spec, nil
+}
+
+func (r *Reconciler) exportHost(ctx context.Context, h *entity.HeadlessHost, group string) (*HostSpec, error) {
+	hs := &HostSpec{
+		Name:                  h.Name,
+		Group:                 group,
+		Account:               h.AccountId,
+		Node:                  h.NodeID,
+		Memo:                  h.Memo,
+		AutoUpdatePolicy:      autoUpdatePolicyNames[h.AutoUpdatePolicy],
+		AutoRestartPolicy:     autoRestartPolicyNames[h.AutoRestartPolicy],
+		AutoRestartMaxRetries: h.AutoRestartMaxRetries,
+		Container:             containerSpecFromEntity(h.ContainerSettings),
+		Settings: HostSettingsSpec{
+			UniverseID:                  h.HostSettings.UniverseID,
+			TickRate:                    h.HostSettings.TickRate,
+			MaxConcurrentAssetTransfers: h.HostSettings.MaxConcurrentAssetTransfers,
+			UsernameOverride:            h.HostSettings.UsernameOverride,
+			AutoSpawnItems:              h.HostSettings.AutoSpawnItems,
+		},
+	}
+
+	for _, a := range h.HostSettings.AllowedUrlHosts {
+		entry := &AllowedURLHostSpec{Host: a.Host, Ports: a.Ports}
+		for _, t := range a.AccessTypes {
+			entry.AccessTypes = append(entry.AccessTypes, accessTypeNames[t])
+		}
+
+		hs.Settings.AllowedURLHosts = append(hs.Settings.AllowedURLHosts, entry)
+	}
+
+	if h.Status != entity.HeadlessHostStatus_RUNNING && h.Status != entity.HeadlessHostStatus_STARTING {
+		hs.State = HostState_STOPPED
+
+		return hs, nil
+	}
+
+	sessions, err := r.activeSessions(ctx, h.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, s := range sessions {
+		if s.StartupParameters == nil {
+			continue
+		}
+
+		params := proto.CloneOf(s.StartupParameters)
+		if params.GetName() == "" {
+			params.Name = proto.String(s.Name)
+		}
+
+		hs.StartWorlds = append(hs.StartWorlds, &WorldParams{WorldStartupParameters: params})
+	}
+
+	return hs, nil
+}
+
+// exportGroupNames は group ID ごとの出力名を返す. 名前が重複するグループは ID で出力する.
+func exportGroupNames(groups entity.GroupList) map[string]string {
+	count := map[string]int{}
+	for _, g := range groups {
+		count[g.Name]++
+	}
+
+	names := make(map[string]string, len(groups))
+
+	for _, g := range groups {
+		if count[g.Name] == 1 {
+			names[g.ID] = g.Name
+		} else {
+			names[g.ID] = g.ID
+		}
+	}
+
+	return names
+}
+
+// loadSnapshot は spec が参照する範囲の現在の状態を集める.
+func (r *Reconciler) loadSnapshot(ctx context.Context, spec *Spec) (*Snapshot, error) {
+	snap := &Snapshot{
+		Members:         map[string]entity.GroupMemberList{},
+		Accounts:        map[string]*entity.HeadlessAccount{},
+		Sessions:        map[string]entity.SessionList{},
+		ScheduledStarts: map[string][]*ScheduledStart{},
+		Now:             time.Now(),
+	}
+
+	var err error
+
+	snap.Groups, err = r.guc.ListGroupsForUser(ctx, domain.SystemUserID)
+	if err != nil {
+		return nil, errors.Wrap(err, 0)
+	}
+
+	for _, g := range spec.Groups {
+		if g.Members == nil {
+			continue
+		}
+
+		for _, existing := range snap.Groups {
+			if existing.Name != g.Name {
+				continue
+			}
+
+			snap.Members[existing.ID], err = r.guc.ListGroupMembers(ctx, existing.ID)
+			if err != nil {
+				return nil, errors.Wrap(err, 0)
+			}
+		}
+	}
+
+	snap.Hosts, err = r.hhuc.HeadlessHostList(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, h := range spec.Hosts {
+		if _, ok := snap.Accounts[h.Account]; ok {
+			continue
+		}
+
+		account, err := r.hauc.GetHeadlessAccount(ctx, h.Account)
+		if err != nil {
+			return nil, errors.WrapPrefix(err, "get headless account "+h.Account, 0)
+		}
+
+		snap.Accounts[h.Account] = account
+	}
+
+	// ホストは名前が一致するものだけ状態を読む (グループの解決は BuildPlan で行う).
+	for _, host := range snap.Hosts {
+		if !slices.ContainsFunc(spec.Hosts, func(h *HostSpec) bool { return h.Name == host.Name }) {
+			continue
+		}
+
+		if host.Status == entity.HeadlessHostStatus_RUNNING {
+			snap.Sessions[host.ID], err = r.activeSessions(ctx, host.ID)
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		snap.ScheduledStarts[host.ID], err = r.listScheduledStarts(ctx, host.ID)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return snap, nil
+}
+
+// activeSessions はホストの起動中 / 稼働中のセッションを返す.
+func (r *Reconciler) activeSessions(ctx context.Context, hostID string) (entity.SessionList, error) {
+	var sessions entity.SessionList
+
+	for _, status := range []entity.SessionStatus{entity.SessionStatus_STARTING, entity.SessionStatus_RUNNING} {
+		result, err := r.suc.SearchSessions(ctx, usecase.SearchSessionsFilter{HostID: &hostID, Status: &status})
+		if err != nil {
+			return nil, errors.Wrap(err, 0)
+		}
+
+		sessions = append(sessions, result.Sessions...)
+	}
+
+	return sessions, nil
+}
+
+func (r *Reconciler) listScheduledStarts(ctx context.Context, hostID string) ([]*ScheduledStart, error) {
+	const pageSize = 100
+
+	status := entity.ScheduledOperationStatus_PENDING
+	filter := usecase.ListScheduledSessionOperationsFilter{HostID: &hostID, Status: &status, PageSize: pageSize}
+
+	var starts []*ScheduledStart
+
+	for {
+		result, err := r.sou.List(ctx, filter)
+		if err != nil {
+			return nil, errors.Wrap(err, 0)
+		}
+
+		for _, op := range result.Items {
+			s, err := decodeScheduledStart(r.sou, op)
+			if err != nil {
+				return nil, errors.WrapPrefix(err, "decode scheduled operation "+op.ID, 0)
+			}
+
+			if s != nil {
+				starts = append(starts, s)
+			}
+		}
+
+		if len(result.Items) < pageSize || (filter.PageIndex+1)*pageSize >= result.TotalCount {
+			return starts, nil
+		}
+
+		filter.PageIndex++
+	}
+}
+
+func (r *Reconciler) createScheduledStart(ctx context.Context, hostID, groupID string, spec *ScheduledStartSpec) error {
+	paramsJSON := []byte("{}")
+
+	if spec.Parameters != nil && spec.Parameters.WorldStartupParameters != nil {
+		b, err := protojson.Marshal(spec.Parameters.WorldStartupParameters)
+		if err != nil {
+			return errors.Wrap(err, 0)
+		}
+
+		paramsJSON = b
+	}
+
+	var memo *string
+	if spec.Memo != "" {
+		memo = &spec.Memo
+	}
+
+	userID := domain.SystemUserID
+
+	act := actions.NewStartSessionAction(hostID, groupID, &userID, memo, paramsJSON)
+	if spec.TemplateID != "" {
+		act.TemplateID = &spec.TemplateID
+	}
+
+	_, err := r.sou.Create(ctx, usecase.CreateScheduledSessionOperationParams{
+		Action:    act,
+		Trigger:   triggers.NewTimeTrigger(spec.At),
+		HostID:    &hostID,
+		CreatedBy: &userID,
+	})
+
+	return err
+}
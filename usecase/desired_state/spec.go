@@ -0,0 +1,416 @@
+// Package desired_state は brhcli apply / export が扱う宣言的な構成ファイル (YAML) と、
+// 現在の状態との差分計算・反映を提供する.
+package desired_state
+
+import (
+	"encoding/json"
+	"io"
+	"time"
+
+	"github.com/go-errors/errors"
+	"github.com/hantabaru1014/baru-reso-headless-controller/domain"
+	"github.com/hantabaru1014/baru-reso-headless-controller/domain/entity"
+	headlessv1 "github.com/hantabaru1014/baru-reso-headless-controller/pbgen/headless/v1"
+	"go.yaml.in/yaml/v3"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+// Spec は構成ファイル全体.
+// グループはグループ名 (またはグループ ID)、ホストは (グループ, ホスト名) で既存のものと対応付ける.
+type Spec struct {
+	Groups              []*GroupSpec          `yaml:"groups,omitempty"`
+	Hosts               []*HostSpec           `yaml:"hosts,omitempty"`
+	ScheduledOperations []*ScheduledStartSpec `yaml:"scheduled_operations,omitempty"`
+}
+
+type GroupSpec struct {
+	Name string `yaml:"name"`
+	// members キーが無ければメンバーは管理しない. `members: []` は「system user 以外のメンバーを置かない」の意味.
+	Members []*MemberSpec `yaml:"members,omitempty"`
+}
+
+type MemberSpec struct {
+	User string `yaml:"user"`
+	Role string `yaml:"role"`
+}
+
+// HostState は宣言するホストの稼働状態.
+type HostState string
+
+const (
+	HostState_RUNNING HostState = "running"
+	HostState_STOPPED HostState = "stopped"
+)
+
+type HostSpec struct {
+	Name  string `yaml:"name"`
+	Group string `yaml:"group"`
+	// Account はヘッドレスアカウントの Resonite ID. ホストと同じグループのアカウントに限る.
+	Account string `yaml:"account"`
+	// State が空なら running.
+	State HostState `yaml:"state,omitempty"`
+
+	// ImageTag / Node / Memo / Settings.AllowedURLHosts は作成時だけ使う.
+	ImageTag string `yaml:"image_tag,omitempty"`
+	Node     string `yaml:"node,omitempty"`
+	Memo     string `yaml:"memo,omitempty"`
+
+	// 以下は空 / 0 なら既存ホストの値を変更しない.
+	AutoUpdatePolicy      string           `yaml:"auto_update_policy,omitempty"`
+	AutoRestartPolicy     string           `yaml:"auto_restart_policy,omitempty"`
+	AutoRestartMaxRetries int32            `yaml:"auto_restart_max_retries,omitempty"`
+	Container             *ContainerSpec   `yaml:"container,omitempty"`
+	Settings              HostSettingsSpec `yaml:"settings,omitempty"`
+
+	// StartWorlds は起動しておくワールド. ワールド名 (name) で稼働中のセッションと対応付ける.
+	// start_worlds キーが無ければセッションは管理しない. `start_worlds: []` はセッションを置かない意味になる.
+	StartWorlds []*WorldParams `yaml:"start_worlds,omitempty"`
+}
+
+type HostSettingsSpec struct {
+	UniverseID                  *string               `yaml:"universe_id,omitempty"`
+	TickRate                    float32               `yaml:"tick_rate,omitempty"`
+	MaxConcurrentAssetTransfers int32                 `yaml:"max_concurrent_asset_transfers,omitempty"`
+	UsernameOverride            *string               `yaml:"username_override,omitempty"`
+	AllowedURLHosts             []*AllowedURLHostSpec `yaml:"allowed_url_hosts,omitempty"`
+	// AutoSpawnItems が nil なら変更しない.
+	AutoSpawnItems []string `yaml:"auto_spawn_items,omitempty"`
+}
+
+type AllowedURLHostSpec struct {
+	Host        string   `yaml:"host"`
+	Ports       []int32  `yaml:"ports,omitempty"`
+	AccessTypes []string `yaml:"access_types,omitempty"`
+}
+
+type ContainerSpec struct {
+	CPUs              float64          `yaml:"cpus,omitempty"`
+	MemoryBytes       int64            `yaml:"memory_bytes,omitempty"`
+	MemorySwapBytes   int64            `yaml:"memory_swap_bytes,omitempty"`
+	CpusetCpus        string           `yaml:"cpuset_cpus,omitempty"`
+	RestartPolicy     string           `yaml:"restart_policy,omitempty"`
+	RestartMaxRetries int32            `yaml:"restart_max_retries,omitempty"`
+	BindMounts        []*BindMountSpec `yaml:"bind_mounts,omitempty"`
+}
+
+type BindMountSpec struct {
+	Source   string `yaml:"source"`
+	Target   string `yaml:"target"`
+	ReadOnly bool   `yaml:"read_only,omitempty"`
+}
+
+// ScheduledStartSpec は時刻指定のセッション起動予約.
+// 既存の予約とは内容 (ホスト・時刻・メモ・テンプレート・パラメータ) が一致するかで対応付ける.
+type ScheduledStartSpec struct {
+	Host       string       `yaml:"host"`
+	Group      string       `yaml:"group"`
+	At         time.Time    `yaml:"at"`
+	Memo       string       `yaml:"memo,omitempty"`
+	TemplateID string       `yaml:"template_id,omitempty"`
+	Parameters *WorldParams `yaml:"parameters,omitempty"`
+}
+
+// WorldParams は WorldStartupParameters を protojson と同じフィールド名の YAML で読み書きする.
+type WorldParams struct {
+	*headlessv1.WorldStartupParameters
+}
+
+func (w *WorldParams) UnmarshalYAML(node *yaml.Node) error {
+	var raw any
+	if err := node.Decode(&raw); err != nil {
+		return errors.Wrap(err, 0)
+	}
+
+	b, err := json.Marshal(raw)
+	if err != nil {
+		return errors.Wrap(err, 0)
+	}
+
+	params := &headlessv1.WorldStartupParameters{}
+	if err := protojson.Unmarshal(b, params); err != nil {
+		return errors.Errorf("line %d: invalid world parameters: %v: %w", node.Line, err, domain.ErrInvalidArgument)
+	}
+
+	w.WorldStartupParameters = params
+
+	return nil
+}
+
+func (w *WorldParams) MarshalYAML() (any, error) {
+	b, err := protojson.MarshalOptions{UseProtoNames: true}.Marshal(w.WorldStartupParameters)
+	if err != nil {
+		return nil, errors.Wrap(err, 0)
+	}
+
+	var raw any
+	if err := json.Unmarshal(b, &raw); err != nil {
+		return nil, errors.Wrap(err, 0)
+	}
+
+	return raw, nil
+}
+
+// LoadSpec は構成ファイルを読み込んで検証する. 未知のキーはエラーにする.
+func LoadSpec(r io.Reader) (*Spec, error) {
+	dec := yaml.NewDecoder(r)
+	dec.KnownFields(true)
+
+	spec := &Spec{}
+	if err := dec.Decode(spec); err != nil && !errors.Is(err, io.EOF) {
+		return nil, errors.Errorf("parse spec: %v: %w", err, domain.ErrInvalidArgument)
+	}
+
+	if err := spec.Validate(); err != nil {
+		return nil, err
+	}
+
+	return spec, nil
+}
+
+// WriteSpec は spec を YAML で書き出す.
+func WriteSpec(w io.Writer, spec *Spec) error {
+	enc := yaml.NewEncoder(w)
+	enc.SetIndent(2)
+
+	if err := enc.Encode(spec); err != nil {
+		return errors.Wrap(err, 0)
+	}
+
+	if err := enc.Close(); err != nil {
+		return errors.Wrap(err, 0)
+	}
+
+	return nil
+}
+
+// Validate は DB を見ずに判定できる不備 (必須項目・重複・列挙値) を検出する.
+func (s *Spec) Validate() error {
+	groups := map[string]bool{}
+
+	for _, g := range s.Groups {
+		if g.Name == "" {
+			return invalidf("groups: name is required")
+		}
+
+		if groups[g.Name] {
+			return invalidf("groups: duplicate group %q", g.Name)
+		}
+
+		groups[g.Name] = true
+
+		users := map[string]bool{}
+
+		for _, m := range g.Members {
+			if m.User == "" || m.Role == "" {
+				return invalidf("group %q: member user and role are required", g.Name)
+			}
+
+			if users[m.User] {
+				return invalidf("group %q: duplicate member %q", g.Name, m.User)
+			}
+
+			users[m.User] = true
+		}
+	}
+
+	hosts := map[string]bool{}
+
+	for _, h := range s.Hosts {
+		if h.Name == "" || h.Group == "" || h.Account == "" {
+			return invalidf("hosts: name, group and account are required")
+		}
+
+		key := hostKey(h.Group, h.Name)
+		if hosts[key] {
+			return invalidf("hosts: duplicate host %s", key)
+		}
+
+		hosts[key] = true
+
+		if err := h.validate(); err != nil {
+			return err
+		}
+	}
+
+	for _, op := range s.ScheduledOperations {
+		if op.Host == "" || op.Group == "" || op.At.IsZero() {
+			return invalidf("scheduled_operations: host, group and at are required")
+		}
+
+		if !hosts[hostKey(op.Group, op.Host)] {
+			return invalidf("scheduled_operations: host %s is not declared in hosts", hostKey(op.Group, op.Host))
+		}
+	}
+
+	return nil
+}
+
+func (h *HostSpec) validate() error {
+	key := hostKey(h.Group, h.Name)
+
+	if h.State != "" && h.State != HostState_RUNNING && h.State != HostState_STOPPED {
+		return invalidf("host %s: unknown state %q", key, h.State)
+	}
+
+	if _, err := parseAutoUpdatePolicy(h.AutoUpdatePolicy); err != nil {
+		return errors.WrapPrefix(err, "host "+key, 0)
+	}
+
+	if _, err := parseAutoRestartPolicy(h.AutoRestartPolicy); err != nil {
+		return errors.WrapPrefix(err, "host "+key, 0)
+	}
+
+	if h.Container != nil {
+		if _, err := h.Container.toEntity(); err != nil {
+			return errors.WrapPrefix(err, "host "+key, 0)
+		}
+	}
+
+	if _, err := h.Settings.allowedURLHosts(); err != nil {
+		return errors.WrapPrefix(err, "host "+key, 0)
+	}
+
+	names := map[string]bool{}
+
+	for _, w := range h.StartWorlds {
+		name := w.GetName()
+		if name == "" {
+			return invalidf("host %s: start_worlds require a name", key)
+		}
+
+		if names[name] {
+			return invalidf("host %s: duplicate start world %q", key, name)
+		}
+
+		names[name] = true
+	}
+
+	return nil
+}
+
+func (h *HostSpec) desiredState() HostState {
+	if h.State == "" {
+		return HostState_RUNNING
+	}
+
+	return h.State
+}
+
+func (c *ContainerSpec) toEntity() (entity.HostContainerSettings, error) {
+	policy, err := parseRestartPolicy(c.RestartPolicy)
+	if err != nil {
+		return entity.HostContainerSettings{}, err
+	}
+
+	mounts := make([]entity.HostBindMount, 0, len(c.BindMounts))
+	for _, m := range c.BindMounts {
+		mounts = append(mounts, entity.HostBindMount{Source: m.Source, Target: m.Target, ReadOnly: m.ReadOnly})
+	}
+
+	return entity.HostContainerSettings{
+		CPUs:              c.CPUs,
+		MemoryBytes:       c.MemoryBytes,
+		MemorySwapBytes:   c.MemorySwapBytes,
+		CpusetCpus:        c.CpusetCpus,
+		RestartPolicy:     policy,
+		RestartMaxRetries: c.RestartMaxRetries,
+		BindMounts:        mounts,
+	}, nil
+}
+
+func containerSpecFromEntity(e entity.HostContainerSettings) *ContainerSpec {
+	c := &ContainerSpec{
+		CPUs:              e.CPUs,
+		MemoryBytes:       e.MemoryBytes,
+		MemorySwapBytes:   e.MemorySwapBytes,
+		CpusetCpus:        e.CpusetCpus,
+		RestartPolicy:     restartPolicyNames[e.RestartPolicy],
+		RestartMaxRetries: e.RestartMaxRetries,
+	}
+	for _, m := range e.BindMounts {
+		c.BindMounts = append(c.BindMounts, &BindMountSpec{Source: m.Source, Target: m.Target, ReadOnly: m.ReadOnly})
+	}
+
+	return c
+}
+
+func (s *HostSettingsSpec) allowedURLHosts() ([]entity.HostAllowedAccessEntry, error) {
+	entries := make([]entity.HostAllowedAccessEntry, 0, len(s.AllowedURLHosts))
+
+	for _, a := range s.AllowedURLHosts {
+		types := make([]entity.HostAllowedAccessType, 0, len(a.AccessTypes))
+
+		for _, name := range a.AccessTypes {
+			t, err := parseEnum(accessTypeNames, name, "access type")
+			if err != nil {
+				return nil, err
+			}
+
+			types = append(types, t)
+		}
+
+		entries = append(entries, entity.HostAllowedAccessEntry{Host: a.Host, Ports: a.Ports, AccessTypes: types})
+	}
+
+	return entries, nil
+}
+
+func hostKey(group, name string) string {
+	return group + "/" + name
+}
+
+func invalidf(format string, args ...any) error {
+	return errors.Errorf(format+": %w", append(args, domain.ErrInvalidArgument)...)
+}
+
+var (
+	autoUpdatePolicyNames = map[entity.HostAutoUpdatePolicy]string{
+		entity.HostAutoUpdatePolicy_NEVER:       "never",
+		entity.HostAutoUpdatePolicy_USERS_EMPTY: "users_empty",
+	}
+	autoRestartPolicyNames = map[entity.HostAutoRestartPolicy]string{
+		entity.HostAutoRestartPolicy_NEVER:    "never",
+		entity.HostAutoRestartPolicy_ON_CRASH: "on_crash",
+		entity.HostAutoRestartPolicy_ALWAYS:   "always",
+	}
+	restartPolicyNames = map[entity.HostRestartPolicy]string{
+		entity.HostRestartPolicy_NO:             "no",
+		entity.HostRestartPolicy_ON_FAILURE:     "on_failure",
+		entity.HostRestartPolicy_ALWAYS:         "always",
+		entity.HostRestartPolicy_UNLESS_STOPPED: "unless_stopped",
+	}
+	accessTypeNames = map[entity.HostAllowedAccessType]string{
+		entity.HostAllowedAccessType_HTTP:          "http",
+		entity.HostAllowedAccessType_WEBSOCKET:     "websocket",
+		entity.HostAllowedAccessType_OSC_RECEIVING: "osc_receiving",
+		entity.HostAllowedAccessType_OSC_SENDING:   "osc_sending",
+	}
+)
+
+func parseAutoUpdatePolicy(name string) (entity.HostAutoUpdatePolicy, error) {
+	return parseEnum(autoUpdatePolicyNames, name, "auto_update_policy")
+}
+
+func parseAutoRestartPolicy(name string) (entity.HostAutoRestartPolicy, error) {
+	return parseEnum(autoRestartPolicyNames, name, "auto_restart_policy")
+}
+
+func parseRestartPolicy(name string) (entity.HostRestartPolicy, error) {
+	return parseEnum(restartPolicyNames, name, "restart_policy")
+}
+
+// parseEnum は names の逆引きを行う. 空文字はゼロ値 (未指定).
+func parseEnum[T comparable](names map[T]string, name, field string) (T, error) {
+	var zero T
+	if name == "" {
+		return zero, nil
+	}
+
+	for v, n := range names {
+		if n == name {
+			return v, nil
+		}
+	}
+
+	return zero, invalidf("unknown %s %q", field, name)
+}
@@ -2,12 +2,17 @@ package usecase
 
 import (
 	"context"
+	"log/slog"
+	"slices"
 
 	"github.com/dchest/uniuri"
 	"github.com/go-errors/errors"
+	"github.com/hantabaru1014/baru-reso-headless-controller/db"
 	"github.com/hantabaru1014/baru-reso-headless-controller/domain"
 	"github.com/hantabaru1014/baru-reso-headless-controller/domain/entity"
 	"github.com/hantabaru1014/baru-reso-headless-controller/usecase/port"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
 )
 
 // ErrGroupOperationForbidden は personal / system グループに対する禁止操作.
@@ -18,20 +23,28 @@ type GroupUsecase struct {
 	groupRepo  port.GroupRepository
 	memberRepo port.GroupMemberRepository
 	roleRepo   port.RoleRepository
-	permUC     *PermissionUsecase
+	// sessionRepo はロールを剥奪したメンバーのログインセッションを失効させるのに使う.
+	sessionRepo port.UserSessionRepository
+	permUC      *PermissionUsecase
+	// queries は personal グループの作成に使う (EnsurePersonalGroupForUserTx).
+	queries *db.Queries
 }
 
 func NewGroupUsecase(
 	groupRepo port.GroupRepository,
 	memberRepo port.GroupMemberRepository,
 	roleRepo port.RoleRepository,
+	sessionRepo port.UserSessionRepository,
 	permUC *PermissionUsecase,
+	queries *db.Queries,
 ) *GroupUsecase {
 	return &GroupUsecase{
-		groupRepo:  groupRepo,
-		memberRepo: memberRepo,
-		roleRepo:   roleRepo,
-		permUC:     permUC,
+		groupRepo:   groupRepo,
+		memberRepo:  memberRepo,
+		roleRepo:    roleRepo,
+		sessionRepo: sessionRepo,
+		permUC:      permUC,
+		queries:     queries,
 	}
 }
 
@@ -66,6 +79,7 @@ func (u *GroupUsecase) GetGroup(ctx context.Context, id string) (*entity.Group,
 
 // ListGroupsForUser は user の閲覧可能なグループ一覧を返す.
 // system:group.list を持つ場合は全グループ、それ以外は所属するグループのみ.
+// グループを制限した API トークンではそのグループだけになる.
 func (u *GroupUsecase) ListGroupsForUser(ctx context.Context, userID string) (entity.GroupList, error) {
 	canListAll, err := u.permUC.HasSystemPermission(ctx, userID, entity.PermKey_SystemGroupList)
 	if err != nil {
@@ -76,7 +90,14 @@ func (u *GroupUsecase) ListGroupsForUser(ctx context.Context, userID string) (en
 		return u.groupRepo.ListAll(ctx)
 	}
 
-	return u.groupRepo.ListByUser(ctx, userID)
+	groups, err := u.groupRepo.ListByUser(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	return slices.DeleteFunc(groups, func(g *entity.Group) bool {
+		return !APITokenAllows(ctx, userID, g.ID, "")
+	}), nil
 }
 
 // UpdateGroupName は normal グループのみ name 変更可能. personal/system は禁止.
@@ -101,6 +122,29 @@ func (u *GroupUsecase) UpdateGroupName(ctx context.Context, id, name string) (*e
 	return u.groupRepo.Get(ctx, id)
 }
 
+// UpdateGroupLogRetention はグループのホストのログを DB に残す日数を設定する.
+// days が nil なら全体の設定 (CONTAINER_LOG_RETENTION_DAYS) に戻す.
+// personal / system グループも設定できる.
+func (u *GroupUsecase) UpdateGroupLogRetention(ctx context.Context, id string, days *int32) (*entity.Group, error) {
+	if days != nil && *days <= 0 {
+		return nil, errors.Errorf("log retention days must be positive: %w", domain.ErrInvalidArgument)
+	}
+
+	if _, err := u.groupRepo.Get(ctx, id); err != nil {
+		return nil, err
+	}
+
+	if err := u.permUC.RequirePermissionForGroup(ctx, id, entity.PermKey_GroupEdit); err != nil {
+		return nil, err
+	}
+
+	if err := u.groupRepo.UpdateLogRetentionDays(ctx, id, days); err != nil {
+		return nil, errors.Wrap(err, 0)
+	}
+
+	return u.groupRepo.Get(ctx, id)
+}
+
 // DeleteGroup は normal グループのみ削除可能. personal/system は禁止.
 func (u *GroupUsecase) DeleteGroup(ctx context.Context, id string) error {
 	g, err := u.groupRepo.Get(ctx, id)
@@ -198,7 +242,11 @@ func (u *GroupUsecase) RemoveGroupMember(ctx context.Context, groupID, userID st
 		}
 	}
 
-	return u.memberRepo.Remove(ctx, groupID, userID)
+	if err := u.memberRepo.Remove(ctx, groupID, userID); err != nil {
+		return err
+	}
+
+	return u.revokeMemberSessions(ctx, userID)
 }
 
 // UpdateGroupMemberRole は member のロールを変更する.
@@ -234,7 +282,8 @@ func (u *GroupUsecase) UpdateGroupMemberRole(ctx context.Context, groupID, userI
 		return nil, err
 	}
 
-	if _, err := u.memberRepo.Get(ctx, groupID, userID); err != nil {
+	member, err := u.memberRepo.Get(ctx, groupID, userID)
+	if err != nil {
 		if errors.Is(err, domain.ErrNotFound) {
 			return nil, err
 		}
@@ -242,13 +291,52 @@ func (u *GroupUsecase) UpdateGroupMemberRole(ctx context.Context, groupID, userI
 		return nil, errors.Wrap(err, 0)
 	}
 
+	oldRole, err := u.roleRepo.Get(ctx, member.RoleID)
+	if err != nil {
+		return nil, errors.Wrap(err, 0)
+	}
+
 	if err := u.memberRepo.UpdateRole(ctx, groupID, userID, roleID); err != nil {
 		return nil, errors.Wrap(err, 0)
 	}
 
+	// 権限が減るロール変更は剥奪として扱い、セッションを失効させる.
+	if !isPermSubset(oldRole.PermissionKeys, role.PermissionKeys) {
+		if err := u.revokeMemberSessions(ctx, userID); err != nil {
+			return nil, err
+		}
+	}
+
 	return u.memberRepo.Get(ctx, groupID, userID)
 }
 
+// revokeMemberSessions はロールを剥奪したメンバーのログインセッションを失効させ、
+// 剥奪前の権限で発行されたトークンを使えなくする. 本人の操作なら操作したセッションは残す.
+//nolint:funcorder // 関連 method (RemoveGroupMember / UpdateGroupMemberRole) 直下にヘルパーを置く方が読みやすい
+func (u *GroupUsecase) revokeMemberSessions(ctx context.Context, userID string) error {
+	n, err := u.sessionRepo.RevokeAllByUser(ctx, userID, currentUserSessionID(ctx, userID))
+	if err != nil {
+		return err
+	}
+
+	if n > 0 {
+		slog.Info("revoked user sessions after role removal", "user_id", userID, "count", n)
+	}
+
+	return nil
+}
+
+// isPermSubset は keys の permission が全て superset に含まれていれば true.
+func isPermSubset(keys, superset []string) bool {
+	for _, k := range keys {
+		if !slices.Contains(superset, k) {
+			return false
+		}
+	}
+
+	return true
+}
+
 // requirePermSubsetOfCaller は role が持つ permission を全て caller が group 上で
 // 持っていることを要求する. role の permission を介した privilege escalation を防ぐ.
 //
@@ -310,35 +398,72 @@ func (u *GroupUsecase) ValidatePersonalRoleAssignable(ctx context.Context, roleI
 	return nil
 }
 
+// validateProvisionMemberRole は roleID を groupID のメンバーに付与できるか確認する.
+// 権限チェックはしないので、設定で決まった所属 (SSO の自動プロビジョニング) にだけ使う.
+// 所属自体は呼び出し側が tx 内で追加する.
+func (u *GroupUsecase) validateProvisionMemberRole(ctx context.Context, groupID, roleID string) error {
+	group, err := u.groupRepo.Get(ctx, groupID)
+	if err != nil {
+		return err
+	}
+
+	role, err := u.roleRepo.Get(ctx, roleID)
+	if err != nil {
+		return err
+	}
+
+	if !isRoleAssignableToGroup(role, group) || (role.GroupID != nil && *role.GroupID != group.ID) {
+		return errors.Errorf("role %s cannot be assigned in group %s: %w", roleID, groupID, domain.ErrInvalidArgument)
+	}
+
+	return nil
+}
+
 // EnsurePersonalGroupForUser は user の personal グループを取得 / 作成する.
 // 引数の roleID は personal メンバーシップに付与するロール (デフォルト seed-admin).
 func (u *GroupUsecase) EnsurePersonalGroupForUser(ctx context.Context, userID, roleID string) (*entity.Group, error) {
+	if err := u.EnsurePersonalGroupForUserTx(ctx, u.queries, userID, roleID); err != nil {
+		return nil, err
+	}
+
+	return u.groupRepo.GetPersonalGroupByUser(ctx, userID)
+}
+
+// EnsurePersonalGroupForUserTx は EnsurePersonalGroupForUser を q で行う.
+// 呼び出し側の tx に束縛した Queries を渡すと、グループとメンバーの作成がその tx に含まれる.
+func (u *GroupUsecase) EnsurePersonalGroupForUserTx(ctx context.Context, q *db.Queries, userID, roleID string) error {
 	if roleID != "" {
 		if err := u.ValidatePersonalRoleAssignable(ctx, roleID); err != nil {
-			return nil, err
+			return err
 		}
+	} else {
+		roleID = entity.SeedRoleID_Admin
 	}
 
-	if g, err := u.groupRepo.GetPersonalGroupByUser(ctx, userID); err == nil {
-		return g, nil
-	} else if !errors.Is(err, domain.ErrNotFound) {
-		return nil, errors.Wrap(err, 0)
+	if _, err := q.GetPersonalGroupByUser(ctx, userID); err == nil {
+		return nil
+	} else if !errors.Is(err, pgx.ErrNoRows) {
+		return errors.Wrap(err, 0)
 	}
 
 	gid := userID + "-personal"
 
-	g, err := u.groupRepo.Create(ctx, gid, gid, entity.GroupType_Personal)
-	if err != nil {
-		return nil, errors.WrapPrefix(err, "create personal group", 0)
+	if _, err := q.CreateGroup(ctx, db.CreateGroupParams{
+		ID:   gid,
+		Name: gid,
+		Type: string(entity.GroupType_Personal),
+	}); err != nil {
+		return errors.WrapPrefix(err, "create personal group", 0)
 	}
 
-	if roleID == "" {
-		roleID = entity.SeedRoleID_Admin
+	if _, err := q.AddGroupMember(ctx, db.AddGroupMemberParams{
+		GroupID: gid,
+		UserID:  userID,
+		RoleID:  roleID,
+		AddedBy: pgtype.Text{Valid: false},
+	}); err != nil {
+		return errors.WrapPrefix(err, "register personal member", 0)
 	}
 
-	if _, err := u.memberRepo.Add(ctx, g.ID, userID, roleID, nil); err != nil {
-		return nil, errors.WrapPrefix(err, "register personal member", 0)
-	}
-
-	return g, nil
+	return nil
 }
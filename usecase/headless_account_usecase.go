@@ -8,6 +8,7 @@ import (
 	"github.com/hantabaru1014/baru-reso-headless-controller/domain"
 	"github.com/hantabaru1014/baru-reso-headless-controller/domain/entity"
 	"github.com/hantabaru1014/baru-reso-headless-controller/lib/skyfrost"
+	"github.com/hantabaru1014/baru-reso-headless-controller/usecase/port"
 	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgtype"
 )
@@ -16,13 +17,15 @@ type HeadlessAccountUsecase struct {
 	queries        *db.Queries
 	skyfrostClient skyfrost.Client
 	permUC         *PermissionUsecase
+	cipher         port.CredentialCipher
 }
 
-func NewHeadlessAccountUsecase(queries *db.Queries, skyfrostClient skyfrost.Client, permUC *PermissionUsecase) *HeadlessAccountUsecase {
+func NewHeadlessAccountUsecase(queries *db.Queries, skyfrostClient skyfrost.Client, permUC *PermissionUsecase, cipher port.CredentialCipher) *HeadlessAccountUsecase {
 	return &HeadlessAccountUsecase{
 		queries:        queries,
 		skyfrostClient: skyfrostClient,
 		permUC:         permUC,
+		cipher:         cipher,
 	}
 }
 
@@ -41,19 +44,26 @@ func (u *HeadlessAccountUsecase) CreateHeadlessAccount(ctx context.Context, cred
 		return errors.Wrap(err, 0)
 	}
 
+	sealed, err := u.cipher.Seal(userSession.UserId, credential, password)
+	if err != nil {
+		return errors.Wrap(err, 0)
+	}
+
 	createdByText := pgtype.Text{}
 	if createdBy != nil {
 		createdByText = pgtype.Text{String: *createdBy, Valid: true}
 	}
 
 	return u.queries.CreateHeadlessAccount(ctx, db.CreateHeadlessAccountParams{
-		ResoniteID:      userSession.UserId,
-		Credential:      credential,
-		Password:        password,
-		LastDisplayName: pgtype.Text{String: userInfo.UserName, Valid: true},
-		LastIconUrl:     pgtype.Text{String: userInfo.IconUrl, Valid: true},
-		GroupID:         groupID,
-		CreatedBy:       createdByText,
+		ResoniteID:          userSession.UserId,
+		CredentialKeyID:     pgtype.Text{String: sealed.KeyID, Valid: true},
+		CredentialDataKey:   sealed.DataKey,
+		EncryptedCredential: sealed.Credential,
+		EncryptedPassword:   sealed.Password,
+		LastDisplayName:     pgtype.Text{String: userInfo.UserName, Valid: true},
+		LastIconUrl:         pgtype.Text{String: userInfo.IconUrl, Valid: true},
+		GroupID:             groupID,
+		CreatedBy:           createdByText,
 	})
 }
 
@@ -76,19 +86,94 @@ func (u *HeadlessAccountUsecase) UpdateHeadlessAccountCredentials(ctx context.Co
 		return errors.New("does not match resonite ID")
 	}
 
+	sealed, err := u.cipher.Seal(resoniteID, credential, password)
+	if err != nil {
+		return errors.Wrap(err, 0)
+	}
+
 	return u.queries.UpdateHeadlessAccountCredentials(ctx, db.UpdateHeadlessAccountCredentialsParams{
-		ResoniteID: resoniteID,
-		Credential: credential,
-		Password:   password,
+		ResoniteID:          resoniteID,
+		CredentialKeyID:     pgtype.Text{String: sealed.KeyID, Valid: true},
+		CredentialDataKey:   sealed.DataKey,
+		EncryptedCredential: sealed.Credential,
+		EncryptedPassword:   sealed.Password,
 	})
 }
 
+// OpenCredentials はアカウントの認証情報を復号する. skyfrost へのログインの直前にだけ呼び、
+// 戻り値を保持したり別の層へ渡したりしないこと.
+func (u *HeadlessAccountUsecase) OpenCredentials(account *entity.HeadlessAccount) (credential, password string, err error) {
+	return u.cipher.Open(account.ResoniteID, account.Credentials)
+}
+
+// ReencryptResult は ReencryptCredentials の結果.
+type ReencryptResult struct {
+	// Encrypted は平文だった行を暗号化した数、Rewrapped は primary の鍵に掛け直した数.
+	Encrypted int
+	Rewrapped int
+	// Skipped は読んでから書くまでの間に認証情報が更新されていた行の数 (更新後の行は primary の鍵).
+	Skipped int
+}
+
+// ReencryptCredentials は暗号化前の平文の行を暗号化し、primary 以外の鍵で暗号化された行を
+// primary の鍵に掛け直す. `brhcli accounts reencrypt` 専用で、権限チェックは行わない.
+func (u *HeadlessAccountUsecase) ReencryptCredentials(ctx context.Context) (*ReencryptResult, error) {
+	list, err := u.queries.ListHeadlessAccounts(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, 0)
+	}
+
+	result := &ReencryptResult{}
+
+	for _, v := range list {
+		account := headlessAccountToEntity(v)
+
+		sealed, changed, err := u.cipher.Rewrap(account.ResoniteID, account.Credentials)
+		if err != nil {
+			return result, errors.Wrap(err, 0)
+		}
+
+		if !changed {
+			continue
+		}
+
+		n, err := u.queries.RewrapHeadlessAccountCredentials(ctx, db.RewrapHeadlessAccountCredentialsParams{
+			ResoniteID:          account.ResoniteID,
+			CredentialKeyID:     pgtype.Text{String: sealed.KeyID, Valid: true},
+			CredentialDataKey:   sealed.DataKey,
+			EncryptedCredential: sealed.Credential,
+			EncryptedPassword:   sealed.Password,
+			OldKeyID:            v.CredentialKeyID,
+		})
+		if err != nil {
+			return result, errors.Wrap(err, 0)
+		}
+
+		switch {
+		case n == 0:
+			result.Skipped++
+		case account.Credentials.IsLegacyPlaintext():
+			result.Encrypted++
+		default:
+			result.Rewrapped++
+		}
+	}
+
+	return result, nil
+}
+
 func headlessAccountToEntity(v db.HeadlessAccount) *entity.HeadlessAccount {
 	e := &entity.HeadlessAccount{
 		ResoniteID: v.ResoniteID,
-		Credential: v.Credential,
-		Password:   v.Password,
-		GroupID:    v.GroupID,
+		Credentials: entity.SealedCredentials{
+			KeyID:            v.CredentialKeyID.String,
+			DataKey:          v.CredentialDataKey,
+			Credential:       v.EncryptedCredential,
+			Password:         v.EncryptedPassword,
+			LegacyCredential: v.Credential.String,
+			LegacyPassword:   v.Password.String,
+		},
+		GroupID: v.GroupID,
 	}
 	if v.LastDisplayName.Valid {
 		e.LastDisplayName = &v.LastDisplayName.String
@@ -165,8 +250,8 @@ func (u *HeadlessAccountUsecase) ListHeadlessAccountsPaged(ctx context.Context,
 // NOTE: このメソッドは意図的に権限チェックを行わない. RPC permission interceptor
 // 自身が group_id 解決のために呼ぶほか、ホスト起動 / async job など「account:use
 // のみ」の文脈からも呼ばれるため、ここで account:read を要求すると権限セマンティクス
-// が壊れる. 戻り値には平文の認証情報が含まれるので、新規の呼び出し元は必ず呼び出し側
-// で認可を済ませること (RPC handler なら interceptor 登録、usecase なら Require*).
+// が壊れる. 戻り値の認証情報は暗号化されたままだが OpenCredentials で復号できるので、
+// 新規の呼び出し元は必ず呼び出し側で認可を済ませること (RPC handler なら interceptor 登録、usecase なら Require*).
 func (u *HeadlessAccountUsecase) GetHeadlessAccount(ctx context.Context, resoniteID string) (*entity.HeadlessAccount, error) {
 	v, err := u.queries.GetHeadlessAccount(ctx, resoniteID)
 	if err != nil {
@@ -213,11 +298,16 @@ func (u *HeadlessAccountUsecase) UpdateHeadlessAccountIcon(ctx context.Context,
 	}
 
 	// Get account credentials from DB
-	account, err := u.queries.GetHeadlessAccount(ctx, resoniteID)
+	account, err := u.GetHeadlessAccount(ctx, resoniteID)
 	if err != nil {
 		return "", errors.Errorf("failed to get headless account: %w", err)
 	}
 
+	credential, password, err := u.OpenCredentials(account)
+	if err != nil {
+		return "", errors.Wrap(err, 0)
+	}
+
 	// Process the image (crop to square, resize to 256x256, convert to PNG)
 	processedData, err := skyfrost.ProcessIconImage(iconData)
 	if err != nil {
@@ -225,7 +315,7 @@ func (u *HeadlessAccountUsecase) UpdateHeadlessAccountIcon(ctx context.Context,
 	}
 
 	// Upload the image to Resonite cloud as a texture record
-	_, iconUrl, err := u.skyfrostClient.UploadTextureRecord(ctx, account.Credential, account.Password, "Profile Icon", "Inventory", processedData)
+	_, iconUrl, err := u.skyfrostClient.UploadTextureRecord(ctx, credential, password, "Profile Icon", "Inventory", processedData)
 	if err != nil {
 		return "", errors.Errorf("failed to upload icon: %w", err)
 	}
@@ -234,7 +324,7 @@ func (u *HeadlessAccountUsecase) UpdateHeadlessAccountIcon(ctx context.Context,
 	profile := &skyfrost.UserProfile{
 		IconUrl: iconUrl,
 	}
-	if err := u.skyfrostClient.UpdateUserProfile(ctx, account.Credential, account.Password, profile); err != nil {
+	if err := u.skyfrostClient.UpdateUserProfile(ctx, credential, password, profile); err != nil {
 		return "", errors.Errorf("failed to update profile: %w", err)
 	}
 
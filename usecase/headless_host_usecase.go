@@ -8,6 +8,8 @@ import (
 	"github.com/go-errors/errors"
 
 	"github.com/hantabaru1014/baru-reso-headless-controller/adapter/converter"
+	"github.com/hantabaru1014/baru-reso-headless-controller/config"
+	"github.com/hantabaru1014/baru-reso-headless-controller/domain"
 	"github.com/hantabaru1014/baru-reso-headless-controller/domain/entity"
 	headlessv1 "github.com/hantabaru1014/baru-reso-headless-controller/pbgen/headless/v1"
 	"github.com/hantabaru1014/baru-reso-headless-controller/usecase/port"
@@ -19,15 +21,18 @@ type HeadlessHostUsecase struct {
 	huc    *SessionUsecase
 	hauc   *HeadlessAccountUsecase
 	permUC *PermissionUsecase
+
+	connectorCfg *config.HostConnectorConfig
 }
 
-func NewHeadlessHostUsecase(hhrepo port.HeadlessHostRepository, srepo port.SessionRepository, huc *SessionUsecase, hauc *HeadlessAccountUsecase, permUC *PermissionUsecase) *HeadlessHostUsecase {
+func NewHeadlessHostUsecase(hhrepo port.HeadlessHostRepository, srepo port.SessionRepository, huc *SessionUsecase, hauc *HeadlessAccountUsecase, permUC *PermissionUsecase, connectorCfg *config.HostConnectorConfig) *HeadlessHostUsecase {
 	return &HeadlessHostUsecase{
-		hhrepo: hhrepo,
-		srepo:  srepo,
-		huc:    huc,
-		hauc:   hauc,
-		permUC: permUC,
+		hhrepo:       hhrepo,
+		srepo:        srepo,
+		huc:          huc,
+		hauc:         hauc,
+		permUC:       permUC,
+		connectorCfg: connectorCfg,
 	}
 }
 
@@ -47,6 +52,14 @@ func (hhuc *HeadlessHostUsecase) HeadlessHostStart(ctx context.Context, params p
 		return "", errors.New("account group does not match host group")
 	}
 
+	if err := hhuc.ValidateContainerSettings(&params.ContainerSettings); err != nil {
+		return "", err
+	}
+
+	if err := ValidateAutoRestartMaxRetries(params.AutoRestartMaxRetries); err != nil {
+		return "", err
+	}
+
 	tag, err := hhuc.resolveTagToUse(ctx, &params.ContainerImageTag)
 	if err != nil {
 		return "", errors.Wrap(err, 0)
@@ -54,7 +67,9 @@ func (hhuc *HeadlessHostUsecase) HeadlessHostStart(ctx context.Context, params p
 
 	params.ContainerImageTag = tag
 
-	return hhuc.hhrepo.Start(ctx, port.HostConnectorType_DOCKER, params, userId)
+	// 新規ホストは HOST_CONNECTOR の connector で起動する. 既存ホストの再起動は
+	// 各ホストが起動時に記録した connector を使い続ける.
+	return hhuc.hhrepo.Start(ctx, port.HostConnectorType(hhuc.connectorCfg.Default), params, userId)
 }
 
 func (hhuc *HeadlessHostUsecase) HeadlessHostList(ctx context.Context) (entity.HeadlessHostList, error) {
@@ -109,6 +124,121 @@ func (hhuc *HeadlessHostUsecase) HeadlessHostDelete(ctx context.Context, id stri
 	return hhuc.hhrepo.Delete(ctx, id)
 }
 
+// HeadlessHostUpdateContainerSettings はホストのコンテナ設定を置き換える.
+// 稼働中のコンテナには反映されず、次回の起動/再起動から適用される.
+func (hhuc *HeadlessHostUsecase) HeadlessHostUpdateContainerSettings(ctx context.Context, id string, settings *entity.HostContainerSettings) error {
+	if err := hhuc.requireHostWrite(ctx, id); err != nil {
+		return err
+	}
+
+	if err := hhuc.ValidateContainerSettings(settings); err != nil {
+		return err
+	}
+
+	return hhuc.hhrepo.UpdateContainerSettings(ctx, id, settings)
+}
+
+// ValidateContainerSettings はコンテナ設定の値域と bind mount の許可パスを検証する.
+// 不正な場合は domain.ErrInvalidArgument を wrap したエラーを返す.
+func (hhuc *HeadlessHostUsecase) ValidateContainerSettings(settings *entity.HostContainerSettings) error {
+	return validateContainerSettings(settings, hhuc.connectorCfg.AllowedBindMountSources)
+}
+
+// HeadlessHostUpdateAutoRestartPolicy はクラッシュ時の自動再起動ポリシーを更新する.
+// 連続再起動回数と crash loop による停止もリセットされる.
+func (hhuc *HeadlessHostUsecase) HeadlessHostUpdateAutoRestartPolicy(ctx context.Context, id string, policy entity.HostAutoRestartPolicy, maxRetries int32) error {
+	if err := hhuc.requireHostWrite(ctx, id); err != nil {
+		return err
+	}
+
+	if err := ValidateAutoRestartMaxRetries(maxRetries); err != nil {
+		return err
+	}
+
+	return hhuc.hhrepo.UpdateAutoRestartPolicy(ctx, id, policy, maxRetries)
+}
+
+// HeadlessHostUpdateAutoUpdatePolicy は自動アップデートポリシーを更新する.
+func (hhuc *HeadlessHostUsecase) HeadlessHostUpdateAutoUpdatePolicy(ctx context.Context, id string, policy entity.HostAutoUpdatePolicy) error {
+	if err := hhuc.requireHostWrite(ctx, id); err != nil {
+		return err
+	}
+
+	return hhuc.hhrepo.UpdateAutoUpdatePolicy(ctx, id, policy)
+}
+
+// HeadlessHostUpdateHostSettings は update で指定された項目だけホスト設定を更新する.
+// 稼働中のホストには RPC で反映し、ホストが反映後に返す設定を保存する.
+// 停止中のホストは保存した設定が次回起動時に使われる.
+// universeID は保存だけ行う (稼働中のホストへの反映は未対応).
+func (hhuc *HeadlessHostUsecase) HeadlessHostUpdateHostSettings(ctx context.Context, id string, update *headlessv1.UpdateHostSettingsRequest, universeID *string) error {
+	if err := hhuc.requireHostWrite(ctx, id); err != nil {
+		return err
+	}
+
+	host, err := hhuc.hhrepo.Find(ctx, id, port.HeadlessHostFetchOptions{})
+	if err != nil {
+		return errors.Wrap(err, 0)
+	}
+
+	if host.Status == entity.HeadlessHostStatus_RUNNING {
+		conn, err := hhuc.hhrepo.GetRpcClient(ctx, id)
+		if err != nil {
+			return errors.Wrap(err, 0)
+		}
+
+		if _, err := conn.UpdateHostSettings(ctx, update); err != nil {
+			return errors.Wrap(err, 0)
+		}
+
+		updated, err := conn.GetStartupConfigToRestore(ctx, &headlessv1.GetStartupConfigToRestoreRequest{
+			IncludeStartWorlds: false,
+		})
+		if err != nil {
+			return errors.Wrap(err, 0)
+		}
+
+		settings := converter.HeadlessHostSettingsProtoToEntity(updated.GetStartupConfig())
+		if universeID != nil {
+			settings.UniverseID = universeID
+		}
+
+		return hhuc.hhrepo.UpdateHostSettings(ctx, id, settings)
+	}
+
+	settings := host.HostSettings
+	if update.TickRate != nil {
+		settings.TickRate = update.GetTickRate()
+	}
+
+	if update.MaxConcurrentAssetTransfers != nil {
+		settings.MaxConcurrentAssetTransfers = update.GetMaxConcurrentAssetTransfers()
+	}
+
+	if update.UsernameOverride != nil {
+		settings.UsernameOverride = update.UsernameOverride
+	}
+
+	if update.GetUpdateAutoSpawnItems() {
+		settings.AutoSpawnItems = update.GetAutoSpawnItems()
+	}
+
+	if universeID != nil {
+		settings.UniverseID = universeID
+	}
+
+	return hhuc.hhrepo.UpdateHostSettings(ctx, id, &settings)
+}
+
+// ValidateAutoRestartMaxRetries は自動再起動の上限回数を検証する. 0 はサーバー設定に従う.
+func ValidateAutoRestartMaxRetries(maxRetries int32) error {
+	if maxRetries < 0 {
+		return errors.Errorf("auto_restart_max_retries must not be negative: %w", domain.ErrInvalidArgument)
+	}
+
+	return nil
+}
+
 // HeadlessHostRestart restarts the headless host with the specified ID.
 // If newTag is "latestRelease", it will use the latest release tag.
 func (hhuc *HeadlessHostUsecase) HeadlessHostRestart(ctx context.Context, id string, newTag *string, withWorldRestart bool, timeoutSeconds int) error {
@@ -148,11 +278,15 @@ func (hhuc *HeadlessHostUsecase) HeadlessHostRestart(ctx context.Context, id str
 		return errors.Wrap(err, 0)
 	}
 
+	// Restart は memo / auto_update_policy も上書きするので現在の値を引き継ぐ.
+	// (クラッシュからの自動再起動でも同じ経路を通るため、ここで落とすと繰り返し消える)
 	startupConfig := port.HeadlessHostStartParams{
 		Name:              host.Name,
 		ContainerImageTag: tagToUse,
 		StartupConfig:     converter.HeadlessHostSettingsToStartupConfigProto(&host.HostSettings),
 		HeadlessAccount:   *account,
+		AutoUpdatePolicy:  host.AutoUpdatePolicy,
+		Memo:              host.Memo,
 	}
 
 	err = hhuc.hhrepo.Restart(ctx, host.ID, startupConfig, timeoutSeconds)
@@ -234,6 +368,7 @@ type HeadlessHostInstance struct {
 	LastLogAt  *int64 // UnixTime (秒), nil = データなし
 	LogCount   int64
 	IsCurrent  bool
+	Archived   bool
 }
 
 func (hhuc *HeadlessHostUsecase) HeadlessHostGetInstances(ctx context.Context, hostID string) ([]*HeadlessHostInstance, error) {
@@ -262,6 +397,7 @@ func (hhuc *HeadlessHostUsecase) HeadlessHostGetInstances(ctx context.Context, h
 			LastLogAt:  ts.LastLogAt,
 			LogCount:   ts.LogCount,
 			IsCurrent:  ts.InstanceID == host.InstanceId,
+			Archived:   ts.Archived,
 		})
 	}
 
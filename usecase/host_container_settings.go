@@ -0,0 +1,93 @@
+package usecase
+
+import (
+	"fmt"
+	"path"
+	"regexp"
+	"strings"
+
+	"github.com/go-errors/errors"
+	"github.com/hantabaru1014/baru-reso-headless-controller/domain"
+	"github.com/hantabaru1014/baru-reso-headless-controller/domain/entity"
+)
+
+// Docker が受け付けるメモリ上限の最小値.
+const minContainerMemoryBytes = 6 * 1024 * 1024
+
+var cpusetPattern = regexp.MustCompile(`^\d+(-\d+)?(,\d+(-\d+)?)*$`)
+
+func invalidContainerSettings(format string, args ...any) error {
+	return errors.Errorf("container settings: %s: %w", fmt.Sprintf(format, args...), domain.ErrInvalidArgument)
+}
+
+func validateContainerSettings(s *entity.HostContainerSettings, allowedBindMountSources []string) error {
+	if s.CPUs < 0 {
+		return invalidContainerSettings("cpus must be >= 0")
+	}
+
+	if s.MemoryBytes != 0 && s.MemoryBytes < minContainerMemoryBytes {
+		return invalidContainerSettings("memory must be at least %d bytes", minContainerMemoryBytes)
+	}
+
+	switch {
+	case s.MemorySwapBytes == 0 || s.MemorySwapBytes == -1:
+	case s.MemorySwapBytes < -1:
+		return invalidContainerSettings("memory swap must be -1 (unlimited) or a positive value")
+	case s.MemoryBytes == 0:
+		return invalidContainerSettings("memory swap requires a memory limit")
+	case s.MemorySwapBytes < s.MemoryBytes:
+		return invalidContainerSettings("memory swap must be greater than or equal to memory")
+	}
+
+	if s.CpusetCpus != "" && !cpusetPattern.MatchString(s.CpusetCpus) {
+		return invalidContainerSettings("invalid cpuset %q", s.CpusetCpus)
+	}
+
+	switch s.RestartPolicy {
+	case entity.HostRestartPolicy_UNSPECIFIED, entity.HostRestartPolicy_NO,
+		entity.HostRestartPolicy_ALWAYS, entity.HostRestartPolicy_UNLESS_STOPPED:
+		if s.RestartMaxRetries != 0 {
+			return invalidContainerSettings("restart max retries is only valid with the on-failure policy")
+		}
+	case entity.HostRestartPolicy_ON_FAILURE:
+		if s.RestartMaxRetries < 0 {
+			return invalidContainerSettings("restart max retries must be >= 0")
+		}
+	default:
+		return invalidContainerSettings("unknown restart policy %d", s.RestartPolicy)
+	}
+
+	targets := make(map[string]struct{}, len(s.BindMounts))
+
+	for _, m := range s.BindMounts {
+		if !path.IsAbs(m.Source) || !path.IsAbs(m.Target) {
+			return invalidContainerSettings("bind mount paths must be absolute: %s:%s", m.Source, m.Target)
+		}
+
+		target := path.Clean(m.Target)
+		if _, dup := targets[target]; dup {
+			return invalidContainerSettings("duplicate bind mount target %s", target)
+		}
+
+		targets[target] = struct{}{}
+
+		if !isAllowedBindMountSource(path.Clean(m.Source), allowedBindMountSources) {
+			return invalidContainerSettings("bind mount source %s is not allowed (HOST_BIND_MOUNT_ALLOWED_SOURCES)", m.Source)
+		}
+	}
+
+	return nil
+}
+
+// isAllowedBindMountSource は source が許可ディレクトリそのものかその配下かを返す.
+// source は path.Clean 済みであること ("/allowed/../etc" のような抜け道を塞ぐため).
+func isAllowedBindMountSource(source string, allowed []string) bool {
+	for _, dir := range allowed {
+		dir = path.Clean(dir)
+		if source == dir || strings.HasPrefix(source, strings.TrimSuffix(dir, "/")+"/") {
+			return true
+		}
+	}
+
+	return false
+}
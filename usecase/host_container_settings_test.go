@@ -0,0 +1,55 @@
+package usecase
+
+import (
+	"testing"
+
+	"github.com/hantabaru1014/baru-reso-headless-controller/domain"
+	"github.com/hantabaru1014/baru-reso-headless-controller/domain/entity"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidateContainerSettings(t *testing.T) {
+	t.Parallel()
+
+	allowed := []string{"/srv/brhc/cache"}
+
+	valid := []entity.HostContainerSettings{
+		{},
+		{CPUs: 1.5, MemoryBytes: 4 << 30, MemorySwapBytes: -1, CpusetCpus: "0-3,6"},
+		{MemoryBytes: 4 << 30, MemorySwapBytes: 8 << 30},
+		{RestartPolicy: entity.HostRestartPolicy_ON_FAILURE, RestartMaxRetries: 3},
+		{BindMounts: []entity.HostBindMount{
+			{Source: "/srv/brhc/cache", Target: "/Cache"},
+			{Source: "/srv/brhc/cache/assets", Target: "/Data/Assets", ReadOnly: true},
+		}},
+	}
+	for _, s := range valid {
+		assert.NoError(t, validateContainerSettings(&s, allowed), "%+v", s)
+	}
+
+	invalid := map[string]entity.HostContainerSettings{
+		"negative cpus":              {CPUs: -1},
+		"too little memory":          {MemoryBytes: 1024},
+		"swap without memory":        {MemorySwapBytes: 8 << 30},
+		"swap below memory":          {MemoryBytes: 4 << 30, MemorySwapBytes: 2 << 30},
+		"bad cpuset":                 {CpusetCpus: "0-"},
+		"retries without on-failure": {RestartPolicy: entity.HostRestartPolicy_ALWAYS, RestartMaxRetries: 1},
+		"unknown restart policy":     {RestartPolicy: 99},
+		"relative target":            {BindMounts: []entity.HostBindMount{{Source: "/srv/brhc/cache", Target: "Cache"}}},
+		"source outside allowlist":   {BindMounts: []entity.HostBindMount{{Source: "/etc", Target: "/Cache"}}},
+		"prefix is not a directory":  {BindMounts: []entity.HostBindMount{{Source: "/srv/brhc/cache-other", Target: "/Cache"}}},
+		"escapes via dot-dot":        {BindMounts: []entity.HostBindMount{{Source: "/srv/brhc/cache/../../../etc", Target: "/Cache"}}},
+		"duplicate target": {BindMounts: []entity.HostBindMount{
+			{Source: "/srv/brhc/cache", Target: "/Cache"},
+			{Source: "/srv/brhc/cache/x", Target: "/Cache/"},
+		}},
+	}
+	for name, s := range invalid {
+		err := validateContainerSettings(&s, allowed)
+		assert.ErrorIs(t, err, domain.ErrInvalidArgument, name)
+	}
+
+	assert.Error(t, validateContainerSettings(&entity.HostContainerSettings{
+		BindMounts: []entity.HostBindMount{{Source: "/srv/brhc/cache", Target: "/Cache"}},
+	}, nil), "bind mounts are disabled without an allowlist")
+}
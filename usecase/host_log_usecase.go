@@ -0,0 +1,382 @@
+package usecase
+
+import (
+	"context"
+	"regexp"
+	"slices"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/go-errors/errors"
+	"github.com/hantabaru1014/baru-reso-headless-controller/domain"
+	"github.com/hantabaru1014/baru-reso-headless-controller/domain/entity"
+	"github.com/hantabaru1014/baru-reso-headless-controller/usecase/port"
+)
+
+const (
+	defaultHostLogTailBacklog = 100
+	maxHostLogTailBacklog     = 1000
+	hostLogTailBacklogPage    = 1000
+	// hostLogTailBacklogScanLimit はフィルタ付きのバックログを集めるときに遡る行数の上限.
+	// 一致するログがまれでも、これ以上は DB を読まずに追従を始める.
+	hostLogTailBacklogScanLimit = 10000
+	maxHostLogPatternLength     = 256
+
+	defaultHostLogSearchLimit = 100
+	maxHostLogSearchLimit     = 500
+	// minHostLogSearchQueryLength はトライグラムインデックスが効く最短の長さ.
+	// これより短いと container_logs を全件なめることになるので受け付けない.
+	minHostLogSearchQueryLength = 3
+)
+
+// ErrHostLogTailLagged は追従中のログの受け取りが追いつかず、購読が打ち切られたことを示す.
+// 最後に受け取ったログの ID を after_id にして開き直せば欠けない.
+var ErrHostLogTailLagged = errors.New("host log tail fell behind")
+
+// HostLogUsecase はホストのログの追従と検索を提供する.
+// 権限要件: ホストのグループに対する host:read (または host:write).
+// 検索は複数グループにまたがるため、対象グループの解決は呼び出し側 (List 系 RPC と同じ
+// resolveListGroupFilter) で行う.
+type HostLogUsecase struct {
+	hhrepo port.HeadlessHostRepository
+	feed   port.HostLogFeed
+	permUC *PermissionUsecase
+}
+
+func NewHostLogUsecase(hhrepo port.HeadlessHostRepository, feed port.HostLogFeed, permUC *PermissionUsecase) *HostLogUsecase {
+	return &HostLogUsecase{hhrepo: hhrepo, feed: feed, permUC: permUC}
+}
+
+// HostLogFilter は送るログの条件. 空のフィールドは条件にしない.
+type HostLogFilter struct {
+	// Contains は本文に含まれる文字列 (大文字小文字を区別しない).
+	Contains string
+	// Pattern は本文に一致する正規表現 (RE2).
+	Pattern  string
+	MinLevel entity.HostLogLevel
+}
+
+type HostLogTailParams struct {
+	HostID string
+	// InstanceID は対象インスタンス. 0 なら現在のインスタンス.
+	InstanceID int32
+	// Backlog は最初に返す直近のログの件数. 0 ならデフォルト.
+	Backlog int32
+	// AfterID を指定するとバックログの代わりにこの ID より後のログから追従する (再接続用).
+	AfterID *int64
+	Filter  HostLogFilter
+}
+
+// HostLogTail は開いた追従. Backlog を送ったあとに Lines から新しいログを受け取る.
+type HostLogTail struct {
+	InstanceID int32
+	Backlog    port.LogLineList
+	// Lines は追記されたログ (フィルタ済み). ctx が終わるか打ち切られると閉じられる.
+	Lines <-chan port.LogLineList
+
+	err error
+}
+
+// Err は Lines が閉じられた理由を返す. 打ち切られた場合は ErrHostLogTailLagged.
+// Lines が閉じる前に呼んではいけない.
+func (t *HostLogTail) Err() error {
+	return t.err
+}
+
+// OpenTail はホストのログの追従を開く. 追従は ctx が終わるまで続く.
+func (u *HostLogUsecase) OpenTail(ctx context.Context, params HostLogTailParams) (*HostLogTail, error) {
+	if err := u.requireHostRead(ctx, params.HostID); err != nil {
+		return nil, err
+	}
+
+	matcher, err := newHostLogMatcher(params.Filter)
+	if err != nil {
+		return nil, err
+	}
+
+	backlogSize := params.Backlog
+	switch {
+	case backlogSize < 0:
+		return nil, errors.Errorf("backlog must be >= 0: %w", domain.ErrInvalidArgument)
+	case backlogSize == 0:
+		backlogSize = defaultHostLogTailBacklog
+	case backlogSize > maxHostLogTailBacklog:
+		backlogSize = maxHostLogTailBacklog
+	}
+
+	instanceID := params.InstanceID
+	if instanceID == 0 {
+		host, err := u.hhrepo.Find(ctx, params.HostID, port.HeadlessHostFetchOptions{})
+		if err != nil {
+			return nil, errors.Wrap(err, 0)
+		}
+
+		instanceID = host.InstanceId
+	}
+
+	tail := &HostLogTail{InstanceID: instanceID}
+
+	var followFrom int64
+
+	if params.AfterID != nil {
+		if *params.AfterID < 0 {
+			return nil, errors.Errorf("after_id must be >= 0: %w", domain.ErrInvalidArgument)
+		}
+
+		followFrom = *params.AfterID
+	} else {
+		tail.Backlog, followFrom, err = u.collectBacklog(ctx, params.HostID, instanceID, int(backlogSize), matcher)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	lines := make(chan port.LogLineList)
+	tail.Lines = lines
+	src := u.feed.Follow(ctx, params.HostID, instanceID, followFrom)
+
+	go func() {
+		defer close(lines)
+
+		for batch := range src {
+			filtered := matcher.filter(batch)
+			if len(filtered) == 0 {
+				continue
+			}
+
+			select {
+			case lines <- filtered:
+			case <-ctx.Done():
+				return
+			}
+		}
+
+		if ctx.Err() == nil {
+			tail.err = ErrHostLogTailLagged
+		}
+	}()
+
+	return tail, nil
+}
+
+// collectBacklog は条件に一致する直近のログを最大 size 件、時系列順に返す.
+// あわせて、読んだ中で最新のログの ID (追従の起点) を返す.
+func (u *HostLogUsecase) collectBacklog(ctx context.Context, hostID string, instanceID int32, size int, matcher *hostLogMatcher) (port.LogLineList, int64, error) {
+	var (
+		backlog  port.LogLineList
+		latestID int64
+		beforeID int64
+		scanned  int
+	)
+
+	for scanned < hostLogTailBacklogScanLimit {
+		page, err := u.hhrepo.GetLogs(ctx, port.GetLogsParams{
+			HostID:     hostID,
+			InstanceID: instanceID,
+			Limit:      hostLogTailBacklogPage,
+			BeforeID:   beforeID,
+		})
+		if err != nil {
+			return nil, 0, errors.Wrap(err, 0)
+		}
+
+		if len(page) == 0 {
+			break
+		}
+
+		if latestID == 0 {
+			latestID = page[len(page)-1].ID
+		}
+
+		// page は時系列順なので新しい方から拾う.
+		for _, line := range slices.Backward(page) {
+			if len(backlog) >= size {
+				break
+			}
+
+			if matcher.match(line) {
+				backlog = append(backlog, line)
+			}
+		}
+
+		scanned += len(page)
+		if len(backlog) >= size || len(page) < hostLogTailBacklogPage {
+			break
+		}
+
+		beforeID = page[0].ID
+	}
+
+	slices.Reverse(backlog)
+
+	return backlog, latestID, nil
+}
+
+type HostLogSearchParams struct {
+	Query string
+	// GroupIDs は nil なら全グループ、空 slice ならゼロ件.
+	GroupIDs []string
+	// HostIDs は空なら全ホスト.
+	HostIDs  []string
+	Since    *time.Time
+	Until    *time.Time
+	BeforeID int64
+	Limit    int32
+}
+
+// HostLogSearchGroup は 1 つのホストの 1 インスタンス分の一致.
+type HostLogSearchGroup struct {
+	HostID     string
+	HostName   string
+	InstanceID int32
+	// Logs は時系列順.
+	Logs port.LogLineList
+}
+
+type HostLogSearchResult struct {
+	// Groups は一致したログを新しい順に並べたときに先に現れるものから並ぶ.
+	Groups []*HostLogSearchGroup
+	// NextBeforeID は続きがあれば次のページの BeforeID. なければ 0.
+	NextBeforeID int64
+}
+
+// Search はホストのログを本文で検索し、ホスト・インスタンスごとにまとめて返す.
+func (u *HostLogUsecase) Search(ctx context.Context, params HostLogSearchParams) (*HostLogSearchResult, error) {
+	if utf8.RuneCountInString(params.Query) < minHostLogSearchQueryLength {
+		return nil, errors.Errorf("query must be at least %d characters: %w", minHostLogSearchQueryLength, domain.ErrInvalidArgument)
+	}
+
+	if params.Since != nil && params.Until != nil && !params.Since.Before(*params.Until) {
+		return nil, errors.Errorf("since must be before until: %w", domain.ErrInvalidArgument)
+	}
+
+	if params.BeforeID < 0 {
+		return nil, errors.Errorf("before_id must be >= 0: %w", domain.ErrInvalidArgument)
+	}
+
+	result := &HostLogSearchResult{Groups: []*HostLogSearchGroup{}}
+	if params.GroupIDs != nil && len(params.GroupIDs) == 0 {
+		return result, nil
+	}
+
+	limit := params.Limit
+	switch {
+	case limit <= 0:
+		limit = defaultHostLogSearchLimit
+	case limit > maxHostLogSearchLimit:
+		limit = maxHostLogSearchLimit
+	}
+
+	// limit+1 件取得して続きの有無を判定
+	hits, err := u.hhrepo.SearchLogs(ctx, port.SearchLogsParams{
+		Query:    params.Query,
+		GroupIDs: params.GroupIDs,
+		HostIDs:  params.HostIDs,
+		Since:    params.Since,
+		Until:    params.Until,
+		BeforeID: params.BeforeID,
+		Limit:    limit + 1,
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, 0)
+	}
+
+	if len(hits) > int(limit) {
+		hits = hits[:limit]
+		result.NextBeforeID = hits[len(hits)-1].Log.ID
+	}
+
+	type groupKey struct {
+		hostID     string
+		instanceID int32
+	}
+
+	groups := make(map[groupKey]*HostLogSearchGroup)
+
+	for _, hit := range hits {
+		key := groupKey{hostID: hit.HostID, instanceID: hit.InstanceID}
+
+		group, ok := groups[key]
+		if !ok {
+			group = &HostLogSearchGroup{HostID: hit.HostID, HostName: hit.HostName, InstanceID: hit.InstanceID}
+			groups[key] = group
+			result.Groups = append(result.Groups, group)
+		}
+
+		group.Logs = append(group.Logs, hit.Log)
+	}
+
+	// hits は新しい順なので、グループ内を時系列順に直す.
+	for _, group := range result.Groups {
+		slices.Reverse(group.Logs)
+	}
+
+	return result, nil
+}
+
+func (u *HostLogUsecase) requireHostRead(ctx context.Context, hostID string) error {
+	groupID, err := u.hhrepo.GetGroupID(ctx, hostID)
+	if err != nil {
+		return errors.Wrap(err, 0)
+	}
+
+	return u.permUC.RequireAnyPermissionForGroup(ctx, groupID,
+		[]string{entity.PermKey_HostRead, entity.PermKey_HostWrite})
+}
+
+type hostLogMatcher struct {
+	contains string
+	pattern  *regexp.Regexp
+	minLevel entity.HostLogLevel
+}
+
+func newHostLogMatcher(filter HostLogFilter) (*hostLogMatcher, error) {
+	m := &hostLogMatcher{
+		contains: strings.ToLower(filter.Contains),
+		minLevel: filter.MinLevel,
+	}
+
+	if filter.Pattern != "" {
+		if len(filter.Pattern) > maxHostLogPatternLength {
+			return nil, errors.Errorf("pattern must be at most %d bytes: %w", maxHostLogPatternLength, domain.ErrInvalidArgument)
+		}
+
+		re, err := regexp.Compile(filter.Pattern)
+		if err != nil {
+			return nil, errors.Errorf("invalid pattern: %v: %w", err, domain.ErrInvalidArgument)
+		}
+
+		m.pattern = re
+	}
+
+	return m, nil
+}
+
+func (m *hostLogMatcher) match(line *port.LogLine) bool {
+	if line.Level < m.minLevel {
+		return false
+	}
+
+	if m.contains != "" && !strings.Contains(strings.ToLower(line.Body), m.contains) {
+		return false
+	}
+
+	if m.pattern != nil && !m.pattern.MatchString(line.Body) {
+		return false
+	}
+
+	return true
+}
+
+func (m *hostLogMatcher) filter(lines port.LogLineList) port.LogLineList {
+	filtered := make(port.LogLineList, 0, len(lines))
+
+	for _, line := range lines {
+		if m.match(line) {
+			filtered = append(filtered, line)
+		}
+	}
+
+	return filtered
+}
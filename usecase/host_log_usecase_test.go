@@ -0,0 +1,293 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/hantabaru1014/baru-reso-headless-controller/domain"
+	"github.com/hantabaru1014/baru-reso-headless-controller/domain/entity"
+	"github.com/hantabaru1014/baru-reso-headless-controller/usecase/port"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// stubLogHostRepo serves GetLogs from an in-memory slice ordered by id, the
+// same way the container_logs query pages through it.
+type stubLogHostRepo struct {
+	stubHostRepo
+
+	instanceID int32
+	logs       port.LogLineList
+
+	// hits は SearchLogs が返す一致 (新しい順).
+	hits     []*port.LogSearchHit
+	searched *port.SearchLogsParams
+}
+
+func (s *stubLogHostRepo) Find(_ context.Context, id string, _ port.HeadlessHostFetchOptions) (*entity.HeadlessHost, error) {
+	return &entity.HeadlessHost{ID: id, InstanceId: s.instanceID}, nil
+}
+
+func (s *stubLogHostRepo) GetLogs(_ context.Context, params port.GetLogsParams) (port.LogLineList, error) {
+	var page port.LogLineList
+
+	for i := len(s.logs) - 1; i >= 0 && len(page) < int(params.Limit); i-- {
+		if params.BeforeID > 0 && s.logs[i].ID >= params.BeforeID {
+			continue
+		}
+
+		page = append(port.LogLineList{s.logs[i]}, page...)
+	}
+
+	return page, nil
+}
+
+func (s *stubLogHostRepo) SearchLogs(_ context.Context, params port.SearchLogsParams) ([]*port.LogSearchHit, error) {
+	s.searched = &params
+
+	return s.hits[:min(len(s.hits), int(params.Limit))], nil
+}
+
+func (s *stubLogHostRepo) ScanLogs(_ context.Context, _ string, _ int32, fn func(port.LogLineList) error) error {
+	for chunk := range slices.Chunk(s.logs, 2) {
+		if err := fn(chunk); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+type fakeLogFeed struct {
+	ch         chan port.LogLineList
+	instanceID int32
+	afterID    int64
+}
+
+func (f *fakeLogFeed) Follow(_ context.Context, _ string, instanceID int32, afterID int64) <-chan port.LogLineList {
+	f.instanceID = instanceID
+	f.afterID = afterID
+
+	return f.ch
+}
+
+func logLine(id int64, body string) *port.LogLine {
+	return &port.LogLine{ID: id, Body: body, Level: entity.ClassifyHostLogLevel(false, body)}
+}
+
+func newHostLogUsecaseUnderTest(repo *stubLogHostRepo) (*HostLogUsecase, *fakeLogFeed) {
+	feed := &fakeLogFeed{ch: make(chan port.LogLineList, 4)}
+	permUC := NewPermissionUsecase(nil, allowAllGroupMemberRepo{}, nil)
+
+	return NewHostLogUsecase(repo, feed, permUC), feed
+}
+
+func logIDs(lines port.LogLineList) []int64 {
+	ids := make([]int64, 0, len(lines))
+	for _, line := range lines {
+		ids = append(ids, line.ID)
+	}
+
+	return ids
+}
+
+func TestHostLogUsecase_OpenTail(t *testing.T) {
+	t.Parallel()
+
+	// 1500 行のうち 100 行ごとに ERROR を混ぜる.
+	var logs port.LogLineList
+	for id := int64(1); id <= 1500; id++ {
+		body := fmt.Sprintf("line %d", id)
+		if id%100 == 0 {
+			body = fmt.Sprintf("ERROR failed at line %d", id)
+		}
+
+		logs = append(logs, logLine(id, body))
+	}
+
+	t.Run("フィルタなし: 直近のログをバックログとして返し、最新のログの後から追従する", func(t *testing.T) {
+		t.Parallel()
+
+		uc, feed := newHostLogUsecaseUnderTest(&stubLogHostRepo{instanceID: 3, logs: logs})
+
+		tail, err := uc.OpenTail(actAsTestUser(t.Context()), HostLogTailParams{HostID: "host-1", Backlog: 3})
+		require.NoError(t, err)
+
+		assert.Equal(t, int32(3), tail.InstanceID)
+		assert.Equal(t, []int64{1498, 1499, 1500}, logIDs(tail.Backlog))
+		assert.Equal(t, int32(3), feed.instanceID)
+		assert.Equal(t, int64(1500), feed.afterID)
+	})
+
+	t.Run("フィルタあり: 1 ページを越えて遡って一致するログを集める", func(t *testing.T) {
+		t.Parallel()
+
+		uc, feed := newHostLogUsecaseUnderTest(&stubLogHostRepo{logs: logs})
+
+		tail, err := uc.OpenTail(actAsTestUser(t.Context()), HostLogTailParams{
+			HostID:     "host-1",
+			InstanceID: 1,
+			Backlog:    8,
+			Filter:     HostLogFilter{MinLevel: entity.HostLogLevel_Error},
+		})
+		require.NoError(t, err)
+
+		assert.Equal(t, []int64{800, 900, 1000, 1100, 1200, 1300, 1400, 1500}, logIDs(tail.Backlog))
+		assert.Equal(t, int64(1500), feed.afterID, "追従は一致しなかったログも含めた最新の後から")
+	})
+
+	t.Run("after_id 指定: バックログを返さずその ID の後から追従する", func(t *testing.T) {
+		t.Parallel()
+
+		uc, feed := newHostLogUsecaseUnderTest(&stubLogHostRepo{logs: logs})
+		afterID := int64(1234)
+
+		tail, err := uc.OpenTail(actAsTestUser(t.Context()), HostLogTailParams{HostID: "host-1", InstanceID: 1, AfterID: &afterID})
+		require.NoError(t, err)
+
+		assert.Empty(t, tail.Backlog)
+		assert.Equal(t, afterID, feed.afterID)
+	})
+
+	t.Run("追従したログにもフィルタを適用する", func(t *testing.T) {
+		t.Parallel()
+
+		uc, feed := newHostLogUsecaseUnderTest(&stubLogHostRepo{})
+
+		tail, err := uc.OpenTail(actAsTestUser(t.Context()), HostLogTailParams{
+			HostID:     "host-1",
+			InstanceID: 1,
+			Filter:     HostLogFilter{Contains: "world", Pattern: `^saved \d+`},
+		})
+		require.NoError(t, err)
+
+		feed.ch <- port.LogLineList{
+			logLine(1, "saved 1 World"),
+			logLine(2, "loading world"),
+			logLine(3, "saved the world"),
+		}
+		feed.ch <- port.LogLineList{logLine(4, "unrelated")}
+		feed.ch <- port.LogLineList{logLine(5, "SAVED 5 world"), logLine(6, "saved 6 world")}
+
+		assert.Equal(t, []int64{1}, logIDs(receiveLines(t, tail)))
+		assert.Equal(t, []int64{6}, logIDs(receiveLines(t, tail)))
+	})
+
+	t.Run("購読が打ち切られたら ErrHostLogTailLagged で閉じる", func(t *testing.T) {
+		t.Parallel()
+
+		uc, feed := newHostLogUsecaseUnderTest(&stubLogHostRepo{})
+
+		tail, err := uc.OpenTail(actAsTestUser(t.Context()), HostLogTailParams{HostID: "host-1", InstanceID: 1})
+		require.NoError(t, err)
+
+		close(feed.ch)
+
+		select {
+		case _, ok := <-tail.Lines:
+			require.False(t, ok)
+		case <-time.After(time.Second):
+			t.Fatal("tail was not closed")
+		}
+
+		assert.ErrorIs(t, tail.Err(), ErrHostLogTailLagged)
+	})
+
+	t.Run("不正な正規表現は InvalidArgument", func(t *testing.T) {
+		t.Parallel()
+
+		uc, _ := newHostLogUsecaseUnderTest(&stubLogHostRepo{})
+
+		_, err := uc.OpenTail(actAsTestUser(t.Context()), HostLogTailParams{
+			HostID:     "host-1",
+			InstanceID: 1,
+			Filter:     HostLogFilter{Pattern: `(unclosed`},
+		})
+		assert.ErrorIs(t, err, domain.ErrInvalidArgument)
+	})
+}
+
+func receiveLines(t *testing.T, tail *HostLogTail) port.LogLineList {
+	t.Helper()
+
+	select {
+	case lines, ok := <-tail.Lines:
+		require.True(t, ok, "tail closed unexpectedly")
+
+		return lines
+	case <-time.After(time.Second):
+		t.Fatal("no lines received")
+
+		return nil
+	}
+}
+
+func TestHostLogUsecase_Search(t *testing.T) {
+	t.Parallel()
+
+	hit := func(hostID string, instanceID int32, id int64) *port.LogSearchHit {
+		return &port.LogSearchHit{HostID: hostID, HostName: "name-" + hostID, InstanceID: instanceID, Log: logLine(id, "match")}
+	}
+
+	t.Run("ホスト・インスタンスごとにまとめ、続きがあればカーソルを返す", func(t *testing.T) {
+		t.Parallel()
+
+		repo := &stubLogHostRepo{hits: []*port.LogSearchHit{
+			hit("host-a", 1, 50),
+			hit("host-b", 1, 40),
+			hit("host-a", 1, 30),
+			hit("host-a", 2, 20),
+			hit("host-b", 1, 10),
+		}}
+		uc, _ := newHostLogUsecaseUnderTest(repo)
+
+		result, err := uc.Search(t.Context(), HostLogSearchParams{Query: "match", Limit: 4})
+		require.NoError(t, err)
+
+		assert.Equal(t, int32(5), repo.searched.Limit, "続きの有無を見るため 1 件多く取る")
+		assert.Equal(t, int64(20), result.NextBeforeID)
+
+		require.Len(t, result.Groups, 3)
+		assert.Equal(t, "host-a", result.Groups[0].HostID)
+		assert.Equal(t, "name-host-a", result.Groups[0].HostName)
+		assert.Equal(t, []int64{30, 50}, logIDs(result.Groups[0].Logs))
+		assert.Equal(t, "host-b", result.Groups[1].HostID)
+		assert.Equal(t, []int64{40}, logIDs(result.Groups[1].Logs))
+		assert.Equal(t, int32(2), result.Groups[2].InstanceID)
+		assert.Equal(t, []int64{20}, logIDs(result.Groups[2].Logs))
+	})
+
+	t.Run("閲覧できるグループがなければ検索しない", func(t *testing.T) {
+		t.Parallel()
+
+		repo := &stubLogHostRepo{hits: []*port.LogSearchHit{hit("host-a", 1, 1)}}
+		uc, _ := newHostLogUsecaseUnderTest(repo)
+
+		result, err := uc.Search(t.Context(), HostLogSearchParams{Query: "match", GroupIDs: []string{}})
+		require.NoError(t, err)
+
+		assert.Empty(t, result.Groups)
+		assert.Nil(t, repo.searched)
+	})
+
+	t.Run("不正な条件は InvalidArgument", func(t *testing.T) {
+		t.Parallel()
+
+		uc, _ := newHostLogUsecaseUnderTest(&stubLogHostRepo{})
+		since := time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC)
+		until := since.Add(-time.Hour)
+
+		cases := map[string]HostLogSearchParams{
+			"短すぎる検索語":          {Query: "ab"},
+			"since が until 以降": {Query: "match", Since: &since, Until: &until},
+		}
+
+		for name, params := range cases {
+			_, err := uc.Search(t.Context(), params)
+			assert.ErrorIs(t, err, domain.ErrInvalidArgument, name)
+		}
+	})
+}
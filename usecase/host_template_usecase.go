@@ -0,0 +1,132 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/dchest/uniuri"
+	"github.com/go-errors/errors"
+	"github.com/hantabaru1014/baru-reso-headless-controller/domain"
+	"github.com/hantabaru1014/baru-reso-headless-controller/domain/entity"
+	"github.com/hantabaru1014/baru-reso-headless-controller/usecase/port"
+)
+
+// HostTemplateUsecase はグループのホストテンプレート (起動設定) を管理する.
+// 権限要件: 一覧 / 取得 / 起動時の利用は対象グループの host:read,
+// 作成 / 更新 / 削除は host:write.
+type HostTemplateUsecase struct {
+	repo   port.HostTemplateRepository
+	permUC *PermissionUsecase
+	hhuc   *HeadlessHostUsecase
+}
+
+func NewHostTemplateUsecase(repo port.HostTemplateRepository, permUC *PermissionUsecase, hhuc *HeadlessHostUsecase) *HostTemplateUsecase {
+	return &HostTemplateUsecase{repo: repo, permUC: permUC, hhuc: hhuc}
+}
+
+func (u *HostTemplateUsecase) ListTemplates(ctx context.Context, groupID string) (entity.HostTemplateList, error) {
+	if err := u.permUC.RequirePermissionForGroup(ctx, groupID, entity.PermKey_HostRead); err != nil {
+		return nil, err
+	}
+
+	return u.repo.ListByGroup(ctx, groupID)
+}
+
+func (u *HostTemplateUsecase) GetTemplate(ctx context.Context, id string) (*entity.HostTemplate, error) {
+	return u.getWithPermission(ctx, id, entity.PermKey_HostRead)
+}
+
+// CreateTemplate は tmpl の ID / CreatedBy を埋めて保存する. GroupID / Name は必須.
+func (u *HostTemplateUsecase) CreateTemplate(ctx context.Context, tmpl *entity.HostTemplate) error {
+	if err := u.permUC.RequirePermissionForGroup(ctx, tmpl.GroupID, entity.PermKey_HostWrite); err != nil {
+		return err
+	}
+
+	if tmpl.Name == "" {
+		return errors.Errorf("host template name is required: %w", domain.ErrInvalidArgument)
+	}
+
+	if err := u.validateSettings(&tmpl.ContainerSettings, tmpl.AutoRestartMaxRetries); err != nil {
+		return err
+	}
+
+	userID, err := CurrentUserID(ctx)
+	if err != nil {
+		return err
+	}
+
+	tmpl.ID = uniuri.New()
+	tmpl.CreatedBy = &userID
+
+	return u.repo.Create(ctx, tmpl)
+}
+
+// UpdateTemplate は params の nil でないフィールドだけを更新する.
+func (u *HostTemplateUsecase) UpdateTemplate(ctx context.Context, id string, params port.HostTemplateUpdateParams) (*entity.HostTemplate, error) {
+	if _, err := u.getWithPermission(ctx, id, entity.PermKey_HostWrite); err != nil {
+		return nil, err
+	}
+
+	if params.Name != nil && *params.Name == "" {
+		return nil, errors.Errorf("host template name is required: %w", domain.ErrInvalidArgument)
+	}
+
+	var maxRetries int32
+	if params.AutoRestartMaxRetries != nil {
+		maxRetries = *params.AutoRestartMaxRetries
+	}
+
+	if err := u.validateSettings(params.ContainerSettings, maxRetries); err != nil {
+		return nil, err
+	}
+
+	return u.repo.Update(ctx, id, params)
+}
+
+func (u *HostTemplateUsecase) DeleteTemplate(ctx context.Context, id string) error {
+	if _, err := u.getWithPermission(ctx, id, entity.PermKey_HostWrite); err != nil {
+		return err
+	}
+
+	return u.repo.Delete(ctx, id)
+}
+
+// ResolveForStart はホスト起動に使うテンプレートを返す.
+// groupID は起動するホストのグループで、テンプレートのグループと一致している必要がある.
+func (u *HostTemplateUsecase) ResolveForStart(ctx context.Context, templateID, groupID string) (*entity.HostTemplate, error) {
+	tmpl, err := u.getWithPermission(ctx, templateID, entity.PermKey_HostRead)
+	if err != nil {
+		return nil, err
+	}
+
+	if tmpl.GroupID != groupID {
+		return nil, errors.Errorf("host template belongs to another group: %w", domain.ErrInvalidArgument)
+	}
+
+	return tmpl, nil
+}
+
+// getWithPermission はテンプレートを取得し、そのグループに対する permKey を最終ガードする.
+func (u *HostTemplateUsecase) getWithPermission(ctx context.Context, id, permKey string) (*entity.HostTemplate, error) {
+	tmpl, err := u.repo.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := u.permUC.RequirePermissionForGroup(ctx, tmpl.GroupID, permKey); err != nil {
+		return nil, err
+	}
+
+	return tmpl, nil
+}
+
+// validateSettings はホスト起動時と同じ基準でコンテナ設定と再起動上限を検証する.
+// settings が nil なら再起動上限のみ検証する.
+func (u *HostTemplateUsecase) validateSettings(settings *entity.HostContainerSettings, maxRetries int32) error {
+	if settings != nil {
+		if err := u.hhuc.ValidateContainerSettings(settings); err != nil {
+			return err
+		}
+	}
+
+	return ValidateAutoRestartMaxRetries(maxRetries)
+}
@@ -0,0 +1,131 @@
+package usecase
+
+import (
+	"context"
+	"time"
+
+	"github.com/go-errors/errors"
+	"github.com/hantabaru1014/baru-reso-headless-controller/config"
+	"github.com/hantabaru1014/baru-reso-headless-controller/domain"
+	"github.com/hantabaru1014/baru-reso-headless-controller/domain/entity"
+	"github.com/hantabaru1014/baru-reso-headless-controller/usecase/port"
+)
+
+// maxMetricsSeriesPoints は 1 回に返すバケット数の上限.
+// 解像度を自動で選ぶときは、これに収まる一番細かい解像度にする.
+const maxMetricsSeriesPoints = 2000
+
+// MetricsUsecase は worker.MetricsSampler が記録したメトリクスの時系列を返す.
+// 権限要件: ホストのメトリクスはホストのグループの host:read, セッションのメトリクスは
+// セッションのグループの session:read (呼び出し側で確認する).
+type MetricsUsecase struct {
+	repo           port.MetricSampleRepository
+	sampleInterval time.Duration
+	retentions     map[entity.MetricResolution]time.Duration
+	now            func() time.Time
+}
+
+func NewMetricsUsecase(repo port.MetricSampleRepository, cfg *config.WorkerConfig) *MetricsUsecase {
+	return &MetricsUsecase{
+		repo:           repo,
+		sampleInterval: cfg.MetricsSampleInterval,
+		retentions: map[entity.MetricResolution]time.Duration{
+			entity.MetricResolution_Raw:    cfg.MetricsRawRetention,
+			entity.MetricResolution_Minute: cfg.MetricsMinuteRetention,
+			entity.MetricResolution_Hour:   cfg.MetricsHourRetention,
+		},
+		now: time.Now,
+	}
+}
+
+type MetricsSeriesParams struct {
+	Kind     entity.MetricKind
+	TargetID string
+	Since    time.Time
+	Until    time.Time
+	// Resolution が nil なら範囲に合わせて選ぶ.
+	Resolution *entity.MetricResolution
+}
+
+type MetricsSeries struct {
+	Resolution entity.MetricResolution
+	Samples    entity.MetricSampleList
+}
+
+// GetSeries は 1 系列の [Since, Until) を返す. 記録がない時間帯のバケットは含まない.
+func (u *MetricsUsecase) GetSeries(ctx context.Context, params MetricsSeriesParams) (*MetricsSeries, error) {
+	switch params.Kind {
+	case entity.MetricKind_HostFps, entity.MetricKind_HostRunning, entity.MetricKind_HostUsers, entity.MetricKind_SessionUsers:
+	default:
+		return nil, errors.Errorf("unknown metric kind: %w", domain.ErrInvalidArgument)
+	}
+
+	if params.TargetID == "" {
+		return nil, errors.Errorf("target id is required: %w", domain.ErrInvalidArgument)
+	}
+
+	if params.Since.IsZero() || params.Until.IsZero() || !params.Since.Before(params.Until) {
+		return nil, errors.Errorf("since must be before until: %w", domain.ErrInvalidArgument)
+	}
+
+	var resolution entity.MetricResolution
+
+	if params.Resolution != nil {
+		resolution = *params.Resolution
+		if resolution != entity.MetricResolution_Raw && resolution != entity.MetricResolution_Minute && resolution != entity.MetricResolution_Hour {
+			return nil, errors.Errorf("unknown resolution: %w", domain.ErrInvalidArgument)
+		}
+
+		if u.estimatePoints(resolution, params.Until.Sub(params.Since)) > maxMetricsSeriesPoints {
+			return nil, errors.Errorf("range is too long for the resolution: %w", domain.ErrInvalidArgument)
+		}
+	} else {
+		resolution = u.pickResolution(params.Since, params.Until)
+	}
+
+	samples, err := u.repo.List(ctx, params.Kind, params.TargetID, resolution, params.Since, params.Until)
+	if err != nil {
+		return nil, errors.Wrap(err, 0)
+	}
+
+	return &MetricsSeries{Resolution: resolution, Samples: samples}, nil
+}
+
+// pickResolution は点数が上限に収まり、since の時点のデータがまだ残っている
+// 一番細かい解像度を返す. どれも当てはまらなければ 1h.
+func (u *MetricsUsecase) pickResolution(since, until time.Time) entity.MetricResolution {
+	oldest := u.now()
+
+	for _, r := range []entity.MetricResolution{entity.MetricResolution_Raw, entity.MetricResolution_Minute} {
+		if u.estimatePoints(r, until.Sub(since)) > maxMetricsSeriesPoints {
+			continue
+		}
+
+		if retention := u.retentions[r]; retention > 0 && since.Before(oldest.Add(-retention)) {
+			continue
+		}
+
+		return r
+	}
+
+	return entity.MetricResolution_Hour
+}
+
+func (u *MetricsUsecase) estimatePoints(r entity.MetricResolution, span time.Duration) int64 {
+	var width time.Duration
+
+	switch r {
+	case entity.MetricResolution_Raw:
+		width = u.sampleInterval
+	case entity.MetricResolution_Minute:
+		width = time.Minute
+	case entity.MetricResolution_Hour:
+		width = time.Hour
+	}
+
+	if width <= 0 {
+		return 0
+	}
+
+	return int64(span / width)
+}
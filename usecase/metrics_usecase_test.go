@@ -0,0 +1,116 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/hantabaru1014/baru-reso-headless-controller/config"
+	"github.com/hantabaru1014/baru-reso-headless-controller/domain"
+	"github.com/hantabaru1014/baru-reso-headless-controller/domain/entity"
+	"github.com/hantabaru1014/baru-reso-headless-controller/usecase/port"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// stubMetricSampleRepo は List に渡された解像度を覚えておく.
+type stubMetricSampleRepo struct {
+	port.MetricSampleRepository
+
+	samples    entity.MetricSampleList
+	resolution *entity.MetricResolution
+}
+
+func (s *stubMetricSampleRepo) List(_ context.Context, _ entity.MetricKind, _ string, resolution entity.MetricResolution, _, _ time.Time) (entity.MetricSampleList, error) {
+	s.resolution = &resolution
+
+	return s.samples, nil
+}
+
+func TestMetricsUsecase_GetSeries(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2026, 7, 14, 12, 0, 0, 0, time.UTC)
+
+	newUsecase := func() (*MetricsUsecase, *stubMetricSampleRepo) {
+		repo := &stubMetricSampleRepo{}
+		u := NewMetricsUsecase(repo, &config.WorkerConfig{
+			MetricsSampleInterval:  15 * time.Second,
+			MetricsRawRetention:    24 * time.Hour,
+			MetricsMinuteRetention: 14 * 24 * time.Hour,
+		})
+		u.now = func() time.Time { return now }
+
+		return u, repo
+	}
+
+	t.Run("範囲に合わせて解像度を選ぶ", func(t *testing.T) {
+		t.Parallel()
+
+		cases := []struct {
+			name  string
+			since time.Time
+			until time.Time
+			want  entity.MetricResolution
+		}{
+			{"直近 1 時間は raw", now.Add(-time.Hour), now, entity.MetricResolution_Raw},
+			{"1 日は raw だと多すぎるので 1m", now.Add(-24 * time.Hour), now, entity.MetricResolution_Minute},
+			{"raw が消えている期間は 1m", now.Add(-3 * 24 * time.Hour), now.Add(-3*24*time.Hour + time.Hour), entity.MetricResolution_Minute},
+			{"1 週間は 1h", now.Add(-7 * 24 * time.Hour), now, entity.MetricResolution_Hour},
+			{"1m が消えている期間は 1h", now.Add(-30 * 24 * time.Hour), now.Add(-30*24*time.Hour + time.Hour), entity.MetricResolution_Hour},
+		}
+
+		for _, tc := range cases {
+			u, repo := newUsecase()
+
+			series, err := u.GetSeries(t.Context(), MetricsSeriesParams{
+				Kind:     entity.MetricKind_HostFps,
+				TargetID: "h1",
+				Since:    tc.since,
+				Until:    tc.until,
+			})
+			require.NoError(t, err, tc.name)
+			assert.Equal(t, tc.want, series.Resolution, tc.name)
+			require.NotNil(t, repo.resolution, tc.name)
+			assert.Equal(t, tc.want, *repo.resolution, tc.name)
+		}
+	})
+
+	t.Run("指定された解像度を使う", func(t *testing.T) {
+		t.Parallel()
+
+		u, repo := newUsecase()
+		repo.samples = entity.MetricSampleList{{Kind: entity.MetricKind_SessionUsers, TargetID: "s1", BucketAt: now.Add(-time.Hour), Avg: 3, Min: 1, Max: 5, Count: 60}}
+		hour := entity.MetricResolution_Hour
+
+		series, err := u.GetSeries(t.Context(), MetricsSeriesParams{
+			Kind:       entity.MetricKind_SessionUsers,
+			TargetID:   "s1",
+			Since:      now.Add(-2 * time.Hour),
+			Until:      now,
+			Resolution: &hour,
+		})
+		require.NoError(t, err)
+		assert.Equal(t, entity.MetricResolution_Hour, series.Resolution)
+		assert.Equal(t, repo.samples, series.Samples)
+	})
+
+	t.Run("不正な指定は弾く", func(t *testing.T) {
+		t.Parallel()
+
+		u, _ := newUsecase()
+		raw := entity.MetricResolution_Raw
+		unknown := entity.MetricResolution(9)
+
+		for _, params := range []MetricsSeriesParams{
+			{Kind: entity.MetricKind_Unknown, TargetID: "h1", Since: now.Add(-time.Hour), Until: now},
+			{Kind: entity.MetricKind_HostFps, Since: now.Add(-time.Hour), Until: now},
+			{Kind: entity.MetricKind_HostFps, TargetID: "h1", Since: now, Until: now.Add(-time.Hour)},
+			{Kind: entity.MetricKind_HostFps, TargetID: "h1", Since: now.Add(-7 * 24 * time.Hour), Until: now, Resolution: &raw},
+			{Kind: entity.MetricKind_HostFps, TargetID: "h1", Since: now.Add(-time.Hour), Until: now, Resolution: &unknown},
+		} {
+			_, err := u.GetSeries(t.Context(), params)
+			require.ErrorIs(t, err, domain.ErrInvalidArgument)
+		}
+	})
+}
@@ -2,10 +2,12 @@
 // NotificationEvent to subscribed frontend clients via the
 // NotificationService server-streaming RPC.
 //
-// The bus is intentionally process-local: there is no persistence and no
-// fan-out across controller instances. If multi-instance deployment is
-// added later, swap the implementation behind the Bus interface (e.g.
-// Redis pub/sub) without touching publishers or the RPC handler.
+// MemoryBus is the process-local fan-out. PersistentBus wraps a Bus to keep
+// a bounded history of published events, which lets reconnecting clients
+// replay what they missed and backs the notification inbox. With several
+// controller instances the wrapped Bus is the Postgres LISTEN/NOTIFY one
+// in adapter/cluster, which relays events between the instances' own
+// MemoryBus; publishers and the RPC handler do not see the difference.
 package notification
 
 import (
@@ -13,6 +15,7 @@ import (
 	"log/slog"
 	"sync"
 
+	"github.com/hantabaru1014/baru-reso-headless-controller/lib/metrics"
 	hdlctrlv1 "github.com/hantabaru1014/baru-reso-headless-controller/pbgen/hdlctrl/v1"
 )
 
@@ -40,6 +43,11 @@ type Bus interface {
 	// userID は PublishTo 経路の宛先キーとしても使う (空文字 = anonymous は
 	// PublishTo の対象にならない).
 	Subscribe(ctx context.Context, userID string) (<-chan *hdlctrlv1.NotificationEvent, func())
+
+	// SubscribeAll は PublishTo 宛ての event も含めて全 event を受け取る
+	// subscriber を登録する. Webhook 送信などサーバ内部の転送用で、
+	// フロントエンドへの stream には使わない. cleanup の扱いは Subscribe と同じ.
+	SubscribeAll(ctx context.Context) (<-chan *hdlctrlv1.NotificationEvent, func())
 }
 
 // subscriberBufferSize は 1 subscriber あたりの未読バッファ容量.
@@ -47,6 +55,10 @@ type Bus interface {
 // 検知できる程度の値.
 const subscriberBufferSize = 64
 
+// allSubscriberBufferSize は SubscribeAll の未読バッファ容量. 受け手が DB 書き込みを
+// 挟むので, フロント向けより大きめに取る.
+const allSubscriberBufferSize = 1024
+
 // MemoryBus は process-local の Bus 実装.
 type MemoryBus struct {
 	mu     sync.RWMutex
@@ -56,7 +68,9 @@ type MemoryBus struct {
 
 type subscriber struct {
 	userID string
-	ch     chan *hdlctrlv1.NotificationEvent
+	// all は SubscribeAll で登録された subscriber. PublishTo の宛先に関係なく受け取る.
+	all bool
+	ch  chan *hdlctrlv1.NotificationEvent
 }
 
 // NewBus は新しい MemoryBus を返す.
@@ -94,7 +108,7 @@ func (b *MemoryBus) PublishTo(userID string, ev *hdlctrlv1.NotificationEvent) {
 	defer b.mu.RUnlock()
 
 	for id, sub := range b.subs {
-		if sub.userID != userID {
+		if sub.userID != userID && !sub.all {
 			continue
 		}
 
@@ -106,8 +120,22 @@ func (b *MemoryBus) PublishTo(userID string, ev *hdlctrlv1.NotificationEvent) {
 // the subscriber from the bus and closes the event channel. Calling it
 // more than once panics on the channel close — defer it exactly once.
 func (b *MemoryBus) Subscribe(_ context.Context, userID string) (<-chan *hdlctrlv1.NotificationEvent, func()) {
-	ch := make(chan *hdlctrlv1.NotificationEvent, subscriberBufferSize)
-	sub := &subscriber{userID: userID, ch: ch}
+	return b.subscribe(&subscriber{
+		userID: userID,
+		ch:     make(chan *hdlctrlv1.NotificationEvent, subscriberBufferSize),
+	})
+}
+
+// SubscribeAll registers a subscriber that also receives PublishTo events.
+func (b *MemoryBus) SubscribeAll(_ context.Context) (<-chan *hdlctrlv1.NotificationEvent, func()) {
+	return b.subscribe(&subscriber{
+		all: true,
+		ch:  make(chan *hdlctrlv1.NotificationEvent, allSubscriberBufferSize),
+	})
+}
+
+func (b *MemoryBus) subscribe(sub *subscriber) (<-chan *hdlctrlv1.NotificationEvent, func()) {
+	ch := sub.ch
 
 	b.mu.Lock()
 	b.nextID++
@@ -139,6 +167,7 @@ func busSend(id uint64, sub *subscriber, ev *hdlctrlv1.NotificationEvent) {
 
 		sub.ch <- ev
 
+		metrics.NotificationDroppedEvents.Inc()
 		slog.Warn("notification bus: dropped oldest event for slow subscriber",
 			"subscriberID", id, "userID", sub.userID)
 	}
@@ -55,6 +55,31 @@ func TestMemoryBus_MultipleSubscribersReceiveSameEvent(t *testing.T) {
 	}
 }
 
+func TestMemoryBus_SubscribeAllReceivesPublishTo(t *testing.T) {
+	bus := NewBus()
+
+	all, cancelAll := bus.SubscribeAll(context.Background())
+	defer cancelAll()
+
+	other, cancelOther := bus.Subscribe(context.Background(), "u2")
+	defer cancelOther()
+
+	bus.PublishTo("u1", &hdlctrlv1.NotificationEvent{Id: "to-u1"})
+
+	select {
+	case got := <-all:
+		assert.Equal(t, "to-u1", got.GetId())
+	case <-time.After(time.Second):
+		t.Fatal("SubscribeAll subscriber did not receive PublishTo event")
+	}
+
+	select {
+	case <-other:
+		t.Fatal("PublishTo must not reach other users")
+	case <-time.After(50 * time.Millisecond):
+	}
+}
+
 func TestMemoryBus_CleanupRemovesSubscriber(t *testing.T) {
 	bus := NewBus()
 	ch, cancel := bus.Subscribe(context.Background(), "u1")
@@ -37,6 +37,21 @@ func HostListChanged() *hdlctrlv1.NotificationEvent {
 	}
 }
 
+func HostAutoRestart(hostID string, kind hdlctrlv1.HostAutoRestartEvent_Kind, attempt int32, message string, level hdlctrlv1.JobCompletedEvent_Level) *hdlctrlv1.NotificationEvent {
+	return &hdlctrlv1.NotificationEvent{
+		OccurredAt: timestamppb.Now(),
+		Payload: &hdlctrlv1.NotificationEvent_HostAutoRestart{
+			HostAutoRestart: &hdlctrlv1.HostAutoRestartEvent{
+				HostId:  hostID,
+				Kind:    kind,
+				Attempt: attempt,
+				Level:   level,
+				Message: message,
+			},
+		},
+	}
+}
+
 func SessionUpdated(sessionID, hostID, id string, occurredAt *timestamppb.Timestamp) *hdlctrlv1.NotificationEvent {
 	return &hdlctrlv1.NotificationEvent{
 		Id:         id,
@@ -109,3 +124,12 @@ func KeepAlive() *hdlctrlv1.NotificationEvent {
 		Payload:    &hdlctrlv1.NotificationEvent_KeepAlive{KeepAlive: &hdlctrlv1.KeepAlive{}},
 	}
 }
+
+// HistoryTruncated は再接続時に取りこぼしを全ては再送できなかったことを伝える.
+// 履歴には残さない.
+func HistoryTruncated() *hdlctrlv1.NotificationEvent {
+	return &hdlctrlv1.NotificationEvent{
+		OccurredAt: timestamppb.Now(),
+		Payload:    &hdlctrlv1.NotificationEvent_HistoryTruncated{HistoryTruncated: &hdlctrlv1.HistoryTruncatedEvent{}},
+	}
+}
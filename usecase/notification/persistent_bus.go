@@ -0,0 +1,87 @@
+package notification
+
+import (
+	"context"
+	"log/slog"
+	"strconv"
+	"time"
+
+	"github.com/hantabaru1014/baru-reso-headless-controller/domain/entity"
+	hdlctrlv1 "github.com/hantabaru1014/baru-reso-headless-controller/pbgen/hdlctrl/v1"
+	"github.com/hantabaru1014/baru-reso-headless-controller/usecase/port"
+)
+
+// persistTimeout bounds the history write done inside Publish, which has
+// no caller context.
+const persistTimeout = 5 * time.Second
+
+// PersistentBus は publish されたイベントを履歴に保存してから内側の Bus で配信する Bus.
+// 保存時に採番した連番を NotificationEvent.id に入れるので、再接続したクライアントは
+// 最後に受け取った id 以降を履歴から再送してもらえる. 内側は単一インスタンスなら
+// MemoryBus、複数インスタンス構成ならインスタンス間で配信する実装になる.
+//
+// 保存に失敗したイベントも配信は行う (id は空になり、再送の対象にならない).
+type PersistentBus struct {
+	Bus
+
+	repo port.NotificationRepository
+}
+
+func NewPersistentBus(repo port.NotificationRepository, inner Bus) *PersistentBus {
+	return &PersistentBus{
+		Bus:  inner,
+		repo: repo,
+	}
+}
+
+func (b *PersistentBus) Publish(ev *hdlctrlv1.NotificationEvent) {
+	if ev == nil {
+		return
+	}
+
+	b.persist(nil, ev)
+	b.Bus.Publish(ev)
+}
+
+func (b *PersistentBus) PublishTo(userID string, ev *hdlctrlv1.NotificationEvent) {
+	if ev == nil || userID == "" {
+		return
+	}
+
+	b.persist(&userID, ev)
+	b.Bus.PublishTo(userID, ev)
+}
+
+// persist は ev を履歴に保存し、ev.Id を採番した連番で置き換える.
+func (b *PersistentBus) persist(targetUserID *string, ev *hdlctrlv1.NotificationEvent) {
+	// Publisher-supplied ids (e.g. headless event ids) are not usable as a
+	// replay cursor, so they never reach subscribers.
+	ev.Id = ""
+
+	if isTransient(ev) {
+		return
+	}
+
+	hostID, scope, _ := EventScope(ev)
+	rec := &entity.NotificationRecord{
+		TargetUserID: targetUserID,
+		Scope:        scope,
+		Inbox:        IsInboxEvent(ev),
+		Event:        ev,
+	}
+
+	if hostID != "" {
+		rec.HostID = &hostID
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), persistTimeout)
+	defer cancel()
+
+	if err := b.repo.Append(ctx, rec); err != nil {
+		slog.Error("notification bus: failed to persist event; delivering without id", "error", err)
+
+		return
+	}
+
+	ev.Id = strconv.FormatInt(rec.Seq, 10)
+}
@@ -0,0 +1,108 @@
+package notification
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/hantabaru1014/baru-reso-headless-controller/domain/entity"
+	hdlctrlv1 "github.com/hantabaru1014/baru-reso-headless-controller/pbgen/hdlctrl/v1"
+	"github.com/hantabaru1014/baru-reso-headless-controller/usecase/port"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// appendOnlyRepo records Append calls; the other methods are never used by the bus.
+type appendOnlyRepo struct {
+	port.NotificationRepository
+
+	records []*entity.NotificationRecord
+	err     error
+}
+
+func (r *appendOnlyRepo) Append(_ context.Context, rec *entity.NotificationRecord) error {
+	if r.err != nil {
+		return r.err
+	}
+
+	rec.Seq = int64(len(r.records) + 1)
+	r.records = append(r.records, rec)
+
+	return nil
+}
+
+func receiveOne(t *testing.T, ch <-chan *hdlctrlv1.NotificationEvent) *hdlctrlv1.NotificationEvent {
+	t.Helper()
+
+	select {
+	case ev := <-ch:
+		return ev
+	case <-time.After(time.Second):
+		t.Fatal("timed out waiting for event")
+
+		return nil
+	}
+}
+
+func TestPersistentBus_AssignsSeqAsID(t *testing.T) {
+	repo := &appendOnlyRepo{}
+	bus := NewPersistentBus(repo, NewBus())
+
+	ch, cancel := bus.Subscribe(context.Background(), "user-1")
+	defer cancel()
+
+	bus.Publish(SessionLifecycle("S-1", "host-A", "", hdlctrlv1.SessionLifecycleEvent_KIND_STARTED, nil))
+	bus.PublishTo("user-1", JobCompleted("job-1", "done", hdlctrlv1.JobCompletedEvent_LEVEL_SUCCESS))
+
+	assert.Equal(t, "1", receiveOne(t, ch).GetId())
+	assert.Equal(t, "2", receiveOne(t, ch).GetId())
+
+	require.Len(t, repo.records, 2)
+
+	session := repo.records[0]
+	assert.Nil(t, session.TargetUserID)
+	require.NotNil(t, session.HostID)
+	assert.Equal(t, "host-A", *session.HostID)
+	assert.Equal(t, entity.NotificationScope_Session, session.Scope)
+	assert.True(t, session.Inbox)
+
+	job := repo.records[1]
+	require.NotNil(t, job.TargetUserID)
+	assert.Equal(t, "user-1", *job.TargetUserID)
+	assert.Nil(t, job.HostID)
+	assert.Equal(t, entity.NotificationScope_None, job.Scope)
+}
+
+func TestPersistentBus_SkipsTransientEvents(t *testing.T) {
+	repo := &appendOnlyRepo{}
+	bus := NewPersistentBus(repo, NewBus())
+
+	ch, cancel := bus.Subscribe(context.Background(), "user-1")
+	defer cancel()
+
+	ev := KeepAlive()
+	ev.Id = "publisher-id"
+	bus.Publish(ev)
+
+	got := receiveOne(t, ch)
+	assert.NotNil(t, got.GetKeepAlive())
+	assert.Empty(t, got.GetId())
+	assert.Empty(t, repo.records)
+}
+
+func TestPersistentBus_DeliversWithoutIDWhenPersistFails(t *testing.T) {
+	repo := &appendOnlyRepo{err: errors.New("db down")}
+	bus := NewPersistentBus(repo, NewBus())
+
+	ch, cancel := bus.Subscribe(context.Background(), "user-1")
+	defer cancel()
+
+	ev := HostUpdated("host-A", "", nil)
+	ev.Id = "publisher-id"
+	bus.Publish(ev)
+
+	got := receiveOne(t, ch)
+	assert.Equal(t, "host-A", got.GetHostUpdated().GetHostId())
+	assert.Empty(t, got.GetId())
+}
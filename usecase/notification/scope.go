@@ -0,0 +1,60 @@
+package notification
+
+import (
+	"github.com/hantabaru1014/baru-reso-headless-controller/domain/entity"
+	hdlctrlv1 "github.com/hantabaru1014/baru-reso-headless-controller/pbgen/hdlctrl/v1"
+)
+
+// EventScope はイベントの認可単位 (host_id, 受信に必要な権限の scope, 認可要否) を返す.
+//
+// 認可を要さない (gated=false) のは、リソース識別子を含まない KeepAlive /
+// HostListChanged / HistoryTruncated と、PublishTo で宛先を絞って配信される
+// JobCompleted のみを明示列挙する. それ以外の未知 payload は fail-closed (gated=true,
+// host_id 空 = 配信不可) とし、将来 host/session scoped イベントを追加した際に本 switch の
+// 更新を忘れても全ユーザーへ漏れないようにする.
+func EventScope(ev *hdlctrlv1.NotificationEvent) (string, entity.NotificationScope, bool) {
+	switch p := ev.GetPayload().(type) {
+	case *hdlctrlv1.NotificationEvent_HostUpdated:
+		return p.HostUpdated.GetHostId(), entity.NotificationScope_Host, true
+	case *hdlctrlv1.NotificationEvent_HostAutoRestart:
+		return p.HostAutoRestart.GetHostId(), entity.NotificationScope_Host, true
+	case *hdlctrlv1.NotificationEvent_SessionUpdated:
+		return p.SessionUpdated.GetHostId(), entity.NotificationScope_Session, true
+	case *hdlctrlv1.NotificationEvent_SessionUserChanged:
+		return p.SessionUserChanged.GetHostId(), entity.NotificationScope_Session, true
+	case *hdlctrlv1.NotificationEvent_SessionLifecycle:
+		return p.SessionLifecycle.GetHostId(), entity.NotificationScope_Session, true
+	case *hdlctrlv1.NotificationEvent_KeepAlive,
+		*hdlctrlv1.NotificationEvent_HistoryTruncated,
+		*hdlctrlv1.NotificationEvent_HostListChanged,
+		*hdlctrlv1.NotificationEvent_JobCompleted:
+		// broadcast-safe (識別子を持たない / PublishTo で宛先限定済み).
+		return "", entity.NotificationScope_None, false
+	default:
+		// 未知 payload は fail-closed.
+		return "", entity.NotificationScope_None, true
+	}
+}
+
+// IsInboxEvent は ev を通知 inbox に残すかを返す. クエリの invalidate にしか
+// 使わない更新通知 (HostUpdated / SessionUpdated 等) は再送用の履歴にだけ残す.
+func IsInboxEvent(ev *hdlctrlv1.NotificationEvent) bool {
+	switch ev.GetPayload().(type) {
+	case *hdlctrlv1.NotificationEvent_SessionLifecycle,
+		*hdlctrlv1.NotificationEvent_HostAutoRestart,
+		*hdlctrlv1.NotificationEvent_JobCompleted:
+		return true
+	default:
+		return false
+	}
+}
+
+// isTransient は履歴に残さない制御用イベントか.
+func isTransient(ev *hdlctrlv1.NotificationEvent) bool {
+	switch ev.GetPayload().(type) {
+	case *hdlctrlv1.NotificationEvent_KeepAlive, *hdlctrlv1.NotificationEvent_HistoryTruncated:
+		return true
+	default:
+		return false
+	}
+}
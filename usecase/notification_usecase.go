@@ -0,0 +1,148 @@
+package usecase
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/go-errors/errors"
+	"github.com/hantabaru1014/baru-reso-headless-controller/domain"
+	"github.com/hantabaru1014/baru-reso-headless-controller/domain/entity"
+	hdlctrlv1 "github.com/hantabaru1014/baru-reso-headless-controller/pbgen/hdlctrl/v1"
+	"github.com/hantabaru1014/baru-reso-headless-controller/usecase/port"
+)
+
+// notificationReplayLimit は 1 回の再接続で再送するイベント数の上限.
+// 超える分は取りこぼしとして扱い、クライアントに全体の再取得を促す.
+const notificationReplayLimit = 500
+
+// NotificationUsecase は notification bus のイベント履歴 (再接続時の再送) と
+// 通知 inbox を提供する. 履歴への保存は notification.PersistentBus が行う.
+// 権限要件: 認証済みであること. 閲覧できるのは自分宛てのイベントと、
+// host / session の read (または write) 権限を持つグループのイベントのみ.
+type NotificationUsecase struct {
+	repo   port.NotificationRepository
+	permUC *PermissionUsecase
+}
+
+func NewNotificationUsecase(repo port.NotificationRepository, permUC *PermissionUsecase) *NotificationUsecase {
+	return &NotificationUsecase{repo: repo, permUC: permUC}
+}
+
+// ListMissedEvents は lastEventID より後に publish されたイベントのうち
+// userID 宛て / 全体向けのものを古い順に返す. 権限による絞り込みは行わないので、
+// 呼び出し側で stream と同じ配信フィルタに通すこと.
+// truncated が true の場合は履歴の削除や件数上限により一部を再送できていない.
+func (u *NotificationUsecase) ListMissedEvents(ctx context.Context, userID, lastEventID string) ([]*hdlctrlv1.NotificationEvent, bool, error) {
+	afterSeq, err := parseNotificationEventID(lastEventID)
+	if err != nil {
+		return nil, false, err
+	}
+
+	oldest, err := u.repo.OldestSeq(ctx)
+	if err != nil {
+		return nil, false, err
+	}
+
+	records, err := u.repo.ListAfter(ctx, userID, afterSeq, notificationReplayLimit+1)
+	if err != nil {
+		return nil, false, err
+	}
+
+	// afterSeq の直後が既に消えていれば、その間のイベントは失われている.
+	truncated := oldest > afterSeq+1
+	if len(records) > notificationReplayLimit {
+		records = records[:notificationReplayLimit]
+		truncated = true
+	}
+
+	events := make([]*hdlctrlv1.NotificationEvent, 0, len(records))
+	for _, rec := range records {
+		events = append(events, rec.Event)
+	}
+
+	return events, truncated, nil
+}
+
+// ListNotifications は呼び出し user の inbox を新しい順に返す.
+func (u *NotificationUsecase) ListNotifications(ctx context.Context, unreadOnly bool, pageIndex, pageSize int32) (*port.NotificationInboxResult, error) {
+	vis, err := u.currentVisibility(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return u.repo.ListInbox(ctx, vis, unreadOnly, pageIndex, pageSize)
+}
+
+// MarkNotificationsRead は eventIDs を呼び出し user の既読にする.
+func (u *NotificationUsecase) MarkNotificationsRead(ctx context.Context, eventIDs []string) error {
+	userID, err := CurrentUserID(ctx)
+	if err != nil {
+		return err
+	}
+
+	seqs := make([]int64, 0, len(eventIDs))
+
+	for _, id := range eventIDs {
+		seq, err := parseNotificationEventID(id)
+		if err != nil {
+			return err
+		}
+
+		seqs = append(seqs, seq)
+	}
+
+	return u.repo.MarkRead(ctx, userID, seqs)
+}
+
+// MarkAllNotificationsRead は呼び出し user が閲覧できる inbox を全て既読にする.
+func (u *NotificationUsecase) MarkAllNotificationsRead(ctx context.Context) error {
+	vis, err := u.currentVisibility(ctx)
+	if err != nil {
+		return err
+	}
+
+	return u.repo.MarkAllRead(ctx, vis)
+}
+
+// currentVisibility は呼び出し user が閲覧できるイベントの範囲を解決する.
+// stream の配信フィルタ (グループの host / session 閲覧権限, system:group.list bypass) と揃える.
+func (u *NotificationUsecase) currentVisibility(ctx context.Context) (port.NotificationVisibility, error) {
+	userID, err := CurrentUserID(ctx)
+	if err != nil {
+		return port.NotificationVisibility{}, err
+	}
+
+	groupIDs, listAll, err := u.permUC.ResolveListGroupFilter(ctx, userID, "")
+	if err != nil {
+		return port.NotificationVisibility{}, err
+	}
+
+	vis := port.NotificationVisibility{UserID: userID, ListAll: listAll}
+	if listAll {
+		return vis, nil
+	}
+
+	for _, groupID := range groupIDs {
+		for _, scope := range entity.GatedNotificationScopes {
+			ok, err := u.permUC.CanReadGroupAny(ctx, userID, groupID, scope.PermKeys())
+			if err != nil {
+				return port.NotificationVisibility{}, err
+			}
+
+			if ok {
+				vis.Grants = append(vis.Grants, port.NotificationGrant{GroupID: groupID, Scope: scope})
+			}
+		}
+	}
+
+	return vis, nil
+}
+
+func parseNotificationEventID(id string) (int64, error) {
+	seq, err := strconv.ParseInt(id, 10, 64)
+	if err != nil || seq < 0 {
+		return 0, errors.Errorf("invalid notification event id %q: %w", id, domain.ErrInvalidArgument)
+	}
+
+	return seq, nil
+}
@@ -0,0 +1,374 @@
+package usecase
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/go-errors/errors"
+	"github.com/hantabaru1014/baru-reso-headless-controller/db"
+	"github.com/hantabaru1014/baru-reso-headless-controller/domain"
+	"github.com/hantabaru1014/baru-reso-headless-controller/lib/auth"
+	"github.com/hantabaru1014/baru-reso-headless-controller/usecase/port"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"golang.org/x/oauth2"
+)
+
+const (
+	// oidcLoginStateTTL は BeginLogin から IdP のコールバックまでに許す時間.
+	oidcLoginStateTTL    = 10 * time.Minute
+	oidcLoginTokenLength = 32
+)
+
+// ErrOIDCDisabled は OIDC_ISSUER_URL が未設定のときに返す.
+var ErrOIDCDisabled = errors.New("oidc sign-in is not configured")
+
+// OIDCLoginOptions は外部アカウントとユーザーの対応付けの設定.
+type OIDCLoginOptions struct {
+	ProviderName string
+	// UserIDClaim は自動プロビジョニングで作成するユーザーの users.id として扱う claim.
+	UserIDClaim string
+	// AutoProvision が true なら未知のアカウントでユーザーを作成する.
+	AutoProvision bool
+	// PersonalRoleID は作成したユーザーの personal グループのロール. 空なら seed-admin.
+	PersonalRoleID string
+	// ProvisionGroupID が空でなければ、作成したユーザーを ProvisionRoleID で所属させる.
+	ProvisionGroupID string
+	ProvisionRoleID  string
+}
+
+// OIDCLoginUsecase は OpenID Connect (認可コード + PKCE) によるサインインを提供する.
+// 外部アカウント (issuer + sub) は user_identities でユーザーに紐付け、
+// トークンの発行はパスワードログインと同じ AuthClaims で行う.
+// 権限要件: なし (未認証で呼ばれる).
+type OIDCLoginUsecase struct {
+	queries  *db.Queries
+	pool     *pgxpool.Pool
+	provider port.OIDCProvider
+	guc      *GroupUsecase
+	opts     OIDCLoginOptions
+}
+
+// NewOIDCLoginUsecase は provider が nil なら SSO 無効として動く.
+func NewOIDCLoginUsecase(queries *db.Queries, pool *pgxpool.Pool, provider port.OIDCProvider, guc *GroupUsecase, opts OIDCLoginOptions) *OIDCLoginUsecase {
+	return &OIDCLoginUsecase{
+		queries:  queries,
+		pool:     pool,
+		provider: provider,
+		guc:      guc,
+		opts:     opts,
+	}
+}
+
+// Enabled は OIDC でサインインできるなら true.
+func (u *OIDCLoginUsecase) Enabled() bool {
+	return u.provider != nil
+}
+
+// ProviderName はサインインボタンに出す IdP の名前.
+func (u *OIDCLoginUsecase) ProviderName() string {
+	return u.opts.ProviderName
+}
+
+// OIDCLoginStart は IdP に送り出すための情報.
+// State はフロントエンドがコールバックで受け取った state と照合するために返す
+// (他人が始めたログインのコールバックを踏まされるのを防ぐ).
+type OIDCLoginStart struct {
+	AuthorizationURL string
+	State            string
+}
+
+// BeginLogin は state / nonce / PKCE verifier を発行して IdP の認可 URL を返す.
+func (u *OIDCLoginUsecase) BeginLogin(ctx context.Context) (*OIDCLoginStart, error) {
+	return u.begin(ctx, pgtype.Text{Valid: false})
+}
+
+// BeginLink はログイン中のユーザーに外部アカウントを紐付けるための認可 URL を返す.
+// state は caller に紐付けるので、他のユーザーがコールバックを完了させることはできない.
+func (u *OIDCLoginUsecase) BeginLink(ctx context.Context) (*OIDCLoginStart, error) {
+	userID, err := oidcLinkUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return u.begin(ctx, pgtype.Text{String: userID, Valid: true})
+}
+
+func (u *OIDCLoginUsecase) begin(ctx context.Context, linkUserID pgtype.Text) (*OIDCLoginStart, error) {
+	if u.provider == nil {
+		return nil, errors.Wrap(ErrOIDCDisabled, 0)
+	}
+
+	state, err := generateSecureToken(oidcLoginTokenLength)
+	if err != nil {
+		return nil, errors.Wrap(err, 0)
+	}
+
+	nonce, err := generateSecureToken(oidcLoginTokenLength)
+	if err != nil {
+		return nil, errors.Wrap(err, 0)
+	}
+
+	verifier := oauth2.GenerateVerifier()
+
+	// 放置された state はここでついでに掃除する. 失敗してもログインは続ける.
+	if _, err := u.queries.DeleteExpiredOidcLoginStates(ctx); err != nil {
+		slog.Warn("failed to delete expired oidc login states", "error", err)
+	}
+
+	if err := u.queries.CreateOidcLoginState(ctx, db.CreateOidcLoginStateParams{
+		State:        state,
+		Nonce:        nonce,
+		CodeVerifier: verifier,
+		ExpiresAt:    pgtype.Timestamptz{Time: time.Now().Add(oidcLoginStateTTL), Valid: true},
+		LinkUserID:   linkUserID,
+	}); err != nil {
+		return nil, errors.Wrap(err, 0)
+	}
+
+	url, err := u.provider.AuthCodeURL(ctx, state, nonce, verifier)
+	if err != nil {
+		return nil, err
+	}
+
+	return &OIDCLoginStart{AuthorizationURL: url, State: state}, nil
+}
+
+// CompleteLogin は IdP から戻ってきた認可コードを検証し、紐付いたユーザーを返す.
+// state は 1 回しか使えない.
+func (u *OIDCLoginUsecase) CompleteLogin(ctx context.Context, code, state string) (*db.User, error) {
+	loginState, identity, err := u.exchange(ctx, code, state)
+	if err != nil {
+		return nil, err
+	}
+
+	if loginState.LinkUserID.Valid {
+		return nil, errors.Errorf("oidc login state is for linking an account: %w", domain.ErrUnauthenticated)
+	}
+
+	userID, err := u.resolveUser(ctx, identity)
+	if err != nil {
+		return nil, err
+	}
+
+	user, err := u.queries.GetUser(ctx, userID)
+	if err != nil {
+		return nil, errors.Wrap(err, 0)
+	}
+
+	return &user, nil
+}
+
+// CompleteLink は BeginLink で始めた認可コードを検証し、外部アカウントを caller に紐付ける.
+func (u *OIDCLoginUsecase) CompleteLink(ctx context.Context, code, state string) error {
+	userID, err := oidcLinkUserID(ctx)
+	if err != nil {
+		return err
+	}
+
+	loginState, identity, err := u.exchange(ctx, code, state)
+	if err != nil {
+		return err
+	}
+
+	if loginState.LinkUserID.String != userID {
+		return errors.Errorf("oidc login state was not issued to this user: %w", domain.ErrPermissionDenied)
+	}
+
+	linked, err := u.queries.GetUserIdentity(ctx, db.GetUserIdentityParams{
+		Issuer:  identity.Issuer,
+		Subject: identity.Subject,
+	})
+	if err == nil {
+		if linked.UserID != userID {
+			return errors.Errorf("this account is already linked to another user: %w", domain.ErrPermissionDenied)
+		}
+
+		return nil
+	} else if !errors.Is(err, pgx.ErrNoRows) {
+		return errors.Wrap(err, 0)
+	}
+
+	return linkIdentity(ctx, u.queries, identity, userID)
+}
+
+// exchange は state を消費し、認可コードを IdP の ID トークンと引き換える.
+func (u *OIDCLoginUsecase) exchange(ctx context.Context, code, state string) (*db.OidcLoginState, *port.OIDCIdentity, error) {
+	if u.provider == nil {
+		return nil, nil, errors.Wrap(ErrOIDCDisabled, 0)
+	}
+
+	if code == "" || state == "" {
+		return nil, nil, errors.Errorf("code and state are required: %w", domain.ErrInvalidArgument)
+	}
+
+	loginState, err := u.queries.ConsumeOidcLoginState(ctx, state)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, nil, errors.Errorf("oidc login state is unknown or expired: %w", domain.ErrUnauthenticated)
+		}
+
+		return nil, nil, errors.Wrap(err, 0)
+	}
+
+	identity, err := u.provider.Exchange(ctx, code, loginState.CodeVerifier, loginState.Nonce)
+	if err != nil {
+		slog.Warn("oidc login failed", "error", err)
+
+		return nil, nil, errors.Errorf("oidc login failed: %w", domain.ErrUnauthenticated)
+	}
+
+	return &loginState, identity, nil
+}
+
+// resolveUser は外部アカウントに紐付いたユーザーを返す. 初めてのアカウントは
+// AutoProvision なら UserIDClaim を ID としてユーザーを作成して紐付ける.
+func (u *OIDCLoginUsecase) resolveUser(ctx context.Context, identity *port.OIDCIdentity) (string, error) {
+	linked, err := u.queries.GetUserIdentity(ctx, db.GetUserIdentityParams{
+		Issuer:  identity.Issuer,
+		Subject: identity.Subject,
+	})
+	if err == nil {
+		if err := u.queries.TouchUserIdentity(ctx, db.TouchUserIdentityParams{
+			Issuer:  identity.Issuer,
+			Subject: identity.Subject,
+			Email:   identityEmail(identity),
+		}); err != nil {
+			slog.Warn("failed to update oidc identity", "user_id", linked.UserID, "error", err)
+		}
+
+		return linked.UserID, nil
+	} else if !errors.Is(err, pgx.ErrNoRows) {
+		return "", errors.Wrap(err, 0)
+	}
+
+	userID, err := u.userIDFromClaims(identity)
+	if err != nil {
+		return "", err
+	}
+
+	_, err = u.queries.GetUser(ctx, userID)
+
+	switch {
+	case errors.Is(err, pgx.ErrNoRows) && u.opts.AutoProvision:
+		if err := u.provisionUser(ctx, identity, userID); err != nil {
+			return "", err
+		}
+	// 既存ユーザーには自動で紐付けない. claim を名乗れば乗っ取れてしまうため、
+	// ログインした状態で BeginLink / CompleteLink から紐付けてもらう.
+	case err == nil || errors.Is(err, pgx.ErrNoRows):
+		return "", errors.Errorf("no user is linked to this account; sign in and link it from user settings: %w", domain.ErrPermissionDenied)
+	default:
+		return "", errors.Wrap(err, 0)
+	}
+
+	return userID, nil
+}
+
+// linkIdentity は外部アカウントを userID に紐付ける.
+func linkIdentity(ctx context.Context, q *db.Queries, identity *port.OIDCIdentity, userID string) error {
+	if err := q.CreateUserIdentity(ctx, db.CreateUserIdentityParams{
+		Issuer:  identity.Issuer,
+		Subject: identity.Subject,
+		UserID:  userID,
+		Email:   identityEmail(identity),
+	}); err != nil {
+		return errors.WrapPrefix(err, "link oidc identity", 0)
+	}
+
+	slog.Info("linked oidc identity", "user_id", userID, "issuer", identity.Issuer, "subject", identity.Subject)
+
+	return nil
+}
+
+func identityEmail(identity *port.OIDCIdentity) pgtype.Text {
+	email := identity.StringClaim("email")
+
+	return pgtype.Text{String: email, Valid: email != ""}
+}
+
+// oidcLinkUserID は外部アカウントを紐付ける caller を返す. API トークンでは紐付けられない.
+func oidcLinkUserID(ctx context.Context) (string, error) {
+	claims, err := auth.GetAuthClaimsFromContext(ctx)
+	if err != nil || claims.UserID == "" {
+		return "", errors.Wrap(domain.ErrUnauthenticated, 0)
+	}
+
+	if claims.APIToken != nil {
+		return "", errors.Errorf("oidc accounts cannot be linked with an api token: %w", domain.ErrPermissionDenied)
+	}
+
+	return claims.UserID, nil
+}
+
+// userIDFromClaims は UserIDClaim の値を返す. email を使うときは IdP が
+// 検証済み (email_verified=true) としたアドレスだけを受け付ける. claim が無ければ未検証として扱う.
+func (u *OIDCLoginUsecase) userIDFromClaims(identity *port.OIDCIdentity) (string, error) {
+	userID := identity.StringClaim(u.opts.UserIDClaim)
+	if userID == "" {
+		return "", errors.Errorf("id_token has no %s claim: %w", u.opts.UserIDClaim, domain.ErrPermissionDenied)
+	}
+
+	if u.opts.UserIDClaim == "email" {
+		if verified, _ := identity.Claims["email_verified"].(bool); !verified {
+			return "", errors.Errorf("email address is not verified: %w", domain.ErrPermissionDenied)
+		}
+	}
+
+	if userID == domain.SystemUserID {
+		return "", errors.Errorf("user id 'system' is reserved: %w", domain.ErrPermissionDenied)
+	}
+
+	return userID, nil
+}
+
+// provisionUser は SSO 専用のユーザーを作成し、外部アカウントを紐付ける. パスワードは空にしておき
+// パスワードではサインインできないようにする (system ユーザーと同じ扱い).
+// 途中で失敗して personal グループの無いユーザーが残らないよう、紐付けまで 1 つの tx で行う.
+func (u *OIDCLoginUsecase) provisionUser(ctx context.Context, identity *port.OIDCIdentity, userID string) error {
+	// tx 外: 設定された所属先の検証 (read-only).
+	if u.opts.ProvisionGroupID != "" {
+		if err := u.guc.validateProvisionMemberRole(ctx, u.opts.ProvisionGroupID, u.opts.ProvisionRoleID); err != nil {
+			return errors.WrapPrefix(err, "validate provision group", 0)
+		}
+	}
+
+	err := db.RunInTx(ctx, u.pool, func(tx pgx.Tx) error {
+		qtx := u.queries.WithTx(tx)
+
+		if err := qtx.CreateUser(ctx, db.CreateUserParams{
+			ID:         userID,
+			Password:   "",
+			ResoniteID: pgtype.Text{Valid: false},
+			IconUrl:    pgtype.Text{Valid: false},
+		}); err != nil {
+			return errors.WrapPrefix(err, "create user", 0)
+		}
+
+		if err := u.guc.EnsurePersonalGroupForUserTx(ctx, qtx, userID, u.opts.PersonalRoleID); err != nil {
+			return errors.WrapPrefix(err, "ensure personal group", 0)
+		}
+
+		if u.opts.ProvisionGroupID != "" {
+			if _, err := qtx.AddGroupMember(ctx, db.AddGroupMemberParams{
+				GroupID: u.opts.ProvisionGroupID,
+				UserID:  userID,
+				RoleID:  u.opts.ProvisionRoleID,
+				AddedBy: pgtype.Text{Valid: false},
+			}); err != nil {
+				return errors.WrapPrefix(err, "join provision group", 0)
+			}
+		}
+
+		return linkIdentity(ctx, qtx, identity, userID)
+	})
+	if err != nil {
+		return err
+	}
+
+	slog.Info("provisioned user from oidc", "user_id", userID)
+
+	return nil
+}
@@ -36,11 +36,17 @@ func NewPermissionUsecase(groupRepo port.GroupRepository, memberRepo port.GroupM
 //   - それ以外 (normal scope) の場合:
 //   - 1. userID が groupID で requiredKey を持つ
 //   - 2. または、userID が system:group.manage を持つ (normal scope の代行権限)
+//
+// caller が API トークンで認証されている場合は、トークンの制限外なら常に false.
 func (u *PermissionUsecase) HasPermission(ctx context.Context, userID, groupID, requiredKey string) (bool, error) {
 	if requiredKey == "" {
 		return true, nil
 	}
 
+	if !APITokenAllows(ctx, userID, groupID, requiredKey) {
+		return false, nil
+	}
+
 	if strings.HasPrefix(requiredKey, "system:") {
 		sysPerms, err := u.memberRepo.ListUserSystemPermissions(ctx, userID)
 		if err != nil {
@@ -88,6 +94,10 @@ func (u *PermissionUsecase) HasAllPermissions(ctx context.Context, userID, group
 
 // HasSystemPermission は system 権限のみを判定する (groupID 引かない).
 func (u *PermissionUsecase) HasSystemPermission(ctx context.Context, userID, key string) (bool, error) {
+	if !APITokenAllows(ctx, userID, "", key) {
+		return false, nil
+	}
+
 	sysPerms, err := u.memberRepo.ListUserSystemPermissions(ctx, userID)
 	if err != nil {
 		return false, errors.Wrap(err, 0)
@@ -143,6 +153,10 @@ func (u *PermissionUsecase) ResolveListGroupFilter(ctx context.Context, userID,
 			continue // system グループは normal-scope リソースを持たないので除外
 		}
 
+		if !APITokenAllows(ctx, userID, m.GroupID, "") {
+			continue
+		}
+
 		if permKey == "" {
 			result = append(result, m.GroupID)
 			continue
@@ -207,6 +221,14 @@ func (u *PermissionUsecase) GetMyPermissionsSummary(ctx context.Context, userID
 			return nil, errors.Wrap(err, 0)
 		}
 
+		// API トークンの制限で使えない key は返さない.
+		perms = slices.DeleteFunc(perms, func(k string) bool {
+			if strings.HasPrefix(k, "system:") {
+				return !APITokenAllows(ctx, userID, "", k)
+			}
+
+			return !APITokenAllows(ctx, userID, m.GroupID, k)
+		})
 		if perms == nil {
 			perms = []string{}
 		}
@@ -244,6 +266,18 @@ func CurrentUserID(ctx context.Context) (string, error) {
 	return claims.UserID, nil
 }
 
+// APITokenAllows は ctx の caller が userID 本人で API トークンで認証されている場合に、
+// groupID に対する key の操作がトークンの制限内か判定する. key が空ならグループの制限だけを見る.
+// API トークン以外の認証 (JWT / CLI) や他ユーザーの判定では常に true.
+func APITokenAllows(ctx context.Context, userID, groupID, key string) bool {
+	claims, err := auth.GetAuthClaimsFromContext(ctx)
+	if err != nil || claims.UserID != userID {
+		return true
+	}
+
+	return claims.APIToken.Allows(groupID, key)
+}
+
 // RequirePermissionForGroup は ctx 上の caller が groupID に対し permKey を
 // 持つことを要求する. 持たなければ domain.ErrPermissionDenied を返す.
 //
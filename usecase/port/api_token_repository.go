@@ -0,0 +1,18 @@
+package port
+
+import (
+	"context"
+
+	"github.com/hantabaru1014/baru-reso-headless-controller/domain/entity"
+)
+
+// ApiTokenRepository は API トークンの永続化を担う.
+type ApiTokenRepository interface {
+	Create(ctx context.Context, token *entity.ApiToken) error
+	// GetByHash は失効済み / 期限切れも含めて返す. 有効性の判定は呼び出し側で行う.
+	GetByHash(ctx context.Context, tokenHash string) (*entity.ApiToken, error)
+	ListByUser(ctx context.Context, userID string) (entity.ApiTokenList, error)
+	// Revoke は userID のトークン id を失効させる. 該当する未失効のトークンが無ければ domain.ErrNotFound.
+	Revoke(ctx context.Context, userID, id string) error
+	TouchLastUsed(ctx context.Context, id string) error
+}
@@ -0,0 +1,33 @@
+package port
+
+import (
+	"context"
+	"time"
+
+	"github.com/hantabaru1014/baru-reso-headless-controller/domain/entity"
+)
+
+// AuditEventFilter は監査ログの絞り込み条件. nil のフィールドは未指定.
+// Until は排他的 (occurred_at < Until).
+type AuditEventFilter struct {
+	GroupID      *string
+	UserID       *string
+	ResourceType *entity.AuditResourceType
+	ResourceID   *string
+	Since        *time.Time
+	Until        *time.Time
+}
+
+type AuditEventListResult struct {
+	Items      entity.AuditEventList
+	TotalCount int32
+}
+
+type AuditEventRepository interface {
+	Create(ctx context.Context, event *entity.AuditEvent) error
+	// List は新しい順にページングして返す.
+	List(ctx context.Context, filter AuditEventFilter, pageIndex, pageSize int32) (*AuditEventListResult, error)
+	// ListAfter は afterID より後の event を ID 昇順で最大 limit 件返す.
+	// 書き込みが続いている中で全件を漏れなく読み出す (export) 用.
+	ListAfter(ctx context.Context, filter AuditEventFilter, afterID int64, limit int32) (entity.AuditEventList, error)
+}
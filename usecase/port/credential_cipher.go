@@ -0,0 +1,16 @@
+package port
+
+import "github.com/hantabaru1014/baru-reso-headless-controller/domain/entity"
+
+// CredentialCipher は headless account の認証情報を暗号化 / 復号する.
+// 平文が必要なのはコンテナ起動と skyfrost へのログインだけなので、それ以外の
+// 経路 (drain 中の再起動パラメータなど) では entity.SealedCredentials のまま持ち回す.
+// 暗号文は resoniteID に紐付けられ、別のアカウントの行に写しても復号できない.
+type CredentialCipher interface {
+	Seal(resoniteID, credential, password string) (entity.SealedCredentials, error)
+	// Open は平文の credential / password を返す. 暗号化導入前の平文の行はそのまま返す.
+	Open(resoniteID string, sealed entity.SealedCredentials) (credential, password string, err error)
+	// Rewrap は平文の行を暗号化し、primary 以外の鍵で暗号化された行を primary の鍵に掛け直す.
+	// 変更が無ければ changed は false.
+	Rewrap(resoniteID string, sealed entity.SealedCredentials) (rewrapped entity.SealedCredentials, changed bool, err error)
+}
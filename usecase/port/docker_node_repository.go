@@ -0,0 +1,18 @@
+package port
+
+import (
+	"context"
+
+	"github.com/hantabaru1014/baru-reso-headless-controller/domain/entity"
+)
+
+// DockerNodeRepository はリモート Docker node の登録情報を扱う.
+// 実装は adapter/docker_node_repository.go.
+type DockerNodeRepository interface {
+	Create(ctx context.Context, node *entity.DockerNode) (*entity.DockerNode, error)
+	Get(ctx context.Context, id string) (*entity.DockerNode, error)
+	ListAll(ctx context.Context) (entity.DockerNodeList, error)
+	SetEnabled(ctx context.Context, id string, enabled bool) error
+	// Delete は node 上に host が残っている場合は削除せずエラーを返す.
+	Delete(ctx context.Context, id string) error
+}
@@ -2,6 +2,7 @@ package port
 
 import (
 	"context"
+	"time"
 
 	"github.com/hantabaru1014/baru-reso-headless-controller/domain/entity"
 	headlessv1 "github.com/hantabaru1014/baru-reso-headless-controller/pbgen/headless/v1"
@@ -12,6 +13,7 @@ type LogLine struct {
 	Timestamp int64
 	IsError   bool
 	Body      string
+	Level     entity.HostLogLevel
 }
 
 type LogLineList []*LogLine
@@ -24,11 +26,33 @@ type GetLogsParams struct {
 	AfterID    int64 // このIDより大きいログ (新しい方向へのページネーション)
 }
 
+type SearchLogsParams struct {
+	// Query は本文に含まれる文字列 (大文字小文字を区別しない).
+	Query string
+	// GroupIDs は nil なら全グループ、空 slice ならゼロ件.
+	GroupIDs []string
+	// HostIDs は空なら全ホスト.
+	HostIDs  []string
+	Since    *time.Time // 含む
+	Until    *time.Time // 含まない
+	BeforeID int64      // このIDより小さいログ (ページネーション)
+	Limit    int32
+}
+
+// LogSearchHit は検索に一致したログ 1 行と、その出力元.
+type LogSearchHit struct {
+	HostID     string
+	HostName   string
+	InstanceID int32
+	Log        *LogLine
+}
+
 type InstanceTimestamp struct {
 	InstanceID int32
 	FirstLogAt *int64 // UnixTime (秒), nil = データなし
 	LastLogAt  *int64 // UnixTime (秒), nil = データなし
 	LogCount   int64
+	Archived   bool // ログ (の一部) を blob store に退避済み
 }
 
 type InstanceTimestampList []*InstanceTimestamp
@@ -41,6 +65,15 @@ type HeadlessHostStartParams struct {
 	AutoUpdatePolicy  entity.HostAutoUpdatePolicy
 	Memo              string
 	GroupID           string // 起動するホストの所属グループ ID. 必須.
+	// NodeID は起動先 node の指定. 空なら connector が空いている node を選ぶ.
+	// Restart で空の場合は直前まで動いていた node を優先する.
+	NodeID string
+	// ContainerSettings は Start 時にホストへ保存される. Restart では無視され、
+	// 保存済みの設定 (UpdateContainerSettings で更新) が使われる.
+	ContainerSettings entity.HostContainerSettings
+	// AutoRestartPolicy / AutoRestartMaxRetries も Start 時のみ保存され、Restart では無視される.
+	AutoRestartPolicy     entity.HostAutoRestartPolicy
+	AutoRestartMaxRetries int32
 }
 
 type HeadlessHostFetchOptions struct {
@@ -74,7 +107,10 @@ type ContainerImageList []*ContainerImage
 
 type HostConnectorType string
 
-const HostConnectorType_DOCKER HostConnectorType = "docker"
+const (
+	HostConnectorType_DOCKER     HostConnectorType = "docker"
+	HostConnectorType_KUBERNETES HostConnectorType = "kubernetes"
+)
 
 type HeadlessHostRepository interface {
 	ListAll(ctx context.Context, fetchOptions HeadlessHostFetchOptions) (entity.HeadlessHostList, error)
@@ -84,12 +120,23 @@ type HeadlessHostRepository interface {
 	// GetGroupID は host の group_id だけを DB のみで返す軽量メソッド.
 	// permission interceptor が container RPC を起こさないようにするための専用 API.
 	GetGroupID(ctx context.Context, id string) (string, error)
+	// ListStatuses は全 host の status を DB だけから返す. key は host id.
+	ListStatuses(ctx context.Context) (map[string]entity.HeadlessHostStatus, error)
 	GetRpcClient(ctx context.Context, id string) (headlessv1.HeadlessControlServiceClient, error)
 	GetLogs(ctx context.Context, params GetLogsParams) (LogLineList, error)
+	// ScanLogs はインスタンスのログをすべて古い順に、いくつかずつ fn に渡す.
+	// 退避済みのログも含む. fn が error を返すとそこで止めてその error を返す.
+	ScanLogs(ctx context.Context, hostID string, instanceID int32, fn func(LogLineList) error) error
+	// SearchLogs は全ホストのログを本文で検索し、新しい順に返す.
+	SearchLogs(ctx context.Context, params SearchLogsParams) ([]*LogSearchHit, error)
 	GetInstanceTimestamps(ctx context.Context, hostID string) (InstanceTimestampList, error)
 	Rename(ctx context.Context, id, newName string) error
 	UpdateHostSettings(ctx context.Context, id string, settings *entity.HeadlessHostSettings) error
 	UpdateAutoUpdatePolicy(ctx context.Context, id string, policy entity.HostAutoUpdatePolicy) error
+	// UpdateContainerSettings は次回の起動/再起動から反映される.
+	UpdateContainerSettings(ctx context.Context, id string, settings *entity.HostContainerSettings) error
+	// UpdateAutoRestartPolicy は連続再起動回数と crash loop による停止もリセットする.
+	UpdateAutoRestartPolicy(ctx context.Context, id string, policy entity.HostAutoRestartPolicy, maxRetries int32) error
 	// コンテナイメージのタグ一覧をリモートから取得する。一番新しいタグが最後。
 	ListContainerTags(ctx context.Context, lastTag *string) (ContainerImageList, error)
 	Restart(ctx context.Context, id string, newStartupConfig HeadlessHostStartParams, timeoutSeconds int) error
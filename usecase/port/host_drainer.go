@@ -14,3 +14,18 @@ type HostDrainer interface {
 type NoopHostDrainer struct{}
 
 func (NoopHostDrainer) IsHostDraining(string) bool { return false }
+
+// HostDrainers reports a host as draining if any of its drainers does.
+// With several controller instances the orchestrator's in-memory drain set
+// only exists on the leader, so the others consult a shared registry too.
+type HostDrainers []HostDrainer
+
+func (d HostDrainers) IsHostDraining(hostID string) bool {
+	for _, drainer := range d {
+		if drainer.IsHostDraining(hostID) {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,28 @@
+package port
+
+import (
+	"context"
+	"time"
+)
+
+// PastLogInstance はログが DB に残っている過去のインスタンス (ホストの現在のインスタンス以外).
+type PastLogInstance struct {
+	HostID     string
+	InstanceID int32
+	LastLogAt  time.Time
+	// RetentionDays はホストのグループの保持日数. nil なら全体の設定に従う.
+	RetentionDays *int32
+}
+
+// HostLogArchive は保持期間を過ぎたホストのログを blob store に退避する.
+// 退避したログも HeadlessHostRepository の GetLogs / GetInstanceTimestamps から
+// 退避前と同じように読める.
+type HostLogArchive interface {
+	// EnsureBucket は退避先のバケットを用意する.
+	EnsureBucket(ctx context.Context) error
+	// ListPastInstances は過去のインスタンスを、最後のログが古い順に返す.
+	ListPastInstances(ctx context.Context) ([]*PastLogInstance, error)
+	// Archive はインスタンスのログを退避して DB から削除し、退避した行数を返す.
+	// 退避済みのインスタンスに後から書き込まれたログは既存の退避分に追記する.
+	Archive(ctx context.Context, hostID string, instanceID int32) (int64, error)
+}
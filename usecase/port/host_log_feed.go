@@ -0,0 +1,11 @@
+package port
+
+import "context"
+
+// HostLogFeed は container_logs に追記されるホストのログを購読する.
+type HostLogFeed interface {
+	// Follow は hostID / instanceID のログのうち afterID より後のものを、追記され次第
+	// 時系列順に返す. チャネルは ctx が終わるか、受け取りが追いつかず購読が打ち切られると
+	// 閉じられる. 打ち切られた場合は最後に受け取ったログの ID から Follow し直せば欠けない.
+	Follow(ctx context.Context, hostID string, instanceID int32, afterID int64) <-chan LogLineList
+}
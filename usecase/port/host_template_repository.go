@@ -0,0 +1,30 @@
+package port
+
+import (
+	"context"
+
+	"github.com/hantabaru1014/baru-reso-headless-controller/domain/entity"
+	headlessv1 "github.com/hantabaru1014/baru-reso-headless-controller/pbgen/headless/v1"
+)
+
+// HostTemplateUpdateParams は nil のフィールドを変更しない.
+// StartupConfig / ContainerSettings は指定すると丸ごと置き換える.
+type HostTemplateUpdateParams struct {
+	Name                  *string
+	Description           *string
+	ImageTag              *string
+	StartupConfig         *headlessv1.StartupConfig
+	AutoUpdatePolicy      *entity.HostAutoUpdatePolicy
+	Memo                  *string
+	ContainerSettings     *entity.HostContainerSettings
+	AutoRestartPolicy     *entity.HostAutoRestartPolicy
+	AutoRestartMaxRetries *int32
+}
+
+type HostTemplateRepository interface {
+	Create(ctx context.Context, tmpl *entity.HostTemplate) error
+	Get(ctx context.Context, id string) (*entity.HostTemplate, error)
+	ListByGroup(ctx context.Context, groupID string) (entity.HostTemplateList, error)
+	Update(ctx context.Context, id string, params HostTemplateUpdateParams) (*entity.HostTemplate, error)
+	Delete(ctx context.Context, id string) error
+}
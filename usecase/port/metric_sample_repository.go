@@ -0,0 +1,28 @@
+package port
+
+import (
+	"context"
+	"time"
+
+	"github.com/hantabaru1014/baru-reso-headless-controller/domain/entity"
+)
+
+// RawMetricValue は raw サンプル 1 つ分の値.
+type RawMetricValue struct {
+	Kind     entity.MetricKind
+	TargetID string
+	Value    float64
+}
+
+// MetricSampleRepository はホスト / セッションのメトリクスの時系列を保存する.
+type MetricSampleRepository interface {
+	// InsertRaw は同じ時刻に取ったサンプルをまとめて raw として保存する.
+	InsertRaw(ctx context.Context, at time.Time, values []RawMetricValue) error
+	// Rollup は to の 1 つ下の解像度の [since, until) を to のバケットにまとめる.
+	// 既にあるバケットは集計し直した値で置き換える.
+	Rollup(ctx context.Context, to entity.MetricResolution, since, until time.Time) (int64, error)
+	// DeleteBefore は resolution のバケットのうち before より前のものを消す.
+	DeleteBefore(ctx context.Context, resolution entity.MetricResolution, before time.Time) (int64, error)
+	// List は 1 系列の [since, until) のバケットを時刻順に返す.
+	List(ctx context.Context, kind entity.MetricKind, targetID string, resolution entity.MetricResolution, since, until time.Time) (entity.MetricSampleList, error)
+}
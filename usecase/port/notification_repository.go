@@ -0,0 +1,53 @@
+package port
+
+import (
+	"context"
+	"time"
+
+	"github.com/hantabaru1014/baru-reso-headless-controller/domain/entity"
+)
+
+// NotificationGrant は user が groupID のイベントを受信できる scope 1 つ分.
+type NotificationGrant struct {
+	GroupID string
+	Scope   entity.NotificationScope
+}
+
+// NotificationVisibility は user が inbox で閲覧できる範囲.
+// UserID 宛てに PublishTo されたもの, 権限不要のものは常に含む.
+type NotificationVisibility struct {
+	UserID string
+	// ListAll なら権限を要するイベントもグループに関係なく含む (system:group.list 保持者).
+	ListAll bool
+	Grants  []NotificationGrant
+}
+
+type NotificationInboxResult struct {
+	Items       entity.NotificationInboxItemList
+	TotalCount  int32
+	UnreadCount int32
+}
+
+// NotificationRepository は notification bus のイベント履歴と inbox の既読状態の永続化を担う.
+type NotificationRepository interface {
+	// Append は rec を履歴に追加し、採番した rec.Seq を埋める.
+	// GroupID は rec.HostID の現在の所属グループが記録される.
+	Append(ctx context.Context, rec *entity.NotificationRecord) error
+	// ListAfter は afterSeq より後のうち userID 宛て / 全体向けのものを古い順に最大 limit 件返す.
+	// 権限による絞り込みは行わない.
+	ListAfter(ctx context.Context, userID string, afterSeq int64, limit int32) (entity.NotificationRecordList, error)
+	// OldestSeq は保持している最古の Seq を返す. 履歴が空なら 0.
+	OldestSeq(ctx context.Context) (int64, error)
+
+	// ListInbox は vis の範囲の inbox 対象イベントを新しい順にページングして返す.
+	ListInbox(ctx context.Context, vis NotificationVisibility, unreadOnly bool, pageIndex, pageSize int32) (*NotificationInboxResult, error)
+	// MarkRead は seqs を userID の既読にする. 存在しない seq は無視する.
+	MarkRead(ctx context.Context, userID string, seqs []int64) error
+	// MarkAllRead は vis の範囲の inbox 対象イベントを全て既読にする.
+	MarkAllRead(ctx context.Context, vis NotificationVisibility) error
+
+	// DeleteBefore は before より前に記録された履歴を消す.
+	DeleteBefore(ctx context.Context, before time.Time) (int64, error)
+	// DeleteBeyondLatest は新しい順に keep 件だけ残して古い履歴を消す.
+	DeleteBeyondLatest(ctx context.Context, keep int32) (int64, error)
+}
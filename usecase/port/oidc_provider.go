@@ -0,0 +1,27 @@
+package port
+
+import "context"
+
+// OIDCIdentity は IdP が ID トークンで保証した外部アカウント.
+type OIDCIdentity struct {
+	Issuer  string
+	Subject string
+	// Claims は検証済み ID トークンの全 claim.
+	Claims map[string]any
+}
+
+// StringClaim は文字列の claim を返す. 無いか文字列でなければ空文字.
+func (i *OIDCIdentity) StringClaim(name string) string {
+	s, _ := i.Claims[name].(string)
+
+	return s
+}
+
+// OIDCProvider は OpenID Connect の認可コードフロー (PKCE) を IdP とやり取りする.
+type OIDCProvider interface {
+	// AuthCodeURL はブラウザを送る IdP の認可エンドポイントの URL を返す.
+	AuthCodeURL(ctx context.Context, state, nonce, codeVerifier string) (string, error)
+	// Exchange は認可コードをトークンに交換し、ID トークンの署名 / issuer / audience /
+	// nonce を検証して外部アカウントを返す.
+	Exchange(ctx context.Context, code, codeVerifier, nonce string) (*OIDCIdentity, error)
+}
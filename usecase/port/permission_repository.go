@@ -16,6 +16,8 @@ type GroupRepository interface {
 	ListByUser(ctx context.Context, userID string) (entity.GroupList, error)
 	GetPersonalGroupByUser(ctx context.Context, userID string) (*entity.Group, error)
 	UpdateName(ctx context.Context, id, name string) error
+	// UpdateLogRetentionDays は days が nil なら設定を解除する.
+	UpdateLogRetentionDays(ctx context.Context, id string, days *int32) error
 	Delete(ctx context.Context, id string) error
 }
 
@@ -53,7 +53,35 @@ type ScheduledSessionOperationRepository interface {
 	// Requeue は trigger.Evaluate が "未だ ready ではない" を返した場合に呼ぶ。
 	// RUNNING の行を PENDING に戻し、次回再評価時刻を設定する。後続 PR の condition 系で使用。
 	Requeue(ctx context.Context, id string, nextFireAt time.Time) error
-	// Cancel は PENDING の行のみを CANCELED にする。RUNNING / SUCCEEDED / FAILED / CANCELED で
+	// Rearm は繰り返し trigger の実行後に呼ぶ。RUNNING の行を nextFireAt で PENDING に戻し、
+	// executed_at と今回の結果 (lastError, 成功なら nil) を記録する。
+	Rearm(ctx context.Context, id string, nextFireAt time.Time, lastError *string) error
+	// Cancel は PENDING / PAUSED の行のみを CANCELED にする。RUNNING / SUCCEEDED / FAILED / CANCELED で
 	// 呼ばれた場合は ok=false を返す。
 	Cancel(ctx context.Context, id string) (ok bool, err error)
+	// Pause は PENDING の行のみを PAUSED にする。
+	Pause(ctx context.Context, id string) (ok bool, err error)
+	// Resume は PAUSED の行を PENDING に戻す。nextFireAt が nil なら next_fire_at は変更しない。
+	Resume(ctx context.Context, id string, nextFireAt *time.Time) (ok bool, err error)
+	// Skip は PENDING かつ next_fire_at が currentFireAt の行の next_fire_at を進める。
+	// 既に worker が claim した等で条件に合わなければ ok=false を返す。
+	Skip(ctx context.Context, id string, currentFireAt, nextFireAt time.Time) (ok bool, err error)
+
+	CreateRun(ctx context.Context, params ScheduledOperationRunCreateParams) error
+	// ListRuns は新しい回から順に返す。
+	ListRuns(ctx context.Context, operationID string, pageIndex, pageSize int32) (*ScheduledOperationRunListResult, error)
+}
+
+type ScheduledOperationRunCreateParams struct {
+	OperationID  string
+	ScheduledFor time.Time
+	StartedAt    *time.Time
+	Outcome      entity.ScheduledOperationRunOutcome
+	Error        *string
+	InstanceID   *string
+}
+
+type ScheduledOperationRunListResult struct {
+	Items      entity.ScheduledOperationRunList
+	TotalCount int32
 }
@@ -25,6 +25,15 @@ type SessionListPageResult struct {
 	TotalCount int32
 }
 
+// SessionActiveBetweenOptions は ListActiveBetween の条件. [Since, Until) に
+// 開いていたセッションを返す. HostID / GroupIDs の扱いは SessionListPageOptions と同じ.
+type SessionActiveBetweenOptions struct {
+	Since    time.Time
+	Until    time.Time
+	HostID   *string
+	GroupIDs []string
+}
+
 type SessionRepository interface {
 	Upsert(ctx context.Context, session *entity.Session) error
 	UpdateStatus(ctx context.Context, id string, status entity.SessionStatus) error
@@ -46,11 +55,19 @@ type SessionRepository interface {
 	// guarded update。RUNNING のときだけ UNKNOWN へ降ろし、StopSession で
 	// ENDED に至った session を巻き戻さない
 	DowngradeToUnknownIfRunning(ctx context.Context, id string) error
+	// MarkCrashedForRestore は host の予期しない停止時に、その host の
+	// restore_on_crash な RUNNING session を CRASHED にして返す。
+	MarkCrashedForRestore(ctx context.Context, hostID string) (entity.SessionList, error)
+	// ListPendingRestore は restore_on_crash で UNKNOWN / CRASHED のまま、
+	// host が RUNNING に戻っている session を返す。
+	ListPendingRestore(ctx context.Context) (entity.SessionList, error)
 	Get(ctx context.Context, id string) (*entity.Session, error)
 	ListAll(ctx context.Context) (entity.SessionList, error)
 	ListByStatus(ctx context.Context, status entity.SessionStatus) (entity.SessionList, error)
 	ListByHostAndStatus(ctx context.Context, hostID string, status entity.SessionStatus) (entity.SessionList, error)
 	ListPaged(ctx context.Context, opts SessionListPageOptions) (*SessionListPageResult, error)
+	// ListActiveBetween は期間中に開いていたセッションを開始順に返す.
+	ListActiveBetween(ctx context.Context, opts SessionActiveBetweenOptions) (entity.SessionList, error)
 	Delete(ctx context.Context, id string) error
 
 	// InsertFromEvent は host 由来の SessionStarted で「DB に存在しない session」を
@@ -0,0 +1,23 @@
+package port
+
+import (
+	"context"
+
+	"github.com/hantabaru1014/baru-reso-headless-controller/domain/entity"
+	headlessv1 "github.com/hantabaru1014/baru-reso-headless-controller/pbgen/headless/v1"
+)
+
+// SessionTemplateUpdateParams は nil のフィールドを変更しない.
+type SessionTemplateUpdateParams struct {
+	Name        *string
+	Description *string
+	Parameters  *headlessv1.WorldStartupParameters
+}
+
+type SessionTemplateRepository interface {
+	Create(ctx context.Context, tmpl *entity.SessionTemplate) error
+	Get(ctx context.Context, id string) (*entity.SessionTemplate, error)
+	ListByGroup(ctx context.Context, groupID string) (entity.SessionTemplateList, error)
+	Update(ctx context.Context, id string, params SessionTemplateUpdateParams) (*entity.SessionTemplate, error)
+	Delete(ctx context.Context, id string) error
+}
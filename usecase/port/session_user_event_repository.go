@@ -0,0 +1,19 @@
+package port
+
+import (
+	"context"
+
+	"github.com/hantabaru1014/baru-reso-headless-controller/domain/entity"
+)
+
+// SessionUserEventRepository はセッションへのユーザーの出入りを保存する.
+// 読み出しにはホスト自身のアカウントの出入りを含めない.
+type SessionUserEventRepository interface {
+	// Insert は出入りを 1 件保存する. 同じ host event が既に保存されていれば
+	// 何もせず false を返す.
+	Insert(ctx context.Context, event *entity.SessionUserEvent) (bool, error)
+	// ListBySession はセッションの出入りを id 順に afterID より後から最大 limit 件返す.
+	ListBySession(ctx context.Context, sessionID string, afterID int64, limit int32) (entity.SessionUserEventList, error)
+	// ListBySessionIDs は複数セッションの出入りをすべて発生順に返す.
+	ListBySessionIDs(ctx context.Context, sessionIDs []string) (entity.SessionUserEventList, error)
+}
@@ -0,0 +1,14 @@
+package port
+
+// StreamOwnership は複数 controller 構成で、ホストや docker node ごとの
+// イベントストリームをこのインスタンスが担当するかを判定する.
+// 担当は生存しているインスタンスの増減に応じて移るので、呼び出し側は
+// 結果をキャッシュせず都度問い合わせること.
+type StreamOwnership interface {
+	Owns(key string) bool
+}
+
+// SingleInstanceOwnership は全てを担当する StreamOwnership. 単一インスタンス構成で使う.
+type SingleInstanceOwnership struct{}
+
+func (SingleInstanceOwnership) Owns(string) bool { return true }
@@ -0,0 +1,13 @@
+package port
+
+import "github.com/hantabaru1014/baru-reso-headless-controller/domain/entity"
+
+// TOTPSecretCipher はユーザーの TOTP secret を暗号化 / 復号する.
+// 暗号文は userID に紐付けられ、別のユーザーの行に写しても復号できない.
+type TOTPSecretCipher interface {
+	Seal(userID string, secret []byte) (entity.SealedSecret, error)
+	Open(userID string, sealed entity.SealedSecret) ([]byte, error)
+	// Rewrap は primary 以外の鍵で暗号化された secret を primary の鍵に掛け直す.
+	// 変更が無ければ changed は false.
+	Rewrap(userID string, sealed entity.SealedSecret) (rewrapped entity.SealedSecret, changed bool, err error)
+}
@@ -0,0 +1,25 @@
+package port
+
+import (
+	"context"
+	"time"
+
+	"github.com/hantabaru1014/baru-reso-headless-controller/domain/entity"
+)
+
+// UserSessionRepository はログインセッションの永続化を担う.
+type UserSessionRepository interface {
+	Create(ctx context.Context, session *entity.UserSession) error
+	// Get は失効済み / 期限切れも含めて返す. 有効性の判定は呼び出し側で行う.
+	Get(ctx context.Context, id string) (*entity.UserSession, error)
+	ListActiveByUser(ctx context.Context, userID string) (entity.UserSessionList, error)
+	// Rotate は世代が generation のままなら世代を進め、端末情報と期限を更新する.
+	// 既に進んでいる / 失効済みなら false を返す.
+	Rotate(ctx context.Context, id string, generation int64, userAgent, ipAddress string, expiresAt time.Time) (bool, error)
+	TouchLastSeen(ctx context.Context, id string) error
+	// Revoke は userID のセッション id を失効させる. 該当する未失効のセッションが無ければ domain.ErrNotFound.
+	Revoke(ctx context.Context, userID, id string) error
+	// RevokeAllByUser は userID の exceptID 以外のセッションを失効させ、失効させた数を返す.
+	RevokeAllByUser(ctx context.Context, userID, exceptID string) (int64, error)
+	DeleteStale(ctx context.Context) error
+}
@@ -0,0 +1,54 @@
+package port
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/hantabaru1014/baru-reso-headless-controller/domain/entity"
+)
+
+// WebhookSubscriptionUpdateParams は nil のフィールドを変更しない.
+// EventTypes は nil なら変更せず、空 slice なら「全イベント」に置き換える.
+type WebhookSubscriptionUpdateParams struct {
+	Name       *string
+	URL        *string
+	Format     *entity.WebhookFormat
+	EventTypes []entity.WebhookEventType
+	Enabled    *bool
+}
+
+type WebhookDeliveryListResult struct {
+	Items      entity.WebhookDeliveryList
+	TotalCount int32
+}
+
+// WebhookRepository は Webhook の通知先と送信キューの永続化を担う.
+// 送信キューは AsyncJobRepository と同様に ClaimDueDeliveries / ReleaseStaleDeliveryClaims で
+// マルチインスタンス安全な claim を提供する.
+type WebhookRepository interface {
+	CreateSubscription(ctx context.Context, sub *entity.WebhookSubscription) error
+	GetSubscription(ctx context.Context, id string) (*entity.WebhookSubscription, error)
+	ListSubscriptionsByGroup(ctx context.Context, groupID string) (entity.WebhookSubscriptionList, error)
+	// ListSubscriptionsForEvent は groupID の有効な subscription のうち eventType を購読しているものを返す.
+	ListSubscriptionsForEvent(ctx context.Context, groupID string, eventType entity.WebhookEventType) (entity.WebhookSubscriptionList, error)
+	UpdateSubscription(ctx context.Context, id string, params WebhookSubscriptionUpdateParams) (*entity.WebhookSubscription, error)
+	UpdateSubscriptionSecret(ctx context.Context, id, secret string) error
+	DeleteSubscription(ctx context.Context, id string) error
+
+	EnqueueDelivery(ctx context.Context, subscriptionID string, eventType entity.WebhookEventType, body json.RawMessage) (*entity.WebhookDelivery, error)
+	// ClaimDueDeliveries は FOR UPDATE SKIP LOCKED で送信予定時刻を過ぎた PENDING を
+	// 最大 batchSize 件、原子的に SENDING へ遷移しながら取得する.
+	ClaimDueDeliveries(ctx context.Context, batchSize int32) (entity.WebhookDeliveryList, error)
+	// ReleaseStaleDeliveryClaims は送信中に instance が死んで SENDING のまま残った行を PENDING に戻す.
+	ReleaseStaleDeliveryClaims(ctx context.Context, staleAfter time.Duration) (int64, error)
+	MarkDeliverySucceeded(ctx context.Context, id int64, statusCode int32) error
+	// RetryDeliveryLater は失敗した送信を nextAttemptAt に再送するよう PENDING に戻す.
+	// statusCode は応答が無かった場合 nil.
+	RetryDeliveryLater(ctx context.Context, id int64, nextAttemptAt time.Time, statusCode *int32, errMessage string) error
+	MarkDeliveryFailed(ctx context.Context, id int64, statusCode *int32, errMessage string) error
+	// ListDeliveries は subscription の送信履歴を新しい順にページングして返す.
+	ListDeliveries(ctx context.Context, subscriptionID string, pageIndex, pageSize int32) (*WebhookDeliveryListResult, error)
+	// DeleteFinishedDeliveriesBefore は before より前に作られた送信済み / 失敗確定の履歴を消す.
+	DeleteFinishedDeliveriesBefore(ctx context.Context, before time.Time) (int64, error)
+}
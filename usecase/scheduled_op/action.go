@@ -17,11 +17,18 @@ type SessionOperator interface {
 	StartSession(ctx context.Context, hostID string, groupID string, userID *string, params *headlessv1.WorldStartupParameters, memo *string) (*entity.Session, error)
 	StopSession(ctx context.Context, sessionID string) error
 	UpdateSessionParameters(ctx context.Context, sessionID string, params *headlessv1.UpdateSessionParametersRequest) error
-	UpdateSessionExtraSettings(ctx context.Context, sessionID string, autoUpgrade *bool, memo *string) error
+	UpdateSessionExtraSettings(ctx context.Context, sessionID string, autoUpgrade *bool, memo *string, restoreOnCrash *bool) error
+}
+
+// SessionTemplateResolver はテンプレート指定の起動予約を実行時に解決する.
+// usecase.SessionTemplateUsecase がそのまま満たす.
+type SessionTemplateResolver interface {
+	ResolveStartupParameters(ctx context.Context, templateID, groupID string, overrides *headlessv1.WorldStartupParameters, overrideFields []string) (*headlessv1.WorldStartupParameters, error)
 }
 
 type ActionExecDeps struct {
-	Session SessionOperator
+	Session   SessionOperator
+	Templates SessionTemplateResolver
 }
 
 type Action interface {
@@ -16,12 +16,15 @@ func init() {
 }
 
 // StartSessionAction は START_SESSION 予約用. payload は WorldStartupParameters の protojson.
+// TemplateID がある場合、テンプレートは発火時点の内容で解決し、StartupParamsJSON は上書き分になる.
 type StartSessionAction struct {
 	HostID            string          `json:"host_id"`
 	GroupID           string          `json:"group_id"`
 	UserID            *string         `json:"user_id,omitempty"`
 	Memo              *string         `json:"memo,omitempty"`
 	StartupParamsJSON json.RawMessage `json:"startup_parameters"`
+	TemplateID        *string         `json:"template_id,omitempty"`
+	OverrideFields    []string        `json:"override_fields,omitempty"`
 }
 
 func NewStartSessionAction(hostID string, groupID string, userID *string, memo *string, params json.RawMessage) *StartSessionAction {
@@ -46,6 +49,19 @@ func (a *StartSessionAction) Execute(ctx context.Context, deps scheduled_op.Acti
 		}
 	}
 
+	if a.TemplateID != nil {
+		if deps.Templates == nil {
+			return errors.New("start session action: session templates are not available")
+		}
+
+		resolved, err := deps.Templates.ResolveStartupParameters(ctx, *a.TemplateID, a.GroupID, params, a.OverrideFields)
+		if err != nil {
+			return errors.WrapPrefix(err, "resolve session template", 0)
+		}
+
+		params = resolved
+	}
+
 	_, err := deps.Session.StartSession(ctx, a.HostID, a.GroupID, a.UserID, params, a.Memo)
 
 	return err
@@ -14,13 +14,14 @@ func init() {
 }
 
 type UpdateExtraSettingsAction struct {
-	SessionID   string  `json:"session_id"`
-	AutoUpgrade *bool   `json:"auto_upgrade,omitempty"`
-	Memo        *string `json:"memo,omitempty"`
+	SessionID      string  `json:"session_id"`
+	AutoUpgrade    *bool   `json:"auto_upgrade,omitempty"`
+	Memo           *string `json:"memo,omitempty"`
+	RestoreOnCrash *bool   `json:"restore_on_crash,omitempty"`
 }
 
-func NewUpdateExtraSettingsAction(sessionID string, autoUpgrade *bool, memo *string) *UpdateExtraSettingsAction {
-	return &UpdateExtraSettingsAction{SessionID: sessionID, AutoUpgrade: autoUpgrade, Memo: memo}
+func NewUpdateExtraSettingsAction(sessionID string, autoUpgrade *bool, memo *string, restoreOnCrash *bool) *UpdateExtraSettingsAction {
+	return &UpdateExtraSettingsAction{SessionID: sessionID, AutoUpgrade: autoUpgrade, Memo: memo, RestoreOnCrash: restoreOnCrash}
 }
 
 func (a *UpdateExtraSettingsAction) Type() entity.ScheduledOperationType {
@@ -28,7 +29,7 @@ func (a *UpdateExtraSettingsAction) Type() entity.ScheduledOperationType {
 }
 
 func (a *UpdateExtraSettingsAction) Execute(ctx context.Context, deps scheduled_op.ActionExecDeps) error {
-	return deps.Session.UpdateSessionExtraSettings(ctx, a.SessionID, a.AutoUpgrade, a.Memo)
+	return deps.Session.UpdateSessionExtraSettings(ctx, a.SessionID, a.AutoUpgrade, a.Memo, a.RestoreOnCrash)
 }
 
 func (a *UpdateExtraSettingsAction) Marshal() (json.RawMessage, error) {
@@ -50,7 +51,7 @@ func decodeUpdateExtra(payload json.RawMessage) (scheduled_op.Action, error) {
 		return nil, errors.New("update extra settings action: session_id is required")
 	}
 
-	if a.AutoUpgrade == nil && a.Memo == nil {
+	if a.AutoUpgrade == nil && a.Memo == nil && a.RestoreOnCrash == nil {
 		return nil, errors.New("update extra settings action: at least one field is required")
 	}
 
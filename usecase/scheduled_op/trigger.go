@@ -33,6 +33,14 @@ type Trigger interface {
 	Marshal() (json.RawMessage, error)
 }
 
+// RecurringTrigger は発火のたびに次回へ再設定される trigger.
+// executor は実行後に op を SUCCEEDED / FAILED にせず、Next の時刻で PENDING に戻す.
+type RecurringTrigger interface {
+	Trigger
+	// Next は after より後の次回発火時刻を返す. 以降発火しないなら zero.
+	Next(after time.Time) time.Time
+}
+
 type TriggerFactory func(json.RawMessage) (Trigger, error)
 
 var (
@@ -0,0 +1,103 @@
+package triggers
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/go-errors/errors"
+	"github.com/hantabaru1014/baru-reso-headless-controller/domain/entity"
+	"github.com/hantabaru1014/baru-reso-headless-controller/lib/cron"
+	"github.com/hantabaru1014/baru-reso-headless-controller/usecase/scheduled_op"
+)
+
+func init() {
+	scheduled_op.RegisterTrigger(entity.ScheduledTriggerType_CRON, decodeCronTrigger)
+}
+
+// CronTrigger は cron 式 (5 フィールド) で繰り返し発火する trigger.
+// 発火時刻は op の next_fire_at が持ち、実行後に executor が Next で次回に進める.
+type CronTrigger struct {
+	Expression string `json:"expression"`
+	// Timezone は IANA のタイムゾーン名. 空なら UTC.
+	Timezone string `json:"timezone,omitempty"`
+
+	schedule *cron.Schedule
+}
+
+var _ scheduled_op.RecurringTrigger = (*CronTrigger)(nil)
+
+// NewCronTrigger は式とタイムゾーンを検証して CronTrigger を作る.
+func NewCronTrigger(expression, timezone string) (*CronTrigger, error) {
+	t := &CronTrigger{Expression: expression, Timezone: timezone}
+	if err := t.parse(); err != nil {
+		return nil, err
+	}
+
+	if t.Next(time.Now()).IsZero() {
+		return nil, errors.Errorf("cron trigger: %q never fires", expression)
+	}
+
+	return t, nil
+}
+
+func (t *CronTrigger) parse() error {
+	loc := time.UTC
+
+	if t.Timezone != "" {
+		l, err := time.LoadLocation(t.Timezone)
+		if err != nil {
+			return errors.Errorf("cron trigger: invalid timezone %q: %w", t.Timezone, err)
+		}
+
+		loc = l
+	}
+
+	s, err := cron.Parse(t.Expression, loc)
+	if err != nil {
+		return errors.WrapPrefix(err, "cron trigger", 0)
+	}
+
+	t.schedule = s
+
+	return nil
+}
+
+func (t *CronTrigger) Type() entity.ScheduledTriggerType {
+	return entity.ScheduledTriggerType_CRON
+}
+
+// Evaluate は常に ready. worker が claim した時点で next_fire_at (= 今回の発火時刻) を過ぎている.
+func (t *CronTrigger) Evaluate(_ context.Context, _ scheduled_op.TriggerEvalDeps) (bool, time.Time, error) {
+	return true, time.Time{}, nil
+}
+
+func (t *CronTrigger) Next(after time.Time) time.Time {
+	return t.schedule.Next(after)
+}
+
+func (t *CronTrigger) Marshal() (json.RawMessage, error) {
+	b, err := json.Marshal(t)
+	if err != nil {
+		return nil, errors.Wrap(err, 0)
+	}
+
+	return b, nil
+}
+
+func decodeCronTrigger(cfg json.RawMessage) (scheduled_op.Trigger, error) {
+	t := &CronTrigger{}
+	if err := json.Unmarshal(cfg, t); err != nil {
+		return nil, errors.WrapPrefix(err, "cron trigger", 0)
+	}
+
+	if t.Expression == "" {
+		return nil, errors.New("cron trigger: expression is required")
+	}
+
+	if err := t.parse(); err != nil {
+		return nil, err
+	}
+
+	return t, nil
+}
@@ -99,18 +99,9 @@ func (u *ScheduledSessionOperationUsecase) Create(ctx context.Context, params Cr
 		return nil, errors.WrapPrefix(err, "marshal trigger", 0)
 	}
 
-	// next_fire_at は trigger.Evaluate(now=very past) でも nextCheck が返るが、
-	// 初回登録時は trigger 側に "登録時の next_fire_at" を聞くのが筋。今回は単純に
-	// Evaluate を 0 時刻で呼び出して nextCheck を貰う (TimeTrigger なら ScheduledAt が返る).
-	_, nextFire, err := params.Trigger.Evaluate(ctx, scheduled_op.TriggerEvalDeps{})
+	nextFire, err := initialNextFireAt(ctx, params.Trigger)
 	if err != nil {
-		return nil, errors.WrapPrefix(err, "trigger initial evaluate", 0)
-	}
-
-	if nextFire.IsZero() {
-		// Trigger が即座に ready を返した場合 (ScheduledAt が現在 <= now のケースなど)。
-		// 直近の worker tick で拾えるよう now をそのまま入れる.
-		nextFire = time.Now()
+		return nil, err
 	}
 
 	created, err := u.repo.Create(ctx, port.ScheduledSessionOperationCreateParams{
@@ -130,6 +121,36 @@ func (u *ScheduledSessionOperationUsecase) Create(ctx context.Context, params Cr
 	return created, nil
 }
 
+// initialNextFireAt は登録時の next_fire_at を決める.
+//nolint:funcorder // Create の直下にヘルパーを置く方が読みやすい
+func initialNextFireAt(ctx context.Context, trig scheduled_op.Trigger) (time.Time, error) {
+	// 繰り返し trigger は初回の発火時刻をそのまま聞く.
+	if rt, ok := trig.(scheduled_op.RecurringTrigger); ok {
+		next := rt.Next(time.Now())
+		if next.IsZero() {
+			return time.Time{}, errors.Errorf("trigger never fires: %w", domain.ErrInvalidArgument)
+		}
+
+		return next, nil
+	}
+
+	// next_fire_at は trigger.Evaluate(now=very past) でも nextCheck が返るが、
+	// 初回登録時は trigger 側に "登録時の next_fire_at" を聞くのが筋。今回は単純に
+	// Evaluate を 0 時刻で呼び出して nextCheck を貰う (TimeTrigger なら ScheduledAt が返る).
+	_, nextFire, err := trig.Evaluate(ctx, scheduled_op.TriggerEvalDeps{})
+	if err != nil {
+		return time.Time{}, errors.WrapPrefix(err, "trigger initial evaluate", 0)
+	}
+
+	if nextFire.IsZero() {
+		// Trigger が即座に ready を返した場合 (ScheduledAt が現在 <= now のケースなど)。
+		// 直近の worker tick で拾えるよう now をそのまま入れる.
+		nextFire = time.Now()
+	}
+
+	return nextFire, nil
+}
+
 func (u *ScheduledSessionOperationUsecase) Get(ctx context.Context, id string) (*entity.ScheduledSessionOperation, error) {
 	return u.repo.Get(ctx, id)
 }
@@ -238,21 +259,33 @@ func (u *ScheduledSessionOperationUsecase) listFilteredByGroups(ctx context.Cont
 	}, nil
 }
 
-var ErrScheduledOperationNotCancelable = errors.New("scheduled operation cannot be canceled in its current status")
+var (
+	ErrScheduledOperationNotCancelable = errors.New("scheduled operation cannot be canceled in its current status")
+	ErrScheduledOperationNotPending    = errors.New("scheduled operation is not pending")
+	ErrScheduledOperationNotPaused     = errors.New("scheduled operation is not paused")
+)
 
-func (u *ScheduledSessionOperationUsecase) Cancel(ctx context.Context, id string) error {
-	// 対象 op を引いて group_id を導出し session:write を要求.
+// getWithPermission は対象 op を引き、その host/session の group_id に対して permKey を要求する.
+func (u *ScheduledSessionOperationUsecase) getWithPermission(ctx context.Context, id, permKey string) (*entity.ScheduledSessionOperation, error) {
 	op, err := u.repo.Get(ctx, id)
 	if err != nil {
-		return errors.Wrap(err, 0)
+		return nil, errors.Wrap(err, 0)
 	}
 
 	groupID, err := u.resolveTargetGroupID(ctx, op.HostID, op.SessionID)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	if err := u.permUC.RequirePermissionForGroup(ctx, groupID, entity.PermKey_SessionWrite); err != nil {
+	if err := u.permUC.RequirePermissionForGroup(ctx, groupID, permKey); err != nil {
+		return nil, err
+	}
+
+	return op, nil
+}
+
+func (u *ScheduledSessionOperationUsecase) Cancel(ctx context.Context, id string) error {
+	if _, err := u.getWithPermission(ctx, id, entity.PermKey_SessionWrite); err != nil {
 		return err
 	}
 
@@ -268,6 +301,119 @@ func (u *ScheduledSessionOperationUsecase) Cancel(ctx context.Context, id string
 	return nil
 }
 
+// Pause は PENDING の op を一時停止する. 再開するまで発火しない.
+func (u *ScheduledSessionOperationUsecase) Pause(ctx context.Context, id string) (*entity.ScheduledSessionOperation, error) {
+	if _, err := u.getWithPermission(ctx, id, entity.PermKey_SessionWrite); err != nil {
+		return nil, err
+	}
+
+	ok, err := u.repo.Pause(ctx, id)
+	if err != nil {
+		return nil, errors.Wrap(err, 0)
+	}
+
+	if !ok {
+		return nil, ErrScheduledOperationNotPending
+	}
+
+	return u.repo.Get(ctx, id)
+}
+
+// Resume は一時停止中の op を再開する. 繰り返し予約は停止中に過ぎた回を実行せず、現在以降の次回から再開する.
+func (u *ScheduledSessionOperationUsecase) Resume(ctx context.Context, id string) (*entity.ScheduledSessionOperation, error) {
+	op, err := u.getWithPermission(ctx, id, entity.PermKey_SessionWrite)
+	if err != nil {
+		return nil, err
+	}
+
+	if op.Status != entity.ScheduledOperationStatus_PAUSED {
+		return nil, ErrScheduledOperationNotPaused
+	}
+
+	trig, err := scheduled_op.DecodeTrigger(op.TriggerType, op.TriggerConfig)
+	if err != nil {
+		return nil, errors.WrapPrefix(err, "decode trigger", 0)
+	}
+
+	var nextFire *time.Time
+
+	if rt, ok := trig.(scheduled_op.RecurringTrigger); ok {
+		next := rt.Next(time.Now())
+		if next.IsZero() {
+			return nil, errors.Errorf("trigger never fires; cancel it instead: %w", domain.ErrInvalidArgument)
+		}
+
+		nextFire = &next
+	}
+
+	ok, err := u.repo.Resume(ctx, id, nextFire)
+	if err != nil {
+		return nil, errors.Wrap(err, 0)
+	}
+
+	if !ok {
+		return nil, ErrScheduledOperationNotPaused
+	}
+
+	return u.repo.Get(ctx, id)
+}
+
+// SkipNext は繰り返し予約の次の 1 回を飛ばし、実行履歴に SKIPPED として残す.
+func (u *ScheduledSessionOperationUsecase) SkipNext(ctx context.Context, id string) (*entity.ScheduledSessionOperation, error) {
+	op, err := u.getWithPermission(ctx, id, entity.PermKey_SessionWrite)
+	if err != nil {
+		return nil, err
+	}
+
+	if op.Status != entity.ScheduledOperationStatus_PENDING {
+		return nil, ErrScheduledOperationNotPending
+	}
+
+	trig, err := scheduled_op.DecodeTrigger(op.TriggerType, op.TriggerConfig)
+	if err != nil {
+		return nil, errors.WrapPrefix(err, "decode trigger", 0)
+	}
+
+	rt, ok := trig.(scheduled_op.RecurringTrigger)
+	if !ok {
+		return nil, errors.Errorf("only recurring operations can skip an occurrence: %w", domain.ErrInvalidArgument)
+	}
+
+	next := rt.Next(op.NextFireAt)
+	if next.IsZero() {
+		return nil, errors.Errorf("no occurrence after the next one; cancel it instead: %w", domain.ErrInvalidArgument)
+	}
+
+	ok, err = u.repo.Skip(ctx, id, op.NextFireAt, next)
+	if err != nil {
+		return nil, errors.Wrap(err, 0)
+	}
+
+	if !ok {
+		// 読んでから更新するまでの間に worker が claim した.
+		return nil, ErrScheduledOperationNotPending
+	}
+
+	if err := u.repo.CreateRun(ctx, port.ScheduledOperationRunCreateParams{
+		OperationID:  id,
+		ScheduledFor: op.NextFireAt,
+		Outcome:      entity.ScheduledOperationRunOutcome_SKIPPED,
+	}); err != nil {
+		return nil, errors.Wrap(err, 0)
+	}
+
+	return u.repo.Get(ctx, id)
+}
+
+// ListRuns は op の実行履歴を新しい回から返す.
+func (u *ScheduledSessionOperationUsecase) ListRuns(ctx context.Context, id string, pageIndex, pageSize int32) (*port.ScheduledOperationRunListResult, error) {
+	if _, err := u.getWithPermission(ctx, id, entity.PermKey_SessionRead); err != nil {
+		return nil, err
+	}
+
+	return u.repo.ListRuns(ctx, id, pageIndex, pageSize)
+}
+
 // DecodeAction / DecodeTrigger は registry の薄いプロキシ. RPC handler から呼ぶ.
 func (u *ScheduledSessionOperationUsecase) DecodeAction(t entity.ScheduledOperationType, payload json.RawMessage) (scheduled_op.Action, error) {
 	return scheduled_op.DecodeAction(t, payload)
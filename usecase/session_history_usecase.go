@@ -0,0 +1,569 @@
+package usecase
+
+import (
+	"cmp"
+	"context"
+	"slices"
+	"time"
+
+	"github.com/go-errors/errors"
+	"github.com/hantabaru1014/baru-reso-headless-controller/domain"
+	"github.com/hantabaru1014/baru-reso-headless-controller/domain/entity"
+	"github.com/hantabaru1014/baru-reso-headless-controller/usecase/port"
+)
+
+const (
+	defaultSessionUserEventLimit = 100
+	maxSessionUserEventLimit     = 500
+	// maxSessionUsageBuckets は 1 回の集計で返すバケット数の上限.
+	// 1 時間単位なら 40 日ほど、1 日単位なら 2 年半ほどになる.
+	maxSessionUsageBuckets = 1000
+)
+
+// SessionHistoryUsecase はセッションへのユーザーの出入りの履歴と、そこから求める
+// 出席・利用状況を提供する.
+// 権限要件: セッションのグループに対する session:read. 複数グループにまたがる集計は
+// 対象グループの解決を呼び出し側 (resolveListGroupFilter) で行う.
+//
+// 出入りの event が欠けている場合 (host の event stream の再接続で取りこぼしたとき
+// など) は、入ったまま出ていないユーザーはセッションの終了まで居たものとし、
+// 入った記録のないユーザーはセッションの開始から居たものとして扱う.
+type SessionHistoryUsecase struct {
+	sessionRepo port.SessionRepository
+	eventRepo   port.SessionUserEventRepository
+	hostRepo    port.HeadlessHostRepository
+	now         func() time.Time
+}
+
+func NewSessionHistoryUsecase(sessionRepo port.SessionRepository, eventRepo port.SessionUserEventRepository, hostRepo port.HeadlessHostRepository) *SessionHistoryUsecase {
+	return &SessionHistoryUsecase{
+		sessionRepo: sessionRepo,
+		eventRepo:   eventRepo,
+		hostRepo:    hostRepo,
+		now:         time.Now,
+	}
+}
+
+// SessionUserEventPage は ListUserEvents の結果.
+type SessionUserEventPage struct {
+	Events entity.SessionUserEventList
+	// NextAfterID は続きがあるときに次の after_id に渡す値. 続きがなければ 0.
+	NextAfterID int64
+}
+
+// ListUserEvents はセッションの出入りを古い順に返す.
+func (u *SessionHistoryUsecase) ListUserEvents(ctx context.Context, sessionID string, afterID int64, limit int32) (*SessionUserEventPage, error) {
+	if afterID < 0 {
+		return nil, errors.Errorf("after_id must be >= 0: %w", domain.ErrInvalidArgument)
+	}
+
+	switch {
+	case limit <= 0:
+		limit = defaultSessionUserEventLimit
+	case limit > maxSessionUserEventLimit:
+		limit = maxSessionUserEventLimit
+	}
+
+	// limit+1 件取得して続きの有無を判定
+	events, err := u.eventRepo.ListBySession(ctx, sessionID, afterID, limit+1)
+	if err != nil {
+		return nil, errors.Wrap(err, 0)
+	}
+
+	page := &SessionUserEventPage{Events: events}
+	if len(events) > int(limit) {
+		page.Events = events[:limit]
+		page.NextAfterID = page.Events[len(page.Events)-1].ID
+	}
+
+	return page, nil
+}
+
+// SessionAttendee はセッションに来たユーザー 1 人分の出席.
+type SessionAttendee struct {
+	UserID   string
+	UserName string
+	// FirstJoinedAt は最初に入った時刻.
+	FirstJoinedAt time.Time
+	// LastLeftAt は最後に出た時刻. まだ居るなら nil.
+	LastLeftAt *time.Time
+	// Duration は滞在時間の合計.
+	Duration time.Duration
+	// JoinCount は入った回数.
+	JoinCount int32
+	// Present はいまセッションに居るかどうか.
+	Present bool
+}
+
+// SessionAttendance はセッション 1 つの出席の集計.
+type SessionAttendance struct {
+	// Attendees は滞在時間の長い順.
+	Attendees   []*SessionAttendee
+	UniqueUsers int32
+	// PeakUsers は同時に居たユーザー数の最大で、PeakAt は最初にその人数になった時刻.
+	PeakUsers int32
+	PeakAt    *time.Time
+	// TotalDuration は全員の滞在時間の合計.
+	TotalDuration time.Duration
+}
+
+// GetAttendance はセッションの出席を集計する.
+func (u *SessionHistoryUsecase) GetAttendance(ctx context.Context, sessionID string) (*SessionAttendance, error) {
+	session, err := u.sessionRepo.Get(ctx, sessionID)
+	if err != nil {
+		return nil, errors.Wrap(err, 0)
+	}
+
+	events, err := u.eventRepo.ListBySessionIDs(ctx, []string{sessionID})
+	if err != nil {
+		return nil, errors.Wrap(err, 0)
+	}
+
+	presences := sessionPresences(session, events, u.now())
+
+	attendance := &SessionAttendance{Attendees: []*SessionAttendee{}}
+	byUser := make(map[string]*SessionAttendee)
+
+	for _, p := range presences {
+		a, ok := byUser[p.userID]
+		if !ok {
+			a = &SessionAttendee{UserID: p.userID, FirstJoinedAt: p.from}
+			byUser[p.userID] = a
+			attendance.Attendees = append(attendance.Attendees, a)
+		}
+
+		a.UserName = p.userName
+		a.Duration += p.to.Sub(p.from)
+		a.JoinCount++
+		attendance.TotalDuration += p.to.Sub(p.from)
+
+		if p.open {
+			a.Present = true
+			a.LastLeftAt = nil
+		} else if !a.Present && (a.LastLeftAt == nil || p.to.After(*a.LastLeftAt)) {
+			to := p.to
+			a.LastLeftAt = &to
+		}
+	}
+
+	slices.SortStableFunc(attendance.Attendees, func(a, b *SessionAttendee) int {
+		return cmp.Or(cmp.Compare(b.Duration, a.Duration), a.FirstJoinedAt.Compare(b.FirstJoinedAt))
+	})
+
+	attendance.UniqueUsers = int32(len(attendance.Attendees)) //nolint:gosec // G115: 1 セッションのユーザー数は int32 に収まる
+
+	peak, peakAt := peakConcurrency(presences)
+	if peak > 0 {
+		attendance.PeakUsers = peak
+		attendance.PeakAt = &peakAt
+	}
+
+	return attendance, nil
+}
+
+// SessionUsageGroupBy は利用状況をまとめる単位.
+type SessionUsageGroupBy int32
+
+const (
+	SessionUsageGroupBy_World SessionUsageGroupBy = iota + 1
+	SessionUsageGroupBy_Host
+)
+
+// SessionUsageInterval は利用状況のバケットの幅.
+type SessionUsageInterval int32
+
+const (
+	SessionUsageInterval_Hour SessionUsageInterval = iota + 1
+	SessionUsageInterval_Day
+	SessionUsageInterval_Week
+)
+
+type SessionUsageParams struct {
+	// GroupIDs の扱いは SearchSessionsFilter と同じ.
+	GroupIDs []string
+	HostID   *string
+	Since    time.Time
+	Until    time.Time
+	GroupBy  SessionUsageGroupBy
+	Interval SessionUsageInterval
+	// Location はバケットの区切りに使うタイムゾーン. nil なら UTC.
+	// 週は月曜始まり.
+	Location *time.Location
+}
+
+// SessionUsageBucket は 1 つのバケットでの利用状況.
+type SessionUsageBucket struct {
+	Start time.Time
+	// Sessions はバケット中に開いていたセッションの数.
+	Sessions    int32
+	UniqueUsers int32
+	PeakUsers   int32
+	// UserDuration はバケット中の滞在時間の合計.
+	UserDuration time.Duration
+}
+
+// SessionUsageSeries はワールドまたはホスト 1 つ分の利用状況.
+type SessionUsageSeries struct {
+	// Key はワールドなら URL (プリセットなら "preset:" + 名前)、ホストならホスト ID.
+	Key string
+	// Label は表示名. ワールドなら期間中で最後に開いたセッションの名前、ホストならホスト名.
+	Label        string
+	Buckets      []*SessionUsageBucket
+	UniqueUsers  int32
+	UserDuration time.Duration
+}
+
+// GetUsageStats は期間中のセッションの利用状況を、ワールドまたはホストごとに
+// バケットに分けて集計する. 滞在時間の合計が長い順に返す.
+func (u *SessionHistoryUsecase) GetUsageStats(ctx context.Context, params SessionUsageParams) ([]*SessionUsageSeries, error) {
+	if params.Since.IsZero() || params.Until.IsZero() || !params.Since.Before(params.Until) {
+		return nil, errors.Errorf("since must be before until: %w", domain.ErrInvalidArgument)
+	}
+
+	if params.GroupBy != SessionUsageGroupBy_World && params.GroupBy != SessionUsageGroupBy_Host {
+		return nil, errors.Errorf("unknown group_by: %w", domain.ErrInvalidArgument)
+	}
+
+	loc := params.Location
+	if loc == nil {
+		loc = time.UTC
+	}
+
+	bounds, err := sessionUsageBucketBounds(params.Since, params.Until, params.Interval, loc)
+	if err != nil {
+		return nil, err
+	}
+
+	if params.GroupIDs != nil && len(params.GroupIDs) == 0 {
+		return []*SessionUsageSeries{}, nil
+	}
+
+	sessions, err := u.sessionRepo.ListActiveBetween(ctx, port.SessionActiveBetweenOptions{
+		Since:    params.Since,
+		Until:    params.Until,
+		HostID:   params.HostID,
+		GroupIDs: params.GroupIDs,
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, 0)
+	}
+
+	sessionIDs := make([]string, 0, len(sessions))
+	for _, s := range sessions {
+		sessionIDs = append(sessionIDs, s.ID)
+	}
+
+	events, err := u.eventRepo.ListBySessionIDs(ctx, sessionIDs)
+	if err != nil {
+		return nil, errors.Wrap(err, 0)
+	}
+
+	eventsBySession := make(map[string]entity.SessionUserEventList, len(sessions))
+	for _, e := range events {
+		eventsBySession[e.SessionID] = append(eventsBySession[e.SessionID], e)
+	}
+
+	now := u.now()
+	builders := make(map[string]*sessionUsageBuilder)
+
+	var order []*sessionUsageBuilder
+
+	for _, s := range sessions {
+		key, label := sessionUsageKey(s, params.GroupBy)
+
+		b, ok := builders[key]
+		if !ok {
+			b = newSessionUsageBuilder(key, bounds)
+			builders[key] = b
+			order = append(order, b)
+		}
+
+		// sessions は開始順なので、最後に開いたセッションの名前が残る
+		if label != "" {
+			b.series.Label = label
+		}
+
+		start, end := sessionSpan(s, eventsBySession[s.ID], now)
+		b.addSession(start, end)
+
+		for _, p := range sessionPresences(s, eventsBySession[s.ID], now) {
+			b.addPresence(p, params.Since, params.Until)
+		}
+	}
+
+	result := make([]*SessionUsageSeries, 0, len(order))
+	for _, b := range order {
+		result = append(result, b.build())
+	}
+
+	if params.GroupBy == SessionUsageGroupBy_Host {
+		for _, series := range result {
+			series.Label = series.Key
+
+			host, err := u.hostRepo.Find(ctx, series.Key, port.HeadlessHostFetchOptions{})
+			if err == nil && host.Name != "" {
+				series.Label = host.Name
+			} else if err != nil && !errors.Is(err, domain.ErrNotFound) {
+				return nil, errors.Wrap(err, 0)
+			}
+		}
+	}
+
+	slices.SortStableFunc(result, func(a, b *SessionUsageSeries) int {
+		return cmp.Compare(b.UserDuration, a.UserDuration)
+	})
+
+	return result, nil
+}
+
+// sessionUsageBucketBounds は [since, until) を覆うバケットの境界を返す.
+// 最初の境界は since を含むバケットの始まりで、最後の境界は until 以降.
+func sessionUsageBucketBounds(since, until time.Time, interval SessionUsageInterval, loc *time.Location) ([]time.Time, error) {
+	var next func(time.Time) time.Time
+
+	t := since.In(loc)
+
+	switch interval {
+	case SessionUsageInterval_Hour:
+		t = time.Date(t.Year(), t.Month(), t.Day(), t.Hour(), 0, 0, 0, loc)
+		next = func(t time.Time) time.Time { return t.Add(time.Hour) }
+	case SessionUsageInterval_Day:
+		t = time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, loc)
+		next = func(t time.Time) time.Time { return t.AddDate(0, 0, 1) }
+	case SessionUsageInterval_Week:
+		t = time.Date(t.Year(), t.Month(), t.Day()-(int(t.Weekday())+6)%7, 0, 0, 0, 0, loc)
+		next = func(t time.Time) time.Time { return t.AddDate(0, 0, 7) }
+	default:
+		return nil, errors.Errorf("unknown interval: %w", domain.ErrInvalidArgument)
+	}
+
+	bounds := []time.Time{t}
+	for t.Before(until) {
+		if len(bounds) > maxSessionUsageBuckets {
+			return nil, errors.Errorf("too many buckets (max %d): %w", maxSessionUsageBuckets, domain.ErrInvalidArgument)
+		}
+
+		t = next(t)
+		bounds = append(bounds, t)
+	}
+
+	return bounds, nil
+}
+
+// sessionUsageKey はセッションをまとめるキーと表示名を返す.
+func sessionUsageKey(s *entity.Session, groupBy SessionUsageGroupBy) (string, string) {
+	if groupBy == SessionUsageGroupBy_Host {
+		return s.HostID, ""
+	}
+
+	if url := s.StartupParameters.GetLoadWorldUrl(); url != "" {
+		return url, s.Name
+	}
+
+	if preset := s.StartupParameters.GetLoadWorldPresetName(); preset != "" {
+		return "preset:" + preset, s.Name
+	}
+
+	// host 側から直接開かれてワールドが分からないセッションは名前でまとめる
+	return "name:" + s.Name, s.Name
+}
+
+// sessionPresence はユーザーがセッションに居た 1 区間 [from, to).
+type sessionPresence struct {
+	userID   string
+	userName string
+	from     time.Time
+	to       time.Time
+	// open はまだ出ていない (セッションが開いたまま) こと.
+	open bool
+}
+
+// sessionSpan はセッションが開いていた区間を返す. 終了時刻が分からずに
+// 終わっているセッション (クラッシュなど) は最後の出入りで閉じたものとする.
+func sessionSpan(s *entity.Session, events entity.SessionUserEventList, now time.Time) (time.Time, time.Time) {
+	var start, end time.Time
+
+	if s.StartedAt != nil {
+		start = *s.StartedAt
+	} else if len(events) > 0 {
+		start = events[0].OccurredAt
+	}
+
+	switch {
+	case s.EndedAt != nil:
+		end = *s.EndedAt
+	case sessionIsOpen(s):
+		end = now
+	case len(events) > 0:
+		end = events[len(events)-1].OccurredAt
+	default:
+		end = start
+	}
+
+	return start, laterTime(start, end)
+}
+
+func sessionIsOpen(s *entity.Session) bool {
+	return s.EndedAt == nil && (s.Status == entity.SessionStatus_RUNNING || s.Status == entity.SessionStatus_STARTING)
+}
+
+// sessionPresences はセッション 1 つの出入り (発生順) をユーザーごとの滞在区間にする.
+func sessionPresences(s *entity.Session, events entity.SessionUserEventList, now time.Time) []sessionPresence {
+	start, end := sessionSpan(s, events, now)
+
+	var presences []sessionPresence
+
+	open := make(map[string]int)
+	seen := make(map[string]bool)
+
+	for _, e := range events {
+		switch e.Kind {
+		case entity.SessionUserEventKind_Joined:
+			if _, ok := open[e.UserID]; ok {
+				continue
+			}
+
+			open[e.UserID] = len(presences)
+			presences = append(presences, sessionPresence{userID: e.UserID, userName: e.UserName, from: e.OccurredAt})
+		case entity.SessionUserEventKind_Left:
+			if i, ok := open[e.UserID]; ok {
+				presences[i].to = laterTime(e.OccurredAt, presences[i].from)
+				delete(open, e.UserID)
+			} else if !seen[e.UserID] && e.OccurredAt.After(start) {
+				// 入った記録がないまま出た: 記録を始める前から居たとみなす
+				presences = append(presences, sessionPresence{userID: e.UserID, userName: e.UserName, from: start, to: e.OccurredAt})
+			}
+		case entity.SessionUserEventKind_Unknown:
+			continue
+		}
+
+		seen[e.UserID] = true
+	}
+
+	stillOpen := sessionIsOpen(s)
+	for _, i := range open {
+		presences[i].to = laterTime(end, presences[i].from)
+		presences[i].open = stillOpen
+	}
+
+	return presences
+}
+
+// peakConcurrency は同時に居た人数の最大と、最初にその人数になった時刻を返す.
+// 同じ時刻の出入りは出たほうを先に数える.
+func peakConcurrency(presences []sessionPresence) (int32, time.Time) {
+	type point struct {
+		at    time.Time
+		delta int32
+	}
+
+	points := make([]point, 0, len(presences)*2)
+	for _, p := range presences {
+		if !p.to.After(p.from) {
+			continue
+		}
+
+		points = append(points, point{p.from, 1}, point{p.to, -1})
+	}
+
+	slices.SortFunc(points, func(a, b point) int {
+		return cmp.Or(a.at.Compare(b.at), cmp.Compare(a.delta, b.delta))
+	})
+
+	var cur, peak int32
+
+	var peakAt time.Time
+
+	for _, pt := range points {
+		cur += pt.delta
+		if cur > peak {
+			peak, peakAt = cur, pt.at
+		}
+	}
+
+	return peak, peakAt
+}
+
+type sessionUsageBuilder struct {
+	series    *SessionUsageSeries
+	bounds    []time.Time
+	users     []map[string]struct{}
+	allUsers  map[string]struct{}
+	presences [][]sessionPresence
+}
+
+func newSessionUsageBuilder(key string, bounds []time.Time) *sessionUsageBuilder {
+	n := len(bounds) - 1
+
+	b := &sessionUsageBuilder{
+		series:    &SessionUsageSeries{Key: key, Buckets: make([]*SessionUsageBucket, n)},
+		bounds:    bounds,
+		users:     make([]map[string]struct{}, n),
+		allUsers:  make(map[string]struct{}),
+		presences: make([][]sessionPresence, n),
+	}
+	for i := range n {
+		b.series.Buckets[i] = &SessionUsageBucket{Start: bounds[i]}
+		b.users[i] = make(map[string]struct{})
+	}
+
+	return b
+}
+
+func (b *sessionUsageBuilder) addSession(start, end time.Time) {
+	for i, bucket := range b.series.Buckets {
+		if start.Before(b.bounds[i+1]) && end.After(bucket.Start) {
+			bucket.Sessions++
+		}
+	}
+}
+
+// addPresence は滞在区間を [since, until) と各バケットで切って足し込む.
+func (b *sessionUsageBuilder) addPresence(p sessionPresence, since, until time.Time) {
+	from, to := laterTime(p.from, since), earlierTime(p.to, until)
+	if !from.Before(to) {
+		return
+	}
+
+	b.allUsers[p.userID] = struct{}{}
+
+	for i, bucket := range b.series.Buckets {
+		bFrom, bTo := laterTime(from, bucket.Start), earlierTime(to, b.bounds[i+1])
+		if !bFrom.Before(bTo) {
+			continue
+		}
+
+		d := bTo.Sub(bFrom)
+		bucket.UserDuration += d
+		b.series.UserDuration += d
+		b.users[i][p.userID] = struct{}{}
+		b.presences[i] = append(b.presences[i], sessionPresence{userID: p.userID, from: bFrom, to: bTo})
+	}
+}
+
+func (b *sessionUsageBuilder) build() *SessionUsageSeries {
+	for i, bucket := range b.series.Buckets {
+		bucket.UniqueUsers = int32(len(b.users[i])) //nolint:gosec // G115: ユーザー数は int32 に収まる
+		bucket.PeakUsers, _ = peakConcurrency(b.presences[i])
+	}
+
+	b.series.UniqueUsers = int32(len(b.allUsers)) //nolint:gosec // G115: ユーザー数は int32 に収まる
+
+	return b.series
+}
+
+func laterTime(a, b time.Time) time.Time {
+	if a.After(b) {
+		return a
+	}
+
+	return b
+}
+
+func earlierTime(a, b time.Time) time.Time {
+	if a.Before(b) {
+		return a
+	}
+
+	return b
+}
@@ -0,0 +1,307 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/hantabaru1014/baru-reso-headless-controller/domain"
+	"github.com/hantabaru1014/baru-reso-headless-controller/domain/entity"
+	headlessv1 "github.com/hantabaru1014/baru-reso-headless-controller/pbgen/headless/v1"
+	"github.com/hantabaru1014/baru-reso-headless-controller/usecase/port"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// stubHistorySessionRepo は Get / ListActiveBetween だけを持つ.
+type stubHistorySessionRepo struct {
+	port.SessionRepository
+
+	sessions entity.SessionList
+	listed   *port.SessionActiveBetweenOptions
+}
+
+func (s *stubHistorySessionRepo) Get(_ context.Context, id string) (*entity.Session, error) {
+	for _, session := range s.sessions {
+		if session.ID == id {
+			return session, nil
+		}
+	}
+
+	return nil, domain.ErrNotFound
+}
+
+func (s *stubHistorySessionRepo) ListActiveBetween(_ context.Context, opts port.SessionActiveBetweenOptions) (entity.SessionList, error) {
+	s.listed = &opts
+
+	return s.sessions, nil
+}
+
+// stubUserEventRepo は発生順に並んだ出入りを返す.
+type stubUserEventRepo struct {
+	port.SessionUserEventRepository
+
+	events entity.SessionUserEventList
+}
+
+func (s *stubUserEventRepo) ListBySessionIDs(_ context.Context, sessionIDs []string) (entity.SessionUserEventList, error) {
+	var result entity.SessionUserEventList
+
+	for _, e := range s.events {
+		for _, id := range sessionIDs {
+			if e.SessionID == id {
+				result = append(result, e)
+			}
+		}
+	}
+
+	return result, nil
+}
+
+type stubNamedHostRepo struct {
+	port.HeadlessHostRepository
+
+	names map[string]string
+}
+
+func (s *stubNamedHostRepo) Find(_ context.Context, id string, _ port.HeadlessHostFetchOptions) (*entity.HeadlessHost, error) {
+	name, ok := s.names[id]
+	if !ok {
+		return nil, domain.ErrNotFound
+	}
+
+	return &entity.HeadlessHost{ID: id, Name: name}, nil
+}
+
+func TestSessionHistoryUsecase_GetAttendance(t *testing.T) {
+	t.Parallel()
+
+	base := time.Date(2026, 7, 4, 12, 0, 0, 0, time.UTC)
+	at := func(minutes int) time.Time { return base.Add(time.Duration(minutes) * time.Minute) }
+	ptr := func(t time.Time) *time.Time { return &t }
+	ev := func(sessionID string, kind entity.SessionUserEventKind, userID string, minutes int) *entity.SessionUserEvent {
+		return &entity.SessionUserEvent{SessionID: sessionID, Kind: kind, UserID: userID, UserName: "name-" + userID, OccurredAt: at(minutes)}
+	}
+
+	const (
+		joined = entity.SessionUserEventKind_Joined
+		left   = entity.SessionUserEventKind_Left
+	)
+
+	t.Run("滞在時間・入った回数・同時接続数を集計する", func(t *testing.T) {
+		t.Parallel()
+
+		sessions := &stubHistorySessionRepo{sessions: entity.SessionList{
+			{ID: "s1", Status: entity.SessionStatus_ENDED, StartedAt: ptr(at(0)), EndedAt: ptr(at(120))},
+		}}
+		events := &stubUserEventRepo{events: entity.SessionUserEventList{
+			ev("s1", joined, "alice", 10),
+			ev("s1", joined, "bob", 20),
+			ev("s1", left, "alice", 40),
+			ev("s1", joined, "carol", 40),
+			ev("s1", joined, "alice", 50),
+			ev("s1", left, "bob", 60),
+			ev("s1", left, "alice", 70),
+			// carol は出た記録がないまま終わった
+		}}
+		uc := NewSessionHistoryUsecase(sessions, events, nil)
+
+		attendance, err := uc.GetAttendance(t.Context(), "s1")
+		require.NoError(t, err)
+
+		assert.Equal(t, int32(3), attendance.UniqueUsers)
+		assert.Equal(t, int32(3), attendance.PeakUsers, "alice が出ると同時に carol が入った時点では 2 人のまま")
+		assert.Equal(t, at(50), *attendance.PeakAt)
+
+		require.Len(t, attendance.Attendees, 3)
+		carol, alice, bob := attendance.Attendees[0], attendance.Attendees[1], attendance.Attendees[2]
+
+		assert.Equal(t, "carol", carol.UserID)
+		assert.Equal(t, 80*time.Minute, carol.Duration, "セッションの終了まで居たものとする")
+		assert.Equal(t, at(120), *carol.LastLeftAt)
+		assert.False(t, carol.Present)
+
+		assert.Equal(t, "bob", bob.UserID)
+		assert.Equal(t, 40*time.Minute, bob.Duration)
+
+		assert.Equal(t, "alice", alice.UserID)
+		assert.Equal(t, "name-alice", alice.UserName)
+		assert.Equal(t, 50*time.Minute, alice.Duration)
+		assert.Equal(t, int32(2), alice.JoinCount)
+		assert.Equal(t, at(10), alice.FirstJoinedAt)
+		assert.Equal(t, at(70), *alice.LastLeftAt)
+
+		assert.Equal(t, 170*time.Minute, attendance.TotalDuration)
+	})
+
+	t.Run("開いているセッションでは居るユーザーを現在時刻まで数える", func(t *testing.T) {
+		t.Parallel()
+
+		sessions := &stubHistorySessionRepo{sessions: entity.SessionList{
+			{ID: "s1", Status: entity.SessionStatus_RUNNING, StartedAt: ptr(at(0))},
+		}}
+		events := &stubUserEventRepo{events: entity.SessionUserEventList{
+			// 入った記録を取りこぼした
+			ev("s1", left, "alice", 30),
+			ev("s1", joined, "bob", 30),
+			ev("s1", joined, "bob", 35),
+		}}
+		uc := NewSessionHistoryUsecase(sessions, events, nil)
+		uc.now = func() time.Time { return at(90) }
+
+		attendance, err := uc.GetAttendance(t.Context(), "s1")
+		require.NoError(t, err)
+
+		require.Len(t, attendance.Attendees, 2)
+		bob, alice := attendance.Attendees[0], attendance.Attendees[1]
+
+		assert.True(t, bob.Present)
+		assert.Nil(t, bob.LastLeftAt)
+		assert.Equal(t, 60*time.Minute, bob.Duration)
+		assert.Equal(t, int32(1), bob.JoinCount, "重複した入室は数えない")
+
+		assert.False(t, alice.Present)
+		assert.Equal(t, at(0), alice.FirstJoinedAt, "開始から居たものとする")
+		assert.Equal(t, 30*time.Minute, alice.Duration)
+
+		assert.Equal(t, int32(1), attendance.PeakUsers)
+	})
+
+	t.Run("誰も来ていなければピークは未設定", func(t *testing.T) {
+		t.Parallel()
+
+		sessions := &stubHistorySessionRepo{sessions: entity.SessionList{
+			{ID: "s1", Status: entity.SessionStatus_ENDED, StartedAt: ptr(at(0)), EndedAt: ptr(at(10))},
+		}}
+		uc := NewSessionHistoryUsecase(sessions, &stubUserEventRepo{}, nil)
+
+		attendance, err := uc.GetAttendance(t.Context(), "s1")
+		require.NoError(t, err)
+
+		assert.Empty(t, attendance.Attendees)
+		assert.Zero(t, attendance.PeakUsers)
+		assert.Nil(t, attendance.PeakAt)
+	})
+}
+
+func TestSessionHistoryUsecase_GetUsageStats(t *testing.T) {
+	t.Parallel()
+
+	tokyo, err := time.LoadLocation("Asia/Tokyo")
+	require.NoError(t, err)
+
+	// 2026-07-04 (土) 00:00 JST
+	base := time.Date(2026, 7, 4, 0, 0, 0, 0, tokyo)
+	at := func(hours float64) time.Time { return base.Add(time.Duration(hours * float64(time.Hour))) }
+	ptr := func(t time.Time) *time.Time { return &t }
+	world := func(url string) *headlessv1.WorldStartupParameters {
+		return &headlessv1.WorldStartupParameters{LoadWorld: &headlessv1.WorldStartupParameters_LoadWorldUrl{LoadWorldUrl: url}}
+	}
+	ev := func(sessionID string, kind entity.SessionUserEventKind, userID string, hours float64) *entity.SessionUserEvent {
+		return &entity.SessionUserEvent{SessionID: sessionID, Kind: kind, UserID: userID, OccurredAt: at(hours)}
+	}
+
+	const (
+		joined = entity.SessionUserEventKind_Joined
+		left   = entity.SessionUserEventKind_Left
+	)
+
+	sessions := entity.SessionList{
+		{ID: "s1", Name: "Meetup #1", HostID: "host-a", Status: entity.SessionStatus_ENDED, StartupParameters: world("resrec:///G-x/R-meetup"), StartedAt: ptr(at(20)), EndedAt: ptr(at(26))},
+		{ID: "s2", Name: "Meetup #2", HostID: "host-b", Status: entity.SessionStatus_ENDED, StartupParameters: world("resrec:///G-x/R-meetup"), StartedAt: ptr(at(45)), EndedAt: ptr(at(47))},
+		{ID: "s3", Name: "Lounge", HostID: "host-a", Status: entity.SessionStatus_ENDED, StartupParameters: world("resrec:///G-x/R-lounge"), StartedAt: ptr(at(22)), EndedAt: ptr(at(23))},
+	}
+	events := entity.SessionUserEventList{
+		// 日付をまたいで居た
+		ev("s1", joined, "alice", 22),
+		ev("s1", joined, "bob", 23),
+		ev("s1", left, "bob", 25),
+		ev("s1", left, "alice", 26),
+		ev("s2", joined, "alice", 45),
+		ev("s2", left, "alice", 46),
+		ev("s3", joined, "carol", 22),
+		ev("s3", left, "carol", 22.5),
+	}
+
+	t.Run("ワールドごとに日単位で集計する", func(t *testing.T) {
+		t.Parallel()
+
+		repo := &stubHistorySessionRepo{sessions: sessions}
+		uc := NewSessionHistoryUsecase(repo, &stubUserEventRepo{events: events}, nil)
+
+		series, err := uc.GetUsageStats(t.Context(), SessionUsageParams{
+			Since:    at(12),
+			Until:    at(72),
+			GroupBy:  SessionUsageGroupBy_World,
+			Interval: SessionUsageInterval_Day,
+			Location: tokyo,
+		})
+		require.NoError(t, err)
+
+		assert.Equal(t, at(12), repo.listed.Since)
+
+		require.Len(t, series, 2)
+		meetup, lounge := series[0], series[1]
+
+		assert.Equal(t, "resrec:///G-x/R-meetup", meetup.Key)
+		assert.Equal(t, "Meetup #2", meetup.Label, "最後に開いたセッションの名前")
+		assert.Equal(t, int32(2), meetup.UniqueUsers)
+		assert.Equal(t, 7*time.Hour, meetup.UserDuration)
+
+		require.Len(t, meetup.Buckets, 3)
+		assert.Equal(t, base, meetup.Buckets[0].Start, "since を含む日の 0 時 (JST) から区切る")
+		assert.Equal(t, []int32{1, 2, 0}, []int32{meetup.Buckets[0].Sessions, meetup.Buckets[1].Sessions, meetup.Buckets[2].Sessions})
+		assert.Equal(t, 3*time.Hour, meetup.Buckets[0].UserDuration)
+		assert.Equal(t, int32(2), meetup.Buckets[0].PeakUsers)
+		assert.Equal(t, 4*time.Hour, meetup.Buckets[1].UserDuration)
+		assert.Equal(t, int32(2), meetup.Buckets[1].UniqueUsers)
+		assert.Equal(t, int32(2), meetup.Buckets[1].PeakUsers)
+		assert.Zero(t, meetup.Buckets[2].UniqueUsers)
+
+		assert.Equal(t, "resrec:///G-x/R-lounge", lounge.Key)
+		assert.Equal(t, 30*time.Minute, lounge.UserDuration)
+		assert.Equal(t, int32(0), lounge.Buckets[1].Sessions)
+	})
+
+	t.Run("ホストごとにまとめてホスト名を付ける", func(t *testing.T) {
+		t.Parallel()
+
+		uc := NewSessionHistoryUsecase(
+			&stubHistorySessionRepo{sessions: sessions},
+			&stubUserEventRepo{events: events},
+			&stubNamedHostRepo{names: map[string]string{"host-a": "Host A"}},
+		)
+
+		series, err := uc.GetUsageStats(t.Context(), SessionUsageParams{
+			Since:    at(0),
+			Until:    at(24 * 7),
+			GroupBy:  SessionUsageGroupBy_Host,
+			Interval: SessionUsageInterval_Week,
+			Location: tokyo,
+		})
+		require.NoError(t, err)
+
+		require.Len(t, series, 2)
+		assert.Equal(t, "Host A", series[0].Label)
+		assert.Equal(t, 6*time.Hour+30*time.Minute, series[0].UserDuration)
+		assert.Equal(t, "host-b", series[1].Label, "削除されたホストは ID のまま")
+
+		require.Len(t, series[0].Buckets, 2)
+		assert.Equal(t, time.Date(2026, 6, 29, 0, 0, 0, 0, tokyo), series[0].Buckets[0].Start, "週は月曜始まり")
+	})
+
+	t.Run("不正な期間やバケットが多すぎる集計は弾く", func(t *testing.T) {
+		t.Parallel()
+
+		uc := NewSessionHistoryUsecase(&stubHistorySessionRepo{}, &stubUserEventRepo{}, nil)
+
+		_, err := uc.GetUsageStats(t.Context(), SessionUsageParams{Since: at(1), Until: at(1), GroupBy: SessionUsageGroupBy_World, Interval: SessionUsageInterval_Day})
+		require.ErrorIs(t, err, domain.ErrInvalidArgument)
+
+		_, err = uc.GetUsageStats(t.Context(), SessionUsageParams{Since: at(0), Until: at(24 * 365), GroupBy: SessionUsageGroupBy_World, Interval: SessionUsageInterval_Hour})
+		require.ErrorIs(t, err, domain.ErrInvalidArgument)
+
+		_, err = uc.GetUsageStats(t.Context(), SessionUsageParams{Since: at(0), Until: at(1), Interval: SessionUsageInterval_Hour})
+		require.ErrorIs(t, err, domain.ErrInvalidArgument)
+	})
+}
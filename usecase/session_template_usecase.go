@@ -0,0 +1,185 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/dchest/uniuri"
+	"github.com/go-errors/errors"
+	"github.com/hantabaru1014/baru-reso-headless-controller/domain"
+	"github.com/hantabaru1014/baru-reso-headless-controller/domain/entity"
+	headlessv1 "github.com/hantabaru1014/baru-reso-headless-controller/pbgen/headless/v1"
+	"github.com/hantabaru1014/baru-reso-headless-controller/usecase/port"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// SessionTemplateUsecase はグループのセッションテンプレートの管理と、
+// 起動時のテンプレート + 上書きの解決を提供する.
+// 権限要件: 一覧 / 取得 / 起動時の利用は対象グループの session:read,
+// 作成 / 更新 / 削除は session:write.
+type SessionTemplateUsecase struct {
+	repo   port.SessionTemplateRepository
+	permUC *PermissionUsecase
+}
+
+func NewSessionTemplateUsecase(repo port.SessionTemplateRepository, permUC *PermissionUsecase) *SessionTemplateUsecase {
+	return &SessionTemplateUsecase{repo: repo, permUC: permUC}
+}
+
+func (u *SessionTemplateUsecase) ListTemplates(ctx context.Context, groupID string) (entity.SessionTemplateList, error) {
+	if err := u.permUC.RequirePermissionForGroup(ctx, groupID, entity.PermKey_SessionRead); err != nil {
+		return nil, err
+	}
+
+	return u.repo.ListByGroup(ctx, groupID)
+}
+
+func (u *SessionTemplateUsecase) GetTemplate(ctx context.Context, id string) (*entity.SessionTemplate, error) {
+	return u.getWithPermission(ctx, id, entity.PermKey_SessionRead)
+}
+
+func (u *SessionTemplateUsecase) CreateTemplate(
+	ctx context.Context,
+	groupID, name, description string,
+	params *headlessv1.WorldStartupParameters,
+) (*entity.SessionTemplate, error) {
+	if err := u.permUC.RequirePermissionForGroup(ctx, groupID, entity.PermKey_SessionWrite); err != nil {
+		return nil, err
+	}
+
+	if name == "" {
+		return nil, errors.Errorf("session template name is required: %w", domain.ErrInvalidArgument)
+	}
+
+	userID, err := CurrentUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if params == nil {
+		params = &headlessv1.WorldStartupParameters{}
+	}
+
+	tmpl := &entity.SessionTemplate{
+		ID:          uniuri.New(),
+		GroupID:     groupID,
+		Name:        name,
+		Description: description,
+		Parameters:  params,
+		CreatedBy:   &userID,
+	}
+	if err := u.repo.Create(ctx, tmpl); err != nil {
+		return nil, err
+	}
+
+	return tmpl, nil
+}
+
+// UpdateTemplate は params の nil でないフィールドだけを更新する.
+func (u *SessionTemplateUsecase) UpdateTemplate(ctx context.Context, id string, params port.SessionTemplateUpdateParams) (*entity.SessionTemplate, error) {
+	if _, err := u.getWithPermission(ctx, id, entity.PermKey_SessionWrite); err != nil {
+		return nil, err
+	}
+
+	if params.Name != nil && *params.Name == "" {
+		return nil, errors.Errorf("session template name is required: %w", domain.ErrInvalidArgument)
+	}
+
+	return u.repo.Update(ctx, id, params)
+}
+
+func (u *SessionTemplateUsecase) DeleteTemplate(ctx context.Context, id string) error {
+	if _, err := u.getWithPermission(ctx, id, entity.PermKey_SessionWrite); err != nil {
+		return err
+	}
+
+	return u.repo.Delete(ctx, id)
+}
+
+// ResolveStartupParameters はテンプレートの parameters に overrides を重ねた起動パラメータを返す.
+// groupID は起動するセッションのグループで、テンプレートのグループと一致している必要がある.
+// 上書きの規則は applyStartupOverrides を参照.
+func (u *SessionTemplateUsecase) ResolveStartupParameters(
+	ctx context.Context,
+	templateID, groupID string,
+	overrides *headlessv1.WorldStartupParameters,
+	overrideFields []string,
+) (*headlessv1.WorldStartupParameters, error) {
+	tmpl, err := u.getWithPermission(ctx, templateID, entity.PermKey_SessionRead)
+	if err != nil {
+		return nil, err
+	}
+
+	if tmpl.GroupID != groupID {
+		return nil, errors.Errorf("session template belongs to another group: %w", domain.ErrInvalidArgument)
+	}
+
+	return applyStartupOverrides(tmpl.Parameters, overrides, overrideFields)
+}
+
+// getWithPermission はテンプレートを取得し、そのグループに対する permKey を最終ガードする.
+func (u *SessionTemplateUsecase) getWithPermission(ctx context.Context, id, permKey string) (*entity.SessionTemplate, error) {
+	tmpl, err := u.repo.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := u.permUC.RequirePermissionForGroup(ctx, tmpl.GroupID, permKey); err != nil {
+		return nil, err
+	}
+
+	return tmpl, nil
+}
+
+// applyStartupOverrides は base のコピーに overrides を重ねる. base / overrides は変更しない.
+// fields が空なら overrides で値が入っているフィールドをすべて置き換える (proto3 の
+// presence の無い bool / 数値は false / 0 だと「値なし」扱いになる).
+// fields を指定した場合はそのトップレベルのフィールドだけを overrides の値 (値なしならゼロ値) で置き換える.
+// repeated フィールドは追記ではなく置き換え.
+func applyStartupOverrides(
+	base, overrides *headlessv1.WorldStartupParameters,
+	fields []string,
+) (*headlessv1.WorldStartupParameters, error) {
+	merged := &headlessv1.WorldStartupParameters{}
+	if base != nil {
+		proto.Merge(merged, base)
+	}
+
+	src := (&headlessv1.WorldStartupParameters{}).ProtoReflect()
+	if overrides != nil {
+		src = proto.Clone(overrides).ProtoReflect()
+	}
+
+	dst := merged.ProtoReflect()
+
+	if len(fields) == 0 {
+		src.Range(func(fd protoreflect.FieldDescriptor, v protoreflect.Value) bool {
+			dst.Set(fd, v)
+
+			return true
+		})
+
+		return merged, nil
+	}
+
+	descs := dst.Descriptor().Fields()
+
+	for _, name := range fields {
+		fd := descs.ByName(protoreflect.Name(name))
+		if fd == nil {
+			fd = descs.ByJSONName(name)
+		}
+
+		if fd == nil {
+			return nil, errors.Errorf("unknown startup parameter field %q: %w", name, domain.ErrInvalidArgument)
+		}
+
+		if src.Has(fd) {
+			dst.Set(fd, src.Get(fd))
+		} else {
+			dst.Clear(fd)
+		}
+	}
+
+	return merged, nil
+}
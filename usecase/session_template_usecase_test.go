@@ -0,0 +1,91 @@
+package usecase
+
+import (
+	"testing"
+
+	"github.com/hantabaru1014/baru-reso-headless-controller/domain"
+	headlessv1 "github.com/hantabaru1014/baru-reso-headless-controller/pbgen/headless/v1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestApplyStartupOverrides(t *testing.T) {
+	t.Parallel()
+
+	base := &headlessv1.WorldStartupParameters{
+		Name:                proto.String("Weekly Meetup"),
+		MaxUsers:            proto.Int32(16),
+		AccessLevel:         headlessv1.AccessLevel_ACCESS_LEVEL_CONTACTS,
+		LoadWorld:           &headlessv1.WorldStartupParameters_LoadWorldUrl{LoadWorldUrl: "resrec:///U-a/R-1"},
+		AutoInviteUsernames: []string{"alice", "bob"},
+		SaveOnExit:          true,
+	}
+	baseCopy := proto.Clone(base)
+
+	t.Run("値が入っているフィールドだけを上書きする", func(t *testing.T) {
+		t.Parallel()
+
+		got, err := applyStartupOverrides(base, &headlessv1.WorldStartupParameters{
+			Name:                proto.String("Weekly Meetup #12"),
+			AutoInviteUsernames: []string{"carol"},
+			SaveOnExit:          false,
+		}, nil)
+		require.NoError(t, err)
+
+		assert.Equal(t, "Weekly Meetup #12", got.GetName())
+		assert.Equal(t, int32(16), got.GetMaxUsers())
+		assert.Equal(t, []string{"carol"}, got.GetAutoInviteUsernames(), "リストは追記ではなく置き換え")
+		assert.True(t, got.GetSaveOnExit(), "false は値なし扱いなので上書きされない")
+	})
+
+	t.Run("override_fields に挙げたフィールドはゼロ値でも上書きする", func(t *testing.T) {
+		t.Parallel()
+
+		got, err := applyStartupOverrides(base, &headlessv1.WorldStartupParameters{
+			MaxUsers: proto.Int32(4),
+			Name:     proto.String("ignored"),
+		}, []string{"save_on_exit", "autoInviteUsernames", "max_users"})
+		require.NoError(t, err)
+
+		assert.Equal(t, "Weekly Meetup", got.GetName(), "挙げていないフィールドは変えない")
+		assert.Equal(t, int32(4), got.GetMaxUsers())
+		assert.False(t, got.GetSaveOnExit())
+		assert.Empty(t, got.GetAutoInviteUsernames())
+	})
+
+	t.Run("oneof はもう片方を消す", func(t *testing.T) {
+		t.Parallel()
+
+		got, err := applyStartupOverrides(base, &headlessv1.WorldStartupParameters{
+			LoadWorld: &headlessv1.WorldStartupParameters_LoadWorldPresetName{LoadWorldPresetName: "Grid"},
+		}, nil)
+		require.NoError(t, err)
+
+		assert.Equal(t, "Grid", got.GetLoadWorldPresetName())
+		assert.Empty(t, got.GetLoadWorldUrl())
+	})
+
+	t.Run("知らないフィールド名は InvalidArgument", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := applyStartupOverrides(base, nil, []string{"no_such_field"})
+		require.ErrorIs(t, err, domain.ErrInvalidArgument)
+	})
+
+	t.Run("上書きがなければテンプレートのまま", func(t *testing.T) {
+		t.Parallel()
+
+		got, err := applyStartupOverrides(base, nil, nil)
+		require.NoError(t, err)
+		assert.True(t, proto.Equal(base, got))
+	})
+
+	t.Run("テンプレートを書き換えない", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := applyStartupOverrides(base, &headlessv1.WorldStartupParameters{MaxUsers: proto.Int32(1)}, nil)
+		require.NoError(t, err)
+		assert.True(t, proto.Equal(baseCopy, base))
+	})
+}
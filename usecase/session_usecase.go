@@ -545,9 +545,9 @@ func (u *SessionUsecase) SaveSessionWorld(ctx context.Context, id string, saveMo
 	}
 }
 
-// UpdateSessionExtraSettings は memo / auto_upgrade を更新する.
+// UpdateSessionExtraSettings は memo / auto_upgrade / restore_on_crash を更新する.
 // 内部的に GetSession (cache hydrate 込み) → Upsert で書き戻す.
-func (u *SessionUsecase) UpdateSessionExtraSettings(ctx context.Context, sessionID string, autoUpgrade *bool, memo *string) error {
+func (u *SessionUsecase) UpdateSessionExtraSettings(ctx context.Context, sessionID string, autoUpgrade *bool, memo *string, restoreOnCrash *bool) error {
 	s, err := u.GetSession(ctx, sessionID)
 	if err != nil {
 		return errors.Wrap(err, 0)
@@ -565,6 +565,10 @@ func (u *SessionUsecase) UpdateSessionExtraSettings(ctx context.Context, session
 		s.Memo = *memo
 	}
 
+	if restoreOnCrash != nil {
+		s.RestoreOnCrash = *restoreOnCrash
+	}
+
 	if err := u.sessionRepo.Upsert(ctx, s); err != nil {
 		return errors.Wrap(err, 0)
 	}
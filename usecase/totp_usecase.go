@@ -0,0 +1,635 @@
+package usecase
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"log/slog"
+	"strings"
+	"time"
+
+	"github.com/dchest/uniuri"
+	"github.com/go-errors/errors"
+	"github.com/hantabaru1014/baru-reso-headless-controller/db"
+	"github.com/hantabaru1014/baru-reso-headless-controller/domain"
+	"github.com/hantabaru1014/baru-reso-headless-controller/domain/entity"
+	"github.com/hantabaru1014/baru-reso-headless-controller/lib/auth"
+	"github.com/hantabaru1014/baru-reso-headless-controller/lib/totp"
+	"github.com/hantabaru1014/baru-reso-headless-controller/usecase/port"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+const (
+	// totpChallengeTTL はパスワード確認後にコードの入力を待つ時間.
+	totpChallengeTTL         = 5 * time.Minute
+	totpChallengeMaxAttempts = 5
+	totpChallengeTokenLength = 32
+
+	totpRecoveryCodeCount = 10
+	// totpRecoveryCodeLength は区切りを除いた文字数 (base32 で 80 bit).
+	totpRecoveryCodeLength = 16
+	totpRecoveryCodeGroup  = 4
+	totpRecoveryCodeChars  = "abcdefghijklmnopqrstuvwxyz234567"
+)
+
+var (
+	// ErrTOTPAlreadyEnabled は登録済みのユーザーが登録をやり直そうとしたときに返す.
+	ErrTOTPAlreadyEnabled = errors.New("totp is already enabled")
+	// ErrTOTPNotEnabled は未登録のユーザーが無効化しようとしたときに返す.
+	ErrTOTPNotEnabled = errors.New("totp is not enabled")
+	// ErrTOTPRequired は 2 段階認証が必須のユーザーが登録せずにサインインしようとしたとき /
+	// 無効化しようとしたときに返す.
+	ErrTOTPRequired = errors.New("totp is required for this user")
+)
+
+// TOTPOptions は 2 段階認証の設定.
+type TOTPOptions struct {
+	// Issuer は認証アプリに表示するサービス名.
+	Issuer string
+	// RequiredForSystemRoles が true なら system グループのメンバーは TOTP を登録しないと
+	// パスワードでサインインできない.
+	RequiredForSystemRoles bool
+}
+
+// TOTPUsecase は認証アプリ (TOTP) による 2 段階認証を提供する.
+// パスワードを確認した後は TOTP のコードを受け取るまでトークンを発行せず、
+// 代わりに短命の challenge を返す.
+// 権限要件: 自分の 2 段階認証の操作のみ (特別な権限は不要).
+type TOTPUsecase struct {
+	queries    *db.Queries
+	pool       *pgxpool.Pool
+	cipher     port.TOTPSecretCipher
+	memberRepo port.GroupMemberRepository
+	opts       TOTPOptions
+}
+
+func NewTOTPUsecase(queries *db.Queries, pool *pgxpool.Pool, cipher port.TOTPSecretCipher, memberRepo port.GroupMemberRepository, opts TOTPOptions) *TOTPUsecase {
+	return &TOTPUsecase{
+		queries:    queries,
+		pool:       pool,
+		cipher:     cipher,
+		memberRepo: memberRepo,
+		opts:       opts,
+	}
+}
+
+// TOTPChallenge はパスワード確認後に返す、コードの入力待ちのログイン.
+type TOTPChallenge struct {
+	Token string
+	// EnrollmentRequired が true なら、先に Token を使って TOTP を登録する必要がある.
+	EnrollmentRequired bool
+}
+
+// BeginLogin はパスワードを確認したユーザーに 2 段階認証が必要なら challenge を返す.
+// 不要なら nil を返すので、呼び出し側はそのままトークンを発行してよい.
+func (u *TOTPUsecase) BeginLogin(ctx context.Context, userID string) (*TOTPChallenge, error) {
+	enabled, err := u.isEnabled(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	enrollmentRequired := false
+
+	if !enabled {
+		required, err := u.isRequired(ctx, userID)
+		if err != nil {
+			return nil, err
+		}
+
+		if !required {
+			return nil, nil //nolint:nilnil // challenge 不要
+		}
+
+		enrollmentRequired = true
+	}
+
+	token, err := generateSecureToken(totpChallengeTokenLength)
+	if err != nil {
+		return nil, errors.Wrap(err, 0)
+	}
+
+	// 放置された challenge はここでついでに掃除する. 失敗してもログインは続ける.
+	if _, err := u.queries.DeleteExpiredTotpLoginChallenges(ctx); err != nil {
+		slog.Warn("failed to delete expired totp login challenges", "error", err)
+	}
+
+	if err := u.queries.CreateTotpLoginChallenge(ctx, db.CreateTotpLoginChallengeParams{
+		TokenHash: hashTOTPValue(token),
+		UserID:    userID,
+		ExpiresAt: pgtype.Timestamptz{Time: time.Now().Add(totpChallengeTTL), Valid: true},
+	}); err != nil {
+		return nil, errors.Wrap(err, 0)
+	}
+
+	return &TOTPChallenge{Token: token, EnrollmentRequired: enrollmentRequired}, nil
+}
+
+// VerifyLogin は challenge に対して TOTP のコードかリカバリーコードを確認し、
+// サインインするユーザーを返す. 1 つの challenge で試せるのは totpChallengeMaxAttempts 回まで.
+func (u *TOTPUsecase) VerifyLogin(ctx context.Context, challenge, code string) (*db.User, error) {
+	if challenge == "" || code == "" {
+		return nil, errors.Errorf("challenge and code are required: %w", domain.ErrInvalidArgument)
+	}
+
+	tokenHash := hashTOTPValue(challenge)
+
+	ch, err := u.queries.CountTotpLoginChallengeAttempt(ctx, db.CountTotpLoginChallengeAttemptParams{
+		TokenHash:   tokenHash,
+		MaxAttempts: totpChallengeMaxAttempts,
+	})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, errors.Errorf("totp challenge is unknown, expired or exhausted: %w", domain.ErrUnauthenticated)
+		}
+
+		return nil, errors.Wrap(err, 0)
+	}
+
+	t, err := u.queries.GetUserTotp(ctx, ch.UserID)
+	if err != nil && !errors.Is(err, pgx.ErrNoRows) {
+		return nil, errors.Wrap(err, 0)
+	}
+
+	if err != nil || !t.EnabledAt.Valid {
+		return nil, errors.Wrap(ErrTOTPRequired, 0)
+	}
+
+	ok, err := u.verifyCode(ctx, t, code)
+	if err != nil {
+		return nil, err
+	}
+
+	if !ok {
+		return nil, errors.Errorf("invalid totp code: %w", domain.ErrUnauthenticated)
+	}
+
+	if err := u.queries.DeleteTotpLoginChallenge(ctx, tokenHash); err != nil {
+		return nil, errors.Wrap(err, 0)
+	}
+
+	user, err := u.queries.GetUser(ctx, ch.UserID)
+	if err != nil {
+		return nil, errors.Wrap(err, 0)
+	}
+
+	return &user, nil
+}
+
+// TOTPStatus は 2 段階認証の状態.
+type TOTPStatus struct {
+	Enabled  bool
+	Required bool
+	// RemainingRecoveryCodes は未使用のリカバリーコードの数.
+	RemainingRecoveryCodes int
+}
+
+// GetStatus は caller 自身の 2 段階認証の状態を返す.
+func (u *TOTPUsecase) GetStatus(ctx context.Context) (*TOTPStatus, error) {
+	userID, err := CurrentUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	status := &TOTPStatus{}
+
+	status.Enabled, err = u.isEnabled(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	status.Required, err = u.isRequired(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	if status.Enabled {
+		n, err := u.queries.CountUnusedTotpRecoveryCodes(ctx, userID)
+		if err != nil {
+			return nil, errors.Wrap(err, 0)
+		}
+
+		status.RemainingRecoveryCodes = int(n)
+	}
+
+	return status, nil
+}
+
+// TOTPEnrollment は認証アプリに登録する secret.
+type TOTPEnrollment struct {
+	// Secret は手入力用の base32 文字列.
+	Secret string
+	// KeyURI は QR コードにする otpauth:// URI.
+	KeyURI string
+}
+
+// BeginEnrollment は新しい secret を発行する. ConfirmEnrollment で最初のコードを
+// 確認するまでは有効にならず、呼び直すと secret は作り直される.
+// challenge を渡すと、登録が必須でまだサインインできないユーザーとして登録する.
+func (u *TOTPUsecase) BeginEnrollment(ctx context.Context, challenge string) (*TOTPEnrollment, error) {
+	userID, err := u.enrollingUserID(ctx, challenge, false)
+	if err != nil {
+		return nil, err
+	}
+
+	secret, err := totp.GenerateSecret()
+	if err != nil {
+		return nil, err
+	}
+
+	sealed, err := u.cipher.Seal(userID, secret)
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := u.queries.UpsertPendingUserTotp(ctx, db.UpsertPendingUserTotpParams{
+		UserID:          userID,
+		SecretKeyID:     sealed.KeyID,
+		SecretDataKey:   sealed.DataKey,
+		EncryptedSecret: sealed.Ciphertext,
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, 0)
+	}
+
+	if rows == 0 {
+		return nil, errors.Wrap(ErrTOTPAlreadyEnabled, 0)
+	}
+
+	return &TOTPEnrollment{
+		Secret: totp.EncodeSecret(secret),
+		KeyURI: totp.KeyURI(u.opts.Issuer, userID, secret),
+	}, nil
+}
+
+// TOTPEnrollmentResult は ConfirmEnrollment の結果.
+type TOTPEnrollmentResult struct {
+	// RecoveryCodes はここでしか取得できない.
+	RecoveryCodes []string
+	// User は challenge で登録したときだけセットされ、そのままサインインさせてよい.
+	User *db.User
+}
+
+// ConfirmEnrollment は認証アプリのコードを確認して 2 段階認証を有効にし、
+// リカバリーコードを発行する. challenge で登録する場合は totpChallengeMaxAttempts 回まで試せる.
+func (u *TOTPUsecase) ConfirmEnrollment(ctx context.Context, challenge, code string) (*TOTPEnrollmentResult, error) {
+	userID, err := u.enrollingUserID(ctx, challenge, true)
+	if err != nil {
+		return nil, err
+	}
+
+	t, err := u.queries.GetUserTotp(ctx, userID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, errors.Errorf("totp enrollment has not been started: %w", domain.ErrInvalidArgument)
+		}
+
+		return nil, errors.Wrap(err, 0)
+	}
+
+	if t.EnabledAt.Valid {
+		return nil, errors.Wrap(ErrTOTPAlreadyEnabled, 0)
+	}
+
+	secret, err := u.openSecret(t)
+	if err != nil {
+		return nil, err
+	}
+
+	step, err := totp.Validate(secret, code, time.Now(), 0)
+	if err != nil {
+		return nil, errors.Errorf("invalid totp code: %w", domain.ErrInvalidArgument)
+	}
+
+	codes := make([]string, 0, totpRecoveryCodeCount)
+
+	err = db.RunInTx(ctx, u.pool, func(tx pgx.Tx) error {
+		qtx := u.queries.WithTx(tx)
+
+		rows, err := qtx.EnableUserTotp(ctx, db.EnableUserTotpParams{UserID: userID, LastUsedStep: step})
+		if err != nil {
+			return errors.Wrap(err, 0)
+		}
+
+		if rows == 0 {
+			return errors.Wrap(ErrTOTPAlreadyEnabled, 0)
+		}
+
+		if err := qtx.DeleteTotpRecoveryCodes(ctx, userID); err != nil {
+			return errors.Wrap(err, 0)
+		}
+
+		for range totpRecoveryCodeCount {
+			code := generateRecoveryCode()
+			if err := qtx.CreateTotpRecoveryCode(ctx, db.CreateTotpRecoveryCodeParams{
+				UserID:   userID,
+				CodeHash: hashTOTPValue(normalizeRecoveryCode(code)),
+			}); err != nil {
+				return errors.Wrap(err, 0)
+			}
+
+			codes = append(codes, code)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	slog.Info("enabled totp", "user_id", userID)
+
+	result := &TOTPEnrollmentResult{RecoveryCodes: codes}
+
+	if challenge != "" {
+		if err := u.queries.DeleteTotpLoginChallenge(ctx, hashTOTPValue(challenge)); err != nil {
+			return nil, errors.Wrap(err, 0)
+		}
+
+		user, err := u.queries.GetUser(ctx, userID)
+		if err != nil {
+			return nil, errors.Wrap(err, 0)
+		}
+
+		result.User = &user
+	}
+
+	return result, nil
+}
+
+// Disable は caller 自身の 2 段階認証を無効にする. 本人確認のため TOTP のコードか
+// リカバリーコードが必要. 登録が必須のユーザーは無効にできない.
+func (u *TOTPUsecase) Disable(ctx context.Context, code string) error {
+	userID, err := u.selfUserID(ctx)
+	if err != nil {
+		return err
+	}
+
+	t, err := u.queries.GetUserTotp(ctx, userID)
+	if err != nil && !errors.Is(err, pgx.ErrNoRows) {
+		return errors.Wrap(err, 0)
+	}
+
+	if err != nil || !t.EnabledAt.Valid {
+		return errors.Wrap(ErrTOTPNotEnabled, 0)
+	}
+
+	required, err := u.isRequired(ctx, userID)
+	if err != nil {
+		return err
+	}
+
+	if required {
+		return errors.Wrap(ErrTOTPRequired, 0)
+	}
+
+	ok, err := u.verifyCode(ctx, t, code)
+	if err != nil {
+		return err
+	}
+
+	if !ok {
+		return errors.Errorf("invalid totp code: %w", domain.ErrInvalidArgument)
+	}
+
+	if err := u.queries.DeleteUserTotp(ctx, userID); err != nil {
+		return errors.Wrap(err, 0)
+	}
+
+	slog.Info("disabled totp", "user_id", userID)
+
+	return nil
+}
+
+// Reset は端末とリカバリーコードを失くしたユーザーの 2 段階認証を消す.
+// `brhcli user reset-totp` 専用で、権限チェックは行わない.
+func (u *TOTPUsecase) Reset(ctx context.Context, userID string) error {
+	if _, err := u.queries.GetUser(ctx, userID); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return errors.Wrap(domain.ErrNotFound, 0)
+		}
+
+		return errors.Wrap(err, 0)
+	}
+
+	if err := u.queries.DeleteUserTotp(ctx, userID); err != nil {
+		return errors.Wrap(err, 0)
+	}
+
+	slog.Info("reset totp", "user_id", userID)
+
+	return nil
+}
+
+// ReencryptSecrets は primary 以外の鍵で暗号化された secret を primary の鍵に掛け直す.
+// `brhcli accounts reencrypt` 専用で、権限チェックは行わない.
+// skipped は読んでから書くまでの間に登録し直された行の数.
+func (u *TOTPUsecase) ReencryptSecrets(ctx context.Context) (rewrapped, skipped int, err error) {
+	list, err := u.queries.ListUserTotp(ctx)
+	if err != nil {
+		return 0, 0, errors.Wrap(err, 0)
+	}
+
+	for _, t := range list {
+		sealed, changed, err := u.cipher.Rewrap(t.UserID, sealedTOTPSecret(t))
+		if err != nil {
+			return rewrapped, skipped, errors.Wrap(err, 0)
+		}
+
+		if !changed {
+			continue
+		}
+
+		n, err := u.queries.RewrapUserTotpSecret(ctx, db.RewrapUserTotpSecretParams{
+			UserID:          t.UserID,
+			SecretKeyID:     sealed.KeyID,
+			SecretDataKey:   sealed.DataKey,
+			EncryptedSecret: sealed.Ciphertext,
+			OldKeyID:        t.SecretKeyID,
+		})
+		if err != nil {
+			return rewrapped, skipped, errors.Wrap(err, 0)
+		}
+
+		if n == 0 {
+			skipped++
+		} else {
+			rewrapped++
+		}
+	}
+
+	return rewrapped, skipped, nil
+}
+
+// verifyCode は 6 桁の数字なら TOTP のコードとして、それ以外はリカバリーコードとして確認する.
+// どちらも 1 回しか通らない.
+func (u *TOTPUsecase) verifyCode(ctx context.Context, t db.UserTotp, code string) (bool, error) {
+	code = strings.TrimSpace(code)
+
+	if !isTOTPCode(code) {
+		rows, err := u.queries.UseTotpRecoveryCode(ctx, db.UseTotpRecoveryCodeParams{
+			UserID:   t.UserID,
+			CodeHash: hashTOTPValue(normalizeRecoveryCode(code)),
+		})
+		if err != nil {
+			return false, errors.Wrap(err, 0)
+		}
+
+		if rows == 1 {
+			slog.Info("used totp recovery code", "user_id", t.UserID)
+		}
+
+		return rows == 1, nil
+	}
+
+	secret, err := u.openSecret(t)
+	if err != nil {
+		return false, err
+	}
+
+	step, err := totp.Validate(secret, code, time.Now(), t.LastUsedStep)
+	if err != nil {
+		return false, nil //nolint:nilerr // コードの不一致は ok=false で返す
+	}
+
+	rows, err := u.queries.UpdateUserTotpLastUsedStep(ctx, db.UpdateUserTotpLastUsedStepParams{
+		UserID:       t.UserID,
+		LastUsedStep: step,
+	})
+	if err != nil {
+		return false, errors.Wrap(err, 0)
+	}
+
+	return rows == 1, nil
+}
+
+func (u *TOTPUsecase) openSecret(t db.UserTotp) ([]byte, error) {
+	return u.cipher.Open(t.UserID, sealedTOTPSecret(t))
+}
+
+func (u *TOTPUsecase) isEnabled(ctx context.Context, userID string) (bool, error) {
+	t, err := u.queries.GetUserTotp(ctx, userID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return false, nil
+		}
+
+		return false, errors.Wrap(err, 0)
+	}
+
+	return t.EnabledAt.Valid, nil
+}
+
+// isRequired は system グループに所属 (= system scope のロールを持つ) していて、
+// 設定で 2 段階認証が必須になっているなら true.
+func (u *TOTPUsecase) isRequired(ctx context.Context, userID string) (bool, error) {
+	if !u.opts.RequiredForSystemRoles {
+		return false, nil
+	}
+
+	if _, err := u.memberRepo.Get(ctx, entity.SystemGroupID, userID); err != nil {
+		if errors.Is(err, domain.ErrNotFound) {
+			return false, nil
+		}
+
+		return false, err
+	}
+
+	return true, nil
+}
+
+// enrollingUserID は challenge があればそのユーザー、無ければ caller 自身を返す.
+// countAttempt ならコードを確認する前に challenge の試行回数を数える (VerifyLogin と同じ上限).
+func (u *TOTPUsecase) enrollingUserID(ctx context.Context, challenge string, countAttempt bool) (string, error) {
+	if challenge == "" {
+		return u.selfUserID(ctx)
+	}
+
+	var (
+		ch  db.TotpLoginChallenge
+		err error
+	)
+
+	if countAttempt {
+		ch, err = u.queries.CountTotpLoginChallengeAttempt(ctx, db.CountTotpLoginChallengeAttemptParams{
+			TokenHash:   hashTOTPValue(challenge),
+			MaxAttempts: totpChallengeMaxAttempts,
+		})
+	} else {
+		ch, err = u.queries.GetTotpLoginChallenge(ctx, db.GetTotpLoginChallengeParams{
+			TokenHash:   hashTOTPValue(challenge),
+			MaxAttempts: totpChallengeMaxAttempts,
+		})
+	}
+
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return "", errors.Errorf("totp challenge is unknown, expired or exhausted: %w", domain.ErrUnauthenticated)
+		}
+
+		return "", errors.Wrap(err, 0)
+	}
+
+	return ch.UserID, nil
+}
+
+// selfUserID は caller 自身の user_id を返す. API トークンからは 2 段階認証を操作させない.
+func (u *TOTPUsecase) selfUserID(ctx context.Context) (string, error) {
+	claims, err := auth.GetAuthClaimsFromContext(ctx)
+	if err != nil || claims.UserID == "" {
+		return "", errors.Wrap(domain.ErrUnauthenticated, 0)
+	}
+
+	if claims.APIToken != nil {
+		return "", errors.Errorf("totp cannot be managed with an api token: %w", domain.ErrPermissionDenied)
+	}
+
+	return claims.UserID, nil
+}
+
+func sealedTOTPSecret(t db.UserTotp) entity.SealedSecret {
+	return entity.SealedSecret{
+		KeyID:      t.SecretKeyID,
+		DataKey:    t.SecretDataKey,
+		Ciphertext: t.EncryptedSecret,
+	}
+}
+
+func isTOTPCode(code string) bool {
+	if len(code) != totp.Digits {
+		return false
+	}
+
+	for _, c := range code {
+		if c < '0' || c > '9' {
+			return false
+		}
+	}
+
+	return true
+}
+
+// generateRecoveryCode は "xxxx-xxxx-xxxx-xxxx" 形式のリカバリーコードを返す.
+func generateRecoveryCode() string {
+	raw := uniuri.NewLenChars(totpRecoveryCodeLength, []byte(totpRecoveryCodeChars))
+
+	groups := make([]string, 0, totpRecoveryCodeLength/totpRecoveryCodeGroup)
+	for i := 0; i < len(raw); i += totpRecoveryCodeGroup {
+		groups = append(groups, raw[i:i+totpRecoveryCodeGroup])
+	}
+
+	return strings.Join(groups, "-")
+}
+
+// normalizeRecoveryCode は区切りや大文字小文字の違いを吸収する.
+func normalizeRecoveryCode(code string) string {
+	return strings.NewReplacer("-", "", " ", "").Replace(strings.ToLower(strings.TrimSpace(code)))
+}
+
+// hashTOTPValue は challenge / リカバリーコードの保存用ダイジェスト (SHA-256 hex) を返す.
+func hashTOTPValue(v string) string {
+	sum := sha256.Sum256([]byte(v))
+
+	return hex.EncodeToString(sum[:])
+}
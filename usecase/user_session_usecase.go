@@ -0,0 +1,226 @@
+package usecase
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/dchest/uniuri"
+	"github.com/go-errors/errors"
+	"github.com/hantabaru1014/baru-reso-headless-controller/db"
+	"github.com/hantabaru1014/baru-reso-headless-controller/domain"
+	"github.com/hantabaru1014/baru-reso-headless-controller/domain/entity"
+	"github.com/hantabaru1014/baru-reso-headless-controller/lib/auth"
+	"github.com/hantabaru1014/baru-reso-headless-controller/usecase/port"
+)
+
+const (
+	// userSessionRefreshGrace は直前の世代の refresh token を受け付ける時間.
+	// 複数のタブが同じ refresh token で同時に refresh しても漏洩扱いにしないため.
+	userSessionRefreshGrace = 30 * time.Second
+	// userSessionUserAgentMaxLength を超える User-Agent は切り詰めて保存する.
+	userSessionUserAgentMaxLength = 512
+)
+
+var _ auth.UserSessionVerifier = (*UserSessionUsecase)(nil)
+
+// UserSessionClient はセッションを開始 / refresh した端末の情報.
+type UserSessionClient struct {
+	UserAgent string
+	IPAddress string
+}
+
+// TokenSet はログイン / refresh で発行するトークンの組.
+type TokenSet struct {
+	Token        string
+	RefreshToken string
+}
+
+// UserSessionUsecase はログインセッション (refresh token の family) を管理する.
+// refresh token は使うたびに世代を進め、古い世代が使われたらセッションごと失効させる.
+// 権限要件: 自分のセッションの操作のみ (特別な権限は不要).
+type UserSessionUsecase struct {
+	repo port.UserSessionRepository
+}
+
+func NewUserSessionUsecase(repo port.UserSessionRepository) *UserSessionUsecase {
+	return &UserSessionUsecase{repo: repo}
+}
+
+// StartSession はサインインしたユーザーのセッションを作り、トークンを発行する.
+func (u *UserSessionUsecase) StartSession(ctx context.Context, user *db.User, client UserSessionClient) (*TokenSet, error) {
+	// 期限切れ / 失効済みのセッションはここでついでに掃除する. 失敗してもログインは続ける.
+	if err := u.repo.DeleteStale(ctx); err != nil {
+		slog.Warn("failed to delete stale user sessions", "error", err)
+	}
+
+	session := &entity.UserSession{
+		ID:        uniuri.New(),
+		UserID:    user.ID,
+		UserAgent: truncateUserAgent(client.UserAgent),
+		IPAddress: client.IPAddress,
+		ExpiresAt: time.Now().Add(auth.RefreshTokenTTL),
+	}
+	if err := u.repo.Create(ctx, session); err != nil {
+		return nil, err
+	}
+
+	return issueSessionTokens(auth.AuthClaims{
+		UserID:     user.ID,
+		ResoniteID: user.ResoniteID.String,
+		IconUrl:    user.IconUrl.String,
+	}, session.ID, session.RefreshGeneration)
+}
+
+// Refresh は refresh token の claims を検証し、世代を進めたトークンを発行する.
+func (u *UserSessionUsecase) Refresh(ctx context.Context, claims *auth.AuthClaims, client UserSessionClient) (*TokenSet, error) {
+	// access token とセッション導入前の refresh token では refresh できない.
+	if claims.UserSessionID == "" || claims.RefreshGeneration == 0 {
+		return nil, errors.Errorf("not a session refresh token: %w", domain.ErrUnauthenticated)
+	}
+
+	session, err := u.activeSession(ctx, claims)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+
+	if claims.RefreshGeneration == session.RefreshGeneration {
+		rotated, err := u.repo.Rotate(ctx, session.ID, session.RefreshGeneration, truncateUserAgent(client.UserAgent), client.IPAddress, now.Add(auth.RefreshTokenTTL))
+		if err != nil {
+			return nil, err
+		}
+
+		if rotated {
+			return issueSessionTokens(*claims, session.ID, session.RefreshGeneration+1)
+		}
+
+		// 同時に refresh されて先を越された. 最新の状態で判定し直す.
+		session, err = u.activeSession(ctx, claims)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if claims.RefreshGeneration == session.RefreshGeneration-1 && now.Sub(session.RotatedAt) < userSessionRefreshGrace {
+		return issueSessionTokens(*claims, session.ID, session.RefreshGeneration)
+	}
+
+	// それ以外の古い世代が使われたら refresh token が盗まれたとみなし、セッションごと失効させる.
+	slog.Warn("refresh token reuse detected, revoking user session", "user_id", session.UserID, "session_id", session.ID)
+
+	if err := u.repo.Revoke(ctx, session.UserID, session.ID); err != nil && !errors.Is(err, domain.ErrNotFound) {
+		return nil, err
+	}
+
+	return nil, errors.Errorf("refresh token has already been used: %w", domain.ErrUnauthenticated)
+}
+
+// VerifyUserSession implements auth.UserSessionVerifier.
+func (u *UserSessionUsecase) VerifyUserSession(ctx context.Context, claims *auth.AuthClaims) error {
+	session, err := u.activeSession(ctx, claims)
+	if err != nil {
+		if errors.Is(err, domain.ErrUnauthenticated) {
+			return errors.Wrap(auth.ErrInvalidUserSession, 0)
+		}
+
+		return err
+	}
+
+	// last_seen_at は参考情報なので、更新に失敗してもリクエストは通す.
+	if err := u.repo.TouchLastSeen(ctx, session.ID); err != nil {
+		slog.Warn("failed to update user session last_seen_at", "session_id", session.ID, "error", err)
+	}
+
+	return nil
+}
+
+// ListMySessions は caller 自身の有効なセッションを最後に使われた順に返す.
+func (u *UserSessionUsecase) ListMySessions(ctx context.Context) (entity.UserSessionList, error) {
+	userID, err := selfSessionUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return u.repo.ListActiveByUser(ctx, userID)
+}
+
+// RevokeSession は caller 自身のセッションを失効させる. 今使っているセッションも指定できる (ログアウト).
+func (u *UserSessionUsecase) RevokeSession(ctx context.Context, id string) error {
+	userID, err := selfSessionUserID(ctx)
+	if err != nil {
+		return err
+	}
+
+	return u.repo.Revoke(ctx, userID, id)
+}
+
+// RevokeAllSessions は userID の全セッションを失効させる.
+// `brhcli user revoke-sessions` 専用で、権限チェックは行わない.
+func (u *UserSessionUsecase) RevokeAllSessions(ctx context.Context, userID string) (int64, error) {
+	return u.repo.RevokeAllByUser(ctx, userID, "")
+}
+
+// activeSession は claims のセッションが有効なら返す. 無効なら domain.ErrUnauthenticated.
+func (u *UserSessionUsecase) activeSession(ctx context.Context, claims *auth.AuthClaims) (*entity.UserSession, error) {
+	session, err := u.repo.Get(ctx, claims.UserSessionID)
+	if err != nil {
+		if errors.Is(err, domain.ErrNotFound) {
+			return nil, errors.Errorf("user session not found: %w", domain.ErrUnauthenticated)
+		}
+
+		return nil, err
+	}
+
+	if session.UserID != claims.UserID || !session.IsActive(time.Now()) {
+		return nil, errors.Errorf("user session is revoked or expired: %w", domain.ErrUnauthenticated)
+	}
+
+	return session, nil
+}
+
+// currentUserSessionID は caller が userID 本人ならリクエストのセッション ID を返す.
+func currentUserSessionID(ctx context.Context, userID string) string {
+	claims, err := auth.GetAuthClaimsFromContext(ctx)
+	if err != nil || claims.UserID != userID {
+		return ""
+	}
+
+	return claims.UserSessionID
+}
+
+func selfSessionUserID(ctx context.Context) (string, error) {
+	claims, err := auth.GetAuthClaimsFromContext(ctx)
+	if err != nil || claims.UserID == "" {
+		return "", errors.Wrap(domain.ErrUnauthenticated, 0)
+	}
+
+	if claims.APIToken != nil {
+		return "", errors.Errorf("user sessions cannot be managed with an api token: %w", domain.ErrPermissionDenied)
+	}
+
+	return claims.UserID, nil
+}
+
+func issueSessionTokens(claims auth.AuthClaims, sessionID string, generation int64) (*TokenSet, error) {
+	token, refreshToken, err := auth.GenerateSessionTokens(auth.AuthClaims{
+		UserID:        claims.UserID,
+		ResoniteID:    claims.ResoniteID,
+		IconUrl:       claims.IconUrl,
+		UserSessionID: sessionID,
+	}, generation)
+	if err != nil {
+		return nil, errors.Wrap(err, 0)
+	}
+
+	return &TokenSet{Token: token, RefreshToken: refreshToken}, nil
+}
+
+func truncateUserAgent(ua string) string {
+	if len(ua) > userSessionUserAgentMaxLength {
+		return ua[:userSessionUserAgentMaxLength]
+	}
+
+	return ua
+}
@@ -134,6 +134,7 @@ func (u *UserUsecase) DeleteUser(ctx context.Context, id string) error {
 		return errors.Wrap(err, 0)
 	}
 
+	// セッションも ON DELETE CASCADE で消えるので、発行済みのトークンはすぐに使えなくなる.
 	if err := u.queries.DeleteUser(ctx, id); err != nil {
 		return errors.Wrap(err, 0)
 	}
@@ -401,9 +402,26 @@ func (u *UserUsecase) ChangePassword(ctx context.Context, userID, currentPasswor
 		return errors.Wrap(err, 0)
 	}
 
-	return u.queries.UpdateUserPassword(ctx, db.UpdateUserPasswordParams{
-		ID:       userID,
-		Password: passwordHash,
+	// パスワードを変えたら、漏れていたかもしれない他のセッションは使えなくする.
+	// 変更したセッション自体は残す.
+	return db.RunInTx(ctx, u.pool, func(tx pgx.Tx) error {
+		qtx := u.queries.WithTx(tx)
+
+		if err := qtx.UpdateUserPassword(ctx, db.UpdateUserPasswordParams{
+			ID:       userID,
+			Password: passwordHash,
+		}); err != nil {
+			return errors.Wrap(err, 0)
+		}
+
+		if _, err := qtx.RevokeUserSessionsByUser(ctx, db.RevokeUserSessionsByUserParams{
+			UserID:   userID,
+			ExceptID: currentUserSessionID(ctx, userID),
+		}); err != nil {
+			return errors.Wrap(err, 0)
+		}
+
+		return nil
 	})
 }
 
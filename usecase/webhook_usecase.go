@@ -0,0 +1,214 @@
+package usecase
+
+import (
+	"context"
+	"net/netip"
+	"net/url"
+	"slices"
+	"time"
+
+	"github.com/dchest/uniuri"
+	"github.com/go-errors/errors"
+	"github.com/hantabaru1014/baru-reso-headless-controller/config"
+	"github.com/hantabaru1014/baru-reso-headless-controller/domain"
+	"github.com/hantabaru1014/baru-reso-headless-controller/domain/entity"
+	"github.com/hantabaru1014/baru-reso-headless-controller/lib/webhook"
+	"github.com/hantabaru1014/baru-reso-headless-controller/usecase/port"
+)
+
+// webhookSecretBytes は署名鍵の乱数バイト数.
+const webhookSecretBytes = 32
+
+// WebhookUsecase はグループの Webhook 通知先の管理を提供する.
+// イベントの enqueue と送信は worker.WebhookDispatcher / WebhookDeliverer が行う.
+// 権限要件: すべての操作で対象グループの group:webhooks.manage.
+type WebhookUsecase struct {
+	repo   port.WebhookRepository
+	permUC *PermissionUsecase
+	// allowedNetworks は URL に指定できる private / loopback / link-local のネットワーク.
+	allowedNetworks []netip.Prefix
+}
+
+func NewWebhookUsecase(repo port.WebhookRepository, permUC *PermissionUsecase, cfg *config.WorkerConfig) *WebhookUsecase {
+	return &WebhookUsecase{repo: repo, permUC: permUC, allowedNetworks: cfg.WebhookAllowedNetworks}
+}
+
+func (u *WebhookUsecase) ListWebhooks(ctx context.Context, groupID string) (entity.WebhookSubscriptionList, error) {
+	if err := u.permUC.RequirePermissionForGroup(ctx, groupID, entity.PermKey_GroupWebhooksManage); err != nil {
+		return nil, err
+	}
+
+	return u.repo.ListSubscriptionsByGroup(ctx, groupID)
+}
+
+// CreateWebhook は通知先を登録する. 返り値の Secret は署名の検証用に利用者へ一度だけ見せる.
+func (u *WebhookUsecase) CreateWebhook(
+	ctx context.Context,
+	groupID, name, rawURL string,
+	format entity.WebhookFormat,
+	eventTypes []entity.WebhookEventType,
+) (*entity.WebhookSubscription, error) {
+	if err := u.permUC.RequirePermissionForGroup(ctx, groupID, entity.PermKey_GroupWebhooksManage); err != nil {
+		return nil, err
+	}
+
+	if err := validateWebhookSettings(ctx, u.allowedNetworks, &name, &rawURL, &format, eventTypes); err != nil {
+		return nil, err
+	}
+
+	userID, err := CurrentUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	secret, err := generateSecureToken(webhookSecretBytes)
+	if err != nil {
+		return nil, errors.Wrap(err, 0)
+	}
+
+	sub := &entity.WebhookSubscription{
+		ID:         uniuri.New(),
+		GroupID:    groupID,
+		Name:       name,
+		URL:        rawURL,
+		Format:     format,
+		EventTypes: eventTypes,
+		Secret:     secret,
+		Enabled:    true,
+		CreatedBy:  &userID,
+	}
+	if err := u.repo.CreateSubscription(ctx, sub); err != nil {
+		return nil, err
+	}
+
+	return sub, nil
+}
+
+// UpdateWebhook は params の nil でないフィールドだけを更新する.
+func (u *WebhookUsecase) UpdateWebhook(ctx context.Context, id string, params port.WebhookSubscriptionUpdateParams) (*entity.WebhookSubscription, error) {
+	if _, err := u.getForManage(ctx, id); err != nil {
+		return nil, err
+	}
+
+	if err := validateWebhookSettings(ctx, u.allowedNetworks, params.Name, params.URL, params.Format, params.EventTypes); err != nil {
+		return nil, err
+	}
+
+	return u.repo.UpdateSubscription(ctx, id, params)
+}
+
+func (u *WebhookUsecase) DeleteWebhook(ctx context.Context, id string) error {
+	if _, err := u.getForManage(ctx, id); err != nil {
+		return err
+	}
+
+	return u.repo.DeleteSubscription(ctx, id)
+}
+
+// RotateWebhookSecret は署名鍵を作り直す. 送信待ちの分も次の送信から新しい鍵で署名される.
+func (u *WebhookUsecase) RotateWebhookSecret(ctx context.Context, id string) (*entity.WebhookSubscription, error) {
+	sub, err := u.getForManage(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	secret, err := generateSecureToken(webhookSecretBytes)
+	if err != nil {
+		return nil, errors.Wrap(err, 0)
+	}
+
+	if err := u.repo.UpdateSubscriptionSecret(ctx, id, secret); err != nil {
+		return nil, err
+	}
+
+	sub.Secret = secret
+
+	return sub, nil
+}
+
+// TestWebhook は疎通確認用の ping を送信キューに入れる. 結果は送信履歴で確認する.
+func (u *WebhookUsecase) TestWebhook(ctx context.Context, id string) (*entity.WebhookDelivery, error) {
+	sub, err := u.getForManage(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := webhook.Render(string(sub.Format), webhook.Event{
+		Type:       string(entity.WebhookEventType_Ping),
+		OccurredAt: time.Now(),
+		GroupID:    sub.GroupID,
+		Level:      webhook.LevelInfo,
+		Title:      "Webhook のテスト送信です",
+		Message:    sub.Name,
+		Data:       map[string]string{"webhook_id": sub.ID},
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, 0)
+	}
+
+	return u.repo.EnqueueDelivery(ctx, sub.ID, entity.WebhookEventType_Ping, body)
+}
+
+func (u *WebhookUsecase) ListDeliveries(ctx context.Context, id string, pageIndex, pageSize int32) (*port.WebhookDeliveryListResult, error) {
+	if _, err := u.getForManage(ctx, id); err != nil {
+		return nil, err
+	}
+
+	return u.repo.ListDeliveries(ctx, id, pageIndex, pageSize)
+}
+
+// getForManage は subscription を取得し、そのグループの管理権限を最終ガードする.
+func (u *WebhookUsecase) getForManage(ctx context.Context, id string) (*entity.WebhookSubscription, error) {
+	sub, err := u.repo.GetSubscription(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := u.permUC.RequirePermissionForGroup(ctx, sub.GroupID, entity.PermKey_GroupWebhooksManage); err != nil {
+		return nil, err
+	}
+
+	return sub, nil
+}
+
+// validateWebhookSettings は nil でない項目を検証する.
+// URL は名前解決し、allowedNetworks 以外の内部向けアドレス (loopback / private / link-local) を拒否する.
+// 不正な場合は domain.ErrInvalidArgument を wrap したエラーを返す.
+func validateWebhookSettings(
+	ctx context.Context,
+	allowedNetworks []netip.Prefix,
+	name, rawURL *string,
+	format *entity.WebhookFormat,
+	eventTypes []entity.WebhookEventType,
+) error {
+	if name != nil && *name == "" {
+		return errors.Errorf("webhook name is required: %w", domain.ErrInvalidArgument)
+	}
+
+	if rawURL != nil {
+		parsed, err := url.Parse(*rawURL)
+		if err != nil || (parsed.Scheme != "http" && parsed.Scheme != "https") || parsed.Host == "" {
+			return errors.Errorf("webhook url must be an absolute http(s) URL: %w", domain.ErrInvalidArgument)
+		}
+
+		if err := webhook.CheckURL(ctx, *rawURL, allowedNetworks); err != nil {
+			if errors.Is(err, webhook.ErrForbiddenDestination) {
+				return errors.Errorf("webhook url must not point to a private or local address: %w", domain.ErrInvalidArgument)
+			}
+
+			return errors.Errorf("cannot resolve webhook url host %q: %w", parsed.Hostname(), domain.ErrInvalidArgument)
+		}
+	}
+
+	if format != nil && !slices.Contains(entity.AllWebhookFormats, *format) {
+		return errors.Errorf("unknown webhook format %q: %w", *format, domain.ErrInvalidArgument)
+	}
+
+	for _, t := range eventTypes {
+		if !slices.Contains(entity.AllWebhookEventTypes, t) {
+			return errors.Errorf("unknown webhook event type %q: %w", t, domain.ErrInvalidArgument)
+		}
+	}
+
+	return nil
+}
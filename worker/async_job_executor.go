@@ -10,6 +10,7 @@ import (
 	"github.com/go-errors/errors"
 	"github.com/hantabaru1014/baru-reso-headless-controller/domain/entity"
 	"github.com/hantabaru1014/baru-reso-headless-controller/lib/auth"
+	"github.com/hantabaru1014/baru-reso-headless-controller/lib/metrics"
 	hdlctrlv1 "github.com/hantabaru1014/baru-reso-headless-controller/pbgen/hdlctrl/v1"
 	"github.com/hantabaru1014/baru-reso-headless-controller/usecase/async_job"
 	"github.com/hantabaru1014/baru-reso-headless-controller/usecase/notification"
@@ -196,6 +197,11 @@ const persistTimeout = 10 * time.Second
 func (e *AsyncJobExecutor) executeOne(ctx context.Context, job *entity.AsyncJob) {
 	logger := slog.With("job_id", job.ID, "type", job.JobType)
 
+	typeLabel := asyncJobTypeLabel(job.JobType)
+	if job.ClaimedAt != nil {
+		metrics.AsyncJobQueueLatency.WithLabelValues(typeLabel).Observe(job.ClaimedAt.Sub(job.CreatedAt).Seconds())
+	}
+
 	// created_by が無い / DB 上のユーザーが消えている場合は実行主体を立てられないので
 	// 即 FAILED にする. systemPrivilege fallback はしない (作成権限の追跡を維持するため).
 	if job.CreatedBy == nil || *job.CreatedBy == "" {
@@ -244,7 +250,16 @@ func (e *AsyncJobExecutor) executeOne(ctx context.Context, job *entity.AsyncJob)
 		}
 	}()
 
+	startedAt := time.Now()
 	result, message, runErr := e.dispatcher.Dispatch(actCtx, job)
+
+	resultLabel := metrics.ResultSucceeded
+	if runErr != nil {
+		resultLabel = metrics.ResultFailed
+	}
+
+	metrics.AsyncJobDuration.WithLabelValues(typeLabel, resultLabel).Observe(time.Since(startedAt).Seconds())
+
 	if runErr != nil {
 		logger.Error("async-job-executor: execute failed", "error", runErr)
 		e.markFailed(ctx, job, runErr)
@@ -300,3 +315,22 @@ func (e *AsyncJobExecutor) publishCompletion(job *entity.AsyncJob, message strin
 
 	e.bus.PublishTo(*job.CreatedBy, notification.JobCompleted(job.ID, message, level))
 }
+
+// asyncJobTypeLabel は metrics の type ラベル値. 未知の値は "unknown" にまとめて
+// ラベルの種類数を抑える.
+func asyncJobTypeLabel(t entity.AsyncJobType) string {
+	switch t {
+	case entity.AsyncJobType_START_HOST:
+		return "start_host"
+	case entity.AsyncJobType_SHUTDOWN_HOST:
+		return "shutdown_host"
+	case entity.AsyncJobType_RESTART_HOST:
+		return "restart_host"
+	case entity.AsyncJobType_START_SESSION:
+		return "start_session"
+	case entity.AsyncJobType_STOP_SESSION:
+		return "stop_session"
+	default:
+		return "unknown"
+	}
+}
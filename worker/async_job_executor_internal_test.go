@@ -48,6 +48,10 @@ func (noopBus) Subscribe(context.Context, string) (<-chan *hdlctrlv1.Notificatio
 	ch := make(chan *hdlctrlv1.NotificationEvent)
 	return ch, func() { close(ch) }
 }
+func (noopBus) SubscribeAll(context.Context) (<-chan *hdlctrlv1.NotificationEvent, func()) {
+	ch := make(chan *hdlctrlv1.NotificationEvent)
+	return ch, func() { close(ch) }
+}
 
 // stubUserChecker は UserExistenceChecker の test stub.
 type stubUserChecker struct {
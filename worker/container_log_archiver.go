@@ -0,0 +1,117 @@
+package worker
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/hantabaru1014/baru-reso-headless-controller/config"
+	"github.com/hantabaru1014/baru-reso-headless-controller/usecase/port"
+)
+
+// ContainerLogArchiver moves the logs of past host instances out of
+// container_logs once they are older than their retention, so the table
+// does not grow forever. An instance is archived as a whole when its newest
+// log passes the retention of the host's group, or the global retention
+// when the group has none. The current instance of a host is never
+// archived since it may still be written to.
+type ContainerLogArchiver struct {
+	archive       port.HostLogArchive
+	retentionDays int
+	interval      time.Duration
+	now           func() time.Time
+
+	bucketReady bool
+}
+
+func NewContainerLogArchiver(archive port.HostLogArchive, cfg *config.WorkerConfig) *ContainerLogArchiver {
+	return &ContainerLogArchiver{
+		archive:       archive,
+		retentionDays: cfg.ContainerLogRetentionDays,
+		interval:      cfg.ContainerLogArchiveInterval,
+		now:           time.Now,
+	}
+}
+
+func (a *ContainerLogArchiver) Name() string { return "container-log-archiver" }
+
+func (a *ContainerLogArchiver) Run(ctx context.Context) error {
+	a.ArchiveExpired(ctx)
+
+	ticker := time.NewTicker(a.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+			a.ArchiveExpired(ctx)
+		}
+	}
+}
+
+// ArchiveExpired archives every past instance that is over its retention.
+// Failures are logged and retried on the next run.
+func (a *ContainerLogArchiver) ArchiveExpired(ctx context.Context) {
+	instances, err := a.archive.ListPastInstances(ctx)
+	if err != nil {
+		slog.Error("container-log-archiver: failed to list past instances", "error", err)
+
+		return
+	}
+
+	now := a.now()
+
+	var archived, lines int64
+
+	for _, inst := range instances {
+		if ctx.Err() != nil {
+			return
+		}
+
+		if !a.expired(inst, now) {
+			continue
+		}
+
+		if !a.bucketReady {
+			if err := a.archive.EnsureBucket(ctx); err != nil {
+				slog.Error("container-log-archiver: archive bucket is not available", "error", err)
+
+				return
+			}
+
+			a.bucketReady = true
+		}
+
+		n, err := a.archive.Archive(ctx, inst.HostID, inst.InstanceID)
+		if err != nil {
+			slog.Error("container-log-archiver: failed to archive instance logs",
+				"hostID", inst.HostID, "instanceID", inst.InstanceID, "error", err)
+
+			continue
+		}
+
+		archived++
+		lines += n
+	}
+
+	if archived > 0 {
+		slog.Info("container-log-archiver: archived instance logs", "instances", archived, "lines", lines)
+	}
+}
+
+func (a *ContainerLogArchiver) expired(inst *port.PastLogInstance, now time.Time) bool {
+	const day = 24 * time.Hour
+
+	days := a.retentionDays
+	if inst.RetentionDays != nil {
+		days = int(*inst.RetentionDays)
+	}
+
+	if days <= 0 {
+		return false
+	}
+
+	return inst.LastLogAt.Before(now.Add(-time.Duration(days) * day))
+}
@@ -0,0 +1,102 @@
+package worker
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/hantabaru1014/baru-reso-headless-controller/usecase/port"
+	"github.com/stretchr/testify/assert"
+)
+
+type fakeHostLogArchive struct {
+	instances []*port.PastLogInstance
+	bucketErr error
+
+	ensured  int
+	archived []string
+}
+
+func (f *fakeHostLogArchive) EnsureBucket(context.Context) error {
+	f.ensured++
+
+	return f.bucketErr
+}
+
+func (f *fakeHostLogArchive) ListPastInstances(context.Context) ([]*port.PastLogInstance, error) {
+	return f.instances, nil
+}
+
+func (f *fakeHostLogArchive) Archive(_ context.Context, hostID string, _ int32) (int64, error) {
+	f.archived = append(f.archived, hostID)
+
+	return 1, nil
+}
+
+func TestContainerLogArchiver_ArchiveExpired(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2026, 7, 1, 0, 0, 0, 0, time.UTC)
+	daysAgo := func(d int) time.Time { return now.Add(-time.Duration(d) * 24 * time.Hour) }
+	days := func(d int32) *int32 { return &d }
+
+	newArchiver := func(archive port.HostLogArchive, retentionDays int) *ContainerLogArchiver {
+		return &ContainerLogArchiver{
+			archive:       archive,
+			retentionDays: retentionDays,
+			interval:      time.Hour,
+			now:           func() time.Time { return now },
+		}
+	}
+
+	t.Run("uses the group retention over the global one", func(t *testing.T) {
+		t.Parallel()
+
+		archive := &fakeHostLogArchive{instances: []*port.PastLogInstance{
+			{HostID: "global-expired", LastLogAt: daysAgo(31)},
+			{HostID: "global-kept", LastLogAt: daysAgo(29)},
+			{HostID: "group-expired", LastLogAt: daysAgo(8), RetentionDays: days(7)},
+			{HostID: "group-kept", LastLogAt: daysAgo(31), RetentionDays: days(90)},
+		}}
+		a := newArchiver(archive, 30)
+
+		a.ArchiveExpired(t.Context())
+
+		assert.Equal(t, []string{"global-expired", "group-expired"}, archive.archived)
+		assert.Equal(t, 1, archive.ensured)
+
+		a.ArchiveExpired(t.Context())
+
+		assert.Equal(t, 1, archive.ensured, "the bucket is prepared only once")
+	})
+
+	t.Run("zero global retention only archives groups that set one", func(t *testing.T) {
+		t.Parallel()
+
+		archive := &fakeHostLogArchive{instances: []*port.PastLogInstance{
+			{HostID: "global", LastLogAt: daysAgo(365)},
+			{HostID: "group", LastLogAt: daysAgo(8), RetentionDays: days(7)},
+		}}
+
+		newArchiver(archive, 0).ArchiveExpired(t.Context())
+
+		assert.Equal(t, []string{"group"}, archive.archived)
+	})
+
+	t.Run("nothing is archived while the bucket is unavailable", func(t *testing.T) {
+		t.Parallel()
+
+		archive := &fakeHostLogArchive{
+			instances: []*port.PastLogInstance{{HostID: "expired", LastLogAt: daysAgo(31)}},
+			bucketErr: errors.New("unreachable"),
+		}
+		a := newArchiver(archive, 30)
+
+		a.ArchiveExpired(t.Context())
+		a.ArchiveExpired(t.Context())
+
+		assert.Empty(t, archive.archived)
+		assert.Equal(t, 2, archive.ensured, "retried on the next run")
+	})
+}
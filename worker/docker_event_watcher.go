@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"log/slog"
+	"sync"
 	"time"
 
 	"github.com/hantabaru1014/baru-reso-headless-controller/adapter/hostconnector"
@@ -11,6 +12,7 @@ import (
 	"github.com/hantabaru1014/baru-reso-headless-controller/db"
 	"github.com/hantabaru1014/baru-reso-headless-controller/domain/entity"
 	"github.com/hantabaru1014/baru-reso-headless-controller/usecase/notification"
+	"github.com/hantabaru1014/baru-reso-headless-controller/usecase/port"
 	"github.com/jackc/pgx/v5/pgtype"
 )
 
@@ -18,17 +20,29 @@ import (
 // output.
 const shortContainerIDLen = 12
 
-// DockerEventWatcher reflects the Docker daemon's container event stream
+// DockerEventWatcher reflects the Docker daemons' container event streams
 // into the hosts table so we always converge on the real container state.
-// It also performs a one-shot reconciliation on startup to fix any drift
-// that happened while the controller was offline.
+// It keeps one stream per docker node (the local daemon plus every node
+// registered in docker_nodes) and picks up added/removed nodes every
+// nodePollInterval. Whenever a node's stream is (re)started it performs a
+// reconciliation of that node's hosts to fix any drift that happened while
+// the controller was offline or the node was unreachable.
+//
+// With several controller instances each node is streamed by the instance
+// ownership assigns it to, so a crash is reported exactly once.
 type DockerEventWatcher struct {
-	dc  *hostconnector.DockerHostConnector
-	q   *db.Queries
-	bus notification.Bus
+	dc        *hostconnector.DockerHostConnector
+	q         *db.Queries
+	bus       notification.Bus
+	observer  HostTerminationObserver
+	ownership port.StreamOwnership
 
+	nodePollInterval time.Duration
 	reconnectDelay   time.Duration
 	maxReconnectWait time.Duration
+
+	mu      sync.Mutex
+	streams map[string]*hostStreamCtl
 }
 
 var _ Runner = (*DockerEventWatcher)(nil)
@@ -37,41 +51,144 @@ func NewDockerEventWatcher(
 	dc *hostconnector.DockerHostConnector,
 	q *db.Queries,
 	bus notification.Bus,
+	observer HostTerminationObserver,
+	ownership port.StreamOwnership,
 	cfg *config.WorkerConfig,
 ) *DockerEventWatcher {
 	return &DockerEventWatcher{
 		dc:               dc,
 		q:                q,
 		bus:              bus,
+		observer:         observer,
+		ownership:        ownership,
+		nodePollInterval: cfg.DockerNodePollInterval,
 		reconnectDelay:   cfg.EventReconnectDelay,
 		maxReconnectWait: cfg.EventMaxReconnectWait,
+		streams:          make(map[string]*hostStreamCtl),
 	}
 }
 
 func (w *DockerEventWatcher) Name() string { return "docker-event-watcher" }
 
 func (w *DockerEventWatcher) Run(ctx context.Context) error {
-	w.syncAllStatuses(ctx)
+	w.reconcileNodes(ctx)
+
+	ticker := time.NewTicker(w.nodePollInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			w.cancelAllAndWait()
+
+			return ctx.Err()
+		case <-ticker.C:
+			w.reconcileNodes(ctx)
+		}
+	}
+}
+
+// reconcileNodes aligns the set of running per-node streams with the
+// nodes currently known to the connector and owned by this instance.
+func (w *DockerEventWatcher) reconcileNodes(ctx context.Context) {
+	ids, err := w.dc.ListNodeIDs(ctx)
+	if err != nil {
+		slog.Error("docker-event-watcher: failed to list docker nodes", "error", err)
+
+		return
+	}
+
+	desired := make(map[string]struct{}, len(ids))
+
+	for _, id := range ids {
+		if w.ownership.Owns(id) {
+			desired[id] = struct{}{}
+		}
+	}
+
+	stopped := make([]*hostStreamCtl, 0)
+
+	w.mu.Lock()
+
+	for id, ctl := range w.streams {
+		if _, ok := desired[id]; !ok {
+			ctl.cancel()
+			stopped = append(stopped, ctl)
+
+			delete(w.streams, id)
+		}
+	}
+
+	w.mu.Unlock()
+
+	for _, ctl := range stopped {
+		<-ctl.done
+	}
+
+	w.mu.Lock()
+	defer w.mu.Unlock()
 
+	for id := range desired {
+		if _, ok := w.streams[id]; ok {
+			continue
+		}
+
+		streamCtx, cancel := context.WithCancel(ctx)
+		ctl := &hostStreamCtl{cancel: cancel, done: make(chan struct{})}
+		w.streams[id] = ctl
+
+		nodeID := id
+
+		go func() {
+			defer close(ctl.done)
+
+			w.runNodeStream(streamCtx, nodeID)
+		}()
+	}
+}
+
+func (w *DockerEventWatcher) cancelAllAndWait() {
+	w.mu.Lock()
+	ctls := make([]*hostStreamCtl, 0, len(w.streams))
+
+	for id, ctl := range w.streams {
+		ctl.cancel()
+		ctls = append(ctls, ctl)
+
+		delete(w.streams, id)
+	}
+
+	w.mu.Unlock()
+
+	for _, ctl := range ctls {
+		<-ctl.done
+	}
+}
+
+func (w *DockerEventWatcher) runNodeStream(ctx context.Context, nodeID string) {
 	RetryWithBackoff(
 		ctx,
-		w.Name(),
+		w.Name()+":"+nodeID,
 		w.reconnectDelay,
 		w.maxReconnectWait,
 		stableConnectionThreshold,
-		w.watchOnce,
+		func(ctx context.Context) error {
+			return w.watchOnce(ctx, nodeID)
+		},
 	)
-
-	return ctx.Err()
 }
 
-func (w *DockerEventWatcher) watchOnce(ctx context.Context) error {
-	events, errChan, err := w.dc.SubscribeEvents(ctx)
+func (w *DockerEventWatcher) watchOnce(ctx context.Context, nodeID string) error {
+	events, errChan, err := w.dc.SubscribeEvents(ctx, nodeID)
 	if err != nil {
 		return err
 	}
 
-	slog.Info("connected to docker events stream")
+	slog.Info("connected to docker events stream", "nodeID", nodeID)
+
+	// Events that happened while we were disconnected are not replayed,
+	// so resync this node's hosts right after (re)subscribing.
+	w.syncNodeStatuses(ctx, nodeID)
 
 	for {
 		select {
@@ -82,7 +199,7 @@ func (w *DockerEventWatcher) watchOnce(ctx context.Context) error {
 				return errors.New("docker events channel closed")
 			}
 
-			w.handleEvent(ctx, event)
+			w.handleEvent(ctx, nodeID, event)
 		case err, ok := <-errChan:
 			if !ok {
 				return errors.New("docker events error channel closed")
@@ -95,12 +212,15 @@ func (w *DockerEventWatcher) watchOnce(ctx context.Context) error {
 	}
 }
 
-func (w *DockerEventWatcher) syncAllStatuses(ctx context.Context) {
-	slog.Info("syncing all container statuses on startup")
+// syncNodeStatuses reconciles the hosts placed on nodeID with the
+// containers actually present there. If the node cannot be listed its hosts
+// are left untouched: an unreachable node is not evidence of a crash.
+func (w *DockerEventWatcher) syncNodeStatuses(ctx context.Context, nodeID string) {
+	slog.Info("syncing container statuses", "nodeID", nodeID)
 
-	statuses, err := w.dc.ListAllContainerStatuses(ctx)
+	statuses, err := w.dc.ListAllContainerStatuses(ctx, nodeID)
 	if err != nil {
-		slog.Error("failed to list container statuses", "error", err)
+		slog.Error("failed to list container statuses", "nodeID", nodeID, "error", err)
 
 		return
 	}
@@ -113,7 +233,16 @@ func (w *DockerEventWatcher) syncAllStatuses(ctx context.Context) {
 	}
 
 	for _, host := range hosts {
-		containerID, _, err := hostconnector.ParseConnectString(hostconnector.HostConnectString(host.ConnectString))
+		if port.HostConnectorType(host.ConnectorType) != port.HostConnectorType_DOCKER {
+			continue
+		}
+
+		connectString := hostconnector.HostConnectString(host.ConnectString)
+		if hostconnector.DockerNodeIDOf(connectString) != nodeID {
+			continue
+		}
+
+		containerID, _, err := hostconnector.ParseConnectString(connectString)
 		if err != nil {
 			continue
 		}
@@ -150,6 +279,10 @@ func (w *DockerEventWatcher) syncAllStatuses(ctx context.Context) {
 
 		slog.Info("synced host status", "hostID", host.ID,
 			"oldStatus", host.Status, "newStatus", newStatus)
+
+		if isUnexpectedTermination(host.Status, newStatus) {
+			w.observer.OnHostTerminated(ctx, host, newStatus)
+		}
 	}
 }
 
@@ -157,15 +290,17 @@ func (w *DockerEventWatcher) publishHostUpdated(hostID string) {
 	w.bus.Publish(notification.HostUpdated(hostID, "", nil))
 }
 
-func (w *DockerEventWatcher) handleEvent(ctx context.Context, event hostconnector.ContainerEvent) {
+func (w *DockerEventWatcher) handleEvent(ctx context.Context, nodeID string, event hostconnector.ContainerEvent) {
 	shortID := event.ContainerID
 	if len(shortID) > shortContainerIDLen {
 		shortID = shortID[:shortContainerIDLen]
 	}
 
-	slog.Debug("received container event", "containerID", shortID, "action", event.Action)
+	slog.Debug("received container event", "nodeID", nodeID, "containerID", shortID, "action", event.Action)
 
-	host, err := w.q.GetHostByContainerID(ctx, pgtype.Text{String: event.ContainerID, Valid: true})
+	prefix := hostconnector.DockerConnectStringPrefix(nodeID, event.ContainerID)
+
+	host, err := w.q.GetHostByContainerID(ctx, pgtype.Text{String: prefix, Valid: true})
 	if err != nil {
 		return // not one of ours
 	}
@@ -192,6 +327,10 @@ func (w *DockerEventWatcher) handleEvent(ctx context.Context, event hostconnecto
 
 	slog.Info("updated host status from docker event",
 		"hostID", host.ID, "action", event.Action, "newStatus", newStatus)
+
+	if isUnexpectedTermination(host.Status, newStatus) {
+		w.observer.OnHostTerminated(ctx, host, newStatus)
+	}
 }
 
 func (w *DockerEventWatcher) eventToStatus(event hostconnector.ContainerEvent) entity.HeadlessHostStatus {
@@ -0,0 +1,423 @@
+package worker
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"sync"
+	"time"
+
+	"github.com/hantabaru1014/baru-reso-headless-controller/config"
+	"github.com/hantabaru1014/baru-reso-headless-controller/db"
+	"github.com/hantabaru1014/baru-reso-headless-controller/domain"
+	"github.com/hantabaru1014/baru-reso-headless-controller/domain/entity"
+	"github.com/hantabaru1014/baru-reso-headless-controller/lib/auth"
+	hdlctrlv1 "github.com/hantabaru1014/baru-reso-headless-controller/pbgen/hdlctrl/v1"
+	"github.com/hantabaru1014/baru-reso-headless-controller/usecase/notification"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+// HostRestarter is the narrow slice of HeadlessHostUsecase the recoverer
+// needs, so crashed hosts come back through exactly the same path as a
+// user-requested restart (session bookkeeping, world restore, ...).
+type HostRestarter interface {
+	HeadlessHostRestart(ctx context.Context, id string, newTag *string, withWorldRestart bool, timeoutSeconds int) error
+}
+
+// HostTerminationObserver is told by the container/pod watchers about hosts
+// that went down without being asked to (see isUnexpectedTermination).
+type HostTerminationObserver interface {
+	OnHostTerminated(ctx context.Context, host db.Host, newStatus entity.HeadlessHostStatus)
+}
+
+// HostTerminationObservers fans a termination out to several observers in
+// order.
+type HostTerminationObservers []HostTerminationObserver
+
+func (o HostTerminationObservers) OnHostTerminated(ctx context.Context, host db.Host, newStatus entity.HeadlessHostStatus) {
+	for _, observer := range o {
+		observer.OnHostTerminated(ctx, host, newStatus)
+	}
+}
+
+// autoRestartStopTimeoutSeconds is passed to HeadlessHostRestart. The host
+// is already down when we restart it, so it only matters if the host was
+// brought back by someone else in the meantime.
+const autoRestartStopTimeoutSeconds = 60
+
+// HostCrashRecoverer restarts hosts that crashed (or, with the ALWAYS
+// policy, exited on their own) according to their auto-restart policy.
+// Consecutive restarts back off exponentially; once a host has been
+// restarted more often than its limit without staying up for
+// stableAfter in between, it is considered crash looping and left down
+// until its policy is updated or it has been running stably again.
+type HostCrashRecoverer struct {
+	q         *db.Queries
+	restarter HostRestarter
+	bus       notification.Bus
+
+	baseDelay          time.Duration
+	maxDelay           time.Duration
+	stableAfter        time.Duration
+	crashLoopThreshold int32
+
+	mu sync.Mutex
+	// ctx is nil until Run starts. Terminations observed before that are
+	// kept in queued and scheduled once Run has a lifecycle context.
+	ctx      context.Context
+	queued   map[string]time.Duration
+	inflight map[string]struct{}
+	wg       sync.WaitGroup
+}
+
+var (
+	_ Runner                  = (*HostCrashRecoverer)(nil)
+	_ HostTerminationObserver = (*HostCrashRecoverer)(nil)
+)
+
+func NewHostCrashRecoverer(
+	q *db.Queries,
+	restarter HostRestarter,
+	bus notification.Bus,
+	cfg *config.WorkerConfig,
+) *HostCrashRecoverer {
+	return &HostCrashRecoverer{
+		q:                  q,
+		restarter:          restarter,
+		bus:                bus,
+		baseDelay:          cfg.AutoRestartBaseDelay,
+		maxDelay:           cfg.AutoRestartMaxDelay,
+		stableAfter:        cfg.AutoRestartStableAfter,
+		crashLoopThreshold: int32(cfg.AutoRestartCrashLoopThreshold), //nolint:gosec // small config value
+		queued:             make(map[string]time.Duration),
+		inflight:           make(map[string]struct{}),
+	}
+}
+
+func (r *HostCrashRecoverer) Name() string { return "host-crash-recoverer" }
+
+func (r *HostCrashRecoverer) Run(ctx context.Context) error {
+	ctx = auth.WithActAsUser(ctx, domain.SystemUserID)
+
+	r.mu.Lock()
+	r.ctx = ctx
+	queued := r.queued
+	r.queued = make(map[string]time.Duration)
+
+	for hostID, delay := range queued {
+		r.launchLocked(hostID, delay)
+	}
+	r.mu.Unlock()
+
+	<-ctx.Done()
+	r.wg.Wait()
+
+	return ctx.Err()
+}
+
+// Resumer returns the Runner that picks up hosts left CRASHED while no
+// controller was watching them. It lists every crashed host, so it must be
+// run as a singleton; the restarts it schedules still run in this
+// recoverer.
+func (r *HostCrashRecoverer) Resumer() Runner {
+	return crashedHostResumer{recoverer: r}
+}
+
+type crashedHostResumer struct {
+	recoverer *HostCrashRecoverer
+}
+
+func (crashedHostResumer) Name() string { return "host-crash-resumer" }
+
+func (c crashedHostResumer) Run(ctx context.Context) error {
+	c.recoverer.resumeCrashedHosts(auth.WithActAsUser(ctx, domain.SystemUserID))
+
+	<-ctx.Done()
+
+	return ctx.Err()
+}
+
+// resumeCrashedHosts picks up hosts that crashed while the controller was
+// down or whose retry sequence was cut short by a restart of the
+// controller. Their crash has already been recorded, so only the retry
+// decision is made again.
+func (r *HostCrashRecoverer) resumeCrashedHosts(ctx context.Context) {
+	hosts, err := r.q.ListHostsByStatus(ctx, int32(entity.HeadlessHostStatus_CRASHED))
+	if err != nil {
+		slog.Error("failed to list crashed hosts", "error", err)
+
+		return
+	}
+
+	for _, host := range hosts {
+		if !shouldAutoRestart(entity.HostAutoRestartPolicy(host.AutoRestartPolicy), entity.HeadlessHostStatus_CRASHED) {
+			continue
+		}
+
+		r.decide(ctx, host)
+	}
+}
+
+// OnHostTerminated implements HostTerminationObserver. It records the crash
+// and schedules a restart if the host's policy asks for one.
+func (r *HostCrashRecoverer) OnHostTerminated(ctx context.Context, host db.Host, newStatus entity.HeadlessHostStatus) {
+	now := time.Now()
+
+	if newStatus == entity.HeadlessHostStatus_CRASHED {
+		if err := r.q.RecordHostCrash(ctx, db.RecordHostCrashParams{
+			ID:        host.ID,
+			CrashedAt: pgtype.Timestamptz{Time: now, Valid: true},
+		}); err != nil {
+			slog.Error("failed to record host crash", "hostID", host.ID, "error", err)
+		}
+	}
+
+	if !shouldAutoRestart(entity.HostAutoRestartPolicy(host.AutoRestartPolicy), newStatus) {
+		return
+	}
+
+	r.mu.Lock()
+	_, busy := r.inflight[host.ID]
+	r.mu.Unlock()
+
+	if busy {
+		// A restart is already pending, or this is its own attempt failing;
+		// restartAfter decides what happens next.
+		return
+	}
+
+	updated, err := r.q.ResetHostAutoRestartAttemptsIfStable(ctx, db.ResetHostAutoRestartAttemptsIfStableParams{
+		ID:          host.ID,
+		StableSince: pgtype.Timestamptz{Time: now.Add(-r.stableAfter), Valid: true},
+	})
+	if err != nil {
+		slog.Error("failed to reset auto restart attempts", "hostID", host.ID, "error", err)
+
+		return
+	}
+
+	r.decide(ctx, updated)
+}
+
+// decide either schedules the next restart of host or, once the host has
+// used up its consecutive restarts, suspends auto-restart and says so.
+func (r *HostCrashRecoverer) decide(ctx context.Context, host db.Host) {
+	if host.AutoRestartSuspended {
+		return
+	}
+
+	limit := autoRestartLimit(host.AutoRestartMaxRetries, r.crashLoopThreshold)
+	if host.AutoRestartAttempts >= limit {
+		if err := r.q.SuspendHostAutoRestart(ctx, host.ID); err != nil {
+			slog.Error("failed to suspend auto restart", "hostID", host.ID, "error", err)
+		}
+
+		slog.Warn("host is crash looping, giving up auto restart",
+			"hostID", host.ID, "attempts", host.AutoRestartAttempts)
+
+		r.bus.Publish(notification.HostAutoRestart(host.ID,
+			hdlctrlv1.HostAutoRestartEvent_KIND_GAVE_UP, host.AutoRestartAttempts,
+			fmt.Sprintf("ホスト %q が %d 回続けてクラッシュしたため自動再起動を停止しました", host.Name, host.AutoRestartAttempts),
+			hdlctrlv1.JobCompletedEvent_LEVEL_ERROR))
+		r.bus.Publish(notification.HostUpdated(host.ID, "", nil))
+
+		return
+	}
+
+	delay := autoRestartDelay(host.AutoRestartAttempts, r.baseDelay, r.maxDelay)
+
+	if !r.schedule(host.ID, delay) {
+		return
+	}
+
+	slog.Info("scheduled host auto restart", "hostID", host.ID,
+		"attempt", host.AutoRestartAttempts+1, "delay", delay)
+
+	r.bus.Publish(notification.HostAutoRestart(host.ID,
+		hdlctrlv1.HostAutoRestartEvent_KIND_SCHEDULED, host.AutoRestartAttempts+1,
+		fmt.Sprintf("ホスト %q が停止したため %s 後に自動再起動します", host.Name, delay),
+		hdlctrlv1.JobCompletedEvent_LEVEL_INFO))
+}
+
+// schedule starts (or, before Run, queues) a delayed restart of hostID.
+// It returns false when one is already pending.
+func (r *HostCrashRecoverer) schedule(hostID string, delay time.Duration) bool {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, ok := r.inflight[hostID]; ok {
+		return false
+	}
+
+	if r.ctx == nil {
+		if _, ok := r.queued[hostID]; ok {
+			return false
+		}
+
+		r.queued[hostID] = delay
+
+		return true
+	}
+
+	r.launchLocked(hostID, delay)
+
+	return true
+}
+
+func (r *HostCrashRecoverer) launchLocked(hostID string, delay time.Duration) {
+	r.inflight[hostID] = struct{}{}
+	r.wg.Add(1)
+
+	ctx := r.ctx
+
+	go func() {
+		defer r.wg.Done()
+
+		r.restartAfter(ctx, hostID, delay)
+	}()
+}
+
+func (r *HostCrashRecoverer) restartAfter(ctx context.Context, hostID string, delay time.Duration) {
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		r.finish(hostID)
+
+		return
+	case <-timer.C:
+	}
+
+	host, err := r.q.GetHost(ctx, hostID)
+	if err != nil {
+		r.finish(hostID)
+
+		return // deleted in the meantime
+	}
+
+	// Someone may have restarted, stopped or reconfigured the host while we
+	// were waiting.
+	status := entity.HeadlessHostStatus(host.Status)
+	if (status != entity.HeadlessHostStatus_CRASHED && status != entity.HeadlessHostStatus_EXITED) ||
+		!shouldAutoRestart(entity.HostAutoRestartPolicy(host.AutoRestartPolicy), status) ||
+		host.AutoRestartSuspended {
+		r.finish(hostID)
+
+		return
+	}
+
+	// Claim the attempt only if nobody else (another instance after a
+	// leadership change, or a user) has touched the host since we read it.
+	attempt, err := r.q.RecordHostAutoRestartAttempt(ctx, db.RecordHostAutoRestartAttemptParams{
+		ID:               hostID,
+		Status:           host.Status,
+		ExpectedAttempts: host.AutoRestartAttempts,
+		AttemptedAt:      pgtype.Timestamptz{Time: time.Now(), Valid: true},
+	})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			slog.Info("auto restart attempt was claimed elsewhere, skipping", "hostID", hostID)
+		} else {
+			slog.Error("failed to record auto restart attempt", "hostID", hostID, "error", err)
+		}
+
+		r.finish(hostID)
+
+		return
+	}
+
+	slog.Info("auto restarting host", "hostID", hostID, "attempt", attempt)
+
+	err = r.restarter.HeadlessHostRestart(ctx, hostID, nil, true, autoRestartStopTimeoutSeconds)
+	if err == nil {
+		r.finish(hostID)
+
+		r.bus.Publish(notification.HostAutoRestart(hostID,
+			hdlctrlv1.HostAutoRestartEvent_KIND_RESTARTED, attempt,
+			fmt.Sprintf("ホスト %q を自動再起動しました", host.Name),
+			hdlctrlv1.JobCompletedEvent_LEVEL_SUCCESS))
+		r.bus.Publish(notification.HostUpdated(hostID, "", nil))
+
+		return
+	}
+
+	slog.Error("failed to auto restart host", "hostID", hostID, "attempt", attempt, "error", err)
+
+	// A failed Restart can leave the host in STOPPING/STARTING. Put it back
+	// to CRASHED so the next attempt (and the UI) see it as down.
+	if err := r.q.UpdateHostStatus(ctx, db.UpdateHostStatusParams{
+		ID:     hostID,
+		Status: int32(entity.HeadlessHostStatus_CRASHED),
+	}); err != nil {
+		slog.Error("failed to update host status", "hostID", hostID, "error", err)
+	}
+
+	r.bus.Publish(notification.HostAutoRestart(hostID,
+		hdlctrlv1.HostAutoRestartEvent_KIND_FAILED, attempt,
+		fmt.Sprintf("ホスト %q の自動再起動に失敗しました: %v", host.Name, err),
+		hdlctrlv1.JobCompletedEvent_LEVEL_ERROR))
+	r.bus.Publish(notification.HostUpdated(hostID, "", nil))
+
+	r.finish(hostID)
+
+	host, err = r.q.GetHost(ctx, hostID)
+	if err != nil {
+		return
+	}
+
+	r.decide(ctx, host)
+}
+
+func (r *HostCrashRecoverer) finish(hostID string) {
+	r.mu.Lock()
+	delete(r.inflight, hostID)
+	r.mu.Unlock()
+}
+
+// isUnexpectedTermination reports whether a status transition observed by a
+// watcher means the host went down on its own. Stop, Kill and Restart all
+// move the host to STOPPING first, so only a host we still believed to be
+// alive can terminate unexpectedly.
+func isUnexpectedTermination(oldStatus int32, newStatus entity.HeadlessHostStatus) bool {
+	switch entity.HeadlessHostStatus(oldStatus) {
+	case entity.HeadlessHostStatus_RUNNING, entity.HeadlessHostStatus_STARTING:
+		return newStatus == entity.HeadlessHostStatus_CRASHED || newStatus == entity.HeadlessHostStatus_EXITED
+	default:
+		return false
+	}
+}
+
+func shouldAutoRestart(policy entity.HostAutoRestartPolicy, status entity.HeadlessHostStatus) bool {
+	switch policy {
+	case entity.HostAutoRestartPolicy_ON_CRASH:
+		return status == entity.HeadlessHostStatus_CRASHED
+	case entity.HostAutoRestartPolicy_ALWAYS:
+		return status == entity.HeadlessHostStatus_CRASHED || status == entity.HeadlessHostStatus_EXITED
+	default:
+		return false
+	}
+}
+
+func autoRestartLimit(maxRetries, crashLoopThreshold int32) int32 {
+	if maxRetries > 0 {
+		return maxRetries
+	}
+
+	return crashLoopThreshold
+}
+
+// autoRestartDelay is base * 2^attempts, capped at maxDelay.
+func autoRestartDelay(attempts int32, base, maxDelay time.Duration) time.Duration {
+	delay := base
+	for range attempts {
+		delay *= 2
+		if delay >= maxDelay {
+			return maxDelay
+		}
+	}
+
+	return min(delay, maxDelay)
+}
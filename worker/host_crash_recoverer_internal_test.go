@@ -0,0 +1,71 @@
+package worker
+
+import (
+	"testing"
+	"time"
+
+	"github.com/hantabaru1014/baru-reso-headless-controller/domain/entity"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIsUnexpectedTermination(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name      string
+		oldStatus entity.HeadlessHostStatus
+		newStatus entity.HeadlessHostStatus
+		expected  bool
+	}{
+		{"running to crashed", entity.HeadlessHostStatus_RUNNING, entity.HeadlessHostStatus_CRASHED, true},
+		{"running to exited", entity.HeadlessHostStatus_RUNNING, entity.HeadlessHostStatus_EXITED, true},
+		{"starting to crashed", entity.HeadlessHostStatus_STARTING, entity.HeadlessHostStatus_CRASHED, true},
+		// Stop / Kill / Restart move the host to STOPPING before touching the container.
+		{"stopping to exited", entity.HeadlessHostStatus_STOPPING, entity.HeadlessHostStatus_EXITED, false},
+		{"stopping to crashed", entity.HeadlessHostStatus_STOPPING, entity.HeadlessHostStatus_CRASHED, false},
+		{"exited to running", entity.HeadlessHostStatus_EXITED, entity.HeadlessHostStatus_RUNNING, false},
+		{"running to stopping", entity.HeadlessHostStatus_RUNNING, entity.HeadlessHostStatus_STOPPING, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, tt.expected, isUnexpectedTermination(int32(tt.oldStatus), tt.newStatus))
+		})
+	}
+}
+
+func TestShouldAutoRestart(t *testing.T) {
+	t.Parallel()
+
+	crashed := entity.HeadlessHostStatus_CRASHED
+	exited := entity.HeadlessHostStatus_EXITED
+
+	assert.False(t, shouldAutoRestart(entity.HostAutoRestartPolicy_UNSPECIFIED, crashed))
+	assert.False(t, shouldAutoRestart(entity.HostAutoRestartPolicy_NEVER, crashed))
+	assert.True(t, shouldAutoRestart(entity.HostAutoRestartPolicy_ON_CRASH, crashed))
+	assert.False(t, shouldAutoRestart(entity.HostAutoRestartPolicy_ON_CRASH, exited))
+	assert.True(t, shouldAutoRestart(entity.HostAutoRestartPolicy_ALWAYS, crashed))
+	assert.True(t, shouldAutoRestart(entity.HostAutoRestartPolicy_ALWAYS, exited))
+}
+
+func TestAutoRestartDelay(t *testing.T) {
+	t.Parallel()
+
+	base := 10 * time.Second
+	maxDelay := time.Minute
+
+	assert.Equal(t, 10*time.Second, autoRestartDelay(0, base, maxDelay))
+	assert.Equal(t, 20*time.Second, autoRestartDelay(1, base, maxDelay))
+	assert.Equal(t, 40*time.Second, autoRestartDelay(2, base, maxDelay))
+	assert.Equal(t, time.Minute, autoRestartDelay(3, base, maxDelay))
+	assert.Equal(t, time.Minute, autoRestartDelay(100, base, maxDelay), "must not overflow")
+}
+
+func TestAutoRestartLimit(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, int32(3), autoRestartLimit(3, 5))
+	assert.Equal(t, int32(5), autoRestartLimit(0, 5))
+}
@@ -0,0 +1,252 @@
+package worker_test
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/hantabaru1014/baru-reso-headless-controller/config"
+	"github.com/hantabaru1014/baru-reso-headless-controller/db"
+	"github.com/hantabaru1014/baru-reso-headless-controller/domain/entity"
+	hdlctrlv1 "github.com/hantabaru1014/baru-reso-headless-controller/pbgen/hdlctrl/v1"
+	"github.com/hantabaru1014/baru-reso-headless-controller/testutil"
+	"github.com/hantabaru1014/baru-reso-headless-controller/usecase/notification"
+	"github.com/hantabaru1014/baru-reso-headless-controller/worker"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// stubHostRestarter は HeadlessHostRestart の呼び出しを記録する. restartErr が nil なら
+// 実際の再起動の代わりに host を RUNNING に戻す.
+type stubHostRestarter struct {
+	q          *db.Queries
+	restartErr error
+
+	mu    sync.Mutex
+	calls []bool // withWorldRestart
+}
+
+func (s *stubHostRestarter) HeadlessHostRestart(ctx context.Context, id string, _ *string, withWorldRestart bool, _ int) error {
+	s.mu.Lock()
+	s.calls = append(s.calls, withWorldRestart)
+	s.mu.Unlock()
+
+	if s.restartErr != nil {
+		return s.restartErr
+	}
+
+	return s.q.UpdateHostStatus(ctx, db.UpdateHostStatusParams{ID: id, Status: int32(entity.HeadlessHostStatus_RUNNING)})
+}
+
+func (s *stubHostRestarter) callCount() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return len(s.calls)
+}
+
+func newTestCrashRecoverer(t *testing.T, q *db.Queries, restarter worker.HostRestarter, bus notification.Bus) *worker.HostCrashRecoverer {
+	t.Helper()
+
+	r := worker.NewHostCrashRecoverer(q, restarter, bus, &config.WorkerConfig{
+		AutoRestartBaseDelay:          10 * time.Millisecond,
+		AutoRestartMaxDelay:           20 * time.Millisecond,
+		AutoRestartStableAfter:        time.Hour,
+		AutoRestartCrashLoopThreshold: 2,
+	})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan struct{})
+
+	go func() {
+		defer close(done)
+
+		_ = r.Run(ctx)
+	}()
+
+	t.Cleanup(func() {
+		cancel()
+		<-done
+	})
+
+	return r
+}
+
+// crashHost は watcher と同じく status を CRASHED にしてから observer に伝える.
+func crashHost(t *testing.T, q *db.Queries, r *worker.HostCrashRecoverer, host db.Host) {
+	t.Helper()
+
+	require.NoError(t, q.UpdateHostStatus(t.Context(), db.UpdateHostStatusParams{
+		ID:     host.ID,
+		Status: int32(entity.HeadlessHostStatus_CRASHED),
+	}))
+	r.OnHostTerminated(t.Context(), host, entity.HeadlessHostStatus_CRASHED)
+}
+
+func TestHostCrashRecoverer_RestartsCrashedHost(t *testing.T) {
+	queries, _ := testutil.SetupTestDB(t)
+
+	account := testutil.CreateTestHeadlessAccount(t, queries, "U-crash-restart", "cred", "pass")
+	host := testutil.CreateTestHeadlessHost(t, queries, account.ResoniteID, "crashy", entity.HeadlessHostStatus_RUNNING)
+	require.NoError(t, queries.UpdateHostAutoRestartPolicy(t.Context(), db.UpdateHostAutoRestartPolicyParams{
+		ID:                host.ID,
+		AutoRestartPolicy: int32(entity.HostAutoRestartPolicy_ON_CRASH),
+	}))
+	host, err := queries.GetHost(t.Context(), host.ID)
+	require.NoError(t, err)
+
+	restarter := &stubHostRestarter{q: queries}
+	r := newTestCrashRecoverer(t, queries, restarter, notification.NewBus())
+
+	crashHost(t, queries, r, host)
+
+	require.Eventually(t, func() bool { return restarter.callCount() == 1 }, 5*time.Second, 10*time.Millisecond)
+	restarter.mu.Lock()
+	assert.True(t, restarter.calls[0], "sessions must be brought back with the host")
+	restarter.mu.Unlock()
+
+	require.Eventually(t, func() bool {
+		got, err := queries.GetHost(t.Context(), host.ID)
+
+		return err == nil && got.Status == int32(entity.HeadlessHostStatus_RUNNING)
+	}, 5*time.Second, 10*time.Millisecond)
+
+	got, err := queries.GetHost(t.Context(), host.ID)
+	require.NoError(t, err)
+	assert.Equal(t, int32(1), got.CrashCount)
+	assert.True(t, got.LastCrashedAt.Valid)
+	assert.Equal(t, int32(1), got.AutoRestartAttempts)
+	assert.False(t, got.AutoRestartSuspended)
+}
+
+func TestHostCrashRecoverer_ResumedRestartIsClaimedOnce(t *testing.T) {
+	queries, _ := testutil.SetupTestDB(t)
+
+	account := testutil.CreateTestHeadlessAccount(t, queries, "U-crash-resume", "cred", "pass")
+	host := testutil.CreateTestHeadlessHost(t, queries, account.ResoniteID, "crashy", entity.HeadlessHostStatus_CRASHED)
+	require.NoError(t, queries.UpdateHostAutoRestartPolicy(t.Context(), db.UpdateHostAutoRestartPolicyParams{
+		ID:                host.ID,
+		AutoRestartPolicy: int32(entity.HostAutoRestartPolicy_ON_CRASH),
+	}))
+
+	// 2 つのインスタンスが同じクラッシュしたホストを拾っても、再起動するのは片方だけ.
+	restarter := &stubHostRestarter{q: queries}
+	for range 2 {
+		r := newTestCrashRecoverer(t, queries, restarter, notification.NewBus())
+		resumer := r.Resumer()
+
+		ctx, cancel := context.WithCancel(context.Background())
+		done := make(chan struct{})
+
+		go func() {
+			defer close(done)
+
+			_ = resumer.Run(ctx)
+		}()
+
+		t.Cleanup(func() {
+			cancel()
+			<-done
+		})
+	}
+
+	require.Eventually(t, func() bool {
+		got, err := queries.GetHost(t.Context(), host.ID)
+
+		return err == nil && got.Status == int32(entity.HeadlessHostStatus_RUNNING)
+	}, 5*time.Second, 10*time.Millisecond)
+
+	time.Sleep(100 * time.Millisecond)
+	assert.Equal(t, 1, restarter.callCount())
+
+	got, err := queries.GetHost(t.Context(), host.ID)
+	require.NoError(t, err)
+	assert.Equal(t, int32(1), got.AutoRestartAttempts)
+}
+
+func TestHostCrashRecoverer_NeverPolicyOnlyCountsCrash(t *testing.T) {
+	queries, _ := testutil.SetupTestDB(t)
+
+	account := testutil.CreateTestHeadlessAccount(t, queries, "U-crash-never", "cred", "pass")
+	host := testutil.CreateTestHeadlessHost(t, queries, account.ResoniteID, "crashy", entity.HeadlessHostStatus_RUNNING)
+
+	restarter := &stubHostRestarter{q: queries}
+	r := newTestCrashRecoverer(t, queries, restarter, notification.NewBus())
+
+	crashHost(t, queries, r, host)
+
+	// 既定値 (UNSPECIFIED = NEVER) では再起動しない.
+	time.Sleep(100 * time.Millisecond)
+	assert.Zero(t, restarter.callCount())
+
+	got, err := queries.GetHost(t.Context(), host.ID)
+	require.NoError(t, err)
+	assert.Equal(t, int32(1), got.CrashCount)
+	assert.Equal(t, int32(entity.HeadlessHostStatus_CRASHED), got.Status)
+}
+
+func TestHostCrashRecoverer_GivesUpOnCrashLoop(t *testing.T) {
+	queries, _ := testutil.SetupTestDB(t)
+
+	account := testutil.CreateTestHeadlessAccount(t, queries, "U-crash-loop", "cred", "pass")
+	host := testutil.CreateTestHeadlessHost(t, queries, account.ResoniteID, "crashy", entity.HeadlessHostStatus_RUNNING)
+	require.NoError(t, queries.UpdateHostAutoRestartPolicy(t.Context(), db.UpdateHostAutoRestartPolicyParams{
+		ID:                host.ID,
+		AutoRestartPolicy: int32(entity.HostAutoRestartPolicy_ALWAYS),
+	}))
+	host, err := queries.GetHost(t.Context(), host.ID)
+	require.NoError(t, err)
+
+	bus := notification.NewBus()
+	events, cleanup := bus.Subscribe(t.Context(), "")
+	defer cleanup()
+
+	restarter := &stubHostRestarter{q: queries, restartErr: errors.New("container failed to start")}
+	r := newTestCrashRecoverer(t, queries, restarter, bus)
+
+	crashHost(t, queries, r, host)
+
+	// crash loop 判定回数 (2) だけ試して止まる.
+	require.Eventually(t, func() bool {
+		got, err := queries.GetHost(t.Context(), host.ID)
+
+		return err == nil && got.AutoRestartSuspended
+	}, 5*time.Second, 10*time.Millisecond)
+	assert.Equal(t, 2, restarter.callCount())
+
+	var kinds []hdlctrlv1.HostAutoRestartEvent_Kind
+
+	timeout := time.After(5 * time.Second)
+
+	for len(kinds) == 0 || kinds[len(kinds)-1] != hdlctrlv1.HostAutoRestartEvent_KIND_GAVE_UP {
+		select {
+		case ev := <-events:
+			if p := ev.GetHostAutoRestart(); p != nil {
+				kinds = append(kinds, p.GetKind())
+			}
+		case <-timeout:
+			t.Fatalf("GAVE_UP was not published: %v", kinds)
+		}
+	}
+
+	assert.Equal(t, []hdlctrlv1.HostAutoRestartEvent_Kind{
+		hdlctrlv1.HostAutoRestartEvent_KIND_SCHEDULED,
+		hdlctrlv1.HostAutoRestartEvent_KIND_FAILED,
+		hdlctrlv1.HostAutoRestartEvent_KIND_SCHEDULED,
+		hdlctrlv1.HostAutoRestartEvent_KIND_FAILED,
+		hdlctrlv1.HostAutoRestartEvent_KIND_GAVE_UP,
+	}, kinds)
+
+	// ポリシーを更新すると停止が解除される.
+	require.NoError(t, queries.UpdateHostAutoRestartPolicy(t.Context(), db.UpdateHostAutoRestartPolicyParams{
+		ID:                host.ID,
+		AutoRestartPolicy: int32(entity.HostAutoRestartPolicy_ALWAYS),
+	}))
+
+	got, err := queries.GetHost(t.Context(), host.ID)
+	require.NoError(t, err)
+	assert.False(t, got.AutoRestartSuspended)
+	assert.Zero(t, got.AutoRestartAttempts)
+}
@@ -9,6 +9,7 @@ import (
 	"time"
 
 	"github.com/hantabaru1014/baru-reso-headless-controller/config"
+	"github.com/hantabaru1014/baru-reso-headless-controller/lib/metrics"
 	headlessv1 "github.com/hantabaru1014/baru-reso-headless-controller/pbgen/headless/v1"
 	"github.com/hantabaru1014/baru-reso-headless-controller/usecase/port"
 	"google.golang.org/grpc/codes"
@@ -43,10 +44,16 @@ type HostEventHandler interface {
 // restarts) to the host_event_checkpoints table so a controller restart
 // resumes from where it left off rather than dropping events that
 // happened during downtime.
+//
+// With several controller instances each one only streams the hosts that
+// ownership assigns to it. When an instance goes away its hosts are picked
+// up by the others on their next reconcile and resume from the shared
+// checkpoints.
 type HostEventWatcher struct {
-	hostRepo port.HeadlessHostRepository
-	store    HostEventStore
-	handlers []HostEventHandler
+	hostRepo  port.HeadlessHostRepository
+	store     HostEventStore
+	ownership port.StreamOwnership
+	handlers  []HostEventHandler
 
 	pollInterval     time.Duration
 	reconnectDelay   time.Duration
@@ -66,12 +73,14 @@ var _ Runner = (*HostEventWatcher)(nil)
 func NewHostEventWatcher(
 	hostRepo port.HeadlessHostRepository,
 	store HostEventStore,
+	ownership port.StreamOwnership,
 	cfg *config.WorkerConfig,
 	handlers []HostEventHandler,
 ) *HostEventWatcher {
 	return &HostEventWatcher{
 		hostRepo:         hostRepo,
 		store:            store,
+		ownership:        ownership,
 		handlers:         handlers,
 		pollInterval:     cfg.HostEventPollInterval,
 		reconnectDelay:   cfg.EventReconnectDelay,
@@ -101,7 +110,8 @@ func (w *HostEventWatcher) Run(ctx context.Context) error {
 }
 
 // reconcile aligns the set of running per-host streams with the set of
-// hosts that are currently RUNNING according to the DB.
+// hosts that are currently RUNNING according to the DB and owned by this
+// instance.
 func (w *HostEventWatcher) reconcile(ctx context.Context) {
 	ids, err := w.store.ListRunningHostIDs(ctx)
 	if err != nil {
@@ -111,11 +121,15 @@ func (w *HostEventWatcher) reconcile(ctx context.Context) {
 	}
 
 	desired := make(map[string]struct{}, len(ids))
+
 	for _, id := range ids {
-		desired[id] = struct{}{}
+		if w.ownership.Owns(id) {
+			desired[id] = struct{}{}
+		}
 	}
 
-	// Cancel streams for hosts that are no longer running and wait for
+	// Cancel streams for hosts that are no longer running (or moved to
+	// another instance) and wait for
 	// each goroutine to exit before forgetting it. Waiting prevents a
 	// host that flaps RUNNING→stopped→RUNNING across two reconcile ticks
 	// from racing two concurrent stream goroutines (with two checkpoint
@@ -177,6 +191,10 @@ func (w *HostEventWatcher) runHostStream(ctx context.Context, hostID string) {
 		lastEventID = ""
 	}
 
+	// opened tells streamOnce whether this host already had a stream in
+	// this run, so only reopenings are counted as reconnects.
+	opened := false
+
 	RetryWithBackoff(
 		ctx,
 		w.Name()+":"+hostID,
@@ -184,12 +202,12 @@ func (w *HostEventWatcher) runHostStream(ctx context.Context, hostID string) {
 		w.maxReconnectWait,
 		stableConnectionThreshold,
 		func(ctx context.Context) error {
-			return w.streamOnce(ctx, hostID, &lastEventID)
+			return w.streamOnce(ctx, hostID, &lastEventID, &opened)
 		},
 	)
 }
 
-func (w *HostEventWatcher) streamOnce(ctx context.Context, hostID string, lastEventID *string) error {
+func (w *HostEventWatcher) streamOnce(ctx context.Context, hostID string, lastEventID *string, opened *bool) error {
 	client, err := w.hostRepo.GetRpcClient(ctx, hostID)
 	if err != nil {
 		return err
@@ -204,6 +222,12 @@ func (w *HostEventWatcher) streamOnce(ctx context.Context, hostID string, lastEv
 
 	slog.Info("host event stream opened", "hostID", hostID, "afterEventID", *lastEventID)
 
+	if *opened {
+		metrics.HostEventStreamReconnects.Inc()
+	}
+
+	*opened = true
+
 	for {
 		ev, err := stream.Recv()
 		if err != nil {
@@ -217,6 +241,7 @@ func (w *HostEventWatcher) streamOnce(ctx context.Context, hostID string, lastEv
 
 				*lastEventID = ""
 
+				metrics.HostEventStreamResets.Inc()
 				w.deleteCheckpoint(ctx, hostID)
 				w.notifyReset(ctx, hostID)
 
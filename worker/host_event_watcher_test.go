@@ -171,7 +171,7 @@ func newRunningHost(id string) *entity.HeadlessHost {
 }
 
 func newWatcher(repo port.HeadlessHostRepository, store HostEventStore, handlers []HostEventHandler) *HostEventWatcher {
-	return NewHostEventWatcher(repo, store, &config.WorkerConfig{
+	return NewHostEventWatcher(repo, store, port.SingleInstanceOwnership{}, &config.WorkerConfig{
 		EventReconnectDelay:   5 * time.Millisecond,
 		EventMaxReconnectWait: 20 * time.Millisecond,
 		HostEventPollInterval: 10 * time.Millisecond,
@@ -518,6 +518,79 @@ func TestHostEventWatcher_NonRunningHostsAreIgnored(t *testing.T) {
 	assert.True(t, ok)
 }
 
+// switchableOwnership owns the hosts in its set, which tests can change
+// while the watcher runs.
+type switchableOwnership struct {
+	mu    sync.Mutex
+	owned map[string]bool
+}
+
+func (o *switchableOwnership) Owns(key string) bool {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+
+	return o.owned[key]
+}
+
+func (o *switchableOwnership) set(owned ...string) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+
+	o.owned = make(map[string]bool, len(owned))
+	for _, id := range owned {
+		o.owned[id] = true
+	}
+}
+
+func TestHostEventWatcher_OnlyStreamsOwnedHosts(t *testing.T) {
+	t.Parallel()
+
+	client := &fakeClient{streamFactory: func(_ int, _ *headlessv1.WatchHostEventsRequest) (grpc.ServerStreamingClient[headlessv1.HostEvent], error) {
+		return &fakeStream{events: make(chan recvResult)}, nil
+	}}
+	repo := &fakeHostRepo{
+		hosts:   entity.HeadlessHostList{newRunningHost("host-1"), newRunningHost("host-2")},
+		clients: map[string]headlessv1.HeadlessControlServiceClient{"host-1": client, "host-2": client},
+	}
+	ownership := &switchableOwnership{}
+	ownership.set("host-1")
+
+	w := NewHostEventWatcher(repo, newFakeHostEventStore(repo), ownership, &config.WorkerConfig{
+		EventReconnectDelay:   5 * time.Millisecond,
+		EventMaxReconnectWait: 20 * time.Millisecond,
+		HostEventPollInterval: 10 * time.Millisecond,
+	}, []HostEventHandler{&recordingHandler{}})
+
+	go func() { _ = w.Run(t.Context()) }()
+
+	streamedHosts := func() []string {
+		w.mu.Lock()
+		defer w.mu.Unlock()
+
+		ids := make([]string, 0, len(w.streams))
+		for id := range w.streams {
+			ids = append(ids, id)
+		}
+
+		return ids
+	}
+
+	waitFor(t, "owned host streamed", func() bool {
+		ids := streamedHosts()
+
+		return len(ids) == 1 && ids[0] == "host-1"
+	})
+
+	// Another instance took over host-1 and this one got host-2.
+	ownership.set("host-2")
+
+	waitFor(t, "ownership moved", func() bool {
+		ids := streamedHosts()
+
+		return len(ids) == 1 && ids[0] == "host-2"
+	})
+}
+
 func TestHostEventWatcher_ResumesFromPersistedCheckpointOnStartup(t *testing.T) {
 	t.Parallel()
 
@@ -42,6 +42,9 @@ import (
 //
 // State is purely in-memory; a controller restart loses it but the next
 // ImageChecker poll will re-discover the upgrade candidate.
+//
+// With several controller instances the orchestrator only runs on the
+// leader (see SetDrainStore).
 type HostUpgradeOrchestrator struct {
 	hostRepo           port.HeadlessHostRepository
 	sessionRepo        port.SessionRepository
@@ -60,6 +63,9 @@ type HostUpgradeOrchestrator struct {
 	stopperMu      sync.RWMutex
 	sessionStopper port.SessionStopper
 
+	// drainStore is nil for a single controller instance; see SetDrainStore.
+	drainStore HostDrainStore
+
 	// lifecycleCtx is the ctx the Manager passed to Run. OnNewImage
 	// spawns its per-host work in a goroutine using this ctx (rather
 	// than the short-lived ctx ImageChecker hands to observers) so
@@ -71,14 +77,23 @@ type HostUpgradeOrchestrator struct {
 
 // drainState is everything the orchestrator captured at enroll time —
 // the StartupConfig snapshot (in particular start_worlds), the resolved
-// account credentials and the target tag — plus the running attempt
-// counter so a permanently-failing restart eventually aborts.
+// account (credentials still sealed; the connector decrypts them) and the
+// target tag — plus the running attempt counter so a permanently-failing
+// restart eventually aborts.
 type drainState struct {
 	targetTag    string
 	restartParms port.HeadlessHostStartParams
 	attempts     int
 }
 
+// HostDrainStore shares the drain set with the other controller instances,
+// which serve StartSession for draining hosts too.
+type HostDrainStore interface {
+	AddDrain(ctx context.Context, hostID, targetTag string) error
+	RemoveDrain(ctx context.Context, hostID string) error
+	ClearDrains(ctx context.Context) error
+}
+
 // HeadlessAccountFetcher is the slice of HeadlessAccountUsecase the
 // orchestrator needs. It is an interface so tests can substitute it
 // without pulling in skyfrost.
@@ -136,6 +151,16 @@ func (o *HostUpgradeOrchestrator) SetSessionStopper(s port.SessionStopper) {
 	o.sessionStopper = s
 }
 
+// SetDrainStore prepares the orchestrator for a multi-instance deployment,
+// where it runs on the leader only. The drain set is mirrored to store,
+// and every leadership term starts from an empty one just like a
+// controller restart. Host events of most hosts are consumed by other
+// instances, so the periodic tick no longer trusts cached user counts and
+// re-fetches them by RPC. Call it before the manager starts.
+func (o *HostUpgradeOrchestrator) SetDrainStore(store HostDrainStore) {
+	o.drainStore = store
+}
+
 func (o *HostUpgradeOrchestrator) Name() string { return "host-upgrade-orchestrator" }
 
 // IsHostDraining implements port.HostDrainer.
@@ -163,6 +188,17 @@ func (o *HostUpgradeOrchestrator) Run(ctx context.Context) error {
 	o.lifecycleCtx = ctx
 	o.lifecycleMu.Unlock()
 
+	if o.drainStore != nil {
+		o.mu.Lock()
+		o.drainTargets = make(map[string]*drainState)
+		o.sessionUsers = make(map[string]map[string]int32)
+		o.mu.Unlock()
+
+		if err := o.drainStore.ClearDrains(ctx); err != nil {
+			slog.Error("upgrade-orchestrator: failed to clear the shared drain set", "error", err)
+		}
+	}
+
 	ticker := time.NewTicker(o.interval)
 	defer ticker.Stop()
 
@@ -242,6 +278,12 @@ func (o *HostUpgradeOrchestrator) HandleHostEventStreamReset(ctx context.Context
 // reconcileAllDraining is the periodic catch-up pass.
 func (o *HostUpgradeOrchestrator) reconcileAllDraining(ctx context.Context) {
 	for _, id := range o.drainingHostIDs() {
+		if o.drainStore != nil {
+			o.mu.Lock()
+			delete(o.sessionUsers, id)
+			o.mu.Unlock()
+		}
+
 		o.reconcileHost(ctx, id)
 	}
 }
@@ -336,6 +378,12 @@ func (o *HostUpgradeOrchestrator) enrollHost(ctx context.Context, host *entity.H
 	o.drainTargets[host.ID] = state
 	o.mu.Unlock()
 
+	if o.drainStore != nil {
+		if err := o.drainStore.AddDrain(ctx, host.ID, targetTag); err != nil {
+			slog.Error("upgrade-orchestrator: failed to share drain state", "hostID", host.ID, "error", err)
+		}
+	}
+
 	slog.Info("upgrade-orchestrator: enrolled host for drain",
 		"hostID", host.ID, "currentAppVersion", host.AppVersion, "targetTag", targetTag)
 
@@ -451,6 +499,8 @@ func (o *HostUpgradeOrchestrator) reconcileHost(ctx context.Context, hostID stri
 	delete(o.sessionUsers, hostID)
 	o.mu.Unlock()
 
+	o.removeSharedDrain(ctx, hostID)
+
 	slog.Info("upgrade-orchestrator: host upgraded", "hostID", hostID, "tag", state.targetTag)
 }
 
@@ -476,6 +526,8 @@ func (o *HostUpgradeOrchestrator) recordRestartFailure(hostID string, restartErr
 	o.mu.Unlock()
 
 	if attempts >= o.maxRestartAttempts {
+		o.removeSharedDrain(o.lifecycle(), hostID)
+
 		slog.Error("upgrade-orchestrator: giving up after repeated restart failures",
 			"hostID", hostID, "tag", target, "attempts", attempts, "lastError", restartErr)
 	} else {
@@ -484,6 +536,16 @@ func (o *HostUpgradeOrchestrator) recordRestartFailure(hostID string, restartErr
 	}
 }
 
+func (o *HostUpgradeOrchestrator) removeSharedDrain(ctx context.Context, hostID string) {
+	if o.drainStore == nil {
+		return
+	}
+
+	if err := o.drainStore.RemoveDrain(ctx, hostID); err != nil {
+		slog.Error("upgrade-orchestrator: failed to remove shared drain state", "hostID", hostID, "error", err)
+	}
+}
+
 // runningSessionsForHost returns RUNNING sessions on this host based on
 // the DB (which the HostEventWatcher keeps up to date).
 func (o *HostUpgradeOrchestrator) runningSessionsForHost(ctx context.Context, hostID string) (entity.SessionList, error) {
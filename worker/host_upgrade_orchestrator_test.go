@@ -166,7 +166,7 @@ func (r *orchestratorSessionRepo) Upsert(_ context.Context, s *entity.Session) e
 type fakeAccountFetcher struct{}
 
 func (fakeAccountFetcher) GetHeadlessAccount(_ context.Context, id string) (*entity.HeadlessAccount, error) {
-	return &entity.HeadlessAccount{ResoniteID: id, Credential: "cred", Password: "pw"}, nil
+	return &entity.HeadlessAccount{ResoniteID: id, Credentials: entity.SealedCredentials{KeyID: "k1", Credential: []byte("sealed-cred"), Password: []byte("sealed-pw")}}, nil
 }
 
 // stubRPCClient is a tiny HeadlessControlServiceClient covering the RPCs
@@ -0,0 +1,184 @@
+package worker
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"strings"
+	"time"
+
+	"github.com/hantabaru1014/baru-reso-headless-controller/adapter/hostconnector"
+	"github.com/hantabaru1014/baru-reso-headless-controller/config"
+	"github.com/hantabaru1014/baru-reso-headless-controller/db"
+	"github.com/hantabaru1014/baru-reso-headless-controller/domain/entity"
+	"github.com/hantabaru1014/baru-reso-headless-controller/usecase/notification"
+	"github.com/hantabaru1014/baru-reso-headless-controller/usecase/port"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+// KubernetesPodWatcher is the Kubernetes counterpart of DockerEventWatcher.
+// It lists the pods we manage, reconciles the kubernetes hosts against that
+// list, then follows a watch from the list's resource version. When the
+// watch ends (API server timeouts close it routinely) it starts over with a
+// fresh list, so nothing that happened in between is missed.
+type KubernetesPodWatcher struct {
+	kc       *hostconnector.KubernetesHostConnector
+	q        *db.Queries
+	bus      notification.Bus
+	observer HostTerminationObserver
+
+	reconnectDelay   time.Duration
+	maxReconnectWait time.Duration
+}
+
+var _ Runner = (*KubernetesPodWatcher)(nil)
+
+func NewKubernetesPodWatcher(
+	kc *hostconnector.KubernetesHostConnector,
+	q *db.Queries,
+	bus notification.Bus,
+	observer HostTerminationObserver,
+	cfg *config.WorkerConfig,
+) *KubernetesPodWatcher {
+	return &KubernetesPodWatcher{
+		kc:               kc,
+		q:                q,
+		bus:              bus,
+		observer:         observer,
+		reconnectDelay:   cfg.EventReconnectDelay,
+		maxReconnectWait: cfg.EventMaxReconnectWait,
+	}
+}
+
+func (w *KubernetesPodWatcher) Name() string { return "kubernetes-pod-watcher" }
+
+func (w *KubernetesPodWatcher) Run(ctx context.Context) error {
+	RetryWithBackoff(
+		ctx,
+		w.Name(),
+		w.reconnectDelay,
+		w.maxReconnectWait,
+		stableConnectionThreshold,
+		w.watchOnce,
+	)
+
+	return ctx.Err()
+}
+
+func (w *KubernetesPodWatcher) watchOnce(ctx context.Context) error {
+	statuses, resourceVersion, err := w.kc.ListPodStatuses(ctx)
+	if err != nil {
+		return err
+	}
+
+	w.syncStatuses(ctx, statuses)
+
+	events, errChan, err := w.kc.WatchPods(ctx, resourceVersion)
+	if err != nil {
+		return err
+	}
+
+	slog.Info("watching kubernetes pods", "resourceVersion", resourceVersion)
+
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case event, ok := <-events:
+			if !ok {
+				select {
+				case err := <-errChan:
+					return err
+				default:
+				}
+
+				return errors.New("kubernetes pod watch closed")
+			}
+
+			w.handleEvent(ctx, event)
+		}
+	}
+}
+
+// syncStatuses reconciles every kubernetes host with the listed pods. A host
+// whose pod no longer exists is treated the same way as a Docker host whose
+// container disappeared.
+func (w *KubernetesPodWatcher) syncStatuses(ctx context.Context, statuses map[string]entity.HeadlessHostStatus) {
+	hosts, err := w.q.ListHosts(ctx)
+	if err != nil {
+		slog.Error("failed to list hosts", "error", err)
+
+		return
+	}
+
+	for _, host := range hosts {
+		if port.HostConnectorType(host.ConnectorType) != port.HostConnectorType_KUBERNETES {
+			continue
+		}
+
+		prefix, _, ok := strings.Cut(host.ConnectString, ":")
+		if !ok {
+			continue
+		}
+
+		status, exists := statuses[prefix]
+		if !exists {
+			status = podGoneStatus(host.Status)
+		}
+
+		w.updateStatus(ctx, host, status)
+	}
+}
+
+func (w *KubernetesPodWatcher) handleEvent(ctx context.Context, event hostconnector.PodEvent) {
+	host, err := w.q.GetHostByContainerID(ctx, pgtype.Text{String: event.ConnectStringPrefix, Valid: true})
+	if err != nil {
+		return // not one of ours
+	}
+
+	status := event.Status
+	if event.Deleted {
+		status = podGoneStatus(host.Status)
+	}
+
+	w.updateStatus(ctx, host, status)
+}
+
+func (w *KubernetesPodWatcher) updateStatus(ctx context.Context, host db.Host, newStatus entity.HeadlessHostStatus) {
+	if newStatus == entity.HeadlessHostStatus_UNKNOWN || host.Status == int32(newStatus) {
+		return
+	}
+
+	if err := w.q.UpdateHostStatus(ctx, db.UpdateHostStatusParams{
+		ID:     host.ID,
+		Status: int32(newStatus),
+	}); err != nil {
+		slog.Error("failed to update host status from pod", "hostID", host.ID, "error", err)
+
+		return
+	}
+
+	w.bus.Publish(notification.HostUpdated(host.ID, "", nil))
+
+	slog.Info("updated host status from kubernetes pod",
+		"hostID", host.ID, "oldStatus", host.Status, "newStatus", newStatus)
+
+	if isUnexpectedTermination(host.Status, newStatus) {
+		w.observer.OnHostTerminated(ctx, host, newStatus)
+	}
+}
+
+// podGoneStatus decides what a host becomes once its pod is deleted. Stop
+// and Kill delete the pod on purpose, so a STOPPING host has exited; a host
+// we still believed alive lost its pod behind our back (eviction, node
+// failure, kubectl delete). Terminal states are left alone.
+func podGoneStatus(current int32) entity.HeadlessHostStatus {
+	switch entity.HeadlessHostStatus(current) {
+	case entity.HeadlessHostStatus_STOPPING:
+		return entity.HeadlessHostStatus_EXITED
+	case entity.HeadlessHostStatus_RUNNING, entity.HeadlessHostStatus_STARTING:
+		return entity.HeadlessHostStatus_CRASHED
+	default:
+		return entity.HeadlessHostStatus_UNKNOWN
+	}
+}
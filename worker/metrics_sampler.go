@@ -0,0 +1,251 @@
+package worker
+
+import (
+	"context"
+	"log/slog"
+	"sync"
+	"time"
+
+	"github.com/hantabaru1014/baru-reso-headless-controller/config"
+	"github.com/hantabaru1014/baru-reso-headless-controller/domain/entity"
+	headlessv1 "github.com/hantabaru1014/baru-reso-headless-controller/pbgen/headless/v1"
+	"github.com/hantabaru1014/baru-reso-headless-controller/usecase/port"
+)
+
+const (
+	// metricsStatusTimeout bounds the GetStatus call to one host so an
+	// unresponsive host only loses its own FPS sample.
+	metricsStatusTimeout = 5 * time.Second
+	// metricsMinuteRollupLookback and metricsHourRollupLookback are how far
+	// back the first rollup after a (re)start reaches. They cover the gap
+	// left by a restart or a leader change; buckets that are already rolled
+	// up are simply computed again.
+	metricsMinuteRollupLookback = time.Hour
+	metricsHourRollupLookback   = 24 * time.Hour
+	// metricsPruneInterval is how often samples past their retention are
+	// deleted.
+	metricsPruneInterval = 10 * time.Minute
+)
+
+// SessionStateRanger walks the cached snapshots of the running sessions.
+// sessionstate.MemoryCache provides it; with clustering it holds the
+// sessions of every instance, not only the ones streamed here.
+type SessionStateRanger interface {
+	Range(fn func(hostID, sessionID string, snapshot *headlessv1.Session))
+}
+
+// MetricsSampler records the FPS, running state and user counts of hosts
+// and the user count of sessions into metric_samples, then rolls the raw
+// samples up into 1m and 1h buckets and deletes each resolution once it is
+// past its retention. The charts on the dashboard read these series, the
+// Prometheus endpoint only ever sees the current values.
+type MetricsSampler struct {
+	repo       port.MetricSampleRepository
+	hostRepo   port.HeadlessHostRepository
+	stateCache SessionStateRanger
+	interval   time.Duration
+	retentions map[entity.MetricResolution]time.Duration
+	now        func() time.Time
+
+	minuteRolledUntil time.Time
+	hourRolledUntil   time.Time
+	prunedAt          time.Time
+}
+
+func NewMetricsSampler(
+	repo port.MetricSampleRepository,
+	hostRepo port.HeadlessHostRepository,
+	stateCache SessionStateRanger,
+	cfg *config.WorkerConfig,
+) *MetricsSampler {
+	return &MetricsSampler{
+		repo:       repo,
+		hostRepo:   hostRepo,
+		stateCache: stateCache,
+		interval:   cfg.MetricsSampleInterval,
+		retentions: map[entity.MetricResolution]time.Duration{
+			entity.MetricResolution_Raw:    cfg.MetricsRawRetention,
+			entity.MetricResolution_Minute: cfg.MetricsMinuteRetention,
+			entity.MetricResolution_Hour:   cfg.MetricsHourRetention,
+		},
+		now: time.Now,
+	}
+}
+
+func (s *MetricsSampler) Name() string { return "metrics-sampler" }
+
+func (s *MetricsSampler) Run(ctx context.Context) error {
+	s.Tick(ctx)
+
+	ticker := time.NewTicker(s.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+			s.Tick(ctx)
+		}
+	}
+}
+
+// Tick records one round of samples, rolls up the buckets that have closed
+// since the previous tick and, every metricsPruneInterval, deletes expired
+// samples. Failures are logged; a failed rollup is retried on the next tick.
+func (s *MetricsSampler) Tick(ctx context.Context) {
+	now := s.now()
+
+	s.sample(ctx, now)
+	s.rollup(ctx, now)
+
+	if now.Sub(s.prunedAt) >= metricsPruneInterval {
+		s.prune(ctx, now)
+		s.prunedAt = now
+	}
+}
+
+func (s *MetricsSampler) sample(ctx context.Context, now time.Time) {
+	statuses, err := s.hostRepo.ListStatuses(ctx)
+	if err != nil {
+		slog.Error("metrics-sampler: failed to list hosts", "error", err)
+
+		return
+	}
+
+	hostUsers := make(map[string]int32, len(statuses))
+
+	var values []port.RawMetricValue
+
+	s.stateCache.Range(func(hostID, sessionID string, snapshot *headlessv1.Session) {
+		hostUsers[hostID] += snapshot.GetUsersCount()
+		values = append(values, port.RawMetricValue{
+			Kind:     entity.MetricKind_SessionUsers,
+			TargetID: sessionID,
+			Value:    float64(snapshot.GetUsersCount()),
+		})
+	})
+
+	running := make([]string, 0, len(statuses))
+
+	for hostID, status := range statuses {
+		up := 0.0
+		if status == entity.HeadlessHostStatus_RUNNING {
+			up = 1
+			running = append(running, hostID)
+			values = append(values, port.RawMetricValue{
+				Kind:     entity.MetricKind_HostUsers,
+				TargetID: hostID,
+				Value:    float64(hostUsers[hostID]),
+			})
+		}
+
+		values = append(values, port.RawMetricValue{
+			Kind:     entity.MetricKind_HostRunning,
+			TargetID: hostID,
+			Value:    up,
+		})
+	}
+
+	values = append(values, s.sampleFps(ctx, running)...)
+
+	if err := s.repo.InsertRaw(ctx, now, values); err != nil {
+		slog.Error("metrics-sampler: failed to record samples", "error", err)
+	}
+}
+
+// sampleFps asks the running hosts for their FPS in parallel. Hosts that
+// do not answer are left out rather than recorded as 0 FPS.
+func (s *MetricsSampler) sampleFps(ctx context.Context, hostIDs []string) []port.RawMetricValue {
+	var (
+		mu     sync.Mutex
+		wg     sync.WaitGroup
+		values = make([]port.RawMetricValue, 0, len(hostIDs))
+	)
+
+	for _, hostID := range hostIDs {
+		wg.Go(func() {
+			ctx, cancel := context.WithTimeout(ctx, metricsStatusTimeout)
+			defer cancel()
+
+			client, err := s.hostRepo.GetRpcClient(ctx, hostID)
+			if err != nil {
+				slog.Warn("metrics-sampler: failed to connect to host", "hostID", hostID, "error", err)
+
+				return
+			}
+
+			status, err := client.GetStatus(ctx, &headlessv1.GetStatusRequest{})
+			if err != nil {
+				slog.Warn("metrics-sampler: failed to get host status", "hostID", hostID, "error", err)
+
+				return
+			}
+
+			mu.Lock()
+			defer mu.Unlock()
+
+			values = append(values, port.RawMetricValue{
+				Kind:     entity.MetricKind_HostFps,
+				TargetID: hostID,
+				Value:    float64(status.GetFps()),
+			})
+		})
+	}
+
+	wg.Wait()
+
+	return values
+}
+
+// rollup folds the minutes and hours that closed since the last rollup.
+// The hour rollup reads the 1m buckets, so it runs after the minute one.
+func (s *MetricsSampler) rollup(ctx context.Context, now time.Time) {
+	s.minuteRolledUntil = s.rollupInto(ctx, entity.MetricResolution_Minute, now.Truncate(time.Minute), s.minuteRolledUntil, metricsMinuteRollupLookback)
+	s.hourRolledUntil = s.rollupInto(ctx, entity.MetricResolution_Hour, now.Truncate(time.Hour), s.hourRolledUntil, metricsHourRollupLookback)
+}
+
+// rollupInto rolls [rolledUntil, until) up into resolution and returns the
+// new rolledUntil. The window never reaches further back than lookback.
+func (s *MetricsSampler) rollupInto(ctx context.Context, resolution entity.MetricResolution, until, rolledUntil time.Time, lookback time.Duration) time.Time {
+	if !until.After(rolledUntil) {
+		return rolledUntil
+	}
+
+	since := rolledUntil
+	if earliest := until.Add(-lookback); since.Before(earliest) {
+		since = earliest
+	}
+
+	if _, err := s.repo.Rollup(ctx, resolution, since, until); err != nil {
+		slog.Error("metrics-sampler: failed to roll up samples", "resolution", resolution, "error", err)
+
+		return rolledUntil
+	}
+
+	return until
+}
+
+func (s *MetricsSampler) prune(ctx context.Context, now time.Time) {
+	for _, resolution := range []entity.MetricResolution{
+		entity.MetricResolution_Raw,
+		entity.MetricResolution_Minute,
+		entity.MetricResolution_Hour,
+	} {
+		retention := s.retentions[resolution]
+		if retention <= 0 {
+			continue
+		}
+
+		n, err := s.repo.DeleteBefore(ctx, resolution, now.Add(-retention))
+		if err != nil {
+			slog.Error("metrics-sampler: failed to delete expired samples", "resolution", resolution, "error", err)
+
+			continue
+		}
+
+		if n > 0 {
+			slog.Info("metrics-sampler: deleted expired samples", "resolution", resolution, "count", n)
+		}
+	}
+}
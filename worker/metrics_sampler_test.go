@@ -0,0 +1,214 @@
+package worker
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/hantabaru1014/baru-reso-headless-controller/config"
+	"github.com/hantabaru1014/baru-reso-headless-controller/domain/entity"
+	headlessv1 "github.com/hantabaru1014/baru-reso-headless-controller/pbgen/headless/v1"
+	"github.com/hantabaru1014/baru-reso-headless-controller/usecase/port"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+)
+
+type rollupCall struct {
+	resolution   entity.MetricResolution
+	since, until time.Time
+}
+
+type fakeMetricSampleRepo struct {
+	port.MetricSampleRepository
+
+	inserted  []port.RawMetricValue
+	rollups   []rollupCall
+	deletes   map[entity.MetricResolution]time.Time
+	rollupErr error
+}
+
+func (r *fakeMetricSampleRepo) InsertRaw(_ context.Context, _ time.Time, values []port.RawMetricValue) error {
+	r.inserted = append(r.inserted, values...)
+
+	return nil
+}
+
+func (r *fakeMetricSampleRepo) Rollup(_ context.Context, to entity.MetricResolution, since, until time.Time) (int64, error) {
+	if r.rollupErr != nil {
+		return 0, r.rollupErr
+	}
+
+	r.rollups = append(r.rollups, rollupCall{resolution: to, since: since, until: until})
+
+	return 1, nil
+}
+
+func (r *fakeMetricSampleRepo) DeleteBefore(_ context.Context, resolution entity.MetricResolution, before time.Time) (int64, error) {
+	if r.deletes == nil {
+		r.deletes = map[entity.MetricResolution]time.Time{}
+	}
+
+	r.deletes[resolution] = before
+
+	return 0, nil
+}
+
+// fakeMetricsHostRepo implements only ListStatuses and GetRpcClient.
+type fakeMetricsHostRepo struct {
+	port.HeadlessHostRepository
+
+	statuses map[string]entity.HeadlessHostStatus
+	clients  map[string]headlessv1.HeadlessControlServiceClient
+}
+
+func (r *fakeMetricsHostRepo) ListStatuses(context.Context) (map[string]entity.HeadlessHostStatus, error) {
+	return r.statuses, nil
+}
+
+func (r *fakeMetricsHostRepo) GetRpcClient(_ context.Context, id string) (headlessv1.HeadlessControlServiceClient, error) {
+	c, ok := r.clients[id]
+	if !ok {
+		return nil, errors.New("no client for host: " + id)
+	}
+
+	return c, nil
+}
+
+// fakeStatusClient implements only GetStatus.
+type fakeStatusClient struct {
+	headlessv1.HeadlessControlServiceClient
+
+	fps float32
+	err error
+}
+
+func (c *fakeStatusClient) GetStatus(context.Context, *headlessv1.GetStatusRequest, ...grpc.CallOption) (*headlessv1.GetStatusResponse, error) {
+	if c.err != nil {
+		return nil, c.err
+	}
+
+	return &headlessv1.GetStatusResponse{Fps: c.fps}, nil
+}
+
+type fakeSessionRanger map[string]struct {
+	hostID string
+	users  int32
+}
+
+func (f fakeSessionRanger) Range(fn func(hostID, sessionID string, snapshot *headlessv1.Session)) {
+	for sessionID, s := range f {
+		fn(s.hostID, sessionID, &headlessv1.Session{Id: sessionID, UsersCount: s.users})
+	}
+}
+
+func newTestMetricsSampler(repo *fakeMetricSampleRepo, hostRepo *fakeMetricsHostRepo, sessions fakeSessionRanger, now *time.Time) *MetricsSampler {
+	s := NewMetricsSampler(repo, hostRepo, sessions, &config.WorkerConfig{
+		MetricsSampleInterval: 15 * time.Second,
+		MetricsRawRetention:   24 * time.Hour,
+		MetricsHourRetention:  365 * 24 * time.Hour,
+	})
+	s.now = func() time.Time { return *now }
+
+	return s
+}
+
+func TestMetricsSampler_RecordsHostsAndSessions(t *testing.T) {
+	t.Parallel()
+
+	repo := &fakeMetricSampleRepo{}
+	hostRepo := &fakeMetricsHostRepo{
+		statuses: map[string]entity.HeadlessHostStatus{
+			"h1": entity.HeadlessHostStatus_RUNNING,
+			"h2": entity.HeadlessHostStatus_EXITED,
+			"h3": entity.HeadlessHostStatus_RUNNING,
+		},
+		clients: map[string]headlessv1.HeadlessControlServiceClient{
+			"h1": &fakeStatusClient{fps: 60},
+			"h3": &fakeStatusClient{err: errors.New("unavailable")},
+		},
+	}
+	sessions := fakeSessionRanger{
+		"s1": {hostID: "h1", users: 3},
+		"s2": {hostID: "h1", users: 2},
+		"s3": {hostID: "h3", users: 1},
+	}
+	now := time.Date(2026, 7, 14, 12, 0, 30, 0, time.UTC)
+	s := newTestMetricsSampler(repo, hostRepo, sessions, &now)
+
+	s.Tick(t.Context())
+
+	assert.ElementsMatch(t, []port.RawMetricValue{
+		{Kind: entity.MetricKind_SessionUsers, TargetID: "s1", Value: 3},
+		{Kind: entity.MetricKind_SessionUsers, TargetID: "s2", Value: 2},
+		{Kind: entity.MetricKind_SessionUsers, TargetID: "s3", Value: 1},
+		{Kind: entity.MetricKind_HostRunning, TargetID: "h1", Value: 1},
+		{Kind: entity.MetricKind_HostRunning, TargetID: "h2", Value: 0},
+		{Kind: entity.MetricKind_HostRunning, TargetID: "h3", Value: 1},
+		{Kind: entity.MetricKind_HostUsers, TargetID: "h1", Value: 5},
+		{Kind: entity.MetricKind_HostUsers, TargetID: "h3", Value: 1},
+		// h3 did not answer, so it has no FPS sample rather than 0 FPS.
+		{Kind: entity.MetricKind_HostFps, TargetID: "h1", Value: 60},
+	}, repo.inserted)
+}
+
+func TestMetricsSampler_RollsUpClosedBuckets(t *testing.T) {
+	t.Parallel()
+
+	repo := &fakeMetricSampleRepo{}
+	now := time.Date(2026, 7, 14, 12, 0, 30, 0, time.UTC)
+	s := newTestMetricsSampler(repo, &fakeMetricsHostRepo{}, fakeSessionRanger{}, &now)
+	at := func(h, m int) time.Time { return time.Date(2026, 7, 14, h, m, 0, 0, time.UTC) }
+
+	s.Tick(t.Context())
+
+	require.Equal(t, []rollupCall{
+		{resolution: entity.MetricResolution_Minute, since: at(11, 0), until: at(12, 0)},
+		{resolution: entity.MetricResolution_Hour, since: at(12, 0).Add(-24 * time.Hour), until: at(12, 0)},
+	}, repo.rollups, "the first tick looks back over the gap before the start")
+
+	repo.rollups = nil
+	now = at(12, 0).Add(45 * time.Second)
+	s.Tick(t.Context())
+
+	assert.Empty(t, repo.rollups, "no bucket has closed since the last tick")
+
+	repo.rollupErr = errors.New("db down")
+	now = at(12, 1).Add(5 * time.Second)
+	s.Tick(t.Context())
+
+	repo.rollupErr = nil
+	now = at(12, 2).Add(5 * time.Second)
+	s.Tick(t.Context())
+
+	assert.Equal(t, []rollupCall{
+		{resolution: entity.MetricResolution_Minute, since: at(12, 0), until: at(12, 2)},
+	}, repo.rollups, "a failed rollup is covered by the next one")
+}
+
+func TestMetricsSampler_PrunesExpiredSamples(t *testing.T) {
+	t.Parallel()
+
+	repo := &fakeMetricSampleRepo{}
+	now := time.Date(2026, 7, 14, 12, 0, 30, 0, time.UTC)
+	s := newTestMetricsSampler(repo, &fakeMetricsHostRepo{}, fakeSessionRanger{}, &now)
+
+	s.Tick(t.Context())
+
+	assert.Equal(t, map[entity.MetricResolution]time.Time{
+		entity.MetricResolution_Raw:  now.Add(-24 * time.Hour),
+		entity.MetricResolution_Hour: now.Add(-365 * 24 * time.Hour),
+	}, repo.deletes, "zero retention keeps the 1m buckets")
+
+	repo.deletes = nil
+	now = now.Add(time.Minute)
+	s.Tick(t.Context())
+
+	assert.Nil(t, repo.deletes)
+
+	now = now.Add(metricsPruneInterval)
+	s.Tick(t.Context())
+
+	assert.Len(t, repo.deletes, 2)
+}
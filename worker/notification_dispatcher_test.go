@@ -36,6 +36,11 @@ func (*fakeBus) Subscribe(_ context.Context, _ string) (<-chan *hdlctrlv1.Notifi
 	return ch, func() { close(ch) }
 }
 
+func (*fakeBus) SubscribeAll(_ context.Context) (<-chan *hdlctrlv1.NotificationEvent, func()) {
+	ch := make(chan *hdlctrlv1.NotificationEvent)
+	return ch, func() { close(ch) }
+}
+
 func (b *fakeBus) snapshot() []*hdlctrlv1.NotificationEvent {
 	b.mu.Lock()
 	defer b.mu.Unlock()
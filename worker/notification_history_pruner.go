@@ -0,0 +1,79 @@
+package worker
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/hantabaru1014/baru-reso-headless-controller/config"
+	"github.com/hantabaru1014/baru-reso-headless-controller/usecase/port"
+)
+
+// notificationPruneInterval is how often the notification history is
+// trimmed back to its configured bounds.
+const notificationPruneInterval = 10 * time.Minute
+
+// NotificationHistoryPruner keeps the notification history written by
+// notification.PersistentBus bounded by age and by row count. Read marks
+// of the inbox go with the events they refer to.
+type NotificationHistoryPruner struct {
+	repo      port.NotificationRepository
+	retention time.Duration
+	maxEvents int32
+	now       func() time.Time
+}
+
+func NewNotificationHistoryPruner(repo port.NotificationRepository, cfg *config.WorkerConfig) *NotificationHistoryPruner {
+	return &NotificationHistoryPruner{
+		repo:      repo,
+		retention: cfg.NotificationHistoryRetention,
+		maxEvents: int32(cfg.NotificationHistoryMaxEvents), //nolint:gosec // G115: small config value
+		now:       time.Now,
+	}
+}
+
+func (p *NotificationHistoryPruner) Name() string { return "notification-history-pruner" }
+
+func (p *NotificationHistoryPruner) Run(ctx context.Context) error {
+	p.Prune(ctx)
+
+	ticker := time.NewTicker(notificationPruneInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+			p.Prune(ctx)
+		}
+	}
+}
+
+// Prune deletes events older than the retention, then everything beyond
+// the newest maxEvents. A non-positive bound disables that limit.
+func (p *NotificationHistoryPruner) Prune(ctx context.Context) {
+	var deleted int64
+
+	if p.retention > 0 {
+		n, err := p.repo.DeleteBefore(ctx, p.now().Add(-p.retention))
+		if err != nil {
+			slog.Error("notification-history-pruner: failed to delete expired events", "error", err)
+		}
+
+		deleted += n
+	}
+
+	if p.maxEvents > 0 {
+		n, err := p.repo.DeleteBeyondLatest(ctx, p.maxEvents)
+		if err != nil {
+			slog.Error("notification-history-pruner: failed to trim events", "error", err)
+		}
+
+		deleted += n
+	}
+
+	if deleted > 0 {
+		slog.Info("notification-history-pruner: deleted old events", "count", deleted)
+	}
+}
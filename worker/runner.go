@@ -9,6 +9,7 @@ import (
 	"errors"
 	"log/slog"
 	"sync"
+	"time"
 )
 
 // Runner is a long-running background job.
@@ -23,13 +24,34 @@ type Runner interface {
 	Run(ctx context.Context) error
 }
 
+// LeaderElector decides which of several controller instances runs the
+// singleton Runners.
+//
+// Campaign blocks until this instance becomes the leader or ctx is
+// cancelled. The returned context is cancelled when leadership is lost
+// (or ctx ends); resign must be called once the work done under it has
+// stopped, so the next leader never overlaps with this one.
+type LeaderElector interface {
+	Campaign(ctx context.Context) (leaderCtx context.Context, resign func(), err error)
+}
+
+// campaignRetryDelay is the wait after a failed campaign before trying again.
+const campaignRetryDelay = 5 * time.Second
+
 // Manager supervises a fixed set of Runners.
 //
 // All Runners are started together by Start and stopped together by Stop.
 // Stop respects the supplied context, so callers can bound the shutdown
 // time.
+//
+// Singletons are Runners that must not run on more than one controller
+// instance at a time. With an elector they only run while this instance
+// holds the leadership and are started again whenever it is regained;
+// without one they run like any other Runner.
 type Manager struct {
-	runners []Runner
+	runners    []Runner
+	singletons []Runner
+	elector    LeaderElector
 
 	mu     sync.Mutex
 	cancel context.CancelFunc
@@ -40,6 +62,16 @@ func NewManager(runners []Runner) *Manager {
 	return &Manager{runners: runners}
 }
 
+// NewLeaderElectedManager is NewManager for multi-instance deployments.
+// A nil elector runs the singletons unconditionally.
+func NewLeaderElectedManager(runners, singletons []Runner, elector LeaderElector) *Manager {
+	if elector == nil {
+		return NewManager(append(append([]Runner{}, runners...), singletons...))
+	}
+
+	return &Manager{runners: runners, singletons: singletons, elector: elector}
+}
+
 // Start launches every Runner in its own goroutine. It returns immediately;
 // runners block in the background until Stop is called.
 func (m *Manager) Start() {
@@ -57,10 +89,21 @@ func (m *Manager) Start() {
 		m.wg.Add(1)
 
 		runner := r
-		go m.runOne(ctx, runner)
+
+		go func() {
+			defer m.wg.Done()
+
+			m.runOne(ctx, runner)
+		}()
 	}
 
-	slog.Info("worker manager started", "count", len(m.runners))
+	if len(m.singletons) > 0 {
+		m.wg.Add(1)
+
+		go m.runSingletons(ctx)
+	}
+
+	slog.Info("worker manager started", "count", len(m.runners), "singletons", len(m.singletons))
 }
 
 // Stop signals every Runner to shut down and waits for them to return.
@@ -99,9 +142,59 @@ func (m *Manager) Stop(ctx context.Context) error {
 	}
 }
 
-func (m *Manager) runOne(ctx context.Context, r Runner) {
+// runSingletons campaigns for the leadership and runs the singletons for
+// as long as it is held, until ctx is cancelled.
+func (m *Manager) runSingletons(ctx context.Context) {
 	defer m.wg.Done()
 
+	for ctx.Err() == nil {
+		leaderCtx, resign, err := m.elector.Campaign(ctx)
+		if err != nil {
+			if ctx.Err() != nil {
+				return
+			}
+
+			slog.Warn("leader campaign failed; retrying", "delay", campaignRetryDelay, "error", err)
+
+			select {
+			case <-ctx.Done():
+				return
+			case <-time.After(campaignRetryDelay):
+			}
+
+			continue
+		}
+
+		slog.Info("became leader; starting singleton workers", "count", len(m.singletons))
+
+		var wg sync.WaitGroup
+
+		for _, r := range m.singletons {
+			wg.Add(1)
+
+			runner := r
+
+			go func() {
+				defer wg.Done()
+
+				m.runOne(leaderCtx, runner)
+			}()
+		}
+
+		wg.Wait()
+
+		// Singletons that gave up on their own stay stopped for the rest of
+		// this term, the same as a Runner without an elector.
+		<-leaderCtx.Done()
+		resign()
+
+		if ctx.Err() == nil {
+			slog.Warn("lost leadership; singleton workers stopped")
+		}
+	}
+}
+
+func (m *Manager) runOne(ctx context.Context, r Runner) {
 	defer func() {
 		if rv := recover(); rv != nil {
 			slog.Error("worker panicked", "name", r.Name(), "panic", rv)
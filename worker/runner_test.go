@@ -146,3 +146,105 @@ func (p *panickyRunner) Run(_ context.Context) error {
 
 	panic(errors.New("boom"))
 }
+
+// fakeElector grants the leadership each time the test sends on grant and
+// hands the test a way to revoke that term.
+type fakeElector struct {
+	grant    chan struct{}
+	terms    chan context.CancelFunc
+	resigned chan struct{}
+}
+
+func newFakeElector() *fakeElector {
+	return &fakeElector{
+		grant:    make(chan struct{}),
+		terms:    make(chan context.CancelFunc, 1),
+		resigned: make(chan struct{}, 1),
+	}
+}
+
+func (e *fakeElector) Campaign(ctx context.Context) (context.Context, func(), error) {
+	select {
+	case <-ctx.Done():
+		return nil, nil, ctx.Err()
+	case <-e.grant:
+	}
+
+	leaderCtx, cancel := context.WithCancel(ctx)
+	e.terms <- cancel
+
+	return leaderCtx, func() { e.resigned <- struct{}{} }, nil
+}
+
+// trackingRunner records whether a Run call is still in progress.
+type trackingRunner struct {
+	running atomic.Bool
+	ran     atomic.Int64
+}
+
+func (r *trackingRunner) Name() string { return "tracking" }
+
+func (r *trackingRunner) Run(ctx context.Context) error {
+	r.running.Store(true)
+	defer r.running.Store(false)
+
+	r.ran.Add(1)
+	<-ctx.Done()
+
+	return ctx.Err()
+}
+
+func TestManager_SingletonsRunOnlyWhileLeader(t *testing.T) {
+	t.Parallel()
+
+	regular := &fakeRunner{name: "regular", started: make(chan struct{})}
+	singleton := &trackingRunner{}
+	elector := newFakeElector()
+	m := NewLeaderElectedManager([]Runner{regular}, []Runner{singleton}, elector)
+
+	m.Start()
+	<-regular.started
+
+	time.Sleep(20 * time.Millisecond)
+	assert.Equal(t, int64(0), singleton.ran.Load(), "singleton must wait for the leadership")
+
+	elector.grant <- struct{}{}
+	revoke := <-elector.terms
+	require.Eventually(t, func() bool { return singleton.ran.Load() == 1 }, time.Second, 5*time.Millisecond)
+
+	revoke()
+	select {
+	case <-elector.resigned:
+		assert.False(t, singleton.running.Load(), "resign must wait for the singleton to stop")
+	case <-time.After(time.Second):
+		t.Fatal("leadership was not resigned")
+	}
+
+	// The leadership is regained: the singleton starts again.
+	elector.grant <- struct{}{}
+	<-elector.terms
+	require.Eventually(t, func() bool { return singleton.ran.Load() == 2 }, time.Second, 5*time.Millisecond)
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+
+	require.NoError(t, m.Stop(ctx))
+	assert.False(t, singleton.running.Load())
+}
+
+func TestManager_SingletonsWithoutElectorRunDirectly(t *testing.T) {
+	t.Parallel()
+
+	regular := &fakeRunner{name: "regular", started: make(chan struct{})}
+	singleton := &fakeRunner{name: "singleton", started: make(chan struct{})}
+	m := NewLeaderElectedManager([]Runner{regular}, []Runner{singleton}, nil)
+
+	m.Start()
+	<-regular.started
+	<-singleton.started
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+
+	require.NoError(t, m.Stop(ctx))
+}
@@ -10,6 +10,7 @@ import (
 	"github.com/go-errors/errors"
 	"github.com/hantabaru1014/baru-reso-headless-controller/domain/entity"
 	"github.com/hantabaru1014/baru-reso-headless-controller/lib/auth"
+	"github.com/hantabaru1014/baru-reso-headless-controller/lib/metrics"
 	"github.com/hantabaru1014/baru-reso-headless-controller/usecase/port"
 	"github.com/hantabaru1014/baru-reso-headless-controller/usecase/scheduled_op"
 )
@@ -21,6 +22,7 @@ import (
 type ScheduledOperationExecutor struct {
 	repo        port.ScheduledSessionOperationRepository
 	sessionOp   scheduled_op.SessionOperator
+	templates   scheduled_op.SessionTemplateResolver
 	sessionRepo port.SessionRepository
 	stateCache  port.SessionStateCache
 	userChecker UserExistenceChecker
@@ -31,6 +33,7 @@ type ScheduledOperationExecutor struct {
 	staleSweepEvry time.Duration
 	batchSize      int32
 	concurrency    int
+	misfireGrace   time.Duration
 }
 
 const (
@@ -40,6 +43,7 @@ const (
 	defaultExecutorBatchSize     = 16
 	defaultExecutorConcurrency   = 4
 	defaultExecutorActionTimeout = 2 * time.Minute
+	defaultExecutorMisfireGrace  = 30 * time.Minute
 )
 
 type ScheduledOperationExecutorOptions struct {
@@ -50,11 +54,16 @@ type ScheduledOperationExecutorOptions struct {
 	BatchSize     int32
 	Concurrency   int
 	ActionTimeout time.Duration
+	// MisfireGrace is how late a recurring operation may still run. Older
+	// occurrences (e.g. while the controller was down) are recorded as missed
+	// and the operation moves on to its next occurrence.
+	MisfireGrace time.Duration
 }
 
 func NewScheduledOperationExecutor(
 	repo port.ScheduledSessionOperationRepository,
 	sessionOp scheduled_op.SessionOperator,
+	templates scheduled_op.SessionTemplateResolver,
 	sessionRepo port.SessionRepository,
 	stateCache port.SessionStateCache,
 	userChecker UserExistenceChecker,
@@ -89,9 +98,14 @@ func NewScheduledOperationExecutor(
 		opts.Concurrency = defaultExecutorConcurrency
 	}
 
+	if opts.MisfireGrace <= 0 {
+		opts.MisfireGrace = defaultExecutorMisfireGrace
+	}
+
 	return &ScheduledOperationExecutor{
 		repo:           repo,
 		sessionOp:      sessionOp,
+		templates:      templates,
 		sessionRepo:    sessionRepo,
 		stateCache:     stateCache,
 		userChecker:    userChecker,
@@ -101,6 +115,7 @@ func NewScheduledOperationExecutor(
 		staleSweepEvry: opts.StaleSweep,
 		batchSize:      opts.BatchSize,
 		concurrency:    opts.Concurrency,
+		misfireGrace:   opts.MisfireGrace,
 	}
 }
 
@@ -174,11 +189,16 @@ func (e *ScheduledOperationExecutor) dispatchOnce(ctx context.Context, wg *sync.
 func (e *ScheduledOperationExecutor) executeOne(ctx context.Context, op *entity.ScheduledSessionOperation) {
 	logger := slog.With("op_id", op.ID, "type", op.OperationType, "trigger", op.TriggerType)
 
+	typeLabel := scheduledOperationTypeLabel(op.OperationType)
+	if op.ClaimedAt != nil {
+		metrics.ScheduledOperationLag.WithLabelValues(typeLabel).Observe(max(op.ClaimedAt.Sub(op.NextFireAt).Seconds(), 0))
+	}
+
 	// created_by が無い / DB 上のユーザーが消えている場合は実行主体を立てられないので
 	// 即 FAILED にする. systemPrivilege fallback はしない (作成権限の追跡を維持するため).
 	if op.CreatedBy == nil || *op.CreatedBy == "" {
 		logger.Error("scheduled-operation-executor: operation has no created_by; marking failed")
-		e.markFailed(ctx, op.ID, errors.New("scheduled operation has no created_by"))
+		e.markFailed(ctx, op, nil, errors.New("scheduled operation has no created_by"))
 
 		return
 	}
@@ -194,14 +214,14 @@ func (e *ScheduledOperationExecutor) executeOne(ctx context.Context, op *entity.
 			}
 
 			logger.Error("scheduled-operation-executor: check created_by user failed", "error", err)
-			e.markFailed(ctx, op.ID, errors.WrapPrefix(err, "check created_by user", 0))
+			e.markFailed(ctx, op, nil, errors.WrapPrefix(err, "check created_by user", 0))
 
 			return
 		}
 
 		if !exists {
 			logger.Error("scheduled-operation-executor: created_by user does not exist; marking failed", "user_id", *op.CreatedBy)
-			e.markFailed(ctx, op.ID, errors.Errorf("created_by user %q does not exist", *op.CreatedBy))
+			e.markFailed(ctx, op, nil, errors.Errorf("created_by user %q does not exist", *op.CreatedBy))
 
 			return
 		}
@@ -210,7 +230,16 @@ func (e *ScheduledOperationExecutor) executeOne(ctx context.Context, op *entity.
 	trig, err := scheduled_op.DecodeTrigger(op.TriggerType, op.TriggerConfig)
 	if err != nil {
 		logger.Error("scheduled-operation-executor: decode trigger failed", "error", err)
-		e.markFailed(ctx, op.ID, errors.WrapPrefix(err, "decode trigger", 0))
+		e.markFailed(ctx, op, nil, errors.WrapPrefix(err, "decode trigger", 0))
+
+		return
+	}
+
+	recurring, isRecurring := trig.(scheduled_op.RecurringTrigger)
+	if isRecurring && time.Since(op.NextFireAt) > e.misfireGrace {
+		// controller 停止中などで大きく遅れた回は実行せず、次回に進める.
+		logger.Warn("scheduled-operation-executor: missed occurrence; moving to the next one", "scheduled_for", op.NextFireAt)
+		e.rearm(ctx, op, recurring, nil, entity.ScheduledOperationRunOutcome_MISSED, nil)
 
 		return
 	}
@@ -222,7 +251,7 @@ func (e *ScheduledOperationExecutor) executeOne(ctx context.Context, op *entity.
 	})
 	if err != nil {
 		logger.Error("scheduled-operation-executor: evaluate trigger failed", "error", err)
-		e.markFailed(ctx, op.ID, errors.WrapPrefix(err, "evaluate trigger", 0))
+		e.markFailed(ctx, op, nil, errors.WrapPrefix(err, "evaluate trigger", 0))
 
 		return
 	}
@@ -243,7 +272,7 @@ func (e *ScheduledOperationExecutor) executeOne(ctx context.Context, op *entity.
 	act, err := scheduled_op.DecodeAction(op.OperationType, op.OperationPayload)
 	if err != nil {
 		logger.Error("scheduled-operation-executor: decode action failed", "error", err)
-		e.markFailed(ctx, op.ID, errors.WrapPrefix(err, "decode action", 0))
+		e.markFailed(ctx, op, nil, errors.WrapPrefix(err, "decode action", 0))
 
 		return
 	}
@@ -256,9 +285,34 @@ func (e *ScheduledOperationExecutor) executeOne(ctx context.Context, op *entity.
 	// 自然に FAILED に倒れる.
 	actCtx = auth.WithActAsUser(actCtx, *op.CreatedBy)
 
-	if err := act.Execute(actCtx, scheduled_op.ActionExecDeps{Session: e.sessionOp}); err != nil {
+	startedAt := time.Now()
+	err = act.Execute(actCtx, scheduled_op.ActionExecDeps{Session: e.sessionOp, Templates: e.templates})
+
+	resultLabel := metrics.ResultSucceeded
+	if err != nil {
+		resultLabel = metrics.ResultFailed
+	}
+
+	metrics.ScheduledOperationDuration.WithLabelValues(typeLabel, resultLabel).Observe(time.Since(startedAt).Seconds())
+
+	if err != nil {
 		logger.Error("scheduled-operation-executor: execute failed", "error", err)
-		e.markFailed(ctx, op.ID, err)
+	}
+
+	// 繰り返し予約は失敗しても次回は実行する. 結果は実行履歴と last_error に残る.
+	if isRecurring {
+		outcome := entity.ScheduledOperationRunOutcome_SUCCEEDED
+		if err != nil {
+			outcome = entity.ScheduledOperationRunOutcome_FAILED
+		}
+
+		e.rearm(ctx, op, recurring, &startedAt, outcome, err)
+
+		return
+	}
+
+	if err != nil {
+		e.markFailed(ctx, op, &startedAt, err)
 
 		return
 	}
@@ -274,16 +328,106 @@ func (e *ScheduledOperationExecutor) executeOne(ctx context.Context, op *entity.
 		return
 	}
 
+	e.recordRun(persistCtx, op, &startedAt, entity.ScheduledOperationRunOutcome_SUCCEEDED, nil)
+
 	logger.Info("scheduled-operation-executor: succeeded")
 }
 
-func (e *ScheduledOperationExecutor) markFailed(ctx context.Context, id string, runErr error) {
+// markFailed は op を FAILED にして実行履歴に残す. startedAt は action を実行する前に失敗したなら nil.
+func (e *ScheduledOperationExecutor) markFailed(ctx context.Context, op *entity.ScheduledSessionOperation, startedAt *time.Time, runErr error) {
 	msg := runErr.Error()
 
 	persistCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), persistTimeout)
 	defer cancel()
 
-	if err := e.repo.MarkFailed(persistCtx, id, msg); err != nil {
-		slog.Error("scheduled-operation-executor: mark failed errored", "op_id", id, "error", err)
+	if err := e.repo.MarkFailed(persistCtx, op.ID, msg); err != nil {
+		slog.Error("scheduled-operation-executor: mark failed errored", "op_id", op.ID, "error", err)
+		return
+	}
+
+	e.recordRun(persistCtx, op, startedAt, entity.ScheduledOperationRunOutcome_FAILED, runErr)
+}
+
+// rearm は繰り返し予約の今回の結果を実行履歴に残し、次回の発火時刻で PENDING に戻す.
+// 次回が無ければ今回の結果で終了させる.
+func (e *ScheduledOperationExecutor) rearm(ctx context.Context, op *entity.ScheduledSessionOperation, trig scheduled_op.RecurringTrigger, startedAt *time.Time, outcome entity.ScheduledOperationRunOutcome, runErr error) {
+	persistCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), persistTimeout)
+	defer cancel()
+
+	logger := slog.With("op_id", op.ID)
+
+	next := trig.Next(time.Now())
+	if next.IsZero() {
+		logger.Info("scheduled-operation-executor: recurring operation has no further occurrence")
+
+		if runErr != nil {
+			e.markFailed(ctx, op, startedAt, runErr)
+
+			return
+		}
+
+		if err := e.repo.MarkSucceeded(persistCtx, op.ID); err != nil {
+			logger.Error("scheduled-operation-executor: mark succeeded failed", "error", err)
+			return
+		}
+
+		e.recordRun(persistCtx, op, startedAt, outcome, nil)
+
+		return
+	}
+
+	var lastError *string
+
+	if runErr != nil {
+		msg := runErr.Error()
+		lastError = &msg
+	}
+
+	if err := e.repo.Rearm(persistCtx, op.ID, next, lastError); err != nil {
+		logger.Error("scheduled-operation-executor: rearm failed", "error", err)
+		return
+	}
+
+	e.recordRun(persistCtx, op, startedAt, outcome, runErr)
+
+	logger.Info("scheduled-operation-executor: rearmed", "outcome", outcome, "next_fire_at", next)
+}
+
+// recordRun は実行履歴を 1 件追加する. 履歴の失敗で op の状態遷移は戻さない.
+func (e *ScheduledOperationExecutor) recordRun(ctx context.Context, op *entity.ScheduledSessionOperation, startedAt *time.Time, outcome entity.ScheduledOperationRunOutcome, runErr error) {
+	var errMsg *string
+
+	if runErr != nil {
+		msg := runErr.Error()
+		errMsg = &msg
+	}
+
+	instanceID := e.instanceID
+
+	if err := e.repo.CreateRun(ctx, port.ScheduledOperationRunCreateParams{
+		OperationID:  op.ID,
+		ScheduledFor: op.NextFireAt,
+		StartedAt:    startedAt,
+		Outcome:      outcome,
+		Error:        errMsg,
+		InstanceID:   &instanceID,
+	}); err != nil {
+		slog.Error("scheduled-operation-executor: record run failed", "op_id", op.ID, "error", err)
+	}
+}
+
+// scheduledOperationTypeLabel は metrics の type ラベル値.
+func scheduledOperationTypeLabel(t entity.ScheduledOperationType) string {
+	switch t {
+	case entity.ScheduledOperationType_START_SESSION:
+		return "start_session"
+	case entity.ScheduledOperationType_STOP_SESSION:
+		return "stop_session"
+	case entity.ScheduledOperationType_UPDATE_PARAMETERS:
+		return "update_parameters"
+	case entity.ScheduledOperationType_UPDATE_EXTRA_SETTINGS:
+		return "update_extra_settings"
+	default:
+		return "unknown"
 	}
 }
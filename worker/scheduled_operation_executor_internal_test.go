@@ -15,6 +15,7 @@ import (
 	_ "github.com/hantabaru1014/baru-reso-headless-controller/usecase/scheduled_op/actions"
 	_ "github.com/hantabaru1014/baru-reso-headless-controller/usecase/scheduled_op/triggers"
 	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/proto"
 )
 
 // stubScheduledRepo は ScheduledOperationExecutor.executeOne の unit テスト用.
@@ -24,6 +25,23 @@ type stubScheduledRepo struct {
 	failedID  string
 	failedMsg string
 	succeeded bool
+
+	rearmedAt    time.Time
+	rearmLastErr *string
+	runs         []port.ScheduledOperationRunCreateParams
+}
+
+func (r *stubScheduledRepo) Rearm(_ context.Context, _ string, nextFireAt time.Time, lastError *string) error {
+	r.rearmedAt = nextFireAt
+	r.rearmLastErr = lastError
+
+	return nil
+}
+
+func (r *stubScheduledRepo) CreateRun(_ context.Context, params port.ScheduledOperationRunCreateParams) error {
+	r.runs = append(r.runs, params)
+
+	return nil
 }
 
 func (r *stubScheduledRepo) MarkFailed(_ context.Context, id, msg string) error {
@@ -47,13 +65,35 @@ func (r *stubScheduledRepo) Requeue(_ context.Context, _ string, _ time.Time) er
 type scheduledSessionOpStub struct {
 	stopErr error
 
-	gotCtx context.Context //nolint:containedctx // test stub: capture ctx for assertion
+	gotCtx      context.Context //nolint:containedctx // test stub: capture ctx for assertion
+	startParams *headlessv1.WorldStartupParameters
 }
 
-func (s *scheduledSessionOpStub) StartSession(_ context.Context, _, _ string, _ *string, _ *headlessv1.WorldStartupParameters, _ *string) (*entity.Session, error) {
+func (s *scheduledSessionOpStub) StartSession(_ context.Context, _, _ string, _ *string, params *headlessv1.WorldStartupParameters, _ *string) (*entity.Session, error) {
+	s.startParams = params
+
 	return nil, nil
 }
 
+// stubTemplateResolver は template の Name を overrides の Name で上書きしたものを返す.
+type stubTemplateResolver struct {
+	templateID, groupID string
+	overrideFields      []string
+}
+
+func (r *stubTemplateResolver) ResolveStartupParameters(_ context.Context, templateID, groupID string, overrides *headlessv1.WorldStartupParameters, overrideFields []string) (*headlessv1.WorldStartupParameters, error) {
+	r.templateID = templateID
+	r.groupID = groupID
+	r.overrideFields = overrideFields
+
+	name := "from template"
+	if overrides.Name != nil {
+		name = overrides.GetName()
+	}
+
+	return &headlessv1.WorldStartupParameters{Name: &name, MaxUsers: proto.Int32(8)}, nil
+}
+
 func (s *scheduledSessionOpStub) StopSession(ctx context.Context, _ string) error {
 	s.gotCtx = ctx
 
@@ -64,17 +104,17 @@ func (s *scheduledSessionOpStub) UpdateSessionParameters(_ context.Context, _ st
 	return nil
 }
 
-func (s *scheduledSessionOpStub) UpdateSessionExtraSettings(_ context.Context, _ string, _ *bool, _ *string) error {
+func (s *scheduledSessionOpStub) UpdateSessionExtraSettings(_ context.Context, _ string, _ *bool, _ *string, _ *bool) error {
 	return nil
 }
 
 func newScheduledExecutor(repo port.ScheduledSessionOperationRepository, checker UserExistenceChecker, sessOp scheduled_op.SessionOperator) *ScheduledOperationExecutor {
-	return NewScheduledOperationExecutor(repo, sessOp, nil, nil, checker, ScheduledOperationExecutorOptions{})
+	return NewScheduledOperationExecutor(repo, sessOp, nil, nil, nil, checker, ScheduledOperationExecutorOptions{})
 }
 
 // newStopSessionOp は STOP_SESSION + TIME trigger (期日 1 時間前) の op を作る.
 func newStopSessionOp(createdBy *string) *entity.ScheduledSessionOperation {
-	payload, _ := json.Marshal(map[string]string{"session_id": "S-1"})                                                            //nolint:errchkjson // test fixture
+	payload, _ := json.Marshal(map[string]string{"session_id": "S-1"})                                                       //nolint:errchkjson // test fixture
 	trigCfg, _ := json.Marshal(map[string]string{"scheduled_at": time.Now().Add(-time.Hour).UTC().Format(time.RFC3339Nano)}) //nolint:errchkjson // test fixture
 
 	return &entity.ScheduledSessionOperation{
@@ -171,3 +211,109 @@ func TestScheduledOperationExecutor_ExecuteOne_SetsActAsUserCtx(t *testing.T) {
 	assert.Equal(t, "user-A", claims.UserID)
 	assert.True(t, repo.succeeded, "successful stop should mark op succeeded")
 }
+
+func TestScheduledOperationExecutor_ExecuteOne_StartFromTemplate(t *testing.T) {
+	t.Parallel()
+
+	payload, _ := json.Marshal(map[string]any{ //nolint:errchkjson // test fixture
+		"host_id":            "H-1",
+		"group_id":           "G-1",
+		"startup_parameters": map[string]any{"name": "Meetup #12"},
+		"template_id":        "T-1",
+		"override_fields":    []string{"name"},
+	})
+	op := newStopSessionOp(strPtrSched("user-A"))
+	op.OperationType = entity.ScheduledOperationType_START_SESSION
+	op.OperationPayload = payload
+
+	repo := &stubScheduledRepo{}
+	sessOp := &scheduledSessionOpStub{}
+	templates := &stubTemplateResolver{}
+	exe := NewScheduledOperationExecutor(repo, sessOp, templates, nil, nil, &stubUserChecker{exists: true}, ScheduledOperationExecutorOptions{})
+
+	exe.executeOne(context.Background(), op)
+
+	assert.True(t, repo.succeeded, repo.failedMsg)
+	assert.Equal(t, "T-1", templates.templateID)
+	assert.Equal(t, "G-1", templates.groupID)
+	assert.Equal(t, []string{"name"}, templates.overrideFields)
+	assert.Equal(t, "Meetup #12", sessOp.startParams.GetName())
+	assert.Equal(t, int32(8), sessOp.startParams.GetMaxUsers())
+}
+
+// newCronStopSessionOp は STOP_SESSION + 毎時 0 分の cron trigger で、firedAgo 前が今回の回の op を作る.
+func newCronStopSessionOp(firedAgo time.Duration) *entity.ScheduledSessionOperation {
+	op := newStopSessionOp(strPtrSched("user-A"))
+	op.TriggerType = entity.ScheduledTriggerType_CRON
+	op.TriggerConfig = json.RawMessage(`{"expression":"0 * * * *","timezone":"Asia/Tokyo"}`)
+	op.NextFireAt = time.Now().Add(-firedAgo)
+
+	return op
+}
+
+func TestScheduledOperationExecutor_ExecuteOne_Recurring(t *testing.T) {
+	t.Parallel()
+
+	t.Run("成功したら次回の時刻で PENDING に戻し履歴に残す", func(t *testing.T) {
+		t.Parallel()
+
+		repo := &stubScheduledRepo{}
+		sessOp := &scheduledSessionOpStub{}
+		exe := newScheduledExecutor(repo, &stubUserChecker{exists: true}, sessOp)
+
+		op := newCronStopSessionOp(time.Minute)
+		exe.executeOne(context.Background(), op)
+
+		assert.NotNil(t, sessOp.gotCtx)
+		assert.False(t, repo.succeeded)
+		assert.Empty(t, repo.failedID)
+		assert.True(t, repo.rearmedAt.After(time.Now()))
+		assert.Zero(t, repo.rearmedAt.Minute())
+		assert.Nil(t, repo.rearmLastErr)
+
+		if assert.Len(t, repo.runs, 1) {
+			assert.Equal(t, entity.ScheduledOperationRunOutcome_SUCCEEDED, repo.runs[0].Outcome)
+			assert.True(t, op.NextFireAt.Equal(repo.runs[0].ScheduledFor))
+			assert.NotNil(t, repo.runs[0].StartedAt)
+		}
+	})
+
+	t.Run("失敗しても次回に進め last_error を残す", func(t *testing.T) {
+		t.Parallel()
+
+		repo := &stubScheduledRepo{}
+		sessOp := &scheduledSessionOpStub{stopErr: domain.ErrPermissionDenied}
+		exe := newScheduledExecutor(repo, &stubUserChecker{exists: true}, sessOp)
+
+		exe.executeOne(context.Background(), newCronStopSessionOp(time.Minute))
+
+		assert.Empty(t, repo.failedID)
+		assert.False(t, repo.rearmedAt.IsZero())
+
+		if assert.NotNil(t, repo.rearmLastErr) {
+			assert.Contains(t, *repo.rearmLastErr, domain.ErrPermissionDenied.Error())
+		}
+
+		if assert.Len(t, repo.runs, 1) {
+			assert.Equal(t, entity.ScheduledOperationRunOutcome_FAILED, repo.runs[0].Outcome)
+		}
+	})
+
+	t.Run("猶予を過ぎた回は実行せず MISSED として次回に進める", func(t *testing.T) {
+		t.Parallel()
+
+		repo := &stubScheduledRepo{}
+		sessOp := &scheduledSessionOpStub{}
+		exe := newScheduledExecutor(repo, &stubUserChecker{exists: true}, sessOp)
+
+		exe.executeOne(context.Background(), newCronStopSessionOp(2*time.Hour))
+
+		assert.Nil(t, sessOp.gotCtx, "missed occurrence must not run the action")
+		assert.True(t, repo.rearmedAt.After(time.Now()))
+
+		if assert.Len(t, repo.runs, 1) {
+			assert.Equal(t, entity.ScheduledOperationRunOutcome_MISSED, repo.runs[0].Outcome)
+			assert.Nil(t, repo.runs[0].StartedAt)
+		}
+	})
+}
@@ -0,0 +1,222 @@
+package worker
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/hantabaru1014/baru-reso-headless-controller/config"
+	"github.com/hantabaru1014/baru-reso-headless-controller/db"
+	"github.com/hantabaru1014/baru-reso-headless-controller/domain"
+	"github.com/hantabaru1014/baru-reso-headless-controller/domain/entity"
+	"github.com/hantabaru1014/baru-reso-headless-controller/lib/auth"
+	headlessv1 "github.com/hantabaru1014/baru-reso-headless-controller/pbgen/headless/v1"
+	"github.com/hantabaru1014/baru-reso-headless-controller/usecase/notification"
+	"github.com/hantabaru1014/baru-reso-headless-controller/usecase/port"
+)
+
+// SessionStarter is the narrow slice of SessionUsecase the restorer needs.
+// Restored sessions go through StartSession like any user-started one, so
+// port allocation, permission checks and the state cache stay consistent.
+type SessionStarter interface {
+	GetSession(ctx context.Context, id string) (*entity.Session, error)
+	StartSession(ctx context.Context, hostID string, groupID string, userID *string, params *headlessv1.WorldStartupParameters, memo *string) (*entity.Session, error)
+}
+
+// SessionRestorer brings restore-on-crash sessions back after their host
+// went down unexpectedly. When the host terminates, the sessions are moved
+// from RUNNING to CRASHED so that restarting the host does not end them;
+// once the host is RUNNING again they are started with their stored
+// startup parameters. A CustomSessionId in those parameters keeps the
+// session ID (and therefore its URL) stable across the restore.
+type SessionRestorer struct {
+	sessionRepo port.SessionRepository
+	starter     SessionStarter
+	hostDrainer port.HostDrainer
+	bus         notification.Bus
+
+	pollInterval time.Duration
+	maxAttempts  int
+
+	// failures counts failed restore attempts per session ID. Only Run's
+	// goroutine touches it.
+	failures map[string]int
+}
+
+var (
+	_ Runner                  = (*SessionRestorer)(nil)
+	_ HostTerminationObserver = (*SessionRestorer)(nil)
+)
+
+func NewSessionRestorer(
+	sessionRepo port.SessionRepository,
+	starter SessionStarter,
+	hostDrainer port.HostDrainer,
+	bus notification.Bus,
+	cfg *config.WorkerConfig,
+) *SessionRestorer {
+	return &SessionRestorer{
+		sessionRepo:  sessionRepo,
+		starter:      starter,
+		hostDrainer:  hostDrainer,
+		bus:          bus,
+		pollInterval: cfg.SessionRestorePollInterval,
+		maxAttempts:  cfg.SessionRestoreMaxAttempts,
+		failures:     make(map[string]int),
+	}
+}
+
+func (r *SessionRestorer) Name() string { return "session-restorer" }
+
+func (r *SessionRestorer) Run(ctx context.Context) error {
+	ctx = auth.WithActAsUser(ctx, domain.SystemUserID)
+
+	r.restorePending(ctx)
+
+	ticker := time.NewTicker(r.pollInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+			r.restorePending(ctx)
+		}
+	}
+}
+
+// OnHostTerminated implements HostTerminationObserver. It has to run before
+// the host is restarted: HeadlessHostRestart ends every RUNNING session of
+// the host, which would drop them from the restore.
+func (r *SessionRestorer) OnHostTerminated(ctx context.Context, host db.Host, _ entity.HeadlessHostStatus) {
+	sessions, err := r.sessionRepo.MarkCrashedForRestore(ctx, host.ID)
+	if err != nil {
+		slog.Error("failed to mark sessions for restore", "hostID", host.ID, "error", err)
+
+		return
+	}
+
+	for _, s := range sessions {
+		slog.Info("session will be restored once its host is back", "sessionID", s.ID, "hostID", host.ID)
+		r.bus.Publish(notification.SessionUpdated(s.ID, host.ID, "", nil))
+	}
+}
+
+// restorePending tries once to restore every pending session whose host is
+// running again.
+func (r *SessionRestorer) restorePending(ctx context.Context) {
+	sessions, err := r.sessionRepo.ListPendingRestore(ctx)
+	if err != nil {
+		slog.Error("failed to list sessions pending restore", "error", err)
+
+		return
+	}
+
+	pending := make(map[string]struct{}, len(sessions))
+
+	for _, s := range sessions {
+		if ctx.Err() != nil {
+			return
+		}
+
+		pending[s.ID] = struct{}{}
+
+		r.restore(ctx, s)
+	}
+
+	// Drop counters of sessions that were restored or ended elsewhere.
+	for id := range r.failures {
+		if _, ok := pending[id]; !ok {
+			delete(r.failures, id)
+		}
+	}
+}
+
+func (r *SessionRestorer) restore(ctx context.Context, s *entity.Session) {
+	if r.hostDrainer.IsHostDraining(s.HostID) {
+		// The upgrade restarts the host; try again once it is back.
+		return
+	}
+
+	// The session may have survived (e.g. it was only marked CRASHED because
+	// an RPC failed). GetSession asks the host and moves it back to RUNNING
+	// if so, in which case there is nothing to restore.
+	current, err := r.starter.GetSession(ctx, s.ID)
+	if err != nil {
+		slog.Error("failed to get session to restore", "sessionID", s.ID, "error", err)
+
+		return
+	}
+
+	if current.Status != entity.SessionStatus_CRASHED && current.Status != entity.SessionStatus_UNKNOWN {
+		r.forget(s.ID)
+
+		return
+	}
+
+	s = current
+
+	restored, err := r.starter.StartSession(ctx, s.HostID, s.GroupID, s.CreatedBy, s.StartupParameters, &s.Memo)
+	if err != nil {
+		r.recordFailure(ctx, s, err)
+
+		return
+	}
+
+	r.forget(s.ID)
+
+	// StartSession upserts the session with default extra settings; carry
+	// them over so it is restored again after the next crash.
+	restored.AutoUpgrade = s.AutoUpgrade
+	restored.RestoreOnCrash = true
+
+	if err := r.sessionRepo.Upsert(ctx, restored); err != nil {
+		slog.Error("failed to carry over session settings", "sessionID", restored.ID, "error", err)
+	}
+
+	if restored.ID != s.ID {
+		// Without a CustomSessionId the host picks a new ID; the old row
+		// only remains as history.
+		r.end(ctx, s)
+	}
+
+	slog.Info("restored session after host crash", "sessionID", restored.ID, "previousSessionID", s.ID, "hostID", s.HostID)
+	r.bus.Publish(notification.SessionUpdated(restored.ID, restored.HostID, "", nil))
+}
+
+func (r *SessionRestorer) recordFailure(ctx context.Context, s *entity.Session, cause error) {
+	r.failures[s.ID]++
+	failures := r.failures[s.ID]
+
+	if failures < r.maxAttempts {
+		slog.Warn("failed to restore session, will retry", "sessionID", s.ID, "hostID", s.HostID,
+			"attempt", failures, "error", cause)
+
+		return
+	}
+
+	slog.Error("giving up restoring session", "sessionID", s.ID, "hostID", s.HostID,
+		"attempts", failures, "error", cause)
+
+	r.forget(s.ID)
+	r.end(ctx, s)
+}
+
+func (r *SessionRestorer) end(ctx context.Context, s *entity.Session) {
+	now := time.Now()
+	s.Status = entity.SessionStatus_ENDED
+	s.EndedAt = &now
+
+	if err := r.sessionRepo.Upsert(ctx, s); err != nil {
+		slog.Error("failed to end session", "sessionID", s.ID, "error", err)
+
+		return
+	}
+
+	r.bus.Publish(notification.SessionUpdated(s.ID, s.HostID, "", nil))
+}
+
+func (r *SessionRestorer) forget(sessionID string) {
+	delete(r.failures, sessionID)
+}
@@ -0,0 +1,228 @@
+package worker_test
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/hantabaru1014/baru-reso-headless-controller/adapter"
+	"github.com/hantabaru1014/baru-reso-headless-controller/config"
+	"github.com/hantabaru1014/baru-reso-headless-controller/db"
+	"github.com/hantabaru1014/baru-reso-headless-controller/domain/entity"
+	headlessv1 "github.com/hantabaru1014/baru-reso-headless-controller/pbgen/headless/v1"
+	"github.com/hantabaru1014/baru-reso-headless-controller/testutil"
+	"github.com/hantabaru1014/baru-reso-headless-controller/usecase/notification"
+	"github.com/hantabaru1014/baru-reso-headless-controller/usecase/port"
+	"github.com/hantabaru1014/baru-reso-headless-controller/worker"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+)
+
+// stubSessionStarter は host へ問い合わせる代わりに DB を直接読み書きする.
+// GetSession は session が生きていない (= 状態を変えない) ものとして振る舞い、
+// StartSession は newID が空なら同じ ID で、そうでなければ newID で起動したことにする.
+type stubSessionStarter struct {
+	repo     port.SessionRepository
+	newID    string
+	startErr error
+
+	mu     sync.Mutex
+	starts []*headlessv1.WorldStartupParameters
+}
+
+func (s *stubSessionStarter) GetSession(ctx context.Context, id string) (*entity.Session, error) {
+	return s.repo.Get(ctx, id)
+}
+
+func (s *stubSessionStarter) StartSession(ctx context.Context, hostID string, groupID string, userID *string, params *headlessv1.WorldStartupParameters, memo *string) (*entity.Session, error) {
+	s.mu.Lock()
+	s.starts = append(s.starts, params)
+	s.mu.Unlock()
+
+	if s.startErr != nil {
+		return nil, s.startErr
+	}
+
+	id := params.GetCustomSessionId()
+	if s.newID != "" {
+		id = s.newID
+	}
+
+	now := time.Now()
+	session := &entity.Session{
+		ID:                id,
+		Name:              params.GetName(),
+		Status:            entity.SessionStatus_RUNNING,
+		HostID:            hostID,
+		StartedAt:         &now,
+		CreatedBy:         userID,
+		StartupParameters: params,
+		GroupID:           groupID,
+		Memo:              *memo,
+	}
+
+	return session, s.repo.Upsert(ctx, session)
+}
+
+func (s *stubSessionStarter) startCount() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return len(s.starts)
+}
+
+func runSessionRestorer(t *testing.T, r *worker.SessionRestorer) {
+	t.Helper()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan struct{})
+
+	go func() {
+		defer close(done)
+
+		_ = r.Run(ctx)
+	}()
+
+	t.Cleanup(func() {
+		cancel()
+		<-done
+	})
+}
+
+func newTestSessionRestorer(repo port.SessionRepository, starter worker.SessionStarter) *worker.SessionRestorer {
+	return worker.NewSessionRestorer(repo, starter, port.NoopHostDrainer{}, notification.NewBus(), &config.WorkerConfig{
+		SessionRestorePollInterval: 10 * time.Millisecond,
+		SessionRestoreMaxAttempts:  2,
+	})
+}
+
+// createRestorableSession は restore_on_crash 付きの RUNNING session を作る.
+func createRestorableSession(t *testing.T, q *db.Queries, repo port.SessionRepository, hostID string, params *headlessv1.WorldStartupParameters) *entity.Session {
+	t.Helper()
+
+	created := testutil.CreateTestSessionWithStartupParameters(t, q, hostID, params.GetName(), entity.SessionStatus_RUNNING, params)
+
+	session, err := repo.Get(t.Context(), created.ID)
+	require.NoError(t, err)
+
+	session.RestoreOnCrash = true
+	session.AutoUpgrade = true
+	session.Memo = "常設"
+	require.NoError(t, repo.Upsert(t.Context(), session))
+
+	return session
+}
+
+func TestSessionRestorer_KeepsSessionsAcrossHostRestart(t *testing.T) {
+	queries, _ := testutil.SetupTestDB(t)
+	repo := adapter.NewSessionRepository(queries)
+
+	account := testutil.CreateTestHeadlessAccount(t, queries, "U-restore", "cred", "pass")
+	host := testutil.CreateTestHeadlessHost(t, queries, account.ResoniteID, "crashy", entity.HeadlessHostStatus_RUNNING)
+
+	flagged := createRestorableSession(t, queries, repo, host.ID, &headlessv1.WorldStartupParameters{Name: proto.String("persistent")})
+	// CustomSessionId があれば host は同じ ID で起動し直す.
+	flagged.StartupParameters.CustomSessionId = proto.String(flagged.ID)
+	require.NoError(t, repo.Upsert(t.Context(), flagged))
+	plain := testutil.CreateTestSession(t, queries, host.ID, "plain", entity.SessionStatus_RUNNING)
+
+	starter := &stubSessionStarter{repo: repo}
+	r := newTestSessionRestorer(repo, starter)
+
+	// watcher と同じく host を CRASHED にしてから observer に伝える.
+	require.NoError(t, queries.UpdateHostStatus(t.Context(), db.UpdateHostStatusParams{
+		ID:     host.ID,
+		Status: int32(entity.HeadlessHostStatus_CRASHED),
+	}))
+	r.OnHostTerminated(t.Context(), host, entity.HeadlessHostStatus_CRASHED)
+
+	got, err := repo.Get(t.Context(), flagged.ID)
+	require.NoError(t, err)
+	assert.Equal(t, entity.SessionStatus_CRASHED, got.Status)
+
+	gotPlain, err := repo.Get(t.Context(), plain.ID)
+	require.NoError(t, err)
+	assert.Equal(t, entity.SessionStatus_RUNNING, gotPlain.Status, "sessions without the flag are left alone")
+
+	runSessionRestorer(t, r)
+
+	// host が落ちている間は復元しない.
+	time.Sleep(50 * time.Millisecond)
+	assert.Zero(t, starter.startCount())
+
+	require.NoError(t, queries.UpdateHostStatus(t.Context(), db.UpdateHostStatusParams{
+		ID:     host.ID,
+		Status: int32(entity.HeadlessHostStatus_RUNNING),
+	}))
+
+	require.Eventually(t, func() bool {
+		got, err := repo.Get(t.Context(), flagged.ID)
+
+		return err == nil && got.Status == entity.SessionStatus_RUNNING
+	}, 5*time.Second, 10*time.Millisecond)
+
+	got, err = repo.Get(t.Context(), flagged.ID)
+	require.NoError(t, err)
+	assert.Equal(t, flagged.ID, got.StartupParameters.GetCustomSessionId())
+	assert.True(t, got.RestoreOnCrash)
+	assert.True(t, got.AutoUpgrade)
+	assert.Equal(t, "常設", got.Memo)
+	assert.Equal(t, 1, starter.startCount())
+}
+
+func TestSessionRestorer_EndsPreviousSessionWhenIDChanges(t *testing.T) {
+	queries, _ := testutil.SetupTestDB(t)
+	repo := adapter.NewSessionRepository(queries)
+
+	account := testutil.CreateTestHeadlessAccount(t, queries, "U-restore-new", "cred", "pass")
+	host := testutil.CreateTestHeadlessHost(t, queries, account.ResoniteID, "crashy", entity.HeadlessHostStatus_RUNNING)
+
+	old := createRestorableSession(t, queries, repo, host.ID, &headlessv1.WorldStartupParameters{Name: proto.String("no-custom-id")})
+	require.NoError(t, repo.UpdateStatus(t.Context(), old.ID, entity.SessionStatus_CRASHED))
+
+	starter := &stubSessionStarter{repo: repo, newID: "S-restored"}
+	runSessionRestorer(t, newTestSessionRestorer(repo, starter))
+
+	require.Eventually(t, func() bool {
+		got, err := repo.Get(t.Context(), "S-restored")
+
+		return err == nil && got.Status == entity.SessionStatus_RUNNING
+	}, 5*time.Second, 10*time.Millisecond)
+
+	restored, err := repo.Get(t.Context(), "S-restored")
+	require.NoError(t, err)
+	assert.True(t, restored.RestoreOnCrash)
+
+	require.Eventually(t, func() bool {
+		got, err := repo.Get(t.Context(), old.ID)
+
+		return err == nil && got.Status == entity.SessionStatus_ENDED
+	}, 5*time.Second, 10*time.Millisecond)
+}
+
+func TestSessionRestorer_GivesUpAfterMaxAttempts(t *testing.T) {
+	queries, _ := testutil.SetupTestDB(t)
+	repo := adapter.NewSessionRepository(queries)
+
+	account := testutil.CreateTestHeadlessAccount(t, queries, "U-restore-fail", "cred", "pass")
+	host := testutil.CreateTestHeadlessHost(t, queries, account.ResoniteID, "crashy", entity.HeadlessHostStatus_RUNNING)
+
+	session := createRestorableSession(t, queries, repo, host.ID, &headlessv1.WorldStartupParameters{Name: proto.String("broken")})
+	require.NoError(t, repo.UpdateStatus(t.Context(), session.ID, entity.SessionStatus_CRASHED))
+
+	starter := &stubSessionStarter{repo: repo, startErr: errors.New("world failed to load")}
+	runSessionRestorer(t, newTestSessionRestorer(repo, starter))
+
+	require.Eventually(t, func() bool {
+		got, err := repo.Get(t.Context(), session.ID)
+
+		return err == nil && got.Status == entity.SessionStatus_ENDED
+	}, 5*time.Second, 10*time.Millisecond)
+
+	// SessionRestoreMaxAttempts (2) 回で諦め、ENDED 以降は試さない.
+	time.Sleep(50 * time.Millisecond)
+	assert.Equal(t, 2, starter.startCount())
+}
@@ -0,0 +1,66 @@
+package worker
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/hantabaru1014/baru-reso-headless-controller/domain/entity"
+	headlessv1 "github.com/hantabaru1014/baru-reso-headless-controller/pbgen/headless/v1"
+	"github.com/hantabaru1014/baru-reso-headless-controller/usecase/port"
+)
+
+// SessionUserEventRecorder persists UserJoinedSession / UserLeftSession into
+// session_user_events so attendance can be looked up after a session ends.
+// Rows are keyed by the host event id, which makes redelivered events
+// no-ops. It must run after SessionLifecycleHandler so the session row the
+// events reference already exists.
+type SessionUserEventRecorder struct {
+	repo port.SessionUserEventRepository
+}
+
+func NewSessionUserEventRecorder(repo port.SessionUserEventRepository) *SessionUserEventRecorder {
+	return &SessionUserEventRecorder{repo: repo}
+}
+
+var _ HostEventHandler = (*SessionUserEventRecorder)(nil)
+
+func (r *SessionUserEventRecorder) HandleHostEvent(ctx context.Context, hostID string, ev *headlessv1.HostEvent) {
+	event := &entity.SessionUserEvent{
+		HostID:  hostID,
+		EventID: ev.GetId(),
+	}
+
+	switch p := ev.GetPayload().(type) {
+	case *headlessv1.HostEvent_UserJoinedSession:
+		event.Kind = entity.SessionUserEventKind_Joined
+		event.SessionID = p.UserJoinedSession.GetSessionId()
+		event.UserID = p.UserJoinedSession.GetUserId()
+		event.UserName = p.UserJoinedSession.GetUserName()
+	case *headlessv1.HostEvent_UserLeftSession:
+		event.Kind = entity.SessionUserEventKind_Left
+		event.SessionID = p.UserLeftSession.GetSessionId()
+		event.UserID = p.UserLeftSession.GetUserId()
+		event.UserName = p.UserLeftSession.GetUserName()
+	default:
+		return
+	}
+
+	logArgs := []any{"hostID", hostID, "eventID", ev.GetId(), "sessionID", event.SessionID}
+
+	if ev.GetOccurredAt() == nil || event.UserID == "" {
+		slog.Warn("session-user-event: dropping event without occurred_at or user id", logArgs...)
+
+		return
+	}
+
+	event.OccurredAt = ev.GetOccurredAt().AsTime()
+
+	if _, err := r.repo.Insert(ctx, event); err != nil {
+		slog.Error("session-user-event: failed to record event", append(logArgs, "error", err)...)
+	}
+}
+
+// HandleHostEventStreamReset is a no-op. Events dropped from the host's ring
+// buffer are gone for good; attendance treats users still marked present as
+// having stayed until the session ended.
+func (r *SessionUserEventRecorder) HandleHostEventStreamReset(_ context.Context, _ string) {}
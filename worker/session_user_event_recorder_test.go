@@ -0,0 +1,116 @@
+package worker
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/hantabaru1014/baru-reso-headless-controller/domain/entity"
+	headlessv1 "github.com/hantabaru1014/baru-reso-headless-controller/pbgen/headless/v1"
+	"github.com/hantabaru1014/baru-reso-headless-controller/usecase/port"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// fakeSessionUserEventRepo dedupes on (host id, event id) like the unique
+// constraint on session_user_events.
+type fakeSessionUserEventRepo struct {
+	port.SessionUserEventRepository
+
+	events    []*entity.SessionUserEvent
+	insertErr error
+}
+
+func (r *fakeSessionUserEventRepo) Insert(_ context.Context, event *entity.SessionUserEvent) (bool, error) {
+	if r.insertErr != nil {
+		return false, r.insertErr
+	}
+
+	for _, e := range r.events {
+		if e.HostID == event.HostID && e.EventID == event.EventID {
+			return false, nil
+		}
+	}
+
+	r.events = append(r.events, event)
+
+	return true, nil
+}
+
+func TestSessionUserEventRecorder_RecordsJoinAndLeave(t *testing.T) {
+	t.Parallel()
+
+	repo := &fakeSessionUserEventRepo{}
+	r := NewSessionUserEventRecorder(repo)
+	occurredAt := time.Date(2026, 7, 4, 12, 0, 0, 0, time.UTC)
+
+	r.HandleHostEvent(t.Context(), "h1", &headlessv1.HostEvent{
+		Id:         "01J0000000000000000000001",
+		OccurredAt: timestamppb.New(occurredAt),
+		Payload: &headlessv1.HostEvent_UserJoinedSession{
+			UserJoinedSession: &headlessv1.UserJoinedSession{SessionId: "s1", UserId: "U-alice", UserName: "alice"},
+		},
+	})
+	r.HandleHostEvent(t.Context(), "h1", &headlessv1.HostEvent{
+		Id:         "01J0000000000000000000002",
+		OccurredAt: timestamppb.New(occurredAt.Add(time.Minute)),
+		Payload: &headlessv1.HostEvent_UserLeftSession{
+			UserLeftSession: &headlessv1.UserLeftSession{SessionId: "s1", UserId: "U-alice", UserName: "alice"},
+		},
+	})
+
+	require.Len(t, repo.events, 2)
+	assert.Equal(t, &entity.SessionUserEvent{
+		SessionID:  "s1",
+		HostID:     "h1",
+		EventID:    "01J0000000000000000000001",
+		Kind:       entity.SessionUserEventKind_Joined,
+		UserID:     "U-alice",
+		UserName:   "alice",
+		OccurredAt: occurredAt,
+	}, repo.events[0])
+	assert.Equal(t, entity.SessionUserEventKind_Left, repo.events[1].Kind)
+	assert.Equal(t, occurredAt.Add(time.Minute), repo.events[1].OccurredAt)
+}
+
+func TestSessionUserEventRecorder_RedeliveredEventIsStoredOnce(t *testing.T) {
+	t.Parallel()
+
+	repo := &fakeSessionUserEventRepo{}
+	r := NewSessionUserEventRecorder(repo)
+	ev := &headlessv1.HostEvent{
+		Id:         "01J0000000000000000000001",
+		OccurredAt: timestamppb.Now(),
+		Payload: &headlessv1.HostEvent_UserJoinedSession{
+			UserJoinedSession: &headlessv1.UserJoinedSession{SessionId: "s1", UserId: "U-alice"},
+		},
+	}
+
+	r.HandleHostEvent(t.Context(), "h1", ev)
+	r.HandleHostEvent(t.Context(), "h1", ev)
+
+	assert.Len(t, repo.events, 1)
+}
+
+func TestSessionUserEventRecorder_IgnoresOtherAndIncompleteEvents(t *testing.T) {
+	t.Parallel()
+
+	repo := &fakeSessionUserEventRepo{insertErr: errors.New("should not be called")}
+	r := NewSessionUserEventRecorder(repo)
+
+	r.HandleHostEvent(t.Context(), "h1", &headlessv1.HostEvent{
+		Id:         "e1",
+		OccurredAt: timestamppb.Now(),
+		Payload:    &headlessv1.HostEvent_SessionStarted{SessionStarted: &headlessv1.SessionStarted{SessionId: "s1"}},
+	})
+	r.HandleHostEvent(t.Context(), "h1", &headlessv1.HostEvent{
+		Id: "e2",
+		Payload: &headlessv1.HostEvent_UserJoinedSession{
+			UserJoinedSession: &headlessv1.UserJoinedSession{SessionId: "s1", UserId: "U-alice"},
+		},
+	})
+
+	assert.Empty(t, repo.events)
+}
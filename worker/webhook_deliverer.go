@@ -0,0 +1,231 @@
+package worker
+
+import (
+	"context"
+	"log/slog"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/go-errors/errors"
+	"github.com/hantabaru1014/baru-reso-headless-controller/config"
+	"github.com/hantabaru1014/baru-reso-headless-controller/domain"
+	"github.com/hantabaru1014/baru-reso-headless-controller/domain/entity"
+	"github.com/hantabaru1014/baru-reso-headless-controller/lib/auth"
+	"github.com/hantabaru1014/baru-reso-headless-controller/lib/webhook"
+	"github.com/hantabaru1014/baru-reso-headless-controller/usecase/port"
+)
+
+const (
+	// webhookBatchSize is how many due deliveries are claimed per poll.
+	// They are sent concurrently.
+	webhookBatchSize = 20
+	// webhookStaleClaimAfter returns deliveries claimed by an instance that
+	// died mid-send to the queue. It has to be well above the request
+	// timeout so that slow but live sends are not duplicated.
+	webhookStaleClaimAfter = 5 * time.Minute
+	// webhookCleanupInterval is how often finished deliveries older than
+	// the retention are deleted.
+	webhookCleanupInterval = time.Hour
+)
+
+// WebhookDeliverer sends the deliveries queued by WebhookDispatcher.
+// Failed sends are retried with exponential backoff (honouring
+// Retry-After) until maxAttempts; 4xx answers other than 408/429,
+// destinations refused by webhook.CheckAddr and deliveries of disabled or
+// deleted subscriptions fail immediately.
+// Claiming goes through FOR UPDATE SKIP LOCKED, so several controller
+// instances can run it side by side.
+type WebhookDeliverer struct {
+	repo   port.WebhookRepository
+	client *webhook.Client
+
+	pollInterval time.Duration
+	baseDelay    time.Duration
+	maxDelay     time.Duration
+	maxAttempts  int32
+	retention    time.Duration
+
+	now func() time.Time
+}
+
+var _ Runner = (*WebhookDeliverer)(nil)
+
+func NewWebhookDeliverer(repo port.WebhookRepository, cfg *config.WorkerConfig) *WebhookDeliverer {
+	return &WebhookDeliverer{
+		repo:         repo,
+		client:       webhook.NewClient(cfg.WebhookTimeout, cfg.WebhookAllowedNetworks),
+		pollInterval: cfg.WebhookPollInterval,
+		baseDelay:    cfg.WebhookRetryBaseDelay,
+		maxDelay:     cfg.WebhookRetryMaxDelay,
+		maxAttempts:  int32(cfg.WebhookMaxAttempts), //nolint:gosec // G115: small config value
+		retention:    cfg.WebhookDeliveryRetention,
+		now:          time.Now,
+	}
+}
+
+func (w *WebhookDeliverer) Name() string { return "webhook-deliverer" }
+
+func (w *WebhookDeliverer) Run(ctx context.Context) error {
+	ctx = auth.WithActAsUser(ctx, domain.SystemUserID)
+
+	w.cleanup(ctx)
+	w.DeliverDue(ctx)
+
+	ticker := time.NewTicker(w.pollInterval)
+	defer ticker.Stop()
+
+	cleanupTicker := time.NewTicker(webhookCleanupInterval)
+	defer cleanupTicker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+			w.DeliverDue(ctx)
+		case <-cleanupTicker.C:
+			w.cleanup(ctx)
+		}
+	}
+}
+
+// DeliverDue claims one batch of due deliveries and sends them, returning
+// once every send has been recorded.
+func (w *WebhookDeliverer) DeliverDue(ctx context.Context) {
+	if released, err := w.repo.ReleaseStaleDeliveryClaims(ctx, webhookStaleClaimAfter); err != nil {
+		slog.Error("webhook-deliverer: failed to release stale claims", "error", err)
+	} else if released > 0 {
+		slog.Warn("webhook-deliverer: released stale claims", "count", released)
+	}
+
+	deliveries, err := w.repo.ClaimDueDeliveries(ctx, webhookBatchSize)
+	if err != nil {
+		slog.Error("webhook-deliverer: failed to claim deliveries", "error", err)
+
+		return
+	}
+
+	// Deliveries of the same subscription share its lookup. A nil entry
+	// means the subscription is gone; a missing one that the lookup failed.
+	subs := make(map[string]*entity.WebhookSubscription)
+
+	for _, d := range deliveries {
+		if _, ok := subs[d.SubscriptionID]; ok {
+			continue
+		}
+
+		sub, err := w.repo.GetSubscription(ctx, d.SubscriptionID)
+		if err != nil && !errors.Is(err, domain.ErrNotFound) {
+			slog.Error("webhook-deliverer: failed to get subscription", "subscriptionID", d.SubscriptionID, "error", err)
+
+			continue
+		}
+
+		subs[d.SubscriptionID] = sub
+	}
+
+	var wg sync.WaitGroup
+
+	for _, d := range deliveries {
+		sub, ok := subs[d.SubscriptionID]
+		if !ok {
+			w.record(w.repo.RetryDeliveryLater(context.WithoutCancel(ctx), d.ID, w.now().Add(w.pollInterval), nil,
+				"failed to load subscription"), d)
+
+			continue
+		}
+
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+
+			w.deliver(ctx, sub, d)
+		}()
+	}
+
+	wg.Wait()
+}
+
+func (w *WebhookDeliverer) deliver(ctx context.Context, sub *entity.WebhookSubscription, d *entity.WebhookDelivery) {
+	// Outcomes are recorded even when shutting down mid-send; otherwise the
+	// row would stay SENDING until the stale claim is released.
+	persistCtx := context.WithoutCancel(ctx)
+
+	if sub == nil || !sub.Enabled {
+		w.record(w.repo.MarkDeliveryFailed(persistCtx, d.ID, nil, "subscription is disabled or deleted"), d)
+
+		return
+	}
+
+	statusCode, err := w.client.Send(ctx, webhook.Request{
+		URL:        sub.URL,
+		Secret:     sub.Secret,
+		EventType:  string(d.EventType),
+		DeliveryID: strconv.FormatInt(d.ID, 10),
+		Body:       d.Body,
+	})
+	if err == nil {
+		w.record(w.repo.MarkDeliverySucceeded(persistCtx, d.ID, int32(statusCode)), d) //nolint:gosec // G115: HTTP status
+
+		return
+	}
+
+	var code *int32
+	if statusCode != 0 {
+		c := int32(statusCode) //nolint:gosec // G115: HTTP status
+		code = &c
+	}
+
+	attempts := d.Attempts + 1
+
+	var statusErr *webhook.StatusError
+	if (errors.As(err, &statusErr) && statusErr.Permanent()) || errors.Is(err, webhook.ErrForbiddenDestination) ||
+		attempts >= w.maxAttempts {
+		slog.Warn("webhook-deliverer: giving up delivery", "deliveryID", d.ID, "subscriptionID", sub.ID,
+			"attempts", attempts, "error", err)
+		w.record(w.repo.MarkDeliveryFailed(persistCtx, d.ID, code, err.Error()), d)
+
+		return
+	}
+
+	delay := w.backoff(attempts)
+	if statusErr != nil && statusErr.RetryAfter > delay {
+		delay = statusErr.RetryAfter
+	}
+
+	slog.Info("webhook-deliverer: delivery failed, will retry", "deliveryID", d.ID, "subscriptionID", sub.ID,
+		"attempts", attempts, "delay", delay, "error", err)
+	w.record(w.repo.RetryDeliveryLater(persistCtx, d.ID, w.now().Add(delay), code, err.Error()), d)
+}
+
+// backoff returns the delay before attempt+1: baseDelay doubled for each
+// failed attempt, capped at maxDelay.
+func (w *WebhookDeliverer) backoff(attempts int32) time.Duration {
+	delay := w.baseDelay
+	for i := int32(1); i < attempts && delay < w.maxDelay; i++ {
+		delay *= 2
+	}
+
+	return min(delay, w.maxDelay)
+}
+
+func (w *WebhookDeliverer) record(err error, d *entity.WebhookDelivery) {
+	if err != nil {
+		slog.Error("webhook-deliverer: failed to record delivery result", "deliveryID", d.ID, "error", err)
+	}
+}
+
+func (w *WebhookDeliverer) cleanup(ctx context.Context) {
+	deleted, err := w.repo.DeleteFinishedDeliveriesBefore(ctx, w.now().Add(-w.retention))
+	if err != nil {
+		slog.Error("webhook-deliverer: failed to delete old deliveries", "error", err)
+
+		return
+	}
+
+	if deleted > 0 {
+		slog.Info("webhook-deliverer: deleted old deliveries", "count", deleted)
+	}
+}
@@ -0,0 +1,177 @@
+package worker_test
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/netip"
+	"strconv"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/hantabaru1014/baru-reso-headless-controller/adapter"
+	"github.com/hantabaru1014/baru-reso-headless-controller/config"
+	"github.com/hantabaru1014/baru-reso-headless-controller/domain/entity"
+	"github.com/hantabaru1014/baru-reso-headless-controller/lib/webhook"
+	"github.com/hantabaru1014/baru-reso-headless-controller/testutil"
+	"github.com/hantabaru1014/baru-reso-headless-controller/usecase/port"
+	"github.com/hantabaru1014/baru-reso-headless-controller/worker"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestWebhookDeliverer(repo port.WebhookRepository, maxAttempts int) *worker.WebhookDeliverer {
+	return worker.NewWebhookDeliverer(repo, &config.WorkerConfig{
+		WebhookPollInterval:      time.Second,
+		WebhookTimeout:           time.Second,
+		WebhookRetryBaseDelay:    time.Minute,
+		WebhookRetryMaxDelay:     time.Hour,
+		WebhookMaxAttempts:       maxAttempts,
+		WebhookDeliveryRetention: time.Hour,
+		// httptest のサーバーは loopback で待ち受ける
+		WebhookAllowedNetworks: []netip.Prefix{netip.MustParsePrefix("127.0.0.0/8")},
+	})
+}
+
+// webhookStandIn は受信したリクエストを数え、固定の status を返すローカルの受け口.
+func webhookStandIn(t *testing.T, status int, verify func(r *http.Request, body []byte)) (*httptest.Server, *atomic.Int32) {
+	t.Helper()
+
+	var hits atomic.Int32
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		hits.Add(1)
+
+		body, _ := io.ReadAll(r.Body)
+		if verify != nil {
+			verify(r, body)
+		}
+
+		w.WriteHeader(status)
+	}))
+	t.Cleanup(srv.Close)
+
+	return srv, &hits
+}
+
+func enqueueTestDelivery(t *testing.T, repo port.WebhookRepository, sub *entity.WebhookSubscription) *entity.WebhookDelivery {
+	t.Helper()
+
+	delivery, err := repo.EnqueueDelivery(t.Context(), sub.ID, entity.WebhookEventType_Ping, []byte(`{"type":"ping"}`))
+	require.NoError(t, err)
+
+	return delivery
+}
+
+func TestWebhookDeliverer_DeliverDue(t *testing.T) {
+	t.Run("成功: 署名付きで送信し SUCCEEDED にする", func(t *testing.T) {
+		queries, _ := testutil.SetupTestDB(t)
+		repo := adapter.NewWebhookRepository(queries)
+
+		var delivery *entity.WebhookDelivery
+
+		srv, hits := webhookStandIn(t, http.StatusNoContent, func(r *http.Request, body []byte) {
+			ts, err := strconv.ParseInt(r.Header.Get(webhook.HeaderTimestamp), 10, 64)
+			assert.NoError(t, err)
+			assert.Equal(t, webhook.Sign("s3cret", ts, body), r.Header.Get(webhook.HeaderSignature))
+			assert.Equal(t, string(entity.WebhookEventType_Ping), r.Header.Get(webhook.HeaderEvent))
+			assert.Equal(t, strconv.FormatInt(delivery.ID, 10), r.Header.Get(webhook.HeaderDelivery))
+		})
+		sub := createTestWebhook(t, repo, srv.URL, entity.WebhookFormat_Generic)
+		delivery = enqueueTestDelivery(t, repo, sub)
+
+		d := newTestWebhookDeliverer(repo, 3)
+		d.DeliverDue(t.Context())
+		// 完了済みの行は再送しない.
+		d.DeliverDue(t.Context())
+
+		assert.Equal(t, int32(1), hits.Load())
+
+		got := listTestDeliveries(t, repo, sub.ID)
+		require.Len(t, got, 1)
+		assert.Equal(t, entity.WebhookDeliveryStatus_SUCCEEDED, got[0].Status)
+		assert.Equal(t, int32(1), got[0].Attempts)
+		require.NotNil(t, got[0].LastStatusCode)
+		assert.Equal(t, int32(http.StatusNoContent), *got[0].LastStatusCode)
+		assert.NotNil(t, got[0].DeliveredAt)
+	})
+
+	t.Run("5xx: backoff 後に再送するため PENDING に戻す", func(t *testing.T) {
+		queries, _ := testutil.SetupTestDB(t)
+		repo := adapter.NewWebhookRepository(queries)
+
+		srv, hits := webhookStandIn(t, http.StatusBadGateway, nil)
+		sub := createTestWebhook(t, repo, srv.URL, entity.WebhookFormat_Generic)
+		enqueueTestDelivery(t, repo, sub)
+
+		before := time.Now()
+		d := newTestWebhookDeliverer(repo, 3)
+		d.DeliverDue(t.Context())
+		// next_attempt_at が未来なので即座には再送しない.
+		d.DeliverDue(t.Context())
+
+		assert.Equal(t, int32(1), hits.Load())
+
+		got := listTestDeliveries(t, repo, sub.ID)
+		require.Len(t, got, 1)
+		assert.Equal(t, entity.WebhookDeliveryStatus_PENDING, got[0].Status)
+		assert.Equal(t, int32(1), got[0].Attempts)
+		assert.True(t, got[0].NextAttemptAt.After(before.Add(30*time.Second)), got[0].NextAttemptAt)
+		require.NotNil(t, got[0].LastError)
+	})
+
+	t.Run("4xx: 再送せず FAILED にする", func(t *testing.T) {
+		queries, _ := testutil.SetupTestDB(t)
+		repo := adapter.NewWebhookRepository(queries)
+
+		srv, _ := webhookStandIn(t, http.StatusNotFound, nil)
+		sub := createTestWebhook(t, repo, srv.URL, entity.WebhookFormat_Generic)
+		enqueueTestDelivery(t, repo, sub)
+
+		newTestWebhookDeliverer(repo, 3).DeliverDue(t.Context())
+
+		got := listTestDeliveries(t, repo, sub.ID)
+		require.Len(t, got, 1)
+		assert.Equal(t, entity.WebhookDeliveryStatus_FAILED, got[0].Status)
+		require.NotNil(t, got[0].LastStatusCode)
+		assert.Equal(t, int32(http.StatusNotFound), *got[0].LastStatusCode)
+	})
+
+	t.Run("最大試行回数に達したら FAILED にする", func(t *testing.T) {
+		queries, _ := testutil.SetupTestDB(t)
+		repo := adapter.NewWebhookRepository(queries)
+
+		srv, _ := webhookStandIn(t, http.StatusServiceUnavailable, nil)
+		sub := createTestWebhook(t, repo, srv.URL, entity.WebhookFormat_Generic)
+		enqueueTestDelivery(t, repo, sub)
+
+		newTestWebhookDeliverer(repo, 1).DeliverDue(t.Context())
+
+		got := listTestDeliveries(t, repo, sub.ID)
+		require.Len(t, got, 1)
+		assert.Equal(t, entity.WebhookDeliveryStatus_FAILED, got[0].Status)
+		assert.Equal(t, int32(1), got[0].Attempts)
+	})
+
+	t.Run("無効化された subscription には送信しない", func(t *testing.T) {
+		queries, _ := testutil.SetupTestDB(t)
+		repo := adapter.NewWebhookRepository(queries)
+
+		srv, hits := webhookStandIn(t, http.StatusNoContent, nil)
+		sub := createTestWebhook(t, repo, srv.URL, entity.WebhookFormat_Generic)
+		enqueueTestDelivery(t, repo, sub)
+
+		disabled := false
+		_, err := repo.UpdateSubscription(t.Context(), sub.ID, port.WebhookSubscriptionUpdateParams{Enabled: &disabled})
+		require.NoError(t, err)
+
+		newTestWebhookDeliverer(repo, 3).DeliverDue(t.Context())
+
+		assert.Zero(t, hits.Load())
+
+		got := listTestDeliveries(t, repo, sub.ID)
+		require.Len(t, got, 1)
+		assert.Equal(t, entity.WebhookDeliveryStatus_FAILED, got[0].Status)
+	})
+}
@@ -0,0 +1,372 @@
+package worker
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/hantabaru1014/baru-reso-headless-controller/db"
+	"github.com/hantabaru1014/baru-reso-headless-controller/domain"
+	"github.com/hantabaru1014/baru-reso-headless-controller/domain/entity"
+	"github.com/hantabaru1014/baru-reso-headless-controller/lib/auth"
+	"github.com/hantabaru1014/baru-reso-headless-controller/lib/webhook"
+	hdlctrlv1 "github.com/hantabaru1014/baru-reso-headless-controller/pbgen/hdlctrl/v1"
+	"github.com/hantabaru1014/baru-reso-headless-controller/usecase/notification"
+	"github.com/hantabaru1014/baru-reso-headless-controller/usecase/port"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+// WebhookDispatcher turns controller events into queued webhook deliveries
+// for the subscriptions of the group the event belongs to. It listens to
+// every event on the notification bus, so nothing is lost when nobody has
+// the dashboard open, and is told about host crashes directly because the
+// bus only carries the resulting HostUpdated.
+//
+// Delivery itself happens in WebhookDeliverer; the dispatcher only renders
+// and enqueues.
+type WebhookDispatcher struct {
+	q    *db.Queries
+	repo port.WebhookRepository
+	bus  notification.Bus
+}
+
+var (
+	_ Runner                  = (*WebhookDispatcher)(nil)
+	_ HostTerminationObserver = (*WebhookDispatcher)(nil)
+)
+
+func NewWebhookDispatcher(q *db.Queries, repo port.WebhookRepository, bus notification.Bus) *WebhookDispatcher {
+	return &WebhookDispatcher{q: q, repo: repo, bus: bus}
+}
+
+func (d *WebhookDispatcher) Name() string { return "webhook-dispatcher" }
+
+func (d *WebhookDispatcher) Run(ctx context.Context) error {
+	ctx = auth.WithActAsUser(ctx, domain.SystemUserID)
+
+	events, cancel := d.bus.SubscribeAll(ctx)
+	defer cancel()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case ev := <-events:
+			d.HandleNotification(ctx, ev)
+		}
+	}
+}
+
+// HandleNotification enqueues the webhook deliveries for one bus event.
+// Events without a webhook counterpart are ignored.
+func (d *WebhookDispatcher) HandleNotification(ctx context.Context, ev *hdlctrlv1.NotificationEvent) {
+	occurredAt := ev.GetOccurredAt().AsTime()
+
+	switch p := ev.GetPayload().(type) {
+	case *hdlctrlv1.NotificationEvent_SessionLifecycle:
+		d.handleSessionLifecycle(ctx, p.SessionLifecycle, occurredAt)
+	case *hdlctrlv1.NotificationEvent_SessionUserChanged:
+		d.handleSessionUserChanged(ctx, p.SessionUserChanged, occurredAt)
+	case *hdlctrlv1.NotificationEvent_HostAutoRestart:
+		d.handleHostAutoRestart(ctx, p.HostAutoRestart, occurredAt)
+	case *hdlctrlv1.NotificationEvent_JobCompleted:
+		d.handleJobCompleted(ctx, p.JobCompleted, occurredAt)
+	}
+}
+
+// OnHostTerminated implements HostTerminationObserver. It has to run before
+// SessionRestorer, which moves the host's RUNNING sessions to CRASHED; the
+// sessions that were running are the ones reported as crashed.
+func (d *WebhookDispatcher) OnHostTerminated(ctx context.Context, host db.Host, newStatus entity.HeadlessHostStatus) {
+	now := time.Now()
+
+	status, title := "crashed", fmt.Sprintf("ホスト %q がクラッシュしました", host.Name)
+	if newStatus == entity.HeadlessHostStatus_EXITED {
+		status, title = "exited", fmt.Sprintf("ホスト %q が停止操作なしに終了しました", host.Name)
+	}
+
+	d.dispatch(ctx, host.GroupID, entity.WebhookEventType_HostCrashed, webhook.Event{
+		OccurredAt: now,
+		Level:      webhook.LevelError,
+		Title:      title,
+		Data: map[string]string{
+			"host_id": host.ID,
+			"status":  status,
+		},
+		Fields: []webhook.Field{{Name: "ホスト", Value: host.Name}},
+	})
+
+	sessions, err := d.q.ListSessionsByHostAndStatus(ctx, db.ListSessionsByHostAndStatusParams{
+		HostID: host.ID,
+		Status: int32(entity.SessionStatus_RUNNING),
+	})
+	if err != nil {
+		slog.Error("webhook-dispatcher: failed to list sessions of terminated host", "hostID", host.ID, "error", err)
+
+		return
+	}
+
+	for _, s := range sessions {
+		d.dispatch(ctx, s.GroupID, entity.WebhookEventType_SessionCrashed, webhook.Event{
+			OccurredAt: now,
+			Level:      webhook.LevelError,
+			Title:      fmt.Sprintf("セッション %q がホストのクラッシュにより停止しました", s.Name),
+			Data: map[string]string{
+				"session_id": s.ID,
+				"host_id":    host.ID,
+			},
+			Fields: []webhook.Field{
+				{Name: "セッション", Value: s.Name},
+				{Name: "ホスト", Value: host.Name},
+			},
+		})
+	}
+}
+
+func (d *WebhookDispatcher) handleSessionLifecycle(ctx context.Context, ev *hdlctrlv1.SessionLifecycleEvent, occurredAt time.Time) {
+	var (
+		eventType entity.WebhookEventType
+		level     webhook.Level
+		format    string
+	)
+
+	switch ev.GetKind() {
+	case hdlctrlv1.SessionLifecycleEvent_KIND_STARTED:
+		eventType, level, format = entity.WebhookEventType_SessionStarted, webhook.LevelSuccess, "セッション %q が開始しました"
+	case hdlctrlv1.SessionLifecycleEvent_KIND_ENDED:
+		eventType, level, format = entity.WebhookEventType_SessionEnded, webhook.LevelInfo, "セッション %q が終了しました"
+	default:
+		return
+	}
+
+	target, ok := d.resolveSession(ctx, ev.GetSessionId(), ev.GetHostId())
+	if !ok {
+		return
+	}
+
+	d.dispatch(ctx, target.groupID, eventType, webhook.Event{
+		OccurredAt: occurredAt,
+		Level:      level,
+		Title:      fmt.Sprintf(format, target.sessionName),
+		Data:       target.data(),
+		Fields:     target.fields(),
+	})
+}
+
+func (d *WebhookDispatcher) handleSessionUserChanged(ctx context.Context, ev *hdlctrlv1.SessionUserChangedEvent, occurredAt time.Time) {
+	var (
+		eventType entity.WebhookEventType
+		format    string
+	)
+
+	switch ev.GetKind() {
+	case hdlctrlv1.SessionUserChangedEvent_KIND_JOINED:
+		eventType, format = entity.WebhookEventType_SessionUserJoined, "%s が %q に参加しました"
+	case hdlctrlv1.SessionUserChangedEvent_KIND_LEFT:
+		eventType, format = entity.WebhookEventType_SessionUserLeft, "%s が %q から退出しました"
+	default:
+		return
+	}
+
+	target, ok := d.resolveSession(ctx, ev.GetSessionId(), ev.GetHostId())
+	if !ok {
+		return
+	}
+
+	data := target.data()
+	data["user_name"] = ev.GetUserName()
+
+	d.dispatch(ctx, target.groupID, eventType, webhook.Event{
+		OccurredAt: occurredAt,
+		Level:      webhook.LevelInfo,
+		Title:      fmt.Sprintf(format, ev.GetUserName(), target.sessionName),
+		Data:       data,
+		Fields:     append([]webhook.Field{{Name: "ユーザー", Value: ev.GetUserName()}}, target.fields()...),
+	})
+}
+
+func (d *WebhookDispatcher) handleHostAutoRestart(ctx context.Context, ev *hdlctrlv1.HostAutoRestartEvent, occurredAt time.Time) {
+	host, err := d.q.GetHost(ctx, ev.GetHostId())
+	if err != nil {
+		slog.Warn("webhook-dispatcher: failed to get host", "hostID", ev.GetHostId(), "error", err)
+
+		return
+	}
+
+	d.dispatch(ctx, host.GroupID, entity.WebhookEventType_HostAutoRestart, webhook.Event{
+		OccurredAt: occurredAt,
+		Level:      webhookLevel(ev.GetLevel()),
+		Title:      ev.GetMessage(),
+		Data: map[string]string{
+			"host_id": host.ID,
+			"kind":    ev.GetKind().String(),
+			"attempt": fmt.Sprint(ev.GetAttempt()),
+		},
+		Fields: []webhook.Field{
+			{Name: "ホスト", Value: host.Name},
+			{Name: "試行回数", Value: fmt.Sprint(ev.GetAttempt())},
+		},
+	})
+}
+
+func (d *WebhookDispatcher) handleJobCompleted(ctx context.Context, ev *hdlctrlv1.JobCompletedEvent, occurredAt time.Time) {
+	eventType, title := entity.WebhookEventType_JobSucceeded, "ジョブ %s が完了しました"
+	if ev.GetLevel() == hdlctrlv1.JobCompletedEvent_LEVEL_ERROR {
+		eventType, title = entity.WebhookEventType_JobFailed, "ジョブ %s が失敗しました"
+	}
+
+	var jobID pgtype.UUID
+	if err := jobID.Scan(ev.GetJobId()); err != nil {
+		slog.Warn("webhook-dispatcher: invalid job id", "jobID", ev.GetJobId(), "error", err)
+
+		return
+	}
+
+	job, err := d.q.GetAsyncJob(ctx, jobID)
+	if err != nil {
+		slog.Warn("webhook-dispatcher: failed to get job", "jobID", ev.GetJobId(), "error", err)
+
+		return
+	}
+
+	var (
+		target webhookTarget
+		ok     bool
+	)
+
+	switch {
+	case job.SessionID.Valid:
+		target, ok = d.resolveSession(ctx, job.SessionID.String, job.HostID.String)
+	case job.HostID.Valid:
+		target, ok = d.resolveHost(ctx, job.HostID.String)
+	}
+
+	if !ok {
+		// Jobs tied to neither a host nor a session have no group.
+		return
+	}
+
+	jobType := asyncJobTypeLabel(entity.AsyncJobType(job.JobType))
+
+	data := target.data()
+	data["job_id"] = ev.GetJobId()
+	data["job_type"] = jobType
+
+	d.dispatch(ctx, target.groupID, eventType, webhook.Event{
+		OccurredAt: occurredAt,
+		Level:      webhookLevel(ev.GetLevel()),
+		Title:      fmt.Sprintf(title, jobType),
+		Message:    ev.GetMessage(),
+		Data:       data,
+		Fields:     target.fields(),
+	})
+}
+
+// dispatch renders ev for every subscription of groupID that wants
+// eventType and queues the result.
+func (d *WebhookDispatcher) dispatch(ctx context.Context, groupID string, eventType entity.WebhookEventType, ev webhook.Event) {
+	subs, err := d.repo.ListSubscriptionsForEvent(ctx, groupID, eventType)
+	if err != nil {
+		slog.Error("webhook-dispatcher: failed to list subscriptions", "groupID", groupID, "eventType", eventType, "error", err)
+
+		return
+	}
+
+	if len(subs) == 0 {
+		return
+	}
+
+	ev.Type = string(eventType)
+	ev.GroupID = groupID
+
+	for _, sub := range subs {
+		body, err := webhook.Render(string(sub.Format), ev)
+		if err != nil {
+			slog.Error("webhook-dispatcher: failed to render event", "subscriptionID", sub.ID, "error", err)
+
+			continue
+		}
+
+		if _, err := d.repo.EnqueueDelivery(ctx, sub.ID, eventType, body); err != nil {
+			slog.Error("webhook-dispatcher: failed to enqueue delivery", "subscriptionID", sub.ID, "error", err)
+		}
+	}
+}
+
+// webhookTarget is the host / session an event is about.
+type webhookTarget struct {
+	groupID     string
+	hostID      string
+	hostName    string
+	sessionID   string
+	sessionName string
+}
+
+func (t webhookTarget) data() map[string]string {
+	data := map[string]string{"host_id": t.hostID}
+	if t.sessionID != "" {
+		data["session_id"] = t.sessionID
+	}
+
+	return data
+}
+
+func (t webhookTarget) fields() []webhook.Field {
+	var fields []webhook.Field
+	if t.sessionName != "" {
+		fields = append(fields, webhook.Field{Name: "セッション", Value: t.sessionName})
+	}
+
+	if t.hostName != "" {
+		fields = append(fields, webhook.Field{Name: "ホスト", Value: t.hostName})
+	}
+
+	return fields
+}
+
+func (d *WebhookDispatcher) resolveHost(ctx context.Context, hostID string) (webhookTarget, bool) {
+	host, err := d.q.GetHost(ctx, hostID)
+	if err != nil {
+		slog.Warn("webhook-dispatcher: failed to get host", "hostID", hostID, "error", err)
+
+		return webhookTarget{}, false
+	}
+
+	return webhookTarget{groupID: host.GroupID, hostID: host.ID, hostName: host.Name}, true
+}
+
+// resolveSession falls back to the host's group when the session row does
+// not exist yet (SessionStarted can arrive before StartSession stored it).
+func (d *WebhookDispatcher) resolveSession(ctx context.Context, sessionID, hostID string) (webhookTarget, bool) {
+	session, err := d.q.GetSession(ctx, sessionID)
+	if err == nil {
+		hostID = session.HostID
+	}
+
+	target, ok := d.resolveHost(ctx, hostID)
+	if !ok {
+		return webhookTarget{}, false
+	}
+
+	target.sessionID = sessionID
+	target.sessionName = sessionID
+
+	if err == nil {
+		target.groupID = session.GroupID
+		target.sessionName = session.Name
+	}
+
+	return target, true
+}
+
+func webhookLevel(level hdlctrlv1.JobCompletedEvent_Level) webhook.Level {
+	switch level {
+	case hdlctrlv1.JobCompletedEvent_LEVEL_SUCCESS:
+		return webhook.LevelSuccess
+	case hdlctrlv1.JobCompletedEvent_LEVEL_ERROR:
+		return webhook.LevelError
+	default:
+		return webhook.LevelInfo
+	}
+}
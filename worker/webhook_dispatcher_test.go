@@ -0,0 +1,150 @@
+package worker_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/hantabaru1014/baru-reso-headless-controller/adapter"
+	"github.com/hantabaru1014/baru-reso-headless-controller/domain/entity"
+	hdlctrlv1 "github.com/hantabaru1014/baru-reso-headless-controller/pbgen/hdlctrl/v1"
+	"github.com/hantabaru1014/baru-reso-headless-controller/testutil"
+	"github.com/hantabaru1014/baru-reso-headless-controller/usecase/notification"
+	"github.com/hantabaru1014/baru-reso-headless-controller/usecase/port"
+	"github.com/hantabaru1014/baru-reso-headless-controller/worker"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// createTestWebhook は移行前全体グループ (テスト用ホストの所属先) に subscription を作る.
+func createTestWebhook(t *testing.T, repo port.WebhookRepository, url string, format entity.WebhookFormat, eventTypes ...entity.WebhookEventType) *entity.WebhookSubscription {
+	t.Helper()
+
+	sub := &entity.WebhookSubscription{
+		ID:         "wh-" + string(format),
+		GroupID:    entity.MigratedPrePermissionGroupID,
+		Name:       "test",
+		URL:        url,
+		Format:     format,
+		EventTypes: eventTypes,
+		Secret:     "s3cret",
+		Enabled:    true,
+	}
+	require.NoError(t, repo.CreateSubscription(t.Context(), sub))
+
+	return sub
+}
+
+func listTestDeliveries(t *testing.T, repo port.WebhookRepository, subscriptionID string) entity.WebhookDeliveryList {
+	t.Helper()
+
+	result, err := repo.ListDeliveries(t.Context(), subscriptionID, 0, 100)
+	require.NoError(t, err)
+
+	return result.Items
+}
+
+func TestWebhookDispatcher_OnHostTerminated(t *testing.T) {
+	queries, _ := testutil.SetupTestDB(t)
+	repo := adapter.NewWebhookRepository(queries)
+
+	account := testutil.CreateTestHeadlessAccount(t, queries, "U-webhook-crash", "cred", "pass")
+	host := testutil.CreateTestHeadlessHost(t, queries, account.ResoniteID, "crashy", entity.HeadlessHostStatus_RUNNING)
+	running := testutil.CreateTestSession(t, queries, host.ID, "running", entity.SessionStatus_RUNNING)
+	testutil.CreateTestSession(t, queries, host.ID, "ended", entity.SessionStatus_ENDED)
+
+	sub := createTestWebhook(t, repo, "http://127.0.0.1/hook", entity.WebhookFormat_Generic)
+
+	d := worker.NewWebhookDispatcher(queries, repo, notification.NewBus())
+	d.OnHostTerminated(t.Context(), host, entity.HeadlessHostStatus_CRASHED)
+
+	deliveries := listTestDeliveries(t, repo, sub.ID)
+	require.Len(t, deliveries, 2)
+
+	byType := map[entity.WebhookEventType]map[string]any{}
+
+	for _, delivery := range deliveries {
+		assert.Equal(t, entity.WebhookDeliveryStatus_PENDING, delivery.Status)
+
+		var body map[string]any
+		require.NoError(t, json.Unmarshal(delivery.Body, &body))
+		byType[delivery.EventType] = body
+	}
+
+	require.Contains(t, byType, entity.WebhookEventType_HostCrashed)
+	assert.Equal(t, map[string]any{"host_id": host.ID, "status": "crashed"}, byType[entity.WebhookEventType_HostCrashed]["data"])
+	assert.Equal(t, "error", byType[entity.WebhookEventType_HostCrashed]["level"])
+
+	// ENDED のセッションはクラッシュとして通知しない.
+	require.Contains(t, byType, entity.WebhookEventType_SessionCrashed)
+	assert.Equal(t, map[string]any{"host_id": host.ID, "session_id": running.ID}, byType[entity.WebhookEventType_SessionCrashed]["data"])
+}
+
+func TestWebhookDispatcher_FiltersByEventTypeAndGroup(t *testing.T) {
+	queries, _ := testutil.SetupTestDB(t)
+	repo := adapter.NewWebhookRepository(queries)
+
+	account := testutil.CreateTestHeadlessAccount(t, queries, "U-webhook-filter", "cred", "pass")
+	host := testutil.CreateTestHeadlessHost(t, queries, account.ResoniteID, "host", entity.HeadlessHostStatus_RUNNING)
+	session := testutil.CreateTestSession(t, queries, host.ID, "みんなの部屋", entity.SessionStatus_RUNNING)
+
+	startedOnly := createTestWebhook(t, repo, "http://127.0.0.1/hook", entity.WebhookFormat_Discord, entity.WebhookEventType_SessionStarted)
+
+	// 別グループの subscription には届かない.
+	testutil.CreateTestGroup(t, queries, "g-webhook-other", "")
+	other := &entity.WebhookSubscription{
+		ID: "wh-other", GroupID: "g-webhook-other", Name: "other", URL: "http://127.0.0.1/other",
+		Format: entity.WebhookFormat_Generic, Secret: "x", Enabled: true,
+	}
+	require.NoError(t, repo.CreateSubscription(t.Context(), other))
+
+	d := worker.NewWebhookDispatcher(queries, repo, notification.NewBus())
+	d.HandleNotification(t.Context(), notification.SessionLifecycle(session.ID, host.ID, "", hdlctrlv1.SessionLifecycleEvent_KIND_STARTED, nil))
+	d.HandleNotification(t.Context(), notification.SessionLifecycle(session.ID, host.ID, "", hdlctrlv1.SessionLifecycleEvent_KIND_ENDED, nil))
+	d.HandleNotification(t.Context(), notification.SessionUserChanged(session.ID, host.ID, "alice", "", hdlctrlv1.SessionUserChangedEvent_KIND_JOINED, nil))
+
+	deliveries := listTestDeliveries(t, repo, startedOnly.ID)
+	require.Len(t, deliveries, 1)
+	assert.Equal(t, entity.WebhookEventType_SessionStarted, deliveries[0].EventType)
+
+	var body struct {
+		Embeds []struct {
+			Title string `json:"title"`
+		} `json:"embeds"`
+	}
+	require.NoError(t, json.Unmarshal(deliveries[0].Body, &body))
+	require.Len(t, body.Embeds, 1)
+	assert.Contains(t, body.Embeds[0].Title, "みんなの部屋")
+
+	assert.Empty(t, listTestDeliveries(t, repo, other.ID))
+}
+
+func TestWebhookDispatcher_JobFailed(t *testing.T) {
+	queries, _ := testutil.SetupTestDB(t)
+	repo := adapter.NewWebhookRepository(queries)
+	jobRepo := adapter.NewAsyncJobRepository(queries)
+
+	account := testutil.CreateTestHeadlessAccount(t, queries, "U-webhook-job", "cred", "pass")
+	host := testutil.CreateTestHeadlessHost(t, queries, account.ResoniteID, "host", entity.HeadlessHostStatus_RUNNING)
+
+	sub := createTestWebhook(t, repo, "http://127.0.0.1/hook", entity.WebhookFormat_Generic,
+		entity.WebhookEventType_JobFailed)
+
+	job, err := jobRepo.Create(t.Context(), port.AsyncJobCreateParams{
+		JobType: entity.AsyncJobType_RESTART_HOST,
+		HostID:  &host.ID,
+	})
+	require.NoError(t, err)
+
+	d := worker.NewWebhookDispatcher(queries, repo, notification.NewBus())
+	d.HandleNotification(t.Context(), notification.JobCompleted(job.ID, "ok", hdlctrlv1.JobCompletedEvent_LEVEL_SUCCESS))
+	d.HandleNotification(t.Context(), notification.JobCompleted(job.ID, "image pull failed", hdlctrlv1.JobCompletedEvent_LEVEL_ERROR))
+
+	deliveries := listTestDeliveries(t, repo, sub.ID)
+	require.Len(t, deliveries, 1)
+	assert.Equal(t, entity.WebhookEventType_JobFailed, deliveries[0].EventType)
+
+	var body map[string]any
+	require.NoError(t, json.Unmarshal(deliveries[0].Body, &body))
+	assert.Equal(t, "image pull failed", body["message"])
+	assert.Equal(t, map[string]any{"host_id": host.ID, "job_id": job.ID, "job_type": "restart_host"}, body["data"])
+}